@@ -1,40 +1,183 @@
 package banking
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
 	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
 	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
 	"github.com/ZanzyTHEbar/firedragon-go/internal" // Import internal for config types
-	// Add imports for OAuth2 and HTTP clients later
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	enableAPIURL        = "https://api.enablebanking.com"
+	enableSandboxAPIURL = "https://api.sandbox.enablebanking.com"
+
+	// enableNormalizerVersion is the version of the mapping of Enable Banking
+	// transactions (toTransaction). Bump it whenever that mapping changes.
+	enableNormalizerVersion = 2
+
+	// enableTokenTTL is the lifetime of the JWTs API requests are signed with
+	enableTokenTTL = time.Hour
 )
 
-// EnableClient implements the BankClient interface for Enable Banking API.
+// EnableClient implements the BankAccountClient interface for Enable Banking API.
 type EnableClient struct {
-	config *internal.EnableBankingConfig
-	// Add fields for HTTP client, OAuth token storage, etc.
+	config       *internal.EnableBankingConfig
+	baseURL      string               // production or sandbox API, unless overridden by api_url
+	balanceTypes []models.BalanceType // balance types GetBalance reports, most preferred first
+	location     *time.Location       // timezone of the booking dates, UTC when nil
+	httpClient   *http.Client
 }
 
 // NewEnableClient creates a new EnableClient.
-func NewEnableClient(cfg *internal.EnableBankingConfig) (interfaces.BankClient, error) {
-	// TODO: Initialize HTTP client, load tokens, etc.
+func NewEnableClient(cfg *internal.EnableBankingConfig) (interfaces.BankAccountClient, error) {
+	balanceTypes := make([]models.BalanceType, 0, len(cfg.BalanceTypes))
+	for _, name := range cfg.BalanceTypes {
+		balanceType := models.BalanceType(name)
+		if err := balanceType.Validate(); err != nil {
+			return nil, err
+		}
+		balanceTypes = append(balanceTypes, balanceType)
+	}
+
+	baseURL := cfg.APIURL
+	switch {
+	case baseURL != "":
+	case cfg.Sandbox:
+		baseURL = enableSandboxAPIURL
+	default:
+		baseURL = enableAPIURL
+	}
+
 	return &EnableClient{
-		config: cfg,
+		config:       cfg,
+		baseURL:      strings.TrimRight(baseURL, "/"),
+		balanceTypes: balanceTypes,
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
 	}, nil
 }
 
-// FetchTransactions retrieves transactions for a bank account.
-// TODO: Implement actual Enable Banking API call for transactions.
+// FetchTransactions retrieves the transactions the bank reports for an
+// account, following the continuation keys of the pages. Booking dates are
+// midnight in c.location.
 func (c *EnableClient) FetchTransactions(accountID string) ([]models.Transaction, error) {
-	// Placeholder implementation
-	return []models.Transaction{}, nil
+	var transactions []models.Transaction
+	continuationKey := ""
+	for {
+		path := "/accounts/" + url.PathEscape(accountID) + "/transactions"
+		if continuationKey != "" {
+			path += "?" + url.Values{"continuation_key": {continuationKey}}.Encode()
+		}
+
+		var page struct {
+			Transactions    []enableTransaction `json:"transactions"`
+			ContinuationKey string              `json:"continuation_key"`
+		}
+		if err := c.do(http.MethodGet, path, nil, &page); err != nil {
+			return nil, err
+		}
+		for _, bankTx := range page.Transactions {
+			tx, err := bankTx.toTransaction(accountID, c.location)
+			if err != nil {
+				return nil, interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "enable banking returned an invalid transaction", err)
+			}
+			transactions = append(transactions, tx)
+		}
+
+		if page.ContinuationKey == "" || page.ContinuationKey == continuationKey {
+			return transactions, nil
+		}
+		continuationKey = page.ContinuationKey
+	}
+}
+
+// enableBalanceTypes maps the ISO 20022 balance codes of the API to the
+// balance types used for reconciliation; other codes are skipped
+var enableBalanceTypes = map[string]models.BalanceType{
+	"OPBD": models.BalanceTypeOpeningBooked,
+	"PRCD": models.BalanceTypeOpeningBooked, // closing booked of the previous day
+	"CLBD": models.BalanceTypeClosingBooked,
+	"ITBD": models.BalanceTypeInterimBooked,
+	"ITAV": models.BalanceTypeInterimAvailable,
+	"CLAV": models.BalanceTypeInterimAvailable,
+	"FWAV": models.BalanceTypeForwardAvailable,
+	"XPCD": models.BalanceTypeExpected,
 }
 
-// GetBalance gets the current balance for a bank account.
-// TODO: Implement actual Enable Banking API call for balance.
+// FetchBalances retrieves every balance type the bank reports for an account.
+func (c *EnableClient) FetchBalances(accountID string) ([]interfaces.Balance, error) {
+	var response struct {
+		Balances []struct {
+			BalanceAmount struct {
+				Currency string `json:"currency"`
+				Amount   string `json:"amount"`
+			} `json:"balance_amount"`
+			BalanceType        string     `json:"balance_type"`
+			LastChangeDateTime *time.Time `json:"last_change_date_time"`
+			ReferenceDate      string     `json:"reference_date"`
+		} `json:"balances"`
+	}
+	if err := c.do(http.MethodGet, "/accounts/"+url.PathEscape(accountID)+"/balances", nil, &response); err != nil {
+		return nil, err
+	}
+
+	balances := make([]interfaces.Balance, 0, len(response.Balances))
+	for _, b := range response.Balances {
+		balanceType, ok := enableBalanceTypes[b.BalanceType]
+		if !ok {
+			continue
+		}
+		amount, err := models.ParseAmount(b.BalanceAmount.Amount, b.BalanceAmount.Currency)
+		if err != nil {
+			return nil, interfaces.NewClientError(interfaces.ErrorTypeProviderBug,
+				fmt.Sprintf("enable banking returned an invalid %s balance %q", b.BalanceType, b.BalanceAmount.Amount), err)
+		}
+
+		// The time of the last change, the reference date otherwise
+		var referenceDate time.Time
+		switch {
+		case b.LastChangeDateTime != nil:
+			referenceDate = *b.LastChangeDateTime
+		case b.ReferenceDate != "":
+			if referenceDate, err = models.ParseDate(time.DateOnly, b.ReferenceDate, c.location); err != nil {
+				return nil, interfaces.NewClientError(interfaces.ErrorTypeProviderBug,
+					fmt.Sprintf("enable banking returned an invalid reference date %q", b.ReferenceDate), err)
+			}
+		}
+
+		balances = append(balances, interfaces.Balance{
+			Amount:        amount,
+			Currency:      b.BalanceAmount.Currency,
+			BalanceType:   balanceType,
+			ReferenceDate: referenceDate,
+		})
+	}
+	return balances, nil
+}
+
+// GetBalance gets the current balance for a bank account, picking the most
+// preferred of the configured balance types the bank reports.
 func (c *EnableClient) GetBalance(accountID string) (models.BalanceInfo, error) {
-	// Placeholder implementation
+	balances, err := c.FetchBalances(accountID)
+	if err != nil {
+		return models.BalanceInfo{}, err
+	}
+	balance, ok := models.SelectBalance(balances, c.balanceTypes)
+	if !ok {
+		return models.BalanceInfo{}, interfaces.NewClientError(interfaces.ErrorTypeNotFound,
+			fmt.Sprintf("enable banking reported none of the configured balance types for account %s", accountID), nil)
+	}
 	return models.BalanceInfo{
-		Amount:   0.0,   // Placeholder
-		Currency: "USD", // Placeholder - Adjust based on actual account currency
+		Amount:      balance.Amount,
+		Currency:    balance.Currency,
+		BalanceType: balance.BalanceType,
 	}, nil
 }
 
@@ -43,6 +186,20 @@ func (c *EnableClient) GetProviderType() string {
 	return "enable"
 }
 
+// WithLocation returns a copy of the client that parses booking dates as
+// midnight in loc, the timezone of the bank
+func (c *EnableClient) WithLocation(loc *time.Location) interfaces.BankAccountClient {
+	clone := *c
+	clone.location = loc
+	return &clone
+}
+
+// NormalizerVersion returns the version of the mapping of Enable Banking
+// transactions
+func (c *EnableClient) NormalizerVersion() int {
+	return enableNormalizerVersion
+}
+
 // ValidateCredentials validates the client's credentials (e.g., checks token validity).
 // TODO: Implement credential validation logic.
 func (c *EnableClient) ValidateCredentials() error {
@@ -58,3 +215,147 @@ func (c *EnableClient) RefreshToken() error {
 	// Use OAuth2 library to refresh the token
 	return nil
 }
+
+// ConsentExpiry returns when the consent to a bank account expires: the
+// valid_until of the session of its last renewal, of the configured
+// session_id before one. It is zero when neither is known.
+func (c *EnableClient) ConsentExpiry(accountID, sessionID string) (time.Time, error) {
+	if sessionID == "" {
+		sessionID = c.config.SessionID
+	}
+	if sessionID == "" {
+		return time.Time{}, nil
+	}
+
+	var session enableSession
+	if err := c.do(http.MethodGet, "/sessions/"+url.PathEscape(sessionID), nil, &session); err != nil {
+		return time.Time{}, err
+	}
+	return session.Access.ValidUntil, nil
+}
+
+// ConsentURL starts an authorization at the bank of the configuration and
+// returns the bank page where the user grants access to its accounts again.
+// The bank redirects to the configured redirect URI with state and a code.
+func (c *EnableClient) ConsentURL(accountID, state string) (string, error) {
+	if c.config.RedirectURI == "" {
+		return "", fmt.Errorf("banking.enable.redirect_uri is required to renew consents")
+	}
+	if c.config.ASPSP == "" || c.config.Country == "" {
+		return "", fmt.Errorf("banking.enable.aspsp and banking.enable.country are required to renew consents")
+	}
+
+	request := enableAuthRequest{
+		State:       state,
+		RedirectURL: c.config.RedirectURI,
+		PSUType:     "personal",
+	}
+	request.Access.ValidUntil = time.Now().Add(models.ConsentValidity).UTC()
+	request.ASPSP.Name = c.config.ASPSP
+	request.ASPSP.Country = c.config.Country
+
+	var response struct {
+		URL string `json:"url"`
+	}
+	if err := c.do(http.MethodPost, "/auth", request, &response); err != nil {
+		return "", err
+	}
+	if response.URL == "" {
+		return "", interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "enable banking returned no authorization url", nil)
+	}
+	return response.URL, nil
+}
+
+// CompleteConsent exchanges the code of the redirect for a new session and
+// returns when its consent expires and the session ID, which later consent
+// checks of the account pass to ConsentExpiry.
+func (c *EnableClient) CompleteConsent(accountID, code string) (time.Time, string, error) {
+	var session enableSession
+	if err := c.do(http.MethodPost, "/sessions", map[string]string{"code": code}, &session); err != nil {
+		return time.Time{}, "", err
+	}
+	if session.SessionID == "" || session.Access.ValidUntil.IsZero() {
+		return time.Time{}, "", interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "enable banking returned a session without id or valid_until", nil)
+	}
+	return session.Access.ValidUntil, session.SessionID, nil
+}
+
+// enableAuthRequest is the body of POST /auth
+type enableAuthRequest struct {
+	Access struct {
+		ValidUntil time.Time `json:"valid_until"`
+	} `json:"access"`
+	ASPSP struct {
+		Name    string `json:"name"`
+		Country string `json:"country"`
+	} `json:"aspsp"`
+	State       string `json:"state"`
+	RedirectURL string `json:"redirect_url"`
+	PSUType     string `json:"psu_type"`
+}
+
+// enableSession is a session of POST /sessions and GET /sessions/{id}
+type enableSession struct {
+	SessionID string `json:"session_id"`
+	Access    struct {
+		ValidUntil time.Time `json:"valid_until"`
+	} `json:"access"`
+}
+
+// do sends a request to the API, signed with a JWT of the application, and
+// decodes the JSON response into out
+func (c *EnableClient) do(method, path string, body, out any) error {
+	token, err := c.token(time.Now())
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	if body != nil {
+		if payload, err = json.Marshal(body); err != nil {
+			return interfaces.NewClientError(interfaces.ErrorTypeValidation, "failed to encode enable banking request", err)
+		}
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return interfaces.NewClientError(interfaces.ErrorTypeValidation, "failed to create enable banking request", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return interfaces.NewTransportError("failed to call enable banking "+path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return interfaces.NewStatusError(resp, fmt.Sprintf("enable banking %s %s returned status %d", method, path, resp.StatusCode))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode enable banking response", err)
+	}
+	return nil
+}
+
+// token returns the JWT the API requests are authorized with: signed with
+// the private key of the application, its ID as key ID
+func (c *EnableClient) token(now time.Time) (string, error) {
+	if c.config.ClientSecret == "" {
+		return "", interfaces.NewClientError(interfaces.ErrorTypeAuth, "banking.enable.client_secret is required to call the API", nil)
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(c.config.ClientSecret))
+	if err != nil {
+		return "", interfaces.NewClientError(interfaces.ErrorTypeAuth, "banking.enable.client_secret is not a PEM private key", err)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+		Issuer:    "enablebanking.com",
+		Audience:  jwt.ClaimStrings{"api.enablebanking.com"},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(enableTokenTTL)),
+	})
+	token.Header["kid"] = c.config.ClientID
+	return token.SignedString(key)
+}
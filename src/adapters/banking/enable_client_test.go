@@ -0,0 +1,189 @@
+package banking
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newTestEnableClient(t *testing.T, handler http.HandlerFunc) *EnableClient {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	secret := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Every request carries a JWT of the application
+		token, err := jwt.Parse(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "),
+			func(token *jwt.Token) (any, error) { return &key.PublicKey, nil },
+			jwt.WithValidMethods([]string{"RS256"}), jwt.WithAudience("api.enablebanking.com"), jwt.WithIssuer("enablebanking.com"))
+		if err != nil || token.Header["kid"] != "app-id" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewEnableClient(&internal.EnableBankingConfig{
+		ClientID:     "app-id",
+		ClientSecret: string(secret),
+		RedirectURI:  "https://firedragon.example/api/firedragon/sources/consent/callback",
+		ASPSP:        "Nordea",
+		Country:      "FI",
+		SessionID:    "session-1",
+		APIURL:       server.URL,
+	})
+	if err != nil {
+		t.Fatalf("NewEnableClient() error = %v", err)
+	}
+	return client.(*EnableClient)
+}
+
+func TestEnableClient_Consent(t *testing.T) {
+	validUntil := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	client := newTestEnableClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /auth":
+			var request enableAuthRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.State != "state-1" ||
+				request.ASPSP.Name != "Nordea" || request.ASPSP.Country != "FI" || request.Access.ValidUntil.IsZero() {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"url": "https://tilisy.enablebanking.com/welcome?sessionid=abc", "authorization_id": "auth-1"}`)
+		case "POST /sessions":
+			var request map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request["code"] != "code-1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprintf(w, `{"session_id": "session-2", "accounts": [{"uid": "account-2"}], "access": {"valid_until": %q}}`, validUntil.Format(time.RFC3339))
+		case "GET /sessions/session-1":
+			fmt.Fprint(w, `{"session_id": "session-1", "access": {"valid_until": "2025-06-01T00:00:00Z"}}`)
+		case "GET /sessions/session-2":
+			fmt.Fprintf(w, `{"session_id": "session-2", "access": {"valid_until": %q}}`, validUntil.Format(time.RFC3339))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	// The configured session
+	expiry, err := client.ConsentExpiry("account-1", "")
+	if err != nil || !expiry.Equal(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("ConsentExpiry() = %v, %v, want the valid_until of session-1", expiry, err)
+	}
+
+	url, err := client.ConsentURL("account-1", "state-1")
+	if err != nil || url != "https://tilisy.enablebanking.com/welcome?sessionid=abc" {
+		t.Fatalf("ConsentURL() = %q, %v, want the url of the authorization", url, err)
+	}
+
+	expiresAt, session, err := client.CompleteConsent("account-1", "code-1")
+	if err != nil || !expiresAt.Equal(validUntil) || session != "session-2" {
+		t.Fatalf("CompleteConsent() = %v, %q, %v, want %v in session-2", expiresAt, session, err, validUntil)
+	}
+
+	// The renewed account is checked against the new session
+	if expiry, err := client.ConsentExpiry("account-1", session); err != nil || !expiry.Equal(validUntil) {
+		t.Errorf("ConsentExpiry() = %v, %v, want the valid_until of session-2", expiry, err)
+	}
+}
+
+func TestEnableClient_CompleteConsentFails(t *testing.T) {
+	client := newTestEnableClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	})
+
+	if expiresAt, _, err := client.CompleteConsent("account-1", "expired-code"); err == nil {
+		t.Errorf("CompleteConsent() = %v, want an error when the session is not created", expiresAt)
+	}
+}
+
+func TestEnableClient_ConsentURLNeedsBank(t *testing.T) {
+	client := newTestEnableClient(t, func(w http.ResponseWriter, r *http.Request) {})
+	client.config.ASPSP = ""
+
+	if _, err := client.ConsentURL("account-1", "state-1"); err == nil {
+		t.Error("ConsentURL() error = nil, want an error without the bank")
+	}
+}
+
+func TestEnableClient_FetchTransactions(t *testing.T) {
+	client := newTestEnableClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "GET /accounts/account-1/transactions" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// Two pages, linked by a continuation key
+		switch r.URL.Query().Get("continuation_key") {
+		case "":
+			fmt.Fprint(w, `{"transactions": [{"entry_reference": "tx-1", "transaction_amount": {"currency": "EUR", "amount": "12.50"},
+				"credit_debit_indicator": "DBIT", "creditor": {"name": "Bakery"}, "booking_date": "2025-03-01"}], "continuation_key": "page-2"}`)
+		case "page-2":
+			fmt.Fprint(w, `{"transactions": [{"entry_reference": "tx-2", "transaction_amount": {"currency": "EUR", "amount": "2000"},
+				"credit_debit_indicator": "CRDT", "debtor": {"name": "Employer"}, "booking_date": "2025-03-02"}]}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	transactions, err := client.FetchTransactions("account-1")
+	if err != nil {
+		t.Fatalf("FetchTransactions() error = %v", err)
+	}
+	if len(transactions) != 2 || transactions[0].ID != "tx-1" || transactions[1].ID != "tx-2" {
+		t.Fatalf("FetchTransactions() = %+v, want tx-1 and tx-2 of both pages", transactions)
+	}
+	if bakery := transactions[0]; bakery.Amount != 12.5 || bakery.Type != models.TransactionTypeExpense || bakery.Description != "Bakery" {
+		t.Errorf("tx-1 = %+v, want a 12.50 expense at the bakery", bakery)
+	}
+}
+
+func TestEnableClient_Balances(t *testing.T) {
+	client := newTestEnableClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "GET /accounts/account-1/balances" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"balances": [
+			{"balance_amount": {"currency": "EUR", "amount": "1500.00"}, "balance_type": "ITAV", "last_change_date_time": "2025-03-02T10:00:00Z"},
+			{"balance_amount": {"currency": "EUR", "amount": "1450.00"}, "balance_type": "CLBD", "reference_date": "2025-03-01"},
+			{"balance_amount": {"currency": "EUR", "amount": "1"}, "balance_type": "OTHR"}
+		]}`)
+	})
+
+	balances, err := client.FetchBalances("account-1")
+	if err != nil {
+		t.Fatalf("FetchBalances() error = %v", err)
+	}
+	if len(balances) != 2 || balances[1].BalanceType != models.BalanceTypeClosingBooked ||
+		!balances[1].ReferenceDate.Equal(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("FetchBalances() = %+v, want interimAvailable and closingBooked without the unknown type", balances)
+	}
+
+	balance, err := client.GetBalance("account-1")
+	if err != nil || balance.Amount != 1450 || balance.BalanceType != models.BalanceTypeClosingBooked {
+		t.Errorf("GetBalance() = %+v, %v, want the closing booked balance", balance, err)
+	}
+
+	client.balanceTypes = []models.BalanceType{models.BalanceTypeExpected}
+	if _, err := client.GetBalance("account-1"); err == nil {
+		t.Error("GetBalance() error = nil, want an error when no configured balance type is reported")
+	}
+}
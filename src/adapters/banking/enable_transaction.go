@@ -0,0 +1,107 @@
+package banking
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// enableTransaction is a transaction as returned by the Enable Banking accounts API
+type enableTransaction struct {
+	EntryReference    string `json:"entry_reference"`
+	TransactionAmount struct {
+		Currency string `json:"currency"`
+		Amount   string `json:"amount"`
+	} `json:"transaction_amount"`
+	Creditor             *enableParty   `json:"creditor"`
+	CreditorAccount      *enableAccount `json:"creditor_account"`
+	Debtor               *enableParty   `json:"debtor"`
+	DebtorAccount        *enableAccount `json:"debtor_account"`
+	CreditDebitIndicator string         `json:"credit_debit_indicator"` // CRDT or DBIT
+	BookingDate          string         `json:"booking_date"`
+	ValueDate            string         `json:"value_date"`
+	ReferenceNumber      string         `json:"reference_number"` // structured creditor reference, when the bank parsed one
+	RemittanceInfo       []string       `json:"remittance_information"`
+}
+
+type enableParty struct {
+	Name string `json:"name"`
+}
+
+type enableAccount struct {
+	IBAN string `json:"iban"`
+}
+
+// toTransaction converts a bank transaction, extracting the payment references
+// from its remittance information. Booking dates are midnight in loc.
+func (t enableTransaction) toTransaction(accountID string, loc *time.Location) (models.Transaction, error) {
+	amount, err := models.ParseAmount(t.TransactionAmount.Amount, t.TransactionAmount.Currency)
+	if err != nil {
+		return models.Transaction{}, fmt.Errorf("transaction %s: invalid amount %q", t.EntryReference, t.TransactionAmount.Amount)
+	}
+	if amount < 0 {
+		amount = -amount
+	}
+
+	dateValue := t.BookingDate
+	if dateValue == "" {
+		dateValue = t.ValueDate
+	}
+	date, err := models.ParseDate(time.DateOnly, dateValue, loc)
+	if err != nil {
+		return models.Transaction{}, fmt.Errorf("transaction %s: invalid booking date %q", t.EntryReference, dateValue)
+	}
+
+	// The counterparty is the creditor of a debit and the debtor of a credit
+	txType := models.TransactionTypeIncome
+	party, partyAccount := t.Debtor, t.DebtorAccount
+	if t.CreditDebitIndicator == "DBIT" {
+		txType = models.TransactionTypeExpense
+		party, partyAccount = t.Creditor, t.CreditorAccount
+	}
+
+	remittance := ParseRemittance(t.RemittanceInfo)
+	reference := t.ReferenceNumber
+	if reference == "" {
+		reference = remittance.Reference
+	}
+
+	metadata := map[string]string{
+		"bank":     "enable",
+		"account":  accountID,
+		"currency": t.TransactionAmount.Currency,
+	}
+	description := remittance.Memo
+	if party != nil && party.Name != "" {
+		metadata["counterparty"] = party.Name
+		description = party.Name
+	}
+	if partyAccount != nil && partyAccount.IBAN != "" {
+		metadata["counterpartyIban"] = partyAccount.IBAN
+	}
+	if remittance.Memo != "" {
+		metadata["memo"] = remittance.Memo
+	}
+	if remittance.MandateID != "" {
+		metadata["mandateId"] = remittance.MandateID
+	}
+	if description == "" {
+		description = fmt.Sprintf("Bank transaction %s", t.EntryReference)
+	}
+
+	return models.Transaction{
+		ID:          t.EntryReference,
+		Amount:      amount,
+		Description: description,
+		Date:        date,
+		Type:        txType,
+		Status:      models.TransactionStatusCompleted,
+		WalletID:    accountID,
+		Reference:   reference,
+		EndToEndID:  remittance.EndToEndID,
+		Metadata:    metadata,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}, nil
+}
@@ -0,0 +1,171 @@
+package banking
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Remittance holds the references extracted from the remittance information of a bank transaction
+type Remittance struct {
+	Reference  string // RF creditor reference or structured communication, else the invoice number
+	EndToEndID string // SEPA end-to-end ID assigned by the payer
+	MandateID  string // SEPA direct debit mandate
+	Memo       string // the remaining free text
+}
+
+// sepaTag matches the field tags German and Austrian banks put into SEPA remittance
+// information, e.g. "EREF+INV-2024-001 MREF+M123 SVWZ+Rent March"
+var sepaTag = regexp.MustCompile(`\b(EREF|KREF|MREF|CRED|DEBT|SVWZ|ABWA|ABWE|COAM|OAMT|IBAN|BIC)\+`)
+
+// endToEndLabel matches an end-to-end ID written out in free text
+var endToEndLabel = regexp.MustCompile(`(?i)\bend[- ]?to[- ]?end(?:[- ]?(?:id|ref(?:erence)?))?\.?:?\s*([A-Za-z0-9/\-?:().,'+]{1,35})`)
+
+// creditorReference matches the start of an ISO 11649 creditor reference
+var creditorReference = regexp.MustCompile(`\bRF\d{2}`)
+
+// structuredCommunication matches the Belgian structured communication, +++123/4567/89002+++
+var structuredCommunication = regexp.MustCompile(`[+*]{3}(\d{3})/(\d{4})/(\d{5})[+*]{3}`)
+
+// invoiceNumber matches an invoice number after its label, in the languages banks commonly use
+var invoiceNumber = regexp.MustCompile(`(?i)\b(?:invoice|inv\.?|rechnung|rechnungs-?nr\.?|rechnungsnummer|re\.?-?nr\.?|factuur|facture|faktura|fattura)\s*(?:no\.?|nr\.?|number|nummer|#)?\s*[:#]?\s*([A-Z0-9][A-Z0-9\-/.]*\d[A-Z0-9\-/]*)`)
+
+// ParseRemittance extracts the structured references from remittance information.
+// Lines are joined with spaces; banks split long texts at arbitrary positions.
+func ParseRemittance(lines []string) Remittance {
+	text := strings.Join(strings.Fields(strings.Join(lines, " ")), " ")
+
+	var remittance Remittance
+	memo := taggedFields(text, func(tag, value string) {
+		switch tag {
+		case "EREF":
+			if !strings.EqualFold(value, "NOTPROVIDED") {
+				remittance.EndToEndID = value
+			}
+		case "MREF":
+			remittance.MandateID = value
+		}
+	})
+
+	if remittance.EndToEndID == "" {
+		if match := endToEndLabel.FindStringSubmatch(memo); match != nil && !strings.EqualFold(match[1], "NOTPROVIDED") {
+			remittance.EndToEndID = match[1]
+		}
+	}
+
+	remittance.Reference = findCreditorReference(text)
+	if remittance.Reference == "" {
+		remittance.Reference = findStructuredCommunication(text)
+	}
+	if remittance.Reference == "" {
+		if match := invoiceNumber.FindStringSubmatch(memo); match != nil {
+			remittance.Reference = strings.TrimRight(match[1], ".-/")
+		}
+	}
+
+	remittance.Memo = memo
+	return remittance
+}
+
+// taggedFields reports every SEPA tagged field of text and returns the free
+// text: the purpose (SVWZ) when tagged, else everything outside the tags
+func taggedFields(text string, field func(tag, value string)) string {
+	matches := sepaTag.FindAllStringSubmatchIndex(text, -1)
+	if matches == nil {
+		return text
+	}
+
+	untagged := strings.TrimSpace(text[:matches[0][0]])
+	purpose := ""
+	for i, match := range matches {
+		end := len(text)
+		if i+1 < len(matches) {
+			end = matches[i+1][0]
+		}
+		tag, value := text[match[2]:match[3]], strings.TrimSpace(text[match[1]:end])
+		if tag == "SVWZ" {
+			purpose = value
+		}
+		field(tag, value)
+	}
+
+	if purpose != "" {
+		return purpose
+	}
+	return untagged
+}
+
+// findCreditorReference returns the first valid ISO 11649 creditor reference
+// in text. References may be printed in groups of four, so the longest run of
+// groups that passes the checksum wins.
+func findCreditorReference(text string) string {
+	for _, start := range creditorReference.FindAllStringIndex(text, -1) {
+		groups := strings.Fields(text[start[0]:])
+		candidate := ""
+		var valid string
+		for _, group := range groups {
+			if !isAlphanumeric(group) {
+				break
+			}
+			candidate += strings.ToUpper(group)
+			if len(candidate) > 25 {
+				break
+			}
+			if len(candidate) >= 5 && validCreditorReference(candidate) {
+				valid = candidate
+			}
+		}
+		if valid != "" {
+			return valid
+		}
+	}
+	return ""
+}
+
+// validCreditorReference checks the ISO 7064 mod 97-10 checksum of a creditor reference
+func validCreditorReference(reference string) bool {
+	rearranged := reference[4:] + reference[:4]
+
+	var digits strings.Builder
+	for _, r := range rearranged {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			digits.WriteString(strconv.Itoa(int(r-'A') + 10))
+		default:
+			return false
+		}
+	}
+
+	n, ok := new(big.Int).SetString(digits.String(), 10)
+	return ok && new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+// findStructuredCommunication returns the first Belgian structured communication
+// in text with a valid mod 97 check, formatted as +++123/4567/89002+++
+func findStructuredCommunication(text string) string {
+	for _, match := range structuredCommunication.FindAllStringSubmatch(text, -1) {
+		digits := match[1] + match[2] + match[3]
+		base, _ := strconv.ParseInt(digits[:10], 10, 64)
+		check, _ := strconv.ParseInt(digits[10:], 10, 64)
+		expected := base % 97
+		if expected == 0 {
+			expected = 97
+		}
+		if check == expected {
+			return "+++" + match[1] + "/" + match[2] + "/" + match[3] + "+++"
+		}
+	}
+	return ""
+}
+
+func isAlphanumeric(value string) bool {
+	for _, r := range value {
+		if !(r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z') {
+			return false
+		}
+	}
+	return value != ""
+}
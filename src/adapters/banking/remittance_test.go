@@ -0,0 +1,100 @@
+package banking
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+func TestParseRemittance(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  Remittance
+	}{
+		{
+			name:  "sepa tags",
+			lines: []string{"EREF+E2E-2024-0042 MREF+MANDATE7 CRED+DE98ZZZ09999999999 SVWZ+Rechnung Nr. 2024-118 Strom"},
+			want:  Remittance{Reference: "2024-118", EndToEndID: "E2E-2024-0042", MandateID: "MANDATE7", Memo: "Rechnung Nr. 2024-118 Strom"},
+		},
+		{
+			name:  "not provided",
+			lines: []string{"EREF+NOTPROVIDED SVWZ+Groceries"},
+			want:  Remittance{Memo: "Groceries"},
+		},
+		{
+			name:  "creditor reference split across lines",
+			lines: []string{"Payment RF18 5390", "0754 7034 thank you"},
+			want:  Remittance{Reference: "RF18539007547034", Memo: "Payment RF18 5390 0754 7034 thank you"},
+		},
+		{
+			name:  "invalid creditor reference",
+			lines: []string{"Payment RF19 5390 0754 7034"},
+			want:  Remittance{Memo: "Payment RF19 5390 0754 7034"},
+		},
+		{
+			name:  "structured communication",
+			lines: []string{"+++090/9337/55493+++"},
+			want:  Remittance{Reference: "+++090/9337/55493+++", Memo: "+++090/9337/55493+++"},
+		},
+		{
+			name:  "labelled end-to-end ID and invoice",
+			lines: []string{"End-to-end ID: ABC123 Invoice #INV-77"},
+			want:  Remittance{Reference: "INV-77", EndToEndID: "ABC123", Memo: "End-to-end ID: ABC123 Invoice #INV-77"},
+		},
+		{
+			name:  "creditor reference wins over invoice",
+			lines: []string{"Invoice 4711 RF712348231"},
+			want:  Remittance{Reference: "RF712348231", Memo: "Invoice 4711 RF712348231"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseRemittance(tt.lines); got != tt.want {
+				t.Errorf("ParseRemittance() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnableTransaction_ToTransaction(t *testing.T) {
+	var bankTx enableTransaction
+	err := json.Unmarshal([]byte(`{
+		"entry_reference": "E1",
+		"transaction_amount": {"currency": "EUR", "amount": "-42.50"},
+		"creditor": {"name": "Stadtwerke"},
+		"creditor_account": {"iban": "DE02120300000000202051"},
+		"credit_debit_indicator": "DBIT",
+		"booking_date": "2024-03-01",
+		"remittance_information": ["EREF+E2E-9 SVWZ+RF18 5390 0754 7034 Strom"]
+	}`), &bankTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := bankTx.toTransaction("acc1", nil)
+	if err != nil {
+		t.Fatalf("toTransaction() error = %v", err)
+	}
+	if tx.Type != models.TransactionTypeExpense || tx.Amount != 42.5 || tx.Description != "Stadtwerke" {
+		t.Errorf("transaction = %+v, want a 42.50 expense to Stadtwerke", tx)
+	}
+	if tx.Reference != "RF18539007547034" || tx.EndToEndID != "E2E-9" {
+		t.Errorf("reference = %q, end-to-end ID = %q", tx.Reference, tx.EndToEndID)
+	}
+	if tx.Metadata["counterparty"] != "Stadtwerke" || tx.Metadata["memo"] != "RF18 5390 0754 7034 Strom" {
+		t.Errorf("metadata = %v", tx.Metadata)
+	}
+
+	berlin := time.FixedZone("CET", 60*60)
+	tx, err = bankTx.toTransaction("acc1", berlin)
+	if err != nil {
+		t.Fatalf("toTransaction() error = %v", err)
+	}
+	if want := time.Date(2024, 2, 29, 23, 0, 0, 0, time.UTC); !tx.Date.Equal(want) {
+		t.Errorf("date = %v, want midnight in Berlin %v", tx.Date.UTC(), want)
+	}
+}
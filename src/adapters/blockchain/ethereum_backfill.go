@@ -0,0 +1,102 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+// backfillPageSize is the number of explorer entries requested per backfill page
+const backfillPageSize = 1000
+
+// backfillActions are the explorer lists a backfill pages through on every network
+var backfillActions = []string{"txlist", "tokentx"}
+
+// ethereumCursor is the position of a backfill. The history of an address is
+// read as one stream per network and explorer list, each from its oldest block.
+type ethereumCursor struct {
+	Stream int   `json:"stream"` // index of the current network and list
+	Block  int64 `json:"block"`  // first block of the next page
+	Oldest int64 `json:"oldest"` // unix time of the stream's oldest entry, for progress
+}
+
+// FetchTransactionPage returns the next page of the full history of an
+// address, oldest first; an empty cursor starts at the beginning. Pages start
+// at the last block of the previous page, so entries of a block split across
+// two pages are fetched twice rather than missed and are deduplicated on import.
+// In field fee mode, gas is only attached to transactions of the same page.
+func (c *EthereumClient) FetchTransactionPage(address, cursor string) (models.TransactionPage, error) {
+	var page models.TransactionPage
+	var position ethereumCursor
+	if cursor != "" {
+		if err := json.Unmarshal([]byte(cursor), &position); err != nil {
+			return page, interfaces.NewClientError(interfaces.ErrorTypeValidation, "invalid backfill cursor", err)
+		}
+	}
+
+	networks := c.networksFor(address)
+	streams := len(networks) * len(backfillActions)
+	if position.Stream >= streams {
+		page.Done, page.Progress = true, 1
+		return page, nil
+	}
+	network := networks[position.Stream/len(backfillActions)]
+	action := backfillActions[position.Stream%len(backfillActions)]
+
+	var result []etherscanTransaction
+	params := url.Values{
+		"action":     {action},
+		"address":    {address},
+		"sort":       {"asc"},
+		"startblock": {strconv.FormatInt(position.Block, 10)},
+		"page":       {"1"},
+		"offset":     {strconv.Itoa(backfillPageSize)},
+	}
+	if err := c.query(network, params, &result); err != nil {
+		return page, err
+	}
+
+	filter := c.filters.forAddress(address)
+	var fees []networkFee
+	for _, tx := range result {
+		if action == "tokentx" && !filter.allows(tx.TokenSymbol, tx.ContractAddress, &page.Filtered) {
+			continue
+		}
+		if transaction, ok := mapEthereumTransaction(address, network, tx); ok {
+			page.Transactions = append(page.Transactions, transaction)
+		}
+		if fee, ok := ethereumFee(address, network, tx); ok && action == "txlist" {
+			fees = append(fees, fee)
+		}
+	}
+	page.Transactions = applyFees(address, page.Transactions, fees, c.feeMode)
+
+	next := ethereumCursor{Stream: position.Stream + 1}
+	fraction := 0.0
+	if len(result) == backfillPageSize {
+		first, _ := strconv.ParseInt(result[0].TimeStamp, 10, 64)
+		last, _ := strconv.ParseInt(result[len(result)-1].TimeStamp, 10, 64)
+		block, _ := strconv.ParseInt(result[len(result)-1].BlockNumber, 10, 64)
+
+		next = ethereumCursor{Stream: position.Stream, Block: max(block, position.Block+1), Oldest: position.Oldest}
+		if next.Oldest == 0 {
+			next.Oldest = first
+		}
+		if span := time.Now().Unix() - next.Oldest; span > 0 {
+			fraction = min(float64(last-next.Oldest)/float64(span), 1)
+		}
+	}
+
+	encoded, err := json.Marshal(next)
+	if err != nil {
+		return page, interfaces.NewClientError(interfaces.ErrorTypeValidation, "failed to encode backfill cursor", err)
+	}
+	page.NextCursor = string(encoded)
+	page.Progress = (float64(next.Stream) + fraction) / float64(streams)
+	page.Done = next.Stream >= streams
+	return page, nil
+}
@@ -0,0 +1,82 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+func TestEthereumClient_FetchTransactionPage(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		requests = append(requests, query.Get("action")+"@"+query.Get("startblock"))
+		if query.Get("sort") != "asc" {
+			t.Errorf("sort = %q, want asc", query.Get("sort"))
+		}
+
+		var result []map[string]string
+		if query.Get("action") == "txlist" {
+			switch query.Get("startblock") {
+			case "0":
+				for block := 1; block <= backfillPageSize; block++ {
+					result = append(result, map[string]string{
+						"hash": fmt.Sprintf("0x%d", block), "blockNumber": fmt.Sprint(block), "timeStamp": fmt.Sprint(1600000000 + block),
+						"from": "0xother", "to": testEthereumAddress, "value": "1000000000000000000", "isError": "0",
+					})
+				}
+			case fmt.Sprint(backfillPageSize):
+				result = append(result, map[string]string{
+					"hash": "0xlast", "blockNumber": fmt.Sprint(backfillPageSize + 1), "timeStamp": "1600002000",
+					"from": "0xother", "to": testEthereumAddress, "value": "1000000000000000000", "isError": "0",
+				})
+			}
+		}
+		data, _ := json.Marshal(result)
+		fmt.Fprintf(w, `{"status":"1","message":"OK","result":%s}`, data)
+	}))
+	defer server.Close()
+
+	client, err := NewEthereumClient(&internal.EthereumConfig{
+		APIKey:   "key",
+		Networks: map[string]internal.EthereumNetworkConfig{"ethereum": {ExplorerURL: server.URL}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewEthereumClient() error = %v", err)
+	}
+	ethereum := client.(*EthereumClient)
+
+	var cursor string
+	var imported []int
+	var progress float64
+	for i := 0; i < 5; i++ {
+		page, err := ethereum.FetchTransactionPage(testEthereumAddress, cursor)
+		if err != nil {
+			t.Fatalf("FetchTransactionPage() error = %v", err)
+		}
+		if page.Progress < progress {
+			t.Errorf("progress went back from %f to %f", progress, page.Progress)
+		}
+		progress = page.Progress
+		imported = append(imported, len(page.Transactions))
+		cursor = page.NextCursor
+		if page.Done {
+			break
+		}
+	}
+
+	wantRequests := []string{"txlist@0", fmt.Sprintf("txlist@%d", backfillPageSize), "tokentx@0"}
+	if fmt.Sprint(requests) != fmt.Sprint(wantRequests) {
+		t.Errorf("requests = %v, want %v", requests, wantRequests)
+	}
+	if fmt.Sprint(imported) != fmt.Sprint([]int{backfillPageSize, 1, 0}) {
+		t.Errorf("transactions per page = %v", imported)
+	}
+	if progress != 1 {
+		t.Errorf("final progress = %f, want 1", progress)
+	}
+}
@@ -1,74 +1,480 @@
 package blockchain
 
 import (
-"github.com/ZanzyTHEbar/firedragon-go/domain/models"
-"github.com/ZanzyTHEbar/firedragon-go/internal" // Import the internal package
-"github.com/ZanzyTHEbar/firedragon-go/interfaces"
-// Add imports for Ethereum specific libraries later (e.g., go-ethereum)
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/address"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/payloads"
 )
 
-// EthereumClient implements the BlockchainClient interface for Ethereum.
+const etherscanAPIBaseURL = "https://api.etherscan.io/v2/api"
+
+// ethereumNormalizerVersion is the version of the mapping of explorer
+// responses to transactions. Bump it whenever that mapping changes, so the
+// transactions imported before can be re-normalized from their archived payloads.
+const ethereumNormalizerVersion = 2
+
+// defaultEthereumNetworks are the networks that work without an explorer URL.
+// They all go through the Etherscan multichain API and differ only in chain ID.
+var defaultEthereumNetworks = map[string]internal.EthereumNetworkConfig{
+	"ethereum": {ExplorerURL: etherscanAPIBaseURL, ChainID: 1, NativeCurrency: "ETH"},
+	"sepolia":  {ExplorerURL: etherscanAPIBaseURL, ChainID: 11155111, NativeCurrency: "ETH"},
+	"arbitrum": {ExplorerURL: etherscanAPIBaseURL, ChainID: 42161, NativeCurrency: "ETH"},
+	"optimism": {ExplorerURL: etherscanAPIBaseURL, ChainID: 10, NativeCurrency: "ETH"},
+	"base":     {ExplorerURL: etherscanAPIBaseURL, ChainID: 8453, NativeCurrency: "ETH"},
+}
+
+// ethereumNetwork is a resolved network with its explorer endpoint
+type ethereumNetwork struct {
+	Name string
+	internal.EthereumNetworkConfig
+}
+
+// EthereumClient implements the BlockchainClient interface for Ethereum and
+// EVM L2 networks, reading history from Etherscan-compatible explorer APIs.
 type EthereumClient struct {
-config *internal.EthereumConfig // Use the correct config type
-// Add any necessary fields, like an HTTP client or RPC client instance
+	networks        []ethereumNetwork
+	addressNetworks map[string][]string // lower-cased address -> network names
+	httpClient      *http.Client
+	filters         *tokenFilters
+	feeMode         models.FeeMode
+}
+
+// NewEthereumClient creates a new EthereumClient for the configured networks.
+// Without a networks section only the network named by NetworkType is used,
+// or Sepolia in sandbox mode. httpClient may be nil.
+func NewEthereumClient(cfg *internal.EthereumConfig, httpClient *http.Client) (interfaces.BlockchainClient, error) {
+	configured := cfg.Networks
+	if len(configured) == 0 {
+		name := cfg.NetworkType
+		switch {
+		case cfg.Sandbox && (name == "" || name == "mainnet"):
+			name = "sepolia"
+		case name == "" || name == "mainnet":
+			name = "ethereum"
+		}
+		configured = map[string]internal.EthereumNetworkConfig{name: {}}
+	}
+
+	networks := make([]ethereumNetwork, 0, len(configured))
+	for name, network := range configured {
+		resolved, err := resolveEthereumNetwork(name, network, cfg.APIKey)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, resolved)
+	}
+	sort.Slice(networks, func(i, j int) bool { return networks[i].Name < networks[j].Name })
+
+	addressNetworks := make(map[string][]string, len(cfg.AddressNetworks))
+	for address, names := range cfg.AddressNetworks {
+		addressNetworks[strings.ToLower(address)] = names
+	}
+
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	filters, err := newTokenFilters(cfg.TokenFilter, cfg.AddressTokenFilters, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EthereumClient{
+		networks:        networks,
+		addressNetworks: addressNetworks,
+		httpClient:      httpClient,
+		filters:         filters,
+		feeMode:         feeMode(cfg.FeeMode),
+	}, nil
+}
+
+// WithTransport returns a copy of the client whose explorer calls go through
+// the transport wrap returns, e.g. to archive or replay the raw responses
+func (c *EthereumClient) WithTransport(wrap func(http.RoundTripper) http.RoundTripper) interfaces.BlockchainClient {
+	clone := *c
+	clone.httpClient = payloads.Client(c.httpClient, wrap)
+	return &clone
+}
+
+// resolveEthereumNetwork fills in the defaults of a known network and the shared API key
+func resolveEthereumNetwork(name string, network internal.EthereumNetworkConfig, apiKey string) (ethereumNetwork, error) {
+	defaults, known := defaultEthereumNetworks[name]
+	if !known && network.ExplorerURL == "" {
+		return ethereumNetwork{}, fmt.Errorf("ethereum network %q needs an explorer_url", name)
+	}
+
+	if network.ExplorerURL == "" {
+		network.ExplorerURL = defaults.ExplorerURL
+		if network.ChainID == 0 {
+			network.ChainID = defaults.ChainID
+		}
+	}
+	if network.NativeCurrency == "" {
+		network.NativeCurrency = defaults.NativeCurrency
+	}
+	if network.NativeCurrency == "" {
+		network.NativeCurrency = "ETH"
+	}
+	if network.APIKey == "" {
+		network.APIKey = apiKey
+	}
+
+	return ethereumNetwork{Name: name, EthereumNetworkConfig: network}, nil
+}
+
+// networksFor returns the networks an address is imported from
+func (c *EthereumClient) networksFor(address string) []ethereumNetwork {
+	names, ok := c.addressNetworks[strings.ToLower(address)]
+	if !ok {
+		return c.networks
+	}
+
+	var networks []ethereumNetwork
+	for _, network := range c.networks {
+		for _, name := range names {
+			if network.Name == name {
+				networks = append(networks, network)
+				break
+			}
+		}
+	}
+	return networks
+}
+
+// etherscanTransaction matches an entry of the explorer's txlist and tokentx responses
+type etherscanTransaction struct {
+	Hash        string `json:"hash"`
+	BlockNumber string `json:"blockNumber"`
+	TimeStamp   string `json:"timeStamp"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Value       string `json:"value"` // wei, or token base units for token transfers
+	IsError     string `json:"isError"`
+	GasUsed     string `json:"gasUsed"`
+	GasPrice    string `json:"gasPrice"` // wei
+
+	// ERC-20 transfers only
+	ContractAddress string `json:"contractAddress"`
+	TokenSymbol     string `json:"tokenSymbol"`
+	TokenDecimal    string `json:"tokenDecimal"`
+}
+
+// query calls an explorer API action and decodes its result into out
+func (c *EthereumClient) query(network ethereumNetwork, params url.Values, out interface{}) error {
+	params.Set("module", "account")
+	params.Set("apikey", network.APIKey)
+	if network.ChainID != 0 {
+		params.Set("chainid", strconv.FormatInt(network.ChainID, 10))
+	}
+
+	req, err := http.NewRequest("GET", network.ExplorerURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return interfaces.NewClientError(interfaces.ErrorTypeValidation, "failed to create "+network.Name+" request", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return interfaces.NewTransportError("failed to query "+network.Name+" explorer", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return interfaces.NewStatusError(resp, fmt.Sprintf("%s explorer returned non-200 status: %d", network.Name, resp.StatusCode))
+	}
+
+	var envelope struct {
+		Status  string          `json:"status"`
+		Message string          `json:"message"`
+		Result  json.RawMessage `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode "+network.Name+" response", err)
+	}
+
+	// Errors come back with status 0 and a message string as the result;
+	// an empty history also has status 0 but an empty list.
+	if envelope.Status != "1" {
+		var message string
+		if json.Unmarshal(envelope.Result, &message) == nil {
+			return interfaces.NewClientError(explorerErrorType(message), fmt.Sprintf("%s explorer: %s", network.Name, message), nil)
+		}
+	}
+
+	if err := json.Unmarshal(envelope.Result, out); err != nil {
+		return interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode "+network.Name+" result", err)
+	}
+	return nil
 }
 
-// NewEthereumClient creates a new EthereumClient.
-func NewEthereumClient(cfg *internal.EthereumConfig) (interfaces.BlockchainClient, error) { // Use the correct config type
-// TODO: Initialize any necessary dependencies (e.g., RPC client from go-ethereum)
-return &EthereumClient{
-config: cfg,
-}, nil
+// explorerErrorType classifies the message an Etherscan-compatible explorer returns instead of a result
+func explorerErrorType(message string) interfaces.ErrorType {
+	message = strings.ToLower(message)
+	switch {
+	case strings.Contains(message, "api key"):
+		return interfaces.ErrorTypeAuth
+	case strings.Contains(message, "rate limit"):
+		return interfaces.ErrorTypeRateLimit
+	case strings.Contains(message, "timeout"), strings.Contains(message, "timed out"):
+		return interfaces.ErrorTypeTimeout
+	default:
+		return interfaces.ErrorTypeValidation
+	}
 }
 
-// FetchTransactions retrieves transactions for a wallet address.
-// TODO: Implement actual Ethereum transaction retrieval logic (e.g., using Etherscan API or RPC calls).
+// FetchTransactions retrieves the native coin and ERC-20 transactions of an address
+// on every network it is configured for. Each transaction is tagged with its network.
+// Gas paid by the address is booked according to the configured fee mode.
 func (c *EthereumClient) FetchTransactions(address string) ([]models.Transaction, error) {
-// Placeholder implementation
-return []models.Transaction{}, nil
+	transactions, _, err := c.FetchFilteredTransactions(address)
+	return transactions, err
 }
 
-// GetBalance gets the current balance for a wallet address.
-// TODO: Implement actual Ethereum balance retrieval logic.
-func (c *EthereumClient) GetBalance(address string) (models.BalanceInfo, error) {
-// Placeholder implementation
-return models.BalanceInfo{
-// Populate with placeholder or actual data later
-Amount:   0.0, // Placeholder
-Currency: "ETH", // Placeholder
-}, nil
+// FetchFilteredTransactions retrieves the transactions of an address and counts
+// the ERC-20 transfers dropped by the address's token filter
+func (c *EthereumClient) FetchFilteredTransactions(address string) ([]models.Transaction, models.TokenFilterStats, error) {
+	var stats models.TokenFilterStats
+	filter := c.filters.forAddress(address)
+
+	var transactions []models.Transaction
+	var fees []networkFee
+	for _, network := range c.networksFor(address) {
+		var result []etherscanTransaction
+		params := url.Values{"action": {"txlist"}, "address": {address}, "sort": {"desc"}, "page": {"1"}, "offset": {"100"}}
+		if err := c.query(network, params, &result); err != nil {
+			return nil, stats, err
+		}
+
+		for _, tx := range result {
+			if transaction, ok := mapEthereumTransaction(address, network, tx); ok {
+				transactions = append(transactions, transaction)
+			}
+			if fee, ok := ethereumFee(address, network, tx); ok {
+				fees = append(fees, fee)
+			}
+		}
+
+		var transfers []etherscanTransaction
+		params = url.Values{"action": {"tokentx"}, "address": {address}, "sort": {"desc"}, "page": {"1"}, "offset": {"100"}}
+		if err := c.query(network, params, &transfers); err != nil {
+			return nil, stats, err
+		}
+
+		for _, tx := range transfers {
+			if !filter.allows(tx.TokenSymbol, tx.ContractAddress, &stats) {
+				continue
+			}
+			if transaction, ok := mapEthereumTransaction(address, network, tx); ok {
+				transactions = append(transactions, transaction)
+			}
+		}
+	}
+
+	return applyFees(address, transactions, fees, c.feeMode), stats, nil
 }
 
-// GetChainType returns the blockchain type.
-func (c *EthereumClient) GetChainType() string {
-	return "ethereum"
+// ethereumFee returns the gas an address paid for a transaction it sent,
+// including failed ones
+func ethereumFee(address string, network ethereumNetwork, tx etherscanTransaction) (networkFee, bool) {
+	if !strings.EqualFold(tx.From, address) {
+		return networkFee{}, false
+	}
+
+	gasUsed, ok := new(big.Int).SetString(tx.GasUsed, 10)
+	if !ok {
+		return networkFee{}, false
+	}
+	gasPrice, ok := new(big.Int).SetString(tx.GasPrice, 10)
+	if !ok {
+		return networkFee{}, false
+	}
+
+	timestamp, _ := strconv.ParseInt(tx.TimeStamp, 10, 64)
+	return networkFee{
+		TxHash:   tx.Hash,
+		Date:     time.Unix(timestamp, 0),
+		Amount:   baseUnitsToAmount(new(big.Int).Mul(gasUsed, gasPrice).String(), 18),
+		Currency: network.NativeCurrency,
+		Chain:    "ethereum",
+		Network:  network.Name,
+	}, true
 }
 
-// IsValidAddress validates a wallet address format.
-// TODO: Implement actual Ethereum address validation logic (e.g., using go-ethereum/common).
-func (c *EthereumClient) IsValidAddress(address string) bool {
-	// Placeholder implementation - assumes valid for now
-	// A real implementation would check length, prefix, checksum etc.
-	return true
+// mapEthereumTransaction maps an explorer transaction or token transfer as seen
+// from the address. Failed transactions and contract calls without value are skipped.
+func mapEthereumTransaction(address string, network ethereumNetwork, tx etherscanTransaction) (models.Transaction, bool) {
+	if tx.IsError == "1" {
+		return models.Transaction{}, false
+	}
+
+	currency := network.NativeCurrency
+	decimals := 18
+	if tx.ContractAddress != "" {
+		currency = tx.TokenSymbol
+		decimals, _ = strconv.Atoi(tx.TokenDecimal)
+	}
+
+	amount := baseUnitsToAmount(tx.Value, decimals)
+	if amount == 0 {
+		return models.Transaction{}, false
+	}
+
+	isSender := strings.EqualFold(tx.From, address)
+	isReceiver := strings.EqualFold(tx.To, address)
+
+	txType := models.TransactionTypeTransfer // self-transfer
+	description := fmt.Sprintf("Moved %s %s on %s", models.FormatAmountCompact(amount, currency), currency, network.Name)
+	if isSender && !isReceiver {
+		txType = models.TransactionTypeExpense
+		description = fmt.Sprintf("Sent %s %s on %s", models.FormatAmountCompact(amount, currency), currency, network.Name)
+	} else if !isSender && isReceiver {
+		txType = models.TransactionTypeIncome
+		description = fmt.Sprintf("Received %s %s on %s", models.FormatAmountCompact(amount, currency), currency, network.Name)
+	}
+
+	timestamp, _ := strconv.ParseInt(tx.TimeStamp, 10, 64)
+
+	transaction := models.Transaction{
+		ID:          tx.Hash,
+		Amount:      amount,
+		Description: description,
+		Date:        time.Unix(timestamp, 0),
+		Type:        txType,
+		Status:      models.TransactionStatusCompleted,
+		WalletID:    address,
+		Tags:        []string{network.Name},
+		Metadata: map[string]string{
+			"chain":    "ethereum",
+			"network":  network.Name,
+			"address":  address,
+			"txHash":   tx.Hash,
+			"currency": currency,
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if tx.ContractAddress != "" {
+		transaction.Metadata["contract"] = tx.ContractAddress
+	}
+
+	// The other side of the transfer, for description templates
+	counterparty := tx.From
+	if isSender {
+		counterparty = tx.To
+	}
+	transaction.Metadata["counterparty"] = counterparty
+
+	return transaction, true
 }
 
-// --- Methods below are not part of the BlockchainClient interface ---
-// --- They might be useful helpers but were removed/commented out ---
-/*
-// GetLatestBlockNumber retrieves the latest block number on the blockchain.
-func (c *EthereumClient) GetLatestBlockNumber(ctx context.Context) (uint64, error) {
-	// Placeholder implementation
-	return 0, nil
+// baseUnitsToAmount converts a decimal string of base units (wei for ether) to a
+// whole-unit amount
+func baseUnitsToAmount(value string, decimals int) float64 {
+	units, ok := new(big.Float).SetString(value)
+	if !ok {
+		return 0
+	}
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	amount, _ := new(big.Float).Quo(units, scale).Float64()
+	return amount
+}
+
+// erc20TransferTopic is the log topic of the ERC-20 Transfer(address,address,uint256) event
+const erc20TransferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// CanWatch reports whether any network of the address has a websocket endpoint
+func (c *EthereumClient) CanWatch(address string) bool {
+	for _, network := range c.networksFor(address) {
+		if network.WebSocketURL != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// WatchAddress subscribes to confirmed ERC-20 transfers from and to the address on
+// every network with a websocket endpoint and calls notify for each one. Plain
+// ether transfers emit no logs; they are picked up by the sync that follows.
+// It returns as soon as one of the subscriptions drops.
+func (c *EthereumClient) WatchAddress(ctx context.Context, address string, notify func()) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	topic := "0x000000000000000000000000" + strings.TrimPrefix(strings.ToLower(address), "0x")
+	requests := []rpcRequest{
+		{ID: 1, Method: "eth_subscribe", Params: []any{"logs", map[string]any{"topics": []any{erc20TransferTopic, topic}}}},
+		{ID: 2, Method: "eth_subscribe", Params: []any{"logs", map[string]any{"topics": []any{erc20TransferTopic, nil, topic}}}},
+	}
+
+	errs := make(chan error, len(c.networks))
+	watching := 0
+	for _, network := range c.networksFor(address) {
+		if network.WebSocketURL == "" {
+			continue
+		}
+		watching++
+		go func(network ethereumNetwork) {
+			err := subscribe(ctx, network.WebSocketURL, "eth_subscription", requests, notify)
+			errs <- fmt.Errorf("%s: %w", network.Name, err)
+		}(network)
+	}
+	if watching == 0 {
+		return interfaces.NewClientError(interfaces.ErrorTypeValidation, "no websocket endpoint configured for "+address, nil)
+	}
+
+	// Stop the remaining subscriptions and wait for them before returning
+	err := <-errs
+	cancel()
+	for i := 1; i < watching; i++ {
+		<-errs
+	}
+	return err
+}
+
+// GetBalance gets the native ETH balance of an address summed over its networks.
+// Networks with another native currency are left out of the sum.
+func (c *EthereumClient) GetBalance(address string) (models.BalanceInfo, error) {
+	balanceInfo := models.BalanceInfo{Currency: "ETH"}
+	for _, network := range c.networksFor(address) {
+		if network.NativeCurrency != balanceInfo.Currency {
+			continue
+		}
+
+		var wei string
+		if err := c.query(network, url.Values{"action": {"balance"}, "address": {address}, "tag": {"latest"}}, &wei); err != nil {
+			return balanceInfo, err
+		}
+		balanceInfo.Amount += baseUnitsToAmount(wei, 18)
+	}
+
+	return balanceInfo, nil
+}
+
+// GetChainType returns the blockchain type.
+func (c *EthereumClient) GetChainType() string {
+	return "ethereum"
 }
 
-// GetTransactionByHash retrieves a specific transaction by its hash.
-func (c *EthereumClient) GetTransactionByHash(ctx context.Context, hash string) (*models.Transaction, error) {
-	// Placeholder implementation
-	return nil, nil // Indicate not found or error
+// NormalizerVersion returns the version of the mapping of explorer responses
+// to transactions
+func (c *EthereumClient) NormalizerVersion() int {
+	return ethereumNormalizerVersion
 }
 
-// GetNativeCurrencySymbol returns the native currency symbol for the blockchain.
-func (c *EthereumClient) GetNativeCurrencySymbol() string {
-	return "ETH"
+// IsValidAddress reports whether account is a hex address with a valid
+// EIP-55 checksum, if it carries one
+func (c *EthereumClient) IsValidAddress(account string) bool {
+	return address.ValidateEthereum(account) == nil
 }
-*/
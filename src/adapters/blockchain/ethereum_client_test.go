@@ -0,0 +1,161 @@
+package blockchain
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+const testEthereumAddress = "0xAbC0000000000000000000000000000000000001"
+
+func TestEthereumClient_FetchTransactionsAcrossNetworks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("apikey") == "" {
+			fmt.Fprint(w, `{"status":"0","message":"NOTOK","result":"Missing/Invalid API Key"}`)
+			return
+		}
+		if r.URL.Query().Get("action") == "tokentx" {
+			fmt.Fprint(w, `{"status":"0","message":"No transactions found","result":[]}`)
+			return
+		}
+		switch r.URL.Path {
+		case "/mainnet":
+			fmt.Fprint(w, `{"status":"1","message":"OK","result":[
+				{"hash":"0x1","timeStamp":"1700000000","from":"0xother","to":"0xabc0000000000000000000000000000000000001","value":"1500000000000000000","isError":"0"},
+				{"hash":"0x2","timeStamp":"1700000100","from":"0xabc0000000000000000000000000000000000001","to":"0xcontract","value":"0","isError":"0"}
+			]}`)
+		case "/arbitrum":
+			fmt.Fprint(w, `{"status":"1","message":"OK","result":[
+				{"hash":"0x3","timeStamp":"1700000200","from":"0xabc0000000000000000000000000000000000001","to":"0xother","value":"250000000000000000","isError":"0"}
+			]}`)
+		default:
+			fmt.Fprint(w, `{"status":"0","message":"No transactions found","result":[]}`)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewEthereumClient(&internal.EthereumConfig{
+		APIKey: "shared",
+		Networks: map[string]internal.EthereumNetworkConfig{
+			"ethereum": {ExplorerURL: server.URL + "/mainnet"},
+			"arbitrum": {ExplorerURL: server.URL + "/arbitrum", APIKey: "arbiscan"},
+			"base":     {ExplorerURL: server.URL + "/base"},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewEthereumClient() error = %v", err)
+	}
+
+	transactions, err := client.FetchTransactions(testEthereumAddress)
+	if err != nil {
+		t.Fatalf("FetchTransactions() error = %v", err)
+	}
+	if len(transactions) != 2 {
+		t.Fatalf("got %d transactions, want 2: %+v", len(transactions), transactions)
+	}
+
+	byNetwork := make(map[string]models.Transaction)
+	for _, tx := range transactions {
+		byNetwork[tx.Metadata["network"]] = tx
+	}
+	if tx := byNetwork["ethereum"]; tx.Type != models.TransactionTypeIncome || tx.Amount != 1.5 || tx.Tags[0] != "ethereum" {
+		t.Errorf("ethereum transaction = %+v", tx)
+	}
+	if tx := byNetwork["arbitrum"]; tx.Type != models.TransactionTypeExpense || tx.Amount != 0.25 || tx.Tags[0] != "arbitrum" {
+		t.Errorf("arbitrum transaction = %+v", tx)
+	}
+}
+
+func TestEthereumClient_AddressNetworks(t *testing.T) {
+	client, err := NewEthereumClient(&internal.EthereumConfig{
+		APIKey:          "shared",
+		Networks:        map[string]internal.EthereumNetworkConfig{"ethereum": {}, "base": {}, "optimism": {}},
+		AddressNetworks: map[string][]string{"0xabc0000000000000000000000000000000000001": {"base"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewEthereumClient() error = %v", err)
+	}
+
+	ethereum := client.(*EthereumClient)
+	if networks := ethereum.networksFor(testEthereumAddress); len(networks) != 1 || networks[0].Name != "base" || networks[0].ChainID != 8453 {
+		t.Errorf("networksFor(restricted) = %+v", networks)
+	}
+	if networks := ethereum.networksFor("0xdef"); len(networks) != 3 {
+		t.Errorf("networksFor(unrestricted) = %d networks, want 3", len(networks))
+	}
+}
+
+func TestNewEthereumClient_SandboxDefaultsToSepolia(t *testing.T) {
+	client, err := NewEthereumClient(&internal.EthereumConfig{APIKey: "shared", Sandbox: true}, nil)
+	if err != nil {
+		t.Fatalf("NewEthereumClient() error = %v", err)
+	}
+
+	networks := client.(*EthereumClient).networks
+	if len(networks) != 1 || networks[0].Name != "sepolia" || networks[0].ChainID != 11155111 {
+		t.Errorf("networks = %+v, want sepolia only", networks)
+	}
+}
+
+func TestNewEthereumClient_UnknownNetworkNeedsExplorer(t *testing.T) {
+	_, err := NewEthereumClient(&internal.EthereumConfig{
+		APIKey:   "shared",
+		Networks: map[string]internal.EthereumNetworkConfig{"zksync": {}},
+	}, nil)
+	if err == nil {
+		t.Error("NewEthereumClient() accepted a custom network without explorer_url")
+	}
+}
+
+func TestEthereumClient_TokenFilter(t *testing.T) {
+	scamList := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# known scam tokens\n0xSCAM\n")
+	}))
+	defer scamList.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("action") != "tokentx" {
+			fmt.Fprint(w, `{"status":"0","message":"No transactions found","result":[]}`)
+			return
+		}
+		fmt.Fprint(w, `{"status":"1","message":"OK","result":[
+			{"hash":"0x1","timeStamp":"1700000000","from":"0xother","to":"0xabc0000000000000000000000000000000000001","value":"2500000","contractAddress":"0xusdc","tokenSymbol":"USDC","tokenDecimal":"6"},
+			{"hash":"0x2","timeStamp":"1700000000","from":"0xother","to":"0xabc0000000000000000000000000000000000001","value":"1","contractAddress":"0xfree","tokenSymbol":"Visit claim-rewards.xyz","tokenDecimal":"0"},
+			{"hash":"0x3","timeStamp":"1700000000","from":"0xother","to":"0xabc0000000000000000000000000000000000001","value":"1","contractAddress":"0xscam","tokenSymbol":"USDC","tokenDecimal":"0"},
+			{"hash":"0x4","timeStamp":"1700000000","from":"0xother","to":"0xabc0000000000000000000000000000000000001","value":"1","contractAddress":"0xother","tokenSymbol":"PEPE","tokenDecimal":"0"}
+		]}`)
+	}))
+	defer server.Close()
+
+	client, err := NewEthereumClient(&internal.EthereumConfig{
+		APIKey:   "shared",
+		Networks: map[string]internal.EthereumNetworkConfig{"ethereum": {ExplorerURL: server.URL}},
+		TokenFilter: internal.TokenFilterConfig{
+			Allow:       []string{"usdc", "0xfree"},
+			Deny:        []string{`(?i)claim|\.xyz`},
+			ScamListURL: scamList.URL,
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewEthereumClient() error = %v", err)
+	}
+
+	transactions, stats, err := client.(*EthereumClient).FetchFilteredTransactions(testEthereumAddress)
+	if err != nil {
+		t.Fatalf("FetchFilteredTransactions() error = %v", err)
+	}
+	if len(transactions) != 1 || transactions[0].Amount != 2.5 || transactions[0].Metadata["currency"] != "USDC" {
+		t.Fatalf("transactions = %+v, want the 2.5 USDC transfer", transactions)
+	}
+	if got := transactions[0].Metadata["counterparty"]; got != "0xother" {
+		t.Errorf("counterparty = %q, want the sender", got)
+	}
+	want := models.TokenFilterStats{NotAllowed: 1, Denied: 1, ScamList: 1}
+	if stats != want {
+		t.Errorf("stats = %+v, want %+v", stats, want)
+	}
+}
@@ -0,0 +1,86 @@
+package blockchain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// networkFee is a fee the queried address paid for one on-chain transaction
+type networkFee struct {
+	TxHash   string
+	Date     time.Time
+	Amount   float64 // in the native coin
+	Currency string
+	Chain    string
+	Network  string // empty for single-network chains
+}
+
+// feeMode parses a configured fee mode, defaulting to separate fee transactions
+func feeMode(mode string) models.FeeMode {
+	if models.FeeMode(mode) == models.FeeModeField {
+		return models.FeeModeField
+	}
+	return models.FeeModeSeparate
+}
+
+// applyFees books the fees an address paid. In field mode a fee is recorded on
+// the transaction it was paid for; fees of transactions that were not imported
+// (failed or value-less contract calls) and all fees in separate mode become
+// expense transactions in the network fees category.
+func applyFees(address string, transactions []models.Transaction, fees []networkFee, mode models.FeeMode) []models.Transaction {
+	for _, fee := range fees {
+		if fee.Amount <= 0 {
+			continue
+		}
+
+		if mode == models.FeeModeField && attachFee(transactions, fee) {
+			continue
+		}
+		transactions = append(transactions, newFeeTransaction(address, fee))
+	}
+	return transactions
+}
+
+// attachFee records the fee on the first transaction of its hash
+func attachFee(transactions []models.Transaction, fee networkFee) bool {
+	for i := range transactions {
+		if transactions[i].Metadata["txHash"] == fee.TxHash {
+			transactions[i].Fee += fee.Amount
+			return true
+		}
+	}
+	return false
+}
+
+func newFeeTransaction(address string, fee networkFee) models.Transaction {
+	description := fmt.Sprintf("Network fee %s %s", models.FormatAmountCompact(fee.Amount, fee.Currency), fee.Currency)
+	tags := []string{models.TagNetworkFee}
+	metadata := map[string]string{
+		models.MetadataCategoryHint: models.CategoryNetworkFees,
+		"chain":                     fee.Chain,
+		"address":                   address,
+		"txHash":                    fee.TxHash,
+		"currency":                  fee.Currency,
+	}
+	if fee.Network != "" {
+		description += " on " + fee.Network
+		tags = append(tags, fee.Network)
+		metadata["network"] = fee.Network
+	}
+
+	return models.Transaction{
+		ID:          fee.TxHash + "-fee",
+		Amount:      fee.Amount,
+		Description: description,
+		Date:        fee.Date,
+		Type:        models.TransactionTypeExpense,
+		Status:      models.TransactionStatusCompleted,
+		WalletID:    address,
+		Tags:        tags,
+		Metadata:    metadata,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
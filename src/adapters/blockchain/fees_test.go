@@ -0,0 +1,37 @@
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+func TestApplyFees(t *testing.T) {
+	sent := models.Transaction{ID: "0x1", Amount: 1, Type: models.TransactionTypeExpense, Metadata: map[string]string{"txHash": "0x1"}}
+	fees := []networkFee{
+		{TxHash: "0x1", Date: time.Unix(1700000000, 0), Amount: 0.002, Currency: "ETH", Chain: "ethereum", Network: "base"},
+		{TxHash: "0x2", Date: time.Unix(1700000100, 0), Amount: 0.001, Currency: "ETH", Chain: "ethereum", Network: "base"}, // failed call
+	}
+
+	t.Run("separate", func(t *testing.T) {
+		got := applyFees("0xabc", []models.Transaction{sent}, fees, models.FeeModeSeparate)
+		if len(got) != 3 || got[0].Fee != 0 {
+			t.Fatalf("applyFees() = %+v, want the transaction and two fee expenses", got)
+		}
+		fee := got[1]
+		if fee.Type != models.TransactionTypeExpense || fee.Amount != 0.002 || fee.Metadata[models.MetadataCategoryHint] != models.CategoryNetworkFees {
+			t.Errorf("fee transaction = %+v", fee)
+		}
+	})
+
+	t.Run("field", func(t *testing.T) {
+		got := applyFees("0xabc", []models.Transaction{sent}, fees, models.FeeModeField)
+		if len(got) != 2 || got[0].Fee != 0.002 {
+			t.Fatalf("applyFees() = %+v, want the fee on the transaction and one fee expense", got)
+		}
+		if got[1].Metadata["txHash"] != "0x2" {
+			t.Errorf("unmatched fee = %+v, want the fee of 0x2", got[1])
+		}
+	})
+}
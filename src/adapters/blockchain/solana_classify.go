@@ -0,0 +1,277 @@
+package blockchain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+const (
+	solanaSystemProgram = "11111111111111111111111111111111"
+	solanaStakeProgram  = "Stake11111111111111111111111111111111111111"
+	solanaTokenProgram  = "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+
+	// airdropMinRecipients is how many distinct token recipients make a transfer
+	// look like a batch distribution rather than a payment
+	airdropMinRecipients = 3
+)
+
+// solscanTransaction matches Solscan's transaction response structure
+type solscanTransaction struct {
+	BlockTime          int64                `json:"blockTime"`
+	Slot               uint64               `json:"slot"`
+	TxHash             string               `json:"txHash"`
+	Fee                uint64               `json:"fee"`
+	Status             string               `json:"status"`
+	Lamport            int64                `json:"lamport"` // Amount in lamports
+	Signer             []string             `json:"signer"`
+	ParsedInstruction  []solscanInstruction `json:"parsedInstruction"`
+	TokenBalanceChange []solscanTokenChange `json:"tokenBalanceChange"`
+}
+
+type solscanInstruction struct {
+	ProgramId string `json:"programId"`
+	Parsed    struct {
+		Info struct {
+			Source       string `json:"source"`
+			Destination  string `json:"destination"`
+			StakeAccount string `json:"stakeAccount"`
+			VoteAccount  string `json:"voteAccount"`
+			Lamports     uint64 `json:"lamports"`
+			Amount       string `json:"amount"` // Can be string for SPL tokens
+		} `json:"info"`
+		Type string `json:"type"`
+	} `json:"parsed"`
+}
+
+type solscanTokenChange struct {
+	Mint        string  `json:"mint"`
+	Amount      float64 `json:"amount"` // Using float for simplicity, might need decimal type
+	Decimals    int     `json:"decimals"`
+	TokenSymbol string  `json:"tokenSymbol"`
+}
+
+// signedBy reports whether the address signed the transaction
+func (tx solscanTransaction) signedBy(address string) bool {
+	for _, signer := range tx.Signer {
+		if signer == address {
+			return true
+		}
+	}
+	return false
+}
+
+// classifySolanaTransaction maps a Solscan transaction onto a domain transaction
+// as seen from the queried address. Staking rewards and airdrops are recognised
+// first and booked as income with a category hint; everything else falls back
+// to plain SPL and SOL transfer detection. It returns false for transactions
+// that move nothing the address owns. SPL token changes rejected by the filter
+// are counted in stats and ignored.
+func classifySolanaTransaction(address string, tx solscanTransaction, filter *tokenFilter,
+	stats *models.TokenFilterStats) (models.Transaction, bool) {
+	tokenChanges := make([]solscanTokenChange, 0, len(tx.TokenBalanceChange))
+	for _, change := range tx.TokenBalanceChange {
+		if change.Mint == solNativeMint || filter.allows(change.TokenSymbol, change.Mint, stats) {
+			tokenChanges = append(tokenChanges, change)
+		}
+	}
+	tx.TokenBalanceChange = tokenChanges
+
+	if transaction, ok := stakingReward(address, tx); ok {
+		return transaction, true
+	}
+	if transaction, ok := airdrop(address, tx); ok {
+		return transaction, true
+	}
+
+	amount := 0.0
+	txType := models.TransactionTypeTransfer // Default, adjust based on context
+	description := fmt.Sprintf("Solana Transaction %s", tx.TxHash)
+	currency := "SOL" // Default, adjust for SPL tokens
+	counterparty := ""
+
+	// Basic logic to determine type and amount (needs refinement for complex txs)
+	isSender := tx.signedBy(address)
+	isReceiver := false
+
+	// Check token balance changes first for SPL transfers
+	splTransferProcessed := false
+	for _, change := range tx.TokenBalanceChange {
+		if change.Mint != solNativeMint { // Process SPL tokens
+			// This logic is simplified. Real logic needs to check source/dest based on instructions
+			if isSender && change.Amount < 0 { // Sent SPL token
+				amount = -change.Amount // Make positive for expense/transfer
+				currency = change.TokenSymbol
+				description = fmt.Sprintf("Sent %s %s", models.FormatAmountCompact(amount, currency), currency)
+				splTransferProcessed = true
+				break
+			} else if !isSender && change.Amount > 0 { // Received SPL token (approximation)
+				// Need better logic to confirm receiver based on instructions
+				amount = change.Amount
+				currency = change.TokenSymbol
+				description = fmt.Sprintf("Received %s %s", models.FormatAmountCompact(amount, currency), currency)
+				splTransferProcessed = true
+				break
+			}
+		}
+	}
+
+	// If not an SPL transfer, check native SOL transfer via instructions
+	if !splTransferProcessed {
+		for _, instruction := range tx.ParsedInstruction {
+			// Look for system program transfers
+			if instruction.ProgramId == solanaSystemProgram && instruction.Parsed.Type == "transfer" {
+				solAmount := float64(instruction.Parsed.Info.Lamports) / 1e9 // Convert lamports to SOL
+
+				if instruction.Parsed.Info.Source == address {
+					isReceiver = false // Confirmed sender
+					amount = solAmount
+					counterparty = instruction.Parsed.Info.Destination
+					description = fmt.Sprintf("Sent %s SOL", models.FormatAmountCompact(amount, "SOL"))
+					break
+				} else if instruction.Parsed.Info.Destination == address {
+					isReceiver = true // Confirmed receiver
+					amount = solAmount
+					counterparty = instruction.Parsed.Info.Source
+					description = fmt.Sprintf("Received %s SOL", models.FormatAmountCompact(amount, "SOL"))
+					break
+				}
+			}
+		}
+	}
+
+	// If still no amount/type determined, it might be a contract interaction, skip for now
+	if amount == 0 {
+		return models.Transaction{}, false
+	}
+
+	// Determine final type based on sender/receiver status
+	if isSender && !isReceiver {
+		txType = models.TransactionTypeExpense // Or Transfer if dest known
+	} else if !isSender && isReceiver {
+		txType = models.TransactionTypeIncome // Or Transfer if source known
+	}
+
+	transaction := newSolanaTransaction(address, tx, amount, description, txType)
+	transaction.Metadata["currency"] = currency
+	if counterparty != "" {
+		transaction.Metadata["counterparty"] = counterparty
+	}
+	return transaction, true
+}
+
+// stakingReward recognises stake program withdrawals into the address. Rewards
+// accrue inside the stake account, so the withdrawal is the point where they
+// reach the wallet. The validator is attributed when the transaction also
+// carries the delegation it was earned under.
+func stakingReward(address string, tx solscanTransaction) (models.Transaction, bool) {
+	var withdrawal *solscanInstruction
+	validator := ""
+	for i, instruction := range tx.ParsedInstruction {
+		if instruction.ProgramId != solanaStakeProgram {
+			continue
+		}
+		switch instruction.Parsed.Type {
+		case "withdraw":
+			if instruction.Parsed.Info.Destination == address && withdrawal == nil {
+				withdrawal = &tx.ParsedInstruction[i]
+			}
+		case "delegate":
+			validator = instruction.Parsed.Info.VoteAccount
+		}
+	}
+	if withdrawal == nil || withdrawal.Parsed.Info.Lamports == 0 {
+		return models.Transaction{}, false
+	}
+
+	amount := float64(withdrawal.Parsed.Info.Lamports) / 1e9
+	stakeAccount := withdrawal.Parsed.Info.StakeAccount
+	source := stakeAccount
+	if validator != "" {
+		source = "validator " + validator
+	}
+
+	transaction := newSolanaTransaction(address, tx, amount,
+		fmt.Sprintf("Staking reward %s SOL from %s", models.FormatAmountCompact(amount, "SOL"), source), models.TransactionTypeIncome)
+	transaction.Tags = []string{models.TagStakingReward}
+	transaction.Metadata[models.MetadataCategoryHint] = models.CategoryStakingRewards
+	transaction.Metadata["currency"] = "SOL"
+	if stakeAccount != "" {
+		transaction.Metadata["stakeAccount"] = stakeAccount
+		transaction.Metadata["counterparty"] = stakeAccount
+	}
+	if validator != "" {
+		transaction.Metadata["validator"] = validator
+		transaction.Metadata["counterparty"] = validator
+	}
+
+	return transaction, true
+}
+
+// airdrop recognises unsolicited SPL token credits: the address did not sign,
+// and the tokens were either minted straight to it or sent as part of a batch
+// distribution to several recipients.
+func airdrop(address string, tx solscanTransaction) (models.Transaction, bool) {
+	if tx.signedBy(address) {
+		return models.Transaction{}, false
+	}
+
+	minted := false
+	recipients := make(map[string]bool)
+	for _, instruction := range tx.ParsedInstruction {
+		if instruction.ProgramId != solanaTokenProgram {
+			continue
+		}
+		switch instruction.Parsed.Type {
+		case "mintTo", "mintToChecked":
+			minted = true
+		case "transfer", "transferChecked":
+			if instruction.Parsed.Info.Source == address {
+				return models.Transaction{}, false
+			}
+			recipients[instruction.Parsed.Info.Destination] = true
+		}
+	}
+	if !minted && len(recipients) < airdropMinRecipients {
+		return models.Transaction{}, false
+	}
+
+	for _, change := range tx.TokenBalanceChange {
+		if change.Mint == solNativeMint || change.Amount <= 0 {
+			continue
+		}
+
+		transaction := newSolanaTransaction(address, tx, change.Amount,
+			fmt.Sprintf("Airdrop %s %s", models.FormatAmountCompact(change.Amount, change.TokenSymbol), change.TokenSymbol), models.TransactionTypeIncome)
+		transaction.Tags = []string{models.TagAirdrop}
+		transaction.Metadata[models.MetadataCategoryHint] = models.CategoryAirdrops
+		transaction.Metadata["mint"] = change.Mint
+		if change.TokenSymbol != "" {
+			transaction.Metadata["token"] = change.TokenSymbol
+		}
+		return transaction, true
+	}
+
+	return models.Transaction{}, false
+}
+
+func newSolanaTransaction(address string, tx solscanTransaction, amount float64, description string,
+	txType models.TransactionType) models.Transaction {
+	return models.Transaction{
+		ID:          tx.TxHash, // Use Solscan Tx Hash as unique ID
+		Amount:      amount,
+		Description: description,
+		Date:        time.Unix(tx.BlockTime, 0),
+		Type:        txType,
+		Status:      models.TransactionStatusCompleted, // Assuming success if Status == "Success"
+		WalletID:    address,                           // Associate with the queried wallet
+		Metadata: map[string]string{
+			"chain":   "solana",
+			"address": address,
+			"txHash":  tx.TxHash,
+		},
+		CreatedAt: time.Now(), // Record creation time
+		UpdatedAt: time.Now(),
+	}
+}
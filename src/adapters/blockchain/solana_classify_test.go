@@ -0,0 +1,101 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+const testSolanaAddress = "9xQeWvG816bUx9EPjHmaT23yvVM2ZWbrrpZb9PusVFin"
+
+func decodeSolscan(t *testing.T, raw string) solscanTransaction {
+	t.Helper()
+	var tx solscanTransaction
+	if err := json.Unmarshal([]byte(raw), &tx); err != nil {
+		t.Fatalf("failed to decode fixture: %v", err)
+	}
+	return tx
+}
+
+func TestClassifySolanaTransaction_StakingReward(t *testing.T) {
+	tx := decodeSolscan(t, `{
+		"blockTime": 1700000000, "txHash": "stake1", "status": "Success",
+		"signer": ["`+testSolanaAddress+`"],
+		"parsedInstruction": [
+			{"programId": "Stake11111111111111111111111111111111111111", "parsed": {"type": "delegate",
+				"info": {"stakeAccount": "StakeAcc1", "voteAccount": "Vote1"}}},
+			{"programId": "Stake11111111111111111111111111111111111111", "parsed": {"type": "withdraw",
+				"info": {"stakeAccount": "StakeAcc1", "destination": "`+testSolanaAddress+`", "lamports": 2500000000}}}
+		]
+	}`)
+
+	got, ok := classifySolanaTransaction(testSolanaAddress, tx, nil, &models.TokenFilterStats{})
+	if !ok {
+		t.Fatal("classifySolanaTransaction() skipped a staking reward")
+	}
+	if got.Type != models.TransactionTypeIncome || got.Amount != 2.5 {
+		t.Errorf("got %s %v, want income 2.5", got.Type, got.Amount)
+	}
+	if got.Metadata[models.MetadataCategoryHint] != models.CategoryStakingRewards {
+		t.Errorf("category hint = %q, want %q", got.Metadata[models.MetadataCategoryHint], models.CategoryStakingRewards)
+	}
+	if got.Metadata["validator"] != "Vote1" || got.Metadata["stakeAccount"] != "StakeAcc1" {
+		t.Errorf("attribution = %v", got.Metadata)
+	}
+	if len(got.Tags) != 1 || got.Tags[0] != models.TagStakingReward {
+		t.Errorf("tags = %v, want [%s]", got.Tags, models.TagStakingReward)
+	}
+}
+
+func TestClassifySolanaTransaction_Airdrop(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		wantAirdrop bool
+	}{
+		{
+			name: "minted to address",
+			raw: `{"txHash": "drop1", "status": "Success", "signer": ["Minter"],
+				"parsedInstruction": [{"programId": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", "parsed": {"type": "mintTo"}}],
+				"tokenBalanceChange": [{"mint": "MintA", "amount": 100, "tokenSymbol": "DROP"}]}`,
+			wantAirdrop: true,
+		},
+		{
+			name: "batch distribution",
+			raw: `{"txHash": "drop2", "status": "Success", "signer": ["Distributor"],
+				"parsedInstruction": [
+					{"programId": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", "parsed": {"type": "transfer", "info": {"source": "Pool", "destination": "A"}}},
+					{"programId": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", "parsed": {"type": "transfer", "info": {"source": "Pool", "destination": "B"}}},
+					{"programId": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", "parsed": {"type": "transfer", "info": {"source": "Pool", "destination": "C"}}}
+				],
+				"tokenBalanceChange": [{"mint": "MintA", "amount": 5, "tokenSymbol": "DROP"}]}`,
+			wantAirdrop: true,
+		},
+		{
+			name: "single payment",
+			raw: `{"txHash": "pay1", "status": "Success", "signer": ["Friend"],
+				"parsedInstruction": [
+					{"programId": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", "parsed": {"type": "transfer", "info": {"source": "Friend", "destination": "A"}}}
+				],
+				"tokenBalanceChange": [{"mint": "MintA", "amount": 5, "tokenSymbol": "USDC"}]}`,
+			wantAirdrop: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := classifySolanaTransaction(testSolanaAddress, decodeSolscan(t, tt.raw), nil, &models.TokenFilterStats{})
+			if !ok {
+				t.Fatal("classifySolanaTransaction() skipped the transaction")
+			}
+			isAirdrop := got.Metadata[models.MetadataCategoryHint] == models.CategoryAirdrops
+			if isAirdrop != tt.wantAirdrop {
+				t.Errorf("airdrop = %v, want %v (metadata %v)", isAirdrop, tt.wantAirdrop, got.Metadata)
+			}
+			if isAirdrop && got.Type != models.TransactionTypeIncome {
+				t.Errorf("type = %s, want income", got.Type)
+			}
+		})
+	}
+}
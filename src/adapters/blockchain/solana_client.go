@@ -1,221 +1,178 @@
 package blockchain
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/ZanzyTHEbar/firedragon-go/domain/address"
 	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
 	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
-	// We might need config later if API keys or specific endpoints are needed
-	// "github.com/ZanzyTHEbar/firedragon-go/internal/config"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/payloads"
 )
 
 const (
 	solanaScanAPIBaseURL = "https://api.solscan.io"
 	solNativeMint        = "So11111111111111111111111111111111111111112" // Address for native SOL
+	solanaSandboxCluster = "devnet"                                      // Solscan cluster read in sandbox mode
+
+	// solanaNormalizerVersion is the version of the mapping of Solscan
+	// responses to transactions. Bump it whenever that mapping changes.
+	solanaNormalizerVersion = 2
 )
 
 // SolanaClient implements the BlockchainClient interface for Solana
 type SolanaClient struct {
 	endpoint   string
+	cluster    string // Solscan cluster; empty for mainnet
+	wsEndpoint string // empty when streaming is not available
 	httpClient *http.Client
-	// config *config.BlockchainConfig // Add if config needed
+	filters    *tokenFilters
+	feeMode    models.FeeMode
 }
 
-// NewSolanaClient creates a new Solana client
-// func NewSolanaClient(cfg *config.BlockchainConfig) (interfaces.BlockchainClient, error) { // Adjusted signature if config is needed
-func NewSolanaClient() (interfaces.BlockchainClient, error) {
+// NewSolanaClient creates a new Solana client, reading devnet in sandbox
+// mode. httpClient may be nil.
+func NewSolanaClient(cfg *internal.SolanaConfig, httpClient *http.Client) (interfaces.BlockchainClient, error) {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	filters, err := newTokenFilters(cfg.TokenFilter, cfg.AddressTokenFilters, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	wsEndpoint := cfg.WSEndpoint
+	if wsEndpoint == "" {
+		wsEndpoint = websocketURL(cfg.RPCEndpoint)
+	}
+
+	cluster := ""
+	if cfg.Sandbox {
+		cluster = solanaSandboxCluster
+	}
+
 	return &SolanaClient{
-		endpoint: solanaScanAPIBaseURL,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		// config: cfg, // Add if config needed
+		endpoint:   solanaScanAPIBaseURL,
+		cluster:    cluster,
+		wsEndpoint: wsEndpoint,
+		httpClient: httpClient,
+		filters:    filters,
+		feeMode:    feeMode(cfg.FeeMode),
 	}, nil
 }
 
+// WithTransport returns a copy of the client whose Solscan calls go through
+// the transport wrap returns, e.g. to archive or replay the raw responses
+func (c *SolanaClient) WithTransport(wrap func(http.RoundTripper) http.RoundTripper) interfaces.BlockchainClient {
+	clone := *c
+	clone.httpClient = payloads.Client(c.httpClient, wrap)
+	return &clone
+}
+
 // FetchTransactions retrieves transactions for a Solana address using the Solscan API
 func (c *SolanaClient) FetchTransactions(address string) ([]models.Transaction, error) {
+	transactions, _, err := c.FetchFilteredTransactions(address)
+	return transactions, err
+}
+
+// FetchFilteredTransactions retrieves transactions for a Solana address and
+// counts the SPL token transfers dropped by the address's token filter
+func (c *SolanaClient) FetchFilteredTransactions(address string) ([]models.Transaction, models.TokenFilterStats, error) {
+	var stats models.TokenFilterStats
 	// Note: Solscan API might require pagination for full history. This fetches recent ones.
-	url := fmt.Sprintf("%s/account/transactions?account=%s&limit=50", c.endpoint, address) // Limit might need adjustment
+	url := c.withCluster(fmt.Sprintf("%s/account/transactions?account=%s&limit=50", c.endpoint, address)) // Limit might need adjustment
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		return nil, interfaces.NewClientError(interfaces.ErrorTypeNetwork, "failed to create solana request", err)
+		return nil, stats, interfaces.NewClientError(interfaces.ErrorTypeValidation, "failed to create solana request", err)
 	}
 	// TODO: Add API Key if required by Solscan
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, interfaces.NewClientError(interfaces.ErrorTypeNetwork, "failed to fetch solana transactions", err)
+		return nil, stats, interfaces.NewTransportError("failed to fetch solana transactions", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, interfaces.NewClientError(interfaces.ErrorTypeNetwork, fmt.Sprintf("solana API returned non-200 status: %d", resp.StatusCode), nil)
-	}
-
-	// Define struct matching Solscan's transaction response structure
-	var result []struct {
-		BlockTime int64  `json:"blockTime"`
-		Slot      uint64 `json:"slot"`
-		TxHash    string `json:"txHash"`
-		Fee       uint64 `json:"fee"`
-		Status    string `json:"status"`
-		Lamport   int64  `json:"lamport"` // Amount in lamports
-		Signer    []string `json:"signer"`
-		ParsedInstruction []struct {
-			ProgramId string `json:"programId"`
-			Parsed    struct {
-				Info struct {
-					Source      string `json:"source"`
-					Destination string `json:"destination"`
-					Lamports    uint64 `json:"lamports"`
-					Amount      string `json:"amount"` // Can be string for SPL tokens
-				} `json:"info"`
-				Type string `json:"type"`
-			} `json:"parsed"`
-		} `json:"parsedInstruction"`
-		TokenBalanceChange []struct {
-			Mint        string  `json:"mint"`
-			Amount      float64 `json:"amount"` // Using float for simplicity, might need decimal type
-			Decimals    int     `json:"decimals"`
-			TokenSymbol string  `json:"tokenSymbol"`
-		} `json:"tokenBalanceChange"`
-		// Add other fields if needed
+		return nil, stats, interfaces.NewStatusError(resp, fmt.Sprintf("solana API returned non-200 status: %d", resp.StatusCode))
 	}
 
+	var result []solscanTransaction
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, interfaces.NewClientError(interfaces.ErrorTypeInvalid, "failed to decode solana response", err)
+		return nil, stats, interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode solana response", err)
 	}
 
+	filter := c.filters.forAddress(address)
 	var transactions []models.Transaction
+	var fees []networkFee
 	for _, tx := range result {
-		// Skip failed transactions
-		if tx.Status != "Success" {
-			continue
-		}
-
-		timestamp := time.Unix(tx.BlockTime, 0)
-		amount := 0.0
-		txType := models.TransactionTypeTransfer // Default, adjust based on context
-		description := fmt.Sprintf("Solana Transaction %s", tx.TxHash)
-		currency := "SOL" // Default, adjust for SPL tokens
-
-		// Basic logic to determine type and amount (needs refinement for complex txs)
-		isSender := false
-		isReceiver := false
-		for _, signer := range tx.Signer {
-			if signer == address {
-				isSender = true
-				break
-			}
-		}
-
-		// Check token balance changes first for SPL transfers
-		splTransferProcessed := false
-		for _, change := range tx.TokenBalanceChange {
-			if change.Mint != solNativeMint { // Process SPL tokens
-				// This logic is simplified. Real logic needs to check source/dest based on instructions
-				if isSender && change.Amount < 0 { // Sent SPL token
-					amount = -change.Amount // Make positive for expense/transfer
-					currency = change.TokenSymbol
-					txType = models.TransactionTypeTransfer // Or Expense if context known
-					description = fmt.Sprintf("Sent %f %s", amount, currency)
-					splTransferProcessed = true
-					break
-				} else if !isSender && change.Amount > 0 { // Received SPL token (approximation)
-					// Need better logic to confirm receiver based on instructions
-					amount = change.Amount
-					currency = change.TokenSymbol
-					txType = models.TransactionTypeTransfer // Or Income if context known
-					description = fmt.Sprintf("Received %f %s", amount, currency)
-					splTransferProcessed = true
-					break
-				}
-			}
+		// The first signer pays the fee, also for failed transactions
+		if len(tx.Signer) > 0 && tx.Signer[0] == address && tx.Fee > 0 {
+			fees = append(fees, networkFee{
+				TxHash:   tx.TxHash,
+				Date:     time.Unix(tx.BlockTime, 0),
+				Amount:   float64(tx.Fee) / 1e9,
+				Currency: "SOL",
+				Chain:    "solana",
+			})
 		}
 
-		// If not an SPL transfer, check native SOL transfer via instructions
-		if !splTransferProcessed {
-			for _, instruction := range tx.ParsedInstruction {
-				// Look for system program transfers
-				if instruction.ProgramId == "11111111111111111111111111111111" && instruction.Parsed.Type == "transfer" {
-					lamports := instruction.Parsed.Info.Lamports
-					solAmount := float64(lamports) / 1e9 // Convert lamports to SOL
-
-					if instruction.Parsed.Info.Source == address {
-						isReceiver = false // Confirmed sender
-						amount = solAmount
-						txType = models.TransactionTypeTransfer // Or Expense
-						description = fmt.Sprintf("Sent %f SOL", amount)
-						break
-					} else if instruction.Parsed.Info.Destination == address {
-						isReceiver = true // Confirmed receiver
-						amount = solAmount
-						txType = models.TransactionTypeTransfer // Or Income
-						description = fmt.Sprintf("Received %f SOL", amount)
-						break
-					}
-				}
-			}
-		}
-		
-		// If still no amount/type determined, it might be a contract interaction, skip for now
-		if amount == 0 {
+		// Skip failed transactions
+		if tx.Status != "Success" {
 			continue
 		}
 
-		// Determine final type based on sender/receiver status
-		if isSender && !isReceiver {
-			txType = models.TransactionTypeExpense // Or Transfer if dest known
-		} else if !isSender && isReceiver {
-			txType = models.TransactionTypeIncome // Or Transfer if source known
-		} else {
-			// Could be self-transfer or complex interaction, mark as transfer
-			txType = models.TransactionTypeTransfer
+		if transaction, ok := classifySolanaTransaction(address, tx, filter, &stats); ok {
+			transactions = append(transactions, transaction)
 		}
+	}
 
+	return applyFees(address, transactions, fees, c.feeMode), stats, nil
+}
 
-		transactions = append(transactions, models.Transaction{
-			ID:          tx.TxHash, // Use Solscan Tx Hash as unique ID
-			Amount:      amount,
-			Description: description,
-			Date:        timestamp,
-			Type:        txType,
-			Status:      models.TransactionStatusCompleted, // Assuming success if Status == "Success"
-			WalletID:    address, // Associate with the queried wallet
-			// CategoryID:  Needs categorization logic
-			// DestWalletID: Needs logic to determine for transfers
-			CreatedAt:   time.Now(), // Record creation time
-			UpdatedAt:   time.Now(),
-		})
-	}
+// CanWatch reports whether a websocket endpoint is configured for streaming
+func (c *SolanaClient) CanWatch(address string) bool {
+	return c.wsEndpoint != ""
+}
 
-	return transactions, nil
+// WatchAddress subscribes to confirmed transactions mentioning the address
+// (logsSubscribe) and calls notify for each one
+func (c *SolanaClient) WatchAddress(ctx context.Context, address string, notify func()) error {
+	return subscribe(ctx, c.wsEndpoint, "logsNotification", []rpcRequest{{
+		ID:     1,
+		Method: "logsSubscribe",
+		Params: []any{
+			map[string]any{"mentions": []string{address}},
+			map[string]any{"commitment": "confirmed"},
+		},
+	}}, notify)
 }
 
 // GetBalance retrieves the current SOL balance for a Solana address using Solscan API
 func (c *SolanaClient) GetBalance(address string) (models.BalanceInfo, error) {
-	balanceInfo := models.BalanceInfo{Currency: "SOL"} // Default to SOL
-	url := fmt.Sprintf("%s/account/%s", c.endpoint, address) // Use account info endpoint
+	balanceInfo := models.BalanceInfo{Currency: "SOL"}                      // Default to SOL
+	url := c.withCluster(fmt.Sprintf("%s/account/%s", c.endpoint, address)) // Use account info endpoint
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		return balanceInfo, interfaces.NewClientError(interfaces.ErrorTypeNetwork, "failed to create solana balance request", err)
+		return balanceInfo, interfaces.NewClientError(interfaces.ErrorTypeValidation, "failed to create solana balance request", err)
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return balanceInfo, interfaces.NewClientError(interfaces.ErrorTypeNetwork, "failed to fetch solana balance", err)
+		return balanceInfo, interfaces.NewTransportError("failed to fetch solana balance", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		// Handle rate limits specifically if possible (e.g., 429 status code)
-		return balanceInfo, interfaces.NewClientError(interfaces.ErrorTypeNetwork, fmt.Sprintf("solana balance API returned non-200 status: %d", resp.StatusCode), nil)
+		return balanceInfo, interfaces.NewStatusError(resp, fmt.Sprintf("solana balance API returned non-200 status: %d", resp.StatusCode))
 	}
 
 	// Define struct matching Solscan's account response structure
@@ -228,12 +185,12 @@ func (c *SolanaClient) GetBalance(address string) (models.BalanceInfo, error) {
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return balanceInfo, interfaces.NewClientError(interfaces.ErrorTypeInvalid, "failed to decode solana balance response", err)
+		return balanceInfo, interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode solana balance response", err)
 	}
 
 	// Assuming the endpoint provides success status, check it if available
 	// if !result.Success {
-	// 	return balanceInfo, interfaces.NewClientError(interfaces.ErrorTypeInvalid, "solana balance API indicated failure", nil)
+	// 	return balanceInfo, interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "solana balance API indicated failure", nil)
 	// }
 
 	balanceInfo.Amount = float64(result.Data.Lamports) / 1e9 // Convert lamports to SOL
@@ -246,11 +203,24 @@ func (c *SolanaClient) GetChainType() string {
 	return "solana"
 }
 
-// IsValidAddress validates a Solana wallet address format (basic check)
-func (c *SolanaClient) IsValidAddress(address string) bool {
-	// Basic validation: Solana addresses are typically base58 encoded strings
-	// of a specific length range. This is a very basic check.
-	// A proper check would involve base58 decoding and length validation.
-	// Example length check (may vary slightly):
-	return len(address) >= 32 && len(address) <= 44
+// NormalizerVersion returns the version of the mapping of Solscan responses
+// to transactions
+func (c *SolanaClient) NormalizerVersion() int {
+	return solanaNormalizerVersion
+}
+
+// IsValidAddress reports whether account is a base58 encoded 32 byte key
+func (c *SolanaClient) IsValidAddress(account string) bool {
+	return address.ValidateSolana(account) == nil
+}
+
+// withCluster adds the Solscan cluster to a request URL outside mainnet
+func (c *SolanaClient) withCluster(url string) string {
+	if c.cluster == "" {
+		return url
+	}
+	if strings.Contains(url, "?") {
+		return url + "&cluster=" + c.cluster
+	}
+	return url + "?cluster=" + c.cluster
 }
@@ -0,0 +1,100 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"golang.org/x/net/websocket"
+)
+
+// rpcRequest is a JSON-RPC 2.0 request sent over a websocket
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+// rpcMessage is a JSON-RPC response or subscription notification
+type rpcMessage struct {
+	ID     *int            `json:"id"`
+	Method string          `json:"method"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// subscribe opens a JSON-RPC websocket, sends the subscription requests and
+// calls notify for every notification of notificationMethod. It blocks until
+// ctx is done or the connection drops, and returns why it stopped.
+func subscribe(ctx context.Context, endpoint, notificationMethod string, requests []rpcRequest, notify func()) error {
+	config, err := websocket.NewConfig(endpoint, "http://localhost")
+	if err != nil {
+		return interfaces.NewClientError(interfaces.ErrorTypeValidation, "invalid websocket endpoint", err)
+	}
+
+	conn, err := config.DialContext(ctx)
+	if err != nil {
+		return interfaces.NewTransportError("failed to connect to "+endpoint, err)
+	}
+	defer conn.Close()
+
+	// Unblock the receive loop when the caller gives up
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	for _, request := range requests {
+		request.JSONRPC = "2.0"
+		if err := websocket.JSON.Send(conn, request); err != nil {
+			return interfaces.NewTransportError("failed to send "+request.Method, err)
+		}
+	}
+
+	for {
+		var message rpcMessage
+		if err := websocket.JSON.Receive(conn, &message); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return interfaces.NewTransportError("subscription closed", err)
+		}
+
+		if message.Error != nil {
+			return interfaces.NewClientError(rpcErrorType(message.Error.Code),
+				fmt.Sprintf("subscription rejected: %s (%d)", message.Error.Message, message.Error.Code), nil)
+		}
+		if message.Method == notificationMethod {
+			notify()
+		}
+	}
+}
+
+// rpcErrorType classifies a JSON-RPC error code. Malformed requests and
+// unsupported methods (-32600 to -32602) will not succeed on retry; -32005 is
+// the limit-exceeded code of most node providers.
+func rpcErrorType(code int) interfaces.ErrorType {
+	switch {
+	case code == -32005:
+		return interfaces.ErrorTypeRateLimit
+	case code >= -32602 && code <= -32600:
+		return interfaces.ErrorTypeValidation
+	default:
+		return interfaces.ErrorTypeProviderBug
+	}
+}
+
+// websocketURL derives the websocket endpoint of an HTTP JSON-RPC endpoint
+func websocketURL(rpcEndpoint string) string {
+	switch {
+	case strings.HasPrefix(rpcEndpoint, "https://"):
+		return "wss://" + strings.TrimPrefix(rpcEndpoint, "https://")
+	case strings.HasPrefix(rpcEndpoint, "http://"):
+		return "ws://" + strings.TrimPrefix(rpcEndpoint, "http://")
+	}
+	return rpcEndpoint
+}
@@ -0,0 +1,72 @@
+package blockchain
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"golang.org/x/net/websocket"
+)
+
+func TestSolanaClient_WatchAddress(t *testing.T) {
+	requests := make(chan rpcRequest, 1)
+	server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		var request rpcRequest
+		if err := websocket.JSON.Receive(conn, &request); err != nil {
+			return
+		}
+		requests <- request
+
+		websocket.Message.Send(conn, `{"jsonrpc":"2.0","id":1,"result":42}`)
+		websocket.Message.Send(conn, `{"jsonrpc":"2.0","method":"logsNotification","params":{"subscription":42}}`)
+		websocket.Message.Send(conn, `{"jsonrpc":"2.0","method":"logsNotification","params":{"subscription":42}}`)
+		// Returning closes the connection, as a dropped subscription would
+	}))
+	defer server.Close()
+
+	client, err := NewSolanaClient(&internal.SolanaConfig{RPCEndpoint: server.URL}, nil)
+	if err != nil {
+		t.Fatalf("NewSolanaClient() error = %v", err)
+	}
+	solana := client.(*SolanaClient)
+	if !strings.HasPrefix(solana.wsEndpoint, "ws://") || !solana.CanWatch(testSolanaAddress) {
+		t.Fatalf("wsEndpoint = %q, want the rpc endpoint over ws", solana.wsEndpoint)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	notified := 0
+	err = solana.WatchAddress(ctx, testSolanaAddress, func() { notified++ })
+	if err == nil || ctx.Err() != nil {
+		t.Fatalf("WatchAddress() error = %v, want the dropped connection", err)
+	}
+	if notified != 2 {
+		t.Errorf("notified %d times, want 2", notified)
+	}
+
+	request := <-requests
+	if request.Method != "logsSubscribe" || request.JSONRPC != "2.0" {
+		t.Errorf("request = %+v, want a logsSubscribe call", request)
+	}
+}
+
+func TestSubscribe_StopsWithContext(t *testing.T) {
+	server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		var request rpcRequest
+		websocket.JSON.Receive(conn, &request)
+		time.Sleep(5 * time.Second) // keep the subscription open
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := subscribe(ctx, websocketURL(server.URL), "logsNotification", []rpcRequest{{ID: 1, Method: "logsSubscribe"}}, func() {})
+	if err != context.DeadlineExceeded {
+		t.Errorf("subscribe() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
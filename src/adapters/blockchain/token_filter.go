@@ -0,0 +1,172 @@
+package blockchain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// tokenFilter decides which token transfers of a wallet are imported.
+// A nil filter allows everything.
+type tokenFilter struct {
+	allow       map[string]bool // lower-cased symbols and addresses
+	deny        []*regexp.Regexp
+	scamListURL string
+	httpClient  *http.Client
+
+	mu   sync.Mutex
+	scam map[string]bool // lower-cased addresses, nil until the list loaded
+}
+
+// newTokenFilter compiles a token filter, returning nil when the config filters nothing
+func newTokenFilter(cfg internal.TokenFilterConfig, httpClient *http.Client) (*tokenFilter, error) {
+	if len(cfg.Allow) == 0 && len(cfg.Deny) == 0 && cfg.ScamListURL == "" {
+		return nil, nil
+	}
+
+	filter := &tokenFilter{scamListURL: cfg.ScamListURL, httpClient: httpClient}
+	if len(cfg.Allow) > 0 {
+		filter.allow = make(map[string]bool, len(cfg.Allow))
+		for _, token := range cfg.Allow {
+			filter.allow[strings.ToLower(token)] = true
+		}
+	}
+	for _, pattern := range cfg.Deny {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token deny pattern %q: %w", pattern, err)
+		}
+		filter.deny = append(filter.deny, re)
+	}
+
+	return filter, nil
+}
+
+// allows reports whether a token transfer is imported, counting it in stats when it is not
+func (f *tokenFilter) allows(symbol, address string, stats *models.TokenFilterStats) bool {
+	if f == nil {
+		return true
+	}
+
+	if f.allow != nil && !f.allow[strings.ToLower(symbol)] && !f.allow[strings.ToLower(address)] {
+		stats.NotAllowed++
+		return false
+	}
+	for _, re := range f.deny {
+		if re.MatchString(symbol) || re.MatchString(address) {
+			stats.Denied++
+			return false
+		}
+	}
+	if f.scamList()[strings.ToLower(address)] {
+		stats.ScamList++
+		return false
+	}
+
+	return true
+}
+
+// scamList returns the scam list, loading it on first use. A list that fails to
+// load filters nothing and is retried on the next call.
+func (f *tokenFilter) scamList() map[string]bool {
+	if f.scamListURL == "" {
+		return nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.scam != nil {
+		return f.scam
+	}
+
+	scam, err := f.loadScamList()
+	if err != nil {
+		logger := internal.GetLogger()
+		logger.Warn().Err(err).Str("url", f.scamListURL).Msg("Failed to load token scam list")
+		return nil
+	}
+	f.scam = scam
+	return f.scam
+}
+
+func (f *tokenFilter) loadScamList() (map[string]bool, error) {
+	resp, err := f.httpClient.Get(f.scamListURL)
+	if err != nil {
+		return nil, interfaces.NewTransportError("failed to fetch scam list", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, interfaces.NewStatusError(resp, fmt.Sprintf("scam list returned non-200 status: %d", resp.StatusCode))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, interfaces.NewTransportError("failed to read scam list", err)
+	}
+
+	var addresses []string
+	if trimmed := bytes.TrimSpace(body); bytes.HasPrefix(trimmed, []byte("[")) {
+		if err := json.Unmarshal(trimmed, &addresses); err != nil {
+			return nil, interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode scam list", err)
+		}
+	} else {
+		scanner := bufio.NewScanner(bytes.NewReader(body))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line != "" && !strings.HasPrefix(line, "#") {
+				addresses = append(addresses, line)
+			}
+		}
+	}
+
+	scam := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		scam[strings.ToLower(address)] = true
+	}
+	return scam, nil
+}
+
+// tokenFilters holds the default token filter and the per-address overrides
+type tokenFilters struct {
+	fallback  *tokenFilter
+	byAddress map[string]*tokenFilter // lower-cased address
+}
+
+func newTokenFilters(fallback internal.TokenFilterConfig, perAddress map[string]internal.TokenFilterConfig,
+	httpClient *http.Client) (*tokenFilters, error) {
+	filters := &tokenFilters{byAddress: make(map[string]*tokenFilter, len(perAddress))}
+
+	var err error
+	if filters.fallback, err = newTokenFilter(fallback, httpClient); err != nil {
+		return nil, err
+	}
+	for address, cfg := range perAddress {
+		filter, err := newTokenFilter(cfg, httpClient)
+		if err != nil {
+			return nil, fmt.Errorf("token filter for %s: %w", address, err)
+		}
+		filters.byAddress[strings.ToLower(address)] = filter
+	}
+
+	return filters, nil
+}
+
+// forAddress returns the filter of an address; an address with its own config
+// does not inherit the default filter
+func (f *tokenFilters) forAddress(address string) *tokenFilter {
+	if filter, ok := f.byAddress[strings.ToLower(address)]; ok {
+		return filter
+	}
+	return f.fallback
+}
@@ -0,0 +1,109 @@
+package categorypacks
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// DefaultLocale is the locale every pack is fully translated to. The labels
+// of other locales fall back to it.
+const DefaultLocale = "en"
+
+// files holds the category trees in packs/ and their labels in locales/. The
+// locales are shared by every pack, keyed by category key.
+//
+//go:embed packs/*.json locales/*.json
+var files embed.FS
+
+// Packs returns the names of the embedded category packs
+func Packs() []string {
+	return names("packs")
+}
+
+// Locales returns the embedded locales
+func Locales() []string {
+	return names("locales")
+}
+
+// Load returns a pack and the translations of a locale followed by its
+// fallbacks, ready for models.CategoryPack.Flatten. A regional locale such as
+// de-AT falls back to de, and every locale to en.
+func Load(pack, locale string) (*models.CategoryPack, []models.CategoryTranslations, error) {
+	data, err := files.ReadFile(path.Join("packs", pack+".json"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown category pack %q, available: %s", pack, strings.Join(Packs(), ", "))
+	}
+	var p models.CategoryPack
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse category pack %s: %w", pack, err)
+	}
+	if p.Name == "" {
+		p.Name = pack
+	}
+	if err := p.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("category pack %s: %w", pack, err)
+	}
+
+	chain := localeChain(locale)
+	loaded := make(map[string]bool, len(chain))
+	var translations []models.CategoryTranslations
+	for _, candidate := range chain {
+		data, err := files.ReadFile(path.Join("locales", candidate+".json"))
+		if err != nil {
+			continue
+		}
+		var labels models.CategoryTranslations
+		if err := json.Unmarshal(data, &labels); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse category locale %s: %w", candidate, err)
+		}
+		translations = append(translations, labels)
+		loaded[candidate] = true
+	}
+	// a regional variant may be missing, the language may not
+	if language, _, _ := strings.Cut(chain[0], "-"); !loaded[language] {
+		return nil, nil, fmt.Errorf("unknown category locale %q, available: %s", locale, strings.Join(Locales(), ", "))
+	}
+
+	return &p, translations, nil
+}
+
+// localeChain returns the locale, its language and the default locale,
+// without duplicates
+func localeChain(locale string) []string {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	var chain []string
+	add := func(candidate string) {
+		for _, existing := range chain {
+			if existing == candidate {
+				return
+			}
+		}
+		if candidate != "" {
+			chain = append(chain, candidate)
+		}
+	}
+	add(locale)
+	if language, _, ok := strings.Cut(locale, "-"); ok {
+		add(language)
+	}
+	add(DefaultLocale)
+	return chain
+}
+
+// names returns the base names of the JSON files of an embedded directory
+func names(dir string) []string {
+	entries, _ := fs.ReadDir(files, dir)
+	var result []string
+	for _, entry := range entries {
+		result = append(result, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,75 @@
+package categorypacks
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLoad_EveryPack(t *testing.T) {
+	for _, name := range Packs() {
+		pack, translations, err := Load(name, DefaultLocale)
+		if err != nil {
+			t.Fatalf("Load(%s) error = %v", name, err)
+		}
+		for _, category := range pack.Flatten(translations...) {
+			if category.Name == category.Key {
+				t.Errorf("pack %s: %s has no %s label", name, category.Key, DefaultLocale)
+			}
+		}
+	}
+}
+
+func TestLocales_Complete(t *testing.T) {
+	_, base, err := Load("standard", DefaultLocale)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	for _, locale := range Locales() {
+		_, translations, err := Load("standard", locale)
+		if err != nil {
+			t.Fatalf("Load(%s) error = %v", locale, err)
+		}
+		for key := range base[0] {
+			if label := translations[0][key]; label.Name == "" {
+				t.Errorf("locale %s: missing label for %s", locale, key)
+			}
+		}
+		for key := range translations[0] {
+			if _, ok := base[0][key]; !ok {
+				t.Errorf("locale %s: label for unknown key %s", locale, key)
+			}
+		}
+	}
+}
+
+func TestLoad_Fallback(t *testing.T) {
+	_, translations, err := Load("standard", "de_AT")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(translations) != 2 || translations[0]["food"].Name != "Essen" || translations[1]["food"].Name != "Food" {
+		t.Errorf("Load(de_AT) translations = %v, want de then en", translations)
+	}
+
+	if _, _, err := Load("standard", "xx"); err == nil {
+		t.Error("Load(xx) error = nil, want an unknown locale")
+	}
+	if _, _, err := Load("unknown", DefaultLocale); err == nil {
+		t.Error("Load(unknown) error = nil, want an unknown pack")
+	}
+}
+
+func TestLocaleChain(t *testing.T) {
+	tests := map[string][]string{
+		"":      {"en"},
+		"en":    {"en"},
+		"fr":    {"fr", "en"},
+		"de-AT": {"de-at", "de", "en"},
+		"en_GB": {"en-gb", "en"},
+	}
+	for locale, want := range tests {
+		if got := localeChain(locale); !reflect.DeepEqual(got, want) {
+			t.Errorf("localeChain(%q) = %v, want %v", locale, got, want)
+		}
+	}
+}
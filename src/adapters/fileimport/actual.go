@@ -0,0 +1,74 @@
+package fileimport
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// ActualProfile parses the transaction export of Actual Budget, where every
+// row has a signed amount and splits carry their share in a split column
+type ActualProfile struct {
+	// Location is the timezone the dates of the export are read in; UTC when nil
+	Location *time.Location
+}
+
+// Name implements BudgetProfile
+func (ActualProfile) Name() string { return "actual" }
+
+// Title implements BudgetProfile
+func (ActualProfile) Title() string { return "Actual Budget" }
+
+// Parse implements BudgetProfile
+func (p ActualProfile) Parse(r io.Reader) ([]models.BudgetTransaction, error) {
+	t, err := readTable(r, "account", "date", "payee", "amount")
+	if err != nil {
+		return nil, err
+	}
+
+	var transactions []models.BudgetTransaction
+	accounts := make(map[string]bool)
+	for i, row := range t.rows {
+		if len(row) < len(t.columns)/2 {
+			continue // blank lines
+		}
+
+		date, err := parseTimeIn(t.get(row, "date"), p.Location, budgetDateLayouts...)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", t.lineOf(i), err)
+		}
+		value := t.get(row, "split_amount")
+		if value == "" {
+			value = t.get(row, "amount")
+		}
+		amount, err := parseAmount(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid amount: %w", t.lineOf(i), err)
+		}
+
+		tx := models.BudgetTransaction{
+			Account:  t.get(row, "account"),
+			Date:     date,
+			Payee:    t.get(row, "payee"),
+			Category: t.get(row, "category"),
+			Memo:     t.get(row, "notes"),
+			Amount:   amount,
+			Cleared:  parseCleared(t.get(row, "cleared")),
+		}
+		accounts[tx.Account] = true
+		transactions = append(transactions, tx)
+	}
+
+	// Actual names the payee of a transfer after the other account
+	for i := range transactions {
+		tx := &transactions[i]
+		if tx.Payee != tx.Account && accounts[tx.Payee] {
+			tx.TransferAccount = tx.Payee
+		}
+	}
+
+	assignBudgetIDs(transactions)
+	return transactions, nil
+}
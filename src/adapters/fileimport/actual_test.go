@@ -0,0 +1,40 @@
+package fileimport
+
+import (
+	"strings"
+	"testing"
+)
+
+const actualExport = `Account,Date,Payee,Notes,Category,Amount,Split_Amount,Cleared
+Checking,2024-01-02,Grocer,weekly shop,Food,-82.40,,true
+Checking,2024-01-05,Employer,,Income,2500.00,,true
+Checking,2024-01-06,Savings,,,-300.00,,false
+Savings,2024-01-06,Checking,,,300.00,,false
+Checking,2024-01-08,Warehouse,,Household,-60.00,-45.00,true
+Checking,2024-01-08,Warehouse,,Food,-60.00,-15.00,true
+`
+
+func TestActualProfile_Parse(t *testing.T) {
+	transactions, err := ActualProfile{}.Parse(strings.NewReader(actualExport))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(transactions) != 6 {
+		t.Fatalf("Parse() = %d transactions, want 6", len(transactions))
+	}
+
+	if grocer := transactions[0]; grocer.Amount != -82.4 || grocer.Category != "Food" || grocer.Memo != "weekly shop" || !grocer.Cleared {
+		t.Errorf("grocer = %+v, want a cleared 82.40 outflow in Food", grocer)
+	}
+
+	if out, in := transactions[2], transactions[3]; out.TransferAccount != "Savings" || in.TransferAccount != "Checking" {
+		t.Errorf("transfer = %+v and %+v, want both sides of the transfer", out, in)
+	}
+	if employer := transactions[1]; employer.TransferAccount != "" {
+		t.Errorf("employer = %+v, want no transfer", employer)
+	}
+
+	if household, food := transactions[4], transactions[5]; household.Amount != -45 || food.Amount != -15 {
+		t.Errorf("splits = %v and %v, want the split amounts", household.Amount, food.Amount)
+	}
+}
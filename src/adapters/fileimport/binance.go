@@ -0,0 +1,86 @@
+package fileimport
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// BinanceProfile parses the Binance transaction history export. Trade legs
+// carry no shared reference, so the legs booked on one account in the same
+// second are paired.
+type BinanceProfile struct{}
+
+// binanceTradeOperations are the operations that book one leg of a trade
+var binanceTradeOperations = map[string]bool{
+	"buy": true, "sell": true, "transaction related": true,
+	"transaction buy": true, "transaction spend": true, "transaction sold": true, "transaction revenue": true,
+	"small assets exchange bnb": true, "binance convert": true, "large otc trading": true,
+}
+
+// binanceFeeOperations are the operations that book a trading fee
+var binanceFeeOperations = map[string]bool{"fee": true, "transaction fee": true}
+
+// binanceInternalMoves mark operations that only move funds between Binance wallets
+var binanceInternalMoves = []string{"subscription", "redemption", "purchase", "transfer between", "transfer_"}
+
+// Name implements Profile
+func (BinanceProfile) Name() string { return "binance" }
+
+// Title implements Profile
+func (BinanceProfile) Title() string { return "Binance" }
+
+// Parse implements Profile
+func (BinanceProfile) Parse(r io.Reader) ([]models.ExchangeMovement, error) {
+	t, err := readTable(r, "utc_time", "account", "operation", "coin", "change")
+	if err != nil {
+		return nil, err
+	}
+
+	var legs []leg
+rows:
+	for i, row := range t.rows {
+		operation := strings.ToLower(t.get(row, "operation"))
+		for _, move := range binanceInternalMoves {
+			if strings.Contains(operation, move) {
+				continue rows
+			}
+		}
+
+		date, err := parseTime(t.get(row, "utc_time"), "2006-01-02 15:04:05", "06-01-02 15:04:05")
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", t.lineOf(i), err)
+		}
+		change, err := parseAmount(t.get(row, "change"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid change: %w", t.lineOf(i), err)
+		}
+
+		l := leg{
+			group:  t.get(row, "utc_time") + "|" + t.get(row, "account"),
+			date:   date,
+			asset:  strings.ToUpper(t.get(row, "coin")),
+			amount: change,
+			note:   t.get(row, "remark"),
+		}
+		switch {
+		case binanceTradeOperations[operation]:
+			l.trade = true
+		case binanceFeeOperations[operation]:
+			l.trade = true
+			l.amount, l.fee = 0, -change
+		default:
+			l.group += "|" + operation + "|" + l.asset + "|" + t.get(row, "change")
+			if strings.Contains(operation, "distribution") || strings.Contains(operation, "airdrop") {
+				l.category = models.CategoryAirdrops
+			} else if strings.Contains(operation, "interest") || strings.Contains(operation, "staking") || strings.Contains(operation, "reward") {
+				l.category = models.CategoryStakingRewards
+			}
+		}
+		legs = append(legs, l)
+	}
+
+	return pairLegs(legs), nil
+}
@@ -0,0 +1,53 @@
+package fileimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+const binanceHistory = `User_ID,UTC_Time,Account,Operation,Coin,Change,Remark
+1,2024-01-02 10:00:00,Spot,Deposit,USDT,1000,
+1,2024-01-03 11:00:00,Spot,Transaction Spend,USDT,-300,
+1,2024-01-03 11:00:00,Spot,Transaction Buy,ETH,0.1,
+1,2024-01-03 11:00:00,Spot,Transaction Spend,USDT,-200,
+1,2024-01-03 11:00:00,Spot,Transaction Buy,ETH,0.066,
+1,2024-01-03 11:00:00,Spot,Transaction Fee,BNB,-0.001,
+1,2024-01-04 12:00:00,Spot,Simple Earn Flexible Subscription,ETH,-0.1,
+1,2024-01-05 12:00:00,Earn,Simple Earn Flexible Interest,ETH,0.0001,
+`
+
+func TestBinanceProfile_Parse(t *testing.T) {
+	movements, err := BinanceProfile{}.Parse(strings.NewReader(binanceHistory))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(movements) != 4 {
+		t.Fatalf("Parse() = %d movements, want deposit, trade, fee and interest: %+v", len(movements), movements)
+	}
+
+	trade, fee := movements[1], movements[2]
+	if trade.Kind != models.ExchangeTrade || trade.Asset != "USDT" || trade.Amount != 500 ||
+		trade.ToAsset != "ETH" || trade.ToAmount != 0.166 {
+		t.Errorf("trade = %+v, want both fills netted into 500 USDT for 0.166 ETH", trade)
+	}
+	if fee.Kind != models.ExchangeFee || fee.Asset != "BNB" || fee.Amount != 0.001 {
+		t.Errorf("fee = %+v, want the BNB trading fee", fee)
+	}
+	if interest := movements[3]; interest.Kind != models.ExchangeDeposit || interest.Category != models.CategoryStakingRewards {
+		t.Errorf("interest = %+v, want a staking reward deposit", interest)
+	}
+}
+
+func TestPairLegs_Unpaired(t *testing.T) {
+	legs := []leg{
+		{group: "g", trade: true, asset: "BTC", amount: -1},
+		{group: "g", trade: true, asset: "ETH", amount: -2},
+	}
+
+	movements := pairLegs(legs)
+	if len(movements) != 2 || movements[0].Kind != models.ExchangeWithdrawal || movements[1].Kind != models.ExchangeWithdrawal {
+		t.Errorf("pairLegs() = %+v, want both legs kept as withdrawals", movements)
+	}
+}
@@ -0,0 +1,66 @@
+package fileimport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// BudgetProfile parses the register export of a budgeting app
+type BudgetProfile interface {
+	// Name is the identifier of the profile, also used as the import source
+	Name() string
+
+	// Title is the display name of the app
+	Title() string
+
+	// Parse reads an export and returns its transactions in file order
+	Parse(r io.Reader) ([]models.BudgetTransaction, error)
+}
+
+// BudgetProfiles returns every supported budgeting app profile, reading the
+// dates of the exports in loc, UTC when nil
+func BudgetProfiles(loc *time.Location) []BudgetProfile {
+	return []BudgetProfile{
+		YNABProfile{Location: loc},
+		ActualProfile{Location: loc},
+	}
+}
+
+// budgetDateLayouts are the date formats budgeting apps export, depending on the user's locale
+var budgetDateLayouts = []string{"2006-01-02", "01/02/2006", "02.01.2006"}
+
+// assignBudgetIDs gives every transaction an ID derived from its content.
+// Register exports carry no IDs, so identical rows are told apart by their
+// occurrence; the IDs stay stable when the same history is exported again.
+func assignBudgetIDs(transactions []models.BudgetTransaction) {
+	seen := make(map[string]int)
+	for i := range transactions {
+		tx := &transactions[i]
+		key := strings.Join([]string{
+			tx.Account,
+			tx.Date.Format(time.DateOnly), // in the location the export was read in
+			tx.Payee,
+			tx.Category,
+			tx.Memo,
+			strconv.FormatFloat(tx.Amount, 'f', -1, 64),
+		}, "|")
+		seen[key]++
+		sum := sha256.Sum256([]byte(key + "|" + strconv.Itoa(seen[key])))
+		tx.ID = hex.EncodeToString(sum[:12])
+	}
+}
+
+// parseCleared reports whether a cleared column marks a transaction as cleared
+func parseCleared(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "cleared", "reconciled", "true", "yes", "c", "r":
+		return true
+	}
+	return false
+}
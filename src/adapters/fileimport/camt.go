@@ -0,0 +1,326 @@
+package fileimport
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/banking"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// ErrNoStatements is returned for XML files without camt.053 statements or camt.052 reports
+var ErrNoStatements = errors.New("file contains no camt.053 statement or camt.052 report")
+
+// CamtParser parses ISO 20022 bank statements: camt.053 end-of-day statements
+// and camt.052 intraday reports, in any message version. Elements are matched
+// by name, so the namespace of the version does not matter.
+type CamtParser struct {
+	// Location is the timezone of the bank, in which booking dates and times
+	// without an offset are read; UTC when nil
+	Location *time.Location
+}
+
+// camtBalanceTypes maps ISO 20022 balance codes to the balance types used for reconciliation
+var camtBalanceTypes = map[string]models.BalanceType{
+	"OPBD": models.BalanceTypeOpeningBooked,
+	"PRCD": models.BalanceTypeOpeningBooked, // closing booked of the previous statement
+	"CLBD": models.BalanceTypeClosingBooked,
+	"ITBD": models.BalanceTypeInterimBooked,
+	"ITAV": models.BalanceTypeInterimAvailable,
+	"CLAV": models.BalanceTypeInterimAvailable,
+	"FWAV": models.BalanceTypeForwardAvailable,
+	"XPCD": models.BalanceTypeExpected,
+}
+
+type camtDocument struct {
+	Statements []camtStatement `xml:"BkToCstmrStmt>Stmt"`
+	Reports    []camtStatement `xml:"BkToCstmrAcctRpt>Rpt"`
+}
+
+type camtStatement struct {
+	ID      string `xml:"Id"`
+	Account struct {
+		IBAN     string `xml:"Id>IBAN"`
+		Other    string `xml:"Id>Othr>Id"`
+		Currency string `xml:"Ccy"`
+	} `xml:"Acct"`
+	Balances []struct {
+		Code      string     `xml:"Tp>CdOrPrtry>Cd"`
+		Amount    camtAmount `xml:"Amt"`
+		Indicator string     `xml:"CdtDbtInd"`
+		Date      camtDate   `xml:"Dt"`
+	} `xml:"Bal"`
+	Entries []camtEntry `xml:"Ntry"`
+}
+
+type camtEntry struct {
+	Reference      string       `xml:"NtryRef"`
+	ServicerRef    string       `xml:"AcctSvcrRef"`
+	Amount         camtAmount   `xml:"Amt"`
+	Indicator      string       `xml:"CdtDbtInd"`
+	Status         camtStatus   `xml:"Sts"`
+	BookingDate    camtDate     `xml:"BookgDt"`
+	ValueDate      camtDate     `xml:"ValDt"`
+	Details        []camtTxDtls `xml:"NtryDtls>TxDtls"`
+	AdditionalInfo string       `xml:"AddtlNtryInf"`
+}
+
+type camtTxDtls struct {
+	ServicerRef    string     `xml:"Refs>AcctSvcrRef"`
+	EndToEndID     string     `xml:"Refs>EndToEndId"`
+	Amount         camtAmount `xml:"Amt"` // version 8 and later
+	TxAmount       camtAmount `xml:"AmtDtls>TxAmt>Amt"`
+	Indicator      string     `xml:"CdtDbtInd"`
+	Debtor         camtParty  `xml:"RltdPties>Dbtr"`
+	DebtorIBAN     string     `xml:"RltdPties>DbtrAcct>Id>IBAN"`
+	Creditor       camtParty  `xml:"RltdPties>Cdtr"`
+	CreditorIBAN   string     `xml:"RltdPties>CdtrAcct>Id>IBAN"`
+	Unstructured   []string   `xml:"RmtInf>Ustrd"`
+	CreditorRef    string     `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+	AdditionalInfo string     `xml:"AddtlTxInf"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+// camtStatus is a plain code before version 8 and a <Cd> element after
+type camtStatus struct {
+	Text string `xml:",chardata"`
+	Code string `xml:"Cd"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+// camtParty holds the name directly before version 8 and in <Pty> after
+type camtParty struct {
+	Name  string `xml:"Nm"`
+	Party string `xml:"Pty>Nm"`
+}
+
+// Parse reads every statement and report of a camt file
+func (p CamtParser) Parse(r io.Reader) ([]models.BankStatement, error) {
+	var document camtDocument
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to read xml: %w", err)
+	}
+
+	sources := append(document.Statements, document.Reports...)
+	if len(sources) == 0 {
+		return nil, ErrNoStatements
+	}
+
+	statements := make([]models.BankStatement, 0, len(sources))
+	for _, source := range sources {
+		statement, err := source.statement(p.Location)
+		if err != nil {
+			return nil, fmt.Errorf("statement %s: %w", source.ID, err)
+		}
+		statements = append(statements, statement)
+	}
+	return statements, nil
+}
+
+func (s camtStatement) statement(loc *time.Location) (models.BankStatement, error) {
+	statement := models.BankStatement{
+		ID:       strings.TrimSpace(s.ID),
+		IBAN:     strings.TrimSpace(s.Account.IBAN),
+		Currency: strings.TrimSpace(s.Account.Currency),
+	}
+	if statement.IBAN == "" {
+		statement.IBAN = strings.TrimSpace(s.Account.Other)
+	}
+
+	for _, balance := range s.Balances {
+		balanceType, ok := camtBalanceTypes[strings.TrimSpace(balance.Code)]
+		if !ok {
+			continue
+		}
+		amount, err := balance.Amount.signed(balance.Indicator)
+		if err != nil {
+			return statement, fmt.Errorf("balance %s: %w", balance.Code, err)
+		}
+		date, err := balance.Date.parse(loc)
+		if err != nil {
+			return statement, fmt.Errorf("balance %s: %w", balance.Code, err)
+		}
+		if statement.Currency == "" {
+			statement.Currency = balance.Amount.Currency
+		}
+		statement.Balances = append(statement.Balances, models.ReportedBalance{
+			Amount:        amount,
+			Currency:      balance.Amount.Currency,
+			BalanceType:   balanceType,
+			ReferenceDate: date,
+		})
+	}
+
+	for i, entry := range s.Entries {
+		entries, err := entry.entries(loc)
+		if err != nil {
+			return statement, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+		statement.Entries = append(statement.Entries, entries...)
+		if statement.Currency == "" {
+			statement.Currency = entry.Amount.Currency
+		}
+	}
+
+	return statement, nil
+}
+
+// entries converts an entry into bank entries: one per transaction of a batch
+// booking that itemizes its transactions, else one for the whole entry
+func (e camtEntry) entries(loc *time.Location) ([]models.BankEntry, error) {
+	date := e.BookingDate
+	if date.Date == "" && date.DateTime == "" {
+		date = e.ValueDate
+	}
+	booked, err := date.parse(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := e.Amount.value()
+	if err != nil {
+		return nil, err
+	}
+
+	id := strings.TrimSpace(e.ServicerRef)
+	if id == "" {
+		id = strings.TrimSpace(e.Reference)
+	}
+
+	status := strings.TrimSpace(e.Status.Code)
+	if status == "" {
+		status = strings.TrimSpace(e.Status.Text)
+	}
+	base := models.BankEntry{
+		ID:     id,
+		Date:   booked,
+		Amount: total,
+		Credit: e.Indicator == "CRDT",
+		Booked: status == "" || status == "BOOK",
+		Memo:   strings.TrimSpace(e.AdditionalInfo),
+	}
+
+	details := e.Details
+	if len(details) > 1 {
+		// Itemize only when every transaction carries its own amount
+		for _, detail := range details {
+			if detail.amount().Value == "" {
+				details = details[:1]
+				break
+			}
+		}
+	}
+	if len(details) == 0 {
+		if base.ID == "" {
+			base.ID = fallbackEntryID(base)
+		}
+		return []models.BankEntry{base}, nil
+	}
+
+	entries := make([]models.BankEntry, 0, len(details))
+	for i, detail := range details {
+		entry := base
+		if len(details) > 1 {
+			entry.Amount, err = detail.amount().value()
+			if err != nil {
+				return nil, err
+			}
+			if detail.Indicator != "" {
+				entry.Credit = detail.Indicator == "CRDT"
+			}
+			if ref := strings.TrimSpace(detail.ServicerRef); ref != "" {
+				entry.ID = ref
+			} else if entry.ID != "" {
+				entry.ID += "-" + strconv.Itoa(i+1)
+			}
+		}
+		detail.apply(&entry)
+		if entry.ID == "" {
+			entry.ID = fallbackEntryID(entry)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (d camtTxDtls) amount() camtAmount {
+	if d.Amount.Value != "" {
+		return d.Amount
+	}
+	return d.TxAmount
+}
+
+// apply fills in the counterparty and references of a transaction
+func (d camtTxDtls) apply(entry *models.BankEntry) {
+	// The counterparty is the creditor of a debit and the debtor of a credit
+	party, iban := d.Creditor, d.CreditorIBAN
+	if entry.Credit {
+		party, iban = d.Debtor, d.DebtorIBAN
+	}
+	entry.Counterparty = strings.TrimSpace(party.Name)
+	if entry.Counterparty == "" {
+		entry.Counterparty = strings.TrimSpace(party.Party)
+	}
+	entry.CounterpartyIBAN = strings.TrimSpace(iban)
+
+	remittance := banking.ParseRemittance(d.Unstructured)
+	if remittance.Memo != "" {
+		entry.Memo = remittance.Memo
+	} else if info := strings.TrimSpace(d.AdditionalInfo); info != "" {
+		entry.Memo = info
+	}
+
+	entry.Reference = strings.TrimSpace(d.CreditorRef)
+	if entry.Reference == "" {
+		entry.Reference = remittance.Reference
+	}
+
+	entry.EndToEndID = strings.TrimSpace(d.EndToEndID)
+	if strings.EqualFold(entry.EndToEndID, "NOTPROVIDED") || entry.EndToEndID == "" {
+		entry.EndToEndID = remittance.EndToEndID
+	}
+}
+
+// fallbackEntryID identifies an entry the bank gave no reference by its contents
+func fallbackEntryID(entry models.BankEntry) string {
+	return strings.Join([]string{
+		entry.Date.Format("2006-01-02"),
+		strconv.FormatFloat(entry.Signed(), 'f', 2, 64),
+		entry.EndToEndID,
+		entry.Counterparty,
+		entry.Memo,
+	}, "|")
+}
+
+func (a camtAmount) value() (float64, error) {
+	return models.ParseAmount(a.Value, a.Currency)
+}
+
+func (a camtAmount) signed(indicator string) (float64, error) {
+	value, err := a.value()
+	if indicator == "DBIT" {
+		value = -value
+	}
+	return value, err
+}
+
+// parse reads a date or date time in loc, unless it carries its own offset
+func (d camtDate) parse(loc *time.Location) (time.Time, error) {
+	if d.DateTime != "" {
+		return parseTimeIn(d.DateTime, loc, time.RFC3339, "2006-01-02T15:04:05.999999999", "2006-01-02T15:04:05")
+	}
+	return parseTimeIn(d.Date, loc, time.DateOnly)
+}
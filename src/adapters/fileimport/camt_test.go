@@ -0,0 +1,174 @@
+package fileimport
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+const camt053 = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt>
+    <GrpHdr><MsgId>MSG1</MsgId><CreDtTm>2024-03-02T06:00:00</CreDtTm></GrpHdr>
+    <Stmt>
+      <Id>STMT-2024-03-01</Id>
+      <Acct><Id><IBAN>DE89370400440532013000</IBAN></Id><Ccy>EUR</Ccy></Acct>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>PRCD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="EUR">1000.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2024-02-29</Dt></Dt>
+      </Bal>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>CLBD</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="EUR">1354.50</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><Dt>2024-03-01</Dt></Dt>
+      </Bal>
+      <Ntry>
+        <Amt Ccy="EUR">500.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts>
+        <BookgDt><Dt>2024-03-01</Dt></BookgDt><AcctSvcrRef>BANK-1</AcctSvcrRef>
+        <NtryDtls><TxDtls>
+          <Refs><EndToEndId>E2E-SALARY-03</EndToEndId></Refs>
+          <RltdPties><Dbtr><Nm>ACME GmbH</Nm></Dbtr><DbtrAcct><Id><IBAN>DE02120300000000202051</IBAN></Id></DbtrAcct></RltdPties>
+          <RmtInf><Ustrd>Salary March</Ustrd></RmtInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">145.50</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts>BOOK</Sts>
+        <BookgDt><Dt>2024-03-01</Dt></BookgDt><AcctSvcrRef>BANK-2</AcctSvcrRef>
+        <NtryDtls>
+          <TxDtls>
+            <Refs><AcctSvcrRef>BANK-2a</AcctSvcrRef></Refs>
+            <AmtDtls><TxAmt><Amt Ccy="EUR">100.00</Amt></TxAmt></AmtDtls>
+            <RltdPties><Cdtr><Nm>Power Utility</Nm></Cdtr><CdtrAcct><Id><IBAN>DE75512108001245126199</IBAN></Id></CdtrAcct></RltdPties>
+            <RmtInf><Strd><CdtrRefInf><Ref>RF18539007547034</Ref></CdtrRefInf></Strd></RmtInf>
+          </TxDtls>
+          <TxDtls>
+            <AmtDtls><TxAmt><Amt Ccy="EUR">45.50</Amt></TxAmt></AmtDtls>
+            <RltdPties><Cdtr><Nm>Phone Company</Nm></Cdtr></RltdPties>
+            <RmtInf><Ustrd>Invoice INV-2024-118</Ustrd></RmtInf>
+          </TxDtls>
+        </NtryDtls>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`
+
+const camt052 = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.052.001.08">
+  <BkToCstmrAcctRpt>
+    <Rpt>
+      <Id>RPT-1</Id>
+      <Acct><Id><IBAN>DE89370400440532013000</IBAN></Id></Acct>
+      <Bal>
+        <Tp><CdOrPrtry><Cd>ITAV</Cd></CdOrPrtry></Tp>
+        <Amt Ccy="EUR">1300.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Dt><DtTm>2024-03-02T12:00:00+01:00</DtTm></Dt>
+      </Bal>
+      <Ntry>
+        <Amt Ccy="EUR">54.50</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>PDNG</Cd></Sts>
+        <ValDt><Dt>2024-03-02</Dt></ValDt>
+        <NtryDtls><TxDtls>
+          <Amt Ccy="EUR">54.50</Amt>
+          <RltdPties><Cdtr><Pty><Nm>Grocery Store</Nm></Pty></Cdtr></RltdPties>
+        </TxDtls></NtryDtls>
+      </Ntry>
+    </Rpt>
+  </BkToCstmrAcctRpt>
+</Document>`
+
+func TestCamtParser_ParseStatement(t *testing.T) {
+	statements, err := CamtParser{}.Parse(strings.NewReader(camt053))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(statements) != 1 {
+		t.Fatalf("Parse() = %d statements, want 1", len(statements))
+	}
+
+	statement := statements[0]
+	if statement.ID != "STMT-2024-03-01" || statement.IBAN != "DE89370400440532013000" || statement.Currency != "EUR" {
+		t.Errorf("statement = %+v, want the EUR account DE89370400440532013000", statement)
+	}
+
+	if opening, ok := statement.Balance(models.BalanceTypeOpeningBooked); !ok || opening.Amount != 1000 {
+		t.Errorf("opening balance = %+v, %v, want the previous closing balance of 1000", opening, ok)
+	}
+	if closing, ok := statement.Balance(models.BalanceTypeClosingBooked); !ok || closing.Amount != 1354.5 {
+		t.Errorf("closing balance = %+v, %v, want 1354.50", closing, ok)
+	}
+	if unbalanced := statement.Unbalanced(); unbalanced != 0 {
+		t.Errorf("Unbalanced() = %v, want 0 for a complete statement", unbalanced)
+	}
+
+	if len(statement.Entries) != 3 {
+		t.Fatalf("entries = %d, want the salary and both itemized batch payments: %+v", len(statement.Entries), statement.Entries)
+	}
+
+	salary := statement.Entries[0]
+	if salary.ID != "BANK-1" || !salary.Credit || !salary.Booked || salary.Amount != 500 ||
+		salary.Counterparty != "ACME GmbH" || salary.CounterpartyIBAN != "DE02120300000000202051" ||
+		salary.EndToEndID != "E2E-SALARY-03" || salary.Memo != "Salary March" {
+		t.Errorf("salary = %+v, want a booked 500 credit from ACME GmbH", salary)
+	}
+
+	utility := statement.Entries[1]
+	if utility.ID != "BANK-2a" || utility.Credit || utility.Amount != 100 ||
+		utility.Counterparty != "Power Utility" || utility.Reference != "RF18539007547034" {
+		t.Errorf("utility = %+v, want a 100 debit to the creditor with its RF reference", utility)
+	}
+
+	phone := statement.Entries[2]
+	if phone.ID != "BANK-2-2" || phone.Amount != 45.5 || phone.Counterparty != "Phone Company" || phone.Reference != "INV-2024-118" {
+		t.Errorf("phone = %+v, want the second batch item with the invoice reference", phone)
+	}
+}
+
+func TestCamtParser_ParseReport(t *testing.T) {
+	statements, err := CamtParser{}.Parse(strings.NewReader(camt052))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(statements) != 1 {
+		t.Fatalf("Parse() = %d statements, want 1", len(statements))
+	}
+
+	report := statements[0]
+	if report.Currency != "EUR" {
+		t.Errorf("Currency = %q, want it taken from the balances", report.Currency)
+	}
+	if available, ok := report.Balance(models.BalanceTypeInterimAvailable); !ok || available.Amount != 1300 {
+		t.Errorf("interim balance = %+v, %v, want 1300", available, ok)
+	}
+
+	if len(report.Entries) != 1 {
+		t.Fatalf("entries = %d, want 1", len(report.Entries))
+	}
+	pending := report.Entries[0]
+	if pending.Booked || pending.Counterparty != "Grocery Store" || pending.ID == "" {
+		t.Errorf("pending = %+v, want an unbooked entry with a derived ID", pending)
+	}
+}
+
+func TestCamtParser_ParseInLocation(t *testing.T) {
+	berlin := time.FixedZone("CET", 60*60)
+	statements, err := CamtParser{Location: berlin}.Parse(strings.NewReader(camt052))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	report := statements[0]
+	if want := time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC); !report.Entries[0].Date.Equal(want) {
+		t.Errorf("entry date = %v, want midnight in the bank's timezone %v", report.Entries[0].Date.UTC(), want)
+	}
+	available, _ := report.Balance(models.BalanceTypeInterimAvailable)
+	if want := time.Date(2024, 3, 2, 11, 0, 0, 0, time.UTC); !available.ReferenceDate.Equal(want) {
+		t.Errorf("balance date = %v, want the offset of the file kept %v", available.ReferenceDate.UTC(), want)
+	}
+}
+
+func TestCamtParser_ParseEmpty(t *testing.T) {
+	_, err := CamtParser{}.Parse(strings.NewReader(`<Document><BkToCstmrStmt></BkToCstmrStmt></Document>`))
+	if !errors.Is(err, ErrNoStatements) {
+		t.Errorf("Parse() error = %v, want ErrNoStatements", err)
+	}
+}
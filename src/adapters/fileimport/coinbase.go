@@ -0,0 +1,118 @@
+package fileimport
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// CoinbaseProfile parses the Coinbase transaction history report, where each
+// row is a complete transaction priced in the account's spot price currency
+type CoinbaseProfile struct{}
+
+// coinbaseConvert extracts both sides of a conversion from its notes,
+// e.g. "Converted 0.5 ETH to 812.45 USDC"
+var coinbaseConvert = regexp.MustCompile(`(?i)converted\s+([\d.,]+)\s+(\S+)\s+to\s+([\d.,]+)\s+(\S+)`)
+
+// Name implements Profile
+func (CoinbaseProfile) Name() string { return "coinbase" }
+
+// Title implements Profile
+func (CoinbaseProfile) Title() string { return "Coinbase" }
+
+// Parse implements Profile
+func (p CoinbaseProfile) Parse(r io.Reader) ([]models.ExchangeMovement, error) {
+	t, err := readTable(r, "timestamp", "transaction type", "asset", "quantity transacted")
+	if err != nil {
+		return nil, err
+	}
+
+	var legs []leg
+	for i, row := range t.rows {
+		if len(row) < len(t.columns)/2 {
+			continue // footer and blank lines
+		}
+
+		rowLegs, err := p.parseRow(t, row)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", t.lineOf(i), err)
+		}
+		legs = append(legs, rowLegs...)
+	}
+
+	return pairLegs(legs), nil
+}
+
+func (CoinbaseProfile) parseRow(t *table, row []string) ([]leg, error) {
+	date, err := parseTime(t.get(row, "timestamp"), "2006-01-02 15:04:05 MST", time.RFC3339, "2006-01-02T15:04:05Z")
+	if err != nil {
+		return nil, err
+	}
+
+	kind := strings.ToLower(t.get(row, "transaction type"))
+	asset := strings.ToUpper(t.get(row, "asset"))
+	quote := strings.ToUpper(t.get(row, "price currency", "spot price currency"))
+	notes := t.get(row, "notes")
+
+	quantity, err := parseAmount(t.get(row, "quantity transacted"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid quantity: %w", err)
+	}
+	subtotal, err := parseAmount(t.get(row, "subtotal"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid subtotal: %w", err)
+	}
+	fees, err := parseAmount(t.get(row, "fees and/or spread", "fees"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid fees: %w", err)
+	}
+	// Newer reports sign the quantity of outgoing rows
+	quantity, subtotal, fees = math.Abs(quantity), math.Abs(subtotal), math.Abs(fees)
+
+	id := t.get(row, "id")
+	if id == "" {
+		id = strings.Join([]string{t.get(row, "timestamp"), kind, asset, t.get(row, "quantity transacted")}, "|")
+	}
+
+	switch kind {
+	case "buy", "advanced trade buy":
+		return []leg{
+			{group: id, date: date, trade: true, asset: quote, amount: -subtotal, fee: fees, note: notes},
+			{group: id, date: date, trade: true, asset: asset, amount: quantity},
+		}, nil
+	case "sell", "advanced trade sell":
+		return []leg{
+			{group: id, date: date, trade: true, asset: asset, amount: -quantity, note: notes},
+			{group: id, date: date, trade: true, asset: quote, amount: subtotal, fee: fees},
+		}, nil
+	case "convert":
+		match := coinbaseConvert.FindStringSubmatch(notes)
+		if match == nil {
+			return nil, fmt.Errorf("cannot read conversion from notes %q", notes)
+		}
+		received, err := parseAmount(match[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid converted amount: %w", err)
+		}
+		// The spread is already reflected in the amounts of both sides
+		return []leg{
+			{group: id, date: date, trade: true, asset: asset, amount: -quantity, note: notes},
+			{group: id, date: date, trade: true, asset: strings.ToUpper(match[4]), amount: received},
+		}, nil
+	case "send", "withdrawal", "withdraw":
+		return []leg{{group: id, date: date, asset: asset, amount: -quantity, note: notes}}, nil
+	case "receive", "deposit":
+		return []leg{{group: id, date: date, asset: asset, amount: quantity, note: notes}}, nil
+	case "staking income", "inflation reward":
+		return []leg{{group: id, date: date, asset: asset, amount: quantity, category: models.CategoryStakingRewards, note: notes}}, nil
+	case "rewards income", "learning reward", "coinbase earn":
+		return []leg{{group: id, date: date, asset: asset, amount: quantity, category: models.CategoryAirdrops, note: notes}}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported transaction type %q", kind)
+}
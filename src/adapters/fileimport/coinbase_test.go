@@ -0,0 +1,57 @@
+package fileimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+const coinbaseReport = `Transactions
+User,jane@example.com,abc123
+ID,Timestamp,Transaction Type,Asset,Quantity Transacted,Price Currency,Price at Transaction,Subtotal,Total (inclusive of fees and/or spread),Fees and/or Spread,Notes
+1,2024-01-02 10:00:00 UTC,Buy,BTC,0.01,USD,$40000.00,$400.00,$405.99,$5.99,Bought 0.01 BTC for $405.99 USD
+2,2024-02-03 11:00:00 UTC,Convert,BTC,0.005,USD,$45000.00,$225.00,$225.00,$0.00,Converted 0.005 BTC to 0.09 ETH
+3,2024-03-04 12:00:00 UTC,Sell,ETH,-0.04,USD,$3000.00,-$120.00,-$118.00,$2.00,Sold 0.04 ETH for $118.00 USD
+4,2024-04-05 13:00:00 UTC,Staking Income,ETH,0.001,USD,$3200.00,$3.20,$3.20,$0.00,
+`
+
+func TestCoinbaseProfile_Parse(t *testing.T) {
+	movements, err := CoinbaseProfile{}.Parse(strings.NewReader(coinbaseReport))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(movements) != 5 {
+		t.Fatalf("Parse() = %d movements, want 3 trades, a fee and a reward: %+v", len(movements), movements)
+	}
+
+	buy := movements[0]
+	if buy.Kind != models.ExchangeTrade || buy.Asset != "USD" || buy.Amount != 400 || buy.Fee != 5.99 ||
+		buy.ToAsset != "BTC" || buy.ToAmount != 0.01 || buy.ID != "1" {
+		t.Errorf("buy = %+v, want 400 USD plus a 5.99 fee for 0.01 BTC", buy)
+	}
+
+	convert := movements[1]
+	if convert.Asset != "BTC" || convert.Amount != 0.005 || convert.ToAsset != "ETH" || convert.ToAmount != 0.09 {
+		t.Errorf("convert = %+v, want 0.005 BTC for 0.09 ETH", convert)
+	}
+
+	sell, fee := movements[2], movements[3]
+	if sell.Asset != "ETH" || sell.Amount != 0.04 || sell.ToAsset != "USD" || sell.ToAmount != 120 || sell.Fee != 0 {
+		t.Errorf("sell = %+v, want 0.04 ETH for 120 USD", sell)
+	}
+	if fee.Kind != models.ExchangeFee || fee.Asset != "USD" || fee.Amount != 2 {
+		t.Errorf("fee = %+v, want the 2 USD selling fee", fee)
+	}
+
+	if reward := movements[4]; reward.Kind != models.ExchangeDeposit || reward.Category != models.CategoryStakingRewards {
+		t.Errorf("reward = %+v, want a staking reward deposit", reward)
+	}
+}
+
+func TestCoinbaseProfile_UnsupportedType(t *testing.T) {
+	report := "Timestamp,Transaction Type,Asset,Quantity Transacted\n2024-01-02 10:00:00 UTC,Teleport,BTC,1\n"
+	if _, err := (CoinbaseProfile{}).Parse(strings.NewReader(report)); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Parse() error = %v, want the unsupported type on line 2", err)
+	}
+}
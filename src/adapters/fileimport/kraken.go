@@ -0,0 +1,103 @@
+package fileimport
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// KrakenProfile parses the Kraken ledgers export, where every balance change
+// is a row and the legs of a trade share a reference ID
+type KrakenProfile struct{}
+
+// krakenAssets maps Kraken's legacy asset codes to their common tickers
+var krakenAssets = map[string]string{
+	"XXBT": "BTC", "XBT": "BTC", "XXDG": "DOGE", "XDG": "DOGE",
+	"XETH": "ETH", "XETC": "ETC", "XLTC": "LTC", "XMLN": "MLN", "XREP": "REP",
+	"XXLM": "XLM", "XXMR": "XMR", "XXRP": "XRP", "XZEC": "ZEC", "ETH2": "ETH",
+	"ZUSD": "USD", "ZEUR": "EUR", "ZGBP": "GBP", "ZCAD": "CAD", "ZJPY": "JPY", "ZAUD": "AUD",
+}
+
+// krakenStakingMoves are internal transfers between the spot and staking wallets
+var krakenStakingMoves = map[string]bool{
+	"spottostaking": true, "stakingfromspot": true, "stakingtospot": true, "spotfromstaking": true,
+}
+
+// Name implements Profile
+func (KrakenProfile) Name() string { return "kraken" }
+
+// Title implements Profile
+func (KrakenProfile) Title() string { return "Kraken" }
+
+// Parse implements Profile
+func (KrakenProfile) Parse(r io.Reader) ([]models.ExchangeMovement, error) {
+	t, err := readTable(r, "txid", "refid", "time", "type", "asset", "amount", "fee")
+	if err != nil {
+		return nil, err
+	}
+
+	var legs []leg
+	for i, row := range t.rows {
+		// Unconfirmed entries are exported again without a txid once settled
+		if t.get(row, "txid") == "" {
+			continue
+		}
+
+		kind := strings.ToLower(t.get(row, "type"))
+		subtype := strings.ToLower(t.get(row, "subtype"))
+		if kind == "transfer" && krakenStakingMoves[subtype] {
+			continue
+		}
+		// Earn allocations only move funds between Kraken wallets; rewards have their own subtype
+		if kind == "earn" && subtype != "reward" {
+			continue
+		}
+
+		date, err := parseTime(t.get(row, "time"), "2006-01-02 15:04:05.9999", "2006-01-02 15:04:05")
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", t.lineOf(i), err)
+		}
+		amount, err := parseAmount(t.get(row, "amount"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid amount: %w", t.lineOf(i), err)
+		}
+		fee, err := parseAmount(t.get(row, "fee"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid fee: %w", t.lineOf(i), err)
+		}
+
+		l := leg{
+			group:  t.get(row, "refid"),
+			date:   date,
+			asset:  krakenAsset(t.get(row, "asset")),
+			amount: amount,
+			fee:    fee,
+		}
+		switch kind {
+		case "trade", "spend", "receive", "dustsweeping":
+			l.trade = true
+		case "staking", "earn":
+			l.category = models.CategoryStakingRewards
+			l.group = t.get(row, "txid")
+		default:
+			l.group = t.get(row, "txid")
+		}
+		legs = append(legs, l)
+	}
+
+	return pairLegs(legs), nil
+}
+
+// krakenAsset normalizes a Kraken asset code, folding staked variants into their asset
+func krakenAsset(code string) string {
+	code = strings.ToUpper(code)
+	if i := strings.IndexByte(code, '.'); i > 0 {
+		code = code[:i]
+	}
+	if ticker, ok := krakenAssets[code]; ok {
+		return ticker
+	}
+	return code
+}
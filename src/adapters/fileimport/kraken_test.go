@@ -0,0 +1,49 @@
+package fileimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+const krakenLedger = `"txid","refid","time","type","subtype","aclass","asset","wallet","amount","fee","balance"
+"","DEP1","2024-01-01 09:00:00","deposit","","currency","ZEUR","spot / main",1000.0000,0.0000,""
+"L1","DEP1","2024-01-01 09:05:00","deposit","","currency","ZEUR","spot / main",1000.0000,0.0000,1000.0000
+"L2","TRD1","2024-01-02 10:00:00","trade","","currency","ZEUR","spot / main",-500.0000,1.3000,498.7000
+"L3","TRD1","2024-01-02 10:00:00","trade","","currency","XXBT","spot / main",0.0125000000,0.0000000000,0.0125000000
+"L4","STK1","2024-01-03 10:00:00","transfer","spottostaking","currency","XETH","spot / main",-1.0,0,0
+"L5","RWD1","2024-01-04 10:00:00","staking","","currency","ETH2.S","spot / main",0.0010,0,1.001
+"L6","WDR1","2024-01-05 10:00:00","withdrawal","","currency","XXBT","spot / main",-0.0100000000,0.0001000000,0.0024000000
+`
+
+func TestKrakenProfile_Parse(t *testing.T) {
+	movements, err := KrakenProfile{}.Parse(strings.NewReader(krakenLedger))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(movements) != 4 {
+		t.Fatalf("Parse() = %d movements, want deposit, trade, reward and withdrawal: %+v", len(movements), movements)
+	}
+
+	if deposit := movements[0]; deposit.Kind != models.ExchangeDeposit || deposit.Asset != "EUR" || deposit.Amount != 1000 {
+		t.Errorf("deposit = %+v, want the settled 1000 EUR deposit only", deposit)
+	}
+
+	trade := movements[1]
+	if trade.Kind != models.ExchangeTrade || trade.ID != "TRD1" || trade.Asset != "EUR" || trade.Amount != 500 ||
+		trade.Fee != 1.3 || trade.ToAsset != "BTC" || trade.ToAmount != 0.0125 {
+		t.Errorf("trade = %+v, want 500 EUR plus a 1.3 fee for 0.0125 BTC", trade)
+	}
+	if rate := trade.Rate(); rate != 0.0125/500 {
+		t.Errorf("Rate() = %v, want BTC per EUR", rate)
+	}
+
+	if reward := movements[2]; reward.Asset != "ETH" || reward.Category != models.CategoryStakingRewards {
+		t.Errorf("reward = %+v, want an ETH staking reward", reward)
+	}
+
+	if withdrawal := movements[3]; withdrawal.Kind != models.ExchangeWithdrawal || withdrawal.Amount != 0.01 || withdrawal.Fee != 0.0001 {
+		t.Errorf("withdrawal = %+v, want 0.01 BTC plus a 0.0001 fee", withdrawal)
+	}
+}
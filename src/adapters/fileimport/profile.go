@@ -0,0 +1,278 @@
+// Package fileimport parses account history files exported by exchanges,
+// statement files exported by banks and register exports of budgeting apps,
+// for users who import their history from files instead of sharing API keys.
+package fileimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// Profile parses the export format of one exchange
+type Profile interface {
+	// Name is the identifier of the profile, also used as the import source
+	Name() string
+
+	// Title is the display name of the exchange
+	Title() string
+
+	// Parse reads an export and returns its movements, oldest first
+	Parse(r io.Reader) ([]models.ExchangeMovement, error)
+}
+
+// Profiles returns every supported exchange profile
+func Profiles() []Profile {
+	return []Profile{
+		CoinbaseProfile{},
+		KrakenProfile{},
+		BinanceProfile{},
+	}
+}
+
+// ErrMissingHeader is returned when an export lacks the columns of its profile
+var ErrMissingHeader = errors.New("export is missing the expected header row")
+
+// table is a CSV export with its columns indexed by header name
+type table struct {
+	columns map[string]int
+	rows    [][]string
+	line    int // line of the header, for error messages
+}
+
+// readTable reads a CSV export, skipping any preamble before the first row
+// that contains every required column
+func readTable(r io.Reader, required ...string) (*table, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv: %w", err)
+	}
+
+	for i, record := range records {
+		columns := make(map[string]int, len(record))
+		for j, name := range record {
+			columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = j
+		}
+
+		found := true
+		for _, name := range required {
+			if _, ok := columns[name]; !ok {
+				found = false
+				break
+			}
+		}
+		if found {
+			return &table{columns: columns, rows: records[i+1:], line: i + 1}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrMissingHeader, strings.Join(required, ", "))
+}
+
+// get returns the trimmed value of the first of the named columns present in a row
+func (t *table) get(row []string, names ...string) string {
+	for _, name := range names {
+		if i, ok := t.columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+	}
+	return ""
+}
+
+// lineOf returns the file line of a row, for error messages
+func (t *table) lineOf(row int) int {
+	return t.line + row + 1
+}
+
+// leg is one balance change of an exchange account. The legs of a trade share a group.
+type leg struct {
+	group    string
+	date     time.Time
+	trade    bool
+	asset    string
+	amount   float64 // signed: positive credits the account
+	fee      float64 // charged in asset on top of amount
+	category string
+	note     string
+}
+
+// pairLegs turns account legs into movements. The legs of each trade group
+// are netted per asset; a group that sold exactly one asset for exactly one
+// other becomes a trade, fees in the sold asset stay on the trade and any
+// other fee becomes a fee movement. Groups that do not pair up are kept as
+// deposits and withdrawals so no balance change is lost.
+func pairLegs(legs []leg) []models.ExchangeMovement {
+	var movements []models.ExchangeMovement
+	groups := make(map[string][]leg)
+	var order []string
+
+	for _, l := range legs {
+		if !l.trade {
+			if l.amount != 0 || l.fee != 0 {
+				movements = append(movements, legMovement(l.group, l))
+			}
+			continue
+		}
+		if _, ok := groups[l.group]; !ok {
+			order = append(order, l.group)
+		}
+		groups[l.group] = append(groups[l.group], l)
+	}
+
+	for _, group := range order {
+		movements = append(movements, pairGroup(group, groups[group])...)
+	}
+
+	sort.SliceStable(movements, func(i, j int) bool {
+		return movements[i].Date.Before(movements[j].Date)
+	})
+	return movements
+}
+
+func pairGroup(group string, legs []leg) []models.ExchangeMovement {
+	net := make(map[string]float64)
+	fees := make(map[string]float64)
+	var assets []string
+	for _, l := range legs {
+		if _, ok := net[l.asset]; !ok {
+			assets = append(assets, l.asset)
+		}
+		net[l.asset] += l.amount
+		fees[l.asset] += l.fee
+	}
+
+	var sold, bought []string
+	for _, asset := range assets {
+		switch {
+		case net[asset] < 0:
+			sold = append(sold, asset)
+		case net[asset] > 0:
+			bought = append(bought, asset)
+		}
+	}
+
+	date := legs[0].date
+	if len(sold) != 1 || len(bought) != 1 {
+		movements := make([]models.ExchangeMovement, 0, len(assets))
+		for _, asset := range assets {
+			if net[asset] == 0 && fees[asset] == 0 {
+				continue
+			}
+			movements = append(movements, legMovement(group+"-"+asset, leg{
+				date:   date,
+				asset:  asset,
+				amount: net[asset],
+				fee:    fees[asset],
+				note:   "unpaired trade leg",
+			}))
+		}
+		return movements
+	}
+
+	trade := models.ExchangeMovement{
+		ID:       group,
+		Kind:     models.ExchangeTrade,
+		Date:     date,
+		Asset:    sold[0],
+		Amount:   -net[sold[0]],
+		ToAsset:  bought[0],
+		ToAmount: net[bought[0]],
+		Fee:      fees[sold[0]],
+		Category: models.CategoryTrades,
+		Note:     legs[0].note,
+	}
+	movements := []models.ExchangeMovement{trade}
+
+	for _, asset := range assets {
+		if asset == trade.Asset || fees[asset] == 0 {
+			continue
+		}
+		movements = append(movements, feeMovement(group+"-fee-"+asset, date, asset, fees[asset]))
+	}
+	return movements
+}
+
+// legMovement converts a single leg into a deposit, withdrawal or fee
+func legMovement(id string, l leg) models.ExchangeMovement {
+	if l.amount == 0 {
+		return feeMovement(id, l.date, l.asset, l.fee)
+	}
+
+	movement := models.ExchangeMovement{
+		ID:       id,
+		Kind:     models.ExchangeDeposit,
+		Date:     l.date,
+		Asset:    l.asset,
+		Amount:   l.amount,
+		Fee:      l.fee,
+		Category: l.category,
+		Note:     l.note,
+	}
+	if l.amount < 0 {
+		movement.Kind = models.ExchangeWithdrawal
+		movement.Amount = -l.amount
+	}
+	if movement.Category == "" {
+		movement.Category = models.CategoryExchangeDeposits
+		if movement.Kind == models.ExchangeWithdrawal {
+			movement.Category = models.CategoryExchangeWithdrawals
+		}
+	}
+	return movement
+}
+
+func feeMovement(id string, date time.Time, asset string, amount float64) models.ExchangeMovement {
+	return models.ExchangeMovement{
+		ID:       id,
+		Kind:     models.ExchangeFee,
+		Date:     date,
+		Asset:    asset,
+		Amount:   amount,
+		Category: models.CategoryExchangeFees,
+	}
+}
+
+// parseAmount parses a number as exported by exchanges, tolerating currency
+// symbols, thousands separators and empty values
+func parseAmount(value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimLeft(value, "$€£")
+	value = strings.ReplaceAll(value, ",", "")
+	value = strings.Replace(value, "-$", "-", 1)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// parseTime parses a UTC timestamp in any of the given layouts
+func parseTime(value string, layouts ...string) (time.Time, error) {
+	t, err := parseTimeIn(value, time.UTC, layouts...)
+	return t.UTC(), err
+}
+
+// parseTimeIn parses a timestamp in any of the given layouts. Values without
+// a zone, like the calendar dates of bank statements, are read in loc, UTC
+// when loc is nil.
+func parseTimeIn(value string, loc *time.Location, layouts ...string) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, strings.TrimSpace(value), loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp %q", value)
+}
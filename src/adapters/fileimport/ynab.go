@@ -0,0 +1,78 @@
+package fileimport
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// YNABProfile parses the register export of YNAB, where every row is a
+// transaction or a split of one with its outflow and inflow in separate columns
+type YNABProfile struct {
+	// Location is the timezone the dates of the export are read in; UTC when nil
+	Location *time.Location
+}
+
+// ynabTransferPrefix starts the payee of transfers between budget accounts
+const ynabTransferPrefix = "Transfer : "
+
+// Name implements BudgetProfile
+func (YNABProfile) Name() string { return "ynab" }
+
+// Title implements BudgetProfile
+func (YNABProfile) Title() string { return "YNAB" }
+
+// Parse implements BudgetProfile
+func (p YNABProfile) Parse(r io.Reader) ([]models.BudgetTransaction, error) {
+	t, err := readTable(r, "account", "date", "payee", "outflow", "inflow")
+	if err != nil {
+		return nil, err
+	}
+
+	var transactions []models.BudgetTransaction
+	for i, row := range t.rows {
+		if len(row) < len(t.columns)/2 {
+			continue // blank lines
+		}
+
+		date, err := parseTimeIn(t.get(row, "date"), p.Location, budgetDateLayouts...)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", t.lineOf(i), err)
+		}
+		outflow, err := parseAmount(t.get(row, "outflow"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid outflow: %w", t.lineOf(i), err)
+		}
+		inflow, err := parseAmount(t.get(row, "inflow"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid inflow: %w", t.lineOf(i), err)
+		}
+
+		tx := models.BudgetTransaction{
+			Account:       t.get(row, "account"),
+			Date:          date,
+			Payee:         t.get(row, "payee"),
+			CategoryGroup: t.get(row, "category group", "master category"),
+			Category:      t.get(row, "category", "sub category"),
+			Memo:          t.get(row, "memo"),
+			Amount:        inflow - outflow,
+			Cleared:       parseCleared(t.get(row, "cleared")),
+		}
+		if tx.Category == "" {
+			// Older exports only have the combined "Group: Category" column
+			if group, category, ok := strings.Cut(t.get(row, "category group/category"), ": "); ok {
+				tx.CategoryGroup, tx.Category = group, category
+			}
+		}
+		if account, ok := strings.CutPrefix(tx.Payee, ynabTransferPrefix); ok {
+			tx.TransferAccount = strings.TrimSpace(account)
+		}
+		transactions = append(transactions, tx)
+	}
+
+	assignBudgetIDs(transactions)
+	return transactions, nil
+}
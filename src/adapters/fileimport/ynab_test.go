@@ -0,0 +1,61 @@
+package fileimport
+
+import (
+	"strings"
+	"testing"
+)
+
+const ynabRegister = `"Account","Flag","Date","Payee","Category Group/Category","Category Group","Category","Memo","Outflow","Inflow","Cleared"
+"Checking","","01/02/2024","Landlord","Bills: Rent","Bills","Rent","January","$1,200.00","$0.00","Cleared"
+"Checking","","01/05/2024","Employer","Inflow: Ready to Assign","Inflow","Ready to Assign","","$0.00","$3,000.00","Reconciled"
+"Checking","","01/06/2024","Transfer : Savings","","","","","$500.00","$0.00","Uncleared"
+"Savings","","01/06/2024","Transfer : Checking","","","","","$0.00","$500.00","Uncleared"
+"Checking","","01/07/2024","Cafe","Food: Dining","Food","Dining","","$4.50","$0.00","Cleared"
+"Checking","","01/07/2024","Cafe","Food: Dining","Food","Dining","","$4.50","$0.00","Cleared"
+`
+
+func TestYNABProfile_Parse(t *testing.T) {
+	transactions, err := YNABProfile{}.Parse(strings.NewReader(ynabRegister))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(transactions) != 6 {
+		t.Fatalf("Parse() = %d transactions, want 6", len(transactions))
+	}
+
+	rent := transactions[0]
+	if rent.Account != "Checking" || rent.Amount != -1200 || rent.CategoryGroup != "Bills" || rent.Category != "Rent" ||
+		rent.Memo != "January" || !rent.Cleared || rent.Date.Day() != 2 {
+		t.Errorf("rent = %+v, want a cleared 1200 outflow in Bills: Rent on January 2", rent)
+	}
+	if salary := transactions[1]; salary.Amount != 3000 || salary.CategoryGroup != "Inflow" {
+		t.Errorf("salary = %+v, want a 3000 inflow", salary)
+	}
+
+	if out, in := transactions[2], transactions[3]; out.TransferAccount != "Savings" || in.TransferAccount != "Checking" || out.Cleared {
+		t.Errorf("transfer = %+v and %+v, want both sides of an uncleared transfer", out, in)
+	}
+
+	if first, second := transactions[4], transactions[5]; first.ID == "" || first.ID == second.ID {
+		t.Errorf("IDs of identical rows = %q and %q, want distinct IDs", first.ID, second.ID)
+	}
+
+	again, err := YNABProfile{}.Parse(strings.NewReader(ynabRegister))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if again[5].ID != transactions[5].ID {
+		t.Errorf("ID changed between exports: %q, then %q", transactions[5].ID, again[5].ID)
+	}
+}
+
+func TestYNABProfile_CombinedCategory(t *testing.T) {
+	register := "Account,Date,Payee,Category Group/Category,Memo,Outflow,Inflow,Cleared\nCash,2024-03-01,Market,Food: Groceries,,12.00,0,Cleared\n"
+	transactions, err := YNABProfile{}.Parse(strings.NewReader(register))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(transactions) != 1 || transactions[0].CategoryGroup != "Food" || transactions[0].Category != "Groceries" {
+		t.Errorf("Parse() = %+v, want the category split from its group", transactions)
+	}
+}
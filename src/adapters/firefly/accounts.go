@@ -0,0 +1,221 @@
+package firefly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// accountAttributes mirrors the account attributes returned by the API.
+// Loosely typed fields have been returned as different JSON types across versions.
+type accountAttributes struct {
+	Name          flexString `json:"name"`
+	Type          string     `json:"type"`
+	IBAN          flexString `json:"iban"`
+	AccountNumber flexString `json:"account_number"`
+	CurrencyCode  flexString `json:"currency_code"`
+	Active        flexBool   `json:"active"`
+
+	CurrentBalance flexString `json:"current_balance"`
+
+	// Default currency of accounts without their own currency, renamed in Firefly 6.3
+	NativeCurrencyCode  flexString `json:"native_currency_code"`
+	PrimaryCurrencyCode flexString `json:"primary_currency_code"`
+}
+
+type accountData struct {
+	ID         flexString        `json:"id"`
+	Attributes accountAttributes `json:"attributes"`
+}
+
+// accountRequest is the wire format for creating an account
+type accountRequest struct {
+	Name               string `json:"name"`
+	Type               string `json:"type"`
+	AccountRole        string `json:"account_role,omitempty"`
+	IBAN               string `json:"iban,omitempty"`
+	AccountNumber      string `json:"account_number,omitempty"`
+	CurrencyCode       string `json:"currency_code,omitempty"`
+	Notes              string `json:"notes,omitempty"`
+	OpeningBalance     string `json:"opening_balance,omitempty"`
+	OpeningBalanceDate string `json:"opening_balance_date,omitempty"`
+}
+
+type pagination struct {
+	CurrentPage int `json:"current_page"`
+	TotalPages  int `json:"total_pages"`
+}
+
+// ListAccounts lists all accounts of a type (empty lists all types), following pagination.
+// Accounts that cannot be decoded are skipped and logged.
+func (c *Client) ListAccounts(ctx context.Context, accountType string) ([]interfaces.FireflyAccount, error) {
+	accounts, skipped, err := c.ListAccountsLenient(ctx, accountType)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := internal.GetLogger().With().Str("client", "firefly").Logger()
+	for _, item := range skipped {
+		logger.Warn().
+			Int("page", item.Page).
+			Int("index", item.Index).
+			Str("id", item.ID).
+			Str("error", item.Error).
+			Msg("Skipped undecodable Firefly account")
+	}
+
+	return accounts, nil
+}
+
+// ListAccountsLenient lists accounts like ListAccounts and also reports the items it had to skip
+func (c *Client) ListAccountsLenient(ctx context.Context, accountType string) ([]interfaces.FireflyAccount, []interfaces.FireflyItemError, error) {
+	compat := c.compat(ctx)
+	accounts := make([]interfaces.FireflyAccount, 0)
+	var skipped []interfaces.FireflyItemError
+
+	pagePath := func(page int) string {
+		query := url.Values{"page": {fmt.Sprint(page)}}
+		if accountType != "" {
+			query.Set("type", accountType)
+		}
+		return "/api/v1/accounts?" + query.Encode()
+	}
+
+	// Items are decoded one by one so a single bad item does not fail the page
+	err := c.paginate(ctx, pagePath, func(page, index int, raw json.RawMessage) error {
+		data, itemErr := decodeItem[accountData](page, index, raw)
+		if itemErr != nil {
+			skipped = append(skipped, *itemErr)
+			return nil
+		}
+		accounts = append(accounts, compat.mapAccount(data))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, skipped, nil
+}
+
+// GetAccount gets an account by ID
+func (c *Client) GetAccount(ctx context.Context, id string) (*interfaces.FireflyAccount, error) {
+	var resp struct {
+		Data accountData `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/accounts/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	account := c.compat(ctx).mapAccount(resp.Data)
+	return &account, nil
+}
+
+// CreateAccount creates a new account
+func (c *Client) CreateAccount(ctx context.Context, account interfaces.FireflyAccountRequest) (*interfaces.FireflyAccount, error) {
+	body := accountRequest{
+		Name:          account.Name,
+		Type:          account.Type,
+		AccountRole:   account.AccountRole,
+		IBAN:          account.IBAN,
+		AccountNumber: account.AccountNumber,
+		CurrencyCode:  account.CurrencyCode,
+		Notes:         account.Notes,
+	}
+	if account.OpeningBalance != 0 {
+		date := account.OpeningBalanceDate
+		if date.IsZero() {
+			date = time.Now()
+		}
+		body.OpeningBalance = formatAmount(account.OpeningBalance, account.CurrencyCode)
+		body.OpeningBalanceDate = date.Format(time.DateOnly)
+	}
+
+	var resp struct {
+		Data accountData `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/accounts", body, &resp); err != nil {
+		return nil, err
+	}
+
+	created := c.compat(ctx).mapAccount(resp.Data)
+	return &created, nil
+}
+
+// ArchiveAccount deactivates an account, keeping its transactions
+func (c *Client) ArchiveAccount(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodPut, "/api/v1/accounts/"+url.PathEscape(id), map[string]bool{"active": false}, nil)
+}
+
+// DeleteAccount deletes an account. Firefly deletes the account's transactions
+// with it, so accounts with transactions are refused unless force is set.
+func (c *Client) DeleteAccount(ctx context.Context, id string, force bool) error {
+	if !force {
+		count, err := c.countAccountTransactions(ctx, id)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return &interfaces.FireflyAccountInUseError{AccountID: id, Transactions: count}
+		}
+	}
+
+	return c.do(ctx, http.MethodDelete, "/api/v1/accounts/"+url.PathEscape(id), nil, nil)
+}
+
+// countAccountTransactions returns the number of transactions booked on an account
+func (c *Client) countAccountTransactions(ctx context.Context, id string) (int, error) {
+	var resp struct {
+		Meta struct {
+			Pagination struct {
+				Total int `json:"total"`
+			} `json:"pagination"`
+		} `json:"meta"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/accounts/"+url.PathEscape(id)+"/transactions?limit=1", nil, &resp); err != nil {
+		return 0, err
+	}
+
+	return resp.Meta.Pagination.Total, nil
+}
+
+// mapAccount maps an account of Firefly 6.3 and later
+func mapAccount(data accountData) interfaces.FireflyAccount {
+	account := mapAccountFields(data)
+	if account.CurrencyCode == "" {
+		account.CurrencyCode = string(data.Attributes.PrimaryCurrencyCode)
+	}
+	return account
+}
+
+// mapAccountNative maps an account of Firefly releases before 6.3
+func mapAccountNative(data accountData) interfaces.FireflyAccount {
+	account := mapAccountFields(data)
+	if account.CurrencyCode == "" {
+		account.CurrencyCode = string(data.Attributes.NativeCurrencyCode)
+	}
+	return account
+}
+
+func mapAccountFields(data accountData) interfaces.FireflyAccount {
+	// An unparseable balance is left at zero rather than dropping the account
+	balance, _ := strconv.ParseFloat(string(data.Attributes.CurrentBalance), 64)
+
+	return interfaces.FireflyAccount{
+		ID:             string(data.ID),
+		Name:           string(data.Attributes.Name),
+		Type:           data.Attributes.Type,
+		IBAN:           string(data.Attributes.IBAN),
+		AccountNumber:  string(data.Attributes.AccountNumber),
+		CurrencyCode:   string(data.Attributes.CurrencyCode),
+		Active:         bool(data.Attributes.Active),
+		CurrentBalance: balance,
+	}
+}
@@ -0,0 +1,154 @@
+package firefly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+// searchPageSize is the page size used when collecting bulk update targets
+const searchPageSize = 100
+
+// splitUpdate is the wire format of a split change in a transaction update
+type splitUpdate struct {
+	JournalID    string    `json:"transaction_journal_id"`
+	Description  *string   `json:"description,omitempty"`
+	CategoryName *string   `json:"category_name,omitempty"`
+	Tags         *[]string `json:"tags,omitempty"` // pointer so an empty list clears the tags
+	Notes        *string   `json:"notes,omitempty"`
+}
+
+// BulkUpdateTransactions applies a bulk update and returns the number of transactions changed,
+// or only counts the matching transactions when dryRun is set.
+//
+// Firefly's bulk endpoint (/api/v1/data/bulk/transactions) only supports moving the
+// transactions of one account to another, so account moves are sent there and
+// every other update is applied group by group to the transactions found by search.
+func (c *Client) BulkUpdateTransactions(ctx context.Context, update *interfaces.FireflyBulkUpdate, dryRun bool) (int, error) {
+	if err := update.Validate(); err != nil {
+		return 0, interfaces.NewClientError(interfaces.ErrorTypeValidation, "invalid bulk update", err)
+	}
+
+	query := update.Where.Search().String()
+
+	if dryRun {
+		var resp struct {
+			Meta struct {
+				Pagination struct {
+					Total int `json:"total"`
+				} `json:"pagination"`
+			} `json:"meta"`
+		}
+		params := url.Values{"query": {query}, "limit": {"1"}}
+		if err := c.do(ctx, http.MethodGet, "/api/v1/search/transactions?"+params.Encode(), nil, &resp); err != nil {
+			return 0, err
+		}
+		return resp.Meta.Pagination.Total, nil
+	}
+
+	if update.IsAccountMove() {
+		return c.moveAccountTransactions(ctx, update)
+	}
+
+	// Collect all targets first: updating may change which transactions match the query
+	groups, err := c.searchTransactionGroups(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, group := range groups {
+		body := struct {
+			ApplyRules   bool          `json:"apply_rules"`
+			Transactions []splitUpdate `json:"transactions"`
+		}{
+			Transactions: make([]splitUpdate, 0, len(group.Attributes.Transactions)),
+		}
+		for _, split := range group.Attributes.Transactions {
+			change := splitUpdate{
+				JournalID:    string(split.JournalID),
+				CategoryName: update.Set.Category,
+				Notes:        update.Set.Notes,
+			}
+			if update.Set.Tags != nil {
+				change.Tags = &update.Set.Tags
+			}
+			body.Transactions = append(body.Transactions, change)
+		}
+
+		if err := c.do(ctx, http.MethodPut, "/api/v1/transactions/"+url.PathEscape(string(group.ID)), body, nil); err != nil {
+			return i, fmt.Errorf("bulk update stopped at transaction %s: %w", group.ID, err)
+		}
+	}
+
+	return len(groups), nil
+}
+
+// moveAccountTransactions moves all transactions of one account through the bulk endpoint
+func (c *Client) moveAccountTransactions(ctx context.Context, update *interfaces.FireflyBulkUpdate) (int, error) {
+	if err := c.compat(ctx).require(interfaces.FireflyFeatureBulkUpdate); err != nil {
+		return 0, err
+	}
+
+	from, err := strconv.Atoi(update.Where.AccountID)
+	if err != nil {
+		return 0, interfaces.NewClientError(interfaces.ErrorTypeValidation, "invalid account id "+update.Where.AccountID, err)
+	}
+	to, err := strconv.Atoi(update.Set.AccountID)
+	if err != nil {
+		return 0, interfaces.NewClientError(interfaces.ErrorTypeValidation, "invalid account id "+update.Set.AccountID, err)
+	}
+
+	count, err := c.BulkUpdateTransactions(ctx, update, true)
+	if err != nil {
+		return 0, err
+	}
+
+	// The endpoint takes the bulk query as a JSON encoded string
+	bulkQuery, err := json.Marshal(map[string]map[string]int{
+		"where":  {"account_id": from},
+		"update": {"account_id": to},
+	})
+	if err != nil {
+		return 0, interfaces.NewClientError(interfaces.ErrorTypeValidation, "failed to encode bulk query", err)
+	}
+
+	if err := c.do(ctx, http.MethodPost, "/api/v1/data/bulk/transactions", map[string]string{"query": string(bulkQuery)}, nil); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// searchTransactionGroups returns every transaction group matching a search query
+func (c *Client) searchTransactionGroups(ctx context.Context, query string) ([]transactionGroupData, error) {
+	groups := make([]transactionGroupData, 0)
+	err := c.eachSearchResult(ctx, query, func(group transactionGroupData) error {
+		groups = append(groups, group)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// eachSearchResult streams the transaction groups matching a search query to fn
+func (c *Client) eachSearchResult(ctx context.Context, query string, fn func(transactionGroupData) error) error {
+	pagePath := func(page int) string {
+		params := url.Values{"query": {query}, "page": {fmt.Sprint(page)}, "limit": {fmt.Sprint(searchPageSize)}}
+		return "/api/v1/search/transactions?" + params.Encode()
+	}
+
+	return c.paginate(ctx, pagePath, func(page, index int, raw json.RawMessage) error {
+		group, err := decodeStrict[transactionGroupData](raw)
+		if err != nil {
+			return err
+		}
+		return fn(group)
+	})
+}
@@ -0,0 +1,335 @@
+package firefly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+// referenceCacheSecret is the secrets store entry holding the persisted reference data cache
+const referenceCacheSecret = "firefly_reference_cache"
+
+// Keys of the cached reference data. Keys ending in a colon are followed by
+// an account type, ID or currency code.
+const (
+	cacheAccounts   = "accounts:" // account listing by type
+	cacheAccount    = "account:"  // single account by ID
+	cacheCurrency   = "currency:" // currency by code
+	cacheCategories = "categories"
+)
+
+// CachedEntry is a cached API response, kept encoded so callers never share
+// the cached value
+type CachedEntry struct {
+	Data      json.RawMessage `json:"data"`
+	ExpiresAt time.Time       `json:"expiresAt"`
+}
+
+// CacheStore persists the reference data cache between restarts
+type CacheStore interface {
+	// LoadCache returns the stored entries, or nil when none have been stored yet
+	LoadCache(ctx context.Context) (map[string]CachedEntry, error)
+
+	// SaveCache stores the entries, replacing any previous ones
+	SaveCache(ctx context.Context, entries map[string]CachedEntry) error
+}
+
+// SecretCacheStore keeps the reference data cache encrypted in the secrets
+// store, since account names and IBANs are personal data
+type SecretCacheStore struct {
+	secrets repositories.SecretRepository
+}
+
+// NewSecretCacheStore creates a new SecretCacheStore
+func NewSecretCacheStore(secrets repositories.SecretRepository) *SecretCacheStore {
+	return &SecretCacheStore{
+		secrets: secrets,
+	}
+}
+
+// LoadCache returns the stored entries, or nil when none have been stored yet
+func (s *SecretCacheStore) LoadCache(ctx context.Context) (map[string]CachedEntry, error) {
+	data, err := s.secrets.Get(ctx, referenceCacheSecret)
+	if errors.Is(err, models.ErrSecretNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries map[string]CachedEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode firefly reference cache: %w", err)
+	}
+
+	return entries, nil
+}
+
+// SaveCache stores the entries, replacing any previous ones
+func (s *SecretCacheStore) SaveCache(ctx context.Context, entries map[string]CachedEntry) error {
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to encode firefly reference cache: %w", err)
+	}
+
+	return s.secrets.Put(ctx, referenceCacheSecret, data)
+}
+
+// CacheStats counts the lookups of the reference data cache
+type CacheStats struct {
+	Hits    int64 `json:"hits"`
+	Misses  int64 `json:"misses"`
+	Entries int   `json:"entries"`
+}
+
+// CachingClient is a read-through cache in front of the reference data of a
+// Firefly client: accounts, currencies and categories. Cached lookups expire
+// after the TTL, and writes through the client drop the entries they may
+// change. Everything else is passed through.
+//
+// Account balances are part of the cached accounts, so callers comparing
+// balances should use the uncached client.
+type CachingClient struct {
+	interfaces.FireflyClient
+	ttl   time.Duration
+	store CacheStore // nil keeps the cache in memory only
+
+	mu      sync.Mutex
+	entries map[string]CachedEntry
+	dirty   bool // changed since the last Persist
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// NewCachingClient wraps a Firefly client with a reference data cache
+func NewCachingClient(next interfaces.FireflyClient, ttl time.Duration) *CachingClient {
+	return &CachingClient{
+		FireflyClient: next,
+		ttl:           ttl,
+		entries:       make(map[string]CachedEntry),
+	}
+}
+
+// WithStore persists the cache in store, see Restore and Persist
+func (c *CachingClient) WithStore(store CacheStore) *CachingClient {
+	c.store = store
+	return c
+}
+
+// Restore loads the unexpired entries persisted by an earlier run
+func (c *CachingClient) Restore(ctx context.Context) error {
+	if c.store == nil {
+		return nil
+	}
+	entries, err := c.store.LoadCache(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range entries {
+		if _, ok := c.entries[key]; !ok && now.Before(entry.ExpiresAt) {
+			c.entries[key] = entry
+		}
+	}
+	return nil
+}
+
+// Persist saves the unexpired entries when the cache changed since the last save
+func (c *CachingClient) Persist(ctx context.Context) error {
+	if c.store == nil {
+		return nil
+	}
+
+	now := time.Now()
+	c.mu.Lock()
+	if !c.dirty {
+		c.mu.Unlock()
+		return nil
+	}
+	entries := make(map[string]CachedEntry, len(c.entries))
+	for key, entry := range c.entries {
+		if now.Before(entry.ExpiresAt) {
+			entries[key] = entry
+		}
+	}
+	c.dirty = false
+	c.mu.Unlock()
+
+	if err := c.store.SaveCache(ctx, entries); err != nil {
+		c.mu.Lock()
+		c.dirty = true
+		c.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Invalidate drops every cached entry, e.g. after reference data was edited in Firefly
+func (c *CachingClient) Invalidate() {
+	c.invalidate("")
+}
+
+// Stats returns the hits and misses since startup and the number of cached entries
+func (c *CachingClient) Stats() CacheStats {
+	c.mu.Lock()
+	entries := len(c.entries)
+	c.mu.Unlock()
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load(), Entries: entries}
+}
+
+// ListAccounts lists all accounts of a type (empty lists all types)
+func (c *CachingClient) ListAccounts(ctx context.Context, accountType string) ([]interfaces.FireflyAccount, error) {
+	return cached(c, cacheAccounts+accountType, func() ([]interfaces.FireflyAccount, error) {
+		return c.FireflyClient.ListAccounts(ctx, accountType)
+	})
+}
+
+// GetAccount gets an account by ID
+func (c *CachingClient) GetAccount(ctx context.Context, id string) (*interfaces.FireflyAccount, error) {
+	return cached(c, cacheAccount+id, func() (*interfaces.FireflyAccount, error) {
+		return c.FireflyClient.GetAccount(ctx, id)
+	})
+}
+
+// GetCurrency gets a currency by its code
+func (c *CachingClient) GetCurrency(ctx context.Context, code string) (*interfaces.FireflyCurrency, error) {
+	return cached(c, cacheCurrency+strings.ToUpper(code), func() (*interfaces.FireflyCurrency, error) {
+		return c.FireflyClient.GetCurrency(ctx, code)
+	})
+}
+
+// ListCategories lists all categories
+func (c *CachingClient) ListCategories(ctx context.Context) ([]interfaces.FireflyCategory, error) {
+	return cached(c, cacheCategories, func() ([]interfaces.FireflyCategory, error) {
+		return c.FireflyClient.ListCategories(ctx)
+	})
+}
+
+// CreateAccount creates a new account
+func (c *CachingClient) CreateAccount(ctx context.Context, account interfaces.FireflyAccountRequest) (*interfaces.FireflyAccount, error) {
+	defer c.invalidate(cacheAccounts, cacheAccount)
+	return c.FireflyClient.CreateAccount(ctx, account)
+}
+
+// ArchiveAccount deactivates an account, keeping its transactions
+func (c *CachingClient) ArchiveAccount(ctx context.Context, id string) error {
+	defer c.invalidate(cacheAccounts, cacheAccount+id)
+	return c.FireflyClient.ArchiveAccount(ctx, id)
+}
+
+// DeleteAccount deletes an account together with its transactions
+func (c *CachingClient) DeleteAccount(ctx context.Context, id string, force bool) error {
+	defer c.invalidate(cacheAccounts, cacheAccount+id)
+	return c.FireflyClient.DeleteAccount(ctx, id, force)
+}
+
+// CreateCurrency creates a new, enabled currency
+func (c *CachingClient) CreateCurrency(ctx context.Context, currency interfaces.FireflyCurrency) (*interfaces.FireflyCurrency, error) {
+	defer c.invalidate(cacheCurrency + strings.ToUpper(currency.Code))
+	return c.FireflyClient.CreateCurrency(ctx, currency)
+}
+
+// EnableCurrency enables a disabled currency
+func (c *CachingClient) EnableCurrency(ctx context.Context, code string) error {
+	defer c.invalidate(cacheCurrency + strings.ToUpper(code))
+	return c.FireflyClient.EnableCurrency(ctx, code)
+}
+
+// CreateTransaction creates a transaction and returns its Firefly ID. Firefly
+// creates the expense, revenue and category named by a transaction when they
+// do not exist yet, and the balances of its accounts change.
+func (c *CachingClient) CreateTransaction(ctx context.Context, tx interfaces.FireflyTransaction) (string, error) {
+	keys := []string{cacheAccounts, cacheAccount}
+	if tx.CategoryName != "" {
+		keys = append(keys, cacheCategories)
+	}
+	defer c.invalidate(keys...)
+	return c.FireflyClient.CreateTransaction(ctx, tx)
+}
+
+// UpdateTransaction applies an edit, which creates a category it sets that
+// does not exist yet
+func (c *CachingClient) UpdateTransaction(ctx context.Context, id string, edit interfaces.FireflyTransactionEdit) error {
+	if edit.Category != nil {
+		defer c.invalidate(cacheCategories)
+	}
+	return c.FireflyClient.UpdateTransaction(ctx, id, edit)
+}
+
+// BulkUpdateTransactions applies a bulk update, which creates a category it
+// sets that does not exist yet and changes balances when moving transactions
+func (c *CachingClient) BulkUpdateTransactions(ctx context.Context, update *interfaces.FireflyBulkUpdate, dryRun bool) (int, error) {
+	if !dryRun {
+		var keys []string
+		if update.Set.Category != nil {
+			keys = append(keys, cacheCategories)
+		}
+		if update.IsAccountMove() {
+			keys = append(keys, cacheAccounts, cacheAccount)
+		}
+		defer c.invalidate(keys...)
+	}
+	return c.FireflyClient.BulkUpdateTransactions(ctx, update, dryRun)
+}
+
+// cached returns the unexpired entry under key, or calls fetch and caches its
+// result. Errors are not cached.
+func cached[T any](c *CachingClient, key string, fetch func() (T, error)) (T, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	var value T
+	if ok && time.Now().Before(entry.ExpiresAt) {
+		if err := json.Unmarshal(entry.Data, &value); err == nil {
+			c.hits.Add(1)
+			return value, nil
+		}
+	}
+
+	c.misses.Add(1)
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value, nil
+	}
+	c.mu.Lock()
+	c.entries[key] = CachedEntry{Data: data, ExpiresAt: time.Now().Add(c.ttl)}
+	c.dirty = true
+	c.mu.Unlock()
+
+	return value, nil
+}
+
+// invalidate drops the entries under the keys. A key ending in a colon drops
+// every entry of that kind and an empty key drops everything.
+func (c *CachingClient) invalidate(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		for _, drop := range keys {
+			if key == drop || (drop == "" || strings.HasSuffix(drop, ":")) && strings.HasPrefix(key, drop) {
+				delete(c.entries, key)
+				c.dirty = true
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package firefly
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+// countingClient counts the reference data requests reaching Firefly
+type countingClient struct {
+	interfaces.FireflyClient
+	calls map[string]int
+}
+
+func (c *countingClient) GetAccount(ctx context.Context, id string) (*interfaces.FireflyAccount, error) {
+	c.calls["account:"+id]++
+	return &interfaces.FireflyAccount{ID: id, Name: "Checking " + id}, nil
+}
+
+func (c *countingClient) ListCategories(ctx context.Context) ([]interfaces.FireflyCategory, error) {
+	c.calls["categories"]++
+	return []interfaces.FireflyCategory{{ID: "1", Name: "Groceries"}}, nil
+}
+
+func (c *countingClient) ArchiveAccount(ctx context.Context, id string) error {
+	return nil
+}
+
+func (c *countingClient) CreateTransaction(ctx context.Context, tx interfaces.FireflyTransaction) (string, error) {
+	return "1", nil
+}
+
+type memoryCacheStore struct {
+	entries map[string]CachedEntry
+	saves   int
+}
+
+func (s *memoryCacheStore) LoadCache(ctx context.Context) (map[string]CachedEntry, error) {
+	return s.entries, nil
+}
+
+func (s *memoryCacheStore) SaveCache(ctx context.Context, entries map[string]CachedEntry) error {
+	s.entries = entries
+	s.saves++
+	return nil
+}
+
+func TestCachingClient_ReadThrough(t *testing.T) {
+	next := &countingClient{calls: make(map[string]int)}
+	client := NewCachingClient(next, time.Hour)
+	ctx := context.Background()
+
+	categories, _ := client.ListCategories(ctx)
+	categories[0].Name = "changed by the caller"
+	categories, err := client.ListCategories(ctx)
+	if err != nil {
+		t.Fatalf("ListCategories() error = %v", err)
+	}
+	if next.calls["categories"] != 1 || categories[0].Name != "Groceries" {
+		t.Errorf("ListCategories() = %+v after %d requests, want the unchanged cached list", categories, next.calls["categories"])
+	}
+
+	client.GetAccount(ctx, "1")
+	client.GetAccount(ctx, "12")
+	client.GetAccount(ctx, "12")
+	if stats := client.Stats(); stats.Hits != 2 || stats.Misses != 3 || stats.Entries != 3 {
+		t.Errorf("Stats() = %+v, want 2 hits, 3 misses and 3 entries", stats)
+	}
+
+	// Expired entries are fetched again
+	expiring := NewCachingClient(next, 0)
+	expiring.ListCategories(ctx)
+	expiring.ListCategories(ctx)
+	if next.calls["categories"] != 3 {
+		t.Errorf("categories requested %d times, want every lookup after expiry", next.calls["categories"])
+	}
+}
+
+func TestCachingClient_InvalidatesOnWrites(t *testing.T) {
+	next := &countingClient{calls: make(map[string]int)}
+	client := NewCachingClient(next, time.Hour)
+	ctx := context.Background()
+
+	client.GetAccount(ctx, "1")
+	client.GetAccount(ctx, "12")
+	client.ListCategories(ctx)
+
+	// Archiving account 1 leaves account 12 cached
+	client.ArchiveAccount(ctx, "1")
+	client.GetAccount(ctx, "1")
+	client.GetAccount(ctx, "12")
+	if next.calls["account:1"] != 2 || next.calls["account:12"] != 1 {
+		t.Errorf("account requests = %v, want only account 1 fetched again", next.calls)
+	}
+
+	// A transaction may create its category and changes account balances
+	client.CreateTransaction(ctx, interfaces.FireflyTransaction{CategoryName: "Dining"})
+	client.ListCategories(ctx)
+	client.GetAccount(ctx, "12")
+	if next.calls["categories"] != 2 || next.calls["account:12"] != 2 {
+		t.Errorf("requests after a transaction = %v, want categories and accounts fetched again", next.calls)
+	}
+
+	client.Invalidate()
+	if stats := client.Stats(); stats.Entries != 0 {
+		t.Errorf("Stats() after Invalidate() = %+v, want no entries", stats)
+	}
+}
+
+func TestCachingClient_Persistence(t *testing.T) {
+	next := &countingClient{calls: make(map[string]int)}
+	store := &memoryCacheStore{}
+	ctx := context.Background()
+
+	client := NewCachingClient(next, time.Hour).WithStore(store)
+	client.ListCategories(ctx)
+	if err := client.Persist(ctx); err != nil {
+		t.Fatalf("Persist() error = %v", err)
+	}
+	// Unchanged caches are not saved again
+	client.Persist(ctx)
+	if store.saves != 1 || len(store.entries) != 1 {
+		t.Fatalf("store after Persist() = %d saves of %v", store.saves, store.entries)
+	}
+
+	store.entries["account:7"] = CachedEntry{Data: []byte(`{"id":"7"}`), ExpiresAt: time.Now().Add(-time.Minute)}
+	restarted := NewCachingClient(next, time.Hour).WithStore(store)
+	if err := restarted.Restore(ctx); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	restarted.ListCategories(ctx)
+	restarted.GetAccount(ctx, "7")
+	if next.calls["categories"] != 1 || next.calls["account:7"] != 1 {
+		t.Errorf("requests after Restore() = %v, want the categories restored and the expired account fetched", next.calls)
+	}
+}
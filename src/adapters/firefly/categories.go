@@ -0,0 +1,160 @@
+package firefly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// categorySum is a spent or earned entry of the category attributes
+type categorySum struct {
+	CurrencyCode string     `json:"currency_code"`
+	Sum          flexString `json:"sum"`
+}
+
+type categoryData struct {
+	ID         flexString `json:"id"`
+	Attributes struct {
+		Name   string        `json:"name"`
+		Notes  string        `json:"notes"`
+		Spent  []categorySum `json:"spent"`
+		Earned []categorySum `json:"earned"`
+	} `json:"attributes"`
+}
+
+// ListCategories lists all categories, following pagination.
+// Categories that cannot be decoded are skipped and logged.
+func (c *Client) ListCategories(ctx context.Context) ([]interfaces.FireflyCategory, error) {
+	logger := internal.GetLogger().With().Str("client", "firefly").Logger()
+	categories := make([]interfaces.FireflyCategory, 0)
+
+	pagePath := func(page int) string {
+		return "/api/v1/categories?" + url.Values{"page": {fmt.Sprint(page)}}.Encode()
+	}
+
+	err := c.paginate(ctx, pagePath, func(page, index int, raw json.RawMessage) error {
+		data, skipped := decodeItem[categoryData](page, index, raw)
+		if skipped != nil {
+			logger.Warn().
+				Int("page", skipped.Page).
+				Int("index", skipped.Index).
+				Str("id", skipped.ID).
+				Str("error", skipped.Error).
+				Msg("Skipped undecodable Firefly category")
+			return nil
+		}
+		categories = append(categories, interfaces.FireflyCategory{
+			ID:    string(data.ID),
+			Name:  data.Attributes.Name,
+			Notes: data.Attributes.Notes,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// GetCategoryReport returns the monthly spent/earned figures of a category between start and end (inclusive).
+// Firefly only scopes the category sums when a date range is passed, so each month is requested separately.
+func (c *Client) GetCategoryReport(ctx context.Context, id string, start, end time.Time) (*interfaces.FireflyCategoryReport, error) {
+	start = startOfDay(start)
+	end = startOfDay(end)
+	if end.Before(start) {
+		return nil, interfaces.NewClientError(interfaces.ErrorTypeValidation, "category report end is before start", nil)
+	}
+
+	report := &interfaces.FireflyCategoryReport{
+		CategoryID: id,
+		Start:      start,
+		End:        end,
+		Periods:    make([]interfaces.FireflyCategoryPeriod, 0),
+	}
+	spent := make(map[string]float64)
+	earned := make(map[string]float64)
+
+	for _, bucket := range monthBuckets(start, end) {
+		query := url.Values{
+			"start": {bucket[0].Format(time.DateOnly)},
+			"end":   {bucket[1].Format(time.DateOnly)},
+		}
+
+		var resp struct {
+			Data categoryData `json:"data"`
+		}
+		if err := c.do(ctx, http.MethodGet, "/api/v1/categories/"+url.PathEscape(id)+"?"+query.Encode(), nil, &resp); err != nil {
+			return nil, err
+		}
+
+		period := interfaces.FireflyCategoryPeriod{Start: bucket[0], End: bucket[1]}
+		var err error
+		if period.Spent, err = mapCategorySums(resp.Data.Attributes.Spent, spent); err != nil {
+			return nil, interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode category spent", err)
+		}
+		if period.Earned, err = mapCategorySums(resp.Data.Attributes.Earned, earned); err != nil {
+			return nil, interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode category earned", err)
+		}
+
+		report.Name = resp.Data.Attributes.Name
+		report.Periods = append(report.Periods, period)
+	}
+
+	report.Spent = sortedAmounts(spent)
+	report.Earned = sortedAmounts(earned)
+
+	return report, nil
+}
+
+// monthBuckets splits [start, end] into calendar months, clipped to the range
+func monthBuckets(start, end time.Time) [][2]time.Time {
+	var buckets [][2]time.Time
+	for from := start; !from.After(end); {
+		to := time.Date(from.Year(), from.Month()+1, 1, 0, 0, 0, 0, from.Location()).AddDate(0, 0, -1)
+		if to.After(end) {
+			to = end
+		}
+		buckets = append(buckets, [2]time.Time{from, to})
+		from = to.AddDate(0, 0, 1)
+	}
+	return buckets
+}
+
+// mapCategorySums converts Firefly sums to positive amounts and adds them to totals
+func mapCategorySums(sums []categorySum, totals map[string]float64) ([]interfaces.FireflyCategoryAmount, error) {
+	amounts := make([]interfaces.FireflyCategoryAmount, 0, len(sums))
+	for _, sum := range sums {
+		value, err := strconv.ParseFloat(string(sum.Sum), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sum %q for %s: %w", sum.Sum, sum.CurrencyCode, err)
+		}
+		value = math.Abs(value)
+
+		amounts = append(amounts, interfaces.FireflyCategoryAmount{CurrencyCode: sum.CurrencyCode, Sum: value})
+		totals[sum.CurrencyCode] += value
+	}
+	return amounts, nil
+}
+
+func sortedAmounts(totals map[string]float64) []interfaces.FireflyCategoryAmount {
+	amounts := make([]interfaces.FireflyCategoryAmount, 0, len(totals))
+	for code, sum := range totals {
+		amounts = append(amounts, interfaces.FireflyCategoryAmount{CurrencyCode: code, Sum: sum})
+	}
+	sort.Slice(amounts, func(i, j int) bool { return amounts[i].CurrencyCode < amounts[j].CurrencyCode })
+	return amounts
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
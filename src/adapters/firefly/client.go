@@ -0,0 +1,185 @@
+// Package firefly implements the Firefly III REST API client.
+package firefly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"golang.org/x/oauth2"
+)
+
+// Client implements the FireflyClient interface for the Firefly III API
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+
+	requestHooks  []RequestHook
+	responseHooks []ResponseHook
+
+	// OAuth2 client, nil when a static personal access token is used
+	oauth  *oauth2.Config
+	tokens TokenStore
+	mu     sync.Mutex
+	cached *oauth2.Token
+
+	// Mappers for the detected Firefly version, nil until detected
+	compatMu sync.Mutex
+	detected *compatibility
+}
+
+// NewClient creates a new Firefly III client. httpClient may be nil.
+func NewClient(cfg internal.FireflyConfig, httpClient *http.Client, opts ...Option) (*Client, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("firefly url is required")
+	}
+
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	client := &Client{
+		baseURL:    strings.TrimRight(cfg.URL, "/"),
+		token:      cfg.Token,
+		httpClient: httpClient,
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client, nil
+}
+
+// do sends a request to the API and decodes the JSON response into out (if not nil).
+// With OAuth, a 401 response refreshes the access token and retries the request once.
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	if out == nil {
+		return c.stream(ctx, method, path, body, nil)
+	}
+
+	return c.stream(ctx, method, path, body, func(r io.Reader) error {
+		if err := json.NewDecoder(r).Decode(out); err != nil {
+			return interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode firefly response", err)
+		}
+		return nil
+	})
+}
+
+// stream sends a request like do and hands the body of a successful response
+// to read (if not nil) without buffering it, so large responses can be
+// decoded as they arrive.
+func (c *Client) stream(ctx context.Context, method, path string, body any, read func(io.Reader) error) error {
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		if err != nil {
+			return interfaces.NewClientError(interfaces.ErrorTypeValidation, "failed to encode firefly request", err)
+		}
+	}
+
+	token, err := c.accessToken(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.send(ctx, method, path, data, token)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && c.oauth != nil {
+		resp.Body.Close()
+
+		// The access token expired early or was revoked
+		token, err = c.accessToken(ctx, token)
+		if err != nil {
+			return err
+		}
+
+		resp, err = c.send(ctx, method, path, data, token)
+		if err != nil {
+			return err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return statusError(method, path, resp)
+	}
+
+	if read == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	return read(resp.Body)
+}
+
+// send performs a single API request
+func (c *Client) send(ctx context.Context, method, path string, data []byte, token string) (*http.Response, error) {
+	var reader io.Reader
+	if data != nil {
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, interfaces.NewClientError(interfaces.ErrorTypeValidation, "failed to create firefly request", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	if data != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if id := internal.RequestIDFrom(ctx); id != "" {
+		req.Header.Set(internal.RequestIDHeader, id)
+	}
+
+	for _, hook := range c.requestHooks {
+		if err := hook(req); err != nil {
+			return nil, interfaces.NewClientError(interfaces.ErrorTypeValidation, "firefly request hook failed", err)
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	duration := time.Since(start)
+	for _, hook := range c.responseHooks {
+		hook(req, resp, err, duration)
+	}
+	if err != nil {
+		return nil, interfaces.NewTransportError(fmt.Sprintf("firefly %s %s failed", method, path), err)
+	}
+
+	return resp, nil
+}
+
+// statusError maps a non-2xx response to a ClientError
+func statusError(method, path string, resp *http.Response) error {
+	// Firefly returns {"message": "...", "errors": {...}} for validation errors
+	var apiErr struct {
+		Message string              `json:"message"`
+		Errors  map[string][]string `json:"errors"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	_ = json.Unmarshal(data, &apiErr)
+
+	message := fmt.Sprintf("firefly %s %s returned status %d", method, path, resp.StatusCode)
+	if apiErr.Message != "" {
+		message += ": " + apiErr.Message
+	}
+	for field, errs := range apiErr.Errors {
+		message += fmt.Sprintf("; %s: %s", field, strings.Join(errs, ", "))
+	}
+
+	return interfaces.NewStatusError(resp, message)
+}
@@ -0,0 +1,875 @@
+package firefly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"golang.org/x/oauth2"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(internal.FireflyConfig{URL: server.URL, Token: "test-token"}, server.Client())
+	if err != nil {
+		t.Fatalf("NewClient() returned unexpected error: %v", err)
+	}
+	return client
+}
+
+func TestClient_ListAccounts(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		page := r.URL.Query().Get("page")
+		fmt.Fprintf(w, `{
+			"data": [{"id": "%s", "attributes": {"name": "Account %s", "type": "asset", "iban": "DE89 3704 0044 0532 0130 00", "active": true, "current_balance": "1250.75"}}],
+			"meta": {"pagination": {"current_page": %s, "total_pages": 2}}
+		}`, page, page, page)
+	})
+
+	accounts, err := client.ListAccounts(context.Background(), "asset")
+	if err != nil {
+		t.Fatalf("ListAccounts() returned unexpected error: %v", err)
+	}
+
+	if len(accounts) != 2 {
+		t.Fatalf("ListAccounts() returned %d accounts, want 2", len(accounts))
+	}
+	if accounts[1].ID != "2" || accounts[1].Name != "Account 2" {
+		t.Errorf("ListAccounts() second account = %+v", accounts[1])
+	}
+	if accounts[0].CurrentBalance != 1250.75 {
+		t.Errorf("ListAccounts() current balance = %v, want 1250.75", accounts[0].CurrentBalance)
+	}
+}
+
+func TestClient_ErrorMapping(t *testing.T) {
+	tests := []struct {
+		status int
+		want   interfaces.ErrorType
+	}{
+		{http.StatusUnauthorized, interfaces.ErrorTypeAuth},
+		{http.StatusNotFound, interfaces.ErrorTypeNotFound},
+		{http.StatusUnprocessableEntity, interfaces.ErrorTypeValidation},
+		{http.StatusTooManyRequests, interfaces.ErrorTypeRateLimit},
+		{http.StatusInternalServerError, interfaces.ErrorTypeProviderBug},
+		{http.StatusBadGateway, interfaces.ErrorTypeNetwork},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(`{"message": "failed", "errors": {"name": ["The name field is required."]}}`))
+			})
+
+			_, err := client.GetAccount(context.Background(), "1")
+
+			var clientErr *interfaces.ClientError
+			if !errors.As(err, &clientErr) {
+				t.Fatalf("GetAccount() error = %v, want *interfaces.ClientError", err)
+			}
+			if clientErr.Type != tt.want {
+				t.Errorf("GetAccount() error type = %s, want %s", clientErr.Type, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FindTransactionByExternalID(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("query") == `external_id_is:"local-1"` {
+			w.Write([]byte(`{"data": [{"id": "42"}]}`))
+			return
+		}
+		w.Write([]byte(`{"data": []}`))
+	})
+
+	id, err := client.FindTransactionByExternalID(context.Background(), "local-1")
+	if err != nil {
+		t.Fatalf("FindTransactionByExternalID() returned unexpected error: %v", err)
+	}
+	if id != "42" {
+		t.Errorf("FindTransactionByExternalID() = %q, want %q", id, "42")
+	}
+
+	_, err = client.FindTransactionByExternalID(context.Background(), "local-2")
+	var clientErr *interfaces.ClientError
+	if !errors.As(err, &clientErr) || clientErr.Type != interfaces.ErrorTypeNotFound {
+		t.Errorf("FindTransactionByExternalID() error = %v, want not found", err)
+	}
+}
+
+type memoryTokenStore struct {
+	token *oauth2.Token
+	saves int
+}
+
+func (s *memoryTokenStore) LoadToken(ctx context.Context) (*oauth2.Token, error) {
+	return s.token, nil
+}
+
+func (s *memoryTokenStore) SaveToken(ctx context.Context, token *oauth2.Token) error {
+	s.token = token
+	s.saves++
+	return nil
+}
+
+func TestClient_OAuthRefreshOn401(t *testing.T) {
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			r.ParseForm()
+			if r.Form.Get("grant_type") != "refresh_token" || r.Form.Get("refresh_token") != "refresh-1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			refreshes++
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token": "access-2", "refresh_token": "refresh-2", "token_type": "Bearer", "expires_in": 3600}`))
+			return
+		}
+
+		// The first access token has been revoked
+		if r.Header.Get("Authorization") != "Bearer access-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"data": {"id": "1", "attributes": {"name": "Checking", "type": "asset"}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	store := &memoryTokenStore{token: &oauth2.Token{
+		AccessToken:  "access-1",
+		RefreshToken: "refresh-1",
+		Expiry:       time.Now().Add(time.Hour),
+	}}
+
+	client, err := NewClient(internal.FireflyConfig{URL: server.URL}, server.Client())
+	if err != nil {
+		t.Fatalf("NewClient() returned unexpected error: %v", err)
+	}
+	client.WithOAuth(internal.FireflyOAuthConfig{ClientID: "client", ClientSecret: "secret"}, store)
+
+	account, err := client.GetAccount(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetAccount() returned unexpected error: %v", err)
+	}
+	if account.Name != "Checking" {
+		t.Errorf("GetAccount() name = %q, want %q", account.Name, "Checking")
+	}
+
+	if refreshes != 1 {
+		t.Errorf("token refreshed %d times, want 1", refreshes)
+	}
+	if store.saves != 1 || store.token.AccessToken != "access-2" || store.token.RefreshToken != "refresh-2" {
+		t.Errorf("stored token = %+v after %d saves, want refreshed token", store.token, store.saves)
+	}
+}
+
+func TestClient_OAuthWithoutToken(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	client.WithOAuth(internal.FireflyOAuthConfig{ClientID: "client"}, &memoryTokenStore{})
+
+	_, err := client.GetAccount(context.Background(), "1")
+
+	var clientErr *interfaces.ClientError
+	if !errors.As(err, &clientErr) || clientErr.Type != interfaces.ErrorTypeAuth {
+		t.Errorf("GetAccount() error = %v, want auth error", err)
+	}
+}
+
+func TestClient_PassesRequestID(t *testing.T) {
+	var got []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/accounts/1" {
+			got = append(got, r.Header.Get(internal.RequestIDHeader))
+		}
+		fmt.Fprint(w, `{"data": {"id": "1", "attributes": {"name": "Checking", "type": "asset"}}}`)
+	})
+
+	client.GetAccount(internal.WithRequestID(context.Background(), "import-42"), "1")
+	client.GetAccount(context.Background(), "1")
+	if len(got) != 2 || got[0] != "import-42" || got[1] != "" {
+		t.Errorf("%s headers = %q, want the ID of the context and none without one", internal.RequestIDHeader, got)
+	}
+}
+
+func TestClient_Hooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Trace-Id") != "trace-1" {
+			t.Errorf("X-Trace-Id = %q, want %q", r.Header.Get("X-Trace-Id"), "trace-1")
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	type traceKey struct{}
+	var observed []string
+	client, err := NewClient(internal.FireflyConfig{URL: server.URL, Token: "test-token"}, server.Client(),
+		WithRequestHook(HeaderHook("X-Trace-Id", func(ctx context.Context) string {
+			id, _ := ctx.Value(traceKey{}).(string)
+			return id
+		})),
+		WithResponseHook(func(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+			observed = append(observed, fmt.Sprintf("%s %s %d", req.Method, endpoint(req.URL.Path), resp.StatusCode))
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() returned unexpected error: %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), traceKey{}, "trace-1")
+	if _, err := client.GetAccount(ctx, "42"); err == nil {
+		t.Fatal("GetAccount() expected not found error")
+	}
+
+	want := "GET /api/v1/accounts/{id} 404"
+	if len(observed) != 1 || observed[0] != want {
+		t.Errorf("response hook observed %v, want [%s]", observed, want)
+	}
+}
+
+func TestHealthHook(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		err     error
+		failure bool
+	}{
+		{name: "success", status: http.StatusOK},
+		{name: "client error", status: http.StatusNotFound},
+		{name: "server error", status: http.StatusBadGateway, failure: true},
+		{name: "rate limited", status: http.StatusTooManyRequests, failure: true},
+		{name: "network error", err: errors.New("connection refused"), failure: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var observed []error
+			hook := HealthHook(func(ctx context.Context, err error) {
+				observed = append(observed, err)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/about", nil)
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{StatusCode: tt.status}
+			}
+			hook(req, resp, tt.err, time.Millisecond)
+
+			if len(observed) != 1 {
+				t.Fatalf("observe called %d times, want 1", len(observed))
+			}
+			if got := observed[0] != nil; got != tt.failure {
+				t.Errorf("observed failure = %v (%v), want %v", got, observed[0], tt.failure)
+			}
+		})
+	}
+}
+
+func TestClient_ListAccountsLenient(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/about" {
+			w.Write([]byte(`{"data": {"version": "6.1.24", "api_version": "2.1.0"}}`))
+			return
+		}
+		w.Write([]byte(`{
+			"data": [
+				{"id": "1", "attributes": {"name": "Checking", "type": "asset", "active": "1", "native_currency_code": "EUR"}},
+				{"id": 2, "attributes": {"name": "Broken", "type": ["asset"]}},
+				{"id": "3", "attributes": {"name": "Savings", "type": "asset", "account_number": 12345, "currency_code": "USD", "active": true}}
+			],
+			"meta": {"pagination": {"current_page": 1, "total_pages": 1}}
+		}`))
+	})
+
+	accounts, skipped, err := client.ListAccountsLenient(context.Background(), "asset")
+	if err != nil {
+		t.Fatalf("ListAccountsLenient() returned unexpected error: %v", err)
+	}
+
+	if len(accounts) != 2 {
+		t.Fatalf("ListAccountsLenient() returned %d accounts, want 2", len(accounts))
+	}
+	if !accounts[0].Active || accounts[0].CurrencyCode != "EUR" {
+		t.Errorf("first account = %+v, want active with native currency EUR", accounts[0])
+	}
+	if accounts[1].AccountNumber != "12345" || accounts[1].CurrencyCode != "USD" {
+		t.Errorf("second account = %+v, want account number 12345 and currency USD", accounts[1])
+	}
+
+	if len(skipped) != 1 || skipped[0].ID != "2" || skipped[0].Index != 1 || skipped[0].Page != 1 {
+		t.Errorf("ListAccountsLenient() skipped = %+v, want item 2 at index 1", skipped)
+	}
+}
+
+func TestCompatibilityFor(t *testing.T) {
+	data := accountData{Attributes: accountAttributes{NativeCurrencyCode: "EUR", PrimaryCurrencyCode: "USD"}}
+
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{"6.1.24", "EUR"},
+		{"v6.2.0-beta.1", "EUR"},
+		{"6.3.0", "USD"},
+		{"7.0", "USD"},
+		{"", "USD"},
+		{"develop", "USD"},
+	}
+
+	for _, tt := range tests {
+		if got := compatibilityFor(tt.version, "").mapAccount(data).CurrencyCode; got != tt.want {
+			t.Errorf("compatibilityFor(%q) currency = %q, want %q", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestClient_Capabilities(t *testing.T) {
+	var bulkCalled bool
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/about":
+			w.Write([]byte(`{"data": {"version": "5.5.13", "api_version": "1.5.2"}}`))
+		case "/api/v1/data/bulk/transactions":
+			bulkCalled = true
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte(`{"data": [], "meta": {"pagination": {"total": 3, "current_page": 1, "total_pages": 1}}}`))
+		}
+	})
+
+	capabilities, err := client.Capabilities(context.Background())
+	if err != nil {
+		t.Fatalf("Capabilities() returned unexpected error: %v", err)
+	}
+	if capabilities.Version != "5.5.13" || capabilities.APIVersion != "1.5.2" {
+		t.Errorf("Capabilities() = %+v, want the detected versions", capabilities)
+	}
+	if !capabilities.Features[interfaces.FireflyFeatureWebhooks] || capabilities.Features[interfaces.FireflyFeatureV2API] {
+		t.Errorf("features = %v, want webhooks but no v2 API", capabilities.Features)
+	}
+
+	_, err = client.BulkUpdateTransactions(context.Background(), &interfaces.FireflyBulkUpdate{
+		Where: interfaces.FireflyTransactionQuery{AccountID: "1"},
+		Set:   interfaces.FireflyTransactionUpdate{AccountID: "2"},
+	}, false)
+	var unsupported *interfaces.FireflyUnsupportedError
+	if !errors.As(err, &unsupported) || unsupported.Feature != interfaces.FireflyFeatureBulkUpdate {
+		t.Fatalf("BulkUpdateTransactions() error = %v, want FireflyUnsupportedError", err)
+	}
+	if want := "bulk_update requires Firefly >= 5.6.0, connected to 5.5.13"; err.Error() != want {
+		t.Errorf("error = %q, want %q", err, want)
+	}
+	if bulkCalled {
+		t.Error("the bulk endpoint was called on a release without it")
+	}
+}
+
+// serveFixture answers API requests with a testdata file, and /api/v1/about with a current version
+func serveFixture(t *testing.T, name string) http.HandlerFunc {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", name, err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/about" {
+			w.Write([]byte(`{"data": {"version": "6.1.24"}}`))
+			return
+		}
+		w.Write(data)
+	}
+}
+
+func TestClient_GetTransaction(t *testing.T) {
+	client := newTestClient(t, serveFixture(t, "transaction_group.json"))
+
+	group, err := client.GetTransaction(context.Background(), "312")
+	if err != nil {
+		t.Fatalf("GetTransaction() returned unexpected error: %v", err)
+	}
+
+	cet := time.FixedZone("CET", 3600)
+	if !group.CreatedAt.Equal(time.Date(2024, 3, 2, 9, 15, 41, 0, cet)) {
+		t.Errorf("CreatedAt = %v, want 2024-03-02T09:15:41+01:00", group.CreatedAt)
+	}
+	if !group.UpdatedAt.Equal(time.Date(2024, 3, 5, 18, 2, 10, 0, cet)) {
+		t.Errorf("UpdatedAt = %v, want 2024-03-05T18:02:10+01:00", group.UpdatedAt)
+	}
+	if group.Title != "Groceries and pharmacy" || len(group.Splits) != 2 {
+		t.Fatalf("GetTransaction() = %+v, want titled group with 2 splits", group)
+	}
+
+	// The booking date of each split, not the creation time of the group
+	first, second := group.Splits[0], group.Splits[1]
+	if !first.Date.Equal(time.Date(2024, 2, 28, 0, 0, 0, 0, cet)) {
+		t.Errorf("first split date = %v, want 2024-02-28T00:00:00+01:00", first.Date)
+	}
+	if !second.Date.Equal(time.Date(2024, 2, 29, 12, 30, 0, 0, time.UTC)) {
+		t.Errorf("second split date = %v, want 2024-02-29 12:30:00", second.Date)
+	}
+
+	if first.JournalID != "401" || first.Amount != 42.15 || first.CategoryID != "4" || first.ExternalID != "local-42" {
+		t.Errorf("first split = %+v", first)
+	}
+	if second.JournalID != "402" || second.SourceID != "1" || second.Notes != "receipt in drawer" || second.CategoryName != "" {
+		t.Errorf("second split = %+v", second)
+	}
+}
+
+func TestClient_ListTransactions(t *testing.T) {
+	var query string
+	fixture := serveFixture(t, "transaction_list.json")
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/transactions" {
+			query = r.URL.RawQuery
+		}
+		fixture(w, r)
+	})
+
+	groups, err := client.ListTransactions(context.Background(), interfaces.FireflyTransactionFilter{
+		Start: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("ListTransactions() returned unexpected error: %v", err)
+	}
+
+	if query != "end=2024-04-30&page=1&start=2024-04-01" {
+		t.Errorf("ListTransactions() query = %q", query)
+	}
+
+	// The group with an unparsable date is skipped
+	if len(groups) != 1 || groups[0].ID != "501" {
+		t.Fatalf("ListTransactions() = %+v, want only group 501", groups)
+	}
+	split := groups[0].Splits[0]
+	if !split.Date.Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)) || split.Date.Equal(groups[0].CreatedAt) {
+		t.Errorf("split date = %v, want booking date 2024-04-01 distinct from created_at %v", split.Date, groups[0].CreatedAt)
+	}
+}
+
+func TestClient_ListAccountTransactions(t *testing.T) {
+	var path, query string
+	fixture := serveFixture(t, "transaction_list.json")
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path, query = r.URL.Path, r.URL.RawQuery
+		fixture(w, r)
+	})
+
+	groups, err := client.ListAccountTransactions(context.Background(), "12", interfaces.FireflyTransactionFilter{
+		Start: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		Type:  "withdrawal",
+	})
+	if err != nil {
+		t.Fatalf("ListAccountTransactions() returned unexpected error: %v", err)
+	}
+	if path != "/api/v1/accounts/12/transactions" || query != "page=1&start=2024-04-01&type=withdrawal" {
+		t.Errorf("ListAccountTransactions() requested %s?%s", path, query)
+	}
+	if len(groups) != 1 || groups[0].ID != "501" {
+		t.Fatalf("ListAccountTransactions() = %+v, want only group 501", groups)
+	}
+
+	if _, err := client.ListAccountTransactions(context.Background(), "", interfaces.FireflyTransactionFilter{}); interfaces.ErrorTypeOf(err) != interfaces.ErrorTypeValidation {
+		t.Errorf("ListAccountTransactions() without an account error = %v, want a validation error", err)
+	}
+}
+
+func TestClient_ListTransactionsUpdatedSince(t *testing.T) {
+	var search string
+	fixture := serveFixture(t, "transaction_list.json")
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		search = r.URL.Query().Get("query")
+		fixture(w, r)
+	})
+
+	since := time.Date(2024, 4, 10, 7, 0, 0, 0, time.UTC)
+	groups, err := client.ListTransactionsUpdatedSince(context.Background(), since)
+	if err != nil {
+		t.Fatalf("ListTransactionsUpdatedSince() returned unexpected error: %v", err)
+	}
+	if search != "updated_at_after:2024-04-09" {
+		t.Errorf("search query = %q, want the day before since", search)
+	}
+	if len(groups) != 1 || groups[0].ID != "501" {
+		t.Fatalf("ListTransactionsUpdatedSince() = %+v, want only group 501", groups)
+	}
+
+	// Changes earlier on the day of since are dropped
+	groups, err = client.ListTransactionsUpdatedSince(context.Background(), since.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("ListTransactionsUpdatedSince() returned unexpected error: %v", err)
+	}
+	if len(groups) != 0 {
+		t.Errorf("ListTransactionsUpdatedSince() = %+v, want none", groups)
+	}
+}
+
+func TestClient_GetCategoryReport(t *testing.T) {
+	var ranges []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		start, end := r.URL.Query().Get("start"), r.URL.Query().Get("end")
+		ranges = append(ranges, start+".."+end)
+
+		spent := map[string]string{"2024-01-15": "-120.50", "2024-02-01": "-30", "2024-03-01": "-0"}[start]
+		fmt.Fprintf(w, `{"data": {"id": "4", "attributes": {
+			"name": "Groceries",
+			"spent": [{"currency_code": "EUR", "sum": %q}],
+			"earned": []
+		}}}`, spent)
+	})
+
+	report, err := client.GetCategoryReport(context.Background(), "4",
+		time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetCategoryReport() returned unexpected error: %v", err)
+	}
+
+	want := []string{"2024-01-15..2024-01-31", "2024-02-01..2024-02-29", "2024-03-01..2024-03-10"}
+	if fmt.Sprint(ranges) != fmt.Sprint(want) {
+		t.Errorf("requested ranges = %v, want %v", ranges, want)
+	}
+
+	if report.Name != "Groceries" || len(report.Periods) != 3 {
+		t.Fatalf("GetCategoryReport() = %+v, want 3 periods of Groceries", report)
+	}
+	if got := report.Periods[0].Spent[0].Sum; got != 120.5 {
+		t.Errorf("January spent = %v, want 120.5", got)
+	}
+	if len(report.Spent) != 1 || report.Spent[0].CurrencyCode != "EUR" || report.Spent[0].Sum != 150.5 {
+		t.Errorf("total spent = %+v, want EUR 150.5", report.Spent)
+	}
+}
+
+func TestClient_ListCategories(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "1" {
+			fmt.Fprint(w, `{
+				"data": [{"id": "4", "attributes": {"name": "Groceries", "notes": "Food only"}}, {"id": 5, "attributes": "broken"}],
+				"meta": {"pagination": {"current_page": 1, "total_pages": 2}}
+			}`)
+			return
+		}
+		fmt.Fprint(w, `{
+			"data": [{"id": 6, "attributes": {"name": "Rent"}}],
+			"meta": {"pagination": {"current_page": 2, "total_pages": 2}}
+		}`)
+	})
+
+	categories, err := client.ListCategories(context.Background())
+	if err != nil {
+		t.Fatalf("ListCategories() returned unexpected error: %v", err)
+	}
+
+	// The undecodable category is skipped
+	if len(categories) != 2 {
+		t.Fatalf("ListCategories() = %+v, want Groceries and Rent", categories)
+	}
+	if categories[0].Name != "Groceries" || categories[0].Notes != "Food only" || categories[1].ID != "6" {
+		t.Errorf("ListCategories() = %+v", categories)
+	}
+}
+
+func TestClient_SearchTransactions(t *testing.T) {
+	var search string
+	fixture := serveFixture(t, "transaction_list.json")
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		search = r.URL.Query().Get("query")
+		fixture(w, r)
+	})
+
+	groups, err := client.SearchTransactions(context.Background(), interfaces.NewFireflySearch().
+		Category("Groceries").
+		AmountMore(10).
+		On(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)))
+	if err != nil {
+		t.Fatalf("SearchTransactions() returned unexpected error: %v", err)
+	}
+	if search != `category_is:"Groceries" amount_more:10 date_on:2024-04-01` {
+		t.Errorf("search query = %q", search)
+	}
+	if len(groups) == 0 {
+		t.Errorf("SearchTransactions() returned no groups")
+	}
+
+	// Invalid and empty searches are rejected before a request is sent
+	search = ""
+	for _, invalid := range []*interfaces.FireflySearch{interfaces.NewFireflySearch().Account("Checking"), interfaces.NewFireflySearch()} {
+		if _, err := client.SearchTransactions(context.Background(), invalid); interfaces.ErrorTypeOf(err) != interfaces.ErrorTypeValidation {
+			t.Errorf("SearchTransactions(%q) error = %v, want a validation error", invalid, err)
+		}
+	}
+	if search != "" {
+		t.Errorf("invalid search sent query %q", search)
+	}
+}
+
+func TestClient_BulkUpdateTransactions(t *testing.T) {
+	var updates []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/search/transactions":
+			if got := r.URL.Query().Get("query"); got != `tag_is:"coffee" date_after:2024-01-01` {
+				t.Errorf("search query = %q", got)
+			}
+			if r.URL.Query().Get("limit") == "1" {
+				w.Write([]byte(`{"data": [], "meta": {"pagination": {"total": 2, "total_pages": 2}}}`))
+				return
+			}
+			fmt.Fprintf(w, `{"data": [{"id": "%s", "attributes": {"transactions": [{"transaction_journal_id": "j%s"}]}}],
+				"meta": {"pagination": {"total": 2, "total_pages": 2}}}`, r.URL.Query().Get("page"), r.URL.Query().Get("page"))
+		case r.Method == http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			updates = append(updates, r.URL.Path+" "+string(body))
+			w.Write([]byte(`{"data": {}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	update := interfaces.NewFireflyBulkUpdate().
+		WithTag("coffee").
+		Between(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}).
+		SetCategory("Dining").
+		SetTags()
+
+	count, err := client.BulkUpdateTransactions(context.Background(), update, true)
+	if err != nil || count != 2 || len(updates) != 0 {
+		t.Fatalf("dry run = %d, %v with %d updates, want 2 matches and no updates", count, err, len(updates))
+	}
+
+	count, err = client.BulkUpdateTransactions(context.Background(), update, false)
+	if err != nil {
+		t.Fatalf("BulkUpdateTransactions() returned unexpected error: %v", err)
+	}
+	if count != 2 || len(updates) != 2 {
+		t.Fatalf("BulkUpdateTransactions() = %d with updates %v, want 2", count, updates)
+	}
+
+	want := `/api/v1/transactions/1 {"apply_rules":false,"transactions":[{"transaction_journal_id":"j1","category_name":"Dining","tags":[]}]}`
+	if updates[0] != want {
+		t.Errorf("first update = %s, want %s", updates[0], want)
+	}
+}
+
+func TestClient_UpdateTransaction(t *testing.T) {
+	var update string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"data": {"id": "7", "attributes": {"transactions": [{"transaction_journal_id": "j1"}, {"transaction_journal_id": "j2"}]}}}`))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			update = r.URL.Path + " " + string(body)
+			w.Write([]byte(`{"data": {}}`))
+		}
+	})
+
+	description := "Coffee at Blue Bottle"
+	if err := client.UpdateTransaction(context.Background(), "7", interfaces.FireflyTransactionEdit{Description: &description}); err != nil {
+		t.Fatalf("UpdateTransaction() returned unexpected error: %v", err)
+	}
+
+	want := `/api/v1/transactions/7 {"apply_rules":false,"transactions":[` +
+		`{"transaction_journal_id":"j1","description":"Coffee at Blue Bottle"},` +
+		`{"transaction_journal_id":"j2","description":"Coffee at Blue Bottle"}]}`
+	if update != want {
+		t.Errorf("update = %s, want %s", update, want)
+	}
+}
+
+func TestFireflyBulkUpdate_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  *interfaces.FireflyBulkUpdate
+		wantErr bool
+	}{
+		{"valid", interfaces.NewFireflyBulkUpdate().InCategory("Food").SetNotes("checked"), false},
+		{"no filter", interfaces.NewFireflyBulkUpdate().SetCategory("Food"), true},
+		{"no change", interfaces.NewFireflyBulkUpdate().WithTag("x"), true},
+		{"inverted range", interfaces.NewFireflyBulkUpdate().Between(time.Now(), time.Now().AddDate(0, 0, -1)).SetTags("x"), true},
+		{"account move", interfaces.NewFireflyBulkUpdate().ForAccount("1").MoveToAccount("2"), false},
+		{"account move with filter", interfaces.NewFireflyBulkUpdate().ForAccount("1").WithTag("x").MoveToAccount("2"), true},
+		{"account move with change", interfaces.NewFireflyBulkUpdate().ForAccount("1").MoveToAccount("2").SetNotes("x"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.update.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_DeleteAccount(t *testing.T) {
+	var deleted bool
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/accounts/7/transactions":
+			w.Write([]byte(`{"data": [{"id": "1"}], "meta": {"pagination": {"total": 12}}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/accounts/7":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	err := client.DeleteAccount(context.Background(), "7", false)
+	var inUse *interfaces.FireflyAccountInUseError
+	if !errors.As(err, &inUse) || inUse.Transactions != 12 || deleted {
+		t.Fatalf("DeleteAccount() error = %v, deleted = %v, want in use with 12 transactions", err, deleted)
+	}
+
+	if err := client.DeleteAccount(context.Background(), "7", true); err != nil || !deleted {
+		t.Errorf("forced DeleteAccount() error = %v, deleted = %v", err, deleted)
+	}
+}
+
+func TestClient_CreateLink(t *testing.T) {
+	var created string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/link_types":
+			w.Write([]byte(`{"data": [
+				{"id": "1", "attributes": {"name": "Related", "inward": "relates to", "outward": "relates to"}},
+				{"id": "2", "attributes": {"name": "Refund", "inward": "is (partially) refunded by", "outward": "(partially) refunds"}}
+			], "meta": {"pagination": {"total_pages": 1}}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/transaction_links":
+			body, _ := io.ReadAll(r.Body)
+			created = string(body)
+			w.Write([]byte(`{"data": {"id": "9", "attributes": {"link_type_id": 2, "inward_id": "401", "outward_id": "402"}}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	link, err := client.CreateLink(context.Background(), interfaces.FireflyLinkRequest{
+		LinkType:  "refund",
+		InwardID:  "401",
+		OutwardID: "402",
+	})
+	if err != nil {
+		t.Fatalf("CreateLink() returned unexpected error: %v", err)
+	}
+
+	if want := `{"inward_id":"401","link_type_id":"2","outward_id":"402"}`; created != want {
+		t.Errorf("CreateLink() sent %s, want %s", created, want)
+	}
+	if link.ID != "9" || link.LinkTypeID != "2" {
+		t.Errorf("CreateLink() = %+v", link)
+	}
+
+	_, err = client.CreateLink(context.Background(), interfaces.FireflyLinkRequest{LinkType: "Unknown", InwardID: "1", OutwardID: "2"})
+	var clientErr *interfaces.ClientError
+	if !errors.As(err, &clientErr) || clientErr.Type != interfaces.ErrorTypeNotFound {
+		t.Errorf("CreateLink() with unknown type error = %v, want not found", err)
+	}
+}
+
+func TestClient_EachTransactionStopsEarly(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page := r.URL.Query().Get("page")
+		fmt.Fprintf(w, `{
+			"data": [
+				{"id": "%[1]s1", "attributes": {"created_at": "2024-04-10T08:00:00+00:00", "updated_at": "2024-04-10T08:00:00+00:00", "transactions": []}},
+				{"id": "%[1]s2", "attributes": {"created_at": "2024-04-10T08:00:00+00:00", "updated_at": "2024-04-10T08:00:00+00:00", "transactions": []}}
+			],
+			"meta": {"pagination": {"current_page": %[1]s, "total_pages": 3}}
+		}`, page)
+	})
+
+	stop := errors.New("enough")
+	var seen []string
+	err := client.EachTransaction(context.Background(), interfaces.FireflyTransactionFilter{}, func(group interfaces.FireflyTransactionGroup) error {
+		seen = append(seen, group.ID)
+		if len(seen) == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("EachTransaction() error = %v, want the error of fn", err)
+	}
+	if fmt.Sprint(seen) != "[11 12 21]" || requests != 2 {
+		t.Errorf("EachTransaction() saw %v in %d requests, want [11 12 21] in 2", seen, requests)
+	}
+}
+
+func TestDecodeListPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantItems []string
+		wantPages int
+		wantErr   bool
+	}{
+		{
+			name:      "meta before data and unknown members skipped",
+			body:      `{"meta": {"pagination": {"total_pages": 4}}, "included": [{"a": [1, {"b": null}]}], "data": [{"id": "1"}, {"id": "2"}], "links": {"self": "x"}}`,
+			wantItems: []string{`{"id": "1"}`, `{"id": "2"}`},
+			wantPages: 4,
+		},
+		{name: "null data", body: `{"data": null, "meta": {"pagination": {"total_pages": 1}}}`, wantPages: 1},
+		{name: "empty object", body: `{}`},
+		{name: "data is not an array", body: `{"data": {"id": "1"}}`, wantErr: true},
+		{name: "truncated", body: `{"data": [{"id": "1"}, {"id"`, wantItems: []string{`{"id": "1"}`}, wantErr: true},
+		{name: "not an object", body: `[]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var items []string
+			meta, err := decodeListPage(strings.NewReader(tt.body), func(index int, raw json.RawMessage) error {
+				if index != len(items) {
+					t.Errorf("item index = %d, want %d", index, len(items))
+				}
+				items = append(items, string(raw))
+				return nil
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeListPage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var clientErr *interfaces.ClientError
+			if err != nil && (!errors.As(err, &clientErr) || clientErr.Type != interfaces.ErrorTypeProviderBug) {
+				t.Errorf("decodeListPage() error = %v, want a provider bug", err)
+			}
+			if fmt.Sprint(items) != fmt.Sprint(tt.wantItems) {
+				t.Errorf("decodeListPage() items = %v, want %v", items, tt.wantItems)
+			}
+			if err == nil && meta.TotalPages != tt.wantPages {
+				t.Errorf("decodeListPage() total pages = %d, want %d", meta.TotalPages, tt.wantPages)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package firefly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// compatibility holds the mappers matching the connected Firefly version.
+// Field names differ between Firefly releases; decoding itself is lenient for
+// all versions so unknown or retyped fields never fail a whole response.
+type compatibility struct {
+	version    string
+	apiVersion string
+	parsed     [3]int
+	known      bool // false when the version could not be detected or parsed
+
+	// mapAccount converts a decoded account, resolving version specific fields
+	mapAccount func(data accountData) interfaces.FireflyAccount
+}
+
+// primaryCurrencyVersion is the first release that renamed the "native"
+// currency fields to "primary"
+var primaryCurrencyVersion = [3]int{6, 3, 0}
+
+// featureVersions is the compatibility matrix of the optional features: the
+// first Firefly release supporting each of them
+var featureVersions = map[interfaces.FireflyFeature][3]int{
+	interfaces.FireflyFeatureWebhooks:      {5, 5, 0},
+	interfaces.FireflyFeatureBulkUpdate:    {5, 6, 0},
+	interfaces.FireflyFeatureUpdatedSearch: {5, 7, 0},
+	interfaces.FireflyFeatureInsights:      {6, 0, 0},
+	interfaces.FireflyFeatureV2API:         {6, 1, 0},
+}
+
+// About returns the version information of the Firefly III instance
+func (c *Client) About(ctx context.Context) (*interfaces.FireflyAbout, error) {
+	var resp struct {
+		Data interfaces.FireflyAbout `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/about", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Data, nil
+}
+
+// Capabilities returns the optional features of the connected Firefly
+// instance, detecting its version on first use. Features are assumed
+// supported when the version cannot be parsed, as for the latest release.
+func (c *Client) Capabilities(ctx context.Context) (*interfaces.FireflyCapabilities, error) {
+	compat, err := c.detect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := &interfaces.FireflyCapabilities{
+		Version:    compat.version,
+		APIVersion: compat.apiVersion,
+		Features:   make(map[interfaces.FireflyFeature]bool, len(featureVersions)),
+	}
+	for feature := range featureVersions {
+		capabilities.Features[feature] = compat.require(feature) == nil
+	}
+	return capabilities, nil
+}
+
+// compat returns the mappers for the connected Firefly version, detecting it on first use.
+// When detection fails the mappers of the latest release are used and detection is retried later.
+func (c *Client) compat(ctx context.Context) compatibility {
+	detected, err := c.detect(ctx)
+	if err != nil {
+		logger := internal.GetLogger().With().Str("client", "firefly").Logger()
+		logger.Warn().Err(err).Msg("Failed to detect Firefly version, assuming the latest release")
+		return compatibilityFor("", "")
+	}
+	return detected
+}
+
+// detect returns the compatibility of the connected Firefly version, asking
+// the instance once and keeping the answer
+func (c *Client) detect(ctx context.Context) (compatibility, error) {
+	c.compatMu.Lock()
+	defer c.compatMu.Unlock()
+
+	if c.detected != nil {
+		return *c.detected, nil
+	}
+
+	about, err := c.About(ctx)
+	if err != nil {
+		return compatibility{}, err
+	}
+
+	detected := compatibilityFor(about.Version, about.APIVersion)
+	c.detected = &detected
+	logger := internal.GetLogger().With().Str("client", "firefly").Logger()
+	logger.Info().Str("version", about.Version).Str("apiVersion", about.APIVersion).Msg("Detected Firefly version")
+
+	return detected, nil
+}
+
+// compatibilityFor selects the mappers for a Firefly version; empty or unparsable means latest
+func compatibilityFor(version, apiVersion string) compatibility {
+	parsed, ok := parseVersion(version)
+	compat := compatibility{version: version, apiVersion: apiVersion, parsed: parsed, known: ok, mapAccount: mapAccount}
+	if ok && compareVersions(parsed, primaryCurrencyVersion) < 0 {
+		compat.mapAccount = mapAccountNative
+	}
+	return compat
+}
+
+// require returns a *interfaces.FireflyUnsupportedError when the connected
+// version is known to predate a feature, so callers get a clear error instead
+// of the 404 of a missing endpoint
+func (c compatibility) require(feature interfaces.FireflyFeature) error {
+	required, ok := featureVersions[feature]
+	if !ok || !c.known || compareVersions(c.parsed, required) >= 0 {
+		return nil
+	}
+	return &interfaces.FireflyUnsupportedError{
+		Feature:  feature,
+		Required: fmt.Sprintf("%d.%d.%d", required[0], required[1], required[2]),
+		Version:  c.version,
+	}
+}
+
+// parseVersion parses "6.1.2", "v6.1" or "6.2.0-beta.1" into major, minor, patch
+func parseVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(version, "-+ "); i >= 0 {
+		version = version[:i]
+	}
+	if version == "" {
+		return parsed, false
+	}
+
+	for i, part := range strings.SplitN(version, ".", 3) {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return parsed, false
+		}
+		parsed[i] = n
+	}
+
+	return parsed, true
+}
+
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+	return 0
+}
+
+// decodeItem decodes a raw list item. An item that fails to decode is
+// reported instead, so a single bad item does not abort the whole list.
+func decodeItem[T any](page, index int, raw json.RawMessage) (T, *interfaces.FireflyItemError) {
+	var item T
+	if err := json.Unmarshal(raw, &item); err != nil {
+		var ref struct {
+			ID flexString `json:"id"`
+		}
+		_ = json.Unmarshal(raw, &ref)
+
+		return item, &interfaces.FireflyItemError{
+			Index: index,
+			Page:  page,
+			ID:    string(ref.ID),
+			Error: err.Error(),
+		}
+	}
+	return item, nil
+}
+
+// flexString accepts JSON strings, numbers and null
+type flexString string
+
+func (s *flexString) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*s = ""
+	case len(data) > 0 && data[0] == '"':
+		var v string
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		*s = flexString(v)
+	default:
+		var v json.Number
+		if err := json.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("expected string or number, got %s", data)
+		}
+		*s = flexString(v.String())
+	}
+	return nil
+}
+
+// flexBool accepts JSON booleans, "true"/"false"/"1"/"0" strings, 0/1 and null
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var s flexString
+	if err := s.UnmarshalJSON(data); err != nil {
+		var v bool
+		if err := json.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("expected boolean, got %s", data)
+		}
+		*b = flexBool(v)
+		return nil
+	}
+
+	switch strings.ToLower(string(s)) {
+	case "", "0", "false":
+		*b = false
+	case "1", "true":
+		*b = true
+	default:
+		return fmt.Errorf("expected boolean, got %s", data)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package firefly
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+type currencyData struct {
+	ID         string                     `json:"id"`
+	Attributes interfaces.FireflyCurrency `json:"attributes"`
+}
+
+// GetCurrency gets a currency by its code
+func (c *Client) GetCurrency(ctx context.Context, code string) (*interfaces.FireflyCurrency, error) {
+	var resp struct {
+		Data currencyData `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/currencies/"+url.PathEscape(strings.ToUpper(code)), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Data.Attributes, nil
+}
+
+// CreateCurrency creates a new, enabled currency
+func (c *Client) CreateCurrency(ctx context.Context, currency interfaces.FireflyCurrency) (*interfaces.FireflyCurrency, error) {
+	currency.Code = strings.ToUpper(currency.Code)
+	currency.Enabled = true
+
+	var resp struct {
+		Data currencyData `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/currencies", currency, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Data.Attributes, nil
+}
+
+// EnableCurrency enables a disabled currency
+func (c *Client) EnableCurrency(ctx context.Context, code string) error {
+	return c.do(ctx, http.MethodPost, "/api/v1/currencies/"+url.PathEscape(strings.ToUpper(code))+"/enable", nil, nil)
+}
@@ -0,0 +1,110 @@
+package firefly
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/rs/zerolog"
+)
+
+// RequestHook is called before every request is sent, after the client has set
+// its own headers. Returning an error aborts the request.
+type RequestHook func(req *http.Request) error
+
+// ResponseHook is called after every request attempt. resp is nil when the
+// request failed before a response arrived (err is set then). Hooks must not
+// read or close the response body.
+type ResponseHook func(req *http.Request, resp *http.Response, err error, duration time.Duration)
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithRequestHook adds a hook that runs before every request
+func WithRequestHook(hook RequestHook) Option {
+	return func(c *Client) {
+		c.requestHooks = append(c.requestHooks, hook)
+	}
+}
+
+// WithResponseHook adds a hook that runs after every request
+func WithResponseHook(hook ResponseHook) Option {
+	return func(c *Client) {
+		c.responseHooks = append(c.responseHooks, hook)
+	}
+}
+
+// HeaderHook sets header name to the value derived from the request context,
+// e.g. an X-Trace-Id. Empty values are not sent.
+func HeaderHook(name string, value func(ctx context.Context) string) RequestHook {
+	return func(req *http.Request) error {
+		if v := value(req.Context()); v != "" {
+			req.Header.Set(name, v)
+		}
+		return nil
+	}
+}
+
+// LoggingHook logs every request at debug level and failures at warn level
+func LoggingHook(logger zerolog.Logger) ResponseHook {
+	return func(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+		event := logger.Debug()
+		if err != nil || resp.StatusCode >= 400 {
+			event = logger.Warn()
+		}
+		if resp != nil {
+			event = event.Int("status", resp.StatusCode)
+		}
+
+		event.Err(err).
+			Str("method", req.Method).
+			Str("path", req.URL.Path).
+			Dur("duration", duration).
+			Msg("Firefly request")
+	}
+}
+
+// MetricsHook records request latencies per endpoint and failures per error type.
+// Numeric path segments are collapsed so IDs do not create new series.
+func MetricsHook(metrics interfaces.MetricsClient) ResponseHook {
+	return func(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+		metrics.RecordLatency("firefly "+req.Method+" "+endpoint(req.URL.Path), duration)
+
+		switch {
+		case err != nil:
+			metrics.RecordError("firefly", string(interfaces.ErrorTypeNetwork))
+		case resp.StatusCode >= 400:
+			metrics.RecordError("firefly", "status_"+strconv.Itoa(resp.StatusCode))
+		}
+	}
+}
+
+// HealthHook reports the outcome of every request to observe, e.g. the
+// incident tracker. Network errors and retryable or server-side statuses count
+// as failures; other client errors mean Firefly itself is up.
+func HealthHook(observe func(ctx context.Context, err error)) ResponseHook {
+	return func(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+		switch {
+		case err != nil:
+			observe(req.Context(), interfaces.NewTransportError("firefly request failed", err))
+		case resp.StatusCode >= 500, interfaces.ErrorTypeForStatus(resp.StatusCode).Retryable():
+			observe(req.Context(), interfaces.NewStatusError(resp, "firefly returned status "+strconv.Itoa(resp.StatusCode)))
+		default:
+			observe(req.Context(), nil)
+		}
+	}
+}
+
+// endpoint replaces numeric path segments with {id}
+func endpoint(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if _, err := strconv.ParseUint(segment, 10, 64); err == nil {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
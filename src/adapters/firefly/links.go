@@ -0,0 +1,135 @@
+package firefly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+type linkTypeData struct {
+	ID         flexString `json:"id"`
+	Attributes struct {
+		Name    string `json:"name"`
+		Inward  string `json:"inward"`
+		Outward string `json:"outward"`
+	} `json:"attributes"`
+}
+
+type linkData struct {
+	ID         flexString `json:"id"`
+	Attributes struct {
+		LinkTypeID flexString `json:"link_type_id"`
+		InwardID   flexString `json:"inward_id"`
+		OutwardID  flexString `json:"outward_id"`
+		Notes      string     `json:"notes"`
+	} `json:"attributes"`
+}
+
+// ListLinkTypes lists the available link types
+func (c *Client) ListLinkTypes(ctx context.Context) ([]interfaces.FireflyLinkType, error) {
+	types := make([]interfaces.FireflyLinkType, 0)
+
+	pagePath := func(page int) string {
+		return fmt.Sprintf("/api/v1/link_types?page=%d", page)
+	}
+
+	err := c.paginate(ctx, pagePath, func(page, index int, raw json.RawMessage) error {
+		data, err := decodeStrict[linkTypeData](raw)
+		if err != nil {
+			return err
+		}
+		types = append(types, interfaces.FireflyLinkType{
+			ID:      string(data.ID),
+			Name:    data.Attributes.Name,
+			Inward:  data.Attributes.Inward,
+			Outward: data.Attributes.Outward,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types, nil
+}
+
+// CreateLink links two transaction journals, resolving the link type by name
+func (c *Client) CreateLink(ctx context.Context, link interfaces.FireflyLinkRequest) (*interfaces.FireflyLink, error) {
+	types, err := c.ListLinkTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	linkTypeID := ""
+	for _, linkType := range types {
+		if strings.EqualFold(linkType.Name, link.LinkType) {
+			linkTypeID = linkType.ID
+			break
+		}
+	}
+	if linkTypeID == "" {
+		return nil, interfaces.NewClientError(interfaces.ErrorTypeNotFound, "unknown firefly link type "+link.LinkType, nil)
+	}
+
+	body := map[string]string{
+		"link_type_id": linkTypeID,
+		"inward_id":    link.InwardID,
+		"outward_id":   link.OutwardID,
+	}
+	if link.Notes != "" {
+		body["notes"] = link.Notes
+	}
+
+	var resp struct {
+		Data linkData `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/transaction_links", body, &resp); err != nil {
+		return nil, err
+	}
+
+	created := mapLink(resp.Data)
+	return &created, nil
+}
+
+// ListLinks lists the links of a transaction journal
+func (c *Client) ListLinks(ctx context.Context, journalID string) ([]interfaces.FireflyLink, error) {
+	links := make([]interfaces.FireflyLink, 0)
+
+	pagePath := func(page int) string {
+		return fmt.Sprintf("/api/v1/transaction-journals/%s/links?page=%d", url.PathEscape(journalID), page)
+	}
+
+	err := c.paginate(ctx, pagePath, func(page, index int, raw json.RawMessage) error {
+		data, err := decodeStrict[linkData](raw)
+		if err != nil {
+			return err
+		}
+		links = append(links, mapLink(data))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// DeleteLink deletes a link by ID
+func (c *Client) DeleteLink(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/transaction_links/"+url.PathEscape(id), nil, nil)
+}
+
+func mapLink(data linkData) interfaces.FireflyLink {
+	return interfaces.FireflyLink{
+		ID:         string(data.ID),
+		LinkTypeID: string(data.Attributes.LinkTypeID),
+		InwardID:   string(data.Attributes.InwardID),
+		OutwardID:  string(data.Attributes.OutwardID),
+		Notes:      data.Attributes.Notes,
+	}
+}
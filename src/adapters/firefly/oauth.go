@@ -0,0 +1,158 @@
+package firefly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"golang.org/x/oauth2"
+)
+
+// oauthTokenSecret is the secrets store entry holding the Firefly OAuth token
+const oauthTokenSecret = "firefly_oauth_token"
+
+// TokenStore persists the OAuth token between restarts
+type TokenStore interface {
+	// LoadToken returns the stored token, or nil when none has been stored yet
+	LoadToken(ctx context.Context) (*oauth2.Token, error)
+
+	// SaveToken stores the token, replacing any previous one
+	SaveToken(ctx context.Context, token *oauth2.Token) error
+}
+
+// SecretTokenStore keeps the OAuth token encrypted in the secrets store
+type SecretTokenStore struct {
+	secrets repositories.SecretRepository
+}
+
+// NewSecretTokenStore creates a new SecretTokenStore
+func NewSecretTokenStore(secrets repositories.SecretRepository) *SecretTokenStore {
+	return &SecretTokenStore{
+		secrets: secrets,
+	}
+}
+
+// LoadToken returns the stored token, or nil when none has been stored yet
+func (s *SecretTokenStore) LoadToken(ctx context.Context) (*oauth2.Token, error) {
+	data, err := s.secrets.Get(ctx, oauthTokenSecret)
+	if errors.Is(err, models.ErrSecretNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	token := &oauth2.Token{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("failed to decode firefly oauth token: %w", err)
+	}
+
+	return token, nil
+}
+
+// SaveToken stores the token, replacing any previous one
+func (s *SecretTokenStore) SaveToken(ctx context.Context, token *oauth2.Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return fmt.Errorf("failed to encode firefly oauth token: %w", err)
+	}
+
+	return s.secrets.Put(ctx, oauthTokenSecret, data)
+}
+
+// WithOAuth authenticates the client with OAuth2 tokens from store instead of
+// the configured personal access token. Firefly III issues them through
+// Laravel Passport at /oauth/authorize and /oauth/token.
+func (c *Client) WithOAuth(cfg internal.FireflyOAuthConfig, store TokenStore) *Client {
+	c.oauth = &oauth2.Config{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		RedirectURL:  cfg.RedirectURL,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  c.baseURL + "/oauth/authorize",
+			TokenURL: c.baseURL + "/oauth/token",
+		},
+	}
+	c.tokens = store
+	return c
+}
+
+// AuthCodeURL returns the Firefly consent page URL starting the authorization code flow
+func (c *Client) AuthCodeURL(state string) string {
+	return c.oauth.AuthCodeURL(state)
+}
+
+// Exchange trades an authorization code for a token and stores it
+func (c *Client) Exchange(ctx context.Context, code string) error {
+	token, err := c.oauth.Exchange(c.oauthContext(ctx), code)
+	if err != nil {
+		return interfaces.NewClientError(interfaces.ErrorTypeAuth, "failed to exchange firefly authorization code", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.tokens.SaveToken(ctx, token); err != nil {
+		return fmt.Errorf("failed to store firefly oauth token: %w", err)
+	}
+	c.cached = token
+
+	return nil
+}
+
+// accessToken returns the bearer token for the next request. With OAuth, an
+// expired token is refreshed, and so is a token equal to rejected (the token a
+// 401 response was returned for) unless another request refreshed it already.
+func (c *Client) accessToken(ctx context.Context, rejected string) (string, error) {
+	if c.oauth == nil {
+		return c.token, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cached == nil {
+		token, err := c.tokens.LoadToken(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to load firefly oauth token: %w", err)
+		}
+		if token == nil {
+			return "", interfaces.NewClientError(interfaces.ErrorTypeAuth, "firefly oauth authorization required", nil)
+		}
+		c.cached = token
+	}
+
+	if c.cached.Valid() && (rejected == "" || c.cached.AccessToken != rejected) {
+		return c.cached.AccessToken, nil
+	}
+
+	if c.cached.RefreshToken == "" {
+		return "", interfaces.NewClientError(interfaces.ErrorTypeAuth, "firefly oauth token expired and cannot be refreshed", nil)
+	}
+
+	// Only the refresh token is passed so the token source always refreshes
+	token, err := c.oauth.TokenSource(c.oauthContext(ctx), &oauth2.Token{RefreshToken: c.cached.RefreshToken}).Token()
+	if err != nil {
+		return "", interfaces.NewClientError(interfaces.ErrorTypeAuth, "failed to refresh firefly oauth token", err)
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = c.cached.RefreshToken
+	}
+
+	if err := c.tokens.SaveToken(ctx, token); err != nil {
+		return "", fmt.Errorf("failed to store firefly oauth token: %w", err)
+	}
+	c.cached = token
+
+	return token.AccessToken, nil
+}
+
+// oauthContext makes the oauth2 package use the client's HTTP client
+func (c *Client) oauthContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, c.httpClient)
+}
@@ -0,0 +1,162 @@
+package firefly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+// errStopPaging ends paginate early without an error
+var errStopPaging = errors.New("stop paging")
+
+// paginate requests the pages of a list endpoint one after the other and
+// streams the items of each page to item as they are decoded, so only one
+// item is held in memory at a time. It stops after the last page, or early
+// when item returns an error; errStopPaging stops it without one.
+func (c *Client) paginate(ctx context.Context, pagePath func(page int) string, item func(page, index int, raw json.RawMessage) error) error {
+	for page := 1; ; page++ {
+		var meta pagination
+		err := c.stream(ctx, http.MethodGet, pagePath(page), nil, func(r io.Reader) error {
+			var err error
+			meta, err = decodeListPage(r, func(index int, raw json.RawMessage) error {
+				return item(page, index, raw)
+			})
+			return err
+		})
+		if errors.Is(err, errStopPaging) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if page >= meta.TotalPages {
+			return nil
+		}
+	}
+}
+
+// decodeStrict decodes a raw list item, failing the list when it cannot be decoded
+func decodeStrict[T any](raw json.RawMessage) (T, error) {
+	var item T
+	if err := json.Unmarshal(raw, &item); err != nil {
+		return item, interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode firefly response", err)
+	}
+	return item, nil
+}
+
+// decodeListPage decodes a list response ({"data": [...], "meta": {...}})
+// token by token, handing each item of data to item as raw JSON, and
+// returns the pagination of the page. Other members are skipped without
+// being buffered.
+func decodeListPage(r io.Reader, item func(index int, raw json.RawMessage) error) (pagination, error) {
+	var meta struct {
+		Pagination pagination `json:"pagination"`
+	}
+	invalid := func(err error) (pagination, error) {
+		return pagination{}, interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode firefly response", err)
+	}
+
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return invalid(err)
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return invalid(err)
+		}
+
+		switch key {
+		case "data":
+			// Errors of item are returned as they are, decoding errors as invalid responses
+			var itemErr error
+			err := decodeArray(dec, func(index int, raw json.RawMessage) error {
+				itemErr = item(index, raw)
+				return itemErr
+			})
+			if itemErr != nil {
+				return pagination{}, itemErr
+			}
+			if err != nil {
+				return invalid(err)
+			}
+		case "meta":
+			if err := dec.Decode(&meta); err != nil {
+				return invalid(err)
+			}
+		default:
+			if err := skipValue(dec); err != nil {
+				return invalid(err)
+			}
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return invalid(err)
+	}
+
+	return meta.Pagination, nil
+}
+
+// decodeArray hands the elements of the array at the position of dec to
+// item one by one. A null array has no elements.
+func decodeArray(dec *json.Decoder, item func(index int, raw json.RawMessage) error) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if token != json.Delim('[') {
+		return fmt.Errorf("expected an array, got %v", token)
+	}
+
+	for index := 0; dec.More(); index++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+		if err := item(index, raw); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+// skipValue reads past the value at the position of dec
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// expectDelim reads the next token and checks that it is the delimiter
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v, got %v", delim, token)
+	}
+	return nil
+}
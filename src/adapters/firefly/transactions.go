@@ -0,0 +1,388 @@
+package firefly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// formatAmount formats an amount with the decimal places of its currency, at
+// most the 12 Firefly stores. Amounts without currency are booked in that of
+// their account and keep all 12.
+func formatAmount(amount float64, currency string) string {
+	decimals := interfaces.FireflyMaxDecimals
+	if currency != "" {
+		decimals = min(models.CurrencyDecimals(currency), decimals)
+	}
+	return models.FormatDecimals(amount, decimals)
+}
+
+// transactionSplit is the wire format of a single transaction split
+type transactionSplit struct {
+	Type            string   `json:"type"`
+	Date            string   `json:"date"`
+	Amount          string   `json:"amount"`
+	Description     string   `json:"description"`
+	CurrencyCode    string   `json:"currency_code,omitempty"`
+	SourceID        string   `json:"source_id,omitempty"`
+	SourceName      string   `json:"source_name,omitempty"`
+	DestinationID   string   `json:"destination_id,omitempty"`
+	DestinationName string   `json:"destination_name,omitempty"`
+	CategoryName    string   `json:"category_name,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	ExternalID      string   `json:"external_id,omitempty"`
+	Notes           string   `json:"notes,omitempty"`
+
+	InternalReference string `json:"internal_reference,omitempty"`
+	SepaCtID          string `json:"sepa_ct_id,omitempty"`
+}
+
+// transactionSplitRead is a split as returned by the API
+type transactionSplitRead struct {
+	JournalID       flexString `json:"transaction_journal_id"`
+	Type            string     `json:"type"`
+	Date            string     `json:"date"` // booking date
+	Amount          flexString `json:"amount"`
+	Description     string     `json:"description"`
+	CurrencyCode    string     `json:"currency_code"`
+	SourceID        flexString `json:"source_id"`
+	SourceName      string     `json:"source_name"`
+	DestinationID   flexString `json:"destination_id"`
+	DestinationName string     `json:"destination_name"`
+	CategoryID      flexString `json:"category_id"`
+	CategoryName    string     `json:"category_name"`
+	BudgetName      string     `json:"budget_name"`
+	Tags            []string   `json:"tags"`
+	ExternalID      flexString `json:"external_id"`
+	Notes           string     `json:"notes"`
+}
+
+// transactionGroupData is a transaction group as returned by the API
+type transactionGroupData struct {
+	ID         flexString `json:"id"`
+	Attributes struct {
+		CreatedAt    string                 `json:"created_at"`
+		UpdatedAt    string                 `json:"updated_at"`
+		GroupTitle   string                 `json:"group_title"`
+		Transactions []transactionSplitRead `json:"transactions"`
+	} `json:"attributes"`
+}
+
+// GetTransaction gets a transaction group by ID
+func (c *Client) GetTransaction(ctx context.Context, id string) (*interfaces.FireflyTransactionGroup, error) {
+	var resp struct {
+		Data transactionGroupData `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/transactions/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	group, err := mapTransactionGroup(resp.Data)
+	if err != nil {
+		return nil, interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "failed to decode firefly transaction "+id, err)
+	}
+
+	return group, nil
+}
+
+// ListTransactions lists transaction groups, following pagination.
+// Groups that cannot be decoded are skipped and logged.
+func (c *Client) ListTransactions(ctx context.Context, filter interfaces.FireflyTransactionFilter) ([]interfaces.FireflyTransactionGroup, error) {
+	groups := make([]interfaces.FireflyTransactionGroup, 0)
+	err := c.EachTransaction(ctx, filter, func(group interfaces.FireflyTransactionGroup) error {
+		groups = append(groups, group)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// EachTransaction streams transaction groups to fn as they are decoded,
+// following pagination, so a large history is never held in memory at once.
+// It stops at the first error fn returns. Groups that cannot be decoded are
+// skipped and logged.
+func (c *Client) EachTransaction(ctx context.Context, filter interfaces.FireflyTransactionFilter, fn func(interfaces.FireflyTransactionGroup) error) error {
+	return c.eachTransactionGroup(ctx, "/api/v1/transactions", filter, fn)
+}
+
+// ListAccountTransactions lists the transaction groups touching one account,
+// following pagination. Firefly filters by account server-side, so only the
+// account's history is paged through. Groups that cannot be decoded are
+// skipped and logged.
+func (c *Client) ListAccountTransactions(ctx context.Context, accountID string, filter interfaces.FireflyTransactionFilter) ([]interfaces.FireflyTransactionGroup, error) {
+	if accountID == "" {
+		return nil, interfaces.NewClientError(interfaces.ErrorTypeValidation, "account id is required", nil)
+	}
+
+	groups := make([]interfaces.FireflyTransactionGroup, 0)
+	path := "/api/v1/accounts/" + url.PathEscape(accountID) + "/transactions"
+	err := c.eachTransactionGroup(ctx, path, filter, func(group interfaces.FireflyTransactionGroup) error {
+		groups = append(groups, group)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// eachTransactionGroup streams the transaction groups of a listing endpoint to fn
+func (c *Client) eachTransactionGroup(ctx context.Context, path string, filter interfaces.FireflyTransactionFilter, fn func(interfaces.FireflyTransactionGroup) error) error {
+	logger := internal.GetLogger().With().Str("client", "firefly").Logger()
+
+	pagePath := func(page int) string {
+		query := url.Values{"page": {fmt.Sprint(page)}}
+		if !filter.Start.IsZero() {
+			query.Set("start", filter.Start.Format(time.DateOnly))
+		}
+		if !filter.End.IsZero() {
+			query.Set("end", filter.End.Format(time.DateOnly))
+		}
+		if filter.Type != "" {
+			query.Set("type", filter.Type)
+		}
+		return path + "?" + query.Encode()
+	}
+
+	// Groups are decoded one by one so a single bad group does not fail the page
+	return c.paginate(ctx, pagePath, func(page, index int, raw json.RawMessage) error {
+		var data transactionGroupData
+		if err := json.Unmarshal(raw, &data); err != nil {
+			logger.Warn().Err(err).Int("page", page).Int("index", index).Msg("Skipped undecodable Firefly transaction")
+			return nil
+		}
+
+		group, err := mapTransactionGroup(data)
+		if err != nil {
+			logger.Warn().Err(err).Int("page", page).Int("index", index).Str("id", string(data.ID)).Msg("Skipped undecodable Firefly transaction")
+			return nil
+		}
+		return fn(*group)
+	})
+}
+
+// ListTransactionsUpdatedSince lists the transaction groups changed after since.
+// Firefly only searches by day, so the day of since is searched and the groups
+// changed earlier that day are dropped here.
+func (c *Client) ListTransactionsUpdatedSince(ctx context.Context, since time.Time) ([]interfaces.FireflyTransactionGroup, error) {
+	if err := c.compat(ctx).require(interfaces.FireflyFeatureUpdatedSearch); err != nil {
+		return nil, err
+	}
+	logger := internal.GetLogger().With().Str("client", "firefly").Logger()
+
+	// updated_at_after excludes the given day
+	search := interfaces.NewFireflySearch().UpdatedAfter(since.AddDate(0, 0, -1))
+	groups := make([]interfaces.FireflyTransactionGroup, 0)
+	err := c.eachSearchResult(ctx, search.String(), func(item transactionGroupData) error {
+		group, err := mapTransactionGroup(item)
+		if err != nil {
+			logger.Warn().Err(err).Str("id", string(item.ID)).Msg("Skipped undecodable Firefly transaction")
+			return nil
+		}
+		if group.UpdatedAt.After(since) {
+			groups = append(groups, *group)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// SearchTransactions lists the transaction groups matching a search, following
+// pagination. Groups that cannot be decoded are skipped and logged.
+func (c *Client) SearchTransactions(ctx context.Context, search *interfaces.FireflySearch) ([]interfaces.FireflyTransactionGroup, error) {
+	logger := internal.GetLogger().With().Str("client", "firefly").Logger()
+
+	if err := search.Validate(); err != nil {
+		return nil, interfaces.NewClientError(interfaces.ErrorTypeValidation, "invalid transaction search", err)
+	}
+	if search.IsEmpty() {
+		return nil, interfaces.NewClientError(interfaces.ErrorTypeValidation, "transaction search must have at least one term", nil)
+	}
+
+	groups := make([]interfaces.FireflyTransactionGroup, 0)
+	err := c.eachSearchResult(ctx, search.String(), func(item transactionGroupData) error {
+		group, err := mapTransactionGroup(item)
+		if err != nil {
+			logger.Warn().Err(err).Str("id", string(item.ID)).Msg("Skipped undecodable Firefly transaction")
+			return nil
+		}
+		groups = append(groups, *group)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// CreateTransaction creates a transaction and returns its Firefly ID
+func (c *Client) CreateTransaction(ctx context.Context, tx interfaces.FireflyTransaction) (string, error) {
+	body := struct {
+		ErrorIfDuplicateHash bool               `json:"error_if_duplicate_hash"`
+		ApplyRules           bool               `json:"apply_rules"`
+		Transactions         []transactionSplit `json:"transactions"`
+	}{
+		ErrorIfDuplicateHash: true,
+		ApplyRules:           true,
+		Transactions: []transactionSplit{{
+			Type:            tx.Type,
+			Date:            tx.Date.Format(time.RFC3339),
+			Amount:          formatAmount(tx.Amount, tx.CurrencyCode),
+			Description:     tx.Description,
+			CurrencyCode:    tx.CurrencyCode,
+			SourceID:        tx.SourceID,
+			SourceName:      tx.SourceName,
+			DestinationID:   tx.DestinationID,
+			DestinationName: tx.DestinationName,
+			CategoryName:    tx.CategoryName,
+			Tags:            tx.Tags,
+			ExternalID:      tx.ExternalID,
+			Notes:           tx.Notes,
+
+			InternalReference: tx.InternalReference,
+			SepaCtID:          tx.SepaCtID,
+		}},
+	}
+
+	var resp struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/transactions", body, &resp); err != nil {
+		return "", err
+	}
+
+	return resp.Data.ID, nil
+}
+
+// UpdateTransaction applies an edit to every split of a transaction group.
+// Firefly's rules are not applied again.
+func (c *Client) UpdateTransaction(ctx context.Context, id string, edit interfaces.FireflyTransactionEdit) error {
+	var resp struct {
+		Data transactionGroupData `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/transactions/"+url.PathEscape(id), nil, &resp); err != nil {
+		return err
+	}
+
+	body := struct {
+		ApplyRules   bool          `json:"apply_rules"`
+		Transactions []splitUpdate `json:"transactions"`
+	}{
+		Transactions: make([]splitUpdate, 0, len(resp.Data.Attributes.Transactions)),
+	}
+	for _, split := range resp.Data.Attributes.Transactions {
+		body.Transactions = append(body.Transactions, splitUpdate{
+			JournalID:    string(split.JournalID),
+			Description:  edit.Description,
+			CategoryName: edit.Category,
+		})
+	}
+	return c.do(ctx, http.MethodPut, "/api/v1/transactions/"+url.PathEscape(id), body, nil)
+}
+
+// FindTransactionByExternalID returns the ID of the transaction with the given external ID
+func (c *Client) FindTransactionByExternalID(ctx context.Context, externalID string) (string, error) {
+	search := interfaces.NewFireflySearch().ExternalID(externalID)
+	if err := search.Validate(); err != nil {
+		return "", interfaces.NewClientError(interfaces.ErrorTypeValidation, "invalid external id", err)
+	}
+	query := url.Values{"query": {search.String()}}
+
+	var resp struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/search/transactions?"+query.Encode(), nil, &resp); err != nil {
+		return "", err
+	}
+
+	if len(resp.Data) == 0 {
+		return "", interfaces.NewClientError(interfaces.ErrorTypeNotFound, fmt.Sprintf("no firefly transaction with external id %s", externalID), nil)
+	}
+
+	return resp.Data[0].ID, nil
+}
+
+// mapTransactionGroup converts a transaction group. Each split keeps its own
+// booking date; the group's created/updated timestamps are kept separately.
+func mapTransactionGroup(data transactionGroupData) (*interfaces.FireflyTransactionGroup, error) {
+	group := &interfaces.FireflyTransactionGroup{
+		ID:     string(data.ID),
+		Title:  data.Attributes.GroupTitle,
+		Splits: make([]interfaces.FireflyTransactionSplit, 0, len(data.Attributes.Transactions)),
+	}
+
+	var err error
+	if group.CreatedAt, err = parseTime(data.Attributes.CreatedAt); err != nil {
+		return nil, fmt.Errorf("invalid created_at: %w", err)
+	}
+	if group.UpdatedAt, err = parseTime(data.Attributes.UpdatedAt); err != nil {
+		return nil, fmt.Errorf("invalid updated_at: %w", err)
+	}
+
+	for _, split := range data.Attributes.Transactions {
+		date, err := parseTime(split.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date of split %s: %w", split.JournalID, err)
+		}
+
+		amount, err := strconv.ParseFloat(string(split.Amount), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount of split %s: %w", split.JournalID, err)
+		}
+
+		group.Splits = append(group.Splits, interfaces.FireflyTransactionSplit{
+			FireflyTransaction: interfaces.FireflyTransaction{
+				Type:            split.Type,
+				Date:            date,
+				Amount:          amount,
+				Description:     split.Description,
+				CurrencyCode:    split.CurrencyCode,
+				SourceID:        string(split.SourceID),
+				SourceName:      split.SourceName,
+				DestinationID:   string(split.DestinationID),
+				DestinationName: split.DestinationName,
+				CategoryName:    split.CategoryName,
+				Tags:            split.Tags,
+				ExternalID:      string(split.ExternalID),
+				Notes:           split.Notes,
+			},
+			JournalID:  string(split.JournalID),
+			CategoryID: string(split.CategoryID),
+			BudgetName: split.BudgetName,
+		})
+	}
+
+	return group, nil
+}
+
+// parseTime parses the timestamps Firefly returns. Empty values give the zero time.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, time.DateTime, time.DateOnly} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unsupported time format %q", value)
+}
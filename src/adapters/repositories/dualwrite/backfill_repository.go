@@ -0,0 +1,72 @@
+package dualwrite
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// BackfillRepository writes backfill checkpoints to both backends and reads
+// them from the new one first
+type BackfillRepository struct {
+	current  repositories.BackfillRepository
+	previous repositories.BackfillRepository
+}
+
+// NewBackfillRepository creates a repository migrating from previous to current
+func NewBackfillRepository(current, previous repositories.BackfillRepository) *BackfillRepository {
+	return &BackfillRepository{current: current, previous: previous}
+}
+
+// FindBySource returns the backfill of a source from the new backend, else
+// from the previous one, or models.ErrBackfillNotFound
+func (r *BackfillRepository) FindBySource(ctx context.Context, sourceID string) (*models.Backfill, error) {
+	backfill, err := r.current.FindBySource(ctx, sourceID)
+	if !errors.Is(err, models.ErrBackfillNotFound) {
+		return backfill, err
+	}
+	return r.previous.FindBySource(ctx, sourceID)
+}
+
+// FindAll returns the backfills of the new backend, and those of the
+// previous backend for the sources the new one lacks, most recently updated first
+func (r *BackfillRepository) FindAll(ctx context.Context) ([]*models.Backfill, error) {
+	backfills, err := r.current.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fallback, err := r.previous.FindAll(ctx)
+	if err != nil {
+		logger := internal.GetLogger().With().Str("component", "state").Logger()
+		logger.Warn().Err(err).Msg("Failed to read backfills of the previous backend")
+		return backfills, nil
+	}
+
+	found := make(map[string]bool, len(backfills))
+	for _, backfill := range backfills {
+		found[backfill.SourceID] = true
+	}
+	for _, backfill := range fallback {
+		if !found[backfill.SourceID] {
+			backfills = append(backfills, backfill)
+		}
+	}
+
+	sort.Slice(backfills, func(i, j int) bool { return backfills[i].UpdatedAt.After(backfills[j].UpdatedAt) })
+	return backfills, nil
+}
+
+// Save stores a checkpoint in both backends. Only a failure of the new
+// backend fails the save.
+func (r *BackfillRepository) Save(ctx context.Context, backfill *models.Backfill) error {
+	if err := r.previous.Save(ctx, backfill); err != nil {
+		logger := internal.GetLogger().With().Str("component", "state").Logger()
+		logger.Warn().Err(err).Str("sourceID", backfill.SourceID).Msg("Failed to write backfill to the previous backend")
+	}
+	return r.current.Save(ctx, backfill)
+}
@@ -0,0 +1,123 @@
+package dualwrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// memoryStates is a source state repository in memory
+type memoryStates struct {
+	states map[string]models.SourceState
+	err    error // returned by every call when set
+}
+
+func (m *memoryStates) FindAll(ctx context.Context) ([]*models.SourceState, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var states []*models.SourceState
+	for _, state := range m.states {
+		states = append(states, &state)
+	}
+	return states, nil
+}
+
+func (m *memoryStates) Save(ctx context.Context, state *models.SourceState) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.states[state.SourceID] = *state
+	return nil
+}
+
+// memoryBackfills is a backfill repository in memory
+type memoryBackfills struct {
+	backfills map[string]models.Backfill
+}
+
+func (m *memoryBackfills) FindBySource(ctx context.Context, sourceID string) (*models.Backfill, error) {
+	backfill, ok := m.backfills[sourceID]
+	if !ok {
+		return nil, fmt.Errorf("source %s: %w", sourceID, models.ErrBackfillNotFound)
+	}
+	return &backfill, nil
+}
+
+func (m *memoryBackfills) FindAll(ctx context.Context) ([]*models.Backfill, error) {
+	var backfills []*models.Backfill
+	for _, backfill := range m.backfills {
+		backfills = append(backfills, &backfill)
+	}
+	return backfills, nil
+}
+
+func (m *memoryBackfills) Save(ctx context.Context, backfill *models.Backfill) error {
+	m.backfills[backfill.SourceID] = *backfill
+	return nil
+}
+
+func TestSourceStateRepository_PrefersCurrent(t *testing.T) {
+	ctx := context.Background()
+	current := &memoryStates{states: map[string]models.SourceState{"a": {SourceID: "a", Runs: 5}}}
+	previous := &memoryStates{states: map[string]models.SourceState{"a": {SourceID: "a", Runs: 2}, "b": {SourceID: "b", Runs: 1}}}
+	repo := NewSourceStateRepository(current, previous)
+
+	states, err := repo.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if len(states) != 2 || states[0].Runs != 5 || states[1].SourceID != "b" {
+		t.Errorf("FindAll() = %+v, want a from the current backend and b from the previous one", states)
+	}
+
+	if err := repo.Save(ctx, &models.SourceState{SourceID: "c", Runs: 1}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, ok := current.states["c"]; !ok {
+		t.Error("Save() did not write the current backend")
+	}
+	if _, ok := previous.states["c"]; !ok {
+		t.Error("Save() did not write the previous backend")
+	}
+
+	previous.err = errors.New("unreachable")
+	if err := repo.Save(ctx, &models.SourceState{SourceID: "d"}); err != nil {
+		t.Errorf("Save() error = %v, want failures of the previous backend ignored", err)
+	}
+	if states, err := repo.FindAll(ctx); err != nil || len(states) != 3 {
+		t.Errorf("FindAll() = %d states, %v, want the current backend alone", len(states), err)
+	}
+}
+
+func TestBackfillRepository_FallsBack(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	current := &memoryBackfills{backfills: map[string]models.Backfill{"a": {SourceID: "a", Cursor: "new", UpdatedAt: now}}}
+	previous := &memoryBackfills{backfills: map[string]models.Backfill{
+		"a": {SourceID: "a", Cursor: "old", UpdatedAt: now.Add(-time.Hour)},
+		"b": {SourceID: "b", Cursor: "page-7", UpdatedAt: now.Add(-2 * time.Hour)},
+	}}
+	repo := NewBackfillRepository(current, previous)
+
+	backfill, err := repo.FindBySource(ctx, "a")
+	if err != nil || backfill.Cursor != "new" {
+		t.Errorf("FindBySource(a) = %+v, %v, want the cursor of the current backend", backfill, err)
+	}
+	backfill, err = repo.FindBySource(ctx, "b")
+	if err != nil || backfill.Cursor != "page-7" {
+		t.Errorf("FindBySource(b) = %+v, %v, want the cursor of the previous backend", backfill, err)
+	}
+	if _, err := repo.FindBySource(ctx, "c"); !errors.Is(err, models.ErrBackfillNotFound) {
+		t.Errorf("FindBySource(c) error = %v, want ErrBackfillNotFound", err)
+	}
+
+	backfills, err := repo.FindAll(ctx)
+	if err != nil || len(backfills) != 2 || backfills[0].SourceID != "a" {
+		t.Errorf("FindAll() = %+v, %v, want a then b", backfills, err)
+	}
+}
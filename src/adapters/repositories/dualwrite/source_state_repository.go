@@ -0,0 +1,66 @@
+// Package dualwrite wraps the repositories of two state store backends while
+// the sync state moves from one to the other. Writes go to both backends and
+// reads prefer the new one, falling back to the previous one for the sources
+// the new backend does not hold yet.
+package dualwrite
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// SourceStateRepository writes source states to both backends and reads them
+// from the new one first
+type SourceStateRepository struct {
+	current  repositories.SourceStateRepository
+	previous repositories.SourceStateRepository
+}
+
+// NewSourceStateRepository creates a repository migrating from previous to current
+func NewSourceStateRepository(current, previous repositories.SourceStateRepository) *SourceStateRepository {
+	return &SourceStateRepository{current: current, previous: previous}
+}
+
+// FindAll returns the states of the new backend, and those of the previous
+// backend for the sources the new one lacks
+func (r *SourceStateRepository) FindAll(ctx context.Context) ([]*models.SourceState, error) {
+	states, err := r.current.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fallback, err := r.previous.FindAll(ctx)
+	if err != nil {
+		logger := internal.GetLogger().With().Str("component", "state").Logger()
+		logger.Warn().Err(err).Msg("Failed to read source states of the previous backend")
+		return states, nil
+	}
+
+	found := make(map[string]bool, len(states))
+	for _, state := range states {
+		found[state.SourceID] = true
+	}
+	for _, state := range fallback {
+		if !found[state.SourceID] {
+			states = append(states, state)
+		}
+	}
+
+	sort.Slice(states, func(i, j int) bool { return states[i].SourceID < states[j].SourceID })
+	return states, nil
+}
+
+// Save stores a state in both backends. Only a failure of the new backend
+// fails the save; the previous one is on its way out.
+func (r *SourceStateRepository) Save(ctx context.Context, state *models.SourceState) error {
+	if err := r.previous.Save(ctx, state); err != nil {
+		logger := internal.GetLogger().With().Str("component", "state").Logger()
+		logger.Warn().Err(err).Str("sourceID", state.SourceID).Msg("Failed to write source state to the previous backend")
+	}
+	// Saved last so the state carries the ID of the new backend
+	return r.current.Save(ctx, state)
+}
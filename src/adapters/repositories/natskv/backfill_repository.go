@@ -0,0 +1,119 @@
+package natskv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// backfillPrefix starts the keys of the backfills
+const backfillPrefix = "backfills."
+
+// BackfillRepository is a NATS key-value implementation of the BackfillRepository interface
+type BackfillRepository struct {
+	kv keyValue
+}
+
+// backfillRecord is a backfill as stored, including its cursor
+type backfillRecord struct {
+	SourceID    string    `json:"source_id"`
+	Status      string    `json:"status"`
+	Cursor      string    `json:"cursor,omitempty"`
+	Pages       int       `json:"pages"`
+	Imported    int       `json:"imported"`
+	Progress    float64   `json:"progress"`
+	Error       string    `json:"error,omitempty"`
+	StartedAt   time.Time `json:"started_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// FindBySource returns the backfill of a source, or models.ErrBackfillNotFound
+func (r *BackfillRepository) FindBySource(ctx context.Context, sourceID string) (*models.Backfill, error) {
+	backfill, err := r.find(ctx, stateKey(backfillPrefix, sourceID))
+	if errors.Is(err, errKeyNotFound) {
+		return nil, fmt.Errorf("source %s: %w", sourceID, models.ErrBackfillNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find backfill of %s: %w", sourceID, err)
+	}
+	return backfill, nil
+}
+
+// FindAll returns every backfill, most recently updated first
+func (r *BackfillRepository) FindAll(ctx context.Context) ([]*models.Backfill, error) {
+	keys, err := r.kv.keys(ctx, backfillPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backfills: %w", err)
+	}
+
+	backfills := make([]*models.Backfill, 0, len(keys))
+	for _, key := range keys {
+		backfill, err := r.find(ctx, key)
+		if errors.Is(err, errKeyNotFound) {
+			continue // deleted while listing
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read backfill %s: %w", key, err)
+		}
+		backfills = append(backfills, backfill)
+	}
+
+	sort.Slice(backfills, func(i, j int) bool { return backfills[i].UpdatedAt.After(backfills[j].UpdatedAt) })
+	return backfills, nil
+}
+
+// Save stores a backfill, replacing the previous checkpoint of its source
+func (r *BackfillRepository) Save(ctx context.Context, backfill *models.Backfill) error {
+	data, err := json.Marshal(backfillRecord{
+		SourceID:    backfill.SourceID,
+		Status:      string(backfill.Status),
+		Cursor:      backfill.Cursor,
+		Pages:       backfill.Pages,
+		Imported:    backfill.Imported,
+		Progress:    backfill.Progress,
+		Error:       backfill.Error,
+		StartedAt:   backfill.StartedAt,
+		UpdatedAt:   backfill.UpdatedAt,
+		CompletedAt: backfill.CompletedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode backfill of %s: %w", backfill.SourceID, err)
+	}
+
+	if err := r.kv.put(ctx, stateKey(backfillPrefix, backfill.SourceID), data); err != nil {
+		return fmt.Errorf("failed to save backfill of %s: %w", backfill.SourceID, err)
+	}
+
+	backfill.ID = backfill.SourceID
+	return nil
+}
+
+func (r *BackfillRepository) find(ctx context.Context, key string) (*models.Backfill, error) {
+	data, err := r.kv.get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	var record backfillRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("failed to decode backfill %s: %w", key, err)
+	}
+	return &models.Backfill{
+		ID:          record.SourceID,
+		SourceID:    record.SourceID,
+		Status:      models.BackfillStatus(record.Status),
+		Cursor:      record.Cursor,
+		Pages:       record.Pages,
+		Imported:    record.Imported,
+		Progress:    record.Progress,
+		Error:       record.Error,
+		StartedAt:   record.StartedAt,
+		UpdatedAt:   record.UpdatedAt,
+		CompletedAt: record.CompletedAt,
+	}, nil
+}
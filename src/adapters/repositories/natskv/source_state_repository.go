@@ -0,0 +1,127 @@
+package natskv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// sourceStatePrefix starts the keys of the source states
+const sourceStatePrefix = "source_states."
+
+// SourceStateRepository is a NATS key-value implementation of the SourceStateRepository interface
+type SourceStateRepository struct {
+	kv keyValue
+}
+
+// sourceStateRecord is a source state as stored, including the fields the
+// model keeps out of its JSON
+type sourceStateRecord struct {
+	SourceID      string    `json:"source_id"`
+	Runs          int       `json:"runs"`
+	Failures      int       `json:"failures"`
+	Imported      int       `json:"imported"`
+	LastError     string    `json:"last_error,omitempty"`
+	LastRunAt     time.Time `json:"last_run_at"`
+	LastSuccessAt time.Time `json:"last_success_at"`
+
+	Lifecycle       string    `json:"lifecycle"`
+	LifecycleReason string    `json:"lifecycle_reason,omitempty"`
+	TransitionedAt  time.Time `json:"transitioned_at"`
+
+	ConsentExpiresAt   time.Time `json:"consent_expires_at"`
+	ConsentSession     string    `json:"consent_session,omitempty"`
+	Renewal            string    `json:"renewal,omitempty"`
+	RenewalURL         string    `json:"renewal_url,omitempty"`
+	RenewalToken       string    `json:"renewal_token,omitempty"`
+	RenewalRequestedAt time.Time `json:"renewal_requested_at"`
+}
+
+// FindAll returns the state of every source that has synced
+func (r *SourceStateRepository) FindAll(ctx context.Context) ([]*models.SourceState, error) {
+	keys, err := r.kv.keys(ctx, sourceStatePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list source states: %w", err)
+	}
+
+	states := make([]*models.SourceState, 0, len(keys))
+	for _, key := range keys {
+		data, err := r.kv.get(ctx, key)
+		if errors.Is(err, errKeyNotFound) {
+			continue // deleted while listing
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read source state %s: %w", key, err)
+		}
+		var record sourceStateRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("failed to decode source state %s: %w", key, err)
+		}
+		states = append(states, record.state())
+	}
+
+	sort.Slice(states, func(i, j int) bool { return states[i].SourceID < states[j].SourceID })
+	return states, nil
+}
+
+// Save stores a source state, replacing the previous state of its source
+func (r *SourceStateRepository) Save(ctx context.Context, state *models.SourceState) error {
+	data, err := json.Marshal(sourceStateRecord{
+		SourceID:           state.SourceID,
+		Runs:               state.Runs,
+		Failures:           state.Failures,
+		Imported:           state.Imported,
+		LastError:          state.LastError,
+		LastRunAt:          state.LastRunAt,
+		LastSuccessAt:      state.LastSuccessAt,
+		Lifecycle:          string(state.Lifecycle),
+		LifecycleReason:    state.LifecycleReason,
+		TransitionedAt:     state.TransitionedAt,
+		ConsentExpiresAt:   state.ConsentExpiresAt,
+		ConsentSession:     state.ConsentSession,
+		Renewal:            string(state.Renewal),
+		RenewalURL:         state.RenewalURL,
+		RenewalToken:       state.RenewalToken,
+		RenewalRequestedAt: state.RenewalRequestedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode state of %s: %w", state.SourceID, err)
+	}
+
+	if err := r.kv.put(ctx, stateKey(sourceStatePrefix, state.SourceID), data); err != nil {
+		return fmt.Errorf("failed to save state of %s: %w", state.SourceID, err)
+	}
+
+	// Keys are derived from the source, which identifies the state here
+	state.ID = state.SourceID
+	return nil
+}
+
+func (r sourceStateRecord) state() *models.SourceState {
+	return &models.SourceState{
+		ID:            r.SourceID,
+		SourceID:      r.SourceID,
+		Runs:          r.Runs,
+		Failures:      r.Failures,
+		Imported:      r.Imported,
+		LastError:     r.LastError,
+		LastRunAt:     r.LastRunAt,
+		LastSuccessAt: r.LastSuccessAt,
+
+		Lifecycle:       models.SourceLifecycle(r.Lifecycle),
+		LifecycleReason: r.LifecycleReason,
+		TransitionedAt:  r.TransitionedAt,
+
+		ConsentExpiresAt:   r.ConsentExpiresAt,
+		ConsentSession:     r.ConsentSession,
+		Renewal:            models.ConsentRenewal(r.Renewal),
+		RenewalURL:         r.RenewalURL,
+		RenewalToken:       r.RenewalToken,
+		RenewalRequestedAt: r.RenewalRequestedAt,
+	}
+}
@@ -0,0 +1,120 @@
+// Package natskv stores the sync state of import sources in a NATS JetStream
+// key-value bucket, shared by every replica connected to the server.
+package natskv
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// errKeyNotFound is returned by keyValue.get for keys the bucket does not hold
+var errKeyNotFound = errors.New("key not found")
+
+// keyValue is the subset of a key-value bucket the repositories use
+type keyValue interface {
+	get(ctx context.Context, key string) ([]byte, error)
+	put(ctx context.Context, key string, value []byte) error
+	keys(ctx context.Context, prefix string) ([]string, error)
+}
+
+// Store holds the bucket of the sync state and creates its repositories
+type Store struct {
+	conn *nats.Conn // nil when the store is not backed by a connection
+	kv   keyValue
+}
+
+// NewStore connects to NATS and makes sure the state bucket exists
+func NewStore(ctx context.Context, cfg internal.NATSConfig) (*Store, error) {
+	name := cfg.ResourceName(cfg.StateBucket)
+	conn, err := nats.Connect(cfg.URL, nats.Name(cfg.ClientName("-state")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:      name,
+		Description: "Sync state of the import sources",
+		History:     1,
+		Storage:     jetstream.FileStorage,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create key-value bucket %s: %w", name, err)
+	}
+
+	return &Store{conn: conn, kv: bucket{kv: kv}}, nil
+}
+
+// CreateSourceStateRepository creates a source state repository on the bucket
+func (s *Store) CreateSourceStateRepository() repositories.SourceStateRepository {
+	return &SourceStateRepository{kv: s.kv}
+}
+
+// CreateBackfillRepository creates a backfill repository on the bucket
+func (s *Store) CreateBackfillRepository() repositories.BackfillRepository {
+	return &BackfillRepository{kv: s.kv}
+}
+
+// Close closes the connection
+func (s *Store) Close() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+}
+
+// bucket adapts a jetstream.KeyValue to keyValue
+type bucket struct {
+	kv jetstream.KeyValue
+}
+
+func (b bucket) get(ctx context.Context, key string) ([]byte, error) {
+	entry, err := b.kv.Get(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil, errKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entry.Value(), nil
+}
+
+func (b bucket) put(ctx context.Context, key string, value []byte) error {
+	_, err := b.kv.Put(ctx, key, value)
+	return err
+}
+
+func (b bucket) keys(ctx context.Context, prefix string) ([]string, error) {
+	lister, err := b.kv.ListKeysFiltered(ctx, prefix+">")
+	if errors.Is(err, jetstream.ErrNoKeysFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer lister.Stop()
+
+	var keys []string
+	for key := range lister.Keys() {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// stateKey returns the key of a source in a kind of state. Source IDs hold
+// characters keys may not, e.g. the colon of "ethereum:0xabc".
+func stateKey(prefix, sourceID string) string {
+	return prefix + base64.RawURLEncoding.EncodeToString([]byte(sourceID))
+}
@@ -0,0 +1,111 @@
+package natskv
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// memoryKV is a keyValue in memory
+type memoryKV struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+func (m *memoryKV) get(ctx context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, ok := m.entries[key]
+	if !ok {
+		return nil, errKeyNotFound
+	}
+	return value, nil
+}
+
+func (m *memoryKV) put(ctx context.Context, key string, value []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = value
+	return nil
+}
+
+func (m *memoryKV) keys(ctx context.Context, prefix string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for key := range m.entries {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func newTestStore() *Store {
+	return &Store{kv: &memoryKV{entries: make(map[string][]byte)}}
+}
+
+func TestSourceStateRepository_SaveAndFindAll(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestStore().CreateSourceStateRepository()
+
+	at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	state := &models.SourceState{
+		SourceID:       "solana:abc",
+		Runs:           3,
+		LastSuccessAt:  at,
+		Lifecycle:      models.SourceActive,
+		RenewalToken:   "secret-state",
+		ConsentSession: "session-1",
+	}
+	if err := repo.Save(ctx, state); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	state.Runs = 4
+	if err := repo.Save(ctx, state); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := repo.Save(ctx, &models.SourceState{SourceID: "ethereum:0xabc", Lifecycle: models.SourceAuthorized}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	states, err := repo.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if len(states) != 2 || states[0].SourceID != "ethereum:0xabc" {
+		t.Fatalf("FindAll() = %+v, want both sources sorted by ID", states)
+	}
+	got := states[1]
+	if got.Runs != 4 || !got.LastSuccessAt.Equal(at) || got.RenewalToken != "secret-state" || got.ConsentSession != "session-1" || got.Lifecycle != models.SourceActive {
+		t.Errorf("state = %+v, want the last save with its renewal token and consent session", got)
+	}
+}
+
+func TestBackfillRepository_FindBySource(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestStore().CreateBackfillRepository()
+
+	if _, err := repo.FindBySource(ctx, "enable:acc1"); !errors.Is(err, models.ErrBackfillNotFound) {
+		t.Fatalf("FindBySource() error = %v, want ErrBackfillNotFound", err)
+	}
+
+	backfill := models.NewBackfill("enable:acc1")
+	backfill.Advance(models.TransactionPage{NextCursor: "page-2", Progress: 0.5}, 20)
+	if err := repo.Save(ctx, backfill); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got, err := repo.FindBySource(ctx, "enable:acc1")
+	if err != nil {
+		t.Fatalf("FindBySource() error = %v", err)
+	}
+	if got.Cursor != "page-2" || got.Imported != 20 || got.Status != models.BackfillRunning {
+		t.Errorf("FindBySource() = %+v, want the checkpoint with its cursor", got)
+	}
+}
@@ -0,0 +1,128 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// AccountMappingRepository is a PocketBase implementation of the AccountMappingRepository interface
+type AccountMappingRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewAccountMappingRepository creates a new PocketBase account mapping repository
+func NewAccountMappingRepository(app *pocketbase.PocketBase) *AccountMappingRepository {
+	return &AccountMappingRepository{
+		app: app,
+	}
+}
+
+// FindBySourceAccount finds the mapping for a source account
+func (r *AccountMappingRepository) FindBySourceAccount(ctx context.Context, source, sourceAccount string) (*models.AccountMapping, error) {
+	record := &core.Record{}
+	err := r.app.RecordQuery("account_mappings").
+		AndWhere(dbx.HashExp{"source": source, "source_account": sourceAccount}).
+		Limit(1).
+		One(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account mapping: %w", err)
+	}
+
+	return r.mapRecordToMapping(record), nil
+}
+
+// FindAll finds mappings, optionally restricted to a source
+func (r *AccountMappingRepository) FindAll(ctx context.Context, source string) ([]*models.AccountMapping, error) {
+	query := r.app.RecordQuery("account_mappings")
+	if source != "" {
+		query = query.AndWhere(dbx.HashExp{"source": source})
+	}
+	query = query.OrderBy("source ASC", "source_account ASC")
+
+	records := []*core.Record{}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find account mappings: %w", err)
+	}
+
+	mappings := make([]*models.AccountMapping, 0, len(records))
+	for _, record := range records {
+		mappings = append(mappings, r.mapRecordToMapping(record))
+	}
+
+	return mappings, nil
+}
+
+// Create creates a new mapping
+func (r *AccountMappingRepository) Create(ctx context.Context, mapping *models.AccountMapping) error {
+	collection, err := r.app.FindCollectionByNameOrId("account_mappings")
+	if err != nil {
+		return fmt.Errorf("failed to find account mappings collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	r.updateRecordFromMapping(record, mapping)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to create account mapping: %w", err)
+	}
+
+	mapping.ID = record.Id
+
+	return nil
+}
+
+// Update updates an existing mapping
+func (r *AccountMappingRepository) Update(ctx context.Context, mapping *models.AccountMapping) error {
+	record, err := r.app.FindRecordById("account_mappings", mapping.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find account mapping: %w", err)
+	}
+
+	r.updateRecordFromMapping(record, mapping)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to update account mapping: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a mapping by ID
+func (r *AccountMappingRepository) Delete(ctx context.Context, id string) error {
+	record, err := r.app.FindRecordById("account_mappings", id)
+	if err != nil {
+		return fmt.Errorf("failed to find account mapping: %w", err)
+	}
+
+	if err := r.app.DeleteWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to delete account mapping: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AccountMappingRepository) mapRecordToMapping(record *core.Record) *models.AccountMapping {
+	return &models.AccountMapping{
+		ID:               record.Id,
+		Source:           record.GetString("source"),
+		SourceAccount:    record.GetString("source_account"),
+		FireflyAccountID: record.GetString("firefly_account_id"),
+		IBAN:             record.GetString("iban"),
+		AutoCreate:       record.GetBool("auto_create"),
+		CreatedAt:        record.GetDateTime("created").Time(),
+		UpdatedAt:        record.GetDateTime("updated").Time(),
+	}
+}
+
+func (r *AccountMappingRepository) updateRecordFromMapping(record *core.Record, mapping *models.AccountMapping) {
+	record.Set("source", mapping.Source)
+	record.Set("source_account", mapping.SourceAccount)
+	record.Set("firefly_account_id", mapping.FireflyAccountID)
+	record.Set("iban", models.NormalizeIBAN(mapping.IBAN))
+	record.Set("auto_create", mapping.AutoCreate)
+}
@@ -0,0 +1,25 @@
+package pocketbase
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/ZanzyTHEbar/firedragon-go/pb_migrations" // the app migrations, applied by the test app
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tests"
+)
+
+// testDate is the date of the test transactions
+var testDate = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+// newTestApp returns a test app with every migration applied
+func newTestApp(t *testing.T) *pocketbase.PocketBase {
+	t.Helper()
+	testApp, err := tests.NewTestAppWithConfig(core.BaseAppConfig{DataDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewTestAppWithConfig() error = %v", err)
+	}
+	t.Cleanup(testApp.Cleanup)
+	return &pocketbase.PocketBase{App: testApp}
+}
@@ -0,0 +1,156 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// AuditRepository is a PocketBase implementation of the AuditRepository interface
+type AuditRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewAuditRepository creates a new PocketBase audit log repository
+func NewAuditRepository(app *pocketbase.PocketBase) *AuditRepository {
+	return &AuditRepository{
+		app: app,
+	}
+}
+
+// Append stores a new audit entry
+func (r *AuditRepository) Append(ctx context.Context, entry *models.AuditEntry) error {
+	collection, err := r.app.FindCollectionByNameOrId("audit_log")
+	if err != nil {
+		return fmt.Errorf("failed to find audit_log collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("action", string(entry.Action))
+	record.Set("collection_name", entry.Collection)
+	record.Set("record", entry.RecordID)
+	record.Set("request", entry.Request)
+	record.Set("status", entry.Status)
+	record.Set("actor", entry.ActorID)
+	record.Set("actor_type", string(entry.ActorType))
+	record.Set("source", string(entry.Source))
+	record.Set("changes", entry.Changes)
+	record.Set("at", entry.At)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to append audit entry: %w", err)
+	}
+
+	entry.ID = record.Id
+
+	return nil
+}
+
+// FindAll finds audit entries with optional filters, newest first
+func (r *AuditRepository) FindAll(ctx context.Context, filter repositories.AuditFilter) ([]*models.AuditEntry, error) {
+	query := r.app.RecordQuery("audit_log")
+
+	// Apply filters
+	if filter.Collection != "" {
+		query = query.AndWhere(dbx.HashExp{"collection_name": filter.Collection})
+	}
+
+	if filter.RecordID != "" {
+		query = query.AndWhere(dbx.HashExp{"record": filter.RecordID})
+	}
+
+	if filter.ActorID != "" {
+		query = query.AndWhere(dbx.HashExp{"actor": filter.ActorID})
+	}
+
+	if filter.Action != "" {
+		query = query.AndWhere(dbx.HashExp{"action": string(filter.Action)})
+	}
+
+	if filter.Source != "" {
+		query = query.AndWhere(dbx.HashExp{"source": string(filter.Source)})
+	}
+
+	if !filter.From.IsZero() {
+		query = query.AndWhere(dbx.NewExp("at >= {:from}", dbx.Params{"from": filter.From}))
+	}
+
+	if !filter.To.IsZero() {
+		query = query.AndWhere(dbx.NewExp("at < {:to}", dbx.Params{"to": filter.To}))
+	}
+
+	// The ID breaks ties so offset pagination neither repeats nor skips entries
+	query = query.OrderBy("at DESC", "id DESC")
+
+	// Apply pagination
+	if filter.Limit > 0 {
+		query = query.Limit(int64(filter.Limit))
+	}
+
+	if filter.Offset > 0 {
+		query = query.Offset(int64(filter.Offset))
+	}
+
+	// Execute query
+	records := []*core.Record{}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find audit entries: %w", err)
+	}
+
+	entries := make([]*models.AuditEntry, 0, len(records))
+	for _, record := range records {
+		entry, err := r.mapRecordToEntry(record)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// PurgeBefore deletes the entries recorded before the given time. The rows
+// are deleted directly, as the audit hooks refuse to delete entries one by one.
+func (r *AuditRepository) PurgeBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.app.DB().Delete("audit_log", dbx.NewExp("at < {:before}", dbx.Params{"before": before})).Execute()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge audit entries: %w", err)
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purged audit entries: %w", err)
+	}
+
+	return int(purged), nil
+}
+
+func (r *AuditRepository) mapRecordToEntry(record *core.Record) (*models.AuditEntry, error) {
+	entry := &models.AuditEntry{
+		ID:         record.Id,
+		Action:     models.AuditAction(record.GetString("action")),
+		Collection: record.GetString("collection_name"),
+		RecordID:   record.GetString("record"),
+		Request:    record.GetString("request"),
+		Status:     record.GetInt("status"),
+		At:         record.GetDateTime("at").Time(),
+		AuditOrigin: models.AuditOrigin{
+			ActorID:   record.GetString("actor"),
+			ActorType: models.AuditActorType(record.GetString("actor_type")),
+			Source:    models.AuditSource(record.GetString("source")),
+		},
+	}
+	if record.GetString("changes") == "" {
+		return entry, nil // request entries change no record
+	}
+	if err := record.UnmarshalJSONField("changes", &entry.Changes); err != nil {
+		return nil, fmt.Errorf("failed to decode changes of audit entry %s: %w", record.Id, err)
+	}
+	return entry, nil
+}
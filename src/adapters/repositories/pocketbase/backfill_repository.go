@@ -0,0 +1,115 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// BackfillRepository is a PocketBase implementation of the BackfillRepository interface
+type BackfillRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewBackfillRepository creates a new PocketBase backfill repository
+func NewBackfillRepository(app *pocketbase.PocketBase) *BackfillRepository {
+	return &BackfillRepository{
+		app: app,
+	}
+}
+
+// FindBySource returns the backfill of a source
+func (r *BackfillRepository) FindBySource(ctx context.Context, sourceID string) (*models.Backfill, error) {
+	record, err := r.findBySource(sourceID)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapRecordToBackfill(record), nil
+}
+
+// FindAll returns every backfill, most recently updated first
+func (r *BackfillRepository) FindAll(ctx context.Context) ([]*models.Backfill, error) {
+	records := []*core.Record{}
+	if err := r.app.RecordQuery("backfills").OrderBy("updated_at DESC").All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find backfills: %w", err)
+	}
+
+	backfills := make([]*models.Backfill, 0, len(records))
+	for _, record := range records {
+		backfills = append(backfills, r.mapRecordToBackfill(record))
+	}
+	return backfills, nil
+}
+
+// Save stores a backfill, replacing the previous checkpoint of its source
+func (r *BackfillRepository) Save(ctx context.Context, backfill *models.Backfill) error {
+	record, err := r.findBySource(backfill.SourceID)
+	if errors.Is(err, models.ErrBackfillNotFound) {
+		collection, err := r.app.FindCollectionByNameOrId("backfills")
+		if err != nil {
+			return fmt.Errorf("failed to find backfills collection: %w", err)
+		}
+		record = core.NewRecord(collection)
+		record.Set("source_id", backfill.SourceID)
+	} else if err != nil {
+		return err
+	}
+
+	record.Set("status", string(backfill.Status))
+	record.Set("cursor", backfill.Cursor)
+	record.Set("pages", backfill.Pages)
+	record.Set("imported", backfill.Imported)
+	record.Set("progress", backfill.Progress)
+	record.Set("error", backfill.Error)
+	record.Set("started_at", backfill.StartedAt)
+	record.Set("updated_at", backfill.UpdatedAt)
+	if backfill.CompletedAt.IsZero() {
+		record.Set("completed_at", nil)
+	} else {
+		record.Set("completed_at", backfill.CompletedAt)
+	}
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save backfill of %s: %w", backfill.SourceID, err)
+	}
+
+	backfill.ID = record.Id
+	return nil
+}
+
+func (r *BackfillRepository) findBySource(sourceID string) (*core.Record, error) {
+	record := &core.Record{}
+	err := r.app.RecordQuery("backfills").
+		AndWhere(dbx.HashExp{"source_id": sourceID}).
+		Limit(1).
+		One(record)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("source %s: %w", sourceID, models.ErrBackfillNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find backfill of %s: %w", sourceID, err)
+	}
+	return record, nil
+}
+
+func (r *BackfillRepository) mapRecordToBackfill(record *core.Record) *models.Backfill {
+	return &models.Backfill{
+		ID:          record.Id,
+		SourceID:    record.GetString("source_id"),
+		Status:      models.BackfillStatus(record.GetString("status")),
+		Cursor:      record.GetString("cursor"),
+		Pages:       record.GetInt("pages"),
+		Imported:    record.GetInt("imported"),
+		Progress:    record.GetFloat("progress"),
+		Error:       record.GetString("error"),
+		StartedAt:   record.GetDateTime("started_at").Time(),
+		UpdatedAt:   record.GetDateTime("updated_at").Time(),
+		CompletedAt: record.GetDateTime("completed_at").Time(),
+	}
+}
@@ -0,0 +1,203 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// BalanceAssertionRepository is a PocketBase implementation of the BalanceAssertionRepository interface
+type BalanceAssertionRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewBalanceAssertionRepository creates a new PocketBase balance assertion repository
+func NewBalanceAssertionRepository(app *pocketbase.PocketBase) *BalanceAssertionRepository {
+	return &BalanceAssertionRepository{
+		app: app,
+	}
+}
+
+// FindByID finds a balance assertion by ID
+func (r *BalanceAssertionRepository) FindByID(ctx context.Context, id string) (*models.BalanceAssertion, error) {
+	record, err := r.app.FindRecordById("balance_assertions", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find balance assertion: %w", err)
+	}
+
+	return r.mapRecordToAssertion(record)
+}
+
+// FindAll finds all balance assertions, by name
+func (r *BalanceAssertionRepository) FindAll(ctx context.Context) ([]*models.BalanceAssertion, error) {
+	records := []*core.Record{}
+	if err := r.app.RecordQuery("balance_assertions").OrderBy("name ASC").All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find balance assertions: %w", err)
+	}
+
+	assertions := make([]*models.BalanceAssertion, 0, len(records))
+	for _, record := range records {
+		assertion, err := r.mapRecordToAssertion(record)
+		if err != nil {
+			return nil, err
+		}
+		assertions = append(assertions, assertion)
+	}
+
+	return assertions, nil
+}
+
+// Create stores a new balance assertion
+func (r *BalanceAssertionRepository) Create(ctx context.Context, assertion *models.BalanceAssertion) error {
+	collection, err := r.app.FindCollectionByNameOrId("balance_assertions")
+	if err != nil {
+		return fmt.Errorf("failed to find balance_assertions collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	r.updateRecordFromAssertion(record, assertion)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to create balance assertion: %w", err)
+	}
+
+	assertion.ID = record.Id
+	assertion.CreatedAt = record.GetDateTime("created").Time()
+	assertion.UpdatedAt = record.GetDateTime("updated").Time()
+	return nil
+}
+
+// Update stores the changes of a balance assertion
+func (r *BalanceAssertionRepository) Update(ctx context.Context, assertion *models.BalanceAssertion) error {
+	record, err := r.app.FindRecordById("balance_assertions", assertion.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find balance assertion: %w", err)
+	}
+
+	r.updateRecordFromAssertion(record, assertion)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to update balance assertion: %w", err)
+	}
+
+	assertion.UpdatedAt = record.GetDateTime("updated").Time()
+	return nil
+}
+
+// Delete deletes a balance assertion and, by cascade, its results
+func (r *BalanceAssertionRepository) Delete(ctx context.Context, id string) error {
+	record, err := r.app.FindRecordById("balance_assertions", id)
+	if err != nil {
+		return fmt.Errorf("failed to find balance assertion: %w", err)
+	}
+
+	if err := r.app.DeleteWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to delete balance assertion: %w", err)
+	}
+
+	return nil
+}
+
+// CreateResult stores the result of a check
+func (r *BalanceAssertionRepository) CreateResult(ctx context.Context, result *models.BalanceAssertionResult) error {
+	collection, err := r.app.FindCollectionByNameOrId("balance_assertion_results")
+	if err != nil {
+		return fmt.Errorf("failed to find balance_assertion_results collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("assertion", result.AssertionID)
+	record.Set("wallet", result.WalletID)
+	record.Set("checked_at", result.CheckedAt)
+	record.Set("currency", result.Currency)
+	record.Set("balances", result.Balances)
+	record.Set("drift", result.Drift)
+	record.Set("tolerance", result.Tolerance)
+	record.Set("passed", result.Passed)
+	record.Set("error", result.Error)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to create balance assertion result: %w", err)
+	}
+
+	result.ID = record.Id
+	return nil
+}
+
+// FindResults finds check results with optional filters, most recent first
+func (r *BalanceAssertionRepository) FindResults(ctx context.Context, filter repositories.BalanceAssertionResultFilter) ([]*models.BalanceAssertionResult, error) {
+	query := r.app.RecordQuery("balance_assertion_results")
+
+	// Apply filters
+	if filter.AssertionID != "" {
+		query = query.AndWhere(dbx.HashExp{"assertion": filter.AssertionID})
+	}
+
+	if filter.OnlyFailed {
+		query = query.AndWhere(dbx.HashExp{"passed": false})
+	}
+
+	query = query.OrderBy("checked_at DESC")
+
+	if filter.Limit > 0 {
+		query = query.Limit(int64(filter.Limit))
+	}
+
+	// Execute query
+	records := []*core.Record{}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find balance assertion results: %w", err)
+	}
+
+	results := make([]*models.BalanceAssertionResult, 0, len(records))
+	for _, record := range records {
+		result := &models.BalanceAssertionResult{
+			ID:          record.Id,
+			AssertionID: record.GetString("assertion"),
+			WalletID:    record.GetString("wallet"),
+			CheckedAt:   record.GetDateTime("checked_at").Time(),
+			Currency:    record.GetString("currency"),
+			Drift:       record.GetFloat("drift"),
+			Tolerance:   record.GetFloat("tolerance"),
+			Passed:      record.GetBool("passed"),
+			Error:       record.GetString("error"),
+		}
+		if err := record.UnmarshalJSONField("balances", &result.Balances); err != nil {
+			return nil, fmt.Errorf("failed to decode balances of result %s: %w", record.Id, err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (r *BalanceAssertionRepository) updateRecordFromAssertion(record *core.Record, assertion *models.BalanceAssertion) {
+	record.Set("name", assertion.Name)
+	record.Set("source", assertion.SourceID)
+	record.Set("compare", assertion.Sides())
+	record.Set("schedule", assertion.Schedule)
+	record.Set("tolerance", assertion.Tolerance)
+	record.Set("enabled", assertion.Enabled)
+}
+
+func (r *BalanceAssertionRepository) mapRecordToAssertion(record *core.Record) (*models.BalanceAssertion, error) {
+	assertion := &models.BalanceAssertion{
+		ID:        record.Id,
+		Name:      record.GetString("name"),
+		SourceID:  record.GetString("source"),
+		Schedule:  record.GetString("schedule"),
+		Tolerance: record.GetFloat("tolerance"),
+		Enabled:   record.GetBool("enabled"),
+		CreatedAt: record.GetDateTime("created").Time(),
+		UpdatedAt: record.GetDateTime("updated").Time(),
+	}
+	if err := record.UnmarshalJSONField("compare", &assertion.Compare); err != nil {
+		return nil, fmt.Errorf("failed to decode compared sides of balance assertion %s: %w", record.Id, err)
+	}
+	return assertion, nil
+}
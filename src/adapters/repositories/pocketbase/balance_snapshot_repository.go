@@ -0,0 +1,126 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// BalanceSnapshotRepository is a PocketBase implementation of the BalanceSnapshotRepository interface
+type BalanceSnapshotRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewBalanceSnapshotRepository creates a new PocketBase balance snapshot repository
+func NewBalanceSnapshotRepository(app *pocketbase.PocketBase) *BalanceSnapshotRepository {
+	return &BalanceSnapshotRepository{
+		app: app,
+	}
+}
+
+// Create stores a new balance snapshot
+func (r *BalanceSnapshotRepository) Create(ctx context.Context, snapshot *models.BalanceSnapshot) error {
+	collection, err := r.app.FindCollectionByNameOrId("balance_snapshots")
+	if err != nil {
+		return fmt.Errorf("failed to find balance snapshots collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("wallet", snapshot.WalletID)
+	record.Set("balance", snapshot.Balance)
+	record.Set("currency", snapshot.Currency)
+	record.Set("source", snapshot.Source)
+	record.Set("balance_type", string(snapshot.BalanceType))
+	record.Set("taken_at", snapshot.TakenAt)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to create balance snapshot: %w", err)
+	}
+
+	snapshot.ID = record.Id
+
+	return nil
+}
+
+// FindAll finds snapshots with optional filters, ordered by time ascending
+func (r *BalanceSnapshotRepository) FindAll(ctx context.Context, filter repositories.BalanceSnapshotFilter) ([]*models.BalanceSnapshot, error) {
+	query := r.filterQuery(filter)
+
+	query = query.OrderBy("taken_at ASC")
+
+	if filter.Limit > 0 {
+		query = query.Limit(int64(filter.Limit))
+	}
+
+	// Execute query
+	records := []*core.Record{}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find balance snapshots: %w", err)
+	}
+
+	snapshots := make([]*models.BalanceSnapshot, 0, len(records))
+	for _, record := range records {
+		snapshots = append(snapshots, r.mapRecordToSnapshot(record))
+	}
+
+	return snapshots, nil
+}
+
+// FindLatest finds the most recent snapshot matching the filter, taken at or before filter.To
+func (r *BalanceSnapshotRepository) FindLatest(ctx context.Context, filter repositories.BalanceSnapshotFilter) (*models.BalanceSnapshot, error) {
+	record := &core.Record{}
+	err := r.filterQuery(filter).
+		OrderBy("taken_at DESC").
+		Limit(1).
+		One(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find latest balance snapshot: %w", err)
+	}
+
+	return r.mapRecordToSnapshot(record), nil
+}
+
+// filterQuery selects the snapshots matching the filter
+func (r *BalanceSnapshotRepository) filterQuery(filter repositories.BalanceSnapshotFilter) *dbx.SelectQuery {
+	query := r.app.RecordQuery("balance_snapshots")
+
+	// Apply filters
+	if filter.WalletID != "" {
+		query = query.AndWhere(dbx.HashExp{"wallet": filter.WalletID})
+	}
+
+	if filter.Source != "" {
+		query = query.AndWhere(dbx.HashExp{"source": filter.Source})
+	}
+
+	if filter.BalanceType != "" {
+		query = query.AndWhere(dbx.HashExp{"balance_type": string(filter.BalanceType)})
+	}
+
+	if !filter.From.IsZero() {
+		query = query.AndWhere(dbx.NewExp("taken_at >= {:from}", dbx.Params{"from": filter.From}))
+	}
+
+	if !filter.To.IsZero() {
+		query = query.AndWhere(dbx.NewExp("taken_at <= {:to}", dbx.Params{"to": filter.To}))
+	}
+
+	return query
+}
+
+func (r *BalanceSnapshotRepository) mapRecordToSnapshot(record *core.Record) *models.BalanceSnapshot {
+	return &models.BalanceSnapshot{
+		ID:          record.Id,
+		WalletID:    record.GetString("wallet"),
+		Balance:     record.GetFloat("balance"),
+		Currency:    record.GetString("currency"),
+		Source:      record.GetString("source"),
+		BalanceType: models.BalanceType(record.GetString("balance_type")),
+		TakenAt:     record.GetDateTime("taken_at").Time(),
+	}
+}
@@ -0,0 +1,93 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// CalendarFeedRepository is a PocketBase implementation of the CalendarFeedRepository interface
+type CalendarFeedRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewCalendarFeedRepository creates a new PocketBase calendar feed repository
+func NewCalendarFeedRepository(app *pocketbase.PocketBase) *CalendarFeedRepository {
+	return &CalendarFeedRepository{
+		app: app,
+	}
+}
+
+// FindByTokenHash finds the feed of a token hash
+func (r *CalendarFeedRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*models.CalendarFeed, error) {
+	record, err := r.findBy("token_hash", tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	return mapRecordToCalendarFeed(record), nil
+}
+
+// Save stores the feed of a user, replacing the token of an existing one
+func (r *CalendarFeedRepository) Save(ctx context.Context, feed *models.CalendarFeed) error {
+	record, err := r.findBy("user", feed.UserID)
+	if errors.Is(err, models.ErrCalendarFeedNotFound) {
+		collection, err := r.app.FindCollectionByNameOrId("calendar_feeds")
+		if err != nil {
+			return fmt.Errorf("failed to find calendar_feeds collection: %w", err)
+		}
+		record = core.NewRecord(collection)
+		record.Set("user", feed.UserID)
+	} else if err != nil {
+		return err
+	}
+	record.Set("token_hash", feed.TokenHash)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+	*feed = *mapRecordToCalendarFeed(record)
+	return nil
+}
+
+// DeleteByUser deletes the feed of a user
+func (r *CalendarFeedRepository) DeleteByUser(ctx context.Context, userID string) error {
+	record, err := r.findBy("user", userID)
+	if err != nil {
+		return err
+	}
+	if err := r.app.DeleteWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+	return nil
+}
+
+func (r *CalendarFeedRepository) findBy(field, value string) (*core.Record, error) {
+	record := &core.Record{}
+	err := r.app.RecordQuery("calendar_feeds").
+		AndWhere(dbx.HashExp{field: value}).
+		Limit(1).
+		One(record)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrCalendarFeedNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find calendar feed: %w", err)
+	}
+	return record, nil
+}
+
+func mapRecordToCalendarFeed(record *core.Record) *models.CalendarFeed {
+	return &models.CalendarFeed{
+		ID:        record.Id,
+		UserID:    record.GetString("user"),
+		TokenHash: record.GetString("token_hash"),
+		CreatedAt: record.GetDateTime("created").Time(),
+		UpdatedAt: record.GetDateTime("updated").Time(),
+	}
+}
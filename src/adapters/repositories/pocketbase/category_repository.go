@@ -39,6 +39,10 @@ func (r *CategoryRepository) FindAll(ctx context.Context, filter repositories.Ca
 	query := r.app.RecordQuery("categories") // Use r.app directly
 
 	// Apply filters
+	if len(filter.IDs) > 0 {
+		query = query.AndWhere(dbx.In("id", stringValues(filter.IDs)...))
+	}
+
 	if filter.Type != "" {
 		query = query.AndWhere(dbx.HashExp{"type": string(filter.Type)})
 	}
@@ -51,6 +55,18 @@ func (r *CategoryRepository) FindAll(ctx context.Context, filter repositories.Ca
 		query = query.AndWhere(dbx.HashExp{"is_system": *filter.IsSystem})
 	}
 
+	if filter.SpaceID != "" {
+		query = query.AndWhere(dbx.HashExp{"space": filter.SpaceID})
+	}
+
+	if filter.VisibleTo != "" {
+		query = query.AndWhere(visibleSpaceExp("space", filter.VisibleTo))
+	}
+
+	if filter.Key != "" {
+		query = query.AndWhere(dbx.HashExp{"key": filter.Key})
+	}
+
 	// Apply sorting
 	if filter.SortBy != "" {
 		direction := "ASC"
@@ -95,7 +111,7 @@ func (r *CategoryRepository) FindAll(ctx context.Context, filter repositories.Ca
 func (r *CategoryRepository) Create(ctx context.Context, category *models.Category) error {
 	record := r.mapCategoryToRecord(category)
 
-	if err := r.app.Save(record); err != nil { // Use r.app directly
+	if err := r.app.SaveWithContext(ctx, record); err != nil { // Use r.app directly
 		return fmt.Errorf("failed to create category: %w", err)
 	}
 
@@ -105,7 +121,8 @@ func (r *CategoryRepository) Create(ctx context.Context, category *models.Catego
 	return nil
 }
 
-// Update updates an existing category
+// Update updates an existing category.
+// It returns models.ErrConflict if the category changed since it was read.
 func (r *CategoryRepository) Update(ctx context.Context, category *models.Category) error {
 	// Check if category exists
 	record, err := r.app.FindRecordById("categories", category.ID) // Use r.app directly
@@ -119,12 +136,15 @@ func (r *CategoryRepository) Update(ctx context.Context, category *models.Catego
 	}
 
 	// Update fields
-	record = r.updateRecordFromCategory(record, category)
-
-	if err := r.app.Save(record); err != nil { // Use r.app directly
+	version, err := saveVersioned(ctx, r.app, "categories", category.ID, category.Version, func(record *core.Record) {
+		r.updateRecordFromCategory(record, category)
+	})
+	if err != nil {
 		return fmt.Errorf("failed to update category: %w", err)
 	}
 
+	category.Version = version
+
 	return nil
 }
 
@@ -153,7 +173,7 @@ func (r *CategoryRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	// If no transactions reference this category, delete it
-	if err := r.app.Delete(record); err != nil { // Use r.app directly
+	if err := r.app.DeleteWithContext(ctx, record); err != nil { // Use r.app directly
 		return fmt.Errorf("failed to delete category: %w", err)
 	}
 
@@ -220,6 +240,10 @@ func (r *CategoryRepository) mapRecordToCategory(record *core.Record) (*models.C
 		Type:        models.CategoryType(record.GetString("type")),
 		Color:       record.GetString("color"),
 		IsSystem:    record.GetBool("is_system"),
+		Key:         record.GetString("key"),
+		ParentID:    record.GetString("parent"),
+		SpaceID:     record.GetString("space"),
+		Version:     record.GetInt("version"),
 		CreatedAt:   record.GetDateTime("created").Time(),
 		UpdatedAt:   record.GetDateTime("updated").Time(),
 	}
@@ -236,7 +260,11 @@ func (r *CategoryRepository) mapCategoryToRecord(category *models.Category) *cor
 	record.Set("description", category.Description)
 	record.Set("type", string(category.Type))
 	record.Set("color", category.Color)
+	record.Set("space", category.SpaceID)
 	record.Set("is_system", category.IsSystem)
+	record.Set("key", category.Key)
+	record.Set("parent", category.ParentID)
+	record.Set("version", 1)
 
 	// Set ID if specified
 	if category.ID != "" {
@@ -258,7 +286,9 @@ func (r *CategoryRepository) updateRecordFromCategory(record *core.Record, categ
 	record.Set("description", category.Description)
 	record.Set("type", string(category.Type))
 	record.Set("color", category.Color)
-	// Don't update is_system flag from regular updates
+	record.Set("space", category.SpaceID)
+	record.Set("parent", category.ParentID)
+	// Don't update is_system flag or key from regular updates
 
 	return record
 }
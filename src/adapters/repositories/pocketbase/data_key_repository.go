@@ -0,0 +1,190 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/security"
+)
+
+// DataKeyRepository is a PocketBase implementation of the DataKeyRepository interface.
+// Data keys are AES-256-GCM wrapped with the configured 32 byte master key.
+type DataKeyRepository struct {
+	app       *pocketbase.PocketBase
+	masterKey string
+}
+
+// NewDataKeyRepository creates a new PocketBase data key repository
+func NewDataKeyRepository(app *pocketbase.PocketBase, masterKey string) *DataKeyRepository {
+	return &DataKeyRepository{
+		app:       app,
+		masterKey: masterKey,
+	}
+}
+
+// FindByID finds a data key by ID
+func (r *DataKeyRepository) FindByID(ctx context.Context, id string) (*models.DataKey, error) {
+	record, err := r.app.FindRecordById("data_keys", id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("data key %s: %w", id, models.ErrDataKeyNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find data key %s: %w", id, err)
+	}
+
+	return r.mapRecordToDataKey(record)
+}
+
+// FindActive finds the active data key of a space
+func (r *DataKeyRepository) FindActive(ctx context.Context, spaceID string) (*models.DataKey, error) {
+	record := &core.Record{}
+	err := r.app.RecordQuery("data_keys").
+		AndWhere(dbx.HashExp{"space": spaceID, "active": true}).
+		OrderBy("created DESC").
+		Limit(1).
+		One(record)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("active data key of space %q: %w", spaceID, models.ErrDataKeyNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active data key: %w", err)
+	}
+
+	return r.mapRecordToDataKey(record)
+}
+
+// FindAll finds every data key, oldest first
+func (r *DataKeyRepository) FindAll(ctx context.Context) ([]*models.DataKey, error) {
+	records := []*core.Record{}
+	if err := r.app.RecordQuery("data_keys").OrderBy("created ASC").All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find data keys: %w", err)
+	}
+
+	keys := make([]*models.DataKey, 0, len(records))
+	for _, record := range records {
+		key, err := r.mapRecordToDataKey(record)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// Create wraps and stores a new active data key, deactivating the previous
+// active key of its space in the same DB transaction
+func (r *DataKeyRepository) Create(ctx context.Context, key *models.DataKey) error {
+	if r.masterKey == "" {
+		return models.ErrMissingMasterKey
+	}
+
+	wrapped, err := security.Encrypt(key.Key, r.masterKey)
+	if err != nil {
+		return fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	var record *core.Record
+	err = r.app.RunInTransaction(func(txApp core.App) error {
+		previous := []*core.Record{}
+		err := txApp.RecordQuery("data_keys").
+			AndWhere(dbx.HashExp{"space": key.SpaceID, "active": true}).
+			All(&previous)
+		if err != nil {
+			return fmt.Errorf("failed to find active data keys: %w", err)
+		}
+		for _, old := range previous {
+			old.Set("active", false)
+			if err := txApp.SaveWithContext(ctx, old); err != nil {
+				return fmt.Errorf("failed to deactivate data key %s: %w", old.Id, err)
+			}
+		}
+
+		collection, err := txApp.FindCollectionByNameOrId("data_keys")
+		if err != nil {
+			return fmt.Errorf("failed to find data keys collection: %w", err)
+		}
+		record = core.NewRecord(collection)
+		record.Set("space", key.SpaceID)
+		record.Set("key", wrapped)
+		record.Set("active", true)
+
+		if err := txApp.SaveWithContext(ctx, record); err != nil {
+			return fmt.Errorf("failed to save data key: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create data key: %w", err)
+	}
+
+	key.ID = record.Id
+	key.Active = true
+	key.CreatedAt = record.GetDateTime("created").Time()
+
+	return nil
+}
+
+// Rewrap wraps every data key with a new master key in one DB transaction.
+// Later calls use the new master key.
+func (r *DataKeyRepository) Rewrap(ctx context.Context, masterKey string) (int, error) {
+	if r.masterKey == "" {
+		return 0, models.ErrMissingMasterKey
+	}
+
+	records := []*core.Record{}
+	if err := r.app.RecordQuery("data_keys").All(&records); err != nil {
+		return 0, fmt.Errorf("failed to find data keys: %w", err)
+	}
+
+	err := r.app.RunInTransaction(func(txApp core.App) error {
+		for _, record := range records {
+			key, err := security.Decrypt(record.GetString("key"), r.masterKey)
+			if err != nil {
+				return fmt.Errorf("failed to unwrap data key %s: %w", record.Id, err)
+			}
+			wrapped, err := security.Encrypt(key, masterKey)
+			if err != nil {
+				return fmt.Errorf("failed to wrap data key %s: %w", record.Id, err)
+			}
+
+			record.Set("key", wrapped)
+			if err := txApp.SaveWithContext(ctx, record); err != nil {
+				return fmt.Errorf("failed to save data key %s: %w", record.Id, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to rewrap data keys: %w", err)
+	}
+
+	r.masterKey = masterKey
+
+	return len(records), nil
+}
+
+func (r *DataKeyRepository) mapRecordToDataKey(record *core.Record) (*models.DataKey, error) {
+	if r.masterKey == "" {
+		return nil, models.ErrMissingMasterKey
+	}
+
+	key, err := security.Decrypt(record.GetString("key"), r.masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key %s: %w", record.Id, err)
+	}
+
+	return &models.DataKey{
+		ID:        record.Id,
+		SpaceID:   record.GetString("space"),
+		Key:       key,
+		Active:    record.GetBool("active"),
+		CreatedAt: record.GetDateTime("created").Time(),
+	}, nil
+}
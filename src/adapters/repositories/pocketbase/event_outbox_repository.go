@@ -0,0 +1,105 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// EventOutboxRepository is a PocketBase implementation of the EventOutboxRepository interface
+type EventOutboxRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewEventOutboxRepository creates a new PocketBase event outbox repository
+func NewEventOutboxRepository(app *pocketbase.PocketBase) *EventOutboxRepository {
+	return &EventOutboxRepository{
+		app: app,
+	}
+}
+
+// FindUnpublished returns events not published yet, in the order they were stored
+func (r *EventOutboxRepository) FindUnpublished(ctx context.Context, limit int) ([]*models.OutboxEvent, error) {
+	records := []*core.Record{}
+	query := r.app.RecordQuery("event_outbox").
+		AndWhere(dbx.HashExp{"published_at": ""}).
+		OrderBy("created ASC", "rowid ASC")
+	if limit > 0 {
+		query = query.Limit(int64(limit))
+	}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find unpublished events: %w", err)
+	}
+
+	events := make([]*models.OutboxEvent, 0, len(records))
+	for _, record := range records {
+		events = append(events, r.mapRecordToEvent(record))
+	}
+	return events, nil
+}
+
+// MarkPublished records that an event has been published
+func (r *EventOutboxRepository) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	record, err := r.app.FindRecordById("event_outbox", id)
+	if err != nil {
+		return fmt.Errorf("failed to find outbox event %s: %w", id, err)
+	}
+
+	record.Set("published_at", at)
+	record.Set("last_error", "")
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to mark outbox event %s published: %w", id, err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed publish attempt
+func (r *EventOutboxRepository) MarkFailed(ctx context.Context, id string, cause error) error {
+	record, err := r.app.FindRecordById("event_outbox", id)
+	if err != nil {
+		return fmt.Errorf("failed to find outbox event %s: %w", id, err)
+	}
+
+	record.Set("attempts", record.GetInt("attempts")+1)
+	record.Set("last_error", cause.Error())
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to update outbox event %s: %w", id, err)
+	}
+	return nil
+}
+
+// PurgePublished deletes the events published before the given time. The rows
+// are deleted directly, as published events have no hooks to run.
+func (r *EventOutboxRepository) PurgePublished(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.app.DB().Delete("event_outbox", dbx.And(
+		dbx.NewExp("published_at != ''"),
+		dbx.NewExp("published_at < {:before}", dbx.Params{"before": before}),
+	)).Execute()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge published events: %w", err)
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purged events: %w", err)
+	}
+	return int(purged), nil
+}
+
+func (r *EventOutboxRepository) mapRecordToEvent(record *core.Record) *models.OutboxEvent {
+	return &models.OutboxEvent{
+		ID:          record.Id,
+		EventID:     record.GetString("event_id"),
+		Type:        record.GetString("type"),
+		Payload:     []byte(record.GetString("payload")),
+		Attempts:    record.GetInt("attempts"),
+		LastError:   record.GetString("last_error"),
+		CreatedAt:   record.GetDateTime("created").Time(),
+		PublishedAt: record.GetDateTime("published_at").Time(),
+	}
+}
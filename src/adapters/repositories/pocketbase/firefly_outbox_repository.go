@@ -0,0 +1,150 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// FireflyOutboxRepository is a PocketBase implementation of the FireflyOutboxRepository interface
+type FireflyOutboxRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewFireflyOutboxRepository creates a new PocketBase Firefly outbox repository
+func NewFireflyOutboxRepository(app *pocketbase.PocketBase) *FireflyOutboxRepository {
+	return &FireflyOutboxRepository{
+		app: app,
+	}
+}
+
+// Enqueue stores new entries, skipping transactions that are already queued
+func (r *FireflyOutboxRepository) Enqueue(ctx context.Context, entries []*models.FireflyOutboxEntry) (int, error) {
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId("firefly_outbox")
+	if err != nil {
+		return 0, fmt.Errorf("failed to find firefly_outbox collection: %w", err)
+	}
+
+	ids := make([]any, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.TransactionID)
+	}
+	existing := []struct {
+		Transaction string `db:"transaction"`
+	}{}
+	if err := r.app.DB().
+		Select("transaction").
+		From("firefly_outbox").
+		Where(dbx.In("transaction", ids...)).
+		All(&existing); err != nil {
+		return 0, fmt.Errorf("failed to find queued transactions: %w", err)
+	}
+	queued := make(map[string]bool, len(existing))
+	for _, row := range existing {
+		queued[row.Transaction] = true
+	}
+
+	created := 0
+	err = r.app.RunInTransaction(func(txApp core.App) error {
+		for _, entry := range entries {
+			if queued[entry.TransactionID] {
+				continue
+			}
+			queued[entry.TransactionID] = true
+
+			record := core.NewRecord(collection)
+			record.Set("transaction", entry.TransactionID)
+			r.updateRecordFromEntry(record, entry)
+			if err := txApp.SaveWithContext(ctx, record); err != nil {
+				return fmt.Errorf("failed to queue transaction %s: %w", entry.TransactionID, err)
+			}
+			entry.ID = record.Id
+			created++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return created, nil
+}
+
+// FindDue returns pending entries whose next attempt is due, oldest first
+func (r *FireflyOutboxRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*models.FireflyOutboxEntry, error) {
+	records := []*core.Record{}
+	query := r.app.RecordQuery("firefly_outbox").
+		AndWhere(dbx.HashExp{"status": string(models.FireflyOutboxPending)}).
+		AndWhere(dbx.NewExp("next_attempt_at <= {:due}", dbx.Params{"due": now.UTC()})).
+		OrderBy("created ASC")
+	if limit > 0 {
+		query = query.Limit(int64(limit))
+	}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find due outbox entries: %w", err)
+	}
+
+	entries := make([]*models.FireflyOutboxEntry, 0, len(records))
+	for _, record := range records {
+		entries = append(entries, r.mapRecordToEntry(record))
+	}
+	return entries, nil
+}
+
+// Update stores the delivery state of an entry
+func (r *FireflyOutboxRepository) Update(ctx context.Context, entry *models.FireflyOutboxEntry) error {
+	record, err := r.app.FindRecordById("firefly_outbox", entry.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find outbox entry %s: %w", entry.ID, err)
+	}
+
+	r.updateRecordFromEntry(record, entry)
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to update outbox entry %s: %w", entry.ID, err)
+	}
+	return nil
+}
+
+// CountPending returns the number of entries not delivered yet
+func (r *FireflyOutboxRepository) CountPending(ctx context.Context) (int, error) {
+	count, err := r.app.CountRecords("firefly_outbox", dbx.HashExp{"status": string(models.FireflyOutboxPending)})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending outbox entries: %w", err)
+	}
+	return int(count), nil
+}
+
+func (r *FireflyOutboxRepository) updateRecordFromEntry(record *core.Record, entry *models.FireflyOutboxEntry) {
+	record.Set("status", string(entry.Status))
+	record.Set("attempts", entry.Attempts)
+	record.Set("last_error", entry.LastError)
+	record.Set("firefly_id", entry.FireflyID)
+	record.Set("next_attempt_at", entry.NextAttemptAt)
+	if entry.DeliveredAt.IsZero() {
+		record.Set("delivered_at", nil)
+	} else {
+		record.Set("delivered_at", entry.DeliveredAt)
+	}
+}
+
+func (r *FireflyOutboxRepository) mapRecordToEntry(record *core.Record) *models.FireflyOutboxEntry {
+	return &models.FireflyOutboxEntry{
+		ID:            record.Id,
+		TransactionID: record.GetString("transaction"),
+		Status:        models.FireflyOutboxStatus(record.GetString("status")),
+		Attempts:      record.GetInt("attempts"),
+		LastError:     record.GetString("last_error"),
+		FireflyID:     record.GetString("firefly_id"),
+		NextAttemptAt: record.GetDateTime("next_attempt_at").Time(),
+		DeliveredAt:   record.GetDateTime("delivered_at").Time(),
+		CreatedAt:     record.GetDateTime("created").Time(),
+	}
+}
@@ -0,0 +1,86 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// ImportRunRepository is a PocketBase implementation of the ImportRunRepository interface
+type ImportRunRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewImportRunRepository creates a new PocketBase import run repository
+func NewImportRunRepository(app *pocketbase.PocketBase) *ImportRunRepository {
+	return &ImportRunRepository{
+		app: app,
+	}
+}
+
+// Create stores the report of a finished import cycle
+func (r *ImportRunRepository) Create(ctx context.Context, report *models.ImportCycleReport) error {
+	collection, err := r.app.FindCollectionByNameOrId("import_runs")
+	if err != nil {
+		return fmt.Errorf("failed to find import_runs collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("cycle_id", report.CycleID)
+	record.Set("started_at", report.StartedAt)
+	record.Set("finished_at", report.FinishedAt)
+	record.Set("imported", report.Imported)
+	record.Set("failed", report.Failed)
+	record.Set("snapshots", report.Snapshots)
+	record.Set("sources", report.Sources)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to create import run: %w", err)
+	}
+
+	report.ID = record.Id
+
+	return nil
+}
+
+// FindRecent returns the most recent reports, newest first
+func (r *ImportRunRepository) FindRecent(ctx context.Context, limit int) ([]*models.ImportCycleReport, error) {
+	query := r.app.RecordQuery("import_runs").OrderBy("started_at DESC")
+	if limit > 0 {
+		query = query.Limit(int64(limit))
+	}
+
+	records := []*core.Record{}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find import runs: %w", err)
+	}
+
+	reports := make([]*models.ImportCycleReport, 0, len(records))
+	for _, record := range records {
+		report, err := r.mapRecordToReport(record)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (r *ImportRunRepository) mapRecordToReport(record *core.Record) (*models.ImportCycleReport, error) {
+	report := &models.ImportCycleReport{
+		ID:         record.Id,
+		CycleID:    record.GetString("cycle_id"),
+		StartedAt:  record.GetDateTime("started_at").Time(),
+		FinishedAt: record.GetDateTime("finished_at").Time(),
+		Imported:   record.GetInt("imported"),
+		Failed:     record.GetInt("failed"),
+		Snapshots:  record.GetInt("snapshots"),
+	}
+	if err := record.UnmarshalJSONField("sources", &report.Sources); err != nil {
+		return nil, fmt.Errorf("failed to decode sources of import run %s: %w", record.Id, err)
+	}
+	return report, nil
+}
@@ -0,0 +1,125 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// IncidentRepository is a PocketBase implementation of the IncidentRepository interface
+type IncidentRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewIncidentRepository creates a new PocketBase incident repository
+func NewIncidentRepository(app *pocketbase.PocketBase) *IncidentRepository {
+	return &IncidentRepository{
+		app: app,
+	}
+}
+
+// Create stores a new incident
+func (r *IncidentRepository) Create(ctx context.Context, incident *models.Incident) error {
+	collection, err := r.app.FindCollectionByNameOrId("incidents")
+	if err != nil {
+		return fmt.Errorf("failed to find incidents collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("provider", incident.Provider)
+	record.Set("error_class", incident.ErrorClass)
+	record.Set("started_at", incident.StartedAt)
+	r.setProgress(record, incident)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to create incident: %w", err)
+	}
+
+	incident.ID = record.Id
+
+	return nil
+}
+
+// Update stores the failure count, last error and end of an incident
+func (r *IncidentRepository) Update(ctx context.Context, incident *models.Incident) error {
+	record, err := r.app.FindRecordById("incidents", incident.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find incident: %w", err)
+	}
+
+	r.setProgress(record, incident)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to update incident: %w", err)
+	}
+
+	return nil
+}
+
+// FindAll finds incidents with optional filters, most recent first
+func (r *IncidentRepository) FindAll(ctx context.Context, filter repositories.IncidentFilter) ([]*models.Incident, error) {
+	query := r.app.RecordQuery("incidents")
+
+	// Apply filters
+	if filter.Provider != "" {
+		query = query.AndWhere(dbx.HashExp{"provider": filter.Provider})
+	}
+
+	if filter.OnlyOpen {
+		query = query.AndWhere(dbx.NewExp("(ended_at IS NULL OR ended_at = '')"))
+	}
+
+	if !filter.From.IsZero() {
+		query = query.AndWhere(dbx.NewExp("(started_at >= {:from} OR ended_at IS NULL OR ended_at = '' OR ended_at >= {:from})", dbx.Params{"from": filter.From}))
+	}
+
+	if !filter.To.IsZero() {
+		query = query.AndWhere(dbx.NewExp("started_at <= {:to}", dbx.Params{"to": filter.To}))
+	}
+
+	query = query.OrderBy("started_at DESC")
+
+	if filter.Limit > 0 {
+		query = query.Limit(int64(filter.Limit))
+	}
+
+	// Execute query
+	records := []*core.Record{}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find incidents: %w", err)
+	}
+
+	incidents := make([]*models.Incident, 0, len(records))
+	for _, record := range records {
+		incidents = append(incidents, r.mapRecordToIncident(record))
+	}
+
+	return incidents, nil
+}
+
+func (r *IncidentRepository) setProgress(record *core.Record, incident *models.Incident) {
+	record.Set("last_error", incident.LastError)
+	record.Set("failures", incident.Failures)
+	if incident.IsOpen() {
+		record.Set("ended_at", nil)
+	} else {
+		record.Set("ended_at", incident.EndedAt)
+	}
+}
+
+func (r *IncidentRepository) mapRecordToIncident(record *core.Record) *models.Incident {
+	return &models.Incident{
+		ID:         record.Id,
+		Provider:   record.GetString("provider"),
+		ErrorClass: record.GetString("error_class"),
+		LastError:  record.GetString("last_error"),
+		Failures:   record.GetInt("failures"),
+		StartedAt:  record.GetDateTime("started_at").Time(),
+		EndedAt:    record.GetDateTime("ended_at").Time(),
+	}
+}
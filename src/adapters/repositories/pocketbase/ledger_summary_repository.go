@@ -0,0 +1,119 @@
+package pocketbase
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// LedgerSummaryRepository is a PocketBase implementation of the LedgerSummaryRepository interface
+type LedgerSummaryRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewLedgerSummaryRepository creates a new PocketBase import ledger summary repository
+func NewLedgerSummaryRepository(app *pocketbase.PocketBase) *LedgerSummaryRepository {
+	return &LedgerSummaryRepository{
+		app: app,
+	}
+}
+
+// CreateMany stores the summaries of a compaction run in one transaction.
+// Filters are stored base64 encoded.
+func (r *LedgerSummaryRepository) CreateMany(ctx context.Context, summaries []*models.LedgerSummary) error {
+	if len(summaries) == 0 {
+		return nil
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId("ledger_summaries")
+	if err != nil {
+		return fmt.Errorf("failed to find ledger_summaries collection: %w", err)
+	}
+
+	return r.app.RunInTransaction(func(txApp core.App) error {
+		for _, summary := range summaries {
+			filter, err := summary.Filter.MarshalBinary()
+			if err != nil {
+				return fmt.Errorf("failed to encode ledger summary of wallet %s: %w", summary.WalletID, err)
+			}
+
+			record := core.NewRecord(collection)
+			record.Set("wallet", summary.WalletID)
+			record.Set("from", summary.From)
+			record.Set("to", summary.To)
+			record.Set("count", summary.Count)
+			record.Set("filter", base64.StdEncoding.EncodeToString(filter))
+
+			if err := txApp.SaveWithContext(ctx, record); err != nil {
+				return fmt.Errorf("failed to save ledger summary of wallet %s: %w", summary.WalletID, err)
+			}
+			summary.ID = record.Id
+			summary.CreatedAt = record.GetDateTime("created").Time()
+		}
+		return nil
+	})
+}
+
+// FindByWallet returns the summaries of a wallet, oldest range first
+func (r *LedgerSummaryRepository) FindByWallet(ctx context.Context, walletID string) ([]*models.LedgerSummary, error) {
+	records := []*core.Record{}
+	err := r.app.RecordQuery("ledger_summaries").
+		AndWhere(dbx.HashExp{"wallet": walletID}).
+		OrderBy("[[from]] ASC", "created ASC").
+		All(&records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ledger summaries of wallet %s: %w", walletID, err)
+	}
+
+	summaries := make([]*models.LedgerSummary, 0, len(records))
+	for _, record := range records {
+		summary, err := r.mapRecordToSummary(record)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// Stats returns how many summaries are stored and how many IDs they hold
+func (r *LedgerSummaryRepository) Stats(ctx context.Context) (int, int, error) {
+	var row struct {
+		Summaries int `db:"summaries"`
+		IDs       int `db:"ids"`
+	}
+	err := r.app.DB().
+		Select("COUNT(*) AS summaries", "COALESCE(SUM([[count]]), 0) AS ids").
+		From("ledger_summaries").
+		One(&row)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count ledger summaries: %w", err)
+	}
+	return row.Summaries, row.IDs, nil
+}
+
+func (r *LedgerSummaryRepository) mapRecordToSummary(record *core.Record) (*models.LedgerSummary, error) {
+	data, err := base64.StdEncoding.DecodeString(record.GetString("filter"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode ledger summary %s: %w", record.Id, err)
+	}
+	filter := &models.BloomFilter{}
+	if err := filter.UnmarshalBinary(data); err != nil {
+		return nil, fmt.Errorf("failed to decode ledger summary %s: %w", record.Id, err)
+	}
+
+	return &models.LedgerSummary{
+		ID:        record.Id,
+		WalletID:  record.GetString("wallet"),
+		From:      record.GetDateTime("from").Time(),
+		To:        record.GetDateTime("to").Time(),
+		Count:     record.GetInt("count"),
+		Filter:    filter,
+		CreatedAt: record.GetDateTime("created").Time(),
+	}, nil
+}
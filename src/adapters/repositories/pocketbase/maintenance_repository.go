@@ -0,0 +1,106 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// MaintenanceRepository is a PocketBase implementation of the MaintenanceRepository interface
+type MaintenanceRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewMaintenanceRepository creates a new PocketBase maintenance repository
+func NewMaintenanceRepository(app *pocketbase.PocketBase) *MaintenanceRepository {
+	return &MaintenanceRepository{
+		app: app,
+	}
+}
+
+// FindAll finds the maintenance switches that are on
+func (r *MaintenanceRepository) FindAll(ctx context.Context) ([]*models.Maintenance, error) {
+	records := []*core.Record{}
+	if err := r.app.RecordQuery("maintenance").OrderBy("space ASC").All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find maintenance switches: %w", err)
+	}
+
+	switches := make([]*models.Maintenance, 0, len(records))
+	for _, record := range records {
+		switches = append(switches, &models.Maintenance{
+			ID:        record.Id,
+			SpaceID:   record.GetString("space"),
+			Reason:    record.GetString("reason"),
+			StartedBy: record.GetString("started_by"),
+			StartedAt: record.GetDateTime("started_at").Time(),
+		})
+	}
+
+	return switches, nil
+}
+
+// Save turns on the maintenance switch of its space, replacing the reason
+// when it is already on
+func (r *MaintenanceRepository) Save(ctx context.Context, maintenance *models.Maintenance) error {
+	record, err := r.find(maintenance.SpaceID)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		collection, err := r.app.FindCollectionByNameOrId("maintenance")
+		if err != nil {
+			return fmt.Errorf("failed to find maintenance collection: %w", err)
+		}
+		record = core.NewRecord(collection)
+		record.Set("space", maintenance.SpaceID)
+		record.Set("started_at", maintenance.StartedAt)
+		record.Set("started_by", maintenance.StartedBy)
+	}
+	record.Set("reason", maintenance.Reason)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to save maintenance switch: %w", err)
+	}
+
+	maintenance.ID = record.Id
+	maintenance.StartedAt = record.GetDateTime("started_at").Time()
+	maintenance.StartedBy = record.GetString("started_by")
+	return nil
+}
+
+// Delete turns off the maintenance switch of a space, the global one for an
+// empty space ID. It returns false when the switch was off.
+func (r *MaintenanceRepository) Delete(ctx context.Context, spaceID string) (bool, error) {
+	record, err := r.find(spaceID)
+	if err != nil || record == nil {
+		return false, err
+	}
+
+	if err := r.app.DeleteWithContext(ctx, record); err != nil {
+		return false, fmt.Errorf("failed to delete maintenance switch: %w", err)
+	}
+
+	return true, nil
+}
+
+// find returns the switch of a space, nil when it is off
+func (r *MaintenanceRepository) find(spaceID string) (*core.Record, error) {
+	record := &core.Record{}
+	err := r.app.RecordQuery("maintenance").
+		AndWhere(dbx.HashExp{"space": spaceID}).
+		Limit(1).
+		One(record)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find maintenance switch: %w", err)
+	}
+	return record, nil
+}
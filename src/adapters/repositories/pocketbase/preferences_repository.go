@@ -0,0 +1,111 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// PreferencesRepository is a PocketBase implementation of the PreferencesRepository interface
+type PreferencesRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewPreferencesRepository creates a new PocketBase preferences repository
+func NewPreferencesRepository(app *pocketbase.PocketBase) *PreferencesRepository {
+	return &PreferencesRepository{
+		app: app,
+	}
+}
+
+// FindByUser finds the preferences of a user
+func (r *PreferencesRepository) FindByUser(ctx context.Context, userID string) (*models.Preferences, error) {
+	record := &core.Record{}
+	err := r.app.RecordQuery("preferences").
+		AndWhere(dbx.HashExp{"user": userID}).
+		Limit(1).
+		One(record)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrPreferencesNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find preferences: %w", err)
+	}
+
+	return r.mapRecordToPreferences(record), nil
+}
+
+// Save stores the preferences of a user, creating them on first save
+func (r *PreferencesRepository) Save(ctx context.Context, preferences *models.Preferences) error {
+	if preferences.ID == "" {
+		collection, err := r.app.FindCollectionByNameOrId("preferences")
+		if err != nil {
+			return fmt.Errorf("failed to find preferences collection: %w", err)
+		}
+		record := core.NewRecord(collection)
+		record.Set("user", preferences.UserID)
+		r.updateRecordFromPreferences(record, preferences)
+		record.Set("version", 1)
+
+		if err := r.app.SaveWithContext(ctx, record); err != nil {
+			return fmt.Errorf("failed to create preferences: %w", err)
+		}
+
+		preferences.ID = record.Id
+		preferences.Version = 1
+		preferences.CreatedAt = record.GetDateTime("created").Time()
+		preferences.UpdatedAt = record.GetDateTime("updated").Time()
+		return nil
+	}
+
+	version, err := saveVersioned(ctx, r.app, "preferences", preferences.ID, preferences.Version, func(record *core.Record) {
+		r.updateRecordFromPreferences(record, preferences)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update preferences: %w", err)
+	}
+
+	preferences.Version = version
+	preferences.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *PreferencesRepository) mapRecordToPreferences(record *core.Record) *models.Preferences {
+	return &models.Preferences{
+		ID:              record.Id,
+		UserID:          record.GetString("user"),
+		BaseCurrency:    record.GetString("base_currency"),
+		FirstDayOfWeek:  time.Weekday(record.GetInt("first_day_of_week")),
+		DefaultWalletID: record.GetString("default_wallet"),
+		DateFormat:      models.DateFormat(record.GetString("date_format")),
+		Notifications: models.NotificationSettings{
+			Incidents:         record.GetBool("notify_incidents"),
+			Subscriptions:     record.GetBool("notify_subscriptions"),
+			BalanceDrift:      record.GetBool("notify_balance_drift"),
+			Consent:           record.GetBool("notify_consent"),
+			LargeTransactions: record.GetFloat("notify_large_transactions"),
+		},
+		Version:   record.GetInt("version"),
+		CreatedAt: record.GetDateTime("created").Time(),
+		UpdatedAt: record.GetDateTime("updated").Time(),
+	}
+}
+
+func (r *PreferencesRepository) updateRecordFromPreferences(record *core.Record, preferences *models.Preferences) {
+	record.Set("base_currency", preferences.BaseCurrency)
+	record.Set("first_day_of_week", int(preferences.FirstDayOfWeek))
+	record.Set("default_wallet", preferences.DefaultWalletID)
+	record.Set("date_format", string(preferences.DateFormat))
+	record.Set("notify_incidents", preferences.Notifications.Incidents)
+	record.Set("notify_subscriptions", preferences.Notifications.Subscriptions)
+	record.Set("notify_balance_drift", preferences.Notifications.BalanceDrift)
+	record.Set("notify_consent", preferences.Notifications.Consent)
+	record.Set("notify_large_transactions", preferences.Notifications.LargeTransactions)
+}
@@ -0,0 +1,159 @@
+package pocketbase
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+// RawPayloadRepository is a PocketBase implementation of the RawPayloadRepository interface
+type RawPayloadRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewRawPayloadRepository creates a new PocketBase raw payload archive repository
+func NewRawPayloadRepository(app *pocketbase.PocketBase) *RawPayloadRepository {
+	return &RawPayloadRepository{
+		app: app,
+	}
+}
+
+// CreateMany stores the payloads of an import run in one transaction.
+// Compressed bodies are stored base64 encoded.
+func (r *RawPayloadRepository) CreateMany(ctx context.Context, payloads []*models.RawPayload) error {
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId("raw_payloads")
+	if err != nil {
+		return fmt.Errorf("failed to find raw_payloads collection: %w", err)
+	}
+
+	return r.app.RunInTransaction(func(txApp core.App) error {
+		for _, payload := range payloads {
+			record := core.NewRecord(collection)
+			record.Set("run_id", payload.RunID)
+			record.Set("source_id", payload.SourceID)
+			record.Set("method", payload.Method)
+			record.Set("url", payload.URL)
+			record.Set("status", payload.Status)
+			if payload.Compressed {
+				record.Set("body", base64.StdEncoding.EncodeToString(payload.Body))
+			} else {
+				record.Set("body", string(payload.Body))
+			}
+			record.Set("compressed", payload.Compressed)
+			record.Set("received_at", payload.ReceivedAt)
+
+			if err := txApp.Save(record); err != nil {
+				return fmt.Errorf("failed to archive payload of %s: %w", payload.SourceID, err)
+			}
+			payload.ID = record.Id
+		}
+		return nil
+	})
+}
+
+// FindByRun returns the payloads archived for a source in an import run,
+// in the order they were received
+func (r *RawPayloadRepository) FindByRun(ctx context.Context, runID, sourceID string) ([]*models.RawPayload, error) {
+	records := []*core.Record{}
+	err := r.app.RecordQuery("raw_payloads").
+		AndWhere(dbx.HashExp{"run_id": runID, "source_id": sourceID}).
+		OrderBy("received_at ASC", "created ASC").
+		All(&records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find payloads of run %s: %w", runID, err)
+	}
+
+	payloads := make([]*models.RawPayload, 0, len(records))
+	for _, record := range records {
+		payload, err := r.mapRecordToPayload(record)
+		if err != nil {
+			return nil, err
+		}
+		payloads = append(payloads, payload)
+	}
+	return payloads, nil
+}
+
+// FindRuns returns the archived runs of a source, or of every source when
+// sourceID is empty, newest first
+func (r *RawPayloadRepository) FindRuns(ctx context.Context, sourceID string, limit int) ([]models.PayloadRun, error) {
+	var rows []struct {
+		RunID      string         `db:"run_id"`
+		SourceID   string         `db:"source_id"`
+		Payloads   int            `db:"payloads"`
+		ReceivedAt types.DateTime `db:"received_at"`
+	}
+	query := r.app.DB().
+		Select("run_id", "source_id", "COUNT(*) AS payloads", "MIN(received_at) AS received_at").
+		From("raw_payloads").
+		GroupBy("run_id", "source_id").
+		OrderBy("received_at DESC")
+	if sourceID != "" {
+		query = query.Where(dbx.HashExp{"source_id": sourceID})
+	}
+	if limit > 0 {
+		query = query.Limit(int64(limit))
+	}
+	if err := query.All(&rows); err != nil {
+		return nil, fmt.Errorf("failed to find archived runs: %w", err)
+	}
+
+	runs := make([]models.PayloadRun, 0, len(rows))
+	for _, row := range rows {
+		runs = append(runs, models.PayloadRun{
+			RunID:      row.RunID,
+			SourceID:   row.SourceID,
+			Payloads:   row.Payloads,
+			ReceivedAt: row.ReceivedAt.Time(),
+		})
+	}
+	return runs, nil
+}
+
+// PurgeBefore deletes the payloads received before the given time
+func (r *RawPayloadRepository) PurgeBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.app.DB().Delete("raw_payloads", dbx.NewExp("received_at < {:before}", dbx.Params{"before": before})).Execute()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge raw payloads: %w", err)
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purged raw payloads: %w", err)
+	}
+
+	return int(purged), nil
+}
+
+func (r *RawPayloadRepository) mapRecordToPayload(record *core.Record) (*models.RawPayload, error) {
+	payload := &models.RawPayload{
+		ID:         record.Id,
+		RunID:      record.GetString("run_id"),
+		SourceID:   record.GetString("source_id"),
+		Method:     record.GetString("method"),
+		URL:        record.GetString("url"),
+		Status:     record.GetInt("status"),
+		Body:       []byte(record.GetString("body")),
+		Compressed: record.GetBool("compressed"),
+		ReceivedAt: record.GetDateTime("received_at").Time(),
+	}
+	if payload.Compressed {
+		body, err := base64.StdEncoding.DecodeString(record.GetString("body"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode payload %s: %w", record.Id, err)
+		}
+		payload.Body = body
+	}
+	return payload, nil
+}
@@ -7,7 +7,8 @@ import (
 
 // RepositoryFactory creates PocketBase-backed repositories
 type RepositoryFactory struct {
-	app *pocketbase.PocketBase
+	app    *pocketbase.PocketBase
+	cipher repositories.FieldCipher
 }
 
 // NewRepositoryFactory creates a new repository factory
@@ -17,9 +18,15 @@ func NewRepositoryFactory(app *pocketbase.PocketBase) *RepositoryFactory {
 	}
 }
 
+// WithFieldCipher makes the repositories created afterwards encrypt sensitive fields at rest
+func (f *RepositoryFactory) WithFieldCipher(cipher repositories.FieldCipher) *RepositoryFactory {
+	f.cipher = cipher
+	return f
+}
+
 // CreateTransactionRepository creates a new transaction repository
 func (f *RepositoryFactory) CreateTransactionRepository() repositories.TransactionRepository {
-	return NewTransactionRepository(f.app)
+	return NewTransactionRepository(f.app).WithCipher(f.cipher)
 }
 
 // CreateWalletRepository creates a new wallet repository
@@ -32,6 +39,121 @@ func (f *RepositoryFactory) CreateCategoryRepository() repositories.CategoryRepo
 	return NewCategoryRepository(f.app)
 }
 
+// CreateBalanceSnapshotRepository creates a new balance snapshot repository
+func (f *RepositoryFactory) CreateBalanceSnapshotRepository() repositories.BalanceSnapshotRepository {
+	return NewBalanceSnapshotRepository(f.app)
+}
+
+// CreateTransformationRuleRepository creates a new transformation rule repository
+func (f *RepositoryFactory) CreateTransformationRuleRepository() repositories.TransformationRuleRepository {
+	return NewTransformationRuleRepository(f.app)
+}
+
+// CreateAccountMappingRepository creates a new account mapping repository
+func (f *RepositoryFactory) CreateAccountMappingRepository() repositories.AccountMappingRepository {
+	return NewAccountMappingRepository(f.app)
+}
+
+// CreateTagRepository creates a new tag repository
+func (f *RepositoryFactory) CreateTagRepository() repositories.TagRepository {
+	return NewTagRepository(f.app)
+}
+
+// CreateSecretRepository creates a new secret repository encrypting values with key
+func (f *RepositoryFactory) CreateSecretRepository(key string) repositories.SecretRepository {
+	return NewSecretRepository(f.app, key)
+}
+
+// CreateDataKeyRepository creates a new data key repository wrapping keys with masterKey
+func (f *RepositoryFactory) CreateDataKeyRepository(masterKey string) repositories.DataKeyRepository {
+	return NewDataKeyRepository(f.app, masterKey)
+}
+
+// CreateIncidentRepository creates a new provider incident repository
+func (f *RepositoryFactory) CreateIncidentRepository() repositories.IncidentRepository {
+	return NewIncidentRepository(f.app)
+}
+
+// CreateBalanceAssertionRepository creates a new balance assertion repository
+func (f *RepositoryFactory) CreateBalanceAssertionRepository() repositories.BalanceAssertionRepository {
+	return NewBalanceAssertionRepository(f.app)
+}
+
+// CreateMaintenanceRepository creates a new maintenance switch repository
+func (f *RepositoryFactory) CreateMaintenanceRepository() repositories.MaintenanceRepository {
+	return NewMaintenanceRepository(f.app)
+}
+
+// CreateBackfillRepository creates a new backfill checkpoint repository
+func (f *RepositoryFactory) CreateBackfillRepository() repositories.BackfillRepository {
+	return NewBackfillRepository(f.app)
+}
+
+// CreateImportRunRepository creates a new import cycle report repository
+func (f *RepositoryFactory) CreateImportRunRepository() repositories.ImportRunRepository {
+	return NewImportRunRepository(f.app)
+}
+
+// CreateSourceStateRepository creates a new source sync state repository
+func (f *RepositoryFactory) CreateSourceStateRepository() repositories.SourceStateRepository {
+	return NewSourceStateRepository(f.app)
+}
+
+// CreateSubscriptionRepository creates a new detected subscription repository
+func (f *RepositoryFactory) CreateSubscriptionRepository() repositories.SubscriptionRepository {
+	return NewSubscriptionRepository(f.app)
+}
+
+// CreateSpaceRepository creates a new space repository
+func (f *RepositoryFactory) CreateSpaceRepository() repositories.SpaceRepository {
+	return NewSpaceRepository(f.app)
+}
+
+// CreatePreferencesRepository creates a new user preferences repository
+func (f *RepositoryFactory) CreatePreferencesRepository() repositories.PreferencesRepository {
+	return NewPreferencesRepository(f.app)
+}
+
+// CreateAuditRepository creates a new audit log repository
+func (f *RepositoryFactory) CreateAuditRepository() repositories.AuditRepository {
+	return NewAuditRepository(f.app)
+}
+
+// CreateFireflyOutboxRepository creates a new Firefly delivery outbox repository
+func (f *RepositoryFactory) CreateFireflyOutboxRepository() repositories.FireflyOutboxRepository {
+	return NewFireflyOutboxRepository(f.app)
+}
+
+// CreateEventOutboxRepository creates a new domain event outbox repository
+func (f *RepositoryFactory) CreateEventOutboxRepository() repositories.EventOutboxRepository {
+	return NewEventOutboxRepository(f.app)
+}
+
+// CreateRawPayloadRepository creates a new raw provider payload archive repository
+func (f *RepositoryFactory) CreateRawPayloadRepository() repositories.RawPayloadRepository {
+	return NewRawPayloadRepository(f.app)
+}
+
+// CreateLedgerSummaryRepository creates a new repository of the compacted import ledger
+func (f *RepositoryFactory) CreateLedgerSummaryRepository() repositories.LedgerSummaryRepository {
+	return NewLedgerSummaryRepository(f.app)
+}
+
+// CreateWebhookRepository creates a new outbound webhook repository
+func (f *RepositoryFactory) CreateWebhookRepository() repositories.WebhookRepository {
+	return NewWebhookRepository(f.app)
+}
+
+// CreateWebhookDeliveryRepository creates a new webhook delivery queue repository
+func (f *RepositoryFactory) CreateWebhookDeliveryRepository() repositories.WebhookDeliveryRepository {
+	return NewWebhookDeliveryRepository(f.app)
+}
+
+// CreateCalendarFeedRepository creates a new calendar feed repository
+func (f *RepositoryFactory) CreateCalendarFeedRepository() repositories.CalendarFeedRepository {
+	return NewCalendarFeedRepository(f.app)
+}
+
 // CreateUnitOfWork creates a new unit of work
 func (f *RepositoryFactory) CreateUnitOfWork() repositories.UnitOfWork {
 	return NewPocketBaseUnitOfWork(f.app)
@@ -0,0 +1,3464 @@
+// Code generated by schemagen. DO NOT EDIT.
+
+package schema
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+// Collection names
+const (
+	CollectionAccountMappings         = "account_mappings"
+	CollectionAuditLog                = "audit_log"
+	CollectionBackfills               = "backfills"
+	CollectionBalanceAssertionResults = "balance_assertion_results"
+	CollectionBalanceAssertions       = "balance_assertions"
+	CollectionBalanceSnapshots        = "balance_snapshots"
+	CollectionCalendarFeeds           = "calendar_feeds"
+	CollectionCategories              = "categories"
+	CollectionDataKeys                = "data_keys"
+	CollectionEventOutbox             = "event_outbox"
+	CollectionFireflyOutbox           = "firefly_outbox"
+	CollectionImportRuns              = "import_runs"
+	CollectionIncidents               = "incidents"
+	CollectionLedgerSummaries         = "ledger_summaries"
+	CollectionMaintenance             = "maintenance"
+	CollectionPreferences             = "preferences"
+	CollectionRawPayloads             = "raw_payloads"
+	CollectionSecrets                 = "secrets"
+	CollectionSourceStates            = "source_states"
+	CollectionSpaceMembers            = "space_members"
+	CollectionSpaces                  = "spaces"
+	CollectionSubscriptions           = "subscriptions"
+	CollectionTags                    = "tags"
+	CollectionTransactionHistory      = "transaction_history"
+	CollectionTransactions            = "transactions"
+	CollectionTransformationRules     = "transformation_rules"
+	CollectionUsers                   = "users"
+	CollectionWallets                 = "wallets"
+	CollectionWebhookDeliveries       = "webhook_deliveries"
+	CollectionWebhooks                = "webhooks"
+)
+
+// Fields of the account_mappings collection
+const (
+	AccountMappingsID               = "id"
+	AccountMappingsSource           = "source"
+	AccountMappingsSourceAccount    = "source_account"
+	AccountMappingsFireflyAccountID = "firefly_account_id"
+	AccountMappingsIBAN             = "iban"
+	AccountMappingsAutoCreate       = "auto_create"
+	AccountMappingsCreated          = "created"
+	AccountMappingsUpdated          = "updated"
+)
+
+// AccountMappings is a typed record of the account_mappings collection
+type AccountMappings struct {
+	core.BaseRecordProxy
+}
+
+// NewAccountMappings wraps a record of the account_mappings collection
+func NewAccountMappings(record *core.Record) *AccountMappings {
+	r := &AccountMappings{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Source returns the source field
+func (r *AccountMappings) Source() string {
+	return r.GetString(AccountMappingsSource)
+}
+
+// SetSource sets the source field
+func (r *AccountMappings) SetSource(v string) {
+	r.Set(AccountMappingsSource, v)
+}
+
+// SourceAccount returns the source_account field
+func (r *AccountMappings) SourceAccount() string {
+	return r.GetString(AccountMappingsSourceAccount)
+}
+
+// SetSourceAccount sets the source_account field
+func (r *AccountMappings) SetSourceAccount(v string) {
+	r.Set(AccountMappingsSourceAccount, v)
+}
+
+// FireflyAccountID returns the firefly_account_id field
+func (r *AccountMappings) FireflyAccountID() string {
+	return r.GetString(AccountMappingsFireflyAccountID)
+}
+
+// SetFireflyAccountID sets the firefly_account_id field
+func (r *AccountMappings) SetFireflyAccountID(v string) {
+	r.Set(AccountMappingsFireflyAccountID, v)
+}
+
+// IBAN returns the iban field
+func (r *AccountMappings) IBAN() string {
+	return r.GetString(AccountMappingsIBAN)
+}
+
+// SetIBAN sets the iban field
+func (r *AccountMappings) SetIBAN(v string) {
+	r.Set(AccountMappingsIBAN, v)
+}
+
+// AutoCreate returns the auto_create field
+func (r *AccountMappings) AutoCreate() bool {
+	return r.GetBool(AccountMappingsAutoCreate)
+}
+
+// SetAutoCreate sets the auto_create field
+func (r *AccountMappings) SetAutoCreate(v bool) {
+	r.Set(AccountMappingsAutoCreate, v)
+}
+
+// Created returns the created field
+func (r *AccountMappings) Created() types.DateTime {
+	return r.GetDateTime(AccountMappingsCreated)
+}
+
+// Updated returns the updated field
+func (r *AccountMappings) Updated() types.DateTime {
+	return r.GetDateTime(AccountMappingsUpdated)
+}
+
+// Fields of the audit_log collection
+const (
+	AuditLogID             = "id"
+	AuditLogAction         = "action"
+	AuditLogCollectionName = "collection_name"
+	AuditLogRecord         = "record"
+	AuditLogRequest        = "request"
+	AuditLogStatus         = "status"
+	AuditLogActor          = "actor"
+	AuditLogActorType      = "actor_type"
+	AuditLogSource         = "source"
+	AuditLogChanges        = "changes"
+	AuditLogAt             = "at"
+)
+
+// AuditLog is a typed record of the audit_log collection
+type AuditLog struct {
+	core.BaseRecordProxy
+}
+
+// NewAuditLog wraps a record of the audit_log collection
+func NewAuditLog(record *core.Record) *AuditLog {
+	r := &AuditLog{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Action returns the action field
+func (r *AuditLog) Action() string {
+	return r.GetString(AuditLogAction)
+}
+
+// SetAction sets the action field
+func (r *AuditLog) SetAction(v string) {
+	r.Set(AuditLogAction, v)
+}
+
+// CollectionName returns the collection_name field
+func (r *AuditLog) CollectionName() string {
+	return r.GetString(AuditLogCollectionName)
+}
+
+// SetCollectionName sets the collection_name field
+func (r *AuditLog) SetCollectionName(v string) {
+	r.Set(AuditLogCollectionName, v)
+}
+
+// Request returns the request field
+func (r *AuditLog) Request() string {
+	return r.GetString(AuditLogRequest)
+}
+
+// SetRequest sets the request field
+func (r *AuditLog) SetRequest(v string) {
+	r.Set(AuditLogRequest, v)
+}
+
+// Status returns the status field
+func (r *AuditLog) Status() int {
+	return r.GetInt(AuditLogStatus)
+}
+
+// SetStatus sets the status field
+func (r *AuditLog) SetStatus(v int) {
+	r.Set(AuditLogStatus, v)
+}
+
+// Actor returns the actor field
+func (r *AuditLog) Actor() string {
+	return r.GetString(AuditLogActor)
+}
+
+// SetActor sets the actor field
+func (r *AuditLog) SetActor(v string) {
+	r.Set(AuditLogActor, v)
+}
+
+// ActorType returns the actor_type field
+func (r *AuditLog) ActorType() string {
+	return r.GetString(AuditLogActorType)
+}
+
+// SetActorType sets the actor_type field
+func (r *AuditLog) SetActorType(v string) {
+	r.Set(AuditLogActorType, v)
+}
+
+// Source returns the source field
+func (r *AuditLog) Source() string {
+	return r.GetString(AuditLogSource)
+}
+
+// SetSource sets the source field
+func (r *AuditLog) SetSource(v string) {
+	r.Set(AuditLogSource, v)
+}
+
+// UnmarshalChanges decodes the changes field into v
+func (r *AuditLog) UnmarshalChanges(v any) error {
+	return r.UnmarshalJSONField(AuditLogChanges, v)
+}
+
+// SetChanges sets the changes field
+func (r *AuditLog) SetChanges(v any) {
+	r.Set(AuditLogChanges, v)
+}
+
+// At returns the at field
+func (r *AuditLog) At() types.DateTime {
+	return r.GetDateTime(AuditLogAt)
+}
+
+// SetAt sets the at field
+func (r *AuditLog) SetAt(v types.DateTime) {
+	r.Set(AuditLogAt, v)
+}
+
+// Fields of the backfills collection
+const (
+	BackfillsID          = "id"
+	BackfillsSourceID    = "source_id"
+	BackfillsStatus      = "status"
+	BackfillsCursor      = "cursor"
+	BackfillsPages       = "pages"
+	BackfillsImported    = "imported"
+	BackfillsProgress    = "progress"
+	BackfillsError       = "error"
+	BackfillsStartedAt   = "started_at"
+	BackfillsUpdatedAt   = "updated_at"
+	BackfillsCompletedAt = "completed_at"
+)
+
+// Backfills is a typed record of the backfills collection
+type Backfills struct {
+	core.BaseRecordProxy
+}
+
+// NewBackfills wraps a record of the backfills collection
+func NewBackfills(record *core.Record) *Backfills {
+	r := &Backfills{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// SourceID returns the source_id field
+func (r *Backfills) SourceID() string {
+	return r.GetString(BackfillsSourceID)
+}
+
+// SetSourceID sets the source_id field
+func (r *Backfills) SetSourceID(v string) {
+	r.Set(BackfillsSourceID, v)
+}
+
+// Status returns the status field
+func (r *Backfills) Status() string {
+	return r.GetString(BackfillsStatus)
+}
+
+// SetStatus sets the status field
+func (r *Backfills) SetStatus(v string) {
+	r.Set(BackfillsStatus, v)
+}
+
+// Cursor returns the cursor field
+func (r *Backfills) Cursor() string {
+	return r.GetString(BackfillsCursor)
+}
+
+// SetCursor sets the cursor field
+func (r *Backfills) SetCursor(v string) {
+	r.Set(BackfillsCursor, v)
+}
+
+// Pages returns the pages field
+func (r *Backfills) Pages() int {
+	return r.GetInt(BackfillsPages)
+}
+
+// SetPages sets the pages field
+func (r *Backfills) SetPages(v int) {
+	r.Set(BackfillsPages, v)
+}
+
+// Imported returns the imported field
+func (r *Backfills) Imported() int {
+	return r.GetInt(BackfillsImported)
+}
+
+// SetImported sets the imported field
+func (r *Backfills) SetImported(v int) {
+	r.Set(BackfillsImported, v)
+}
+
+// Progress returns the progress field
+func (r *Backfills) Progress() float64 {
+	return r.GetFloat(BackfillsProgress)
+}
+
+// SetProgress sets the progress field
+func (r *Backfills) SetProgress(v float64) {
+	r.Set(BackfillsProgress, v)
+}
+
+// Error returns the error field
+func (r *Backfills) Error() string {
+	return r.GetString(BackfillsError)
+}
+
+// SetError sets the error field
+func (r *Backfills) SetError(v string) {
+	r.Set(BackfillsError, v)
+}
+
+// StartedAt returns the started_at field
+func (r *Backfills) StartedAt() types.DateTime {
+	return r.GetDateTime(BackfillsStartedAt)
+}
+
+// SetStartedAt sets the started_at field
+func (r *Backfills) SetStartedAt(v types.DateTime) {
+	r.Set(BackfillsStartedAt, v)
+}
+
+// UpdatedAt returns the updated_at field
+func (r *Backfills) UpdatedAt() types.DateTime {
+	return r.GetDateTime(BackfillsUpdatedAt)
+}
+
+// SetUpdatedAt sets the updated_at field
+func (r *Backfills) SetUpdatedAt(v types.DateTime) {
+	r.Set(BackfillsUpdatedAt, v)
+}
+
+// CompletedAt returns the completed_at field
+func (r *Backfills) CompletedAt() types.DateTime {
+	return r.GetDateTime(BackfillsCompletedAt)
+}
+
+// SetCompletedAt sets the completed_at field
+func (r *Backfills) SetCompletedAt(v types.DateTime) {
+	r.Set(BackfillsCompletedAt, v)
+}
+
+// Fields of the balance_assertion_results collection
+const (
+	BalanceAssertionResultsID        = "id"
+	BalanceAssertionResultsAssertion = "assertion"
+	BalanceAssertionResultsWallet    = "wallet"
+	BalanceAssertionResultsCheckedAt = "checked_at"
+	BalanceAssertionResultsCurrency  = "currency"
+	BalanceAssertionResultsBalances  = "balances"
+	BalanceAssertionResultsDrift     = "drift"
+	BalanceAssertionResultsTolerance = "tolerance"
+	BalanceAssertionResultsPassed    = "passed"
+	BalanceAssertionResultsError     = "error"
+)
+
+// BalanceAssertionResults is a typed record of the balance_assertion_results collection
+type BalanceAssertionResults struct {
+	core.BaseRecordProxy
+}
+
+// NewBalanceAssertionResults wraps a record of the balance_assertion_results collection
+func NewBalanceAssertionResults(record *core.Record) *BalanceAssertionResults {
+	r := &BalanceAssertionResults{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Assertion returns the assertion field
+func (r *BalanceAssertionResults) Assertion() string {
+	return r.GetString(BalanceAssertionResultsAssertion)
+}
+
+// SetAssertion sets the assertion field
+func (r *BalanceAssertionResults) SetAssertion(v string) {
+	r.Set(BalanceAssertionResultsAssertion, v)
+}
+
+// Wallet returns the wallet field
+func (r *BalanceAssertionResults) Wallet() string {
+	return r.GetString(BalanceAssertionResultsWallet)
+}
+
+// SetWallet sets the wallet field
+func (r *BalanceAssertionResults) SetWallet(v string) {
+	r.Set(BalanceAssertionResultsWallet, v)
+}
+
+// CheckedAt returns the checked_at field
+func (r *BalanceAssertionResults) CheckedAt() types.DateTime {
+	return r.GetDateTime(BalanceAssertionResultsCheckedAt)
+}
+
+// SetCheckedAt sets the checked_at field
+func (r *BalanceAssertionResults) SetCheckedAt(v types.DateTime) {
+	r.Set(BalanceAssertionResultsCheckedAt, v)
+}
+
+// Currency returns the currency field
+func (r *BalanceAssertionResults) Currency() string {
+	return r.GetString(BalanceAssertionResultsCurrency)
+}
+
+// SetCurrency sets the currency field
+func (r *BalanceAssertionResults) SetCurrency(v string) {
+	r.Set(BalanceAssertionResultsCurrency, v)
+}
+
+// UnmarshalBalances decodes the balances field into v
+func (r *BalanceAssertionResults) UnmarshalBalances(v any) error {
+	return r.UnmarshalJSONField(BalanceAssertionResultsBalances, v)
+}
+
+// SetBalances sets the balances field
+func (r *BalanceAssertionResults) SetBalances(v any) {
+	r.Set(BalanceAssertionResultsBalances, v)
+}
+
+// Drift returns the drift field
+func (r *BalanceAssertionResults) Drift() float64 {
+	return r.GetFloat(BalanceAssertionResultsDrift)
+}
+
+// SetDrift sets the drift field
+func (r *BalanceAssertionResults) SetDrift(v float64) {
+	r.Set(BalanceAssertionResultsDrift, v)
+}
+
+// Tolerance returns the tolerance field
+func (r *BalanceAssertionResults) Tolerance() float64 {
+	return r.GetFloat(BalanceAssertionResultsTolerance)
+}
+
+// SetTolerance sets the tolerance field
+func (r *BalanceAssertionResults) SetTolerance(v float64) {
+	r.Set(BalanceAssertionResultsTolerance, v)
+}
+
+// Passed returns the passed field
+func (r *BalanceAssertionResults) Passed() bool {
+	return r.GetBool(BalanceAssertionResultsPassed)
+}
+
+// SetPassed sets the passed field
+func (r *BalanceAssertionResults) SetPassed(v bool) {
+	r.Set(BalanceAssertionResultsPassed, v)
+}
+
+// Error returns the error field
+func (r *BalanceAssertionResults) Error() string {
+	return r.GetString(BalanceAssertionResultsError)
+}
+
+// SetError sets the error field
+func (r *BalanceAssertionResults) SetError(v string) {
+	r.Set(BalanceAssertionResultsError, v)
+}
+
+// Fields of the balance_assertions collection
+const (
+	BalanceAssertionsID        = "id"
+	BalanceAssertionsName      = "name"
+	BalanceAssertionsSource    = "source"
+	BalanceAssertionsCompare   = "compare"
+	BalanceAssertionsSchedule  = "schedule"
+	BalanceAssertionsTolerance = "tolerance"
+	BalanceAssertionsEnabled   = "enabled"
+	BalanceAssertionsCreated   = "created"
+	BalanceAssertionsUpdated   = "updated"
+)
+
+// BalanceAssertions is a typed record of the balance_assertions collection
+type BalanceAssertions struct {
+	core.BaseRecordProxy
+}
+
+// NewBalanceAssertions wraps a record of the balance_assertions collection
+func NewBalanceAssertions(record *core.Record) *BalanceAssertions {
+	r := &BalanceAssertions{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Name returns the name field
+func (r *BalanceAssertions) Name() string {
+	return r.GetString(BalanceAssertionsName)
+}
+
+// SetName sets the name field
+func (r *BalanceAssertions) SetName(v string) {
+	r.Set(BalanceAssertionsName, v)
+}
+
+// Source returns the source field
+func (r *BalanceAssertions) Source() string {
+	return r.GetString(BalanceAssertionsSource)
+}
+
+// SetSource sets the source field
+func (r *BalanceAssertions) SetSource(v string) {
+	r.Set(BalanceAssertionsSource, v)
+}
+
+// UnmarshalCompare decodes the compare field into v
+func (r *BalanceAssertions) UnmarshalCompare(v any) error {
+	return r.UnmarshalJSONField(BalanceAssertionsCompare, v)
+}
+
+// SetCompare sets the compare field
+func (r *BalanceAssertions) SetCompare(v any) {
+	r.Set(BalanceAssertionsCompare, v)
+}
+
+// Schedule returns the schedule field
+func (r *BalanceAssertions) Schedule() string {
+	return r.GetString(BalanceAssertionsSchedule)
+}
+
+// SetSchedule sets the schedule field
+func (r *BalanceAssertions) SetSchedule(v string) {
+	r.Set(BalanceAssertionsSchedule, v)
+}
+
+// Tolerance returns the tolerance field
+func (r *BalanceAssertions) Tolerance() float64 {
+	return r.GetFloat(BalanceAssertionsTolerance)
+}
+
+// SetTolerance sets the tolerance field
+func (r *BalanceAssertions) SetTolerance(v float64) {
+	r.Set(BalanceAssertionsTolerance, v)
+}
+
+// Enabled returns the enabled field
+func (r *BalanceAssertions) Enabled() bool {
+	return r.GetBool(BalanceAssertionsEnabled)
+}
+
+// SetEnabled sets the enabled field
+func (r *BalanceAssertions) SetEnabled(v bool) {
+	r.Set(BalanceAssertionsEnabled, v)
+}
+
+// Created returns the created field
+func (r *BalanceAssertions) Created() types.DateTime {
+	return r.GetDateTime(BalanceAssertionsCreated)
+}
+
+// Updated returns the updated field
+func (r *BalanceAssertions) Updated() types.DateTime {
+	return r.GetDateTime(BalanceAssertionsUpdated)
+}
+
+// Fields of the balance_snapshots collection
+const (
+	BalanceSnapshotsID          = "id"
+	BalanceSnapshotsWallet      = "wallet"
+	BalanceSnapshotsBalance     = "balance"
+	BalanceSnapshotsCurrency    = "currency"
+	BalanceSnapshotsSource      = "source"
+	BalanceSnapshotsTakenAt     = "taken_at"
+	BalanceSnapshotsBalanceType = "balance_type"
+)
+
+// BalanceSnapshots is a typed record of the balance_snapshots collection
+type BalanceSnapshots struct {
+	core.BaseRecordProxy
+}
+
+// NewBalanceSnapshots wraps a record of the balance_snapshots collection
+func NewBalanceSnapshots(record *core.Record) *BalanceSnapshots {
+	r := &BalanceSnapshots{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Wallet returns the wallet field
+func (r *BalanceSnapshots) Wallet() string {
+	return r.GetString(BalanceSnapshotsWallet)
+}
+
+// SetWallet sets the wallet field
+func (r *BalanceSnapshots) SetWallet(v string) {
+	r.Set(BalanceSnapshotsWallet, v)
+}
+
+// Balance returns the balance field
+func (r *BalanceSnapshots) Balance() float64 {
+	return r.GetFloat(BalanceSnapshotsBalance)
+}
+
+// SetBalance sets the balance field
+func (r *BalanceSnapshots) SetBalance(v float64) {
+	r.Set(BalanceSnapshotsBalance, v)
+}
+
+// Currency returns the currency field
+func (r *BalanceSnapshots) Currency() string {
+	return r.GetString(BalanceSnapshotsCurrency)
+}
+
+// SetCurrency sets the currency field
+func (r *BalanceSnapshots) SetCurrency(v string) {
+	r.Set(BalanceSnapshotsCurrency, v)
+}
+
+// Source returns the source field
+func (r *BalanceSnapshots) Source() string {
+	return r.GetString(BalanceSnapshotsSource)
+}
+
+// SetSource sets the source field
+func (r *BalanceSnapshots) SetSource(v string) {
+	r.Set(BalanceSnapshotsSource, v)
+}
+
+// TakenAt returns the taken_at field
+func (r *BalanceSnapshots) TakenAt() types.DateTime {
+	return r.GetDateTime(BalanceSnapshotsTakenAt)
+}
+
+// SetTakenAt sets the taken_at field
+func (r *BalanceSnapshots) SetTakenAt(v types.DateTime) {
+	r.Set(BalanceSnapshotsTakenAt, v)
+}
+
+// BalanceType returns the balance_type field
+func (r *BalanceSnapshots) BalanceType() string {
+	return r.GetString(BalanceSnapshotsBalanceType)
+}
+
+// SetBalanceType sets the balance_type field
+func (r *BalanceSnapshots) SetBalanceType(v string) {
+	r.Set(BalanceSnapshotsBalanceType, v)
+}
+
+// Fields of the calendar_feeds collection
+const (
+	CalendarFeedsID        = "id"
+	CalendarFeedsUser      = "user"
+	CalendarFeedsTokenHash = "token_hash"
+	CalendarFeedsCreated   = "created"
+	CalendarFeedsUpdated   = "updated"
+)
+
+// CalendarFeeds is a typed record of the calendar_feeds collection
+type CalendarFeeds struct {
+	core.BaseRecordProxy
+}
+
+// NewCalendarFeeds wraps a record of the calendar_feeds collection
+func NewCalendarFeeds(record *core.Record) *CalendarFeeds {
+	r := &CalendarFeeds{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// User returns the user field
+func (r *CalendarFeeds) User() string {
+	return r.GetString(CalendarFeedsUser)
+}
+
+// SetUser sets the user field
+func (r *CalendarFeeds) SetUser(v string) {
+	r.Set(CalendarFeedsUser, v)
+}
+
+// TokenHash returns the token_hash field
+func (r *CalendarFeeds) TokenHash() string {
+	return r.GetString(CalendarFeedsTokenHash)
+}
+
+// SetTokenHash sets the token_hash field
+func (r *CalendarFeeds) SetTokenHash(v string) {
+	r.Set(CalendarFeedsTokenHash, v)
+}
+
+// Created returns the created field
+func (r *CalendarFeeds) Created() types.DateTime {
+	return r.GetDateTime(CalendarFeedsCreated)
+}
+
+// Updated returns the updated field
+func (r *CalendarFeeds) Updated() types.DateTime {
+	return r.GetDateTime(CalendarFeedsUpdated)
+}
+
+// Fields of the categories collection
+const (
+	CategoriesID          = "id"
+	CategoriesName        = "name"
+	CategoriesDescription = "description"
+	CategoriesType        = "type"
+	CategoriesColor       = "color"
+	CategoriesIsSystem    = "is_system"
+	CategoriesVersion     = "version"
+	CategoriesSpace       = "space"
+	CategoriesKey         = "key"
+	CategoriesParent      = "parent"
+	CategoriesCreated     = "created"
+	CategoriesUpdated     = "updated"
+)
+
+// Categories is a typed record of the categories collection
+type Categories struct {
+	core.BaseRecordProxy
+}
+
+// NewCategories wraps a record of the categories collection
+func NewCategories(record *core.Record) *Categories {
+	r := &Categories{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Name returns the name field
+func (r *Categories) Name() string {
+	return r.GetString(CategoriesName)
+}
+
+// SetName sets the name field
+func (r *Categories) SetName(v string) {
+	r.Set(CategoriesName, v)
+}
+
+// Description returns the description field
+func (r *Categories) Description() string {
+	return r.GetString(CategoriesDescription)
+}
+
+// SetDescription sets the description field
+func (r *Categories) SetDescription(v string) {
+	r.Set(CategoriesDescription, v)
+}
+
+// Type returns the type field
+func (r *Categories) Type() string {
+	return r.GetString(CategoriesType)
+}
+
+// SetType sets the type field
+func (r *Categories) SetType(v string) {
+	r.Set(CategoriesType, v)
+}
+
+// Color returns the color field
+func (r *Categories) Color() string {
+	return r.GetString(CategoriesColor)
+}
+
+// SetColor sets the color field
+func (r *Categories) SetColor(v string) {
+	r.Set(CategoriesColor, v)
+}
+
+// IsSystem returns the is_system field
+func (r *Categories) IsSystem() bool {
+	return r.GetBool(CategoriesIsSystem)
+}
+
+// SetIsSystem sets the is_system field
+func (r *Categories) SetIsSystem(v bool) {
+	r.Set(CategoriesIsSystem, v)
+}
+
+// Version returns the version field
+func (r *Categories) Version() int {
+	return r.GetInt(CategoriesVersion)
+}
+
+// SetVersion sets the version field
+func (r *Categories) SetVersion(v int) {
+	r.Set(CategoriesVersion, v)
+}
+
+// Space returns the space field
+func (r *Categories) Space() string {
+	return r.GetString(CategoriesSpace)
+}
+
+// SetSpace sets the space field
+func (r *Categories) SetSpace(v string) {
+	r.Set(CategoriesSpace, v)
+}
+
+// Key returns the key field
+func (r *Categories) Key() string {
+	return r.GetString(CategoriesKey)
+}
+
+// SetKey sets the key field
+func (r *Categories) SetKey(v string) {
+	r.Set(CategoriesKey, v)
+}
+
+// Parent returns the parent field
+func (r *Categories) Parent() string {
+	return r.GetString(CategoriesParent)
+}
+
+// SetParent sets the parent field
+func (r *Categories) SetParent(v string) {
+	r.Set(CategoriesParent, v)
+}
+
+// Created returns the created field
+func (r *Categories) Created() types.DateTime {
+	return r.GetDateTime(CategoriesCreated)
+}
+
+// Updated returns the updated field
+func (r *Categories) Updated() types.DateTime {
+	return r.GetDateTime(CategoriesUpdated)
+}
+
+// Fields of the data_keys collection
+const (
+	DataKeysID      = "id"
+	DataKeysSpace   = "space"
+	DataKeysKey     = "key"
+	DataKeysActive  = "active"
+	DataKeysCreated = "created"
+)
+
+// DataKeys is a typed record of the data_keys collection
+type DataKeys struct {
+	core.BaseRecordProxy
+}
+
+// NewDataKeys wraps a record of the data_keys collection
+func NewDataKeys(record *core.Record) *DataKeys {
+	r := &DataKeys{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Space returns the space field
+func (r *DataKeys) Space() string {
+	return r.GetString(DataKeysSpace)
+}
+
+// SetSpace sets the space field
+func (r *DataKeys) SetSpace(v string) {
+	r.Set(DataKeysSpace, v)
+}
+
+// Key returns the key field
+func (r *DataKeys) Key() string {
+	return r.GetString(DataKeysKey)
+}
+
+// SetKey sets the key field
+func (r *DataKeys) SetKey(v string) {
+	r.Set(DataKeysKey, v)
+}
+
+// Active returns the active field
+func (r *DataKeys) Active() bool {
+	return r.GetBool(DataKeysActive)
+}
+
+// SetActive sets the active field
+func (r *DataKeys) SetActive(v bool) {
+	r.Set(DataKeysActive, v)
+}
+
+// Created returns the created field
+func (r *DataKeys) Created() types.DateTime {
+	return r.GetDateTime(DataKeysCreated)
+}
+
+// Fields of the event_outbox collection
+const (
+	EventOutboxID          = "id"
+	EventOutboxEventID     = "event_id"
+	EventOutboxType        = "type"
+	EventOutboxPayload     = "payload"
+	EventOutboxAttempts    = "attempts"
+	EventOutboxLastError   = "last_error"
+	EventOutboxPublishedAt = "published_at"
+	EventOutboxCreated     = "created"
+)
+
+// EventOutbox is a typed record of the event_outbox collection
+type EventOutbox struct {
+	core.BaseRecordProxy
+}
+
+// NewEventOutbox wraps a record of the event_outbox collection
+func NewEventOutbox(record *core.Record) *EventOutbox {
+	r := &EventOutbox{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// EventID returns the event_id field
+func (r *EventOutbox) EventID() string {
+	return r.GetString(EventOutboxEventID)
+}
+
+// SetEventID sets the event_id field
+func (r *EventOutbox) SetEventID(v string) {
+	r.Set(EventOutboxEventID, v)
+}
+
+// Type returns the type field
+func (r *EventOutbox) Type() string {
+	return r.GetString(EventOutboxType)
+}
+
+// SetType sets the type field
+func (r *EventOutbox) SetType(v string) {
+	r.Set(EventOutboxType, v)
+}
+
+// UnmarshalPayload decodes the payload field into v
+func (r *EventOutbox) UnmarshalPayload(v any) error {
+	return r.UnmarshalJSONField(EventOutboxPayload, v)
+}
+
+// SetPayload sets the payload field
+func (r *EventOutbox) SetPayload(v any) {
+	r.Set(EventOutboxPayload, v)
+}
+
+// Attempts returns the attempts field
+func (r *EventOutbox) Attempts() int {
+	return r.GetInt(EventOutboxAttempts)
+}
+
+// SetAttempts sets the attempts field
+func (r *EventOutbox) SetAttempts(v int) {
+	r.Set(EventOutboxAttempts, v)
+}
+
+// LastError returns the last_error field
+func (r *EventOutbox) LastError() string {
+	return r.GetString(EventOutboxLastError)
+}
+
+// SetLastError sets the last_error field
+func (r *EventOutbox) SetLastError(v string) {
+	r.Set(EventOutboxLastError, v)
+}
+
+// PublishedAt returns the published_at field
+func (r *EventOutbox) PublishedAt() types.DateTime {
+	return r.GetDateTime(EventOutboxPublishedAt)
+}
+
+// SetPublishedAt sets the published_at field
+func (r *EventOutbox) SetPublishedAt(v types.DateTime) {
+	r.Set(EventOutboxPublishedAt, v)
+}
+
+// Created returns the created field
+func (r *EventOutbox) Created() types.DateTime {
+	return r.GetDateTime(EventOutboxCreated)
+}
+
+// Fields of the firefly_outbox collection
+const (
+	FireflyOutboxID            = "id"
+	FireflyOutboxTransaction   = "transaction"
+	FireflyOutboxStatus        = "status"
+	FireflyOutboxAttempts      = "attempts"
+	FireflyOutboxLastError     = "last_error"
+	FireflyOutboxFireflyID     = "firefly_id"
+	FireflyOutboxNextAttemptAt = "next_attempt_at"
+	FireflyOutboxDeliveredAt   = "delivered_at"
+	FireflyOutboxCreated       = "created"
+)
+
+// FireflyOutbox is a typed record of the firefly_outbox collection
+type FireflyOutbox struct {
+	core.BaseRecordProxy
+}
+
+// NewFireflyOutbox wraps a record of the firefly_outbox collection
+func NewFireflyOutbox(record *core.Record) *FireflyOutbox {
+	r := &FireflyOutbox{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Transaction returns the transaction field
+func (r *FireflyOutbox) Transaction() string {
+	return r.GetString(FireflyOutboxTransaction)
+}
+
+// SetTransaction sets the transaction field
+func (r *FireflyOutbox) SetTransaction(v string) {
+	r.Set(FireflyOutboxTransaction, v)
+}
+
+// Status returns the status field
+func (r *FireflyOutbox) Status() string {
+	return r.GetString(FireflyOutboxStatus)
+}
+
+// SetStatus sets the status field
+func (r *FireflyOutbox) SetStatus(v string) {
+	r.Set(FireflyOutboxStatus, v)
+}
+
+// Attempts returns the attempts field
+func (r *FireflyOutbox) Attempts() int {
+	return r.GetInt(FireflyOutboxAttempts)
+}
+
+// SetAttempts sets the attempts field
+func (r *FireflyOutbox) SetAttempts(v int) {
+	r.Set(FireflyOutboxAttempts, v)
+}
+
+// LastError returns the last_error field
+func (r *FireflyOutbox) LastError() string {
+	return r.GetString(FireflyOutboxLastError)
+}
+
+// SetLastError sets the last_error field
+func (r *FireflyOutbox) SetLastError(v string) {
+	r.Set(FireflyOutboxLastError, v)
+}
+
+// FireflyID returns the firefly_id field
+func (r *FireflyOutbox) FireflyID() string {
+	return r.GetString(FireflyOutboxFireflyID)
+}
+
+// SetFireflyID sets the firefly_id field
+func (r *FireflyOutbox) SetFireflyID(v string) {
+	r.Set(FireflyOutboxFireflyID, v)
+}
+
+// NextAttemptAt returns the next_attempt_at field
+func (r *FireflyOutbox) NextAttemptAt() types.DateTime {
+	return r.GetDateTime(FireflyOutboxNextAttemptAt)
+}
+
+// SetNextAttemptAt sets the next_attempt_at field
+func (r *FireflyOutbox) SetNextAttemptAt(v types.DateTime) {
+	r.Set(FireflyOutboxNextAttemptAt, v)
+}
+
+// DeliveredAt returns the delivered_at field
+func (r *FireflyOutbox) DeliveredAt() types.DateTime {
+	return r.GetDateTime(FireflyOutboxDeliveredAt)
+}
+
+// SetDeliveredAt sets the delivered_at field
+func (r *FireflyOutbox) SetDeliveredAt(v types.DateTime) {
+	r.Set(FireflyOutboxDeliveredAt, v)
+}
+
+// Created returns the created field
+func (r *FireflyOutbox) Created() types.DateTime {
+	return r.GetDateTime(FireflyOutboxCreated)
+}
+
+// Fields of the import_runs collection
+const (
+	ImportRunsID         = "id"
+	ImportRunsCycleID    = "cycle_id"
+	ImportRunsStartedAt  = "started_at"
+	ImportRunsFinishedAt = "finished_at"
+	ImportRunsImported   = "imported"
+	ImportRunsFailed     = "failed"
+	ImportRunsSnapshots  = "snapshots"
+	ImportRunsSources    = "sources"
+)
+
+// ImportRuns is a typed record of the import_runs collection
+type ImportRuns struct {
+	core.BaseRecordProxy
+}
+
+// NewImportRuns wraps a record of the import_runs collection
+func NewImportRuns(record *core.Record) *ImportRuns {
+	r := &ImportRuns{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// CycleID returns the cycle_id field
+func (r *ImportRuns) CycleID() string {
+	return r.GetString(ImportRunsCycleID)
+}
+
+// SetCycleID sets the cycle_id field
+func (r *ImportRuns) SetCycleID(v string) {
+	r.Set(ImportRunsCycleID, v)
+}
+
+// StartedAt returns the started_at field
+func (r *ImportRuns) StartedAt() types.DateTime {
+	return r.GetDateTime(ImportRunsStartedAt)
+}
+
+// SetStartedAt sets the started_at field
+func (r *ImportRuns) SetStartedAt(v types.DateTime) {
+	r.Set(ImportRunsStartedAt, v)
+}
+
+// FinishedAt returns the finished_at field
+func (r *ImportRuns) FinishedAt() types.DateTime {
+	return r.GetDateTime(ImportRunsFinishedAt)
+}
+
+// SetFinishedAt sets the finished_at field
+func (r *ImportRuns) SetFinishedAt(v types.DateTime) {
+	r.Set(ImportRunsFinishedAt, v)
+}
+
+// Imported returns the imported field
+func (r *ImportRuns) Imported() int {
+	return r.GetInt(ImportRunsImported)
+}
+
+// SetImported sets the imported field
+func (r *ImportRuns) SetImported(v int) {
+	r.Set(ImportRunsImported, v)
+}
+
+// Failed returns the failed field
+func (r *ImportRuns) Failed() int {
+	return r.GetInt(ImportRunsFailed)
+}
+
+// SetFailed sets the failed field
+func (r *ImportRuns) SetFailed(v int) {
+	r.Set(ImportRunsFailed, v)
+}
+
+// Snapshots returns the snapshots field
+func (r *ImportRuns) Snapshots() int {
+	return r.GetInt(ImportRunsSnapshots)
+}
+
+// SetSnapshots sets the snapshots field
+func (r *ImportRuns) SetSnapshots(v int) {
+	r.Set(ImportRunsSnapshots, v)
+}
+
+// UnmarshalSources decodes the sources field into v
+func (r *ImportRuns) UnmarshalSources(v any) error {
+	return r.UnmarshalJSONField(ImportRunsSources, v)
+}
+
+// SetSources sets the sources field
+func (r *ImportRuns) SetSources(v any) {
+	r.Set(ImportRunsSources, v)
+}
+
+// Fields of the incidents collection
+const (
+	IncidentsID         = "id"
+	IncidentsProvider   = "provider"
+	IncidentsErrorClass = "error_class"
+	IncidentsLastError  = "last_error"
+	IncidentsFailures   = "failures"
+	IncidentsStartedAt  = "started_at"
+	IncidentsEndedAt    = "ended_at"
+)
+
+// Incidents is a typed record of the incidents collection
+type Incidents struct {
+	core.BaseRecordProxy
+}
+
+// NewIncidents wraps a record of the incidents collection
+func NewIncidents(record *core.Record) *Incidents {
+	r := &Incidents{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Provider returns the provider field
+func (r *Incidents) Provider() string {
+	return r.GetString(IncidentsProvider)
+}
+
+// SetProvider sets the provider field
+func (r *Incidents) SetProvider(v string) {
+	r.Set(IncidentsProvider, v)
+}
+
+// ErrorClass returns the error_class field
+func (r *Incidents) ErrorClass() string {
+	return r.GetString(IncidentsErrorClass)
+}
+
+// SetErrorClass sets the error_class field
+func (r *Incidents) SetErrorClass(v string) {
+	r.Set(IncidentsErrorClass, v)
+}
+
+// LastError returns the last_error field
+func (r *Incidents) LastError() string {
+	return r.GetString(IncidentsLastError)
+}
+
+// SetLastError sets the last_error field
+func (r *Incidents) SetLastError(v string) {
+	r.Set(IncidentsLastError, v)
+}
+
+// Failures returns the failures field
+func (r *Incidents) Failures() int {
+	return r.GetInt(IncidentsFailures)
+}
+
+// SetFailures sets the failures field
+func (r *Incidents) SetFailures(v int) {
+	r.Set(IncidentsFailures, v)
+}
+
+// StartedAt returns the started_at field
+func (r *Incidents) StartedAt() types.DateTime {
+	return r.GetDateTime(IncidentsStartedAt)
+}
+
+// SetStartedAt sets the started_at field
+func (r *Incidents) SetStartedAt(v types.DateTime) {
+	r.Set(IncidentsStartedAt, v)
+}
+
+// EndedAt returns the ended_at field
+func (r *Incidents) EndedAt() types.DateTime {
+	return r.GetDateTime(IncidentsEndedAt)
+}
+
+// SetEndedAt sets the ended_at field
+func (r *Incidents) SetEndedAt(v types.DateTime) {
+	r.Set(IncidentsEndedAt, v)
+}
+
+// Fields of the ledger_summaries collection
+const (
+	LedgerSummariesID      = "id"
+	LedgerSummariesWallet  = "wallet"
+	LedgerSummariesFrom    = "from"
+	LedgerSummariesTo      = "to"
+	LedgerSummariesCount   = "count"
+	LedgerSummariesFilter  = "filter"
+	LedgerSummariesCreated = "created"
+)
+
+// LedgerSummaries is a typed record of the ledger_summaries collection
+type LedgerSummaries struct {
+	core.BaseRecordProxy
+}
+
+// NewLedgerSummaries wraps a record of the ledger_summaries collection
+func NewLedgerSummaries(record *core.Record) *LedgerSummaries {
+	r := &LedgerSummaries{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Wallet returns the wallet field
+func (r *LedgerSummaries) Wallet() string {
+	return r.GetString(LedgerSummariesWallet)
+}
+
+// SetWallet sets the wallet field
+func (r *LedgerSummaries) SetWallet(v string) {
+	r.Set(LedgerSummariesWallet, v)
+}
+
+// From returns the from field
+func (r *LedgerSummaries) From() types.DateTime {
+	return r.GetDateTime(LedgerSummariesFrom)
+}
+
+// SetFrom sets the from field
+func (r *LedgerSummaries) SetFrom(v types.DateTime) {
+	r.Set(LedgerSummariesFrom, v)
+}
+
+// To returns the to field
+func (r *LedgerSummaries) To() types.DateTime {
+	return r.GetDateTime(LedgerSummariesTo)
+}
+
+// SetTo sets the to field
+func (r *LedgerSummaries) SetTo(v types.DateTime) {
+	r.Set(LedgerSummariesTo, v)
+}
+
+// Count returns the count field
+func (r *LedgerSummaries) Count() int {
+	return r.GetInt(LedgerSummariesCount)
+}
+
+// SetCount sets the count field
+func (r *LedgerSummaries) SetCount(v int) {
+	r.Set(LedgerSummariesCount, v)
+}
+
+// Filter returns the filter field
+func (r *LedgerSummaries) Filter() string {
+	return r.GetString(LedgerSummariesFilter)
+}
+
+// SetFilter sets the filter field
+func (r *LedgerSummaries) SetFilter(v string) {
+	r.Set(LedgerSummariesFilter, v)
+}
+
+// Created returns the created field
+func (r *LedgerSummaries) Created() types.DateTime {
+	return r.GetDateTime(LedgerSummariesCreated)
+}
+
+// Fields of the maintenance collection
+const (
+	MaintenanceID        = "id"
+	MaintenanceSpace     = "space"
+	MaintenanceReason    = "reason"
+	MaintenanceStartedBy = "started_by"
+	MaintenanceStartedAt = "started_at"
+)
+
+// Maintenance is a typed record of the maintenance collection
+type Maintenance struct {
+	core.BaseRecordProxy
+}
+
+// NewMaintenance wraps a record of the maintenance collection
+func NewMaintenance(record *core.Record) *Maintenance {
+	r := &Maintenance{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Space returns the space field
+func (r *Maintenance) Space() string {
+	return r.GetString(MaintenanceSpace)
+}
+
+// SetSpace sets the space field
+func (r *Maintenance) SetSpace(v string) {
+	r.Set(MaintenanceSpace, v)
+}
+
+// Reason returns the reason field
+func (r *Maintenance) Reason() string {
+	return r.GetString(MaintenanceReason)
+}
+
+// SetReason sets the reason field
+func (r *Maintenance) SetReason(v string) {
+	r.Set(MaintenanceReason, v)
+}
+
+// StartedBy returns the started_by field
+func (r *Maintenance) StartedBy() string {
+	return r.GetString(MaintenanceStartedBy)
+}
+
+// SetStartedBy sets the started_by field
+func (r *Maintenance) SetStartedBy(v string) {
+	r.Set(MaintenanceStartedBy, v)
+}
+
+// StartedAt returns the started_at field
+func (r *Maintenance) StartedAt() types.DateTime {
+	return r.GetDateTime(MaintenanceStartedAt)
+}
+
+// SetStartedAt sets the started_at field
+func (r *Maintenance) SetStartedAt(v types.DateTime) {
+	r.Set(MaintenanceStartedAt, v)
+}
+
+// Fields of the preferences collection
+const (
+	PreferencesID                      = "id"
+	PreferencesUser                    = "user"
+	PreferencesBaseCurrency            = "base_currency"
+	PreferencesFirstDayOfWeek          = "first_day_of_week"
+	PreferencesDefaultWallet           = "default_wallet"
+	PreferencesDateFormat              = "date_format"
+	PreferencesNotifyIncidents         = "notify_incidents"
+	PreferencesNotifySubscriptions     = "notify_subscriptions"
+	PreferencesNotifyLargeTransactions = "notify_large_transactions"
+	PreferencesNotifyBalanceDrift      = "notify_balance_drift"
+	PreferencesVersion                 = "version"
+	PreferencesCreated                 = "created"
+	PreferencesUpdated                 = "updated"
+	PreferencesNotifyConsent           = "notify_consent"
+)
+
+// Preferences is a typed record of the preferences collection
+type Preferences struct {
+	core.BaseRecordProxy
+}
+
+// NewPreferences wraps a record of the preferences collection
+func NewPreferences(record *core.Record) *Preferences {
+	r := &Preferences{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// User returns the user field
+func (r *Preferences) User() string {
+	return r.GetString(PreferencesUser)
+}
+
+// SetUser sets the user field
+func (r *Preferences) SetUser(v string) {
+	r.Set(PreferencesUser, v)
+}
+
+// BaseCurrency returns the base_currency field
+func (r *Preferences) BaseCurrency() string {
+	return r.GetString(PreferencesBaseCurrency)
+}
+
+// SetBaseCurrency sets the base_currency field
+func (r *Preferences) SetBaseCurrency(v string) {
+	r.Set(PreferencesBaseCurrency, v)
+}
+
+// FirstDayOfWeek returns the first_day_of_week field
+func (r *Preferences) FirstDayOfWeek() int {
+	return r.GetInt(PreferencesFirstDayOfWeek)
+}
+
+// SetFirstDayOfWeek sets the first_day_of_week field
+func (r *Preferences) SetFirstDayOfWeek(v int) {
+	r.Set(PreferencesFirstDayOfWeek, v)
+}
+
+// DefaultWallet returns the default_wallet field
+func (r *Preferences) DefaultWallet() string {
+	return r.GetString(PreferencesDefaultWallet)
+}
+
+// SetDefaultWallet sets the default_wallet field
+func (r *Preferences) SetDefaultWallet(v string) {
+	r.Set(PreferencesDefaultWallet, v)
+}
+
+// DateFormat returns the date_format field
+func (r *Preferences) DateFormat() string {
+	return r.GetString(PreferencesDateFormat)
+}
+
+// SetDateFormat sets the date_format field
+func (r *Preferences) SetDateFormat(v string) {
+	r.Set(PreferencesDateFormat, v)
+}
+
+// NotifyIncidents returns the notify_incidents field
+func (r *Preferences) NotifyIncidents() bool {
+	return r.GetBool(PreferencesNotifyIncidents)
+}
+
+// SetNotifyIncidents sets the notify_incidents field
+func (r *Preferences) SetNotifyIncidents(v bool) {
+	r.Set(PreferencesNotifyIncidents, v)
+}
+
+// NotifySubscriptions returns the notify_subscriptions field
+func (r *Preferences) NotifySubscriptions() bool {
+	return r.GetBool(PreferencesNotifySubscriptions)
+}
+
+// SetNotifySubscriptions sets the notify_subscriptions field
+func (r *Preferences) SetNotifySubscriptions(v bool) {
+	r.Set(PreferencesNotifySubscriptions, v)
+}
+
+// NotifyLargeTransactions returns the notify_large_transactions field
+func (r *Preferences) NotifyLargeTransactions() float64 {
+	return r.GetFloat(PreferencesNotifyLargeTransactions)
+}
+
+// SetNotifyLargeTransactions sets the notify_large_transactions field
+func (r *Preferences) SetNotifyLargeTransactions(v float64) {
+	r.Set(PreferencesNotifyLargeTransactions, v)
+}
+
+// NotifyBalanceDrift returns the notify_balance_drift field
+func (r *Preferences) NotifyBalanceDrift() bool {
+	return r.GetBool(PreferencesNotifyBalanceDrift)
+}
+
+// SetNotifyBalanceDrift sets the notify_balance_drift field
+func (r *Preferences) SetNotifyBalanceDrift(v bool) {
+	r.Set(PreferencesNotifyBalanceDrift, v)
+}
+
+// Version returns the version field
+func (r *Preferences) Version() int {
+	return r.GetInt(PreferencesVersion)
+}
+
+// SetVersion sets the version field
+func (r *Preferences) SetVersion(v int) {
+	r.Set(PreferencesVersion, v)
+}
+
+// Created returns the created field
+func (r *Preferences) Created() types.DateTime {
+	return r.GetDateTime(PreferencesCreated)
+}
+
+// Updated returns the updated field
+func (r *Preferences) Updated() types.DateTime {
+	return r.GetDateTime(PreferencesUpdated)
+}
+
+// NotifyConsent returns the notify_consent field
+func (r *Preferences) NotifyConsent() bool {
+	return r.GetBool(PreferencesNotifyConsent)
+}
+
+// SetNotifyConsent sets the notify_consent field
+func (r *Preferences) SetNotifyConsent(v bool) {
+	r.Set(PreferencesNotifyConsent, v)
+}
+
+// Fields of the raw_payloads collection
+const (
+	RawPayloadsID         = "id"
+	RawPayloadsRunID      = "run_id"
+	RawPayloadsSourceID   = "source_id"
+	RawPayloadsMethod     = "method"
+	RawPayloadsURL        = "url"
+	RawPayloadsStatus     = "status"
+	RawPayloadsBody       = "body"
+	RawPayloadsCompressed = "compressed"
+	RawPayloadsReceivedAt = "received_at"
+	RawPayloadsCreated    = "created"
+)
+
+// RawPayloads is a typed record of the raw_payloads collection
+type RawPayloads struct {
+	core.BaseRecordProxy
+}
+
+// NewRawPayloads wraps a record of the raw_payloads collection
+func NewRawPayloads(record *core.Record) *RawPayloads {
+	r := &RawPayloads{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// RunID returns the run_id field
+func (r *RawPayloads) RunID() string {
+	return r.GetString(RawPayloadsRunID)
+}
+
+// SetRunID sets the run_id field
+func (r *RawPayloads) SetRunID(v string) {
+	r.Set(RawPayloadsRunID, v)
+}
+
+// SourceID returns the source_id field
+func (r *RawPayloads) SourceID() string {
+	return r.GetString(RawPayloadsSourceID)
+}
+
+// SetSourceID sets the source_id field
+func (r *RawPayloads) SetSourceID(v string) {
+	r.Set(RawPayloadsSourceID, v)
+}
+
+// Method returns the method field
+func (r *RawPayloads) Method() string {
+	return r.GetString(RawPayloadsMethod)
+}
+
+// SetMethod sets the method field
+func (r *RawPayloads) SetMethod(v string) {
+	r.Set(RawPayloadsMethod, v)
+}
+
+// URL returns the url field
+func (r *RawPayloads) URL() string {
+	return r.GetString(RawPayloadsURL)
+}
+
+// SetURL sets the url field
+func (r *RawPayloads) SetURL(v string) {
+	r.Set(RawPayloadsURL, v)
+}
+
+// Status returns the status field
+func (r *RawPayloads) Status() int {
+	return r.GetInt(RawPayloadsStatus)
+}
+
+// SetStatus sets the status field
+func (r *RawPayloads) SetStatus(v int) {
+	r.Set(RawPayloadsStatus, v)
+}
+
+// Body returns the body field
+func (r *RawPayloads) Body() string {
+	return r.GetString(RawPayloadsBody)
+}
+
+// SetBody sets the body field
+func (r *RawPayloads) SetBody(v string) {
+	r.Set(RawPayloadsBody, v)
+}
+
+// Compressed returns the compressed field
+func (r *RawPayloads) Compressed() bool {
+	return r.GetBool(RawPayloadsCompressed)
+}
+
+// SetCompressed sets the compressed field
+func (r *RawPayloads) SetCompressed(v bool) {
+	r.Set(RawPayloadsCompressed, v)
+}
+
+// ReceivedAt returns the received_at field
+func (r *RawPayloads) ReceivedAt() types.DateTime {
+	return r.GetDateTime(RawPayloadsReceivedAt)
+}
+
+// SetReceivedAt sets the received_at field
+func (r *RawPayloads) SetReceivedAt(v types.DateTime) {
+	r.Set(RawPayloadsReceivedAt, v)
+}
+
+// Created returns the created field
+func (r *RawPayloads) Created() types.DateTime {
+	return r.GetDateTime(RawPayloadsCreated)
+}
+
+// Fields of the secrets collection
+const (
+	SecretsID      = "id"
+	SecretsName    = "name"
+	SecretsValue   = "value"
+	SecretsCreated = "created"
+	SecretsUpdated = "updated"
+)
+
+// Secrets is a typed record of the secrets collection
+type Secrets struct {
+	core.BaseRecordProxy
+}
+
+// NewSecrets wraps a record of the secrets collection
+func NewSecrets(record *core.Record) *Secrets {
+	r := &Secrets{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Name returns the name field
+func (r *Secrets) Name() string {
+	return r.GetString(SecretsName)
+}
+
+// SetName sets the name field
+func (r *Secrets) SetName(v string) {
+	r.Set(SecretsName, v)
+}
+
+// Value returns the value field
+func (r *Secrets) Value() string {
+	return r.GetString(SecretsValue)
+}
+
+// SetValue sets the value field
+func (r *Secrets) SetValue(v string) {
+	r.Set(SecretsValue, v)
+}
+
+// Created returns the created field
+func (r *Secrets) Created() types.DateTime {
+	return r.GetDateTime(SecretsCreated)
+}
+
+// Updated returns the updated field
+func (r *Secrets) Updated() types.DateTime {
+	return r.GetDateTime(SecretsUpdated)
+}
+
+// Fields of the source_states collection
+const (
+	SourceStatesID                 = "id"
+	SourceStatesSourceID           = "source_id"
+	SourceStatesRuns               = "runs"
+	SourceStatesFailures           = "failures"
+	SourceStatesImported           = "imported"
+	SourceStatesLastError          = "last_error"
+	SourceStatesLastRunAt          = "last_run_at"
+	SourceStatesLastSuccessAt      = "last_success_at"
+	SourceStatesLifecycle          = "lifecycle"
+	SourceStatesLifecycleReason    = "lifecycle_reason"
+	SourceStatesTransitionedAt     = "transitioned_at"
+	SourceStatesConsentExpiresAt   = "consent_expires_at"
+	SourceStatesRenewal            = "renewal"
+	SourceStatesRenewalURL         = "renewal_url"
+	SourceStatesRenewalToken       = "renewal_token"
+	SourceStatesRenewalRequestedAt = "renewal_requested_at"
+	SourceStatesConsentSession     = "consent_session"
+)
+
+// SourceStates is a typed record of the source_states collection
+type SourceStates struct {
+	core.BaseRecordProxy
+}
+
+// NewSourceStates wraps a record of the source_states collection
+func NewSourceStates(record *core.Record) *SourceStates {
+	r := &SourceStates{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// SourceID returns the source_id field
+func (r *SourceStates) SourceID() string {
+	return r.GetString(SourceStatesSourceID)
+}
+
+// SetSourceID sets the source_id field
+func (r *SourceStates) SetSourceID(v string) {
+	r.Set(SourceStatesSourceID, v)
+}
+
+// Runs returns the runs field
+func (r *SourceStates) Runs() int {
+	return r.GetInt(SourceStatesRuns)
+}
+
+// SetRuns sets the runs field
+func (r *SourceStates) SetRuns(v int) {
+	r.Set(SourceStatesRuns, v)
+}
+
+// Failures returns the failures field
+func (r *SourceStates) Failures() int {
+	return r.GetInt(SourceStatesFailures)
+}
+
+// SetFailures sets the failures field
+func (r *SourceStates) SetFailures(v int) {
+	r.Set(SourceStatesFailures, v)
+}
+
+// Imported returns the imported field
+func (r *SourceStates) Imported() int {
+	return r.GetInt(SourceStatesImported)
+}
+
+// SetImported sets the imported field
+func (r *SourceStates) SetImported(v int) {
+	r.Set(SourceStatesImported, v)
+}
+
+// LastError returns the last_error field
+func (r *SourceStates) LastError() string {
+	return r.GetString(SourceStatesLastError)
+}
+
+// SetLastError sets the last_error field
+func (r *SourceStates) SetLastError(v string) {
+	r.Set(SourceStatesLastError, v)
+}
+
+// LastRunAt returns the last_run_at field
+func (r *SourceStates) LastRunAt() types.DateTime {
+	return r.GetDateTime(SourceStatesLastRunAt)
+}
+
+// SetLastRunAt sets the last_run_at field
+func (r *SourceStates) SetLastRunAt(v types.DateTime) {
+	r.Set(SourceStatesLastRunAt, v)
+}
+
+// LastSuccessAt returns the last_success_at field
+func (r *SourceStates) LastSuccessAt() types.DateTime {
+	return r.GetDateTime(SourceStatesLastSuccessAt)
+}
+
+// SetLastSuccessAt sets the last_success_at field
+func (r *SourceStates) SetLastSuccessAt(v types.DateTime) {
+	r.Set(SourceStatesLastSuccessAt, v)
+}
+
+// Lifecycle returns the lifecycle field
+func (r *SourceStates) Lifecycle() string {
+	return r.GetString(SourceStatesLifecycle)
+}
+
+// SetLifecycle sets the lifecycle field
+func (r *SourceStates) SetLifecycle(v string) {
+	r.Set(SourceStatesLifecycle, v)
+}
+
+// LifecycleReason returns the lifecycle_reason field
+func (r *SourceStates) LifecycleReason() string {
+	return r.GetString(SourceStatesLifecycleReason)
+}
+
+// SetLifecycleReason sets the lifecycle_reason field
+func (r *SourceStates) SetLifecycleReason(v string) {
+	r.Set(SourceStatesLifecycleReason, v)
+}
+
+// TransitionedAt returns the transitioned_at field
+func (r *SourceStates) TransitionedAt() types.DateTime {
+	return r.GetDateTime(SourceStatesTransitionedAt)
+}
+
+// SetTransitionedAt sets the transitioned_at field
+func (r *SourceStates) SetTransitionedAt(v types.DateTime) {
+	r.Set(SourceStatesTransitionedAt, v)
+}
+
+// ConsentExpiresAt returns the consent_expires_at field
+func (r *SourceStates) ConsentExpiresAt() types.DateTime {
+	return r.GetDateTime(SourceStatesConsentExpiresAt)
+}
+
+// SetConsentExpiresAt sets the consent_expires_at field
+func (r *SourceStates) SetConsentExpiresAt(v types.DateTime) {
+	r.Set(SourceStatesConsentExpiresAt, v)
+}
+
+// Renewal returns the renewal field
+func (r *SourceStates) Renewal() string {
+	return r.GetString(SourceStatesRenewal)
+}
+
+// SetRenewal sets the renewal field
+func (r *SourceStates) SetRenewal(v string) {
+	r.Set(SourceStatesRenewal, v)
+}
+
+// RenewalURL returns the renewal_url field
+func (r *SourceStates) RenewalURL() string {
+	return r.GetString(SourceStatesRenewalURL)
+}
+
+// SetRenewalURL sets the renewal_url field
+func (r *SourceStates) SetRenewalURL(v string) {
+	r.Set(SourceStatesRenewalURL, v)
+}
+
+// RenewalToken returns the renewal_token field
+func (r *SourceStates) RenewalToken() string {
+	return r.GetString(SourceStatesRenewalToken)
+}
+
+// SetRenewalToken sets the renewal_token field
+func (r *SourceStates) SetRenewalToken(v string) {
+	r.Set(SourceStatesRenewalToken, v)
+}
+
+// RenewalRequestedAt returns the renewal_requested_at field
+func (r *SourceStates) RenewalRequestedAt() types.DateTime {
+	return r.GetDateTime(SourceStatesRenewalRequestedAt)
+}
+
+// SetRenewalRequestedAt sets the renewal_requested_at field
+func (r *SourceStates) SetRenewalRequestedAt(v types.DateTime) {
+	r.Set(SourceStatesRenewalRequestedAt, v)
+}
+
+// ConsentSession returns the consent_session field
+func (r *SourceStates) ConsentSession() string {
+	return r.GetString(SourceStatesConsentSession)
+}
+
+// SetConsentSession sets the consent_session field
+func (r *SourceStates) SetConsentSession(v string) {
+	r.Set(SourceStatesConsentSession, v)
+}
+
+// Fields of the space_members collection
+const (
+	SpaceMembersID      = "id"
+	SpaceMembersSpace   = "space"
+	SpaceMembersUser    = "user"
+	SpaceMembersRole    = "role"
+	SpaceMembersCreated = "created"
+)
+
+// SpaceMembers is a typed record of the space_members collection
+type SpaceMembers struct {
+	core.BaseRecordProxy
+}
+
+// NewSpaceMembers wraps a record of the space_members collection
+func NewSpaceMembers(record *core.Record) *SpaceMembers {
+	r := &SpaceMembers{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Space returns the space field
+func (r *SpaceMembers) Space() string {
+	return r.GetString(SpaceMembersSpace)
+}
+
+// SetSpace sets the space field
+func (r *SpaceMembers) SetSpace(v string) {
+	r.Set(SpaceMembersSpace, v)
+}
+
+// User returns the user field
+func (r *SpaceMembers) User() string {
+	return r.GetString(SpaceMembersUser)
+}
+
+// SetUser sets the user field
+func (r *SpaceMembers) SetUser(v string) {
+	r.Set(SpaceMembersUser, v)
+}
+
+// Role returns the role field
+func (r *SpaceMembers) Role() string {
+	return r.GetString(SpaceMembersRole)
+}
+
+// SetRole sets the role field
+func (r *SpaceMembers) SetRole(v string) {
+	r.Set(SpaceMembersRole, v)
+}
+
+// Created returns the created field
+func (r *SpaceMembers) Created() types.DateTime {
+	return r.GetDateTime(SpaceMembersCreated)
+}
+
+// Fields of the spaces collection
+const (
+	SpacesID      = "id"
+	SpacesName    = "name"
+	SpacesKind    = "kind"
+	SpacesCreated = "created"
+	SpacesUpdated = "updated"
+)
+
+// Spaces is a typed record of the spaces collection
+type Spaces struct {
+	core.BaseRecordProxy
+}
+
+// NewSpaces wraps a record of the spaces collection
+func NewSpaces(record *core.Record) *Spaces {
+	r := &Spaces{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Name returns the name field
+func (r *Spaces) Name() string {
+	return r.GetString(SpacesName)
+}
+
+// SetName sets the name field
+func (r *Spaces) SetName(v string) {
+	r.Set(SpacesName, v)
+}
+
+// Kind returns the kind field
+func (r *Spaces) Kind() string {
+	return r.GetString(SpacesKind)
+}
+
+// SetKind sets the kind field
+func (r *Spaces) SetKind(v string) {
+	r.Set(SpacesKind, v)
+}
+
+// Created returns the created field
+func (r *Spaces) Created() types.DateTime {
+	return r.GetDateTime(SpacesCreated)
+}
+
+// Updated returns the updated field
+func (r *Spaces) Updated() types.DateTime {
+	return r.GetDateTime(SpacesUpdated)
+}
+
+// Fields of the subscriptions collection
+const (
+	SubscriptionsID             = "id"
+	SubscriptionsWallet         = "wallet"
+	SubscriptionsMerchant       = "merchant"
+	SubscriptionsName           = "name"
+	SubscriptionsCategory       = "category"
+	SubscriptionsInterval       = "interval"
+	SubscriptionsAmount         = "amount"
+	SubscriptionsPreviousAmount = "previous_amount"
+	SubscriptionsMonthlyCost    = "monthly_cost"
+	SubscriptionsCharges        = "charges"
+	SubscriptionsFirstChargeAt  = "first_charge_at"
+	SubscriptionsLastChargeAt   = "last_charge_at"
+	SubscriptionsNextChargeAt   = "next_charge_at"
+	SubscriptionsStatus         = "status"
+	SubscriptionsUpdated        = "updated"
+	SubscriptionsCurrency       = "currency"
+)
+
+// Subscriptions is a typed record of the subscriptions collection
+type Subscriptions struct {
+	core.BaseRecordProxy
+}
+
+// NewSubscriptions wraps a record of the subscriptions collection
+func NewSubscriptions(record *core.Record) *Subscriptions {
+	r := &Subscriptions{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Wallet returns the wallet field
+func (r *Subscriptions) Wallet() string {
+	return r.GetString(SubscriptionsWallet)
+}
+
+// SetWallet sets the wallet field
+func (r *Subscriptions) SetWallet(v string) {
+	r.Set(SubscriptionsWallet, v)
+}
+
+// Merchant returns the merchant field
+func (r *Subscriptions) Merchant() string {
+	return r.GetString(SubscriptionsMerchant)
+}
+
+// SetMerchant sets the merchant field
+func (r *Subscriptions) SetMerchant(v string) {
+	r.Set(SubscriptionsMerchant, v)
+}
+
+// Name returns the name field
+func (r *Subscriptions) Name() string {
+	return r.GetString(SubscriptionsName)
+}
+
+// SetName sets the name field
+func (r *Subscriptions) SetName(v string) {
+	r.Set(SubscriptionsName, v)
+}
+
+// Category returns the category field
+func (r *Subscriptions) Category() string {
+	return r.GetString(SubscriptionsCategory)
+}
+
+// SetCategory sets the category field
+func (r *Subscriptions) SetCategory(v string) {
+	r.Set(SubscriptionsCategory, v)
+}
+
+// Interval returns the interval field
+func (r *Subscriptions) Interval() string {
+	return r.GetString(SubscriptionsInterval)
+}
+
+// SetInterval sets the interval field
+func (r *Subscriptions) SetInterval(v string) {
+	r.Set(SubscriptionsInterval, v)
+}
+
+// Amount returns the amount field
+func (r *Subscriptions) Amount() float64 {
+	return r.GetFloat(SubscriptionsAmount)
+}
+
+// SetAmount sets the amount field
+func (r *Subscriptions) SetAmount(v float64) {
+	r.Set(SubscriptionsAmount, v)
+}
+
+// PreviousAmount returns the previous_amount field
+func (r *Subscriptions) PreviousAmount() float64 {
+	return r.GetFloat(SubscriptionsPreviousAmount)
+}
+
+// SetPreviousAmount sets the previous_amount field
+func (r *Subscriptions) SetPreviousAmount(v float64) {
+	r.Set(SubscriptionsPreviousAmount, v)
+}
+
+// MonthlyCost returns the monthly_cost field
+func (r *Subscriptions) MonthlyCost() float64 {
+	return r.GetFloat(SubscriptionsMonthlyCost)
+}
+
+// SetMonthlyCost sets the monthly_cost field
+func (r *Subscriptions) SetMonthlyCost(v float64) {
+	r.Set(SubscriptionsMonthlyCost, v)
+}
+
+// Charges returns the charges field
+func (r *Subscriptions) Charges() int {
+	return r.GetInt(SubscriptionsCharges)
+}
+
+// SetCharges sets the charges field
+func (r *Subscriptions) SetCharges(v int) {
+	r.Set(SubscriptionsCharges, v)
+}
+
+// FirstChargeAt returns the first_charge_at field
+func (r *Subscriptions) FirstChargeAt() types.DateTime {
+	return r.GetDateTime(SubscriptionsFirstChargeAt)
+}
+
+// SetFirstChargeAt sets the first_charge_at field
+func (r *Subscriptions) SetFirstChargeAt(v types.DateTime) {
+	r.Set(SubscriptionsFirstChargeAt, v)
+}
+
+// LastChargeAt returns the last_charge_at field
+func (r *Subscriptions) LastChargeAt() types.DateTime {
+	return r.GetDateTime(SubscriptionsLastChargeAt)
+}
+
+// SetLastChargeAt sets the last_charge_at field
+func (r *Subscriptions) SetLastChargeAt(v types.DateTime) {
+	r.Set(SubscriptionsLastChargeAt, v)
+}
+
+// NextChargeAt returns the next_charge_at field
+func (r *Subscriptions) NextChargeAt() types.DateTime {
+	return r.GetDateTime(SubscriptionsNextChargeAt)
+}
+
+// SetNextChargeAt sets the next_charge_at field
+func (r *Subscriptions) SetNextChargeAt(v types.DateTime) {
+	r.Set(SubscriptionsNextChargeAt, v)
+}
+
+// Status returns the status field
+func (r *Subscriptions) Status() string {
+	return r.GetString(SubscriptionsStatus)
+}
+
+// SetStatus sets the status field
+func (r *Subscriptions) SetStatus(v string) {
+	r.Set(SubscriptionsStatus, v)
+}
+
+// Updated returns the updated field
+func (r *Subscriptions) Updated() types.DateTime {
+	return r.GetDateTime(SubscriptionsUpdated)
+}
+
+// Currency returns the currency field
+func (r *Subscriptions) Currency() string {
+	return r.GetString(SubscriptionsCurrency)
+}
+
+// SetCurrency sets the currency field
+func (r *Subscriptions) SetCurrency(v string) {
+	r.Set(SubscriptionsCurrency, v)
+}
+
+// Fields of the tags collection
+const (
+	TagsID          = "id"
+	TagsName        = "name"
+	TagsColor       = "color"
+	TagsDescription = "description"
+	TagsVersion     = "version"
+	TagsCreated     = "created"
+	TagsUpdated     = "updated"
+)
+
+// Tags is a typed record of the tags collection
+type Tags struct {
+	core.BaseRecordProxy
+}
+
+// NewTags wraps a record of the tags collection
+func NewTags(record *core.Record) *Tags {
+	r := &Tags{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Name returns the name field
+func (r *Tags) Name() string {
+	return r.GetString(TagsName)
+}
+
+// SetName sets the name field
+func (r *Tags) SetName(v string) {
+	r.Set(TagsName, v)
+}
+
+// Color returns the color field
+func (r *Tags) Color() string {
+	return r.GetString(TagsColor)
+}
+
+// SetColor sets the color field
+func (r *Tags) SetColor(v string) {
+	r.Set(TagsColor, v)
+}
+
+// Description returns the description field
+func (r *Tags) Description() string {
+	return r.GetString(TagsDescription)
+}
+
+// SetDescription sets the description field
+func (r *Tags) SetDescription(v string) {
+	r.Set(TagsDescription, v)
+}
+
+// Version returns the version field
+func (r *Tags) Version() int {
+	return r.GetInt(TagsVersion)
+}
+
+// SetVersion sets the version field
+func (r *Tags) SetVersion(v int) {
+	r.Set(TagsVersion, v)
+}
+
+// Created returns the created field
+func (r *Tags) Created() types.DateTime {
+	return r.GetDateTime(TagsCreated)
+}
+
+// Updated returns the updated field
+func (r *Tags) Updated() types.DateTime {
+	return r.GetDateTime(TagsUpdated)
+}
+
+// Fields of the transaction_history collection
+const (
+	TransactionHistoryID                    = "id"
+	TransactionHistoryTransaction           = "transaction"
+	TransactionHistoryAction                = "action"
+	TransactionHistoryChanges               = "changes"
+	TransactionHistoryPerformedBy           = "performed_by"
+	TransactionHistoryPerformedAt           = "performed_at"
+	TransactionHistoryOldBalance            = "old_balance"
+	TransactionHistoryNewBalance            = "new_balance"
+	TransactionHistoryWallet                = "wallet"
+	TransactionHistoryDestinationWallet     = "destination_wallet"
+	TransactionHistoryOldDestinationBalance = "old_destination_balance"
+	TransactionHistoryNewDestinationBalance = "new_destination_balance"
+)
+
+// TransactionHistory is a typed record of the transaction_history collection
+type TransactionHistory struct {
+	core.BaseRecordProxy
+}
+
+// NewTransactionHistory wraps a record of the transaction_history collection
+func NewTransactionHistory(record *core.Record) *TransactionHistory {
+	r := &TransactionHistory{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Transaction returns the transaction field
+func (r *TransactionHistory) Transaction() string {
+	return r.GetString(TransactionHistoryTransaction)
+}
+
+// SetTransaction sets the transaction field
+func (r *TransactionHistory) SetTransaction(v string) {
+	r.Set(TransactionHistoryTransaction, v)
+}
+
+// Action returns the action field
+func (r *TransactionHistory) Action() string {
+	return r.GetString(TransactionHistoryAction)
+}
+
+// SetAction sets the action field
+func (r *TransactionHistory) SetAction(v string) {
+	r.Set(TransactionHistoryAction, v)
+}
+
+// Changes returns the changes field
+func (r *TransactionHistory) Changes() string {
+	return r.GetString(TransactionHistoryChanges)
+}
+
+// SetChanges sets the changes field
+func (r *TransactionHistory) SetChanges(v string) {
+	r.Set(TransactionHistoryChanges, v)
+}
+
+// PerformedBy returns the performed_by field
+func (r *TransactionHistory) PerformedBy() string {
+	return r.GetString(TransactionHistoryPerformedBy)
+}
+
+// SetPerformedBy sets the performed_by field
+func (r *TransactionHistory) SetPerformedBy(v string) {
+	r.Set(TransactionHistoryPerformedBy, v)
+}
+
+// PerformedAt returns the performed_at field
+func (r *TransactionHistory) PerformedAt() types.DateTime {
+	return r.GetDateTime(TransactionHistoryPerformedAt)
+}
+
+// SetPerformedAt sets the performed_at field
+func (r *TransactionHistory) SetPerformedAt(v types.DateTime) {
+	r.Set(TransactionHistoryPerformedAt, v)
+}
+
+// OldBalance returns the old_balance field
+func (r *TransactionHistory) OldBalance() float64 {
+	return r.GetFloat(TransactionHistoryOldBalance)
+}
+
+// SetOldBalance sets the old_balance field
+func (r *TransactionHistory) SetOldBalance(v float64) {
+	r.Set(TransactionHistoryOldBalance, v)
+}
+
+// NewBalance returns the new_balance field
+func (r *TransactionHistory) NewBalance() float64 {
+	return r.GetFloat(TransactionHistoryNewBalance)
+}
+
+// SetNewBalance sets the new_balance field
+func (r *TransactionHistory) SetNewBalance(v float64) {
+	r.Set(TransactionHistoryNewBalance, v)
+}
+
+// Wallet returns the wallet field
+func (r *TransactionHistory) Wallet() string {
+	return r.GetString(TransactionHistoryWallet)
+}
+
+// SetWallet sets the wallet field
+func (r *TransactionHistory) SetWallet(v string) {
+	r.Set(TransactionHistoryWallet, v)
+}
+
+// DestinationWallet returns the destination_wallet field
+func (r *TransactionHistory) DestinationWallet() string {
+	return r.GetString(TransactionHistoryDestinationWallet)
+}
+
+// SetDestinationWallet sets the destination_wallet field
+func (r *TransactionHistory) SetDestinationWallet(v string) {
+	r.Set(TransactionHistoryDestinationWallet, v)
+}
+
+// OldDestinationBalance returns the old_destination_balance field
+func (r *TransactionHistory) OldDestinationBalance() float64 {
+	return r.GetFloat(TransactionHistoryOldDestinationBalance)
+}
+
+// SetOldDestinationBalance sets the old_destination_balance field
+func (r *TransactionHistory) SetOldDestinationBalance(v float64) {
+	r.Set(TransactionHistoryOldDestinationBalance, v)
+}
+
+// NewDestinationBalance returns the new_destination_balance field
+func (r *TransactionHistory) NewDestinationBalance() float64 {
+	return r.GetFloat(TransactionHistoryNewDestinationBalance)
+}
+
+// SetNewDestinationBalance sets the new_destination_balance field
+func (r *TransactionHistory) SetNewDestinationBalance(v float64) {
+	r.Set(TransactionHistoryNewDestinationBalance, v)
+}
+
+// Fields of the transactions collection
+const (
+	TransactionsID                = "id"
+	TransactionsAmount            = "amount"
+	TransactionsDescription       = "description"
+	TransactionsDate              = "date"
+	TransactionsType              = "type"
+	TransactionsWallet            = "wallet"
+	TransactionsDestinationWallet = "destination_wallet"
+	TransactionsExchangeRate      = "exchange_rate"
+	TransactionsCategory          = "category"
+	TransactionsStatus            = "status"
+	TransactionsDeletedAt         = "deleted_at"
+	TransactionsFireflyID         = "firefly_id"
+	TransactionsVersion           = "version"
+	TransactionsTags              = "tags"
+	TransactionsNotes             = "notes"
+	TransactionsMetadata          = "metadata"
+	TransactionsFee               = "fee"
+	TransactionsReference         = "reference"
+	TransactionsEndToEndID        = "end_to_end_id"
+	TransactionsCreated           = "created"
+	TransactionsUpdated           = "updated"
+)
+
+// Transactions is a typed record of the transactions collection
+type Transactions struct {
+	core.BaseRecordProxy
+}
+
+// NewTransactions wraps a record of the transactions collection
+func NewTransactions(record *core.Record) *Transactions {
+	r := &Transactions{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Amount returns the amount field
+func (r *Transactions) Amount() float64 {
+	return r.GetFloat(TransactionsAmount)
+}
+
+// SetAmount sets the amount field
+func (r *Transactions) SetAmount(v float64) {
+	r.Set(TransactionsAmount, v)
+}
+
+// Description returns the description field
+func (r *Transactions) Description() string {
+	return r.GetString(TransactionsDescription)
+}
+
+// SetDescription sets the description field
+func (r *Transactions) SetDescription(v string) {
+	r.Set(TransactionsDescription, v)
+}
+
+// Date returns the date field
+func (r *Transactions) Date() types.DateTime {
+	return r.GetDateTime(TransactionsDate)
+}
+
+// SetDate sets the date field
+func (r *Transactions) SetDate(v types.DateTime) {
+	r.Set(TransactionsDate, v)
+}
+
+// Type returns the type field
+func (r *Transactions) Type() string {
+	return r.GetString(TransactionsType)
+}
+
+// SetType sets the type field
+func (r *Transactions) SetType(v string) {
+	r.Set(TransactionsType, v)
+}
+
+// Wallet returns the wallet field
+func (r *Transactions) Wallet() string {
+	return r.GetString(TransactionsWallet)
+}
+
+// SetWallet sets the wallet field
+func (r *Transactions) SetWallet(v string) {
+	r.Set(TransactionsWallet, v)
+}
+
+// DestinationWallet returns the destination_wallet field
+func (r *Transactions) DestinationWallet() string {
+	return r.GetString(TransactionsDestinationWallet)
+}
+
+// SetDestinationWallet sets the destination_wallet field
+func (r *Transactions) SetDestinationWallet(v string) {
+	r.Set(TransactionsDestinationWallet, v)
+}
+
+// ExchangeRate returns the exchange_rate field
+func (r *Transactions) ExchangeRate() float64 {
+	return r.GetFloat(TransactionsExchangeRate)
+}
+
+// SetExchangeRate sets the exchange_rate field
+func (r *Transactions) SetExchangeRate(v float64) {
+	r.Set(TransactionsExchangeRate, v)
+}
+
+// Category returns the category field
+func (r *Transactions) Category() string {
+	return r.GetString(TransactionsCategory)
+}
+
+// SetCategory sets the category field
+func (r *Transactions) SetCategory(v string) {
+	r.Set(TransactionsCategory, v)
+}
+
+// Status returns the status field
+func (r *Transactions) Status() string {
+	return r.GetString(TransactionsStatus)
+}
+
+// SetStatus sets the status field
+func (r *Transactions) SetStatus(v string) {
+	r.Set(TransactionsStatus, v)
+}
+
+// DeletedAt returns the deleted_at field
+func (r *Transactions) DeletedAt() types.DateTime {
+	return r.GetDateTime(TransactionsDeletedAt)
+}
+
+// SetDeletedAt sets the deleted_at field
+func (r *Transactions) SetDeletedAt(v types.DateTime) {
+	r.Set(TransactionsDeletedAt, v)
+}
+
+// FireflyID returns the firefly_id field
+func (r *Transactions) FireflyID() string {
+	return r.GetString(TransactionsFireflyID)
+}
+
+// SetFireflyID sets the firefly_id field
+func (r *Transactions) SetFireflyID(v string) {
+	r.Set(TransactionsFireflyID, v)
+}
+
+// Version returns the version field
+func (r *Transactions) Version() int {
+	return r.GetInt(TransactionsVersion)
+}
+
+// SetVersion sets the version field
+func (r *Transactions) SetVersion(v int) {
+	r.Set(TransactionsVersion, v)
+}
+
+// UnmarshalTags decodes the tags field into v
+func (r *Transactions) UnmarshalTags(v any) error {
+	return r.UnmarshalJSONField(TransactionsTags, v)
+}
+
+// SetTags sets the tags field
+func (r *Transactions) SetTags(v any) {
+	r.Set(TransactionsTags, v)
+}
+
+// Notes returns the notes field
+func (r *Transactions) Notes() string {
+	return r.GetString(TransactionsNotes)
+}
+
+// SetNotes sets the notes field
+func (r *Transactions) SetNotes(v string) {
+	r.Set(TransactionsNotes, v)
+}
+
+// UnmarshalMetadata decodes the metadata field into v
+func (r *Transactions) UnmarshalMetadata(v any) error {
+	return r.UnmarshalJSONField(TransactionsMetadata, v)
+}
+
+// SetMetadata sets the metadata field
+func (r *Transactions) SetMetadata(v any) {
+	r.Set(TransactionsMetadata, v)
+}
+
+// Fee returns the fee field
+func (r *Transactions) Fee() float64 {
+	return r.GetFloat(TransactionsFee)
+}
+
+// SetFee sets the fee field
+func (r *Transactions) SetFee(v float64) {
+	r.Set(TransactionsFee, v)
+}
+
+// Reference returns the reference field
+func (r *Transactions) Reference() string {
+	return r.GetString(TransactionsReference)
+}
+
+// SetReference sets the reference field
+func (r *Transactions) SetReference(v string) {
+	r.Set(TransactionsReference, v)
+}
+
+// EndToEndID returns the end_to_end_id field
+func (r *Transactions) EndToEndID() string {
+	return r.GetString(TransactionsEndToEndID)
+}
+
+// SetEndToEndID sets the end_to_end_id field
+func (r *Transactions) SetEndToEndID(v string) {
+	r.Set(TransactionsEndToEndID, v)
+}
+
+// Created returns the created field
+func (r *Transactions) Created() types.DateTime {
+	return r.GetDateTime(TransactionsCreated)
+}
+
+// Updated returns the updated field
+func (r *Transactions) Updated() types.DateTime {
+	return r.GetDateTime(TransactionsUpdated)
+}
+
+// Fields of the transformation_rules collection
+const (
+	TransformationRulesID       = "id"
+	TransformationRulesName     = "name"
+	TransformationRulesSource   = "source"
+	TransformationRulesScript   = "script"
+	TransformationRulesPriority = "priority"
+	TransformationRulesEnabled  = "enabled"
+	TransformationRulesCreated  = "created"
+	TransformationRulesUpdated  = "updated"
+)
+
+// TransformationRules is a typed record of the transformation_rules collection
+type TransformationRules struct {
+	core.BaseRecordProxy
+}
+
+// NewTransformationRules wraps a record of the transformation_rules collection
+func NewTransformationRules(record *core.Record) *TransformationRules {
+	r := &TransformationRules{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Name returns the name field
+func (r *TransformationRules) Name() string {
+	return r.GetString(TransformationRulesName)
+}
+
+// SetName sets the name field
+func (r *TransformationRules) SetName(v string) {
+	r.Set(TransformationRulesName, v)
+}
+
+// Source returns the source field
+func (r *TransformationRules) Source() string {
+	return r.GetString(TransformationRulesSource)
+}
+
+// SetSource sets the source field
+func (r *TransformationRules) SetSource(v string) {
+	r.Set(TransformationRulesSource, v)
+}
+
+// Script returns the script field
+func (r *TransformationRules) Script() string {
+	return r.GetString(TransformationRulesScript)
+}
+
+// SetScript sets the script field
+func (r *TransformationRules) SetScript(v string) {
+	r.Set(TransformationRulesScript, v)
+}
+
+// Priority returns the priority field
+func (r *TransformationRules) Priority() int {
+	return r.GetInt(TransformationRulesPriority)
+}
+
+// SetPriority sets the priority field
+func (r *TransformationRules) SetPriority(v int) {
+	r.Set(TransformationRulesPriority, v)
+}
+
+// Enabled returns the enabled field
+func (r *TransformationRules) Enabled() bool {
+	return r.GetBool(TransformationRulesEnabled)
+}
+
+// SetEnabled sets the enabled field
+func (r *TransformationRules) SetEnabled(v bool) {
+	r.Set(TransformationRulesEnabled, v)
+}
+
+// Created returns the created field
+func (r *TransformationRules) Created() types.DateTime {
+	return r.GetDateTime(TransformationRulesCreated)
+}
+
+// Updated returns the updated field
+func (r *TransformationRules) Updated() types.DateTime {
+	return r.GetDateTime(TransformationRulesUpdated)
+}
+
+// Fields of the users collection
+const (
+	UsersID              = "id"
+	UsersPassword        = "password"
+	UsersTokenKey        = "tokenKey"
+	UsersEmail           = "email"
+	UsersEmailVisibility = "emailVisibility"
+	UsersVerified        = "verified"
+	UsersName            = "name"
+	UsersAvatar          = "avatar"
+	UsersCreated         = "created"
+	UsersUpdated         = "updated"
+)
+
+// Users is a typed record of the users collection
+type Users struct {
+	core.BaseRecordProxy
+}
+
+// NewUsers wraps a record of the users collection
+func NewUsers(record *core.Record) *Users {
+	r := &Users{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Name returns the name field
+func (r *Users) Name() string {
+	return r.GetString(UsersName)
+}
+
+// SetName sets the name field
+func (r *Users) SetName(v string) {
+	r.Set(UsersName, v)
+}
+
+// Avatar returns the avatar field
+func (r *Users) Avatar() string {
+	return r.GetString(UsersAvatar)
+}
+
+// SetAvatar sets the avatar field
+func (r *Users) SetAvatar(v string) {
+	r.Set(UsersAvatar, v)
+}
+
+// Created returns the created field
+func (r *Users) Created() types.DateTime {
+	return r.GetDateTime(UsersCreated)
+}
+
+// Updated returns the updated field
+func (r *Users) Updated() types.DateTime {
+	return r.GetDateTime(UsersUpdated)
+}
+
+// Fields of the wallets collection
+const (
+	WalletsID             = "id"
+	WalletsName           = "name"
+	WalletsBalance        = "balance"
+	WalletsCurrency       = "currency"
+	WalletsType           = "type"
+	WalletsArchived       = "archived"
+	WalletsArchivedAt     = "archived_at"
+	WalletsVersion        = "version"
+	WalletsSpace          = "space"
+	WalletsCreated        = "created"
+	WalletsUpdated        = "updated"
+	WalletsOpeningBalance = "opening_balance"
+)
+
+// Wallets is a typed record of the wallets collection
+type Wallets struct {
+	core.BaseRecordProxy
+}
+
+// NewWallets wraps a record of the wallets collection
+func NewWallets(record *core.Record) *Wallets {
+	r := &Wallets{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Name returns the name field
+func (r *Wallets) Name() string {
+	return r.GetString(WalletsName)
+}
+
+// SetName sets the name field
+func (r *Wallets) SetName(v string) {
+	r.Set(WalletsName, v)
+}
+
+// Balance returns the balance field
+func (r *Wallets) Balance() float64 {
+	return r.GetFloat(WalletsBalance)
+}
+
+// SetBalance sets the balance field
+func (r *Wallets) SetBalance(v float64) {
+	r.Set(WalletsBalance, v)
+}
+
+// Currency returns the currency field
+func (r *Wallets) Currency() string {
+	return r.GetString(WalletsCurrency)
+}
+
+// SetCurrency sets the currency field
+func (r *Wallets) SetCurrency(v string) {
+	r.Set(WalletsCurrency, v)
+}
+
+// Type returns the type field
+func (r *Wallets) Type() string {
+	return r.GetString(WalletsType)
+}
+
+// SetType sets the type field
+func (r *Wallets) SetType(v string) {
+	r.Set(WalletsType, v)
+}
+
+// Archived returns the archived field
+func (r *Wallets) Archived() bool {
+	return r.GetBool(WalletsArchived)
+}
+
+// SetArchived sets the archived field
+func (r *Wallets) SetArchived(v bool) {
+	r.Set(WalletsArchived, v)
+}
+
+// ArchivedAt returns the archived_at field
+func (r *Wallets) ArchivedAt() types.DateTime {
+	return r.GetDateTime(WalletsArchivedAt)
+}
+
+// SetArchivedAt sets the archived_at field
+func (r *Wallets) SetArchivedAt(v types.DateTime) {
+	r.Set(WalletsArchivedAt, v)
+}
+
+// Version returns the version field
+func (r *Wallets) Version() int {
+	return r.GetInt(WalletsVersion)
+}
+
+// SetVersion sets the version field
+func (r *Wallets) SetVersion(v int) {
+	r.Set(WalletsVersion, v)
+}
+
+// Space returns the space field
+func (r *Wallets) Space() string {
+	return r.GetString(WalletsSpace)
+}
+
+// SetSpace sets the space field
+func (r *Wallets) SetSpace(v string) {
+	r.Set(WalletsSpace, v)
+}
+
+// Created returns the created field
+func (r *Wallets) Created() types.DateTime {
+	return r.GetDateTime(WalletsCreated)
+}
+
+// Updated returns the updated field
+func (r *Wallets) Updated() types.DateTime {
+	return r.GetDateTime(WalletsUpdated)
+}
+
+// OpeningBalance returns the opening_balance field
+func (r *Wallets) OpeningBalance() float64 {
+	return r.GetFloat(WalletsOpeningBalance)
+}
+
+// SetOpeningBalance sets the opening_balance field
+func (r *Wallets) SetOpeningBalance(v float64) {
+	r.Set(WalletsOpeningBalance, v)
+}
+
+// Fields of the webhook_deliveries collection
+const (
+	WebhookDeliveriesID             = "id"
+	WebhookDeliveriesWebhook        = "webhook"
+	WebhookDeliveriesEventID        = "event_id"
+	WebhookDeliveriesEventType      = "event_type"
+	WebhookDeliveriesPayload        = "payload"
+	WebhookDeliveriesStatus         = "status"
+	WebhookDeliveriesAttempts       = "attempts"
+	WebhookDeliveriesResponseStatus = "response_status"
+	WebhookDeliveriesLastError      = "last_error"
+	WebhookDeliveriesNextAttemptAt  = "next_attempt_at"
+	WebhookDeliveriesDeliveredAt    = "delivered_at"
+	WebhookDeliveriesCreated        = "created"
+)
+
+// WebhookDeliveries is a typed record of the webhook_deliveries collection
+type WebhookDeliveries struct {
+	core.BaseRecordProxy
+}
+
+// NewWebhookDeliveries wraps a record of the webhook_deliveries collection
+func NewWebhookDeliveries(record *core.Record) *WebhookDeliveries {
+	r := &WebhookDeliveries{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// Webhook returns the webhook field
+func (r *WebhookDeliveries) Webhook() string {
+	return r.GetString(WebhookDeliveriesWebhook)
+}
+
+// SetWebhook sets the webhook field
+func (r *WebhookDeliveries) SetWebhook(v string) {
+	r.Set(WebhookDeliveriesWebhook, v)
+}
+
+// EventID returns the event_id field
+func (r *WebhookDeliveries) EventID() string {
+	return r.GetString(WebhookDeliveriesEventID)
+}
+
+// SetEventID sets the event_id field
+func (r *WebhookDeliveries) SetEventID(v string) {
+	r.Set(WebhookDeliveriesEventID, v)
+}
+
+// EventType returns the event_type field
+func (r *WebhookDeliveries) EventType() string {
+	return r.GetString(WebhookDeliveriesEventType)
+}
+
+// SetEventType sets the event_type field
+func (r *WebhookDeliveries) SetEventType(v string) {
+	r.Set(WebhookDeliveriesEventType, v)
+}
+
+// UnmarshalPayload decodes the payload field into v
+func (r *WebhookDeliveries) UnmarshalPayload(v any) error {
+	return r.UnmarshalJSONField(WebhookDeliveriesPayload, v)
+}
+
+// SetPayload sets the payload field
+func (r *WebhookDeliveries) SetPayload(v any) {
+	r.Set(WebhookDeliveriesPayload, v)
+}
+
+// Status returns the status field
+func (r *WebhookDeliveries) Status() string {
+	return r.GetString(WebhookDeliveriesStatus)
+}
+
+// SetStatus sets the status field
+func (r *WebhookDeliveries) SetStatus(v string) {
+	r.Set(WebhookDeliveriesStatus, v)
+}
+
+// Attempts returns the attempts field
+func (r *WebhookDeliveries) Attempts() int {
+	return r.GetInt(WebhookDeliveriesAttempts)
+}
+
+// SetAttempts sets the attempts field
+func (r *WebhookDeliveries) SetAttempts(v int) {
+	r.Set(WebhookDeliveriesAttempts, v)
+}
+
+// ResponseStatus returns the response_status field
+func (r *WebhookDeliveries) ResponseStatus() int {
+	return r.GetInt(WebhookDeliveriesResponseStatus)
+}
+
+// SetResponseStatus sets the response_status field
+func (r *WebhookDeliveries) SetResponseStatus(v int) {
+	r.Set(WebhookDeliveriesResponseStatus, v)
+}
+
+// LastError returns the last_error field
+func (r *WebhookDeliveries) LastError() string {
+	return r.GetString(WebhookDeliveriesLastError)
+}
+
+// SetLastError sets the last_error field
+func (r *WebhookDeliveries) SetLastError(v string) {
+	r.Set(WebhookDeliveriesLastError, v)
+}
+
+// NextAttemptAt returns the next_attempt_at field
+func (r *WebhookDeliveries) NextAttemptAt() types.DateTime {
+	return r.GetDateTime(WebhookDeliveriesNextAttemptAt)
+}
+
+// SetNextAttemptAt sets the next_attempt_at field
+func (r *WebhookDeliveries) SetNextAttemptAt(v types.DateTime) {
+	r.Set(WebhookDeliveriesNextAttemptAt, v)
+}
+
+// DeliveredAt returns the delivered_at field
+func (r *WebhookDeliveries) DeliveredAt() types.DateTime {
+	return r.GetDateTime(WebhookDeliveriesDeliveredAt)
+}
+
+// SetDeliveredAt sets the delivered_at field
+func (r *WebhookDeliveries) SetDeliveredAt(v types.DateTime) {
+	r.Set(WebhookDeliveriesDeliveredAt, v)
+}
+
+// Created returns the created field
+func (r *WebhookDeliveries) Created() types.DateTime {
+	return r.GetDateTime(WebhookDeliveriesCreated)
+}
+
+// Fields of the webhooks collection
+const (
+	WebhooksID          = "id"
+	WebhooksURL         = "url"
+	WebhooksSecret      = "secret"
+	WebhooksEvents      = "events"
+	WebhooksEnabled     = "enabled"
+	WebhooksDescription = "description"
+	WebhooksCreated     = "created"
+	WebhooksUpdated     = "updated"
+)
+
+// Webhooks is a typed record of the webhooks collection
+type Webhooks struct {
+	core.BaseRecordProxy
+}
+
+// NewWebhooks wraps a record of the webhooks collection
+func NewWebhooks(record *core.Record) *Webhooks {
+	r := &Webhooks{}
+	r.SetProxyRecord(record)
+	return r
+}
+
+// URL returns the url field
+func (r *Webhooks) URL() string {
+	return r.GetString(WebhooksURL)
+}
+
+// SetURL sets the url field
+func (r *Webhooks) SetURL(v string) {
+	r.Set(WebhooksURL, v)
+}
+
+// Secret returns the secret field
+func (r *Webhooks) Secret() string {
+	return r.GetString(WebhooksSecret)
+}
+
+// SetSecret sets the secret field
+func (r *Webhooks) SetSecret(v string) {
+	r.Set(WebhooksSecret, v)
+}
+
+// UnmarshalEvents decodes the events field into v
+func (r *Webhooks) UnmarshalEvents(v any) error {
+	return r.UnmarshalJSONField(WebhooksEvents, v)
+}
+
+// SetEvents sets the events field
+func (r *Webhooks) SetEvents(v any) {
+	r.Set(WebhooksEvents, v)
+}
+
+// Enabled returns the enabled field
+func (r *Webhooks) Enabled() bool {
+	return r.GetBool(WebhooksEnabled)
+}
+
+// SetEnabled sets the enabled field
+func (r *Webhooks) SetEnabled(v bool) {
+	r.Set(WebhooksEnabled, v)
+}
+
+// Description returns the description field
+func (r *Webhooks) Description() string {
+	return r.GetString(WebhooksDescription)
+}
+
+// SetDescription sets the description field
+func (r *Webhooks) SetDescription(v string) {
+	r.Set(WebhooksDescription, v)
+}
+
+// Created returns the created field
+func (r *Webhooks) Created() types.DateTime {
+	return r.GetDateTime(WebhooksCreated)
+}
+
+// Updated returns the updated field
+func (r *Webhooks) Updated() types.DateTime {
+	return r.GetDateTime(WebhooksUpdated)
+}
+
+// Snapshot is the schema the code was generated from
+var Snapshot = []Collection{
+	{Name: CollectionAccountMappings, Fields: []Field{
+		{Name: AccountMappingsID, Type: "text"},
+		{Name: AccountMappingsSource, Type: "text"},
+		{Name: AccountMappingsSourceAccount, Type: "text"},
+		{Name: AccountMappingsFireflyAccountID, Type: "text"},
+		{Name: AccountMappingsIBAN, Type: "text"},
+		{Name: AccountMappingsAutoCreate, Type: "bool"},
+		{Name: AccountMappingsCreated, Type: "autodate"},
+		{Name: AccountMappingsUpdated, Type: "autodate"},
+	}},
+	{Name: CollectionAuditLog, Fields: []Field{
+		{Name: AuditLogID, Type: "text"},
+		{Name: AuditLogAction, Type: "select"},
+		{Name: AuditLogCollectionName, Type: "text"},
+		{Name: AuditLogRecord, Type: "text"},
+		{Name: AuditLogRequest, Type: "text"},
+		{Name: AuditLogStatus, Type: "number"},
+		{Name: AuditLogActor, Type: "text"},
+		{Name: AuditLogActorType, Type: "select"},
+		{Name: AuditLogSource, Type: "select"},
+		{Name: AuditLogChanges, Type: "json"},
+		{Name: AuditLogAt, Type: "date"},
+	}},
+	{Name: CollectionBackfills, Fields: []Field{
+		{Name: BackfillsID, Type: "text"},
+		{Name: BackfillsSourceID, Type: "text"},
+		{Name: BackfillsStatus, Type: "select"},
+		{Name: BackfillsCursor, Type: "text"},
+		{Name: BackfillsPages, Type: "number"},
+		{Name: BackfillsImported, Type: "number"},
+		{Name: BackfillsProgress, Type: "number"},
+		{Name: BackfillsError, Type: "text"},
+		{Name: BackfillsStartedAt, Type: "date"},
+		{Name: BackfillsUpdatedAt, Type: "date"},
+		{Name: BackfillsCompletedAt, Type: "date"},
+	}},
+	{Name: CollectionBalanceAssertionResults, Fields: []Field{
+		{Name: BalanceAssertionResultsID, Type: "text"},
+		{Name: BalanceAssertionResultsAssertion, Type: "relation"},
+		{Name: BalanceAssertionResultsWallet, Type: "relation"},
+		{Name: BalanceAssertionResultsCheckedAt, Type: "date"},
+		{Name: BalanceAssertionResultsCurrency, Type: "text"},
+		{Name: BalanceAssertionResultsBalances, Type: "json"},
+		{Name: BalanceAssertionResultsDrift, Type: "number"},
+		{Name: BalanceAssertionResultsTolerance, Type: "number"},
+		{Name: BalanceAssertionResultsPassed, Type: "bool"},
+		{Name: BalanceAssertionResultsError, Type: "text"},
+	}},
+	{Name: CollectionBalanceAssertions, Fields: []Field{
+		{Name: BalanceAssertionsID, Type: "text"},
+		{Name: BalanceAssertionsName, Type: "text"},
+		{Name: BalanceAssertionsSource, Type: "text"},
+		{Name: BalanceAssertionsCompare, Type: "json"},
+		{Name: BalanceAssertionsSchedule, Type: "text"},
+		{Name: BalanceAssertionsTolerance, Type: "number"},
+		{Name: BalanceAssertionsEnabled, Type: "bool"},
+		{Name: BalanceAssertionsCreated, Type: "autodate"},
+		{Name: BalanceAssertionsUpdated, Type: "autodate"},
+	}},
+	{Name: CollectionBalanceSnapshots, Fields: []Field{
+		{Name: BalanceSnapshotsID, Type: "text"},
+		{Name: BalanceSnapshotsWallet, Type: "relation"},
+		{Name: BalanceSnapshotsBalance, Type: "number"},
+		{Name: BalanceSnapshotsCurrency, Type: "text"},
+		{Name: BalanceSnapshotsSource, Type: "text"},
+		{Name: BalanceSnapshotsTakenAt, Type: "date"},
+		{Name: BalanceSnapshotsBalanceType, Type: "text"},
+	}},
+	{Name: CollectionCalendarFeeds, Fields: []Field{
+		{Name: CalendarFeedsID, Type: "text"},
+		{Name: CalendarFeedsUser, Type: "relation"},
+		{Name: CalendarFeedsTokenHash, Type: "text"},
+		{Name: CalendarFeedsCreated, Type: "autodate"},
+		{Name: CalendarFeedsUpdated, Type: "autodate"},
+	}},
+	{Name: CollectionCategories, Fields: []Field{
+		{Name: CategoriesID, Type: "text"},
+		{Name: CategoriesName, Type: "text"},
+		{Name: CategoriesDescription, Type: "text"},
+		{Name: CategoriesType, Type: "select"},
+		{Name: CategoriesColor, Type: "text"},
+		{Name: CategoriesIsSystem, Type: "bool"},
+		{Name: CategoriesVersion, Type: "number"},
+		{Name: CategoriesSpace, Type: "relation"},
+		{Name: CategoriesKey, Type: "text"},
+		{Name: CategoriesParent, Type: "relation"},
+		{Name: CategoriesCreated, Type: "autodate"},
+		{Name: CategoriesUpdated, Type: "autodate"},
+	}},
+	{Name: CollectionDataKeys, Fields: []Field{
+		{Name: DataKeysID, Type: "text"},
+		{Name: DataKeysSpace, Type: "relation"},
+		{Name: DataKeysKey, Type: "text"},
+		{Name: DataKeysActive, Type: "bool"},
+		{Name: DataKeysCreated, Type: "autodate"},
+	}},
+	{Name: CollectionEventOutbox, Fields: []Field{
+		{Name: EventOutboxID, Type: "text"},
+		{Name: EventOutboxEventID, Type: "text"},
+		{Name: EventOutboxType, Type: "text"},
+		{Name: EventOutboxPayload, Type: "json"},
+		{Name: EventOutboxAttempts, Type: "number"},
+		{Name: EventOutboxLastError, Type: "text"},
+		{Name: EventOutboxPublishedAt, Type: "date"},
+		{Name: EventOutboxCreated, Type: "autodate"},
+	}},
+	{Name: CollectionFireflyOutbox, Fields: []Field{
+		{Name: FireflyOutboxID, Type: "text"},
+		{Name: FireflyOutboxTransaction, Type: "relation"},
+		{Name: FireflyOutboxStatus, Type: "select"},
+		{Name: FireflyOutboxAttempts, Type: "number"},
+		{Name: FireflyOutboxLastError, Type: "text"},
+		{Name: FireflyOutboxFireflyID, Type: "text"},
+		{Name: FireflyOutboxNextAttemptAt, Type: "date"},
+		{Name: FireflyOutboxDeliveredAt, Type: "date"},
+		{Name: FireflyOutboxCreated, Type: "autodate"},
+	}},
+	{Name: CollectionImportRuns, Fields: []Field{
+		{Name: ImportRunsID, Type: "text"},
+		{Name: ImportRunsCycleID, Type: "text"},
+		{Name: ImportRunsStartedAt, Type: "date"},
+		{Name: ImportRunsFinishedAt, Type: "date"},
+		{Name: ImportRunsImported, Type: "number"},
+		{Name: ImportRunsFailed, Type: "number"},
+		{Name: ImportRunsSnapshots, Type: "number"},
+		{Name: ImportRunsSources, Type: "json"},
+	}},
+	{Name: CollectionIncidents, Fields: []Field{
+		{Name: IncidentsID, Type: "text"},
+		{Name: IncidentsProvider, Type: "text"},
+		{Name: IncidentsErrorClass, Type: "text"},
+		{Name: IncidentsLastError, Type: "text"},
+		{Name: IncidentsFailures, Type: "number"},
+		{Name: IncidentsStartedAt, Type: "date"},
+		{Name: IncidentsEndedAt, Type: "date"},
+	}},
+	{Name: CollectionLedgerSummaries, Fields: []Field{
+		{Name: LedgerSummariesID, Type: "text"},
+		{Name: LedgerSummariesWallet, Type: "relation"},
+		{Name: LedgerSummariesFrom, Type: "date"},
+		{Name: LedgerSummariesTo, Type: "date"},
+		{Name: LedgerSummariesCount, Type: "number"},
+		{Name: LedgerSummariesFilter, Type: "text"},
+		{Name: LedgerSummariesCreated, Type: "autodate"},
+	}},
+	{Name: CollectionMaintenance, Fields: []Field{
+		{Name: MaintenanceID, Type: "text"},
+		{Name: MaintenanceSpace, Type: "relation"},
+		{Name: MaintenanceReason, Type: "text"},
+		{Name: MaintenanceStartedBy, Type: "text"},
+		{Name: MaintenanceStartedAt, Type: "date"},
+	}},
+	{Name: CollectionPreferences, Fields: []Field{
+		{Name: PreferencesID, Type: "text"},
+		{Name: PreferencesUser, Type: "relation"},
+		{Name: PreferencesBaseCurrency, Type: "text"},
+		{Name: PreferencesFirstDayOfWeek, Type: "number"},
+		{Name: PreferencesDefaultWallet, Type: "relation"},
+		{Name: PreferencesDateFormat, Type: "text"},
+		{Name: PreferencesNotifyIncidents, Type: "bool"},
+		{Name: PreferencesNotifySubscriptions, Type: "bool"},
+		{Name: PreferencesNotifyLargeTransactions, Type: "number"},
+		{Name: PreferencesNotifyBalanceDrift, Type: "bool"},
+		{Name: PreferencesVersion, Type: "number"},
+		{Name: PreferencesCreated, Type: "autodate"},
+		{Name: PreferencesUpdated, Type: "autodate"},
+		{Name: PreferencesNotifyConsent, Type: "bool"},
+	}},
+	{Name: CollectionRawPayloads, Fields: []Field{
+		{Name: RawPayloadsID, Type: "text"},
+		{Name: RawPayloadsRunID, Type: "text"},
+		{Name: RawPayloadsSourceID, Type: "text"},
+		{Name: RawPayloadsMethod, Type: "text"},
+		{Name: RawPayloadsURL, Type: "text"},
+		{Name: RawPayloadsStatus, Type: "number"},
+		{Name: RawPayloadsBody, Type: "text"},
+		{Name: RawPayloadsCompressed, Type: "bool"},
+		{Name: RawPayloadsReceivedAt, Type: "date"},
+		{Name: RawPayloadsCreated, Type: "autodate"},
+	}},
+	{Name: CollectionSecrets, Fields: []Field{
+		{Name: SecretsID, Type: "text"},
+		{Name: SecretsName, Type: "text"},
+		{Name: SecretsValue, Type: "text"},
+		{Name: SecretsCreated, Type: "autodate"},
+		{Name: SecretsUpdated, Type: "autodate"},
+	}},
+	{Name: CollectionSourceStates, Fields: []Field{
+		{Name: SourceStatesID, Type: "text"},
+		{Name: SourceStatesSourceID, Type: "text"},
+		{Name: SourceStatesRuns, Type: "number"},
+		{Name: SourceStatesFailures, Type: "number"},
+		{Name: SourceStatesImported, Type: "number"},
+		{Name: SourceStatesLastError, Type: "text"},
+		{Name: SourceStatesLastRunAt, Type: "date"},
+		{Name: SourceStatesLastSuccessAt, Type: "date"},
+		{Name: SourceStatesLifecycle, Type: "select"},
+		{Name: SourceStatesLifecycleReason, Type: "text"},
+		{Name: SourceStatesTransitionedAt, Type: "date"},
+		{Name: SourceStatesConsentExpiresAt, Type: "date"},
+		{Name: SourceStatesRenewal, Type: "select"},
+		{Name: SourceStatesRenewalURL, Type: "text"},
+		{Name: SourceStatesRenewalToken, Type: "text"},
+		{Name: SourceStatesRenewalRequestedAt, Type: "date"},
+		{Name: SourceStatesConsentSession, Type: "text"},
+	}},
+	{Name: CollectionSpaceMembers, Fields: []Field{
+		{Name: SpaceMembersID, Type: "text"},
+		{Name: SpaceMembersSpace, Type: "relation"},
+		{Name: SpaceMembersUser, Type: "relation"},
+		{Name: SpaceMembersRole, Type: "select"},
+		{Name: SpaceMembersCreated, Type: "autodate"},
+	}},
+	{Name: CollectionSpaces, Fields: []Field{
+		{Name: SpacesID, Type: "text"},
+		{Name: SpacesName, Type: "text"},
+		{Name: SpacesKind, Type: "select"},
+		{Name: SpacesCreated, Type: "autodate"},
+		{Name: SpacesUpdated, Type: "autodate"},
+	}},
+	{Name: CollectionSubscriptions, Fields: []Field{
+		{Name: SubscriptionsID, Type: "text"},
+		{Name: SubscriptionsWallet, Type: "relation"},
+		{Name: SubscriptionsMerchant, Type: "text"},
+		{Name: SubscriptionsName, Type: "text"},
+		{Name: SubscriptionsCategory, Type: "text"},
+		{Name: SubscriptionsInterval, Type: "select"},
+		{Name: SubscriptionsAmount, Type: "number"},
+		{Name: SubscriptionsPreviousAmount, Type: "number"},
+		{Name: SubscriptionsMonthlyCost, Type: "number"},
+		{Name: SubscriptionsCharges, Type: "number"},
+		{Name: SubscriptionsFirstChargeAt, Type: "date"},
+		{Name: SubscriptionsLastChargeAt, Type: "date"},
+		{Name: SubscriptionsNextChargeAt, Type: "date"},
+		{Name: SubscriptionsStatus, Type: "select"},
+		{Name: SubscriptionsUpdated, Type: "autodate"},
+		{Name: SubscriptionsCurrency, Type: "text"},
+	}},
+	{Name: CollectionTags, Fields: []Field{
+		{Name: TagsID, Type: "text"},
+		{Name: TagsName, Type: "text"},
+		{Name: TagsColor, Type: "text"},
+		{Name: TagsDescription, Type: "text"},
+		{Name: TagsVersion, Type: "number"},
+		{Name: TagsCreated, Type: "autodate"},
+		{Name: TagsUpdated, Type: "autodate"},
+	}},
+	{Name: CollectionTransactionHistory, Fields: []Field{
+		{Name: TransactionHistoryID, Type: "text"},
+		{Name: TransactionHistoryTransaction, Type: "relation"},
+		{Name: TransactionHistoryAction, Type: "select"},
+		{Name: TransactionHistoryChanges, Type: "text"},
+		{Name: TransactionHistoryPerformedBy, Type: "relation"},
+		{Name: TransactionHistoryPerformedAt, Type: "date"},
+		{Name: TransactionHistoryOldBalance, Type: "number"},
+		{Name: TransactionHistoryNewBalance, Type: "number"},
+		{Name: TransactionHistoryWallet, Type: "relation"},
+		{Name: TransactionHistoryDestinationWallet, Type: "relation"},
+		{Name: TransactionHistoryOldDestinationBalance, Type: "number"},
+		{Name: TransactionHistoryNewDestinationBalance, Type: "number"},
+	}},
+	{Name: CollectionTransactions, Fields: []Field{
+		{Name: TransactionsID, Type: "text"},
+		{Name: TransactionsAmount, Type: "number"},
+		{Name: TransactionsDescription, Type: "text"},
+		{Name: TransactionsDate, Type: "date"},
+		{Name: TransactionsType, Type: "select"},
+		{Name: TransactionsWallet, Type: "relation"},
+		{Name: TransactionsDestinationWallet, Type: "relation"},
+		{Name: TransactionsExchangeRate, Type: "number"},
+		{Name: TransactionsCategory, Type: "relation"},
+		{Name: TransactionsStatus, Type: "select"},
+		{Name: TransactionsDeletedAt, Type: "date"},
+		{Name: TransactionsFireflyID, Type: "text"},
+		{Name: TransactionsVersion, Type: "number"},
+		{Name: TransactionsTags, Type: "json"},
+		{Name: TransactionsNotes, Type: "text"},
+		{Name: TransactionsMetadata, Type: "json"},
+		{Name: TransactionsFee, Type: "number"},
+		{Name: TransactionsReference, Type: "text"},
+		{Name: TransactionsEndToEndID, Type: "text"},
+		{Name: TransactionsCreated, Type: "autodate"},
+		{Name: TransactionsUpdated, Type: "autodate"},
+	}},
+	{Name: CollectionTransformationRules, Fields: []Field{
+		{Name: TransformationRulesID, Type: "text"},
+		{Name: TransformationRulesName, Type: "text"},
+		{Name: TransformationRulesSource, Type: "text"},
+		{Name: TransformationRulesScript, Type: "text"},
+		{Name: TransformationRulesPriority, Type: "number"},
+		{Name: TransformationRulesEnabled, Type: "bool"},
+		{Name: TransformationRulesCreated, Type: "autodate"},
+		{Name: TransformationRulesUpdated, Type: "autodate"},
+	}},
+	{Name: CollectionUsers, Fields: []Field{
+		{Name: UsersID, Type: "text"},
+		{Name: UsersPassword, Type: "password"},
+		{Name: UsersTokenKey, Type: "text"},
+		{Name: UsersEmail, Type: "email"},
+		{Name: UsersEmailVisibility, Type: "bool"},
+		{Name: UsersVerified, Type: "bool"},
+		{Name: UsersName, Type: "text"},
+		{Name: UsersAvatar, Type: "file"},
+		{Name: UsersCreated, Type: "autodate"},
+		{Name: UsersUpdated, Type: "autodate"},
+	}},
+	{Name: CollectionWallets, Fields: []Field{
+		{Name: WalletsID, Type: "text"},
+		{Name: WalletsName, Type: "text"},
+		{Name: WalletsBalance, Type: "number"},
+		{Name: WalletsCurrency, Type: "text"},
+		{Name: WalletsType, Type: "select"},
+		{Name: WalletsArchived, Type: "bool"},
+		{Name: WalletsArchivedAt, Type: "date"},
+		{Name: WalletsVersion, Type: "number"},
+		{Name: WalletsSpace, Type: "relation"},
+		{Name: WalletsCreated, Type: "autodate"},
+		{Name: WalletsUpdated, Type: "autodate"},
+		{Name: WalletsOpeningBalance, Type: "number"},
+	}},
+	{Name: CollectionWebhookDeliveries, Fields: []Field{
+		{Name: WebhookDeliveriesID, Type: "text"},
+		{Name: WebhookDeliveriesWebhook, Type: "relation"},
+		{Name: WebhookDeliveriesEventID, Type: "text"},
+		{Name: WebhookDeliveriesEventType, Type: "text"},
+		{Name: WebhookDeliveriesPayload, Type: "json"},
+		{Name: WebhookDeliveriesStatus, Type: "select"},
+		{Name: WebhookDeliveriesAttempts, Type: "number"},
+		{Name: WebhookDeliveriesResponseStatus, Type: "number"},
+		{Name: WebhookDeliveriesLastError, Type: "text"},
+		{Name: WebhookDeliveriesNextAttemptAt, Type: "date"},
+		{Name: WebhookDeliveriesDeliveredAt, Type: "date"},
+		{Name: WebhookDeliveriesCreated, Type: "autodate"},
+	}},
+	{Name: CollectionWebhooks, Fields: []Field{
+		{Name: WebhooksID, Type: "text"},
+		{Name: WebhooksURL, Type: "url"},
+		{Name: WebhooksSecret, Type: "text"},
+		{Name: WebhooksEvents, Type: "json"},
+		{Name: WebhooksEnabled, Type: "bool"},
+		{Name: WebhooksDescription, Type: "text"},
+		{Name: WebhooksCreated, Type: "autodate"},
+		{Name: WebhooksUpdated, Type: "autodate"},
+	}},
+}
@@ -0,0 +1,99 @@
+// Package schema holds the names and typed accessors of the PocketBase
+// collections the repositories use, generated from a snapshot of the live
+// collections, and checks a database for drift from that snapshot.
+//
+// Regenerate it after changing the collections:
+//
+//	go run ./tools/schemagen -dir pb_data -export pb_schema.json
+//
+// or from the committed export alone with go generate.
+package schema
+
+//go:generate go run ../../../../tools/schemagen -collections ../../../../pb_schema.json -out schema.gen.go
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// Collection is a collection of the snapshot
+type Collection struct {
+	Name   string
+	Fields []Field
+}
+
+// Field is a field of the snapshot
+type Field struct {
+	Name string
+	Type string // a core.FieldType* constant, e.g. "relation"
+}
+
+// DriftKind is the kind of a difference between the snapshot and a database
+type DriftKind string
+
+const (
+	DriftMissingCollection DriftKind = "missing_collection"
+	DriftMissingField      DriftKind = "missing_field"
+	DriftFieldType         DriftKind = "field_type"
+)
+
+// Drift is a difference between the snapshot and a database. Collections
+// and fields the snapshot does not know are not drift.
+type Drift struct {
+	Kind       DriftKind `json:"kind"`
+	Collection string    `json:"collection"`
+	Field      string    `json:"field,omitempty"`
+	Want       string    `json:"want,omitempty"` // field type of the snapshot
+	Got        string    `json:"got,omitempty"`  // field type of the database
+}
+
+func (d Drift) String() string {
+	switch d.Kind {
+	case DriftMissingCollection:
+		return fmt.Sprintf("collection %s is missing", d.Collection)
+	case DriftMissingField:
+		return fmt.Sprintf("field %s.%s is missing", d.Collection, d.Field)
+	default:
+		return fmt.Sprintf("field %s.%s is a %s field, want %s", d.Collection, d.Field, d.Got, d.Want)
+	}
+}
+
+// Check compares the collections of app with the snapshot
+func Check(app core.App) ([]Drift, error) {
+	return CheckCollections(app, Snapshot)
+}
+
+// CheckCollections compares the collections of app with the given snapshot
+func CheckCollections(app core.App, snapshot []Collection) ([]Drift, error) {
+	live, err := app.FindAllCollections()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	byName := make(map[string]*core.Collection, len(live))
+	for _, collection := range live {
+		byName[collection.Name] = collection
+	}
+
+	var drift []Drift
+	for _, want := range snapshot {
+		collection, ok := byName[want.Name]
+		if !ok {
+			drift = append(drift, Drift{Kind: DriftMissingCollection, Collection: want.Name})
+			continue
+		}
+		for _, field := range want.Fields {
+			got := collection.Fields.GetByName(field.Name)
+			switch {
+			case got == nil:
+				drift = append(drift, Drift{Kind: DriftMissingField, Collection: want.Name, Field: field.Name})
+			case got.Type() != field.Type:
+				drift = append(drift, Drift{Kind: DriftFieldType, Collection: want.Name, Field: field.Name, Want: field.Type, Got: got.Type()})
+			}
+		}
+	}
+
+	sort.SliceStable(drift, func(i, j int) bool { return drift[i].Collection < drift[j].Collection })
+	return drift, nil
+}
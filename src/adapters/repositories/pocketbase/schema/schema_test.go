@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pocketbase/pocketbase/core"
+	_ "github.com/pocketbase/pocketbase/migrations"
+)
+
+func TestCheckCollections(t *testing.T) {
+	app := core.NewBaseApp(core.BaseAppConfig{DataDir: t.TempDir()})
+	if err := app.Bootstrap(); err != nil {
+		t.Fatalf("Bootstrap() error = %v", err)
+	}
+	defer app.ResetBootstrapState()
+
+	wallets := core.NewBaseCollection("wallets")
+	wallets.Fields.Add(
+		&core.TextField{Name: "name"},
+		&core.TextField{Name: "balance"},
+		&core.TextField{Name: "unknown"},
+	)
+	if err := app.Save(wallets); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	snapshot := []Collection{
+		{Name: "wallets", Fields: []Field{
+			{Name: "name", Type: core.FieldTypeText},
+			{Name: "balance", Type: core.FieldTypeNumber},
+			{Name: "currency", Type: core.FieldTypeText},
+		}},
+		{Name: "transactions", Fields: []Field{{Name: "amount", Type: core.FieldTypeNumber}}},
+	}
+
+	drift, err := CheckCollections(app, snapshot)
+	if err != nil {
+		t.Fatalf("CheckCollections() error = %v", err)
+	}
+	want := []Drift{
+		{Kind: DriftMissingCollection, Collection: "transactions"},
+		{Kind: DriftFieldType, Collection: "wallets", Field: "balance", Want: core.FieldTypeNumber, Got: core.FieldTypeText},
+		{Kind: DriftMissingField, Collection: "wallets", Field: "currency"},
+	}
+	if !reflect.DeepEqual(drift, want) {
+		t.Errorf("CheckCollections() = %v, want %v", drift, want)
+	}
+}
+
+func TestCheck_Snapshot(t *testing.T) {
+	app := core.NewBaseApp(core.BaseAppConfig{DataDir: t.TempDir()})
+	if err := app.Bootstrap(); err != nil {
+		t.Fatalf("Bootstrap() error = %v", err)
+	}
+	defer app.ResetBootstrapState()
+
+	drift, err := Check(app)
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	// A fresh database only has the system collections and users
+	for _, d := range drift {
+		if d.Collection == CollectionUsers {
+			t.Errorf("unexpected drift of the users collection: %s", d)
+		}
+		if d.Kind != DriftMissingCollection {
+			t.Errorf("unexpected drift %s", d)
+		}
+	}
+	if len(drift) != len(Snapshot)-1 {
+		t.Errorf("Check() found %d missing collections, want %d", len(drift), len(Snapshot)-1)
+	}
+}
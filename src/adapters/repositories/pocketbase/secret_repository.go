@@ -0,0 +1,112 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/security"
+)
+
+// SecretRepository is a PocketBase implementation of the SecretRepository interface.
+// Values are AES-256-GCM encrypted with the configured 32 byte key.
+type SecretRepository struct {
+	app *pocketbase.PocketBase
+	key string
+}
+
+// NewSecretRepository creates a new PocketBase secret repository
+func NewSecretRepository(app *pocketbase.PocketBase, key string) *SecretRepository {
+	return &SecretRepository{
+		app: app,
+		key: key,
+	}
+}
+
+// Get returns the decrypted secret stored under name
+func (r *SecretRepository) Get(ctx context.Context, name string) ([]byte, error) {
+	if r.key == "" {
+		return nil, models.ErrMissingSecretsKey
+	}
+
+	record, err := r.findByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := security.Decrypt(record.GetString("value"), r.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret %s: %w", name, err)
+	}
+
+	return value, nil
+}
+
+// Put encrypts and stores a secret, replacing any previous value
+func (r *SecretRepository) Put(ctx context.Context, name string, value []byte) error {
+	if r.key == "" {
+		return models.ErrMissingSecretsKey
+	}
+
+	encrypted, err := security.Encrypt(value, r.key)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt secret %s: %w", name, err)
+	}
+
+	record, err := r.findByName(name)
+	if errors.Is(err, models.ErrSecretNotFound) {
+		collection, err := r.app.FindCollectionByNameOrId("secrets")
+		if err != nil {
+			return fmt.Errorf("failed to find secrets collection: %w", err)
+		}
+		record = core.NewRecord(collection)
+		record.Set("name", name)
+	} else if err != nil {
+		return err
+	}
+
+	record.Set("value", encrypted)
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save secret %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// Delete removes a secret
+func (r *SecretRepository) Delete(ctx context.Context, name string) error {
+	record, err := r.findByName(name)
+	if errors.Is(err, models.ErrSecretNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := r.app.Delete(record); err != nil {
+		return fmt.Errorf("failed to delete secret %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (r *SecretRepository) findByName(name string) (*core.Record, error) {
+	record := &core.Record{}
+	err := r.app.RecordQuery("secrets").
+		AndWhere(dbx.HashExp{"name": name}).
+		Limit(1).
+		One(record)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("secret %s: %w", name, models.ErrSecretNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find secret %s: %w", name, err)
+	}
+
+	return record, nil
+}
@@ -0,0 +1,121 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// SourceStateRepository is a PocketBase implementation of the SourceStateRepository interface
+type SourceStateRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewSourceStateRepository creates a new PocketBase source state repository
+func NewSourceStateRepository(app *pocketbase.PocketBase) *SourceStateRepository {
+	return &SourceStateRepository{
+		app: app,
+	}
+}
+
+// FindAll returns the state of every source that has synced
+func (r *SourceStateRepository) FindAll(ctx context.Context) ([]*models.SourceState, error) {
+	records := []*core.Record{}
+	if err := r.app.RecordQuery("source_states").OrderBy("source_id ASC").All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find source states: %w", err)
+	}
+
+	states := make([]*models.SourceState, 0, len(records))
+	for _, record := range records {
+		states = append(states, r.mapRecordToState(record))
+	}
+	return states, nil
+}
+
+// Save stores a source state, replacing the previous state of its source
+func (r *SourceStateRepository) Save(ctx context.Context, state *models.SourceState) error {
+	record := &core.Record{}
+	err := r.app.RecordQuery("source_states").
+		AndWhere(dbx.HashExp{"source_id": state.SourceID}).
+		Limit(1).
+		One(record)
+	if errors.Is(err, sql.ErrNoRows) {
+		collection, err := r.app.FindCollectionByNameOrId("source_states")
+		if err != nil {
+			return fmt.Errorf("failed to find source_states collection: %w", err)
+		}
+		record = core.NewRecord(collection)
+		record.Set("source_id", state.SourceID)
+	} else if err != nil {
+		return fmt.Errorf("failed to find state of %s: %w", state.SourceID, err)
+	}
+
+	record.Set("runs", state.Runs)
+	record.Set("failures", state.Failures)
+	record.Set("imported", state.Imported)
+	record.Set("last_error", state.LastError)
+	record.Set("last_run_at", state.LastRunAt)
+	if state.LastSuccessAt.IsZero() {
+		record.Set("last_success_at", nil)
+	} else {
+		record.Set("last_success_at", state.LastSuccessAt)
+	}
+	record.Set("lifecycle", string(state.Lifecycle))
+	record.Set("lifecycle_reason", state.LifecycleReason)
+	if state.TransitionedAt.IsZero() {
+		record.Set("transitioned_at", nil)
+	} else {
+		record.Set("transitioned_at", state.TransitionedAt)
+	}
+	if state.ConsentExpiresAt.IsZero() {
+		record.Set("consent_expires_at", nil)
+	} else {
+		record.Set("consent_expires_at", state.ConsentExpiresAt)
+	}
+	record.Set("consent_session", state.ConsentSession)
+	record.Set("renewal", string(state.Renewal))
+	record.Set("renewal_url", state.RenewalURL)
+	record.Set("renewal_token", state.RenewalToken)
+	if state.RenewalRequestedAt.IsZero() {
+		record.Set("renewal_requested_at", nil)
+	} else {
+		record.Set("renewal_requested_at", state.RenewalRequestedAt)
+	}
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save state of %s: %w", state.SourceID, err)
+	}
+
+	state.ID = record.Id
+	return nil
+}
+
+func (r *SourceStateRepository) mapRecordToState(record *core.Record) *models.SourceState {
+	return &models.SourceState{
+		ID:            record.Id,
+		SourceID:      record.GetString("source_id"),
+		Runs:          record.GetInt("runs"),
+		Failures:      record.GetInt("failures"),
+		Imported:      record.GetInt("imported"),
+		LastError:     record.GetString("last_error"),
+		LastRunAt:     record.GetDateTime("last_run_at").Time(),
+		LastSuccessAt: record.GetDateTime("last_success_at").Time(),
+
+		Lifecycle:       models.SourceLifecycle(record.GetString("lifecycle")),
+		LifecycleReason: record.GetString("lifecycle_reason"),
+		TransitionedAt:  record.GetDateTime("transitioned_at").Time(),
+
+		ConsentExpiresAt:   record.GetDateTime("consent_expires_at").Time(),
+		ConsentSession:     record.GetString("consent_session"),
+		Renewal:            models.ConsentRenewal(record.GetString("renewal")),
+		RenewalURL:         record.GetString("renewal_url"),
+		RenewalToken:       record.GetString("renewal_token"),
+		RenewalRequestedAt: record.GetDateTime("renewal_requested_at").Time(),
+	}
+}
@@ -0,0 +1,184 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// SpaceRepository is a PocketBase implementation of the SpaceRepository interface
+type SpaceRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewSpaceRepository creates a new PocketBase space repository
+func NewSpaceRepository(app *pocketbase.PocketBase) *SpaceRepository {
+	return &SpaceRepository{
+		app: app,
+	}
+}
+
+// FindByID finds a space by ID
+func (r *SpaceRepository) FindByID(ctx context.Context, id string) (*models.Space, error) {
+	record, err := r.app.FindRecordById("spaces", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find space: %w", err)
+	}
+
+	return r.mapRecordToSpace(record), nil
+}
+
+// FindAll finds spaces with optional filters, by name
+func (r *SpaceRepository) FindAll(ctx context.Context, filter repositories.SpaceFilter) ([]*models.Space, error) {
+	query := r.app.RecordQuery("spaces")
+
+	// Apply filters
+	if filter.UserID != "" {
+		query = query.AndWhere(dbx.NewExp("id IN (SELECT space FROM space_members WHERE user = {:user})", dbx.Params{"user": filter.UserID}))
+	}
+
+	query = query.OrderBy("name ASC")
+
+	// Execute query
+	records := []*core.Record{}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find spaces: %w", err)
+	}
+
+	spaces := make([]*models.Space, 0, len(records))
+	for _, record := range records {
+		spaces = append(spaces, r.mapRecordToSpace(record))
+	}
+
+	return spaces, nil
+}
+
+// Create creates a new space
+func (r *SpaceRepository) Create(ctx context.Context, space *models.Space) error {
+	collection, err := r.app.FindCollectionByNameOrId("spaces")
+	if err != nil {
+		return fmt.Errorf("failed to find spaces collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("name", space.Name)
+	record.Set("kind", string(space.Kind))
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to create space: %w", err)
+	}
+
+	space.ID = record.Id
+	space.CreatedAt = record.GetDateTime("created").Time()
+	space.UpdatedAt = record.GetDateTime("updated").Time()
+	return nil
+}
+
+// FindMembers finds the members of a space
+func (r *SpaceRepository) FindMembers(ctx context.Context, spaceID string) ([]*models.SpaceMember, error) {
+	records := []*core.Record{}
+	err := r.app.RecordQuery("space_members").
+		AndWhere(dbx.HashExp{"space": spaceID}).
+		OrderBy("created ASC").
+		All(&records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find space members: %w", err)
+	}
+
+	members := make([]*models.SpaceMember, 0, len(records))
+	for _, record := range records {
+		members = append(members, r.mapRecordToMember(record))
+	}
+
+	return members, nil
+}
+
+// FindMember finds the membership of a user in a space
+func (r *SpaceRepository) FindMember(ctx context.Context, spaceID, userID string) (*models.SpaceMember, error) {
+	record, err := r.findMemberRecord(spaceID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find space member: %w", err)
+	}
+
+	return r.mapRecordToMember(record), nil
+}
+
+// SaveMember stores a membership, replacing the role of an existing member
+func (r *SpaceRepository) SaveMember(ctx context.Context, member *models.SpaceMember) error {
+	record, err := r.findMemberRecord(member.SpaceID, member.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		collection, err := r.app.FindCollectionByNameOrId("space_members")
+		if err != nil {
+			return fmt.Errorf("failed to find space_members collection: %w", err)
+		}
+		record = core.NewRecord(collection)
+		record.Set("space", member.SpaceID)
+		record.Set("user", member.UserID)
+	} else if err != nil {
+		return fmt.Errorf("failed to find space member %s: %w", member.UserID, err)
+	}
+
+	record.Set("role", string(member.Role))
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to save space member %s: %w", member.UserID, err)
+	}
+
+	member.ID = record.Id
+	member.CreatedAt = record.GetDateTime("created").Time()
+	return nil
+}
+
+// DeleteMember removes a user from a space
+func (r *SpaceRepository) DeleteMember(ctx context.Context, spaceID, userID string) error {
+	record, err := r.findMemberRecord(spaceID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to find space member: %w", err)
+	}
+
+	if err := r.app.DeleteWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to delete space member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SpaceRepository) findMemberRecord(spaceID, userID string) (*core.Record, error) {
+	record := &core.Record{}
+	err := r.app.RecordQuery("space_members").
+		AndWhere(dbx.HashExp{"space": spaceID, "user": userID}).
+		Limit(1).
+		One(record)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (r *SpaceRepository) mapRecordToSpace(record *core.Record) *models.Space {
+	return &models.Space{
+		ID:        record.Id,
+		Name:      record.GetString("name"),
+		Kind:      models.SpaceKind(record.GetString("kind")),
+		CreatedAt: record.GetDateTime("created").Time(),
+		UpdatedAt: record.GetDateTime("updated").Time(),
+	}
+}
+
+func (r *SpaceRepository) mapRecordToMember(record *core.Record) *models.SpaceMember {
+	return &models.SpaceMember{
+		ID:        record.Id,
+		SpaceID:   record.GetString("space"),
+		UserID:    record.GetString("user"),
+		Role:      models.SpaceRole(record.GetString("role")),
+		CreatedAt: record.GetDateTime("created").Time(),
+	}
+}
@@ -0,0 +1,130 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// SubscriptionRepository is a PocketBase implementation of the SubscriptionRepository interface
+type SubscriptionRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewSubscriptionRepository creates a new PocketBase subscription repository
+func NewSubscriptionRepository(app *pocketbase.PocketBase) *SubscriptionRepository {
+	return &SubscriptionRepository{
+		app: app,
+	}
+}
+
+// FindAll finds subscriptions with optional filters, most expensive first
+func (r *SubscriptionRepository) FindAll(ctx context.Context, filter repositories.SubscriptionFilter) ([]*models.Subscription, error) {
+	query := r.app.RecordQuery("subscriptions")
+
+	// Apply filters
+	if filter.WalletID != "" {
+		query = query.AndWhere(dbx.HashExp{"wallet": filter.WalletID})
+	}
+
+	if filter.VisibleTo != "" {
+		query = query.AndWhere(visibleWalletExp("wallet", filter.VisibleTo))
+	}
+
+	if filter.Status != "" {
+		query = query.AndWhere(dbx.HashExp{"status": string(filter.Status)})
+	}
+
+	query = query.OrderBy("monthly_cost DESC", "merchant ASC")
+
+	// Execute query
+	records := []*core.Record{}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find subscriptions: %w", err)
+	}
+
+	subscriptions := make([]*models.Subscription, 0, len(records))
+	for _, record := range records {
+		subscriptions = append(subscriptions, r.mapRecordToSubscription(record))
+	}
+
+	return subscriptions, nil
+}
+
+// Save stores a subscription, replacing the one of the same wallet and merchant.
+// A subscription without a currency takes that of its wallet.
+func (r *SubscriptionRepository) Save(ctx context.Context, subscription *models.Subscription) error {
+	if subscription.Currency == "" {
+		wallet, err := r.app.FindRecordById("wallets", subscription.WalletID)
+		if err != nil {
+			return fmt.Errorf("failed to find wallet %s: %w", subscription.WalletID, err)
+		}
+		subscription.Currency = wallet.GetString("currency")
+	}
+
+	record := &core.Record{}
+	err := r.app.RecordQuery("subscriptions").
+		AndWhere(dbx.HashExp{"wallet": subscription.WalletID, "merchant": subscription.Merchant}).
+		Limit(1).
+		One(record)
+	if errors.Is(err, sql.ErrNoRows) {
+		collection, err := r.app.FindCollectionByNameOrId("subscriptions")
+		if err != nil {
+			return fmt.Errorf("failed to find subscriptions collection: %w", err)
+		}
+		record = core.NewRecord(collection)
+		record.Set("wallet", subscription.WalletID)
+		record.Set("merchant", subscription.Merchant)
+	} else if err != nil {
+		return fmt.Errorf("failed to find subscription %s: %w", subscription.Merchant, err)
+	}
+
+	record.Set("name", subscription.Name)
+	record.Set("currency", subscription.Currency)
+	record.Set("category", subscription.CategoryID)
+	record.Set("interval", string(subscription.Interval))
+	record.Set("amount", subscription.Amount)
+	record.Set("previous_amount", subscription.PreviousAmount)
+	record.Set("monthly_cost", subscription.MonthlyCost)
+	record.Set("charges", subscription.Charges)
+	record.Set("first_charge_at", subscription.FirstChargeAt)
+	record.Set("last_charge_at", subscription.LastChargeAt)
+	record.Set("next_charge_at", subscription.NextChargeAt)
+	record.Set("status", string(subscription.Status))
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save subscription %s: %w", subscription.Merchant, err)
+	}
+
+	subscription.ID = record.Id
+	subscription.UpdatedAt = record.GetDateTime("updated").Time()
+	return nil
+}
+
+func (r *SubscriptionRepository) mapRecordToSubscription(record *core.Record) *models.Subscription {
+	return &models.Subscription{
+		ID:             record.Id,
+		Merchant:       record.GetString("merchant"),
+		Name:           record.GetString("name"),
+		WalletID:       record.GetString("wallet"),
+		Currency:       record.GetString("currency"),
+		CategoryID:     record.GetString("category"),
+		Interval:       models.SubscriptionInterval(record.GetString("interval")),
+		Amount:         record.GetFloat("amount"),
+		PreviousAmount: record.GetFloat("previous_amount"),
+		MonthlyCost:    record.GetFloat("monthly_cost"),
+		Charges:        record.GetInt("charges"),
+		FirstChargeAt:  record.GetDateTime("first_charge_at").Time(),
+		LastChargeAt:   record.GetDateTime("last_charge_at").Time(),
+		NextChargeAt:   record.GetDateTime("next_charge_at").Time(),
+		Status:         models.SubscriptionStatus(record.GetString("status")),
+		UpdatedAt:      record.GetDateTime("updated").Time(),
+	}
+}
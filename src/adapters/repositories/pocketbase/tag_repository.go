@@ -0,0 +1,360 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// TagRepository is a PocketBase implementation of the TagRepository interface
+type TagRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewTagRepository creates a new PocketBase tag repository
+func NewTagRepository(app *pocketbase.PocketBase) *TagRepository {
+	return &TagRepository{
+		app: app,
+	}
+}
+
+// FindByID finds a tag by ID
+func (r *TagRepository) FindByID(ctx context.Context, id string) (*models.Tag, error) {
+	record, err := r.app.FindRecordById("tags", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tag: %w", err)
+	}
+
+	return r.mapRecordToTag(record), nil
+}
+
+// FindByName finds a tag by name (case-insensitive)
+func (r *TagRepository) FindByName(ctx context.Context, name string) (*models.Tag, error) {
+	record := &core.Record{}
+	err := r.app.RecordQuery("tags").
+		AndWhere(dbx.NewExp("LOWER(name) = LOWER({:name})", dbx.Params{"name": name})).
+		Limit(1).
+		One(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tag: %w", err)
+	}
+
+	return r.mapRecordToTag(record), nil
+}
+
+// FindAll finds all tags with optional filters
+func (r *TagRepository) FindAll(ctx context.Context, filter repositories.TagFilter) ([]*models.Tag, error) {
+	query := r.app.RecordQuery("tags").OrderBy("name ASC")
+
+	if filter.NameLike != "" {
+		query = query.AndWhere(dbx.NewExp("name LIKE {:name}", dbx.Params{"name": "%" + filter.NameLike + "%"}))
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(int64(filter.Limit))
+	}
+
+	if filter.Offset > 0 {
+		query = query.Offset(int64(filter.Offset))
+	}
+
+	records := []*core.Record{}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find tags: %w", err)
+	}
+
+	tags := make([]*models.Tag, 0, len(records))
+	for _, record := range records {
+		tags = append(tags, r.mapRecordToTag(record))
+	}
+
+	return tags, nil
+}
+
+// Create creates a new tag
+func (r *TagRepository) Create(ctx context.Context, tag *models.Tag) error {
+	collection, err := r.app.FindCollectionByNameOrId("tags")
+	if err != nil {
+		return fmt.Errorf("failed to find tags collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("name", tag.Name)
+	record.Set("color", tag.Color)
+	record.Set("description", tag.Description)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	tag.ID = record.Id
+
+	return nil
+}
+
+// Update updates the color and description of a tag. Use Rename to change its name.
+func (r *TagRepository) Update(ctx context.Context, tag *models.Tag) error {
+	record, err := r.app.FindRecordById("tags", tag.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find tag: %w", err)
+	}
+
+	record.Set("color", tag.Color)
+	record.Set("description", tag.Description)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a tag by ID and removes it from all transactions
+func (r *TagRepository) Delete(ctx context.Context, id string) (int, error) {
+	var updated int
+
+	err := r.app.RunInTransaction(func(txApp core.App) error {
+		record, err := txApp.FindRecordById("tags", id)
+		if err != nil {
+			return fmt.Errorf("failed to find tag: %w", err)
+		}
+
+		name := record.GetString("name")
+		updated, err = rewriteTransactionTagsTx(ctx, txApp, name, func(tags []string) []string {
+			result := make([]string, 0, len(tags))
+			for _, tag := range tags {
+				if tag != name {
+					result = append(result, tag)
+				}
+			}
+			return result
+		})
+		if err != nil {
+			return err
+		}
+
+		return txApp.DeleteWithContext(ctx, record)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return updated, nil
+}
+
+// Rename renames a tag and every transaction reference to it in one DB transaction
+func (r *TagRepository) Rename(ctx context.Context, id, name string) (int, error) {
+	var updated int
+
+	err := r.app.RunInTransaction(func(txApp core.App) error {
+		record, err := txApp.FindRecordById("tags", id)
+		if err != nil {
+			return fmt.Errorf("failed to find tag: %w", err)
+		}
+
+		oldName := record.GetString("name")
+		if oldName == name {
+			return nil
+		}
+
+		record.Set("name", name)
+		if err := txApp.SaveWithContext(ctx, record); err != nil {
+			return err
+		}
+
+		updated, err = rewriteTransactionTagsTx(ctx, txApp, oldName, func(tags []string) []string {
+			result, _ := models.ReplaceTag(tags, oldName, name)
+			return result
+		})
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	return updated, nil
+}
+
+// Wallets returns the wallets of the transactions referencing a tag,
+// including deleted transactions as the rewrite reaches them too
+func (r *TagRepository) Wallets(ctx context.Context, id string) ([]string, error) {
+	record, err := r.app.FindRecordById("tags", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tag: %w", err)
+	}
+
+	rows := []struct {
+		Wallet      string `db:"wallet"`
+		Destination string `db:"destination_wallet"`
+	}{}
+	err = r.app.DB().
+		Select("wallet", "destination_wallet").
+		Distinct(true).
+		From("transactions").
+		Where(dbx.NewExp("EXISTS (SELECT 1 FROM json_each(transactions.tags) WHERE json_each.value = {:tag})", dbx.Params{"tag": record.GetString("name")})).
+		WithContext(ctx).
+		All(&rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the wallets tagged %q: %w", record.GetString("name"), err)
+	}
+
+	wallets := make([]string, 0, len(rows))
+	for _, row := range rows {
+		wallets = append(wallets, row.Wallet)
+		if row.Destination != "" {
+			wallets = append(wallets, row.Destination)
+		}
+	}
+	return wallets, nil
+}
+
+// Merge replaces the source tag with the target tag on every transaction and
+// deletes the source tag, in one DB transaction
+func (r *TagRepository) Merge(ctx context.Context, sourceID, targetID string) (int, error) {
+	if sourceID == targetID {
+		return 0, models.ErrInvalidTagMerge
+	}
+
+	var updated int
+
+	err := r.app.RunInTransaction(func(txApp core.App) error {
+		source, err := txApp.FindRecordById("tags", sourceID)
+		if err != nil {
+			return fmt.Errorf("failed to find source tag: %w", err)
+		}
+		target, err := txApp.FindRecordById("tags", targetID)
+		if err != nil {
+			return fmt.Errorf("failed to find target tag: %w", err)
+		}
+
+		sourceName := source.GetString("name")
+		targetName := target.GetString("name")
+		updated, err = rewriteTransactionTagsTx(ctx, txApp, sourceName, func(tags []string) []string {
+			result, _ := models.ReplaceTag(tags, sourceName, targetName)
+			return result
+		})
+		if err != nil {
+			return err
+		}
+
+		return txApp.DeleteWithContext(ctx, source)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to merge tags: %w", err)
+	}
+
+	return updated, nil
+}
+
+// tagSpendRow is a single row of the tag spend aggregation
+type tagSpendRow struct {
+	Tag      string  `db:"tag"`
+	Currency string  `db:"currency"`
+	Type     string  `db:"type"`
+	Count    int     `db:"count"`
+	Total    float64 `db:"total"`
+}
+
+// Spend aggregates non-deleted transactions, except sandbox test data, per tag and wallet currency
+func (r *TagRepository) Spend(ctx context.Context, filter repositories.TagSpendFilter) ([]*models.TagSpend, error) {
+	query := r.app.DB().
+		Select("tag.value AS tag", "w.currency AS currency", "t.type AS type", "COUNT(*) AS count", "SUM(t.amount) AS total").
+		From("transactions t").
+		InnerJoin("json_each(t.tags) tag", nil).
+		InnerJoin("wallets w", dbx.NewExp("w.id = t.wallet")).
+		Where(dbx.NewExp("(t.deleted_at IS NULL OR t.deleted_at = '')")).
+		AndWhere(dbx.NewExp("t.status != {:failed}", dbx.Params{"failed": string(models.TransactionStatusFailed)})).
+		AndWhere(notSandboxExp("t.")).
+		GroupBy("tag.value", "w.currency", "t.type").
+		OrderBy("tag.value ASC", "w.currency ASC")
+
+	if filter.Tag != "" {
+		query = query.AndWhere(dbx.NewExp("tag.value = {:tag}", dbx.Params{"tag": filter.Tag}))
+	}
+
+	if filter.VisibleTo != "" {
+		query = query.AndWhere(visibleWalletExp("t.wallet", filter.VisibleTo))
+	}
+
+	if !filter.DateFrom.IsZero() {
+		query = query.AndWhere(dbx.NewExp("t.date >= {:from}", dbx.Params{"from": filter.DateFrom}))
+	}
+
+	if !filter.DateTo.IsZero() {
+		query = query.AndWhere(dbx.NewExp("t.date <= {:to}", dbx.Params{"to": filter.DateTo}))
+	}
+
+	rows := []tagSpendRow{}
+	if err := query.All(&rows); err != nil {
+		return nil, fmt.Errorf("failed to aggregate tag spend: %w", err)
+	}
+
+	spend := make([]*models.TagSpend, 0)
+	byKey := make(map[string]*models.TagSpend)
+	for _, row := range rows {
+		key := row.Tag + "|" + row.Currency
+		entry, ok := byKey[key]
+		if !ok {
+			entry = &models.TagSpend{Tag: row.Tag, Currency: row.Currency}
+			byKey[key] = entry
+			spend = append(spend, entry)
+		}
+
+		entry.TransactionCount += row.Count
+		switch models.TransactionType(row.Type) {
+		case models.TransactionTypeIncome:
+			entry.Income += row.Total
+		case models.TransactionTypeExpense:
+			entry.Expense += row.Total
+		case models.TransactionTypeTransfer:
+			entry.Transfer += row.Total
+		}
+	}
+
+	return spend, nil
+}
+
+// rewriteTransactionTagsTx applies rewrite to the tags of every transaction carrying
+// the named tag and returns the number of transactions updated
+func rewriteTransactionTagsTx(ctx context.Context, txApp core.App, name string, rewrite func(tags []string) []string) (int, error) {
+	records := []*core.Record{}
+	err := txApp.RecordQuery("transactions").
+		AndWhere(dbx.NewExp("EXISTS (SELECT 1 FROM json_each(transactions.tags) WHERE json_each.value = {:tag})", dbx.Params{"tag": name})).
+		All(&records)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find transactions tagged %q: %w", name, err)
+	}
+
+	for _, record := range records {
+		tags, err := recordTags(record)
+		if err != nil {
+			return 0, err
+		}
+
+		record.Set("tags", rewrite(tags))
+		record.Set("version", record.GetInt("version")+1)
+		if err := txApp.SaveWithContext(ctx, record); err != nil {
+			return 0, fmt.Errorf("failed to update tags of transaction %s: %w", record.Id, err)
+		}
+	}
+
+	return len(records), nil
+}
+
+// Helper methods for mapping between domain models and PocketBase records
+
+func (r *TagRepository) mapRecordToTag(record *core.Record) *models.Tag {
+	return &models.Tag{
+		ID:          record.Id,
+		Name:        record.GetString("name"),
+		Color:       record.GetString("color"),
+		Description: record.GetString("description"),
+		CreatedAt:   record.GetDateTime("created").Time(),
+		UpdatedAt:   record.GetDateTime("updated").Time(),
+	}
+}
@@ -2,7 +2,10 @@ package pocketbase
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
@@ -13,9 +16,13 @@ import (
 	// Removed daos import
 )
 
+// batchChunkSize is the number of records written per DB transaction in batch operations
+const batchChunkSize = 500
+
 // TransactionRepository is a PocketBase implementation of the TransactionRepository interface
 type TransactionRepository struct {
-	app *pocketbase.PocketBase // Use app instead of dao
+	app    *pocketbase.PocketBase // Use app instead of dao
+	cipher repositories.FieldCipher
 }
 
 // NewTransactionRepository creates a new PocketBase transaction repository
@@ -25,6 +32,13 @@ func NewTransactionRepository(app *pocketbase.PocketBase) *TransactionRepository
 	}
 }
 
+// WithCipher encrypts the description, notes and counterparty of transactions
+// at rest with the data key of their wallet's space, and decrypts them on read
+func (r *TransactionRepository) WithCipher(cipher repositories.FieldCipher) *TransactionRepository {
+	r.cipher = cipher
+	return r
+}
+
 // FindByID finds a transaction by ID
 func (r *TransactionRepository) FindByID(ctx context.Context, id string) (*models.Transaction, error) {
 	record, err := r.app.FindRecordById("transactions", id) // Use r.app directly
@@ -32,7 +46,7 @@ func (r *TransactionRepository) FindByID(ctx context.Context, id string) (*model
 		return nil, fmt.Errorf("failed to find transaction: %w", err)
 	}
 
-	return r.mapRecordToTransaction(record)
+	return r.mapRecordToTransaction(ctx, record)
 }
 
 // FindAll finds all transactions with optional filters
@@ -44,6 +58,14 @@ func (r *TransactionRepository) FindAll(ctx context.Context, filter repositories
 		query = query.AndWhere(dbx.HashExp{"wallet": filter.WalletID})
 	}
 
+	if filter.SpaceID != "" {
+		query = query.AndWhere(dbx.NewExp("wallet IN (SELECT id FROM wallets WHERE space = {:space})", dbx.Params{"space": filter.SpaceID}))
+	}
+
+	if filter.VisibleTo != "" {
+		query = query.AndWhere(visibleWalletExp("wallet", filter.VisibleTo))
+	}
+
 	if filter.CategoryID != "" {
 		query = query.AndWhere(dbx.HashExp{"category": filter.CategoryID})
 	}
@@ -52,10 +74,22 @@ func (r *TransactionRepository) FindAll(ctx context.Context, filter repositories
 		query = query.AndWhere(dbx.HashExp{"type": string(filter.Type)})
 	}
 
-	if filter.Description != "" {
+	// Encrypted text can only be matched once decrypted, so the text filters
+	// and the pagination then run on the decrypted transactions
+	matchText := r.cipher != nil && (filter.Description != "" || filter.Notes != "")
+
+	if filter.Description != "" && !matchText {
 		query = query.AndWhere(dbx.NewExp("description LIKE {:desc}", dbx.Params{"desc": "%" + filter.Description + "%"}))
 	}
 
+	if filter.Notes != "" && !matchText {
+		query = query.AndWhere(dbx.NewExp("notes LIKE {:notes}", dbx.Params{"notes": "%" + filter.Notes + "%"}))
+	}
+
+	for _, exp := range metadataExps(filter.Metadata) {
+		query = query.AndWhere(exp)
+	}
+
 	if !filter.DateFrom.IsZero() {
 		query = query.AndWhere(dbx.NewExp("date >= {:date_from}", dbx.Params{"date_from": filter.DateFrom}))
 	}
@@ -76,24 +110,44 @@ func (r *TransactionRepository) FindAll(ctx context.Context, filter repositories
 		query = query.AndWhere(dbx.HashExp{"status": string(filter.Status)})
 	}
 
+	if filter.OnlyUnlinked {
+		query = query.AndWhere(dbx.NewExp("(firefly_id IS NULL OR firefly_id = '')"))
+	}
+
+	if filter.ExcludeSandbox {
+		query = query.AndWhere(notSandboxExp(""))
+	}
+
+	// Soft-deleted transactions are hidden unless explicitly requested
+	if filter.OnlyDeleted {
+		query = query.AndWhere(deletedExp())
+	} else if !filter.IncludeDeleted {
+		query = query.AndWhere(notDeletedExp())
+	}
+	if !filter.DeletedBefore.IsZero() {
+		query = query.AndWhere(deletedExp()).
+			AndWhere(dbx.NewExp("deleted_at < {:deleted_before}", dbx.Params{"deleted_before": filter.DeletedBefore}))
+	}
+
 	// Apply sorting
 	if filter.SortBy != "" {
 		direction := "ASC"
 		if filter.SortOrder == "desc" {
 			direction = "DESC"
 		}
-		query = query.OrderBy(fmt.Sprintf("%s %s", filter.SortBy, direction))
+		// The ID breaks ties so offset pagination neither repeats nor skips records
+		query = query.OrderBy(fmt.Sprintf("%s %s", filter.SortBy, direction), "id "+direction)
 	} else {
 		// Default sort by date descending
-		query = query.OrderBy("date DESC")
+		query = query.OrderBy("date DESC", "id DESC")
 	}
 
 	// Apply pagination
-	if filter.Limit > 0 {
+	if filter.Limit > 0 && !matchText {
 		query = query.Limit(int64(filter.Limit)) // Cast to int64
 	}
 
-	if filter.Offset > 0 {
+	if filter.Offset > 0 && !matchText {
 		query = query.Offset(int64(filter.Offset)) // Cast to int64
 	}
 
@@ -106,45 +160,162 @@ func (r *TransactionRepository) FindAll(ctx context.Context, filter repositories
 	// Convert records to domain models
 	transactions := make([]*models.Transaction, 0, len(records))
 	for _, record := range records {
-		transaction, err := r.mapRecordToTransaction(record)
+		transaction, err := r.mapRecordToTransaction(ctx, record)
 		if err != nil {
 			return nil, fmt.Errorf("failed to map record to transaction: %w", err)
 		}
+		if matchText && !matchesText(transaction, filter) {
+			continue
+		}
 		transactions = append(transactions, transaction)
 	}
 
+	if matchText {
+		transactions = paginate(transactions, filter.Offset, filter.Limit)
+	}
+
 	return transactions, nil
 }
 
+// matchesText reports whether a decrypted transaction matches the
+// description and notes filters like the LIKE queries would
+func matchesText(transaction *models.Transaction, filter repositories.TransactionFilter) bool {
+	contains := func(value, substr string) bool {
+		return strings.Contains(strings.ToLower(value), strings.ToLower(substr))
+	}
+	return (filter.Description == "" || contains(transaction.Description, filter.Description)) &&
+		(filter.Notes == "" || contains(transaction.Notes, filter.Notes))
+}
+
+// paginate returns the page of transactions at offset, at most limit long (zero for all)
+func paginate(transactions []*models.Transaction, offset, limit int) []*models.Transaction {
+	if offset >= len(transactions) {
+		return []*models.Transaction{}
+	}
+	transactions = transactions[offset:]
+	if limit > 0 && limit < len(transactions) {
+		transactions = transactions[:limit]
+	}
+	return transactions
+}
+
 // Create creates a new transaction
 func (r *TransactionRepository) Create(ctx context.Context, transaction *models.Transaction) error {
-	record := r.mapTransactionToRecord(transaction)
+	sealed, err := r.seal(ctx, transaction, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create transaction: %w", err)
+	}
+	record := r.mapTransactionToRecord(sealed)
 
-	if err := r.app.Save(record); err != nil { // Use r.app directly
+	if err := r.app.SaveWithContext(ctx, record); err != nil { // Use r.app directly
 		return fmt.Errorf("failed to create transaction: %w", err)
 	}
 
 	return nil
 }
 
-// Update updates an existing transaction
+// Update updates an existing transaction.
+// It returns models.ErrConflict if the transaction changed since it was read.
 func (r *TransactionRepository) Update(ctx context.Context, transaction *models.Transaction) error {
-	// Check if transaction exists
-	record, err := r.app.FindRecordById("transactions", transaction.ID) // Use r.app directly
+	sealed, err := r.seal(ctx, transaction, nil)
 	if err != nil {
-		return fmt.Errorf("failed to find transaction: %w", err)
+		return fmt.Errorf("failed to update transaction: %w", err)
 	}
 
-	// Update fields
-	record = r.updateRecordFromTransaction(record, transaction)
-
-	if err := r.app.Save(record); err != nil { // Use r.app directly
+	version, err := saveVersioned(ctx, r.app, "transactions", transaction.ID, transaction.Version, func(record *core.Record) {
+		r.updateRecordFromTransaction(record, sealed)
+	})
+	if err != nil {
 		return fmt.Errorf("failed to update transaction: %w", err)
 	}
 
+	transaction.Version = version
+
 	return nil
 }
 
+// CreateMany creates transactions in chunks, each chunk in a single DB transaction.
+// It returns the number of transactions created; a failing chunk is rolled back.
+func (r *TransactionRepository) CreateMany(ctx context.Context, transactions []*models.Transaction) (int, error) {
+	created := 0
+	spaces := make(map[string]string)
+
+	for start := 0; start < len(transactions); start += batchChunkSize {
+		if err := ctx.Err(); err != nil {
+			return created, err
+		}
+
+		chunk := transactions[start:min(start+batchChunkSize, len(transactions))]
+		sealed, err := r.sealAll(ctx, chunk, spaces)
+		if err != nil {
+			return created, fmt.Errorf("failed to create transactions: %w", err)
+		}
+
+		err = r.app.RunInTransaction(func(txApp core.App) error {
+			for i, transaction := range chunk {
+				record := r.mapTransactionToRecord(sealed[i])
+				if err := txApp.SaveWithContext(ctx, record); err != nil {
+					return fmt.Errorf("failed to create transaction %q: %w", transaction.Description, err)
+				}
+				transaction.ID = record.Id
+				transaction.Version = 1
+			}
+			return nil
+		})
+		if err != nil {
+			return created, fmt.Errorf("failed to create transactions: %w", err)
+		}
+
+		created += len(chunk)
+	}
+
+	return created, nil
+}
+
+// UpdateMany updates transactions in chunks, each chunk in a single DB transaction.
+// A version mismatch fails its chunk with models.ErrConflict.
+func (r *TransactionRepository) UpdateMany(ctx context.Context, transactions []*models.Transaction) (int, error) {
+	updated := 0
+	spaces := make(map[string]string)
+
+	for start := 0; start < len(transactions); start += batchChunkSize {
+		if err := ctx.Err(); err != nil {
+			return updated, err
+		}
+
+		chunk := transactions[start:min(start+batchChunkSize, len(transactions))]
+		versions := make([]int, len(chunk))
+		sealed, err := r.sealAll(ctx, chunk, spaces)
+		if err != nil {
+			return updated, fmt.Errorf("failed to update transactions: %w", err)
+		}
+
+		err = r.app.RunInTransaction(func(txApp core.App) error {
+			for i, transaction := range chunk {
+				version, err := saveVersionedTx(ctx, txApp, "transactions", transaction.ID, transaction.Version, func(record *core.Record) {
+					r.updateRecordFromTransaction(record, sealed[i])
+				})
+				if err != nil {
+					return err
+				}
+				versions[i] = version
+			}
+			return nil
+		})
+		if err != nil {
+			return updated, fmt.Errorf("failed to update transactions: %w", err)
+		}
+
+		// Only publish the new versions once the chunk is committed
+		for i, transaction := range chunk {
+			transaction.Version = versions[i]
+		}
+		updated += len(chunk)
+	}
+
+	return updated, nil
+}
+
 // Delete deletes a transaction by ID
 func (r *TransactionRepository) Delete(ctx context.Context, id string) error {
 	record, err := r.app.FindRecordById("transactions", id) // Use r.app directly
@@ -152,26 +323,42 @@ func (r *TransactionRepository) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to find transaction: %w", err)
 	}
 
-	if err := r.app.Delete(record); err != nil { // Use r.app directly
+	if err := r.app.DeleteWithContext(ctx, record); err != nil { // Use r.app directly
 		return fmt.Errorf("failed to delete transaction: %w", err)
 	}
 
 	return nil
 }
 
-// FindDuplicates finds potential duplicate transactions
-func (r *TransactionRepository) FindDuplicates(ctx context.Context, transaction *models.Transaction, timeWindow time.Duration) ([]*models.Transaction, error) {
-	// Calculate time range for duplicate check
-	startTime := transaction.Date.Add(-timeWindow / 2)
-	endTime := transaction.Date.Add(timeWindow / 2)
-
+// FindDuplicates finds potential duplicate transactions dated between from
+// and to (inclusive) whose amount differs by less than tolerance
+func (r *TransactionRepository) FindDuplicates(ctx context.Context, transaction *models.Transaction, from, to time.Time, tolerance float64) ([]*models.Transaction, error) {
 	// Build query for potential duplicates
+	similar := dbx.And(
+		dbx.NewExp("ABS(amount - {:amount}) <= {:tolerance}", dbx.Params{"amount": transaction.Amount, "tolerance": tolerance}),
+		dbx.NewExp("date >= {:start_date}", dbx.Params{"start_date": from}),
+		dbx.NewExp("date <= {:end_date}", dbx.Params{"end_date": to}),
+		dbx.HashExp{"type": string(transaction.Type)},
+	)
+
+	// A payment with an end-to-end ID is the same payment as any other with that
+	// ID; otherwise it only resembles transactions that carry no ID of their own
+	if transaction.EndToEndID != "" {
+		similar = dbx.Or(
+			dbx.HashExp{"end_to_end_id": transaction.EndToEndID},
+			dbx.And(similar, dbx.HashExp{"end_to_end_id": ""}),
+		)
+	}
+
 	query := r.app.RecordQuery("transactions"). // Use r.app directly
-		AndWhere(dbx.HashExp{"wallet": transaction.WalletID}).
-		AndWhere(dbx.NewExp("ABS(amount - {:amount}) < 0.01", dbx.Params{"amount": transaction.Amount})).
-		AndWhere(dbx.NewExp("date >= {:start_date}", dbx.Params{"start_date": startTime})).
-		AndWhere(dbx.NewExp("date <= {:end_date}", dbx.Params{"end_date": endTime})).
-		AndWhere(dbx.HashExp{"type": string(transaction.Type)})
+							AndWhere(dbx.HashExp{"wallet": transaction.WalletID}).
+							AndWhere(similar).
+							AndWhere(notDeletedExp())
+
+	// Payments for different references are different payments
+	if transaction.Reference != "" {
+		query = query.AndWhere(dbx.HashExp{"reference": []any{transaction.Reference, ""}})
+	}
 
 	// For transfers, also check destination wallet
 	if transaction.Type == models.TransactionTypeTransfer && transaction.DestWalletID != "" {
@@ -192,7 +379,7 @@ func (r *TransactionRepository) FindDuplicates(ctx context.Context, transaction
 	// Convert records to domain models
 	duplicates := make([]*models.Transaction, 0, len(records))
 	for _, record := range records {
-		duplicate, err := r.mapRecordToTransaction(record)
+		duplicate, err := r.mapRecordToTransaction(ctx, record)
 		if err != nil {
 			return nil, fmt.Errorf("failed to map record to transaction: %w", err)
 		}
@@ -202,19 +389,491 @@ func (r *TransactionRepository) FindDuplicates(ctx context.Context, transaction
 	return duplicates, nil
 }
 
+// SoftDelete marks a transaction as deleted so it can be restored later
+func (r *TransactionRepository) SoftDelete(ctx context.Context, id string) error {
+	record, err := r.app.FindRecordById("transactions", id)
+	if err != nil {
+		return fmt.Errorf("failed to find transaction: %w", err)
+	}
+
+	if !record.GetDateTime("deleted_at").IsZero() {
+		return models.ErrTransactionDeleted
+	}
+
+	record.Set("deleted_at", time.Now())
+	record.Set("version", record.GetInt("version")+1)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to soft-delete transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Restore clears the deleted marker of a soft-deleted transaction
+func (r *TransactionRepository) Restore(ctx context.Context, id string) error {
+	record, err := r.app.FindRecordById("transactions", id)
+	if err != nil {
+		return fmt.Errorf("failed to find transaction: %w", err)
+	}
+
+	transaction, err := r.mapRecordToTransaction(ctx, record)
+	if err != nil {
+		return fmt.Errorf("failed to map record to transaction: %w", err)
+	}
+
+	if err := transaction.Restore(); err != nil {
+		return err
+	}
+
+	record.Set("deleted_at", "")
+	record.Set("version", record.GetInt("version")+1)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to restore transaction: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeDeleted permanently removes transactions soft-deleted before the given time
+func (r *TransactionRepository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	records := []*core.Record{}
+	err := r.app.RecordQuery("transactions").
+		AndWhere(deletedExp()).
+		AndWhere(dbx.NewExp("deleted_at < {:before}", dbx.Params{"before": before})).
+		All(&records)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find deleted transactions: %w", err)
+	}
+
+	err = r.app.RunInTransaction(func(txApp core.App) error {
+		for _, record := range records {
+			if err := txApp.DeleteWithContext(ctx, record); err != nil {
+				return fmt.Errorf("failed to purge transaction %s: %w", record.Id, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(records), nil
+}
+
+// FindByFireflyID finds the transaction linked to a Firefly III transaction
+func (r *TransactionRepository) FindByFireflyID(ctx context.Context, fireflyID string) (*models.Transaction, error) {
+	record := &core.Record{}
+	err := r.app.RecordQuery("transactions").
+		AndWhere(dbx.HashExp{"firefly_id": fireflyID}).
+		Limit(1).
+		One(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction by firefly id: %w", err)
+	}
+
+	return r.mapRecordToTransaction(ctx, record)
+}
+
+// SetFireflyID links a transaction to a Firefly III transaction (empty unlinks it)
+func (r *TransactionRepository) SetFireflyID(ctx context.Context, id, fireflyID string) error {
+	record, err := r.app.FindRecordById("transactions", id)
+	if err != nil {
+		return fmt.Errorf("failed to find transaction: %w", err)
+	}
+
+	record.Set("firefly_id", fireflyID)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to link transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Merge saves keep and soft-deletes the dropped transactions in one DB transaction,
+// reversing their balance effects and recording the merge in the transaction history.
+func (r *TransactionRepository) Merge(ctx context.Context, keep *models.Transaction, dropped []*models.Transaction) error {
+	droppedIDs := make([]string, 0, len(dropped))
+	for _, tx := range dropped {
+		droppedIDs = append(droppedIDs, tx.ID)
+	}
+
+	var keepVersion int
+	droppedVersions := make([]int, len(dropped))
+	now := time.Now()
+
+	sealed, err := r.seal(ctx, keep, nil)
+	if err != nil {
+		return fmt.Errorf("failed to merge transactions: %w", err)
+	}
+
+	err = r.app.RunInTransaction(func(txApp core.App) error {
+		var err error
+		keepVersion, err = saveVersionedTx(ctx, txApp, "transactions", keep.ID, keep.Version, func(record *core.Record) {
+			r.updateRecordFromTransaction(record, sealed)
+		})
+		if err != nil {
+			return err
+		}
+
+		balance, err := walletBalanceTx(txApp, keep.WalletID)
+		if err != nil {
+			return err
+		}
+		changes := map[string]any{"merged": droppedIDs, "tags": keep.Tags, "notes": sealed.Notes}
+		if err := r.recordHistoryTx(txApp, keep, changes, balance, balance, now); err != nil {
+			return err
+		}
+
+		for i, tx := range dropped {
+			effects := tx.BalanceEffects()
+
+			droppedVersions[i], err = saveVersionedTx(ctx, txApp, "transactions", tx.ID, tx.Version, func(record *core.Record) {
+				record.Set("deleted_at", now)
+			})
+			if err != nil {
+				return err
+			}
+
+			oldBalance, err := walletBalanceTx(txApp, tx.WalletID)
+			if err != nil {
+				return err
+			}
+			for walletID, delta := range effects {
+				if err := adjustWalletBalanceTx(ctx, txApp, walletID, -delta); err != nil {
+					return err
+				}
+			}
+
+			if err := r.recordHistoryTx(txApp, tx, map[string]any{"mergedInto": keep.ID}, oldBalance, oldBalance-effects[tx.WalletID], now); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to merge transactions: %w", err)
+	}
+
+	keep.Version = keepVersion
+	for i, tx := range dropped {
+		tx.DeletedAt = now
+		tx.Version = droppedVersions[i]
+	}
+
+	return nil
+}
+
+// recordHistoryTx writes a "merged" transaction history entry for tx
+func (r *TransactionRepository) recordHistoryTx(txApp core.App, tx *models.Transaction, changes map[string]any, oldBalance, newBalance float64, at time.Time) error {
+	collection, err := txApp.FindCollectionByNameOrId("transaction_history")
+	if err != nil {
+		return fmt.Errorf("failed to find transaction history collection: %w", err)
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("failed to encode history changes: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("transaction", tx.ID)
+	record.Set("action", "merged")
+	record.Set("changes", string(data))
+	record.Set("performed_at", at)
+	record.Set("wallet", tx.WalletID)
+	record.Set("old_balance", oldBalance)
+	record.Set("new_balance", newBalance)
+	if tx.DestWalletID != "" {
+		record.Set("destination_wallet", tx.DestWalletID)
+	}
+
+	if err := txApp.Save(record); err != nil {
+		return fmt.Errorf("failed to record history for transaction %s: %w", tx.ID, err)
+	}
+
+	return nil
+}
+
+// walletBalanceTx returns the stored balance of a wallet
+func walletBalanceTx(txApp core.App, walletID string) (float64, error) {
+	record, err := txApp.FindRecordById("wallets", walletID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find wallet %s: %w", walletID, err)
+	}
+
+	return record.GetFloat("balance"), nil
+}
+
+// adjustWalletBalanceTx adds amount to a wallet balance and bumps its version
+func adjustWalletBalanceTx(ctx context.Context, txApp core.App, walletID string, amount float64) error {
+	record, err := txApp.FindRecordById("wallets", walletID)
+	if err != nil {
+		return fmt.Errorf("failed to find wallet %s: %w", walletID, err)
+	}
+
+	record.Set("balance", record.GetFloat("balance")+amount)
+	record.Set("version", record.GetInt("version")+1)
+
+	if err := txApp.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to update balance of wallet %s: %w", walletID, err)
+	}
+
+	return nil
+}
+
+// metadataExps matches transactions whose metadata has the given values.
+// Keys are sorted so the generated SQL is stable.
+func metadataExps(metadata map[string]string) []dbx.Expression {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	exps := make([]dbx.Expression, 0, len(keys))
+	for i, key := range keys {
+		pathParam := fmt.Sprintf("meta_path%d", i)
+		valueParam := fmt.Sprintf("meta_value%d", i)
+		path := `$."` + strings.ReplaceAll(key, `"`, "") + `"`
+		exps = append(exps, dbx.NewExp(
+			fmt.Sprintf("json_extract(metadata, {:%s}) = {:%s}", pathParam, valueParam),
+			dbx.Params{pathParam: path, valueParam: metadata[key]},
+		))
+	}
+
+	return exps
+}
+
+// notSandboxExp matches the transactions that are not sandbox test data. The
+// prefix qualifies the metadata column in joins, e.g. "t.".
+func notSandboxExp(prefix string) dbx.Expression {
+	return dbx.NewExp(fmt.Sprintf("json_extract(%smetadata, {:sandbox_path}) IS NOT 'true'", prefix),
+		dbx.Params{"sandbox_path": "$." + models.MetadataSandbox})
+}
+
+// recordMetadata reads the metadata JSON object of a transaction record
+func recordMetadata(record *core.Record) (map[string]string, error) {
+	raw := record.GetString("metadata")
+	if raw == "" || raw == "null" {
+		return nil, nil
+	}
+
+	var metadata map[string]string
+	if err := json.Unmarshal([]byte(raw), &metadata); err != nil {
+		return nil, fmt.Errorf("failed to read metadata of transaction %s: %w", record.Id, err)
+	}
+
+	return metadata, nil
+}
+
+// recordTags reads the JSON array of tag names of a transaction record
+func recordTags(record *core.Record) ([]string, error) {
+	raw := record.GetString("tags")
+	if raw == "" || raw == "null" {
+		return nil, nil
+	}
+
+	var tags []string
+	if err := json.Unmarshal([]byte(raw), &tags); err != nil {
+		return nil, fmt.Errorf("failed to read tags of transaction %s: %w", record.Id, err)
+	}
+
+	return tags, nil
+}
+
+// notDeletedExp matches transactions that have not been soft-deleted
+func notDeletedExp() dbx.Expression {
+	return dbx.NewExp("(deleted_at IS NULL OR deleted_at = '')")
+}
+
+// deletedExp matches soft-deleted transactions
+func deletedExp() dbx.Expression {
+	return dbx.NewExp("(deleted_at IS NOT NULL AND deleted_at <> '')")
+}
+
+// ReencryptFields encrypts the sensitive fields of every transaction again
+// with the active data key of its wallet's space, in chunks of one DB
+// transaction each. Versions are left alone as the values do not change.
+func (r *TransactionRepository) ReencryptFields(ctx context.Context) (int, error) {
+	if r.cipher == nil {
+		return 0, nil
+	}
+
+	reencrypted := 0
+	spaces := make(map[string]string)
+	lastID := ""
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return reencrypted, err
+		}
+
+		records := []*core.Record{}
+		err := r.app.RecordQuery("transactions").
+			AndWhere(dbx.NewExp("id > {:last}", dbx.Params{"last": lastID})).
+			OrderBy("id ASC").
+			Limit(batchChunkSize).
+			All(&records)
+		if err != nil {
+			return reencrypted, fmt.Errorf("failed to find transactions: %w", err)
+		}
+		if len(records) == 0 {
+			return reencrypted, nil
+		}
+		lastID = records[len(records)-1].Id
+
+		// Encrypt before the DB transaction, which a new data key must not wait on
+		changed := []*core.Record{}
+		for _, record := range records {
+			transaction, err := r.mapRecordToTransaction(ctx, record)
+			if err != nil {
+				return reencrypted, err
+			}
+			if transaction.Description == "" && transaction.Notes == "" && !hasEncryptedMetadata(transaction) {
+				continue
+			}
+
+			sealed, err := r.seal(ctx, transaction, spaces)
+			if err != nil {
+				return reencrypted, err
+			}
+			record.Set("description", sealed.Description)
+			record.Set("notes", sealed.Notes)
+			record.Set("metadata", sealed.Metadata)
+			changed = append(changed, record)
+		}
+
+		err = r.app.RunInTransaction(func(txApp core.App) error {
+			for _, record := range changed {
+				if err := txApp.SaveWithContext(ctx, record); err != nil {
+					return fmt.Errorf("failed to save transaction %s: %w", record.Id, err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return reencrypted, fmt.Errorf("failed to re-encrypt transactions: %w", err)
+		}
+
+		reencrypted += len(changed)
+	}
+}
+
+// seal returns a copy of transaction whose sensitive fields are encrypted
+// with the data key of its wallet's space, or transaction itself without a
+// cipher. spaces caches the space of each wallet across calls; nil disables it.
+func (r *TransactionRepository) seal(ctx context.Context, transaction *models.Transaction, spaces map[string]string) (*models.Transaction, error) {
+	if r.cipher == nil {
+		return transaction, nil
+	}
+
+	spaceID, ok := spaces[transaction.WalletID]
+	if !ok {
+		wallet, err := r.app.FindRecordById("wallets", transaction.WalletID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find wallet %s: %w", transaction.WalletID, err)
+		}
+		spaceID = wallet.GetString("space")
+		if spaces != nil {
+			spaces[transaction.WalletID] = spaceID
+		}
+	}
+
+	sealed := *transaction
+	var err error
+	if sealed.Description, err = r.cipher.EncryptField(ctx, spaceID, transaction.Description); err != nil {
+		return nil, fmt.Errorf("failed to encrypt description: %w", err)
+	}
+	if sealed.Notes, err = r.cipher.EncryptField(ctx, spaceID, transaction.Notes); err != nil {
+		return nil, fmt.Errorf("failed to encrypt notes: %w", err)
+	}
+
+	if hasEncryptedMetadata(transaction) {
+		sealed.Metadata = make(map[string]string, len(transaction.Metadata))
+		for key, value := range transaction.Metadata {
+			sealed.Metadata[key] = value
+		}
+		for _, key := range models.EncryptedMetadataKeys {
+			if sealed.Metadata[key], err = r.cipher.EncryptField(ctx, spaceID, transaction.Metadata[key]); err != nil {
+				return nil, fmt.Errorf("failed to encrypt metadata %s: %w", key, err)
+			}
+		}
+	}
+
+	return &sealed, nil
+}
+
+// sealAll seals a chunk of transactions. It runs before the chunk's DB
+// transaction, as sealing may store the first data key of a space.
+func (r *TransactionRepository) sealAll(ctx context.Context, transactions []*models.Transaction, spaces map[string]string) ([]*models.Transaction, error) {
+	sealed := make([]*models.Transaction, len(transactions))
+	for i, transaction := range transactions {
+		var err error
+		if sealed[i], err = r.seal(ctx, transaction, spaces); err != nil {
+			return nil, err
+		}
+	}
+	return sealed, nil
+}
+
+// open decrypts the sensitive fields of a transaction read from its record
+func (r *TransactionRepository) open(ctx context.Context, transaction *models.Transaction) error {
+	if r.cipher == nil {
+		return nil
+	}
+
+	var err error
+	if transaction.Description, err = r.cipher.DecryptField(ctx, transaction.Description); err != nil {
+		return err
+	}
+	if transaction.Notes, err = r.cipher.DecryptField(ctx, transaction.Notes); err != nil {
+		return err
+	}
+	for _, key := range models.EncryptedMetadataKeys {
+		if value, ok := transaction.Metadata[key]; ok {
+			if transaction.Metadata[key], err = r.cipher.DecryptField(ctx, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasEncryptedMetadata reports whether a transaction has metadata entries that are encrypted
+func hasEncryptedMetadata(transaction *models.Transaction) bool {
+	for _, key := range models.EncryptedMetadataKeys {
+		if transaction.Metadata[key] != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // Helper methods for mapping between domain models and PocketBase records
 
-func (r *TransactionRepository) mapRecordToTransaction(record *core.Record) (*models.Transaction, error) {
+func (r *TransactionRepository) mapRecordToTransaction(ctx context.Context, record *core.Record) (*models.Transaction, error) {
 	// Create transaction with basic fields
 	tx := &models.Transaction{
 		ID:          record.Id,
 		Amount:      record.GetFloat("amount"),
 		Description: record.GetString("description"),
+		Notes:       record.GetString("notes"),
+		Reference:   record.GetString("reference"),
+		EndToEndID:  record.GetString("end_to_end_id"),
 		Date:        record.GetDateTime("date").Time(),
 		Type:        models.TransactionType(record.GetString("type")),
 		Status:      models.TransactionStatus(record.GetString("status")),
 		CategoryID:  record.GetString("category"),
 		WalletID:    record.GetString("wallet"),
+		DeletedAt:   record.GetDateTime("deleted_at").Time(),
+		FireflyID:   record.GetString("firefly_id"),
+		Fee:         record.GetFloat("fee"),
+		Version:     record.GetInt("version"),
 		CreatedAt:   record.GetDateTime("created").Time(),
 		UpdatedAt:   record.GetDateTime("updated").Time(),
 	}
@@ -225,17 +884,21 @@ func (r *TransactionRepository) mapRecordToTransaction(record *core.Record) (*mo
 		tx.ExchangeRate = record.GetFloat("exchange_rate")
 	}
 
-	// Handle tags if present
-	if tagValue := record.Get("tags"); tagValue != nil {
-		tags := record.Get("tags")
-		if tagsArray, ok := tags.([]interface{}); ok {
-			tx.Tags = make([]string, 0, len(tagsArray))
-			for _, tag := range tagsArray {
-				if tagStr, ok := tag.(string); ok {
-					tx.Tags = append(tx.Tags, tagStr)
-				}
-			}
-		}
+	// Tags are stored as a JSON array of tag names
+	tags, err := recordTags(record)
+	if err != nil {
+		return nil, err
+	}
+	tx.Tags = tags
+
+	metadata, err := recordMetadata(record)
+	if err != nil {
+		return nil, err
+	}
+	tx.Metadata = metadata
+
+	if err := r.open(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to decrypt transaction %s: %w", record.Id, err)
 	}
 
 	return tx, nil
@@ -248,11 +911,18 @@ func (r *TransactionRepository) mapTransactionToRecord(transaction *models.Trans
 	// Set basic fields
 	record.Set("amount", transaction.Amount)
 	record.Set("description", transaction.Description)
+	record.Set("notes", transaction.Notes)
+	record.Set("reference", transaction.Reference)
+	record.Set("end_to_end_id", transaction.EndToEndID)
+	record.Set("metadata", transaction.Metadata)
 	record.Set("date", transaction.Date)
 	record.Set("type", string(transaction.Type))
 	record.Set("status", string(transaction.Status))
 	record.Set("category", transaction.CategoryID)
 	record.Set("wallet", transaction.WalletID)
+	record.Set("firefly_id", transaction.FireflyID)
+	record.Set("fee", transaction.Fee)
+	record.Set("version", 1)
 
 	// Set transfer-specific fields
 	if transaction.Type == models.TransactionTypeTransfer {
@@ -277,11 +947,21 @@ func (r *TransactionRepository) updateRecordFromTransaction(record *core.Record,
 	// Update fields
 	record.Set("amount", transaction.Amount)
 	record.Set("description", transaction.Description)
+	record.Set("notes", transaction.Notes)
+	record.Set("reference", transaction.Reference)
+	record.Set("end_to_end_id", transaction.EndToEndID)
+	record.Set("metadata", transaction.Metadata)
 	record.Set("date", transaction.Date)
 	record.Set("type", string(transaction.Type))
 	record.Set("status", string(transaction.Status))
 	record.Set("category", transaction.CategoryID)
 	record.Set("wallet", transaction.WalletID)
+	record.Set("fee", transaction.Fee)
+
+	// Links are managed through SetFireflyID; don't clear them on regular updates
+	if transaction.FireflyID != "" {
+		record.Set("firefly_id", transaction.FireflyID)
+	}
 
 	// Update transfer-specific fields
 	if transaction.Type == models.TransactionTypeTransfer {
@@ -293,10 +973,8 @@ func (r *TransactionRepository) updateRecordFromTransaction(record *core.Record,
 		record.Set("exchange_rate", 0)
 	}
 
-	// Update tags if present
-	if len(transaction.Tags) > 0 {
-		record.Set("tags", transaction.Tags)
-	}
+	// Always write tags so removing the last tag is persisted
+	record.Set("tags", transaction.Tags)
 
 	return record
 }
@@ -0,0 +1,85 @@
+package pocketbase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// A failing item rolls back its own chunk only: the chunks committed before
+// it are kept and the items after it are not written
+func TestTransactionRepository_BatchesRollBackTheFailingChunk(t *testing.T) {
+	ctx := context.Background()
+	app := newTestApp(t)
+	repo := NewTransactionRepository(app)
+
+	wallet := &models.Wallet{Name: "Checking", Currency: "EUR", Type: models.WalletTypeBank, Balance: 1000}
+	if err := NewWalletRepository(app).Create(ctx, wallet); err != nil {
+		t.Fatalf("failed to create wallet: %v", err)
+	}
+	category, err := app.FindFirstRecordByFilter("categories", "")
+	if err != nil {
+		t.Fatalf("failed to find a category: %v", err)
+	}
+
+	// Two chunks, the second failing on its second item
+	transactions := make([]*models.Transaction, batchChunkSize+3)
+	for i := range transactions {
+		transactions[i] = &models.Transaction{Amount: float64(i + 1), Description: fmt.Sprintf("Charge %d", i), Date: testDate,
+			Type: models.TransactionTypeExpense, Status: models.TransactionStatusCompleted, CategoryID: category.Id, WalletID: wallet.ID}
+	}
+	transactions[batchChunkSize+1].CategoryID = "missing"
+
+	created, err := repo.CreateMany(ctx, transactions)
+	if err == nil || created != batchChunkSize {
+		t.Fatalf("CreateMany() = %d, %v, want the first chunk created and an error", created, err)
+	}
+	assertTransactionCount(t, app, batchChunkSize)
+	if _, err := repo.FindByID(ctx, transactions[batchChunkSize].ID); err == nil {
+		t.Errorf("transaction %d of the failing chunk was kept, want it rolled back", batchChunkSize)
+	}
+
+	// Store the rest, then update every transaction with the second chunk stale
+	transactions[batchChunkSize+1].CategoryID = category.Id
+	if _, err := repo.CreateMany(ctx, transactions[batchChunkSize:]); err != nil {
+		t.Fatalf("CreateMany() error = %v", err)
+	}
+	for _, transaction := range transactions {
+		transaction.Notes = "reviewed"
+	}
+	transactions[batchChunkSize+1].Version = 0
+
+	updated, err := repo.UpdateMany(ctx, transactions)
+	if !errors.Is(err, models.ErrConflict) || updated != batchChunkSize {
+		t.Fatalf("UpdateMany() = %d, %v, want the first chunk updated and ErrConflict", updated, err)
+	}
+	for i, transaction := range []*models.Transaction{transactions[0], transactions[batchChunkSize-1], transactions[batchChunkSize]} {
+		stored, err := repo.FindByID(ctx, transaction.ID)
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		wantNotes, wantVersion := "reviewed", 2
+		if i == 2 {
+			wantNotes, wantVersion = "", 1 // rolled back with its chunk
+		}
+		if stored.Notes != wantNotes || stored.Version != wantVersion || transaction.Version != wantVersion {
+			t.Errorf("transaction %q = notes %q, version %d (%d in memory), want %q at version %d",
+				transaction.Description, stored.Notes, stored.Version, transaction.Version, wantNotes, wantVersion)
+		}
+	}
+}
+
+func assertTransactionCount(t *testing.T, app core.App, want int) {
+	t.Helper()
+	count, err := app.CountRecords("transactions")
+	if err != nil {
+		t.Fatalf("CountRecords() error = %v", err)
+	}
+	if count != int64(want) {
+		t.Errorf("stored transactions = %d, want %d", count, want)
+	}
+}
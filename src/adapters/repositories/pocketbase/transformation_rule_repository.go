@@ -0,0 +1,133 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// TransformationRuleRepository is a PocketBase implementation of the TransformationRuleRepository interface
+type TransformationRuleRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewTransformationRuleRepository creates a new PocketBase transformation rule repository
+func NewTransformationRuleRepository(app *pocketbase.PocketBase) *TransformationRuleRepository {
+	return &TransformationRuleRepository{
+		app: app,
+	}
+}
+
+// FindByID finds a rule by ID
+func (r *TransformationRuleRepository) FindByID(ctx context.Context, id string) (*models.TransformationRule, error) {
+	record, err := r.app.FindRecordById("transformation_rules", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transformation rule: %w", err)
+	}
+
+	return r.mapRecordToRule(record), nil
+}
+
+// FindAll finds rules with optional filters, ordered by priority
+func (r *TransformationRuleRepository) FindAll(ctx context.Context, filter repositories.TransformationRuleFilter) ([]*models.TransformationRule, error) {
+	query := r.app.RecordQuery("transformation_rules")
+
+	// Apply filters
+	if filter.Source != "" {
+		query = query.AndWhere(dbx.NewExp("(source = '' OR LOWER(source) = LOWER({:source}))", dbx.Params{"source": filter.Source}))
+	}
+
+	if filter.OnlyEnabled {
+		query = query.AndWhere(dbx.HashExp{"enabled": true})
+	}
+
+	query = query.OrderBy("priority ASC", "created ASC")
+
+	// Execute query
+	records := []*core.Record{}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find transformation rules: %w", err)
+	}
+
+	rules := make([]*models.TransformationRule, 0, len(records))
+	for _, record := range records {
+		rules = append(rules, r.mapRecordToRule(record))
+	}
+
+	return rules, nil
+}
+
+// Create creates a new rule
+func (r *TransformationRuleRepository) Create(ctx context.Context, rule *models.TransformationRule) error {
+	collection, err := r.app.FindCollectionByNameOrId("transformation_rules")
+	if err != nil {
+		return fmt.Errorf("failed to find transformation rules collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	r.updateRecordFromRule(record, rule)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to create transformation rule: %w", err)
+	}
+
+	rule.ID = record.Id
+
+	return nil
+}
+
+// Update updates an existing rule
+func (r *TransformationRuleRepository) Update(ctx context.Context, rule *models.TransformationRule) error {
+	record, err := r.app.FindRecordById("transformation_rules", rule.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find transformation rule: %w", err)
+	}
+
+	r.updateRecordFromRule(record, rule)
+
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to update transformation rule: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a rule by ID
+func (r *TransformationRuleRepository) Delete(ctx context.Context, id string) error {
+	record, err := r.app.FindRecordById("transformation_rules", id)
+	if err != nil {
+		return fmt.Errorf("failed to find transformation rule: %w", err)
+	}
+
+	if err := r.app.DeleteWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to delete transformation rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TransformationRuleRepository) mapRecordToRule(record *core.Record) *models.TransformationRule {
+	return &models.TransformationRule{
+		ID:        record.Id,
+		Name:      record.GetString("name"),
+		Source:    record.GetString("source"),
+		Script:    record.GetString("script"),
+		Priority:  record.GetInt("priority"),
+		Enabled:   record.GetBool("enabled"),
+		CreatedAt: record.GetDateTime("created").Time(),
+		UpdatedAt: record.GetDateTime("updated").Time(),
+	}
+}
+
+func (r *TransformationRuleRepository) updateRecordFromRule(record *core.Record, rule *models.TransformationRule) {
+	record.Set("name", rule.Name)
+	record.Set("source", rule.Source)
+	record.Set("script", rule.Script)
+	record.Set("priority", rule.Priority)
+	record.Set("enabled", rule.Enabled)
+}
@@ -0,0 +1,50 @@
+package pocketbase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// saveVersioned applies changes to a record and saves it, but only if the
+// stored version still matches the version the caller read. The check and the
+// save run in one DB transaction and the version is bumped on success.
+// It returns the new version.
+func saveVersioned(ctx context.Context, app core.App, collection, id string, expected int, apply func(record *core.Record)) (int, error) {
+	var version int
+
+	err := app.RunInTransaction(func(txApp core.App) error {
+		var err error
+		version, err = saveVersionedTx(ctx, txApp, collection, id, expected, apply)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// saveVersionedTx is saveVersioned for callers that already run inside a DB transaction
+func saveVersionedTx(ctx context.Context, txApp core.App, collection, id string, expected int, apply func(record *core.Record)) (int, error) {
+	record, err := txApp.FindRecordById(collection, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find record: %w", err)
+	}
+
+	current := record.GetInt("version")
+	if current != expected {
+		return 0, fmt.Errorf("%s %s has version %d, expected %d: %w", collection, id, current, expected, models.ErrConflict)
+	}
+
+	apply(record)
+	record.Set("version", current+1)
+
+	if err := txApp.SaveWithContext(ctx, record); err != nil {
+		return 0, err
+	}
+
+	return current + 1, nil
+}
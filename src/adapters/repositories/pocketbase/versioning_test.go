@@ -0,0 +1,78 @@
+package pocketbase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// An update made from a stale read is refused with ErrConflict and leaves the
+// newer write in place
+func TestUpdate_RefusesStaleVersions(t *testing.T) {
+	ctx := context.Background()
+	app := newTestApp(t)
+	walletRepo := NewWalletRepository(app)
+	transactionRepo := NewTransactionRepository(app)
+
+	wallet := &models.Wallet{Name: "Checking", Currency: "EUR", Type: models.WalletTypeBank, Balance: 100}
+	if err := walletRepo.Create(ctx, wallet); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	category, err := app.FindFirstRecordByFilter("categories", "")
+	if err != nil {
+		t.Fatalf("failed to find a category: %v", err)
+	}
+	tx := &models.Transaction{ID: core.GenerateDefaultRandomId(), Amount: 30, Description: "Groceries", Date: testDate,
+		Type: models.TransactionTypeExpense, Status: models.TransactionStatusCompleted, CategoryID: category.Id, WalletID: wallet.ID}
+	if err := transactionRepo.Create(ctx, tx); err != nil {
+		t.Fatalf("failed to create transaction: %v", err)
+	}
+
+	t.Run("wallet", func(t *testing.T) {
+		first, err := walletRepo.FindByID(ctx, wallet.ID)
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		stale := *first
+
+		first.Name = "Main"
+		if err := walletRepo.Update(ctx, first); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if first.Version != stale.Version+1 {
+			t.Errorf("Version = %d, want it bumped from %d", first.Version, stale.Version)
+		}
+
+		stale.Name = "Savings"
+		if err := walletRepo.Update(ctx, &stale); !errors.Is(err, models.ErrConflict) {
+			t.Fatalf("Update() of a stale wallet error = %v, want ErrConflict", err)
+		}
+		if stored, err := walletRepo.FindByID(ctx, wallet.ID); err != nil || stored.Name != "Main" || stored.Version != first.Version {
+			t.Errorf("wallet = %+v, %v, want the first update kept", stored, err)
+		}
+	})
+
+	t.Run("transaction", func(t *testing.T) {
+		first, err := transactionRepo.FindByID(ctx, tx.ID)
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		stale := *first
+
+		first.Notes = "weekly shop"
+		if err := transactionRepo.Update(ctx, first); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		stale.Notes = "monthly shop"
+		if err := transactionRepo.Update(ctx, &stale); !errors.Is(err, models.ErrConflict) {
+			t.Fatalf("Update() of a stale transaction error = %v, want ErrConflict", err)
+		}
+		if stored, err := transactionRepo.FindByID(ctx, tx.ID); err != nil || stored.Notes != "weekly shop" || stored.Version != first.Version {
+			t.Errorf("transaction = %+v, %v, want the first update kept", stored, err)
+		}
+	})
+}
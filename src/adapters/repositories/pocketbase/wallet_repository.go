@@ -39,6 +39,10 @@ func (r *WalletRepository) FindAll(ctx context.Context, filter repositories.Wall
 	query := r.app.RecordQuery("wallets")
 
 	// Apply filters
+	if len(filter.IDs) > 0 {
+		query = query.AndWhere(dbx.In("id", stringValues(filter.IDs)...))
+	}
+
 	if filter.Type != "" {
 		query = query.AndWhere(dbx.HashExp{"type": string(filter.Type)})
 	}
@@ -51,6 +55,19 @@ func (r *WalletRepository) FindAll(ctx context.Context, filter repositories.Wall
 		query = query.AndWhere(dbx.NewExp("name LIKE {:name}", dbx.Params{"name": "%" + filter.NameLike + "%"}))
 	}
 
+	if filter.SpaceID != "" {
+		query = query.AndWhere(dbx.HashExp{"space": filter.SpaceID})
+	}
+
+	if filter.VisibleTo != "" {
+		query = query.AndWhere(visibleWalletExp("id", filter.VisibleTo))
+	}
+
+	// Archived wallets are hidden unless explicitly requested
+	if !filter.IncludeArchived {
+		query = query.AndWhere(dbx.NewExp("(archived IS NULL OR archived = FALSE)"))
+	}
+
 	// Apply sorting
 	if filter.SortBy != "" {
 		direction := "ASC"
@@ -93,9 +110,13 @@ func (r *WalletRepository) FindAll(ctx context.Context, filter repositories.Wall
 
 // Create creates a new wallet
 func (r *WalletRepository) Create(ctx context.Context, wallet *models.Wallet) error {
+	// A new wallet has no transactions yet, so it opens at its balance
+	if wallet.OpeningBalance == 0 {
+		wallet.OpeningBalance = wallet.Balance
+	}
 	record := r.mapWalletToRecord(wallet)
 
-	if err := r.app.Save(record); err != nil {
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
 		return fmt.Errorf("failed to create wallet: %w", err)
 	}
 
@@ -105,45 +126,93 @@ func (r *WalletRepository) Create(ctx context.Context, wallet *models.Wallet) er
 	return nil
 }
 
-// Update updates an existing wallet
+// Update updates an existing wallet.
+// It returns models.ErrConflict if the wallet changed since it was read.
 func (r *WalletRepository) Update(ctx context.Context, wallet *models.Wallet) error {
-	// Check if wallet exists
-	record, err := r.app.FindRecordById("wallets", wallet.ID)
+	version, err := saveVersioned(ctx, r.app, "wallets", wallet.ID, wallet.Version, func(record *core.Record) {
+		r.updateRecordFromWallet(record, wallet)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to find wallet: %w", err)
-	}
-
-	// Update fields
-	record = r.updateRecordFromWallet(record, wallet)
-
-	if err := r.app.Save(record); err != nil {
 		return fmt.Errorf("failed to update wallet: %w", err)
 	}
 
+	wallet.Version = version
+
 	return nil
 }
 
-// Delete deletes a wallet by ID
+// Delete deletes a wallet by ID.
+// Wallets with active transactions cannot be deleted and should be archived instead.
+// Soft-deleted transactions referencing the wallet are purged along with it.
 func (r *WalletRepository) Delete(ctx context.Context, id string) error {
 	record, err := r.app.FindRecordById("wallets", id) // Use r.app directly
 	if err != nil {
 		return fmt.Errorf("failed to find wallet: %w", err)
 	}
 
-	// Check for transactions associated with this wallet
-	var txCount int64 // Use int64 for count
-	// Select count(*) and use Row() to scan the result
-	countQuery := r.app.RecordQuery("transactions").Select("count(*)").AndWhere(dbx.Or(dbx.HashExp{"wallet": id}, dbx.HashExp{"destination_wallet": id}))
-	if err := countQuery.Row(&txCount); err != nil { // Use Row() to get the count
-		return fmt.Errorf("failed to check for transactions using wallet: %w", err)
+	walletExp := dbx.Or(dbx.HashExp{"wallet": id}, dbx.HashExp{"destination_wallet": id})
+
+	// Check for active transactions and purge the soft-deleted ones in the same
+	// DB transaction, so a transaction created in between blocks the delete
+	return r.app.RunInTransaction(func(txApp core.App) error {
+		var txCount int64 // Use int64 for count
+		// Select count(*) and use Row() to scan the result
+		countQuery := txApp.RecordQuery("transactions").Select("count(*)").
+			AndWhere(walletExp).
+			AndWhere(notDeletedExp())
+		if err := countQuery.Row(&txCount); err != nil { // Use Row() to get the count
+			return fmt.Errorf("failed to check for transactions using wallet: %w", err)
+		}
+
+		if txCount > 0 {
+			return fmt.Errorf("wallet has %d associated transactions: %w", txCount, models.ErrWalletHasTransactions)
+		}
+
+		deleted := []*core.Record{}
+		if err := txApp.RecordQuery("transactions").AndWhere(walletExp).All(&deleted); err != nil {
+			return fmt.Errorf("failed to find deleted transactions for wallet: %w", err)
+		}
+
+		for _, txRecord := range deleted {
+			if err := txApp.DeleteWithContext(ctx, txRecord); err != nil {
+				return fmt.Errorf("failed to purge transaction %s: %w", txRecord.Id, err)
+			}
+		}
+
+		if err := txApp.DeleteWithContext(ctx, record); err != nil {
+			return fmt.Errorf("failed to delete wallet: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Archive hides a wallet from pickers and net worth without deleting it
+func (r *WalletRepository) Archive(ctx context.Context, id string) error {
+	return r.setArchived(ctx, id, true)
+}
+
+// Unarchive restores an archived wallet
+func (r *WalletRepository) Unarchive(ctx context.Context, id string) error {
+	return r.setArchived(ctx, id, false)
+}
+
+func (r *WalletRepository) setArchived(ctx context.Context, id string, archived bool) error {
+	record, err := r.app.FindRecordById("wallets", id)
+	if err != nil {
+		return fmt.Errorf("failed to find wallet: %w", err)
 	}
 
-	if txCount > 0 {
-		return fmt.Errorf("wallet cannot be deleted because it has %d associated transactions", txCount)
+	record.Set("archived", archived)
+	if archived {
+		record.Set("archived_at", time.Now())
+	} else {
+		record.Set("archived_at", "")
 	}
+	record.Set("version", record.GetInt("version")+1)
 
-	if err := r.app.Delete(record); err != nil { // Use r.app directly
-		return fmt.Errorf("failed to delete wallet: %w", err)
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to update wallet archive state: %w", err)
 	}
 
 	return nil
@@ -158,14 +227,105 @@ func (r *WalletRepository) UpdateBalance(ctx context.Context, id string, amount
 
 	currentBalance := record.GetFloat("balance")
 	record.Set("balance", currentBalance+amount)
+	record.Set("version", record.GetInt("version")+1)
 
-	if err := r.app.Save(record); err != nil {
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
 		return fmt.Errorf("failed to update wallet balance: %w", err)
 	}
 
 	return nil
 }
 
+// ReplayBalances recomputes wallet balances from their opening balances and
+// transactions in chronological order.
+// Reads and fixes run in one DB transaction so the comparison sees a consistent snapshot.
+func (r *WalletRepository) ReplayBalances(ctx context.Context, walletID string, fix bool) ([]*models.WalletBalanceCheck, error) {
+	var checks []*models.WalletBalanceCheck
+
+	err := r.app.RunInTransaction(func(txApp core.App) error {
+		walletQuery := txApp.RecordQuery("wallets").OrderBy("name ASC")
+		txQuery := txApp.RecordQuery("transactions").
+			AndWhere(notDeletedExp()).
+			AndWhere(dbx.NewExp("status != {:failed}", dbx.Params{"failed": string(models.TransactionStatusFailed)})).
+			OrderBy("date ASC", "id ASC")
+		if walletID != "" {
+			walletQuery = walletQuery.AndWhere(dbx.HashExp{"id": walletID})
+			txQuery = txQuery.AndWhere(dbx.Or(dbx.HashExp{"wallet": walletID}, dbx.HashExp{"destination_wallet": walletID}))
+		}
+
+		walletRecords := []*core.Record{}
+		if err := walletQuery.All(&walletRecords); err != nil {
+			return fmt.Errorf("failed to find wallets: %w", err)
+		}
+		if walletID != "" && len(walletRecords) == 0 {
+			return fmt.Errorf("wallet %s: %w", walletID, models.ErrWalletNotFound)
+		}
+
+		checks = make([]*models.WalletBalanceCheck, 0, len(walletRecords))
+		byWallet := make(map[string]*models.WalletBalanceCheck, len(walletRecords))
+		for _, record := range walletRecords {
+			check := &models.WalletBalanceCheck{
+				WalletID:      record.Id,
+				Name:          record.GetString("name"),
+				Currency:      record.GetString("currency"),
+				StoredBalance: record.GetFloat("balance"),
+			}
+			check.OpeningBalance = record.GetFloat("opening_balance")
+			check.ReplayedBalance = check.OpeningBalance
+			checks = append(checks, check)
+			byWallet[record.Id] = check
+		}
+
+		txRecords := []*core.Record{}
+		if err := txQuery.All(&txRecords); err != nil {
+			return fmt.Errorf("failed to find transactions: %w", err)
+		}
+
+		for _, record := range txRecords {
+			tx := &models.Transaction{
+				Amount:       record.GetFloat("amount"),
+				Type:         models.TransactionType(record.GetString("type")),
+				Status:       models.TransactionStatus(record.GetString("status")),
+				WalletID:     record.GetString("wallet"),
+				DestWalletID: record.GetString("destination_wallet"),
+				ExchangeRate: record.GetFloat("exchange_rate"),
+				Fee:          record.GetFloat("fee"),
+			}
+			for id, delta := range tx.BalanceEffects() {
+				if check, ok := byWallet[id]; ok {
+					check.ReplayedBalance += delta
+					check.TransactionCount++
+				}
+			}
+		}
+
+		if !fix {
+			return nil
+		}
+
+		for _, record := range walletRecords {
+			check := byWallet[record.Id]
+			if !check.HasDiscrepancy() {
+				continue
+			}
+
+			record.Set("balance", check.ReplayedBalance)
+			record.Set("version", record.GetInt("version")+1)
+			if err := txApp.SaveWithContext(ctx, record); err != nil {
+				return fmt.Errorf("failed to fix balance of wallet %s: %w", record.Id, err)
+			}
+			check.Fixed = true
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay wallet balances: %w", err)
+	}
+
+	return checks, nil
+}
+
 // FindByName finds a wallet by name (case-insensitive)
 func (r *WalletRepository) FindByName(ctx context.Context, name string) (*models.Wallet, error) {
 	record := &core.Record{}
@@ -184,14 +344,19 @@ func (r *WalletRepository) FindByName(ctx context.Context, name string) (*models
 
 func (r *WalletRepository) mapRecordToWallet(record *core.Record) (*models.Wallet, error) {
 	wallet := &models.Wallet{
-		ID:          record.Id,
-		Name:        record.GetString("name"),
-		Description: record.GetString("description"),
-		Balance:     record.GetFloat("balance"),
-		Currency:    record.GetString("currency"),
-		Type:        models.WalletType(record.GetString("type")),
-		CreatedAt:   record.GetDateTime("created").Time(),
-		UpdatedAt:   record.GetDateTime("updated").Time(),
+		ID:             record.Id,
+		Name:           record.GetString("name"),
+		Description:    record.GetString("description"),
+		Balance:        record.GetFloat("balance"),
+		OpeningBalance: record.GetFloat("opening_balance"),
+		Currency:       record.GetString("currency"),
+		Type:           models.WalletType(record.GetString("type")),
+		SpaceID:        record.GetString("space"),
+		Archived:       record.GetBool("archived"),
+		ArchivedAt:     record.GetDateTime("archived_at").Time(),
+		Version:        record.GetInt("version"),
+		CreatedAt:      record.GetDateTime("created").Time(),
+		UpdatedAt:      record.GetDateTime("updated").Time(),
 	}
 
 	return wallet, nil
@@ -205,8 +370,15 @@ func (r *WalletRepository) mapWalletToRecord(wallet *models.Wallet) *core.Record
 	record.Set("name", wallet.Name)
 	record.Set("description", wallet.Description)
 	record.Set("balance", wallet.Balance)
+	record.Set("opening_balance", wallet.OpeningBalance)
 	record.Set("currency", wallet.Currency)
 	record.Set("type", string(wallet.Type))
+	record.Set("space", wallet.SpaceID)
+	record.Set("archived", wallet.Archived)
+	if wallet.Archived {
+		record.Set("archived_at", wallet.ArchivedAt)
+	}
+	record.Set("version", 1)
 
 	// Set ID if specified
 	if wallet.ID != "" {
@@ -229,6 +401,41 @@ func (r *WalletRepository) updateRecordFromWallet(record *core.Record, wallet *m
 	record.Set("balance", wallet.Balance)
 	record.Set("currency", wallet.Currency)
 	record.Set("type", string(wallet.Type))
+	record.Set("space", wallet.SpaceID)
+	record.Set("archived", wallet.Archived)
+	if wallet.Archived {
+		record.Set("archived_at", wallet.ArchivedAt)
+	} else {
+		record.Set("archived_at", "")
+	}
 
 	return record
 }
+
+// visibleWalletExp matches the rows whose wallet column is a wallet the user
+// can see: wallets outside any space are shared, the others are visible to
+// the members of their space
+func visibleWalletExp(column, userID string) dbx.Expression {
+	return dbx.NewExp(column+" IN (SELECT id FROM wallets WHERE "+visibleSpaceSQL("space")+")",
+		dbx.Params{"visible_user": userID})
+}
+
+// visibleSpaceExp matches the rows whose space column is empty, i.e. shared
+// with every user, or a space the user is a member of
+func visibleSpaceExp(column, userID string) dbx.Expression {
+	return dbx.NewExp(visibleSpaceSQL(column), dbx.Params{"visible_user": userID})
+}
+
+// visibleSpaceSQL is the condition of visibleSpaceExp, bound to {:visible_user}
+func visibleSpaceSQL(column string) string {
+	return fmt.Sprintf("(%[1]s = '' OR %[1]s IS NULL OR %[1]s IN (SELECT space FROM space_members WHERE user = {:visible_user}))", column)
+}
+
+// stringValues converts IDs to the values of a dbx.In expression
+func stringValues(ids []string) []any {
+	values := make([]any, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+	return values
+}
@@ -0,0 +1,48 @@
+package pocketbase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// The replay starts from the balance a wallet opened at, so fixing a drifted
+// balance keeps it
+func TestWalletRepository_ReplayBalancesFromTheOpeningBalance(t *testing.T) {
+	ctx := context.Background()
+	app := newTestApp(t)
+	repo := NewWalletRepository(app)
+
+	wallet := &models.Wallet{Name: "Checking", Currency: "EUR", Type: models.WalletTypeBank, Balance: 100}
+	if err := repo.Create(ctx, wallet); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if wallet.OpeningBalance != 100 {
+		t.Fatalf("OpeningBalance = %v, want the initial balance", wallet.OpeningBalance)
+	}
+
+	// A 30 expense, then a drift of the stored balance
+	category, err := app.FindFirstRecordByFilter("categories", "")
+	if err != nil {
+		t.Fatalf("failed to find a category: %v", err)
+	}
+	if err := NewTransactionRepository(app).Create(ctx, &models.Transaction{Amount: 30, Description: "Groceries", Date: testDate,
+		Type: models.TransactionTypeExpense, Status: models.TransactionStatusCompleted, CategoryID: category.Id, WalletID: wallet.ID}); err != nil {
+		t.Fatalf("failed to create transaction: %v", err)
+	}
+	if err := repo.UpdateBalance(ctx, wallet.ID, -45); err != nil {
+		t.Fatalf("UpdateBalance() error = %v", err)
+	}
+
+	checks, err := repo.ReplayBalances(ctx, wallet.ID, true)
+	if err != nil {
+		t.Fatalf("ReplayBalances() error = %v", err)
+	}
+	if len(checks) != 1 || checks[0].ReplayedBalance != 70 || checks[0].StoredBalance != 55 || !checks[0].Fixed {
+		t.Fatalf("ReplayBalances() = %+v, want 55 fixed to the 100 opening less the 30 expense", checks)
+	}
+	if stored, err := repo.FindByID(ctx, wallet.ID); err != nil || stored.Balance != 70 || stored.OpeningBalance != 100 {
+		t.Errorf("wallet = %+v, %v, want the balance fixed to 70 and its opening balance kept", stored, err)
+	}
+}
@@ -0,0 +1,204 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// WebhookRepository is a PocketBase implementation of the WebhookRepository interface
+type WebhookRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewWebhookRepository creates a new PocketBase webhook repository
+func NewWebhookRepository(app *pocketbase.PocketBase) *WebhookRepository {
+	return &WebhookRepository{
+		app: app,
+	}
+}
+
+// FindByID returns a webhook
+func (r *WebhookRepository) FindByID(ctx context.Context, id string) (*models.Webhook, error) {
+	record, err := r.app.FindRecordById("webhooks", id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("webhook %s: %w", id, models.ErrWebhookNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook %s: %w", id, err)
+	}
+	return mapRecordToWebhook(record)
+}
+
+// FindAll returns every registered webhook, oldest first
+func (r *WebhookRepository) FindAll(ctx context.Context) ([]*models.Webhook, error) {
+	records := []*core.Record{}
+	if err := r.app.RecordQuery("webhooks").OrderBy("created ASC").All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find webhooks: %w", err)
+	}
+
+	webhooks := make([]*models.Webhook, 0, len(records))
+	for _, record := range records {
+		webhook, err := mapRecordToWebhook(record)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, nil
+}
+
+func mapRecordToWebhook(record *core.Record) (*models.Webhook, error) {
+	webhook := &models.Webhook{
+		ID:          record.Id,
+		URL:         record.GetString("url"),
+		Secret:      record.GetString("secret"),
+		Enabled:     record.GetBool("enabled"),
+		Description: record.GetString("description"),
+		CreatedAt:   record.GetDateTime("created").Time(),
+		UpdatedAt:   record.GetDateTime("updated").Time(),
+	}
+	if err := record.UnmarshalJSONField("events", &webhook.Events); err != nil {
+		return nil, fmt.Errorf("failed to decode events of webhook %s: %w", record.Id, err)
+	}
+	return webhook, nil
+}
+
+// WebhookDeliveryRepository is a PocketBase implementation of the WebhookDeliveryRepository interface
+type WebhookDeliveryRepository struct {
+	app *pocketbase.PocketBase
+}
+
+// NewWebhookDeliveryRepository creates a new PocketBase webhook delivery repository
+func NewWebhookDeliveryRepository(app *pocketbase.PocketBase) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		app: app,
+	}
+}
+
+// FindDue returns pending deliveries whose next attempt is due, oldest first
+func (r *WebhookDeliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	records := []*core.Record{}
+	query := r.app.RecordQuery("webhook_deliveries").
+		AndWhere(dbx.HashExp{"status": string(models.WebhookDeliveryPending)}).
+		AndWhere(dbx.NewExp("next_attempt_at <= {:due}", dbx.Params{"due": now.UTC()})).
+		OrderBy("created ASC")
+	if limit > 0 {
+		query = query.Limit(int64(limit))
+	}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find due webhook deliveries: %w", err)
+	}
+	return r.mapRecords(records), nil
+}
+
+// FindByID returns a delivery
+func (r *WebhookDeliveryRepository) FindByID(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	record, err := r.app.FindRecordById("webhook_deliveries", id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("webhook delivery %s: %w", id, models.ErrWebhookDeliveryNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook delivery %s: %w", id, err)
+	}
+	return r.mapRecordToDelivery(record), nil
+}
+
+// FindByWebhook returns the deliveries of a webhook, newest first, optionally of one status
+func (r *WebhookDeliveryRepository) FindByWebhook(ctx context.Context, webhookID string, status models.WebhookDeliveryStatus, limit int) ([]*models.WebhookDelivery, error) {
+	records := []*core.Record{}
+	query := r.app.RecordQuery("webhook_deliveries").
+		AndWhere(dbx.HashExp{"webhook": webhookID}).
+		OrderBy("created DESC")
+	if status != "" {
+		query = query.AndWhere(dbx.HashExp{"status": string(status)})
+	}
+	if limit > 0 {
+		query = query.Limit(int64(limit))
+	}
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to find deliveries of webhook %s: %w", webhookID, err)
+	}
+	return r.mapRecords(records), nil
+}
+
+// Update stores the delivery state of a delivery
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, delivery *models.WebhookDelivery) error {
+	record, err := r.app.FindRecordById("webhook_deliveries", delivery.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find webhook delivery %s: %w", delivery.ID, err)
+	}
+
+	record.Set("status", string(delivery.Status))
+	record.Set("attempts", delivery.Attempts)
+	record.Set("response_status", delivery.ResponseStatus)
+	record.Set("last_error", delivery.LastError)
+	record.Set("next_attempt_at", delivery.NextAttemptAt)
+	if delivery.DeliveredAt.IsZero() {
+		record.Set("delivered_at", nil)
+	} else {
+		record.Set("delivered_at", delivery.DeliveredAt)
+	}
+	if err := r.app.SaveWithContext(ctx, record); err != nil {
+		return fmt.Errorf("failed to update webhook delivery %s: %w", delivery.ID, err)
+	}
+	return nil
+}
+
+// CountPending returns the number of deliveries not delivered yet
+func (r *WebhookDeliveryRepository) CountPending(ctx context.Context) (int, error) {
+	count, err := r.app.CountRecords("webhook_deliveries", dbx.HashExp{"status": string(models.WebhookDeliveryPending)})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending webhook deliveries: %w", err)
+	}
+	return int(count), nil
+}
+
+// PurgeDelivered deletes the deliveries delivered before the given time
+func (r *WebhookDeliveryRepository) PurgeDelivered(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.app.DB().Delete("webhook_deliveries", dbx.And(
+		dbx.HashExp{"status": string(models.WebhookDeliveryDelivered)},
+		dbx.NewExp("delivered_at < {:before}", dbx.Params{"before": before.UTC()}),
+	)).Execute()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge webhook deliveries: %w", err)
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purged webhook deliveries: %w", err)
+	}
+	return int(purged), nil
+}
+
+func (r *WebhookDeliveryRepository) mapRecords(records []*core.Record) []*models.WebhookDelivery {
+	deliveries := make([]*models.WebhookDelivery, 0, len(records))
+	for _, record := range records {
+		deliveries = append(deliveries, r.mapRecordToDelivery(record))
+	}
+	return deliveries
+}
+
+func (r *WebhookDeliveryRepository) mapRecordToDelivery(record *core.Record) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:             record.Id,
+		WebhookID:      record.GetString("webhook"),
+		EventID:        record.GetString("event_id"),
+		EventType:      record.GetString("event_type"),
+		Payload:        []byte(record.GetString("payload")),
+		Status:         models.WebhookDeliveryStatus(record.GetString("status")),
+		Attempts:       record.GetInt("attempts"),
+		ResponseStatus: record.GetInt("response_status"),
+		LastError:      record.GetString("last_error"),
+		NextAttemptAt:  record.GetDateTime("next_attempt_at").Time(),
+		DeliveredAt:    record.GetDateTime("delivered_at").Time(),
+		CreatedAt:      record.GetDateTime("created").Time(),
+	}
+}
@@ -0,0 +1,92 @@
+// Package storage keeps generated files, such as large exports, in the file
+// storage PocketBase is configured with.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/filesystem"
+)
+
+// FileStore stores files in the PocketBase storage: the local pb_data/storage
+// directory, or the S3 bucket configured in the PocketBase settings
+type FileStore struct {
+	app core.App
+}
+
+// NewFileStore creates a new FileStore
+func NewFileStore(app core.App) *FileStore {
+	return &FileStore{app: app}
+}
+
+// Save stores the content of r under key. The content is spooled to a
+// temporary file first, so it can be produced while the upload waits.
+func (s *FileStore) Save(ctx context.Context, key string, r io.Reader) error {
+	tmp, err := os.CreateTemp("", "firedragon-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to spool %s: %w", key, err)
+	}
+
+	file, err := filesystem.NewFileFromPath(tmp.Name())
+	if err != nil {
+		return err
+	}
+
+	fsys, err := s.app.NewFilesystem()
+	if err != nil {
+		return fmt.Errorf("failed to open storage: %w", err)
+	}
+	defer fsys.Close()
+	fsys.SetContext(ctx)
+
+	if err := fsys.UploadFile(file, key); err != nil {
+		return fmt.Errorf("failed to store %s: %w", key, err)
+	}
+	return nil
+}
+
+// Open returns a reader of the file stored under key
+func (s *FileStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	fsys, err := s.app.NewFilesystem()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open storage: %w", err)
+	}
+	fsys.SetContext(ctx)
+
+	reader, err := fsys.GetFile(key)
+	if err != nil {
+		fsys.Close()
+		if errors.Is(err, filesystem.ErrNotFound) {
+			return nil, fmt.Errorf("%s: %w", key, models.ErrExportNotFound)
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	return &storedFile{ReadCloser: reader, fsys: fsys}, nil
+}
+
+// storedFile closes the storage along with the file
+type storedFile struct {
+	io.ReadCloser
+	fsys *filesystem.System
+}
+
+func (f *storedFile) Close() error {
+	err := f.ReadCloser.Close()
+	f.fsys.Close()
+	return err
+}
@@ -0,0 +1,17150 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	PocketbaseScopes = "pocketbase.Scopes"
+)
+
+// Defines values for AuditAction.
+const (
+	AuditActionCreate  AuditAction = "create"
+	AuditActionDelete  AuditAction = "delete"
+	AuditActionRequest AuditAction = "request"
+	AuditActionUpdate  AuditAction = "update"
+)
+
+// Defines values for AuditActorType.
+const (
+	AuditActorTypeSuperuser AuditActorType = "superuser"
+	AuditActorTypeSystem    AuditActorType = "system"
+	AuditActorTypeUser      AuditActorType = "user"
+)
+
+// Defines values for AuditSource.
+const (
+	AuditSourceApi    AuditSource = "api"
+	AuditSourceHook   AuditSource = "hook"
+	AuditSourceImport AuditSource = "import"
+	AuditSourceSystem AuditSource = "system"
+)
+
+// Defines values for BackfillStatus.
+const (
+	BackfillStatusCompleted BackfillStatus = "completed"
+	BackfillStatusFailed    BackfillStatus = "failed"
+	BackfillStatusRunning   BackfillStatus = "running"
+)
+
+// Defines values for BalanceSide.
+const (
+	Firefly  BalanceSide = "firefly"
+	Local    BalanceSide = "local"
+	Provider BalanceSide = "provider"
+)
+
+// Defines values for BalanceType.
+const (
+	ClosingBooked    BalanceType = "closingBooked"
+	Expected         BalanceType = "expected"
+	ForwardAvailable BalanceType = "forwardAvailable"
+	InterimAvailable BalanceType = "interimAvailable"
+	InterimBooked    BalanceType = "interimBooked"
+	OpeningBooked    BalanceType = "openingBooked"
+)
+
+// Defines values for BudgetImportStatus.
+const (
+	BudgetImportStatusCommitted BudgetImportStatus = "committed"
+	BudgetImportStatusDiscarded BudgetImportStatus = "discarded"
+	BudgetImportStatusPending   BudgetImportStatus = "pending"
+)
+
+// Defines values for CategoryType.
+const (
+	CategoryTypeExpense  CategoryType = "expense"
+	CategoryTypeIncome   CategoryType = "income"
+	CategoryTypeTransfer CategoryType = "transfer"
+)
+
+// Defines values for ConsentRenewal.
+const (
+	ConsentRenewalCompleted ConsentRenewal = "completed"
+	ConsentRenewalPending   ConsentRenewal = "pending"
+)
+
+// Defines values for CostBasisMethod.
+const (
+	Average CostBasisMethod = "average"
+	Fifo    CostBasisMethod = "fifo"
+)
+
+// Defines values for DateFormat.
+const (
+	DDMMYYYY  DateFormat = "DD.MM.YYYY"
+	DDMMYYYY1 DateFormat = "DD/MM/YYYY"
+	MMDDYYYY  DateFormat = "MM/DD/YYYY"
+	YYYYMMDD  DateFormat = "YYYY-MM-DD"
+)
+
+// Defines values for DuplicateAction.
+const (
+	Allow DuplicateAction = "allow"
+	Block DuplicateAction = "block"
+	Flag  DuplicateAction = "flag"
+)
+
+// Defines values for ErrorType.
+const (
+	Auth        ErrorType = "auth"
+	Network     ErrorType = "network"
+	NotFound    ErrorType = "not_found"
+	ProviderBug ErrorType = "provider_bug"
+	RateLimit   ErrorType = "rate_limit"
+	Timeout     ErrorType = "timeout"
+	Unknown     ErrorType = "unknown"
+	Validation  ErrorType = "validation"
+)
+
+// Defines values for ExportDataset.
+const (
+	Categories   ExportDataset = "categories"
+	Transactions ExportDataset = "transactions"
+	Wallets      ExportDataset = "wallets"
+)
+
+// Defines values for ExportFormat.
+const (
+	Csv  ExportFormat = "csv"
+	Json ExportFormat = "json"
+)
+
+// Defines values for ExportJobStatus.
+const (
+	ExportJobStatusCompleted ExportJobStatus = "completed"
+	ExportJobStatusFailed    ExportJobStatus = "failed"
+	ExportJobStatusRunning   ExportJobStatus = "running"
+)
+
+// Defines values for SourceLifecycle.
+const (
+	SourceLifecycleActive         SourceLifecycle = "active"
+	SourceLifecycleAuthorized     SourceLifecycle = "authorized"
+	SourceLifecycleDegraded       SourceLifecycle = "degraded"
+	SourceLifecycleDisabled       SourceLifecycle = "disabled"
+	SourceLifecycleExpiredConsent SourceLifecycle = "expired_consent"
+	SourceLifecycleUnconfigured   SourceLifecycle = "unconfigured"
+)
+
+// Defines values for SpaceKind.
+const (
+	Business  SpaceKind = "business"
+	Household SpaceKind = "household"
+	Personal  SpaceKind = "personal"
+)
+
+// Defines values for SpaceRole.
+const (
+	Editor SpaceRole = "editor"
+	Owner  SpaceRole = "owner"
+	Viewer SpaceRole = "viewer"
+)
+
+// Defines values for SubscriptionInterval.
+const (
+	Monthly   SubscriptionInterval = "monthly"
+	Quarterly SubscriptionInterval = "quarterly"
+	Weekly    SubscriptionInterval = "weekly"
+	Yearly    SubscriptionInterval = "yearly"
+)
+
+// Defines values for SubscriptionStatus.
+const (
+	SubscriptionStatusActive SubscriptionStatus = "active"
+	SubscriptionStatusMissed SubscriptionStatus = "missed"
+)
+
+// Defines values for TransactionStatus.
+const (
+	TransactionStatusCompleted TransactionStatus = "completed"
+	TransactionStatusFailed    TransactionStatus = "failed"
+	TransactionStatusPending   TransactionStatus = "pending"
+)
+
+// Defines values for TransactionType.
+const (
+	TransactionTypeExpense  TransactionType = "expense"
+	TransactionTypeIncome   TransactionType = "income"
+	TransactionTypeTransfer TransactionType = "transfer"
+)
+
+// Defines values for WalletType.
+const (
+	Bank   WalletType = "bank"
+	Cash   WalletType = "cash"
+	Crypto WalletType = "crypto"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for Weekday.
+const (
+	N0 Weekday = 0
+	N1 Weekday = 1
+	N2 Weekday = 2
+	N3 Weekday = 3
+	N4 Weekday = 4
+	N5 Weekday = 5
+	N6 Weekday = 6
+)
+
+// AccountRemoval defines model for AccountRemoval.
+type AccountRemoval struct {
+	Archived         bool   `json:"archived"`
+	Deleted          bool   `json:"deleted"`
+	FireflyAccountId string `json:"fireflyAccountId"`
+	RemovedMappings  int    `json:"removedMappings"`
+}
+
+// AssetGains defines model for AssetGains.
+type AssetGains struct {
+	Asset      string   `json:"asset"`
+	CostBasis  float64  `json:"costBasis"`
+	Error      *string  `json:"error,omitempty"`
+	Price      *float64 `json:"price,omitempty"`
+	Quantity   float64  `json:"quantity"`
+	Realized   float64  `json:"realized"`
+	Unrealized float64  `json:"unrealized"`
+	Value      *float64 `json:"value,omitempty"`
+}
+
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditActorType defines model for AuditActorType.
+type AuditActorType string
+
+// AuditChange defines model for AuditChange.
+type AuditChange struct {
+	After  interface{} `json:"after"`
+	Before interface{} `json:"before"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action     AuditAction             `json:"action"`
+	ActorId    *string                 `json:"actorId,omitempty"`
+	ActorType  AuditActorType          `json:"actorType"`
+	At         time.Time               `json:"at"`
+	Changes    *map[string]AuditChange `json:"changes,omitempty"`
+	Collection *string                 `json:"collection,omitempty"`
+	Id         string                  `json:"id"`
+	RecordId   *string                 `json:"recordId,omitempty"`
+	Request    *string                 `json:"request,omitempty"`
+	Source     AuditSource             `json:"source"`
+	Status     *int                    `json:"status,omitempty"`
+}
+
+// AuditSource defines model for AuditSource.
+type AuditSource string
+
+// Backfill defines model for Backfill.
+type Backfill struct {
+	CompletedAt *time.Time     `json:"completedAt,omitempty"`
+	Error       *string        `json:"error,omitempty"`
+	Id          string         `json:"id"`
+	Imported    int            `json:"imported"`
+	Pages       int            `json:"pages"`
+	Progress    float64        `json:"progress"`
+	SourceId    string         `json:"sourceId"`
+	StartedAt   time.Time      `json:"startedAt"`
+	Status      BackfillStatus `json:"status"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+}
+
+// BackfillStatus defines model for BackfillStatus.
+type BackfillStatus string
+
+// BalanceAssertion defines model for BalanceAssertion.
+type BalanceAssertion struct {
+	Compare   []BalanceSide `json:"compare"`
+	CreatedAt *time.Time    `json:"createdAt,omitempty"`
+	Enabled   bool          `json:"enabled"`
+	Id        string        `json:"id"`
+	Name      string        `json:"name"`
+	Schedule  string        `json:"schedule"`
+	SourceId  string        `json:"sourceId"`
+	Tolerance float64       `json:"tolerance"`
+	UpdatedAt *time.Time    `json:"updatedAt,omitempty"`
+}
+
+// BalanceAssertionResult defines model for BalanceAssertionResult.
+type BalanceAssertionResult struct {
+	AssertionId string             `json:"assertionId"`
+	Balances    map[string]float64 `json:"balances"`
+	CheckedAt   time.Time          `json:"checkedAt"`
+	Currency    *string            `json:"currency,omitempty"`
+	Drift       float64            `json:"drift"`
+	Error       *string            `json:"error,omitempty"`
+	Id          string             `json:"id"`
+	Passed      bool               `json:"passed"`
+	Tolerance   float64            `json:"tolerance"`
+	WalletId    *string            `json:"walletId,omitempty"`
+}
+
+// BalanceDrift defines model for BalanceDrift.
+type BalanceDrift struct {
+	Currency         string  `json:"currency"`
+	Drift            float64 `json:"drift"`
+	Firefly          float64 `json:"firefly"`
+	FireflyAccountId string  `json:"fireflyAccountId"`
+	Reported         float64 `json:"reported"`
+	SourceId         string  `json:"sourceId"`
+}
+
+// BalanceRecalculationReport defines model for BalanceRecalculationReport.
+type BalanceRecalculationReport struct {
+	Checked       int                  `json:"checked"`
+	CheckedAt     time.Time            `json:"checkedAt"`
+	Discrepancies []WalletBalanceCheck `json:"discrepancies"`
+	Fixed         int                  `json:"fixed"`
+}
+
+// BalanceSide defines model for BalanceSide.
+type BalanceSide string
+
+// BalanceType defines model for BalanceType.
+type BalanceType string
+
+// BalanceUpdateReport defines model for BalanceUpdateReport.
+type BalanceUpdateReport struct {
+	Compared   int            `json:"compared"`
+	Drifts     []BalanceDrift `json:"drifts"`
+	Errors     *[]string      `json:"errors,omitempty"`
+	FinishedAt time.Time      `json:"finishedAt"`
+	Snapshots  int            `json:"snapshots"`
+	Sources    int            `json:"sources"`
+	StartedAt  time.Time      `json:"startedAt"`
+}
+
+// BootstrapAccountResult defines model for BootstrapAccountResult.
+type BootstrapAccountResult struct {
+	Account          string   `json:"account"`
+	Created          bool     `json:"created"`
+	Currency         *string  `json:"currency,omitempty"`
+	Error            *string  `json:"error,omitempty"`
+	FireflyAccountId *string  `json:"fireflyAccountId,omitempty"`
+	OpeningBalance   *float64 `json:"openingBalance,omitempty"`
+	Source           string   `json:"source"`
+	Warning          *string  `json:"warning,omitempty"`
+}
+
+// BootstrapReport defines model for BootstrapReport.
+type BootstrapReport struct {
+	Accounts          []BootstrapAccountResult `json:"accounts"`
+	CreatedCurrencies []string                 `json:"createdCurrencies"`
+	EnabledCurrencies []string                 `json:"enabledCurrencies"`
+}
+
+// BudgetAccountMapping defines model for BudgetAccountMapping.
+type BudgetAccountMapping struct {
+	Account      string  `json:"account"`
+	Currency     string  `json:"currency"`
+	Skip         *bool   `json:"skip,omitempty"`
+	Transactions int     `json:"transactions"`
+	WalletId     *string `json:"walletId,omitempty"`
+}
+
+// BudgetCategoryMapping defines model for BudgetCategoryMapping.
+type BudgetCategoryMapping struct {
+	Category     string       `json:"category"`
+	CategoryId   *string      `json:"categoryId,omitempty"`
+	Group        *string      `json:"group,omitempty"`
+	Transactions int          `json:"transactions"`
+	Type         CategoryType `json:"type"`
+}
+
+// BudgetImport defines model for BudgetImport.
+type BudgetImport struct {
+	Accounts     []BudgetAccountMapping  `json:"accounts"`
+	Categories   []BudgetCategoryMapping `json:"categories"`
+	CreatedAt    time.Time               `json:"createdAt"`
+	Error        *string                 `json:"error,omitempty"`
+	Id           string                  `json:"id"`
+	Imported     int                     `json:"imported"`
+	Payees       int                     `json:"payees"`
+	Profile      string                  `json:"profile"`
+	Status       BudgetImportStatus      `json:"status"`
+	Transactions []BudgetTransaction     `json:"transactions"`
+	UpdatedAt    time.Time               `json:"updatedAt"`
+}
+
+// BudgetImportReport defines model for BudgetImportReport.
+type BudgetImportReport struct {
+	CategoriesCreated int            `json:"categoriesCreated"`
+	Import            BudgetImport   `json:"import"`
+	Imported          int            `json:"imported"`
+	Skipped           int            `json:"skipped"`
+	Wallets           []ImportReport `json:"wallets"`
+	WalletsCreated    int            `json:"walletsCreated"`
+}
+
+// BudgetImportStatus defines model for BudgetImportStatus.
+type BudgetImportStatus string
+
+// BudgetMappingInput defines model for BudgetMappingInput.
+type BudgetMappingInput struct {
+	Accounts   []BudgetAccountMapping  `json:"accounts"`
+	Categories []BudgetCategoryMapping `json:"categories"`
+}
+
+// BudgetTransaction defines model for BudgetTransaction.
+type BudgetTransaction struct {
+	Account         string    `json:"account"`
+	Amount          float64   `json:"amount"`
+	Category        *string   `json:"category,omitempty"`
+	CategoryGroup   *string   `json:"categoryGroup,omitempty"`
+	Cleared         bool      `json:"cleared"`
+	Date            time.Time `json:"date"`
+	Id              string    `json:"id"`
+	Memo            *string   `json:"memo,omitempty"`
+	Payee           *string   `json:"payee,omitempty"`
+	TransferAccount *string   `json:"transferAccount,omitempty"`
+}
+
+// CalendarEvent defines model for CalendarEvent.
+type CalendarEvent struct {
+	Categories  *[]string `json:"categories,omitempty"`
+	Date        time.Time `json:"date"`
+	Description *string   `json:"description,omitempty"`
+	Summary     string    `json:"summary"`
+	Uid         string    `json:"uid"`
+}
+
+// CategorizationStatus defines model for CategorizationStatus.
+type CategorizationStatus struct {
+	AutoApply     float64    `json:"autoApply"`
+	Categories    int        `json:"categories"`
+	MinConfidence float64    `json:"minConfidence"`
+	TrainedAt     *time.Time `json:"trainedAt,omitempty"`
+	Transactions  int        `json:"transactions"`
+	Vocabulary    int        `json:"vocabulary"`
+}
+
+// Category defines model for Category.
+type Category struct {
+	Color       string       `json:"color"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	Description string       `json:"description"`
+	Id          string       `json:"id"`
+	IsSystem    bool         `json:"isSystem"`
+	Key         *string      `json:"key,omitempty"`
+	Name        string       `json:"name"`
+	ParentId    *string      `json:"parentId,omitempty"`
+	SpaceId     *string      `json:"spaceId,omitempty"`
+	Type        CategoryType `json:"type"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+	Version     int          `json:"version"`
+}
+
+// CategoryReview defines model for CategoryReview.
+type CategoryReview struct {
+	Suggestions []CategorySuggestion `json:"suggestions"`
+	Transaction Transaction          `json:"transaction"`
+}
+
+// CategorySuggestion defines model for CategorySuggestion.
+type CategorySuggestion struct {
+	CategoryId string  `json:"categoryId"`
+	Confidence float64 `json:"confidence"`
+}
+
+// CategoryType defines model for CategoryType.
+type CategoryType string
+
+// CompactRequest defines model for CompactRequest.
+type CompactRequest struct {
+	Keep int64 `json:"keep"`
+}
+
+// ConsentRenewal defines model for ConsentRenewal.
+type ConsentRenewal string
+
+// CostBasisMethod defines model for CostBasisMethod.
+type CostBasisMethod string
+
+// CostBasisReport defines model for CostBasisReport.
+type CostBasisReport struct {
+	AsOf            time.Time       `json:"asOf"`
+	Assets          []AssetGains    `json:"assets"`
+	BaseCurrency    string          `json:"baseCurrency"`
+	Method          CostBasisMethod `json:"method"`
+	Realized        []RealizedGain  `json:"realized"`
+	TotalRealized   float64         `json:"totalRealized"`
+	TotalUnrealized float64         `json:"totalUnrealized"`
+}
+
+// DateFormat defines model for DateFormat.
+type DateFormat string
+
+// DescriptionFields defines model for DescriptionFields.
+type DescriptionFields struct {
+	Amount       float64   `json:"amount"`
+	Asset        string    `json:"asset"`
+	Chain        string    `json:"chain"`
+	Counterparty string    `json:"counterparty"`
+	Date         time.Time `json:"date"`
+	Description  string    `json:"description"`
+	Memo         string    `json:"memo"`
+	Reference    string    `json:"reference"`
+	Source       string    `json:"source"`
+	Type         string    `json:"type"`
+}
+
+// DescriptionPreview defines model for DescriptionPreview.
+type DescriptionPreview struct {
+	Description string            `json:"description"`
+	Fields      DescriptionFields `json:"fields"`
+	Template    string            `json:"template"`
+}
+
+// DescriptionPreviewInput defines model for DescriptionPreviewInput.
+type DescriptionPreviewInput struct {
+	Source      string      `json:"source"`
+	Template    string      `json:"template"`
+	Transaction Transaction `json:"transaction"`
+}
+
+// DuplicateAction defines model for DuplicateAction.
+type DuplicateAction string
+
+// DuplicateDecision defines model for DuplicateDecision.
+type DuplicateDecision struct {
+	Action       DuplicateAction `json:"action"`
+	BatchMatches *[]int          `json:"batchMatches,omitempty"`
+	Description  string          `json:"description"`
+	Index        int             `json:"index"`
+	Matches      []string        `json:"matches"`
+}
+
+// DuplicatePolicy defines model for DuplicatePolicy.
+type DuplicatePolicy struct {
+	Action    DuplicateAction `json:"action"`
+	Tolerance float64         `json:"tolerance"`
+
+	// Window nanoseconds
+	Window int64 `json:"window"`
+}
+
+// ErrorType defines model for ErrorType.
+type ErrorType string
+
+// ExchangeImportReport defines model for ExchangeImportReport.
+type ExchangeImportReport struct {
+	Imported  int            `json:"imported"`
+	Movements int            `json:"movements"`
+	Profile   string         `json:"profile"`
+	Skipped   int            `json:"skipped"`
+	Wallets   []ImportReport `json:"wallets"`
+}
+
+// ExchangeProfile defines model for ExchangeProfile.
+type ExchangeProfile struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+// ExportDataset defines model for ExportDataset.
+type ExportDataset string
+
+// ExportFormat defines model for ExportFormat.
+type ExportFormat string
+
+// ExportJob defines model for ExportJob.
+type ExportJob struct {
+	CompletedAt *time.Time      `json:"completedAt,omitempty"`
+	Error       *string         `json:"error,omitempty"`
+	FileName    string          `json:"fileName"`
+	Id          string          `json:"id"`
+	Records     int             `json:"records"`
+	Request     ExportRequest   `json:"request"`
+	StartedAt   time.Time       `json:"startedAt"`
+	Status      ExportJobStatus `json:"status"`
+}
+
+// ExportJobStatus defines model for ExportJobStatus.
+type ExportJobStatus string
+
+// ExportRequest defines model for ExportRequest.
+type ExportRequest struct {
+	CategoryId *string       `json:"categoryId,omitempty"`
+	Dataset    ExportDataset `json:"dataset"`
+	DateFrom   *time.Time    `json:"dateFrom,omitempty"`
+	DateTo     *time.Time    `json:"dateTo,omitempty"`
+	Format     ExportFormat  `json:"format"`
+	WalletId   *string       `json:"walletId,omitempty"`
+}
+
+// FireflyConflict defines model for FireflyConflict.
+type FireflyConflict struct {
+	Fields        []string `json:"fields"`
+	FireflyId     string   `json:"fireflyId"`
+	TransactionId string   `json:"transactionId"`
+}
+
+// FireflyLink defines model for FireflyLink.
+type FireflyLink struct {
+	Id         string  `json:"id"`
+	InwardId   string  `json:"inward_id"`
+	LinkTypeId string  `json:"link_type_id"`
+	Notes      *string `json:"notes,omitempty"`
+	OutwardId  string  `json:"outward_id"`
+}
+
+// FireflyOutboxReport defines model for FireflyOutboxReport.
+type FireflyOutboxReport struct {
+	Delivered int       `json:"delivered"`
+	Discarded int       `json:"discarded"`
+	Errors    *[]string `json:"errors,omitempty"`
+	Failed    int       `json:"failed"`
+	Skipped   bool      `json:"skipped"`
+}
+
+// FireflyOutboxStats defines model for FireflyOutboxStats.
+type FireflyOutboxStats struct {
+	Available   bool       `json:"available"`
+	LastDrainAt *time.Time `json:"lastDrainAt,omitempty"`
+	LastError   *string    `json:"lastError,omitempty"`
+	Pending     int        `json:"pending"`
+	RetryAt     *time.Time `json:"retryAt,omitempty"`
+}
+
+// FireflyPullReport defines model for FireflyPullReport.
+type FireflyPullReport struct {
+	Changed    int               `json:"changed"`
+	Conflicts  []FireflyConflict `json:"conflicts"`
+	Errors     *[]string         `json:"errors,omitempty"`
+	FinishedAt time.Time         `json:"finishedAt"`
+	Since      time.Time         `json:"since"`
+	StartedAt  time.Time         `json:"startedAt"`
+	Unlinked   int               `json:"unlinked"`
+	Updated    int               `json:"updated"`
+}
+
+// GetNetworthResult defines model for GetNetworthResult.
+type GetNetworthResult struct {
+	AsOf         time.Time         `json:"asOf"`
+	BaseCurrency string            `json:"baseCurrency"`
+	History      *[]NetWorthPoint  `json:"history,omitempty"`
+	Total        float64           `json:"total"`
+	Wallets      []WalletValuation `json:"wallets"`
+}
+
+// ImportCycleReport defines model for ImportCycleReport.
+type ImportCycleReport struct {
+	CycleId    string              `json:"cycleId"`
+	Failed     int                 `json:"failed"`
+	FinishedAt time.Time           `json:"finishedAt"`
+	Id         string              `json:"id"`
+	Imported   int                 `json:"imported"`
+	Snapshots  int                 `json:"snapshots"`
+	Sources    []SourceCycleReport `json:"sources"`
+	StartedAt  time.Time           `json:"startedAt"`
+}
+
+// ImportInput defines model for ImportInput.
+type ImportInput struct {
+	Filtered     TokenFilterStats `json:"filtered"`
+	Source       string           `json:"source"`
+	Transactions []Transaction    `json:"transactions"`
+	WalletId     string           `json:"walletId"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	Classified         int                  `json:"classified"`
+	DuplicateDecisions *[]DuplicateDecision `json:"duplicateDecisions,omitempty"`
+	DuplicatePolicy    DuplicatePolicy      `json:"duplicatePolicy"`
+	Duplicates         int                  `json:"duplicates"`
+	Errors             *[]string            `json:"errors,omitempty"`
+	Filtered           int                  `json:"filtered"`
+	FinishedAt         time.Time            `json:"finishedAt"`
+	Flagged            int                  `json:"flagged"`
+	Imported           int                  `json:"imported"`
+	Invalid            int                  `json:"invalid"`
+	Queued             int                  `json:"queued"`
+	Received           int                  `json:"received"`
+	Snapshots          int                  `json:"snapshots"`
+	Source             string               `json:"source"`
+	StartedAt          time.Time            `json:"startedAt"`
+	TokenFilter        TokenFilterStats     `json:"tokenFilter"`
+	WalletId           string               `json:"walletId"`
+}
+
+// Incident defines model for Incident.
+type Incident struct {
+	EndedAt    *time.Time `json:"endedAt,omitempty"`
+	ErrorClass string     `json:"errorClass"`
+	Failures   int        `json:"failures"`
+	Id         string     `json:"id"`
+	LastError  string     `json:"lastError"`
+	Provider   string     `json:"provider"`
+	StartedAt  time.Time  `json:"startedAt"`
+}
+
+// Location defines model for Location.
+type Location struct {
+	Column int `json:"column"`
+	Line   int `json:"line"`
+}
+
+// Maintenance defines model for Maintenance.
+type Maintenance struct {
+	Id        string    `json:"id"`
+	Reason    *string   `json:"reason,omitempty"`
+	SpaceId   *string   `json:"spaceId,omitempty"`
+	StartedAt time.Time `json:"startedAt"`
+	StartedBy *string   `json:"startedBy,omitempty"`
+}
+
+// MaintenanceState defines model for MaintenanceState.
+type MaintenanceState struct {
+	Global Maintenance   `json:"global"`
+	Spaces []Maintenance `json:"spaces"`
+}
+
+// NetWorthPoint defines model for NetWorthPoint.
+type NetWorthPoint struct {
+	Date  time.Time `json:"date"`
+	Total float64   `json:"total"`
+}
+
+// NotificationSettings defines model for NotificationSettings.
+type NotificationSettings struct {
+	BalanceDrift      bool    `json:"balanceDrift"`
+	Consent           bool    `json:"consent"`
+	Incidents         bool    `json:"incidents"`
+	LargeTransactions float64 `json:"largeTransactions"`
+	Subscriptions     bool    `json:"subscriptions"`
+}
+
+// Period defines model for Period.
+type Period struct {
+	End   time.Time `json:"end"`
+	Name  string    `json:"name"`
+	Start time.Time `json:"start"`
+}
+
+// PeriodSpend defines model for PeriodSpend.
+type PeriodSpend struct {
+	End   time.Time  `json:"end"`
+	Name  string     `json:"name"`
+	Spend []TagSpend `json:"spend"`
+	Start time.Time  `json:"start"`
+}
+
+// Portfolio defines model for Portfolio.
+type Portfolio struct {
+	AsOf         time.Time          `json:"asOf"`
+	Assets       []PortfolioGroup   `json:"assets"`
+	BaseCurrency string             `json:"baseCurrency"`
+	Chains       []PortfolioGroup   `json:"chains"`
+	Change24h    *PortfolioChange   `json:"change24h,omitempty"`
+	Change7d     *PortfolioChange   `json:"change7d,omitempty"`
+	Holdings     []PortfolioHolding `json:"holdings"`
+	Total        float64            `json:"total"`
+}
+
+// PortfolioChange defines model for PortfolioChange.
+type PortfolioChange struct {
+	From    float64 `json:"from"`
+	Percent float64 `json:"percent"`
+	Value   float64 `json:"value"`
+}
+
+// PortfolioGroup defines model for PortfolioGroup.
+type PortfolioGroup struct {
+	Balance   *float64         `json:"balance,omitempty"`
+	Change24h *PortfolioChange `json:"change24h,omitempty"`
+	Change7d  *PortfolioChange `json:"change7d,omitempty"`
+	Name      string           `json:"name"`
+	Share     float64          `json:"share"`
+	Value     float64          `json:"value"`
+}
+
+// PortfolioHolding defines model for PortfolioHolding.
+type PortfolioHolding struct {
+	Asset     string           `json:"asset"`
+	Balance   float64          `json:"balance"`
+	Chain     string           `json:"chain"`
+	Change24h *PortfolioChange `json:"change24h,omitempty"`
+	Change7d  *PortfolioChange `json:"change7d,omitempty"`
+	Error     *string          `json:"error,omitempty"`
+	Name      string           `json:"name"`
+	Price     *float64         `json:"price,omitempty"`
+	Value     float64          `json:"value"`
+	WalletId  string           `json:"walletId"`
+}
+
+// Preferences defines model for Preferences.
+type Preferences struct {
+	BaseCurrency    string               `json:"baseCurrency"`
+	CreatedAt       *time.Time           `json:"createdAt,omitempty"`
+	DateFormat      DateFormat           `json:"dateFormat"`
+	DefaultWalletId *string              `json:"defaultWalletId,omitempty"`
+	FirstDayOfWeek  Weekday              `json:"firstDayOfWeek"`
+	Id              *string              `json:"id,omitempty"`
+	Notifications   NotificationSettings `json:"notifications"`
+	UpdatedAt       *time.Time           `json:"updatedAt,omitempty"`
+	UserId          string               `json:"userId"`
+	Version         int                  `json:"version"`
+}
+
+// Problem defines model for Problem.
+type Problem struct {
+	Code   string  `json:"code"`
+	Detail *string `json:"detail,omitempty"`
+	Errors *[]struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+		Rule    string `json:"rule"`
+	} `json:"errors,omitempty"`
+	Instance  *string `json:"instance,omitempty"`
+	RequestId *string `json:"requestId,omitempty"`
+	Status    int     `json:"status"`
+	Title     string  `json:"title"`
+	Type      string  `json:"type"`
+}
+
+// ProviderStats defines model for ProviderStats.
+type ProviderStats struct {
+	Lanes     map[string]int `json:"lanes"`
+	LatencyMs float64        `json:"latencyMs"`
+	Limit     int            `json:"limit"`
+	MaxMs     float64        `json:"maxMs"`
+	Processed int64          `json:"processed"`
+	Provider  string         `json:"provider"`
+	Queued    int            `json:"queued"`
+	Restarts  int64          `json:"restarts"`
+	Running   int            `json:"running"`
+}
+
+// PurgeRequest defines model for PurgeRequest.
+type PurgeRequest struct {
+	OlderThan *string `json:"olderThan,omitempty"`
+	Subject   *string `json:"subject,omitempty"`
+}
+
+// PurgeResult defines model for PurgeResult.
+type PurgeResult struct {
+	Purged int64  `json:"purged"`
+	Stream string `json:"stream"`
+}
+
+// QueryError defines model for QueryError.
+type QueryError struct {
+	Extensions *map[string]interface{} `json:"extensions,omitempty"`
+	Locations  *[]Location             `json:"locations,omitempty"`
+	Message    string                  `json:"message"`
+	Path       *[]interface{}          `json:"path,omitempty"`
+}
+
+// RealizedGain defines model for RealizedGain.
+type RealizedGain struct {
+	AcquiredAt    *time.Time `json:"acquiredAt,omitempty"`
+	Asset         string     `json:"asset"`
+	CostBasis     float64    `json:"costBasis"`
+	DisposedAt    time.Time  `json:"disposedAt"`
+	Gain          float64    `json:"gain"`
+	LongTerm      bool       `json:"longTerm"`
+	MissingBasis  *bool      `json:"missingBasis,omitempty"`
+	Proceeds      float64    `json:"proceeds"`
+	Quantity      float64    `json:"quantity"`
+	TransactionId string     `json:"transactionId"`
+}
+
+// RecalculateOptions defines model for RecalculateOptions.
+type RecalculateOptions struct {
+	Fix      bool    `json:"fix"`
+	WalletId *string `json:"walletId,omitempty"`
+}
+
+// ReportedBalance defines model for ReportedBalance.
+type ReportedBalance struct {
+	Amount        float64     `json:"amount"`
+	BalanceType   BalanceType `json:"balanceType"`
+	Currency      string      `json:"currency"`
+	ReferenceDate time.Time   `json:"referenceDate"`
+}
+
+// Request defines model for Request.
+type Request struct {
+	OperationName *string                 `json:"operationName,omitempty"`
+	Query         string                  `json:"query"`
+	Variables     *map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Response defines model for Response.
+type Response struct {
+	Data       *interface{}            `json:"data,omitempty"`
+	Errors     *[]QueryError           `json:"errors,omitempty"`
+	Extensions *map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Result defines model for Result.
+type Result struct {
+	Changed     bool                 `json:"changed"`
+	Output      interface{}          `json:"output"`
+	Transaction ScriptingTransaction `json:"transaction"`
+}
+
+// RetentionRequest defines model for RetentionRequest.
+type RetentionRequest struct {
+	MaxAge   *string `json:"maxAge,omitempty"`
+	MaxBytes *int64  `json:"maxBytes,omitempty"`
+	MaxMsgs  *int64  `json:"maxMsgs,omitempty"`
+}
+
+// RuleApplication defines model for RuleApplication.
+type RuleApplication struct {
+	AppliedRules []string             `json:"appliedRules"`
+	FailedRules  *[]string            `json:"failedRules,omitempty"`
+	Transaction  ScriptingTransaction `json:"transaction"`
+}
+
+// SchedulerStatus defines model for SchedulerStatus.
+type SchedulerStatus struct {
+	Interval string `json:"interval"`
+	Leader   bool   `json:"leader"`
+}
+
+// ScriptingTransaction defines model for ScriptingTransaction.
+type ScriptingTransaction struct {
+	Amount      float64   `json:"amount"`
+	Category    string    `json:"category"`
+	Currency    string    `json:"currency"`
+	Date        time.Time `json:"date"`
+	Description string    `json:"description"`
+	Source      string    `json:"source"`
+	Tags        []string  `json:"tags"`
+	Type        string    `json:"type"`
+}
+
+// SourceCycleReport defines model for SourceCycleReport.
+type SourceCycleReport struct {
+	Duplicates int       `json:"duplicates"`
+	DurationMs int64     `json:"durationMs"`
+	Error      *string   `json:"error,omitempty"`
+	Errors     *[]string `json:"errors,omitempty"`
+	Filtered   int       `json:"filtered"`
+	Flagged    int       `json:"flagged"`
+	Imported   int       `json:"imported"`
+	Invalid    int       `json:"invalid"`
+	Received   int       `json:"received"`
+	Snapshots  int       `json:"snapshots"`
+	SourceId   string    `json:"sourceId"`
+}
+
+// SourceInfo defines model for SourceInfo.
+type SourceInfo struct {
+	Account   string    `json:"account"`
+	Addresses *[]string `json:"addresses,omitempty"`
+	HasClient bool      `json:"hasClient"`
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	Sandbox   *bool     `json:"sandbox,omitempty"`
+	Source    string    `json:"source"`
+}
+
+// SourceLifecycle defines model for SourceLifecycle.
+type SourceLifecycle string
+
+// SourceState defines model for SourceState.
+type SourceState struct {
+	ConsentExpiresAt   time.Time       `json:"consentExpiresAt"`
+	Failures           int             `json:"failures"`
+	Id                 string          `json:"id"`
+	Imported           int             `json:"imported"`
+	LastError          *string         `json:"lastError,omitempty"`
+	LastRunAt          time.Time       `json:"lastRunAt"`
+	LastSuccessAt      time.Time       `json:"lastSuccessAt"`
+	Lifecycle          SourceLifecycle `json:"lifecycle"`
+	LifecycleReason    *string         `json:"lifecycleReason,omitempty"`
+	Renewal            *ConsentRenewal `json:"renewal,omitempty"`
+	RenewalRequestedAt time.Time       `json:"renewalRequestedAt"`
+	RenewalUrl         *string         `json:"renewalUrl,omitempty"`
+	Runs               int             `json:"runs"`
+	SourceId           string          `json:"sourceId"`
+	TransitionedAt     time.Time       `json:"transitionedAt"`
+}
+
+// SourceTestReport defines model for SourceTestReport.
+type SourceTestReport struct {
+	Balance     *float64           `json:"balance,omitempty"`
+	BalanceType *BalanceType       `json:"balanceType,omitempty"`
+	Balances    *[]ReportedBalance `json:"balances,omitempty"`
+	Currency    *string            `json:"currency,omitempty"`
+	LatencyMs   int64              `json:"latencyMs"`
+	Ok          bool               `json:"ok"`
+	SourceId    string             `json:"sourceId"`
+	StartedAt   time.Time          `json:"startedAt"`
+	Steps       []SourceTestStep   `json:"steps"`
+}
+
+// SourceTestStep defines model for SourceTestStep.
+type SourceTestStep struct {
+	Count     *int       `json:"count,omitempty"`
+	Error     *string    `json:"error,omitempty"`
+	ErrorType *ErrorType `json:"errorType,omitempty"`
+	Filtered  *int       `json:"filtered,omitempty"`
+	LatencyMs int64      `json:"latencyMs"`
+	Name      string     `json:"name"`
+	Ok        bool       `json:"ok"`
+	Skipped   *bool      `json:"skipped,omitempty"`
+}
+
+// Space defines model for Space.
+type Space struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        string    `json:"id"`
+	Kind      SpaceKind `json:"kind"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SpaceKind defines model for SpaceKind.
+type SpaceKind string
+
+// SpaceMember defines model for SpaceMember.
+type SpaceMember struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        string    `json:"id"`
+	Role      SpaceRole `json:"role"`
+	SpaceId   string    `json:"spaceId"`
+	UserId    string    `json:"userId"`
+}
+
+// SpaceRole defines model for SpaceRole.
+type SpaceRole string
+
+// StatementImportReport defines model for StatementImportReport.
+type StatementImportReport struct {
+	Imported   int               `json:"imported"`
+	Statements []StatementReport `json:"statements"`
+}
+
+// StatementReport defines model for StatementReport.
+type StatementReport struct {
+	Closing     *ReportedBalance `json:"closing,omitempty"`
+	Drift       float64          `json:"drift"`
+	Entries     int              `json:"entries"`
+	Iban        string           `json:"iban"`
+	Import      *ImportReport    `json:"import,omitempty"`
+	Pending     int              `json:"pending"`
+	Skipped     int              `json:"skipped"`
+	Snapshots   int              `json:"snapshots"`
+	StatementId string           `json:"statementId"`
+	Unbalanced  float64          `json:"unbalanced"`
+	WalletId    string           `json:"walletId"`
+}
+
+// StreamUsage defines model for StreamUsage.
+type StreamUsage struct {
+	Bytes     int64      `json:"bytes"`
+	Consumers int        `json:"consumers"`
+	FirstSeq  int64      `json:"firstSeq"`
+	FirstTime *time.Time `json:"firstTime,omitempty"`
+	Kind      string     `json:"kind"`
+	LastSeq   int64      `json:"lastSeq"`
+	LastTime  *time.Time `json:"lastTime,omitempty"`
+	MaxAge    string     `json:"maxAge"`
+	MaxBytes  int64      `json:"maxBytes"`
+	MaxMsgs   int64      `json:"maxMsgs"`
+	Messages  int64      `json:"messages"`
+	Stream    string     `json:"stream"`
+	Subjects  int64      `json:"subjects"`
+}
+
+// Subscription defines model for Subscription.
+type Subscription struct {
+	Amount         float64              `json:"amount"`
+	CategoryId     *string              `json:"categoryId,omitempty"`
+	Charges        int                  `json:"charges"`
+	Currency       string               `json:"currency"`
+	FirstChargeAt  time.Time            `json:"firstChargeAt"`
+	Id             string               `json:"id"`
+	Interval       SubscriptionInterval `json:"interval"`
+	LastChargeAt   time.Time            `json:"lastChargeAt"`
+	Merchant       string               `json:"merchant"`
+	MonthlyCost    float64              `json:"monthlyCost"`
+	Name           string               `json:"name"`
+	NextChargeAt   time.Time            `json:"nextChargeAt"`
+	PreviousAmount float64              `json:"previousAmount"`
+	Status         SubscriptionStatus   `json:"status"`
+	UpdatedAt      time.Time            `json:"updatedAt"`
+	WalletId       string               `json:"walletId"`
+}
+
+// SubscriptionInterval defines model for SubscriptionInterval.
+type SubscriptionInterval string
+
+// SubscriptionReport defines model for SubscriptionReport.
+type SubscriptionReport struct {
+	Checked        int             `json:"checked"`
+	Detected       int             `json:"detected"`
+	Errors         *[]string       `json:"errors,omitempty"`
+	Missed         *[]Subscription `json:"missed,omitempty"`
+	PriceIncreases *[]Subscription `json:"priceIncreases,omitempty"`
+	Updated        int             `json:"updated"`
+}
+
+// SubscriptionStatus defines model for SubscriptionStatus.
+type SubscriptionStatus string
+
+// SyncStatus defines model for SyncStatus.
+type SyncStatus struct {
+	LastCycle ImportCycleReport `json:"lastCycle"`
+	Paused    []string          `json:"paused"`
+	Queue     []ProviderStats   `json:"queue"`
+	Sources   []SourceState     `json:"sources"`
+}
+
+// TagSpend defines model for TagSpend.
+type TagSpend struct {
+	Currency         string  `json:"currency"`
+	Expense          float64 `json:"expense"`
+	Income           float64 `json:"income"`
+	Tag              string  `json:"tag"`
+	TransactionCount int     `json:"transactionCount"`
+	Transfer         float64 `json:"transfer"`
+}
+
+// TokenFilterStats defines model for TokenFilterStats.
+type TokenFilterStats struct {
+	Denied     int `json:"denied"`
+	NotAllowed int `json:"notAllowed"`
+	ScamList   int `json:"scamList"`
+}
+
+// Transaction defines model for Transaction.
+type Transaction struct {
+	Amount       float64            `json:"amount"`
+	CategoryId   string             `json:"categoryId"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	Date         time.Time          `json:"date"`
+	DeletedAt    *time.Time         `json:"deletedAt,omitempty"`
+	Description  string             `json:"description"`
+	DestWalletId *string            `json:"destWalletId,omitempty"`
+	EndToEndId   *string            `json:"endToEndId,omitempty"`
+	ExchangeRate *float64           `json:"exchangeRate,omitempty"`
+	Fee          *float64           `json:"fee,omitempty"`
+	FireflyId    *string            `json:"fireflyId,omitempty"`
+	Id           string             `json:"id"`
+	Metadata     *map[string]string `json:"metadata,omitempty"`
+	Notes        *string            `json:"notes,omitempty"`
+	Reference    *string            `json:"reference,omitempty"`
+	Status       TransactionStatus  `json:"status"`
+	Tags         *[]string          `json:"tags,omitempty"`
+	Type         TransactionType    `json:"type"`
+	UpdatedAt    time.Time          `json:"updatedAt"`
+	Version      int                `json:"version"`
+	WalletId     string             `json:"walletId"`
+}
+
+// TransactionPatch defines model for TransactionPatch.
+type TransactionPatch struct {
+	CategoryId  *string   `json:"categoryId,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Id          string    `json:"id"`
+	Notes       *string   `json:"notes,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+	Version     int       `json:"version"`
+}
+
+// TransactionStatus defines model for TransactionStatus.
+type TransactionStatus string
+
+// TransactionType defines model for TransactionType.
+type TransactionType string
+
+// Wallet defines model for Wallet.
+type Wallet struct {
+	Archived       bool       `json:"archived"`
+	ArchivedAt     *time.Time `json:"archivedAt,omitempty"`
+	Balance        float64    `json:"balance"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	Currency       string     `json:"currency"`
+	Description    string     `json:"description"`
+	Id             string     `json:"id"`
+	Name           string     `json:"name"`
+	OpeningBalance float64    `json:"openingBalance"`
+	SpaceId        *string    `json:"spaceId,omitempty"`
+	Type           WalletType `json:"type"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+	Version        int        `json:"version"`
+}
+
+// WalletBalanceCheck defines model for WalletBalanceCheck.
+type WalletBalanceCheck struct {
+	Currency         string  `json:"currency"`
+	Fixed            *bool   `json:"fixed,omitempty"`
+	Name             string  `json:"name"`
+	OpeningBalance   float64 `json:"openingBalance"`
+	ReplayedBalance  float64 `json:"replayedBalance"`
+	StoredBalance    float64 `json:"storedBalance"`
+	TransactionCount int     `json:"transactionCount"`
+	WalletId         string  `json:"walletId"`
+}
+
+// WalletType defines model for WalletType.
+type WalletType string
+
+// WalletValuation defines model for WalletValuation.
+type WalletValuation struct {
+	Archived *bool   `json:"archived,omitempty"`
+	Balance  float64 `json:"balance"`
+	Currency string  `json:"currency"`
+	Error    *string `json:"error,omitempty"`
+	Name     string  `json:"name"`
+	Rate     float64 `json:"rate"`
+	Value    float64 `json:"value"`
+	WalletId string  `json:"walletId"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts       int                   `json:"attempts"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	DeliveredAt    *time.Time            `json:"deliveredAt,omitempty"`
+	EventId        string                `json:"eventId"`
+	EventType      string                `json:"eventType"`
+	Id             string                `json:"id"`
+	LastError      *string               `json:"lastError,omitempty"`
+	NextAttemptAt  time.Time             `json:"nextAttemptAt"`
+	ResponseStatus *int                  `json:"responseStatus,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	WebhookId      string                `json:"webhookId"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// Weekday defines model for Weekday.
+type Weekday int
+
+// YearGains defines model for YearGains.
+type YearGains struct {
+	BaseCurrency string          `json:"baseCurrency"`
+	CostBasis    float64         `json:"costBasis"`
+	Disposals    []RealizedGain  `json:"disposals"`
+	LongTerm     float64         `json:"longTerm"`
+	Method       CostBasisMethod `json:"method"`
+	Period       Period          `json:"period"`
+	Proceeds     float64         `json:"proceeds"`
+	ShortTerm    float64         `json:"shortTerm"`
+	Total        float64         `json:"total"`
+	Year         int             `json:"year"`
+}
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	Collection *string `form:"collection,omitempty" json:"collection,omitempty"`
+	Record     *string `form:"record,omitempty" json:"record,omitempty"`
+	Actor      *string `form:"actor,omitempty" json:"actor,omitempty"`
+	Action     *string `form:"action,omitempty" json:"action,omitempty"`
+	Source     *string `form:"source,omitempty" json:"source,omitempty"`
+	From       *string `form:"from,omitempty" json:"from,omitempty"`
+	To         *string `form:"to,omitempty" json:"to,omitempty"`
+	Limit      *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset     *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetBalancesAssertionsByIdResultsParams defines parameters for GetBalancesAssertionsByIdResults.
+type GetBalancesAssertionsByIdResultsParams struct {
+	Failed *bool `form:"failed,omitempty" json:"failed,omitempty"`
+	Limit  *int  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetCalendarFeedIcsParams defines parameters for GetCalendarFeedIcs.
+type GetCalendarFeedIcsParams struct {
+	Token *string `form:"token,omitempty" json:"token,omitempty"`
+}
+
+// GetCategorizationReviewsParams defines parameters for GetCategorizationReviews.
+type GetCategorizationReviewsParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostCategorizationReviewsByIdJSONBody defines parameters for PostCategorizationReviewsById.
+type PostCategorizationReviewsByIdJSONBody struct {
+	CategoryId string `json:"categoryId"`
+}
+
+// PostCategorizationSuggestParams defines parameters for PostCategorizationSuggest.
+type PostCategorizationSuggestParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetCostBasisParams defines parameters for GetCostBasis.
+type GetCostBasisParams struct {
+	Method *string `form:"method,omitempty" json:"method,omitempty"`
+	Base   *string `form:"base,omitempty" json:"base,omitempty"`
+}
+
+// GetCostBasisByYearParams defines parameters for GetCostBasisByYear.
+type GetCostBasisByYearParams struct {
+	Method *string `form:"method,omitempty" json:"method,omitempty"`
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+	Base   *string `form:"base,omitempty" json:"base,omitempty"`
+}
+
+// GetExportByDatasetParams defines parameters for GetExportByDataset.
+type GetExportByDatasetParams struct {
+	Format   *string `form:"format,omitempty" json:"format,omitempty"`
+	From     *string `form:"from,omitempty" json:"from,omitempty"`
+	To       *string `form:"to,omitempty" json:"to,omitempty"`
+	Wallet   *string `form:"wallet,omitempty" json:"wallet,omitempty"`
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+	Async    *string `form:"async,omitempty" json:"async,omitempty"`
+}
+
+// DeleteFireflyAccountsByIdParams defines parameters for DeleteFireflyAccountsById.
+type DeleteFireflyAccountsByIdParams struct {
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// PostFireflyLinksJSONBody defines parameters for PostFireflyLinks.
+type PostFireflyLinksJSONBody struct {
+	Inward  string `json:"inward"`
+	Notes   string `json:"notes"`
+	Outward string `json:"outward"`
+	Type    string `json:"type"`
+}
+
+// GetFireflyOauthCallbackParams defines parameters for GetFireflyOauthCallback.
+type GetFireflyOauthCallbackParams struct {
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+}
+
+// PostImportsBudgetsByProfileMultipartBody defines parameters for PostImportsBudgetsByProfile.
+type PostImportsBudgetsByProfileMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// PostImportsBudgetsByProfileParams defines parameters for PostImportsBudgetsByProfile.
+type PostImportsBudgetsByProfileParams struct {
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+}
+
+// PostImportsStatementsMultipartBody defines parameters for PostImportsStatements.
+type PostImportsStatementsMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// PostImportsStatementsParams defines parameters for PostImportsStatements.
+type PostImportsStatementsParams struct {
+	Wallet *string `form:"wallet,omitempty" json:"wallet,omitempty"`
+}
+
+// PostImportsByProfileMultipartBody defines parameters for PostImportsByProfile.
+type PostImportsByProfileMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// GetIncidentsParams defines parameters for GetIncidents.
+type GetIncidentsParams struct {
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty"`
+	Open     *bool   `form:"open,omitempty" json:"open,omitempty"`
+	From     *string `form:"from,omitempty" json:"from,omitempty"`
+	To       *string `form:"to,omitempty" json:"to,omitempty"`
+	Limit    *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PutMaintenanceJSONBody defines parameters for PutMaintenance.
+type PutMaintenanceJSONBody struct {
+	Reason string `json:"reason"`
+}
+
+// GetNetworthParams defines parameters for GetNetworth.
+type GetNetworthParams struct {
+	Base            *string `form:"base,omitempty" json:"base,omitempty"`
+	IncludeArchived *bool   `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+	From            *string `form:"from,omitempty" json:"from,omitempty"`
+	To              *string `form:"to,omitempty" json:"to,omitempty"`
+	Period          *string `form:"period,omitempty" json:"period,omitempty"`
+}
+
+// GetPeriodsParams defines parameters for GetPeriods.
+type GetPeriodsParams struct {
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// GetPortfolioParams defines parameters for GetPortfolio.
+type GetPortfolioParams struct {
+	Base            *string `form:"base,omitempty" json:"base,omitempty"`
+	IncludeArchived *bool   `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+}
+
+// PostRulesApplyJSONBody defines parameters for PostRulesApply.
+type PostRulesApplyJSONBody struct {
+	Source      string               `json:"source"`
+	Transaction ScriptingTransaction `json:"transaction"`
+}
+
+// PostRulesTestJSONBody defines parameters for PostRulesTest.
+type PostRulesTestJSONBody struct {
+	Script      string               `json:"script"`
+	Transaction ScriptingTransaction `json:"transaction"`
+}
+
+// GetSourcesConsentCallbackParams defines parameters for GetSourcesConsentCallback.
+type GetSourcesConsentCallbackParams struct {
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+}
+
+// GetSourcesCyclesParams defines parameters for GetSourcesCycles.
+type GetSourcesCyclesParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostSourcesByIdBackfillParams defines parameters for PostSourcesByIdBackfill.
+type PostSourcesByIdBackfillParams struct {
+	Restart *bool `form:"restart,omitempty" json:"restart,omitempty"`
+}
+
+// PutSourcesByIdLifecycleJSONBody defines parameters for PutSourcesByIdLifecycle.
+type PutSourcesByIdLifecycleJSONBody struct {
+	Reason string          `json:"reason"`
+	State  SourceLifecycle `json:"state"`
+}
+
+// PostSpacesJSONBody defines parameters for PostSpaces.
+type PostSpacesJSONBody struct {
+	Kind SpaceKind `json:"kind"`
+	Name string    `json:"name"`
+}
+
+// PutSpacesBySpaceMaintenanceJSONBody defines parameters for PutSpacesBySpaceMaintenance.
+type PutSpacesBySpaceMaintenanceJSONBody struct {
+	Reason string `json:"reason"`
+}
+
+// PutSpacesBySpaceMembersByUserJSONBody defines parameters for PutSpacesBySpaceMembersByUser.
+type PutSpacesBySpaceMembersByUserJSONBody struct {
+	Role SpaceRole `json:"role"`
+}
+
+// GetSpacesBySpaceTransactionsParams defines parameters for GetSpacesBySpaceTransactions.
+type GetSpacesBySpaceTransactionsParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetSubscriptionsParams defines parameters for GetSubscriptions.
+type GetSubscriptionsParams struct {
+	Wallet *string `form:"wallet,omitempty" json:"wallet,omitempty"`
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetTagsSpendParams defines parameters for GetTagsSpend.
+type GetTagsSpendParams struct {
+	Tag    *string `form:"tag,omitempty" json:"tag,omitempty"`
+	From   *string `form:"from,omitempty" json:"from,omitempty"`
+	To     *string `form:"to,omitempty" json:"to,omitempty"`
+	Period *string `form:"period,omitempty" json:"period,omitempty"`
+	Group  *string `form:"group,omitempty" json:"group,omitempty"`
+}
+
+// PostTagsByIdMergeJSONBody defines parameters for PostTagsByIdMerge.
+type PostTagsByIdMergeJSONBody struct {
+	Into string `json:"into"`
+}
+
+// PostTagsByIdRenameJSONBody defines parameters for PostTagsByIdRename.
+type PostTagsByIdRenameJSONBody struct {
+	Name string `json:"name"`
+}
+
+// GetTransactionsParams defines parameters for GetTransactions.
+type GetTransactionsParams struct {
+	Wallet      *string `form:"wallet,omitempty" json:"wallet,omitempty"`
+	Description *string `form:"description,omitempty" json:"description,omitempty"`
+	Notes       *string `form:"notes,omitempty" json:"notes,omitempty"`
+	Limit       *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset      *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PatchTransactionsBulkJSONBody defines parameters for PatchTransactionsBulk.
+type PatchTransactionsBulkJSONBody struct {
+	Transactions []TransactionPatch `json:"transactions"`
+}
+
+// PostTransactionsByIdMergeJSONBody defines parameters for PostTransactionsByIdMerge.
+type PostTransactionsByIdMergeJSONBody struct {
+	Drop []string `json:"drop"`
+}
+
+// GetWebhooksByIdDeliveriesParams defines parameters for GetWebhooksByIdDeliveries.
+type GetWebhooksByIdDeliveriesParams struct {
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostBalancesAssertionsJSONRequestBody defines body for PostBalancesAssertions for application/json ContentType.
+type PostBalancesAssertionsJSONRequestBody = BalanceAssertion
+
+// PutBalancesAssertionsByIdJSONRequestBody defines body for PutBalancesAssertionsById for application/json ContentType.
+type PutBalancesAssertionsByIdJSONRequestBody = BalanceAssertion
+
+// PostBalancesRecalculateJSONRequestBody defines body for PostBalancesRecalculate for application/json ContentType.
+type PostBalancesRecalculateJSONRequestBody = RecalculateOptions
+
+// PostCategorizationReviewsByIdJSONRequestBody defines body for PostCategorizationReviewsById for application/json ContentType.
+type PostCategorizationReviewsByIdJSONRequestBody PostCategorizationReviewsByIdJSONBody
+
+// PostCategorizationSuggestJSONRequestBody defines body for PostCategorizationSuggest for application/json ContentType.
+type PostCategorizationSuggestJSONRequestBody = Transaction
+
+// PostFireflyLinksJSONRequestBody defines body for PostFireflyLinks for application/json ContentType.
+type PostFireflyLinksJSONRequestBody PostFireflyLinksJSONBody
+
+// PostGraphqlJSONRequestBody defines body for PostGraphql for application/json ContentType.
+type PostGraphqlJSONRequestBody = Request
+
+// PostImportsBudgetsByProfileMultipartRequestBody defines body for PostImportsBudgetsByProfile for multipart/form-data ContentType.
+type PostImportsBudgetsByProfileMultipartRequestBody PostImportsBudgetsByProfileMultipartBody
+
+// PostImportsDescriptionsPreviewJSONRequestBody defines body for PostImportsDescriptionsPreview for application/json ContentType.
+type PostImportsDescriptionsPreviewJSONRequestBody = DescriptionPreviewInput
+
+// PutImportsReviewsByIdMappingJSONRequestBody defines body for PutImportsReviewsByIdMapping for application/json ContentType.
+type PutImportsReviewsByIdMappingJSONRequestBody = BudgetMappingInput
+
+// PostImportsStatementsMultipartRequestBody defines body for PostImportsStatements for multipart/form-data ContentType.
+type PostImportsStatementsMultipartRequestBody PostImportsStatementsMultipartBody
+
+// PostImportsByProfileMultipartRequestBody defines body for PostImportsByProfile for multipart/form-data ContentType.
+type PostImportsByProfileMultipartRequestBody PostImportsByProfileMultipartBody
+
+// PutMaintenanceJSONRequestBody defines body for PutMaintenance for application/json ContentType.
+type PutMaintenanceJSONRequestBody PutMaintenanceJSONBody
+
+// PutPreferencesJSONRequestBody defines body for PutPreferences for application/json ContentType.
+type PutPreferencesJSONRequestBody = Preferences
+
+// PostRulesApplyJSONRequestBody defines body for PostRulesApply for application/json ContentType.
+type PostRulesApplyJSONRequestBody PostRulesApplyJSONBody
+
+// PostRulesTestJSONRequestBody defines body for PostRulesTest for application/json ContentType.
+type PostRulesTestJSONRequestBody PostRulesTestJSONBody
+
+// PutSourcesByIdLifecycleJSONRequestBody defines body for PutSourcesByIdLifecycle for application/json ContentType.
+type PutSourcesByIdLifecycleJSONRequestBody PutSourcesByIdLifecycleJSONBody
+
+// PostSpacesJSONRequestBody defines body for PostSpaces for application/json ContentType.
+type PostSpacesJSONRequestBody PostSpacesJSONBody
+
+// PutSpacesBySpaceMaintenanceJSONRequestBody defines body for PutSpacesBySpaceMaintenance for application/json ContentType.
+type PutSpacesBySpaceMaintenanceJSONRequestBody PutSpacesBySpaceMaintenanceJSONBody
+
+// PutSpacesBySpaceMembersByUserJSONRequestBody defines body for PutSpacesBySpaceMembersByUser for application/json ContentType.
+type PutSpacesBySpaceMembersByUserJSONRequestBody PutSpacesBySpaceMembersByUserJSONBody
+
+// PostStreamsByStreamCompactJSONRequestBody defines body for PostStreamsByStreamCompact for application/json ContentType.
+type PostStreamsByStreamCompactJSONRequestBody = CompactRequest
+
+// PostStreamsByStreamPurgeJSONRequestBody defines body for PostStreamsByStreamPurge for application/json ContentType.
+type PostStreamsByStreamPurgeJSONRequestBody = PurgeRequest
+
+// PutStreamsByStreamRetentionJSONRequestBody defines body for PutStreamsByStreamRetention for application/json ContentType.
+type PutStreamsByStreamRetentionJSONRequestBody = RetentionRequest
+
+// PostTagsByIdMergeJSONRequestBody defines body for PostTagsByIdMerge for application/json ContentType.
+type PostTagsByIdMergeJSONRequestBody PostTagsByIdMergeJSONBody
+
+// PostTagsByIdRenameJSONRequestBody defines body for PostTagsByIdRename for application/json ContentType.
+type PostTagsByIdRenameJSONRequestBody PostTagsByIdRenameJSONBody
+
+// PatchTransactionsBulkJSONRequestBody defines body for PatchTransactionsBulk for application/json ContentType.
+type PatchTransactionsBulkJSONRequestBody PatchTransactionsBulkJSONBody
+
+// PostTransactionsImportJSONRequestBody defines body for PostTransactionsImport for application/json ContentType.
+type PostTransactionsImportJSONRequestBody = ImportInput
+
+// PostTransactionsByIdMergeJSONRequestBody defines body for PostTransactionsByIdMerge for application/json ContentType.
+type PostTransactionsByIdMergeJSONRequestBody PostTransactionsByIdMergeJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetAudit request
+	GetAudit(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBalancesAssertions request
+	GetBalancesAssertions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBalancesAssertionsWithBody request with any body
+	PostBalancesAssertionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostBalancesAssertions(ctx context.Context, body PostBalancesAssertionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBalancesAssertionsById request
+	DeleteBalancesAssertionsById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutBalancesAssertionsByIdWithBody request with any body
+	PutBalancesAssertionsByIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutBalancesAssertionsById(ctx context.Context, id string, body PutBalancesAssertionsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBalancesAssertionsByIdCheck request
+	PostBalancesAssertionsByIdCheck(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBalancesAssertionsByIdResults request
+	GetBalancesAssertionsByIdResults(ctx context.Context, id string, params *GetBalancesAssertionsByIdResultsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBalancesDrift request
+	GetBalancesDrift(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBalancesRecalculateWithBody request with any body
+	PostBalancesRecalculateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostBalancesRecalculate(ctx context.Context, body PostBalancesRecalculateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBalancesUpdate request
+	PostBalancesUpdate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendarEvents request
+	GetCalendarEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCalendarFeed request
+	DeleteCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCalendarFeed request
+	PostCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendarFeedIcs request
+	GetCalendarFeedIcs(ctx context.Context, params *GetCalendarFeedIcsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCategorization request
+	GetCategorization(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCategorizationReviews request
+	GetCategorizationReviews(ctx context.Context, params *GetCategorizationReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCategorizationReviewsByIdWithBody request with any body
+	PostCategorizationReviewsByIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostCategorizationReviewsById(ctx context.Context, id string, body PostCategorizationReviewsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCategorizationSuggestWithBody request with any body
+	PostCategorizationSuggestWithBody(ctx context.Context, params *PostCategorizationSuggestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostCategorizationSuggest(ctx context.Context, params *PostCategorizationSuggestParams, body PostCategorizationSuggestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCategorizationTrain request
+	PostCategorizationTrain(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCostBasis request
+	GetCostBasis(ctx context.Context, params *GetCostBasisParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCostBasisByYear request
+	GetCostBasisByYear(ctx context.Context, year string, params *GetCostBasisByYearParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDocs request
+	GetDocs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExportJobsById request
+	GetExportJobsById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExportJobsByIdDownload request
+	GetExportJobsByIdDownload(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExportByDataset request
+	GetExportByDataset(ctx context.Context, dataset string, params *GetExportByDatasetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteFireflyAccountsById request
+	DeleteFireflyAccountsById(ctx context.Context, id string, params *DeleteFireflyAccountsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostFireflyAccountsByIdArchive request
+	PostFireflyAccountsByIdArchive(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostFireflyBootstrap request
+	PostFireflyBootstrap(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostFireflyLinksWithBody request with any body
+	PostFireflyLinksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostFireflyLinks(ctx context.Context, body PostFireflyLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFireflyOauthAuthorize request
+	GetFireflyOauthAuthorize(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFireflyOauthCallback request
+	GetFireflyOauthCallback(ctx context.Context, params *GetFireflyOauthCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFireflyOutbox request
+	GetFireflyOutbox(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostFireflyOutboxDrain request
+	PostFireflyOutboxDrain(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostFireflyPull request
+	PostFireflyPull(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostGraphqlWithBody request with any body
+	PostGraphqlWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostGraphql(ctx context.Context, body PostGraphqlJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetImportsBudgetsProfiles request
+	GetImportsBudgetsProfiles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostImportsBudgetsByProfileWithBody request with any body
+	PostImportsBudgetsByProfileWithBody(ctx context.Context, profile string, params *PostImportsBudgetsByProfileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostImportsDescriptionsPreviewWithBody request with any body
+	PostImportsDescriptionsPreviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostImportsDescriptionsPreview(ctx context.Context, body PostImportsDescriptionsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetImportsProfiles request
+	GetImportsProfiles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetImportsReviews request
+	GetImportsReviews(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteImportsReviewsById request
+	DeleteImportsReviewsById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetImportsReviewsById request
+	GetImportsReviewsById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostImportsReviewsByIdCommit request
+	PostImportsReviewsByIdCommit(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutImportsReviewsByIdMappingWithBody request with any body
+	PutImportsReviewsByIdMappingWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutImportsReviewsByIdMapping(ctx context.Context, id string, body PutImportsReviewsByIdMappingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostImportsStatementsWithBody request with any body
+	PostImportsStatementsWithBody(ctx context.Context, params *PostImportsStatementsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostImportsByProfileWithBody request with any body
+	PostImportsByProfileWithBody(ctx context.Context, profile string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIncidents request
+	GetIncidents(ctx context.Context, params *GetIncidentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMaintenance request
+	DeleteMaintenance(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMaintenance request
+	GetMaintenance(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutMaintenanceWithBody request with any body
+	PutMaintenanceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutMaintenance(ctx context.Context, body PutMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMetrics request
+	GetMetrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNetworth request
+	GetNetworth(ctx context.Context, params *GetNetworthParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenapiJson request
+	GetOpenapiJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPeriods request
+	GetPeriods(ctx context.Context, params *GetPeriodsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPeriodsByName request
+	GetPeriodsByName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPortfolio request
+	GetPortfolio(ctx context.Context, params *GetPortfolioParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPreferences request
+	GetPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutPreferencesWithBody request with any body
+	PutPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutPreferences(ctx context.Context, body PutPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRulesApplyWithBody request with any body
+	PostRulesApplyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRulesApply(ctx context.Context, body PostRulesApplyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRulesTestWithBody request with any body
+	PostRulesTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRulesTest(ctx context.Context, body PostRulesTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSources request
+	GetSources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSourcesBackfills request
+	GetSourcesBackfills(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSourcesConsentCallback request
+	GetSourcesConsentCallback(ctx context.Context, params *GetSourcesConsentCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSourcesConsents request
+	GetSourcesConsents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSourcesCycles request
+	GetSourcesCycles(ctx context.Context, params *GetSourcesCyclesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSourcesQueue request
+	GetSourcesQueue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSourcesScheduler request
+	GetSourcesScheduler(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSourcesStatus request
+	GetSourcesStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSourcesSync request
+	PostSourcesSync(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSourcesByIdBackfill request
+	GetSourcesByIdBackfill(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSourcesByIdBackfill request
+	PostSourcesByIdBackfill(ctx context.Context, id string, params *PostSourcesByIdBackfillParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSourcesByIdConsentRenew request
+	PostSourcesByIdConsentRenew(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutSourcesByIdLifecycleWithBody request with any body
+	PutSourcesByIdLifecycleWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutSourcesByIdLifecycle(ctx context.Context, id string, body PutSourcesByIdLifecycleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSourcesByIdPause request
+	PostSourcesByIdPause(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSourcesByIdResume request
+	PostSourcesByIdResume(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSourcesByIdSync request
+	PostSourcesByIdSync(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSourcesByIdTest request
+	PostSourcesByIdTest(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpaces request
+	GetSpaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSpacesWithBody request with any body
+	PostSpacesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSpaces(ctx context.Context, body PostSpacesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpacesBySpaceCategories request
+	GetSpacesBySpaceCategories(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSpacesBySpaceCategoriesByCategory request
+	PostSpacesBySpaceCategoriesByCategory(ctx context.Context, space string, category string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSpacesBySpaceMaintenance request
+	DeleteSpacesBySpaceMaintenance(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutSpacesBySpaceMaintenanceWithBody request with any body
+	PutSpacesBySpaceMaintenanceWithBody(ctx context.Context, space string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutSpacesBySpaceMaintenance(ctx context.Context, space string, body PutSpacesBySpaceMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpacesBySpaceMembers request
+	GetSpacesBySpaceMembers(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSpacesBySpaceMembersByUser request
+	DeleteSpacesBySpaceMembersByUser(ctx context.Context, space string, user string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutSpacesBySpaceMembersByUserWithBody request with any body
+	PutSpacesBySpaceMembersByUserWithBody(ctx context.Context, space string, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutSpacesBySpaceMembersByUser(ctx context.Context, space string, user string, body PutSpacesBySpaceMembersByUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpacesBySpaceTransactions request
+	GetSpacesBySpaceTransactions(ctx context.Context, space string, params *GetSpacesBySpaceTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpacesBySpaceWallets request
+	GetSpacesBySpaceWallets(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSpacesBySpaceWalletsByWallet request
+	DeleteSpacesBySpaceWalletsByWallet(ctx context.Context, space string, wallet string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSpacesBySpaceWalletsByWallet request
+	PostSpacesBySpaceWalletsByWallet(ctx context.Context, space string, wallet string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStreams request
+	GetStreams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostStreamsByStreamCompactWithBody request with any body
+	PostStreamsByStreamCompactWithBody(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostStreamsByStreamCompact(ctx context.Context, stream string, body PostStreamsByStreamCompactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostStreamsByStreamPurgeWithBody request with any body
+	PostStreamsByStreamPurgeWithBody(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostStreamsByStreamPurge(ctx context.Context, stream string, body PostStreamsByStreamPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutStreamsByStreamRetentionWithBody request with any body
+	PutStreamsByStreamRetentionWithBody(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutStreamsByStreamRetention(ctx context.Context, stream string, body PutStreamsByStreamRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSubscriptions request
+	GetSubscriptions(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSubscriptionsDetect request
+	PostSubscriptionsDetect(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTagsSpend request
+	GetTagsSpend(ctx context.Context, params *GetTagsSpendParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTagsById request
+	DeleteTagsById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTagsByIdMergeWithBody request with any body
+	PostTagsByIdMergeWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTagsByIdMerge(ctx context.Context, id string, body PostTagsByIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTagsByIdRenameWithBody request with any body
+	PostTagsByIdRenameWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTagsByIdRename(ctx context.Context, id string, body PostTagsByIdRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransactions request
+	GetTransactions(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchTransactionsBulkWithBody request with any body
+	PatchTransactionsBulkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchTransactionsBulk(ctx context.Context, body PatchTransactionsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTransactionsImportWithBody request with any body
+	PostTransactionsImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTransactionsImport(ctx context.Context, body PostTransactionsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTransactionsByIdMergeWithBody request with any body
+	PostTransactionsByIdMergeWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTransactionsByIdMerge(ctx context.Context, id string, body PostTransactionsByIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksDeliveriesByIdRedeliver request
+	PostWebhooksDeliveriesByIdRedeliver(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksByIdDeliveries request
+	GetWebhooksByIdDeliveries(ctx context.Context, id string, params *GetWebhooksByIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAudit(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBalancesAssertions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBalancesAssertionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostBalancesAssertionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBalancesAssertionsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostBalancesAssertions(ctx context.Context, body PostBalancesAssertionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBalancesAssertionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteBalancesAssertionsById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBalancesAssertionsByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutBalancesAssertionsByIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBalancesAssertionsByIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutBalancesAssertionsById(ctx context.Context, id string, body PutBalancesAssertionsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutBalancesAssertionsByIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostBalancesAssertionsByIdCheck(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBalancesAssertionsByIdCheckRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBalancesAssertionsByIdResults(ctx context.Context, id string, params *GetBalancesAssertionsByIdResultsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBalancesAssertionsByIdResultsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBalancesDrift(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBalancesDriftRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostBalancesRecalculateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBalancesRecalculateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostBalancesRecalculate(ctx context.Context, body PostBalancesRecalculateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBalancesRecalculateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostBalancesUpdate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBalancesUpdateRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCalendarEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarEventsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCalendarFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCalendarFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCalendarFeedIcs(ctx context.Context, params *GetCalendarFeedIcsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarFeedIcsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCategorization(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCategorizationRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCategorizationReviews(ctx context.Context, params *GetCategorizationReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCategorizationReviewsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostCategorizationReviewsByIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCategorizationReviewsByIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostCategorizationReviewsById(ctx context.Context, id string, body PostCategorizationReviewsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCategorizationReviewsByIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostCategorizationSuggestWithBody(ctx context.Context, params *PostCategorizationSuggestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCategorizationSuggestRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostCategorizationSuggest(ctx context.Context, params *PostCategorizationSuggestParams, body PostCategorizationSuggestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCategorizationSuggestRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostCategorizationTrain(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCategorizationTrainRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCostBasis(ctx context.Context, params *GetCostBasisParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCostBasisRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCostBasisByYear(ctx context.Context, year string, params *GetCostBasisByYearParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCostBasisByYearRequest(c.Server, year, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDocs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDocsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetExportJobsById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExportJobsByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetExportJobsByIdDownload(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExportJobsByIdDownloadRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetExportByDataset(ctx context.Context, dataset string, params *GetExportByDatasetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExportByDatasetRequest(c.Server, dataset, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteFireflyAccountsById(ctx context.Context, id string, params *DeleteFireflyAccountsByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFireflyAccountsByIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostFireflyAccountsByIdArchive(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFireflyAccountsByIdArchiveRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostFireflyBootstrap(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFireflyBootstrapRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostFireflyLinksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFireflyLinksRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostFireflyLinks(ctx context.Context, body PostFireflyLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFireflyLinksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFireflyOauthAuthorize(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFireflyOauthAuthorizeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFireflyOauthCallback(ctx context.Context, params *GetFireflyOauthCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFireflyOauthCallbackRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFireflyOutbox(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFireflyOutboxRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostFireflyOutboxDrain(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFireflyOutboxDrainRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostFireflyPull(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFireflyPullRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostGraphqlWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGraphqlRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostGraphql(ctx context.Context, body PostGraphqlJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGraphqlRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetImportsBudgetsProfiles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetImportsBudgetsProfilesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostImportsBudgetsByProfileWithBody(ctx context.Context, profile string, params *PostImportsBudgetsByProfileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostImportsBudgetsByProfileRequestWithBody(c.Server, profile, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostImportsDescriptionsPreviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostImportsDescriptionsPreviewRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostImportsDescriptionsPreview(ctx context.Context, body PostImportsDescriptionsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostImportsDescriptionsPreviewRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetImportsProfiles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetImportsProfilesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetImportsReviews(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetImportsReviewsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteImportsReviewsById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteImportsReviewsByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetImportsReviewsById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetImportsReviewsByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostImportsReviewsByIdCommit(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostImportsReviewsByIdCommitRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutImportsReviewsByIdMappingWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutImportsReviewsByIdMappingRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutImportsReviewsByIdMapping(ctx context.Context, id string, body PutImportsReviewsByIdMappingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutImportsReviewsByIdMappingRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostImportsStatementsWithBody(ctx context.Context, params *PostImportsStatementsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostImportsStatementsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostImportsByProfileWithBody(ctx context.Context, profile string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostImportsByProfileRequestWithBody(c.Server, profile, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIncidents(ctx context.Context, params *GetIncidentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIncidentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteMaintenance(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMaintenanceRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMaintenance(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMaintenanceRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutMaintenanceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMaintenanceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutMaintenance(ctx context.Context, body PutMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMaintenanceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMetrics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMetricsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNetworth(ctx context.Context, params *GetNetworthParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNetworthRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOpenapiJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenapiJsonRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPeriods(ctx context.Context, params *GetPeriodsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPeriodsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPeriodsByName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPeriodsByNameRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPortfolio(ctx context.Context, params *GetPortfolioParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPortfolioRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPreferencesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPreferencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutPreferences(ctx context.Context, body PutPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPreferencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRulesApplyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRulesApplyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRulesApply(ctx context.Context, body PostRulesApplyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRulesApplyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRulesTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRulesTestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRulesTest(ctx context.Context, body PostRulesTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRulesTestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSourcesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSourcesBackfills(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSourcesBackfillsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSourcesConsentCallback(ctx context.Context, params *GetSourcesConsentCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSourcesConsentCallbackRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSourcesConsents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSourcesConsentsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSourcesCycles(ctx context.Context, params *GetSourcesCyclesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSourcesCyclesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSourcesQueue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSourcesQueueRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSourcesScheduler(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSourcesSchedulerRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSourcesStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSourcesStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSourcesSync(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSourcesSyncRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSourcesByIdBackfill(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSourcesByIdBackfillRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSourcesByIdBackfill(ctx context.Context, id string, params *PostSourcesByIdBackfillParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSourcesByIdBackfillRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSourcesByIdConsentRenew(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSourcesByIdConsentRenewRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSourcesByIdLifecycleWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSourcesByIdLifecycleRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSourcesByIdLifecycle(ctx context.Context, id string, body PutSourcesByIdLifecycleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSourcesByIdLifecycleRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSourcesByIdPause(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSourcesByIdPauseRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSourcesByIdResume(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSourcesByIdResumeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSourcesByIdSync(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSourcesByIdSyncRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSourcesByIdTest(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSourcesByIdTestRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSpaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpacesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSpacesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSpacesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSpaces(ctx context.Context, body PostSpacesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSpacesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSpacesBySpaceCategories(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpacesBySpaceCategoriesRequest(c.Server, space)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSpacesBySpaceCategoriesByCategory(ctx context.Context, space string, category string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSpacesBySpaceCategoriesByCategoryRequest(c.Server, space, category)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSpacesBySpaceMaintenance(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSpacesBySpaceMaintenanceRequest(c.Server, space)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSpacesBySpaceMaintenanceWithBody(ctx context.Context, space string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSpacesBySpaceMaintenanceRequestWithBody(c.Server, space, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSpacesBySpaceMaintenance(ctx context.Context, space string, body PutSpacesBySpaceMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSpacesBySpaceMaintenanceRequest(c.Server, space, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSpacesBySpaceMembers(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpacesBySpaceMembersRequest(c.Server, space)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSpacesBySpaceMembersByUser(ctx context.Context, space string, user string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSpacesBySpaceMembersByUserRequest(c.Server, space, user)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSpacesBySpaceMembersByUserWithBody(ctx context.Context, space string, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSpacesBySpaceMembersByUserRequestWithBody(c.Server, space, user, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutSpacesBySpaceMembersByUser(ctx context.Context, space string, user string, body PutSpacesBySpaceMembersByUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSpacesBySpaceMembersByUserRequest(c.Server, space, user, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSpacesBySpaceTransactions(ctx context.Context, space string, params *GetSpacesBySpaceTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpacesBySpaceTransactionsRequest(c.Server, space, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSpacesBySpaceWallets(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpacesBySpaceWalletsRequest(c.Server, space)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSpacesBySpaceWalletsByWallet(ctx context.Context, space string, wallet string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSpacesBySpaceWalletsByWalletRequest(c.Server, space, wallet)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSpacesBySpaceWalletsByWallet(ctx context.Context, space string, wallet string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSpacesBySpaceWalletsByWalletRequest(c.Server, space, wallet)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStreams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStreamsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStreamsByStreamCompactWithBody(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStreamsByStreamCompactRequestWithBody(c.Server, stream, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStreamsByStreamCompact(ctx context.Context, stream string, body PostStreamsByStreamCompactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStreamsByStreamCompactRequest(c.Server, stream, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStreamsByStreamPurgeWithBody(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStreamsByStreamPurgeRequestWithBody(c.Server, stream, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStreamsByStreamPurge(ctx context.Context, stream string, body PostStreamsByStreamPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStreamsByStreamPurgeRequest(c.Server, stream, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutStreamsByStreamRetentionWithBody(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutStreamsByStreamRetentionRequestWithBody(c.Server, stream, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutStreamsByStreamRetention(ctx context.Context, stream string, body PutStreamsByStreamRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutStreamsByStreamRetentionRequest(c.Server, stream, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSubscriptions(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSubscriptionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSubscriptionsDetect(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSubscriptionsDetectRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTagsSpend(ctx context.Context, params *GetTagsSpendParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTagsSpendRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTagsById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagsByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTagsByIdMergeWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTagsByIdMergeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTagsByIdMerge(ctx context.Context, id string, body PostTagsByIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTagsByIdMergeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTagsByIdRenameWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTagsByIdRenameRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTagsByIdRename(ctx context.Context, id string, body PostTagsByIdRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTagsByIdRenameRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTransactions(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchTransactionsBulkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchTransactionsBulkRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchTransactionsBulk(ctx context.Context, body PatchTransactionsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchTransactionsBulkRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTransactionsImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTransactionsImportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTransactionsImport(ctx context.Context, body PostTransactionsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTransactionsImportRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTransactionsByIdMergeWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTransactionsByIdMergeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTransactionsByIdMerge(ctx context.Context, id string, body PostTransactionsByIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTransactionsByIdMergeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWebhooksDeliveriesByIdRedeliver(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksDeliveriesByIdRedeliverRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhooksByIdDeliveries(ctx context.Context, id string, params *GetWebhooksByIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksByIdDeliveriesRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAuditRequest generates requests for GetAudit
+func NewGetAuditRequest(server string, params *GetAuditParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Collection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "collection", runtime.ParamLocationQuery, *params.Collection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Record != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "record", runtime.ParamLocationQuery, *params.Record); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Source != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBalancesAssertionsRequest generates requests for GetBalancesAssertions
+func NewGetBalancesAssertionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/balances/assertions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostBalancesAssertionsRequest calls the generic PostBalancesAssertions builder with application/json body
+func NewPostBalancesAssertionsRequest(server string, body PostBalancesAssertionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBalancesAssertionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostBalancesAssertionsRequestWithBody generates requests for PostBalancesAssertions with any type of body
+func NewPostBalancesAssertionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/balances/assertions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBalancesAssertionsByIdRequest generates requests for DeleteBalancesAssertionsById
+func NewDeleteBalancesAssertionsByIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/balances/assertions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutBalancesAssertionsByIdRequest calls the generic PutBalancesAssertionsById builder with application/json body
+func NewPutBalancesAssertionsByIdRequest(server string, id string, body PutBalancesAssertionsByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutBalancesAssertionsByIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutBalancesAssertionsByIdRequestWithBody generates requests for PutBalancesAssertionsById with any type of body
+func NewPutBalancesAssertionsByIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/balances/assertions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostBalancesAssertionsByIdCheckRequest generates requests for PostBalancesAssertionsByIdCheck
+func NewPostBalancesAssertionsByIdCheckRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/balances/assertions/%s/check", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBalancesAssertionsByIdResultsRequest generates requests for GetBalancesAssertionsByIdResults
+func NewGetBalancesAssertionsByIdResultsRequest(server string, id string, params *GetBalancesAssertionsByIdResultsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/balances/assertions/%s/results", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Failed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "failed", runtime.ParamLocationQuery, *params.Failed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBalancesDriftRequest generates requests for GetBalancesDrift
+func NewGetBalancesDriftRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/balances/drift")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostBalancesRecalculateRequest calls the generic PostBalancesRecalculate builder with application/json body
+func NewPostBalancesRecalculateRequest(server string, body PostBalancesRecalculateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBalancesRecalculateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostBalancesRecalculateRequestWithBody generates requests for PostBalancesRecalculate with any type of body
+func NewPostBalancesRecalculateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/balances/recalculate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostBalancesUpdateRequest generates requests for PostBalancesUpdate
+func NewPostBalancesUpdateRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/balances/update")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarEventsRequest generates requests for GetCalendarEvents
+func NewGetCalendarEventsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/calendar/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteCalendarFeedRequest generates requests for DeleteCalendarFeed
+func NewDeleteCalendarFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/calendar/feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostCalendarFeedRequest generates requests for PostCalendarFeed
+func NewPostCalendarFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/calendar/feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarFeedIcsRequest generates requests for GetCalendarFeedIcs
+func NewGetCalendarFeedIcsRequest(server string, params *GetCalendarFeedIcsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/calendar/feed.ics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Token != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, *params.Token); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCategorizationRequest generates requests for GetCategorization
+func NewGetCategorizationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/categorization")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCategorizationReviewsRequest generates requests for GetCategorizationReviews
+func NewGetCategorizationReviewsRequest(server string, params *GetCategorizationReviewsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/categorization/reviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostCategorizationReviewsByIdRequest calls the generic PostCategorizationReviewsById builder with application/json body
+func NewPostCategorizationReviewsByIdRequest(server string, id string, body PostCategorizationReviewsByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCategorizationReviewsByIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostCategorizationReviewsByIdRequestWithBody generates requests for PostCategorizationReviewsById with any type of body
+func NewPostCategorizationReviewsByIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/categorization/reviews/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostCategorizationSuggestRequest calls the generic PostCategorizationSuggest builder with application/json body
+func NewPostCategorizationSuggestRequest(server string, params *PostCategorizationSuggestParams, body PostCategorizationSuggestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCategorizationSuggestRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostCategorizationSuggestRequestWithBody generates requests for PostCategorizationSuggest with any type of body
+func NewPostCategorizationSuggestRequestWithBody(server string, params *PostCategorizationSuggestParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/categorization/suggest")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostCategorizationTrainRequest generates requests for PostCategorizationTrain
+func NewPostCategorizationTrainRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/categorization/train")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCostBasisRequest generates requests for GetCostBasis
+func NewGetCostBasisRequest(server string, params *GetCostBasisParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/cost-basis")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Method != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "method", runtime.ParamLocationQuery, *params.Method); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Base != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "base", runtime.ParamLocationQuery, *params.Base); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCostBasisByYearRequest generates requests for GetCostBasisByYear
+func NewGetCostBasisByYearRequest(server string, year string, params *GetCostBasisByYearParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "year", runtime.ParamLocationPath, year)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/cost-basis/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Method != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "method", runtime.ParamLocationQuery, *params.Method); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Base != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "base", runtime.ParamLocationQuery, *params.Base); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDocsRequest generates requests for GetDocs
+func NewGetDocsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/docs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetExportJobsByIdRequest generates requests for GetExportJobsById
+func NewGetExportJobsByIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/export/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetExportJobsByIdDownloadRequest generates requests for GetExportJobsByIdDownload
+func NewGetExportJobsByIdDownloadRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/export/jobs/%s/download", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetExportByDatasetRequest generates requests for GetExportByDataset
+func NewGetExportByDatasetRequest(server string, dataset string, params *GetExportByDatasetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dataset", runtime.ParamLocationPath, dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/export/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Wallet != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wallet", runtime.ParamLocationQuery, *params.Wallet); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Category != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category", runtime.ParamLocationQuery, *params.Category); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Async != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "async", runtime.ParamLocationQuery, *params.Async); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteFireflyAccountsByIdRequest generates requests for DeleteFireflyAccountsById
+func NewDeleteFireflyAccountsByIdRequest(server string, id string, params *DeleteFireflyAccountsByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/firefly/accounts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFireflyAccountsByIdArchiveRequest generates requests for PostFireflyAccountsByIdArchive
+func NewPostFireflyAccountsByIdArchiveRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/firefly/accounts/%s/archive", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFireflyBootstrapRequest generates requests for PostFireflyBootstrap
+func NewPostFireflyBootstrapRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/firefly/bootstrap")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFireflyLinksRequest calls the generic PostFireflyLinks builder with application/json body
+func NewPostFireflyLinksRequest(server string, body PostFireflyLinksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostFireflyLinksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostFireflyLinksRequestWithBody generates requests for PostFireflyLinks with any type of body
+func NewPostFireflyLinksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/firefly/links")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFireflyOauthAuthorizeRequest generates requests for GetFireflyOauthAuthorize
+func NewGetFireflyOauthAuthorizeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/firefly/oauth/authorize")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFireflyOauthCallbackRequest generates requests for GetFireflyOauthCallback
+func NewGetFireflyOauthCallbackRequest(server string, params *GetFireflyOauthCallbackParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/firefly/oauth/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Code != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Error != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFireflyOutboxRequest generates requests for GetFireflyOutbox
+func NewGetFireflyOutboxRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/firefly/outbox")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFireflyOutboxDrainRequest generates requests for PostFireflyOutboxDrain
+func NewPostFireflyOutboxDrainRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/firefly/outbox/drain")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFireflyPullRequest generates requests for PostFireflyPull
+func NewPostFireflyPullRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/firefly/pull")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostGraphqlRequest calls the generic PostGraphql builder with application/json body
+func NewPostGraphqlRequest(server string, body PostGraphqlJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostGraphqlRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostGraphqlRequestWithBody generates requests for PostGraphql with any type of body
+func NewPostGraphqlRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/graphql")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetImportsBudgetsProfilesRequest generates requests for GetImportsBudgetsProfiles
+func NewGetImportsBudgetsProfilesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/budgets/profiles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostImportsBudgetsByProfileRequestWithBody generates requests for PostImportsBudgetsByProfile with any type of body
+func NewPostImportsBudgetsByProfileRequestWithBody(server string, profile string, params *PostImportsBudgetsByProfileParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profile", runtime.ParamLocationPath, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/budgets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostImportsDescriptionsPreviewRequest calls the generic PostImportsDescriptionsPreview builder with application/json body
+func NewPostImportsDescriptionsPreviewRequest(server string, body PostImportsDescriptionsPreviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostImportsDescriptionsPreviewRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostImportsDescriptionsPreviewRequestWithBody generates requests for PostImportsDescriptionsPreview with any type of body
+func NewPostImportsDescriptionsPreviewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/descriptions/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetImportsProfilesRequest generates requests for GetImportsProfiles
+func NewGetImportsProfilesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/profiles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetImportsReviewsRequest generates requests for GetImportsReviews
+func NewGetImportsReviewsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/reviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteImportsReviewsByIdRequest generates requests for DeleteImportsReviewsById
+func NewDeleteImportsReviewsByIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/reviews/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetImportsReviewsByIdRequest generates requests for GetImportsReviewsById
+func NewGetImportsReviewsByIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/reviews/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostImportsReviewsByIdCommitRequest generates requests for PostImportsReviewsByIdCommit
+func NewPostImportsReviewsByIdCommitRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/reviews/%s/commit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutImportsReviewsByIdMappingRequest calls the generic PutImportsReviewsByIdMapping builder with application/json body
+func NewPutImportsReviewsByIdMappingRequest(server string, id string, body PutImportsReviewsByIdMappingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutImportsReviewsByIdMappingRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutImportsReviewsByIdMappingRequestWithBody generates requests for PutImportsReviewsByIdMapping with any type of body
+func NewPutImportsReviewsByIdMappingRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/reviews/%s/mapping", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostImportsStatementsRequestWithBody generates requests for PostImportsStatements with any type of body
+func NewPostImportsStatementsRequestWithBody(server string, params *PostImportsStatementsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/statements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Wallet != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wallet", runtime.ParamLocationQuery, *params.Wallet); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostImportsByProfileRequestWithBody generates requests for PostImportsByProfile with any type of body
+func NewPostImportsByProfileRequestWithBody(server string, profile string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profile", runtime.ParamLocationPath, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/imports/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetIncidentsRequest generates requests for GetIncidents
+func NewGetIncidentsRequest(server string, params *GetIncidentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/incidents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Provider != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "provider", runtime.ParamLocationQuery, *params.Provider); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Open != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "open", runtime.ParamLocationQuery, *params.Open); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteMaintenanceRequest generates requests for DeleteMaintenance
+func NewDeleteMaintenanceRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/maintenance")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMaintenanceRequest generates requests for GetMaintenance
+func NewGetMaintenanceRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/maintenance")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutMaintenanceRequest calls the generic PutMaintenance builder with application/json body
+func NewPutMaintenanceRequest(server string, body PutMaintenanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutMaintenanceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutMaintenanceRequestWithBody generates requests for PutMaintenance with any type of body
+func NewPutMaintenanceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/maintenance")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMetricsRequest generates requests for GetMetrics
+func NewGetMetricsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/metrics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNetworthRequest generates requests for GetNetworth
+func NewGetNetworthRequest(server string, params *GetNetworthParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/networth")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Base != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "base", runtime.ParamLocationQuery, *params.Base); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeArchived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_archived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOpenapiJsonRequest generates requests for GetOpenapiJson
+func NewGetOpenapiJsonRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/openapi.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPeriodsRequest generates requests for GetPeriods
+func NewGetPeriodsRequest(server string, params *GetPeriodsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/periods")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPeriodsByNameRequest generates requests for GetPeriodsByName
+func NewGetPeriodsByNameRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/periods/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPortfolioRequest generates requests for GetPortfolio
+func NewGetPortfolioRequest(server string, params *GetPortfolioParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/portfolio")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Base != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "base", runtime.ParamLocationQuery, *params.Base); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeArchived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_archived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPreferencesRequest generates requests for GetPreferences
+func NewGetPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutPreferencesRequest calls the generic PutPreferences builder with application/json body
+func NewPutPreferencesRequest(server string, body PutPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutPreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutPreferencesRequestWithBody generates requests for PutPreferences with any type of body
+func NewPutPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostRulesApplyRequest calls the generic PostRulesApply builder with application/json body
+func NewPostRulesApplyRequest(server string, body PostRulesApplyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRulesApplyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostRulesApplyRequestWithBody generates requests for PostRulesApply with any type of body
+func NewPostRulesApplyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/rules/apply")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostRulesTestRequest calls the generic PostRulesTest builder with application/json body
+func NewPostRulesTestRequest(server string, body PostRulesTestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRulesTestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostRulesTestRequestWithBody generates requests for PostRulesTest with any type of body
+func NewPostRulesTestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/rules/test")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSourcesRequest generates requests for GetSources
+func NewGetSourcesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSourcesBackfillsRequest generates requests for GetSourcesBackfills
+func NewGetSourcesBackfillsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/backfills")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSourcesConsentCallbackRequest generates requests for GetSourcesConsentCallback
+func NewGetSourcesConsentCallbackRequest(server string, params *GetSourcesConsentCallbackParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/consent/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Code != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Error != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSourcesConsentsRequest generates requests for GetSourcesConsents
+func NewGetSourcesConsentsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/consents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSourcesCyclesRequest generates requests for GetSourcesCycles
+func NewGetSourcesCyclesRequest(server string, params *GetSourcesCyclesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/cycles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSourcesQueueRequest generates requests for GetSourcesQueue
+func NewGetSourcesQueueRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/queue")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSourcesSchedulerRequest generates requests for GetSourcesScheduler
+func NewGetSourcesSchedulerRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/scheduler")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSourcesStatusRequest generates requests for GetSourcesStatus
+func NewGetSourcesStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSourcesSyncRequest generates requests for PostSourcesSync
+func NewPostSourcesSyncRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSourcesByIdBackfillRequest generates requests for GetSourcesByIdBackfill
+func NewGetSourcesByIdBackfillRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/%s/backfill", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSourcesByIdBackfillRequest generates requests for PostSourcesByIdBackfill
+func NewPostSourcesByIdBackfillRequest(server string, id string, params *PostSourcesByIdBackfillParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/%s/backfill", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Restart != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "restart", runtime.ParamLocationQuery, *params.Restart); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSourcesByIdConsentRenewRequest generates requests for PostSourcesByIdConsentRenew
+func NewPostSourcesByIdConsentRenewRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/%s/consent/renew", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutSourcesByIdLifecycleRequest calls the generic PutSourcesByIdLifecycle builder with application/json body
+func NewPutSourcesByIdLifecycleRequest(server string, id string, body PutSourcesByIdLifecycleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutSourcesByIdLifecycleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutSourcesByIdLifecycleRequestWithBody generates requests for PutSourcesByIdLifecycle with any type of body
+func NewPutSourcesByIdLifecycleRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/%s/lifecycle", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSourcesByIdPauseRequest generates requests for PostSourcesByIdPause
+func NewPostSourcesByIdPauseRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/%s/pause", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSourcesByIdResumeRequest generates requests for PostSourcesByIdResume
+func NewPostSourcesByIdResumeRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/%s/resume", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSourcesByIdSyncRequest generates requests for PostSourcesByIdSync
+func NewPostSourcesByIdSyncRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/%s/sync", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSourcesByIdTestRequest generates requests for PostSourcesByIdTest
+func NewPostSourcesByIdTestRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/sources/%s/test", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSpacesRequest generates requests for GetSpaces
+func NewGetSpacesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSpacesRequest calls the generic PostSpaces builder with application/json body
+func NewPostSpacesRequest(server string, body PostSpacesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSpacesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostSpacesRequestWithBody generates requests for PostSpaces with any type of body
+func NewPostSpacesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSpacesBySpaceCategoriesRequest generates requests for GetSpacesBySpaceCategories
+func NewGetSpacesBySpaceCategoriesRequest(server string, space string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/categories", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSpacesBySpaceCategoriesByCategoryRequest generates requests for PostSpacesBySpaceCategoriesByCategory
+func NewPostSpacesBySpaceCategoriesByCategoryRequest(server string, space string, category string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "category", runtime.ParamLocationPath, category)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/categories/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteSpacesBySpaceMaintenanceRequest generates requests for DeleteSpacesBySpaceMaintenance
+func NewDeleteSpacesBySpaceMaintenanceRequest(server string, space string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/maintenance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutSpacesBySpaceMaintenanceRequest calls the generic PutSpacesBySpaceMaintenance builder with application/json body
+func NewPutSpacesBySpaceMaintenanceRequest(server string, space string, body PutSpacesBySpaceMaintenanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutSpacesBySpaceMaintenanceRequestWithBody(server, space, "application/json", bodyReader)
+}
+
+// NewPutSpacesBySpaceMaintenanceRequestWithBody generates requests for PutSpacesBySpaceMaintenance with any type of body
+func NewPutSpacesBySpaceMaintenanceRequestWithBody(server string, space string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/maintenance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSpacesBySpaceMembersRequest generates requests for GetSpacesBySpaceMembers
+func NewGetSpacesBySpaceMembersRequest(server string, space string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteSpacesBySpaceMembersByUserRequest generates requests for DeleteSpacesBySpaceMembersByUser
+func NewDeleteSpacesBySpaceMembersByUserRequest(server string, space string, user string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user", runtime.ParamLocationPath, user)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutSpacesBySpaceMembersByUserRequest calls the generic PutSpacesBySpaceMembersByUser builder with application/json body
+func NewPutSpacesBySpaceMembersByUserRequest(server string, space string, user string, body PutSpacesBySpaceMembersByUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutSpacesBySpaceMembersByUserRequestWithBody(server, space, user, "application/json", bodyReader)
+}
+
+// NewPutSpacesBySpaceMembersByUserRequestWithBody generates requests for PutSpacesBySpaceMembersByUser with any type of body
+func NewPutSpacesBySpaceMembersByUserRequestWithBody(server string, space string, user string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user", runtime.ParamLocationPath, user)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSpacesBySpaceTransactionsRequest generates requests for GetSpacesBySpaceTransactions
+func NewGetSpacesBySpaceTransactionsRequest(server string, space string, params *GetSpacesBySpaceTransactionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/transactions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSpacesBySpaceWalletsRequest generates requests for GetSpacesBySpaceWallets
+func NewGetSpacesBySpaceWalletsRequest(server string, space string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/wallets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteSpacesBySpaceWalletsByWalletRequest generates requests for DeleteSpacesBySpaceWalletsByWallet
+func NewDeleteSpacesBySpaceWalletsByWalletRequest(server string, space string, wallet string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "wallet", runtime.ParamLocationPath, wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/wallets/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSpacesBySpaceWalletsByWalletRequest generates requests for PostSpacesBySpaceWalletsByWallet
+func NewPostSpacesBySpaceWalletsByWalletRequest(server string, space string, wallet string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "space", runtime.ParamLocationPath, space)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "wallet", runtime.ParamLocationPath, wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/spaces/%s/wallets/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStreamsRequest generates requests for GetStreams
+func NewGetStreamsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/streams")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostStreamsByStreamCompactRequest calls the generic PostStreamsByStreamCompact builder with application/json body
+func NewPostStreamsByStreamCompactRequest(server string, stream string, body PostStreamsByStreamCompactJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostStreamsByStreamCompactRequestWithBody(server, stream, "application/json", bodyReader)
+}
+
+// NewPostStreamsByStreamCompactRequestWithBody generates requests for PostStreamsByStreamCompact with any type of body
+func NewPostStreamsByStreamCompactRequestWithBody(server string, stream string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "stream", runtime.ParamLocationPath, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/streams/%s/compact", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostStreamsByStreamPurgeRequest calls the generic PostStreamsByStreamPurge builder with application/json body
+func NewPostStreamsByStreamPurgeRequest(server string, stream string, body PostStreamsByStreamPurgeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostStreamsByStreamPurgeRequestWithBody(server, stream, "application/json", bodyReader)
+}
+
+// NewPostStreamsByStreamPurgeRequestWithBody generates requests for PostStreamsByStreamPurge with any type of body
+func NewPostStreamsByStreamPurgeRequestWithBody(server string, stream string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "stream", runtime.ParamLocationPath, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/streams/%s/purge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutStreamsByStreamRetentionRequest calls the generic PutStreamsByStreamRetention builder with application/json body
+func NewPutStreamsByStreamRetentionRequest(server string, stream string, body PutStreamsByStreamRetentionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutStreamsByStreamRetentionRequestWithBody(server, stream, "application/json", bodyReader)
+}
+
+// NewPutStreamsByStreamRetentionRequestWithBody generates requests for PutStreamsByStreamRetention with any type of body
+func NewPutStreamsByStreamRetentionRequestWithBody(server string, stream string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "stream", runtime.ParamLocationPath, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/streams/%s/retention", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSubscriptionsRequest generates requests for GetSubscriptions
+func NewGetSubscriptionsRequest(server string, params *GetSubscriptionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Wallet != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wallet", runtime.ParamLocationQuery, *params.Wallet); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSubscriptionsDetectRequest generates requests for PostSubscriptionsDetect
+func NewPostSubscriptionsDetectRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/subscriptions/detect")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTagsSpendRequest generates requests for GetTagsSpend
+func NewGetTagsSpendRequest(server string, params *GetTagsSpendParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/tags/spend")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Group != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group", runtime.ParamLocationQuery, *params.Group); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteTagsByIdRequest generates requests for DeleteTagsById
+func NewDeleteTagsByIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTagsByIdMergeRequest calls the generic PostTagsByIdMerge builder with application/json body
+func NewPostTagsByIdMergeRequest(server string, id string, body PostTagsByIdMergeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTagsByIdMergeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostTagsByIdMergeRequestWithBody generates requests for PostTagsByIdMerge with any type of body
+func NewPostTagsByIdMergeRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/tags/%s/merge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTagsByIdRenameRequest calls the generic PostTagsByIdRename builder with application/json body
+func NewPostTagsByIdRenameRequest(server string, id string, body PostTagsByIdRenameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTagsByIdRenameRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostTagsByIdRenameRequestWithBody generates requests for PostTagsByIdRename with any type of body
+func NewPostTagsByIdRenameRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/tags/%s/rename", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTransactionsRequest generates requests for GetTransactions
+func NewGetTransactionsRequest(server string, params *GetTransactionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Wallet != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wallet", runtime.ParamLocationQuery, *params.Wallet); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Description != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Notes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "notes", runtime.ParamLocationQuery, *params.Notes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchTransactionsBulkRequest calls the generic PatchTransactionsBulk builder with application/json body
+func NewPatchTransactionsBulkRequest(server string, body PatchTransactionsBulkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchTransactionsBulkRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPatchTransactionsBulkRequestWithBody generates requests for PatchTransactionsBulk with any type of body
+func NewPatchTransactionsBulkRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/transactions/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTransactionsImportRequest calls the generic PostTransactionsImport builder with application/json body
+func NewPostTransactionsImportRequest(server string, body PostTransactionsImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTransactionsImportRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTransactionsImportRequestWithBody generates requests for PostTransactionsImport with any type of body
+func NewPostTransactionsImportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/transactions/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTransactionsByIdMergeRequest calls the generic PostTransactionsByIdMerge builder with application/json body
+func NewPostTransactionsByIdMergeRequest(server string, id string, body PostTransactionsByIdMergeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTransactionsByIdMergeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostTransactionsByIdMergeRequestWithBody generates requests for PostTransactionsByIdMerge with any type of body
+func NewPostTransactionsByIdMergeRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/transactions/%s/merge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostWebhooksDeliveriesByIdRedeliverRequest generates requests for PostWebhooksDeliveriesByIdRedeliver
+func NewPostWebhooksDeliveriesByIdRedeliverRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/webhooks/deliveries/%s/redeliver", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksByIdDeliveriesRequest generates requests for GetWebhooksByIdDeliveries
+func NewGetWebhooksByIdDeliveriesRequest(server string, id string, params *GetWebhooksByIdDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/firedragon/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAuditWithResponse request
+	GetAuditWithResponse(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*GetAuditResponse, error)
+
+	// GetBalancesAssertionsWithResponse request
+	GetBalancesAssertionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBalancesAssertionsResponse, error)
+
+	// PostBalancesAssertionsWithBodyWithResponse request with any body
+	PostBalancesAssertionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBalancesAssertionsResponse, error)
+
+	PostBalancesAssertionsWithResponse(ctx context.Context, body PostBalancesAssertionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBalancesAssertionsResponse, error)
+
+	// DeleteBalancesAssertionsByIdWithResponse request
+	DeleteBalancesAssertionsByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteBalancesAssertionsByIdResponse, error)
+
+	// PutBalancesAssertionsByIdWithBodyWithResponse request with any body
+	PutBalancesAssertionsByIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBalancesAssertionsByIdResponse, error)
+
+	PutBalancesAssertionsByIdWithResponse(ctx context.Context, id string, body PutBalancesAssertionsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBalancesAssertionsByIdResponse, error)
+
+	// PostBalancesAssertionsByIdCheckWithResponse request
+	PostBalancesAssertionsByIdCheckWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostBalancesAssertionsByIdCheckResponse, error)
+
+	// GetBalancesAssertionsByIdResultsWithResponse request
+	GetBalancesAssertionsByIdResultsWithResponse(ctx context.Context, id string, params *GetBalancesAssertionsByIdResultsParams, reqEditors ...RequestEditorFn) (*GetBalancesAssertionsByIdResultsResponse, error)
+
+	// GetBalancesDriftWithResponse request
+	GetBalancesDriftWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBalancesDriftResponse, error)
+
+	// PostBalancesRecalculateWithBodyWithResponse request with any body
+	PostBalancesRecalculateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBalancesRecalculateResponse, error)
+
+	PostBalancesRecalculateWithResponse(ctx context.Context, body PostBalancesRecalculateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBalancesRecalculateResponse, error)
+
+	// PostBalancesUpdateWithResponse request
+	PostBalancesUpdateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostBalancesUpdateResponse, error)
+
+	// GetCalendarEventsWithResponse request
+	GetCalendarEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCalendarEventsResponse, error)
+
+	// DeleteCalendarFeedWithResponse request
+	DeleteCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteCalendarFeedResponse, error)
+
+	// PostCalendarFeedWithResponse request
+	PostCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostCalendarFeedResponse, error)
+
+	// GetCalendarFeedIcsWithResponse request
+	GetCalendarFeedIcsWithResponse(ctx context.Context, params *GetCalendarFeedIcsParams, reqEditors ...RequestEditorFn) (*GetCalendarFeedIcsResponse, error)
+
+	// GetCategorizationWithResponse request
+	GetCategorizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCategorizationResponse, error)
+
+	// GetCategorizationReviewsWithResponse request
+	GetCategorizationReviewsWithResponse(ctx context.Context, params *GetCategorizationReviewsParams, reqEditors ...RequestEditorFn) (*GetCategorizationReviewsResponse, error)
+
+	// PostCategorizationReviewsByIdWithBodyWithResponse request with any body
+	PostCategorizationReviewsByIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCategorizationReviewsByIdResponse, error)
+
+	PostCategorizationReviewsByIdWithResponse(ctx context.Context, id string, body PostCategorizationReviewsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCategorizationReviewsByIdResponse, error)
+
+	// PostCategorizationSuggestWithBodyWithResponse request with any body
+	PostCategorizationSuggestWithBodyWithResponse(ctx context.Context, params *PostCategorizationSuggestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCategorizationSuggestResponse, error)
+
+	PostCategorizationSuggestWithResponse(ctx context.Context, params *PostCategorizationSuggestParams, body PostCategorizationSuggestJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCategorizationSuggestResponse, error)
+
+	// PostCategorizationTrainWithResponse request
+	PostCategorizationTrainWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostCategorizationTrainResponse, error)
+
+	// GetCostBasisWithResponse request
+	GetCostBasisWithResponse(ctx context.Context, params *GetCostBasisParams, reqEditors ...RequestEditorFn) (*GetCostBasisResponse, error)
+
+	// GetCostBasisByYearWithResponse request
+	GetCostBasisByYearWithResponse(ctx context.Context, year string, params *GetCostBasisByYearParams, reqEditors ...RequestEditorFn) (*GetCostBasisByYearResponse, error)
+
+	// GetDocsWithResponse request
+	GetDocsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocsResponse, error)
+
+	// GetExportJobsByIdWithResponse request
+	GetExportJobsByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetExportJobsByIdResponse, error)
+
+	// GetExportJobsByIdDownloadWithResponse request
+	GetExportJobsByIdDownloadWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetExportJobsByIdDownloadResponse, error)
+
+	// GetExportByDatasetWithResponse request
+	GetExportByDatasetWithResponse(ctx context.Context, dataset string, params *GetExportByDatasetParams, reqEditors ...RequestEditorFn) (*GetExportByDatasetResponse, error)
+
+	// DeleteFireflyAccountsByIdWithResponse request
+	DeleteFireflyAccountsByIdWithResponse(ctx context.Context, id string, params *DeleteFireflyAccountsByIdParams, reqEditors ...RequestEditorFn) (*DeleteFireflyAccountsByIdResponse, error)
+
+	// PostFireflyAccountsByIdArchiveWithResponse request
+	PostFireflyAccountsByIdArchiveWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostFireflyAccountsByIdArchiveResponse, error)
+
+	// PostFireflyBootstrapWithResponse request
+	PostFireflyBootstrapWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostFireflyBootstrapResponse, error)
+
+	// PostFireflyLinksWithBodyWithResponse request with any body
+	PostFireflyLinksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFireflyLinksResponse, error)
+
+	PostFireflyLinksWithResponse(ctx context.Context, body PostFireflyLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFireflyLinksResponse, error)
+
+	// GetFireflyOauthAuthorizeWithResponse request
+	GetFireflyOauthAuthorizeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFireflyOauthAuthorizeResponse, error)
+
+	// GetFireflyOauthCallbackWithResponse request
+	GetFireflyOauthCallbackWithResponse(ctx context.Context, params *GetFireflyOauthCallbackParams, reqEditors ...RequestEditorFn) (*GetFireflyOauthCallbackResponse, error)
+
+	// GetFireflyOutboxWithResponse request
+	GetFireflyOutboxWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFireflyOutboxResponse, error)
+
+	// PostFireflyOutboxDrainWithResponse request
+	PostFireflyOutboxDrainWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostFireflyOutboxDrainResponse, error)
+
+	// PostFireflyPullWithResponse request
+	PostFireflyPullWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostFireflyPullResponse, error)
+
+	// PostGraphqlWithBodyWithResponse request with any body
+	PostGraphqlWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGraphqlResponse, error)
+
+	PostGraphqlWithResponse(ctx context.Context, body PostGraphqlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGraphqlResponse, error)
+
+	// GetImportsBudgetsProfilesWithResponse request
+	GetImportsBudgetsProfilesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetImportsBudgetsProfilesResponse, error)
+
+	// PostImportsBudgetsByProfileWithBodyWithResponse request with any body
+	PostImportsBudgetsByProfileWithBodyWithResponse(ctx context.Context, profile string, params *PostImportsBudgetsByProfileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostImportsBudgetsByProfileResponse, error)
+
+	// PostImportsDescriptionsPreviewWithBodyWithResponse request with any body
+	PostImportsDescriptionsPreviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostImportsDescriptionsPreviewResponse, error)
+
+	PostImportsDescriptionsPreviewWithResponse(ctx context.Context, body PostImportsDescriptionsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostImportsDescriptionsPreviewResponse, error)
+
+	// GetImportsProfilesWithResponse request
+	GetImportsProfilesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetImportsProfilesResponse, error)
+
+	// GetImportsReviewsWithResponse request
+	GetImportsReviewsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetImportsReviewsResponse, error)
+
+	// DeleteImportsReviewsByIdWithResponse request
+	DeleteImportsReviewsByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteImportsReviewsByIdResponse, error)
+
+	// GetImportsReviewsByIdWithResponse request
+	GetImportsReviewsByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetImportsReviewsByIdResponse, error)
+
+	// PostImportsReviewsByIdCommitWithResponse request
+	PostImportsReviewsByIdCommitWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostImportsReviewsByIdCommitResponse, error)
+
+	// PutImportsReviewsByIdMappingWithBodyWithResponse request with any body
+	PutImportsReviewsByIdMappingWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutImportsReviewsByIdMappingResponse, error)
+
+	PutImportsReviewsByIdMappingWithResponse(ctx context.Context, id string, body PutImportsReviewsByIdMappingJSONRequestBody, reqEditors ...RequestEditorFn) (*PutImportsReviewsByIdMappingResponse, error)
+
+	// PostImportsStatementsWithBodyWithResponse request with any body
+	PostImportsStatementsWithBodyWithResponse(ctx context.Context, params *PostImportsStatementsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostImportsStatementsResponse, error)
+
+	// PostImportsByProfileWithBodyWithResponse request with any body
+	PostImportsByProfileWithBodyWithResponse(ctx context.Context, profile string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostImportsByProfileResponse, error)
+
+	// GetIncidentsWithResponse request
+	GetIncidentsWithResponse(ctx context.Context, params *GetIncidentsParams, reqEditors ...RequestEditorFn) (*GetIncidentsResponse, error)
+
+	// DeleteMaintenanceWithResponse request
+	DeleteMaintenanceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteMaintenanceResponse, error)
+
+	// GetMaintenanceWithResponse request
+	GetMaintenanceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMaintenanceResponse, error)
+
+	// PutMaintenanceWithBodyWithResponse request with any body
+	PutMaintenanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMaintenanceResponse, error)
+
+	PutMaintenanceWithResponse(ctx context.Context, body PutMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMaintenanceResponse, error)
+
+	// GetMetricsWithResponse request
+	GetMetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMetricsResponse, error)
+
+	// GetNetworthWithResponse request
+	GetNetworthWithResponse(ctx context.Context, params *GetNetworthParams, reqEditors ...RequestEditorFn) (*GetNetworthResponse, error)
+
+	// GetOpenapiJsonWithResponse request
+	GetOpenapiJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenapiJsonResponse, error)
+
+	// GetPeriodsWithResponse request
+	GetPeriodsWithResponse(ctx context.Context, params *GetPeriodsParams, reqEditors ...RequestEditorFn) (*GetPeriodsResponse, error)
+
+	// GetPeriodsByNameWithResponse request
+	GetPeriodsByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetPeriodsByNameResponse, error)
+
+	// GetPortfolioWithResponse request
+	GetPortfolioWithResponse(ctx context.Context, params *GetPortfolioParams, reqEditors ...RequestEditorFn) (*GetPortfolioResponse, error)
+
+	// GetPreferencesWithResponse request
+	GetPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPreferencesResponse, error)
+
+	// PutPreferencesWithBodyWithResponse request with any body
+	PutPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPreferencesResponse, error)
+
+	PutPreferencesWithResponse(ctx context.Context, body PutPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutPreferencesResponse, error)
+
+	// PostRulesApplyWithBodyWithResponse request with any body
+	PostRulesApplyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRulesApplyResponse, error)
+
+	PostRulesApplyWithResponse(ctx context.Context, body PostRulesApplyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRulesApplyResponse, error)
+
+	// PostRulesTestWithBodyWithResponse request with any body
+	PostRulesTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRulesTestResponse, error)
+
+	PostRulesTestWithResponse(ctx context.Context, body PostRulesTestJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRulesTestResponse, error)
+
+	// GetSourcesWithResponse request
+	GetSourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesResponse, error)
+
+	// GetSourcesBackfillsWithResponse request
+	GetSourcesBackfillsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesBackfillsResponse, error)
+
+	// GetSourcesConsentCallbackWithResponse request
+	GetSourcesConsentCallbackWithResponse(ctx context.Context, params *GetSourcesConsentCallbackParams, reqEditors ...RequestEditorFn) (*GetSourcesConsentCallbackResponse, error)
+
+	// GetSourcesConsentsWithResponse request
+	GetSourcesConsentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesConsentsResponse, error)
+
+	// GetSourcesCyclesWithResponse request
+	GetSourcesCyclesWithResponse(ctx context.Context, params *GetSourcesCyclesParams, reqEditors ...RequestEditorFn) (*GetSourcesCyclesResponse, error)
+
+	// GetSourcesQueueWithResponse request
+	GetSourcesQueueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesQueueResponse, error)
+
+	// GetSourcesSchedulerWithResponse request
+	GetSourcesSchedulerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesSchedulerResponse, error)
+
+	// GetSourcesStatusWithResponse request
+	GetSourcesStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesStatusResponse, error)
+
+	// PostSourcesSyncWithResponse request
+	PostSourcesSyncWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostSourcesSyncResponse, error)
+
+	// GetSourcesByIdBackfillWithResponse request
+	GetSourcesByIdBackfillWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetSourcesByIdBackfillResponse, error)
+
+	// PostSourcesByIdBackfillWithResponse request
+	PostSourcesByIdBackfillWithResponse(ctx context.Context, id string, params *PostSourcesByIdBackfillParams, reqEditors ...RequestEditorFn) (*PostSourcesByIdBackfillResponse, error)
+
+	// PostSourcesByIdConsentRenewWithResponse request
+	PostSourcesByIdConsentRenewWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostSourcesByIdConsentRenewResponse, error)
+
+	// PutSourcesByIdLifecycleWithBodyWithResponse request with any body
+	PutSourcesByIdLifecycleWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSourcesByIdLifecycleResponse, error)
+
+	PutSourcesByIdLifecycleWithResponse(ctx context.Context, id string, body PutSourcesByIdLifecycleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSourcesByIdLifecycleResponse, error)
+
+	// PostSourcesByIdPauseWithResponse request
+	PostSourcesByIdPauseWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostSourcesByIdPauseResponse, error)
+
+	// PostSourcesByIdResumeWithResponse request
+	PostSourcesByIdResumeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostSourcesByIdResumeResponse, error)
+
+	// PostSourcesByIdSyncWithResponse request
+	PostSourcesByIdSyncWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostSourcesByIdSyncResponse, error)
+
+	// PostSourcesByIdTestWithResponse request
+	PostSourcesByIdTestWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostSourcesByIdTestResponse, error)
+
+	// GetSpacesWithResponse request
+	GetSpacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSpacesResponse, error)
+
+	// PostSpacesWithBodyWithResponse request with any body
+	PostSpacesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSpacesResponse, error)
+
+	PostSpacesWithResponse(ctx context.Context, body PostSpacesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSpacesResponse, error)
+
+	// GetSpacesBySpaceCategoriesWithResponse request
+	GetSpacesBySpaceCategoriesWithResponse(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*GetSpacesBySpaceCategoriesResponse, error)
+
+	// PostSpacesBySpaceCategoriesByCategoryWithResponse request
+	PostSpacesBySpaceCategoriesByCategoryWithResponse(ctx context.Context, space string, category string, reqEditors ...RequestEditorFn) (*PostSpacesBySpaceCategoriesByCategoryResponse, error)
+
+	// DeleteSpacesBySpaceMaintenanceWithResponse request
+	DeleteSpacesBySpaceMaintenanceWithResponse(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*DeleteSpacesBySpaceMaintenanceResponse, error)
+
+	// PutSpacesBySpaceMaintenanceWithBodyWithResponse request with any body
+	PutSpacesBySpaceMaintenanceWithBodyWithResponse(ctx context.Context, space string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSpacesBySpaceMaintenanceResponse, error)
+
+	PutSpacesBySpaceMaintenanceWithResponse(ctx context.Context, space string, body PutSpacesBySpaceMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSpacesBySpaceMaintenanceResponse, error)
+
+	// GetSpacesBySpaceMembersWithResponse request
+	GetSpacesBySpaceMembersWithResponse(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*GetSpacesBySpaceMembersResponse, error)
+
+	// DeleteSpacesBySpaceMembersByUserWithResponse request
+	DeleteSpacesBySpaceMembersByUserWithResponse(ctx context.Context, space string, user string, reqEditors ...RequestEditorFn) (*DeleteSpacesBySpaceMembersByUserResponse, error)
+
+	// PutSpacesBySpaceMembersByUserWithBodyWithResponse request with any body
+	PutSpacesBySpaceMembersByUserWithBodyWithResponse(ctx context.Context, space string, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSpacesBySpaceMembersByUserResponse, error)
+
+	PutSpacesBySpaceMembersByUserWithResponse(ctx context.Context, space string, user string, body PutSpacesBySpaceMembersByUserJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSpacesBySpaceMembersByUserResponse, error)
+
+	// GetSpacesBySpaceTransactionsWithResponse request
+	GetSpacesBySpaceTransactionsWithResponse(ctx context.Context, space string, params *GetSpacesBySpaceTransactionsParams, reqEditors ...RequestEditorFn) (*GetSpacesBySpaceTransactionsResponse, error)
+
+	// GetSpacesBySpaceWalletsWithResponse request
+	GetSpacesBySpaceWalletsWithResponse(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*GetSpacesBySpaceWalletsResponse, error)
+
+	// DeleteSpacesBySpaceWalletsByWalletWithResponse request
+	DeleteSpacesBySpaceWalletsByWalletWithResponse(ctx context.Context, space string, wallet string, reqEditors ...RequestEditorFn) (*DeleteSpacesBySpaceWalletsByWalletResponse, error)
+
+	// PostSpacesBySpaceWalletsByWalletWithResponse request
+	PostSpacesBySpaceWalletsByWalletWithResponse(ctx context.Context, space string, wallet string, reqEditors ...RequestEditorFn) (*PostSpacesBySpaceWalletsByWalletResponse, error)
+
+	// GetStreamsWithResponse request
+	GetStreamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStreamsResponse, error)
+
+	// PostStreamsByStreamCompactWithBodyWithResponse request with any body
+	PostStreamsByStreamCompactWithBodyWithResponse(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStreamsByStreamCompactResponse, error)
+
+	PostStreamsByStreamCompactWithResponse(ctx context.Context, stream string, body PostStreamsByStreamCompactJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStreamsByStreamCompactResponse, error)
+
+	// PostStreamsByStreamPurgeWithBodyWithResponse request with any body
+	PostStreamsByStreamPurgeWithBodyWithResponse(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStreamsByStreamPurgeResponse, error)
+
+	PostStreamsByStreamPurgeWithResponse(ctx context.Context, stream string, body PostStreamsByStreamPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStreamsByStreamPurgeResponse, error)
+
+	// PutStreamsByStreamRetentionWithBodyWithResponse request with any body
+	PutStreamsByStreamRetentionWithBodyWithResponse(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutStreamsByStreamRetentionResponse, error)
+
+	PutStreamsByStreamRetentionWithResponse(ctx context.Context, stream string, body PutStreamsByStreamRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutStreamsByStreamRetentionResponse, error)
+
+	// GetSubscriptionsWithResponse request
+	GetSubscriptionsWithResponse(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*GetSubscriptionsResponse, error)
+
+	// PostSubscriptionsDetectWithResponse request
+	PostSubscriptionsDetectWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostSubscriptionsDetectResponse, error)
+
+	// GetTagsSpendWithResponse request
+	GetTagsSpendWithResponse(ctx context.Context, params *GetTagsSpendParams, reqEditors ...RequestEditorFn) (*GetTagsSpendResponse, error)
+
+	// DeleteTagsByIdWithResponse request
+	DeleteTagsByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteTagsByIdResponse, error)
+
+	// PostTagsByIdMergeWithBodyWithResponse request with any body
+	PostTagsByIdMergeWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTagsByIdMergeResponse, error)
+
+	PostTagsByIdMergeWithResponse(ctx context.Context, id string, body PostTagsByIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTagsByIdMergeResponse, error)
+
+	// PostTagsByIdRenameWithBodyWithResponse request with any body
+	PostTagsByIdRenameWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTagsByIdRenameResponse, error)
+
+	PostTagsByIdRenameWithResponse(ctx context.Context, id string, body PostTagsByIdRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTagsByIdRenameResponse, error)
+
+	// GetTransactionsWithResponse request
+	GetTransactionsWithResponse(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*GetTransactionsResponse, error)
+
+	// PatchTransactionsBulkWithBodyWithResponse request with any body
+	PatchTransactionsBulkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTransactionsBulkResponse, error)
+
+	PatchTransactionsBulkWithResponse(ctx context.Context, body PatchTransactionsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTransactionsBulkResponse, error)
+
+	// PostTransactionsImportWithBodyWithResponse request with any body
+	PostTransactionsImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTransactionsImportResponse, error)
+
+	PostTransactionsImportWithResponse(ctx context.Context, body PostTransactionsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTransactionsImportResponse, error)
+
+	// PostTransactionsByIdMergeWithBodyWithResponse request with any body
+	PostTransactionsByIdMergeWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTransactionsByIdMergeResponse, error)
+
+	PostTransactionsByIdMergeWithResponse(ctx context.Context, id string, body PostTransactionsByIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTransactionsByIdMergeResponse, error)
+
+	// PostWebhooksDeliveriesByIdRedeliverWithResponse request
+	PostWebhooksDeliveriesByIdRedeliverWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostWebhooksDeliveriesByIdRedeliverResponse, error)
+
+	// GetWebhooksByIdDeliveriesWithResponse request
+	GetWebhooksByIdDeliveriesWithResponse(ctx context.Context, id string, params *GetWebhooksByIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhooksByIdDeliveriesResponse, error)
+}
+
+type GetAuditResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]AuditEntry
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBalancesAssertionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]BalanceAssertion
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBalancesAssertionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBalancesAssertionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBalancesAssertionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *BalanceAssertion
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBalancesAssertionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBalancesAssertionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBalancesAssertionsByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBalancesAssertionsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBalancesAssertionsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutBalancesAssertionsByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BalanceAssertion
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutBalancesAssertionsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutBalancesAssertionsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBalancesAssertionsByIdCheckResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BalanceAssertionResult
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBalancesAssertionsByIdCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBalancesAssertionsByIdCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBalancesAssertionsByIdResultsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]BalanceAssertionResult
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBalancesAssertionsByIdResultsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBalancesAssertionsByIdResultsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBalancesDriftResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BalanceUpdateReport
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBalancesDriftResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBalancesDriftResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBalancesRecalculateResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BalanceRecalculationReport
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBalancesRecalculateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBalancesRecalculateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBalancesUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BalanceUpdateReport
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBalancesUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBalancesUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]CalendarEvent
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCalendarFeedResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCalendarFeedResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *map[string]string
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarFeedIcsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarFeedIcsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarFeedIcsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCategorizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CategorizationStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCategorizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCategorizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCategorizationReviewsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]CategoryReview
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCategorizationReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCategorizationReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCategorizationReviewsByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Transaction
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCategorizationReviewsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCategorizationReviewsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCategorizationSuggestResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]CategorySuggestion
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCategorizationSuggestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCategorizationSuggestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCategorizationTrainResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CategorizationStatus
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCategorizationTrainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCategorizationTrainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCostBasisResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CostBasisReport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCostBasisResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCostBasisResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCostBasisByYearResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *YearGains
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCostBasisByYearResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCostBasisByYearResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDocsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDocsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDocsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExportJobsByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ExportJob
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExportJobsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExportJobsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExportJobsByIdDownloadResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExportJobsByIdDownloadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExportJobsByIdDownloadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExportByDatasetResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON202                   *ExportJob
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExportByDatasetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExportByDatasetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFireflyAccountsByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AccountRemoval
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFireflyAccountsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFireflyAccountsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFireflyAccountsByIdArchiveResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AccountRemoval
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFireflyAccountsByIdArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFireflyAccountsByIdArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFireflyBootstrapResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BootstrapReport
+	JSON207      *BootstrapReport
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFireflyBootstrapResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFireflyBootstrapResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFireflyLinksResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *FireflyLink
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFireflyLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFireflyLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFireflyOauthAuthorizeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *map[string]string
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFireflyOauthAuthorizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFireflyOauthAuthorizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFireflyOauthCallbackResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *map[string]string
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON502 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFireflyOauthCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFireflyOauthCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFireflyOutboxResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *FireflyOutboxStats
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFireflyOutboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFireflyOutboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFireflyOutboxDrainResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *FireflyOutboxReport
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFireflyOutboxDrainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFireflyOutboxDrainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFireflyPullResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *FireflyPullReport
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFireflyPullResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFireflyPullResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostGraphqlResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Response
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGraphqlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGraphqlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetImportsBudgetsProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ExchangeProfile
+}
+
+// Status returns HTTPResponse.Status
+func (r GetImportsBudgetsProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetImportsBudgetsProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostImportsBudgetsByProfileResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *BudgetImport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostImportsBudgetsByProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostImportsBudgetsByProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostImportsDescriptionsPreviewResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DescriptionPreview
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostImportsDescriptionsPreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostImportsDescriptionsPreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetImportsProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ExchangeProfile
+}
+
+// Status returns HTTPResponse.Status
+func (r GetImportsProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetImportsProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetImportsReviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BudgetImport
+}
+
+// Status returns HTTPResponse.Status
+func (r GetImportsReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetImportsReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteImportsReviewsByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BudgetImport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteImportsReviewsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteImportsReviewsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetImportsReviewsByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BudgetImport
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetImportsReviewsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetImportsReviewsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostImportsReviewsByIdCommitResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BudgetImportReport
+	JSON207                   *BudgetImportReport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostImportsReviewsByIdCommitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostImportsReviewsByIdCommitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutImportsReviewsByIdMappingResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *BudgetImport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutImportsReviewsByIdMappingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutImportsReviewsByIdMappingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostImportsStatementsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StatementImportReport
+	JSON207                   *StatementImportReport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostImportsStatementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostImportsStatementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostImportsByProfileResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ExchangeImportReport
+	JSON207                   *ExchangeImportReport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostImportsByProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostImportsByProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIncidentsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Incident
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIncidentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIncidentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMaintenanceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMaintenanceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MaintenanceState
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutMaintenanceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Maintenance
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNetworthResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *GetNetworthResult
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNetworthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNetworthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenapiJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenapiJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenapiJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPeriodsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *map[string]interface{}
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPeriodsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPeriodsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPeriodsByNameResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Period
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPeriodsByNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPeriodsByNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPortfolioResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Portfolio
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPortfolioResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPortfolioResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPreferencesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Preferences
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutPreferencesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Preferences
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRulesApplyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RuleApplication
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRulesApplyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRulesApplyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRulesTestResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Result
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRulesTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRulesTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSourcesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SourceInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSourcesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSourcesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSourcesBackfillsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Backfill
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSourcesBackfillsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSourcesBackfillsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSourcesConsentCallbackResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SourceState
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON502 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSourcesConsentCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSourcesConsentCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSourcesConsentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SourceState
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSourcesConsentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSourcesConsentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSourcesCyclesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]ImportCycleReport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSourcesCyclesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSourcesCyclesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSourcesQueueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProviderStats
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSourcesQueueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSourcesQueueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSourcesSchedulerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchedulerStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSourcesSchedulerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSourcesSchedulerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSourcesStatusResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SyncStatus
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSourcesStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSourcesStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSourcesSyncResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ImportCycleReport
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSourcesSyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSourcesSyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSourcesByIdBackfillResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Backfill
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSourcesByIdBackfillResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSourcesByIdBackfillResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSourcesByIdBackfillResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON202                   *Backfill
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSourcesByIdBackfillResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSourcesByIdBackfillResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSourcesByIdConsentRenewResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SourceState
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+	ApplicationproblemJSON502 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSourcesByIdConsentRenewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSourcesByIdConsentRenewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutSourcesByIdLifecycleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SourceState
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutSourcesByIdLifecycleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutSourcesByIdLifecycleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSourcesByIdPauseResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *map[string][]string
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSourcesByIdPauseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSourcesByIdPauseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSourcesByIdResumeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *map[string][]string
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSourcesByIdResumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSourcesByIdResumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSourcesByIdSyncResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ImportReport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSourcesByIdSyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSourcesByIdSyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSourcesByIdTestResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SourceTestReport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSourcesByIdTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSourcesByIdTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpacesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Space
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSpacesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Space
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSpacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSpacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpacesBySpaceCategoriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Category
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpacesBySpaceCategoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpacesBySpaceCategoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSpacesBySpaceCategoriesByCategoryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Category
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSpacesBySpaceCategoriesByCategoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSpacesBySpaceCategoriesByCategoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSpacesBySpaceMaintenanceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSpacesBySpaceMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSpacesBySpaceMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutSpacesBySpaceMaintenanceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Maintenance
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutSpacesBySpaceMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutSpacesBySpaceMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpacesBySpaceMembersResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]SpaceMember
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpacesBySpaceMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpacesBySpaceMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSpacesBySpaceMembersByUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *map[string]string
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSpacesBySpaceMembersByUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSpacesBySpaceMembersByUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutSpacesBySpaceMembersByUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SpaceMember
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutSpacesBySpaceMembersByUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutSpacesBySpaceMembersByUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpacesBySpaceTransactionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Transaction
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpacesBySpaceTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpacesBySpaceTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpacesBySpaceWalletsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Wallet
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpacesBySpaceWalletsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpacesBySpaceWalletsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSpacesBySpaceWalletsByWalletResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Wallet
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSpacesBySpaceWalletsByWalletResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSpacesBySpaceWalletsByWalletResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSpacesBySpaceWalletsByWalletResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Wallet
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSpacesBySpaceWalletsByWalletResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSpacesBySpaceWalletsByWalletResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStreamsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]StreamUsage
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStreamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStreamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostStreamsByStreamCompactResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *PurgeResult
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostStreamsByStreamCompactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostStreamsByStreamCompactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostStreamsByStreamPurgeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *PurgeResult
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostStreamsByStreamPurgeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostStreamsByStreamPurgeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutStreamsByStreamRetentionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StreamUsage
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutStreamsByStreamRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutStreamsByStreamRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSubscriptionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Subscription
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSubscriptionsDetectResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SubscriptionReport
+	JSON207                   *SubscriptionReport
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSubscriptionsDetectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSubscriptionsDetectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagsSpendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		union json.RawMessage
+	}
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+type GetTagsSpend2000 = []PeriodSpend
+type GetTagsSpend2001 = []TagSpend
+
+// Status returns HTTPResponse.Status
+func (r GetTagsSpendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagsSpendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTagsByIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *map[string]int
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTagsByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTagsByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTagsByIdMergeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *map[string]int
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTagsByIdMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTagsByIdMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTagsByIdRenameResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *map[string]int
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTagsByIdRenameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTagsByIdRenameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]Transaction
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchTransactionsBulkResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *map[string]int
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchTransactionsBulkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchTransactionsBulkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTransactionsImportResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ImportReport
+	JSON207                   *ImportReport
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTransactionsImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTransactionsImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTransactionsByIdMergeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Transaction
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTransactionsByIdMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTransactionsByIdMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksDeliveriesByIdRedeliverResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *WebhookDelivery
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksDeliveriesByIdRedeliverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksDeliveriesByIdRedeliverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksByIdDeliveriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]WebhookDelivery
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksByIdDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksByIdDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAuditWithResponse request returning *GetAuditResponse
+func (c *ClientWithResponses) GetAuditWithResponse(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*GetAuditResponse, error) {
+	rsp, err := c.GetAudit(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditResponse(rsp)
+}
+
+// GetBalancesAssertionsWithResponse request returning *GetBalancesAssertionsResponse
+func (c *ClientWithResponses) GetBalancesAssertionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBalancesAssertionsResponse, error) {
+	rsp, err := c.GetBalancesAssertions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBalancesAssertionsResponse(rsp)
+}
+
+// PostBalancesAssertionsWithBodyWithResponse request with arbitrary body returning *PostBalancesAssertionsResponse
+func (c *ClientWithResponses) PostBalancesAssertionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBalancesAssertionsResponse, error) {
+	rsp, err := c.PostBalancesAssertionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBalancesAssertionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBalancesAssertionsWithResponse(ctx context.Context, body PostBalancesAssertionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBalancesAssertionsResponse, error) {
+	rsp, err := c.PostBalancesAssertions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBalancesAssertionsResponse(rsp)
+}
+
+// DeleteBalancesAssertionsByIdWithResponse request returning *DeleteBalancesAssertionsByIdResponse
+func (c *ClientWithResponses) DeleteBalancesAssertionsByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteBalancesAssertionsByIdResponse, error) {
+	rsp, err := c.DeleteBalancesAssertionsById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBalancesAssertionsByIdResponse(rsp)
+}
+
+// PutBalancesAssertionsByIdWithBodyWithResponse request with arbitrary body returning *PutBalancesAssertionsByIdResponse
+func (c *ClientWithResponses) PutBalancesAssertionsByIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutBalancesAssertionsByIdResponse, error) {
+	rsp, err := c.PutBalancesAssertionsByIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBalancesAssertionsByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutBalancesAssertionsByIdWithResponse(ctx context.Context, id string, body PutBalancesAssertionsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutBalancesAssertionsByIdResponse, error) {
+	rsp, err := c.PutBalancesAssertionsById(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutBalancesAssertionsByIdResponse(rsp)
+}
+
+// PostBalancesAssertionsByIdCheckWithResponse request returning *PostBalancesAssertionsByIdCheckResponse
+func (c *ClientWithResponses) PostBalancesAssertionsByIdCheckWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostBalancesAssertionsByIdCheckResponse, error) {
+	rsp, err := c.PostBalancesAssertionsByIdCheck(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBalancesAssertionsByIdCheckResponse(rsp)
+}
+
+// GetBalancesAssertionsByIdResultsWithResponse request returning *GetBalancesAssertionsByIdResultsResponse
+func (c *ClientWithResponses) GetBalancesAssertionsByIdResultsWithResponse(ctx context.Context, id string, params *GetBalancesAssertionsByIdResultsParams, reqEditors ...RequestEditorFn) (*GetBalancesAssertionsByIdResultsResponse, error) {
+	rsp, err := c.GetBalancesAssertionsByIdResults(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBalancesAssertionsByIdResultsResponse(rsp)
+}
+
+// GetBalancesDriftWithResponse request returning *GetBalancesDriftResponse
+func (c *ClientWithResponses) GetBalancesDriftWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBalancesDriftResponse, error) {
+	rsp, err := c.GetBalancesDrift(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBalancesDriftResponse(rsp)
+}
+
+// PostBalancesRecalculateWithBodyWithResponse request with arbitrary body returning *PostBalancesRecalculateResponse
+func (c *ClientWithResponses) PostBalancesRecalculateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBalancesRecalculateResponse, error) {
+	rsp, err := c.PostBalancesRecalculateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBalancesRecalculateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBalancesRecalculateWithResponse(ctx context.Context, body PostBalancesRecalculateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBalancesRecalculateResponse, error) {
+	rsp, err := c.PostBalancesRecalculate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBalancesRecalculateResponse(rsp)
+}
+
+// PostBalancesUpdateWithResponse request returning *PostBalancesUpdateResponse
+func (c *ClientWithResponses) PostBalancesUpdateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostBalancesUpdateResponse, error) {
+	rsp, err := c.PostBalancesUpdate(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBalancesUpdateResponse(rsp)
+}
+
+// GetCalendarEventsWithResponse request returning *GetCalendarEventsResponse
+func (c *ClientWithResponses) GetCalendarEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCalendarEventsResponse, error) {
+	rsp, err := c.GetCalendarEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarEventsResponse(rsp)
+}
+
+// DeleteCalendarFeedWithResponse request returning *DeleteCalendarFeedResponse
+func (c *ClientWithResponses) DeleteCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteCalendarFeedResponse, error) {
+	rsp, err := c.DeleteCalendarFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCalendarFeedResponse(rsp)
+}
+
+// PostCalendarFeedWithResponse request returning *PostCalendarFeedResponse
+func (c *ClientWithResponses) PostCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostCalendarFeedResponse, error) {
+	rsp, err := c.PostCalendarFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCalendarFeedResponse(rsp)
+}
+
+// GetCalendarFeedIcsWithResponse request returning *GetCalendarFeedIcsResponse
+func (c *ClientWithResponses) GetCalendarFeedIcsWithResponse(ctx context.Context, params *GetCalendarFeedIcsParams, reqEditors ...RequestEditorFn) (*GetCalendarFeedIcsResponse, error) {
+	rsp, err := c.GetCalendarFeedIcs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarFeedIcsResponse(rsp)
+}
+
+// GetCategorizationWithResponse request returning *GetCategorizationResponse
+func (c *ClientWithResponses) GetCategorizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCategorizationResponse, error) {
+	rsp, err := c.GetCategorization(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCategorizationResponse(rsp)
+}
+
+// GetCategorizationReviewsWithResponse request returning *GetCategorizationReviewsResponse
+func (c *ClientWithResponses) GetCategorizationReviewsWithResponse(ctx context.Context, params *GetCategorizationReviewsParams, reqEditors ...RequestEditorFn) (*GetCategorizationReviewsResponse, error) {
+	rsp, err := c.GetCategorizationReviews(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCategorizationReviewsResponse(rsp)
+}
+
+// PostCategorizationReviewsByIdWithBodyWithResponse request with arbitrary body returning *PostCategorizationReviewsByIdResponse
+func (c *ClientWithResponses) PostCategorizationReviewsByIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCategorizationReviewsByIdResponse, error) {
+	rsp, err := c.PostCategorizationReviewsByIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCategorizationReviewsByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCategorizationReviewsByIdWithResponse(ctx context.Context, id string, body PostCategorizationReviewsByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCategorizationReviewsByIdResponse, error) {
+	rsp, err := c.PostCategorizationReviewsById(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCategorizationReviewsByIdResponse(rsp)
+}
+
+// PostCategorizationSuggestWithBodyWithResponse request with arbitrary body returning *PostCategorizationSuggestResponse
+func (c *ClientWithResponses) PostCategorizationSuggestWithBodyWithResponse(ctx context.Context, params *PostCategorizationSuggestParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCategorizationSuggestResponse, error) {
+	rsp, err := c.PostCategorizationSuggestWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCategorizationSuggestResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCategorizationSuggestWithResponse(ctx context.Context, params *PostCategorizationSuggestParams, body PostCategorizationSuggestJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCategorizationSuggestResponse, error) {
+	rsp, err := c.PostCategorizationSuggest(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCategorizationSuggestResponse(rsp)
+}
+
+// PostCategorizationTrainWithResponse request returning *PostCategorizationTrainResponse
+func (c *ClientWithResponses) PostCategorizationTrainWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostCategorizationTrainResponse, error) {
+	rsp, err := c.PostCategorizationTrain(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCategorizationTrainResponse(rsp)
+}
+
+// GetCostBasisWithResponse request returning *GetCostBasisResponse
+func (c *ClientWithResponses) GetCostBasisWithResponse(ctx context.Context, params *GetCostBasisParams, reqEditors ...RequestEditorFn) (*GetCostBasisResponse, error) {
+	rsp, err := c.GetCostBasis(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCostBasisResponse(rsp)
+}
+
+// GetCostBasisByYearWithResponse request returning *GetCostBasisByYearResponse
+func (c *ClientWithResponses) GetCostBasisByYearWithResponse(ctx context.Context, year string, params *GetCostBasisByYearParams, reqEditors ...RequestEditorFn) (*GetCostBasisByYearResponse, error) {
+	rsp, err := c.GetCostBasisByYear(ctx, year, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCostBasisByYearResponse(rsp)
+}
+
+// GetDocsWithResponse request returning *GetDocsResponse
+func (c *ClientWithResponses) GetDocsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocsResponse, error) {
+	rsp, err := c.GetDocs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDocsResponse(rsp)
+}
+
+// GetExportJobsByIdWithResponse request returning *GetExportJobsByIdResponse
+func (c *ClientWithResponses) GetExportJobsByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetExportJobsByIdResponse, error) {
+	rsp, err := c.GetExportJobsById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExportJobsByIdResponse(rsp)
+}
+
+// GetExportJobsByIdDownloadWithResponse request returning *GetExportJobsByIdDownloadResponse
+func (c *ClientWithResponses) GetExportJobsByIdDownloadWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetExportJobsByIdDownloadResponse, error) {
+	rsp, err := c.GetExportJobsByIdDownload(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExportJobsByIdDownloadResponse(rsp)
+}
+
+// GetExportByDatasetWithResponse request returning *GetExportByDatasetResponse
+func (c *ClientWithResponses) GetExportByDatasetWithResponse(ctx context.Context, dataset string, params *GetExportByDatasetParams, reqEditors ...RequestEditorFn) (*GetExportByDatasetResponse, error) {
+	rsp, err := c.GetExportByDataset(ctx, dataset, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExportByDatasetResponse(rsp)
+}
+
+// DeleteFireflyAccountsByIdWithResponse request returning *DeleteFireflyAccountsByIdResponse
+func (c *ClientWithResponses) DeleteFireflyAccountsByIdWithResponse(ctx context.Context, id string, params *DeleteFireflyAccountsByIdParams, reqEditors ...RequestEditorFn) (*DeleteFireflyAccountsByIdResponse, error) {
+	rsp, err := c.DeleteFireflyAccountsById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFireflyAccountsByIdResponse(rsp)
+}
+
+// PostFireflyAccountsByIdArchiveWithResponse request returning *PostFireflyAccountsByIdArchiveResponse
+func (c *ClientWithResponses) PostFireflyAccountsByIdArchiveWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostFireflyAccountsByIdArchiveResponse, error) {
+	rsp, err := c.PostFireflyAccountsByIdArchive(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFireflyAccountsByIdArchiveResponse(rsp)
+}
+
+// PostFireflyBootstrapWithResponse request returning *PostFireflyBootstrapResponse
+func (c *ClientWithResponses) PostFireflyBootstrapWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostFireflyBootstrapResponse, error) {
+	rsp, err := c.PostFireflyBootstrap(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFireflyBootstrapResponse(rsp)
+}
+
+// PostFireflyLinksWithBodyWithResponse request with arbitrary body returning *PostFireflyLinksResponse
+func (c *ClientWithResponses) PostFireflyLinksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFireflyLinksResponse, error) {
+	rsp, err := c.PostFireflyLinksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFireflyLinksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostFireflyLinksWithResponse(ctx context.Context, body PostFireflyLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFireflyLinksResponse, error) {
+	rsp, err := c.PostFireflyLinks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFireflyLinksResponse(rsp)
+}
+
+// GetFireflyOauthAuthorizeWithResponse request returning *GetFireflyOauthAuthorizeResponse
+func (c *ClientWithResponses) GetFireflyOauthAuthorizeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFireflyOauthAuthorizeResponse, error) {
+	rsp, err := c.GetFireflyOauthAuthorize(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFireflyOauthAuthorizeResponse(rsp)
+}
+
+// GetFireflyOauthCallbackWithResponse request returning *GetFireflyOauthCallbackResponse
+func (c *ClientWithResponses) GetFireflyOauthCallbackWithResponse(ctx context.Context, params *GetFireflyOauthCallbackParams, reqEditors ...RequestEditorFn) (*GetFireflyOauthCallbackResponse, error) {
+	rsp, err := c.GetFireflyOauthCallback(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFireflyOauthCallbackResponse(rsp)
+}
+
+// GetFireflyOutboxWithResponse request returning *GetFireflyOutboxResponse
+func (c *ClientWithResponses) GetFireflyOutboxWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFireflyOutboxResponse, error) {
+	rsp, err := c.GetFireflyOutbox(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFireflyOutboxResponse(rsp)
+}
+
+// PostFireflyOutboxDrainWithResponse request returning *PostFireflyOutboxDrainResponse
+func (c *ClientWithResponses) PostFireflyOutboxDrainWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostFireflyOutboxDrainResponse, error) {
+	rsp, err := c.PostFireflyOutboxDrain(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFireflyOutboxDrainResponse(rsp)
+}
+
+// PostFireflyPullWithResponse request returning *PostFireflyPullResponse
+func (c *ClientWithResponses) PostFireflyPullWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostFireflyPullResponse, error) {
+	rsp, err := c.PostFireflyPull(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFireflyPullResponse(rsp)
+}
+
+// PostGraphqlWithBodyWithResponse request with arbitrary body returning *PostGraphqlResponse
+func (c *ClientWithResponses) PostGraphqlWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGraphqlResponse, error) {
+	rsp, err := c.PostGraphqlWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGraphqlResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostGraphqlWithResponse(ctx context.Context, body PostGraphqlJSONRequestBody, reqEditors ...RequestEditorFn) (*PostGraphqlResponse, error) {
+	rsp, err := c.PostGraphql(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGraphqlResponse(rsp)
+}
+
+// GetImportsBudgetsProfilesWithResponse request returning *GetImportsBudgetsProfilesResponse
+func (c *ClientWithResponses) GetImportsBudgetsProfilesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetImportsBudgetsProfilesResponse, error) {
+	rsp, err := c.GetImportsBudgetsProfiles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetImportsBudgetsProfilesResponse(rsp)
+}
+
+// PostImportsBudgetsByProfileWithBodyWithResponse request with arbitrary body returning *PostImportsBudgetsByProfileResponse
+func (c *ClientWithResponses) PostImportsBudgetsByProfileWithBodyWithResponse(ctx context.Context, profile string, params *PostImportsBudgetsByProfileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostImportsBudgetsByProfileResponse, error) {
+	rsp, err := c.PostImportsBudgetsByProfileWithBody(ctx, profile, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostImportsBudgetsByProfileResponse(rsp)
+}
+
+// PostImportsDescriptionsPreviewWithBodyWithResponse request with arbitrary body returning *PostImportsDescriptionsPreviewResponse
+func (c *ClientWithResponses) PostImportsDescriptionsPreviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostImportsDescriptionsPreviewResponse, error) {
+	rsp, err := c.PostImportsDescriptionsPreviewWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostImportsDescriptionsPreviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostImportsDescriptionsPreviewWithResponse(ctx context.Context, body PostImportsDescriptionsPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostImportsDescriptionsPreviewResponse, error) {
+	rsp, err := c.PostImportsDescriptionsPreview(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostImportsDescriptionsPreviewResponse(rsp)
+}
+
+// GetImportsProfilesWithResponse request returning *GetImportsProfilesResponse
+func (c *ClientWithResponses) GetImportsProfilesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetImportsProfilesResponse, error) {
+	rsp, err := c.GetImportsProfiles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetImportsProfilesResponse(rsp)
+}
+
+// GetImportsReviewsWithResponse request returning *GetImportsReviewsResponse
+func (c *ClientWithResponses) GetImportsReviewsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetImportsReviewsResponse, error) {
+	rsp, err := c.GetImportsReviews(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetImportsReviewsResponse(rsp)
+}
+
+// DeleteImportsReviewsByIdWithResponse request returning *DeleteImportsReviewsByIdResponse
+func (c *ClientWithResponses) DeleteImportsReviewsByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteImportsReviewsByIdResponse, error) {
+	rsp, err := c.DeleteImportsReviewsById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteImportsReviewsByIdResponse(rsp)
+}
+
+// GetImportsReviewsByIdWithResponse request returning *GetImportsReviewsByIdResponse
+func (c *ClientWithResponses) GetImportsReviewsByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetImportsReviewsByIdResponse, error) {
+	rsp, err := c.GetImportsReviewsById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetImportsReviewsByIdResponse(rsp)
+}
+
+// PostImportsReviewsByIdCommitWithResponse request returning *PostImportsReviewsByIdCommitResponse
+func (c *ClientWithResponses) PostImportsReviewsByIdCommitWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostImportsReviewsByIdCommitResponse, error) {
+	rsp, err := c.PostImportsReviewsByIdCommit(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostImportsReviewsByIdCommitResponse(rsp)
+}
+
+// PutImportsReviewsByIdMappingWithBodyWithResponse request with arbitrary body returning *PutImportsReviewsByIdMappingResponse
+func (c *ClientWithResponses) PutImportsReviewsByIdMappingWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutImportsReviewsByIdMappingResponse, error) {
+	rsp, err := c.PutImportsReviewsByIdMappingWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutImportsReviewsByIdMappingResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutImportsReviewsByIdMappingWithResponse(ctx context.Context, id string, body PutImportsReviewsByIdMappingJSONRequestBody, reqEditors ...RequestEditorFn) (*PutImportsReviewsByIdMappingResponse, error) {
+	rsp, err := c.PutImportsReviewsByIdMapping(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutImportsReviewsByIdMappingResponse(rsp)
+}
+
+// PostImportsStatementsWithBodyWithResponse request with arbitrary body returning *PostImportsStatementsResponse
+func (c *ClientWithResponses) PostImportsStatementsWithBodyWithResponse(ctx context.Context, params *PostImportsStatementsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostImportsStatementsResponse, error) {
+	rsp, err := c.PostImportsStatementsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostImportsStatementsResponse(rsp)
+}
+
+// PostImportsByProfileWithBodyWithResponse request with arbitrary body returning *PostImportsByProfileResponse
+func (c *ClientWithResponses) PostImportsByProfileWithBodyWithResponse(ctx context.Context, profile string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostImportsByProfileResponse, error) {
+	rsp, err := c.PostImportsByProfileWithBody(ctx, profile, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostImportsByProfileResponse(rsp)
+}
+
+// GetIncidentsWithResponse request returning *GetIncidentsResponse
+func (c *ClientWithResponses) GetIncidentsWithResponse(ctx context.Context, params *GetIncidentsParams, reqEditors ...RequestEditorFn) (*GetIncidentsResponse, error) {
+	rsp, err := c.GetIncidents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIncidentsResponse(rsp)
+}
+
+// DeleteMaintenanceWithResponse request returning *DeleteMaintenanceResponse
+func (c *ClientWithResponses) DeleteMaintenanceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteMaintenanceResponse, error) {
+	rsp, err := c.DeleteMaintenance(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMaintenanceResponse(rsp)
+}
+
+// GetMaintenanceWithResponse request returning *GetMaintenanceResponse
+func (c *ClientWithResponses) GetMaintenanceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMaintenanceResponse, error) {
+	rsp, err := c.GetMaintenance(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMaintenanceResponse(rsp)
+}
+
+// PutMaintenanceWithBodyWithResponse request with arbitrary body returning *PutMaintenanceResponse
+func (c *ClientWithResponses) PutMaintenanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMaintenanceResponse, error) {
+	rsp, err := c.PutMaintenanceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMaintenanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutMaintenanceWithResponse(ctx context.Context, body PutMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMaintenanceResponse, error) {
+	rsp, err := c.PutMaintenance(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMaintenanceResponse(rsp)
+}
+
+// GetMetricsWithResponse request returning *GetMetricsResponse
+func (c *ClientWithResponses) GetMetricsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMetricsResponse, error) {
+	rsp, err := c.GetMetrics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMetricsResponse(rsp)
+}
+
+// GetNetworthWithResponse request returning *GetNetworthResponse
+func (c *ClientWithResponses) GetNetworthWithResponse(ctx context.Context, params *GetNetworthParams, reqEditors ...RequestEditorFn) (*GetNetworthResponse, error) {
+	rsp, err := c.GetNetworth(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNetworthResponse(rsp)
+}
+
+// GetOpenapiJsonWithResponse request returning *GetOpenapiJsonResponse
+func (c *ClientWithResponses) GetOpenapiJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenapiJsonResponse, error) {
+	rsp, err := c.GetOpenapiJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenapiJsonResponse(rsp)
+}
+
+// GetPeriodsWithResponse request returning *GetPeriodsResponse
+func (c *ClientWithResponses) GetPeriodsWithResponse(ctx context.Context, params *GetPeriodsParams, reqEditors ...RequestEditorFn) (*GetPeriodsResponse, error) {
+	rsp, err := c.GetPeriods(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPeriodsResponse(rsp)
+}
+
+// GetPeriodsByNameWithResponse request returning *GetPeriodsByNameResponse
+func (c *ClientWithResponses) GetPeriodsByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetPeriodsByNameResponse, error) {
+	rsp, err := c.GetPeriodsByName(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPeriodsByNameResponse(rsp)
+}
+
+// GetPortfolioWithResponse request returning *GetPortfolioResponse
+func (c *ClientWithResponses) GetPortfolioWithResponse(ctx context.Context, params *GetPortfolioParams, reqEditors ...RequestEditorFn) (*GetPortfolioResponse, error) {
+	rsp, err := c.GetPortfolio(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPortfolioResponse(rsp)
+}
+
+// GetPreferencesWithResponse request returning *GetPreferencesResponse
+func (c *ClientWithResponses) GetPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPreferencesResponse, error) {
+	rsp, err := c.GetPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPreferencesResponse(rsp)
+}
+
+// PutPreferencesWithBodyWithResponse request with arbitrary body returning *PutPreferencesResponse
+func (c *ClientWithResponses) PutPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPreferencesResponse, error) {
+	rsp, err := c.PutPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutPreferencesWithResponse(ctx context.Context, body PutPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutPreferencesResponse, error) {
+	rsp, err := c.PutPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPreferencesResponse(rsp)
+}
+
+// PostRulesApplyWithBodyWithResponse request with arbitrary body returning *PostRulesApplyResponse
+func (c *ClientWithResponses) PostRulesApplyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRulesApplyResponse, error) {
+	rsp, err := c.PostRulesApplyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRulesApplyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRulesApplyWithResponse(ctx context.Context, body PostRulesApplyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRulesApplyResponse, error) {
+	rsp, err := c.PostRulesApply(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRulesApplyResponse(rsp)
+}
+
+// PostRulesTestWithBodyWithResponse request with arbitrary body returning *PostRulesTestResponse
+func (c *ClientWithResponses) PostRulesTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRulesTestResponse, error) {
+	rsp, err := c.PostRulesTestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRulesTestResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRulesTestWithResponse(ctx context.Context, body PostRulesTestJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRulesTestResponse, error) {
+	rsp, err := c.PostRulesTest(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRulesTestResponse(rsp)
+}
+
+// GetSourcesWithResponse request returning *GetSourcesResponse
+func (c *ClientWithResponses) GetSourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesResponse, error) {
+	rsp, err := c.GetSources(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSourcesResponse(rsp)
+}
+
+// GetSourcesBackfillsWithResponse request returning *GetSourcesBackfillsResponse
+func (c *ClientWithResponses) GetSourcesBackfillsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesBackfillsResponse, error) {
+	rsp, err := c.GetSourcesBackfills(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSourcesBackfillsResponse(rsp)
+}
+
+// GetSourcesConsentCallbackWithResponse request returning *GetSourcesConsentCallbackResponse
+func (c *ClientWithResponses) GetSourcesConsentCallbackWithResponse(ctx context.Context, params *GetSourcesConsentCallbackParams, reqEditors ...RequestEditorFn) (*GetSourcesConsentCallbackResponse, error) {
+	rsp, err := c.GetSourcesConsentCallback(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSourcesConsentCallbackResponse(rsp)
+}
+
+// GetSourcesConsentsWithResponse request returning *GetSourcesConsentsResponse
+func (c *ClientWithResponses) GetSourcesConsentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesConsentsResponse, error) {
+	rsp, err := c.GetSourcesConsents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSourcesConsentsResponse(rsp)
+}
+
+// GetSourcesCyclesWithResponse request returning *GetSourcesCyclesResponse
+func (c *ClientWithResponses) GetSourcesCyclesWithResponse(ctx context.Context, params *GetSourcesCyclesParams, reqEditors ...RequestEditorFn) (*GetSourcesCyclesResponse, error) {
+	rsp, err := c.GetSourcesCycles(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSourcesCyclesResponse(rsp)
+}
+
+// GetSourcesQueueWithResponse request returning *GetSourcesQueueResponse
+func (c *ClientWithResponses) GetSourcesQueueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesQueueResponse, error) {
+	rsp, err := c.GetSourcesQueue(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSourcesQueueResponse(rsp)
+}
+
+// GetSourcesSchedulerWithResponse request returning *GetSourcesSchedulerResponse
+func (c *ClientWithResponses) GetSourcesSchedulerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesSchedulerResponse, error) {
+	rsp, err := c.GetSourcesScheduler(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSourcesSchedulerResponse(rsp)
+}
+
+// GetSourcesStatusWithResponse request returning *GetSourcesStatusResponse
+func (c *ClientWithResponses) GetSourcesStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSourcesStatusResponse, error) {
+	rsp, err := c.GetSourcesStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSourcesStatusResponse(rsp)
+}
+
+// PostSourcesSyncWithResponse request returning *PostSourcesSyncResponse
+func (c *ClientWithResponses) PostSourcesSyncWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostSourcesSyncResponse, error) {
+	rsp, err := c.PostSourcesSync(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSourcesSyncResponse(rsp)
+}
+
+// GetSourcesByIdBackfillWithResponse request returning *GetSourcesByIdBackfillResponse
+func (c *ClientWithResponses) GetSourcesByIdBackfillWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetSourcesByIdBackfillResponse, error) {
+	rsp, err := c.GetSourcesByIdBackfill(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSourcesByIdBackfillResponse(rsp)
+}
+
+// PostSourcesByIdBackfillWithResponse request returning *PostSourcesByIdBackfillResponse
+func (c *ClientWithResponses) PostSourcesByIdBackfillWithResponse(ctx context.Context, id string, params *PostSourcesByIdBackfillParams, reqEditors ...RequestEditorFn) (*PostSourcesByIdBackfillResponse, error) {
+	rsp, err := c.PostSourcesByIdBackfill(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSourcesByIdBackfillResponse(rsp)
+}
+
+// PostSourcesByIdConsentRenewWithResponse request returning *PostSourcesByIdConsentRenewResponse
+func (c *ClientWithResponses) PostSourcesByIdConsentRenewWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostSourcesByIdConsentRenewResponse, error) {
+	rsp, err := c.PostSourcesByIdConsentRenew(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSourcesByIdConsentRenewResponse(rsp)
+}
+
+// PutSourcesByIdLifecycleWithBodyWithResponse request with arbitrary body returning *PutSourcesByIdLifecycleResponse
+func (c *ClientWithResponses) PutSourcesByIdLifecycleWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSourcesByIdLifecycleResponse, error) {
+	rsp, err := c.PutSourcesByIdLifecycleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSourcesByIdLifecycleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutSourcesByIdLifecycleWithResponse(ctx context.Context, id string, body PutSourcesByIdLifecycleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSourcesByIdLifecycleResponse, error) {
+	rsp, err := c.PutSourcesByIdLifecycle(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSourcesByIdLifecycleResponse(rsp)
+}
+
+// PostSourcesByIdPauseWithResponse request returning *PostSourcesByIdPauseResponse
+func (c *ClientWithResponses) PostSourcesByIdPauseWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostSourcesByIdPauseResponse, error) {
+	rsp, err := c.PostSourcesByIdPause(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSourcesByIdPauseResponse(rsp)
+}
+
+// PostSourcesByIdResumeWithResponse request returning *PostSourcesByIdResumeResponse
+func (c *ClientWithResponses) PostSourcesByIdResumeWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostSourcesByIdResumeResponse, error) {
+	rsp, err := c.PostSourcesByIdResume(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSourcesByIdResumeResponse(rsp)
+}
+
+// PostSourcesByIdSyncWithResponse request returning *PostSourcesByIdSyncResponse
+func (c *ClientWithResponses) PostSourcesByIdSyncWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostSourcesByIdSyncResponse, error) {
+	rsp, err := c.PostSourcesByIdSync(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSourcesByIdSyncResponse(rsp)
+}
+
+// PostSourcesByIdTestWithResponse request returning *PostSourcesByIdTestResponse
+func (c *ClientWithResponses) PostSourcesByIdTestWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostSourcesByIdTestResponse, error) {
+	rsp, err := c.PostSourcesByIdTest(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSourcesByIdTestResponse(rsp)
+}
+
+// GetSpacesWithResponse request returning *GetSpacesResponse
+func (c *ClientWithResponses) GetSpacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSpacesResponse, error) {
+	rsp, err := c.GetSpaces(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSpacesResponse(rsp)
+}
+
+// PostSpacesWithBodyWithResponse request with arbitrary body returning *PostSpacesResponse
+func (c *ClientWithResponses) PostSpacesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSpacesResponse, error) {
+	rsp, err := c.PostSpacesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSpacesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSpacesWithResponse(ctx context.Context, body PostSpacesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSpacesResponse, error) {
+	rsp, err := c.PostSpaces(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSpacesResponse(rsp)
+}
+
+// GetSpacesBySpaceCategoriesWithResponse request returning *GetSpacesBySpaceCategoriesResponse
+func (c *ClientWithResponses) GetSpacesBySpaceCategoriesWithResponse(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*GetSpacesBySpaceCategoriesResponse, error) {
+	rsp, err := c.GetSpacesBySpaceCategories(ctx, space, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSpacesBySpaceCategoriesResponse(rsp)
+}
+
+// PostSpacesBySpaceCategoriesByCategoryWithResponse request returning *PostSpacesBySpaceCategoriesByCategoryResponse
+func (c *ClientWithResponses) PostSpacesBySpaceCategoriesByCategoryWithResponse(ctx context.Context, space string, category string, reqEditors ...RequestEditorFn) (*PostSpacesBySpaceCategoriesByCategoryResponse, error) {
+	rsp, err := c.PostSpacesBySpaceCategoriesByCategory(ctx, space, category, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSpacesBySpaceCategoriesByCategoryResponse(rsp)
+}
+
+// DeleteSpacesBySpaceMaintenanceWithResponse request returning *DeleteSpacesBySpaceMaintenanceResponse
+func (c *ClientWithResponses) DeleteSpacesBySpaceMaintenanceWithResponse(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*DeleteSpacesBySpaceMaintenanceResponse, error) {
+	rsp, err := c.DeleteSpacesBySpaceMaintenance(ctx, space, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSpacesBySpaceMaintenanceResponse(rsp)
+}
+
+// PutSpacesBySpaceMaintenanceWithBodyWithResponse request with arbitrary body returning *PutSpacesBySpaceMaintenanceResponse
+func (c *ClientWithResponses) PutSpacesBySpaceMaintenanceWithBodyWithResponse(ctx context.Context, space string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSpacesBySpaceMaintenanceResponse, error) {
+	rsp, err := c.PutSpacesBySpaceMaintenanceWithBody(ctx, space, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSpacesBySpaceMaintenanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutSpacesBySpaceMaintenanceWithResponse(ctx context.Context, space string, body PutSpacesBySpaceMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSpacesBySpaceMaintenanceResponse, error) {
+	rsp, err := c.PutSpacesBySpaceMaintenance(ctx, space, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSpacesBySpaceMaintenanceResponse(rsp)
+}
+
+// GetSpacesBySpaceMembersWithResponse request returning *GetSpacesBySpaceMembersResponse
+func (c *ClientWithResponses) GetSpacesBySpaceMembersWithResponse(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*GetSpacesBySpaceMembersResponse, error) {
+	rsp, err := c.GetSpacesBySpaceMembers(ctx, space, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSpacesBySpaceMembersResponse(rsp)
+}
+
+// DeleteSpacesBySpaceMembersByUserWithResponse request returning *DeleteSpacesBySpaceMembersByUserResponse
+func (c *ClientWithResponses) DeleteSpacesBySpaceMembersByUserWithResponse(ctx context.Context, space string, user string, reqEditors ...RequestEditorFn) (*DeleteSpacesBySpaceMembersByUserResponse, error) {
+	rsp, err := c.DeleteSpacesBySpaceMembersByUser(ctx, space, user, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSpacesBySpaceMembersByUserResponse(rsp)
+}
+
+// PutSpacesBySpaceMembersByUserWithBodyWithResponse request with arbitrary body returning *PutSpacesBySpaceMembersByUserResponse
+func (c *ClientWithResponses) PutSpacesBySpaceMembersByUserWithBodyWithResponse(ctx context.Context, space string, user string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSpacesBySpaceMembersByUserResponse, error) {
+	rsp, err := c.PutSpacesBySpaceMembersByUserWithBody(ctx, space, user, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSpacesBySpaceMembersByUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutSpacesBySpaceMembersByUserWithResponse(ctx context.Context, space string, user string, body PutSpacesBySpaceMembersByUserJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSpacesBySpaceMembersByUserResponse, error) {
+	rsp, err := c.PutSpacesBySpaceMembersByUser(ctx, space, user, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSpacesBySpaceMembersByUserResponse(rsp)
+}
+
+// GetSpacesBySpaceTransactionsWithResponse request returning *GetSpacesBySpaceTransactionsResponse
+func (c *ClientWithResponses) GetSpacesBySpaceTransactionsWithResponse(ctx context.Context, space string, params *GetSpacesBySpaceTransactionsParams, reqEditors ...RequestEditorFn) (*GetSpacesBySpaceTransactionsResponse, error) {
+	rsp, err := c.GetSpacesBySpaceTransactions(ctx, space, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSpacesBySpaceTransactionsResponse(rsp)
+}
+
+// GetSpacesBySpaceWalletsWithResponse request returning *GetSpacesBySpaceWalletsResponse
+func (c *ClientWithResponses) GetSpacesBySpaceWalletsWithResponse(ctx context.Context, space string, reqEditors ...RequestEditorFn) (*GetSpacesBySpaceWalletsResponse, error) {
+	rsp, err := c.GetSpacesBySpaceWallets(ctx, space, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSpacesBySpaceWalletsResponse(rsp)
+}
+
+// DeleteSpacesBySpaceWalletsByWalletWithResponse request returning *DeleteSpacesBySpaceWalletsByWalletResponse
+func (c *ClientWithResponses) DeleteSpacesBySpaceWalletsByWalletWithResponse(ctx context.Context, space string, wallet string, reqEditors ...RequestEditorFn) (*DeleteSpacesBySpaceWalletsByWalletResponse, error) {
+	rsp, err := c.DeleteSpacesBySpaceWalletsByWallet(ctx, space, wallet, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSpacesBySpaceWalletsByWalletResponse(rsp)
+}
+
+// PostSpacesBySpaceWalletsByWalletWithResponse request returning *PostSpacesBySpaceWalletsByWalletResponse
+func (c *ClientWithResponses) PostSpacesBySpaceWalletsByWalletWithResponse(ctx context.Context, space string, wallet string, reqEditors ...RequestEditorFn) (*PostSpacesBySpaceWalletsByWalletResponse, error) {
+	rsp, err := c.PostSpacesBySpaceWalletsByWallet(ctx, space, wallet, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSpacesBySpaceWalletsByWalletResponse(rsp)
+}
+
+// GetStreamsWithResponse request returning *GetStreamsResponse
+func (c *ClientWithResponses) GetStreamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStreamsResponse, error) {
+	rsp, err := c.GetStreams(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStreamsResponse(rsp)
+}
+
+// PostStreamsByStreamCompactWithBodyWithResponse request with arbitrary body returning *PostStreamsByStreamCompactResponse
+func (c *ClientWithResponses) PostStreamsByStreamCompactWithBodyWithResponse(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStreamsByStreamCompactResponse, error) {
+	rsp, err := c.PostStreamsByStreamCompactWithBody(ctx, stream, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStreamsByStreamCompactResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostStreamsByStreamCompactWithResponse(ctx context.Context, stream string, body PostStreamsByStreamCompactJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStreamsByStreamCompactResponse, error) {
+	rsp, err := c.PostStreamsByStreamCompact(ctx, stream, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStreamsByStreamCompactResponse(rsp)
+}
+
+// PostStreamsByStreamPurgeWithBodyWithResponse request with arbitrary body returning *PostStreamsByStreamPurgeResponse
+func (c *ClientWithResponses) PostStreamsByStreamPurgeWithBodyWithResponse(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStreamsByStreamPurgeResponse, error) {
+	rsp, err := c.PostStreamsByStreamPurgeWithBody(ctx, stream, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStreamsByStreamPurgeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostStreamsByStreamPurgeWithResponse(ctx context.Context, stream string, body PostStreamsByStreamPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStreamsByStreamPurgeResponse, error) {
+	rsp, err := c.PostStreamsByStreamPurge(ctx, stream, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStreamsByStreamPurgeResponse(rsp)
+}
+
+// PutStreamsByStreamRetentionWithBodyWithResponse request with arbitrary body returning *PutStreamsByStreamRetentionResponse
+func (c *ClientWithResponses) PutStreamsByStreamRetentionWithBodyWithResponse(ctx context.Context, stream string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutStreamsByStreamRetentionResponse, error) {
+	rsp, err := c.PutStreamsByStreamRetentionWithBody(ctx, stream, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutStreamsByStreamRetentionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutStreamsByStreamRetentionWithResponse(ctx context.Context, stream string, body PutStreamsByStreamRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutStreamsByStreamRetentionResponse, error) {
+	rsp, err := c.PutStreamsByStreamRetention(ctx, stream, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutStreamsByStreamRetentionResponse(rsp)
+}
+
+// GetSubscriptionsWithResponse request returning *GetSubscriptionsResponse
+func (c *ClientWithResponses) GetSubscriptionsWithResponse(ctx context.Context, params *GetSubscriptionsParams, reqEditors ...RequestEditorFn) (*GetSubscriptionsResponse, error) {
+	rsp, err := c.GetSubscriptions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubscriptionsResponse(rsp)
+}
+
+// PostSubscriptionsDetectWithResponse request returning *PostSubscriptionsDetectResponse
+func (c *ClientWithResponses) PostSubscriptionsDetectWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostSubscriptionsDetectResponse, error) {
+	rsp, err := c.PostSubscriptionsDetect(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSubscriptionsDetectResponse(rsp)
+}
+
+// GetTagsSpendWithResponse request returning *GetTagsSpendResponse
+func (c *ClientWithResponses) GetTagsSpendWithResponse(ctx context.Context, params *GetTagsSpendParams, reqEditors ...RequestEditorFn) (*GetTagsSpendResponse, error) {
+	rsp, err := c.GetTagsSpend(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTagsSpendResponse(rsp)
+}
+
+// DeleteTagsByIdWithResponse request returning *DeleteTagsByIdResponse
+func (c *ClientWithResponses) DeleteTagsByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteTagsByIdResponse, error) {
+	rsp, err := c.DeleteTagsById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTagsByIdResponse(rsp)
+}
+
+// PostTagsByIdMergeWithBodyWithResponse request with arbitrary body returning *PostTagsByIdMergeResponse
+func (c *ClientWithResponses) PostTagsByIdMergeWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTagsByIdMergeResponse, error) {
+	rsp, err := c.PostTagsByIdMergeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTagsByIdMergeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTagsByIdMergeWithResponse(ctx context.Context, id string, body PostTagsByIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTagsByIdMergeResponse, error) {
+	rsp, err := c.PostTagsByIdMerge(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTagsByIdMergeResponse(rsp)
+}
+
+// PostTagsByIdRenameWithBodyWithResponse request with arbitrary body returning *PostTagsByIdRenameResponse
+func (c *ClientWithResponses) PostTagsByIdRenameWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTagsByIdRenameResponse, error) {
+	rsp, err := c.PostTagsByIdRenameWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTagsByIdRenameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTagsByIdRenameWithResponse(ctx context.Context, id string, body PostTagsByIdRenameJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTagsByIdRenameResponse, error) {
+	rsp, err := c.PostTagsByIdRename(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTagsByIdRenameResponse(rsp)
+}
+
+// GetTransactionsWithResponse request returning *GetTransactionsResponse
+func (c *ClientWithResponses) GetTransactionsWithResponse(ctx context.Context, params *GetTransactionsParams, reqEditors ...RequestEditorFn) (*GetTransactionsResponse, error) {
+	rsp, err := c.GetTransactions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionsResponse(rsp)
+}
+
+// PatchTransactionsBulkWithBodyWithResponse request with arbitrary body returning *PatchTransactionsBulkResponse
+func (c *ClientWithResponses) PatchTransactionsBulkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTransactionsBulkResponse, error) {
+	rsp, err := c.PatchTransactionsBulkWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchTransactionsBulkResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchTransactionsBulkWithResponse(ctx context.Context, body PatchTransactionsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTransactionsBulkResponse, error) {
+	rsp, err := c.PatchTransactionsBulk(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchTransactionsBulkResponse(rsp)
+}
+
+// PostTransactionsImportWithBodyWithResponse request with arbitrary body returning *PostTransactionsImportResponse
+func (c *ClientWithResponses) PostTransactionsImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTransactionsImportResponse, error) {
+	rsp, err := c.PostTransactionsImportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTransactionsImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTransactionsImportWithResponse(ctx context.Context, body PostTransactionsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTransactionsImportResponse, error) {
+	rsp, err := c.PostTransactionsImport(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTransactionsImportResponse(rsp)
+}
+
+// PostTransactionsByIdMergeWithBodyWithResponse request with arbitrary body returning *PostTransactionsByIdMergeResponse
+func (c *ClientWithResponses) PostTransactionsByIdMergeWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTransactionsByIdMergeResponse, error) {
+	rsp, err := c.PostTransactionsByIdMergeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTransactionsByIdMergeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTransactionsByIdMergeWithResponse(ctx context.Context, id string, body PostTransactionsByIdMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTransactionsByIdMergeResponse, error) {
+	rsp, err := c.PostTransactionsByIdMerge(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTransactionsByIdMergeResponse(rsp)
+}
+
+// PostWebhooksDeliveriesByIdRedeliverWithResponse request returning *PostWebhooksDeliveriesByIdRedeliverResponse
+func (c *ClientWithResponses) PostWebhooksDeliveriesByIdRedeliverWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostWebhooksDeliveriesByIdRedeliverResponse, error) {
+	rsp, err := c.PostWebhooksDeliveriesByIdRedeliver(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksDeliveriesByIdRedeliverResponse(rsp)
+}
+
+// GetWebhooksByIdDeliveriesWithResponse request returning *GetWebhooksByIdDeliveriesResponse
+func (c *ClientWithResponses) GetWebhooksByIdDeliveriesWithResponse(ctx context.Context, id string, params *GetWebhooksByIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhooksByIdDeliveriesResponse, error) {
+	rsp, err := c.GetWebhooksByIdDeliveries(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksByIdDeliveriesResponse(rsp)
+}
+
+// ParseGetAuditResponse parses an HTTP response from a GetAuditWithResponse call
+func ParseGetAuditResponse(rsp *http.Response) (*GetAuditResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuditEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBalancesAssertionsResponse parses an HTTP response from a GetBalancesAssertionsWithResponse call
+func ParseGetBalancesAssertionsResponse(rsp *http.Response) (*GetBalancesAssertionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBalancesAssertionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BalanceAssertion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBalancesAssertionsResponse parses an HTTP response from a PostBalancesAssertionsWithResponse call
+func ParsePostBalancesAssertionsResponse(rsp *http.Response) (*PostBalancesAssertionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBalancesAssertionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BalanceAssertion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBalancesAssertionsByIdResponse parses an HTTP response from a DeleteBalancesAssertionsByIdWithResponse call
+func ParseDeleteBalancesAssertionsByIdResponse(rsp *http.Response) (*DeleteBalancesAssertionsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBalancesAssertionsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutBalancesAssertionsByIdResponse parses an HTTP response from a PutBalancesAssertionsByIdWithResponse call
+func ParsePutBalancesAssertionsByIdResponse(rsp *http.Response) (*PutBalancesAssertionsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutBalancesAssertionsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BalanceAssertion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBalancesAssertionsByIdCheckResponse parses an HTTP response from a PostBalancesAssertionsByIdCheckWithResponse call
+func ParsePostBalancesAssertionsByIdCheckResponse(rsp *http.Response) (*PostBalancesAssertionsByIdCheckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBalancesAssertionsByIdCheckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BalanceAssertionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBalancesAssertionsByIdResultsResponse parses an HTTP response from a GetBalancesAssertionsByIdResultsWithResponse call
+func ParseGetBalancesAssertionsByIdResultsResponse(rsp *http.Response) (*GetBalancesAssertionsByIdResultsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBalancesAssertionsByIdResultsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BalanceAssertionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBalancesDriftResponse parses an HTTP response from a GetBalancesDriftWithResponse call
+func ParseGetBalancesDriftResponse(rsp *http.Response) (*GetBalancesDriftResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBalancesDriftResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BalanceUpdateReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBalancesRecalculateResponse parses an HTTP response from a PostBalancesRecalculateWithResponse call
+func ParsePostBalancesRecalculateResponse(rsp *http.Response) (*PostBalancesRecalculateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBalancesRecalculateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BalanceRecalculationReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBalancesUpdateResponse parses an HTTP response from a PostBalancesUpdateWithResponse call
+func ParsePostBalancesUpdateResponse(rsp *http.Response) (*PostBalancesUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBalancesUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BalanceUpdateReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarEventsResponse parses an HTTP response from a GetCalendarEventsWithResponse call
+func ParseGetCalendarEventsResponse(rsp *http.Response) (*GetCalendarEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CalendarEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCalendarFeedResponse parses an HTTP response from a DeleteCalendarFeedWithResponse call
+func ParseDeleteCalendarFeedResponse(rsp *http.Response) (*DeleteCalendarFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCalendarFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCalendarFeedResponse parses an HTTP response from a PostCalendarFeedWithResponse call
+func ParsePostCalendarFeedResponse(rsp *http.Response) (*PostCalendarFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCalendarFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest map[string]string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarFeedIcsResponse parses an HTTP response from a GetCalendarFeedIcsWithResponse call
+func ParseGetCalendarFeedIcsResponse(rsp *http.Response) (*GetCalendarFeedIcsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarFeedIcsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCategorizationResponse parses an HTTP response from a GetCategorizationWithResponse call
+func ParseGetCategorizationResponse(rsp *http.Response) (*GetCategorizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCategorizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CategorizationStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCategorizationReviewsResponse parses an HTTP response from a GetCategorizationReviewsWithResponse call
+func ParseGetCategorizationReviewsResponse(rsp *http.Response) (*GetCategorizationReviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCategorizationReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CategoryReview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCategorizationReviewsByIdResponse parses an HTTP response from a PostCategorizationReviewsByIdWithResponse call
+func ParsePostCategorizationReviewsByIdResponse(rsp *http.Response) (*PostCategorizationReviewsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCategorizationReviewsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Transaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCategorizationSuggestResponse parses an HTTP response from a PostCategorizationSuggestWithResponse call
+func ParsePostCategorizationSuggestResponse(rsp *http.Response) (*PostCategorizationSuggestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCategorizationSuggestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CategorySuggestion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCategorizationTrainResponse parses an HTTP response from a PostCategorizationTrainWithResponse call
+func ParsePostCategorizationTrainResponse(rsp *http.Response) (*PostCategorizationTrainResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCategorizationTrainResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CategorizationStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCostBasisResponse parses an HTTP response from a GetCostBasisWithResponse call
+func ParseGetCostBasisResponse(rsp *http.Response) (*GetCostBasisResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCostBasisResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CostBasisReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCostBasisByYearResponse parses an HTTP response from a GetCostBasisByYearWithResponse call
+func ParseGetCostBasisByYearResponse(rsp *http.Response) (*GetCostBasisByYearResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCostBasisByYearResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest YearGains
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDocsResponse parses an HTTP response from a GetDocsWithResponse call
+func ParseGetDocsResponse(rsp *http.Response) (*GetDocsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDocsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetExportJobsByIdResponse parses an HTTP response from a GetExportJobsByIdWithResponse call
+func ParseGetExportJobsByIdResponse(rsp *http.Response) (*GetExportJobsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetExportJobsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExportJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetExportJobsByIdDownloadResponse parses an HTTP response from a GetExportJobsByIdDownloadWithResponse call
+func ParseGetExportJobsByIdDownloadResponse(rsp *http.Response) (*GetExportJobsByIdDownloadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetExportJobsByIdDownloadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetExportByDatasetResponse parses an HTTP response from a GetExportByDatasetWithResponse call
+func ParseGetExportByDatasetResponse(rsp *http.Response) (*GetExportByDatasetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetExportByDatasetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ExportJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteFireflyAccountsByIdResponse parses an HTTP response from a DeleteFireflyAccountsByIdWithResponse call
+func ParseDeleteFireflyAccountsByIdResponse(rsp *http.Response) (*DeleteFireflyAccountsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteFireflyAccountsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountRemoval
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFireflyAccountsByIdArchiveResponse parses an HTTP response from a PostFireflyAccountsByIdArchiveWithResponse call
+func ParsePostFireflyAccountsByIdArchiveResponse(rsp *http.Response) (*PostFireflyAccountsByIdArchiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFireflyAccountsByIdArchiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountRemoval
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFireflyBootstrapResponse parses an HTTP response from a PostFireflyBootstrapWithResponse call
+func ParsePostFireflyBootstrapResponse(rsp *http.Response) (*PostFireflyBootstrapResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFireflyBootstrapResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BootstrapReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest BootstrapReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFireflyLinksResponse parses an HTTP response from a PostFireflyLinksWithResponse call
+func ParsePostFireflyLinksResponse(rsp *http.Response) (*PostFireflyLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFireflyLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FireflyLink
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFireflyOauthAuthorizeResponse parses an HTTP response from a GetFireflyOauthAuthorizeWithResponse call
+func ParseGetFireflyOauthAuthorizeResponse(rsp *http.Response) (*GetFireflyOauthAuthorizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFireflyOauthAuthorizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFireflyOauthCallbackResponse parses an HTTP response from a GetFireflyOauthCallbackWithResponse call
+func ParseGetFireflyOauthCallbackResponse(rsp *http.Response) (*GetFireflyOauthCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFireflyOauthCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFireflyOutboxResponse parses an HTTP response from a GetFireflyOutboxWithResponse call
+func ParseGetFireflyOutboxResponse(rsp *http.Response) (*GetFireflyOutboxResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFireflyOutboxResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FireflyOutboxStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFireflyOutboxDrainResponse parses an HTTP response from a PostFireflyOutboxDrainWithResponse call
+func ParsePostFireflyOutboxDrainResponse(rsp *http.Response) (*PostFireflyOutboxDrainResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFireflyOutboxDrainResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FireflyOutboxReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFireflyPullResponse parses an HTTP response from a PostFireflyPullWithResponse call
+func ParsePostFireflyPullResponse(rsp *http.Response) (*PostFireflyPullResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFireflyPullResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FireflyPullReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostGraphqlResponse parses an HTTP response from a PostGraphqlWithResponse call
+func ParsePostGraphqlResponse(rsp *http.Response) (*PostGraphqlResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostGraphqlResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Response
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetImportsBudgetsProfilesResponse parses an HTTP response from a GetImportsBudgetsProfilesWithResponse call
+func ParseGetImportsBudgetsProfilesResponse(rsp *http.Response) (*GetImportsBudgetsProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetImportsBudgetsProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ExchangeProfile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostImportsBudgetsByProfileResponse parses an HTTP response from a PostImportsBudgetsByProfileWithResponse call
+func ParsePostImportsBudgetsByProfileResponse(rsp *http.Response) (*PostImportsBudgetsByProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostImportsBudgetsByProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BudgetImport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostImportsDescriptionsPreviewResponse parses an HTTP response from a PostImportsDescriptionsPreviewWithResponse call
+func ParsePostImportsDescriptionsPreviewResponse(rsp *http.Response) (*PostImportsDescriptionsPreviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostImportsDescriptionsPreviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DescriptionPreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetImportsProfilesResponse parses an HTTP response from a GetImportsProfilesWithResponse call
+func ParseGetImportsProfilesResponse(rsp *http.Response) (*GetImportsProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetImportsProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ExchangeProfile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetImportsReviewsResponse parses an HTTP response from a GetImportsReviewsWithResponse call
+func ParseGetImportsReviewsResponse(rsp *http.Response) (*GetImportsReviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetImportsReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BudgetImport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteImportsReviewsByIdResponse parses an HTTP response from a DeleteImportsReviewsByIdWithResponse call
+func ParseDeleteImportsReviewsByIdResponse(rsp *http.Response) (*DeleteImportsReviewsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteImportsReviewsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BudgetImport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetImportsReviewsByIdResponse parses an HTTP response from a GetImportsReviewsByIdWithResponse call
+func ParseGetImportsReviewsByIdResponse(rsp *http.Response) (*GetImportsReviewsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetImportsReviewsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BudgetImport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostImportsReviewsByIdCommitResponse parses an HTTP response from a PostImportsReviewsByIdCommitWithResponse call
+func ParsePostImportsReviewsByIdCommitResponse(rsp *http.Response) (*PostImportsReviewsByIdCommitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostImportsReviewsByIdCommitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BudgetImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest BudgetImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutImportsReviewsByIdMappingResponse parses an HTTP response from a PutImportsReviewsByIdMappingWithResponse call
+func ParsePutImportsReviewsByIdMappingResponse(rsp *http.Response) (*PutImportsReviewsByIdMappingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutImportsReviewsByIdMappingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BudgetImport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostImportsStatementsResponse parses an HTTP response from a PostImportsStatementsWithResponse call
+func ParsePostImportsStatementsResponse(rsp *http.Response) (*PostImportsStatementsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostImportsStatementsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StatementImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest StatementImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostImportsByProfileResponse parses an HTTP response from a PostImportsByProfileWithResponse call
+func ParsePostImportsByProfileResponse(rsp *http.Response) (*PostImportsByProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostImportsByProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExchangeImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest ExchangeImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIncidentsResponse parses an HTTP response from a GetIncidentsWithResponse call
+func ParseGetIncidentsResponse(rsp *http.Response) (*GetIncidentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIncidentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Incident
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMaintenanceResponse parses an HTTP response from a DeleteMaintenanceWithResponse call
+func ParseDeleteMaintenanceResponse(rsp *http.Response) (*DeleteMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMaintenanceResponse parses an HTTP response from a GetMaintenanceWithResponse call
+func ParseGetMaintenanceResponse(rsp *http.Response) (*GetMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MaintenanceState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutMaintenanceResponse parses an HTTP response from a PutMaintenanceWithResponse call
+func ParsePutMaintenanceResponse(rsp *http.Response) (*PutMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Maintenance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMetricsResponse parses an HTTP response from a GetMetricsWithResponse call
+func ParseGetMetricsResponse(rsp *http.Response) (*GetMetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetNetworthResponse parses an HTTP response from a GetNetworthWithResponse call
+func ParseGetNetworthResponse(rsp *http.Response) (*GetNetworthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNetworthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetNetworthResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenapiJsonResponse parses an HTTP response from a GetOpenapiJsonWithResponse call
+func ParseGetOpenapiJsonResponse(rsp *http.Response) (*GetOpenapiJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenapiJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPeriodsResponse parses an HTTP response from a GetPeriodsWithResponse call
+func ParseGetPeriodsResponse(rsp *http.Response) (*GetPeriodsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPeriodsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPeriodsByNameResponse parses an HTTP response from a GetPeriodsByNameWithResponse call
+func ParseGetPeriodsByNameResponse(rsp *http.Response) (*GetPeriodsByNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPeriodsByNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Period
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPortfolioResponse parses an HTTP response from a GetPortfolioWithResponse call
+func ParseGetPortfolioResponse(rsp *http.Response) (*GetPortfolioResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPortfolioResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Portfolio
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPreferencesResponse parses an HTTP response from a GetPreferencesWithResponse call
+func ParseGetPreferencesResponse(rsp *http.Response) (*GetPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Preferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutPreferencesResponse parses an HTTP response from a PutPreferencesWithResponse call
+func ParsePutPreferencesResponse(rsp *http.Response) (*PutPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Preferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRulesApplyResponse parses an HTTP response from a PostRulesApplyWithResponse call
+func ParsePostRulesApplyResponse(rsp *http.Response) (*PostRulesApplyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRulesApplyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RuleApplication
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRulesTestResponse parses an HTTP response from a PostRulesTestWithResponse call
+func ParsePostRulesTestResponse(rsp *http.Response) (*PostRulesTestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRulesTestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Result
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSourcesResponse parses an HTTP response from a GetSourcesWithResponse call
+func ParseGetSourcesResponse(rsp *http.Response) (*GetSourcesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSourcesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SourceInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSourcesBackfillsResponse parses an HTTP response from a GetSourcesBackfillsWithResponse call
+func ParseGetSourcesBackfillsResponse(rsp *http.Response) (*GetSourcesBackfillsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSourcesBackfillsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Backfill
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSourcesConsentCallbackResponse parses an HTTP response from a GetSourcesConsentCallbackWithResponse call
+func ParseGetSourcesConsentCallbackResponse(rsp *http.Response) (*GetSourcesConsentCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSourcesConsentCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SourceState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSourcesConsentsResponse parses an HTTP response from a GetSourcesConsentsWithResponse call
+func ParseGetSourcesConsentsResponse(rsp *http.Response) (*GetSourcesConsentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSourcesConsentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SourceState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSourcesCyclesResponse parses an HTTP response from a GetSourcesCyclesWithResponse call
+func ParseGetSourcesCyclesResponse(rsp *http.Response) (*GetSourcesCyclesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSourcesCyclesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ImportCycleReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSourcesQueueResponse parses an HTTP response from a GetSourcesQueueWithResponse call
+func ParseGetSourcesQueueResponse(rsp *http.Response) (*GetSourcesQueueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSourcesQueueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProviderStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSourcesSchedulerResponse parses an HTTP response from a GetSourcesSchedulerWithResponse call
+func ParseGetSourcesSchedulerResponse(rsp *http.Response) (*GetSourcesSchedulerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSourcesSchedulerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchedulerStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSourcesStatusResponse parses an HTTP response from a GetSourcesStatusWithResponse call
+func ParseGetSourcesStatusResponse(rsp *http.Response) (*GetSourcesStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSourcesStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSourcesSyncResponse parses an HTTP response from a PostSourcesSyncWithResponse call
+func ParsePostSourcesSyncResponse(rsp *http.Response) (*PostSourcesSyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSourcesSyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportCycleReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSourcesByIdBackfillResponse parses an HTTP response from a GetSourcesByIdBackfillWithResponse call
+func ParseGetSourcesByIdBackfillResponse(rsp *http.Response) (*GetSourcesByIdBackfillResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSourcesByIdBackfillResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Backfill
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSourcesByIdBackfillResponse parses an HTTP response from a PostSourcesByIdBackfillWithResponse call
+func ParsePostSourcesByIdBackfillResponse(rsp *http.Response) (*PostSourcesByIdBackfillResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSourcesByIdBackfillResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Backfill
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSourcesByIdConsentRenewResponse parses an HTTP response from a PostSourcesByIdConsentRenewWithResponse call
+func ParsePostSourcesByIdConsentRenewResponse(rsp *http.Response) (*PostSourcesByIdConsentRenewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSourcesByIdConsentRenewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SourceState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutSourcesByIdLifecycleResponse parses an HTTP response from a PutSourcesByIdLifecycleWithResponse call
+func ParsePutSourcesByIdLifecycleResponse(rsp *http.Response) (*PutSourcesByIdLifecycleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutSourcesByIdLifecycleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SourceState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSourcesByIdPauseResponse parses an HTTP response from a PostSourcesByIdPauseWithResponse call
+func ParsePostSourcesByIdPauseResponse(rsp *http.Response) (*PostSourcesByIdPauseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSourcesByIdPauseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string][]string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSourcesByIdResumeResponse parses an HTTP response from a PostSourcesByIdResumeWithResponse call
+func ParsePostSourcesByIdResumeResponse(rsp *http.Response) (*PostSourcesByIdResumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSourcesByIdResumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string][]string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSourcesByIdSyncResponse parses an HTTP response from a PostSourcesByIdSyncWithResponse call
+func ParsePostSourcesByIdSyncResponse(rsp *http.Response) (*PostSourcesByIdSyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSourcesByIdSyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSourcesByIdTestResponse parses an HTTP response from a PostSourcesByIdTestWithResponse call
+func ParsePostSourcesByIdTestResponse(rsp *http.Response) (*PostSourcesByIdTestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSourcesByIdTestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SourceTestReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSpacesResponse parses an HTTP response from a GetSpacesWithResponse call
+func ParseGetSpacesResponse(rsp *http.Response) (*GetSpacesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSpacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Space
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSpacesResponse parses an HTTP response from a PostSpacesWithResponse call
+func ParsePostSpacesResponse(rsp *http.Response) (*PostSpacesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSpacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Space
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSpacesBySpaceCategoriesResponse parses an HTTP response from a GetSpacesBySpaceCategoriesWithResponse call
+func ParseGetSpacesBySpaceCategoriesResponse(rsp *http.Response) (*GetSpacesBySpaceCategoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSpacesBySpaceCategoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSpacesBySpaceCategoriesByCategoryResponse parses an HTTP response from a PostSpacesBySpaceCategoriesByCategoryWithResponse call
+func ParsePostSpacesBySpaceCategoriesByCategoryResponse(rsp *http.Response) (*PostSpacesBySpaceCategoriesByCategoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSpacesBySpaceCategoriesByCategoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Category
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSpacesBySpaceMaintenanceResponse parses an HTTP response from a DeleteSpacesBySpaceMaintenanceWithResponse call
+func ParseDeleteSpacesBySpaceMaintenanceResponse(rsp *http.Response) (*DeleteSpacesBySpaceMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSpacesBySpaceMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutSpacesBySpaceMaintenanceResponse parses an HTTP response from a PutSpacesBySpaceMaintenanceWithResponse call
+func ParsePutSpacesBySpaceMaintenanceResponse(rsp *http.Response) (*PutSpacesBySpaceMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutSpacesBySpaceMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Maintenance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSpacesBySpaceMembersResponse parses an HTTP response from a GetSpacesBySpaceMembersWithResponse call
+func ParseGetSpacesBySpaceMembersResponse(rsp *http.Response) (*GetSpacesBySpaceMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSpacesBySpaceMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SpaceMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSpacesBySpaceMembersByUserResponse parses an HTTP response from a DeleteSpacesBySpaceMembersByUserWithResponse call
+func ParseDeleteSpacesBySpaceMembersByUserResponse(rsp *http.Response) (*DeleteSpacesBySpaceMembersByUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSpacesBySpaceMembersByUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutSpacesBySpaceMembersByUserResponse parses an HTTP response from a PutSpacesBySpaceMembersByUserWithResponse call
+func ParsePutSpacesBySpaceMembersByUserResponse(rsp *http.Response) (*PutSpacesBySpaceMembersByUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutSpacesBySpaceMembersByUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SpaceMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSpacesBySpaceTransactionsResponse parses an HTTP response from a GetSpacesBySpaceTransactionsWithResponse call
+func ParseGetSpacesBySpaceTransactionsResponse(rsp *http.Response) (*GetSpacesBySpaceTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSpacesBySpaceTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Transaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSpacesBySpaceWalletsResponse parses an HTTP response from a GetSpacesBySpaceWalletsWithResponse call
+func ParseGetSpacesBySpaceWalletsResponse(rsp *http.Response) (*GetSpacesBySpaceWalletsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSpacesBySpaceWalletsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Wallet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSpacesBySpaceWalletsByWalletResponse parses an HTTP response from a DeleteSpacesBySpaceWalletsByWalletWithResponse call
+func ParseDeleteSpacesBySpaceWalletsByWalletResponse(rsp *http.Response) (*DeleteSpacesBySpaceWalletsByWalletResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSpacesBySpaceWalletsByWalletResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wallet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSpacesBySpaceWalletsByWalletResponse parses an HTTP response from a PostSpacesBySpaceWalletsByWalletWithResponse call
+func ParsePostSpacesBySpaceWalletsByWalletResponse(rsp *http.Response) (*PostSpacesBySpaceWalletsByWalletResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSpacesBySpaceWalletsByWalletResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wallet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStreamsResponse parses an HTTP response from a GetStreamsWithResponse call
+func ParseGetStreamsResponse(rsp *http.Response) (*GetStreamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStreamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []StreamUsage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostStreamsByStreamCompactResponse parses an HTTP response from a PostStreamsByStreamCompactWithResponse call
+func ParsePostStreamsByStreamCompactResponse(rsp *http.Response) (*PostStreamsByStreamCompactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostStreamsByStreamCompactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurgeResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostStreamsByStreamPurgeResponse parses an HTTP response from a PostStreamsByStreamPurgeWithResponse call
+func ParsePostStreamsByStreamPurgeResponse(rsp *http.Response) (*PostStreamsByStreamPurgeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostStreamsByStreamPurgeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurgeResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutStreamsByStreamRetentionResponse parses an HTTP response from a PutStreamsByStreamRetentionWithResponse call
+func ParsePutStreamsByStreamRetentionResponse(rsp *http.Response) (*PutStreamsByStreamRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutStreamsByStreamRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StreamUsage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSubscriptionsResponse parses an HTTP response from a GetSubscriptionsWithResponse call
+func ParseGetSubscriptionsResponse(rsp *http.Response) (*GetSubscriptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSubscriptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Subscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSubscriptionsDetectResponse parses an HTTP response from a PostSubscriptionsDetectWithResponse call
+func ParsePostSubscriptionsDetectResponse(rsp *http.Response) (*PostSubscriptionsDetectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSubscriptionsDetectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SubscriptionReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest SubscriptionReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTagsSpendResponse parses an HTTP response from a GetTagsSpendWithResponse call
+func ParseGetTagsSpendResponse(rsp *http.Response) (*GetTagsSpendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTagsSpendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			union json.RawMessage
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTagsByIdResponse parses an HTTP response from a DeleteTagsByIdWithResponse call
+func ParseDeleteTagsByIdResponse(rsp *http.Response) (*DeleteTagsByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTagsByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]int
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTagsByIdMergeResponse parses an HTTP response from a PostTagsByIdMergeWithResponse call
+func ParsePostTagsByIdMergeResponse(rsp *http.Response) (*PostTagsByIdMergeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTagsByIdMergeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]int
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTagsByIdRenameResponse parses an HTTP response from a PostTagsByIdRenameWithResponse call
+func ParsePostTagsByIdRenameResponse(rsp *http.Response) (*PostTagsByIdRenameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTagsByIdRenameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]int
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransactionsResponse parses an HTTP response from a GetTransactionsWithResponse call
+func ParseGetTransactionsResponse(rsp *http.Response) (*GetTransactionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransactionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Transaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchTransactionsBulkResponse parses an HTTP response from a PatchTransactionsBulkWithResponse call
+func ParsePatchTransactionsBulkResponse(rsp *http.Response) (*PatchTransactionsBulkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchTransactionsBulkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]int
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTransactionsImportResponse parses an HTTP response from a PostTransactionsImportWithResponse call
+func ParsePostTransactionsImportResponse(rsp *http.Response) (*PostTransactionsImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTransactionsImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTransactionsByIdMergeResponse parses an HTTP response from a PostTransactionsByIdMergeWithResponse call
+func ParsePostTransactionsByIdMergeResponse(rsp *http.Response) (*PostTransactionsByIdMergeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTransactionsByIdMergeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Transaction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksDeliveriesByIdRedeliverResponse parses an HTTP response from a PostWebhooksDeliveriesByIdRedeliverWithResponse call
+func ParsePostWebhooksDeliveriesByIdRedeliverResponse(rsp *http.Response) (*PostWebhooksDeliveriesByIdRedeliverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksDeliveriesByIdRedeliverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksByIdDeliveriesResponse parses an HTTP response from a GetWebhooksByIdDeliveriesWithResponse call
+func ParseGetWebhooksByIdDeliveriesResponse(rsp *http.Response) (*GetWebhooksByIdDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksByIdDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,13 @@
+// Package client is a generated Go client for the custom /api/firedragon
+// routes. It is produced from the OpenAPI document the server serves at
+// /api/firedragon/openapi.json, and regenerated with it by go generate in
+// internal/pocketbase after a route changes.
+//
+// Requests are authenticated with a PocketBase auth token:
+//
+//	c, err := client.NewClientWithResponses("https://firedragon.example.com",
+//		client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+//			req.Header.Set("Authorization", token)
+//			return nil
+//		}))
+package client
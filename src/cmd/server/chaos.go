@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/chaos"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/workerpool"
+	"github.com/spf13/cobra"
+)
+
+// chaosScenario returns the fault injection scenario of the configuration
+func chaosScenario(cfg internal.ChaosConfig) chaos.Scenario {
+	if !cfg.Enabled {
+		return chaos.Scenario{}
+	}
+	return chaos.Scenario{
+		HTTPFailureRate: cfg.HTTPFailureRate,
+		HTTPStatus:      cfg.HTTPStatus,
+		HTTPDelayRate:   cfg.HTTPDelayRate,
+		HTTPDelay:       cfg.HTTPDelay,
+		Hosts:           cfg.Hosts,
+		NATSDropRate:    cfg.NATSDropRate,
+		WorkerKillRate:  cfg.WorkerKillRate,
+	}
+}
+
+// chaosReport is the outcome of a chaos scenario run
+type chaosReport struct {
+	Scenario  chaos.Scenario             `json:"scenario"`
+	Injected  chaos.Stats                `json:"injected"`
+	Faulted   *models.ImportCycleReport  `json:"faulted"`            // import cycle run with the faults
+	Recovery  *models.ImportCycleReport  `json:"recovery,omitempty"` // import cycle run after the faults stopped
+	Recovered bool                       `json:"recovered"`          // no source failed in the recovery cycle
+	Workers   []workerpool.ProviderStats `json:"workers"`            // includes the restarts of killed workers
+}
+
+func newChaosCommand(injector *chaos.Injector, syncer *usecases.SourceSyncService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chaos",
+		Short: "Drive fault injection scenarios against the import pipeline",
+	}
+
+	scenario := injector.Scenario()
+	var recovery bool
+	run := &cobra.Command{
+		Use:   "run",
+		Short: "Run an import cycle with faults injected, then one without to check that it recovers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := injector.Set(scenario); err != nil {
+				return err
+			}
+
+			report := &chaosReport{Scenario: scenario}
+			report.Faulted = syncer.SyncAll(cmd.Context())
+			report.Injected = injector.Stats()
+
+			if err := injector.Set(chaos.Scenario{}); err != nil {
+				return err
+			}
+			if recovery {
+				report.Recovery = syncer.SyncAll(cmd.Context())
+				report.Recovered = report.Recovery.Failed == 0
+			}
+			report.Workers = syncer.QueueStats()
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+
+	flags := run.Flags()
+	flags.Float64Var(&scenario.HTTPFailureRate, "http-failure-rate", scenario.HTTPFailureRate, "probability that an outbound HTTP call fails")
+	flags.IntVar(&scenario.HTTPStatus, "http-status", scenario.HTTPStatus, "status of failed HTTP calls; 0 fails the connection instead")
+	flags.Float64Var(&scenario.HTTPDelayRate, "http-delay-rate", scenario.HTTPDelayRate, "probability that an outbound HTTP call is delayed")
+	flags.DurationVar(&scenario.HTTPDelay, "http-delay", scenario.HTTPDelay, "delay of delayed HTTP calls")
+	flags.StringSliceVar(&scenario.Hosts, "hosts", scenario.Hosts, "hosts HTTP faults apply to; every host when empty")
+	flags.Float64Var(&scenario.NATSDropRate, "nats-drop-rate", scenario.NATSDropRate, "probability that an event publish is dropped")
+	flags.Float64Var(&scenario.WorkerKillRate, "worker-kill-rate", scenario.WorkerKillRate, "probability that a sync worker is killed")
+	flags.BoolVar(&recovery, "recovery", true, "run a second import cycle without faults and report whether every source recovered")
+
+	cmd.AddCommand(run)
+	return cmd
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/firefly"
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/repositories/pocketbase/schema"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/events"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/spf13/cobra"
+)
+
+// errRollback undoes the write of the database check
+var errRollback = errors.New("rollback")
+
+// databaseCheck verifies that the database accepts writes. The test table is
+// created in a transaction that is always rolled back.
+func databaseCheck(app core.App) usecases.StartupCheck {
+	return usecases.StartupCheck{
+		Name:  "database",
+		Fatal: true,
+		Run: func(ctx context.Context) (string, error) {
+			err := app.RunInTransaction(func(txApp core.App) error {
+				if _, err := txApp.DB().NewQuery("CREATE TABLE _firedragon_check (id INTEGER)").WithContext(ctx).Execute(); err != nil {
+					return fmt.Errorf("database is not writable: %w", err)
+				}
+				return errRollback
+			})
+			if !errors.Is(err, errRollback) {
+				return "", err
+			}
+			return app.DataDir(), nil
+		},
+	}
+}
+
+// schemaCheck compares the collections with the schema the repositories
+// were generated from. Drift is not fatal so that a fresh database can start
+// and have the collections imported.
+func schemaCheck(app core.App) usecases.StartupCheck {
+	return usecases.StartupCheck{
+		Name: "schema",
+		Run: func(ctx context.Context) (string, error) {
+			drift, err := schema.Check(app)
+			if err != nil {
+				return "", err
+			}
+			if len(drift) > 0 {
+				problems := make([]string, len(drift))
+				for i, d := range drift {
+					problems[i] = d.String()
+				}
+				return "", fmt.Errorf("%d schema differences: %s", len(drift), strings.Join(problems, "; "))
+			}
+			return fmt.Sprintf("%d collections", len(schema.Snapshot)), nil
+		},
+	}
+}
+
+// maintenanceCheck reports the maintenance mode. Maintenance is deliberate,
+// so the check only warns while it is on.
+func maintenanceCheck(maintenance *usecases.MaintenanceService) usecases.StartupCheck {
+	return usecases.StartupCheck{
+		Name: "maintenance",
+		Run: func(ctx context.Context) (string, error) {
+			state, err := maintenance.Status(ctx)
+			if err != nil {
+				return "", err
+			}
+			if state.Global != nil {
+				return "", state.Global.Err()
+			}
+			if len(state.Spaces) > 0 {
+				spaces := make([]string, len(state.Spaces))
+				for i, m := range state.Spaces {
+					spaces[i] = m.SpaceID
+				}
+				return "", fmt.Errorf("%w: %d spaces frozen: %s", models.ErrMaintenance, len(spaces), strings.Join(spaces, ", "))
+			}
+			return "off", nil
+		},
+	}
+}
+
+// natsCheck verifies that NATS and JetStream are reachable. NATS is only
+// required for leader election; without it events wait in the outbox.
+func natsCheck(cfg internal.NATSConfig) usecases.StartupCheck {
+	return usecases.StartupCheck{
+		Name:  "nats",
+		Fatal: cfg.LeaderElection,
+		Run: func(ctx context.Context) (string, error) {
+			version, err := events.Ping(ctx, cfg)
+			if err != nil {
+				return "", err
+			}
+			return "server " + version, nil
+		},
+	}
+}
+
+// fireflyCheck verifies that Firefly III is reachable and accepts the token,
+// and detects its version and the optional features it lacks
+func fireflyCheck(client *firefly.Client) usecases.StartupCheck {
+	return usecases.StartupCheck{
+		Name:  "firefly",
+		Fatal: true,
+		Run: func(ctx context.Context) (string, error) {
+			capabilities, err := client.Capabilities(ctx)
+			if err != nil {
+				return "", err
+			}
+			var missing []string
+			for feature, supported := range capabilities.Features {
+				if !supported {
+					missing = append(missing, string(feature))
+				}
+			}
+			if len(missing) == 0 {
+				return "version " + capabilities.Version, nil
+			}
+			slices.Sort(missing)
+			return "version " + capabilities.Version + ", without " + strings.Join(missing, ", "), nil
+		},
+	}
+}
+
+// sourceChecks runs the self-test of every source with a client. A failing
+// source only fails its own imports, so none of them is fatal.
+func sourceChecks(sources *usecases.SourceService) []usecases.StartupCheck {
+	var checks []usecases.StartupCheck
+	for _, info := range sources.ListSources() {
+		if !info.HasClient {
+			continue
+		}
+		id := info.ID
+		checks = append(checks, usecases.StartupCheck{
+			Name: "source " + id,
+			Run: func(ctx context.Context) (string, error) {
+				report, err := sources.TestSource(ctx, id)
+				if err != nil {
+					return "", err
+				}
+				for _, step := range report.Steps {
+					if !step.OK && !step.Skipped {
+						return "", interfaces.NewClientError(step.ErrorType, step.Name+": "+step.Error, nil)
+					}
+				}
+				if report.Balance == nil {
+					return "", nil
+				}
+				return fmt.Sprintf("balance %g %s", *report.Balance, report.Currency), nil
+			},
+		})
+	}
+	return checks
+}
+
+// registerStartupChecks runs the checks before the server starts serving
+// and adds the --check flag, which runs them and exits
+func registerStartupChecks(app *pocketbase.PocketBase, diagnostics *usecases.DiagnosticsService, onServe bool) {
+	logger := internal.GetLogger()
+
+	if onServe {
+		app.OnServe().BindFunc(func(e *core.ServeEvent) error {
+			report := diagnostics.Run(context.Background())
+			printDiagnostics(os.Stdout, report)
+			if !report.OK {
+				logger.Fatal().Int("failed", len(report.Failed())).Msg("Startup checks failed")
+			}
+			return e.Next()
+		})
+	}
+
+	var check bool
+	app.RootCmd.Flags().BoolVar(&check, "check", false, "verify the configured dependencies and exit, non-zero on fatal failures")
+	app.RootCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if !check {
+			return cmd.Help()
+		}
+		report := diagnostics.Run(cmd.Context())
+		printDiagnostics(os.Stdout, report)
+		if !report.OK {
+			// Execute ignores the errors of the command, so exit here
+			os.Exit(1)
+		}
+		return nil
+	}
+}
+
+// printDiagnostics writes the results of the checks as a table
+func printDiagnostics(w io.Writer, report *usecases.DiagnosticsReport) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECK\tSTATUS\tLATENCY\tDETAIL")
+	for _, result := range report.Results {
+		status, detail := "ok", result.Detail
+		if !result.OK {
+			status, detail = "warn", result.Error
+			if result.Fatal {
+				status = "FAIL"
+			}
+		}
+		detail = strings.ReplaceAll(detail, "\n", " ")
+		fmt.Fprintf(tw, "%s\t%s\t%dms\t%s\n", result.Name, status, result.LatencyMS, detail)
+	}
+	tw.Flush()
+}
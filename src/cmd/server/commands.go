@@ -0,0 +1,381 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/spf13/cobra"
+)
+
+// newRepairLinksCommand creates the command that re-links local transactions to Firefly III
+func newRepairLinksCommand(links *usecases.FireflyLinkService) *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "firefly-repair-links",
+		Short: "Re-link local transactions to Firefly III via their external_id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := links.RepairLinks(cmd.Context(), dryRun)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report the links that would be repaired without writing them")
+
+	return cmd
+}
+
+// newRecalculateBalancesCommand creates the command that replays transactions to verify wallet balances
+func newRecalculateBalancesCommand(balances *usecases.BalanceService) *cobra.Command {
+	var opts usecases.RecalculateOptions
+
+	cmd := &cobra.Command{
+		Use:   "recalculate-balances",
+		Short: "Replay transactions and report (or fix) drifted wallet balances",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := balances.RecalculateBalances(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.WalletID, "wallet", "", "only check the wallet with this ID")
+	cmd.Flags().BoolVar(&opts.Fix, "fix", false, "overwrite drifted balances with the replayed value")
+
+	return cmd
+}
+
+// newFireflyMigrateCommand creates the command that copies an existing Firefly III instance into the local database
+func newFireflyMigrateCommand(migration *usecases.FireflyMigrationService) *cobra.Command {
+	var opts usecases.FireflyMigrationOptions
+	var from, to string
+
+	cmd := &cobra.Command{
+		Use:   "firefly-migrate",
+		Short: "Import accounts, categories and transactions from Firefly III",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if from != "" {
+				if opts.Start, err = time.Parse(time.DateOnly, from); err != nil {
+					return fmt.Errorf("invalid --from date: %w", err)
+				}
+			}
+			if to != "" {
+				if opts.End, err = time.Parse(time.DateOnly, to); err != nil {
+					return fmt.Errorf("invalid --to date: %w", err)
+				}
+			}
+
+			report, err := migration.Migrate(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "report what would be migrated without writing anything")
+	cmd.Flags().StringVar(&from, "from", "", "only migrate transactions on or after this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&to, "to", "", "only migrate transactions on or before this date (YYYY-MM-DD)")
+
+	return cmd
+}
+
+// newRotateKeysCommand creates the command that rotates the keys encrypting sensitive fields
+func newRotateKeysCommand(encryption *usecases.FieldEncryptionService) *cobra.Command {
+	var dataKeys bool
+	var masterKeyEnv string
+
+	cmd := &cobra.Command{
+		Use:   "encryption-rotate-keys",
+		Short: "Rewrap the data keys with a new master key and/or rotate the data keys",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if masterKeyEnv == "" && !dataKeys {
+				return fmt.Errorf("nothing to rotate: pass --master-key-env and/or --data-keys")
+			}
+
+			report := &models.KeyRotationReport{}
+			if masterKeyEnv != "" {
+				masterKey := os.Getenv(masterKeyEnv)
+				if masterKey == "" {
+					return fmt.Errorf("environment variable %s is not set", masterKeyEnv)
+				}
+				rewrapped, err := encryption.RotateMasterKey(cmd.Context(), masterKey)
+				if err != nil {
+					return err
+				}
+				report.DataKeys = rewrapped.DataKeys
+			}
+
+			if dataKeys {
+				rotated, err := encryption.RotateDataKeys(cmd.Context())
+				if err != nil {
+					return err
+				}
+				report.DataKeys += rotated.DataKeys
+				report.Reencrypted = rotated.Reencrypted
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+
+	cmd.Flags().StringVar(&masterKeyEnv, "master-key-env", "", "environment variable holding the new 32 byte master key; set encryption.master_key to it afterwards")
+	cmd.Flags().BoolVar(&dataKeys, "data-keys", false, "create new data keys and encrypt every transaction again with them")
+
+	return cmd
+}
+
+// newSeedCommand creates the command that populates an empty instance with demo data
+func newSeedCommand(seed *usecases.SeedService) *cobra.Command {
+	var demo bool
+	opts := usecases.SeedOptions{Months: 6, Seed: 1}
+
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Populate the database with demo wallets, categories and transactions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !demo {
+				return fmt.Errorf("nothing to seed: pass --demo")
+			}
+
+			report, err := seed.SeedDemo(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+
+	cmd.Flags().BoolVar(&demo, "demo", false, "create the demo dataset")
+	cmd.Flags().IntVar(&opts.Months, "months", opts.Months, "months of transaction history up to today")
+	cmd.Flags().Int64Var(&opts.Seed, "seed", opts.Seed, "seed of the generated amounts and dates")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "seed even when the database already has wallets")
+
+	return cmd
+}
+
+// newMaintenanceCommand creates the command that switches maintenance mode. The
+// switches are stored, so a running server picks them up with its next request.
+func newMaintenanceCommand(maintenance *usecases.MaintenanceService) *cobra.Command {
+	var spaceID, reason string
+	actor := usecases.SpaceActor{UserID: "cli", Superuser: true}
+
+	cmd := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Freeze imports and changes during migrations and restores",
+	}
+	cmd.PersistentFlags().StringVar(&spaceID, "space", "", "only switch the maintenance mode of the space with this ID")
+
+	on := &cobra.Command{
+		Use:   "on",
+		Short: "Pause the importers and refuse changes, reads keep working",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := maintenance.Enable(cmd.Context(), actor, spaceID, reason); err != nil {
+				return err
+			}
+			return printMaintenance(cmd, maintenance)
+		},
+	}
+	on.Flags().StringVar(&reason, "reason", "", "reason shown to clients whose changes are refused")
+
+	off := &cobra.Command{
+		Use:   "off",
+		Short: "Resume the importers and accept changes again",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := maintenance.Disable(cmd.Context(), actor, spaceID); err != nil {
+				return err
+			}
+			return printMaintenance(cmd, maintenance)
+		},
+	}
+
+	status := &cobra.Command{
+		Use:   "status",
+		Short: "Show the maintenance switches that are on",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return printMaintenance(cmd, maintenance)
+		},
+	}
+
+	cmd.AddCommand(on, off, status)
+	return cmd
+}
+
+// printMaintenance writes the maintenance switches that are on as JSON
+func printMaintenance(cmd *cobra.Command, maintenance *usecases.MaintenanceService) error {
+	state, err := maintenance.Status(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(state)
+}
+
+// newReplayCommand creates the command that re-runs the normalization of an
+// import run from its archived payloads, without calling the provider
+func newReplayCommand(sync *usecases.SourceSyncService, archive *usecases.PayloadArchiveService) *cobra.Command {
+	var apply bool
+	var limit int
+	var sourceID string
+
+	cmd := &cobra.Command{
+		Use:   "replay <run-id> <source-id>",
+		Short: "Re-run the normalization of an import run from its archived payloads",
+		Long: "Re-runs the normalization and categorization of an import run from the archived raw payloads " +
+			"and reports the transactions that are missing or normalize differently. With --apply the missing " +
+			"transactions are imported and the new descriptions and categories stored.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := sync.Replay(cmd.Context(), args[0], args[1], apply)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+	}
+	cmd.Flags().BoolVar(&apply, "apply", false, "import the missing transactions and store the new descriptions and categories")
+
+	runs := &cobra.Command{
+		Use:   "runs",
+		Short: "List the archived import runs, newest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			list, err := archive.ListRuns(cmd.Context(), sourceID, limit)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(list)
+		},
+	}
+	runs.Flags().IntVar(&limit, "limit", 20, "maximum number of runs to list")
+	runs.Flags().StringVar(&sourceID, "source", "", "only list the runs of this source")
+
+	cmd.AddCommand(runs)
+	return cmd
+}
+
+// newRenormalizeCommand creates the command that re-normalizes the
+// transactions imported by an older version of their source's normalizer
+func newRenormalizeCommand(normalization *usecases.NormalizationService) *cobra.Command {
+	var apply bool
+
+	cmd := &cobra.Command{
+		Use:   "renormalize [source-id]",
+		Short: "Re-normalize the transactions imported by an older normalizer version",
+		Long: "Replays the archived raw payloads of every source, or of the given one, through the current " +
+			"version of its normalizer and reports the transactions an older version produced differently. " +
+			"With --apply the new descriptions and categories are stored locally and in Firefly III, and the " +
+			"transactions are tagged with the current version, so running it again changes nothing.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var reports []*usecases.RenormalizationReport
+			if len(args) == 1 {
+				report, err := normalization.Renormalize(cmd.Context(), args[0], apply)
+				if err != nil {
+					return err
+				}
+				reports = append(reports, report)
+			} else {
+				var err error
+				if reports, err = normalization.RenormalizeAll(cmd.Context(), apply); err != nil {
+					return err
+				}
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(reports)
+		},
+	}
+	cmd.Flags().BoolVar(&apply, "apply", false, "store the new descriptions, categories and normalizer versions")
+	return cmd
+}
+
+// newStateCommand creates the command that copies the sync state between
+// state store backends
+func newStateCommand(open stateStoreOpener, cfg internal.StateConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Manage the store of the sources' sync state",
+	}
+
+	var from, to string
+	var apply bool
+	migrate := &cobra.Command{
+		Use:   "migrate",
+		Short: "Copy the sync state to another state store backend and verify it",
+		Long: "Copies the last import times, lifecycle and backfill cursors of every source to the target " +
+			"backend, keeping the records the target holds newer, then reads them back to verify the copy. " +
+			"Run it while state.migrate_from keeps writing both backends, then remove migrate_from once " +
+			"the report is verified. Without --apply it only reports what would be copied.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if from == "" || to == "" || from == to {
+				return fmt.Errorf("--from and --to must name two different backends")
+			}
+			source, closeSource, err := open(from)
+			if err != nil {
+				return err
+			}
+			defer closeSource()
+			target, closeTarget, err := open(to)
+			if err != nil {
+				return err
+			}
+			defer closeTarget()
+
+			report, err := usecases.NewStateMigrationService(source, target).Migrate(cmd.Context(), apply)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(report); err != nil {
+				return err
+			}
+			if apply && !report.Verified {
+				return fmt.Errorf("%d records differ after the copy", len(report.Mismatches))
+			}
+			return nil
+		},
+	}
+	migrate.Flags().StringVar(&from, "from", cfg.MigrateFrom, "backend to copy from: pocketbase or nats")
+	migrate.Flags().StringVar(&to, "to", cfg.Backend, "backend to copy to: pocketbase or nats")
+	migrate.Flags().BoolVar(&apply, "apply", false, "write the records the target lacks or holds older")
+
+	cmd.AddCommand(migrate)
+	return cmd
+}
@@ -1,15 +1,39 @@
 package main
 
 import (
+	"context"
 	"log"
+	"net/http"
 	"os"
 	"strings"
+	"time"
+	_ "time/tzdata" // timezones of import sources on hosts without a zoneinfo database
 
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/categorypacks"
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/fileimport"
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/firefly"
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/repositories/dualwrite"
 	pbRepo "github.com/ZanzyTHEbar/firedragon-go/adapters/repositories/pocketbase"
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/storage"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
 	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/chaos"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/cluster"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/control"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/events"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/fx"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/graphapi"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/httpclient"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/leader"
 	pbInternal "github.com/ZanzyTHEbar/firedragon-go/internal/pocketbase"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/scripting"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/workerpool"
 	hooks "github.com/ZanzyTHEbar/firedragon-go/pb_hooks"
+	_ "github.com/ZanzyTHEbar/firedragon-go/pb_migrations" // registers the collection migrations
 	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
 	"github.com/pocketbase/pocketbase/plugins/migratecmd"
 )
 
@@ -22,28 +46,746 @@ func main() {
 	logger := internal.GetLogger()
 	logger.Info().Msg("Starting FireDragon server...")
 
+	// Load configuration; LoadConfig falls back to the defaults when no config
+	// file is present, so an error means the configuration is unreadable or invalid
+	cfg, err := internal.LoadConfig(os.Getenv("FIREDRAGON_CONFIG"))
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to load configuration")
+	}
+	for code, decimals := range cfg.Currencies.Decimals {
+		if err := models.RegisterCurrency(code, decimals); err != nil {
+			logger.Fatal().Err(err).Msg("Invalid currency configuration")
+		}
+	}
+
 	// Register migrations
 	isGoRun := strings.HasPrefix(os.Args[0], os.TempDir())
 	migratecmd.MustRegister(app, app.RootCmd, migratecmd.Config{
 		Dir:         "pb_migrations",
 		Automigrate: isGoRun,
 	})
+	extendMigrateCommand(app)
 
 	// Create repositories
 	log.Println("[INFO] Initializing repositories...")
 	repoFactory := pbRepo.NewRepositoryFactory(app)
+
+	// Sensitive transaction fields are encrypted at rest when configured
+	var fieldEncryption *usecases.FieldEncryptionService
+	if cfg.Encryption.Enabled {
+		fieldEncryption = usecases.NewFieldEncryptionService(repoFactory.CreateDataKeyRepository(cfg.Encryption.MasterKey))
+		repoFactory.WithFieldCipher(fieldEncryption)
+	}
+
 	walletRepo := repoFactory.CreateWalletRepository()
 	categoryRepo := repoFactory.CreateCategoryRepository()
 	transactionRepo := repoFactory.CreateTransactionRepository()
+	snapshotRepo := repoFactory.CreateBalanceSnapshotRepository()
+	ruleRepo := repoFactory.CreateTransformationRuleRepository()
+	accountMappingRepo := repoFactory.CreateAccountMappingRepository()
+	tagRepo := repoFactory.CreateTagRepository()
+	secretRepo := repoFactory.CreateSecretRepository(cfg.Secrets.Key)
+	incidentRepo := repoFactory.CreateIncidentRepository()
+	importRunRepo := repoFactory.CreateImportRunRepository()
+	subscriptionRepo := repoFactory.CreateSubscriptionRepository()
+	spaceRepo := repoFactory.CreateSpaceRepository()
+	auditRepo := repoFactory.CreateAuditRepository()
+	eventOutboxRepo := repoFactory.CreateEventOutboxRepository()
+	assertionRepo := repoFactory.CreateBalanceAssertionRepository()
+	maintenanceRepo := repoFactory.CreateMaintenanceRepository()
+	rawPayloadRepo := repoFactory.CreateRawPayloadRepository()
+	if fieldEncryption != nil {
+		fieldEncryption.WithTransactions(transactionRepo)
+	}
+
+	// The sync state lives in the configured backend. While migrating from
+	// another backend it is written to both and read from the new one first.
+	openStateStore := openStateStores(cfg, repoFactory)
+	stateStore, closeStateStore, err := openStateStore(cfg.State.Backend)
+	if err != nil {
+		logger.Fatal().Err(err).Str("backend", cfg.State.Backend).Msg("Failed to open the state store")
+	}
+	app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+		closeStateStore()
+		return e.Next()
+	})
+	sourceStateRepo, backfillRepo := stateStore.SourceStates, stateStore.Backfills
+	if cfg.State.MigrateFrom != "" {
+		previousStore, closePreviousStore, err := openStateStore(cfg.State.MigrateFrom)
+		if err != nil {
+			logger.Fatal().Err(err).Str("backend", cfg.State.MigrateFrom).Msg("Failed to open the state store migrated from")
+		}
+		app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+			closePreviousStore()
+			return e.Next()
+		})
+		sourceStateRepo = dualwrite.NewSourceStateRepository(stateStore.SourceStates, previousStore.SourceStates)
+		backfillRepo = dualwrite.NewBackfillRepository(stateStore.Backfills, previousStore.Backfills)
+		logger.Info().Str("from", cfg.State.MigrateFrom).Str("to", cfg.State.Backend).Msg("State store in dual-write mode")
+	}
 	log.Println("[INFO] Repositories initialized successfully")
 
+	// Fault injection is only built into binaries built with the chaos tag
+	var injector *chaos.Injector
+	if chaos.Enabled {
+		injector, err = chaos.New(chaosScenario(cfg.Chaos), cfg.Chaos.Seed)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Invalid chaos configuration")
+		}
+		logger.Warn().Interface("scenario", injector.Scenario()).Msg("Fault injection is built in")
+	} else if cfg.Chaos.Enabled {
+		logger.Warn().Err(chaos.ErrUnavailable).Msg("Ignoring the chaos configuration")
+	}
+
+	// Outbound clients share pooled keep-alive connections
+	httpClients, err := httpclient.New(cfg.HTTP)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Invalid HTTP configuration")
+	}
+	if injector != nil {
+		httpClients.WithMiddleware(injector.Transport)
+		// Clients not built by the factory use the default transport
+		http.DefaultTransport = injector.Transport(http.DefaultTransport)
+	}
+
+	// Create exchange-rate provider chain
+	rates, err := fx.NewProviderFromConfig(cfg.FX, httpClients.Client("fx"))
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to configure exchange-rate providers")
+	}
+
+	// Create domain services
+	periods, err := usecases.PeriodCalendarFromConfig(cfg.Periods)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Invalid period configuration")
+	}
+	preferenceDefaults, err := usecases.PreferencesDefaultsFromConfig(cfg.Preferences, cfg.Service.BaseCurrency, periods)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Invalid preferences configuration")
+	}
+	preferencesService := usecases.NewPreferencesService(repoFactory.CreatePreferencesRepository(), walletRepo, preferenceDefaults)
+
+	valuationService := usecases.NewValuationService(walletRepo, snapshotRepo, rates, cfg.Service.BaseCurrency)
+	costBasisService := usecases.NewCostBasisService(walletRepo, transactionRepo, rates, cfg.Service.BaseCurrency,
+		models.CostBasisMethod(cfg.Service.CostBasisMethod)).
+		WithPeriods(periods)
+	ruleService := usecases.NewRuleService(ruleRepo, categoryRepo, scripting.NewEngine(cfg.Service.RuleTimeout))
+
+	duplicatePolicies, err := usecases.DuplicatePoliciesFromConfig(cfg.Duplicates)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Invalid duplicate policy configuration")
+	}
+	descriptionTemplates, err := usecases.DescriptionTemplatesFromConfig(cfg.Descriptions)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Invalid description template configuration")
+	}
+
+	transactionService := usecases.NewTransactionService(walletRepo, categoryRepo, transactionRepo).
+		WithRateProvider(rates).
+		WithRules(ruleService).
+		WithDuplicatePolicies(duplicatePolicies)
+	importService := usecases.NewImportService(walletRepo, transactionRepo).
+		WithCategories(categoryRepo).
+		WithRules(ruleService).
+		WithDuplicatePolicies(duplicatePolicies).
+		WithDescriptionTemplates(descriptionTemplates)
+	// The classifier is optional; it suggests categories for imports the source left uncategorized
+	var categorizationService *usecases.CategorizationService
+	if cfg.Categorization.Enabled {
+		categorizationService = usecases.NewCategorizationService(transactionRepo, categoryRepo).
+			WithThresholds(cfg.Categorization.MinConfidence, cfg.Categorization.AutoApply)
+		importService.WithCategorization(categorizationService)
+	}
+	categoryPack, categoryTranslations, err := categorypacks.Load(cfg.Categories.Pack, cfg.Categories.Locale)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Invalid category configuration")
+	}
+	categoryBootstrap := usecases.NewCategoryBootstrapService(categoryRepo, categoryPack, categoryTranslations...)
+	balanceService := usecases.NewBalanceService(walletRepo)
+	tagService := usecases.NewTagService(tagRepo).WithPeriods(periods)
+	incidentService := usecases.NewIncidentService(incidentRepo, cfg.Service.IncidentThreshold)
+	subscriptionService := usecases.NewSubscriptionService(transactionRepo, subscriptionRepo)
+	calendarService := usecases.NewCalendarService(repoFactory.CreateCalendarFeedRepository(), subscriptionRepo, preferencesService, periods)
+	spaceService := usecases.NewSpaceService(spaceRepo, walletRepo, categoryRepo, transactionRepo)
+	// Maintenance mode freezes the imports into and changes to every space, or to one
+	maintenanceService := usecases.NewMaintenanceService(maintenanceRepo, spaceService)
+	importService.WithMaintenance(maintenanceService)
+	transactionService.WithMaintenance(maintenanceService)
+	tagService.WithMaintenance(maintenanceService)
+	balanceService.WithMaintenance(maintenanceService)
+	if categorizationService != nil {
+		categorizationService.WithMaintenance(maintenanceService)
+	}
+	// Deleted transactions are pruned from the import ledger past the keep period
+	ledgerService := usecases.NewLedgerService(transactionRepo, repoFactory.CreateLedgerSummaryRepository(), cfg.Ledger.KeepMonths).
+		WithCompaction(cfg.Ledger.Compact).
+		WithDedupeWindow(cfg.Duplicates.MaxWindow())
+	importService.WithLedger(ledgerService)
+	graphAPI, err := graphapi.NewAPI(walletRepo, categoryRepo, transactionRepo, valuationService, tagService, preferencesService)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to build the GraphQL API")
+	}
+	var auditService *usecases.AuditService
+	if cfg.Audit.Enabled {
+		auditService = usecases.NewAuditService(auditRepo, cfg.Audit.Retention)
+	}
+
+	sources, err := configuredSources(cfg, httpClients)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to configure sources")
+	}
+	sourceService := usecases.NewSourceService(sources, cfg.Service.SourceTestTimeout)
+	valuationService.WithSources(sources)
+
+	// Verify the configured dependencies before serving, or only that with --check
+	diagnostics := usecases.NewDiagnosticsService(cfg.Service.SourceTestTimeout).WithCheck(databaseCheck(app)).WithCheck(schemaCheck(app)).
+		WithCheck(maintenanceCheck(maintenanceService))
+	if cfg.NATS.URL != "" {
+		diagnostics.WithCheck(natsCheck(cfg.NATS))
+	}
+	for _, check := range sourceChecks(sourceService) {
+		diagnostics.WithCheck(check)
+	}
+	registerStartupChecks(app, diagnostics, cfg.Service.StartupChecks)
+
+	syncPool := workerpool.New(workerpool.Config{
+		Workers:       cfg.Service.SyncWorkers,
+		ProviderLimit: cfg.Service.ProviderConcurrency,
+		Limits:        cfg.Service.ProviderLimits,
+	})
+	app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+		syncPool.Close()
+		return e.Next()
+	})
+	if injector != nil {
+		syncPool.WithInterceptor(injector.Job)
+	}
+	sourceSyncService := usecases.NewSourceSyncService(sources, walletRepo, transactionRepo, importService).
+		WithSnapshots(snapshotRepo).
+		WithIncidents(incidentService).
+		WithRetries(cfg.Service.MaxRetries, cfg.Service.RetryDelay).
+		WithPool(syncPool).
+		WithRuns(importRunRepo).
+		WithState(sourceStateRepo).
+		WithMaintenance(maintenanceService)
+	var archiveService *usecases.PayloadArchiveService
+	var normalizationService *usecases.NormalizationService
+	if cfg.Archive.Enabled {
+		archiveService = usecases.NewPayloadArchiveService(rawPayloadRepo, cfg.Archive.Retention, cfg.Archive.MaxPayloadBytes).
+			WithCompression(cfg.Archive.Compress)
+		sourceSyncService.WithArchive(archiveService)
+		normalizationService = usecases.NewNormalizationService(sourceSyncService)
+	}
+	consentService := usecases.NewConsentService(sourceSyncService, cfg.Banking.Enable.ConsentRenewBefore)
+	backfillService := usecases.NewBackfillService(sourceSyncService, backfillRepo)
+	balanceUpdateService := usecases.NewBalanceUpdateService(sourceSyncService, snapshotRepo, cfg.Service.BalanceTolerance)
+	balanceAssertionService := usecases.NewBalanceAssertionService(assertionRepo, sourceSyncService, cfg.Service.BalanceTolerance)
+	importScheduler := usecases.NewImportScheduler(sourceSyncService, cfg.Service.UpdateInterval).
+		WithMaintenance(maintenanceService)
+
+	var exchangeParsers []usecases.ExchangeParser
+	for _, profile := range fileimport.Profiles() {
+		exchangeParsers = append(exchangeParsers, profile)
+	}
+	exchangeImportService := usecases.NewExchangeImportService(walletRepo, transactionRepo, importService, exchangeParsers...)
+	exportService := usecases.NewExportService(walletRepo, transactionRepo, categoryRepo).
+		WithStore(storage.NewFileStore(app))
+	statementLocation := timezone(cfg.Timezones.For("camt", ""))
+	statementImportService := usecases.NewStatementImportService(walletRepo, transactionRepo, snapshotRepo, importService, fileimport.CamtParser{Location: statementLocation}).
+		WithLocation(statementLocation)
+
+	var budgetParsers []usecases.BudgetParser
+	budgetLocation := timezone(cfg.Timezones.For("budget", ""))
+	for _, profile := range fileimport.BudgetProfiles(budgetLocation) {
+		budgetParsers = append(budgetParsers, profile)
+	}
+	budgetImportService := usecases.NewBudgetImportService(walletRepo, categoryRepo, transactionRepo, importService, budgetParsers...).
+		WithLocation(budgetLocation)
+
+	app.RootCmd.AddCommand(newRecalculateBalancesCommand(balanceService))
+	app.RootCmd.AddCommand(newPerfCommand())
+	app.RootCmd.AddCommand(newStreamsCommand(cfg.NATS))
+	app.RootCmd.AddCommand(newWorkerCommand(cfg.NATS, sources))
+	app.RootCmd.AddCommand(newMaintenanceCommand(maintenanceService))
+	app.RootCmd.AddCommand(newStateCommand(openStateStore, cfg.State))
+	if archiveService != nil {
+		app.RootCmd.AddCommand(newReplayCommand(sourceSyncService, archiveService))
+		app.RootCmd.AddCommand(newRenormalizeCommand(normalizationService))
+	}
+	app.RootCmd.AddCommand(newSeedCommand(usecases.NewSeedService(walletRepo, categoryRepo, importService).
+		WithCategories(categoryBootstrap).
+		WithTags(tagService).
+		WithSubscriptions(subscriptionService)))
+	if injector != nil {
+		app.RootCmd.AddCommand(newChaosCommand(injector, sourceSyncService))
+	}
+	if fieldEncryption != nil {
+		app.RootCmd.AddCommand(newRotateKeysCommand(fieldEncryption))
+	}
+
+	// Services exposed through the custom API routes
+	services := &pbInternal.Services{
+		Valuation:         valuationService,
+		Rules:             ruleService,
+		Transactions:      transactionService,
+		Import:            importService,
+		Balances:          balanceService,
+		Tags:              tagService,
+		Sources:           sourceService,
+		SourceSync:        sourceSyncService,
+		Consents:          consentService,
+		CostBasis:         costBasisService,
+		ExchangeImport:    exchangeImportService,
+		StatementImport:   statementImportService,
+		BudgetImport:      budgetImportService,
+		Incidents:         incidentService,
+		Backfills:         backfillService,
+		BalanceUpdates:    balanceUpdateService,
+		BalanceAssertions: balanceAssertionService,
+		Scheduler:         importScheduler,
+		Export:            exportService,
+		Subscriptions:     subscriptionService,
+		Spaces:            spaceService,
+		Preferences:       preferencesService,
+		Maintenance:       maintenanceService,
+		Ledger:            ledgerService,
+		Calendar:          calendarService,
+		GraphQL:           graphAPI,
+		Audit:             auditService,
+		Periods:           periods,
+		Categorization:    categorizationService,
+	}
+
 	// Register hooks with repository dependencies
-	log.Println("[INFO] Registering transaction hooks...")
-	hooks.RegisterTransactionHooks(app, walletRepo, categoryRepo, transactionRepo)
+	hooks.RegisterTransactionHooks(app)
+	hooks.RegisterRuleHooks(app, ruleService)
+	hooks.RegisterConcurrencyHooks(app)
+	hooks.RegisterTagHooks(app, tagService)
+	if auditService != nil {
+		hooks.RegisterAuditHooks(app, auditService)
+	}
+	if fieldEncryption != nil {
+		hooks.RegisterEncryptionHooks(app, fieldEncryption)
+	}
+
+	// Install the system categories, restore the source statistics and resume
+	// the incidents and backfills a previous run left open
+	app.OnServe().BindFunc(func(e *core.ServeEvent) error {
+		if cfg.Categories.Bootstrap {
+			if _, err := categoryBootstrap.Bootstrap(context.Background()); err != nil {
+				logger.Warn().Err(err).Msg("Failed to install the system categories")
+			}
+		}
+		if err := sourceSyncService.LoadState(context.Background()); err != nil {
+			logger.Warn().Err(err).Msg("Failed to load source sync state")
+		}
+		if err := incidentService.Load(context.Background()); err != nil {
+			logger.Warn().Err(err).Msg("Failed to load open provider incidents")
+		}
+		if err := backfillService.Resume(context.Background()); err != nil {
+			logger.Warn().Err(err).Msg("Failed to resume backfills")
+		}
+		if categorizationService != nil {
+			if _, err := categorizationService.Train(context.Background()); err != nil {
+				logger.Warn().Err(err).Msg("Failed to train the category classifier")
+			}
+		}
+		return e.Next()
+	})
+	app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+		backfillService.Stop()
+		return e.Next()
+	})
+
+	// Streaming is optional; sources are then synced on demand only
+	if cfg.Service.StreamingEnabled {
+		ctx, cancel := context.WithCancel(context.Background())
+		monitor := usecases.NewSourceMonitor(sources, sourceSyncService)
+		app.OnServe().BindFunc(func(e *core.ServeEvent) error {
+			go monitor.Run(ctx)
+			return e.Next()
+		})
+		app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+			cancel()
+			return e.Next()
+		})
+	}
+
+	// Import every source at the configured interval; the interval can be changed remotely
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	app.OnServe().BindFunc(func(e *core.ServeEvent) error {
+		go importScheduler.Run(schedulerCtx)
+		return e.Next()
+	})
+	app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+		stopScheduler()
+		return e.Next()
+	})
+
+	// Events are posted to the webhooks registered in the webhooks collection
+	eventSinks := hooks.EventSinks{}
+	if cfg.Webhooks.Enabled {
+		webhookService := usecases.NewWebhookService(repoFactory.CreateWebhookRepository(), repoFactory.CreateWebhookDeliveryRepository(),
+			httpClients.Client("webhooks")).
+			WithTimeout(cfg.Webhooks.Timeout).
+			WithMaxAttempts(cfg.Webhooks.MaxAttempts).
+			WithLeadership(importScheduler)
+		eventSinks.Webhooks = webhookService
+		services.Webhooks = webhookService
+
+		webhookCtx, stopWebhooks := context.WithCancel(context.Background())
+		app.OnServe().BindFunc(func(e *core.ServeEvent) error {
+			go webhookService.Run(webhookCtx, cfg.Webhooks.Interval)
+			return e.Next()
+		})
+		app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+			stopWebhooks()
+			return e.Next()
+		})
+
+		if cfg.Webhooks.Retention > 0 {
+			app.Cron().MustAdd("purge_webhook_deliveries", "50 3 * * *", func() {
+				purged, err := webhookService.Purge(context.Background(), time.Now().Add(-cfg.Webhooks.Retention))
+				if err != nil {
+					logger.Error().Err(err).Msg("Failed to purge webhook deliveries")
+					return
+				}
+				logger.Info().Int("count", purged).Msg("Purged webhook deliveries")
+			})
+		}
+	}
+
+	// Domain events are optional; the server keeps running without a NATS connection
+	var publisher events.Publisher
+	if cfg.NATS.URL != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		jetStream, err := events.NewJetStreamPublisher(ctx, cfg.NATS)
+		cancel()
+		// Hook events wait in the outbox until they are published
+		var relay *events.OutboxRelay
+		if err != nil {
+			logger.Warn().Err(err).Msg("Failed to connect to NATS, domain events are queued until the next start")
+		} else {
+			publisher = jetStream
+			services.Events = jetStream
+			if injector != nil {
+				publisher = injector.Publisher(publisher)
+			}
+			relay = events.NewOutboxRelay(eventOutboxRepo, publisher, cfg.NATS.OutboxInterval)
+			exportService.WithPublisher(publisher)
+
+			streamAdmin, err := events.NewStreamAdmin(cfg.NATS)
+			if err != nil {
+				logger.Warn().Err(err).Msg("Failed to connect the stream administration to NATS")
+			} else {
+				services.Streams = streamAdmin
+				monitor := events.NewStorageMonitor(streamAdmin, cfg.NATS.StorageAlertPercent, cfg.NATS.StorageAlertBytes)
+				app.Cron().MustAdd("check_stream_storage", "*/15 * * * *", func() {
+					if !importScheduler.IsLeader() {
+						return
+					}
+					checkStreamStorage(context.Background(), monitor, publisher)
+				})
+				app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+					streamAdmin.Close()
+					return e.Next()
+				})
+			}
+
+			relayCtx, stopRelay := context.WithCancel(context.Background())
+			var relayDone chan struct{} // nil unless serving, e.g. for migrate commands
+			app.OnServe().BindFunc(func(e *core.ServeEvent) error {
+				relayDone = make(chan struct{})
+				go func() {
+					defer close(relayDone)
+					relay.Run(relayCtx)
+				}()
+				return e.Next()
+			})
+			app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+				stopRelay()
+				if relayDone != nil {
+					<-relayDone
+				}
+				publisher.Close()
+				return e.Next()
+			})
+		}
+		eventSinks.Outbox = true
+		eventSinks.Relay = relay
+
+		// Published events only need to outlive the stream's deduplication window
+		app.Cron().MustAdd("purge_event_outbox", "45 3 * * *", func() {
+			purged, err := eventOutboxRepo.PurgePublished(context.Background(), time.Now().Add(-cfg.NATS.OutboxRetention))
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to purge published events")
+				return
+			}
+			logger.Info().Int("count", purged).Msg("Purged published events")
+		})
+
+		// With several replicas only the lease holder runs the scheduled imports
+		if cfg.NATS.LeaderElection {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			elector, err := leader.NewElector(ctx, cfg.NATS)
+			cancel()
+			if err != nil {
+				logger.Fatal().Err(err).Msg("Failed to set up leader election")
+			}
+			importScheduler.WithLeadership(elector)
+
+			electorCtx, stopElector := context.WithCancel(context.Background())
+			var electorDone chan struct{} // nil unless serving, e.g. for migrate commands
+			app.OnServe().BindFunc(func(e *core.ServeEvent) error {
+				electorDone = make(chan struct{})
+				go func() {
+					defer close(electorDone)
+					elector.Run(electorCtx)
+				}()
+				return e.Next()
+			})
+			app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+				stopElector()
+				if electorDone != nil {
+					<-electorDone // Run releases the lease before returning
+				}
+				elector.Close()
+				return e.Next()
+			})
+			logger.Info().Str("instance", elector.ID()).Msg("Leader election enabled")
+		}
+
+		// Remote control commands are answered on the request's reply subject
+		controlServer, err := control.NewServer(cfg.NATS, control.NewHandler(sourceSyncService, importScheduler))
+		if err != nil {
+			logger.Warn().Err(err).Msg("Failed to start the NATS control server")
+		} else {
+			app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+				controlServer.Close()
+				return e.Next()
+			})
+		}
+
+		// Sources served by worker nodes are fetched there and imported here
+		if cfg.NATS.Coordinator {
+			coordinator, err := cluster.NewCoordinator(cfg.NATS)
+			if err != nil {
+				logger.Warn().Err(err).Msg("Failed to start the cluster coordinator, every source is fetched locally")
+			} else {
+				sourceSyncService.WithRemote(coordinator)
+				app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+					coordinator.Close()
+					return e.Next()
+				})
+				logger.Info().Msg("Cluster coordinator enabled")
+			}
+		}
+	}
+	if eventSinks.Outbox || eventSinks.Webhooks != nil {
+		eventQueue := hooks.NewEventQueue(app, eventSinks, preferencesService.Defaults())
+		hooks.RegisterEventHooks(app, eventQueue)
+		// Source states may be stored in NATS, so the service announces their changes
+		sourceSyncService.WithPublisher(eventQueue)
+	}
+
+	// Firefly III integration is optional
+	if cfg.Firefly.URL != "" {
+		fireflyClient, err := firefly.NewClient(cfg.Firefly, httpClients.Client("firefly"),
+			firefly.WithResponseHook(firefly.LoggingHook(logger.With().Str("client", "firefly").Logger())),
+			firefly.WithResponseHook(firefly.HealthHook(func(ctx context.Context, err error) {
+				incidentService.Observe(ctx, "firefly", err)
+			})),
+		)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Failed to create Firefly client")
+		}
+		diagnostics.WithCheck(fireflyCheck(fireflyClient))
+		if cfg.Firefly.OAuth.ClientID != "" {
+			fireflyClient.WithOAuth(cfg.Firefly.OAuth, firefly.NewSecretTokenStore(secretRepo))
+			services.FireflyOAuth = usecases.NewFireflyOAuthService(fireflyClient)
+		}
+
+		// Accounts, currencies and categories are read constantly and change
+		// rarely. Balance checks keep reading the uncached client.
+		var referenceClient interfaces.FireflyClient = fireflyClient
+		if cfg.Firefly.Cache.TTL > 0 {
+			referenceCache := firefly.NewCachingClient(fireflyClient, cfg.Firefly.Cache.TTL)
+			if cfg.Firefly.Cache.Persist {
+				referenceCache.WithStore(firefly.NewSecretCacheStore(secretRepo))
+				app.OnServe().BindFunc(func(e *core.ServeEvent) error {
+					if err := referenceCache.Restore(context.Background()); err != nil {
+						logger.Warn().Err(err).Msg("Failed to restore the Firefly reference cache")
+					}
+					return e.Next()
+				})
+				app.Cron().MustAdd("persist_firefly_cache", "*/5 * * * *", func() {
+					if err := referenceCache.Persist(context.Background()); err != nil {
+						logger.Warn().Err(err).Msg("Failed to persist the Firefly reference cache")
+					}
+				})
+				app.OnTerminate().BindFunc(func(e *core.TerminateEvent) error {
+					if err := referenceCache.Persist(context.Background()); err != nil {
+						logger.Warn().Err(err).Msg("Failed to persist the Firefly reference cache")
+					}
+					return e.Next()
+				})
+			}
+			services.FireflyCache = referenceCache
+			referenceClient = referenceCache
+		}
+
+		accountMappingService := usecases.NewAccountMappingService(
+			accountMappingRepo,
+			referenceClient,
+			usecases.AccountMappingsFromConfig(cfg.Firefly),
+			cfg.Firefly.AutoCreateAccounts,
+		)
+		hooks.RegisterAccountMappingHooks(app, accountMappingService)
+		services.FireflyAccounts = accountMappingService
+		services.FireflyLinks = usecases.NewFireflyLinkService(referenceClient, transactionRepo)
+		app.RootCmd.AddCommand(newRepairLinksCommand(services.FireflyLinks))
+		app.RootCmd.AddCommand(newFireflyMigrateCommand(usecases.NewFireflyMigrationService(
+			referenceClient, walletRepo, categoryRepo, transactionRepo, accountMappingRepo)))
+
+		services.FireflyBootstrap = usecases.NewFireflyBootstrapService(referenceClient, accountMappingService, sources)
+		balanceUpdateService.WithFirefly(accountMappingService, fireflyClient)
+		balanceAssertionService.WithFirefly(accountMappingService, fireflyClient)
+		if normalizationService != nil {
+			normalizationService.WithFirefly(referenceClient, categoryRepo)
+		}
+
+		// Pull the categories and tags users edit in Firefly back into local transactions
+		services.FireflySync = usecases.NewFireflySyncService(referenceClient, transactionRepo, categoryRepo).
+			WithState(sourceStateRepo)
+		if publisher != nil {
+			services.FireflySync.WithPublisher(publisher)
+		}
+		if cfg.Firefly.PullSchedule != "" {
+			app.Cron().MustAdd("pull_firefly", cfg.Firefly.PullSchedule, func() {
+				if !importScheduler.IsLeader() || maintenanceService.Check(context.Background(), "") != nil {
+					return
+				}
+				if _, err := services.FireflySync.Pull(context.Background()); err != nil {
+					logger.Error().Err(err).Msg("Failed to pull Firefly edits")
+				}
+			})
+		}
+
+		// Queue imported transactions for Firefly and deliver them while it is reachable
+		if cfg.Firefly.Outbox.Enabled {
+			services.FireflyOutbox = usecases.NewFireflyOutboxService(
+				repoFactory.CreateFireflyOutboxRepository(),
+				usecases.NewFireflyExportService(referenceClient, accountMappingService, transactionRepo),
+				referenceClient, walletRepo, categoryRepo, transactionRepo, sources,
+			).WithBatchSize(cfg.Firefly.Outbox.Batch)
+			importService.WithOutbox(services.FireflyOutbox)
+			app.Cron().MustAdd("drain_firefly_outbox", cfg.Firefly.Outbox.Schedule, func() {
+				if !importScheduler.IsLeader() {
+					return
+				}
+				if _, err := services.FireflyOutbox.Drain(context.Background(), false); err != nil {
+					logger.Error().Err(err).Msg("Failed to drain Firefly outbox")
+				}
+			})
+		}
+
+		// Provision Firefly currencies and accounts before the first import
+		app.OnServe().BindFunc(func(e *core.ServeEvent) error {
+			go func() {
+				if _, err := services.FireflyBootstrap.Bootstrap(context.Background()); err != nil {
+					logger.Error().Err(err).Msg("Firefly bootstrap finished with errors")
+				}
+			}()
+			return e.Next()
+		})
+	}
+
+	// Prune soft-deleted transactions from the import ledger once the keep period has elapsed
+	app.Cron().MustAdd("prune_import_ledger", cfg.Ledger.Schedule, func() {
+		if !importScheduler.IsLeader() {
+			return
+		}
+		report, err := ledgerService.Prune(context.Background(), time.Now())
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to prune the import ledger")
+			return
+		}
+		logger.Info().Int("pruned", report.Pruned).Int("compacted", report.Compacted).Int("summaries", report.Summaries).
+			Time("cutoff", report.Cutoff).Msg("Pruned the import ledger")
+	})
+
+	// Purge audit entries once they outlive the retention
+	if auditService != nil {
+		app.Cron().MustAdd("purge_audit_log", "30 3 * * *", func() {
+			purged, err := auditService.Purge(context.Background(), time.Now())
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to purge audit log")
+				return
+			}
+			logger.Info().Int("count", purged).Msg("Purged audit log")
+		})
+	}
+
+	// Purge the archived raw payloads past their retention
+	if archiveService != nil {
+		app.Cron().MustAdd("purge_raw_payloads", "40 3 * * *", func() {
+			purged, err := archiveService.Purge(context.Background(), time.Now())
+			if err != nil {
+				logger.Error().Err(err).Msg("Failed to purge raw payloads")
+				return
+			}
+			logger.Info().Int("count", purged).Msg("Purged raw payloads")
+		})
+	}
+
+	// Snapshot wallet balances daily for the net worth history
+	app.Cron().MustAdd("snapshot_balances", "55 23 * * *", func() {
+		count, err := valuationService.RecordSnapshots(context.Background(), time.Now())
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to record balance snapshots")
+			return
+		}
+		logger.Info().Int("count", count).Msg("Recorded balance snapshots")
+	})
+
+	// Snapshot source balances and check the Firefly accounts for drift
+	if cfg.Service.BalanceSchedule != "" {
+		app.Cron().MustAdd("update_balances", cfg.Service.BalanceSchedule, func() {
+			if importScheduler.IsLeader() && maintenanceService.Check(context.Background(), "") == nil {
+				balanceUpdateService.UpdateBalances(context.Background())
+			}
+		})
+	}
+
+	// Renew bank consents before they expire; the links are sent by the event hooks
+	app.Cron().MustAdd("check_consents", "0 8 * * *", func() {
+		if importScheduler.IsLeader() {
+			consentService.CheckConsents(context.Background())
+		}
+	})
+
+	// Check the balance assertions whose schedule is due; failures are alerted by the event hooks
+	app.Cron().MustAdd("balance_assertions", "* * * * *", func() {
+		if importScheduler.IsLeader() {
+			balanceAssertionService.RunDue(context.Background(), time.Now())
+		}
+	})
+
+	// Detect recurring charges; price increases and missed charges are published by the event hooks
+	if cfg.Service.SubscriptionSchedule != "" {
+		app.Cron().MustAdd("detect_subscriptions", cfg.Service.SubscriptionSchedule, func() {
+			if !importScheduler.IsLeader() {
+				return
+			}
+			if _, err := subscriptionService.Detect(context.Background(), time.Now()); err != nil {
+				logger.Error().Err(err).Msg("Failed to detect subscriptions")
+			}
+		})
+	}
 
 	// Register custom API routes
 	log.Println("[INFO] Registering custom API routes...")
-	if err := pbInternal.RegisterRoutes(app); err != nil {
+	if err := pbInternal.RegisterRoutes(app, services); err != nil {
 		logger.Fatal().Err(err).Msg("Failed to register custom routes")
 	}
 	log.Println("[INFO] Server initialization complete")
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal/migrate"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/spf13/cobra"
+)
+
+// extendMigrateCommand replaces up and down of the migrate command registered
+// by migratecmd with the planning runner and adds status. The generators
+// (create, collections, history-sync) keep their PocketBase implementation.
+func extendMigrateCommand(app *pocketbase.PocketBase) {
+	var command *cobra.Command
+	for _, c := range app.RootCmd.Commands() {
+		if c.Name() == "migrate" {
+			command = c
+		}
+	}
+	if command == nil {
+		return
+	}
+
+	generate := command.RunE
+	var opts migrate.Options
+
+	command.Long = `Supported arguments are:
+- up            - applies the pending migrations (default)
+- down [number] - reverts the last [number] applied migrations (default 1)
+- status        - lists the migrations and whether they are applied
+- create name   - creates new blank migration template file
+- collections   - creates new migration file with snapshot of the local collections configuration
+- history-sync  - ensures that the _migrations history table doesn't have references to deleted migration files
+
+up and down print the schema changes of every migration. Migrations dropping
+collections or fields that hold records are refused unless --allow-destructive
+is set.
+`
+	command.ValidArgs = append(command.ValidArgs, "status")
+	command.RunE = func(cmd *cobra.Command, args []string) error {
+		action := "up"
+		if len(args) > 0 {
+			action = args[0]
+		}
+
+		runner := migrate.NewRunner(app, core.AppMigrations)
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+
+		var plan *migrate.Plan
+		var err error
+		switch action {
+		case "status":
+			statuses, err := runner.Status()
+			if err != nil {
+				return err
+			}
+			return encoder.Encode(statuses)
+		case "up":
+			plan, err = runner.Up(opts)
+		case "down":
+			n := 1
+			if len(args) > 1 {
+				if n, err = strconv.Atoi(args[1]); err != nil {
+					return fmt.Errorf("invalid number of migrations %q", args[1])
+				}
+			}
+			plan, err = runner.Down(n, opts)
+		default:
+			return generate(cmd, args)
+		}
+
+		if plan != nil {
+			if encodeErr := encoder.Encode(plan); encodeErr != nil {
+				return encodeErr
+			}
+		}
+		if err != nil {
+			// Execute ignores the errors of the command, so exit here for deploy scripts
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
+		}
+		return nil
+	}
+
+	command.Flags().BoolVar(&opts.DryRun, "dry-run", false, "print the planned schema changes without applying them")
+	command.Flags().BoolVar(&opts.AllowDestructive, "allow-destructive", false, "apply migrations that drop collections or fields")
+}
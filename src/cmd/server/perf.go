@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal/perf"
+	"github.com/spf13/cobra"
+)
+
+// newPerfCommand creates the command that load-tests the import pipeline
+// against a mock Firefly III server
+func newPerfCommand() *cobra.Command {
+	var cfg perf.Config
+	var minThroughput float64
+
+	cmd := &cobra.Command{
+		Use:   "perf",
+		Short: "Import synthetic sources through the pipeline and report throughput, latencies and allocations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			result, err := perf.Run(cmd.Context(), cfg)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(result); err != nil {
+				return err
+			}
+
+			if result.Throughput < minThroughput {
+				return fmt.Errorf("throughput %.0f tx/s is below the minimum of %.0f", result.Throughput, minThroughput)
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.IntVar(&cfg.Wallets, "wallets", perf.DefaultWallets, "synthetic sources, each with its own wallet")
+	flags.IntVar(&cfg.Transactions, "transactions", perf.DefaultTransactions, "transactions per wallet")
+	flags.IntVar(&cfg.Rounds, "rounds", perf.DefaultRounds, "import cycles, each fetching a longer part of the history")
+	flags.Float64Var(&cfg.DuplicateRate, "duplicate-rate", 0.05, "share of transactions re-issued with a new provider ID")
+	flags.IntVar(&cfg.Workers, "workers", 0, "sources synced at once (default: the worker pool default)")
+	flags.IntVar(&cfg.Batch, "batch", 0, "outbox entries delivered per drain (default: the outbox default)")
+	flags.DurationVar(&cfg.Latency, "latency", 0, "response delay of the mock Firefly server")
+	flags.Uint64Var(&cfg.Seed, "seed", 1, "seed of the generated histories")
+	flags.Float64Var(&minThroughput, "min-throughput", 0, "fail when fewer transactions per second are imported")
+
+	return cmd
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/banking"
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/blockchain"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/httpclient"
+)
+
+// configuredSources lists the source accounts configured for import, with the
+// client used to read their balances where one is available and the space
+// their wallets belong to
+func configuredSources(cfg *internal.Config, httpClients *httpclient.Factory) ([]usecases.Source, error) {
+	var sources []usecases.Source
+
+	if internal.AddressCount(cfg.Ethereum.Addresses, cfg.Ethereum.Wallets) > 0 {
+		client, err := blockchain.NewEthereumClient(&cfg.Ethereum, httpClients.Client("ethereum"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create ethereum client: %w", err)
+		}
+		sources = append(sources, chainSources("ethereum", "Ethereum", "ETH", cfg.Ethereum.Addresses, cfg.Ethereum.Wallets, cfg.Ethereum.Sandbox, client)...)
+	}
+
+	if internal.AddressCount(cfg.Solana.Addresses, cfg.Solana.Wallets) > 0 {
+		client, err := blockchain.NewSolanaClient(&cfg.Solana, httpClients.Client("solana"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create solana client: %w", err)
+		}
+		sources = append(sources, chainSources("solana", "Solana", "SOL", cfg.Solana.Addresses, cfg.Solana.Wallets, cfg.Solana.Sandbox, client)...)
+	}
+
+	// No SUI client yet, so SUI accounts are provisioned without an opening balance
+	for _, address := range cfg.Sui.Addresses {
+		sources = append(sources, usecases.Source{
+			Account: usecases.AccountRef{Source: "sui", Account: address, Name: "Sui " + shortAddress(address), Currency: "SUI"},
+		})
+	}
+
+	if len(cfg.Banking.Enable.AccountIDs) > 0 {
+		client, err := banking.NewEnableClient(&cfg.Banking.Enable)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create enable banking client: %w", err)
+		}
+		balanceTypes := make([]models.BalanceType, 0, len(cfg.Banking.Enable.BalanceTypes))
+		for _, balanceType := range cfg.Banking.Enable.BalanceTypes {
+			balanceTypes = append(balanceTypes, models.BalanceType(balanceType))
+		}
+		for _, accountID := range cfg.Banking.Enable.AccountIDs {
+			source := usecases.Source{
+				Account:      usecases.AccountRef{Source: "enable", Account: accountID, Name: "Bank " + accountID},
+				Client:       client,
+				BalanceTypes: balanceTypes,
+				Sandbox:      cfg.Banking.Enable.Sandbox,
+			}
+			sources = append(sources, source.InLocation(timezone(cfg.Timezones.For("enable", accountID))))
+		}
+	}
+
+	spaces := make(map[string]string, len(cfg.Spaces.Sources))
+	for _, source := range cfg.Spaces.Sources {
+		spaces[source.Source+":"+source.Account] = source.Space
+	}
+	for i := range sources {
+		sources[i].SpaceID = spaces[sources[i].ID()]
+	}
+
+	return sources, nil
+}
+
+// timezone loads a timezone validated with the config, nil when none is set
+// so dates keep being compared at their exact times
+func timezone(name string) *time.Location {
+	if name == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil
+	}
+	return loc
+}
+
+// chainSources lists a source per configured address of a chain, and one per
+// wallet spanning several addresses, identified by its first address
+func chainSources(chain, label, currency string, addresses []string, wallets []internal.AddressWalletConfig, sandbox bool, client usecases.SourceClient) []usecases.Source {
+	var sources []usecases.Source
+	for _, address := range addresses {
+		sources = append(sources, usecases.Source{
+			Account: usecases.AccountRef{Source: chain, Account: address, Name: label + " " + shortAddress(address), Currency: currency},
+			Client:  client,
+			Sandbox: sandbox,
+		})
+	}
+	for _, wallet := range wallets {
+		name := wallet.Name
+		if name == "" {
+			name = label + " " + shortAddress(wallet.Addresses[0])
+		}
+		sources = append(sources, usecases.Source{
+			Account:   usecases.AccountRef{Source: chain, Account: wallet.Addresses[0], Name: name, Currency: currency},
+			Client:    client,
+			Addresses: wallet.Addresses,
+			Sandbox:   sandbox,
+		})
+	}
+	return sources
+}
+
+// shortAddress abbreviates a wallet address for account names
+func shortAddress(address string) string {
+	if len(address) <= 12 {
+		return address
+	}
+	return address[:6] + "…" + address[len(address)-4:]
+}
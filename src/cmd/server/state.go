@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/repositories/natskv"
+	pbRepo "github.com/ZanzyTHEbar/firedragon-go/adapters/repositories/pocketbase"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// stateStoreOpener opens the state store of a backend. The returned func
+// releases its connection.
+type stateStoreOpener func(backend string) (usecases.StateStore, func(), error)
+
+// openStateStores returns an opener of the configured state store backends
+func openStateStores(cfg *internal.Config, repoFactory *pbRepo.RepositoryFactory) stateStoreOpener {
+	return func(backend string) (usecases.StateStore, func(), error) {
+		switch backend {
+		case internal.StateBackendPocketBase:
+			return usecases.StateStore{
+				Name:         backend,
+				SourceStates: repoFactory.CreateSourceStateRepository(),
+				Backfills:    repoFactory.CreateBackfillRepository(),
+			}, func() {}, nil
+		case internal.StateBackendNATS:
+		default:
+			return usecases.StateStore{}, nil, fmt.Errorf("unknown state store backend %q", backend)
+		}
+		if cfg.NATS.URL == "" {
+			return usecases.StateStore{}, nil, fmt.Errorf("the nats state store requires nats.url")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		store, err := natskv.NewStore(ctx, cfg.NATS)
+		if err != nil {
+			return usecases.StateStore{}, nil, err
+		}
+		return usecases.StateStore{
+			Name:         internal.StateBackendNATS,
+			SourceStates: store.CreateSourceStateRepository(),
+			Backfills:    store.CreateBackfillRepository(),
+		}, store.Close, nil
+	}
+}
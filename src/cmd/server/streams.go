@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/events"
+	"github.com/spf13/cobra"
+)
+
+// checkStreamStorage logs and publishes the streams whose storage crossed
+// their alert threshold since the last check
+func checkStreamStorage(ctx context.Context, monitor *events.StorageMonitor, publisher events.Publisher) {
+	logger := internal.GetLogger().With().Str("component", "streams").Logger()
+
+	alerts, err := monitor.Check(ctx)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to check stream storage")
+		return
+	}
+
+	for _, alert := range alerts {
+		if alert.Above {
+			logger.Warn().Str("stream", alert.Stream).Uint64("bytes", alert.Bytes).Uint64("threshold", alert.Threshold).
+				Msg("Stream storage above the alert threshold")
+		} else {
+			logger.Info().Str("stream", alert.Stream).Uint64("bytes", alert.Bytes).Uint64("threshold", alert.Threshold).
+				Msg("Stream storage back below the alert threshold")
+		}
+
+		event := interfaces.NewEvent(interfaces.EventTypeStreamStorage, "streams").
+			WithTarget(alert.Stream).
+			WithData("kind", alert.Kind).
+			WithData("bytes", alert.Bytes).
+			WithData("threshold", alert.Threshold).
+			WithData("above", alert.Above)
+		if err := publisher.Publish(ctx, event); err != nil {
+			logger.Warn().Err(err).Str("stream", alert.Stream).Msg("Failed to publish stream storage alert")
+		}
+	}
+}
+
+// newStreamsCommand creates the command that inspects and maintains the
+// JetStream streams of the install
+func newStreamsCommand(cfg internal.NATSConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "streams",
+		Short: "Show and manage the storage of the JetStream streams the app creates",
+	}
+
+	// withAdmin connects for the run of a subcommand and prints its result
+	withAdmin := func(run func(ctx context.Context, admin *events.StreamAdmin) (any, error)) func(*cobra.Command, []string) error {
+		return func(cmd *cobra.Command, args []string) error {
+			if cfg.URL == "" {
+				return fmt.Errorf("nats.url is not configured")
+			}
+			admin, err := events.NewStreamAdmin(cfg)
+			if err != nil {
+				return err
+			}
+			defer admin.Close()
+
+			result, err := run(cmd.Context(), admin)
+			if err != nil {
+				return err
+			}
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(result)
+		}
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "usage",
+		Short: "Show the messages, disk usage and limits of every stream",
+		RunE: withAdmin(func(ctx context.Context, admin *events.StreamAdmin) (any, error) {
+			return admin.Usage(ctx)
+		}),
+	})
+
+	var subject string
+	var olderThan time.Duration
+	purge := &cobra.Command{
+		Use:   "purge <stream>",
+		Short: "Remove the messages of a stream (events, dedupe, leader or a stream name)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if olderThan < 0 {
+				return fmt.Errorf("--older-than must not be negative")
+			}
+			return withAdmin(func(ctx context.Context, admin *events.StreamAdmin) (any, error) {
+				opts := events.PurgeOptions{Subject: subject}
+				if olderThan > 0 {
+					opts.Before = time.Now().Add(-olderThan)
+				}
+				return admin.Purge(ctx, args[0], opts)
+			})(cmd, args)
+		},
+	}
+	purge.Flags().StringVar(&subject, "subject", "", "only purge messages on this subject, wildcards allowed")
+	purge.Flags().DurationVar(&olderThan, "older-than", 0, "only purge messages older than this, e.g. 720h")
+	cmd.AddCommand(purge)
+
+	var maxAge time.Duration
+	var maxBytes, maxMsgs int64
+	retention := &cobra.Command{
+		Use:   "retention <stream>",
+		Short: "Change the limits of the event stream; zero removes a limit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var change events.Retention
+			if cmd.Flags().Changed("max-age") {
+				change.MaxAge = &maxAge
+			}
+			if cmd.Flags().Changed("max-bytes") {
+				change.MaxBytes = &maxBytes
+			}
+			if cmd.Flags().Changed("max-msgs") {
+				change.MaxMsgs = &maxMsgs
+			}
+			if change.MaxAge == nil && change.MaxBytes == nil && change.MaxMsgs == nil {
+				return fmt.Errorf("nothing to change: pass --max-age, --max-bytes and/or --max-msgs")
+			}
+			return withAdmin(func(ctx context.Context, admin *events.StreamAdmin) (any, error) {
+				return admin.SetRetention(ctx, args[0], change)
+			})(cmd, args)
+		},
+	}
+	retention.Flags().DurationVar(&maxAge, "max-age", 0, "remove messages older than this, e.g. 2160h")
+	retention.Flags().Int64Var(&maxBytes, "max-bytes", 0, "remove the oldest messages above this size in bytes")
+	retention.Flags().Int64Var(&maxMsgs, "max-msgs", 0, "remove the oldest messages above this count")
+	cmd.AddCommand(retention)
+
+	var keep uint64
+	compact := &cobra.Command{
+		Use:   "compact <stream>",
+		Short: "Keep only the last messages of every subject of a stream",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdmin(func(ctx context.Context, admin *events.StreamAdmin) (any, error) {
+				return admin.Compact(ctx, args[0], keep)
+			})(cmd, args)
+		},
+	}
+	compact.Flags().Uint64Var(&keep, "keep", 1, "messages to keep per subject")
+	cmd.AddCommand(compact)
+
+	return cmd
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/cluster"
+	"github.com/spf13/cobra"
+)
+
+// newWorkerCommand creates the command that runs this install as a worker
+// node: it fetches the sources listed in nats.worker_sources for the
+// coordinator until it is interrupted
+func newWorkerCommand(cfg internal.NATSConfig, sources []usecases.Source) *cobra.Command {
+	return &cobra.Command{
+		Use:   "worker",
+		Short: "Fetch import sources for the coordinator on the main node",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.URL == "" {
+				return fmt.Errorf("nats.url is not configured")
+			}
+			served, err := workerSources(sources, cfg.WorkerSources)
+			if err != nil {
+				return err
+			}
+
+			worker, err := cluster.NewWorker(cfg, served)
+			if err != nil {
+				return err
+			}
+			defer worker.Close()
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			heartbeat := worker.Heartbeat()
+			logger := internal.GetLogger()
+			logger.Info().Str("node", heartbeat.Node).Strs("sources", heartbeat.Sources).Msg("Worker started")
+			worker.Run(ctx)
+			logger.Info().Str("node", heartbeat.Node).Msg("Worker stopped")
+			return nil
+		},
+	}
+}
+
+// workerSources picks the sources a worker serves: the listed ones, or every
+// configured source with a client when none are listed
+func workerSources(sources []usecases.Source, ids []string) ([]usecases.Source, error) {
+	byID := make(map[string]usecases.Source, len(sources))
+	for _, source := range sources {
+		if source.Client != nil {
+			byID[source.ID()] = source
+		}
+	}
+
+	if len(ids) == 0 {
+		served := make([]usecases.Source, 0, len(byID))
+		for _, source := range sources {
+			if source.Client != nil {
+				served = append(served, source)
+			}
+		}
+		return served, nil
+	}
+
+	served := make([]usecases.Source, 0, len(ids))
+	for _, id := range ids {
+		source, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("nats.worker_sources: no configured source %q with a client", id)
+		}
+		served = append(served, source)
+	}
+	return served, nil
+}
@@ -0,0 +1,263 @@
+// Package address validates blockchain wallet addresses, so malformed
+// addresses are rejected when they are configured instead of failing every
+// sync with an opaque provider error.
+package address
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Chains with address validation
+const (
+	ChainEthereum = "ethereum"
+	ChainSolana   = "solana"
+	ChainBitcoin  = "bitcoin"
+)
+
+var (
+	// ErrInvalidAddress is returned for addresses that are malformed for their chain
+	ErrInvalidAddress = errors.New("invalid address")
+
+	// ErrChecksumMismatch is returned for addresses whose checksum does not match
+	ErrChecksumMismatch = errors.New("address checksum mismatch")
+)
+
+// Supports reports whether addresses of chain can be validated
+func Supports(chain string) bool {
+	switch strings.ToLower(chain) {
+	case ChainEthereum, ChainSolana, ChainBitcoin:
+		return true
+	}
+	return false
+}
+
+// Validate checks that address is well-formed for chain. Addresses of chains
+// without validation rules are accepted.
+func Validate(chain, address string) error {
+	switch strings.ToLower(chain) {
+	case ChainEthereum:
+		return ValidateEthereum(address)
+	case ChainSolana:
+		return ValidateSolana(address)
+	case ChainBitcoin:
+		return ValidateBitcoin(address)
+	}
+	return nil
+}
+
+// ValidateEthereum checks a 0x-prefixed, 20 byte hex address. Mixed-case
+// addresses must carry a valid EIP-55 checksum; all lower or all upper case
+// addresses carry none.
+func ValidateEthereum(address string) error {
+	digits, ok := strings.CutPrefix(address, "0x")
+	if !ok || len(digits) != 40 {
+		return fmt.Errorf("%w: ethereum addresses are 0x followed by 40 hex digits", ErrInvalidAddress)
+	}
+	if _, err := hex.DecodeString(digits); err != nil {
+		return fmt.Errorf("%w: ethereum addresses are 0x followed by 40 hex digits", ErrInvalidAddress)
+	}
+
+	if digits == strings.ToLower(digits) || digits == strings.ToUpper(digits) {
+		return nil
+	}
+	if address != ChecksumEthereum(address) {
+		return fmt.Errorf("%w: %s fails its EIP-55 checksum", ErrChecksumMismatch, address)
+	}
+	return nil
+}
+
+// ChecksumEthereum returns the EIP-55 mixed-case form of a hex address
+func ChecksumEthereum(address string) string {
+	digits := strings.ToLower(strings.TrimPrefix(address, "0x"))
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(digits))
+	sum := hash.Sum(nil)
+
+	out := []byte(digits)
+	for i, c := range out {
+		// A letter is upper case when its nibble of the hash is 8 or above
+		nibble := sum[i/2] >> 4
+		if i%2 == 1 {
+			nibble = sum[i/2] & 0x0f
+		}
+		if c >= 'a' && nibble >= 8 {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(out)
+}
+
+// ValidateSolana checks a base58 encoded 32 byte public key
+func ValidateSolana(address string) error {
+	key, err := decodeBase58(address)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	if len(key) != 32 {
+		return fmt.Errorf("%w: solana addresses are 32 byte keys, got %d bytes", ErrInvalidAddress, len(key))
+	}
+	return nil
+}
+
+// ValidateBitcoin checks a segwit address (bech32 for version 0, bech32m for
+// later versions) or a legacy base58check P2PKH or P2SH address, on mainnet,
+// testnet or regtest
+func ValidateBitcoin(address string) error {
+	lower := strings.ToLower(address)
+	for _, hrp := range []string{"bc", "tb", "bcrt"} {
+		if strings.HasPrefix(lower, hrp+"1") {
+			return validateSegwit(address, hrp)
+		}
+	}
+	return validateBase58Check(address)
+}
+
+// legacyVersions are the version bytes of P2PKH and P2SH addresses on mainnet and testnet
+var legacyVersions = map[byte]bool{0x00: true, 0x05: true, 0x6f: true, 0xc4: true}
+
+func validateBase58Check(address string) error {
+	data, err := decodeBase58(address)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	if len(data) != 25 || !legacyVersions[data[0]] {
+		return fmt.Errorf("%w: not a bitcoin address", ErrInvalidAddress)
+	}
+
+	first := sha256.Sum256(data[:21])
+	second := sha256.Sum256(first[:])
+	if string(second[:4]) != string(data[21:]) {
+		return fmt.Errorf("%w: %s fails its base58check checksum", ErrChecksumMismatch, address)
+	}
+	return nil
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// decodeBase58 decodes the Bitcoin base58 alphabet, which Solana uses as well
+func decodeBase58(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("empty base58 string")
+	}
+
+	var out []byte // big-endian, without the leading zeros
+	for _, c := range []byte(s) {
+		carry := strings.IndexByte(base58Alphabet, c)
+		if carry < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", c)
+		}
+		for i := len(out) - 1; i >= 0; i-- {
+			carry += int(out[i]) * 58
+			out[i] = byte(carry)
+			carry >>= 8
+		}
+		for ; carry > 0; carry >>= 8 {
+			out = append([]byte{byte(carry)}, out...)
+		}
+	}
+
+	// Every leading 1 encodes a leading zero byte
+	zeros := 0
+	for zeros < len(s) && s[zeros] == '1' {
+		zeros++
+	}
+	return append(make([]byte, zeros), out...), nil
+}
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// Checksum constants of bech32 (BIP-173) and bech32m (BIP-350)
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+func validateSegwit(address, hrp string) error {
+	if address != strings.ToLower(address) && address != strings.ToUpper(address) {
+		return fmt.Errorf("%w: bech32 addresses must not mix case", ErrInvalidAddress)
+	}
+	address = strings.ToLower(address)
+	if len(address) > 90 {
+		return fmt.Errorf("%w: bech32 addresses are at most 90 characters", ErrInvalidAddress)
+	}
+
+	data := make([]byte, 0, len(address)-len(hrp)-1)
+	for _, c := range []byte(address[len(hrp)+1:]) {
+		value := strings.IndexByte(bech32Charset, c)
+		if value < 0 {
+			return fmt.Errorf("%w: invalid bech32 character %q", ErrInvalidAddress, c)
+		}
+		data = append(data, byte(value))
+	}
+	if len(data) < 7 {
+		return fmt.Errorf("%w: bech32 address too short", ErrInvalidAddress)
+	}
+
+	version := data[0]
+	want := uint32(bech32Const)
+	if version > 0 {
+		want = bech32mConst
+	}
+	if bech32Polymod(hrp, data) != want {
+		return fmt.Errorf("%w: %s fails its bech32 checksum", ErrChecksumMismatch, address)
+	}
+
+	program, ok := regroupBits(data[1 : len(data)-6])
+	if !ok || version > 16 || len(program) < 2 || len(program) > 40 ||
+		version == 0 && len(program) != 20 && len(program) != 32 {
+		return fmt.Errorf("%w: invalid segwit program", ErrInvalidAddress)
+	}
+	return nil
+}
+
+// bech32Polymod computes the checksum of the human-readable part and the data
+func bech32Polymod(hrp string, data []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+	values := make([]byte, 0, len(hrp)*2+1+len(data))
+	for _, c := range []byte(hrp) {
+		values = append(values, c>>5)
+	}
+	values = append(values, 0)
+	for _, c := range []byte(hrp) {
+		values = append(values, c&31)
+	}
+	values = append(values, data...)
+
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := range generator {
+			if (top>>i)&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+// regroupBits converts 5-bit groups into bytes, rejecting non-zero padding
+func regroupBits(data []byte) ([]byte, bool) {
+	var out []byte
+	acc, bits := 0, 0
+	for _, value := range data {
+		acc = acc<<5 | int(value)
+		bits += 5
+		for bits >= 8 {
+			bits -= 8
+			out = append(out, byte(acc>>bits))
+		}
+	}
+	if bits >= 5 || (acc<<(8-bits))&0xff != 0 {
+		return nil, false
+	}
+	return out, true
+}
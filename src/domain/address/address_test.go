@@ -0,0 +1,54 @@
+package address
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		chain, address string
+		want           error
+	}{
+		// EIP-55 test vectors
+		{ChainEthereum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{ChainEthereum, "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", nil},
+		{ChainEthereum, "0xdbf03b407c01e7cd3cbea99509d93f8dddc8c6fb", nil},
+		{ChainEthereum, "0xDBF03B407C01E7CD3CBEA99509D93F8DDDC8C6FB", nil},
+		{ChainEthereum, "0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ErrChecksumMismatch},
+		{ChainEthereum, "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ErrInvalidAddress},
+		{ChainEthereum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAe", ErrInvalidAddress},
+		{ChainEthereum, "0xzzzeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ErrInvalidAddress},
+
+		{ChainSolana, "11111111111111111111111111111111", nil},
+		{ChainSolana, "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", nil},
+		{ChainSolana, "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5D0", ErrInvalidAddress}, // 0 is not base58
+		{ChainSolana, "4vJ9JU1bJJE96FWSJKvHsmmFADCg4gp", ErrInvalidAddress},             // too short for a key
+		{ChainSolana, "", ErrInvalidAddress},
+
+		{ChainBitcoin, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", nil},
+		{ChainBitcoin, "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", nil},
+		{ChainBitcoin, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb", ErrChecksumMismatch},
+		{ChainBitcoin, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", nil},
+		{ChainBitcoin, "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", nil},
+		{ChainBitcoin, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", nil},
+		{ChainBitcoin, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", ErrChecksumMismatch},
+		{ChainBitcoin, "bc1Qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", ErrInvalidAddress},
+
+		// Chains without rules accept anything
+		{"sui", "anything", nil},
+	}
+
+	for _, tt := range tests {
+		err := Validate(tt.chain, tt.address)
+		if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("Validate(%s, %q) = %v, want %v", tt.chain, tt.address, err, tt.want)
+		}
+	}
+}
+
+func TestChecksumEthereum(t *testing.T) {
+	if got := ChecksumEthereum("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"); got != "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed" {
+		t.Errorf("ChecksumEthereum() = %s", got)
+	}
+}
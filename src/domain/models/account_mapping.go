@@ -0,0 +1,52 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+	"github.com/google/uuid"
+)
+
+// AccountMapping links an account of an import source (wallet address, bank
+// account ID) to the Firefly III asset account its transactions are booked on.
+type AccountMapping struct {
+	ID               string    `json:"id"`
+	Source           string    `json:"source"`        // import source, e.g. ethereum, solana, enable
+	SourceAccount    string    `json:"sourceAccount"` // address or provider account ID
+	FireflyAccountID string    `json:"fireflyAccountId"`
+	IBAN             string    `json:"iban,omitempty"` // used to match existing Firefly accounts
+	AutoCreate       bool      `json:"autoCreate"`     // create the Firefly account when no match exists
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+// NewAccountMapping creates a new account mapping
+func NewAccountMapping(source, sourceAccount, fireflyAccountID string) *AccountMapping {
+	return &AccountMapping{
+		ID:               uuid.New().String(),
+		Source:           source,
+		SourceAccount:    sourceAccount,
+		FireflyAccountID: fireflyAccountID,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+}
+
+// Validate checks if the mapping is valid
+func (m *AccountMapping) Validate() error {
+	v := validation.New()
+	v.Required("source", m.Source, ErrMissingMappingSource)
+	v.Required("sourceAccount", m.SourceAccount, ErrMissingMappingSource)
+	return v.Err()
+}
+
+// IsResolved reports whether the mapping points at a Firefly account
+func (m *AccountMapping) IsResolved() bool {
+	return m.FireflyAccountID != ""
+}
+
+// NormalizeIBAN strips spaces and upper-cases an IBAN for comparison
+func NormalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.ReplaceAll(iban, " ", ""))
+}
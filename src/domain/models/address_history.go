@@ -0,0 +1,122 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Metadata keys source clients set on blockchain transactions
+const (
+	MetadataAddress      = "address"      // address of the wallet the transaction was fetched for
+	MetadataCounterparty = "counterparty" // address on the other side of the transfer
+	MetadataInternalMove = "internalMove" // set on the fee of a move between addresses of one wallet
+)
+
+// MergeAddressHistories merges the transactions fetched for the addresses of
+// one wallet into its history. Each transaction is tagged with the address it
+// was fetched for, and a transaction reported for several addresses is kept
+// once. Moves between two addresses of the wallet do not change its balance,
+// so both sides are dropped; a fee recorded on the sending side is kept as a
+// network fee expense.
+func MergeAddressHistories(histories map[string][]Transaction, addresses []string) []Transaction {
+	owned := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		owned[strings.ToLower(address)] = true
+	}
+
+	var merged []Transaction
+	seen := make(map[string]bool)
+	for _, address := range addresses {
+		for _, tx := range histories[address] {
+			tx.MergeMetadata(map[string]string{MetadataAddress: address})
+
+			counterparty := strings.ToLower(tx.Metadata[MetadataCounterparty])
+			if counterparty != "" && counterparty != strings.ToLower(address) && owned[counterparty] {
+				if tx.Type != TransactionTypeExpense || tx.Fee <= 0 {
+					continue
+				}
+				tx = internalMoveFee(tx)
+			}
+
+			if tx.ID != "" {
+				if seen[tx.ID] {
+					continue
+				}
+				seen[tx.ID] = true
+			}
+			merged = append(merged, tx)
+		}
+	}
+	return merged
+}
+
+// internalMoveFee reduces the sending side of a move between addresses of one
+// wallet to the network fee it paid
+func internalMoveFee(tx Transaction) Transaction {
+	metadata := make(map[string]string, len(tx.Metadata)+2)
+	for key, value := range tx.Metadata {
+		metadata[key] = value
+	}
+	metadata[MetadataCategoryHint] = CategoryNetworkFees
+	metadata[MetadataInternalMove] = "true"
+
+	tx.Metadata = metadata
+	tx.Amount = tx.Fee
+	tx.Fee = 0
+	tx.Description = "Network fee of a move between own addresses"
+	tx.Tags = append([]string{TagNetworkFee}, tx.Tags...)
+	return tx
+}
+
+// AddressCursor is the backfill position of a wallet with several addresses.
+// The addresses are backfilled one after another, each with its own cursor.
+type AddressCursor struct {
+	Address string `json:"address"`
+	Cursor  string `json:"cursor,omitempty"` // cursor of the source client within the address
+}
+
+// ParseAddressCursor decodes a backfill cursor of a wallet with several
+// addresses. Cursors stored before the wallet had several addresses, and the
+// empty cursor, resume the first address.
+func ParseAddressCursor(cursor string, addresses []string) AddressCursor {
+	var position AddressCursor
+	if cursor == "" || json.Unmarshal([]byte(cursor), &position) != nil || position.Address == "" {
+		position = AddressCursor{Cursor: cursor}
+		if len(addresses) > 0 {
+			position.Address = addresses[0]
+		}
+	}
+	return position
+}
+
+// String encodes the cursor for storage in a backfill
+func (c AddressCursor) String() string {
+	data, _ := json.Marshal(c)
+	return string(data)
+}
+
+// AdvanceAddressPage turns a page of one address into a page of the wallet:
+// the cursor records the address, a finished address continues with the next
+// one and the progress spans all addresses
+func AdvanceAddressPage(page TransactionPage, position AddressCursor, addresses []string) TransactionPage {
+	index := 0
+	for i, address := range addresses {
+		if address == position.Address {
+			index = i
+		}
+	}
+
+	progress := page.Progress
+	if page.Done {
+		progress = 1
+	}
+	page.Progress = (float64(index) + min(max(progress, 0), 1)) / float64(max(len(addresses), 1))
+
+	next := AddressCursor{Address: position.Address, Cursor: page.NextCursor}
+	if page.Done && index+1 < len(addresses) {
+		next = AddressCursor{Address: addresses[index+1]}
+		page.Done = false
+	}
+	page.NextCursor = next.String()
+	return page
+}
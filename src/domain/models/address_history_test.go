@@ -0,0 +1,64 @@
+package models
+
+import "testing"
+
+func TestMergeAddressHistories(t *testing.T) {
+	addresses := []string{"0xA", "0xB"}
+	histories := map[string][]Transaction{
+		"0xA": {
+			{ID: "0x1", Type: TransactionTypeIncome, Amount: 2, Metadata: map[string]string{MetadataCounterparty: "0xexternal"}},
+			// Move to the second address, paying a fee
+			{ID: "0x2", Type: TransactionTypeExpense, Amount: 1, Fee: 0.01, Metadata: map[string]string{MetadataCounterparty: "0xb"}},
+		},
+		"0xB": {
+			{ID: "0x2", Type: TransactionTypeIncome, Amount: 1, Metadata: map[string]string{MetadataCounterparty: "0xa"}},
+			// A payment both addresses report
+			{ID: "0x1", Type: TransactionTypeIncome, Amount: 2, Metadata: map[string]string{MetadataCounterparty: "0xexternal"}},
+			{ID: "0x3", Type: TransactionTypeExpense, Amount: 0.5},
+		},
+	}
+
+	merged := MergeAddressHistories(histories, addresses)
+	if len(merged) != 3 {
+		t.Fatalf("MergeAddressHistories() = %+v, want 3 transactions", merged)
+	}
+	if merged[0].ID != "0x1" || merged[0].Metadata[MetadataAddress] != "0xA" {
+		t.Errorf("first transaction = %+v, want 0x1 of the first address", merged[0])
+	}
+	fee := merged[1]
+	if fee.ID != "0x2" || fee.Type != TransactionTypeExpense || fee.Amount != 0.01 || fee.Fee != 0 ||
+		fee.Metadata[MetadataInternalMove] != "true" || fee.Metadata[MetadataCategoryHint] != CategoryNetworkFees {
+		t.Errorf("internal move = %+v, want only its fee as an expense", fee)
+	}
+	if merged[2].ID != "0x3" || merged[2].Metadata[MetadataAddress] != "0xB" {
+		t.Errorf("last transaction = %+v, want 0x3 tagged with the second address", merged[2])
+	}
+}
+
+func TestAddressCursor(t *testing.T) {
+	addresses := []string{"0xA", "0xB"}
+
+	// Backfills started before the wallet had several addresses resume the first
+	position := ParseAddressCursor("legacy-cursor", addresses)
+	if position.Address != "0xA" || position.Cursor != "legacy-cursor" {
+		t.Errorf("ParseAddressCursor() = %+v", position)
+	}
+
+	page := AdvanceAddressPage(TransactionPage{NextCursor: "page-2", Progress: 0.5}, position, addresses)
+	if page.Done || page.Progress != 0.25 {
+		t.Errorf("page of the first address = %+v, want a quarter of the progress", page)
+	}
+	if next := ParseAddressCursor(page.NextCursor, addresses); next.Address != "0xA" || next.Cursor != "page-2" {
+		t.Errorf("next cursor = %+v", next)
+	}
+
+	page = AdvanceAddressPage(TransactionPage{NextCursor: "end", Done: true}, position, addresses)
+	if next := ParseAddressCursor(page.NextCursor, addresses); page.Done || next.Address != "0xB" || next.Cursor != "" || page.Progress != 0.5 {
+		t.Errorf("last page of the first address = %+v, want the second address to start", page)
+	}
+
+	page = AdvanceAddressPage(TransactionPage{Done: true}, ParseAddressCursor(page.NextCursor, addresses), addresses)
+	if !page.Done || page.Progress != 1 {
+		t.Errorf("last page of the last address = %+v, want the backfill done", page)
+	}
+}
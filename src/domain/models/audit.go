@@ -0,0 +1,148 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// AuditAction is what an audit entry records
+type AuditAction string
+
+const (
+	// AuditActionCreate records a created record
+	AuditActionCreate AuditAction = "create"
+
+	// AuditActionUpdate records an updated record
+	AuditActionUpdate AuditAction = "update"
+
+	// AuditActionDelete records a deleted record
+	AuditActionDelete AuditAction = "delete"
+
+	// AuditActionRequest records a mutating API request, such as a sync or a
+	// merge, whatever records it changed
+	AuditActionRequest AuditAction = "request"
+)
+
+// AuditActorType tells who made a change
+type AuditActorType string
+
+const (
+	// AuditActorUser is an authenticated user
+	AuditActorUser AuditActorType = "user"
+
+	// AuditActorSuperuser is an authenticated superuser
+	AuditActorSuperuser AuditActorType = "superuser"
+
+	// AuditActorSystem is the server itself, e.g. a scheduled sync
+	AuditActorSystem AuditActorType = "system"
+)
+
+// AuditSource tells through which path a change was made
+type AuditSource string
+
+const (
+	// AuditSourceAPI is a request to the record API or the custom routes
+	AuditSourceAPI AuditSource = "api"
+
+	// AuditSourceHook is a hook reacting to another change
+	AuditSourceHook AuditSource = "hook"
+
+	// AuditSourceImport is an import from a source, a statement or a budget export
+	AuditSourceImport AuditSource = "import"
+
+	// AuditSourceSystem is a scheduled job or a startup task
+	AuditSourceSystem AuditSource = "system"
+)
+
+// auditIgnoredFields are system fields, or change on every save, and say
+// nothing about the change
+var auditIgnoredFields = map[string]bool{
+	"id":             true,
+	"collectionId":   true,
+	"collectionName": true,
+	"created":        true,
+	"updated":        true,
+	"version":        true,
+}
+
+// AuditChange is the value of a field before and after a change
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditOrigin is who made a change and through which path. It travels in the
+// context of the request or job making the change.
+type AuditOrigin struct {
+	ActorID   string         `json:"actorId,omitempty"`
+	ActorType AuditActorType `json:"actorType"`
+	Source    AuditSource    `json:"source"`
+}
+
+// AuditEntry is an append-only record of a change or a mutating request
+type AuditEntry struct {
+	ID         string                 `json:"id"`
+	Action     AuditAction            `json:"action"`
+	Collection string                 `json:"collection,omitempty"`
+	RecordID   string                 `json:"recordId,omitempty"`
+	Request    string                 `json:"request,omitempty"` // method and path of a request entry
+	Status     int                    `json:"status,omitempty"`  // response status of a request entry
+	Changes    map[string]AuditChange `json:"changes,omitempty"`
+	At         time.Time              `json:"at"`
+	AuditOrigin
+}
+
+type auditOriginKey struct{}
+
+// WithAuditOrigin returns a context attributing the changes made with it to origin
+func WithAuditOrigin(ctx context.Context, origin AuditOrigin) context.Context {
+	return context.WithValue(ctx, auditOriginKey{}, origin)
+}
+
+// WithAuditSource returns a context attributing the changes made with it to
+// the same actor as ctx, through another path
+func WithAuditSource(ctx context.Context, source AuditSource) context.Context {
+	origin := AuditOriginFrom(ctx)
+	origin.Source = source
+	return WithAuditOrigin(ctx, origin)
+}
+
+// AuditOriginFrom returns the origin carried by ctx. Changes made without one
+// are attributed to the system.
+func AuditOriginFrom(ctx context.Context) AuditOrigin {
+	if ctx != nil {
+		if origin, ok := ctx.Value(auditOriginKey{}).(AuditOrigin); ok {
+			return origin
+		}
+	}
+	return AuditOrigin{ActorType: AuditActorSystem, Source: AuditSourceSystem}
+}
+
+// AuditDiff returns the fields whose value differs between two exports of a
+// record. A nil before lists every field of a created record, a nil after
+// every field of a deleted one.
+func AuditDiff(before, after map[string]any) map[string]AuditChange {
+	changes := make(map[string]AuditChange)
+	for field, old := range before {
+		current, ok := after[field]
+		if auditIgnoredFields[field] || (ok && sameAuditValue(old, current)) {
+			continue
+		}
+		changes[field] = AuditChange{Before: old, After: current}
+	}
+	for field, current := range after {
+		if _, ok := before[field]; !ok && !auditIgnoredFields[field] {
+			changes[field] = AuditChange{After: current}
+		}
+	}
+	return changes
+}
+
+// sameAuditValue compares values by their JSON encoding, as stored
+// values and the values set on a record often differ in type only
+func sameAuditValue(a, b any) bool {
+	encodedA, errA := json.Marshal(a)
+	encodedB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(encodedA) == string(encodedB)
+}
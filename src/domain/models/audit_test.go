@@ -0,0 +1,63 @@
+package models
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestAuditDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		before map[string]any
+		after  map[string]any
+		want   map[string]AuditChange
+	}{
+		{
+			name:   "create lists every field but the system ones",
+			before: nil,
+			after:  map[string]any{"id": "tx1", "amount": 12.5, "created": "2025-01-01", "version": 1},
+			want:   map[string]AuditChange{"amount": {After: 12.5}},
+		},
+		{
+			name:   "update lists changed fields only",
+			before: map[string]any{"amount": 12.5, "description": "Coffee", "version": 1, "updated": "a"},
+			after:  map[string]any{"amount": 13.0, "description": "Coffee", "version": 2, "updated": "b"},
+			want:   map[string]AuditChange{"amount": {Before: 12.5, After: 13.0}},
+		},
+		{
+			name:   "values compared by their encoding",
+			before: map[string]any{"amount": 12, "tags": []any{"food"}},
+			after:  map[string]any{"amount": 12.0, "tags": []string{"food"}},
+			want:   map[string]AuditChange{},
+		},
+		{
+			name:   "delete lists every field",
+			before: map[string]any{"name": "Groceries"},
+			after:  nil,
+			want:   map[string]AuditChange{"name": {Before: "Groceries"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AuditDiff(tt.before, tt.after); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AuditDiff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuditOrigin(t *testing.T) {
+	system := AuditOriginFrom(context.Background())
+	if system.ActorType != AuditActorSystem || system.Source != AuditSourceSystem {
+		t.Errorf("AuditOriginFrom() without origin = %+v, want the system", system)
+	}
+
+	ctx := WithAuditOrigin(context.Background(), AuditOrigin{ActorID: "u1", ActorType: AuditActorUser, Source: AuditSourceAPI})
+	imported := AuditOriginFrom(WithAuditSource(ctx, AuditSourceImport))
+	want := AuditOrigin{ActorID: "u1", ActorType: AuditActorUser, Source: AuditSourceImport}
+	if imported != want {
+		t.Errorf("AuditOriginFrom() = %+v, want %+v", imported, want)
+	}
+}
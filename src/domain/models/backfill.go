@@ -0,0 +1,79 @@
+package models
+
+import "time"
+
+// BackfillStatus is the state of a source backfill
+type BackfillStatus string
+
+const (
+	BackfillRunning   BackfillStatus = "running"
+	BackfillFailed    BackfillStatus = "failed" // stopped by an error, resumed on the next start
+	BackfillCompleted BackfillStatus = "completed"
+)
+
+// TransactionPage is one page of the full history of an account, oldest first
+type TransactionPage struct {
+	Transactions []Transaction
+	Filtered     TokenFilterStats
+	NextCursor   string  // resumes after this page
+	Progress     float64 // estimated fraction of the history fetched after this page, 0 to 1
+	Done         bool    // no pages follow
+}
+
+// Backfill is the checkpointed import of the full history of a source. The
+// cursor of the last imported page is stored after every page, so a backfill
+// interrupted by an error or a restart resumes where it stopped.
+type Backfill struct {
+	ID          string         `json:"id"`
+	SourceID    string         `json:"sourceId"`
+	Status      BackfillStatus `json:"status"`
+	Cursor      string         `json:"-"` // opaque to everything but the source client
+	Pages       int            `json:"pages"`
+	Imported    int            `json:"imported"`
+	Progress    float64        `json:"progress"` // percentage, 0 to 100
+	Error       string         `json:"error,omitempty"`
+	StartedAt   time.Time      `json:"startedAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+	CompletedAt time.Time      `json:"completedAt,omitempty"`
+}
+
+// NewBackfill creates a backfill of a source starting at the oldest page
+func NewBackfill(sourceID string) *Backfill {
+	now := time.Now()
+	return &Backfill{
+		SourceID:  sourceID,
+		Status:    BackfillRunning,
+		StartedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Advance checkpoints a page that was imported
+func (b *Backfill) Advance(page TransactionPage, imported int) {
+	b.Cursor = page.NextCursor
+	b.Pages++
+	b.Imported += imported
+	b.Progress = min(max(page.Progress, 0), 1) * 100
+	b.Error = ""
+	b.UpdatedAt = time.Now()
+
+	if page.Done {
+		b.Status = BackfillCompleted
+		b.Progress = 100
+		b.CompletedAt = b.UpdatedAt
+	}
+}
+
+// Fail stops the backfill at its last checkpoint
+func (b *Backfill) Fail(err error) {
+	b.Status = BackfillFailed
+	b.Error = err.Error()
+	b.UpdatedAt = time.Now()
+}
+
+// Resume marks a stopped backfill as running again from its last checkpoint
+func (b *Backfill) Resume() {
+	b.Status = BackfillRunning
+	b.Error = ""
+	b.UpdatedAt = time.Now()
+}
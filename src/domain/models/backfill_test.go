@@ -0,0 +1,29 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBackfill_Checkpoints(t *testing.T) {
+	backfill := NewBackfill("ethereum:0xabc")
+
+	backfill.Advance(TransactionPage{NextCursor: "page-2", Progress: 0.25}, 40)
+	if backfill.Cursor != "page-2" || backfill.Pages != 1 || backfill.Imported != 40 || backfill.Progress != 25 {
+		t.Errorf("after first page backfill = %+v", backfill)
+	}
+
+	backfill.Fail(errors.New("rate limited"))
+	if backfill.Status != BackfillFailed || backfill.Cursor != "page-2" {
+		t.Errorf("a failed backfill must keep its checkpoint, got %+v", backfill)
+	}
+
+	backfill.Resume()
+	backfill.Advance(TransactionPage{NextCursor: "page-3", Progress: 1.5, Done: true}, 10)
+	if backfill.Status != BackfillCompleted || backfill.Progress != 100 || backfill.Error != "" {
+		t.Errorf("after last page backfill = %+v", backfill)
+	}
+	if backfill.Imported != 50 || backfill.CompletedAt.IsZero() {
+		t.Errorf("completed backfill imported %d, completed at %v", backfill.Imported, backfill.CompletedAt)
+	}
+}
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+)
+
+// BalanceSide is one of the balances of a source account an assertion compares
+type BalanceSide string
+
+const (
+	// BalanceSideLocal is the balance of the wallet computed from its transactions
+	BalanceSideLocal BalanceSide = "local"
+
+	// BalanceSideProvider is the balance the bank or chain reports
+	BalanceSideProvider BalanceSide = "provider"
+
+	// BalanceSideFirefly is the balance of the Firefly asset account the source is mapped to
+	BalanceSideFirefly BalanceSide = "firefly"
+)
+
+// DefaultBalanceSides are compared by assertions that do not choose their sides
+var DefaultBalanceSides = []BalanceSide{BalanceSideLocal, BalanceSideProvider}
+
+// Valid reports whether the side is a known balance side
+func (s BalanceSide) Valid() bool {
+	switch s {
+	case BalanceSideLocal, BalanceSideProvider, BalanceSideFirefly:
+		return true
+	}
+	return false
+}
+
+// BalanceAssertion expects the balances of a source account to agree, e.g.
+// "checking should equal the bank-reported balance daily at 07:00". It is
+// checked on its cron schedule and alerts when the balances drift apart by
+// more than the tolerance.
+type BalanceAssertion struct {
+	ID        string        `json:"id"`
+	Name      string        `json:"name"`
+	SourceID  string        `json:"sourceId"`
+	Compare   []BalanceSide `json:"compare"`   // at least two sides; defaults to local and provider
+	Schedule  string        `json:"schedule"`  // cron expression, e.g. "0 7 * * *"
+	Tolerance float64       `json:"tolerance"` // largest drift that passes, zero for the default tolerance
+	Enabled   bool          `json:"enabled"`
+	CreatedAt time.Time     `json:"createdAt,omitempty"`
+	UpdatedAt time.Time     `json:"updatedAt,omitempty"`
+}
+
+// Sides returns the balances the assertion compares
+func (a *BalanceAssertion) Sides() []BalanceSide {
+	if len(a.Compare) == 0 {
+		return DefaultBalanceSides
+	}
+	return a.Compare
+}
+
+// Validate checks that the assertion names a source, compares at least two
+// distinct known sides and has a schedule. The schedule syntax is checked by
+// the service that runs it.
+func (a *BalanceAssertion) Validate() error {
+	v := validation.New()
+	v.Required("name", a.Name, fmt.Errorf("%w: must have a name", ErrInvalidBalanceAssertion))
+	v.Required("sourceId", a.SourceID, fmt.Errorf("%w: must name a source", ErrInvalidBalanceAssertion))
+	v.Required("schedule", a.Schedule, fmt.Errorf("%w: must have a schedule", ErrInvalidBalanceAssertion))
+	v.Check(a.Tolerance >= 0, "tolerance", validation.RuleMin,
+		fmt.Errorf("%w: tolerance must not be negative", ErrInvalidBalanceAssertion))
+
+	seen := make(map[BalanceSide]bool, len(a.Compare))
+	for _, side := range a.Compare {
+		v.Check(side.Valid(), "compare", validation.RuleOneOf,
+			fmt.Errorf("%w: unknown balance side %q, want local, provider or firefly", ErrInvalidBalanceAssertion, side))
+		v.Check(!seen[side], "compare", validation.RuleDistinct,
+			fmt.Errorf("%w: balance side %q compared twice", ErrInvalidBalanceAssertion, side))
+		seen[side] = true
+	}
+	v.Check(len(a.Compare) == 0 || len(a.Compare) >= 2, "compare", validation.RuleMin,
+		fmt.Errorf("%w: must compare at least two balances", ErrInvalidBalanceAssertion))
+	return v.Err()
+}
+
+// BalanceAssertionResult is the outcome of one check of a balance assertion
+type BalanceAssertionResult struct {
+	ID          string                  `json:"id"`
+	AssertionID string                  `json:"assertionId"`
+	WalletID    string                  `json:"walletId,omitempty"` // wallet of the source, empty when it could not be resolved
+	CheckedAt   time.Time               `json:"checkedAt"`
+	Currency    string                  `json:"currency,omitempty"`
+	Balances    map[BalanceSide]float64 `json:"balances"` // the balance of every side that could be read
+	Drift       float64                 `json:"drift"`    // largest minus smallest balance
+	Tolerance   float64                 `json:"tolerance"`
+	Passed      bool                    `json:"passed"`
+	Error       string                  `json:"error,omitempty"` // set when a balance could not be read; the check then fails
+}
+
+// Evaluate sets the drift between the balances and whether it is within the
+// tolerance. A result with an error never passes.
+func (r *BalanceAssertionResult) Evaluate() {
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, balance := range r.Balances {
+		low = math.Min(low, balance)
+		high = math.Max(high, balance)
+	}
+	r.Drift = 0
+	if len(r.Balances) > 1 {
+		r.Drift = high - low
+	}
+	r.Passed = r.Error == "" && len(r.Balances) > 1 && r.Drift <= r.Tolerance+BalanceTolerance
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+)
+
+func TestBalanceAssertion_Validate(t *testing.T) {
+	valid := BalanceAssertion{Name: "Checking", SourceID: "gocardless:acc-1", Schedule: "0 7 * * *"}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if sides := valid.Sides(); len(sides) != 2 || sides[0] != BalanceSideLocal || sides[1] != BalanceSideProvider {
+		t.Errorf("Sides() = %v, want the defaults", sides)
+	}
+
+	tests := []struct {
+		name      string
+		assertion BalanceAssertion
+		field     string
+	}{
+		{"no source", BalanceAssertion{Name: "Checking", Schedule: "0 7 * * *"}, "sourceId"},
+		{"no schedule", BalanceAssertion{Name: "Checking", SourceID: "s"}, "schedule"},
+		{"negative tolerance", BalanceAssertion{Name: "Checking", SourceID: "s", Schedule: "@daily", Tolerance: -1}, "tolerance"},
+		{"one side", BalanceAssertion{Name: "Checking", SourceID: "s", Schedule: "@daily", Compare: []BalanceSide{BalanceSideLocal}}, "compare"},
+		{"unknown side", BalanceAssertion{Name: "Checking", SourceID: "s", Schedule: "@daily", Compare: []BalanceSide{BalanceSideLocal, "ledger"}}, "compare"},
+		{"same side twice", BalanceAssertion{Name: "Checking", SourceID: "s", Schedule: "@daily", Compare: []BalanceSide{BalanceSideFirefly, BalanceSideFirefly}}, "compare"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.assertion.Validate()
+			if !errors.Is(err, ErrInvalidBalanceAssertion) {
+				t.Fatalf("Validate() error = %v, want ErrInvalidBalanceAssertion", err)
+			}
+			if fields := validation.FieldErrors(err); len(fields) == 0 || fields[0].Field != tt.field {
+				t.Errorf("Validate() fields = %v, want %s", fields, tt.field)
+			}
+		})
+	}
+}
+
+func TestBalanceAssertionResult_Evaluate(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     BalanceAssertionResult
+		wantDrift  float64
+		wantPassed bool
+	}{
+		{"within tolerance", BalanceAssertionResult{Balances: map[BalanceSide]float64{BalanceSideLocal: 100, BalanceSideProvider: 100.005}, Tolerance: 0.01}, 0.005, true},
+		{"drifted", BalanceAssertionResult{Balances: map[BalanceSide]float64{BalanceSideLocal: 100, BalanceSideProvider: 90, BalanceSideFirefly: 95}, Tolerance: 0.01}, 10, false},
+		{"exactly at tolerance", BalanceAssertionResult{Balances: map[BalanceSide]float64{BalanceSideLocal: 10, BalanceSideFirefly: 11}, Tolerance: 1}, 1, true},
+		{"balance unreadable", BalanceAssertionResult{Balances: map[BalanceSide]float64{BalanceSideLocal: 100}, Error: "provider down"}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.result.Evaluate()
+			if diff := tt.result.Drift - tt.wantDrift; diff > 1e-9 || diff < -1e-9 || tt.result.Passed != tt.wantPassed {
+				t.Errorf("Evaluate() = drift %v, passed %v, want %v, %v", tt.result.Drift, tt.result.Passed, tt.wantDrift, tt.wantPassed)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package models
+
+import "math"
+
+// BalanceTolerance is the largest difference between a stored and a replayed
+// balance that is still treated as rounding noise
+const BalanceTolerance = 1e-8
+
+// WalletBalanceCheck compares a stored wallet balance with the balance obtained
+// by replaying all of the wallet's transactions on its opening balance
+type WalletBalanceCheck struct {
+	WalletID         string  `json:"walletId"`
+	Name             string  `json:"name"`
+	Currency         string  `json:"currency"`
+	OpeningBalance   float64 `json:"openingBalance"` // the replay starts from it
+	StoredBalance    float64 `json:"storedBalance"`
+	ReplayedBalance  float64 `json:"replayedBalance"`
+	TransactionCount int     `json:"transactionCount"`
+	Fixed            bool    `json:"fixed,omitempty"`
+}
+
+// Difference returns how far the stored balance is from the replayed balance
+func (c *WalletBalanceCheck) Difference() float64 {
+	return c.StoredBalance - c.ReplayedBalance
+}
+
+// HasDiscrepancy reports whether the stored balance has drifted
+func (c *WalletBalanceCheck) HasDiscrepancy() bool {
+	return math.Abs(c.Difference()) > BalanceTolerance
+}
@@ -0,0 +1,124 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BalanceType identifies which of an account's balances a provider reported,
+// using the ISO 20022 balance type names banks expose through PSD2 APIs
+type BalanceType string
+
+const (
+	// BalanceTypeClosingBooked is the booked balance at the end of the last business day
+	BalanceTypeClosingBooked BalanceType = "closingBooked"
+
+	// BalanceTypeOpeningBooked is the booked balance at the start of the business day
+	BalanceTypeOpeningBooked BalanceType = "openingBooked"
+
+	// BalanceTypeInterimBooked is the booked balance during the business day
+	BalanceTypeInterimBooked BalanceType = "interimBooked"
+
+	// BalanceTypeInterimAvailable is the balance available to spend right now
+	BalanceTypeInterimAvailable BalanceType = "interimAvailable"
+
+	// BalanceTypeExpected includes pending transactions
+	BalanceTypeExpected BalanceType = "expected"
+
+	// BalanceTypeForwardAvailable is the balance available at a future date
+	BalanceTypeForwardAvailable BalanceType = "forwardAvailable"
+)
+
+// DefaultBalanceTypes is the order in which balance types drive reconciliation
+// when none is configured: settled balances before intraday ones
+var DefaultBalanceTypes = []BalanceType{
+	BalanceTypeClosingBooked,
+	BalanceTypeInterimBooked,
+	BalanceTypeInterimAvailable,
+	BalanceTypeExpected,
+}
+
+// Validate checks if the balance type is known
+func (t BalanceType) Validate() error {
+	switch t {
+	case BalanceTypeClosingBooked, BalanceTypeOpeningBooked, BalanceTypeInterimBooked,
+		BalanceTypeInterimAvailable, BalanceTypeExpected, BalanceTypeForwardAvailable:
+		return nil
+	}
+	return fmt.Errorf("%q: %w", t, ErrInvalidBalanceType)
+}
+
+// ReportedBalance is one balance of an account as reported by its provider
+type ReportedBalance struct {
+	Amount        float64     `json:"amount"`
+	Currency      string      `json:"currency"`
+	BalanceType   BalanceType `json:"balanceType"`
+	ReferenceDate time.Time   `json:"referenceDate"` // when the provider computed the balance
+}
+
+// SelectBalance returns the balance of the first preferred type the provider
+// reported. Among balances of the same type the most recent one wins.
+func SelectBalance(balances []ReportedBalance, preferred []BalanceType) (ReportedBalance, bool) {
+	if len(preferred) == 0 {
+		preferred = DefaultBalanceTypes
+	}
+
+	for _, balanceType := range preferred {
+		var selected ReportedBalance
+		found := false
+		for _, balance := range balances {
+			if balance.BalanceType != balanceType {
+				continue
+			}
+			if !found || balance.ReferenceDate.After(selected.ReferenceDate) {
+				selected, found = balance, true
+			}
+		}
+		if found {
+			return selected, true
+		}
+	}
+
+	return ReportedBalance{}, false
+}
+
+// BalanceSnapshot records the balance of a wallet at a point in time
+type BalanceSnapshot struct {
+	ID          string      `json:"id"`
+	WalletID    string      `json:"walletId"`
+	Balance     float64     `json:"balance"`
+	Currency    string      `json:"currency"`
+	Source      string      `json:"source"`                // local, or the provider that reported the balance
+	BalanceType BalanceType `json:"balanceType,omitempty"` // empty for local snapshots
+	TakenAt     time.Time   `json:"takenAt"`
+}
+
+// BalanceSnapshotSourceLocal marks snapshots taken from the locally computed wallet balance
+const BalanceSnapshotSourceLocal = "local"
+
+// NewBalanceSnapshot creates a snapshot of the wallet's current balance
+func NewBalanceSnapshot(wallet *Wallet, source string, takenAt time.Time) *BalanceSnapshot {
+	return &BalanceSnapshot{
+		ID:       uuid.New().String(),
+		WalletID: wallet.ID,
+		Balance:  wallet.Balance,
+		Currency: wallet.Currency,
+		Source:   source,
+		TakenAt:  takenAt,
+	}
+}
+
+// NewReportedBalanceSnapshot creates a snapshot of a balance reported by a provider
+func NewReportedBalanceSnapshot(walletID, source string, balance ReportedBalance, takenAt time.Time) *BalanceSnapshot {
+	return &BalanceSnapshot{
+		ID:          uuid.New().String(),
+		WalletID:    walletID,
+		Balance:     balance.Amount,
+		Currency:    balance.Currency,
+		Source:      source,
+		BalanceType: balance.BalanceType,
+		TakenAt:     takenAt,
+	}
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSelectBalance(t *testing.T) {
+	morning := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	noon := morning.Add(4 * time.Hour)
+	balances := []ReportedBalance{
+		{Amount: 90, Currency: "EUR", BalanceType: BalanceTypeInterimAvailable, ReferenceDate: morning},
+		{Amount: 95, Currency: "EUR", BalanceType: BalanceTypeInterimAvailable, ReferenceDate: noon},
+		{Amount: 100, Currency: "EUR", BalanceType: BalanceTypeClosingBooked, ReferenceDate: morning},
+	}
+
+	tests := []struct {
+		name      string
+		preferred []BalanceType
+		want      float64
+		wantFound bool
+	}{
+		{"defaults prefer booked", nil, 100, true},
+		{"latest of a type wins", []BalanceType{BalanceTypeInterimAvailable}, 95, true},
+		{"falls through the preference", []BalanceType{BalanceTypeExpected, BalanceTypeInterimAvailable}, 95, true},
+		{"no preferred type reported", []BalanceType{BalanceTypeForwardAvailable}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := SelectBalance(balances, tt.preferred)
+			if found != tt.wantFound || got.Amount != tt.want {
+				t.Errorf("SelectBalance() = %v, %v, want %v, %v", got.Amount, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestBalanceType_Validate(t *testing.T) {
+	if err := BalanceTypeExpected.Validate(); err != nil {
+		t.Errorf("Validate(expected) error = %v", err)
+	}
+	if err := BalanceType("CLBD").Validate(); !errors.Is(err, ErrInvalidBalanceType) {
+		t.Errorf("Validate(CLBD) error = %v, want %v", err, ErrInvalidBalanceType)
+	}
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// BankStatement is an account statement or intraday report exported by a bank,
+// e.g. an ISO 20022 camt.053 or camt.052 file
+type BankStatement struct {
+	ID       string            `json:"id"` // statement ID assigned by the bank
+	IBAN     string            `json:"iban"`
+	Currency string            `json:"currency"`
+	Balances []ReportedBalance `json:"balances"` // balances the statement asserts
+	Entries  []BankEntry       `json:"entries"`
+}
+
+// BankEntry is a single booking on a bank statement
+type BankEntry struct {
+	ID               string    `json:"id"` // bank reference, unique for the account
+	Date             time.Time `json:"date"`
+	Amount           float64   `json:"amount"` // always positive; Credit tells the direction
+	Credit           bool      `json:"credit"`
+	Booked           bool      `json:"booked"` // false for pending entries of intraday reports
+	Counterparty     string    `json:"counterparty,omitempty"`
+	CounterpartyIBAN string    `json:"counterpartyIban,omitempty"`
+	Reference        string    `json:"reference,omitempty"`
+	EndToEndID       string    `json:"endToEndId,omitempty"`
+	Memo             string    `json:"memo,omitempty"`
+}
+
+// Signed returns the amount of the entry, negative for debits
+func (e BankEntry) Signed() float64 {
+	if e.Credit {
+		return e.Amount
+	}
+	return -e.Amount
+}
+
+// Balance returns the most recent balance of the given type the statement asserts
+func (s BankStatement) Balance(balanceType BalanceType) (ReportedBalance, bool) {
+	return SelectBalance(s.Balances, []BalanceType{balanceType})
+}
+
+// Unbalanced returns the difference between the closing balance the statement
+// asserts and the one its opening balance and booked entries add up to. It is
+// zero when the statement lacks either balance.
+func (s BankStatement) Unbalanced() float64 {
+	opening, ok := s.Balance(BalanceTypeOpeningBooked)
+	if !ok {
+		return 0
+	}
+	closing, ok := s.Balance(BalanceTypeClosingBooked)
+	if !ok {
+		return 0
+	}
+
+	computed := opening.Amount
+	for _, entry := range s.Entries {
+		if entry.Booked {
+			computed += entry.Signed()
+		}
+	}
+	return math.Round((closing.Amount-computed)*100) / 100
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBankStatement_Unbalanced(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	statement := BankStatement{
+		Balances: []ReportedBalance{
+			{Amount: 100, BalanceType: BalanceTypeOpeningBooked, ReferenceDate: day},
+			{Amount: 120.1, BalanceType: BalanceTypeClosingBooked, ReferenceDate: day},
+		},
+		Entries: []BankEntry{
+			{Amount: 50.2, Credit: true, Booked: true},
+			{Amount: 30.1, Booked: true},
+			{Amount: 999, Booked: false}, // pending entries do not move the booked balance
+		},
+	}
+
+	if got := statement.Unbalanced(); got != 0 {
+		t.Errorf("Unbalanced() = %v, want 0", got)
+	}
+
+	statement.Entries = statement.Entries[:1]
+	if got := statement.Unbalanced(); got != -30.1 {
+		t.Errorf("Unbalanced() = %v, want the missing -30.10 debit", got)
+	}
+
+	statement.Balances = statement.Balances[1:]
+	if got := statement.Unbalanced(); got != 0 {
+		t.Errorf("Unbalanced() = %v, want 0 without an opening balance", got)
+	}
+}
@@ -0,0 +1,223 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BudgetTransaction is a normalized register entry of a budgeting app export
+// such as YNAB or Actual Budget. Split transactions are exported as one entry
+// per split.
+type BudgetTransaction struct {
+	ID            string    `json:"id"` // unique within the export and stable across exports
+	Account       string    `json:"account"`
+	Date          time.Time `json:"date"`
+	Payee         string    `json:"payee,omitempty"`
+	CategoryGroup string    `json:"categoryGroup,omitempty"`
+	Category      string    `json:"category,omitempty"`
+	Memo          string    `json:"memo,omitempty"`
+	Amount        float64   `json:"amount"` // signed: positive is an inflow to the account
+	Cleared       bool      `json:"cleared"`
+
+	// TransferAccount is the other account of a transfer between accounts
+	// of the export. Both sides of a transfer are exported.
+	TransferAccount string `json:"transferAccount,omitempty"`
+}
+
+// BudgetImportStatus is the state of a staged budget import
+type BudgetImportStatus string
+
+const (
+	// BudgetImportPending waits for its mapping to be reviewed
+	BudgetImportPending BudgetImportStatus = "pending"
+
+	// BudgetImportCommitted was imported
+	BudgetImportCommitted BudgetImportStatus = "committed"
+
+	// BudgetImportDiscarded was dropped without importing
+	BudgetImportDiscarded BudgetImportStatus = "discarded"
+)
+
+// BudgetAccountMapping maps an account of a budget export to a wallet
+type BudgetAccountMapping struct {
+	Account      string `json:"account"`
+	WalletID     string `json:"walletId,omitempty"` // empty creates a wallet named after the account
+	Currency     string `json:"currency"`           // currency of a created wallet
+	Skip         bool   `json:"skip,omitempty"`     // leave the account out of the import
+	Transactions int    `json:"transactions"`
+}
+
+// BudgetCategoryMapping maps a category of a budget export to a category
+type BudgetCategoryMapping struct {
+	Category     string       `json:"category"`
+	Group        string       `json:"group,omitempty"`
+	CategoryID   string       `json:"categoryId,omitempty"` // empty creates a category of the same name
+	Type         CategoryType `json:"type"`                 // type of a created category
+	Transactions int          `json:"transactions"`
+}
+
+// BudgetImport is a budget export staged for review. Its mapping proposes a
+// wallet for every account and a category for every category of the export;
+// nothing is imported until the reviewed mapping is committed.
+type BudgetImport struct {
+	ID           string                  `json:"id"`
+	Profile      string                  `json:"profile"`
+	Status       BudgetImportStatus      `json:"status"`
+	Accounts     []BudgetAccountMapping  `json:"accounts"`
+	Categories   []BudgetCategoryMapping `json:"categories"`
+	Payees       int                     `json:"payees"`
+	Transactions []BudgetTransaction     `json:"transactions"`
+	Imported     int                     `json:"imported"`
+	Error        string                  `json:"error,omitempty"`
+	CreatedAt    time.Time               `json:"createdAt"`
+	UpdatedAt    time.Time               `json:"updatedAt"`
+}
+
+// NewBudgetImport stages the transactions of an export. Accounts and
+// categories are listed by name with their number of transactions; new
+// wallets default to the given currency.
+func NewBudgetImport(profile, currency string, transactions []BudgetTransaction) *BudgetImport {
+	accounts := make(map[string]*BudgetAccountMapping)
+	categories := make(map[string]*BudgetCategoryMapping)
+	inflows := make(map[string]int)
+	payees := make(map[string]bool)
+
+	for _, tx := range transactions {
+		account, ok := accounts[tx.Account]
+		if !ok {
+			account = &BudgetAccountMapping{Account: tx.Account, Currency: currency}
+			accounts[tx.Account] = account
+		}
+		account.Transactions++
+
+		if tx.Payee != "" && tx.TransferAccount == "" {
+			payees[strings.ToLower(tx.Payee)] = true
+		}
+
+		if tx.Category == "" {
+			continue
+		}
+		category, ok := categories[tx.Category]
+		if !ok {
+			category = &BudgetCategoryMapping{Category: tx.Category, Group: tx.CategoryGroup}
+			categories[tx.Category] = category
+		}
+		category.Transactions++
+		if tx.Amount > 0 {
+			inflows[tx.Category]++
+		}
+	}
+
+	budget := &BudgetImport{
+		ID:           uuid.New().String(),
+		Profile:      profile,
+		Status:       BudgetImportPending,
+		Accounts:     make([]BudgetAccountMapping, 0, len(accounts)),
+		Categories:   make([]BudgetCategoryMapping, 0, len(categories)),
+		Payees:       len(payees),
+		Transactions: transactions,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	for _, account := range accounts {
+		budget.Accounts = append(budget.Accounts, *account)
+	}
+	for _, category := range categories {
+		// Budgeting apps file income under an inflow group; anything else only
+		// counts as income when every transaction of the category is an inflow
+		category.Type = CategoryTypeExpense
+		if isInflowGroup(category.Group) || inflows[category.Category] == category.Transactions {
+			category.Type = CategoryTypeIncome
+		}
+		budget.Categories = append(budget.Categories, *category)
+	}
+	sort.Slice(budget.Accounts, func(i, j int) bool { return budget.Accounts[i].Account < budget.Accounts[j].Account })
+	sort.Slice(budget.Categories, func(i, j int) bool { return budget.Categories[i].Category < budget.Categories[j].Category })
+
+	return budget
+}
+
+func isInflowGroup(group string) bool {
+	switch strings.ToLower(group) {
+	case "inflow", "income":
+		return true
+	}
+	return false
+}
+
+// Account returns the mapping of an account of the export
+func (b *BudgetImport) Account(name string) (*BudgetAccountMapping, bool) {
+	for i := range b.Accounts {
+		if b.Accounts[i].Account == name {
+			return &b.Accounts[i], true
+		}
+	}
+	return nil, false
+}
+
+// Category returns the mapping of a category of the export
+func (b *BudgetImport) Category(name string) (*BudgetCategoryMapping, bool) {
+	for i := range b.Categories {
+		if b.Categories[i].Category == name {
+			return &b.Categories[i], true
+		}
+	}
+	return nil, false
+}
+
+// ApplyMapping replaces the mapping of the given accounts and categories;
+// the others keep their current mapping. The transaction counts cannot be changed.
+func (b *BudgetImport) ApplyMapping(accounts []BudgetAccountMapping, categories []BudgetCategoryMapping) error {
+	if b.Status != BudgetImportPending {
+		return fmt.Errorf("budget import %s is %s: %w", b.ID, b.Status, ErrBudgetImportNotPending)
+	}
+
+	for _, update := range accounts {
+		account, ok := b.Account(update.Account)
+		if !ok {
+			return fmt.Errorf("%w: unknown account %q", ErrInvalidBudgetMapping, update.Account)
+		}
+		if update.WalletID == "" && !update.Skip && update.Currency == "" {
+			return fmt.Errorf("%w: account %q needs a wallet or a currency", ErrInvalidBudgetMapping, update.Account)
+		}
+		update.Transactions = account.Transactions
+		*account = update
+	}
+
+	for _, update := range categories {
+		category, ok := b.Category(update.Category)
+		if !ok {
+			return fmt.Errorf("%w: unknown category %q", ErrInvalidBudgetMapping, update.Category)
+		}
+		if update.CategoryID == "" && update.Type != CategoryTypeIncome && update.Type != CategoryTypeExpense {
+			return fmt.Errorf("%w: category %q must be income or expense", ErrInvalidBudgetMapping, update.Category)
+		}
+		update.Group = category.Group
+		update.Transactions = category.Transactions
+		*category = update
+	}
+
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// Commit marks the import as imported with the given number of transactions
+func (b *BudgetImport) Commit(imported int) {
+	b.Status = BudgetImportCommitted
+	b.Imported = imported
+	b.UpdatedAt = time.Now()
+}
+
+// Discard marks the import as dropped
+func (b *BudgetImport) Discard() error {
+	if b.Status != BudgetImportPending {
+		return fmt.Errorf("budget import %s is %s: %w", b.ID, b.Status, ErrBudgetImportNotPending)
+	}
+	b.Status = BudgetImportDiscarded
+	b.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewBudgetImport(t *testing.T) {
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	budget := NewBudgetImport("ynab", "EUR", []BudgetTransaction{
+		{Account: "Checking", Date: day, Payee: "Landlord", CategoryGroup: "Bills", Category: "Rent", Amount: -1200},
+		{Account: "Checking", Date: day, Payee: "Employer", CategoryGroup: "Inflow", Category: "Ready to Assign", Amount: 3000},
+		{Account: "Checking", Date: day, Payee: "Shop", Category: "Refunds", Amount: 20},
+		{Account: "Savings", Date: day, Payee: "Transfer : Checking", Amount: 500, TransferAccount: "Checking"},
+		{Account: "Checking", Date: day, Payee: "landlord", CategoryGroup: "Bills", Category: "Rent", Amount: -50},
+	})
+
+	if budget.Status != BudgetImportPending || budget.Payees != 3 {
+		t.Errorf("NewBudgetImport() = %s with %d payees, want pending with 3 payees", budget.Status, budget.Payees)
+	}
+
+	checking, ok := budget.Account("Checking")
+	if !ok || checking.Transactions != 4 || checking.Currency != "EUR" || len(budget.Accounts) != 2 {
+		t.Errorf("accounts = %+v, want Checking with 4 transactions in EUR and Savings", budget.Accounts)
+	}
+
+	tests := []struct {
+		category string
+		want     CategoryType
+	}{
+		{"Rent", CategoryTypeExpense},
+		{"Ready to Assign", CategoryTypeIncome},
+		{"Refunds", CategoryTypeIncome},
+	}
+	for _, tt := range tests {
+		if category, ok := budget.Category(tt.category); !ok || category.Type != tt.want {
+			t.Errorf("category %q = %+v, want type %s", tt.category, category, tt.want)
+		}
+	}
+}
+
+func TestBudgetImport_ApplyMapping(t *testing.T) {
+	budget := NewBudgetImport("actual", "USD", []BudgetTransaction{
+		{Account: "Checking", Category: "Food", Amount: -10},
+	})
+
+	err := budget.ApplyMapping(
+		[]BudgetAccountMapping{{Account: "Checking", WalletID: "wallet-1", Transactions: 99}},
+		[]BudgetCategoryMapping{{Category: "Food", CategoryID: "category-1"}},
+	)
+	if err != nil {
+		t.Fatalf("ApplyMapping() error = %v", err)
+	}
+	if account, _ := budget.Account("Checking"); account.WalletID != "wallet-1" || account.Transactions != 1 {
+		t.Errorf("account = %+v, want wallet-1 with its transaction count kept", account)
+	}
+
+	if err := budget.ApplyMapping([]BudgetAccountMapping{{Account: "Brokerage"}}, nil); !errors.Is(err, ErrInvalidBudgetMapping) {
+		t.Errorf("ApplyMapping() unknown account error = %v, want ErrInvalidBudgetMapping", err)
+	}
+	if err := budget.ApplyMapping(nil, []BudgetCategoryMapping{{Category: "Food", Type: CategoryTypeTransfer}}); !errors.Is(err, ErrInvalidBudgetMapping) {
+		t.Errorf("ApplyMapping() transfer category error = %v, want ErrInvalidBudgetMapping", err)
+	}
+
+	budget.Commit(1)
+	if err := budget.ApplyMapping(nil, nil); !errors.Is(err, ErrBudgetImportNotPending) {
+		t.Errorf("ApplyMapping() after commit error = %v, want ErrBudgetImportNotPending", err)
+	}
+	if err := budget.Discard(); !errors.Is(err, ErrBudgetImportNotPending) {
+		t.Errorf("Discard() after commit error = %v, want ErrBudgetImportNotPending", err)
+	}
+}
@@ -0,0 +1,201 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// CalendarRefreshInterval is how often calendar apps are asked to fetch the feed again
+const CalendarRefreshInterval = 6 * time.Hour
+
+// icalLineLength is the longest content line in octets, longer lines are folded (RFC 5545, 3.1)
+const icalLineLength = 75
+
+// CalendarFeed is the iCalendar feed of a user. Calendar apps cannot send an
+// auth header, so the feed URL carries a secret token; only its hash is
+// stored and issuing a new token revokes the previous one.
+type CalendarFeed struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"userId"`
+	TokenHash string    `json:"-"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewCalendarFeedToken returns a random feed token
+func NewCalendarFeedToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate calendar feed token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// HashCalendarFeedToken returns the hash a feed token is stored and looked up by
+func HashCalendarFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CalendarEvent is an all-day money event in the feed
+type CalendarEvent struct {
+	UID         string    `json:"uid"` // stable across fetches, so calendar apps update the event in place
+	Date        time.Time `json:"date"`
+	Summary     string    `json:"summary"`
+	Description string    `json:"description,omitempty"`
+	Categories  []string  `json:"categories,omitempty"`
+}
+
+// SubscriptionEvents returns the expected charges of the active subscriptions
+// from..to. Quarterly and yearly subscriptions are listed as renewals.
+// Missed subscriptions are left out, their next charge is not expected.
+func SubscriptionEvents(subscriptions []*Subscription, from, to time.Time) []CalendarEvent {
+	var events []CalendarEvent
+	for _, subscription := range subscriptions {
+		if subscription.Status == SubscriptionMissed || subscription.NextChargeAt.IsZero() {
+			continue
+		}
+
+		amount := func(value float64) string {
+			return strings.TrimSpace(FormatAmount(value, subscription.Currency) + " " + subscription.Currency)
+		}
+		summary := fmt.Sprintf("%s: %s", subscription.Name, amount(subscription.Amount))
+		if subscription.Interval == SubscriptionQuarterly || subscription.Interval == SubscriptionYearly {
+			summary = fmt.Sprintf("%s renews: %s", subscription.Name, amount(subscription.Amount))
+		}
+		description := fmt.Sprintf("Expected %s charge, about %s a month. Last charged on %s.",
+			subscription.Interval, amount(subscription.MonthlyCost), subscription.LastChargeAt.UTC().Format(time.DateOnly))
+		if increase := subscription.PriceIncrease(); increase > 0 {
+			description += fmt.Sprintf(" The price went up by %s.", amount(increase))
+		}
+
+		for date := subscription.NextChargeAt; date.Before(to); date = subscription.Interval.Next(date) {
+			if date.Before(from) {
+				continue
+			}
+			events = append(events, CalendarEvent{
+				UID:         fmt.Sprintf("subscription-%s-%s@firedragon", subscription.ID, date.UTC().Format("20060102")),
+				Date:        date,
+				Summary:     summary,
+				Description: description,
+				Categories:  []string{"Subscription"},
+			})
+		}
+	}
+	return events
+}
+
+// PeriodEvents returns the starts of the pay periods and fiscal years from..to
+func PeriodEvents(calendar PeriodCalendar, from, to time.Time) []CalendarEvent {
+	var events []CalendarEvent
+	for _, period := range calendar.PayPeriods(from, to) {
+		if period.Start.Before(from) {
+			continue
+		}
+		events = append(events, CalendarEvent{
+			UID:         "pay-period-" + period.Name + "@firedragon",
+			Date:        period.Start,
+			Summary:     "Budget period " + period.Name + " starts",
+			Description: "Runs until " + period.Last().Format(time.DateOnly) + ".",
+			Categories:  []string{"Budget period"},
+		})
+	}
+
+	for year := from.UTC().Year(); ; year++ {
+		period := calendar.FiscalYearOf(year)
+		if !period.Start.Before(to) {
+			break
+		}
+		if period.Start.Before(from) {
+			continue
+		}
+		events = append(events, CalendarEvent{
+			UID:         "fiscal-year-" + strings.ReplaceAll(period.Name, "/", "-") + "@firedragon",
+			Date:        period.Start,
+			Summary:     "Fiscal year " + period.Name + " starts",
+			Description: "Runs until " + period.Last().Format(time.DateOnly) + ".",
+			Categories:  []string{"Budget period"},
+		})
+	}
+	return events
+}
+
+// RenderICalendar renders the events as an iCalendar (RFC 5545) document,
+// sorted by date, with CRLF line endings and long lines folded
+func RenderICalendar(name string, events []CalendarEvent, now time.Time) []byte {
+	sorted := append([]CalendarEvent(nil), events...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].Date.Equal(sorted[j].Date) {
+			return sorted[i].Date.Before(sorted[j].Date)
+		}
+		return sorted[i].UID < sorted[j].UID
+	})
+
+	refresh := fmt.Sprintf("PT%dH", int(CalendarRefreshInterval.Hours()))
+	stamp := now.UTC().Format("20060102T150405Z")
+
+	var b strings.Builder
+	line := func(content string) {
+		writeICalLine(&b, content)
+	}
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//FireDragon//Money calendar//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + escapeICalText(name))
+	line("REFRESH-INTERVAL;VALUE=DURATION:" + refresh)
+	line("X-PUBLISHED-TTL:" + refresh)
+	for _, event := range sorted {
+		date := event.Date.UTC()
+		line("BEGIN:VEVENT")
+		line("UID:" + event.UID)
+		line("DTSTAMP:" + stamp)
+		line("DTSTART;VALUE=DATE:" + date.Format("20060102"))
+		line("DTEND;VALUE=DATE:" + date.AddDate(0, 0, 1).Format("20060102"))
+		line("SUMMARY:" + escapeICalText(event.Summary))
+		if event.Description != "" {
+			line("DESCRIPTION:" + escapeICalText(event.Description))
+		}
+		if len(event.Categories) > 0 {
+			categories := make([]string, len(event.Categories))
+			for i, category := range event.Categories {
+				categories[i] = escapeICalText(category)
+			}
+			line("CATEGORIES:" + strings.Join(categories, ","))
+		}
+		line("TRANSP:TRANSPARENT")
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// escapeICalText escapes a TEXT value (RFC 5545, 3.3.11)
+func escapeICalText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// writeICalLine writes a content line, folding it into lines of at most
+// icalLineLength octets without splitting a UTF-8 character
+func writeICalLine(b *strings.Builder, content string) {
+	limit := icalLineLength
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		b.WriteString(content[:cut])
+		b.WriteString("\r\n ")
+		content = content[cut:]
+		limit = icalLineLength - 1 // the continuation starts with a space
+	}
+	b.WriteString(content)
+	b.WriteString("\r\n")
+}
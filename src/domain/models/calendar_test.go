@@ -0,0 +1,123 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSubscriptionEvents(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 3, 0)
+	subscriptions := []*Subscription{
+		{
+			ID: "netflix", Name: "Netflix", Currency: "EUR", Interval: SubscriptionMonthly, Amount: 15.99, PreviousAmount: 13.99,
+			NextChargeAt: time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC), Status: SubscriptionActive,
+		},
+		{
+			ID: "domain", Name: "Domain", Currency: "JPY", Interval: SubscriptionYearly, Amount: 1500,
+			NextChargeAt: time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC), Status: SubscriptionActive,
+		},
+		{
+			ID: "gym", Name: "Gym", Currency: "EUR", Interval: SubscriptionMonthly, Amount: 29.9,
+			NextChargeAt: time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC), Status: SubscriptionMissed,
+		},
+	}
+
+	events := SubscriptionEvents(subscriptions, from, to)
+	var summaries []string
+	for _, event := range events {
+		summaries = append(summaries, event.Date.Format(time.DateOnly)+" "+event.Summary)
+	}
+	want := []string{
+		"2025-03-08 Netflix: 15.99 EUR",
+		"2025-04-08 Netflix: 15.99 EUR",
+		"2025-05-08 Netflix: 15.99 EUR",
+		"2025-04-02 Domain renews: 1500 JPY",
+	}
+	if strings.Join(summaries, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("SubscriptionEvents() =\n%s\nwant\n%s", strings.Join(summaries, "\n"), strings.Join(want, "\n"))
+	}
+	if events[0].UID != "subscription-netflix-20250308@firedragon" {
+		t.Errorf("UID = %q, want it stable per subscription and date", events[0].UID)
+	}
+	if !strings.Contains(events[0].Description, "went up by 2.00 EUR") {
+		t.Errorf("Description = %q, want the price increase", events[0].Description)
+	}
+}
+
+func TestPeriodEvents(t *testing.T) {
+	calendar := PeriodCalendar{FiscalYearStart: time.April, PayPeriodStart: 25}
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	events := PeriodEvents(calendar, from, from.AddDate(0, 2, 0))
+	var summaries []string
+	for _, event := range events {
+		summaries = append(summaries, event.Date.Format(time.DateOnly)+" "+event.Summary)
+	}
+	want := []string{
+		"2025-03-25 Budget period 2025-03 starts",
+		"2025-04-25 Budget period 2025-04 starts",
+		"2025-04-01 Fiscal year 2025/26 starts",
+	}
+	if strings.Join(summaries, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("PeriodEvents() =\n%s\nwant\n%s", strings.Join(summaries, "\n"), strings.Join(want, "\n"))
+	}
+	if events[0].Description != "Runs until 2025-04-24." {
+		t.Errorf("Description = %q, want the last day of the period", events[0].Description)
+	}
+}
+
+func TestRenderICalendar(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	events := []CalendarEvent{
+		{UID: "b@firedragon", Date: time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC), Summary: "Rent; flat, city\\north"},
+		{
+			UID: "a@firedragon", Date: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), Summary: "Süßwaren",
+			Description: strings.Repeat("ä", 60), Categories: []string{"Subscription"},
+		},
+	}
+
+	document := string(RenderICalendar("FireDragon", events, now))
+	if !strings.HasPrefix(document, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n") || !strings.HasSuffix(document, "END:VCALENDAR\r\n") {
+		t.Fatalf("document is not a CRLF delimited calendar:\n%s", document)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(document, "\r\n"), "\r\n") {
+		if len(line) > icalLineLength {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+	}
+	for _, want := range []string{
+		"DTSTAMP:20250301T120000Z",
+		"DTSTART;VALUE=DATE:20250308\r\nDTEND;VALUE=DATE:20250309",
+		`SUMMARY:Rent\; flat\, city\\north`,
+		"CATEGORIES:Subscription",
+		"REFRESH-INTERVAL;VALUE=DURATION:PT6H",
+	} {
+		if !strings.Contains(document, want) {
+			t.Errorf("document lacks %q:\n%s", want, document)
+		}
+	}
+	if strings.Index(document, "UID:a@firedragon") > strings.Index(document, "UID:b@firedragon") {
+		t.Error("events are not sorted by date")
+	}
+
+	unfolded := strings.ReplaceAll(document, "\r\n ", "")
+	if !strings.Contains(unfolded, "DESCRIPTION:"+strings.Repeat("ä", 60)+"\r\n") {
+		t.Error("folding split a character or lost text")
+	}
+}
+
+func TestHashCalendarFeedToken(t *testing.T) {
+	token, err := NewCalendarFeedToken()
+	if err != nil {
+		t.Fatalf("NewCalendarFeedToken() error = %v", err)
+	}
+	other, _ := NewCalendarFeedToken()
+	if len(token) != 64 || token == other {
+		t.Errorf("tokens %q and %q, want distinct 32 byte hex tokens", token, other)
+	}
+	if HashCalendarFeedToken(token) != HashCalendarFeedToken(token) || HashCalendarFeedToken(token) == HashCalendarFeedToken(other) {
+		t.Error("HashCalendarFeedToken() is not a stable hash of the token")
+	}
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
 	"github.com/google/uuid"
 )
 
@@ -12,14 +13,27 @@ type CategoryType string
 const (
 	// CategoryTypeIncome represents an income category
 	CategoryTypeIncome CategoryType = "income"
-	
+
 	// CategoryTypeExpense represents an expense category
 	CategoryTypeExpense CategoryType = "expense"
-	
+
 	// CategoryTypeTransfer represents a transfer category
 	CategoryTypeTransfer CategoryType = "transfer"
 )
 
+// Names of the categories source clients suggest for classified transactions.
+// They are created on first use by the import.
+const (
+	// CategoryStakingRewards holds staking rewards credited to a wallet
+	CategoryStakingRewards = "Staking Rewards"
+
+	// CategoryAirdrops holds unsolicited token distributions
+	CategoryAirdrops = "Airdrops"
+
+	// CategoryNetworkFees holds blockchain gas and transaction fees
+	CategoryNetworkFees = "Network fees"
+)
+
 // Category represents a transaction category
 type Category struct {
 	ID          string       `json:"id"`
@@ -28,6 +42,10 @@ type Category struct {
 	Type        CategoryType `json:"type"`
 	Color       string       `json:"color"`
 	IsSystem    bool         `json:"isSystem"`
+	Key         string       `json:"key,omitempty"`      // identifier in the category pack, set on system categories
+	ParentID    string       `json:"parentId,omitempty"` // parent in the category tree, empty for top-level categories
+	SpaceID     string       `json:"spaceId,omitempty"`  // owning space, empty when shared with every user
+	Version     int          `json:"version"`            // optimistic concurrency version, bumped on every update
 	CreatedAt   time.Time    `json:"createdAt"`
 	UpdatedAt   time.Time    `json:"updatedAt"`
 }
@@ -55,19 +73,11 @@ func NewSystemCategory(name, description string, categoryType CategoryType, colo
 
 // Validate checks if the category is valid
 func (c *Category) Validate() error {
-	// Name is required
-	if c.Name == "" {
-		return ErrMissingCategoryName
-	}
-	
-	// Type must be valid
-	if c.Type != CategoryTypeIncome && 
-	   c.Type != CategoryTypeExpense && 
-	   c.Type != CategoryTypeTransfer {
-		return ErrInvalidCategoryType
-	}
-	
-	return nil
+	v := validation.New()
+	v.Required("name", c.Name, ErrMissingCategoryName)
+	v.Check(c.Type == CategoryTypeIncome || c.Type == CategoryTypeExpense || c.Type == CategoryTypeTransfer,
+		"type", validation.RuleOneOf, ErrInvalidCategoryType)
+	return v.Err()
 }
 
 // MatchesTransactionType checks if the category type matches the transaction type
@@ -82,4 +92,4 @@ func (c *Category) MatchesTransactionType(txType TransactionType) bool {
 	default:
 		return false
 	}
-} 
\ No newline at end of file
+}
@@ -0,0 +1,193 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// CategoryReviewState tracks a category the classifier assigned to a transaction
+type CategoryReviewState string
+
+const (
+	// CategoryReviewAuto marks a category applied with a confidence above the auto-apply threshold
+	CategoryReviewAuto CategoryReviewState = "auto"
+
+	// CategoryReviewPending marks a suggested category waiting for the user to confirm it
+	CategoryReviewPending CategoryReviewState = "pending"
+
+	// CategoryReviewAccepted marks a suggested category the user confirmed
+	CategoryReviewAccepted CategoryReviewState = "accepted"
+
+	// CategoryReviewCorrected marks a suggested category the user replaced
+	CategoryReviewCorrected CategoryReviewState = "corrected"
+)
+
+const (
+	// MetadataCategoryReview holds the CategoryReviewState of a classified transaction
+	MetadataCategoryReview = "categoryReview"
+
+	// MetadataCategoryConfidence holds the confidence of the suggested category, 0-1
+	MetadataCategoryConfidence = "categoryConfidence"
+
+	// CategoryReviewTag is added to transactions whose suggested category awaits review
+	CategoryReviewTag = "review-category"
+)
+
+// CategorySuggestion is a category the classifier suggests for a transaction
+type CategorySuggestion struct {
+	CategoryID string  `json:"categoryId"`
+	Confidence float64 `json:"confidence"` // posterior probability, 0-1
+}
+
+// CategoryClassifierStats describes what a classifier was trained on
+type CategoryClassifierStats struct {
+	Transactions int `json:"transactions"`
+	Categories   int `json:"categories"`
+	Vocabulary   int `json:"vocabulary"` // distinct features seen
+}
+
+// CategoryClassifier is a multinomial naive Bayes model suggesting categories
+// from the description, counterparty, amount, type and source of a
+// transaction. It is not safe for concurrent use.
+type CategoryClassifier struct {
+	categories map[string]*categoryCounts
+	vocabulary map[string]int // categories a feature was seen in
+	documents  int
+}
+
+type categoryCounts struct {
+	documents int
+	features  map[string]int
+	total     int
+}
+
+// NewCategoryClassifier creates an untrained classifier
+func NewCategoryClassifier() *CategoryClassifier {
+	return &CategoryClassifier{
+		categories: make(map[string]*categoryCounts),
+		vocabulary: make(map[string]int),
+	}
+}
+
+// HasUserCategory reports whether the category of a transaction was chosen by
+// the user. Categories the classifier assigned itself do not count until they
+// are reviewed, so its mistakes do not reinforce themselves.
+func (t *Transaction) HasUserCategory() bool {
+	if t.CategoryID == "" || t.IsDeleted() {
+		return false
+	}
+	switch CategoryReviewState(t.Metadata[MetadataCategoryReview]) {
+	case CategoryReviewAuto, CategoryReviewPending:
+		return false
+	}
+	return true
+}
+
+// Learn adds a categorized transaction to the model
+func (c *CategoryClassifier) Learn(tx *Transaction) {
+	if tx.CategoryID == "" {
+		return
+	}
+
+	counts, ok := c.categories[tx.CategoryID]
+	if !ok {
+		counts = &categoryCounts{features: make(map[string]int)}
+		c.categories[tx.CategoryID] = counts
+	}
+	counts.documents++
+	c.documents++
+
+	for _, feature := range TransactionFeatures(tx) {
+		if counts.features[feature] == 0 {
+			c.vocabulary[feature]++
+		}
+		counts.features[feature]++
+		counts.total++
+	}
+}
+
+// Suggest returns up to limit categories for a transaction, most likely first.
+// An untrained classifier suggests nothing.
+func (c *CategoryClassifier) Suggest(tx *Transaction, limit int) []CategorySuggestion {
+	if c.documents == 0 || limit <= 0 {
+		return nil
+	}
+
+	features := TransactionFeatures(tx)
+	vocabulary := float64(len(c.vocabulary) + 1) // +1 for features never seen
+	scores := make(map[string]float64, len(c.categories))
+	best := math.Inf(-1)
+	for id, counts := range c.categories {
+		score := math.Log(float64(counts.documents) / float64(c.documents))
+		for _, feature := range features {
+			// Laplace smoothing keeps unseen features from ruling a category out
+			score += math.Log((float64(counts.features[feature]) + 1) / (float64(counts.total) + vocabulary))
+		}
+		scores[id] = score
+		best = math.Max(best, score)
+	}
+
+	// Normalize the log likelihoods into probabilities
+	var sum float64
+	for _, score := range scores {
+		sum += math.Exp(score - best)
+	}
+	suggestions := make([]CategorySuggestion, 0, len(scores))
+	for id, score := range scores {
+		suggestions = append(suggestions, CategorySuggestion{CategoryID: id, Confidence: math.Exp(score-best) / sum})
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Confidence != suggestions[j].Confidence {
+			return suggestions[i].Confidence > suggestions[j].Confidence
+		}
+		return suggestions[i].CategoryID < suggestions[j].CategoryID
+	})
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// Stats returns the size of the training data
+func (c *CategoryClassifier) Stats() CategoryClassifierStats {
+	return CategoryClassifierStats{
+		Transactions: c.documents,
+		Categories:   len(c.categories),
+		Vocabulary:   len(c.vocabulary),
+	}
+}
+
+// TransactionFeatures returns the features the classifier sees of a
+// transaction: the words of its description, its counterparty, type, source
+// and the order of magnitude of its amount.
+func TransactionFeatures(tx *Transaction) []string {
+	var features []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(tx.Description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		// Digits are mostly dates, references and amounts that never repeat
+		if len([]rune(word)) < 2 || strings.IndexFunc(word, unicode.IsLetter) < 0 {
+			continue
+		}
+		features = append(features, "word:"+word)
+	}
+
+	if counterparty := strings.ToLower(strings.TrimSpace(tx.Metadata["counterparty"])); counterparty != "" {
+		features = append(features, "counterparty:"+counterparty)
+	}
+	if tx.Type != "" {
+		features = append(features, "type:"+string(tx.Type))
+	}
+	if source := tx.Metadata["source"]; source != "" {
+		features = append(features, "source:"+source)
+	}
+	if tx.Amount > 0 {
+		// Half-decade buckets: 1-3, 3-10, 10-31, ...
+		features = append(features, "amount:"+strconv.Itoa(int(math.Floor(math.Log10(tx.Amount)*2))))
+	}
+	return features
+}
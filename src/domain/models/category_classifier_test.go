@@ -0,0 +1,136 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func trainingTransaction(description, counterparty string, amount float64, categoryID string) *Transaction {
+	tx := NewTransaction(amount, description, time.Now(), TransactionTypeExpense, categoryID, "wallet")
+	tx.Metadata = map[string]string{"counterparty": counterparty, "source": "enable"}
+	return tx
+}
+
+func TestCategoryClassifier_Suggest(t *testing.T) {
+	classifier := NewCategoryClassifier()
+	if got := classifier.Suggest(trainingTransaction("REWE Markt", "", 20, ""), 3); got != nil {
+		t.Fatalf("untrained Suggest() = %v, want nil", got)
+	}
+
+	for _, tx := range []*Transaction{
+		trainingTransaction("REWE Markt 1234", "REWE", 42.10, "groceries"),
+		trainingTransaction("Lidl sagt danke", "Lidl", 18.35, "groceries"),
+		trainingTransaction("REWE Markt 5678", "REWE", 63.00, "groceries"),
+		trainingTransaction("Shell station 0042", "Shell", 71.20, "fuel"),
+		trainingTransaction("Aral station", "Aral", 55.00, "fuel"),
+		trainingTransaction("Netflix subscription", "Netflix", 12.99, "subscriptions"),
+	} {
+		classifier.Learn(tx)
+	}
+
+	stats := classifier.Stats()
+	if stats.Transactions != 6 || stats.Categories != 3 {
+		t.Errorf("Stats() = %+v, want 6 transactions in 3 categories", stats)
+	}
+
+	tests := []struct {
+		name string
+		tx   *Transaction
+		want string
+	}{
+		{"known merchant", trainingTransaction("REWE Markt 9999", "REWE", 35, ""), "groceries"},
+		{"description words", trainingTransaction("Shell station 1111", "", 60, ""), "fuel"},
+		{"counterparty only", trainingTransaction("Card payment", "Netflix", 12.99, ""), "subscriptions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suggestions := classifier.Suggest(tt.tx, 2)
+			if len(suggestions) != 2 {
+				t.Fatalf("Suggest() returned %d suggestions, want 2", len(suggestions))
+			}
+			if suggestions[0].CategoryID != tt.want {
+				t.Errorf("Suggest()[0] = %s, want %s", suggestions[0].CategoryID, tt.want)
+			}
+			if suggestions[0].Confidence < suggestions[1].Confidence {
+				t.Errorf("suggestions not ordered by confidence: %v", suggestions)
+			}
+			if suggestions[0].Confidence <= 0.5 || suggestions[0].Confidence > 1 {
+				t.Errorf("Suggest()[0].Confidence = %v, want a majority", suggestions[0].Confidence)
+			}
+		})
+	}
+}
+
+func TestCategoryClassifier_LearnCorrection(t *testing.T) {
+	classifier := NewCategoryClassifier()
+	classifier.Learn(trainingTransaction("Amazon order", "Amazon", 25, "shopping"))
+	classifier.Learn(trainingTransaction("Amazon order", "Amazon", 30, "shopping"))
+	classifier.Learn(trainingTransaction("Bakery", "Bakery", 4, "groceries"))
+
+	tx := trainingTransaction("Amazon Fresh order", "Amazon", 28, "")
+	if got := classifier.Suggest(tx, 1)[0].CategoryID; got != "shopping" {
+		t.Fatalf("Suggest() before corrections = %s, want shopping", got)
+	}
+
+	for i := 0; i < 3; i++ {
+		classifier.Learn(trainingTransaction("Amazon Fresh order", "Amazon", 28, "groceries"))
+	}
+	if got := classifier.Suggest(tx, 1)[0].CategoryID; got != "groceries" {
+		t.Errorf("Suggest() after corrections = %s, want groceries", got)
+	}
+}
+
+func TestTransaction_HasUserCategory(t *testing.T) {
+	tests := []struct {
+		name  string
+		state CategoryReviewState
+		want  bool
+	}{
+		{"manual", "", true},
+		{"auto applied", CategoryReviewAuto, false},
+		{"pending review", CategoryReviewPending, false},
+		{"accepted", CategoryReviewAccepted, true},
+		{"corrected", CategoryReviewCorrected, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := trainingTransaction("Coffee", "", 3, "food")
+			if tt.state != "" {
+				tx.Metadata[MetadataCategoryReview] = string(tt.state)
+			}
+			if got := tx.HasUserCategory(); got != tt.want {
+				t.Errorf("HasUserCategory() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if tx := trainingTransaction("Coffee", "", 3, ""); tx.HasUserCategory() {
+		t.Error("HasUserCategory() = true for an uncategorized transaction")
+	}
+}
+
+func TestTransactionFeatures(t *testing.T) {
+	tx := trainingTransaction("Payment 2024-05-01 to ACME, ref 12345", "ACME Corp", 150, "")
+	features := TransactionFeatures(tx)
+
+	want := map[string]bool{
+		"word:payment":           true,
+		"word:to":                true,
+		"word:acme":              true,
+		"word:ref":               true,
+		"counterparty:acme corp": true,
+		"type:expense":           true,
+		"source:enable":          true,
+		"amount:4":               true,
+	}
+	if len(features) != len(want) {
+		t.Fatalf("TransactionFeatures() = %v, want %d features", features, len(want))
+	}
+	for _, feature := range features {
+		if !want[feature] {
+			t.Errorf("unexpected feature %q", feature)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"fmt"
+)
+
+// CategoryPack is a standard category tree installed as system categories.
+// Categories are identified by key; their names come from the translations
+// of the configured locale.
+type CategoryPack struct {
+	Name       string             `json:"name"`
+	Categories []CategoryTemplate `json:"categories"`
+}
+
+// CategoryTemplate is a category of a pack. Children inherit the type and,
+// unless they set their own, the color of their parent.
+type CategoryTemplate struct {
+	Key      string             `json:"key"`
+	Type     CategoryType       `json:"type,omitempty"`
+	Color    string             `json:"color,omitempty"`
+	Children []CategoryTemplate `json:"children,omitempty"`
+}
+
+// CategoryLabel is the translated name of a pack category
+type CategoryLabel struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// CategoryTranslations are the labels of one locale by category key
+type CategoryTranslations map[string]CategoryLabel
+
+// PackCategory is a category of a pack, flattened and translated
+type PackCategory struct {
+	Key         string       `json:"key"`
+	ParentKey   string       `json:"parentKey,omitempty"`
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Type        CategoryType `json:"type"`
+	Color       string       `json:"color,omitempty"`
+}
+
+// Validate checks that every key is unique and every category has a valid
+// type matching its parent
+func (p *CategoryPack) Validate() error {
+	seen := make(map[string]bool)
+	var validate func(templates []CategoryTemplate, parentType CategoryType) error
+	validate = func(templates []CategoryTemplate, parentType CategoryType) error {
+		for _, template := range templates {
+			if template.Key == "" {
+				return fmt.Errorf("%w: category without a key", ErrInvalidCategoryPack)
+			}
+			if seen[template.Key] {
+				return fmt.Errorf("%w: duplicate key %s", ErrInvalidCategoryPack, template.Key)
+			}
+			seen[template.Key] = true
+
+			categoryType := template.Type
+			switch {
+			case categoryType == "" && parentType == "":
+				return fmt.Errorf("%w: %s has no type", ErrInvalidCategoryPack, template.Key)
+			case categoryType == "":
+				categoryType = parentType
+			case parentType != "" && categoryType != parentType:
+				return fmt.Errorf("%w: %s is a %s category under a %s category", ErrInvalidCategoryPack, template.Key, categoryType, parentType)
+			}
+			if err := (&Category{Name: template.Key, Type: categoryType}).Validate(); err != nil {
+				return fmt.Errorf("%w: %s: %v", ErrInvalidCategoryPack, template.Key, err)
+			}
+
+			if err := validate(template.Children, categoryType); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return validate(p.Categories, "")
+}
+
+// Flatten returns the categories of the pack with every parent before its
+// children. A label is taken from the first translations that have the key,
+// so pass the locale before its fallbacks; without any the key is the name.
+func (p *CategoryPack) Flatten(translations ...CategoryTranslations) []PackCategory {
+	var categories []PackCategory
+	var flatten func(templates []CategoryTemplate, parent *PackCategory)
+	flatten = func(templates []CategoryTemplate, parent *PackCategory) {
+		for _, template := range templates {
+			category := PackCategory{Key: template.Key, Name: template.Key, Type: template.Type, Color: template.Color}
+			if parent != nil {
+				category.ParentKey = parent.Key
+				category.Type = parent.Type
+				if category.Color == "" {
+					category.Color = parent.Color
+				}
+			}
+			for _, locale := range translations {
+				if label, ok := locale[template.Key]; ok && label.Name != "" {
+					category.Name, category.Description = label.Name, label.Description
+					break
+				}
+			}
+
+			categories = append(categories, category)
+			flatten(template.Children, &category)
+		}
+	}
+	flatten(p.Categories, nil)
+	return categories
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCategoryPack_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		pack    CategoryPack
+		wantErr bool
+	}{
+		{
+			name: "valid tree",
+			pack: CategoryPack{Categories: []CategoryTemplate{
+				{Key: "food", Type: CategoryTypeExpense, Children: []CategoryTemplate{
+					{Key: "food.groceries"},
+					{Key: "food.dining", Type: CategoryTypeExpense},
+				}},
+			}},
+		},
+		{
+			name:    "missing key",
+			pack:    CategoryPack{Categories: []CategoryTemplate{{Type: CategoryTypeExpense}}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate key",
+			pack: CategoryPack{Categories: []CategoryTemplate{
+				{Key: "food", Type: CategoryTypeExpense, Children: []CategoryTemplate{{Key: "food"}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "top-level category without type",
+			pack:    CategoryPack{Categories: []CategoryTemplate{{Key: "food"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid type",
+			pack:    CategoryPack{Categories: []CategoryTemplate{{Key: "food", Type: "food"}}},
+			wantErr: true,
+		},
+		{
+			name: "child type differs from parent",
+			pack: CategoryPack{Categories: []CategoryTemplate{
+				{Key: "income", Type: CategoryTypeIncome, Children: []CategoryTemplate{{Key: "income.rent", Type: CategoryTypeExpense}}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pack.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidCategoryPack) {
+				t.Errorf("Validate() error = %v, want ErrInvalidCategoryPack", err)
+			}
+		})
+	}
+}
+
+func TestCategoryPack_Flatten(t *testing.T) {
+	pack := CategoryPack{Categories: []CategoryTemplate{
+		{Key: "food", Type: CategoryTypeExpense, Color: "#795548", Children: []CategoryTemplate{
+			{Key: "food.groceries"},
+			{Key: "food.dining", Color: "#FF7043"},
+		}},
+		{Key: "transfer", Type: CategoryTypeTransfer},
+	}}
+	german := CategoryTranslations{
+		"food":           {Name: "Essen", Description: "Lebensmittel und Restaurants"},
+		"food.groceries": {Name: "Lebensmittel"},
+	}
+	english := CategoryTranslations{
+		"food.dining": {Name: "Dining Out"},
+		"food":        {Name: "Food"},
+	}
+
+	got := pack.Flatten(german, english)
+	want := []PackCategory{
+		{Key: "food", Name: "Essen", Description: "Lebensmittel und Restaurants", Type: CategoryTypeExpense, Color: "#795548"},
+		{Key: "food.groceries", ParentKey: "food", Name: "Lebensmittel", Type: CategoryTypeExpense, Color: "#795548"},
+		{Key: "food.dining", ParentKey: "food", Name: "Dining Out", Type: CategoryTypeExpense, Color: "#FF7043"},
+		{Key: "transfer", Name: "transfer", Type: CategoryTypeTransfer},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten() = %+v, want %+v", got, want)
+	}
+}
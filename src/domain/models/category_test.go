@@ -1,6 +1,7 @@
 package models
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -102,8 +103,8 @@ func TestCategory_Validate(t *testing.T) {
 				return
 			}
 
-			if tt.expectErr && err != tt.errType {
-				t.Errorf("Validate() error type = %T, want %T (%v)", err, tt.errType, tt.errType)
+			if tt.expectErr && !errors.Is(err, tt.errType) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.errType)
 			}
 		})
 	}
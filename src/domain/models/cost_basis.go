@@ -0,0 +1,180 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CostBasisMethod defines how disposals are matched against acquired lots
+type CostBasisMethod string
+
+const (
+	// CostBasisFIFO disposes of the oldest lots first
+	CostBasisFIFO CostBasisMethod = "fifo"
+
+	// CostBasisAverage pools all holdings of an asset at their average cost
+	CostBasisAverage CostBasisMethod = "average"
+)
+
+// LongTermHolding is the holding period after which a gain counts as long term
+const LongTermHolding = 365 * 24 * time.Hour
+
+// Validate checks if the method is known
+func (m CostBasisMethod) Validate() error {
+	switch m {
+	case CostBasisFIFO, CostBasisAverage:
+		return nil
+	}
+	return fmt.Errorf("%q: %w", m, ErrInvalidCostBasisMethod)
+}
+
+// Lot is a quantity of an asset acquired at a known cost
+type Lot struct {
+	Quantity   float64   `json:"quantity"`
+	Cost       float64   `json:"cost"` // total cost of the remaining quantity, in the base currency
+	AcquiredAt time.Time `json:"acquiredAt"`
+}
+
+// RealizedGain is the gain or loss of disposing of (part of) a lot
+type RealizedGain struct {
+	Asset         string    `json:"asset"`
+	Quantity      float64   `json:"quantity"`
+	Proceeds      float64   `json:"proceeds"`
+	CostBasis     float64   `json:"costBasis"`
+	Gain          float64   `json:"gain"`
+	AcquiredAt    time.Time `json:"acquiredAt,omitempty"` // zero for average cost and unknown basis
+	DisposedAt    time.Time `json:"disposedAt"`
+	LongTerm      bool      `json:"longTerm"`
+	MissingBasis  bool      `json:"missingBasis,omitempty"` // more was disposed of than was acquired
+	TransactionID string    `json:"transactionId"`
+}
+
+// CostBasisLedger tracks the lots held per asset and the gains realized by disposals
+type CostBasisLedger struct {
+	method   CostBasisMethod
+	lots     map[string][]Lot
+	realized []RealizedGain
+}
+
+// NewCostBasisLedger creates an empty ledger using the given method
+func NewCostBasisLedger(method CostBasisMethod) *CostBasisLedger {
+	return &CostBasisLedger{
+		method: method,
+		lots:   make(map[string][]Lot),
+	}
+}
+
+// Acquire adds a lot of an asset bought or received at the given total cost
+func (l *CostBasisLedger) Acquire(asset string, quantity, cost float64, at time.Time) {
+	if quantity <= 0 {
+		return
+	}
+	asset = strings.ToUpper(asset)
+
+	// Average cost keeps a single pooled lot per asset
+	if l.method == CostBasisAverage && len(l.lots[asset]) > 0 {
+		pool := &l.lots[asset][0]
+		pool.Quantity += quantity
+		pool.Cost += cost
+		return
+	}
+
+	l.lots[asset] = append(l.lots[asset], Lot{Quantity: quantity, Cost: cost, AcquiredAt: at})
+}
+
+// Dispose removes a quantity of an asset sold or spent for the given total
+// proceeds and records the realized gains. FIFO yields one gain per lot touched.
+// Any quantity beyond the holdings is realized with a zero cost basis.
+func (l *CostBasisLedger) Dispose(asset string, quantity, proceeds float64, at time.Time, transactionID string) []RealizedGain {
+	if quantity <= 0 {
+		return nil
+	}
+	asset = strings.ToUpper(asset)
+
+	var gains []RealizedGain
+	remaining := quantity
+	lots := l.lots[asset]
+	for len(lots) > 0 && remaining > BalanceTolerance {
+		lot := &lots[0]
+		used := min(remaining, lot.Quantity)
+		cost := lot.Cost * used / lot.Quantity
+
+		gain := RealizedGain{
+			Asset:         asset,
+			Quantity:      used,
+			Proceeds:      proceeds * used / quantity,
+			CostBasis:     cost,
+			DisposedAt:    at,
+			TransactionID: transactionID,
+		}
+		if l.method == CostBasisFIFO {
+			gain.AcquiredAt = lot.AcquiredAt
+			gain.LongTerm = at.Sub(lot.AcquiredAt) > LongTermHolding
+		}
+		gain.Gain = gain.Proceeds - gain.CostBasis
+		gains = append(gains, gain)
+
+		lot.Quantity -= used
+		lot.Cost -= cost
+		remaining -= used
+		if lot.Quantity <= BalanceTolerance {
+			lots = lots[1:]
+		}
+	}
+	l.lots[asset] = lots
+
+	if remaining > BalanceTolerance {
+		share := proceeds * remaining / quantity
+		gains = append(gains, RealizedGain{
+			Asset:         asset,
+			Quantity:      remaining,
+			Proceeds:      share,
+			Gain:          share,
+			DisposedAt:    at,
+			MissingBasis:  true,
+			TransactionID: transactionID,
+		})
+	}
+
+	l.realized = append(l.realized, gains...)
+	return gains
+}
+
+// Holdings returns the quantity held of an asset and its remaining cost basis
+func (l *CostBasisLedger) Holdings(asset string) (quantity, cost float64) {
+	for _, lot := range l.lots[strings.ToUpper(asset)] {
+		quantity += lot.Quantity
+		cost += lot.Cost
+	}
+	return quantity, cost
+}
+
+// Lots returns the open lots of an asset, oldest first
+func (l *CostBasisLedger) Lots(asset string) []Lot {
+	return append([]Lot(nil), l.lots[strings.ToUpper(asset)]...)
+}
+
+// Assets returns every asset the ledger has seen, sorted by name
+func (l *CostBasisLedger) Assets() []string {
+	seen := make(map[string]bool, len(l.lots))
+	for asset := range l.lots {
+		seen[asset] = true
+	}
+	for _, gain := range l.realized {
+		seen[gain.Asset] = true
+	}
+
+	assets := make([]string, 0, len(seen))
+	for asset := range seen {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	return assets
+}
+
+// Realized returns all gains realized so far, in disposal order
+func (l *CostBasisLedger) Realized() []RealizedGain {
+	return append([]RealizedGain(nil), l.realized...)
+}
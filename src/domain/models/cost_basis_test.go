@@ -0,0 +1,86 @@
+package models
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCostBasisLedger_FIFO(t *testing.T) {
+	jan := time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC)
+	jun := time.Date(2023, 6, 10, 0, 0, 0, 0, time.UTC)
+	sold := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	ledger := NewCostBasisLedger(CostBasisFIFO)
+	ledger.Acquire("eth", 1, 1000, jan)
+	ledger.Acquire("ETH", 1, 2000, jun)
+
+	gains := ledger.Dispose("ETH", 1.5, 4500, sold, "tx-1")
+	if len(gains) != 2 {
+		t.Fatalf("Dispose() = %d gains, want one per lot", len(gains))
+	}
+
+	first, second := gains[0], gains[1]
+	if first.Quantity != 1 || first.CostBasis != 1000 || first.Proceeds != 3000 || first.Gain != 2000 {
+		t.Errorf("first gain = %+v, want the January lot sold for 3000", first)
+	}
+	if !first.LongTerm || !first.AcquiredAt.Equal(jan) {
+		t.Errorf("first gain should be long term and acquired in January, got %+v", first)
+	}
+	if second.Quantity != 0.5 || second.CostBasis != 1000 || second.Gain != 500 || second.LongTerm {
+		t.Errorf("second gain = %+v, want half the June lot as a short-term gain of 500", second)
+	}
+
+	quantity, cost := ledger.Holdings("eth")
+	if quantity != 0.5 || cost != 1000 {
+		t.Errorf("Holdings() = %v, %v, want 0.5 ETH at a cost of 1000", quantity, cost)
+	}
+}
+
+func TestCostBasisLedger_Average(t *testing.T) {
+	now := time.Now()
+	ledger := NewCostBasisLedger(CostBasisAverage)
+	ledger.Acquire("SOL", 2, 100, now)
+	ledger.Acquire("SOL", 2, 300, now)
+
+	gains := ledger.Dispose("SOL", 1, 150, now, "tx-1")
+	if len(gains) != 1 {
+		t.Fatalf("Dispose() = %d gains, want a single pooled gain", len(gains))
+	}
+	if gains[0].CostBasis != 100 || gains[0].Gain != 50 || !gains[0].AcquiredAt.IsZero() {
+		t.Errorf("gain = %+v, want the average cost of 100", gains[0])
+	}
+
+	if lots := ledger.Lots("SOL"); len(lots) != 1 || lots[0].Quantity != 3 || lots[0].Cost != 300 {
+		t.Errorf("Lots() = %+v, want one pool of 3 SOL at 300", lots)
+	}
+}
+
+func TestCostBasisLedger_MissingBasis(t *testing.T) {
+	ledger := NewCostBasisLedger(CostBasisFIFO)
+	ledger.Acquire("BTC", 1, 100, time.Now())
+
+	gains := ledger.Dispose("BTC", 2, 400, time.Now(), "tx-1")
+	if len(gains) != 2 {
+		t.Fatalf("Dispose() = %d gains, want the held lot and the uncovered rest", len(gains))
+	}
+	if !gains[1].MissingBasis || gains[1].CostBasis != 0 || math.Abs(gains[1].Gain-200) > 1e-9 {
+		t.Errorf("uncovered gain = %+v, want a zero basis gain of 200", gains[1])
+	}
+	if quantity, _ := ledger.Holdings("BTC"); quantity != 0 {
+		t.Errorf("Holdings() = %v, want nothing left", quantity)
+	}
+	if assets := ledger.Assets(); len(assets) != 1 || assets[0] != "BTC" {
+		t.Errorf("Assets() = %v, want [BTC]", assets)
+	}
+}
+
+func TestCostBasisMethod_Validate(t *testing.T) {
+	if err := CostBasisAverage.Validate(); err != nil {
+		t.Errorf("Validate(average) error = %v", err)
+	}
+	if err := CostBasisMethod("lifo").Validate(); !errors.Is(err, ErrInvalidCostBasisMethod) {
+		t.Errorf("Validate(lifo) error = %v, want %v", err, ErrInvalidCostBasisMethod)
+	}
+}
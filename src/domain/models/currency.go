@@ -0,0 +1,140 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultCurrencyDecimals is the number of decimal places of currencies the
+// registry does not know, the minor unit of most ISO 4217 currencies
+const DefaultCurrencyDecimals = 2
+
+// MaxCurrencyDecimals is the largest number of decimal places a currency can
+// have, that of ether and most ERC-20 tokens
+const MaxCurrencyDecimals = 18
+
+// currencyDecimals holds the decimal places of the currencies that differ
+// from DefaultCurrencyDecimals, keyed by upper-cased code
+var currencyDecimals = map[string]int{
+	// ISO 4217 currencies without minor unit
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+
+	// ISO 4217 currencies with three decimal places
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+
+	// Crypto assets, in the base units of their chains
+	"BTC": 8, "WBTC": 8, "ETH": 18, "WETH": 18, "DAI": 18, "SOL": 9, "SUI": 9,
+	"USDC": 6, "USDT": 6,
+}
+
+var currencyMu sync.RWMutex
+
+// RegisterCurrency sets the number of decimal places of a currency, e.g. of a
+// token the registry does not know
+func RegisterCurrency(code string, decimals int) error {
+	if decimals < 0 || decimals > MaxCurrencyDecimals {
+		return fmt.Errorf("currency %s: decimal places must be between 0 and %d, got %d", code, MaxCurrencyDecimals, decimals)
+	}
+	currencyMu.Lock()
+	defer currencyMu.Unlock()
+	currencyDecimals[strings.ToUpper(code)] = decimals
+	return nil
+}
+
+// CurrencyDecimals returns the number of decimal places of a currency,
+// DefaultCurrencyDecimals when the registry does not know it
+func CurrencyDecimals(code string) int {
+	if decimals, ok := LookupCurrencyDecimals(code); ok {
+		return decimals
+	}
+	return DefaultCurrencyDecimals
+}
+
+// LookupCurrencyDecimals returns the number of decimal places of a currency
+// and whether the registry knows it. The common fiat currencies not listed
+// are known to have DefaultCurrencyDecimals.
+func LookupCurrencyDecimals(code string) (int, bool) {
+	code = strings.ToUpper(code)
+	currencyMu.RLock()
+	defer currencyMu.RUnlock()
+	if decimals, ok := currencyDecimals[code]; ok {
+		return decimals, true
+	}
+	return DefaultCurrencyDecimals, IsFiatCurrency(code)
+}
+
+// RoundAmount rounds an amount to the decimal places of its currency
+func RoundAmount(amount float64, currency string) float64 {
+	return roundDecimals(amount, CurrencyDecimals(currency))
+}
+
+// FormatAmount formats an amount with exactly the decimal places of its
+// currency, e.g. "1235" for 1234.5 JPY and "0.00012000" for 0.00012 BTC
+func FormatAmount(amount float64, currency string) string {
+	return FormatDecimals(amount, CurrencyDecimals(currency))
+}
+
+// FormatAmountCompact formats an amount rounded to the decimal places of its
+// currency without trailing zeros, for descriptions, e.g. "1.5" for 1.5 ETH.
+// Amounts of currencies the registry does not know, like most tokens, keep
+// all their digits.
+func FormatAmountCompact(amount float64, currency string) string {
+	rounded := amount
+	if decimals, ok := LookupCurrencyDecimals(currency); ok {
+		rounded = roundDecimals(amount, decimals)
+	}
+	if rounded == 0 {
+		rounded = 0 // drops the sign of -0
+	}
+	return strconv.FormatFloat(rounded, 'f', -1, 64)
+}
+
+// FormatDecimals formats an amount rounded to the given decimal places,
+// never as negative zero
+func FormatDecimals(amount float64, decimals int) string {
+	rounded := roundDecimals(amount, decimals)
+	if rounded == 0 {
+		rounded = 0 // drops the sign of -0
+	}
+
+	// Padding the shortest representation avoids printing the binary noise
+	// beyond a float's precision, like 0.100000000000000006 for 0.1 ETH
+	formatted := strconv.FormatFloat(rounded, 'f', -1, 64)
+	places := 0
+	if dot := strings.IndexByte(formatted, '.'); dot >= 0 {
+		places = len(formatted) - dot - 1
+	}
+	if places > decimals {
+		return strconv.FormatFloat(rounded, 'f', decimals, 64)
+	}
+	if places == 0 && decimals > 0 {
+		formatted += "."
+	}
+	return formatted + strings.Repeat("0", decimals-places)
+}
+
+// ParseAmount parses a decimal amount of a currency, rounded to its decimal
+// places. Surrounding whitespace is ignored.
+func ParseAmount(value, currency string) (float64, error) {
+	amount, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return 0, fmt.Errorf("invalid %s amount %q", currency, value)
+	}
+	return RoundAmount(amount, currency), nil
+}
+
+// roundDecimals rounds half away from zero. Amounts whose scaled value does
+// not fit a float exactly, like 18 decimal places of a large balance, are
+// returned as they are.
+func roundDecimals(amount float64, decimals int) float64 {
+	scale := math.Pow10(decimals)
+	scaled := amount * scale
+	if math.Abs(scaled) >= 1<<53 {
+		return amount
+	}
+	return math.Round(scaled) / scale
+}
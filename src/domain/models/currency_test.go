@@ -0,0 +1,81 @@
+package models
+
+import "testing"
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency string
+		want     string
+	}{
+		{12.5, "EUR", "12.50"},
+		{0.1 + 0.2, "usd", "0.30"},
+		{1234.5, "JPY", "1235"},
+		{1.2345, "KWD", "1.235"},
+		{0.00012, "BTC", "0.00012000"},
+		{1.5, "ETH", "1.500000000000000000"},
+		{0.1, "ETH", "0.100000000000000000"},
+		{-0.001, "EUR", "0.00"},
+		{7, "XYZ", "7.00"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatAmount(tt.amount, tt.currency); got != tt.want {
+			t.Errorf("FormatAmount(%v, %s) = %s, want %s", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestFormatAmountCompact(t *testing.T) {
+	if got := FormatAmountCompact(1.5, "ETH"); got != "1.5" {
+		t.Errorf("FormatAmountCompact(1.5 ETH) = %s, want 1.5", got)
+	}
+	if got := FormatAmountCompact(0.1+0.2, "EUR"); got != "0.3" {
+		t.Errorf("FormatAmountCompact(0.1+0.2 EUR) = %s, want 0.3", got)
+	}
+	if got := FormatAmountCompact(0.000123, "PEPE"); got != "0.000123" {
+		t.Errorf("FormatAmountCompact(0.000123 PEPE) = %s, want the digits of an unknown token kept", got)
+	}
+	if got := FormatAmountCompact(2, "EUR"); got != "2" {
+		t.Errorf("FormatAmountCompact(2 EUR) = %s, want 2", got)
+	}
+	if got := FormatAmountCompact(1234.5, "JPY"); got != "1235" {
+		t.Errorf("FormatAmountCompact(1234.5 JPY) = %s, want 1235", got)
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	amount, err := ParseAmount(" 1234.56 ", "JPY")
+	if err != nil || amount != 1235 {
+		t.Errorf("ParseAmount() = %v, %v, want 1235", amount, err)
+	}
+
+	amount, err = ParseAmount("0.123456789", "SOL")
+	if err != nil || amount != 0.123456789 {
+		t.Errorf("ParseAmount() = %v, %v, want the 9 decimals of SOL kept", amount, err)
+	}
+
+	for _, invalid := range []string{"", "12,50", "NaN", "Inf"} {
+		if _, err := ParseAmount(invalid, "EUR"); err == nil {
+			t.Errorf("ParseAmount(%q) error = nil", invalid)
+		}
+	}
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	if err := RegisterCurrency("pepe", 18); err != nil {
+		t.Fatalf("RegisterCurrency() error = %v", err)
+	}
+	t.Cleanup(func() {
+		currencyMu.Lock()
+		delete(currencyDecimals, "PEPE")
+		currencyMu.Unlock()
+	})
+
+	if got := CurrencyDecimals("PEPE"); got != 18 {
+		t.Errorf("CurrencyDecimals() = %d, want the registered 18", got)
+	}
+	if err := RegisterCurrency("BAD", MaxCurrencyDecimals+1); err == nil {
+		t.Error("RegisterCurrency() accepted more than MaxCurrencyDecimals")
+	}
+}
@@ -0,0 +1,134 @@
+package models
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// DescriptionFields are the transaction fields available to description templates
+type DescriptionFields struct {
+	Amount       float64   `json:"amount"`
+	Asset        string    `json:"asset"`        // currency or token symbol
+	Counterparty string    `json:"counterparty"` // address or name of the other party
+	Chain        string    `json:"chain"`
+	Memo         string    `json:"memo"`
+	Reference    string    `json:"reference"` // structured payment reference reported by the bank
+	Type         string    `json:"type"`
+	Source       string    `json:"source"`
+	Description  string    `json:"description"` // the description generated by the source client
+	Date         time.Time `json:"date"`
+}
+
+// NewDescriptionFields collects the template fields of a transaction from its
+// provider metadata
+func NewDescriptionFields(tx *Transaction, source string) DescriptionFields {
+	asset := tx.Metadata["token"]
+	if asset == "" {
+		asset = tx.Metadata["currency"]
+	}
+	memo := tx.Metadata["memo"]
+	if memo == "" {
+		memo = tx.Metadata["note"]
+	}
+
+	return DescriptionFields{
+		Amount:       tx.Amount,
+		Asset:        asset,
+		Counterparty: tx.Metadata["counterparty"],
+		Chain:        tx.Metadata["chain"],
+		Memo:         memo,
+		Reference:    tx.Reference,
+		Type:         string(tx.Type),
+		Source:       source,
+		Description:  tx.Description,
+		Date:         tx.Date,
+	}
+}
+
+// descriptionFuncs are the helper functions available to description templates
+var descriptionFuncs = template.FuncMap{
+	"short": shortIdentifier,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// shortIdentifier abbreviates long addresses and hashes to their first and last characters
+func shortIdentifier(value string) string {
+	if len(value) <= 13 {
+		return value
+	}
+	return value[:6] + "…" + value[len(value)-4:]
+}
+
+// DescriptionTemplate renders transaction descriptions with a Go text/template,
+// e.g. "{{.Type}} {{.Amount}} {{.Asset}} {{with .Counterparty}}with {{short .}}{{end}}"
+type DescriptionTemplate struct {
+	text     string
+	template *template.Template
+}
+
+// ParseDescriptionTemplate parses a template and checks that it only uses known fields
+func ParseDescriptionTemplate(text string) (*DescriptionTemplate, error) {
+	tmpl, err := template.New("description").Funcs(descriptionFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDescriptionTemplate, err)
+	}
+
+	t := &DescriptionTemplate{text: text, template: tmpl}
+	if _, err := t.Render(DescriptionFields{}); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDescriptionTemplate, err)
+	}
+	return t, nil
+}
+
+// String returns the template text
+func (t *DescriptionTemplate) String() string {
+	return t.text
+}
+
+// Render renders the description of a transaction with runs of whitespace
+// collapsed, so optional fields leave no gaps
+func (t *DescriptionTemplate) Render(fields DescriptionFields) (string, error) {
+	var b bytes.Buffer
+	if err := t.template.Execute(&b, fields); err != nil {
+		return "", err
+	}
+	return strings.Join(strings.Fields(b.String()), " "), nil
+}
+
+// DescriptionTemplates holds the global description template and per-source
+// overrides. A nil template keeps the description of the source client.
+type DescriptionTemplates struct {
+	Default *DescriptionTemplate
+	Sources map[string]*DescriptionTemplate
+}
+
+// For returns the template that applies to a source, nil when there is none
+func (t DescriptionTemplates) For(source string) *DescriptionTemplate {
+	if tmpl, ok := t.Sources[source]; ok {
+		return tmpl
+	}
+	return t.Default
+}
+
+// Apply replaces the description of a transaction with the rendered template
+// of its source. An empty rendering keeps the original description, so
+// templates can leave transactions they do not describe untouched.
+func (t DescriptionTemplates) Apply(tx *Transaction, source string) error {
+	tmpl := t.For(source)
+	if tmpl == nil {
+		return nil
+	}
+
+	description, err := tmpl.Render(NewDescriptionFields(tx, source))
+	if err != nil {
+		return err
+	}
+	if description != "" {
+		tx.Description = description
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseDescriptionTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr bool
+	}{
+		{"fields", "{{.Type}} {{.Amount}} {{.Asset}} on {{.Chain}}", false},
+		{"helpers", "{{upper .Asset}} {{with .Counterparty}}{{short .}}{{end}}", false},
+		{"unknown field", "{{.Recipient}}", true},
+		{"syntax", "{{.Amount", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDescriptionTemplate(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDescriptionTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidDescriptionTemplate) {
+				t.Errorf("ParseDescriptionTemplate() error = %v, want %v", err, ErrInvalidDescriptionTemplate)
+			}
+		})
+	}
+}
+
+func TestDescriptionTemplates_Apply(t *testing.T) {
+	sent, err := ParseDescriptionTemplate(`Sent {{.Amount}} {{.Asset}} {{with .Counterparty}}to {{short .}}{{end}} {{with .Memo}}({{.}}){{end}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	templates := DescriptionTemplates{
+		Default: sent,
+		Sources: map[string]*DescriptionTemplate{"kraken": nil},
+	}
+
+	tx := &Transaction{
+		Amount:      1.5,
+		Description: "Sent 1.500000 SOL",
+		Type:        TransactionTypeExpense,
+		Metadata: map[string]string{
+			"chain":        "solana",
+			"currency":     "SOL",
+			"counterparty": "7xKXtg2CW87d97TXJSDpbD5jBkheTqA83TZRuJosgAsU",
+		},
+	}
+	if err := templates.Apply(tx, "solana"); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if want := "Sent 1.5 SOL to 7xKXtg…gAsU"; tx.Description != want {
+		t.Errorf("Description = %q, want %q", tx.Description, want)
+	}
+
+	// A source without a template keeps the description of its client
+	tx = &Transaction{Amount: 2, Description: "Deposit 2 BTC to Kraken"}
+	if err := templates.Apply(tx, "kraken"); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if tx.Description != "Deposit 2 BTC to Kraken" {
+		t.Errorf("Description = %q, want the original", tx.Description)
+	}
+}
+
+func TestNewDescriptionFields_PrefersToken(t *testing.T) {
+	tx := &Transaction{Metadata: map[string]string{"token": "USDC", "currency": "ETH", "note": "rent"}}
+	fields := NewDescriptionFields(tx, "ethereum")
+	if fields.Asset != "USDC" || fields.Memo != "rent" || fields.Source != "ethereum" {
+		t.Errorf("fields = %+v", fields)
+	}
+}
@@ -0,0 +1,131 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// DuplicateAction defines what happens to a transaction that looks like a duplicate
+type DuplicateAction string
+
+const (
+	// DuplicateActionBlock rejects the transaction
+	DuplicateActionBlock DuplicateAction = "block"
+
+	// DuplicateActionFlag stores the transaction tagged for manual review
+	DuplicateActionFlag DuplicateAction = "flag"
+
+	// DuplicateActionAllow stores the transaction tagged as a possible duplicate
+	DuplicateActionAllow DuplicateAction = "allow"
+)
+
+const (
+	// DuplicateReviewTag is added to transactions flagged for review
+	DuplicateReviewTag = "needs-review"
+
+	// PossibleDuplicateTag is added to every stored transaction that matched a duplicate
+	PossibleDuplicateTag = "possible-duplicate"
+)
+
+// DuplicatePolicy controls how potential duplicates are detected and handled
+type DuplicatePolicy struct {
+	Window    time.Duration   `json:"window"`    // transactions this close in time are compared
+	Tolerance float64         `json:"tolerance"` // maximum amount difference for a match
+	Action    DuplicateAction `json:"action"`
+}
+
+// DefaultDuplicatePolicy blocks transactions with the same amount within a day
+func DefaultDuplicatePolicy() DuplicatePolicy {
+	return DuplicatePolicy{
+		Window:    24 * time.Hour,
+		Tolerance: 0.01,
+		Action:    DuplicateActionBlock,
+	}
+}
+
+// Validate checks if the policy is valid
+func (p DuplicatePolicy) Validate() error {
+	switch p.Action {
+	case DuplicateActionBlock, DuplicateActionFlag, DuplicateActionAllow:
+	default:
+		return fmt.Errorf("unknown action %q: %w", p.Action, ErrInvalidDuplicatePolicy)
+	}
+
+	if p.Window < 0 || p.Tolerance < 0 {
+		return fmt.Errorf("window and tolerance must not be negative: %w", ErrInvalidDuplicatePolicy)
+	}
+
+	return nil
+}
+
+// Range returns the dates a transaction's duplicates can fall between: the
+// window centered on its date. With a location the range widens to whole
+// calendar days there, so a booking date at midnight of the source's
+// timezone matches the other transactions of that day wherever the window
+// would cut it.
+func (p DuplicatePolicy) Range(date time.Time, loc *time.Location) (time.Time, time.Time) {
+	from, to := date.Add(-p.Window/2), date.Add(p.Window/2)
+	if loc == nil {
+		return from, to
+	}
+	return StartOfDay(from, loc), StartOfDay(to, loc).AddDate(0, 0, 1).Add(-time.Nanosecond)
+}
+
+// Matches reports whether other is a duplicate of tx under the policy, by the
+// rules the transaction repository's FindDuplicates applies to stored
+// transactions. It compares transactions that are not stored yet, e.g. the
+// earlier ones of an import batch.
+func (p DuplicatePolicy) Matches(tx, other *Transaction, loc *time.Location) bool {
+	if other.IsDeleted() || other.WalletID != tx.WalletID {
+		return false
+	}
+	// Payments for different references are different payments
+	if tx.Reference != "" && other.Reference != "" && other.Reference != tx.Reference {
+		return false
+	}
+	if tx.Type == TransactionTypeTransfer && tx.DestWalletID != "" && other.DestWalletID != tx.DestWalletID {
+		return false
+	}
+
+	// A payment with an end-to-end ID is the same payment as any other with that
+	// ID; otherwise it only resembles transactions that carry no ID of their own
+	if tx.EndToEndID != "" {
+		if other.EndToEndID == tx.EndToEndID {
+			return true
+		}
+		if other.EndToEndID != "" {
+			return false
+		}
+	}
+
+	from, to := p.Range(tx.Date, loc)
+	return other.Type == tx.Type &&
+		math.Abs(other.Amount-tx.Amount) <= p.Tolerance &&
+		!other.Date.Before(from) && !other.Date.After(to)
+}
+
+// Tags returns the tags added to a transaction stored despite matching a duplicate
+func (p DuplicatePolicy) Tags() []string {
+	switch p.Action {
+	case DuplicateActionFlag:
+		return []string{PossibleDuplicateTag, DuplicateReviewTag}
+	case DuplicateActionAllow:
+		return []string{PossibleDuplicateTag}
+	}
+	return nil
+}
+
+// DuplicatePolicies holds the global duplicate policy and per-source overrides
+type DuplicatePolicies struct {
+	Default DuplicatePolicy
+	Sources map[string]DuplicatePolicy
+}
+
+// For returns the policy that applies to a source
+func (p DuplicatePolicies) For(source string) DuplicatePolicy {
+	if policy, ok := p.Sources[source]; ok {
+		return policy
+	}
+	return p.Default
+}
@@ -0,0 +1,110 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDuplicatePolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  DuplicatePolicy
+		wantErr bool
+	}{
+		{"default", DefaultDuplicatePolicy(), false},
+		{"flag", DuplicatePolicy{Window: time.Hour, Action: DuplicateActionFlag}, false},
+		{"unknown action", DuplicatePolicy{Window: time.Hour, Action: "ignore"}, true},
+		{"negative tolerance", DuplicatePolicy{Tolerance: -1, Action: DuplicateActionAllow}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidDuplicatePolicy) {
+				t.Errorf("Validate() error = %v, want %v", err, ErrInvalidDuplicatePolicy)
+			}
+		})
+	}
+}
+
+func TestDuplicatePolicies_For(t *testing.T) {
+	policies := DuplicatePolicies{
+		Default: DefaultDuplicatePolicy(),
+		Sources: map[string]DuplicatePolicy{
+			"csv": {Window: time.Hour, Tolerance: 0, Action: DuplicateActionFlag},
+		},
+	}
+
+	if got := policies.For("csv").Action; got != DuplicateActionFlag {
+		t.Errorf("For(csv).Action = %v, want %v", got, DuplicateActionFlag)
+	}
+	if got := policies.For("ethereum").Action; got != DuplicateActionBlock {
+		t.Errorf("For(ethereum).Action = %v, want %v", got, DuplicateActionBlock)
+	}
+	if tags := policies.For("csv").Tags(); len(tags) != 2 {
+		t.Errorf("For(csv).Tags() = %v, want possible-duplicate and needs-review", tags)
+	}
+}
+
+func TestDuplicatePolicy_Range(t *testing.T) {
+	policy := DefaultDuplicatePolicy()
+	sydney := time.FixedZone("AEST", 10*60*60)
+	booked := time.Date(2024, 5, 2, 0, 0, 0, 0, sydney)
+
+	from, to := policy.Range(booked, nil)
+	if !from.Equal(booked.Add(-12*time.Hour)) || !to.Equal(booked.Add(12*time.Hour)) {
+		t.Errorf("Range() without location = %v - %v, want the window centered on the date", from, to)
+	}
+
+	from, to = policy.Range(booked, sydney)
+	if want := time.Date(2024, 5, 1, 0, 0, 0, 0, sydney); !from.Equal(want) {
+		t.Errorf("Range() from = %v, want %v", from, want)
+	}
+	// A card payment at 23:30 on the booking day is in range
+	if payment := time.Date(2024, 5, 2, 23, 30, 0, 0, sydney); to.Before(payment) {
+		t.Errorf("Range() to = %v, want it to cover %v", to, payment)
+	}
+}
+
+func TestDuplicatePolicy_Matches(t *testing.T) {
+	policy := DefaultDuplicatePolicy()
+	date := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)
+	tx := &Transaction{WalletID: "wallet-1", Type: TransactionTypeExpense, Amount: 12.5, Date: date}
+
+	tests := []struct {
+		name  string
+		other Transaction
+		want  bool
+	}{
+		{"same payment", Transaction{WalletID: "wallet-1", Type: TransactionTypeExpense, Amount: 12.5, Date: date.Add(time.Hour)}, true},
+		{"within tolerance", Transaction{WalletID: "wallet-1", Type: TransactionTypeExpense, Amount: 12.51, Date: date}, true},
+		{"other amount", Transaction{WalletID: "wallet-1", Type: TransactionTypeExpense, Amount: 13, Date: date}, false},
+		{"other wallet", Transaction{WalletID: "wallet-2", Type: TransactionTypeExpense, Amount: 12.5, Date: date}, false},
+		{"other type", Transaction{WalletID: "wallet-1", Type: TransactionTypeIncome, Amount: 12.5, Date: date}, false},
+		{"outside the window", Transaction{WalletID: "wallet-1", Type: TransactionTypeExpense, Amount: 12.5, Date: date.Add(13 * time.Hour)}, false},
+		{"other reference", Transaction{WalletID: "wallet-1", Type: TransactionTypeExpense, Amount: 12.5, Date: date, Reference: "INV-2"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.Matches(tx, &tt.other, nil); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Different references and end-to-end IDs tell payments apart
+	referenced := &Transaction{WalletID: "wallet-1", Type: TransactionTypeExpense, Amount: 12.5, Date: date, Reference: "INV-1", EndToEndID: "E2E-1"}
+	if policy.Matches(referenced, &Transaction{WalletID: "wallet-1", Type: TransactionTypeExpense, Amount: 12.5, Date: date, Reference: "INV-2"}, nil) {
+		t.Error("Matches() = true for another reference")
+	}
+	if policy.Matches(referenced, &Transaction{WalletID: "wallet-1", Type: TransactionTypeExpense, Amount: 12.5, Date: date, EndToEndID: "E2E-2"}, nil) {
+		t.Error("Matches() = true for another end-to-end ID")
+	}
+	if !policy.Matches(referenced, &Transaction{WalletID: "wallet-1", Type: TransactionTypeExpense, Amount: 99, Date: date.AddDate(0, 0, -3), EndToEndID: "E2E-1"}, nil) {
+		t.Error("Matches() = false for the same end-to-end ID")
+	}
+}
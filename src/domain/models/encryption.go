@@ -0,0 +1,145 @@
+package models
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EncryptedFieldPrefix marks a field value encrypted with a data key. Values
+// without it are plaintext, e.g. written before encryption was enabled.
+const EncryptedFieldPrefix = "enc:v1:"
+
+// DataKeySize is the size of a data key in bytes (AES-256)
+const DataKeySize = 32
+
+// EncryptedMetadataKeys are the transaction metadata entries encrypted along
+// with the description and the notes
+var EncryptedMetadataKeys = []string{"counterparty"}
+
+// DataKey encrypts the sensitive fields of the records of one space. It is
+// stored wrapped with the master key; Key holds the unwrapped key and is
+// never serialized.
+type DataKey struct {
+	ID        string    `json:"id"`
+	SpaceID   string    `json:"spaceId,omitempty"` // empty for records outside any space
+	Key       []byte    `json:"-"`
+	Active    bool      `json:"active"` // new values are encrypted with the active key of their space
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NewDataKey creates an active data key for a space with random key material.
+// The ID is assigned when the key is stored.
+func NewDataKey(spaceID string) (*DataKey, error) {
+	key := make([]byte, DataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	return &DataKey{
+		SpaceID:   spaceID,
+		Key:       key,
+		Active:    true,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// EncryptField encrypts value with AES-256-GCM into
+// "enc:v1:<key id>:<base64 nonce and ciphertext>". Empty values stay empty.
+func (k *DataKey) EncryptField(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	aead, err := k.aead()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(value), []byte(k.ID))
+	return EncryptedFieldPrefix + k.ID + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptField decrypts a value encrypted with this key. Plaintext values are
+// returned unchanged; values encrypted with another key fail with
+// ErrDataKeyMismatch.
+func (k *DataKey) DecryptField(value string) (string, error) {
+	keyID, data, ok := parseEncryptedField(value)
+	if !ok {
+		return value, nil
+	}
+	if keyID != k.ID {
+		return "", fmt.Errorf("value encrypted with key %s: %w", keyID, ErrDataKeyMismatch)
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(data)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidEncryptedField, err)
+	}
+
+	aead, err := k.aead()
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("%w: ciphertext too short", ErrInvalidEncryptedField)
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(k.ID))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidEncryptedField, err)
+	}
+
+	return string(plaintext), nil
+}
+
+func (k *DataKey) aead() (cipher.AEAD, error) {
+	if len(k.Key) != DataKeySize {
+		return nil, fmt.Errorf("data key %s must be %d bytes long", k.ID, DataKeySize)
+	}
+
+	block, err := aes.NewCipher(k.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// EncryptedFieldKeyID returns the ID of the data key that encrypted value,
+// or false if value is plaintext
+func EncryptedFieldKeyID(value string) (string, bool) {
+	keyID, _, ok := parseEncryptedField(value)
+	return keyID, ok
+}
+
+// parseEncryptedField splits an encrypted value into its key ID and encoded ciphertext
+func parseEncryptedField(value string) (keyID, data string, ok bool) {
+	rest, found := strings.CutPrefix(value, EncryptedFieldPrefix)
+	if !found {
+		return "", "", false
+	}
+
+	keyID, data, found = strings.Cut(rest, ":")
+	if !found || keyID == "" {
+		return "", "", false
+	}
+
+	return keyID, data, true
+}
+
+// KeyRotationReport summarizes a key rotation
+type KeyRotationReport struct {
+	DataKeys    int `json:"dataKeys"`    // data keys created or rewrapped
+	Reencrypted int `json:"reencrypted"` // records whose fields were encrypted again
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testDataKey(t *testing.T, id string) *DataKey {
+	t.Helper()
+	key, err := NewDataKey("space1")
+	if err != nil {
+		t.Fatalf("NewDataKey() error = %v", err)
+	}
+	key.ID = id
+	return key
+}
+
+func TestDataKeyEncryptField(t *testing.T) {
+	key := testDataKey(t, "key1")
+
+	encrypted, err := key.EncryptField("Rent for March")
+	if err != nil {
+		t.Fatalf("EncryptField() error = %v", err)
+	}
+	if !strings.HasPrefix(encrypted, EncryptedFieldPrefix+"key1:") {
+		t.Errorf("EncryptField() = %q, want the prefix and key ID", encrypted)
+	}
+	if strings.Contains(encrypted, "Rent") {
+		t.Errorf("EncryptField() = %q leaks the plaintext", encrypted)
+	}
+
+	if id, ok := EncryptedFieldKeyID(encrypted); !ok || id != "key1" {
+		t.Errorf("EncryptedFieldKeyID() = %q, %v, want key1, true", id, ok)
+	}
+
+	decrypted, err := key.DecryptField(encrypted)
+	if err != nil {
+		t.Fatalf("DecryptField() error = %v", err)
+	}
+	if decrypted != "Rent for March" {
+		t.Errorf("DecryptField() = %q, want Rent for March", decrypted)
+	}
+}
+
+func TestDataKeyEncryptFieldEmpty(t *testing.T) {
+	key := testDataKey(t, "key1")
+
+	encrypted, err := key.EncryptField("")
+	if err != nil || encrypted != "" {
+		t.Errorf("EncryptField(\"\") = %q, %v, want empty", encrypted, err)
+	}
+}
+
+func TestDataKeyDecryptField(t *testing.T) {
+	key := testDataKey(t, "key1")
+	other := testDataKey(t, "key2")
+
+	encrypted, err := key.EncryptField("Coffee")
+	if err != nil {
+		t.Fatalf("EncryptField() error = %v", err)
+	}
+
+	if got, err := key.DecryptField("plain text"); err != nil || got != "plain text" {
+		t.Errorf("DecryptField(plaintext) = %q, %v, want it unchanged", got, err)
+	}
+
+	if _, err := other.DecryptField(encrypted); !errors.Is(err, ErrDataKeyMismatch) {
+		t.Errorf("DecryptField() with another key error = %v, want ErrDataKeyMismatch", err)
+	}
+
+	// A value moved to another key ID fails authentication
+	forged := strings.Replace(encrypted, "key1", "key2", 1)
+	other.Key = key.Key
+	if _, err := other.DecryptField(forged); !errors.Is(err, ErrInvalidEncryptedField) {
+		t.Errorf("DecryptField() of a forged value error = %v, want ErrInvalidEncryptedField", err)
+	}
+
+	if _, err := key.DecryptField(EncryptedFieldPrefix + "key1:!!"); !errors.Is(err, ErrInvalidEncryptedField) {
+		t.Errorf("DecryptField() of malformed value error = %v, want ErrInvalidEncryptedField", err)
+	}
+}
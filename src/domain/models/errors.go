@@ -9,60 +9,303 @@ var (
 	// Transaction errors
 	// ErrInvalidAmount is returned when a transaction amount is invalid
 	ErrInvalidAmount = errors.New("transaction amount must be greater than 0")
-	
+
 	// ErrFutureDate is returned when a transaction date is in the future
 	ErrFutureDate = errors.New("transaction date cannot be in the future")
-	
+
 	// ErrMissingWallet is returned when a transaction has no wallet
 	ErrMissingWallet = errors.New("transaction must have a wallet")
-	
+
 	// ErrMissingCategory is returned when a transaction has no category
 	ErrMissingCategory = errors.New("transaction must have a category")
-	
+
 	// ErrMissingDestWallet is returned when a transfer transaction has no destination wallet
 	ErrMissingDestWallet = errors.New("transfer transaction must have a destination wallet")
-	
+
 	// ErrSameWallet is returned when a transfer transaction has the same source and destination wallet
 	ErrSameWallet = errors.New("transfer transaction cannot have the same source and destination wallet")
-	
+
 	// ErrNotTransferTransaction is returned when trying to set a destination wallet on a non-transfer transaction
 	ErrNotTransferTransaction = errors.New("destination wallet can only be set on transfer transactions")
-	
+
 	// ErrInsufficientBalance is returned when a wallet has insufficient balance for a transaction
 	ErrInsufficientBalance = errors.New("wallet has insufficient balance for this transaction")
-	
+
 	// ErrCategoryTypeMismatch is returned when a transaction type doesn't match the category type
 	ErrCategoryTypeMismatch = errors.New("transaction type doesn't match category type")
-	
+
+	// ErrConflict is returned when a record was modified by someone else since it was read
+	ErrConflict = errors.New("record was modified concurrently")
+
 	// ErrDuplicateTransaction is returned when a duplicate transaction is detected
 	ErrDuplicateTransaction = errors.New("duplicate transaction detected")
-	
+
 	// ErrInvalidExchangeRate is returned when a cross-currency transfer has an invalid exchange rate
 	ErrInvalidExchangeRate = errors.New("cross-currency transfer must have a valid exchange rate")
 
+	// ErrTransactionDeleted is returned when operating on a soft-deleted transaction
+	ErrTransactionDeleted = errors.New("transaction has been deleted")
+
+	// ErrTransactionNotDeleted is returned when restoring a transaction that is not deleted
+	ErrTransactionNotDeleted = errors.New("transaction is not deleted")
+
+	// ErrRestoreWindowExpired is returned when a soft-deleted transaction is too old to restore
+	ErrRestoreWindowExpired = errors.New("transaction restore window has expired")
+
+	// ErrInvalidFee is returned when a transaction fee is negative
+	ErrInvalidFee = errors.New("transaction fee cannot be negative")
+
+	// ErrInvalidMerge is returned when transactions cannot be merged
+	ErrInvalidMerge = errors.New("transactions cannot be merged")
+
 	// Wallet errors
 	// ErrMissingWalletName is returned when a wallet has no name
 	ErrMissingWalletName = errors.New("wallet must have a name")
-	
+
 	// ErrMissingCurrency is returned when a wallet has no currency
 	ErrMissingCurrency = errors.New("wallet must have a currency")
-	
+
 	// ErrWalletNotFound is returned when a wallet is not found
 	ErrWalletNotFound = errors.New("wallet not found")
-	
+
 	// ErrInvalidCurrency is returned when a wallet has an invalid currency
 	ErrInvalidCurrency = errors.New("invalid currency code")
 
+	// ErrWalletArchived is returned when a transaction targets an archived wallet
+	ErrWalletArchived = errors.New("wallet is archived")
+
+	// ErrWalletHasTransactions is returned when deleting a wallet that still has transactions
+	ErrWalletHasTransactions = errors.New("wallet has transactions; archive it instead")
+
+	// ErrInvalidBalanceType is returned when a balance type is not a known ISO 20022 balance type
+	ErrInvalidBalanceType = errors.New("invalid balance type")
+
+	// Balance assertion errors
+	// ErrInvalidBalanceAssertion is returned when a balance assertion is incomplete or out of range
+	ErrInvalidBalanceAssertion = errors.New("invalid balance assertion")
+
+	// ErrBalanceAssertionNotFound is returned when a balance assertion does not exist
+	ErrBalanceAssertionNotFound = errors.New("balance assertion not found")
+
 	// Category errors
 	// ErrMissingCategoryName is returned when a category has no name
 	ErrMissingCategoryName = errors.New("category must have a name")
-	
+
 	// ErrInvalidCategoryType is returned when a category has an invalid type
 	ErrInvalidCategoryType = errors.New("invalid category type")
-	
+
 	// ErrCategoryNotFound is returned when a category is not found
 	ErrCategoryNotFound = errors.New("category not found")
-	
+
 	// ErrSystemCategoryCannotBeDeleted is returned when attempting to delete a system category
 	ErrSystemCategoryCannotBeDeleted = errors.New("system categories cannot be deleted")
-) 
\ No newline at end of file
+
+	// ErrInvalidCategoryPack is returned when a category pack is malformed
+	ErrInvalidCategoryPack = errors.New("invalid category pack")
+
+	// Transformation rule errors
+	// ErrMissingRuleName is returned when a transformation rule has no name
+	ErrMissingRuleName = errors.New("transformation rule must have a name")
+
+	// ErrMissingRuleScript is returned when a transformation rule has no script
+	ErrMissingRuleScript = errors.New("transformation rule must have a script")
+
+	// Account mapping errors
+	// ErrMissingMappingSource is returned when an account mapping has no source or source account
+	ErrMissingMappingSource = errors.New("account mapping must have a source and source account")
+
+	// ErrAccountMappingNotFound is returned when a source account cannot be mapped to a Firefly account
+	ErrAccountMappingNotFound = errors.New("no Firefly account mapped for source account")
+
+	// ErrAccountMappingConfigured is returned when deleting a Firefly account that a configured mapping points at
+	ErrAccountMappingConfigured = errors.New("Firefly account is mapped in the configuration")
+
+	// ErrTransactionNotExported is returned when a Firefly operation needs a transaction that was never exported
+	ErrTransactionNotExported = errors.New("transaction is not linked to a Firefly transaction")
+
+	// Secret errors
+	// ErrSecretNotFound is returned when no secret is stored under the given name
+	ErrSecretNotFound = errors.New("secret not found")
+
+	// ErrMissingSecretsKey is returned when secrets are used without an encryption key configured
+	ErrMissingSecretsKey = errors.New("secrets encryption key is not configured")
+
+	// ErrInvalidOAuthState is returned when an OAuth callback carries an unknown or expired state
+	ErrInvalidOAuthState = errors.New("invalid or expired oauth state")
+
+	// Tag errors
+	// ErrMissingTagName is returned when a tag has no name
+	ErrMissingTagName = errors.New("tag must have a name")
+
+	// ErrInvalidTagMerge is returned when a tag is merged into itself
+	ErrInvalidTagMerge = errors.New("a tag cannot be merged into itself")
+
+	// Source errors
+	// ErrSourceNotFound is returned when no import source is configured with the given ID
+	ErrSourceNotFound = errors.New("import source not found")
+
+	// ErrSourceHasNoClient is returned when syncing a source that has no client yet
+	ErrSourceHasNoClient = errors.New("import source has no client")
+
+	// ErrSourcePaused is returned when syncing a source that was paused
+	ErrSourcePaused = errors.New("import source is paused")
+
+	// ErrInvalidSourceTransition is returned when moving a source to a lifecycle
+	// state that cannot follow its current one
+	ErrInvalidSourceTransition = errors.New("invalid import source transition")
+
+	// ErrSourceNotSyncable is returned when importing from a source whose
+	// lifecycle state does not allow it, e.g. a disabled source
+	ErrSourceNotSyncable = errors.New("import source cannot sync in its current state")
+
+	// ErrConsentNotSupported is returned when renewing the consent of a source
+	// whose provider grants access without one
+	ErrConsentNotSupported = errors.New("import source has no consent to renew")
+
+	// ErrNoArchivedPayloads is returned when replaying an import run whose
+	// raw payloads were not archived or were already purged
+	ErrNoArchivedPayloads = errors.New("no raw payloads archived for the import run")
+
+	// ErrNormalizerNotVersioned is returned when re-normalizing a source whose
+	// client does not report the version of its normalizer
+	ErrNormalizerNotVersioned = errors.New("source client does not version its normalizer")
+
+	// ErrWorkerUnavailable is returned when no live worker node fetches a source,
+	// which is then fetched locally
+	ErrWorkerUnavailable = errors.New("no worker node available for the source")
+
+	// ErrUnknownImportProfile is returned when importing a file with a profile that does not exist
+	ErrUnknownImportProfile = errors.New("unknown file import profile")
+
+	// ErrInvalidImportFile is returned when an import file cannot be parsed by its profile
+	ErrInvalidImportFile = errors.New("invalid import file")
+
+	// ErrBackfillUnsupported is returned when backfilling a source whose client cannot page through its history
+	ErrBackfillUnsupported = errors.New("import source does not support backfills")
+
+	// ErrBackfillRunning is returned when starting a backfill of a source that is already being backfilled
+	ErrBackfillRunning = errors.New("backfill is already running")
+
+	// ErrBackfillNotFound is returned when a source has no backfill
+	ErrBackfillNotFound = errors.New("backfill not found")
+
+	// Duplicate policy errors
+	// ErrInvalidDuplicatePolicy is returned when a duplicate policy has an unknown action or negative limits
+	ErrInvalidDuplicatePolicy = errors.New("invalid duplicate policy")
+
+	// Description template errors
+	// ErrInvalidDescriptionTemplate is returned when a description template does not parse or uses unknown fields
+	ErrInvalidDescriptionTemplate = errors.New("invalid description template")
+
+	// Cost basis errors
+	// ErrInvalidCostBasisMethod is returned when a cost basis method is unknown
+	ErrInvalidCostBasisMethod = errors.New("cost basis method must be fifo or average")
+
+	// Period errors
+	// ErrInvalidPeriodCalendar is returned when the fiscal year or pay period start is out of range
+	ErrInvalidPeriodCalendar = errors.New("invalid period calendar")
+
+	// ErrInvalidPeriod is returned when a period name cannot be resolved
+	ErrInvalidPeriod = errors.New("invalid period")
+
+	// Export errors
+	// ErrUnknownExportDataset is returned when an export requests records that cannot be exported
+	ErrUnknownExportDataset = errors.New("unknown export dataset")
+
+	// ErrInvalidExportFormat is returned when an export format is neither csv nor json
+	ErrInvalidExportFormat = errors.New("export format must be csv or json")
+
+	// ErrInvalidExportRange is returned when an export range ends before it starts
+	ErrInvalidExportRange = errors.New("invalid export range")
+
+	// ErrExportNotFound is returned when an export job does not exist
+	ErrExportNotFound = errors.New("export not found")
+
+	// ErrExportNotReady is returned when the file of a running or failed export is requested
+	ErrExportNotReady = errors.New("export is not ready")
+
+	// Budget import errors
+	// ErrBudgetImportNotFound is returned when a staged budget import does not exist
+	ErrBudgetImportNotFound = errors.New("budget import not found")
+
+	// ErrBudgetImportNotPending is returned when changing a budget import that was already committed or discarded
+	ErrBudgetImportNotPending = errors.New("budget import is no longer pending")
+
+	// ErrInvalidBudgetMapping is returned when a budget import mapping names unknown accounts or categories
+	ErrInvalidBudgetMapping = errors.New("invalid budget import mapping")
+
+	// Categorization errors
+	// ErrNoCategoryReview is returned when reviewing a transaction whose category was not suggested by the classifier
+	ErrNoCategoryReview = errors.New("transaction has no suggested category to review")
+
+	// Space errors
+	// ErrMissingSpaceName is returned when a space has no name
+	ErrMissingSpaceName = errors.New("space must have a name")
+
+	// ErrInvalidSpaceKind is returned when a space is not a household, personal or business space
+	ErrInvalidSpaceKind = errors.New("space kind must be household, personal or business")
+
+	// ErrInvalidSpaceRole is returned when a member role is not viewer, editor or owner
+	ErrInvalidSpaceRole = errors.New("space role must be viewer, editor or owner")
+
+	// ErrSpaceNotFound is returned when a space does not exist
+	ErrSpaceNotFound = errors.New("space not found")
+
+	// ErrSpaceMemberNotFound is returned when a user is not a member of a space
+	ErrSpaceMemberNotFound = errors.New("space member not found")
+
+	// ErrSpaceAccessDenied is returned when a user's role in a space does not allow an action
+	ErrSpaceAccessDenied = errors.New("space access denied")
+
+	// ErrLastSpaceOwner is returned when removing or demoting the only owner of a space
+	ErrLastSpaceOwner = errors.New("space must keep an owner")
+
+	// ErrNotInSpace is returned when releasing a wallet from a space it does not belong to
+	ErrNotInSpace = errors.New("wallet is not in the space")
+
+	// ErrSystemCategoryNotMovable is returned when moving a system category into a space
+	ErrSystemCategoryNotMovable = errors.New("system categories are shared by every space")
+
+	// Preferences errors
+	// ErrInvalidPreferences is returned when user preferences are out of range
+	ErrInvalidPreferences = errors.New("invalid preferences")
+
+	// ErrPreferencesNotFound is returned when a user has not stored preferences
+	ErrPreferencesNotFound = errors.New("preferences not found")
+
+	// Audit errors
+	// ErrAuditLogAppendOnly is returned when updating or deleting an audit entry
+	ErrAuditLogAppendOnly = errors.New("audit log entries cannot be changed or deleted")
+
+	// Encryption errors
+	// ErrMissingMasterKey is returned when encrypting fields without a configured master key
+	ErrMissingMasterKey = errors.New("encryption master key is not configured")
+
+	// ErrDataKeyNotFound is returned when a data key does not exist
+	ErrDataKeyNotFound = errors.New("data key not found")
+
+	// ErrDataKeyMismatch is returned when decrypting a value with a key other than the one that encrypted it
+	ErrDataKeyMismatch = errors.New("value was encrypted with another data key")
+
+	// ErrInvalidEncryptedField is returned when an encrypted value is malformed or fails authentication
+	ErrInvalidEncryptedField = errors.New("invalid encrypted field")
+
+	// Maintenance errors
+	// ErrMaintenance is returned for imports and changes blocked by maintenance mode
+	ErrMaintenance = errors.New("maintenance mode is on")
+
+	// Webhook errors
+	// ErrWebhookNotFound is returned when a webhook does not exist
+	ErrWebhookNotFound = errors.New("webhook not found")
+
+	// ErrWebhookDeliveryNotFound is returned when a webhook delivery does not exist
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+	// Calendar feed errors
+	// ErrCalendarFeedNotFound is returned when no calendar feed has the token or belongs to the user
+	ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+	// Seed errors
+	// ErrSeedNotEmpty is returned when seeding demo data into a database that already has wallets
+	ErrSeedNotEmpty = errors.New("database already has wallets")
+)
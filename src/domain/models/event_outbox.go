@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// OutboxEvent is a domain event stored in the same database transaction as the
+// change it describes. The relay publishes it once the change has committed,
+// so events are neither lost while NATS is down nor published for changes
+// that were rolled back.
+type OutboxEvent struct {
+	ID          string    `json:"id"`
+	EventID     string    `json:"eventId"` // ID of the event envelope, used by JetStream to drop republished events
+	Type        string    `json:"type"`
+	Payload     []byte    `json:"payload"` // the encoded event envelope
+	Attempts    int       `json:"attempts"`
+	LastError   string    `json:"lastError,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	PublishedAt time.Time `json:"publishedAt,omitempty"`
+}
+
+// IsPublished reports whether the event has been published
+func (e *OutboxEvent) IsPublished() bool {
+	return !e.PublishedAt.IsZero()
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// ExchangeMovementKind defines what an exchange history entry did
+type ExchangeMovementKind string
+
+const (
+	// ExchangeTrade exchanges one asset for another on the exchange
+	ExchangeTrade ExchangeMovementKind = "trade"
+
+	// ExchangeDeposit credits an asset to the exchange account
+	ExchangeDeposit ExchangeMovementKind = "deposit"
+
+	// ExchangeWithdrawal debits an asset from the exchange account
+	ExchangeWithdrawal ExchangeMovementKind = "withdrawal"
+
+	// ExchangeFee is a fee charged separately from the movement it was paid for
+	ExchangeFee ExchangeMovementKind = "fee"
+)
+
+// ExchangeMovement is a normalized entry of an exchange's account history.
+// A trade sells Amount of Asset for ToAmount of ToAsset; every other kind
+// moves Amount of Asset in or out of the account.
+type ExchangeMovement struct {
+	ID       string               `json:"id"` // exchange reference, unique within the export
+	Kind     ExchangeMovementKind `json:"kind"`
+	Date     time.Time            `json:"date"`
+	Asset    string               `json:"asset"`
+	Amount   float64              `json:"amount"`
+	ToAsset  string               `json:"toAsset,omitempty"`
+	ToAmount float64              `json:"toAmount,omitempty"`
+	Fee      float64              `json:"fee,omitempty"` // paid in Asset on top of Amount
+	Category string               `json:"category,omitempty"`
+	Note     string               `json:"note,omitempty"`
+}
+
+// Rate returns the amount of ToAsset received per unit of Asset
+func (m ExchangeMovement) Rate() float64 {
+	if m.Amount == 0 {
+		return 0
+	}
+	return m.ToAmount / m.Amount
+}
+
+// Names of the categories exchange imports suggest
+const (
+	// CategoryTrades holds trades between assets on an exchange
+	CategoryTrades = "Trades"
+
+	// CategoryExchangeDeposits holds funds deposited to an exchange
+	CategoryExchangeDeposits = "Exchange deposits"
+
+	// CategoryExchangeWithdrawals holds funds withdrawn from an exchange
+	CategoryExchangeWithdrawals = "Exchange withdrawals"
+
+	// CategoryExchangeFees holds trading and withdrawal fees charged by an exchange
+	CategoryExchangeFees = "Exchange fees"
+)
+
+// fiatCurrencies are the ISO 4217 currencies exchanges commonly trade against
+var fiatCurrencies = map[string]bool{
+	"USD": true, "EUR": true, "GBP": true, "CHF": true, "JPY": true, "CAD": true,
+	"AUD": true, "NZD": true, "SEK": true, "NOK": true, "DKK": true, "PLN": true,
+	"CZK": true, "HUF": true, "TRY": true, "BRL": true, "MXN": true, "ARS": true,
+	"ZAR": true, "INR": true, "SGD": true, "HKD": true, "KRW": true, "AED": true,
+	"RUB": true, "UAH": true, "NGN": true, "IDR": true, "PHP": true, "VND": true,
+}
+
+// IsFiatCurrency reports whether a currency code is a common fiat currency
+func IsFiatCurrency(code string) bool {
+	return fiatCurrencies[strings.ToUpper(code)]
+}
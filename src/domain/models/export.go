@@ -0,0 +1,122 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+	"github.com/google/uuid"
+)
+
+// ExportDataset is the kind of records an export contains
+type ExportDataset string
+
+const (
+	ExportTransactions ExportDataset = "transactions"
+	ExportWallets      ExportDataset = "wallets"
+	ExportCategories   ExportDataset = "categories"
+)
+
+// ExportFormat is the file format of an export
+type ExportFormat string
+
+const (
+	ExportCSV  ExportFormat = "csv"
+	ExportJSON ExportFormat = "json"
+)
+
+// ContentType returns the MIME type of the format
+func (f ExportFormat) ContentType() string {
+	if f == ExportJSON {
+		return "application/json"
+	}
+	return "text/csv"
+}
+
+// ExportRequest selects the records of an export. The filters other than the
+// wallet only apply to transactions.
+type ExportRequest struct {
+	Dataset    ExportDataset `json:"dataset"`
+	Format     ExportFormat  `json:"format"`
+	DateFrom   time.Time     `json:"dateFrom,omitempty"`
+	DateTo     time.Time     `json:"dateTo,omitempty"`
+	WalletID   string        `json:"walletId,omitempty"`
+	CategoryID string        `json:"categoryId,omitempty"`
+}
+
+// Validate checks the dataset, format and date range of the request
+func (r ExportRequest) Validate() error {
+	v := validation.New()
+	switch r.Dataset {
+	case ExportTransactions, ExportWallets, ExportCategories:
+	default:
+		v.Check(false, "dataset", validation.RuleOneOf, fmt.Errorf("%w: %q", ErrUnknownExportDataset, r.Dataset))
+	}
+
+	switch r.Format {
+	case ExportCSV, ExportJSON:
+	default:
+		v.Check(false, "format", validation.RuleOneOf, fmt.Errorf("%w: %q", ErrInvalidExportFormat, r.Format))
+	}
+
+	v.Check(r.DateFrom.IsZero() || r.DateTo.IsZero() || !r.DateTo.Before(r.DateFrom), "dateTo", validation.RuleRange,
+		fmt.Errorf("%w: %s is before %s", ErrInvalidExportRange, r.DateTo.Format(time.DateOnly), r.DateFrom.Format(time.DateOnly)))
+	return v.Err()
+}
+
+// FileName returns the name the export is downloaded as
+func (r ExportRequest) FileName(at time.Time) string {
+	return fmt.Sprintf("%s-%s.%s", r.Dataset, at.Format("20060102-150405"), r.Format)
+}
+
+// ExportJobStatus is the state of an asynchronous export
+type ExportJobStatus string
+
+const (
+	ExportJobRunning   ExportJobStatus = "running"
+	ExportJobCompleted ExportJobStatus = "completed"
+	ExportJobFailed    ExportJobStatus = "failed"
+)
+
+// ExportJob is an export written to the file storage in the background, for
+// exports too large to stream within a request
+type ExportJob struct {
+	ID          string          `json:"id"`
+	Request     ExportRequest   `json:"request"`
+	Status      ExportJobStatus `json:"status"`
+	FileKey     string          `json:"-"` // storage key of the finished file
+	FileName    string          `json:"fileName"`
+	Records     int             `json:"records"`
+	Error       string          `json:"error,omitempty"`
+	StartedAt   time.Time       `json:"startedAt"`
+	CompletedAt time.Time       `json:"completedAt,omitempty"`
+}
+
+// NewExportJob creates a running export job
+func NewExportJob(request ExportRequest) *ExportJob {
+	id := uuid.New().String()
+	now := time.Now()
+	fileName := request.FileName(now)
+	return &ExportJob{
+		ID:        id,
+		Request:   request,
+		Status:    ExportJobRunning,
+		FileKey:   "exports/" + id + "/" + fileName,
+		FileName:  fileName,
+		StartedAt: now,
+	}
+}
+
+// Complete marks the job as finished after writing the given number of records
+func (j *ExportJob) Complete(records int) {
+	j.Status = ExportJobCompleted
+	j.Records = records
+	j.CompletedAt = time.Now()
+}
+
+// Fail marks the job as stopped by an error
+func (j *ExportJob) Fail(err error) {
+	j.Status = ExportJobFailed
+	j.Error = err.Error()
+	j.CompletedAt = time.Now()
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExportRequest_Validate(t *testing.T) {
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		request ExportRequest
+		wantErr error
+	}{
+		{"transactions as csv", ExportRequest{Dataset: ExportTransactions, Format: ExportCSV}, nil},
+		{"wallets as json", ExportRequest{Dataset: ExportWallets, Format: ExportJSON}, nil},
+		{"unknown dataset", ExportRequest{Dataset: "budgets", Format: ExportCSV}, ErrUnknownExportDataset},
+		{"unknown format", ExportRequest{Dataset: ExportCategories, Format: "xlsx"}, ErrInvalidExportFormat},
+		{"inverted range", ExportRequest{Dataset: ExportTransactions, Format: ExportCSV, DateFrom: day, DateTo: day.AddDate(0, 0, -1)}, ErrInvalidExportRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExportJob(t *testing.T) {
+	job := NewExportJob(ExportRequest{Dataset: ExportTransactions, Format: ExportJSON})
+	if job.Status != ExportJobRunning || !strings.HasSuffix(job.FileName, ".json") || !strings.Contains(job.FileKey, job.ID) {
+		t.Errorf("NewExportJob() = %+v, want a running job with a JSON file keyed by its ID", job)
+	}
+
+	job.Complete(42)
+	if job.Status != ExportJobCompleted || job.Records != 42 || job.CompletedAt.IsZero() {
+		t.Errorf("Complete() = %+v, want a completed job with 42 records", job)
+	}
+
+	job.Fail(errors.New("disk full"))
+	if job.Status != ExportJobFailed || job.Error != "disk full" {
+		t.Errorf("Fail() = %+v, want a failed job with the error", job)
+	}
+}
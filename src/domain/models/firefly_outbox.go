@@ -0,0 +1,100 @@
+package models
+
+import "time"
+
+// FireflyOutboxStatus is the delivery state of a transaction queued for Firefly III
+type FireflyOutboxStatus string
+
+const (
+	FireflyOutboxPending   FireflyOutboxStatus = "pending"
+	FireflyOutboxDelivered FireflyOutboxStatus = "delivered"
+	FireflyOutboxDiscarded FireflyOutboxStatus = "discarded" // the transaction was deleted before delivery
+)
+
+const (
+	// fireflyOutboxFirstRetry is the wait after the first failed delivery, doubled for each next one
+	fireflyOutboxFirstRetry = 30 * time.Second
+
+	// fireflyOutboxMaxRetry caps the wait between deliveries
+	fireflyOutboxMaxRetry = time.Hour
+)
+
+// FireflyOutboxEntry is a transaction waiting to be booked in Firefly III.
+// Entries are delivered at least once; the transaction ID is sent as the
+// Firefly external ID, so a delivery that booked the transaction but failed
+// before it was marked is recognized on the next attempt.
+type FireflyOutboxEntry struct {
+	ID            string              `json:"id"`
+	TransactionID string              `json:"transactionId"`
+	Status        FireflyOutboxStatus `json:"status"`
+	Attempts      int                 `json:"attempts"`
+	LastError     string              `json:"lastError,omitempty"`
+	FireflyID     string              `json:"fireflyId,omitempty"`
+	NextAttemptAt time.Time           `json:"nextAttemptAt"`
+	DeliveredAt   time.Time           `json:"deliveredAt,omitempty"`
+	CreatedAt     time.Time           `json:"createdAt"`
+}
+
+// NewFireflyOutboxEntry queues a transaction for immediate delivery
+func NewFireflyOutboxEntry(transactionID string, now time.Time) *FireflyOutboxEntry {
+	return &FireflyOutboxEntry{
+		TransactionID: transactionID,
+		Status:        FireflyOutboxPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// Deliver marks the entry as booked in Firefly
+func (e *FireflyOutboxEntry) Deliver(fireflyID string, at time.Time) {
+	e.Status = FireflyOutboxDelivered
+	e.FireflyID = fireflyID
+	e.LastError = ""
+	e.DeliveredAt = at
+}
+
+// Discard marks the entry as no longer to be delivered
+func (e *FireflyOutboxEntry) Discard(reason string) {
+	e.Status = FireflyOutboxDiscarded
+	e.LastError = reason
+}
+
+// Fail records a failed delivery and schedules the next attempt with an
+// exponential backoff
+func (e *FireflyOutboxEntry) Fail(err error, now time.Time) {
+	e.Attempts++
+	e.LastError = err.Error()
+	e.NextAttemptAt = now.Add(FireflyOutboxBackoff(e.Attempts))
+}
+
+// FireflyOutboxBackoff returns the wait after the given number of
+// consecutive failures: 30s, 1m, 2m, ... up to an hour
+func FireflyOutboxBackoff(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+
+	wait := fireflyOutboxFirstRetry
+	for i := 1; i < failures && wait < fireflyOutboxMaxRetry; i++ {
+		wait *= 2
+	}
+	return min(wait, fireflyOutboxMaxRetry)
+}
+
+// FireflyOutboxStats summarizes the outbox and whether Firefly is reachable
+type FireflyOutboxStats struct {
+	Pending     int       `json:"pending"`
+	Available   bool      `json:"available"`         // false while Firefly is considered unreachable
+	RetryAt     time.Time `json:"retryAt,omitempty"` // when delivery is attempted again after an outage
+	LastError   string    `json:"lastError,omitempty"`
+	LastDrainAt time.Time `json:"lastDrainAt,omitempty"`
+}
+
+// FireflyOutboxReport summarizes one drain of the outbox
+type FireflyOutboxReport struct {
+	Delivered int      `json:"delivered"`
+	Discarded int      `json:"discarded"`
+	Failed    int      `json:"failed"`
+	Skipped   bool     `json:"skipped"` // Firefly was unreachable, nothing was attempted
+	Errors    []string `json:"errors,omitempty"`
+}
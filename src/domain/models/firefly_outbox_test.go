@@ -0,0 +1,50 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFireflyOutboxBackoff(t *testing.T) {
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{4, 4 * time.Minute},
+		{8, time.Hour},
+		{50, time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := FireflyOutboxBackoff(tt.failures); got != tt.want {
+			t.Errorf("FireflyOutboxBackoff(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestFireflyOutboxEntryLifecycle(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	entry := NewFireflyOutboxEntry("tx1", now)
+
+	if entry.Status != FireflyOutboxPending || !entry.NextAttemptAt.Equal(now) {
+		t.Fatalf("NewFireflyOutboxEntry() = %+v, want pending and due now", entry)
+	}
+
+	entry.Fail(errors.New("connection refused"), now)
+	entry.Fail(errors.New("connection refused"), now)
+	if entry.Attempts != 2 || !entry.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("after two failures Attempts = %d, NextAttemptAt = %v, want 2 and a minute later", entry.Attempts, entry.NextAttemptAt)
+	}
+	if entry.Status != FireflyOutboxPending {
+		t.Errorf("failed entry Status = %s, want pending", entry.Status)
+	}
+
+	entry.Deliver("ff42", now)
+	if entry.Status != FireflyOutboxDelivered || entry.FireflyID != "ff42" || entry.LastError != "" {
+		t.Errorf("delivered entry = %+v, want delivered to ff42 without error", entry)
+	}
+}
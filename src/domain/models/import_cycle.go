@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// ImportCycleReport summarizes one import cycle, a sync of every source
+type ImportCycleReport struct {
+	ID         string              `json:"id"`
+	CycleID    string              `json:"cycleId"`
+	StartedAt  time.Time           `json:"startedAt"`
+	FinishedAt time.Time           `json:"finishedAt"`
+	Sources    []SourceCycleReport `json:"sources"`
+
+	// Totals over all sources
+	Imported  int `json:"imported"`
+	Failed    int `json:"failed"` // sources whose sync returned an error
+	Snapshots int `json:"snapshots"`
+}
+
+// SourceCycleReport is the outcome of syncing one source in an import cycle
+type SourceCycleReport struct {
+	SourceID   string   `json:"sourceId"`
+	Received   int      `json:"received"`
+	Imported   int      `json:"imported"`
+	Duplicates int      `json:"duplicates"`
+	Flagged    int      `json:"flagged"`
+	Invalid    int      `json:"invalid"`
+	Filtered   int      `json:"filtered"`
+	Snapshots  int      `json:"snapshots"` // balance snapshots recorded after the import
+	DurationMS int64    `json:"durationMs"`
+	Errors     []string `json:"errors,omitempty"` // per-transaction import errors
+	Error      string   `json:"error,omitempty"`  // the sync failed
+}
+
+// Add accounts the result of a source in the cycle totals
+func (r *ImportCycleReport) Add(source SourceCycleReport) {
+	r.Sources = append(r.Sources, source)
+	r.Imported += source.Imported
+	r.Snapshots += source.Snapshots
+	if source.Error != "" {
+		r.Failed++
+	}
+}
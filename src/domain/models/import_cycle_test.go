@@ -0,0 +1,17 @@
+package models
+
+import "testing"
+
+func TestImportCycleReport_Add(t *testing.T) {
+	report := &ImportCycleReport{}
+	report.Add(SourceCycleReport{SourceID: "bank:checking", Imported: 12, Snapshots: 3})
+	report.Add(SourceCycleReport{SourceID: "ethereum:0xabc", Error: "rate limited"})
+	report.Add(SourceCycleReport{SourceID: "solana:abc", Imported: 4})
+
+	if len(report.Sources) != 3 {
+		t.Fatalf("expected 3 sources, got %d", len(report.Sources))
+	}
+	if report.Imported != 16 || report.Snapshots != 3 || report.Failed != 1 {
+		t.Errorf("totals = imported %d, snapshots %d, failed %d", report.Imported, report.Snapshots, report.Failed)
+	}
+}
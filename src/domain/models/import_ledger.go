@@ -0,0 +1,115 @@
+package models
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"math"
+	"time"
+)
+
+// LedgerFalsePositiveRate is the rate at which a ledger summary claims an ID
+// it was not built from. A false positive skips a new transaction, so the
+// summaries are sized generously.
+const LedgerFalsePositiveRate = 0.0001
+
+// LedgerSummary compacts the provider IDs of the transactions a wallet
+// imported in a date range, after their soft-deleted records were purged.
+// It keeps a deleted transaction from being imported again once its record
+// is gone.
+type LedgerSummary struct {
+	ID        string       `json:"id"`
+	WalletID  string       `json:"walletId"`
+	From      time.Time    `json:"from"` // date of the earliest transaction
+	To        time.Time    `json:"to"`   // date of the latest transaction
+	Count     int          `json:"count"`
+	Filter    *BloomFilter `json:"-"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+// Covers reports whether a transaction dated at the given time falls in the
+// range of the summary. Dates are compared by day, as providers report them.
+func (s *LedgerSummary) Covers(date time.Time) bool {
+	day := date.UTC().Truncate(24 * time.Hour)
+	return !day.Before(s.From.UTC().Truncate(24*time.Hour)) && !day.After(s.To.UTC().Truncate(24*time.Hour))
+}
+
+// MayContain reports whether the summary may hold the provider ID of a
+// transaction dated at the given time
+func (s *LedgerSummary) MayContain(externalID string, date time.Time) bool {
+	return s.Filter != nil && s.Covers(date) && s.Filter.MayContain(externalID)
+}
+
+// BloomFilter is a set of strings that answers membership with no false
+// negatives and a bounded rate of false positives
+type BloomFilter struct {
+	bits   []byte
+	hashes int
+}
+
+// NewBloomFilter creates a filter sized for n entries at the given false positive rate
+func NewBloomFilter(n int, falsePositiveRate float64) *BloomFilter {
+	if n < 1 {
+		n = 1
+	}
+	size := math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	hashes := int(math.Round(size / float64(n) * math.Ln2))
+	if hashes < 1 {
+		hashes = 1
+	}
+	return &BloomFilter{bits: make([]byte, (int(size)+7)/8), hashes: hashes}
+}
+
+// Add puts a string into the filter
+func (f *BloomFilter) Add(value string) {
+	for _, bit := range f.positions(value) {
+		f.bits[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// MayContain reports whether the string may have been added to the filter
+func (f *BloomFilter) MayContain(value string) bool {
+	for _, bit := range f.positions(value) {
+		if f.bits[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// positions derives the bits of a string from two FNV hashes
+func (f *BloomFilter) positions(value string) []uint64 {
+	h1 := fnv.New64a()
+	h1.Write([]byte(value))
+	h2 := fnv.New64()
+	h2.Write([]byte(value))
+	a, b := h1.Sum64(), h2.Sum64()|1
+
+	size := uint64(len(f.bits)) * 8
+	positions := make([]uint64, f.hashes)
+	for i := range positions {
+		positions[i] = (a + uint64(i)*b) % size
+	}
+	return positions
+}
+
+// MarshalBinary encodes the filter as its hash count followed by its bits
+func (f *BloomFilter) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 2, 2+len(f.bits))
+	binary.BigEndian.PutUint16(data, uint16(f.hashes))
+	return append(data, f.bits...), nil
+}
+
+// UnmarshalBinary decodes a filter encoded by MarshalBinary
+func (f *BloomFilter) UnmarshalBinary(data []byte) error {
+	if len(data) < 3 {
+		return errors.New("bloom filter is too short")
+	}
+	hashes := int(binary.BigEndian.Uint16(data))
+	if hashes < 1 {
+		return errors.New("bloom filter has no hash functions")
+	}
+	f.hashes = hashes
+	f.bits = append([]byte(nil), data[2:]...)
+	return nil
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBloomFilter(t *testing.T) {
+	filter := NewBloomFilter(1000, LedgerFalsePositiveRate)
+	for i := 0; i < 1000; i++ {
+		filter.Add(fmt.Sprintf("tx-%d", i))
+	}
+
+	for i := 0; i < 1000; i++ {
+		if !filter.MayContain(fmt.Sprintf("tx-%d", i)) {
+			t.Fatalf("MayContain(tx-%d) = false for an added ID", i)
+		}
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if filter.MayContain(fmt.Sprintf("other-%d", i)) {
+			falsePositives++
+		}
+	}
+	if falsePositives > 10 {
+		t.Errorf("%d false positives in 10000 lookups, want about 1", falsePositives)
+	}
+
+	data, err := filter.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+	decoded := &BloomFilter{}
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+	if !decoded.MayContain("tx-42") || decoded.MayContain("other-42") != filter.MayContain("other-42") {
+		t.Error("decoded filter answers differently")
+	}
+	if err := decoded.UnmarshalBinary([]byte{0}); err == nil {
+		t.Error("UnmarshalBinary() of a truncated filter succeeded")
+	}
+}
+
+func TestLedgerSummary_MayContain(t *testing.T) {
+	filter := NewBloomFilter(1, LedgerFalsePositiveRate)
+	filter.Add("tx-1")
+	summary := &LedgerSummary{
+		From:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+		Filter: filter,
+	}
+
+	tests := []struct {
+		name string
+		id   string
+		date time.Time
+		want bool
+	}{
+		{"in range", "tx-1", time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC), true},
+		{"last day", "tx-1", time.Date(2025, 1, 31, 23, 0, 0, 0, time.UTC), true},
+		{"after the range", "tx-1", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), false},
+		{"unknown ID", "tx-2", time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summary.MayContain(tt.id, tt.date); got != tt.want {
+				t.Errorf("MayContain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
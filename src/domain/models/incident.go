@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Incident is a period during which an external provider (Firefly, a bank, a
+// chain explorer) kept failing. It opens after a number of consecutive
+// failures and closes with the first success.
+type Incident struct {
+	ID         string    `json:"id"`
+	Provider   string    `json:"provider"`
+	ErrorClass string    `json:"errorClass"` // client error type of the failure that opened it
+	LastError  string    `json:"lastError"`
+	Failures   int       `json:"failures"` // consecutive failures so far
+	StartedAt  time.Time `json:"startedAt"`
+	EndedAt    time.Time `json:"endedAt,omitempty"` // zero while the incident is open
+}
+
+// IsOpen reports whether the provider is still failing
+func (i *Incident) IsOpen() bool {
+	return i.EndedAt.IsZero()
+}
+
+// Duration returns how long the incident lasted, or has lasted so far
+func (i *Incident) Duration(now time.Time) time.Duration {
+	if i.IsOpen() {
+		return now.Sub(i.StartedAt)
+	}
+	return i.EndedAt.Sub(i.StartedAt)
+}
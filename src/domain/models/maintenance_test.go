@@ -0,0 +1,50 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMaintenanceState_Frozen(t *testing.T) {
+	household := &Maintenance{SpaceID: "household", Reason: "restore"}
+	business := &Maintenance{SpaceID: "business"}
+	global := &Maintenance{Reason: "migration"}
+
+	state := NewMaintenanceState([]*Maintenance{household, business})
+	if !state.Active() || state.Global != nil || len(state.Spaces) != 2 || state.Spaces[0] != business {
+		t.Fatalf("NewMaintenanceState() = %+v, want the spaces by ID", state)
+	}
+	if got := state.Frozen("household"); got != household {
+		t.Errorf("Frozen(household) = %v, want the household switch", got)
+	}
+	if got := state.Frozen("personal"); got != nil {
+		t.Errorf("Frozen(personal) = %v, want nil", got)
+	}
+	if got := state.Frozen(""); got != nil {
+		t.Errorf("Frozen(shared) = %v, want nil without the global switch", got)
+	}
+
+	state = NewMaintenanceState([]*Maintenance{household, global})
+	for _, space := range []string{"", "household", "personal"} {
+		if got := state.Frozen(space); got != global {
+			t.Errorf("Frozen(%q) = %v, want the global switch", space, got)
+		}
+	}
+
+	if NewMaintenanceState(nil).Active() {
+		t.Error("Active() = true without switches")
+	}
+}
+
+func TestMaintenance_Err(t *testing.T) {
+	started := time.Date(2025, 3, 22, 10, 0, 0, 0, time.UTC)
+	err := (&Maintenance{SpaceID: "household", Reason: "restore", StartedAt: started}).Err()
+	if !errors.Is(err, ErrMaintenance) {
+		t.Fatalf("Err() = %v, want ErrMaintenance", err)
+	}
+	if want := "maintenance of space household since 2025-03-22T10:00:00Z: restore"; !strings.Contains(err.Error(), want) {
+		t.Errorf("Err() = %q, want it to contain %q", err, want)
+	}
+}
@@ -0,0 +1,168 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PeriodCalendar defines the periods reports are grouped by: fiscal years
+// starting in a given month, and pay periods starting on a given day of the
+// month so budgets follow the salary cycle, e.g. from the 25th to the 24th.
+// The zero value is the calendar year, calendar months and weeks starting on
+// Sunday.
+type PeriodCalendar struct {
+	FiscalYearStart time.Month   `json:"fiscalYearStart"` // month the fiscal year starts in
+	PayPeriodStart  int          `json:"payPeriodStart"`  // day of the month pay periods start on
+	WeekStart       time.Weekday `json:"weekStart"`       // day weeks start on, 0 for Sunday
+}
+
+// Period is a reporting period. Start is inclusive, End exclusive.
+type Period struct {
+	Name  string    `json:"name"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Contains reports whether t falls within the period
+func (p Period) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+// Last returns the last instant of the period, for filters with inclusive bounds
+func (p Period) Last() time.Time {
+	return p.End.Add(-time.Nanosecond)
+}
+
+// Validate checks that the fiscal year starts in a month and pay periods on a day of the month
+func (c PeriodCalendar) Validate() error {
+	if c.FiscalYearStart < 0 || c.FiscalYearStart > time.December {
+		return fmt.Errorf("%w: fiscal year start must be a month from 1 to 12", ErrInvalidPeriodCalendar)
+	}
+	if c.PayPeriodStart < 0 || c.PayPeriodStart > 31 {
+		return fmt.Errorf("%w: pay period start must be a day from 1 to 31", ErrInvalidPeriodCalendar)
+	}
+	if c.WeekStart < time.Sunday || c.WeekStart > time.Saturday {
+		return fmt.Errorf("%w: week start must be a weekday from 0 (Sunday) to 6", ErrInvalidPeriodCalendar)
+	}
+	return nil
+}
+
+func (c PeriodCalendar) fiscalYearStart() time.Month {
+	if c.FiscalYearStart == 0 {
+		return time.January
+	}
+	return c.FiscalYearStart
+}
+
+// payDay returns the day a pay period starts on in the given month. Days past
+// the end of a short month start the period on its last day.
+func (c PeriodCalendar) payDay(year int, month time.Month) time.Time {
+	day := c.PayPeriodStart
+	if day == 0 {
+		day = 1
+	}
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+		day = last
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// PayPeriodOf returns the pay period that starts in the given month
+func (c PeriodCalendar) PayPeriodOf(year int, month time.Month) Period {
+	start := c.payDay(year, month)
+	return Period{
+		Name:  start.Format("2006-01"),
+		Start: start,
+		End:   c.payDay(year, month+1),
+	}
+}
+
+// PayPeriod returns the pay period containing t
+func (c PeriodCalendar) PayPeriod(t time.Time) Period {
+	t = t.UTC()
+	period := c.PayPeriodOf(t.Year(), t.Month())
+	if t.Before(period.Start) {
+		return c.PayPeriodOf(t.Year(), t.Month()-1)
+	}
+	return period
+}
+
+// PayPeriods returns the pay periods overlapping the range from..to, oldest first
+func (c PeriodCalendar) PayPeriods(from, to time.Time) []Period {
+	var periods []Period
+	for period := c.PayPeriod(from); period.Start.Before(to); period = c.PayPeriod(period.End) {
+		periods = append(periods, period)
+	}
+	return periods
+}
+
+// Week returns the week containing t, named after the day it starts on
+func (c PeriodCalendar) Week(t time.Time) Period {
+	t = t.UTC()
+	offset := (int(t.Weekday()) - int(c.WeekStart) + 7) % 7
+	start := time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+	return Period{Name: start.Format(time.DateOnly), Start: start, End: start.AddDate(0, 0, 7)}
+}
+
+// FiscalYearOf returns the fiscal year that starts in the given calendar year.
+// Fiscal years that do not start in January are named after both years they
+// span, e.g. "2024/25".
+func (c PeriodCalendar) FiscalYearOf(year int) Period {
+	start := time.Date(year, c.fiscalYearStart(), 1, 0, 0, 0, 0, time.UTC)
+	name := strconv.Itoa(year)
+	if start.Month() != time.January {
+		name = fmt.Sprintf("%d/%02d", year, (year+1)%100)
+	}
+	return Period{Name: name, Start: start, End: start.AddDate(1, 0, 0)}
+}
+
+// FiscalYear returns the fiscal year containing t
+func (c PeriodCalendar) FiscalYear(t time.Time) Period {
+	t = t.UTC()
+	period := c.FiscalYearOf(t.Year())
+	if t.Before(period.Start) {
+		return c.FiscalYearOf(t.Year() - 1)
+	}
+	return period
+}
+
+// Resolve returns the period a name refers to, relative to now:
+//
+//	week, last-week    the current or previous week
+//	month, last-month  the current or previous pay period
+//	year, last-year    the current or previous fiscal year
+//	2025-03            the pay period starting in March 2025
+//	2025, 2025/26      the fiscal year starting in 2025
+func (c PeriodCalendar) Resolve(name string, now time.Time) (Period, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	switch name {
+	case "week":
+		return c.Week(now), nil
+	case "last-week":
+		return c.Week(c.Week(now).Start.Add(-time.Nanosecond)), nil
+	case "month":
+		return c.PayPeriod(now), nil
+	case "last-month":
+		return c.PayPeriod(c.PayPeriod(now).Start.Add(-time.Nanosecond)), nil
+	case "year":
+		return c.FiscalYear(now), nil
+	case "last-year":
+		return c.FiscalYear(c.FiscalYear(now).Start.Add(-time.Nanosecond)), nil
+	}
+
+	if month, err := time.Parse("2006-01", name); err == nil {
+		return c.PayPeriodOf(month.Year(), month.Month()), nil
+	}
+
+	if year, _, _ := strings.Cut(name, "/"); len(year) == 4 {
+		if n, err := strconv.Atoi(year); err == nil {
+			if period := c.FiscalYearOf(n); period.Name == name || year == name {
+				return period, nil
+			}
+		}
+	}
+
+	return Period{}, fmt.Errorf("%w: %q", ErrInvalidPeriod, name)
+}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestPeriodCalendar_PayPeriod(t *testing.T) {
+	tests := []struct {
+		name      string
+		calendar  PeriodCalendar
+		at        time.Time
+		wantName  string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"calendar months by default", PeriodCalendar{}, date(2025, 3, 10), "2025-03", date(2025, 3, 1), date(2025, 4, 1)},
+		{"on the pay day", PeriodCalendar{PayPeriodStart: 25}, date(2025, 3, 25), "2025-03", date(2025, 3, 25), date(2025, 4, 25)},
+		{"before the pay day", PeriodCalendar{PayPeriodStart: 25}, date(2025, 3, 24), "2025-02", date(2025, 2, 25), date(2025, 3, 25)},
+		{"across the year end", PeriodCalendar{PayPeriodStart: 15}, date(2025, 1, 3), "2024-12", date(2024, 12, 15), date(2025, 1, 15)},
+		{"clamped to short months", PeriodCalendar{PayPeriodStart: 31}, date(2025, 3, 1), "2025-02", date(2025, 2, 28), date(2025, 3, 31)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.calendar.PayPeriod(tt.at)
+			if got.Name != tt.wantName || !got.Start.Equal(tt.wantStart) || !got.End.Equal(tt.wantEnd) {
+				t.Errorf("PayPeriod() = %s %s..%s, want %s %s..%s", got.Name, got.Start.Format(time.DateOnly), got.End.Format(time.DateOnly),
+					tt.wantName, tt.wantStart.Format(time.DateOnly), tt.wantEnd.Format(time.DateOnly))
+			}
+			if !got.Contains(tt.at) {
+				t.Errorf("PayPeriod(%s) does not contain its own date", tt.at.Format(time.DateOnly))
+			}
+		})
+	}
+}
+
+func TestPeriodCalendar_PayPeriods(t *testing.T) {
+	calendar := PeriodCalendar{PayPeriodStart: 25}
+	periods := calendar.PayPeriods(date(2025, 1, 1), date(2025, 3, 31))
+
+	var names []string
+	for _, period := range periods {
+		names = append(names, period.Name)
+	}
+	if len(periods) != 4 || names[0] != "2024-12" || names[3] != "2025-03" {
+		t.Errorf("PayPeriods() = %v, want 2024-12 through 2025-03", names)
+	}
+	for i := 1; i < len(periods); i++ {
+		if !periods[i].Start.Equal(periods[i-1].End) {
+			t.Errorf("period %s does not start where %s ends", periods[i].Name, periods[i-1].Name)
+		}
+	}
+}
+
+func TestPeriodCalendar_FiscalYear(t *testing.T) {
+	calendar := PeriodCalendar{FiscalYearStart: time.April}
+
+	got := calendar.FiscalYear(date(2025, 2, 1))
+	if got.Name != "2024/25" || !got.Start.Equal(date(2024, 4, 1)) || !got.End.Equal(date(2025, 4, 1)) {
+		t.Errorf("FiscalYear() = %+v, want 2024/25 from April to April", got)
+	}
+
+	if got := (PeriodCalendar{}).FiscalYear(date(2025, 2, 1)); got.Name != "2025" || !got.Start.Equal(date(2025, 1, 1)) {
+		t.Errorf("FiscalYear() = %+v, want the calendar year 2025", got)
+	}
+}
+
+func TestPeriodCalendar_Week(t *testing.T) {
+	tests := []struct {
+		name      string
+		weekStart time.Weekday
+		t         time.Time
+		wantStart time.Time
+	}{
+		{"sunday weeks", time.Sunday, date(2025, 5, 2), date(2025, 4, 27)},
+		{"monday weeks", time.Monday, date(2025, 5, 2), date(2025, 4, 28)},
+		{"on the first day", time.Monday, date(2025, 4, 28), date(2025, 4, 28)},
+		{"across the year", time.Monday, date(2025, 1, 1), date(2024, 12, 30)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PeriodCalendar{WeekStart: tt.weekStart}.Week(tt.t)
+			if !got.Start.Equal(tt.wantStart) || !got.End.Equal(tt.wantStart.AddDate(0, 0, 7)) || !got.Contains(tt.t) {
+				t.Errorf("Week() = %+v, want the week starting %s", got, tt.wantStart.Format(time.DateOnly))
+			}
+		})
+	}
+}
+
+func TestPeriodCalendar_Resolve(t *testing.T) {
+	calendar := PeriodCalendar{FiscalYearStart: time.April, PayPeriodStart: 25, WeekStart: time.Monday}
+	now := date(2025, 5, 2)
+
+	tests := []struct {
+		name      string
+		wantName  string
+		wantStart time.Time
+	}{
+		{"week", "2025-04-28", date(2025, 4, 28)},
+		{"last-week", "2025-04-21", date(2025, 4, 21)},
+		{"month", "2025-04", date(2025, 4, 25)},
+		{"last-month", "2025-03", date(2025, 3, 25)},
+		{"year", "2025/26", date(2025, 4, 1)},
+		{"last-year", "2024/25", date(2024, 4, 1)},
+		{"2025-01", "2025-01", date(2025, 1, 25)},
+		{"2023", "2023/24", date(2023, 4, 1)},
+		{"2023/24", "2023/24", date(2023, 4, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := calendar.Resolve(tt.name, now)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if got.Name != tt.wantName || !got.Start.Equal(tt.wantStart) {
+				t.Errorf("Resolve() = %s from %s, want %s from %s", got.Name, got.Start.Format(time.DateOnly), tt.wantName, tt.wantStart.Format(time.DateOnly))
+			}
+		})
+	}
+
+	for _, name := range []string{"", "fortnight", "2023/25", "2025-13"} {
+		if _, err := calendar.Resolve(name, now); !errors.Is(err, ErrInvalidPeriod) {
+			t.Errorf("Resolve(%q) error = %v, want ErrInvalidPeriod", name, err)
+		}
+	}
+}
+
+func TestPeriodCalendar_Validate(t *testing.T) {
+	if err := (PeriodCalendar{FiscalYearStart: time.July, PayPeriodStart: 25}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	for _, calendar := range []PeriodCalendar{{FiscalYearStart: 13}, {PayPeriodStart: 32}, {PayPeriodStart: -1}, {WeekStart: 7}} {
+		if err := calendar.Validate(); !errors.Is(err, ErrInvalidPeriodCalendar) {
+			t.Errorf("Validate(%+v) error = %v, want ErrInvalidPeriodCalendar", calendar, err)
+		}
+	}
+}
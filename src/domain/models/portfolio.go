@@ -0,0 +1,136 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// PortfolioChainOther is the chain of crypto wallets no configured source imports into
+const PortfolioChainOther = "other"
+
+// PortfolioChange is the change of a value over a period
+type PortfolioChange struct {
+	From    float64 `json:"from"`    // value at the start of the period
+	Value   float64 `json:"value"`   // absolute change
+	Percent float64 `json:"percent"` // relative change, zero when the period started at zero
+}
+
+// NewPortfolioChange returns the change from one value to another
+func NewPortfolioChange(from, to float64) *PortfolioChange {
+	change := &PortfolioChange{From: from, Value: to - from}
+	if from != 0 {
+		change.Percent = change.Value / from * 100
+	}
+	return change
+}
+
+// add accumulates the change of another value into the change of a group
+func (c *PortfolioChange) add(other *PortfolioChange) *PortfolioChange {
+	if other == nil {
+		return c
+	}
+	if c == nil {
+		return NewPortfolioChange(other.From, other.From+other.Value)
+	}
+	return NewPortfolioChange(c.From+other.From, c.From+c.Value+other.From+other.Value)
+}
+
+// PortfolioHolding is the valued position of one crypto wallet
+type PortfolioHolding struct {
+	WalletID  string           `json:"walletId"`
+	Name      string           `json:"name"`
+	Asset     string           `json:"asset"`
+	Chain     string           `json:"chain"`
+	Balance   float64          `json:"balance"`
+	Price     float64          `json:"price,omitempty"`
+	Value     float64          `json:"value"`
+	Change24h *PortfolioChange `json:"change24h,omitempty"` // omitted when no balance was recorded a day ago
+	Change7d  *PortfolioChange `json:"change7d,omitempty"`  // omitted when no balance was recorded a week ago
+	Error     string           `json:"error,omitempty"`     // set when the holding could not be priced
+}
+
+// PortfolioGroup is the value of the holdings of one asset or chain
+type PortfolioGroup struct {
+	Name      string           `json:"name"`
+	Balance   float64          `json:"balance,omitempty"` // summed for assets only, chains mix assets
+	Value     float64          `json:"value"`
+	Share     float64          `json:"share"` // percent of the portfolio total
+	Change24h *PortfolioChange `json:"change24h,omitempty"`
+	Change7d  *PortfolioChange `json:"change7d,omitempty"`
+}
+
+// Portfolio is the value of all crypto holdings in a base currency, broken
+// down by asset and by chain
+type Portfolio struct {
+	BaseCurrency string             `json:"baseCurrency"`
+	AsOf         time.Time          `json:"asOf"`
+	Total        float64            `json:"total"`
+	Change24h    *PortfolioChange   `json:"change24h,omitempty"`
+	Change7d     *PortfolioChange   `json:"change7d,omitempty"`
+	Assets       []PortfolioGroup   `json:"assets"`
+	Chains       []PortfolioGroup   `json:"chains"`
+	Holdings     []PortfolioHolding `json:"holdings"`
+}
+
+// NewPortfolio sums valued holdings into a portfolio. Holdings that could not
+// be priced are listed but left out of the totals and groups. Groups are
+// ordered by value, largest first.
+func NewPortfolio(baseCurrency string, asOf time.Time, holdings []PortfolioHolding) *Portfolio {
+	portfolio := &Portfolio{
+		BaseCurrency: baseCurrency,
+		AsOf:         asOf,
+		Holdings:     holdings,
+	}
+	if portfolio.Holdings == nil {
+		portfolio.Holdings = []PortfolioHolding{}
+	}
+
+	assets := make(map[string]*PortfolioGroup)
+	chains := make(map[string]*PortfolioGroup)
+	group := func(groups map[string]*PortfolioGroup, name string) *PortfolioGroup {
+		if groups[name] == nil {
+			groups[name] = &PortfolioGroup{Name: name}
+		}
+		return groups[name]
+	}
+
+	for _, holding := range holdings {
+		if holding.Error != "" {
+			continue
+		}
+		portfolio.Total += holding.Value
+		portfolio.Change24h = portfolio.Change24h.add(holding.Change24h)
+		portfolio.Change7d = portfolio.Change7d.add(holding.Change7d)
+
+		asset := group(assets, holding.Asset)
+		asset.Balance += holding.Balance
+		chain := group(chains, holding.Chain)
+		for _, g := range []*PortfolioGroup{asset, chain} {
+			g.Value += holding.Value
+			g.Change24h = g.Change24h.add(holding.Change24h)
+			g.Change7d = g.Change7d.add(holding.Change7d)
+		}
+	}
+
+	portfolio.Assets = sortedGroups(assets, portfolio.Total)
+	portfolio.Chains = sortedGroups(chains, portfolio.Total)
+	return portfolio
+}
+
+// sortedGroups returns the groups largest first with their share of the total
+func sortedGroups(groups map[string]*PortfolioGroup, total float64) []PortfolioGroup {
+	sorted := make([]PortfolioGroup, 0, len(groups))
+	for _, group := range groups {
+		if total != 0 {
+			group.Share = group.Value / total * 100
+		}
+		sorted = append(sorted, *group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Value != sorted[j].Value {
+			return sorted[i].Value > sorted[j].Value
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
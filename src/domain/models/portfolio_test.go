@@ -0,0 +1,55 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewPortfolio(t *testing.T) {
+	holdings := []PortfolioHolding{
+		{Name: "Main", Asset: "ETH", Chain: "ethereum", Balance: 2, Value: 6000,
+			Change24h: NewPortfolioChange(5000, 6000), Change7d: NewPortfolioChange(4000, 6000)},
+		{Name: "Cold", Asset: "ETH", Chain: "ethereum", Balance: 1, Value: 3000,
+			Change24h: NewPortfolioChange(3000, 3000)},
+		{Name: "Phantom", Asset: "SOL", Chain: "solana", Balance: 10, Value: 1000,
+			Change24h: NewPortfolioChange(1000, 1000), Change7d: NewPortfolioChange(0, 1000)},
+		{Name: "Unpriced", Asset: "XYZ", Chain: PortfolioChainOther, Balance: 5, Error: "no rate"},
+	}
+
+	portfolio := NewPortfolio("EUR", time.Now(), holdings)
+	if portfolio.Total != 10000 || len(portfolio.Holdings) != 4 {
+		t.Fatalf("Total = %v with %d holdings, want 10000 over all four", portfolio.Total, len(portfolio.Holdings))
+	}
+	if c := portfolio.Change24h; c == nil || c.From != 9000 || c.Value != 1000 {
+		t.Errorf("Change24h = %+v, want +1000 from 9000", c)
+	}
+	// The cold wallet has no balance from a week ago and is left out of the weekly change
+	if c := portfolio.Change7d; c == nil || c.From != 4000 || c.Value != 3000 || c.Percent != 75 {
+		t.Errorf("Change7d = %+v, want +3000 (75%%) from 4000", c)
+	}
+
+	if len(portfolio.Assets) != 2 {
+		t.Fatalf("Assets = %+v, want ETH and SOL", portfolio.Assets)
+	}
+	eth := portfolio.Assets[0]
+	if eth.Name != "ETH" || eth.Balance != 3 || eth.Value != 9000 || eth.Share != 90 {
+		t.Errorf("Assets[0] = %+v, want 3 ETH worth 9000 (90%%)", eth)
+	}
+	if c := eth.Change24h; c == nil || c.From != 8000 || c.Percent != 12.5 {
+		t.Errorf("ETH Change24h = %+v, want 12.5%% from 8000", c)
+	}
+
+	if len(portfolio.Chains) != 2 || portfolio.Chains[1].Name != "solana" || portfolio.Chains[1].Value != 1000 {
+		t.Errorf("Chains = %+v, want ethereum then solana", portfolio.Chains)
+	}
+	if c := portfolio.Chains[1].Change7d; c == nil || c.Percent != 0 {
+		t.Errorf("solana Change7d = %+v, want no percentage from zero", c)
+	}
+}
+
+func TestNewPortfolio_Empty(t *testing.T) {
+	portfolio := NewPortfolio("EUR", time.Now(), nil)
+	if portfolio.Total != 0 || portfolio.Change24h != nil || portfolio.Holdings == nil || len(portfolio.Assets) != 0 {
+		t.Errorf("NewPortfolio(nil) = %+v, want an empty portfolio without changes", portfolio)
+	}
+}
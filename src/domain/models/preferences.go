@@ -0,0 +1,124 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+)
+
+// DateFormat is how a user wants dates written
+type DateFormat string
+
+const (
+	// DateFormatISO writes dates as 2025-03-31
+	DateFormatISO DateFormat = "YYYY-MM-DD"
+
+	// DateFormatEuropean writes dates as 31.03.2025
+	DateFormatEuropean DateFormat = "DD.MM.YYYY"
+
+	// DateFormatBritish writes dates as 31/03/2025
+	DateFormatBritish DateFormat = "DD/MM/YYYY"
+
+	// DateFormatAmerican writes dates as 03/31/2025
+	DateFormatAmerican DateFormat = "MM/DD/YYYY"
+)
+
+var dateFormatLayouts = map[DateFormat]string{
+	DateFormatISO:      time.DateOnly,
+	DateFormatEuropean: "02.01.2006",
+	DateFormatBritish:  "02/01/2006",
+	DateFormatAmerican: "01/02/2006",
+}
+
+// Layout returns the time layout of the format, empty for unknown formats
+func (f DateFormat) Layout() string {
+	return dateFormatLayouts[f]
+}
+
+// NotificationKind is a kind of change users can be notified about
+type NotificationKind string
+
+const (
+	// NotificationIncident announces provider incidents opening and closing
+	NotificationIncident NotificationKind = "incident"
+
+	// NotificationSubscription announces detected subscriptions, price increases and missed charges
+	NotificationSubscription NotificationKind = "subscription"
+
+	// NotificationLargeTransaction announces new transactions above the user's threshold
+	NotificationLargeTransaction NotificationKind = "large_transaction"
+
+	// NotificationBalanceDrift announces failed balance assertions
+	NotificationBalanceDrift NotificationKind = "balance_drift"
+
+	// NotificationConsent sends the links renewing bank consents before they expire
+	NotificationConsent NotificationKind = "consent"
+)
+
+// NotificationSettings selects the notifications a user receives
+type NotificationSettings struct {
+	Incidents         bool    `json:"incidents"`
+	Subscriptions     bool    `json:"subscriptions"`
+	BalanceDrift      bool    `json:"balanceDrift"`
+	Consent           bool    `json:"consent"`
+	LargeTransactions float64 `json:"largeTransactions"` // absolute amount at or above which a new transaction is announced, zero for none
+}
+
+// Wants reports whether a change of the given kind is announced. amount is
+// only used for transactions.
+func (n NotificationSettings) Wants(kind NotificationKind, amount float64) bool {
+	switch kind {
+	case NotificationIncident:
+		return n.Incidents
+	case NotificationSubscription:
+		return n.Subscriptions
+	case NotificationBalanceDrift:
+		return n.BalanceDrift
+	case NotificationConsent:
+		return n.Consent
+	case NotificationLargeTransaction:
+		if amount < 0 {
+			amount = -amount
+		}
+		return n.LargeTransactions > 0 && amount >= n.LargeTransactions
+	}
+	return false
+}
+
+// Preferences are the settings of a user. Users without stored preferences
+// get the defaults of the instance.
+type Preferences struct {
+	ID              string               `json:"id,omitempty"`
+	UserID          string               `json:"userId"`
+	BaseCurrency    string               `json:"baseCurrency"`
+	FirstDayOfWeek  time.Weekday         `json:"firstDayOfWeek"` // 0 for Sunday to 6 for Saturday
+	DefaultWalletID string               `json:"defaultWalletId,omitempty"`
+	DateFormat      DateFormat           `json:"dateFormat"`
+	Notifications   NotificationSettings `json:"notifications"`
+	Version         int                  `json:"version"` // optimistic concurrency version, zero until stored
+	CreatedAt       time.Time            `json:"createdAt,omitempty"`
+	UpdatedAt       time.Time            `json:"updatedAt,omitempty"`
+}
+
+// Validate checks the currency, weekday, date format and notification threshold
+func (p *Preferences) Validate() error {
+	p.BaseCurrency = strings.ToUpper(strings.TrimSpace(p.BaseCurrency))
+
+	v := validation.New()
+	v.Required("baseCurrency", p.BaseCurrency, fmt.Errorf("%w: base currency is required", ErrInvalidPreferences))
+	v.Check(p.FirstDayOfWeek >= time.Sunday && p.FirstDayOfWeek <= time.Saturday, "firstDayOfWeek", validation.RuleOneOf,
+		fmt.Errorf("%w: first day of week must be from 0 (Sunday) to 6", ErrInvalidPreferences))
+	v.Check(p.DateFormat.Layout() != "", "dateFormat", validation.RuleOneOf,
+		fmt.Errorf("%w: unknown date format %q", ErrInvalidPreferences, p.DateFormat))
+	v.Check(p.Notifications.LargeTransactions >= 0, "notifications.largeTransactions", validation.RuleMin,
+		fmt.Errorf("%w: large transaction threshold must not be negative", ErrInvalidPreferences))
+	return v.Err()
+}
+
+// Calendar returns the calendar with the weeks of the user
+func (p *Preferences) Calendar(calendar PeriodCalendar) PeriodCalendar {
+	calendar.WeekStart = p.FirstDayOfWeek
+	return calendar
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPreferences_Validate(t *testing.T) {
+	valid := func() Preferences {
+		return Preferences{BaseCurrency: " eur", FirstDayOfWeek: time.Monday, DateFormat: DateFormatEuropean}
+	}
+
+	p := valid()
+	if err := p.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if p.BaseCurrency != "EUR" {
+		t.Errorf("BaseCurrency = %q, want EUR", p.BaseCurrency)
+	}
+
+	tests := map[string]func(p *Preferences){
+		"missing currency":   func(p *Preferences) { p.BaseCurrency = "" },
+		"weekday too large":  func(p *Preferences) { p.FirstDayOfWeek = 7 },
+		"unknown format":     func(p *Preferences) { p.DateFormat = "D/M/Y" },
+		"negative threshold": func(p *Preferences) { p.Notifications.LargeTransactions = -1 },
+	}
+	for name, change := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := valid()
+			change(&p)
+			if err := p.Validate(); !errors.Is(err, ErrInvalidPreferences) {
+				t.Errorf("Validate() error = %v, want ErrInvalidPreferences", err)
+			}
+		})
+	}
+}
+
+func TestNotificationSettings_Wants(t *testing.T) {
+	settings := NotificationSettings{Incidents: true, BalanceDrift: true, LargeTransactions: 500}
+
+	tests := []struct {
+		kind   NotificationKind
+		amount float64
+		want   bool
+	}{
+		{NotificationIncident, 0, true},
+		{NotificationSubscription, 0, false},
+		{NotificationBalanceDrift, 0, true},
+		{NotificationLargeTransaction, 499.99, false},
+		{NotificationLargeTransaction, 500, true},
+		{NotificationLargeTransaction, -750, true},
+		{"unknown", 0, false},
+	}
+	for _, tt := range tests {
+		if got := settings.Wants(tt.kind, tt.amount); got != tt.want {
+			t.Errorf("Wants(%s, %v) = %v, want %v", tt.kind, tt.amount, got, tt.want)
+		}
+	}
+
+	if (NotificationSettings{}).Wants(NotificationLargeTransaction, 1e9) {
+		t.Error("Wants() = true without a threshold")
+	}
+}
+
+func TestDateFormat_Layout(t *testing.T) {
+	day := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	want := map[DateFormat]string{
+		DateFormatISO:      "2025-03-31",
+		DateFormatEuropean: "31.03.2025",
+		DateFormatBritish:  "31/03/2025",
+		DateFormatAmerican: "03/31/2025",
+	}
+	for format, formatted := range want {
+		if got := day.Format(format.Layout()); got != formatted {
+			t.Errorf("%s formats as %s, want %s", format, got, formatted)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package models
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RedactedValue replaces secrets in archived payloads
+const RedactedValue = "[REDACTED]"
+
+// secretNames are the query parameters and JSON keys whose values are
+// redacted before a payload is archived, compared case-insensitively
+var secretNames = map[string]bool{
+	"apikey":        true,
+	"api_key":       true,
+	"key":           true,
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+	"id_token":      true,
+	"client_secret": true,
+	"secret":        true,
+	"password":      true,
+	"authorization": true,
+}
+
+// RawPayload is a response of a provider API as received during an import
+// run, archived to debug the normalization and to replay it after a mapping
+// fix without calling the provider again
+type RawPayload struct {
+	ID         string    `json:"id"`
+	RunID      string    `json:"runId"` // import cycle, or the request of a sync outside a cycle
+	SourceID   string    `json:"sourceId"`
+	Method     string    `json:"method"`
+	URL        string    `json:"url"` // with secrets redacted, see RedactPayloadURL
+	Status     int       `json:"status"`
+	Body       []byte    `json:"body"`
+	Compressed bool      `json:"compressed"` // Body is gzipped
+	ReceivedAt time.Time `json:"receivedAt"`
+}
+
+// PayloadRun summarizes the payloads archived for a source in an import run
+type PayloadRun struct {
+	RunID      string    `json:"runId"`
+	SourceID   string    `json:"sourceId"`
+	Payloads   int       `json:"payloads"`
+	ReceivedAt time.Time `json:"receivedAt"` // of the first payload
+}
+
+// Compress gzips the body unless it already is
+func (p *RawPayload) Compress() error {
+	if p.Compressed {
+		return nil
+	}
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(p.Body); err != nil {
+		return fmt.Errorf("failed to compress payload: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to compress payload: %w", err)
+	}
+	p.Body = buf.Bytes()
+	p.Compressed = true
+	return nil
+}
+
+// Content returns the body as received, decompressing it if needed
+func (p *RawPayload) Content() ([]byte, error) {
+	if !p.Compressed {
+		return p.Body, nil
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(p.Body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress payload: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress payload: %w", err)
+	}
+	return content, nil
+}
+
+// RedactPayloadURL replaces the values of secret query parameters, e.g. an
+// explorer API key, and any user info of a URL. The redaction is stable, so
+// the redacted URL of a replayed request matches the archived one.
+func RedactPayloadURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	if u.User != nil {
+		u.User = url.User(RedactedValue)
+	}
+	query := u.Query()
+	for name := range query {
+		if secretNames[strings.ToLower(name)] {
+			query.Set(name, RedactedValue)
+		}
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// RedactPayloadBody replaces the values of secret keys anywhere in a JSON
+// body. Bodies that are not JSON are returned as they are.
+func RedactPayloadBody(body []byte) []byte {
+	var document any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber() // keep amounts exactly as received
+	if err := decoder.Decode(&document); err != nil {
+		return body
+	}
+	if !redactValue(document) {
+		return body
+	}
+	redacted, err := json.Marshal(document)
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// redactValue redacts the secrets of a decoded JSON value in place and
+// reports whether it found any
+func redactValue(value any) bool {
+	found := false
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if secretNames[strings.ToLower(key)] {
+				v[key] = RedactedValue
+				found = true
+				continue
+			}
+			found = redactValue(nested) || found
+		}
+	case []any:
+		for _, nested := range v {
+			found = redactValue(nested) || found
+		}
+	}
+	return found
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRawPayload_Compress(t *testing.T) {
+	body := []byte(`{"status":"1","result":[` + strings.Repeat(`{"hash":"0xabc"},`, 100) + `{}]}`)
+	payload := &RawPayload{Body: body}
+
+	if err := payload.Compress(); err != nil {
+		t.Fatalf("Compress() error = %v", err)
+	}
+	if !payload.Compressed || len(payload.Body) >= len(body) {
+		t.Errorf("compressed %d bytes to %d", len(body), len(payload.Body))
+	}
+
+	content, err := payload.Content()
+	if err != nil || string(content) != string(body) {
+		t.Errorf("Content() = %q, %v, want the original body", content, err)
+	}
+}
+
+func TestRedactPayload(t *testing.T) {
+	redacted := RedactPayloadURL("https://api.etherscan.io/v2/api?action=txlist&address=0xabc&apikey=SECRET")
+	if strings.Contains(redacted, "SECRET") || !strings.Contains(redacted, "address=0xabc") {
+		t.Errorf("RedactPayloadURL() = %q", redacted)
+	}
+	if again := RedactPayloadURL(redacted); again != redacted {
+		t.Errorf("redacting twice = %q, want the stable %q", again, redacted)
+	}
+
+	body := RedactPayloadBody([]byte(`{"access_token":"SECRET","data":[{"amount":12.50,"Client_Secret":"SECRET"}]}`))
+	if strings.Contains(string(body), "SECRET") || !strings.Contains(string(body), "12.50") {
+		t.Errorf("RedactPayloadBody() = %s", body)
+	}
+
+	plain := []byte("not json, token=abc")
+	if got := RedactPayloadBody(plain); string(got) != string(plain) {
+		t.Errorf("RedactPayloadBody() of a non-JSON body = %s", got)
+	}
+}
@@ -0,0 +1,203 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// SourceLifecycle is the state of an import source in its lifecycle
+type SourceLifecycle string
+
+const (
+	// SourceUnconfigured has no client yet, e.g. missing credentials
+	SourceUnconfigured SourceLifecycle = "unconfigured"
+
+	// SourceAuthorized has a client but has not synced successfully yet
+	SourceAuthorized SourceLifecycle = "authorized"
+
+	// SourceActive synced successfully the last time
+	SourceActive SourceLifecycle = "active"
+
+	// SourceDegraded failed SourceDegradedAfter syncs in a row and keeps retrying
+	SourceDegraded SourceLifecycle = "degraded"
+
+	// SourceDisabled was turned off and does not sync until it is enabled
+	SourceDisabled SourceLifecycle = "disabled"
+
+	// SourceConsentExpired was rejected by its provider and needs the user to
+	// grant access again
+	SourceConsentExpired SourceLifecycle = "expired_consent"
+)
+
+// SourceDegradedAfter is the number of consecutive failed syncs that degrade a source
+const SourceDegradedAfter = 3
+
+// ConsentValidity is how long a PSD2 bank consent lasts before the user has
+// to grant access again
+const ConsentValidity = 90 * 24 * time.Hour
+
+// ConsentRenewal is the progress of renewing the consent of a source
+type ConsentRenewal string
+
+const (
+	// ConsentRenewalPending waits for the user to grant access at the renewal link
+	ConsentRenewalPending ConsentRenewal = "pending"
+
+	// ConsentRenewalCompleted was granted again through the callback
+	ConsentRenewalCompleted ConsentRenewal = "completed"
+)
+
+// sourceTransitions lists the states each state may move to. Any state may
+// fall back to unconfigured when its client goes away.
+var sourceTransitions = map[SourceLifecycle][]SourceLifecycle{
+	SourceUnconfigured:   {SourceAuthorized, SourceDisabled},
+	SourceAuthorized:     {SourceActive, SourceDegraded, SourceDisabled, SourceConsentExpired, SourceUnconfigured},
+	SourceActive:         {SourceDegraded, SourceDisabled, SourceConsentExpired, SourceUnconfigured},
+	SourceDegraded:       {SourceActive, SourceDisabled, SourceConsentExpired, SourceUnconfigured},
+	SourceDisabled:       {SourceAuthorized, SourceUnconfigured},
+	SourceConsentExpired: {SourceAuthorized, SourceDisabled, SourceUnconfigured},
+}
+
+// Validate checks that the state is known
+func (l SourceLifecycle) Validate() error {
+	if _, ok := sourceTransitions[l]; !ok {
+		return fmt.Errorf("%w: unknown state %q", ErrInvalidSourceTransition, l)
+	}
+	return nil
+}
+
+// CanSync reports whether a source in this state may import
+func (l SourceLifecycle) CanSync() bool {
+	switch l {
+	case SourceAuthorized, SourceActive, SourceDegraded:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransition reports whether a source may move from this state to another
+func (l SourceLifecycle) CanTransition(to SourceLifecycle) bool {
+	for _, next := range sourceTransitions[l] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// SourceState holds the sync statistics and the lifecycle state of a source,
+// persisted so they survive restarts
+type SourceState struct {
+	ID            string    `json:"id"`
+	SourceID      string    `json:"sourceId"`
+	Runs          int       `json:"runs"`
+	Failures      int       `json:"failures"` // consecutive failed syncs
+	Imported      int       `json:"imported"` // transactions imported over all syncs
+	LastError     string    `json:"lastError,omitempty"`
+	LastRunAt     time.Time `json:"lastRunAt"`
+	LastSuccessAt time.Time `json:"lastSuccessAt"`
+
+	Lifecycle       SourceLifecycle `json:"lifecycle"`
+	LifecycleReason string          `json:"lifecycleReason,omitempty"` // why the source entered its state
+	TransitionedAt  time.Time       `json:"transitionedAt"`
+
+	// ConsentExpiresAt is when the access the user granted at the provider
+	// runs out, zero for sources without a consent
+	ConsentExpiresAt   time.Time      `json:"consentExpiresAt"`
+	ConsentSession     string         `json:"-"` // provider session the consent was granted in, empty before a renewal
+	Renewal            ConsentRenewal `json:"renewal,omitempty"`
+	RenewalURL         string         `json:"renewalUrl,omitempty"` // where the user grants access again
+	RenewalToken       string         `json:"-"`                    // state the callback carries back
+	RenewalRequestedAt time.Time      `json:"renewalRequestedAt"`
+}
+
+// NewSourceState creates the state of a source that has never synced, in the
+// given lifecycle state
+func NewSourceState(sourceID string, lifecycle SourceLifecycle) *SourceState {
+	return &SourceState{SourceID: sourceID, Lifecycle: lifecycle}
+}
+
+// Transition moves the source to another lifecycle state. Moving to the
+// current state only replaces the reason.
+func (s *SourceState) Transition(to SourceLifecycle, reason string, at time.Time) error {
+	if err := to.Validate(); err != nil {
+		return err
+	}
+	if s.Lifecycle == to {
+		s.LifecycleReason = reason
+		return nil
+	}
+	if !s.Lifecycle.CanTransition(to) {
+		return fmt.Errorf("%w: %s cannot become %s", ErrInvalidSourceTransition, s.Lifecycle, to)
+	}
+	s.Lifecycle = to
+	s.LifecycleReason = reason
+	s.TransitionedAt = at
+	return nil
+}
+
+// Record accounts a finished sync that imported the given number of
+// transactions and moves the source along its lifecycle: a success makes it
+// active and SourceDegradedAfter failures in a row degrade it. A source
+// disabled while it synced stays disabled.
+func (s *SourceState) Record(at time.Time, imported int, err error) {
+	s.Runs++
+	s.Imported += imported
+	s.LastRunAt = at
+	if err != nil {
+		s.Failures++
+		s.LastError = err.Error()
+		if s.Failures >= SourceDegradedAfter {
+			_ = s.Transition(SourceDegraded, fmt.Sprintf("%d syncs failed in a row", s.Failures), at)
+		}
+		return
+	}
+	s.Failures = 0
+	s.LastError = ""
+	s.LastSuccessAt = at
+	_ = s.Transition(SourceActive, "", at)
+}
+
+// ConsentDue reports whether the consent expires within the given time and
+// no renewal is already waiting for the user
+func (s *SourceState) ConsentDue(now time.Time, within time.Duration) bool {
+	return !s.ConsentExpiresAt.IsZero() && s.Renewal != ConsentRenewalPending && !now.Add(within).Before(s.ConsentExpiresAt)
+}
+
+// ExpireConsent moves a syncing source whose consent ran out by now to
+// expired_consent and reports whether it did
+func (s *SourceState) ExpireConsent(now time.Time) bool {
+	if s.ConsentExpiresAt.IsZero() || now.Before(s.ConsentExpiresAt) || !s.Lifecycle.CanSync() {
+		return false
+	}
+	reason := "consent expired on " + s.ConsentExpiresAt.Format(time.DateOnly)
+	return s.Transition(SourceConsentExpired, reason, now) == nil
+}
+
+// RequestRenewal records the renewal link sent to the user and the token its
+// callback carries back. A new request replaces a pending one.
+func (s *SourceState) RequestRenewal(url, token string, at time.Time) {
+	s.Renewal = ConsentRenewalPending
+	s.RenewalURL = url
+	s.RenewalToken = token
+	s.RenewalRequestedAt = at
+}
+
+// CompleteRenewal records the consent granted at the renewal link in the
+// provider session, expiring at expiresAt. A source whose consent had expired
+// may sync again only now.
+func (s *SourceState) CompleteRenewal(session string, expiresAt, at time.Time) error {
+	if s.Renewal != ConsentRenewalPending {
+		return fmt.Errorf("%w: no consent renewal is pending for %s", ErrInvalidOAuthState, s.SourceID)
+	}
+	s.ConsentExpiresAt = expiresAt
+	s.ConsentSession = session
+	s.Renewal = ConsentRenewalCompleted
+	s.RenewalURL = ""
+	s.RenewalToken = ""
+	if s.Lifecycle == SourceConsentExpired {
+		return s.Transition(SourceAuthorized, "consent renewed", at)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSourceState_Record(t *testing.T) {
+	state := NewSourceState("ethereum:0xabc", SourceAuthorized)
+	first := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	state.Record(first, 5, nil)
+	state.Record(first.Add(time.Minute), 0, errors.New("rate limited"))
+	state.Record(first.Add(2*time.Minute), 1, errors.New("timeout"))
+
+	if state.Runs != 3 || state.Failures != 2 || state.Imported != 6 {
+		t.Errorf("after two failures state = %+v", state)
+	}
+	if state.LastError != "timeout" || !state.LastSuccessAt.Equal(first) {
+		t.Errorf("last error %q, last success %v", state.LastError, state.LastSuccessAt)
+	}
+
+	state.Record(first.Add(3*time.Minute), 2, nil)
+	if state.Failures != 0 || state.LastError != "" || !state.LastSuccessAt.Equal(state.LastRunAt) {
+		t.Errorf("a successful sync must reset the failures, got %+v", state)
+	}
+}
+
+func TestSourceState_Lifecycle(t *testing.T) {
+	state := NewSourceState("enable:1", SourceAuthorized)
+	at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	state.Record(at, 1, nil)
+	if state.Lifecycle != SourceActive || !state.TransitionedAt.Equal(at) {
+		t.Fatalf("after a success state = %+v, want active", state)
+	}
+
+	for i := 1; i <= SourceDegradedAfter; i++ {
+		state.Record(at.Add(time.Duration(i)*time.Minute), 0, errors.New("timeout"))
+		if want := i >= SourceDegradedAfter; (state.Lifecycle == SourceDegraded) != want {
+			t.Fatalf("after %d failures lifecycle = %s", i, state.Lifecycle)
+		}
+	}
+	if !state.Lifecycle.CanSync() {
+		t.Error("a degraded source must keep syncing")
+	}
+
+	if err := state.Transition(SourceDisabled, "closed", at); err != nil {
+		t.Fatalf("Transition(disabled) error = %v", err)
+	}
+	state.Record(at.Add(time.Hour), 1, nil)
+	if state.Lifecycle != SourceDisabled || state.Lifecycle.CanSync() {
+		t.Errorf("a sync finishing after the source was disabled moved it to %s", state.Lifecycle)
+	}
+
+	if err := state.Transition(SourceActive, "", at); !errors.Is(err, ErrInvalidSourceTransition) {
+		t.Errorf("Transition(disabled -> active) error = %v, want ErrInvalidSourceTransition", err)
+	}
+	if err := state.Transition("paused", "", at); !errors.Is(err, ErrInvalidSourceTransition) {
+		t.Errorf("Transition(unknown) error = %v, want ErrInvalidSourceTransition", err)
+	}
+	if err := state.Transition(SourceAuthorized, "enabled again", at); err != nil || !state.Lifecycle.CanSync() {
+		t.Errorf("Transition(disabled -> authorized) error = %v, lifecycle %s", err, state.Lifecycle)
+	}
+}
+
+func TestSourceState_ConsentRenewal(t *testing.T) {
+	granted := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := NewSourceState("enable:1", SourceActive)
+	state.ConsentExpiresAt = granted.Add(ConsentValidity)
+
+	if state.ConsentDue(granted, 7*24*time.Hour) {
+		t.Error("a fresh consent must not be due")
+	}
+	week := state.ConsentExpiresAt.Add(-7 * 24 * time.Hour)
+	if !state.ConsentDue(week, 7*24*time.Hour) {
+		t.Error("a consent expiring within the window must be due")
+	}
+
+	state.RequestRenewal("https://bank.example/auth", "token", week)
+	if state.ConsentDue(week, 7*24*time.Hour) {
+		t.Error("a consent waiting for its renewal must not be due again")
+	}
+
+	if !state.ExpireConsent(state.ConsentExpiresAt) || state.Lifecycle != SourceConsentExpired {
+		t.Fatalf("ExpireConsent() at expiry lifecycle = %s, want expired_consent", state.Lifecycle)
+	}
+	if state.ExpireConsent(state.ConsentExpiresAt.Add(time.Hour)) {
+		t.Error("ExpireConsent() must not expire a source twice")
+	}
+
+	renewed := state.ConsentExpiresAt.Add(time.Hour)
+	if err := state.CompleteRenewal("session-2", renewed.Add(ConsentValidity), renewed); err != nil {
+		t.Fatalf("CompleteRenewal() error = %v", err)
+	}
+	if state.Lifecycle != SourceAuthorized || state.Renewal != ConsentRenewalCompleted || state.RenewalToken != "" {
+		t.Errorf("after the renewal state = %+v, want an authorized source", state)
+	}
+	if err := state.CompleteRenewal("session-3", renewed, renewed); !errors.Is(err, ErrInvalidOAuthState) {
+		t.Errorf("CompleteRenewal() without a pending renewal error = %v, want ErrInvalidOAuthState", err)
+	}
+}
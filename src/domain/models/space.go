@@ -0,0 +1,94 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+	"github.com/google/uuid"
+)
+
+// SpaceKind describes who a space is for
+type SpaceKind string
+
+const (
+	// SpaceKindHousehold is shared by the people of a household
+	SpaceKindHousehold SpaceKind = "household"
+
+	// SpaceKindPersonal holds the accounts of a single person
+	SpaceKindPersonal SpaceKind = "personal"
+
+	// SpaceKindBusiness holds the accounts of a business
+	SpaceKindBusiness SpaceKind = "business"
+)
+
+// SpaceRole is what a member may do in a space. Each role includes the
+// permissions of the roles below it.
+type SpaceRole string
+
+const (
+	// SpaceRoleViewer may read the wallets, categories and transactions of the space
+	SpaceRoleViewer SpaceRole = "viewer"
+
+	// SpaceRoleEditor may also move wallets and categories into the space
+	SpaceRoleEditor SpaceRole = "editor"
+
+	// SpaceRoleOwner may also manage the members of the space
+	SpaceRoleOwner SpaceRole = "owner"
+)
+
+var spaceRoleRanks = map[SpaceRole]int{
+	SpaceRoleViewer: 1,
+	SpaceRoleEditor: 2,
+	SpaceRoleOwner:  3,
+}
+
+// Valid reports whether the role is known
+func (r SpaceRole) Valid() bool {
+	return spaceRoleRanks[r] > 0
+}
+
+// Allows reports whether the role includes the permissions of required
+func (r SpaceRole) Allows(required SpaceRole) bool {
+	return r.Valid() && spaceRoleRanks[r] >= spaceRoleRanks[required]
+}
+
+// Space owns wallets and categories, so several people can share some of
+// their finances while keeping the rest private. Wallets and categories
+// outside any space stay visible to every user, as before spaces existed.
+type Space struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Kind      SpaceKind `json:"kind"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewSpace creates a new space with defaults
+func NewSpace(name string, kind SpaceKind) *Space {
+	return &Space{
+		ID:        uuid.New().String(),
+		Name:      strings.TrimSpace(name),
+		Kind:      kind,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Validate checks if the space is valid
+func (s *Space) Validate() error {
+	v := validation.New()
+	v.Required("name", s.Name, ErrMissingSpaceName)
+	v.Check(s.Kind == SpaceKindHousehold || s.Kind == SpaceKindPersonal || s.Kind == SpaceKindBusiness,
+		"kind", validation.RuleOneOf, ErrInvalidSpaceKind)
+	return v.Err()
+}
+
+// SpaceMember grants a user a role in a space
+type SpaceMember struct {
+	ID        string    `json:"id"`
+	SpaceID   string    `json:"spaceId"`
+	UserID    string    `json:"userId"` // ID of the PocketBase auth record
+	Role      SpaceRole `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSpaceRoleAllows(t *testing.T) {
+	tests := []struct {
+		role     SpaceRole
+		required SpaceRole
+		want     bool
+	}{
+		{SpaceRoleOwner, SpaceRoleOwner, true},
+		{SpaceRoleOwner, SpaceRoleViewer, true},
+		{SpaceRoleEditor, SpaceRoleEditor, true},
+		{SpaceRoleEditor, SpaceRoleOwner, false},
+		{SpaceRoleViewer, SpaceRoleViewer, true},
+		{SpaceRoleViewer, SpaceRoleEditor, false},
+		{SpaceRole("admin"), SpaceRoleViewer, false},
+		{SpaceRole(""), SpaceRoleViewer, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.Allows(tt.required); got != tt.want {
+			t.Errorf("%q.Allows(%q) = %v, want %v", tt.role, tt.required, got, tt.want)
+		}
+	}
+}
+
+func TestSpaceValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		space *Space
+		want  error
+	}{
+		{"household", NewSpace("Home", SpaceKindHousehold), nil},
+		{"business", NewSpace(" Shop ", SpaceKindBusiness), nil},
+		{"blank name", NewSpace("  ", SpaceKindPersonal), ErrMissingSpaceName},
+		{"unknown kind", NewSpace("Home", SpaceKind("club")), ErrInvalidSpaceKind},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.space.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if name := NewSpace(" Shop ", SpaceKindBusiness).Name; name != "Shop" {
+		t.Errorf("NewSpace() name = %q, want trimmed", name)
+	}
+}
@@ -0,0 +1,268 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// SubscriptionInterval is how often a subscription charges
+type SubscriptionInterval string
+
+const (
+	// SubscriptionWeekly charges every week
+	SubscriptionWeekly SubscriptionInterval = "weekly"
+
+	// SubscriptionMonthly charges every month
+	SubscriptionMonthly SubscriptionInterval = "monthly"
+
+	// SubscriptionQuarterly charges every three months
+	SubscriptionQuarterly SubscriptionInterval = "quarterly"
+
+	// SubscriptionYearly charges every year
+	SubscriptionYearly SubscriptionInterval = "yearly"
+)
+
+// SubscriptionStatus tells whether a subscription still charges on schedule
+type SubscriptionStatus string
+
+const (
+	// SubscriptionActive charged within its interval
+	SubscriptionActive SubscriptionStatus = "active"
+
+	// SubscriptionMissed is overdue: its next charge did not arrive in time
+	SubscriptionMissed SubscriptionStatus = "missed"
+)
+
+const (
+	// SubscriptionMinCharges is the number of regular charges that make a subscription
+	SubscriptionMinCharges = 3
+
+	// SubscriptionAmountTolerance is how much two charges of the same price may differ, relative to the larger one
+	SubscriptionAmountTolerance = 0.1
+
+	// SubscriptionMaxPriceChange bounds a price change, relative to the larger price;
+	// bigger jumps are taken as a different charge
+	SubscriptionMaxPriceChange = 0.5
+
+	// SubscriptionPriceIncreaseThreshold is the relative increase of a charge that counts as a price increase
+	SubscriptionPriceIncreaseThreshold = 0.01
+)
+
+// subscriptionCadence is the gap between the charges of an interval and how far a gap may deviate
+type subscriptionCadence struct {
+	interval  SubscriptionInterval
+	days      float64
+	tolerance float64
+}
+
+var subscriptionCadences = []subscriptionCadence{
+	{SubscriptionWeekly, 7, 1},
+	{SubscriptionMonthly, 30.44, 4},
+	{SubscriptionQuarterly, 91.31, 8},
+	{SubscriptionYearly, 365.25, 12},
+}
+
+// Subscription is a recurring charge from the same merchant: a similar amount
+// taken from a wallet at a regular interval
+type Subscription struct {
+	ID             string               `json:"id"`
+	Merchant       string               `json:"merchant"` // normalized counterparty or description the charges share
+	Name           string               `json:"name"`     // counterparty or description of the latest charge
+	WalletID       string               `json:"walletId"`
+	Currency       string               `json:"currency"` // of the wallet, which the amounts are in
+	CategoryID     string               `json:"categoryId,omitempty"`
+	Interval       SubscriptionInterval `json:"interval"`
+	Amount         float64              `json:"amount"`         // latest charge
+	PreviousAmount float64              `json:"previousAmount"` // charge before the latest
+	MonthlyCost    float64              `json:"monthlyCost"`
+	Charges        int                  `json:"charges"` // regular charges seen in a row
+	FirstChargeAt  time.Time            `json:"firstChargeAt"`
+	LastChargeAt   time.Time            `json:"lastChargeAt"`
+	NextChargeAt   time.Time            `json:"nextChargeAt"`
+	Status         SubscriptionStatus   `json:"status"`
+	UpdatedAt      time.Time            `json:"updatedAt"`
+}
+
+// Key identifies the subscription across detection runs
+func (s *Subscription) Key() string {
+	return s.WalletID + "|" + s.Merchant
+}
+
+// PriceIncrease returns how much the latest charge exceeds the one before it, or 0
+func (s *Subscription) PriceIncrease() float64 {
+	if s.Amount <= s.PreviousAmount || relativeDifference(s.Amount, s.PreviousAmount) < SubscriptionPriceIncreaseThreshold {
+		return 0
+	}
+	return s.Amount - s.PreviousAmount
+}
+
+// Overdue reports whether the next charge should have arrived by now
+func (s *Subscription) Overdue(now time.Time) bool {
+	grace := time.Duration(cadenceOf(s.Interval).tolerance*24) * time.Hour
+	return now.After(s.NextChargeAt.Add(grace))
+}
+
+// Next returns when the charge after one made at t is expected
+func (i SubscriptionInterval) Next(t time.Time) time.Time {
+	switch i {
+	case SubscriptionWeekly:
+		return t.AddDate(0, 0, 7)
+	case SubscriptionQuarterly:
+		return t.AddDate(0, 3, 0)
+	case SubscriptionYearly:
+		return t.AddDate(1, 0, 0)
+	default:
+		return t.AddDate(0, 1, 0)
+	}
+}
+
+// MonthlyCost converts the amount charged every interval to an average monthly cost
+func (i SubscriptionInterval) MonthlyCost(amount float64) float64 {
+	return math.Round(amount*cadenceOf(SubscriptionMonthly).days/cadenceOf(i).days*100) / 100
+}
+
+func cadenceOf(interval SubscriptionInterval) subscriptionCadence {
+	for _, cadence := range subscriptionCadences {
+		if cadence.interval == interval {
+			return cadence
+		}
+	}
+	return subscriptionCadences[1]
+}
+
+// matchCadence returns the cadence a gap between two charges fits
+func matchCadence(gap time.Duration) (subscriptionCadence, bool) {
+	days := gap.Hours() / 24
+	for _, cadence := range subscriptionCadences {
+		if math.Abs(days-cadence.days) <= cadence.tolerance {
+			return cadence, true
+		}
+	}
+	return subscriptionCadence{}, false
+}
+
+// DetectSubscriptions finds the recurring charges among expenses. The charges
+// of a wallet are grouped by merchant, and the latest run of at least
+// SubscriptionMinCharges charges at a regular interval with similar amounts
+// becomes a subscription. One price change is allowed within a run.
+func DetectSubscriptions(transactions []*Transaction, now time.Time) []*Subscription {
+	groups := make(map[string][]*Transaction)
+	for _, tx := range transactions {
+		if tx.Type != TransactionTypeExpense || tx.IsDeleted() || tx.Amount <= 0 {
+			continue
+		}
+		merchant := MerchantKey(tx)
+		if merchant == "" {
+			continue
+		}
+		key := tx.WalletID + "|" + merchant
+		groups[key] = append(groups[key], tx)
+	}
+
+	var subscriptions []*Subscription
+	for _, charges := range groups {
+		if subscription := detectSubscription(charges, now); subscription != nil {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool { return subscriptions[i].Key() < subscriptions[j].Key() })
+	return subscriptions
+}
+
+// detectSubscription walks back from the latest charge of a merchant while the
+// charges keep the interval of the latest gap
+func detectSubscription(charges []*Transaction, now time.Time) *Subscription {
+	if len(charges) < SubscriptionMinCharges {
+		return nil
+	}
+	sort.Slice(charges, func(i, j int) bool { return charges[i].Date.Before(charges[j].Date) })
+
+	last := len(charges) - 1
+	cadence, ok := matchCadence(charges[last].Date.Sub(charges[last-1].Date))
+	if !ok {
+		return nil
+	}
+
+	first := last
+	priceChanged := false
+	for first > 0 {
+		previous, current := charges[first-1], charges[first]
+		gap, ok := matchCadence(current.Date.Sub(previous.Date))
+		if !ok || gap.interval != cadence.interval {
+			break
+		}
+		if differentPrice(previous.Amount, current.Amount) {
+			if priceChanged || relativeDifference(previous.Amount, current.Amount) > SubscriptionMaxPriceChange {
+				break
+			}
+			priceChanged = true
+		}
+		first--
+	}
+
+	count := last - first + 1
+	if count < SubscriptionMinCharges {
+		return nil
+	}
+
+	latest := charges[last]
+	name := strings.TrimSpace(latest.Metadata["counterparty"])
+	if name == "" {
+		name = latest.Description
+	}
+	subscription := &Subscription{
+		Merchant:       MerchantKey(latest),
+		Name:           name,
+		WalletID:       latest.WalletID,
+		CategoryID:     latest.CategoryID,
+		Interval:       cadence.interval,
+		Amount:         latest.Amount,
+		PreviousAmount: charges[last-1].Amount,
+		MonthlyCost:    cadence.interval.MonthlyCost(latest.Amount),
+		Charges:        count,
+		FirstChargeAt:  charges[first].Date,
+		LastChargeAt:   latest.Date,
+		NextChargeAt:   cadence.interval.Next(latest.Date),
+		Status:         SubscriptionActive,
+		UpdatedAt:      now,
+	}
+	if subscription.Overdue(now) {
+		subscription.Status = SubscriptionMissed
+	}
+	return subscription
+}
+
+// MerchantKey returns the normalized merchant of a transaction: its
+// counterparty, or else the words of its description without the digits of
+// dates and references that change from charge to charge
+func MerchantKey(tx *Transaction) string {
+	if counterparty := strings.TrimSpace(tx.Metadata["counterparty"]); counterparty != "" {
+		return strings.ToLower(counterparty)
+	}
+
+	var words []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(tx.Description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if strings.IndexFunc(word, unicode.IsDigit) < 0 {
+			words = append(words, word)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func differentPrice(a, b float64) bool {
+	return relativeDifference(a, b) > SubscriptionAmountTolerance
+}
+
+func relativeDifference(a, b float64) float64 {
+	larger := math.Max(a, b)
+	if larger == 0 {
+		return 0
+	}
+	return math.Abs(a-b) / larger
+}
@@ -0,0 +1,135 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func charge(date time.Time, amount float64, description, counterparty string) *Transaction {
+	tx := NewTransaction(amount, description, date, TransactionTypeExpense, "subscriptions", "checking")
+	tx.Metadata = map[string]string{"counterparty": counterparty}
+	return tx
+}
+
+func monthlyCharges(start time.Time, amounts ...float64) []*Transaction {
+	var charges []*Transaction
+	for i, amount := range amounts {
+		charges = append(charges, charge(start.AddDate(0, i, 0), amount, "NETFLIX.COM 8675309", "Netflix"))
+	}
+	return charges
+}
+
+func TestDetectSubscriptions(t *testing.T) {
+	start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	transactions := monthlyCharges(start, 12.99, 12.99, 12.99, 12.99, 15.49)
+	// Weekly charges identified by their description only
+	for i := 0; i < 4; i++ {
+		transactions = append(transactions, charge(now.AddDate(0, 0, -7*(4-i)), 4.99, "Gym pass week "+string(rune('1'+i)), ""))
+	}
+	// Irregular groceries are not a subscription
+	for _, day := range []int{1, 3, 12, 13, 29} {
+		transactions = append(transactions, charge(start.AddDate(0, 0, day), 50, "REWE", "REWE"))
+	}
+	// Too few charges
+	transactions = append(transactions,
+		charge(start, 9.99, "Spotify", "Spotify"), charge(start.AddDate(0, 1, 0), 9.99, "Spotify", "Spotify"))
+
+	subscriptions := DetectSubscriptions(transactions, now)
+	if len(subscriptions) != 2 {
+		t.Fatalf("DetectSubscriptions() found %d subscriptions, want 2: %+v", len(subscriptions), subscriptions)
+	}
+
+	gym, netflix := subscriptions[0], subscriptions[1]
+	if gym.Merchant != "gym pass week" || gym.Interval != SubscriptionWeekly || gym.Charges != 4 {
+		t.Errorf("gym = %+v, want 4 weekly charges of gym pass week", gym)
+	}
+	if gym.MonthlyCost != 21.7 {
+		t.Errorf("gym.MonthlyCost = %v, want 21.7", gym.MonthlyCost)
+	}
+
+	if netflix.Merchant != "netflix" || netflix.Name != "Netflix" || netflix.Interval != SubscriptionMonthly {
+		t.Errorf("netflix = %+v, want a monthly Netflix subscription", netflix)
+	}
+	if netflix.Charges != 5 || netflix.Amount != 15.49 || netflix.PreviousAmount != 12.99 {
+		t.Errorf("netflix charges = %d of %v after %v, want 5 of 15.49 after 12.99", netflix.Charges, netflix.Amount, netflix.PreviousAmount)
+	}
+	if want := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC); !netflix.NextChargeAt.Equal(want) {
+		t.Errorf("netflix.NextChargeAt = %v, want %v", netflix.NextChargeAt, want)
+	}
+	if netflix.PriceIncrease() != 15.49-12.99 {
+		t.Errorf("netflix.PriceIncrease() = %v, want 2.5", netflix.PriceIncrease())
+	}
+	if netflix.Status != SubscriptionActive {
+		t.Errorf("netflix.Status = %s, want active", netflix.Status)
+	}
+}
+
+func TestDetectSubscriptions_Runs(t *testing.T) {
+	start := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		amounts     []float64
+		wantCharges int // 0 when no subscription is expected
+	}{
+		{"stable price", []float64{10, 10, 10}, 3},
+		{"small variation", []float64{40.10, 42.30, 39.80, 41.00}, 4},
+		{"one price change", []float64{10, 10, 12, 12}, 4},
+		{"second price change ends the run", []float64{8, 10, 10, 12, 12, 12}, 5},
+		{"jump is a different charge", []float64{10, 10, 30, 30}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptions := DetectSubscriptions(monthlyCharges(start, tt.amounts...), now)
+			if tt.wantCharges == 0 {
+				if len(subscriptions) != 0 {
+					t.Errorf("DetectSubscriptions() = %+v, want none", subscriptions)
+				}
+				return
+			}
+			if len(subscriptions) != 1 || subscriptions[0].Charges != tt.wantCharges {
+				t.Errorf("DetectSubscriptions() = %+v, want one run of %d charges", subscriptions, tt.wantCharges)
+			}
+		})
+	}
+}
+
+func TestSubscription_Overdue(t *testing.T) {
+	last := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	subscription := &Subscription{Interval: SubscriptionMonthly, LastChargeAt: last, NextChargeAt: SubscriptionMonthly.Next(last)}
+
+	if subscription.Overdue(time.Date(2024, 4, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Overdue() = true within the grace period")
+	}
+	if !subscription.Overdue(time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Overdue() = false after the grace period")
+	}
+
+	charges := monthlyCharges(last.AddDate(0, -2, 0), 5, 5, 5)
+	subscriptions := DetectSubscriptions(charges, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC))
+	if len(subscriptions) != 1 || subscriptions[0].Status != SubscriptionMissed {
+		t.Errorf("DetectSubscriptions() = %+v, want a missed subscription", subscriptions)
+	}
+}
+
+func TestSubscription_PriceIncrease(t *testing.T) {
+	tests := []struct {
+		amount, previous, want float64
+	}{
+		{10, 10, 0},
+		{10.05, 10, 0}, // within rounding noise
+		{11, 10, 1},
+		{9, 10, 0},
+	}
+
+	for _, tt := range tests {
+		subscription := &Subscription{Amount: tt.amount, PreviousAmount: tt.previous}
+		if got := subscription.PriceIncrease(); got != tt.want {
+			t.Errorf("PriceIncrease(%v after %v) = %v, want %v", tt.amount, tt.previous, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+	"github.com/google/uuid"
+)
+
+const (
+	// TagStakingReward is added to staking rewards recognised by a source client
+	TagStakingReward = "staking-reward"
+
+	// TagAirdrop is added to token airdrops recognised by a source client
+	TagAirdrop = "airdrop"
+
+	// TagNetworkFee is added to fee transactions booked by a source client
+	TagNetworkFee = "network-fee"
+)
+
+// Tag is a label that can be attached to transactions. Transactions reference
+// tags by name, so renaming or merging a tag rewrites the referencing transactions.
+type Tag struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Color       string    `json:"color,omitempty"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// NewTag creates a new tag
+func NewTag(name, color, description string) *Tag {
+	return &Tag{
+		ID:          uuid.New().String(),
+		Name:        strings.TrimSpace(name),
+		Color:       color,
+		Description: description,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// Validate checks if the tag is valid
+func (t *Tag) Validate() error {
+	v := validation.New()
+	v.Required("name", t.Name, ErrMissingTagName)
+	return v.Err()
+}
+
+// TagSpend aggregates the transactions carrying a tag in one currency
+type TagSpend struct {
+	Tag              string  `json:"tag"`
+	Currency         string  `json:"currency"`
+	TransactionCount int     `json:"transactionCount"`
+	Income           float64 `json:"income"`
+	Expense          float64 `json:"expense"`
+	Transfer         float64 `json:"transfer"`
+}
+
+// Net returns income minus expenses
+func (s *TagSpend) Net() float64 {
+	return s.Income - s.Expense
+}
+
+// ReplaceTag renames a tag in a list of tags, dropping the old name and
+// avoiding a duplicate when the new name is already present.
+// It reports whether the list changed.
+func ReplaceTag(tags []string, oldName, newName string) ([]string, bool) {
+	result := make([]string, 0, len(tags))
+	changed := false
+	hasNew := false
+
+	for _, tag := range tags {
+		if tag == oldName {
+			changed = true
+			tag = newName
+		}
+		if tag == newName {
+			if hasNew {
+				continue
+			}
+			hasNew = true
+		}
+		result = append(result, tag)
+	}
+
+	return result, changed
+}
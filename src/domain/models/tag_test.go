@@ -0,0 +1,38 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTag_Validate(t *testing.T) {
+	if err := NewTag("  ", "", "").Validate(); !errors.Is(err, ErrMissingTagName) {
+		t.Errorf("Validate() error = %v, want %v", err, ErrMissingTagName)
+	}
+	if err := NewTag("travel", "#00ff00", "").Validate(); err != nil {
+		t.Errorf("Validate() returned unexpected error: %v", err)
+	}
+}
+
+func TestReplaceTag(t *testing.T) {
+	tests := []struct {
+		name        string
+		tags        []string
+		want        []string
+		wantChanged bool
+	}{
+		{"rename", []string{"food", "trip"}, []string{"food", "travel"}, true},
+		{"target already present", []string{"trip", "travel"}, []string{"travel"}, true},
+		{"not tagged", []string{"food"}, []string{"food"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := ReplaceTag(tt.tags, "trip", "travel")
+			if !reflect.DeepEqual(got, tt.want) || changed != tt.wantChanged {
+				t.Errorf("ReplaceTag() = %v, %v, want %v, %v", got, changed, tt.want, tt.wantChanged)
+			}
+		})
+	}
+}
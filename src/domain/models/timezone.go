@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// StartOfDay returns midnight of the calendar day t falls on in loc, UTC
+// when loc is nil
+func StartOfDay(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// ParseDate parses a calendar date such as a bank's booking date as
+// midnight in loc, UTC when loc is nil
+func ParseDate(layout, value string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.ParseInLocation(layout, value, loc)
+}
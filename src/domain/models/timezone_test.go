@@ -0,0 +1,37 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	sydney := time.FixedZone("AEST", 10*60*60)
+	date, err := ParseDate(time.DateOnly, "2024-05-02", sydney)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC); !date.Equal(want) {
+		t.Errorf("ParseDate() = %v, want local midnight %v", date.UTC(), want)
+	}
+
+	// Booked today in Sydney is not in the future while it is still yesterday in UTC
+	now := time.Date(2024, 5, 1, 22, 0, 0, 0, time.UTC)
+	if date.After(now) {
+		t.Errorf("booking date %v is after %v", date, now)
+	}
+
+	utc, err := ParseDate(time.DateOnly, "2024-05-02", nil)
+	if err != nil || !utc.Equal(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ParseDate() without location = %v, %v, want UTC midnight", utc, err)
+	}
+}
+
+func TestStartOfDay(t *testing.T) {
+	newYork := time.FixedZone("EDT", -4*60*60)
+	// 02:00 UTC is still the evening before in New York
+	got := StartOfDay(time.Date(2024, 5, 2, 2, 0, 0, 0, time.UTC), newYork)
+	if want := time.Date(2024, 5, 1, 0, 0, 0, 0, newYork); !got.Equal(want) {
+		t.Errorf("StartOfDay() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+// TokenFilterStats counts the token transfers a wallet's token filter dropped
+type TokenFilterStats struct {
+	NotAllowed int `json:"notAllowed"` // token missing from the allow list
+	Denied     int `json:"denied"`     // token matched a deny pattern
+	ScamList   int `json:"scamList"`   // token listed on the scam list
+}
+
+// Total returns the number of filtered token transfers
+func (s TokenFilterStats) Total() int {
+	return s.NotAllowed + s.Denied + s.ScamList
+}
+
+// Add accumulates the counts of another run
+func (s *TokenFilterStats) Add(other TokenFilterStats) {
+	s.NotAllowed += other.NotAllowed
+	s.Denied += other.Denied
+	s.ScamList += other.ScamList
+}
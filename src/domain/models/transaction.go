@@ -1,8 +1,11 @@
 package models
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
 	"github.com/google/uuid"
 )
 
@@ -12,10 +15,10 @@ type TransactionType string
 const (
 	// TransactionTypeIncome represents an income transaction
 	TransactionTypeIncome TransactionType = "income"
-	
+
 	// TransactionTypeExpense represents an expense transaction
 	TransactionTypeExpense TransactionType = "expense"
-	
+
 	// TransactionTypeTransfer represents a transfer between wallets
 	TransactionTypeTransfer TransactionType = "transfer"
 )
@@ -26,34 +29,69 @@ type TransactionStatus string
 const (
 	// TransactionStatusPending represents a pending transaction
 	TransactionStatusPending TransactionStatus = "pending"
-	
+
 	// TransactionStatusCompleted represents a completed transaction
 	TransactionStatusCompleted TransactionStatus = "completed"
-	
+
 	// TransactionStatusFailed represents a failed transaction
 	TransactionStatusFailed TransactionStatus = "failed"
 )
 
+// FeeMode controls how source clients book the fees paid for a transaction
+type FeeMode string
+
+const (
+	// FeeModeSeparate books each fee as its own expense transaction
+	FeeModeSeparate FeeMode = "separate"
+
+	// FeeModeField records the fee on the transaction it was paid for
+	FeeModeField FeeMode = "field"
+)
+
+// MetadataCategoryHint is the metadata key a source client uses to suggest a
+// category by name when it has classified a transaction
+const MetadataCategoryHint = "category"
+
+// MetadataSandbox is the metadata key marking a transaction imported from a
+// provider's sandbox as test data; reports leave such transactions out
+const MetadataSandbox = "sandbox"
+
+// MetadataNormalizer is the metadata key holding the version of the source
+// client's normalizer that produced an imported transaction
+const MetadataNormalizer = "normalizer"
+
+// TransactionRestoreWindow is how long a soft-deleted transaction can be restored
+// before it becomes eligible for purging
+const TransactionRestoreWindow = 30 * 24 * time.Hour
+
 // Transaction represents a financial transaction in the system
 type Transaction struct {
-	ID              string            `json:"id"`
-	Amount          float64           `json:"amount"`
-	Description     string            `json:"description"`
-	Date            time.Time         `json:"date"`
-	Type            TransactionType   `json:"type"`
-	Status          TransactionStatus `json:"status"`
-	CategoryID      string            `json:"categoryId"`
-	WalletID        string            `json:"walletId"`
-	DestWalletID    string            `json:"destWalletId,omitempty"`
-	ExchangeRate    float64           `json:"exchangeRate,omitempty"`
-	Tags            []string          `json:"tags,omitempty"`
-	CreatedAt       time.Time         `json:"createdAt"`
-	UpdatedAt       time.Time         `json:"updatedAt"`
+	ID           string            `json:"id"`
+	Amount       float64           `json:"amount"`
+	Description  string            `json:"description"`
+	Date         time.Time         `json:"date"`
+	Type         TransactionType   `json:"type"`
+	Status       TransactionStatus `json:"status"`
+	CategoryID   string            `json:"categoryId"`
+	WalletID     string            `json:"walletId"`
+	DestWalletID string            `json:"destWalletId,omitempty"`
+	ExchangeRate float64           `json:"exchangeRate,omitempty"`
+	Fee          float64           `json:"fee,omitempty"` // fee paid by the source wallet on top of Amount
+	Tags         []string          `json:"tags,omitempty"`
+	Notes        string            `json:"notes,omitempty"`      // free-text user notes
+	Reference    string            `json:"reference,omitempty"`  // structured payment reference, e.g. an RF creditor reference or invoice number
+	EndToEndID   string            `json:"endToEndId,omitempty"` // SEPA end-to-end ID the payer assigned to the payment
+	Metadata     map[string]string `json:"metadata,omitempty"`   // provider metadata: chain, address, bank, raw IDs
+	DeletedAt    time.Time         `json:"deletedAt,omitempty"`
+	FireflyID    string            `json:"fireflyId,omitempty"` // ID of the linked Firefly III transaction
+	Version      int               `json:"version"`             // optimistic concurrency version, bumped on every update
+	CreatedAt    time.Time         `json:"createdAt"`
+	UpdatedAt    time.Time         `json:"updatedAt"`
 }
 
 // NewTransaction creates a new transaction with defaults
-func NewTransaction(amount float64, description string, date time.Time, txType TransactionType, 
-					categoryID, walletID string) *Transaction {
+func NewTransaction(amount float64, description string, date time.Time, txType TransactionType,
+	categoryID, walletID string) *Transaction {
 	return &Transaction{
 		ID:          uuid.New().String(),
 		Amount:      amount,
@@ -73,53 +111,34 @@ func (t *Transaction) SetDestinationWallet(destWalletID string, exchangeRate flo
 	if t.Type != TransactionTypeTransfer {
 		return ErrNotTransferTransaction
 	}
-	
+
 	if destWalletID == t.WalletID {
 		return ErrSameWallet
 	}
-	
+
 	t.DestWalletID = destWalletID
 	t.ExchangeRate = exchangeRate
 	t.UpdatedAt = time.Now()
-	
+
 	return nil
 }
 
 // Validate checks if the transaction is valid
 func (t *Transaction) Validate() error {
-	// Amount must be positive
-	if t.Amount <= 0 {
-		return ErrInvalidAmount
-	}
-	
-	// Date cannot be in the future
-	if t.Date.After(time.Now()) {
-		return ErrFutureDate
-	}
-	
-	// Must have a wallet
-	if t.WalletID == "" {
-		return ErrMissingWallet
-	}
-	
-	// Must have a category
-	if t.CategoryID == "" {
-		return ErrMissingCategory
-	}
-	
-	// For transfers, must have a destination wallet
+	v := validation.New()
+	v.Check(t.Amount > 0, "amount", validation.RulePositive, ErrInvalidAmount)
+	v.Check(!t.Date.After(time.Now()), "date", validation.RulePast, ErrFutureDate)
+	v.Check(t.Fee >= 0, "fee", validation.RuleMin, ErrInvalidFee)
+	v.Required("walletId", t.WalletID, ErrMissingWallet)
+	v.Required("categoryId", t.CategoryID, ErrMissingCategory)
+
+	// Transfers move money between two different wallets
 	if t.Type == TransactionTypeTransfer {
-		if t.DestWalletID == "" {
-			return ErrMissingDestWallet
-		}
-		
-		// Source and destination wallets must be different
-		if t.WalletID == t.DestWalletID {
-			return ErrSameWallet
-		}
+		v.Required("destWalletId", t.DestWalletID, ErrMissingDestWallet)
+		v.Check(t.DestWalletID == "" || t.WalletID != t.DestWalletID, "destWalletId", validation.RuleDistinct, ErrSameWallet)
 	}
-	
-	return nil
+
+	return v.Err()
 }
 
 // MarkAsCompleted marks the transaction as completed
@@ -132,4 +151,141 @@ func (t *Transaction) MarkAsCompleted() {
 func (t *Transaction) MarkAsFailed() {
 	t.Status = TransactionStatusFailed
 	t.UpdatedAt = time.Now()
-} 
\ No newline at end of file
+}
+
+// IsDeleted reports whether the transaction has been soft-deleted
+func (t *Transaction) IsDeleted() bool {
+	return !t.DeletedAt.IsZero()
+}
+
+// SoftDelete marks the transaction as deleted without removing it
+func (t *Transaction) SoftDelete() error {
+	if t.IsDeleted() {
+		return ErrTransactionDeleted
+	}
+
+	t.DeletedAt = time.Now()
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// Restore clears the deleted marker if the restore window has not elapsed
+func (t *Transaction) Restore() error {
+	if !t.IsDeleted() {
+		return ErrTransactionNotDeleted
+	}
+
+	if time.Since(t.DeletedAt) > TransactionRestoreWindow {
+		return ErrRestoreWindowExpired
+	}
+
+	t.DeletedAt = time.Time{}
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsLinked reports whether the transaction is linked to a Firefly III transaction
+func (t *Transaction) IsLinked() bool {
+	return t.FireflyID != ""
+}
+
+// MarkSandbox marks the transaction as test data from a provider sandbox
+func (t *Transaction) MarkSandbox() {
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]string, 1)
+	}
+	t.Metadata[MetadataSandbox] = "true"
+}
+
+// IsSandbox reports whether the transaction is test data from a provider sandbox
+func (t *Transaction) IsSandbox() bool {
+	return t.Metadata[MetadataSandbox] == "true"
+}
+
+// SetNormalizerVersion records the version of the normalizer that produced
+// the transaction
+func (t *Transaction) SetNormalizerVersion(version int) {
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]string, 1)
+	}
+	t.Metadata[MetadataNormalizer] = strconv.Itoa(version)
+}
+
+// NormalizerVersion returns the version of the normalizer that produced the
+// transaction, zero when it was not recorded
+func (t *Transaction) NormalizerVersion() int {
+	version, err := strconv.Atoi(t.Metadata[MetadataNormalizer])
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+// BalanceEffects returns the change this transaction makes to each affected wallet balance,
+// keyed by wallet ID. Failed and soft-deleted transactions have no effect.
+func (t *Transaction) BalanceEffects() map[string]float64 {
+	if t.IsDeleted() || t.Status == TransactionStatusFailed {
+		return nil
+	}
+
+	switch t.Type {
+	case TransactionTypeIncome:
+		return map[string]float64{t.WalletID: t.Amount - t.Fee}
+	case TransactionTypeExpense:
+		return map[string]float64{t.WalletID: -t.Amount - t.Fee}
+	case TransactionTypeTransfer:
+		destAmount := t.Amount
+		if t.ExchangeRate > 0 {
+			destAmount *= t.ExchangeRate
+		}
+		return map[string]float64{
+			t.WalletID:     -t.Amount - t.Fee,
+			t.DestWalletID: destAmount,
+		}
+	}
+
+	return nil
+}
+
+// MergeTags adds the given tags that the transaction does not carry yet, keeping their order
+func (t *Transaction) MergeTags(tags []string) {
+	seen := make(map[string]bool, len(t.Tags))
+	for _, tag := range t.Tags {
+		seen[tag] = true
+	}
+
+	for _, tag := range tags {
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		t.Tags = append(t.Tags, tag)
+	}
+}
+
+// MergeNotes appends notes from another transaction on a new paragraph
+func (t *Transaction) MergeNotes(notes string) {
+	notes = strings.TrimSpace(notes)
+	if notes == "" || strings.Contains(t.Notes, notes) {
+		return
+	}
+
+	if t.Notes == "" {
+		t.Notes = notes
+		return
+	}
+	t.Notes += "\n\n" + notes
+}
+
+// MergeMetadata copies metadata entries that the transaction does not have yet
+func (t *Transaction) MergeMetadata(metadata map[string]string) {
+	for key, value := range metadata {
+		if _, ok := t.Metadata[key]; ok {
+			continue
+		}
+		if t.Metadata == nil {
+			t.Metadata = make(map[string]string, len(metadata))
+		}
+		t.Metadata[key] = value
+	}
+}
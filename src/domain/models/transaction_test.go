@@ -0,0 +1,171 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+)
+
+func TestTransaction_SoftDeleteAndRestore(t *testing.T) {
+	tx := NewTransaction(10, "Coffee", time.Now(), TransactionTypeExpense, "cat-1", "wallet-1")
+
+	if tx.IsDeleted() {
+		t.Fatal("Expected new transaction not to be deleted")
+	}
+
+	if err := tx.Restore(); err != ErrTransactionNotDeleted {
+		t.Errorf("Restore() on active transaction error = %v, want %v", err, ErrTransactionNotDeleted)
+	}
+
+	if err := tx.SoftDelete(); err != nil {
+		t.Fatalf("SoftDelete() returned unexpected error: %v", err)
+	}
+	if !tx.IsDeleted() {
+		t.Error("Expected transaction to be deleted after SoftDelete()")
+	}
+
+	if err := tx.SoftDelete(); err != ErrTransactionDeleted {
+		t.Errorf("SoftDelete() on deleted transaction error = %v, want %v", err, ErrTransactionDeleted)
+	}
+
+	if err := tx.Restore(); err != nil {
+		t.Fatalf("Restore() returned unexpected error: %v", err)
+	}
+	if tx.IsDeleted() {
+		t.Error("Expected transaction not to be deleted after Restore()")
+	}
+}
+
+func TestTransaction_RestoreWindowExpired(t *testing.T) {
+	tx := NewTransaction(10, "Coffee", time.Now(), TransactionTypeExpense, "cat-1", "wallet-1")
+	tx.DeletedAt = time.Now().Add(-TransactionRestoreWindow - time.Hour)
+
+	if err := tx.Restore(); err != ErrRestoreWindowExpired {
+		t.Errorf("Restore() error = %v, want %v", err, ErrRestoreWindowExpired)
+	}
+	if !tx.IsDeleted() {
+		t.Error("Expected transaction to remain deleted after expired Restore()")
+	}
+}
+
+func TestTransaction_BalanceEffects(t *testing.T) {
+	income := NewTransaction(100, "Salary", time.Now(), TransactionTypeIncome, "cat-1", "wallet-1")
+	if got := income.BalanceEffects()["wallet-1"]; got != 100 {
+		t.Errorf("income effect = %v, want 100", got)
+	}
+
+	expense := NewTransaction(30, "Groceries", time.Now(), TransactionTypeExpense, "cat-2", "wallet-1")
+	if got := expense.BalanceEffects()["wallet-1"]; got != -30 {
+		t.Errorf("expense effect = %v, want -30", got)
+	}
+
+	transfer := NewTransaction(50, "Savings", time.Now(), TransactionTypeTransfer, "cat-3", "wallet-1")
+	if err := transfer.SetDestinationWallet("wallet-2", 0.5); err != nil {
+		t.Fatalf("SetDestinationWallet() returned unexpected error: %v", err)
+	}
+	effects := transfer.BalanceEffects()
+	if effects["wallet-1"] != -50 || effects["wallet-2"] != 25 {
+		t.Errorf("transfer effects = %v, want wallet-1: -50, wallet-2: 25", effects)
+	}
+
+	expense.Fee = 0.5
+	if got := expense.BalanceEffects()["wallet-1"]; got != -30.5 {
+		t.Errorf("expense with fee effect = %v, want -30.5", got)
+	}
+
+	expense.MarkAsFailed()
+	if effects := expense.BalanceEffects(); len(effects) != 0 {
+		t.Errorf("failed transaction effects = %v, want none", effects)
+	}
+
+	income.DeletedAt = time.Now()
+	if effects := income.BalanceEffects(); len(effects) != 0 {
+		t.Errorf("deleted transaction effects = %v, want none", effects)
+	}
+}
+
+func TestTransaction_MergeTags(t *testing.T) {
+	tx := NewTransaction(10, "Coffee", time.Now(), TransactionTypeExpense, "cat-1", "wallet-1")
+	tx.Tags = []string{"food", "cafe"}
+
+	tx.MergeTags([]string{"cafe", "", "work", "work"})
+
+	want := []string{"food", "cafe", "work"}
+	if len(tx.Tags) != len(want) {
+		t.Fatalf("Tags = %v, want %v", tx.Tags, want)
+	}
+	for i := range want {
+		if tx.Tags[i] != want[i] {
+			t.Errorf("Tags = %v, want %v", tx.Tags, want)
+			break
+		}
+	}
+}
+
+func TestTransaction_MergeNotesAndMetadata(t *testing.T) {
+	tx := NewTransaction(10, "Coffee", time.Now(), TransactionTypeExpense, "cat-1", "wallet-1")
+	tx.Notes = "paid by card"
+	tx.Metadata = map[string]string{"bank": "enable"}
+
+	tx.MergeNotes("refund expected")
+	tx.MergeNotes("paid by card")
+	if tx.Notes != "paid by card\n\nrefund expected" {
+		t.Errorf("Notes = %q", tx.Notes)
+	}
+
+	tx.MergeMetadata(map[string]string{"bank": "other", "raw_id": "42"})
+	if tx.Metadata["bank"] != "enable" || tx.Metadata["raw_id"] != "42" {
+		t.Errorf("Metadata = %v, want existing keys kept and new keys added", tx.Metadata)
+	}
+
+	empty := NewTransaction(10, "Coffee", time.Now(), TransactionTypeExpense, "cat-1", "wallet-1")
+	empty.MergeMetadata(map[string]string{"source": "csv"})
+	if empty.Metadata["source"] != "csv" {
+		t.Errorf("Metadata = %v, want source set on nil map", empty.Metadata)
+	}
+}
+
+func TestTransaction_MarkSandbox(t *testing.T) {
+	tx := NewTransaction(10, "Coffee", time.Now(), TransactionTypeExpense, "cat-1", "wallet-1")
+	if tx.IsSandbox() {
+		t.Fatal("IsSandbox() = true for a new transaction")
+	}
+
+	tx.MarkSandbox()
+	if !tx.IsSandbox() || tx.Metadata[MetadataSandbox] != "true" {
+		t.Errorf("Metadata = %v, want the sandbox marker", tx.Metadata)
+	}
+}
+
+func TestTransaction_NormalizerVersion(t *testing.T) {
+	tx := NewTransaction(10, "Coffee", time.Now(), TransactionTypeExpense, "cat-1", "wallet-1")
+	if got := tx.NormalizerVersion(); got != 0 {
+		t.Fatalf("NormalizerVersion() = %d for an untagged transaction, want 0", got)
+	}
+
+	tx.SetNormalizerVersion(3)
+	if got := tx.NormalizerVersion(); got != 3 || tx.Metadata[MetadataNormalizer] != "3" {
+		t.Errorf("NormalizerVersion() = %d, metadata %v, want 3", got, tx.Metadata)
+	}
+}
+
+func TestTransaction_ValidateReportsEveryField(t *testing.T) {
+	tx := &Transaction{Type: TransactionTypeTransfer, Amount: -5, Date: time.Now().Add(time.Hour), WalletID: "w1", DestWalletID: "w1"}
+
+	err := tx.Validate()
+	if !errors.Is(err, ErrInvalidAmount) || !errors.Is(err, ErrSameWallet) {
+		t.Fatalf("Validate() error = %v, want the amount and wallet failures", err)
+	}
+
+	var fields []string
+	for _, fieldErr := range validation.FieldErrors(err) {
+		fields = append(fields, fieldErr.Field+"/"+fieldErr.Rule)
+	}
+	want := []string{"amount/positive", "date/past", "categoryId/required", "destWalletId/distinct"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("field errors = %v, want %v", fields, want)
+	}
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+	"github.com/google/uuid"
+)
+
+// TransformationRule is a user-defined script that rewrites incoming transactions,
+// e.g. "if description contains X then set category Y and tag Z".
+type TransformationRule struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Source    string    `json:"source"` // source the rule applies to; empty applies to all sources
+	Script    string    `json:"script"`
+	Priority  int       `json:"priority"` // lower priorities run first
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewTransformationRule creates a new enabled rule
+func NewTransformationRule(name, source, script string, priority int) *TransformationRule {
+	return &TransformationRule{
+		ID:        uuid.New().String(),
+		Name:      name,
+		Source:    source,
+		Script:    script,
+		Priority:  priority,
+		Enabled:   true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Validate checks if the rule is valid. Script syntax is checked by the scripting engine.
+func (r *TransformationRule) Validate() error {
+	v := validation.New()
+	v.Required("name", r.Name, ErrMissingRuleName)
+	v.Required("script", r.Script, ErrMissingRuleScript)
+	return v.Err()
+}
+
+// AppliesTo reports whether the rule should run for transactions from the given source
+func (r *TransformationRule) AppliesTo(source string) bool {
+	return r.Enabled && (r.Source == "" || strings.EqualFold(r.Source, source))
+}
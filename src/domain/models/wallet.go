@@ -3,13 +3,15 @@ package models
 import (
 	"time"
 
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
 	"github.com/google/uuid"
 )
 
 // BalanceInfo holds balance and currency information.
 type BalanceInfo struct {
-	Amount   float64 `json:"amount"`
-	Currency string  `json:"currency"`
+	Amount      float64     `json:"amount"`
+	Currency    string      `json:"currency"`
+	BalanceType BalanceType `json:"balanceType,omitempty"` // set by providers that report several balance types
 }
 
 // WalletType defines the type of wallet
@@ -18,24 +20,29 @@ type WalletType string
 const (
 	// WalletTypeBank represents a bank account
 	WalletTypeBank WalletType = "bank"
-	
+
 	// WalletTypeCrypto represents a cryptocurrency wallet
 	WalletTypeCrypto WalletType = "crypto"
-	
+
 	// WalletTypeCash represents a cash wallet
 	WalletTypeCash WalletType = "cash"
 )
 
 // Wallet represents a financial wallet in the system
 type Wallet struct {
-	ID          string     `json:"id"`
-	Name        string     `json:"name"`
-	Description string     `json:"description"`
-	Balance     float64    `json:"balance"`
-	Currency    string     `json:"currency"`
-	Type        WalletType `json:"type"`
-	CreatedAt   time.Time  `json:"createdAt"`
-	UpdatedAt   time.Time  `json:"updatedAt"`
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description"`
+	Balance        float64    `json:"balance"`
+	OpeningBalance float64    `json:"openingBalance"` // balance before any transaction, which replays start from
+	Currency       string     `json:"currency"`
+	Type           WalletType `json:"type"`
+	SpaceID        string     `json:"spaceId,omitempty"` // owning space, empty when shared with every user
+	Archived       bool       `json:"archived"`
+	ArchivedAt     time.Time  `json:"archivedAt,omitempty"`
+	Version        int        `json:"version"` // optimistic concurrency version, bumped on every update
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
 }
 
 // NewWallet creates a new wallet with defaults
@@ -54,17 +61,31 @@ func NewWallet(name, description string, currency string, walletType WalletType)
 
 // Validate checks if the wallet is valid
 func (w *Wallet) Validate() error {
-	// Name is required
-	if w.Name == "" {
-		return ErrMissingWalletName
+	v := validation.New()
+	v.Required("name", w.Name, ErrMissingWalletName)
+	v.Required("currency", w.Currency, ErrMissingCurrency)
+	return v.Err()
+}
+
+// Archive marks the wallet as archived. Archived wallets are hidden from
+// pickers and excluded from net worth by default, but keep their history.
+func (w *Wallet) Archive() {
+	if w.Archived {
+		return
 	}
-	
-	// Currency is required
-	if w.Currency == "" {
-		return ErrMissingCurrency
+	w.Archived = true
+	w.ArchivedAt = time.Now()
+	w.UpdatedAt = time.Now()
+}
+
+// Unarchive restores an archived wallet to active use
+func (w *Wallet) Unarchive() {
+	if !w.Archived {
+		return
 	}
-	
-	return nil
+	w.Archived = false
+	w.ArchivedAt = time.Time{}
+	w.UpdatedAt = time.Now()
 }
 
 // UpdateBalance updates the wallet balance
@@ -88,7 +109,7 @@ func (w *Wallet) ProcessExpense(amount float64) error {
 	if !w.HasSufficientBalance(amount) {
 		return ErrInsufficientBalance
 	}
-	
+
 	w.UpdateBalance(-amount)
 	return nil
 }
@@ -104,6 +125,6 @@ func (w *Wallet) ProcessTransferIn(amount float64, exchangeRate float64) {
 	if exchangeRate > 0 {
 		amount *= exchangeRate
 	}
-	
+
 	w.ProcessIncome(amount)
 }
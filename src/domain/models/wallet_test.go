@@ -1,6 +1,7 @@
 package models
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -90,8 +91,8 @@ func TestWallet_Validate(t *testing.T) {
 				return
 			}
 
-			if tt.expectErr && err != tt.errType {
-				t.Errorf("Validate() error type = %T, want %T (%v)", err, tt.errType, tt.errType)
+			if tt.expectErr && !errors.Is(err, tt.errType) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.errType)
 			}
 		})
 	}
@@ -284,3 +285,29 @@ func TestWallet_ProcessTransferIn(t *testing.T) {
 		}
 	})
 }
+
+func TestWallet_Archive(t *testing.T) {
+	wallet := NewWallet("Test", "", "USD", WalletTypeBank)
+
+	wallet.Archive()
+	if !wallet.Archived {
+		t.Error("Expected wallet to be archived after Archive()")
+	}
+	if wallet.ArchivedAt.IsZero() {
+		t.Error("Expected ArchivedAt to be set after Archive()")
+	}
+
+	archivedAt := wallet.ArchivedAt
+	wallet.Archive()
+	if !wallet.ArchivedAt.Equal(archivedAt) {
+		t.Error("Expected Archive() on an archived wallet to keep the original ArchivedAt")
+	}
+
+	wallet.Unarchive()
+	if wallet.Archived {
+		t.Error("Expected wallet to be active after Unarchive()")
+	}
+	if !wallet.ArchivedAt.IsZero() {
+		t.Errorf("Expected ArchivedAt to be cleared after Unarchive(), got %v", wallet.ArchivedAt)
+	}
+}
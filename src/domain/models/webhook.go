@@ -0,0 +1,158 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// WebhookSignatureHeader carries the HMAC-SHA256 signature of a delivery, see SignWebhook
+	WebhookSignatureHeader = "X-Firedragon-Signature"
+	// WebhookTimestampHeader carries the Unix time the delivery was signed at
+	WebhookTimestampHeader = "X-Firedragon-Timestamp"
+	// WebhookEventHeader carries the type of the delivered event
+	WebhookEventHeader = "X-Firedragon-Event"
+	// WebhookDeliveryHeader carries the delivery ID, the same for every attempt
+	WebhookDeliveryHeader = "X-Firedragon-Delivery"
+)
+
+const (
+	// webhookFirstRetry is the wait after the first failed delivery, doubled for each next one
+	webhookFirstRetry = 30 * time.Second
+
+	// webhookMaxRetry caps the wait between deliveries
+	webhookMaxRetry = 6 * time.Hour
+)
+
+// Webhook is an endpoint events are posted to. Events is a list of event
+// types, where "transaction.*" matches every type below transaction and
+// "*" every type.
+type Webhook struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Secret      string    `json:"-"` // HMAC key of the signatures
+	Events      []string  `json:"events"`
+	Enabled     bool      `json:"enabled"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// Matches reports whether the webhook receives events of the given type
+func (w *Webhook) Matches(eventType string) bool {
+	for _, pattern := range w.Events {
+		switch {
+		case pattern == "*", pattern == eventType:
+			return true
+		case strings.HasSuffix(pattern, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(pattern, "*")):
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus is the delivery state of an event posted to a webhook
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed" // gave up after the maximum attempts
+)
+
+// WebhookDelivery is an event queued for a webhook. It is stored in the same
+// database transaction as the change the event describes and posted at least
+// once; receivers drop repeated deliveries by their ID.
+type WebhookDelivery struct {
+	ID             string                `json:"id"`
+	WebhookID      string                `json:"webhookId"`
+	EventID        string                `json:"eventId"`
+	EventType      string                `json:"eventType"`
+	Payload        []byte                `json:"-"` // the encoded event envelope
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	ResponseStatus int                   `json:"responseStatus,omitempty"` // HTTP status of the last attempt
+	LastError      string                `json:"lastError,omitempty"`
+	NextAttemptAt  time.Time             `json:"nextAttemptAt"`
+	DeliveredAt    time.Time             `json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time             `json:"createdAt"`
+}
+
+// Deliver marks the delivery as accepted by the receiver
+func (d *WebhookDelivery) Deliver(status int, at time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryDelivered
+	d.ResponseStatus = status
+	d.LastError = ""
+	d.DeliveredAt = at
+}
+
+// Fail records a failed attempt and schedules the next one with an
+// exponential backoff, or gives up after maxAttempts (zero retries forever).
+// status is the HTTP status of the response, zero when none was received.
+func (d *WebhookDelivery) Fail(err error, status int, now time.Time, maxAttempts int) {
+	d.Attempts++
+	d.ResponseStatus = status
+	d.LastError = err.Error()
+	if maxAttempts > 0 && d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryFailed
+		return
+	}
+	d.NextAttemptAt = now.Add(WebhookBackoff(d.Attempts))
+}
+
+// Cancel gives the delivery up without an attempt, e.g. once its webhook was disabled
+func (d *WebhookDelivery) Cancel(reason string) {
+	d.Status = WebhookDeliveryFailed
+	d.LastError = reason
+}
+
+// Redeliver queues the delivery again for an immediate attempt, keeping its
+// ID so receivers still recognize it
+func (d *WebhookDelivery) Redeliver(now time.Time) {
+	d.Status = WebhookDeliveryPending
+	d.NextAttemptAt = now
+	d.DeliveredAt = time.Time{}
+}
+
+// WebhookBackoff returns the wait after the given number of consecutive
+// failures: 30s, 1m, 2m, ... up to six hours
+func WebhookBackoff(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+
+	wait := webhookFirstRetry
+	for i := 1; i < failures && wait < webhookMaxRetry; i++ {
+		wait *= 2
+	}
+	return min(wait, webhookMaxRetry)
+}
+
+// SignWebhook returns the signature of a payload posted at the given time:
+// "sha256=" followed by the hex HMAC-SHA256 of "<unix time>.<payload>" keyed
+// with the webhook secret. Signing the time lets receivers reject replays.
+func SignWebhook(secret string, at time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(at.Unix(), 10) + "."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhook reports whether a signature was made with the secret for the
+// payload posted at the given time
+func VerifyWebhook(secret string, at time.Time, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(SignWebhook(secret, at, payload)), []byte(signature))
+}
+
+// WebhookDeliveryReport summarizes one run of the delivery queue
+type WebhookDeliveryReport struct {
+	Delivered int      `json:"delivered"`
+	Failed    int      `json:"failed"` // attempts that failed and are retried
+	GaveUp    int      `json:"gaveUp"` // deliveries that reached the maximum attempts
+	Errors    []string `json:"errors,omitempty"`
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWebhook_Matches(t *testing.T) {
+	webhook := &Webhook{Events: []string{"transaction.*", "budget.threshold"}}
+
+	tests := []struct {
+		eventType string
+		want      bool
+	}{
+		{"transaction.created", true},
+		{"transaction.deleted", true},
+		{"budget.threshold", true},
+		{"budget.other", false},
+		{"transactions.created", false},
+		{"wallet.balance_changed", false},
+	}
+	for _, tt := range tests {
+		if got := webhook.Matches(tt.eventType); got != tt.want {
+			t.Errorf("Matches(%q) = %v, want %v", tt.eventType, got, tt.want)
+		}
+	}
+
+	if all := (&Webhook{Events: []string{"*"}}); !all.Matches("incident.opened") {
+		t.Error("* does not match every event")
+	}
+	if none := (&Webhook{}); none.Matches("incident.opened") {
+		t.Error("a webhook without events matches")
+	}
+}
+
+func TestWebhookDeliveryLifecycle(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	delivery := &WebhookDelivery{Status: WebhookDeliveryPending, NextAttemptAt: now}
+
+	delivery.Fail(errors.New("status 500"), 500, now, 3)
+	delivery.Fail(errors.New("status 502"), 502, now, 3)
+	if delivery.Status != WebhookDeliveryPending || delivery.Attempts != 2 || !delivery.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("after two failures = %+v, want pending and retried a minute later", delivery)
+	}
+
+	delivery.Fail(errors.New("connection refused"), 0, now, 3)
+	if delivery.Status != WebhookDeliveryFailed || delivery.ResponseStatus != 0 {
+		t.Errorf("after the maximum attempts = %+v, want failed", delivery)
+	}
+
+	delivery.Redeliver(now)
+	if delivery.Status != WebhookDeliveryPending || !delivery.NextAttemptAt.Equal(now) {
+		t.Errorf("redelivered = %+v, want pending and due now", delivery)
+	}
+
+	delivery.Deliver(204, now)
+	if delivery.Status != WebhookDeliveryDelivered || delivery.Attempts != 4 || delivery.LastError != "" {
+		t.Errorf("delivered = %+v, want delivered after four attempts without error", delivery)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{1, 30 * time.Second},
+		{3, 2 * time.Minute},
+		{11, 6 * time.Hour},
+		{50, 6 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := WebhookBackoff(tt.failures); got != tt.want {
+			t.Errorf("WebhookBackoff(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestSignWebhook(t *testing.T) {
+	at := time.Unix(1740830400, 0)
+	payload := []byte(`{"type":"transaction.created"}`)
+
+	signature := SignWebhook("secret", at, payload)
+	// echo -n '1740830400.{"type":"transaction.created"}' | openssl dgst -sha256 -hmac secret
+	if want := "sha256=0bbbdee976acb2abde3550e482c43914ed3e5cac61f73e427f887f7aac3d93e8"; signature != want {
+		t.Fatalf("SignWebhook() = %q, want %q", signature, want)
+	}
+	if !VerifyWebhook("secret", at, payload, signature) {
+		t.Error("VerifyWebhook() rejects its own signature")
+	}
+	if VerifyWebhook("other", at, payload, signature) || VerifyWebhook("secret", at.Add(time.Second), payload, signature) {
+		t.Error("VerifyWebhook() accepts another secret or time")
+	}
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// AccountMappingRepository defines the interface for Firefly account mapping data access
+type AccountMappingRepository interface {
+	// FindBySourceAccount finds the mapping for a source account
+	FindBySourceAccount(ctx context.Context, source, sourceAccount string) (*models.AccountMapping, error)
+
+	// FindAll finds mappings, optionally restricted to a source
+	FindAll(ctx context.Context, source string) ([]*models.AccountMapping, error)
+
+	// Create creates a new mapping
+	Create(ctx context.Context, mapping *models.AccountMapping) error
+
+	// Update updates an existing mapping
+	Update(ctx context.Context, mapping *models.AccountMapping) error
+
+	// Delete deletes a mapping by ID
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// AuditRepository defines the interface for the append-only audit log
+type AuditRepository interface {
+	// Append stores a new audit entry
+	Append(ctx context.Context, entry *models.AuditEntry) error
+
+	// FindAll finds audit entries with optional filters, newest first
+	FindAll(ctx context.Context, filter AuditFilter) ([]*models.AuditEntry, error)
+
+	// PurgeBefore deletes the entries recorded before the given time and
+	// returns how many were deleted
+	PurgeBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// AuditFilter defines filters for finding audit entries
+type AuditFilter struct {
+	Collection string
+	RecordID   string
+	ActorID    string
+	Action     models.AuditAction
+	Source     models.AuditSource
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
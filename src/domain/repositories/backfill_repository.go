@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// BackfillRepository defines the interface for the backfill checkpoint store
+type BackfillRepository interface {
+	// FindBySource returns the backfill of a source, or models.ErrBackfillNotFound
+	FindBySource(ctx context.Context, sourceID string) (*models.Backfill, error)
+
+	// FindAll returns every backfill, most recently updated first
+	FindAll(ctx context.Context) ([]*models.Backfill, error)
+
+	// Save stores a backfill, replacing the previous checkpoint of its source
+	Save(ctx context.Context, backfill *models.Backfill) error
+}
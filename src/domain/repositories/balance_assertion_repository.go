@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// BalanceAssertionRepository defines the interface for balance assertion data access
+type BalanceAssertionRepository interface {
+	// FindByID finds a balance assertion by ID
+	FindByID(ctx context.Context, id string) (*models.BalanceAssertion, error)
+
+	// FindAll finds all balance assertions, by name
+	FindAll(ctx context.Context) ([]*models.BalanceAssertion, error)
+
+	// Create stores a new balance assertion
+	Create(ctx context.Context, assertion *models.BalanceAssertion) error
+
+	// Update stores the changes of a balance assertion
+	Update(ctx context.Context, assertion *models.BalanceAssertion) error
+
+	// Delete deletes a balance assertion and its results
+	Delete(ctx context.Context, id string) error
+
+	// CreateResult stores the result of a check
+	CreateResult(ctx context.Context, result *models.BalanceAssertionResult) error
+
+	// FindResults finds check results with optional filters, most recent first
+	FindResults(ctx context.Context, filter BalanceAssertionResultFilter) ([]*models.BalanceAssertionResult, error)
+}
+
+// BalanceAssertionResultFilter defines filters for finding check results
+type BalanceAssertionResultFilter struct {
+	AssertionID string
+	OnlyFailed  bool
+	Limit       int
+}
@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// BalanceSnapshotRepository defines the interface for balance history data access
+type BalanceSnapshotRepository interface {
+	// Create stores a new balance snapshot
+	Create(ctx context.Context, snapshot *models.BalanceSnapshot) error
+
+	// FindAll finds snapshots with optional filters, ordered by time ascending
+	FindAll(ctx context.Context, filter BalanceSnapshotFilter) ([]*models.BalanceSnapshot, error)
+
+	// FindLatest finds the most recent snapshot matching the filter, taken at or before filter.To.
+	// Limit is ignored.
+	FindLatest(ctx context.Context, filter BalanceSnapshotFilter) (*models.BalanceSnapshot, error)
+}
+
+// BalanceSnapshotFilter defines filters for finding balance snapshots
+type BalanceSnapshotFilter struct {
+	WalletID string
+	Source   string
+	// BalanceType selects provider snapshots of one balance type
+	BalanceType models.BalanceType
+	From        time.Time
+	To          time.Time
+	Limit       int
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// CalendarFeedRepository defines the interface for calendar feed data access
+type CalendarFeedRepository interface {
+	// FindByTokenHash finds the feed of a token hash.
+	// It returns models.ErrCalendarFeedNotFound if no feed has it.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*models.CalendarFeed, error)
+
+	// Save stores the feed of a user, replacing the token of an existing one
+	Save(ctx context.Context, feed *models.CalendarFeed) error
+
+	// DeleteByUser deletes the feed of a user.
+	// It returns models.ErrCalendarFeedNotFound if the user has none.
+	DeleteByUser(ctx context.Context, userID string) error
+}
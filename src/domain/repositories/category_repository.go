@@ -32,9 +32,13 @@ type CategoryRepository interface {
 
 // CategoryFilter defines filters for finding categories
 type CategoryFilter struct {
+	IDs        []string // only these categories
 	Type       models.CategoryType
 	NameLike   string
 	IsSystem   *bool
+	SpaceID    string // only categories owned by this space
+	VisibleTo  string // only shared categories and those of this user's spaces
+	Key        string // only the category installed from a pack under this key
 	Limit      int
 	Offset     int
 	SortBy     string
@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// DataKeyRepository defines the interface for the data keys encrypting
+// sensitive fields. Keys are stored wrapped with the master key; callers
+// only ever see unwrapped keys.
+type DataKeyRepository interface {
+	// FindByID finds a data key by ID, or returns models.ErrDataKeyNotFound
+	FindByID(ctx context.Context, id string) (*models.DataKey, error)
+
+	// FindActive finds the active data key of a space ("" for records outside
+	// any space), or returns models.ErrDataKeyNotFound
+	FindActive(ctx context.Context, spaceID string) (*models.DataKey, error)
+
+	// FindAll finds every data key, active or not
+	FindAll(ctx context.Context) ([]*models.DataKey, error)
+
+	// Create stores a new active data key and deactivates the previous active
+	// key of its space
+	Create(ctx context.Context, key *models.DataKey) error
+
+	// Rewrap wraps every data key with a new master key and returns how many
+	// keys were rewrapped
+	Rewrap(ctx context.Context, masterKey string) (int, error)
+}
+
+// FieldCipher encrypts and decrypts the sensitive fields of records with the
+// data key of the space owning them
+type FieldCipher interface {
+	// EncryptField encrypts value with the active data key of a space ("" for
+	// records outside any space)
+	EncryptField(ctx context.Context, spaceID, value string) (string, error)
+
+	// DecryptField decrypts a value; plaintext values are returned unchanged
+	DecryptField(ctx context.Context, value string) (string, error)
+}
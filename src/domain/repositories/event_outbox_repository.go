@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// EventOutboxRepository defines the interface for the outbox of domain events
+// waiting to be published. Events are added by the hooks of the changes they
+// describe, inside the same database transaction.
+type EventOutboxRepository interface {
+	// FindUnpublished returns events not published yet, in the order they were stored
+	FindUnpublished(ctx context.Context, limit int) ([]*models.OutboxEvent, error)
+
+	// MarkPublished records that an event has been published
+	MarkPublished(ctx context.Context, id string, at time.Time) error
+
+	// MarkFailed records a failed publish attempt
+	MarkFailed(ctx context.Context, id string, cause error) error
+
+	// PurgePublished deletes the events published before the given time
+	PurgePublished(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// FireflyOutboxRepository defines the interface for the durable queue of
+// transactions waiting to be booked in Firefly III
+type FireflyOutboxRepository interface {
+	// Enqueue stores new entries. Transactions that are already queued are skipped.
+	Enqueue(ctx context.Context, entries []*models.FireflyOutboxEntry) (int, error)
+
+	// FindDue returns pending entries whose next attempt is due, oldest first
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*models.FireflyOutboxEntry, error)
+
+	// Update stores the delivery state of an entry
+	Update(ctx context.Context, entry *models.FireflyOutboxEntry) error
+
+	// CountPending returns the number of entries not delivered yet
+	CountPending(ctx context.Context) (int, error)
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// ImportRunRepository defines the interface for import cycle report data access
+type ImportRunRepository interface {
+	// Create stores the report of a finished import cycle
+	Create(ctx context.Context, report *models.ImportCycleReport) error
+
+	// FindRecent returns the most recent reports, newest first
+	FindRecent(ctx context.Context, limit int) ([]*models.ImportCycleReport, error)
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// IncidentRepository defines the interface for provider incident data access
+type IncidentRepository interface {
+	// Create stores a new incident
+	Create(ctx context.Context, incident *models.Incident) error
+
+	// Update stores the failure count, last error and end of an incident
+	Update(ctx context.Context, incident *models.Incident) error
+
+	// FindAll finds incidents with optional filters, most recent first
+	FindAll(ctx context.Context, filter IncidentFilter) ([]*models.Incident, error)
+}
+
+// IncidentFilter defines filters for finding incidents
+type IncidentFilter struct {
+	Provider string
+	OnlyOpen bool
+	From     time.Time // incidents still open at or started after
+	To       time.Time // incidents started at or before
+	Limit    int
+}
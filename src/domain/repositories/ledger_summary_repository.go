@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// LedgerSummaryRepository defines the interface for the compacted summaries
+// of the provider IDs of purged transactions
+type LedgerSummaryRepository interface {
+	// CreateMany stores the summaries of a compaction run
+	CreateMany(ctx context.Context, summaries []*models.LedgerSummary) error
+
+	// FindByWallet returns the summaries of a wallet
+	FindByWallet(ctx context.Context, walletID string) ([]*models.LedgerSummary, error)
+
+	// Stats returns how many summaries are stored and how many IDs they hold
+	Stats(ctx context.Context) (summaries, ids int, err error)
+}
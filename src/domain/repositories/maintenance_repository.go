@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// MaintenanceRepository defines the interface for maintenance switch data access
+type MaintenanceRepository interface {
+	// FindAll finds the maintenance switches that are on
+	FindAll(ctx context.Context) ([]*models.Maintenance, error)
+
+	// Save turns on the maintenance switch of its space, replacing the reason
+	// when it is already on
+	Save(ctx context.Context, maintenance *models.Maintenance) error
+
+	// Delete turns off the maintenance switch of a space, the global one for
+	// an empty space ID. It returns false when the switch was off.
+	Delete(ctx context.Context, spaceID string) (bool, error)
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// PreferencesRepository defines the interface for user preferences data access
+type PreferencesRepository interface {
+	// FindByUser finds the preferences of a user.
+	// It returns models.ErrPreferencesNotFound if the user has not stored any.
+	FindByUser(ctx context.Context, userID string) (*models.Preferences, error)
+
+	// Save stores the preferences of a user, creating them on first save.
+	// It returns models.ErrConflict if they changed since they were read.
+	Save(ctx context.Context, preferences *models.Preferences) error
+}
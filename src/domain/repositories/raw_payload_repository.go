@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// RawPayloadRepository defines the interface for the archive of raw provider payloads
+type RawPayloadRepository interface {
+	// CreateMany stores the payloads of an import run
+	CreateMany(ctx context.Context, payloads []*models.RawPayload) error
+
+	// FindByRun returns the payloads archived for a source in an import run,
+	// in the order they were received
+	FindByRun(ctx context.Context, runID, sourceID string) ([]*models.RawPayload, error)
+
+	// FindRuns returns the archived runs of a source, or of every source when
+	// sourceID is empty, newest first
+	FindRuns(ctx context.Context, sourceID string, limit int) ([]models.PayloadRun, error)
+
+	// PurgeBefore deletes the payloads received before the given time and
+	// returns how many were deleted
+	PurgeBefore(ctx context.Context, before time.Time) (int, error)
+}
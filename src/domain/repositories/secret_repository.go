@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+)
+
+// SecretRepository defines the interface for the encrypted secrets store.
+// Values are encrypted at rest; callers only ever see plaintext.
+type SecretRepository interface {
+	// Get returns the decrypted secret stored under name, or models.ErrSecretNotFound
+	Get(ctx context.Context, name string) ([]byte, error)
+
+	// Put encrypts and stores a secret, replacing any previous value
+	Put(ctx context.Context, name string, value []byte) error
+
+	// Delete removes a secret. Deleting a missing secret is not an error.
+	Delete(ctx context.Context, name string) error
+}
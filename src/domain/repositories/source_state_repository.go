@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// SourceStateRepository defines the interface for the source sync state store
+type SourceStateRepository interface {
+	// FindAll returns the state of every source that has synced
+	FindAll(ctx context.Context) ([]*models.SourceState, error)
+
+	// Save stores a source state, replacing the previous state of its source
+	Save(ctx context.Context, state *models.SourceState) error
+}
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// SpaceRepository defines the interface for space and membership data access
+type SpaceRepository interface {
+	// FindByID finds a space by ID
+	FindByID(ctx context.Context, id string) (*models.Space, error)
+
+	// FindAll finds spaces with optional filters, by name
+	FindAll(ctx context.Context, filter SpaceFilter) ([]*models.Space, error)
+
+	// Create creates a new space
+	Create(ctx context.Context, space *models.Space) error
+
+	// FindMembers finds the members of a space
+	FindMembers(ctx context.Context, spaceID string) ([]*models.SpaceMember, error)
+
+	// FindMember finds the membership of a user in a space
+	FindMember(ctx context.Context, spaceID, userID string) (*models.SpaceMember, error)
+
+	// SaveMember stores a membership, replacing the role of an existing member
+	SaveMember(ctx context.Context, member *models.SpaceMember) error
+
+	// DeleteMember removes a user from a space
+	DeleteMember(ctx context.Context, spaceID, userID string) error
+}
+
+// SpaceFilter defines filters for finding spaces
+type SpaceFilter struct {
+	UserID string // only spaces the user is a member of
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// SubscriptionRepository defines the interface for detected subscription data access
+type SubscriptionRepository interface {
+	// FindAll finds subscriptions with optional filters, most expensive first
+	FindAll(ctx context.Context, filter SubscriptionFilter) ([]*models.Subscription, error)
+
+	// Save stores a subscription, replacing the one of the same wallet and merchant
+	Save(ctx context.Context, subscription *models.Subscription) error
+}
+
+// SubscriptionFilter defines filters for finding subscriptions
+type SubscriptionFilter struct {
+	WalletID  string
+	VisibleTo string // only subscriptions of wallets this user can see
+	Status    models.SubscriptionStatus
+}
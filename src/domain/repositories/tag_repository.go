@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// TagRepository defines the interface for tag data access
+type TagRepository interface {
+	// FindByID finds a tag by ID
+	FindByID(ctx context.Context, id string) (*models.Tag, error)
+
+	// FindByName finds a tag by name (case-insensitive)
+	FindByName(ctx context.Context, name string) (*models.Tag, error)
+
+	// FindAll finds all tags with optional filters
+	FindAll(ctx context.Context, filter TagFilter) ([]*models.Tag, error)
+
+	// Create creates a new tag
+	Create(ctx context.Context, tag *models.Tag) error
+
+	// Update updates the color and description of a tag. Use Rename to change its name.
+	Update(ctx context.Context, tag *models.Tag) error
+
+	// Delete deletes a tag by ID and removes it from all transactions
+	Delete(ctx context.Context, id string) (int, error)
+
+	// Rename renames a tag and every transaction reference to it in one DB transaction.
+	// It returns the number of transactions updated.
+	Rename(ctx context.Context, id, name string) (int, error)
+
+	// Merge replaces the source tag with the target tag on every transaction and
+	// deletes the source tag, in one DB transaction. It returns the number of
+	// transactions updated.
+	Merge(ctx context.Context, sourceID, targetID string) (int, error)
+
+	// Wallets returns the wallets, sources and destinations, of the
+	// transactions referencing a tag, which renaming, merging or deleting it rewrites
+	Wallets(ctx context.Context, id string) ([]string, error)
+
+	// Spend aggregates non-deleted transactions, except sandbox test data, per tag and wallet currency
+	Spend(ctx context.Context, filter TagSpendFilter) ([]*models.TagSpend, error)
+}
+
+// TagFilter defines filters for finding tags
+type TagFilter struct {
+	NameLike string
+	Limit    int
+	Offset   int
+}
+
+// TagSpendFilter defines the transactions included in a tag spend report
+type TagSpendFilter struct {
+	Tag       string // empty reports all tags
+	VisibleTo string // only transactions of wallets this user can see
+	DateFrom  time.Time
+	DateTo    time.Time
+}
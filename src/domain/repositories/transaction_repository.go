@@ -21,26 +21,69 @@ type TransactionRepository interface {
 	// Update updates an existing transaction
 	Update(ctx context.Context, transaction *models.Transaction) error
 
+	// CreateMany creates transactions in chunks, each chunk in a single DB transaction.
+	// It returns the number of transactions created; a failing chunk is rolled back.
+	CreateMany(ctx context.Context, transactions []*models.Transaction) (int, error)
+
+	// UpdateMany updates transactions in chunks, each chunk in a single DB transaction.
+	// A version mismatch fails its chunk with models.ErrConflict.
+	UpdateMany(ctx context.Context, transactions []*models.Transaction) (int, error)
+
 	// Delete deletes a transaction by ID
 	Delete(ctx context.Context, id string) error
 
-	// FindDuplicates finds potential duplicate transactions
-	FindDuplicates(ctx context.Context, transaction *models.Transaction, timeWindow time.Duration) ([]*models.Transaction, error)
+	// FindDuplicates finds potential duplicate transactions dated between from
+	// and to (inclusive) whose amount differs by less than tolerance
+	FindDuplicates(ctx context.Context, transaction *models.Transaction, from, to time.Time, tolerance float64) ([]*models.Transaction, error)
+
+	// SoftDelete marks a transaction as deleted so it can be restored later
+	SoftDelete(ctx context.Context, id string) error
+
+	// Restore clears the deleted marker of a soft-deleted transaction
+	Restore(ctx context.Context, id string) error
+
+	// PurgeDeleted permanently removes transactions soft-deleted before the given time
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+
+	// FindByFireflyID finds the transaction linked to a Firefly III transaction
+	FindByFireflyID(ctx context.Context, fireflyID string) (*models.Transaction, error)
+
+	// Merge saves keep and soft-deletes the dropped transactions in one DB transaction,
+	// reversing their balance effects and recording the merge in the transaction history.
+	// A version mismatch on any of the transactions fails the merge with models.ErrConflict.
+	Merge(ctx context.Context, keep *models.Transaction, dropped []*models.Transaction) error
+
+	// SetFireflyID links a transaction to a Firefly III transaction (empty unlinks it)
+	SetFireflyID(ctx context.Context, id, fireflyID string) error
+
+	// ReencryptFields encrypts the sensitive fields of every transaction again
+	// with the active data key of its space, e.g. after a key rotation, and
+	// returns how many transactions changed. It does nothing without a cipher.
+	ReencryptFields(ctx context.Context) (int, error)
 }
 
 // TransactionFilter defines filters for finding transactions
 type TransactionFilter struct {
-	WalletID     string
-	CategoryID   string
-	Type         models.TransactionType
-	DateFrom     time.Time
-	DateTo       time.Time
-	AmountMin    float64
-	AmountMax    float64
-	Description  string
-	Status       models.TransactionStatus
-	Limit        int
-	Offset       int
-	SortBy       string
-	SortOrder    string
-} 
\ No newline at end of file
+	WalletID       string
+	SpaceID        string // only transactions of wallets owned by this space
+	VisibleTo      string // only transactions of wallets this user can see: shared wallets and those of the user's spaces
+	CategoryID     string
+	Type           models.TransactionType
+	DateFrom       time.Time
+	DateTo         time.Time
+	AmountMin      float64
+	AmountMax      float64
+	Description    string
+	Notes          string            // substring match on notes
+	Metadata       map[string]string // exact match on every given metadata key
+	Status         models.TransactionStatus
+	IncludeDeleted bool      // include soft-deleted transactions (excluded by default)
+	OnlyDeleted    bool      // return only soft-deleted transactions (trash view)
+	DeletedBefore  time.Time // only transactions soft-deleted before this time
+	OnlyUnlinked   bool      // return only transactions not linked to Firefly III
+	ExcludeSandbox bool      // leave out the test data imported from provider sandboxes
+	Limit          int
+	Offset         int
+	SortBy         string
+	SortOrder      string
+}
@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// TransformationRuleRepository defines the interface for transformation rule data access
+type TransformationRuleRepository interface {
+	// FindByID finds a rule by ID
+	FindByID(ctx context.Context, id string) (*models.TransformationRule, error)
+
+	// FindAll finds rules with optional filters, ordered by priority
+	FindAll(ctx context.Context, filter TransformationRuleFilter) ([]*models.TransformationRule, error)
+
+	// Create creates a new rule
+	Create(ctx context.Context, rule *models.TransformationRule) error
+
+	// Update updates an existing rule
+	Update(ctx context.Context, rule *models.TransformationRule) error
+
+	// Delete deletes a rule by ID
+	Delete(ctx context.Context, id string) error
+}
+
+// TransformationRuleFilter defines filters for finding transformation rules
+type TransformationRuleFilter struct {
+	Source      string // matches rules for this source plus rules for all sources
+	OnlyEnabled bool
+}
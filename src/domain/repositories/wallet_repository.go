@@ -25,15 +25,31 @@ type WalletRepository interface {
 
 	// UpdateBalance updates a wallet balance
 	UpdateBalance(ctx context.Context, id string, amount float64) error
+
+	// Archive hides a wallet without deleting it or its transactions
+	Archive(ctx context.Context, id string) error
+
+	// Unarchive restores an archived wallet
+	Unarchive(ctx context.Context, id string) error
+
+	// ReplayBalances replays the transactions of a wallet (or of all wallets when
+	// walletID is empty) on its opening balance in a single DB transaction and
+	// compares the result with the stored balances. When fix is true, drifted
+	// balances are overwritten.
+	ReplayBalances(ctx context.Context, walletID string, fix bool) ([]*models.WalletBalanceCheck, error)
 }
 
 // WalletFilter defines filters for finding wallets
 type WalletFilter struct {
-	Type       models.WalletType
-	Currency   string
-	NameLike   string
-	Limit      int
-	Offset     int
-	SortBy     string
-	SortOrder  string
-} 
\ No newline at end of file
+	IDs             []string // only these wallets, e.g. to load the wallets of a page in one query
+	Type            models.WalletType
+	Currency        string
+	NameLike        string
+	SpaceID         string // only wallets owned by this space
+	VisibleTo       string // only shared wallets and those of this user's spaces
+	IncludeArchived bool   // include archived wallets (excluded by default)
+	Limit           int
+	Offset          int
+	SortBy          string
+	SortOrder       string
+}
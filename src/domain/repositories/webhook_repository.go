@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// WebhookRepository defines the interface for the registered outbound webhooks
+type WebhookRepository interface {
+	// FindByID returns a webhook, or models.ErrWebhookNotFound
+	FindByID(ctx context.Context, id string) (*models.Webhook, error)
+
+	// FindAll returns every registered webhook
+	FindAll(ctx context.Context) ([]*models.Webhook, error)
+}
+
+// WebhookDeliveryRepository defines the interface for the queue of events
+// waiting to be posted to webhooks. Deliveries are queued by the event hooks
+// in the transaction of the change.
+type WebhookDeliveryRepository interface {
+	// FindDue returns pending deliveries whose next attempt is due, oldest first
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error)
+
+	// FindByID returns a delivery, or models.ErrWebhookDeliveryNotFound
+	FindByID(ctx context.Context, id string) (*models.WebhookDelivery, error)
+
+	// FindByWebhook returns the deliveries of a webhook, newest first,
+	// optionally of one status
+	FindByWebhook(ctx context.Context, webhookID string, status models.WebhookDeliveryStatus, limit int) ([]*models.WebhookDelivery, error)
+
+	// Update stores the delivery state of a delivery
+	Update(ctx context.Context, delivery *models.WebhookDelivery) error
+
+	// CountPending returns the number of deliveries not delivered yet
+	CountPending(ctx context.Context) (int, error)
+
+	// PurgeDelivered deletes the deliveries delivered before the given time
+	// and returns how many were deleted
+	PurgeDelivered(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,317 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// AccountRef identifies an account of an import source together with the
+// hints used to find or create its Firefly counterpart
+type AccountRef struct {
+	Source   string `json:"source"`
+	Account  string `json:"account"` // wallet address or provider account ID
+	Name     string `json:"name"`    // display name used when auto-creating
+	IBAN     string `json:"iban,omitempty"`
+	Currency string `json:"currency,omitempty"`
+}
+
+func (r AccountRef) key() string {
+	return strings.ToLower(r.Source) + "|" + strings.ToLower(r.Account)
+}
+
+// AccountMappingService resolves source accounts to Firefly asset accounts.
+// Resolution order: configured mappings, stored mappings, IBAN match against
+// existing Firefly asset accounts, and finally auto-creation when enabled.
+// Resolved IDs are cached and discovered mappings are persisted.
+type AccountMappingService struct {
+	mappingRepo repositories.AccountMappingRepository
+	firefly     interfaces.FireflyClient
+	static      map[string]*models.AccountMapping
+	autoCreate  bool
+
+	mu     sync.RWMutex
+	cache  map[string]string
+	assets []interfaces.FireflyAccount // lazily loaded asset accounts for IBAN matching
+}
+
+// NewAccountMappingService creates a new AccountMappingService.
+// static mappings come from configuration and take precedence over stored ones.
+func NewAccountMappingService(
+	mappingRepo repositories.AccountMappingRepository,
+	firefly interfaces.FireflyClient,
+	static []*models.AccountMapping,
+	autoCreate bool,
+) *AccountMappingService {
+	s := &AccountMappingService{
+		mappingRepo: mappingRepo,
+		firefly:     firefly,
+		static:      make(map[string]*models.AccountMapping, len(static)),
+		autoCreate:  autoCreate,
+		cache:       make(map[string]string),
+	}
+
+	for _, mapping := range static {
+		s.static[AccountRef{Source: mapping.Source, Account: mapping.SourceAccount}.key()] = mapping
+	}
+
+	return s
+}
+
+// AccountMappingsFromConfig converts configured account mappings to domain mappings
+func AccountMappingsFromConfig(cfg internal.FireflyConfig) []*models.AccountMapping {
+	mappings := make([]*models.AccountMapping, 0, len(cfg.AccountMappings))
+	for _, m := range cfg.AccountMappings {
+		mapping := models.NewAccountMapping(m.Source, m.Account, m.FireflyAccountID)
+		mapping.IBAN = m.IBAN
+		mappings = append(mappings, mapping)
+	}
+	return mappings
+}
+
+// OpeningBalance is the balance a newly created Firefly account starts with
+type OpeningBalance struct {
+	Amount float64
+	Date   time.Time
+}
+
+// Resolve returns the Firefly account ID for a source account
+func (s *AccountMappingService) Resolve(ctx context.Context, ref AccountRef) (string, error) {
+	id, _, err := s.resolve(ctx, ref, nil)
+	return id, err
+}
+
+// EnsureAccount resolves the Firefly account for a source account, creating it
+// with the opening balance when none exists regardless of the auto-create setting.
+func (s *AccountMappingService) EnsureAccount(ctx context.Context, ref AccountRef, opening OpeningBalance) (string, bool, error) {
+	return s.resolve(ctx, ref, &opening)
+}
+
+// resolve implements Resolve and EnsureAccount. A non-nil opening balance forces
+// creation of missing accounts. It also reports whether the account was created.
+func (s *AccountMappingService) resolve(ctx context.Context, ref AccountRef, opening *OpeningBalance) (string, bool, error) {
+	logger := internal.GetLogger().With().Str("usecase", "ResolveAccount").
+		Str("source", ref.Source).Str("account", ref.Account).Logger()
+
+	key := ref.key()
+
+	s.mu.RLock()
+	id, ok := s.cache[key]
+	s.mu.RUnlock()
+	if ok {
+		return id, false, nil
+	}
+
+	iban := ref.IBAN
+	autoCreate := s.autoCreate
+
+	// Configured mappings win
+	if mapping, ok := s.static[key]; ok {
+		if mapping.IsResolved() {
+			s.remember(key, mapping.FireflyAccountID)
+			return mapping.FireflyAccountID, false, nil
+		}
+		if mapping.IBAN != "" {
+			iban = mapping.IBAN
+		}
+	}
+
+	// Stored mappings, which may only carry hints
+	stored, err := s.mappingRepo.FindBySourceAccount(ctx, ref.Source, ref.Account)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", false, err
+	}
+	if stored != nil {
+		if stored.IsResolved() {
+			s.remember(key, stored.FireflyAccountID)
+			return stored.FireflyAccountID, false, nil
+		}
+		if stored.IBAN != "" {
+			iban = stored.IBAN
+		}
+		autoCreate = autoCreate || stored.AutoCreate
+	}
+
+	// Match an existing asset account by IBAN
+	if iban != "" {
+		account, err := s.findByIBAN(ctx, iban)
+		if err != nil {
+			return "", false, err
+		}
+		if account != nil {
+			logger.Info().Str("fireflyAccountID", account.ID).Msg("Matched Firefly account by IBAN")
+			return account.ID, false, s.save(ctx, key, ref, stored, account.ID, iban)
+		}
+	}
+
+	if !autoCreate && opening == nil {
+		return "", false, fmt.Errorf("%s account %s: %w", ref.Source, ref.Account, models.ErrAccountMappingNotFound)
+	}
+
+	name := ref.Name
+	if name == "" {
+		name = fmt.Sprintf("%s %s", ref.Source, ref.Account)
+	}
+
+	request := interfaces.FireflyAccountRequest{
+		Name:         name,
+		Type:         "asset",
+		AccountRole:  "defaultAsset",
+		IBAN:         models.NormalizeIBAN(iban),
+		CurrencyCode: ref.Currency,
+		Notes:        fmt.Sprintf("Created by FireDragon for %s account %s", ref.Source, ref.Account),
+	}
+	if opening != nil && opening.Amount != 0 {
+		request.OpeningBalance = opening.Amount
+		request.OpeningBalanceDate = opening.Date
+	}
+
+	account, err := s.firefly.CreateAccount(ctx, request)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create Firefly account: %w", err)
+	}
+
+	logger.Info().Str("fireflyAccountID", account.ID).Msg("Created Firefly account")
+
+	s.mu.Lock()
+	s.assets = append(s.assets, *account)
+	s.mu.Unlock()
+
+	return account.ID, true, s.save(ctx, key, ref, stored, account.ID, iban)
+}
+
+// RemoveAccountOptions controls how a Firefly account is removed
+type RemoveAccountOptions struct {
+	Delete bool `json:"delete"` // delete instead of archiving
+	Force  bool `json:"force"`  // delete even if the account has transactions
+}
+
+// AccountRemoval is the outcome of removing a Firefly account
+type AccountRemoval struct {
+	FireflyAccountID string `json:"fireflyAccountId"`
+	Archived         bool   `json:"archived"`
+	Deleted          bool   `json:"deleted"`
+	RemovedMappings  int    `json:"removedMappings"`
+}
+
+// RemoveAccount archives a Firefly account, or deletes it when opts.Delete is set.
+// Deletion is refused with models.ErrAccountMappingConfigured while a configured
+// mapping points at the account, and with a *interfaces.FireflyAccountInUseError
+// while it has transactions unless opts.Force is set. Stored mappings of a
+// deleted account are removed so the source accounts are resolved again.
+func (s *AccountMappingService) RemoveAccount(ctx context.Context, fireflyID string, opts RemoveAccountOptions) (*AccountRemoval, error) {
+	logger := internal.GetLogger().With().Str("usecase", "RemoveAccount").Str("fireflyAccountID", fireflyID).Logger()
+	removal := &AccountRemoval{FireflyAccountID: fireflyID}
+
+	if !opts.Delete {
+		if err := s.firefly.ArchiveAccount(ctx, fireflyID); err != nil {
+			return nil, fmt.Errorf("failed to archive Firefly account %s: %w", fireflyID, err)
+		}
+		removal.Archived = true
+		logger.Info().Msg("Archived Firefly account")
+		return removal, nil
+	}
+
+	for _, mapping := range s.static {
+		if mapping.FireflyAccountID == fireflyID {
+			return nil, fmt.Errorf("%w: %s account %s", models.ErrAccountMappingConfigured, mapping.Source, mapping.SourceAccount)
+		}
+	}
+
+	if err := s.firefly.DeleteAccount(ctx, fireflyID, opts.Force); err != nil {
+		return nil, fmt.Errorf("failed to delete Firefly account %s: %w", fireflyID, err)
+	}
+	removal.Deleted = true
+
+	mappings, err := s.mappingRepo.FindAll(ctx, "")
+	if err != nil {
+		return removal, fmt.Errorf("failed to find account mappings: %w", err)
+	}
+	for _, mapping := range mappings {
+		if mapping.FireflyAccountID != fireflyID {
+			continue
+		}
+		if err := s.mappingRepo.Delete(ctx, mapping.ID); err != nil {
+			return removal, fmt.Errorf("failed to delete account mapping %s: %w", mapping.ID, err)
+		}
+		removal.RemovedMappings++
+	}
+	s.Invalidate()
+
+	logger.Info().Bool("forced", opts.Force).Int("removedMappings", removal.RemovedMappings).Msg("Deleted Firefly account")
+	return removal, nil
+}
+
+// Invalidate drops all cached resolutions, e.g. after mappings were edited
+func (s *AccountMappingService) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.cache)
+	s.assets = nil
+}
+
+// findByIBAN finds an asset account by IBAN, loading the account list on first use
+func (s *AccountMappingService) findByIBAN(ctx context.Context, iban string) (*interfaces.FireflyAccount, error) {
+	s.mu.RLock()
+	assets := s.assets
+	s.mu.RUnlock()
+
+	if assets == nil {
+		loaded, err := s.firefly.ListAccounts(ctx, "asset")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Firefly asset accounts: %w", err)
+		}
+
+		s.mu.Lock()
+		s.assets = loaded
+		s.mu.Unlock()
+		assets = loaded
+	}
+
+	iban = models.NormalizeIBAN(iban)
+	for i := range assets {
+		if assets[i].IBAN != "" && models.NormalizeIBAN(assets[i].IBAN) == iban {
+			return &assets[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// save caches a resolution and persists it as a mapping
+func (s *AccountMappingService) save(ctx context.Context, key string, ref AccountRef, stored *models.AccountMapping, fireflyID, iban string) error {
+	s.remember(key, fireflyID)
+
+	if stored != nil {
+		stored.FireflyAccountID = fireflyID
+		stored.IBAN = iban
+		if err := s.mappingRepo.Update(ctx, stored); err != nil {
+			return fmt.Errorf("failed to store account mapping: %w", err)
+		}
+		return nil
+	}
+
+	mapping := models.NewAccountMapping(ref.Source, ref.Account, fireflyID)
+	mapping.IBAN = iban
+	if err := s.mappingRepo.Create(ctx, mapping); err != nil {
+		return fmt.Errorf("failed to store account mapping: %w", err)
+	}
+
+	return nil
+}
+
+func (s *AccountMappingService) remember(key, fireflyID string) {
+	s.mu.Lock()
+	s.cache[key] = fireflyID
+	s.mu.Unlock()
+}
@@ -0,0 +1,93 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// AuditService records who changed which domain records, and which mutating
+// requests were made, in an append-only audit log. Entries older than the
+// retention are purged.
+type AuditService struct {
+	auditRepo repositories.AuditRepository
+	retention time.Duration // zero keeps entries forever
+}
+
+// NewAuditService creates a new AuditService
+func NewAuditService(auditRepo repositories.AuditRepository, retention time.Duration) *AuditService {
+	return &AuditService{
+		auditRepo: auditRepo,
+		retention: retention,
+	}
+}
+
+// RecordChange appends an entry for a created, updated or deleted record,
+// attributed to the origin carried by ctx. before is nil for created records
+// and after for deleted ones. Updates that change nothing but the version are
+// not recorded. Failures are logged, never returned: auditing must not fail
+// the change it records.
+func (s *AuditService) RecordChange(ctx context.Context, action models.AuditAction, collection, recordID string, before, after map[string]any) {
+	changes := models.AuditDiff(before, after)
+	if action == models.AuditActionUpdate && len(changes) == 0 {
+		return
+	}
+
+	s.append(ctx, &models.AuditEntry{
+		Action:      action,
+		Collection:  collection,
+		RecordID:    recordID,
+		Changes:     changes,
+		At:          time.Now(),
+		AuditOrigin: models.AuditOriginFrom(ctx),
+	})
+}
+
+// RecordRequest appends an entry for a mutating API request and its response status
+func (s *AuditService) RecordRequest(ctx context.Context, method, path string, status int) {
+	s.append(ctx, &models.AuditEntry{
+		Action:      models.AuditActionRequest,
+		Request:     method + " " + path,
+		Status:      status,
+		At:          time.Now(),
+		AuditOrigin: models.AuditOriginFrom(ctx),
+	})
+}
+
+func (s *AuditService) append(ctx context.Context, entry *models.AuditEntry) {
+	if err := s.auditRepo.Append(ctx, entry); err != nil {
+		logger := internal.GetLogger().With().Str("usecase", "Audit").Logger()
+		logger.Error().Err(err).
+			Str("action", string(entry.Action)).
+			Str("collection", entry.Collection).
+			Str("recordID", entry.RecordID).
+			Str("request", entry.Request).
+			Msg("Failed to append audit entry")
+	}
+}
+
+// List returns audit entries, newest first
+func (s *AuditService) List(ctx context.Context, filter repositories.AuditFilter) ([]*models.AuditEntry, error) {
+	entries, err := s.auditRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit entries: %w", err)
+	}
+	return entries, nil
+}
+
+// Purge deletes the entries older than the retention and returns how many were deleted
+func (s *AuditService) Purge(ctx context.Context, now time.Time) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+
+	purged, err := s.auditRepo.PurgeBefore(ctx, now.Add(-s.retention))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge audit entries: %w", err)
+	}
+	return purged, nil
+}
@@ -0,0 +1,222 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/workerpool"
+)
+
+// pagedFetcher is implemented by clients that can page through the full
+// history of an account, oldest first
+type pagedFetcher interface {
+	FetchTransactionPage(account, cursor string) (models.TransactionPage, error)
+}
+
+// BackfillService imports the full history of sources page by page in the
+// background. The cursor is checkpointed after every imported page, so a
+// backfill stopped by an error or a restart resumes where it left off.
+type BackfillService struct {
+	syncer *SourceSyncService
+	repo   repositories.BackfillRepository
+
+	ctx     context.Context // bounds every running backfill
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+// NewBackfillService creates a new BackfillService
+func NewBackfillService(syncer *SourceSyncService, repo repositories.BackfillRepository) *BackfillService {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &BackfillService{
+		syncer:  syncer,
+		repo:    repo,
+		ctx:     ctx,
+		cancel:  cancel,
+		running: make(map[string]bool),
+	}
+}
+
+// Start backfills a source in the background. A stopped backfill resumes from
+// its checkpoint unless restart is set; a completed one always starts over.
+func (s *BackfillService) Start(ctx context.Context, id string, restart bool) (*models.Backfill, error) {
+	source, err := s.syncer.source(id)
+	if err != nil {
+		return nil, err
+	}
+	fetcher, ok := source.Client.(pagedFetcher)
+	if !ok {
+		return nil, fmt.Errorf("source %q: %w", id, models.ErrBackfillUnsupported)
+	}
+	if err := s.syncer.checkSyncable(id); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running[id] {
+		return nil, fmt.Errorf("source %q: %w", id, models.ErrBackfillRunning)
+	}
+
+	backfill, err := s.repo.FindBySource(ctx, id)
+	switch {
+	case errors.Is(err, models.ErrBackfillNotFound):
+		backfill = models.NewBackfill(id)
+	case err != nil:
+		return nil, err
+	case restart || backfill.Status == models.BackfillCompleted:
+		backfill = models.NewBackfill(id)
+	default:
+		backfill.Resume()
+	}
+	if err := s.repo.Save(ctx, backfill); err != nil {
+		return nil, err
+	}
+
+	s.running[id] = true
+	s.wg.Add(1)
+	go func(backfill models.Backfill) {
+		defer s.wg.Done()
+		s.run(source, fetcher, &backfill)
+
+		s.mu.Lock()
+		delete(s.running, id)
+		s.mu.Unlock()
+	}(*backfill)
+
+	return backfill, nil
+}
+
+// Resume restarts the backfills that had not completed when the server stopped
+func (s *BackfillService) Resume(ctx context.Context) error {
+	backfills, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load backfills: %w", err)
+	}
+
+	var errs []error
+	for _, backfill := range backfills {
+		if backfill.Status == models.BackfillCompleted {
+			continue
+		}
+		if _, err := s.Start(ctx, backfill.SourceID, false); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GetBackfill returns the backfill of a source with its progress
+func (s *BackfillService) GetBackfill(ctx context.Context, id string) (*models.Backfill, error) {
+	return s.repo.FindBySource(ctx, id)
+}
+
+// ListBackfills returns every backfill with its progress
+func (s *BackfillService) ListBackfills(ctx context.Context) ([]*models.Backfill, error) {
+	return s.repo.FindAll(ctx)
+}
+
+// Stop interrupts the running backfills and waits for them to checkpoint
+func (s *BackfillService) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// run imports pages until the history is exhausted, an error stops the
+// backfill or the service is stopped
+func (s *BackfillService) run(source Source, fetcher pagedFetcher, backfill *models.Backfill) {
+	ctx := s.ctx
+	logger := internal.GetLogger().With().Str("usecase", "Backfill").Str("sourceID", source.ID()).Logger()
+	logger.Info().Int("pages", backfill.Pages).Msg("Backfill started")
+
+	for backfill.Status == models.BackfillRunning {
+		if ctx.Err() != nil {
+			// Still marked running, so the next start resumes it
+			logger.Info().Int("pages", backfill.Pages).Msg("Backfill interrupted")
+			return
+		}
+
+		imported, page, err := s.importPage(ctx, source, fetcher, backfill.Cursor)
+		if err != nil {
+			backfill.Fail(err)
+			if err := s.repo.Save(ctx, backfill); err != nil {
+				logger.Error().Err(err).Msg("Failed to checkpoint backfill")
+			}
+			logger.Error().Err(err).Int("pages", backfill.Pages).Msg("Backfill stopped")
+			return
+		}
+
+		backfill.Advance(page, imported)
+		if err := s.repo.Save(ctx, backfill); err != nil {
+			logger.Error().Err(err).Msg("Failed to checkpoint backfill")
+			return
+		}
+		logger.Debug().Int("pages", backfill.Pages).Float64("progress", backfill.Progress).Msg("Backfill page imported")
+	}
+
+	logger.Info().Int("pages", backfill.Pages).Int("imported", backfill.Imported).Msg("Backfill completed")
+}
+
+// importPage imports the page after cursor as a bulk job on the worker pool,
+// so pages wait while scheduled and interactive syncs are queued and count
+// against the concurrency limit of the provider
+func (s *BackfillService) importPage(ctx context.Context, source Source, fetcher pagedFetcher, cursor string) (imported int, page models.TransactionPage, err error) {
+	poolErr := s.syncer.onPool(source, workerpool.PriorityBulk, func() {
+		imported, page, err = s.fetchPage(ctx, source, fetcher, cursor)
+	})
+	if poolErr != nil {
+		return 0, page, poolErr
+	}
+	return imported, page, err
+}
+
+// fetchPage fetches and imports the page after cursor. It holds the source's
+// sync lock, so backfills and regular syncs never import concurrently.
+func (s *BackfillService) fetchPage(ctx context.Context, source Source, fetcher pagedFetcher, cursor string) (int, models.TransactionPage, error) {
+	lock := s.syncer.lock(source.ID())
+	lock.Lock()
+	defer lock.Unlock()
+
+	wallet, err := s.syncer.sourceWallet(ctx, source)
+	if err != nil {
+		return 0, models.TransactionPage{}, err
+	}
+
+	// The addresses of a wallet spanning several are backfilled one after another
+	addresses := source.OwnedAddresses()
+	position := models.AddressCursor{Address: source.Account.Account, Cursor: cursor}
+	if len(addresses) > 1 {
+		position = models.ParseAddressCursor(cursor, addresses)
+	}
+
+	var page models.TransactionPage
+	err = s.syncer.retry(ctx, source, func() error {
+		var err error
+		page, err = fetcher.FetchTransactionPage(position.Address, position.Cursor)
+		return err
+	})
+	if s.syncer.incidents != nil {
+		s.syncer.incidents.Observe(ctx, source.Provider(), err)
+	}
+	if err != nil {
+		return 0, page, fmt.Errorf("failed to fetch page: %w", err)
+	}
+
+	if len(addresses) > 1 {
+		page.Transactions = models.MergeAddressHistories(map[string][]models.Transaction{position.Address: page.Transactions}, addresses)
+		page = models.AdvanceAddressPage(page, position, addresses)
+	}
+
+	report, err := s.syncer.importFetched(ctx, source, wallet.ID, page.Transactions, page.Filtered)
+	if err != nil {
+		return 0, page, err
+	}
+	return report.Imported, page, nil
+}
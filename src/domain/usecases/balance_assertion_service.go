@@ -0,0 +1,249 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/pocketbase/pocketbase/tools/cron"
+)
+
+// BalanceAssertionService runs the balance assertions users define on their
+// cron schedules. Each check reads the compared balances of the source
+// account, the local wallet balance, the balance the provider reports and the
+// balance of its Firefly account, and stores the drift between them. The
+// event hooks alert on the results that fail.
+type BalanceAssertionService struct {
+	repo      repositories.BalanceAssertionRepository
+	syncer    *SourceSyncService
+	tolerance float64                  // used by assertions without a tolerance of their own
+	accounts  *AccountMappingService   // optional: nil when Firefly is not configured
+	firefly   interfaces.FireflyClient // optional: nil when Firefly is not configured
+}
+
+// NewBalanceAssertionService creates a new BalanceAssertionService. A
+// non-positive tolerance falls back to DefaultBalanceTolerance.
+func NewBalanceAssertionService(repo repositories.BalanceAssertionRepository, syncer *SourceSyncService, tolerance float64) *BalanceAssertionService {
+	if tolerance <= 0 {
+		tolerance = DefaultBalanceTolerance
+	}
+	return &BalanceAssertionService{
+		repo:      repo,
+		syncer:    syncer,
+		tolerance: tolerance,
+	}
+}
+
+// WithFirefly lets assertions compare the Firefly asset account of their source
+func (s *BalanceAssertionService) WithFirefly(accounts *AccountMappingService, firefly interfaces.FireflyClient) *BalanceAssertionService {
+	s.accounts = accounts
+	s.firefly = firefly
+	return s
+}
+
+// ListAssertions returns all balance assertions, by name
+func (s *BalanceAssertionService) ListAssertions(ctx context.Context) ([]*models.BalanceAssertion, error) {
+	return s.repo.FindAll(ctx)
+}
+
+// CreateAssertion validates and stores a new balance assertion
+func (s *BalanceAssertionService) CreateAssertion(ctx context.Context, assertion *models.BalanceAssertion) error {
+	if err := s.validate(assertion); err != nil {
+		return err
+	}
+	return s.repo.Create(ctx, assertion)
+}
+
+// UpdateAssertion validates and stores the changes of a balance assertion
+func (s *BalanceAssertionService) UpdateAssertion(ctx context.Context, assertion *models.BalanceAssertion) error {
+	if _, err := s.repo.FindByID(ctx, assertion.ID); err != nil {
+		return err
+	}
+	if err := s.validate(assertion); err != nil {
+		return err
+	}
+	return s.repo.Update(ctx, assertion)
+}
+
+// DeleteAssertion deletes a balance assertion and its results
+func (s *BalanceAssertionService) DeleteAssertion(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// Results returns stored check results, most recent first
+func (s *BalanceAssertionService) Results(ctx context.Context, filter repositories.BalanceAssertionResultFilter) ([]*models.BalanceAssertionResult, error) {
+	return s.repo.FindResults(ctx, filter)
+}
+
+// Check runs a balance assertion now, whether or not it is enabled, and
+// stores its result. Balances that cannot be read fail the check rather than
+// the call.
+func (s *BalanceAssertionService) Check(ctx context.Context, id string) (*models.BalanceAssertionResult, error) {
+	assertion, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.check(ctx, assertion)
+}
+
+// RunDue checks the enabled assertions whose schedule is due at the given
+// minute and returns how many were checked. Failures are logged and do not
+// stop the other assertions.
+func (s *BalanceAssertionService) RunDue(ctx context.Context, at time.Time) int {
+	ctx, _ = internal.EnsureRequestID(ctx)
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "RunBalanceAssertions").Logger()
+
+	assertions, err := s.repo.FindAll(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to load balance assertions")
+		return 0
+	}
+
+	moment := cron.NewMoment(at)
+	checked := 0
+	for _, assertion := range assertions {
+		if !assertion.Enabled {
+			continue
+		}
+		schedule, err := cron.NewSchedule(assertion.Schedule)
+		if err != nil {
+			logger.Warn().Err(err).Str("assertionID", assertion.ID).Msg("Skipping balance assertion with an invalid schedule")
+			continue
+		}
+		if !schedule.IsDue(moment) {
+			continue
+		}
+
+		checked++
+		result, err := s.check(ctx, assertion)
+		if err != nil {
+			logger.Error().Err(err).Str("assertionID", assertion.ID).Msg("Failed to check balance assertion")
+			continue
+		}
+		if !result.Passed {
+			logger.Warn().
+				Str("assertionID", assertion.ID).
+				Str("sourceID", assertion.SourceID).
+				Float64("drift", result.Drift).
+				Float64("tolerance", result.Tolerance).
+				Str("error", result.Error).
+				Msg("Balance assertion failed")
+		}
+	}
+
+	return checked
+}
+
+// check reads the compared balances of an assertion and stores the result
+func (s *BalanceAssertionService) check(ctx context.Context, assertion *models.BalanceAssertion) (*models.BalanceAssertionResult, error) {
+	result := &models.BalanceAssertionResult{
+		AssertionID: assertion.ID,
+		CheckedAt:   time.Now(),
+		Balances:    make(map[models.BalanceSide]float64),
+		Tolerance:   assertion.Tolerance,
+	}
+	if result.Tolerance == 0 {
+		result.Tolerance = s.tolerance
+	}
+
+	var problems []string
+	for _, side := range assertion.Sides() {
+		balance, currency, err := s.balance(ctx, assertion.SourceID, side, result)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", side, err))
+			continue
+		}
+		if currency != "" && result.Currency != "" && !strings.EqualFold(currency, result.Currency) {
+			problems = append(problems, fmt.Sprintf("%s: balance is in %s, want %s", side, currency, result.Currency))
+			continue
+		}
+		if result.Currency == "" {
+			result.Currency = strings.ToUpper(currency)
+		}
+		result.Balances[side] = balance
+	}
+	result.Error = strings.Join(problems, "; ")
+	result.Evaluate()
+
+	if err := s.repo.CreateResult(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// balance reads one side of a source account and its currency. The wallet of
+// the source is recorded on the result once resolved.
+func (s *BalanceAssertionService) balance(ctx context.Context, sourceID string, side models.BalanceSide, result *models.BalanceAssertionResult) (float64, string, error) {
+	source, err := s.syncer.source(sourceID)
+	if err != nil {
+		return 0, "", err
+	}
+
+	switch side {
+	case models.BalanceSideLocal:
+		wallet, err := s.syncer.sourceWallet(ctx, source)
+		if err != nil {
+			return 0, "", err
+		}
+		result.WalletID = wallet.ID
+		return wallet.Balance, wallet.Currency, nil
+
+	case models.BalanceSideProvider:
+		var balance models.BalanceInfo
+		err := s.syncer.retry(ctx, source, func() error {
+			var err error
+			balance, _, err = source.Balance()
+			return err
+		})
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to fetch balance: %w", err)
+		}
+		return balance.Amount, balance.Currency, nil
+
+	case models.BalanceSideFirefly:
+		if s.accounts == nil {
+			return 0, "", fmt.Errorf("firefly is not configured")
+		}
+		fireflyID, err := s.accounts.Resolve(ctx, source.Account)
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to resolve Firefly account: %w", err)
+		}
+		account, err := s.firefly.GetAccount(ctx, fireflyID)
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to get Firefly account %s: %w", fireflyID, err)
+		}
+		return account.CurrentBalance, account.CurrencyCode, nil
+	}
+
+	return 0, "", fmt.Errorf("unknown balance side %q", side)
+}
+
+// validate checks an assertion, its schedule and that it names a configured
+// source, reporting every failure at once
+func (s *BalanceAssertionService) validate(assertion *models.BalanceAssertion) error {
+	v := validation.New()
+	v.Include("", assertion.Validate())
+
+	if assertion.Schedule != "" {
+		_, err := cron.NewSchedule(assertion.Schedule)
+		v.Check(err == nil, "schedule", validation.RuleFormat,
+			fmt.Errorf("%w: schedule is not a valid cron expression", models.ErrInvalidBalanceAssertion))
+	}
+	if assertion.SourceID != "" {
+		_, ok := s.syncer.sources[assertion.SourceID]
+		v.Check(ok, "sourceId", validation.RuleOneOf,
+			fmt.Errorf("%w: unknown source %q", models.ErrInvalidBalanceAssertion, assertion.SourceID))
+	}
+	for _, side := range assertion.Compare {
+		v.Check(side != models.BalanceSideFirefly || s.accounts != nil, "compare", validation.RuleOneOf,
+			fmt.Errorf("%w: firefly is not configured", models.ErrInvalidBalanceAssertion))
+	}
+
+	return v.Err()
+}
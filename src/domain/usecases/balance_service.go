@@ -0,0 +1,102 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// BalanceService keeps stored wallet balances consistent with their transactions
+type BalanceService struct {
+	walletRepo  repositories.WalletRepository
+	maintenance *MaintenanceService // optional: refuses fixes in frozen spaces
+}
+
+// NewBalanceService creates a new BalanceService
+func NewBalanceService(walletRepo repositories.WalletRepository) *BalanceService {
+	return &BalanceService{
+		walletRepo: walletRepo,
+	}
+}
+
+// WithMaintenance refuses to fix the balances of wallets in frozen spaces;
+// checking them keeps working
+func (s *BalanceService) WithMaintenance(maintenance *MaintenanceService) *BalanceService {
+	s.maintenance = maintenance
+	return s
+}
+
+// RecalculateOptions controls a balance recalculation run
+type RecalculateOptions struct {
+	WalletID string `json:"walletId,omitempty"` // empty checks every wallet
+	Fix      bool   `json:"fix"`                // overwrite drifted balances with the replayed value
+}
+
+// BalanceRecalculationReport summarizes a RecalculateBalances run
+type BalanceRecalculationReport struct {
+	CheckedAt     time.Time                    `json:"checkedAt"`
+	Checked       int                          `json:"checked"`
+	Fixed         int                          `json:"fixed"`
+	Discrepancies []*models.WalletBalanceCheck `json:"discrepancies"`
+}
+
+// RecalculateBalances replays all transactions per wallet on its opening balance
+// and reports wallets whose stored balance has drifted, e.g. after records were
+// edited in the admin UI.
+func (s *BalanceService) RecalculateBalances(ctx context.Context, opts RecalculateOptions) (*BalanceRecalculationReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "RecalculateBalances").Bool("fix", opts.Fix).Logger()
+
+	if opts.Fix && s.maintenance != nil {
+		// Fixing every wallet reaches into every space
+		var err error
+		if opts.WalletID != "" {
+			err = s.maintenance.CheckWallets(ctx, opts.WalletID)
+		} else {
+			err = s.maintenance.CheckAll(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	checks, err := s.walletRepo.ReplayBalances(ctx, opts.WalletID, opts.Fix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recalculate balances: %w", err)
+	}
+
+	report := &BalanceRecalculationReport{
+		CheckedAt:     time.Now(),
+		Checked:       len(checks),
+		Discrepancies: make([]*models.WalletBalanceCheck, 0),
+	}
+
+	for _, check := range checks {
+		if !check.HasDiscrepancy() {
+			continue
+		}
+
+		logger.Warn().
+			Str("walletID", check.WalletID).
+			Float64("stored", check.StoredBalance).
+			Float64("replayed", check.ReplayedBalance).
+			Bool("fixed", check.Fixed).
+			Msg("Wallet balance drifted")
+
+		report.Discrepancies = append(report.Discrepancies, check)
+		if check.Fixed {
+			report.Fixed++
+		}
+	}
+
+	logger.Info().
+		Int("checked", report.Checked).
+		Int("discrepancies", len(report.Discrepancies)).
+		Int("fixed", report.Fixed).
+		Msg("Balance recalculation finished")
+
+	return report, nil
+}
@@ -0,0 +1,197 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// DefaultBalanceTolerance is the largest difference between a source and its
+// Firefly account that is not reported as drift
+const DefaultBalanceTolerance = 0.01
+
+// BalanceDrift is a source whose Firefly asset account disagrees with the
+// balance the provider reports
+type BalanceDrift struct {
+	SourceID         string  `json:"sourceId"`
+	FireflyAccountID string  `json:"fireflyAccountId"`
+	Currency         string  `json:"currency"`
+	Reported         float64 `json:"reported"` // balance the provider reports
+	Firefly          float64 `json:"firefly"`  // current balance of the Firefly account
+	Drift            float64 `json:"drift"`    // Reported - Firefly
+}
+
+// BalanceUpdateReport summarizes a balance update of every source
+type BalanceUpdateReport struct {
+	StartedAt  time.Time      `json:"startedAt"`
+	FinishedAt time.Time      `json:"finishedAt"`
+	Sources    int            `json:"sources"`
+	Snapshots  int            `json:"snapshots"`
+	Compared   int            `json:"compared"` // sources compared with their Firefly account
+	Drifts     []BalanceDrift `json:"drifts"`
+	Errors     []string       `json:"errors,omitempty"`
+}
+
+// BalanceUpdateService fetches the balances of every source, records them in
+// the snapshot history and compares them with the Firefly asset accounts the
+// sources are mapped to.
+type BalanceUpdateService struct {
+	syncer       *SourceSyncService
+	snapshotRepo repositories.BalanceSnapshotRepository
+	tolerance    float64
+	accounts     *AccountMappingService   // optional: nil when Firefly is not configured
+	firefly      interfaces.FireflyClient // optional: nil when Firefly is not configured
+
+	mu   sync.Mutex
+	last *BalanceUpdateReport
+}
+
+// NewBalanceUpdateService creates a new BalanceUpdateService. A non-positive
+// tolerance falls back to DefaultBalanceTolerance.
+func NewBalanceUpdateService(syncer *SourceSyncService, snapshotRepo repositories.BalanceSnapshotRepository, tolerance float64) *BalanceUpdateService {
+	if tolerance <= 0 {
+		tolerance = DefaultBalanceTolerance
+	}
+	return &BalanceUpdateService{
+		syncer:       syncer,
+		snapshotRepo: snapshotRepo,
+		tolerance:    tolerance,
+	}
+}
+
+// WithFirefly compares every balance with the Firefly asset account of its source
+func (s *BalanceUpdateService) WithFirefly(accounts *AccountMappingService, firefly interfaces.FireflyClient) *BalanceUpdateService {
+	s.accounts = accounts
+	s.firefly = firefly
+	return s
+}
+
+// UpdateBalances snapshots the balance of every source that has a client and
+// reports the sources whose Firefly account drifted beyond the tolerance.
+// Failing sources are listed in the report and do not stop the others.
+func (s *BalanceUpdateService) UpdateBalances(ctx context.Context) *BalanceUpdateReport {
+	logger := internal.GetLogger().With().Str("usecase", "UpdateBalances").Logger()
+
+	ids := make([]string, 0, len(s.syncer.sources))
+	for id, source := range s.syncer.sources {
+		// Disabled sources and those whose consent expired are not read
+		if source.Client != nil && s.syncer.checkSyncable(id) == nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	report := &BalanceUpdateReport{StartedAt: time.Now(), Drifts: make([]BalanceDrift, 0)}
+	for _, id := range ids {
+		report.Sources++
+		if err := s.updateSource(ctx, id, report); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", id, err))
+		}
+	}
+	report.FinishedAt = time.Now()
+
+	for _, drift := range report.Drifts {
+		logger.Warn().
+			Str("sourceID", drift.SourceID).
+			Str("fireflyAccountID", drift.FireflyAccountID).
+			Float64("reported", drift.Reported).
+			Float64("firefly", drift.Firefly).
+			Float64("drift", drift.Drift).
+			Msg("Firefly account balance drifted")
+	}
+	logger.Info().
+		Int("sources", report.Sources).
+		Int("snapshots", report.Snapshots).
+		Int("drifts", len(report.Drifts)).
+		Int("errors", len(report.Errors)).
+		Msg("Balances updated")
+
+	s.mu.Lock()
+	s.last = report
+	s.mu.Unlock()
+
+	return report
+}
+
+// LastReport returns the report of the last balance update, or nil before the first
+func (s *BalanceUpdateService) LastReport() *BalanceUpdateReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last
+}
+
+// updateSource snapshots the balance of one source and compares it with Firefly
+func (s *BalanceUpdateService) updateSource(ctx context.Context, id string, report *BalanceUpdateReport) error {
+	source, err := s.syncer.source(id)
+	if err != nil {
+		return err
+	}
+	wallet, err := s.syncer.sourceWallet(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	var balance models.BalanceInfo
+	var reported []models.ReportedBalance
+	err = s.syncer.retry(ctx, source, func() error {
+		var err error
+		balance, reported, err = source.Balance()
+		return err
+	})
+	if s.syncer.incidents != nil {
+		s.syncer.incidents.Observe(ctx, source.Provider(), err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch balance: %w", err)
+	}
+
+	// Banks report every balance type; other sources only the one balance
+	if len(reported) == 0 {
+		reported = []models.ReportedBalance{{Amount: balance.Amount, Currency: balance.Currency, BalanceType: balance.BalanceType}}
+	}
+	takenAt := time.Now()
+	for _, value := range reported {
+		snapshot := models.NewReportedBalanceSnapshot(wallet.ID, source.Account.Source, value, takenAt)
+		if err := s.snapshotRepo.Create(ctx, snapshot); err != nil {
+			return fmt.Errorf("failed to snapshot balance: %w", err)
+		}
+		report.Snapshots++
+	}
+
+	if s.accounts == nil {
+		return nil
+	}
+	fireflyID, err := s.accounts.Resolve(ctx, source.Account)
+	if err != nil {
+		return fmt.Errorf("failed to resolve Firefly account: %w", err)
+	}
+	account, err := s.firefly.GetAccount(ctx, fireflyID)
+	if err != nil {
+		return fmt.Errorf("failed to get Firefly account %s: %w", fireflyID, err)
+	}
+	if account.CurrencyCode != "" && balance.Currency != "" && !strings.EqualFold(account.CurrencyCode, balance.Currency) {
+		return fmt.Errorf("firefly account %s is in %s, the source reports %s", fireflyID, account.CurrencyCode, balance.Currency)
+	}
+
+	report.Compared++
+	if drift := balance.Amount - account.CurrentBalance; math.Abs(drift) > s.tolerance {
+		report.Drifts = append(report.Drifts, BalanceDrift{
+			SourceID:         id,
+			FireflyAccountID: fireflyID,
+			Currency:         balance.Currency,
+			Reported:         balance.Amount,
+			Firefly:          account.CurrentBalance,
+			Drift:            drift,
+		})
+	}
+	return nil
+}
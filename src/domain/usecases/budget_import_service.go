@@ -0,0 +1,411 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// BudgetParser parses the export of a budgeting app. The fileimport budget
+// profiles satisfy it.
+type BudgetParser interface {
+	Name() string
+	Title() string
+	Parse(r io.Reader) ([]models.BudgetTransaction, error)
+}
+
+// BudgetImportService imports the history of budgeting apps such as YNAB and
+// Actual Budget. An uploaded export is staged in a review queue with a
+// proposed mapping of its accounts to wallets and its categories to
+// categories; it is only imported once the reviewed mapping is committed.
+// Staged imports are kept in memory, like export jobs.
+type BudgetImportService struct {
+	walletRepo      repositories.WalletRepository
+	categoryRepo    repositories.CategoryRepository
+	transactionRepo repositories.TransactionRepository
+	imports         *ImportService
+	parsers         map[string]BudgetParser
+	location        *time.Location
+
+	mu      sync.Mutex
+	reviews map[string]*models.BudgetImport
+}
+
+// NewBudgetImportService creates a new BudgetImportService
+func NewBudgetImportService(
+	walletRepo repositories.WalletRepository,
+	categoryRepo repositories.CategoryRepository,
+	transactionRepo repositories.TransactionRepository,
+	imports *ImportService,
+	parsers ...BudgetParser,
+) *BudgetImportService {
+	byName := make(map[string]BudgetParser, len(parsers))
+	for _, parser := range parsers {
+		byName[parser.Name()] = parser
+	}
+
+	return &BudgetImportService{
+		walletRepo:      walletRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+		imports:         imports,
+		parsers:         byName,
+		reviews:         make(map[string]*models.BudgetImport),
+	}
+}
+
+// WithLocation sets the timezone the exports are dated in. Duplicates are
+// then looked for across whole days there instead of around the exact times.
+func (s *BudgetImportService) WithLocation(loc *time.Location) *BudgetImportService {
+	s.location = loc
+	return s
+}
+
+// BudgetMappingInput is a reviewed mapping of a staged budget import
+type BudgetMappingInput struct {
+	Accounts   []models.BudgetAccountMapping  `json:"accounts"`
+	Categories []models.BudgetCategoryMapping `json:"categories"`
+}
+
+// BudgetImportReport summarizes a committed budget import
+type BudgetImportReport struct {
+	Import            *models.BudgetImport `json:"import"`
+	Skipped           int                  `json:"skipped"` // imported by an earlier run or of skipped accounts
+	Imported          int                  `json:"imported"`
+	WalletsCreated    int                  `json:"walletsCreated"`
+	CategoriesCreated int                  `json:"categoriesCreated"`
+	Wallets           []*ImportReport      `json:"wallets"`
+}
+
+// ListProfiles returns the supported export formats sorted by name
+func (s *BudgetImportService) ListProfiles() []ExchangeProfile {
+	profiles := make([]ExchangeProfile, 0, len(s.parsers))
+	for _, parser := range s.parsers {
+		profiles = append(profiles, ExchangeProfile{Name: parser.Name(), Title: parser.Title()})
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles
+}
+
+// Stage parses an export with the named profile and queues it for review.
+// Accounts are proposed the wallet of the same name and categories the
+// category of the same name; the others are created on commit, new wallets
+// in the given currency.
+func (s *BudgetImportService) Stage(ctx context.Context, profile string, r io.Reader, currency string) (*models.BudgetImport, error) {
+	parser, ok := s.parsers[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile %q: %w", profile, models.ErrUnknownImportProfile)
+	}
+
+	transactions, err := parser.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidImportFile, err)
+	}
+
+	review := models.NewBudgetImport(profile, strings.ToUpper(currency), transactions)
+
+	wallets, err := s.walletRepo.FindAll(ctx, repositories.WalletFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+	for i := range review.Accounts {
+		account := &review.Accounts[i]
+		for _, wallet := range wallets {
+			if strings.EqualFold(wallet.Name, account.Account) {
+				account.WalletID = wallet.ID
+				account.Currency = wallet.Currency
+				break
+			}
+		}
+	}
+
+	for i := range review.Categories {
+		category := &review.Categories[i]
+		id, err := findCategoryIDByName(ctx, s.categoryRepo, category.Category)
+		if err != nil && !errors.Is(err, models.ErrCategoryNotFound) {
+			return nil, err
+		}
+		category.CategoryID = id
+	}
+
+	s.mu.Lock()
+	s.reviews[review.ID] = review
+	s.mu.Unlock()
+
+	return s.GetReview(review.ID)
+}
+
+// ListReviews returns the staged budget imports, newest first
+func (s *BudgetImportService) ListReviews() []*models.BudgetImport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reviews := make([]*models.BudgetImport, 0, len(s.reviews))
+	for _, review := range s.reviews {
+		snapshot := *review
+		reviews = append(reviews, &snapshot)
+	}
+	sort.Slice(reviews, func(i, j int) bool { return reviews[i].CreatedAt.After(reviews[j].CreatedAt) })
+	return reviews
+}
+
+// GetReview returns a staged budget import
+func (s *BudgetImportService) GetReview(id string) (*models.BudgetImport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	review, ok := s.reviews[id]
+	if !ok {
+		return nil, models.ErrBudgetImportNotFound
+	}
+	snapshot := *review
+	return &snapshot, nil
+}
+
+// UpdateMapping applies a reviewed mapping to a staged budget import
+func (s *BudgetImportService) UpdateMapping(ctx context.Context, id string, input BudgetMappingInput) (*models.BudgetImport, error) {
+	for _, account := range input.Accounts {
+		if account.WalletID == "" || account.Skip {
+			continue
+		}
+		if _, err := s.walletRepo.FindByID(ctx, account.WalletID); err != nil {
+			return nil, fmt.Errorf("%w: account %q: wallet %s: %v", models.ErrInvalidBudgetMapping, account.Account, account.WalletID, err)
+		}
+	}
+	for _, category := range input.Categories {
+		if category.CategoryID == "" {
+			continue
+		}
+		if _, err := s.categoryRepo.FindByID(ctx, category.CategoryID); err != nil {
+			return nil, fmt.Errorf("%w: category %q: %v", models.ErrInvalidBudgetMapping, category.Category, err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	review, ok := s.reviews[id]
+	if !ok {
+		return nil, models.ErrBudgetImportNotFound
+	}
+	if err := review.ApplyMapping(input.Accounts, input.Categories); err != nil {
+		return nil, err
+	}
+	snapshot := *review
+	return &snapshot, nil
+}
+
+// Discard drops a staged budget import without importing it
+func (s *BudgetImportService) Discard(id string) (*models.BudgetImport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	review, ok := s.reviews[id]
+	if !ok {
+		return nil, models.ErrBudgetImportNotFound
+	}
+	if err := review.Discard(); err != nil {
+		return nil, err
+	}
+	snapshot := *review
+	return &snapshot, nil
+}
+
+// Commit imports a staged budget import with its reviewed mapping. Missing
+// wallets and categories are created first; transactions imported by an
+// earlier commit of the same history are skipped.
+func (s *BudgetImportService) Commit(ctx context.Context, id string) (*BudgetImportReport, error) {
+	s.mu.Lock()
+	review, ok := s.reviews[id]
+	if ok && review.Status != models.BudgetImportPending {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("budget import %s is %s: %w", id, review.Status, models.ErrBudgetImportNotPending)
+	}
+	var staged models.BudgetImport
+	if ok {
+		staged = *review
+		staged.Accounts = append([]models.BudgetAccountMapping(nil), review.Accounts...)
+		staged.Categories = append([]models.BudgetCategoryMapping(nil), review.Categories...)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return nil, models.ErrBudgetImportNotFound
+	}
+
+	logger := internal.GetLogger().With().Str("usecase", "CommitBudgetImport").
+		Str("profile", staged.Profile).Str("import", id).Logger()
+	title := staged.Profile
+	if parser, ok := s.parsers[staged.Profile]; ok {
+		title = parser.Title()
+	}
+
+	report := &BudgetImportReport{Wallets: make([]*ImportReport, 0)}
+	finish := func(err error) (*BudgetImportReport, error) {
+		s.mu.Lock()
+		review.Accounts = staged.Accounts
+		review.Categories = staged.Categories
+		if err != nil {
+			review.Error = err.Error()
+		} else {
+			review.Error = ""
+			review.Commit(report.Imported)
+		}
+		snapshot := *review
+		s.mu.Unlock()
+		report.Import = &snapshot
+		return report, err
+	}
+
+	wallets := make(map[string]string) // account -> wallet ID
+	for i := range staged.Accounts {
+		account := &staged.Accounts[i]
+		if account.Skip {
+			continue
+		}
+		if account.WalletID == "" {
+			wallet, err := findOrCreateWallet(ctx, s.walletRepo, account.Account, "Imported from "+title, account.Currency, models.WalletTypeBank)
+			if err != nil {
+				return finish(fmt.Errorf("account %q: %w", account.Account, err))
+			}
+			account.WalletID = wallet.ID
+			report.WalletsCreated++
+		}
+		wallets[account.Account] = account.WalletID
+	}
+
+	categories := make(map[string]string) // category -> category ID
+	for i := range staged.Categories {
+		category := &staged.Categories[i]
+		if category.CategoryID == "" {
+			description := "Imported from " + title
+			if category.Group != "" {
+				description += " group " + category.Group
+			}
+			created := models.NewCategory(category.Category, description, category.Type, "")
+			if err := s.categoryRepo.Create(ctx, created); err != nil {
+				return finish(fmt.Errorf("category %q: %w", category.Category, err))
+			}
+			category.CategoryID = created.ID
+			report.CategoriesCreated++
+		}
+		categories[category.Category] = category.CategoryID
+	}
+
+	// Batch per wallet, keeping the order in which accounts first appear
+	batches := make(map[string][]*models.Transaction)
+	var order []string
+	for _, entry := range staged.Transactions {
+		walletID, ok := wallets[entry.Account]
+		if !ok || entry.Amount == 0 {
+			report.Skipped++
+			continue
+		}
+
+		// Both sides of a transfer are exported; the outflow becomes the
+		// transfer and the inflow is dropped. Transfers to an account left
+		// out of the import stay plain income and expenses.
+		destID, transfer := wallets[entry.TransferAccount]
+		if transfer && entry.Amount > 0 {
+			continue
+		}
+
+		known, err := alreadyImported(ctx, s.transactionRepo, s.imports.ledger, walletID, entry.ID, entry.Date)
+		if err != nil {
+			return finish(err)
+		}
+		if known {
+			report.Skipped++
+			continue
+		}
+
+		tx := budgetTransaction(title, entry)
+		tx.CategoryID = categories[entry.Category]
+		if transfer {
+			tx.Type = models.TransactionTypeTransfer
+			tx.DestWalletID = destID
+		}
+
+		if _, ok := batches[walletID]; !ok {
+			order = append(order, walletID)
+		}
+		batches[walletID] = append(batches[walletID], tx)
+	}
+
+	for _, walletID := range order {
+		walletReport, err := s.imports.Import(ctx, ImportInput{
+			Source:       staged.Profile,
+			WalletID:     walletID,
+			Transactions: batches[walletID],
+			Location:     s.location,
+		})
+		if walletReport != nil {
+			report.Wallets = append(report.Wallets, walletReport)
+			report.Imported += walletReport.Imported
+		}
+		if err != nil {
+			return finish(fmt.Errorf("failed to import into wallet %s: %w", walletID, err))
+		}
+	}
+
+	logger.Info().
+		Int("imported", report.Imported).
+		Int("skipped", report.Skipped).
+		Int("wallets", report.WalletsCreated).
+		Int("categories", report.CategoriesCreated).
+		Msg("Budget import committed")
+
+	return finish(nil)
+}
+
+// budgetTransaction converts a budget register entry into a transaction of its account
+func budgetTransaction(app string, entry models.BudgetTransaction) *models.Transaction {
+	txType := models.TransactionTypeExpense
+	amount := -entry.Amount
+	if entry.Amount > 0 {
+		txType = models.TransactionTypeIncome
+		amount = entry.Amount
+	}
+
+	description := entry.Payee
+	if description == "" {
+		description = entry.Memo
+	}
+	if description == "" {
+		description = app + " transaction"
+	}
+
+	metadata := map[string]string{
+		MetadataExternalID: entry.ID,
+		"app":              app,
+		"account":          entry.Account,
+	}
+	if entry.Payee != "" {
+		metadata["counterparty"] = entry.Payee
+	}
+	if entry.CategoryGroup != "" {
+		metadata["categoryGroup"] = entry.CategoryGroup
+	}
+	if !entry.Cleared {
+		metadata["cleared"] = "false"
+	}
+
+	return &models.Transaction{
+		Amount:      amount,
+		Description: description,
+		Date:        entry.Date,
+		Type:        txType,
+		Notes:       entry.Memo,
+		Metadata:    metadata,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+)
+
+// calendarHorizonMonths is how far ahead the feed lists events; a year shows
+// every yearly renewal once
+const calendarHorizonMonths = 12
+
+// CalendarService serves the iCalendar feeds of upcoming money events: the
+// expected charges and renewals of the detected subscriptions and the starts
+// of the budget periods. The feed is built on every fetch, so it follows the
+// subscription detection runs and the user's calendar preferences.
+type CalendarService struct {
+	feedRepo         repositories.CalendarFeedRepository
+	subscriptionRepo repositories.SubscriptionRepository
+	preferences      *PreferencesService
+	periods          models.PeriodCalendar
+}
+
+// NewCalendarService creates a new CalendarService with the reporting periods of the instance
+func NewCalendarService(
+	feedRepo repositories.CalendarFeedRepository,
+	subscriptionRepo repositories.SubscriptionRepository,
+	preferences *PreferencesService,
+	periods models.PeriodCalendar,
+) *CalendarService {
+	return &CalendarService{
+		feedRepo:         feedRepo,
+		subscriptionRepo: subscriptionRepo,
+		preferences:      preferences,
+		periods:          periods,
+	}
+}
+
+// Issue creates the feed of a user and returns its token. A user has one
+// feed, issuing again replaces the token so the previous URL stops working.
+func (s *CalendarService) Issue(ctx context.Context, userID string) (string, error) {
+	token, err := models.NewCalendarFeedToken()
+	if err != nil {
+		return "", err
+	}
+	feed := &models.CalendarFeed{UserID: userID, TokenHash: models.HashCalendarFeedToken(token)}
+	if err := s.feedRepo.Save(ctx, feed); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Revoke deletes the feed of a user
+func (s *CalendarService) Revoke(ctx context.Context, userID string) error {
+	return s.feedRepo.DeleteByUser(ctx, userID)
+}
+
+// Feed renders the events of the next months for the feed of a token.
+// It returns models.ErrCalendarFeedNotFound for unknown or revoked tokens.
+func (s *CalendarService) Feed(ctx context.Context, token string, now time.Time) ([]byte, error) {
+	feed, err := s.feedRepo.FindByTokenHash(ctx, models.HashCalendarFeedToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.Events(ctx, feed.UserID, now)
+	if err != nil {
+		return nil, err
+	}
+	return models.RenderICalendar("FireDragon", events, now), nil
+}
+
+// Events returns the money events of a user from the start of today to the
+// horizon, with the subscriptions of shared wallets and the user's spaces
+func (s *CalendarService) Events(ctx context.Context, userID string, now time.Time) ([]models.CalendarEvent, error) {
+	preferences, err := s.preferences.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load preferences: %w", err)
+	}
+
+	// The feed only lists the subscriptions of the wallets the user can see
+	subscriptions, err := s.subscriptionRepo.FindAll(ctx, repositories.SubscriptionFilter{
+		VisibleTo: userID,
+		Status:    models.SubscriptionActive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+
+	now = now.UTC()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, calendarHorizonMonths, 0)
+
+	events := models.SubscriptionEvents(subscriptions, from, to)
+	events = append(events, models.PeriodEvents(preferences.Calendar(s.periods), from, to)...)
+	return events, nil
+}
@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+)
+
+// noPreferences is a preferences repository of users who stored none
+type noPreferences struct{}
+
+func (noPreferences) FindByUser(ctx context.Context, userID string) (*models.Preferences, error) {
+	return nil, models.ErrPreferencesNotFound
+}
+
+func (noPreferences) Save(ctx context.Context, preferences *models.Preferences) error {
+	return nil
+}
+
+// visibleSubscriptions keeps the subscriptions of the wallets each user can see
+type visibleSubscriptions struct {
+	subscriptions []*models.Subscription
+	wallets       map[string][]string // by user
+}
+
+func (r *visibleSubscriptions) FindAll(ctx context.Context, filter repositories.SubscriptionFilter) ([]*models.Subscription, error) {
+	var found []*models.Subscription
+	for _, subscription := range r.subscriptions {
+		if filter.Status != "" && subscription.Status != filter.Status {
+			continue
+		}
+		if filter.VisibleTo != "" && !slices.Contains(r.wallets[filter.VisibleTo], subscription.WalletID) {
+			continue
+		}
+		found = append(found, subscription)
+	}
+	return found, nil
+}
+
+func (r *visibleSubscriptions) Save(ctx context.Context, subscription *models.Subscription) error {
+	return nil
+}
+
+func TestCalendarService_EventsOfTheUsersWallets(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	charge := func(id, wallet string) *models.Subscription {
+		return &models.Subscription{
+			ID: id, Name: id, WalletID: wallet, Interval: models.SubscriptionMonthly, Amount: 10,
+			NextChargeAt: time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC), Status: models.SubscriptionActive,
+		}
+	}
+	subscriptions := &visibleSubscriptions{
+		subscriptions: []*models.Subscription{charge("netflix", "shared"), charge("gym", "alice"), charge("rent", "bob")},
+		wallets: map[string][]string{
+			"alice": {"shared", "alice"},
+			"bob":   {"shared", "bob"},
+		},
+	}
+	preferences := NewPreferencesService(noPreferences{}, nil, models.Preferences{})
+	calendar := NewCalendarService(nil, subscriptions, preferences, models.PeriodCalendar{PayPeriodStart: 1})
+
+	for user, want := range map[string][]string{
+		"alice": {"netflix", "gym"},
+		"bob":   {"netflix", "rent"},
+	} {
+		events, err := calendar.Events(context.Background(), user, now)
+		if err != nil {
+			t.Fatalf("Events(%s) error = %v", user, err)
+		}
+
+		charged := make(map[string]bool)
+		for _, event := range events {
+			for _, subscription := range subscriptions.subscriptions {
+				if event.UID == "subscription-"+subscription.ID+"-20250308@firedragon" {
+					charged[subscription.ID] = true
+				}
+			}
+		}
+		if len(charged) != len(want) {
+			t.Errorf("Events(%s) charges %v, want %v", user, charged, want)
+		}
+		for _, id := range want {
+			if !charged[id] {
+				t.Errorf("Events(%s) lacks the charge of %s", user, id)
+			}
+		}
+	}
+}
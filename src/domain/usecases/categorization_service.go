@@ -0,0 +1,232 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// trainingPageSize is the number of transactions loaded per query while training
+const trainingPageSize = 500
+
+// reviewSuggestions is the number of alternatives listed with a category review
+const reviewSuggestions = 3
+
+// CategorizationService suggests categories for imported transactions with a
+// naive Bayes classifier trained on the user's categorized transactions.
+// Suggestions below the auto-apply confidence are queued for review, and every
+// review teaches the classifier.
+type CategorizationService struct {
+	transactionRepo repositories.TransactionRepository
+	categoryRepo    repositories.CategoryRepository
+	maintenance     *MaintenanceService // optional: refuses reviews in frozen spaces
+	minConfidence   float64             // suggestions below this are dropped
+	autoApply       float64             // suggestions at or above this skip the review queue
+
+	mu         sync.RWMutex
+	classifier *models.CategoryClassifier
+	trainedAt  time.Time
+}
+
+// NewCategorizationService creates a new CategorizationService with an untrained classifier
+func NewCategorizationService(
+	transactionRepo repositories.TransactionRepository,
+	categoryRepo repositories.CategoryRepository,
+) *CategorizationService {
+	return &CategorizationService{
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		minConfidence:   0.3,
+		autoApply:       0.9,
+		classifier:      models.NewCategoryClassifier(),
+	}
+}
+
+// WithThresholds sets the confidence a suggestion needs to be applied at all,
+// and the confidence at which it is applied without review.
+func (s *CategorizationService) WithThresholds(minConfidence, autoApply float64) *CategorizationService {
+	s.minConfidence = minConfidence
+	s.autoApply = autoApply
+	return s
+}
+
+// WithMaintenance refuses to review the transactions of wallets in frozen spaces
+func (s *CategorizationService) WithMaintenance(maintenance *MaintenanceService) *CategorizationService {
+	s.maintenance = maintenance
+	return s
+}
+
+// CategorizationStatus describes the trained classifier
+type CategorizationStatus struct {
+	models.CategoryClassifierStats
+	TrainedAt     time.Time `json:"trainedAt,omitempty"`
+	MinConfidence float64   `json:"minConfidence"`
+	AutoApply     float64   `json:"autoApply"`
+}
+
+// CategoryReview is a transaction whose suggested category awaits review
+type CategoryReview struct {
+	Transaction *models.Transaction         `json:"transaction"`
+	Suggestions []models.CategorySuggestion `json:"suggestions"` // best alternatives, the applied one included
+}
+
+// Status returns the size of the training data and the thresholds in use
+func (s *CategorizationService) Status() CategorizationStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return CategorizationStatus{
+		CategoryClassifierStats: s.classifier.Stats(),
+		TrainedAt:               s.trainedAt,
+		MinConfidence:           s.minConfidence,
+		AutoApply:               s.autoApply,
+	}
+}
+
+// Train rebuilds the classifier from every transaction whose category the user
+// chose, replacing the current model once it is complete.
+func (s *CategorizationService) Train(ctx context.Context) (CategorizationStatus, error) {
+	logger := internal.GetLogger().With().Str("usecase", "TrainCategorization").Logger()
+
+	classifier := models.NewCategoryClassifier()
+	filter := repositories.TransactionFilter{SortBy: "id", Limit: trainingPageSize}
+	for {
+		transactions, err := s.transactionRepo.FindAll(ctx, filter)
+		if err != nil {
+			return s.Status(), fmt.Errorf("failed to list transactions: %w", err)
+		}
+		for _, tx := range transactions {
+			if tx.HasUserCategory() {
+				classifier.Learn(tx)
+			}
+		}
+		if len(transactions) < trainingPageSize {
+			break
+		}
+		filter.Offset += trainingPageSize
+	}
+
+	s.mu.Lock()
+	s.classifier = classifier
+	s.trainedAt = time.Now()
+	s.mu.Unlock()
+
+	status := s.Status()
+	logger.Info().Int("transactions", status.Transactions).Int("categories", status.Categories).
+		Msg("Trained category classifier")
+	return status, nil
+}
+
+// Suggest returns up to limit categories for a transaction, most likely first
+func (s *CategorizationService) Suggest(tx *models.Transaction, limit int) []models.CategorySuggestion {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.classifier.Suggest(tx, limit)
+}
+
+// Categorize applies the best suggestion to an uncategorized transaction and
+// reports whether it did. Suggestions below the auto-apply confidence tag the
+// transaction for review.
+func (s *CategorizationService) Categorize(tx *models.Transaction) bool {
+	if tx.CategoryID != "" {
+		return false
+	}
+
+	suggestions := s.Suggest(tx, 1)
+	if len(suggestions) == 0 || suggestions[0].Confidence < s.minConfidence {
+		return false
+	}
+	suggestion := suggestions[0]
+
+	state := models.CategoryReviewAuto
+	if suggestion.Confidence < s.autoApply {
+		state = models.CategoryReviewPending
+		tx.MergeTags([]string{models.CategoryReviewTag})
+	}
+
+	tx.CategoryID = suggestion.CategoryID
+	tx.MergeMetadata(map[string]string{
+		models.MetadataCategoryReview:     string(state),
+		models.MetadataCategoryConfidence: strconv.FormatFloat(suggestion.Confidence, 'f', 3, 64),
+	})
+	return true
+}
+
+// ListReviews returns the transactions whose suggested category awaits review, newest first
+func (s *CategorizationService) ListReviews(ctx context.Context, limit, offset int) ([]CategoryReview, error) {
+	transactions, err := s.transactionRepo.FindAll(ctx, repositories.TransactionFilter{
+		Metadata: map[string]string{models.MetadataCategoryReview: string(models.CategoryReviewPending)},
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list category reviews: %w", err)
+	}
+
+	reviews := make([]CategoryReview, 0, len(transactions))
+	for _, tx := range transactions {
+		reviews = append(reviews, CategoryReview{Transaction: tx, Suggestions: s.Suggest(tx, reviewSuggestions)})
+	}
+	return reviews, nil
+}
+
+// Review settles the suggested category of a transaction: an empty categoryID
+// accepts it, any other replaces it. Categories applied without review can be
+// corrected the same way. The reviewed transaction is learned right away.
+func (s *CategorizationService) Review(ctx context.Context, id, categoryID string) (*models.Transaction, error) {
+	logger := internal.GetLogger().With().Str("usecase", "ReviewCategory").Str("transactionID", id).Logger()
+
+	tx, err := s.transactionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if tx.IsDeleted() {
+		return nil, fmt.Errorf("transaction %s: %w", id, models.ErrTransactionDeleted)
+	}
+	switch models.CategoryReviewState(tx.Metadata[models.MetadataCategoryReview]) {
+	case models.CategoryReviewPending, models.CategoryReviewAuto:
+	default:
+		return nil, fmt.Errorf("transaction %s: %w", id, models.ErrNoCategoryReview)
+	}
+	if s.maintenance != nil {
+		if err := s.maintenance.CheckWallets(ctx, transactionWallets(tx)...); err != nil {
+			return nil, err
+		}
+	}
+
+	state := models.CategoryReviewAccepted
+	if categoryID != "" && categoryID != tx.CategoryID {
+		if _, err := s.categoryRepo.FindByID(ctx, categoryID); err != nil {
+			return nil, fmt.Errorf("failed to get category: %w", err)
+		}
+		tx.CategoryID = categoryID
+		state = models.CategoryReviewCorrected
+	}
+
+	tags := tx.Tags[:0]
+	for _, tag := range tx.Tags {
+		if tag != models.CategoryReviewTag {
+			tags = append(tags, tag)
+		}
+	}
+	tx.Tags = tags
+	tx.Metadata[models.MetadataCategoryReview] = string(state)
+
+	if err := s.transactionRepo.Update(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+
+	s.mu.Lock()
+	s.classifier.Learn(tx)
+	s.mu.Unlock()
+
+	logger.Debug().Str("state", string(state)).Str("categoryID", tx.CategoryID).Msg("Reviewed suggested category")
+	return tx, nil
+}
@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// CategoryBootstrapReport summarizes a category bootstrap run
+type CategoryBootstrapReport struct {
+	Pack     string   `json:"pack"`
+	Created  []string `json:"created"`  // keys of the categories created
+	Existing []string `json:"existing"` // keys of the categories already present
+}
+
+// CategoryBootstrapService installs the categories of a category pack as
+// system categories
+type CategoryBootstrapService struct {
+	categoryRepo repositories.CategoryRepository
+	pack         *models.CategoryPack
+	translations []models.CategoryTranslations
+}
+
+// NewCategoryBootstrapService creates a new CategoryBootstrapService. The
+// translations name the categories, the first one having a key wins.
+func NewCategoryBootstrapService(
+	categoryRepo repositories.CategoryRepository,
+	pack *models.CategoryPack,
+	translations ...models.CategoryTranslations,
+) *CategoryBootstrapService {
+	return &CategoryBootstrapService{
+		categoryRepo: categoryRepo,
+		pack:         pack,
+		translations: translations,
+	}
+}
+
+// Bootstrap creates the categories of the pack that do not exist yet. It is
+// idempotent: a category is found by its key, or by name for the categories
+// created before keys existed, and is never renamed, so switching the locale
+// later only affects new categories.
+func (s *CategoryBootstrapService) Bootstrap(ctx context.Context) (*CategoryBootstrapReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "CategoryBootstrap").Str("pack", s.pack.Name).Logger()
+
+	report := &CategoryBootstrapReport{
+		Pack:     s.pack.Name,
+		Created:  make([]string, 0),
+		Existing: make([]string, 0),
+	}
+	ids := make(map[string]string)
+
+	for _, def := range s.pack.Flatten(s.translations...) {
+		id, err := s.find(ctx, def)
+		if err != nil {
+			return report, err
+		}
+		if id != "" {
+			ids[def.Key] = id
+			report.Existing = append(report.Existing, def.Key)
+			continue
+		}
+
+		category := models.NewSystemCategory(def.Name, def.Description, def.Type, def.Color)
+		category.Key = def.Key
+		category.ParentID = ids[def.ParentKey]
+		if err := s.categoryRepo.Create(ctx, category); err != nil {
+			return report, fmt.Errorf("failed to create category %s: %w", def.Key, err)
+		}
+		ids[def.Key] = category.ID
+		report.Created = append(report.Created, def.Key)
+	}
+
+	if len(report.Created) > 0 {
+		logger.Info().Int("created", len(report.Created)).Int("existing", len(report.Existing)).Msg("Installed system categories")
+	}
+	return report, nil
+}
+
+// find returns the ID of the shared category matching a pack category, empty
+// when there is none
+func (s *CategoryBootstrapService) find(ctx context.Context, def models.PackCategory) (string, error) {
+	byKey, err := s.categoryRepo.FindAll(ctx, repositories.CategoryFilter{Key: def.Key, Limit: 1})
+	if err != nil {
+		return "", fmt.Errorf("failed to find category %s: %w", def.Key, err)
+	}
+	if len(byKey) > 0 {
+		return byKey[0].ID, nil
+	}
+
+	byName, err := s.categoryRepo.FindAll(ctx, repositories.CategoryFilter{NameLike: def.Name, Type: def.Type})
+	if err != nil {
+		return "", fmt.Errorf("failed to find category %s: %w", def.Key, err)
+	}
+	for _, category := range byName {
+		if category.SpaceID == "" && category.Key == "" && strings.EqualFold(category.Name, def.Name) {
+			return category.ID, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,221 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// DefaultConsentRenewBefore is how long before a consent expires the user is
+// sent its renewal link
+const DefaultConsentRenewBefore = 7 * 24 * time.Hour
+
+// consentClient is implemented by clients whose access is a consent the user
+// grants at the provider for a limited time, like PSD2 bank consents
+type consentClient interface {
+	// ConsentExpiry returns when the consent to an account expires, zero when
+	// unknown. session is the provider session of the last renewal, empty
+	// before the first one.
+	ConsentExpiry(account, session string) (time.Time, error)
+
+	// ConsentURL returns the page where the user grants access to an account
+	// again. The provider redirects back to the callback with state and a code.
+	ConsentURL(account, state string) (string, error)
+
+	// CompleteConsent exchanges the code of the callback for a new consent and
+	// returns when it expires and the provider session it was granted in
+	CompleteConsent(account, code string) (expiresAt time.Time, session string, err error)
+}
+
+// ConsentService renews the consents of sources before they expire: it sends
+// the user a renewal link, expires sources whose consent ran out and lets
+// them sync again once the callback of the link completes the renewal
+type ConsentService struct {
+	sync        *SourceSyncService
+	renewBefore time.Duration
+}
+
+// NewConsentService creates a new ConsentService. A zero renewBefore uses
+// DefaultConsentRenewBefore.
+func NewConsentService(sync *SourceSyncService, renewBefore time.Duration) *ConsentService {
+	if renewBefore <= 0 {
+		renewBefore = DefaultConsentRenewBefore
+	}
+	return &ConsentService{
+		sync:        sync,
+		renewBefore: renewBefore,
+	}
+}
+
+// consentSources returns the sources whose client has a consent, sorted by ID
+func (s *ConsentService) consentSources() []Source {
+	var sources []Source
+	for _, source := range s.sync.sources {
+		if _, ok := source.Client.(consentClient); ok {
+			sources = append(sources, source)
+		}
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].ID() < sources[j].ID() })
+	return sources
+}
+
+// Consents returns the state of every source with a consent, including its
+// expiry and renewal, sorted by source ID
+func (s *ConsentService) Consents() []models.SourceState {
+	sources := s.consentSources()
+	states := make([]models.SourceState, 0, len(sources))
+
+	s.sync.mu.Lock()
+	defer s.sync.mu.Unlock()
+	for _, source := range sources {
+		states = append(states, *s.sync.stateOf(source.ID()))
+	}
+	return states
+}
+
+// CheckConsents expires the sources whose consent ran out and requests the
+// renewal of the consents expiring within the renew-before window, except of
+// disabled sources. It returns the states of the renewals it requested;
+// sources failing to check are logged and skipped.
+func (s *ConsentService) CheckConsents(ctx context.Context) []models.SourceState {
+	ctx, _ = internal.EnsureRequestID(ctx)
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "CheckConsents").Logger()
+
+	var requested []models.SourceState
+	for _, source := range s.consentSources() {
+		id := source.ID()
+		client := source.Client.(consentClient)
+
+		s.sync.mu.Lock()
+		expiresAt, session := s.sync.stateOf(id).ConsentExpiresAt, s.sync.stateOf(id).ConsentSession
+		s.sync.mu.Unlock()
+		if expiresAt.IsZero() {
+			var err error
+			if expiresAt, err = client.ConsentExpiry(source.Account.Account, session); err != nil {
+				logger.Warn().Err(err).Str("sourceID", id).Msg("Failed to read consent expiry")
+				continue
+			}
+			if expiresAt.IsZero() {
+				continue
+			}
+		}
+
+		now := time.Now()
+		due := false
+		state, err := s.sync.updateState(ctx, id, func(state *models.SourceState) error {
+			if state.ConsentExpiresAt.IsZero() {
+				state.ConsentExpiresAt = expiresAt
+			}
+			if state.ExpireConsent(now) {
+				logger.Info().Str("sourceID", id).Msg("Consent expired")
+			}
+			due = state.ConsentDue(now, s.renewBefore) &&
+				state.Lifecycle != models.SourceDisabled && state.Lifecycle != models.SourceUnconfigured
+			return nil
+		})
+		if err != nil || !due {
+			continue
+		}
+
+		if state, err = s.requestRenewal(ctx, source, client); err != nil {
+			logger.Warn().Err(err).Str("sourceID", id).Msg("Failed to request consent renewal")
+			continue
+		}
+		requested = append(requested, *state)
+	}
+	return requested
+}
+
+// RenewConsent requests the renewal of the consent of a source right away,
+// e.g. after it expired, replacing a pending renewal
+func (s *ConsentService) RenewConsent(ctx context.Context, id string) (*models.SourceState, error) {
+	source, err := s.sync.source(id)
+	if err != nil {
+		return nil, err
+	}
+	client, ok := source.Client.(consentClient)
+	if !ok {
+		return nil, fmt.Errorf("source %q: %w", id, models.ErrConsentNotSupported)
+	}
+	return s.requestRenewal(ctx, source, client)
+}
+
+// requestRenewal creates the renewal link of a source and stores it as
+// pending, which notifies the user
+func (s *ConsentService) requestRenewal(ctx context.Context, source Source, client consentClient) (*models.SourceState, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("failed to generate consent state: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	url, err := client.ConsentURL(source.Account.Account, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create renewal link of %s: %w", source.ID(), err)
+	}
+
+	return s.sync.updateState(ctx, source.ID(), func(state *models.SourceState) error {
+		state.RequestRenewal(url, token, time.Now())
+		return nil
+	})
+}
+
+// CompleteConsent handles the redirect back from the provider after the user
+// granted access at a renewal link. Only then does a source whose consent
+// expired sync again. Each state can only be used once.
+func (s *ConsentService) CompleteConsent(ctx context.Context, token, code string) (*models.SourceState, error) {
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "CompleteConsent").Logger()
+
+	var id string
+	s.sync.mu.Lock()
+	for sourceID, state := range s.sync.states {
+		if token != "" && state.Renewal == models.ConsentRenewalPending && state.RenewalToken == token {
+			id = sourceID
+			break
+		}
+	}
+	s.sync.mu.Unlock()
+	if id == "" {
+		return nil, models.ErrInvalidOAuthState
+	}
+	if code == "" {
+		return nil, fmt.Errorf("authorization code is required")
+	}
+
+	source, err := s.sync.source(id)
+	if err != nil {
+		return nil, err
+	}
+	client, ok := source.Client.(consentClient)
+	if !ok {
+		return nil, fmt.Errorf("source %q: %w", id, models.ErrConsentNotSupported)
+	}
+
+	expiresAt, session, err := client.CompleteConsent(source.Account.Account, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete consent of %s: %w", id, err)
+	}
+	now := time.Now()
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(models.ConsentValidity)
+	}
+
+	state, err := s.sync.updateState(ctx, id, func(state *models.SourceState) error {
+		if state.RenewalToken != token {
+			return models.ErrInvalidOAuthState
+		}
+		return state.CompleteRenewal(session, expiresAt, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("sourceID", id).Time("expiresAt", expiresAt).Msg("Consent renewed")
+	return state, nil
+}
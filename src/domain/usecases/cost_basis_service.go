@@ -0,0 +1,393 @@
+package usecases
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// CostBasisService tracks the cost basis of crypto holdings and the capital
+// gains realized by disposing of them. Acquisitions and disposals are valued in
+// the base currency at the provider's rate on the transaction date.
+type CostBasisService struct {
+	walletRepo      repositories.WalletRepository
+	transactionRepo repositories.TransactionRepository
+	rates           *dailyRates
+	baseCurrency    string
+	method          models.CostBasisMethod
+	periods         models.PeriodCalendar
+}
+
+// NewCostBasisService creates a new CostBasisService
+func NewCostBasisService(
+	walletRepo repositories.WalletRepository,
+	transactionRepo repositories.TransactionRepository,
+	rates ExchangeRateProvider,
+	baseCurrency string,
+	method models.CostBasisMethod,
+) *CostBasisService {
+	if method == "" {
+		method = models.CostBasisFIFO
+	}
+
+	return &CostBasisService{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+		rates:           newDailyRates(rates),
+		baseCurrency:    strings.ToUpper(baseCurrency),
+		method:          method,
+	}
+}
+
+// WithPeriods sets the calendar whose fiscal years gains are reported for;
+// calendar years by default
+func (s *CostBasisService) WithPeriods(periods models.PeriodCalendar) *CostBasisService {
+	s.periods = periods
+	return s
+}
+
+// CostBasisOptions controls how gains are computed
+type CostBasisOptions struct {
+	Method       models.CostBasisMethod // defaults to the configured method
+	BaseCurrency string                 // defaults to the service base currency
+}
+
+// AssetGains summarizes the position and gains of a single asset
+type AssetGains struct {
+	Asset      string  `json:"asset"`
+	Quantity   float64 `json:"quantity"`
+	CostBasis  float64 `json:"costBasis"`
+	Price      float64 `json:"price,omitempty"`
+	Value      float64 `json:"value,omitempty"`
+	Realized   float64 `json:"realized"`
+	Unrealized float64 `json:"unrealized"`
+	Error      string  `json:"error,omitempty"` // set when the holdings could not be priced
+}
+
+// CostBasisReport lists realized and unrealized gains per asset
+type CostBasisReport struct {
+	Method          models.CostBasisMethod `json:"method"`
+	BaseCurrency    string                 `json:"baseCurrency"`
+	AsOf            time.Time              `json:"asOf"`
+	Assets          []AssetGains           `json:"assets"`
+	Realized        []models.RealizedGain  `json:"realized"`
+	TotalRealized   float64                `json:"totalRealized"`
+	TotalUnrealized float64                `json:"totalUnrealized"`
+}
+
+// YearGains is the realized gain summary of one fiscal year
+type YearGains struct {
+	Year         int                    `json:"year"`
+	Period       models.Period          `json:"period"`
+	Method       models.CostBasisMethod `json:"method"`
+	BaseCurrency string                 `json:"baseCurrency"`
+	Proceeds     float64                `json:"proceeds"`
+	CostBasis    float64                `json:"costBasis"`
+	ShortTerm    float64                `json:"shortTerm"`
+	LongTerm     float64                `json:"longTerm"`
+	Total        float64                `json:"total"`
+	Disposals    []models.RealizedGain  `json:"disposals"`
+}
+
+// GetReport replays all crypto transactions and reports the gains per asset,
+// valuing the remaining holdings at today's prices
+func (s *CostBasisService) GetReport(ctx context.Context, opts CostBasisOptions) (*CostBasisReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "GetCostBasisReport").Logger()
+	method, base, err := s.resolve(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger, err := s.replay(ctx, method, base)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	report := &CostBasisReport{
+		Method:       method,
+		BaseCurrency: base,
+		AsOf:         now,
+		Assets:       make([]AssetGains, 0),
+		Realized:     ledger.Realized(),
+	}
+
+	realized := make(map[string]float64)
+	for _, gain := range report.Realized {
+		realized[gain.Asset] += gain.Gain
+		report.TotalRealized += gain.Gain
+	}
+
+	for _, asset := range ledger.Assets() {
+		quantity, cost := ledger.Holdings(asset)
+		gains := AssetGains{
+			Asset:     asset,
+			Quantity:  quantity,
+			CostBasis: cost,
+			Realized:  realized[asset],
+		}
+
+		if quantity > models.BalanceTolerance {
+			price, err := s.rates.get(ctx, asset, base, now)
+			if err != nil {
+				// Report the asset without a value rather than failing the whole report
+				logger.Warn().Err(err).Str("asset", asset).Msg("Failed to price holdings")
+				gains.Error = err.Error()
+			} else {
+				gains.Price = price
+				gains.Value = quantity * price
+				gains.Unrealized = gains.Value - cost
+				report.TotalUnrealized += gains.Unrealized
+			}
+		}
+
+		report.Assets = append(report.Assets, gains)
+	}
+
+	return report, nil
+}
+
+// GetYearGains summarizes the gains realized by disposals within the fiscal
+// year starting in the given year
+func (s *CostBasisService) GetYearGains(ctx context.Context, year int, opts CostBasisOptions) (*YearGains, error) {
+	method, base, err := s.resolve(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger, err := s.replay(ctx, method, base)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &YearGains{
+		Year:         year,
+		Period:       s.periods.FiscalYearOf(year),
+		Method:       method,
+		BaseCurrency: base,
+		Disposals:    make([]models.RealizedGain, 0),
+	}
+	for _, gain := range ledger.Realized() {
+		if !summary.Period.Contains(gain.DisposedAt) {
+			continue
+		}
+
+		summary.Disposals = append(summary.Disposals, gain)
+		summary.Proceeds += gain.Proceeds
+		summary.CostBasis += gain.CostBasis
+		if gain.LongTerm {
+			summary.LongTerm += gain.Gain
+		} else {
+			summary.ShortTerm += gain.Gain
+		}
+		summary.Total += gain.Gain
+	}
+
+	return summary, nil
+}
+
+// WriteYearGainsCSV writes one row per disposal of the year, in the layout of
+// common capital gains tax forms
+func (s *CostBasisService) WriteYearGainsCSV(ctx context.Context, w io.Writer, year int, opts CostBasisOptions) error {
+	summary, err := s.GetYearGains(ctx, year, opts)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Write([]string{
+		"description", "date_acquired", "date_sold", "proceeds", "cost_basis", "gain",
+		"term", "currency", "method", "transaction_id",
+	})
+
+	for _, gain := range summary.Disposals {
+		acquired := "VARIOUS"
+		if !gain.AcquiredAt.IsZero() {
+			acquired = gain.AcquiredAt.Format(time.DateOnly)
+		}
+		term := "short"
+		if gain.LongTerm {
+			term = "long"
+		}
+
+		writer.Write([]string{
+			models.FormatAmountCompact(gain.Quantity, gain.Asset) + " " + gain.Asset,
+			acquired,
+			gain.DisposedAt.Format(time.DateOnly),
+			models.FormatAmount(gain.Proceeds, summary.BaseCurrency),
+			models.FormatAmount(gain.CostBasis, summary.BaseCurrency),
+			models.FormatAmount(gain.Gain, summary.BaseCurrency),
+			term,
+			summary.BaseCurrency,
+			string(summary.Method),
+			gain.TransactionID,
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// replay feeds every crypto transaction, oldest first, into a new ledger.
+// Transfers between crypto wallets of the same asset only move holdings and
+// are not taxable; fees paid in crypto are disposals of the wallet currency.
+func (s *CostBasisService) replay(ctx context.Context, method models.CostBasisMethod, base string) (*models.CostBasisLedger, error) {
+	wallets, err := s.walletRepo.FindAll(ctx, repositories.WalletFilter{IncludeArchived: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+	byID := make(map[string]*models.Wallet, len(wallets))
+	for _, wallet := range wallets {
+		byID[wallet.ID] = wallet
+	}
+
+	transactions, err := s.transactionRepo.FindAll(ctx, repositories.TransactionFilter{
+		SortBy:         "date",
+		SortOrder:      "asc",
+		ExcludeSandbox: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	ledger := models.NewCostBasisLedger(method)
+	for _, tx := range transactions {
+		if tx.Status == models.TransactionStatusFailed {
+			continue
+		}
+
+		source := byID[tx.WalletID]
+		if source == nil {
+			continue
+		}
+		asset := transactionAsset(tx, source)
+		sourceCrypto := source.Type == models.WalletTypeCrypto
+
+		switch tx.Type {
+		case models.TransactionTypeIncome:
+			if sourceCrypto {
+				if err := s.acquire(ctx, ledger, base, asset, tx.Amount, tx.Date); err != nil {
+					return nil, err
+				}
+			}
+		case models.TransactionTypeExpense:
+			if sourceCrypto {
+				if err := s.dispose(ctx, ledger, base, asset, tx.Amount, tx); err != nil {
+					return nil, err
+				}
+			}
+		case models.TransactionTypeTransfer:
+			if err := s.transfer(ctx, ledger, base, tx, source, asset, byID[tx.DestWalletID]); err != nil {
+				return nil, err
+			}
+		}
+
+		if sourceCrypto && tx.Fee > 0 {
+			if err := s.dispose(ctx, ledger, base, source.Currency, tx.Fee, tx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return ledger, nil
+}
+
+// transfer books a transfer that exchanges one asset for another. Selling for
+// fiat realizes exactly the fiat received and buying with fiat costs exactly
+// the fiat spent; swaps between crypto assets are valued at market price.
+func (s *CostBasisService) transfer(ctx context.Context, ledger *models.CostBasisLedger, base string,
+	tx *models.Transaction, source *models.Wallet, asset string, dest *models.Wallet) error {
+	sourceCrypto := source.Type == models.WalletTypeCrypto
+	destCrypto := dest != nil && dest.Type == models.WalletTypeCrypto
+	if !sourceCrypto && !destCrypto {
+		return nil
+	}
+	if sourceCrypto && destCrypto && strings.EqualFold(asset, dest.Currency) {
+		return nil // moving holdings between own wallets
+	}
+
+	destAmount := tx.Amount
+	if tx.ExchangeRate > 0 {
+		destAmount *= tx.ExchangeRate
+	}
+
+	valueAsset, valueAmount := asset, tx.Amount
+	if sourceCrypto && dest != nil && !destCrypto {
+		valueAsset, valueAmount = dest.Currency, destAmount
+	}
+	value, err := s.value(ctx, base, valueAsset, valueAmount, tx.Date)
+	if err != nil && sourceCrypto && destCrypto {
+		// A swap is worth what either side is worth; fall back to the received asset
+		value, err = s.value(ctx, base, dest.Currency, destAmount, tx.Date)
+	}
+	if err != nil {
+		return err
+	}
+
+	if sourceCrypto {
+		ledger.Dispose(asset, tx.Amount, value, tx.Date, tx.ID)
+	}
+	if destCrypto {
+		ledger.Acquire(dest.Currency, destAmount, value, tx.Date)
+	}
+	return nil
+}
+
+func (s *CostBasisService) acquire(ctx context.Context, ledger *models.CostBasisLedger, base, asset string, quantity float64, date time.Time) error {
+	cost, err := s.value(ctx, base, asset, quantity, date)
+	if err != nil {
+		return err
+	}
+	ledger.Acquire(asset, quantity, cost, date)
+	return nil
+}
+
+func (s *CostBasisService) dispose(ctx context.Context, ledger *models.CostBasisLedger, base, asset string, quantity float64, tx *models.Transaction) error {
+	proceeds, err := s.value(ctx, base, asset, quantity, tx.Date)
+	if err != nil {
+		return err
+	}
+	ledger.Dispose(asset, quantity, proceeds, tx.Date, tx.ID)
+	return nil
+}
+
+// value converts a quantity of an asset to the base currency on a date
+func (s *CostBasisService) value(ctx context.Context, base, asset string, quantity float64, date time.Time) (float64, error) {
+	rate, err := s.rates.get(ctx, asset, base, date)
+	if err != nil {
+		return 0, fmt.Errorf("failed to price %s on %s: %w", asset, date.Format(time.DateOnly), err)
+	}
+	return quantity * rate, nil
+}
+
+func (s *CostBasisService) resolve(opts CostBasisOptions) (models.CostBasisMethod, string, error) {
+	method := opts.Method
+	if method == "" {
+		method = s.method
+	}
+	if err := method.Validate(); err != nil {
+		return "", "", err
+	}
+
+	base := s.baseCurrency
+	if opts.BaseCurrency != "" {
+		base = strings.ToUpper(opts.BaseCurrency)
+	}
+	return method, base, nil
+}
+
+// transactionAsset returns the asset a transaction moves: token transfers carry
+// their currency in metadata, everything else moves the wallet currency
+func transactionAsset(tx *models.Transaction, wallet *models.Wallet) string {
+	if currency := tx.Metadata["currency"]; currency != "" {
+		return strings.ToUpper(currency)
+	}
+	return strings.ToUpper(wallet.Currency)
+}
@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// DescriptionTemplatesFromConfig parses the configured description templates
+func DescriptionTemplatesFromConfig(cfg internal.DescriptionsConfig) (models.DescriptionTemplates, error) {
+	templates := models.DescriptionTemplates{Sources: make(map[string]*models.DescriptionTemplate, len(cfg.Sources))}
+
+	var err error
+	if cfg.Template != "" {
+		templates.Default, err = models.ParseDescriptionTemplate(cfg.Template)
+		if err != nil {
+			return templates, fmt.Errorf("descriptions.template: %w", err)
+		}
+	}
+
+	for source, text := range cfg.Sources {
+		// An empty override keeps the descriptions of the source client
+		var tmpl *models.DescriptionTemplate
+		if text != "" {
+			tmpl, err = models.ParseDescriptionTemplate(text)
+			if err != nil {
+				return templates, fmt.Errorf("descriptions.sources.%s: %w", source, err)
+			}
+		}
+		templates.Sources[source] = tmpl
+	}
+
+	return templates, nil
+}
+
+// DescriptionPreviewInput is a transaction to describe with a template
+type DescriptionPreviewInput struct {
+	Source      string             `json:"source"`
+	Template    string             `json:"template"` // empty previews the configured template of the source
+	Transaction models.Transaction `json:"transaction"`
+}
+
+// DescriptionPreview is the rendered description of a transaction
+type DescriptionPreview struct {
+	Template    string                   `json:"template"`
+	Fields      models.DescriptionFields `json:"fields"`
+	Description string                   `json:"description"`
+}
@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// DefaultCheckTimeout bounds each startup check
+const DefaultCheckTimeout = 15 * time.Second
+
+// StartupCheck verifies one configured dependency. Run returns a short
+// detail for the report, e.g. a version. A failed fatal check keeps the
+// server from starting, unless the error is retryable: a provider that is
+// unreachable or throttling may come back, rejected credentials will not.
+type StartupCheck struct {
+	Name  string
+	Fatal bool
+	Run   func(ctx context.Context) (string, error)
+}
+
+// CheckResult is the outcome of a startup check
+type CheckResult struct {
+	Name      string               `json:"name"`
+	OK        bool                 `json:"ok"`
+	Fatal     bool                 `json:"fatal,omitempty"` // the failure keeps the server from starting
+	LatencyMS int64                `json:"latencyMs"`
+	Detail    string               `json:"detail,omitempty"`
+	Error     string               `json:"error,omitempty"`
+	ErrorType interfaces.ErrorType `json:"errorType,omitempty"`
+}
+
+// DiagnosticsReport is the outcome of the startup checks, in registration order
+type DiagnosticsReport struct {
+	OK        bool          `json:"ok"` // no fatal check failed
+	StartedAt time.Time     `json:"startedAt"`
+	LatencyMS int64         `json:"latencyMs"`
+	Results   []CheckResult `json:"results"`
+}
+
+// Failed returns the failed checks
+func (r *DiagnosticsReport) Failed() []CheckResult {
+	var failed []CheckResult
+	for _, result := range r.Results {
+		if !result.OK {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// DiagnosticsService runs the startup self-checks of the configured dependencies
+type DiagnosticsService struct {
+	checks  []StartupCheck
+	timeout time.Duration
+}
+
+// NewDiagnosticsService creates a new DiagnosticsService. A zero timeout uses DefaultCheckTimeout.
+func NewDiagnosticsService(timeout time.Duration) *DiagnosticsService {
+	if timeout <= 0 {
+		timeout = DefaultCheckTimeout
+	}
+	return &DiagnosticsService{timeout: timeout}
+}
+
+// WithCheck adds a check
+func (s *DiagnosticsService) WithCheck(check StartupCheck) *DiagnosticsService {
+	s.checks = append(s.checks, check)
+	return s
+}
+
+// Run runs every check concurrently, each within the service timeout
+func (s *DiagnosticsService) Run(ctx context.Context) *DiagnosticsReport {
+	logger := internal.GetLogger().With().Str("usecase", "RunDiagnostics").Logger()
+
+	report := &DiagnosticsReport{
+		OK:        true,
+		StartedAt: time.Now(),
+		Results:   make([]CheckResult, len(s.checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, check := range s.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Results[i] = s.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	for _, result := range report.Results {
+		if !result.OK && result.Fatal {
+			report.OK = false
+		}
+	}
+	report.LatencyMS = time.Since(report.StartedAt).Milliseconds()
+
+	logger.Info().Bool("ok", report.OK).Int("failed", len(report.Failed())).Msg("Startup checks finished")
+	return report
+}
+
+// run runs one check, giving up when it outlives the timeout. Like the source
+// self-tests, a check that ignores ctx keeps running in the background.
+func (s *DiagnosticsService) run(ctx context.Context, check StartupCheck) CheckResult {
+	result := CheckResult{Name: check.Name}
+	start := time.Now()
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	type outcome struct {
+		detail string
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- outcome{err: fmt.Errorf("check panicked: %v", r)}
+			}
+		}()
+		detail, err := check.Run(ctx)
+		done <- outcome{detail: detail, err: err}
+	}()
+
+	var err error
+	select {
+	case o := <-done:
+		result.Detail, err = o.detail, o.err
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result.LatencyMS = time.Since(start).Milliseconds()
+	if err != nil {
+		result.Error = err.Error()
+		result.ErrorType = interfaces.ErrorTypeOf(err)
+		result.Fatal = check.Fatal && !interfaces.IsRetryable(err)
+		return result
+	}
+	result.OK = true
+	return result
+}
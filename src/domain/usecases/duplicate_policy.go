@@ -0,0 +1,99 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// DuplicatePoliciesFromConfig builds the duplicate policies from the configuration.
+// Per-source overrides inherit unset fields from the global policy.
+func DuplicatePoliciesFromConfig(cfg internal.DuplicatesConfig) (models.DuplicatePolicies, error) {
+	policies := models.DuplicatePolicies{
+		Default: mergeDuplicatePolicy(models.DefaultDuplicatePolicy(), cfg.DuplicatePolicyConfig),
+		Sources: make(map[string]models.DuplicatePolicy, len(cfg.Sources)),
+	}
+	if err := policies.Default.Validate(); err != nil {
+		return policies, fmt.Errorf("duplicates: %w", err)
+	}
+
+	for source, override := range cfg.Sources {
+		policy := mergeDuplicatePolicy(policies.Default, override)
+		if err := policy.Validate(); err != nil {
+			return policies, fmt.Errorf("duplicates.sources.%s: %w", source, err)
+		}
+		policies.Sources[source] = policy
+	}
+
+	return policies, nil
+}
+
+// mergeDuplicatePolicy overrides the fields of base that are set in cfg
+func mergeDuplicatePolicy(base models.DuplicatePolicy, cfg internal.DuplicatePolicyConfig) models.DuplicatePolicy {
+	if cfg.Window != 0 {
+		base.Window = cfg.Window
+	}
+	if cfg.Tolerance != 0 {
+		base.Tolerance = cfg.Tolerance
+	}
+	if cfg.Action != "" {
+		base.Action = models.DuplicateAction(cfg.Action)
+	}
+	return base
+}
+
+// DuplicateDecision records how the duplicate policy treated a single transaction
+type DuplicateDecision struct {
+	Index        int                    `json:"index"` // position in the submitted batch
+	Description  string                 `json:"description"`
+	Action       models.DuplicateAction `json:"action"`
+	Matches      []string               `json:"matches"`                // IDs of the existing transactions it matched
+	BatchMatches []int                  `json:"batchMatches,omitempty"` // positions of the earlier transactions of the batch it matched
+}
+
+// checkBatchDuplicate adds the earlier transactions of a batch that tx matches
+// under the policy to decision, creating it on the first match. The batch is
+// not stored yet, so checkDuplicate cannot find them.
+func checkBatchDuplicate(decision *DuplicateDecision, tx *models.Transaction, policy models.DuplicatePolicy,
+	loc *time.Location, earlier []*models.Transaction, positions []int) *DuplicateDecision {
+	for i, other := range earlier {
+		if !policy.Matches(tx, other, loc) {
+			continue
+		}
+		if decision == nil {
+			decision = &DuplicateDecision{Description: tx.Description, Action: policy.Action, Matches: []string{}}
+		}
+		decision.BatchMatches = append(decision.BatchMatches, positions[i])
+	}
+	return decision
+}
+
+// checkDuplicate looks for existing transactions matching tx under the policy,
+// comparing whole calendar days of loc when it is set. It returns nil when
+// there is no match.
+func checkDuplicate(ctx context.Context, transactionRepo repositories.TransactionRepository, tx *models.Transaction,
+	policy models.DuplicatePolicy, loc *time.Location) (*DuplicateDecision, error) {
+	from, to := policy.Range(tx.Date, loc)
+	duplicates, err := transactionRepo.FindDuplicates(ctx, tx, from, to, policy.Tolerance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for duplicates: %w", err)
+	}
+	if len(duplicates) == 0 {
+		return nil, nil
+	}
+
+	decision := &DuplicateDecision{
+		Description: tx.Description,
+		Action:      policy.Action,
+		Matches:     make([]string, 0, len(duplicates)),
+	}
+	for _, duplicate := range duplicates {
+		decision.Matches = append(decision.Matches, duplicate.ID)
+	}
+
+	return decision, nil
+}
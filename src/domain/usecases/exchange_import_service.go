@@ -0,0 +1,214 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// ExchangeParser parses the account history export of an exchange.
+// The fileimport profiles satisfy it.
+type ExchangeParser interface {
+	Name() string
+	Title() string
+	Parse(r io.Reader) ([]models.ExchangeMovement, error)
+}
+
+// ExchangeImportService imports exchange history exports. Every asset held on
+// an exchange gets its own wallet, e.g. "Kraken BTC"; trades become transfers
+// between those wallets at the traded rate, so the cost-basis engine sees fiat
+// purchases and sales at their exact prices.
+type ExchangeImportService struct {
+	walletRepo      repositories.WalletRepository
+	transactionRepo repositories.TransactionRepository
+	imports         *ImportService
+	parsers         map[string]ExchangeParser
+}
+
+// NewExchangeImportService creates a new ExchangeImportService
+func NewExchangeImportService(
+	walletRepo repositories.WalletRepository,
+	transactionRepo repositories.TransactionRepository,
+	imports *ImportService,
+	parsers ...ExchangeParser,
+) *ExchangeImportService {
+	byName := make(map[string]ExchangeParser, len(parsers))
+	for _, parser := range parsers {
+		byName[parser.Name()] = parser
+	}
+
+	return &ExchangeImportService{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+		imports:         imports,
+		parsers:         byName,
+	}
+}
+
+// ExchangeProfile describes a supported export format
+type ExchangeProfile struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+// ExchangeImportReport summarizes the import of one export file
+type ExchangeImportReport struct {
+	Profile   string          `json:"profile"`
+	Movements int             `json:"movements"`
+	Skipped   int             `json:"skipped"` // imported by an earlier run
+	Imported  int             `json:"imported"`
+	Wallets   []*ImportReport `json:"wallets"`
+}
+
+// ListProfiles returns the supported export formats sorted by name
+func (s *ExchangeImportService) ListProfiles() []ExchangeProfile {
+	profiles := make([]ExchangeProfile, 0, len(s.parsers))
+	for _, parser := range s.parsers {
+		profiles = append(profiles, ExchangeProfile{Name: parser.Name(), Title: parser.Title()})
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles
+}
+
+// ImportFile parses an export with the named profile and imports its
+// movements. Movements imported by an earlier run are skipped, so overlapping
+// exports can be imported repeatedly.
+func (s *ExchangeImportService) ImportFile(ctx context.Context, profile string, r io.Reader) (*ExchangeImportReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "ImportExchangeFile").Str("profile", profile).Logger()
+
+	parser, ok := s.parsers[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile %q: %w", profile, models.ErrUnknownImportProfile)
+	}
+
+	movements, err := parser.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidImportFile, err)
+	}
+
+	report := &ExchangeImportReport{
+		Profile:   profile,
+		Movements: len(movements),
+		Wallets:   make([]*ImportReport, 0),
+	}
+
+	wallets := make(map[string]*models.Wallet)
+	walletFor := func(asset string) (*models.Wallet, error) {
+		if wallet, ok := wallets[asset]; ok {
+			return wallet, nil
+		}
+
+		walletType := models.WalletTypeCrypto
+		if models.IsFiatCurrency(asset) {
+			walletType = models.WalletTypeBank
+		}
+		wallet, err := findOrCreateWallet(ctx, s.walletRepo, parser.Title()+" "+asset, "Imported from "+parser.Title()+" exports", asset, walletType)
+		if err != nil {
+			return nil, err
+		}
+		wallets[asset] = wallet
+		return wallet, nil
+	}
+
+	// Batch per source wallet, keeping the order in which wallets first appear
+	batches := make(map[string][]*models.Transaction)
+	var order []string
+	for _, movement := range movements {
+		source, err := walletFor(movement.Asset)
+		if err != nil {
+			return nil, err
+		}
+
+		known, err := alreadyImported(ctx, s.transactionRepo, s.imports.ledger, source.ID, movement.ID, movement.Date)
+		if err != nil {
+			return nil, err
+		}
+		if known {
+			report.Skipped++
+			continue
+		}
+
+		tx := exchangeTransaction(parser.Title(), movement)
+		if movement.Kind == models.ExchangeTrade {
+			dest, err := walletFor(movement.ToAsset)
+			if err != nil {
+				return nil, err
+			}
+			tx.DestWalletID = dest.ID
+			tx.ExchangeRate = movement.Rate()
+		}
+
+		if _, ok := batches[source.ID]; !ok {
+			order = append(order, source.ID)
+		}
+		batches[source.ID] = append(batches[source.ID], tx)
+	}
+
+	for _, walletID := range order {
+		walletReport, err := s.imports.Import(ctx, ImportInput{
+			Source:       profile,
+			WalletID:     walletID,
+			Transactions: batches[walletID],
+		})
+		if walletReport != nil {
+			report.Wallets = append(report.Wallets, walletReport)
+			report.Imported += walletReport.Imported
+		}
+		if err != nil {
+			return report, fmt.Errorf("failed to import into wallet %s: %w", walletID, err)
+		}
+	}
+
+	logger.Info().
+		Int("movements", report.Movements).
+		Int("skipped", report.Skipped).
+		Int("imported", report.Imported).
+		Msg("Exchange file imported")
+
+	return report, nil
+}
+
+// exchangeTransaction converts a movement into a transaction of its source wallet
+func exchangeTransaction(exchange string, movement models.ExchangeMovement) *models.Transaction {
+	tx := &models.Transaction{
+		Amount: movement.Amount,
+		Date:   movement.Date,
+		Fee:    movement.Fee,
+		Metadata: map[string]string{
+			MetadataExternalID:          movement.ID,
+			models.MetadataCategoryHint: movement.Category,
+			"currency":                  movement.Asset,
+			"exchange":                  exchange,
+			"counterparty":              exchange,
+			"kind":                      string(movement.Kind),
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if movement.Note != "" {
+		tx.Metadata["note"] = movement.Note
+	}
+
+	switch movement.Kind {
+	case models.ExchangeTrade:
+		tx.Type = models.TransactionTypeTransfer
+		tx.Description = fmt.Sprintf("Trade %g %s for %g %s on %s", movement.Amount, movement.Asset, movement.ToAmount, movement.ToAsset, exchange)
+	case models.ExchangeDeposit:
+		tx.Type = models.TransactionTypeIncome
+		tx.Description = fmt.Sprintf("Deposit %g %s to %s", movement.Amount, movement.Asset, exchange)
+	case models.ExchangeWithdrawal:
+		tx.Type = models.TransactionTypeExpense
+		tx.Description = fmt.Sprintf("Withdrawal %g %s from %s", movement.Amount, movement.Asset, exchange)
+	case models.ExchangeFee:
+		tx.Type = models.TransactionTypeExpense
+		tx.Description = fmt.Sprintf("%s fee %g %s", exchange, movement.Amount, movement.Asset)
+	}
+
+	return tx
+}
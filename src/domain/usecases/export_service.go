@@ -0,0 +1,416 @@
+package usecases
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// exportPageSize is the number of transactions loaded per query while exporting
+const exportPageSize = 500
+
+// ExportStore keeps the files of asynchronous exports. storage.FileStore satisfies it.
+type ExportStore interface {
+	Save(ctx context.Context, key string, r io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// ExportService exports local data as CSV or JSON. Exports are written as they
+// are read, so they can be streamed to the client; exports too large for a
+// request are written to the file storage in the background instead.
+type ExportService struct {
+	walletRepo      repositories.WalletRepository
+	transactionRepo repositories.TransactionRepository
+	categoryRepo    repositories.CategoryRepository
+	store           ExportStore    // optional: enables asynchronous exports
+	publisher       EventPublisher // optional: announces finished exports
+
+	mu   sync.Mutex
+	jobs map[string]*models.ExportJob
+}
+
+// NewExportService creates a new ExportService
+func NewExportService(
+	walletRepo repositories.WalletRepository,
+	transactionRepo repositories.TransactionRepository,
+	categoryRepo repositories.CategoryRepository,
+) *ExportService {
+	return &ExportService{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		jobs:            make(map[string]*models.ExportJob),
+	}
+}
+
+// WithStore enables asynchronous exports into the given file storage
+func (s *ExportService) WithStore(store ExportStore) *ExportService {
+	s.store = store
+	return s
+}
+
+// WithPublisher publishes an export.ready event when an asynchronous export finishes
+func (s *ExportService) WithPublisher(publisher EventPublisher) *ExportService {
+	s.publisher = publisher
+	return s
+}
+
+// Export writes the requested records to w and returns their number
+func (s *ExportService) Export(ctx context.Context, w io.Writer, request models.ExportRequest) (int, error) {
+	if err := request.Validate(); err != nil {
+		return 0, err
+	}
+
+	switch request.Dataset {
+	case models.ExportWallets:
+		return s.exportWallets(ctx, w, request)
+	case models.ExportCategories:
+		return s.exportCategories(ctx, w, request)
+	default:
+		return s.exportTransactions(ctx, w, request)
+	}
+}
+
+// StartExport writes the requested records to the file storage in the background
+func (s *ExportService) StartExport(request models.ExportRequest) (*models.ExportJob, error) {
+	if s.store == nil {
+		return nil, fmt.Errorf("asynchronous exports require a file storage")
+	}
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	job := models.NewExportJob(request)
+	s.mu.Lock()
+	s.jobs[job.ID] = job
+	started := *job
+	s.mu.Unlock()
+
+	go s.run(job)
+	return &started, nil
+}
+
+// GetJob returns an asynchronous export
+func (s *ExportService) GetJob(id string) (*models.ExportJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, models.ErrExportNotFound
+	}
+	snapshot := *job
+	return &snapshot, nil
+}
+
+// OpenJob returns the file of a finished asynchronous export
+func (s *ExportService) OpenJob(ctx context.Context, id string) (*models.ExportJob, io.ReadCloser, error) {
+	job, err := s.GetJob(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job.Status != models.ExportJobCompleted {
+		return job, nil, fmt.Errorf("export %s is %s: %w", id, job.Status, models.ErrExportNotReady)
+	}
+
+	file, err := s.store.Open(ctx, job.FileKey)
+	if err != nil {
+		return job, nil, err
+	}
+	return job, file, nil
+}
+
+func (s *ExportService) run(job *models.ExportJob) {
+	logger := internal.GetLogger().With().Str("usecase", "Export").Str("export", job.ID).Logger()
+	ctx := context.Background()
+
+	// The export is produced into a pipe the store reads from
+	reader, writer := io.Pipe()
+	exported := make(chan int, 1)
+	go func() {
+		records, err := s.Export(ctx, writer, job.Request)
+		writer.CloseWithError(err)
+		exported <- records
+	}()
+	err := s.store.Save(ctx, job.FileKey, reader)
+	reader.CloseWithError(err) // unblocks the export when the store gave up
+	records := <-exported
+
+	s.mu.Lock()
+	if err != nil {
+		job.Fail(err)
+	} else {
+		job.Complete(records)
+	}
+	finished := *job
+	s.mu.Unlock()
+
+	if err != nil {
+		logger.Error().Err(err).Msg("Export failed")
+		return
+	}
+	logger.Info().Int("records", records).Msg("Export finished")
+
+	if s.publisher == nil {
+		return
+	}
+	event := interfaces.NewEvent(interfaces.EventTypeExportReady, "export").
+		WithTarget(finished.ID).
+		WithData("exportId", finished.ID).
+		WithData("dataset", string(finished.Request.Dataset)).
+		WithData("format", string(finished.Request.Format)).
+		WithData("fileName", finished.FileName).
+		WithData("records", finished.Records)
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		logger.Warn().Err(err).Msg("Failed to publish export.ready")
+	}
+}
+
+func (s *ExportService) exportTransactions(ctx context.Context, w io.Writer, request models.ExportRequest) (int, error) {
+	wallets, err := s.walletNames(ctx)
+	if err != nil {
+		return 0, err
+	}
+	categories, err := s.categoryNames(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := newExportWriter(w, request.Format, []string{
+		"id", "date", "type", "status", "amount", "fee", "currency", "description",
+		"category", "wallet", "dest_wallet", "exchange_rate", "tags", "notes",
+		"reference", "end_to_end_id", "firefly_id", "created_at", "updated_at",
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	filter := repositories.TransactionFilter{
+		WalletID:   request.WalletID,
+		CategoryID: request.CategoryID,
+		DateFrom:   request.DateFrom,
+		DateTo:     request.DateTo,
+		SortBy:     "date",
+		SortOrder:  "asc",
+		Limit:      exportPageSize,
+	}
+	for {
+		page, err := s.transactionRepo.FindAll(ctx, filter)
+		if err != nil {
+			return out.count, fmt.Errorf("failed to list transactions: %w", err)
+		}
+
+		for _, tx := range page {
+			wallet := wallets[tx.WalletID]
+			err := out.write(tx, []string{
+				tx.ID,
+				tx.Date.Format(time.RFC3339),
+				string(tx.Type),
+				string(tx.Status),
+				models.FormatAmountCompact(tx.Amount, wallet.Currency),
+				models.FormatAmountCompact(tx.Fee, wallet.Currency),
+				wallet.Currency,
+				tx.Description,
+				categories[tx.CategoryID],
+				wallet.Name,
+				wallets[tx.DestWalletID].Name,
+				formatExportNumber(tx.ExchangeRate),
+				strings.Join(tx.Tags, ";"),
+				tx.Notes,
+				tx.Reference,
+				tx.EndToEndID,
+				tx.FireflyID,
+				tx.CreatedAt.Format(time.RFC3339),
+				tx.UpdatedAt.Format(time.RFC3339),
+			})
+			if err != nil {
+				return out.count, err
+			}
+		}
+		if err := out.flush(); err != nil {
+			return out.count, err
+		}
+
+		if len(page) < exportPageSize {
+			break
+		}
+		filter.Offset += exportPageSize
+	}
+
+	return out.count, out.close()
+}
+
+func (s *ExportService) exportWallets(ctx context.Context, w io.Writer, request models.ExportRequest) (int, error) {
+	wallets, err := s.walletRepo.FindAll(ctx, repositories.WalletFilter{IncludeArchived: true, SortBy: "name"})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	out, err := newExportWriter(w, request.Format, []string{
+		"id", "name", "description", "type", "currency", "balance", "archived", "created_at", "updated_at",
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, wallet := range wallets {
+		if request.WalletID != "" && wallet.ID != request.WalletID {
+			continue
+		}
+		err := out.write(wallet, []string{
+			wallet.ID,
+			wallet.Name,
+			wallet.Description,
+			string(wallet.Type),
+			wallet.Currency,
+			models.FormatAmountCompact(wallet.Balance, wallet.Currency),
+			strconv.FormatBool(wallet.Archived),
+			wallet.CreatedAt.Format(time.RFC3339),
+			wallet.UpdatedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return out.count, err
+		}
+	}
+
+	return out.count, out.close()
+}
+
+func (s *ExportService) exportCategories(ctx context.Context, w io.Writer, request models.ExportRequest) (int, error) {
+	categories, err := s.categoryRepo.FindAll(ctx, repositories.CategoryFilter{SortBy: "name"})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	out, err := newExportWriter(w, request.Format, []string{
+		"id", "name", "description", "type", "color", "system", "created_at", "updated_at",
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, category := range categories {
+		if request.CategoryID != "" && category.ID != request.CategoryID {
+			continue
+		}
+		err := out.write(category, []string{
+			category.ID,
+			category.Name,
+			category.Description,
+			string(category.Type),
+			category.Color,
+			strconv.FormatBool(category.IsSystem),
+			category.CreatedAt.Format(time.RFC3339),
+			category.UpdatedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return out.count, err
+		}
+	}
+
+	return out.count, out.close()
+}
+
+// walletNames indexes every wallet, archived ones included, by ID
+func (s *ExportService) walletNames(ctx context.Context) (map[string]models.Wallet, error) {
+	wallets, err := s.walletRepo.FindAll(ctx, repositories.WalletFilter{IncludeArchived: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	byID := make(map[string]models.Wallet, len(wallets))
+	for _, wallet := range wallets {
+		byID[wallet.ID] = *wallet
+	}
+	return byID, nil
+}
+
+// categoryNames maps category IDs to names
+func (s *ExportService) categoryNames(ctx context.Context) (map[string]string, error) {
+	categories, err := s.categoryRepo.FindAll(ctx, repositories.CategoryFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	names := make(map[string]string, len(categories))
+	for _, category := range categories {
+		names[category.ID] = category.Name
+	}
+	return names, nil
+}
+
+// exportWriter writes records as CSV rows or as the elements of a JSON array
+type exportWriter struct {
+	format models.ExportFormat
+	w      io.Writer
+	csv    *csv.Writer
+	count  int
+}
+
+func newExportWriter(w io.Writer, format models.ExportFormat, header []string) (*exportWriter, error) {
+	out := &exportWriter{format: format, w: w}
+	if format == models.ExportJSON {
+		_, err := io.WriteString(w, "[")
+		return out, err
+	}
+
+	out.csv = csv.NewWriter(w)
+	return out, out.csv.Write(header)
+}
+
+// write writes one record; CSV exports use the row, JSON exports the record itself
+func (o *exportWriter) write(record any, row []string) error {
+	if o.format != models.ExportJSON {
+		o.count++
+		return o.csv.Write(row)
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+	separator := ",\n"
+	if o.count == 0 {
+		separator = "\n"
+	}
+	if _, err := io.WriteString(o.w, separator); err != nil {
+		return err
+	}
+	if _, err := o.w.Write(data); err != nil {
+		return err
+	}
+	o.count++
+	return nil
+}
+
+// flush sends the buffered CSV rows on to the underlying writer
+func (o *exportWriter) flush() error {
+	if o.csv == nil {
+		return nil
+	}
+	o.csv.Flush()
+	return o.csv.Error()
+}
+
+func (o *exportWriter) close() error {
+	if o.format == models.ExportJSON {
+		_, err := io.WriteString(o.w, "\n]\n")
+		return err
+	}
+	return o.flush()
+}
+
+func formatExportNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+)
+
+// FieldEncryptionService encrypts the sensitive fields of records with
+// envelope encryption: each space has its own data key, and data keys are
+// stored wrapped with the master key. Unwrapped keys are cached; a space gets
+// its first data key when it first encrypts a field.
+type FieldEncryptionService struct {
+	keyRepo         repositories.DataKeyRepository
+	transactionRepo repositories.TransactionRepository
+
+	mu     sync.Mutex
+	keys   map[string]*models.DataKey // by ID
+	active map[string]*models.DataKey // by space ID
+}
+
+// NewFieldEncryptionService creates a new FieldEncryptionService
+func NewFieldEncryptionService(keyRepo repositories.DataKeyRepository) *FieldEncryptionService {
+	return &FieldEncryptionService{
+		keyRepo: keyRepo,
+		keys:    make(map[string]*models.DataKey),
+		active:  make(map[string]*models.DataKey),
+	}
+}
+
+// WithTransactions sets the transaction repository re-encrypted on key rotation.
+// The repository encrypts through this service, so it is set after both exist.
+func (s *FieldEncryptionService) WithTransactions(transactionRepo repositories.TransactionRepository) *FieldEncryptionService {
+	s.transactionRepo = transactionRepo
+	return s
+}
+
+// EncryptField encrypts value with the active data key of a space. Values
+// that are already encrypted are returned unchanged.
+func (s *FieldEncryptionService) EncryptField(ctx context.Context, spaceID, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if _, ok := models.EncryptedFieldKeyID(value); ok {
+		return value, nil
+	}
+
+	key, err := s.activeKey(ctx, spaceID)
+	if err != nil {
+		return "", err
+	}
+
+	return key.EncryptField(value)
+}
+
+// DecryptField decrypts a value with the data key that encrypted it.
+// Plaintext values are returned unchanged.
+func (s *FieldEncryptionService) DecryptField(ctx context.Context, value string) (string, error) {
+	keyID, ok := models.EncryptedFieldKeyID(value)
+	if !ok {
+		return value, nil
+	}
+
+	key, err := s.key(ctx, keyID)
+	if err != nil {
+		return "", err
+	}
+
+	return key.DecryptField(value)
+}
+
+// RotateDataKeys gives every space holding a data key a new one, and
+// encrypts the fields of every transaction again with the new keys. Old keys
+// are kept to read anything written while the rotation runs.
+func (s *FieldEncryptionService) RotateDataKeys(ctx context.Context) (*models.KeyRotationReport, error) {
+	keys, err := s.keyRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data keys: %w", err)
+	}
+
+	report := &models.KeyRotationReport{}
+	for _, old := range keys {
+		if !old.Active {
+			continue
+		}
+
+		key, err := models.NewDataKey(old.SpaceID)
+		if err != nil {
+			return report, err
+		}
+		if err := s.keyRepo.Create(ctx, key); err != nil {
+			return report, fmt.Errorf("failed to rotate data key of space %q: %w", old.SpaceID, err)
+		}
+		s.cache(key)
+		report.DataKeys++
+	}
+
+	if s.transactionRepo != nil {
+		report.Reencrypted, err = s.transactionRepo.ReencryptFields(ctx)
+		if err != nil {
+			return report, fmt.Errorf("failed to re-encrypt transactions: %w", err)
+		}
+	}
+
+	return report, nil
+}
+
+// RotateMasterKey wraps every data key with a new master key. The encrypted
+// fields are untouched, since their data keys do not change.
+func (s *FieldEncryptionService) RotateMasterKey(ctx context.Context, masterKey string) (*models.KeyRotationReport, error) {
+	if len(masterKey) != 32 {
+		return nil, fmt.Errorf("new master key must be 32 bytes long")
+	}
+
+	rewrapped, err := s.keyRepo.Rewrap(ctx, masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.KeyRotationReport{DataKeys: rewrapped}, nil
+}
+
+// activeKey returns the active data key of a space, creating it if the space has none
+func (s *FieldEncryptionService) activeKey(ctx context.Context, spaceID string) (*models.DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.active[spaceID]; ok {
+		return key, nil
+	}
+
+	key, err := s.keyRepo.FindActive(ctx, spaceID)
+	if errors.Is(err, models.ErrDataKeyNotFound) {
+		if key, err = models.NewDataKey(spaceID); err != nil {
+			return nil, err
+		}
+		err = s.keyRepo.Create(ctx, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data key of space %q: %w", spaceID, err)
+	}
+
+	s.keys[key.ID] = key
+	s.active[spaceID] = key
+	return key, nil
+}
+
+// key returns a data key by ID
+func (s *FieldEncryptionService) key(ctx context.Context, id string) (*models.DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.keys[id]; ok {
+		return key, nil
+	}
+
+	key, err := s.keyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.keys[id] = key
+	return key, nil
+}
+
+// cache makes key the active key of its space
+func (s *FieldEncryptionService) cache(key *models.DataKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key.ID] = key
+	s.active[key.SpaceID] = key
+}
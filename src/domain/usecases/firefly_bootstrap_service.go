@@ -0,0 +1,203 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// BootstrapAccountResult is the provisioning outcome for a single source account
+type BootstrapAccountResult struct {
+	Source           string  `json:"source"`
+	Account          string  `json:"account"`
+	FireflyAccountID string  `json:"fireflyAccountId,omitempty"`
+	Currency         string  `json:"currency,omitempty"`
+	OpeningBalance   float64 `json:"openingBalance,omitempty"`
+	Created          bool    `json:"created"`
+	Warning          string  `json:"warning,omitempty"`
+	Error            string  `json:"error,omitempty"`
+}
+
+// BootstrapReport summarizes a bootstrap run
+type BootstrapReport struct {
+	CreatedCurrencies []string                 `json:"createdCurrencies"`
+	EnabledCurrencies []string                 `json:"enabledCurrencies"`
+	Accounts          []BootstrapAccountResult `json:"accounts"`
+}
+
+// FireflyBootstrapService makes sure the Firefly currencies and asset accounts
+// of all configured sources exist before transactions are imported
+type FireflyBootstrapService struct {
+	firefly  interfaces.FireflyClient
+	accounts *AccountMappingService
+	sources  []Source
+}
+
+// NewFireflyBootstrapService creates a new FireflyBootstrapService
+func NewFireflyBootstrapService(firefly interfaces.FireflyClient, accounts *AccountMappingService, sources []Source) *FireflyBootstrapService {
+	return &FireflyBootstrapService{
+		firefly:  firefly,
+		accounts: accounts,
+		sources:  sources,
+	}
+}
+
+// Bootstrap provisions currencies and accounts for every configured source.
+// It is idempotent: existing currencies and mapped accounts are left untouched.
+// Per-source failures are recorded in the report; the returned error joins them.
+func (s *FireflyBootstrapService) Bootstrap(ctx context.Context) (*BootstrapReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "FireflyBootstrap").Logger()
+
+	report := &BootstrapReport{
+		CreatedCurrencies: make([]string, 0),
+		EnabledCurrencies: make([]string, 0),
+		Accounts:          make([]BootstrapAccountResult, 0, len(s.sources)),
+	}
+	ensured := make(map[string]error)
+
+	var errs []error
+	for _, source := range s.sources {
+		ref := source.Account
+		result := BootstrapAccountResult{Source: ref.Source, Account: ref.Account}
+
+		var opening OpeningBalance
+		if source.Client != nil {
+			var currency string
+			var err error
+			opening, currency, err = s.openingBalance(source)
+			if err != nil {
+				logger.Warn().Err(err).Str("source", ref.Source).Str("account", ref.Account).Msg("Failed to derive opening balance")
+				result.Warning = "opening balance unavailable: " + err.Error()
+			} else if ref.Currency == "" {
+				ref.Currency = currency
+			}
+		}
+		ref.Currency = strings.ToUpper(ref.Currency)
+		result.Currency = ref.Currency
+
+		// Make sure the currency exists and is enabled, once per currency
+		if ref.Currency != "" {
+			err, done := ensured[ref.Currency]
+			if !done {
+				err = s.ensureCurrency(ctx, ref.Currency, report)
+				ensured[ref.Currency] = err
+			}
+			if err != nil {
+				result.Error = err.Error()
+				report.Accounts = append(report.Accounts, result)
+				errs = append(errs, fmt.Errorf("%s account %s: %w", ref.Source, ref.Account, err))
+				continue
+			}
+		}
+
+		id, created, err := s.accounts.EnsureAccount(ctx, ref, opening)
+		if err != nil {
+			result.Error = err.Error()
+			report.Accounts = append(report.Accounts, result)
+			errs = append(errs, fmt.Errorf("%s account %s: %w", ref.Source, ref.Account, err))
+			continue
+		}
+
+		result.FireflyAccountID = id
+		result.Created = created
+		if created {
+			result.OpeningBalance = opening.Amount
+		} else if warning := s.checkCurrency(ctx, id, ref.Currency); warning != "" {
+			result.Warning = warning
+		}
+
+		report.Accounts = append(report.Accounts, result)
+	}
+
+	logger.Info().
+		Int("accounts", len(report.Accounts)).
+		Strs("createdCurrencies", report.CreatedCurrencies).
+		Int("errors", len(errs)).
+		Msg("Firefly bootstrap complete")
+
+	return report, errors.Join(errs...)
+}
+
+// openingBalance derives the opening balance of a source account from its
+// current balance and the transactions it reports, which are imported into
+// Firefly after the account is created. The opening balance is the balance
+// before the earliest of them, dated the day before, so the imported history
+// adds up to the current balance instead of counting twice.
+func (s *FireflyBootstrapService) openingBalance(source Source) (OpeningBalance, string, error) {
+	balance, _, err := source.Balance()
+	if err != nil {
+		return OpeningBalance{}, "", err
+	}
+	transactions, _, err := source.FetchTransactions()
+	if err != nil {
+		return OpeningBalance{}, "", fmt.Errorf("failed to fetch the transactions to import: %w", err)
+	}
+
+	opening := OpeningBalance{Amount: balance.Amount, Date: time.Now()}
+	for _, tx := range transactions {
+		tx.WalletID = source.ID()
+		opening.Amount -= tx.BalanceEffects()[tx.WalletID]
+		if date := tx.Date.AddDate(0, 0, -1); date.Before(opening.Date) {
+			opening.Date = date
+		}
+	}
+	return opening, balance.Currency, nil
+}
+
+// ensureCurrency creates or enables a currency in Firefly
+func (s *FireflyBootstrapService) ensureCurrency(ctx context.Context, code string, report *BootstrapReport) error {
+	currency, err := s.firefly.GetCurrency(ctx, code)
+	if err != nil {
+		var clientErr *interfaces.ClientError
+		if !errors.As(err, &clientErr) || clientErr.Type != interfaces.ErrorTypeNotFound {
+			return fmt.Errorf("failed to look up currency %s: %w", code, err)
+		}
+
+		// Firefly stores at most 12 decimal places, fewer than ether has
+		decimals := min(models.CurrencyDecimals(code), interfaces.FireflyMaxDecimals)
+		if _, err := s.firefly.CreateCurrency(ctx, interfaces.FireflyCurrency{
+			Code:          code,
+			Name:          code,
+			Symbol:        code,
+			DecimalPlaces: decimals,
+		}); err != nil {
+			return fmt.Errorf("failed to create currency %s: %w", code, err)
+		}
+
+		report.CreatedCurrencies = append(report.CreatedCurrencies, code)
+		return nil
+	}
+
+	if !currency.Enabled {
+		if err := s.firefly.EnableCurrency(ctx, code); err != nil {
+			return fmt.Errorf("failed to enable currency %s: %w", code, err)
+		}
+		report.EnabledCurrencies = append(report.EnabledCurrencies, code)
+	}
+
+	return nil
+}
+
+// checkCurrency returns a warning when an existing account uses a different currency
+func (s *FireflyBootstrapService) checkCurrency(ctx context.Context, accountID, currency string) string {
+	if currency == "" {
+		return ""
+	}
+
+	account, err := s.firefly.GetAccount(ctx, accountID)
+	if err != nil {
+		return "failed to verify account currency: " + err.Error()
+	}
+
+	if account.CurrencyCode != "" && !strings.EqualFold(account.CurrencyCode, currency) {
+		return fmt.Sprintf("Firefly account uses %s but the source reports %s", account.CurrencyCode, currency)
+	}
+
+	return ""
+}
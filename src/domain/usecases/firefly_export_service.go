@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// FireflyExportService books imported transactions in Firefly III
+type FireflyExportService struct {
+	firefly         interfaces.FireflyClient
+	accounts        *AccountMappingService
+	transactionRepo repositories.TransactionRepository
+}
+
+// NewFireflyExportService creates a new FireflyExportService
+func NewFireflyExportService(
+	firefly interfaces.FireflyClient,
+	accounts *AccountMappingService,
+	transactionRepo repositories.TransactionRepository,
+) *FireflyExportService {
+	return &FireflyExportService{
+		firefly:         firefly,
+		accounts:        accounts,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// FireflyExportInput describes a transaction to book in Firefly
+type FireflyExportInput struct {
+	Transaction  *models.Transaction
+	Account      AccountRef  // source account the transaction was imported from
+	DestAccount  *AccountRef // destination account, for transfers
+	Currency     string
+	Counterparty string // expense/revenue account name; Firefly creates it if missing
+	CategoryName string
+}
+
+// ExportTransaction books a transaction in Firefly, links the local record to it
+// and returns the Firefly transaction ID. Already linked transactions are skipped.
+func (s *FireflyExportService) ExportTransaction(ctx context.Context, input FireflyExportInput) (string, error) {
+	logger := internal.GetLogger().With().Str("usecase", "ExportTransaction").Logger()
+	tx := input.Transaction
+
+	if tx.IsLinked() {
+		return tx.FireflyID, nil
+	}
+
+	accountID, err := s.accounts.Resolve(ctx, input.Account)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve Firefly account: %w", err)
+	}
+
+	// Prefer the payee the source reported over the description, so payments to
+	// one payee share an expense or revenue account in Firefly
+	counterparty := input.Counterparty
+	if counterparty == "" {
+		counterparty = tx.Metadata["counterparty"]
+	}
+	if counterparty == "" {
+		counterparty = tx.Description
+	}
+
+	fireflyTx := interfaces.FireflyTransaction{
+		Date:         tx.Date,
+		Amount:       tx.Amount,
+		Description:  tx.Description,
+		CurrencyCode: input.Currency,
+		CategoryName: input.CategoryName,
+		Tags:         tx.Tags,
+		Notes:        tx.Notes,
+		ExternalID:   tx.ID,
+
+		InternalReference: tx.Reference,
+		SepaCtID:          tx.EndToEndID,
+	}
+
+	switch tx.Type {
+	case models.TransactionTypeIncome:
+		fireflyTx.Type = "deposit"
+		fireflyTx.SourceName = counterparty
+		fireflyTx.DestinationID = accountID
+	case models.TransactionTypeExpense:
+		fireflyTx.Type = "withdrawal"
+		fireflyTx.SourceID = accountID
+		fireflyTx.DestinationName = counterparty
+	case models.TransactionTypeTransfer:
+		if input.DestAccount == nil {
+			return "", models.ErrMissingDestWallet
+		}
+		destID, err := s.accounts.Resolve(ctx, *input.DestAccount)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve Firefly destination account: %w", err)
+		}
+		fireflyTx.Type = "transfer"
+		fireflyTx.SourceID = accountID
+		fireflyTx.DestinationID = destID
+	default:
+		return "", fmt.Errorf("unsupported transaction type %q", tx.Type)
+	}
+
+	id, err := s.firefly.CreateTransaction(ctx, fireflyTx)
+	if err != nil {
+		logger.Error().Err(err).Str("transactionID", tx.ID).Msg("Failed to create Firefly transaction")
+		return "", fmt.Errorf("failed to create Firefly transaction: %w", err)
+	}
+
+	if err := s.transactionRepo.SetFireflyID(ctx, tx.ID, id); err != nil {
+		// The Firefly transaction carries our ID as external_id, so RepairLinks can recover
+		logger.Error().Err(err).Str("transactionID", tx.ID).Str("fireflyID", id).Msg("Failed to link transaction")
+		return id, fmt.Errorf("failed to link transaction to Firefly: %w", err)
+	}
+	tx.FireflyID = id
+
+	logger.Debug().Str("transactionID", tx.ID).Str("fireflyID", id).Msg("Exported transaction to Firefly")
+	return id, nil
+}
@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// linkRepairBatchSize is the number of transactions loaded per page during repair
+const linkRepairBatchSize = 200
+
+// FireflyLinkService maintains the links between local transactions and Firefly III
+type FireflyLinkService struct {
+	firefly         interfaces.FireflyClient
+	transactionRepo repositories.TransactionRepository
+}
+
+// NewFireflyLinkService creates a new FireflyLinkService
+func NewFireflyLinkService(firefly interfaces.FireflyClient, transactionRepo repositories.TransactionRepository) *FireflyLinkService {
+	return &FireflyLinkService{
+		firefly:         firefly,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// LinkRepairReport summarizes a RepairLinks run
+type LinkRepairReport struct {
+	Checked  int      `json:"checked"`
+	Linked   int      `json:"linked"`
+	Unmapped int      `json:"unmapped"` // no Firefly transaction carries the local ID
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// RepairLinks re-links unlinked local transactions by searching Firefly for
+// transactions whose external_id is the local transaction ID. With dryRun set
+// nothing is written.
+func (s *FireflyLinkService) RepairLinks(ctx context.Context, dryRun bool) (*LinkRepairReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "RepairLinks").Bool("dryRun", dryRun).Logger()
+	report := &LinkRepairReport{}
+
+	offset := 0
+	for {
+		transactions, err := s.transactionRepo.FindAll(ctx, repositories.TransactionFilter{
+			OnlyUnlinked:   true,
+			IncludeDeleted: true,
+			SortBy:         "id",
+			Limit:          linkRepairBatchSize,
+			Offset:         offset,
+		})
+		if err != nil {
+			return report, fmt.Errorf("failed to list unlinked transactions: %w", err)
+		}
+
+		linkedInPage := 0
+		for _, tx := range transactions {
+			if err := ctx.Err(); err != nil {
+				return report, err
+			}
+			report.Checked++
+
+			fireflyID, err := s.firefly.FindTransactionByExternalID(ctx, tx.ID)
+			if err != nil {
+				var clientErr *interfaces.ClientError
+				if errors.As(err, &clientErr) && clientErr.Type == interfaces.ErrorTypeNotFound {
+					report.Unmapped++
+					continue
+				}
+				report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", tx.ID, err))
+				continue
+			}
+
+			if !dryRun {
+				if err := s.transactionRepo.SetFireflyID(ctx, tx.ID, fireflyID); err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", tx.ID, err))
+					continue
+				}
+				linkedInPage++
+			}
+			report.Linked++
+		}
+
+		if len(transactions) < linkRepairBatchSize {
+			break
+		}
+		// Linked records drop out of the unlinked set, so only skip the ones that remain
+		offset += len(transactions) - linkedInPage
+	}
+
+	logger.Info().
+		Int("checked", report.Checked).
+		Int("linked", report.Linked).
+		Int("unmapped", report.Unmapped).
+		Int("errors", len(report.Errors)).
+		Msg("Firefly link repair complete")
+
+	return report, nil
+}
+
+// LinkTransactions relates two exported transactions in Firefly, reading as
+// "outward <link type outward phrase> inward", e.g. a refund linked with
+// interfaces.FireflyLinkRefund to the purchase it refunds. An existing identical
+// link is returned instead of creating a duplicate.
+func (s *FireflyLinkService) LinkTransactions(ctx context.Context, outwardID, inwardID, linkType, notes string) (*interfaces.FireflyLink, error) {
+	logger := internal.GetLogger().With().Str("usecase", "LinkTransactions").Logger()
+
+	outward, err := s.journalID(ctx, outwardID)
+	if err != nil {
+		return nil, err
+	}
+	inward, err := s.journalID(ctx, inwardID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.firefly.ListLinks(ctx, outward)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Firefly links: %w", err)
+	}
+	for i := range existing {
+		if existing[i].OutwardID == outward && existing[i].InwardID == inward {
+			return &existing[i], nil
+		}
+	}
+
+	link, err := s.firefly.CreateLink(ctx, interfaces.FireflyLinkRequest{
+		LinkType:  linkType,
+		InwardID:  inward,
+		OutwardID: outward,
+		Notes:     notes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Firefly link: %w", err)
+	}
+
+	logger.Info().Str("outward", outwardID).Str("inward", inwardID).Str("type", linkType).Msg("Linked transactions in Firefly")
+	return link, nil
+}
+
+// journalID returns the Firefly journal ID of the first split of an exported transaction
+func (s *FireflyLinkService) journalID(ctx context.Context, transactionID string) (string, error) {
+	tx, err := s.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find transaction %s: %w", transactionID, err)
+	}
+	if !tx.IsLinked() {
+		return "", fmt.Errorf("transaction %s: %w", transactionID, models.ErrTransactionNotExported)
+	}
+
+	group, err := s.firefly.GetTransaction(ctx, tx.FireflyID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Firefly transaction %s: %w", tx.FireflyID, err)
+	}
+	if len(group.Splits) == 0 {
+		return "", fmt.Errorf("firefly transaction %s has no splits", tx.FireflyID)
+	}
+
+	return group.Splits[0].JournalID, nil
+}
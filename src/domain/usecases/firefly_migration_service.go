@@ -0,0 +1,353 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// FireflyMigrationSource is the import source of data migrated from Firefly III.
+// Account mappings of this source map local wallet IDs to Firefly account IDs.
+const FireflyMigrationSource = "firefly"
+
+// fireflyWalletAccountTypes are the Firefly account types migrated as wallets.
+// Expense and revenue accounts are counterparties and only kept by name.
+var fireflyWalletAccountTypes = []string{"asset", "liabilities"}
+
+// FireflyMigrationService copies the accounts, categories and transactions of
+// an existing Firefly III instance into the local database, so users adopting
+// FireDragon keep their history. Migrated transactions stay linked to their
+// Firefly transactions, and every migrated account is recorded as an account
+// mapping, so running the migration again only adds what is new.
+type FireflyMigrationService struct {
+	firefly         interfaces.FireflyClient
+	walletRepo      repositories.WalletRepository
+	categoryRepo    repositories.CategoryRepository
+	transactionRepo repositories.TransactionRepository
+	mappingRepo     repositories.AccountMappingRepository
+}
+
+// NewFireflyMigrationService creates a new FireflyMigrationService
+func NewFireflyMigrationService(
+	firefly interfaces.FireflyClient,
+	walletRepo repositories.WalletRepository,
+	categoryRepo repositories.CategoryRepository,
+	transactionRepo repositories.TransactionRepository,
+	mappingRepo repositories.AccountMappingRepository,
+) *FireflyMigrationService {
+	return &FireflyMigrationService{
+		firefly:         firefly,
+		walletRepo:      walletRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+		mappingRepo:     mappingRepo,
+	}
+}
+
+// FireflyMigrationOptions restricts a migration
+type FireflyMigrationOptions struct {
+	Start  time.Time // only migrate transactions booked on or after; zero migrates all
+	End    time.Time // only migrate transactions booked on or before; zero migrates all
+	DryRun bool      // report what would be migrated without writing anything
+}
+
+// FireflyMigrationReport summarizes a migration
+type FireflyMigrationReport struct {
+	DryRun            bool                    `json:"dryRun"`
+	WalletsCreated    int                     `json:"walletsCreated"`
+	WalletsMapped     int                     `json:"walletsMapped"` // migrated by an earlier run
+	CategoriesCreated int                     `json:"categoriesCreated"`
+	Groups            int                     `json:"groups"`
+	Migrated          int                     `json:"migrated"`
+	AlreadyMigrated   int                     `json:"alreadyMigrated"` // groups linked by an earlier run
+	Skipped           int                     `json:"skipped"`         // splits not touching a migrated account
+	Errors            []string                `json:"errors,omitempty"`
+	Balances          []FireflyMigrationDrift `json:"balances"`
+	StartedAt         time.Time               `json:"startedAt"`
+	FinishedAt        time.Time               `json:"finishedAt"`
+}
+
+// FireflyMigrationDrift compares a migrated wallet with its Firefly account
+type FireflyMigrationDrift struct {
+	WalletID         string  `json:"walletId"`
+	FireflyAccountID string  `json:"fireflyAccountId"`
+	Name             string  `json:"name"`
+	Balance          float64 `json:"balance"`
+	FireflyBalance   float64 `json:"fireflyBalance"`
+	Drift            float64 `json:"drift"`
+}
+
+// fireflyWallet is a Firefly account together with its local wallet
+type fireflyWallet struct {
+	account interfaces.FireflyAccount
+	wallet  *models.Wallet
+}
+
+// Migrate copies accounts, categories and transactions from Firefly III
+func (s *FireflyMigrationService) Migrate(ctx context.Context, opts FireflyMigrationOptions) (*FireflyMigrationReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "MigrateFirefly").Bool("dryRun", opts.DryRun).Logger()
+	report := &FireflyMigrationReport{
+		DryRun:    opts.DryRun,
+		Balances:  make([]FireflyMigrationDrift, 0),
+		StartedAt: time.Now(),
+	}
+
+	wallets, err := s.migrateAccounts(ctx, opts, report)
+	if err != nil {
+		return report, err
+	}
+
+	categories, err := s.migrateCategories(ctx, opts, report)
+	if err != nil {
+		return report, err
+	}
+
+	// Groups are converted as they stream in, so only the local transactions
+	// are held in memory
+	var pending []*models.Transaction
+	var lookupErr error
+	filter := interfaces.FireflyTransactionFilter{Start: opts.Start, End: opts.End}
+	err = s.firefly.EachTransaction(ctx, filter, func(group interfaces.FireflyTransactionGroup) error {
+		report.Groups++
+		_, err := s.transactionRepo.FindByFireflyID(ctx, group.ID)
+		if err == nil {
+			report.AlreadyMigrated++
+			return nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			lookupErr = err
+			return err
+		}
+
+		for _, split := range group.Splits {
+			tx := fireflyMigratedTransaction(group, split, wallets, categories)
+			if tx == nil {
+				report.Skipped++
+				continue
+			}
+			if err := tx.Validate(); err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("transaction %s/%s: %v", group.ID, split.JournalID, err))
+				continue
+			}
+			pending = append(pending, tx)
+		}
+		return nil
+	})
+	if lookupErr != nil {
+		return report, lookupErr
+	}
+	if err != nil {
+		return report, fmt.Errorf("failed to list Firefly transactions: %w", err)
+	}
+
+	if opts.DryRun {
+		report.Migrated = len(pending)
+	} else {
+		created, err := s.transactionRepo.CreateMany(ctx, pending)
+		report.Migrated = created
+		if err != nil {
+			report.Errors = append(report.Errors, err.Error())
+		}
+
+		deltas := make(map[string]float64)
+		for _, tx := range pending[:created] {
+			for walletID, delta := range tx.BalanceEffects() {
+				deltas[walletID] += delta
+			}
+		}
+		for walletID, delta := range deltas {
+			if delta == 0 {
+				continue
+			}
+			if err := s.walletRepo.UpdateBalance(ctx, walletID, delta); err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("wallet %s balance: %v", walletID, err))
+			}
+		}
+	}
+
+	// Compare the migrated balances with Firefly; a complete migration has no drift
+	for _, account := range wallets {
+		drift := FireflyMigrationDrift{
+			WalletID:         account.wallet.ID,
+			FireflyAccountID: account.account.ID,
+			Name:             account.account.Name,
+			FireflyBalance:   account.account.CurrentBalance,
+		}
+		if wallet, err := s.walletRepo.FindByID(ctx, account.wallet.ID); err == nil {
+			drift.Balance = wallet.Balance
+		}
+		drift.Drift = drift.Balance - drift.FireflyBalance
+		report.Balances = append(report.Balances, drift)
+	}
+
+	report.FinishedAt = time.Now()
+	logger.Info().
+		Int("wallets", report.WalletsCreated).
+		Int("categories", report.CategoriesCreated).
+		Int("migrated", report.Migrated).
+		Int("alreadyMigrated", report.AlreadyMigrated).
+		Int("skipped", report.Skipped).
+		Msg("Firefly migration finished")
+
+	return report, nil
+}
+
+// migrateAccounts creates a wallet for every asset and liability account and
+// returns them keyed by Firefly account ID
+func (s *FireflyMigrationService) migrateAccounts(ctx context.Context, opts FireflyMigrationOptions, report *FireflyMigrationReport) (map[string]*fireflyWallet, error) {
+	mappings, err := s.mappingRepo.FindAll(ctx, FireflyMigrationSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load account mappings: %w", err)
+	}
+	mapped := make(map[string]string, len(mappings)) // Firefly account ID -> wallet ID
+	for _, mapping := range mappings {
+		mapped[mapping.FireflyAccountID] = mapping.SourceAccount
+	}
+
+	wallets := make(map[string]*fireflyWallet)
+	for _, accountType := range fireflyWalletAccountTypes {
+		accounts, err := s.firefly.ListAccounts(ctx, accountType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Firefly %s accounts: %w", accountType, err)
+		}
+
+		for _, account := range accounts {
+			if walletID, ok := mapped[account.ID]; ok {
+				wallet, err := s.walletRepo.FindByID(ctx, walletID)
+				if err == nil {
+					wallets[account.ID] = &fireflyWallet{account: account, wallet: wallet}
+					report.WalletsMapped++
+					continue
+				}
+				// The wallet was deleted since; migrate the account again
+			}
+
+			wallet := models.NewWallet(account.Name, "Migrated from Firefly III", account.CurrencyCode, fireflyWalletType(account))
+			if err := wallet.Validate(); err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("account %s: %v", account.ID, err))
+				continue
+			}
+			if !account.Active {
+				wallet.Archive()
+			}
+
+			if !opts.DryRun {
+				if err := s.walletRepo.Create(ctx, wallet); err != nil {
+					return nil, fmt.Errorf("failed to create wallet for account %s: %w", account.ID, err)
+				}
+				mapping := models.NewAccountMapping(FireflyMigrationSource, wallet.ID, account.ID)
+				mapping.IBAN = models.NormalizeIBAN(account.IBAN)
+				if err := s.mappingRepo.Create(ctx, mapping); err != nil {
+					return nil, fmt.Errorf("failed to map account %s: %w", account.ID, err)
+				}
+			}
+			wallets[account.ID] = &fireflyWallet{account: account, wallet: wallet}
+			report.WalletsCreated++
+		}
+	}
+
+	return wallets, nil
+}
+
+// migrateCategories creates the Firefly categories missing locally and returns
+// the local category IDs keyed by lowercase name
+func (s *FireflyMigrationService) migrateCategories(ctx context.Context, opts FireflyMigrationOptions, report *FireflyMigrationReport) (map[string]string, error) {
+	categories, err := s.firefly.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Firefly categories: %w", err)
+	}
+
+	ids := make(map[string]string, len(categories))
+	for _, category := range categories {
+		id, err := findCategoryIDByName(ctx, s.categoryRepo, category.Name)
+		if err == nil {
+			ids[strings.ToLower(category.Name)] = id
+			continue
+		}
+		if !errors.Is(err, models.ErrCategoryNotFound) {
+			return nil, err
+		}
+
+		// Firefly categories have no type; most categorize spending
+		local := models.NewCategory(category.Name, category.Notes, models.CategoryTypeExpense, "")
+		if !opts.DryRun {
+			if err := s.categoryRepo.Create(ctx, local); err != nil {
+				return nil, fmt.Errorf("failed to create category %q: %w", category.Name, err)
+			}
+		}
+		ids[strings.ToLower(category.Name)] = local.ID
+		report.CategoriesCreated++
+	}
+
+	return ids, nil
+}
+
+// fireflyMigratedTransaction converts a split into a local transaction, or
+// returns nil when neither side of the split is a migrated account
+func fireflyMigratedTransaction(group interfaces.FireflyTransactionGroup, split interfaces.FireflyTransactionSplit,
+	wallets map[string]*fireflyWallet, categories map[string]string) *models.Transaction {
+	source, fromWallet := wallets[split.SourceID]
+	dest, toWallet := wallets[split.DestinationID]
+
+	tx := &models.Transaction{
+		Amount:      split.Amount,
+		Description: split.Description,
+		Date:        split.Date,
+		Status:      models.TransactionStatusCompleted,
+		CategoryID:  categories[strings.ToLower(split.CategoryName)],
+		Tags:        split.Tags,
+		Notes:       split.Notes,
+		Reference:   split.InternalReference,
+		EndToEndID:  split.SepaCtID,
+		FireflyID:   group.ID,
+		Metadata: map[string]string{
+			"source":           FireflyMigrationSource,
+			"fireflyJournalId": split.JournalID,
+			"fireflyType":      split.Type,
+			"currency":         split.CurrencyCode,
+		},
+		CreatedAt: group.CreatedAt,
+		UpdatedAt: group.UpdatedAt,
+	}
+	if split.ExternalID != "" {
+		tx.Metadata[MetadataExternalID] = split.ExternalID
+	}
+	if split.BudgetName != "" {
+		tx.Metadata["budget"] = split.BudgetName
+	}
+
+	switch {
+	case fromWallet && toWallet:
+		tx.Type = models.TransactionTypeTransfer
+		tx.WalletID = source.wallet.ID
+		tx.DestWalletID = dest.wallet.ID
+	case toWallet:
+		tx.Type = models.TransactionTypeIncome
+		tx.WalletID = dest.wallet.ID
+		tx.Metadata["counterparty"] = split.SourceName
+	case fromWallet:
+		tx.Type = models.TransactionTypeExpense
+		tx.WalletID = source.wallet.ID
+		tx.Metadata["counterparty"] = split.DestinationName
+	default:
+		return nil
+	}
+
+	return tx
+}
+
+// fireflyWalletType guesses the wallet type of a Firefly account
+func fireflyWalletType(account interfaces.FireflyAccount) models.WalletType {
+	if account.Type == "cash" || strings.Contains(strings.ToLower(account.Name), "cash") {
+		return models.WalletTypeCash
+	}
+	return models.WalletTypeBank
+}
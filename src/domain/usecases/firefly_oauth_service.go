@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// oauthStateTTL is how long an authorization request may take to complete
+const oauthStateTTL = 10 * time.Minute
+
+// FireflyAuthorizer runs the Firefly OAuth2 authorization code flow
+type FireflyAuthorizer interface {
+	// AuthCodeURL returns the consent page URL for state
+	AuthCodeURL(state string) string
+
+	// Exchange trades an authorization code for a token and stores it
+	Exchange(ctx context.Context, code string) error
+}
+
+// FireflyOAuthService connects FireDragon to Firefly III through OAuth2
+type FireflyOAuthService struct {
+	authorizer FireflyAuthorizer
+
+	mu     sync.Mutex
+	states map[string]time.Time // pending state -> expiry
+}
+
+// NewFireflyOAuthService creates a new FireflyOAuthService
+func NewFireflyOAuthService(authorizer FireflyAuthorizer) *FireflyOAuthService {
+	return &FireflyOAuthService{
+		authorizer: authorizer,
+		states:     make(map[string]time.Time),
+	}
+}
+
+// StartAuthorization returns the Firefly consent page URL the user has to visit
+func (s *FireflyOAuthService) StartAuthorization() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate oauth state: %w", err)
+	}
+	state := hex.EncodeToString(buf)
+
+	s.mu.Lock()
+	now := time.Now()
+	for pending, expiry := range s.states {
+		if now.After(expiry) {
+			delete(s.states, pending)
+		}
+	}
+	s.states[state] = now.Add(oauthStateTTL)
+	s.mu.Unlock()
+
+	return s.authorizer.AuthCodeURL(state), nil
+}
+
+// CompleteAuthorization handles the redirect back from Firefly. Each state can only be used once.
+func (s *FireflyOAuthService) CompleteAuthorization(ctx context.Context, state, code string) error {
+	logger := internal.GetLogger().With().Str("usecase", "CompleteAuthorization").Logger()
+
+	s.mu.Lock()
+	expiry, ok := s.states[state]
+	delete(s.states, state)
+	s.mu.Unlock()
+
+	if !ok || time.Now().After(expiry) {
+		return models.ErrInvalidOAuthState
+	}
+	if code == "" {
+		return fmt.Errorf("authorization code is required")
+	}
+
+	if err := s.authorizer.Exchange(ctx, code); err != nil {
+		return err
+	}
+
+	logger.Info().Msg("Firefly OAuth authorization completed")
+	return nil
+}
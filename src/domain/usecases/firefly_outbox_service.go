@@ -0,0 +1,279 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// DefaultFireflyOutboxBatch is the number of entries delivered per drain when none is configured
+const DefaultFireflyOutboxBatch = 100
+
+// FireflyOutboxService queues imported transactions for Firefly III and
+// delivers them when Firefly is reachable. It caches Firefly's availability:
+// after a delivery fails because Firefly is unreachable, drains are skipped
+// until the backoff elapses, so an outage costs one request per retry instead
+// of one per queued transaction.
+type FireflyOutboxService struct {
+	outboxRepo   repositories.FireflyOutboxRepository
+	exporter     *FireflyExportService
+	firefly      interfaces.FireflyClient
+	walletRepo   repositories.WalletRepository
+	categoryRepo repositories.CategoryRepository
+	txRepo       repositories.TransactionRepository
+	accounts     map[string]AccountRef // source accounts by wallet name, lower-cased
+	batch        int
+
+	mu               sync.Mutex
+	draining         bool
+	outages          int
+	unavailableUntil time.Time
+	lastError        string
+	lastDrainAt      time.Time
+}
+
+// NewFireflyOutboxService creates a new FireflyOutboxService. The transactions
+// of a source's wallet are booked on the Firefly account of the source; other
+// wallets use the account they were mapped to by a Firefly migration.
+func NewFireflyOutboxService(
+	outboxRepo repositories.FireflyOutboxRepository,
+	exporter *FireflyExportService,
+	firefly interfaces.FireflyClient,
+	walletRepo repositories.WalletRepository,
+	categoryRepo repositories.CategoryRepository,
+	txRepo repositories.TransactionRepository,
+	sources []Source,
+) *FireflyOutboxService {
+	accounts := make(map[string]AccountRef, len(sources))
+	for _, source := range sources {
+		accounts[strings.ToLower(source.Account.Name)] = source.Account
+	}
+	return &FireflyOutboxService{
+		outboxRepo:   outboxRepo,
+		exporter:     exporter,
+		firefly:      firefly,
+		walletRepo:   walletRepo,
+		categoryRepo: categoryRepo,
+		txRepo:       txRepo,
+		accounts:     accounts,
+		batch:        DefaultFireflyOutboxBatch,
+	}
+}
+
+// WithBatchSize sets the number of entries delivered per drain
+func (s *FireflyOutboxService) WithBatchSize(batch int) *FireflyOutboxService {
+	if batch > 0 {
+		s.batch = batch
+	}
+	return s
+}
+
+// Enqueue queues transactions for delivery. Transactions already linked to
+// Firefly or already queued, and sandbox test data, are skipped.
+func (s *FireflyOutboxService) Enqueue(ctx context.Context, transactions []*models.Transaction) (int, error) {
+	now := time.Now()
+	entries := make([]*models.FireflyOutboxEntry, 0, len(transactions))
+	for _, tx := range transactions {
+		if tx.ID == "" || tx.IsLinked() || tx.IsSandbox() {
+			continue
+		}
+		entries = append(entries, models.NewFireflyOutboxEntry(tx.ID, now))
+	}
+	return s.outboxRepo.Enqueue(ctx, entries)
+}
+
+// Stats returns the number of queued transactions and Firefly's availability
+func (s *FireflyOutboxService) Stats(ctx context.Context) (*models.FireflyOutboxStats, error) {
+	pending, err := s.outboxRepo.CountPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := &models.FireflyOutboxStats{
+		Pending:     pending,
+		Available:   !time.Now().Before(s.unavailableUntil),
+		LastError:   s.lastError,
+		LastDrainAt: s.lastDrainAt,
+	}
+	if !stats.Available {
+		stats.RetryAt = s.unavailableUntil
+	}
+	return stats, nil
+}
+
+// Drain delivers the due entries, oldest first. It stops at the first failure
+// that marks Firefly as unreachable; entries failing for other reasons are
+// retried with their own backoff. Unless force is set, nothing is attempted
+// while Firefly is considered unreachable.
+func (s *FireflyOutboxService) Drain(ctx context.Context, force bool) (*models.FireflyOutboxReport, error) {
+	ctx, _ = internal.EnsureRequestID(ctx)
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "DrainFireflyOutbox").Logger()
+	report := &models.FireflyOutboxReport{}
+
+	s.mu.Lock()
+	if s.draining || (!force && time.Now().Before(s.unavailableUntil)) {
+		s.mu.Unlock()
+		report.Skipped = true
+		return report, nil
+	}
+	s.draining = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.draining = false
+		s.lastDrainAt = time.Now()
+		s.mu.Unlock()
+	}()
+
+	entries, err := s.outboxRepo.FindDue(ctx, time.Now(), s.batch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+
+		fireflyID, err := s.deliver(ctx, entry)
+		now := time.Now()
+		switch {
+		case err != nil:
+			entry.Fail(err, now)
+			report.Failed++
+			report.Errors = append(report.Errors, fmt.Sprintf("transaction %s: %v", entry.TransactionID, err))
+		case fireflyID == "":
+			report.Discarded++
+		default:
+			entry.Deliver(fireflyID, now)
+			report.Delivered++
+		}
+		if err := s.outboxRepo.Update(ctx, entry); err != nil {
+			return report, err
+		}
+
+		if err != nil && interfaces.IsRetryable(err) {
+			s.markUnavailable(err)
+			logger.Warn().Err(err).Int("queued", len(entries)-report.Delivered-report.Discarded).
+				Msg("Firefly is unreachable, postponing delivery")
+			break
+		}
+		if err == nil {
+			s.markAvailable()
+		}
+	}
+
+	if report.Delivered > 0 || report.Failed > 0 {
+		logger.Info().
+			Int("delivered", report.Delivered).
+			Int("discarded", report.Discarded).
+			Int("failed", report.Failed).
+			Msg("Firefly outbox drained")
+	}
+	return report, nil
+}
+
+// deliver books the transaction of an entry in Firefly and returns its Firefly
+// ID. It returns an empty ID without error when the entry was discarded.
+func (s *FireflyOutboxService) deliver(ctx context.Context, entry *models.FireflyOutboxEntry) (string, error) {
+	tx, err := s.txRepo.FindByID(ctx, entry.TransactionID)
+	if err != nil {
+		return "", err
+	}
+	if tx.IsDeleted() {
+		entry.Discard("transaction was deleted")
+		return "", nil
+	}
+	if tx.IsLinked() {
+		return tx.FireflyID, nil
+	}
+
+	// A previous delivery may have booked the transaction and failed before
+	// linking it; the external ID recognizes it
+	if fireflyID, err := s.firefly.FindTransactionByExternalID(ctx, tx.ID); err == nil && fireflyID != "" {
+		if err := s.txRepo.SetFireflyID(ctx, tx.ID, fireflyID); err != nil {
+			return "", fmt.Errorf("failed to link transaction to Firefly: %w", err)
+		}
+		return fireflyID, nil
+	} else if err != nil && interfaces.ErrorTypeOf(err) != interfaces.ErrorTypeNotFound {
+		return "", err
+	}
+
+	input, err := s.exportInput(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+	return s.exporter.ExportTransaction(ctx, input)
+}
+
+// exportInput resolves the accounts and category a transaction is booked with
+func (s *FireflyOutboxService) exportInput(ctx context.Context, tx *models.Transaction) (FireflyExportInput, error) {
+	wallet, err := s.walletRepo.FindByID(ctx, tx.WalletID)
+	if err != nil {
+		return FireflyExportInput{}, fmt.Errorf("failed to get wallet: %w", err)
+	}
+	input := FireflyExportInput{
+		Transaction: tx,
+		Account:     s.accountOf(wallet),
+		Currency:    wallet.Currency,
+	}
+
+	if tx.DestWalletID != "" {
+		dest, err := s.walletRepo.FindByID(ctx, tx.DestWalletID)
+		if err != nil {
+			return FireflyExportInput{}, fmt.Errorf("failed to get destination wallet: %w", err)
+		}
+		account := s.accountOf(dest)
+		input.DestAccount = &account
+	}
+
+	if tx.CategoryID != "" {
+		category, err := s.categoryRepo.FindByID(ctx, tx.CategoryID)
+		if err != nil {
+			return FireflyExportInput{}, fmt.Errorf("failed to get category: %w", err)
+		}
+		input.CategoryName = category.Name
+	}
+	return input, nil
+}
+
+// accountOf returns the source account a wallet was imported from, or the
+// wallet itself as migrated from Firefly
+func (s *FireflyOutboxService) accountOf(wallet *models.Wallet) AccountRef {
+	if account, ok := s.accounts[strings.ToLower(wallet.Name)]; ok {
+		return account
+	}
+	return AccountRef{
+		Source:   FireflyMigrationSource,
+		Account:  wallet.ID,
+		Name:     wallet.Name,
+		Currency: wallet.Currency,
+	}
+}
+
+// markUnavailable postpones drains by the backoff of the consecutive outages
+func (s *FireflyOutboxService) markUnavailable(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.outages++
+	s.lastError = err.Error()
+	s.unavailableUntil = time.Now().Add(models.FireflyOutboxBackoff(s.outages))
+}
+
+// markAvailable resets the outage backoff after a successful delivery
+func (s *FireflyOutboxService) markAvailable() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.outages = 0
+	s.lastError = ""
+	s.unavailableUntil = time.Time{}
+}
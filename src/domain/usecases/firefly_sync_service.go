@@ -0,0 +1,280 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+const (
+	// FireflyPullSource identifies the Firefly pull-sync in the source states
+	FireflyPullSource = "firefly:pull"
+
+	// fireflyPullLookback bounds the first pull, which has no previous sync to start from
+	fireflyPullLookback = 30 * 24 * time.Hour
+)
+
+// EventPublisher publishes domain events. events.Publisher satisfies it.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *interfaces.Event) error
+}
+
+// FireflyConflict is a linked transaction whose category or tags were changed
+// both locally and in Firefly since the last pull. The local values are kept.
+type FireflyConflict struct {
+	TransactionID string   `json:"transactionId"`
+	FireflyID     string   `json:"fireflyId"`
+	Fields        []string `json:"fields"`
+}
+
+// FireflyPullReport summarizes a pull of the edits made in Firefly
+type FireflyPullReport struct {
+	Since      time.Time         `json:"since"`
+	StartedAt  time.Time         `json:"startedAt"`
+	FinishedAt time.Time         `json:"finishedAt"`
+	Changed    int               `json:"changed"`  // Firefly transactions changed since the last pull
+	Updated    int               `json:"updated"`  // local transactions updated from Firefly
+	Unlinked   int               `json:"unlinked"` // changed in Firefly but not linked to a local transaction
+	Conflicts  []FireflyConflict `json:"conflicts"`
+	Errors     []string          `json:"errors,omitempty"`
+}
+
+// FireflySyncService pulls the categories and tags users edit in Firefly's UI
+// back into the linked local transactions
+type FireflySyncService struct {
+	firefly         interfaces.FireflyClient
+	transactionRepo repositories.TransactionRepository
+	categoryRepo    repositories.CategoryRepository
+	stateRepo       repositories.SourceStateRepository // optional: persists the time of the last pull
+	publisher       EventPublisher                     // optional: receives conflict events
+
+	mu    sync.Mutex // one pull at a time
+	state *models.SourceState
+}
+
+// NewFireflySyncService creates a new FireflySyncService
+func NewFireflySyncService(
+	firefly interfaces.FireflyClient,
+	transactionRepo repositories.TransactionRepository,
+	categoryRepo repositories.CategoryRepository,
+) *FireflySyncService {
+	return &FireflySyncService{
+		firefly:         firefly,
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+	}
+}
+
+// WithState persists the time of the last pull, so a restart resumes from it
+func (s *FireflySyncService) WithState(stateRepo repositories.SourceStateRepository) *FireflySyncService {
+	s.stateRepo = stateRepo
+	return s
+}
+
+// WithPublisher publishes a firefly.conflict event for every conflict
+func (s *FireflySyncService) WithPublisher(publisher EventPublisher) *FireflySyncService {
+	s.publisher = publisher
+	return s
+}
+
+// Pull applies the category and tag edits made in Firefly since the last pull
+// to the linked local transactions. A transaction also changed locally since
+// the last pull keeps its local values and is reported as a conflict.
+func (s *FireflySyncService) Pull(ctx context.Context) (*FireflyPullReport, error) {
+	ctx, _ = internal.EnsureRequestID(ctx)
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "PullFirefly").Logger()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := s.loadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &FireflyPullReport{
+		Since:     state.LastSuccessAt,
+		StartedAt: time.Now(),
+		Conflicts: make([]FireflyConflict, 0),
+	}
+	if report.Since.IsZero() {
+		report.Since = report.StartedAt.Add(-fireflyPullLookback)
+	}
+
+	groups, err := s.firefly.ListTransactionsUpdatedSince(ctx, report.Since)
+	if err == nil {
+		report.Changed = len(groups)
+		for _, group := range groups {
+			if err := s.pullGroup(ctx, group, report); err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", group.ID, err))
+			}
+		}
+	}
+
+	// The next pull starts after the local writes of this one, so they are not
+	// mistaken for local edits
+	report.FinishedAt = time.Now()
+	state.Record(report.FinishedAt, report.Updated, err)
+	s.saveState(ctx, state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changed Firefly transactions: %w", err)
+	}
+
+	logger.Info().
+		Int("changed", report.Changed).
+		Int("updated", report.Updated).
+		Int("conflicts", len(report.Conflicts)).
+		Int("errors", len(report.Errors)).
+		Msg("Pulled Firefly edits")
+
+	return report, nil
+}
+
+// pullGroup applies the edits of one Firefly transaction to its local transaction
+func (s *FireflySyncService) pullGroup(ctx context.Context, group interfaces.FireflyTransactionGroup, report *FireflyPullReport) error {
+	if len(group.Splits) == 0 {
+		return nil
+	}
+	split := group.Splits[0]
+
+	tx, err := s.transactionRepo.FindByFireflyID(ctx, group.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		report.Unlinked++
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	categoryID := tx.CategoryID
+	if split.CategoryName != "" {
+		if categoryID, err = s.categoryID(ctx, split.CategoryName, tx.Type); err != nil {
+			return err
+		}
+	}
+
+	var fields []string
+	if categoryID != tx.CategoryID {
+		fields = append(fields, "category")
+	}
+	if !sameTags(tx.Tags, split.Tags) {
+		fields = append(fields, "tags")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	if tx.UpdatedAt.After(report.Since) {
+		conflict := FireflyConflict{TransactionID: tx.ID, FireflyID: group.ID, Fields: fields}
+		report.Conflicts = append(report.Conflicts, conflict)
+		s.publishConflict(ctx, conflict)
+		return nil
+	}
+
+	tx.CategoryID = categoryID
+	tx.Tags = slices.Clone(split.Tags)
+	if err := s.transactionRepo.Update(ctx, tx); err != nil {
+		return fmt.Errorf("failed to update transaction %s: %w", tx.ID, err)
+	}
+	report.Updated++
+	return nil
+}
+
+// categoryID resolves a Firefly category name to a local category, creating
+// the categories users added in Firefly
+func (s *FireflySyncService) categoryID(ctx context.Context, name string, txType models.TransactionType) (string, error) {
+	id, err := findCategoryIDByName(ctx, s.categoryRepo, name)
+	if !errors.Is(err, models.ErrCategoryNotFound) {
+		return id, err
+	}
+
+	categoryType := models.CategoryTypeExpense
+	switch txType {
+	case models.TransactionTypeIncome:
+		categoryType = models.CategoryTypeIncome
+	case models.TransactionTypeTransfer:
+		categoryType = models.CategoryTypeTransfer
+	}
+
+	category := models.NewCategory(name, "Created in Firefly III", categoryType, "")
+	if err := s.categoryRepo.Create(ctx, category); err != nil {
+		return "", err
+	}
+	return category.ID, nil
+}
+
+func (s *FireflySyncService) publishConflict(ctx context.Context, conflict FireflyConflict) {
+	if s.publisher == nil {
+		return
+	}
+
+	event := interfaces.NewEvent(interfaces.EventTypeFireflyConflict, "firefly").
+		WithTarget(conflict.TransactionID).
+		WithData("transactionId", conflict.TransactionID).
+		WithData("fireflyId", conflict.FireflyID).
+		WithData("fields", conflict.Fields)
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		logger := internal.LoggerFrom(ctx)
+		logger.Warn().Err(err).Str("transactionID", conflict.TransactionID).Msg("Failed to publish Firefly conflict")
+	}
+}
+
+// loadState returns the pull state, loading it from the store on first use
+func (s *FireflySyncService) loadState(ctx context.Context) (*models.SourceState, error) {
+	if s.state != nil {
+		return s.state, nil
+	}
+
+	s.state = models.NewSourceState(FireflyPullSource, models.SourceAuthorized)
+	if s.stateRepo == nil {
+		return s.state, nil
+	}
+
+	states, err := s.stateRepo.FindAll(ctx)
+	if err != nil {
+		s.state = nil
+		return nil, err
+	}
+	for _, state := range states {
+		if state.SourceID == FireflyPullSource {
+			s.state = state
+		}
+	}
+	return s.state, nil
+}
+
+func (s *FireflySyncService) saveState(ctx context.Context, state *models.SourceState) {
+	if s.stateRepo == nil {
+		return
+	}
+	if err := s.stateRepo.Save(ctx, state); err != nil {
+		logger := internal.LoggerFrom(ctx)
+		logger.Warn().Err(err).Msg("Failed to store Firefly pull state")
+	}
+}
+
+// sameTags reports whether two tag lists hold the same tags, ignoring order and case
+func sameTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	normalize := func(tags []string) []string {
+		normalized := make([]string, len(tags))
+		for i, tag := range tags {
+			normalized[i] = strings.ToLower(tag)
+		}
+		slices.Sort(normalized)
+		return normalized
+	}
+	return slices.Equal(normalize(a), normalize(b))
+}
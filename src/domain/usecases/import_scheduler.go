@@ -0,0 +1,141 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// MinImportInterval is the shortest interval between scheduled import cycles
+const MinImportInterval = time.Minute
+
+// Leadership reports whether this replica leads the replicas sharing the
+// database. Only the leader runs scheduled jobs.
+type Leadership interface {
+	IsLeader() bool
+}
+
+// ImportScheduler runs an import cycle of every source at a fixed interval.
+// The interval can be changed while it runs; zero stops scheduled imports
+// until a new interval is set.
+type ImportScheduler struct {
+	syncer      *SourceSyncService
+	leadership  Leadership          // nil for a single replica, which always leads
+	maintenance *MaintenanceService // optional: skips the cycles during global maintenance
+
+	mu       sync.Mutex
+	interval time.Duration
+	reset    chan struct{} // signals an interval change to Run
+}
+
+// NewImportScheduler creates a new ImportScheduler
+func NewImportScheduler(syncer *SourceSyncService, interval time.Duration) *ImportScheduler {
+	return &ImportScheduler{
+		syncer:   syncer,
+		interval: interval,
+		reset:    make(chan struct{}, 1),
+	}
+}
+
+// WithLeadership runs scheduled cycles only while this replica is the leader
+func (s *ImportScheduler) WithLeadership(leadership Leadership) *ImportScheduler {
+	s.leadership = leadership
+	return s
+}
+
+// WithMaintenance skips the scheduled cycles while every space is frozen
+func (s *ImportScheduler) WithMaintenance(maintenance *MaintenanceService) *ImportScheduler {
+	s.maintenance = maintenance
+	return s
+}
+
+// IsLeader reports whether this replica runs the scheduled cycles
+func (s *ImportScheduler) IsLeader() bool {
+	return s.leadership == nil || s.leadership.IsLeader()
+}
+
+// SchedulerStatus describes the import schedule of this replica
+type SchedulerStatus struct {
+	Interval string `json:"interval"` // "0s" when scheduled imports are disabled
+	Leader   bool   `json:"leader"`
+}
+
+// Status returns the interval and whether this replica runs the scheduled cycles
+func (s *ImportScheduler) Status() SchedulerStatus {
+	return SchedulerStatus{Interval: s.Interval().String(), Leader: s.IsLeader()}
+}
+
+// Interval returns the time between scheduled import cycles, zero when disabled
+func (s *ImportScheduler) Interval() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.interval
+}
+
+// SetInterval changes the time between import cycles. The next cycle runs one
+// full interval after the change.
+func (s *ImportScheduler) SetInterval(interval time.Duration) error {
+	if interval != 0 && interval < MinImportInterval {
+		return fmt.Errorf("import interval must be zero or at least %s", MinImportInterval)
+	}
+
+	s.mu.Lock()
+	s.interval = interval
+	s.mu.Unlock()
+
+	select {
+	case s.reset <- struct{}{}:
+	default: // a change is already pending
+	}
+	return nil
+}
+
+// Run syncs every source once per interval until ctx is done. Replicas that
+// are not the leader skip the cycles, and take over when they become leader.
+func (s *ImportScheduler) Run(ctx context.Context) {
+	logger := internal.GetLogger().With().Str("usecase", "ImportScheduler").Logger()
+
+	for {
+		var timer *time.Timer
+		var tick <-chan time.Time
+		if interval := s.Interval(); interval > 0 {
+			timer = time.NewTimer(interval)
+			tick = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-s.reset:
+			logger.Info().Dur("interval", s.Interval()).Msg("Import interval changed")
+		case <-tick:
+			switch {
+			case !s.IsLeader():
+				logger.Debug().Msg("Not the leader, skipping scheduled import cycle")
+			case s.frozen(ctx):
+				logger.Info().Msg("Maintenance mode is on, skipping scheduled import cycle")
+			default:
+				s.syncer.SyncAll(ctx)
+			}
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// frozen reports whether global maintenance mode is on. Spaces frozen on
+// their own are skipped by the cycle.
+func (s *ImportScheduler) frozen(ctx context.Context) bool {
+	if s.maintenance == nil {
+		return false
+	}
+	state, err := s.maintenance.Status(ctx)
+	return err == nil && state.Global != nil
+}
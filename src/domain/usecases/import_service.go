@@ -0,0 +1,344 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// ImportService imports batches of transactions fetched from a source into a wallet
+type ImportService struct {
+	walletRepo      repositories.WalletRepository
+	transactionRepo repositories.TransactionRepository
+	rules           *RuleService                    // optional: user-defined transformation rules
+	categoryRepo    repositories.CategoryRepository // optional: resolves category hints from sources
+	categorizer     *CategorizationService          // optional: suggests categories for the rest
+	outbox          *FireflyOutboxService           // optional: queues new transactions for Firefly
+	maintenance     *MaintenanceService             // optional: refuses imports into frozen spaces
+	ledger          *LedgerService                  // optional: skips provider IDs of pruned transactions
+	duplicates      models.DuplicatePolicies
+	descriptions    models.DescriptionTemplates
+}
+
+// NewImportService creates a new ImportService
+func NewImportService(
+	walletRepo repositories.WalletRepository,
+	transactionRepo repositories.TransactionRepository,
+) *ImportService {
+	return &ImportService{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+		duplicates:      models.DuplicatePolicies{Default: models.DefaultDuplicatePolicy()},
+	}
+}
+
+// WithRules sets the transformation rules applied to imported transactions.
+func (s *ImportService) WithRules(rules *RuleService) *ImportService {
+	s.rules = rules
+	return s
+}
+
+// WithCategories resolves the category hints source clients attach to classified
+// transactions (models.MetadataCategoryHint), creating missing categories on first use.
+func (s *ImportService) WithCategories(categoryRepo repositories.CategoryRepository) *ImportService {
+	s.categoryRepo = categoryRepo
+	return s
+}
+
+// WithCategorization suggests categories for transactions the source left
+// uncategorized, before the transformation rules run.
+func (s *ImportService) WithCategorization(categorizer *CategorizationService) *ImportService {
+	s.categorizer = categorizer
+	return s
+}
+
+// WithOutbox queues the imported transactions for delivery to Firefly III.
+func (s *ImportService) WithOutbox(outbox *FireflyOutboxService) *ImportService {
+	s.outbox = outbox
+	return s
+}
+
+// WithLedger skips the provider IDs the ledger summaries hold, those of
+// deleted transactions whose records were pruned
+func (s *ImportService) WithLedger(ledger *LedgerService) *ImportService {
+	s.ledger = ledger
+	return s
+}
+
+// WithMaintenance refuses imports into the wallets of spaces in maintenance mode
+func (s *ImportService) WithMaintenance(maintenance *MaintenanceService) *ImportService {
+	s.maintenance = maintenance
+	return s
+}
+
+// WithDuplicatePolicies sets the duplicate policies applied per import source.
+func (s *ImportService) WithDuplicatePolicies(policies models.DuplicatePolicies) *ImportService {
+	s.duplicates = policies
+	return s
+}
+
+// WithDescriptionTemplates sets the templates that describe imported transactions,
+// selected by the import source.
+func (s *ImportService) WithDescriptionTemplates(templates models.DescriptionTemplates) *ImportService {
+	s.descriptions = templates
+	return s
+}
+
+// ImportInput is a batch of transactions from one source for one wallet
+type ImportInput struct {
+	Source       string                `json:"source"`
+	WalletID     string                `json:"walletId"`
+	Transactions []*models.Transaction `json:"transactions"`
+
+	// Filtered counts the token transfers the source's token filter dropped
+	// before the batch was built
+	Filtered models.TokenFilterStats `json:"filtered"`
+
+	// Location is the timezone of the source's calendar dates; duplicates are
+	// then looked for over whole days there. Nil compares the exact window.
+	Location *time.Location `json:"-"`
+}
+
+// ImportReport summarizes an import run
+type ImportReport struct {
+	Source     string    `json:"source"`
+	WalletID   string    `json:"walletId"`
+	Received   int       `json:"received"`
+	Imported   int       `json:"imported"`
+	Duplicates int       `json:"duplicates"` // blocked as duplicates
+	Flagged    int       `json:"flagged"`    // imported but tagged as possible duplicates
+	Invalid    int       `json:"invalid"`
+	Classified int       `json:"classified"` // categorized by the classifier
+	Filtered   int       `json:"filtered"`   // dropped by the source's token filter
+	Snapshots  int       `json:"snapshots"`  // balance snapshots recorded after a source sync
+	Queued     int       `json:"queued"`     // queued for delivery to Firefly
+	Errors     []string  `json:"errors,omitempty"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+
+	// DuplicatePolicy is the policy applied to this source
+	DuplicatePolicy models.DuplicatePolicy `json:"duplicatePolicy"`
+	// DuplicateDecisions lists every transaction that matched an existing one
+	DuplicateDecisions []DuplicateDecision `json:"duplicateDecisions,omitempty"`
+	// TokenFilter breaks Filtered down by reason
+	TokenFilter models.TokenFilterStats `json:"tokenFilter"`
+}
+
+// Import validates, transforms and de-duplicates a batch of transactions, stores
+// the new ones with a single batched write and applies their net effect to the
+// wallet balances. The changes are audited as made by an import.
+func (s *ImportService) Import(ctx context.Context, input ImportInput) (*ImportReport, error) {
+	ctx = models.WithAuditSource(ctx, models.AuditSourceImport)
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "Import").
+		Str("source", input.Source).Str("walletID", input.WalletID).Logger()
+
+	policy := s.duplicates.For(input.Source)
+	report := &ImportReport{
+		Source:          input.Source,
+		WalletID:        input.WalletID,
+		Received:        len(input.Transactions),
+		Filtered:        input.Filtered.Total(),
+		TokenFilter:     input.Filtered,
+		StartedAt:       time.Now(),
+		DuplicatePolicy: policy,
+	}
+
+	wallet, err := s.walletRepo.FindByID(ctx, input.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet: %w", err)
+	}
+	if s.maintenance != nil {
+		if err := s.maintenance.Check(ctx, wallet.SpaceID); err != nil {
+			return nil, err
+		}
+	}
+	if wallet.Archived {
+		return nil, fmt.Errorf("wallet %s: %w", wallet.ID, models.ErrWalletArchived)
+	}
+
+	categories := make(map[string]string)
+	pending := make([]*models.Transaction, 0, len(input.Transactions))
+	positions := make([]int, 0, len(input.Transactions)) // batch position of each pending transaction
+	for i, tx := range input.Transactions {
+		if s.prepare(ctx, input.Source, wallet.ID, tx, categories) {
+			report.Classified++
+		}
+
+		if err := tx.Validate(); err != nil {
+			report.Invalid++
+			report.Errors = append(report.Errors, fmt.Sprintf("transaction %d: %v", i, err))
+			continue
+		}
+
+		decision, err := checkDuplicate(ctx, s.transactionRepo, tx, policy, input.Location)
+		if err != nil {
+			return nil, err
+		}
+		decision = checkBatchDuplicate(decision, tx, policy, input.Location, pending, positions)
+		if decision != nil {
+			decision.Index = i
+			report.DuplicateDecisions = append(report.DuplicateDecisions, *decision)
+			if policy.Action == models.DuplicateActionBlock {
+				report.Duplicates++
+				continue
+			}
+			tx.MergeTags(policy.Tags())
+			report.Flagged++
+		}
+
+		pending = append(pending, tx)
+		positions = append(positions, i)
+	}
+
+	created, err := s.transactionRepo.CreateMany(ctx, pending)
+	report.Imported = created
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+
+	// Apply the net balance effect of everything that was stored
+	deltas := make(map[string]float64)
+	for _, tx := range pending[:created] {
+		for walletID, delta := range tx.BalanceEffects() {
+			deltas[walletID] += delta
+		}
+	}
+	for walletID, delta := range deltas {
+		if delta == 0 {
+			continue
+		}
+		if err := s.walletRepo.UpdateBalance(ctx, walletID, delta); err != nil {
+			logger.Error().Err(err).Str("balanceWalletID", walletID).Msg("Failed to apply import balance change")
+			report.Errors = append(report.Errors, fmt.Sprintf("wallet %s balance: %v", walletID, err))
+		}
+	}
+
+	if s.outbox != nil && created > 0 {
+		queued, err := s.outbox.Enqueue(ctx, pending[:created])
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to queue transactions for Firefly")
+			report.Errors = append(report.Errors, fmt.Sprintf("firefly outbox: %v", err))
+		}
+		report.Queued = queued
+	}
+
+	report.FinishedAt = time.Now()
+	logger.Info().
+		Int("received", report.Received).
+		Int("imported", report.Imported).
+		Int("queued", report.Queued).
+		Int("duplicates", report.Duplicates).
+		Int("flagged", report.Flagged).
+		Int("invalid", report.Invalid).
+		Int("classified", report.Classified).
+		Int("filtered", report.Filtered).
+		Msg("Import complete")
+
+	return report, err
+}
+
+// prepare normalizes a fetched transaction for its wallet: it renders the
+// description, resolves the category hint, runs the classifier and then the
+// transformation rules. Failures are logged and never block the transaction.
+// It reports whether the classifier categorized the transaction.
+func (s *ImportService) prepare(ctx context.Context, source, walletID string, tx *models.Transaction, categories map[string]string) bool {
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "Import").
+		Str("source", source).Str("walletID", walletID).Logger()
+
+	tx.WalletID = walletID
+	tx.Status = models.TransactionStatusCompleted
+	tx.MergeMetadata(map[string]string{"source": source})
+
+	if err := s.descriptions.Apply(tx, source); err != nil {
+		logger.Warn().Err(err).Msg("Failed to render description template")
+	}
+
+	if err := s.applyCategoryHint(ctx, tx, categories); err != nil {
+		logger.Warn().Err(err).Str("category", tx.Metadata[models.MetadataCategoryHint]).
+			Msg("Failed to resolve category hint")
+	}
+
+	classified := s.categorizer != nil && s.categorizer.Categorize(tx)
+
+	if s.rules != nil {
+		if err := s.rules.ApplyTo(ctx, source, tx); err != nil {
+			logger.Warn().Err(err).Msg("Failed to apply transformation rules")
+		}
+	}
+	return classified
+}
+
+// applyCategoryHint sets the category suggested by the source on a transaction
+// that has none yet. Resolved IDs are cached per import run; unknown names are
+// created as system categories matching the transaction type.
+func (s *ImportService) applyCategoryHint(ctx context.Context, tx *models.Transaction, cache map[string]string) error {
+	name := tx.Metadata[models.MetadataCategoryHint]
+	if s.categoryRepo == nil || name == "" || tx.CategoryID != "" {
+		return nil
+	}
+
+	if id, ok := cache[name]; ok {
+		tx.CategoryID = id
+		return nil
+	}
+
+	id, err := findCategoryIDByName(ctx, s.categoryRepo, name)
+	if errors.Is(err, models.ErrCategoryNotFound) {
+		categoryType := models.CategoryTypeExpense
+		switch tx.Type {
+		case models.TransactionTypeIncome:
+			categoryType = models.CategoryTypeIncome
+		case models.TransactionTypeTransfer:
+			categoryType = models.CategoryTypeTransfer
+		}
+
+		category := models.NewSystemCategory(name, "Created on import from source classification", categoryType, "")
+		if err := s.categoryRepo.Create(ctx, category); err != nil {
+			return err
+		}
+		id, err = category.ID, nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cache[name] = id
+	tx.CategoryID = id
+	return nil
+}
+
+// PreviewDescription renders the description a transaction would get on
+// import, with the given template or the one configured for its source
+func (s *ImportService) PreviewDescription(input DescriptionPreviewInput) (*DescriptionPreview, error) {
+	tmpl := s.descriptions.For(input.Source)
+	if input.Template != "" {
+		var err error
+		tmpl, err = models.ParseDescriptionTemplate(input.Template)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	preview := &DescriptionPreview{
+		Fields:      models.NewDescriptionFields(&input.Transaction, input.Source),
+		Description: input.Transaction.Description,
+	}
+	if tmpl == nil {
+		return preview, nil
+	}
+
+	description, err := tmpl.Render(preview.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidDescriptionTemplate, err)
+	}
+	preview.Template = tmpl.String()
+	if description != "" {
+		preview.Description = description
+	}
+	return preview, nil
+}
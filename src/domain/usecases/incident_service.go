@@ -0,0 +1,127 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// DefaultIncidentThreshold is the number of consecutive failures that opens an incident
+const DefaultIncidentThreshold = 3
+
+// IncidentService tracks consecutive failures per external provider and keeps
+// the incident timeline. An incident opens once a provider failed threshold
+// times in a row and closes with its next success. Opening and closing an
+// incident is persisted, so the event hooks notify subscribers of both.
+type IncidentService struct {
+	repo      repositories.IncidentRepository
+	threshold int
+
+	mu       sync.Mutex
+	failures map[string]int              // consecutive failures per provider
+	open     map[string]*models.Incident // open incident per provider
+}
+
+// NewIncidentService creates a new IncidentService
+func NewIncidentService(repo repositories.IncidentRepository, threshold int) *IncidentService {
+	if threshold <= 0 {
+		threshold = DefaultIncidentThreshold
+	}
+
+	return &IncidentService{
+		repo:      repo,
+		threshold: threshold,
+		failures:  make(map[string]int),
+		open:      make(map[string]*models.Incident),
+	}
+}
+
+// Load restores the incidents left open by a previous run, so they are closed
+// by the next success instead of staying open forever
+func (s *IncidentService) Load(ctx context.Context) error {
+	incidents, err := s.repo.FindAll(ctx, repositories.IncidentFilter{OnlyOpen: true})
+	if err != nil {
+		return fmt.Errorf("failed to load open incidents: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, incident := range incidents {
+		s.open[incident.Provider] = incident
+		s.failures[incident.Provider] = incident.Failures
+	}
+	return nil
+}
+
+// Observe records the outcome of a call to a provider; err is nil on success.
+// Failing to persist the timeline is only logged, so the caller's own error
+// handling is never affected.
+func (s *IncidentService) Observe(ctx context.Context, provider string, err error) {
+	logger := internal.GetLogger().With().Str("usecase", "ObserveProvider").Str("provider", provider).Logger()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	incident := s.open[provider]
+	if err == nil {
+		s.failures[provider] = 0
+		if incident == nil {
+			return
+		}
+
+		delete(s.open, provider)
+		incident.EndedAt = time.Now()
+		if err := s.repo.Update(ctx, incident); err != nil {
+			logger.Warn().Err(err).Msg("Failed to close provider incident")
+			return
+		}
+		logger.Info().Dur("duration", incident.Duration(incident.EndedAt)).Msg("Provider recovered")
+		return
+	}
+
+	s.failures[provider]++
+	failures := s.failures[provider]
+
+	if incident != nil {
+		incident.Failures = failures
+		incident.LastError = err.Error()
+		if err := s.repo.Update(ctx, incident); err != nil {
+			logger.Warn().Err(err).Msg("Failed to update provider incident")
+		}
+		return
+	}
+
+	if failures < s.threshold {
+		return
+	}
+
+	incident = &models.Incident{
+		Provider:   provider,
+		ErrorClass: string(interfaces.ErrorTypeOf(err)),
+		LastError:  err.Error(),
+		Failures:   failures,
+		StartedAt:  time.Now(),
+	}
+	if err := s.repo.Create(ctx, incident); err != nil {
+		logger.Warn().Err(err).Msg("Failed to open provider incident")
+		return
+	}
+	s.open[provider] = incident
+
+	logger.Warn().
+		Str("errorClass", incident.ErrorClass).
+		Int("failures", failures).
+		Msg("Provider incident opened")
+}
+
+// ListIncidents returns the incident timeline, most recent first
+func (s *IncidentService) ListIncidents(ctx context.Context, filter repositories.IncidentFilter) ([]*models.Incident, error) {
+	return s.repo.FindAll(ctx, filter)
+}
@@ -0,0 +1,207 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+)
+
+// ledgerPageSize is how many deleted transactions are compacted per query
+const ledgerPageSize = 500
+
+// LedgerService prunes the import ledger. Imports skip the provider IDs of
+// every transaction a wallet holds, deleted ones included, so a transaction
+// the user deleted does not come back; those soft-deleted records pile up.
+// Past the keep period they are purged, and with compaction their provider
+// IDs are first folded into a bloom filter summary per wallet and month that
+// imports consult once the records are gone.
+type LedgerService struct {
+	transactionRepo repositories.TransactionRepository
+	summaryRepo     repositories.LedgerSummaryRepository
+	keepMonths      int
+	compact         bool
+	dedupeWindow    time.Duration // longest duplicate window, deleted transactions are kept at least that long
+
+	mu    sync.Mutex
+	stats LedgerStats
+}
+
+// NewLedgerService creates a new LedgerService keeping deleted transactions
+// for the given number of months
+func NewLedgerService(transactionRepo repositories.TransactionRepository, summaryRepo repositories.LedgerSummaryRepository, keepMonths int) *LedgerService {
+	return &LedgerService{
+		transactionRepo: transactionRepo,
+		summaryRepo:     summaryRepo,
+		keepMonths:      keepMonths,
+	}
+}
+
+// WithCompaction keeps the provider IDs of purged transactions as summaries
+func (s *LedgerService) WithCompaction(compact bool) *LedgerService {
+	s.compact = compact
+	return s
+}
+
+// WithDedupeWindow keeps deleted transactions at least as long as the
+// longest duplicate window, so duplicate detection still sees them
+func (s *LedgerService) WithDedupeWindow(window time.Duration) *LedgerService {
+	s.dedupeWindow = window
+	return s
+}
+
+// LedgerPruneReport summarizes a pruning run
+type LedgerPruneReport struct {
+	Cutoff    time.Time `json:"cutoff"`    // transactions deleted before it were pruned
+	Clamped   bool      `json:"clamped"`   // the keep period was shorter than the restore or duplicate window
+	Pruned    int       `json:"pruned"`    // deleted transactions purged
+	Compacted int       `json:"compacted"` // provider IDs folded into summaries
+	Summaries int       `json:"summaries"` // summaries created
+}
+
+// LedgerStats are the pruning counters since the service started and the
+// size of the stored summaries
+type LedgerStats struct {
+	Runs          int64     `json:"runs"`
+	Pruned        int64     `json:"pruned"`
+	Compacted     int64     `json:"compacted"`
+	LastRunAt     time.Time `json:"lastRunAt"`
+	Summaries     int       `json:"summaries"`
+	SummarizedIDs int       `json:"summarizedIds"`
+}
+
+// Prune purges the transactions deleted before the keep period, compacting
+// their provider IDs first when configured. The cutoff never falls within
+// the restore window or the duplicate window.
+func (s *LedgerService) Prune(ctx context.Context, now time.Time) (*LedgerPruneReport, error) {
+	report := &LedgerPruneReport{Cutoff: now.AddDate(0, -s.keepMonths, 0)}
+	safe := now.Add(-max(models.TransactionRestoreWindow, s.dedupeWindow))
+	if report.Cutoff.After(safe) {
+		report.Cutoff = safe
+		report.Clamped = true
+	}
+
+	if s.compact {
+		summaries, compacted, err := s.summarize(ctx, report.Cutoff)
+		if err != nil {
+			return report, err
+		}
+		// Summaries are stored before the records go, so an import running
+		// in between still skips the IDs
+		if err := s.summaryRepo.CreateMany(ctx, summaries); err != nil {
+			return report, fmt.Errorf("failed to store ledger summaries: %w", err)
+		}
+		report.Summaries = len(summaries)
+		report.Compacted = compacted
+	}
+
+	pruned, err := s.transactionRepo.PurgeDeleted(ctx, report.Cutoff)
+	if err != nil {
+		return report, err
+	}
+	report.Pruned = pruned
+
+	s.mu.Lock()
+	s.stats.Runs++
+	s.stats.Pruned += int64(report.Pruned)
+	s.stats.Compacted += int64(report.Compacted)
+	s.stats.LastRunAt = now
+	s.mu.Unlock()
+
+	return report, nil
+}
+
+// ledgerRange groups the provider IDs of a wallet's transactions in a month
+type ledgerRange struct {
+	walletID string
+	month    time.Time
+}
+
+// summarize builds the summaries of the imported transactions deleted before the cutoff
+func (s *LedgerService) summarize(ctx context.Context, cutoff time.Time) ([]*models.LedgerSummary, int, error) {
+	ids := make(map[ledgerRange][]string)
+	bounds := make(map[ledgerRange][2]time.Time)
+	var order []ledgerRange
+
+	for offset := 0; ; offset += ledgerPageSize {
+		page, err := s.transactionRepo.FindAll(ctx, repositories.TransactionFilter{
+			OnlyDeleted:   true,
+			DeletedBefore: cutoff,
+			Limit:         ledgerPageSize,
+			Offset:        offset,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to find deleted transactions: %w", err)
+		}
+
+		for _, tx := range page {
+			externalID := tx.Metadata[MetadataExternalID]
+			if externalID == "" {
+				continue
+			}
+			date := tx.Date.UTC()
+			key := ledgerRange{walletID: tx.WalletID, month: time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)}
+			if _, ok := ids[key]; !ok {
+				order = append(order, key)
+				bounds[key] = [2]time.Time{date, date}
+			}
+			ids[key] = append(ids[key], externalID)
+			if b := bounds[key]; date.Before(b[0]) {
+				bounds[key] = [2]time.Time{date, b[1]}
+			} else if date.After(b[1]) {
+				bounds[key] = [2]time.Time{b[0], date}
+			}
+		}
+
+		if len(page) < ledgerPageSize {
+			break
+		}
+	}
+
+	summaries := make([]*models.LedgerSummary, 0, len(order))
+	compacted := 0
+	for _, key := range order {
+		filter := models.NewBloomFilter(len(ids[key]), models.LedgerFalsePositiveRate)
+		for _, id := range ids[key] {
+			filter.Add(id)
+		}
+		summaries = append(summaries, &models.LedgerSummary{
+			WalletID: key.walletID,
+			From:     bounds[key][0],
+			To:       bounds[key][1],
+			Count:    len(ids[key]),
+			Filter:   filter,
+		})
+		compacted += len(ids[key])
+	}
+	return summaries, compacted, nil
+}
+
+// Contains reports whether the summaries of a wallet may hold the provider
+// ID of a transaction dated at the given time
+func (s *LedgerService) Contains(ctx context.Context, walletID, externalID string, date time.Time) (bool, error) {
+	summaries, err := s.summaryRepo.FindByWallet(ctx, walletID)
+	if err != nil {
+		return false, err
+	}
+	for _, summary := range summaries {
+		if summary.MayContain(externalID, date) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Stats returns the pruning counters and the size of the stored summaries
+func (s *LedgerService) Stats(ctx context.Context) (LedgerStats, error) {
+	s.mu.Lock()
+	stats := s.stats
+	s.mu.Unlock()
+
+	var err error
+	stats.Summaries, stats.SummarizedIDs, err = s.summaryRepo.Stats(ctx)
+	return stats, err
+}
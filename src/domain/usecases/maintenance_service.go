@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// MaintenanceService turns maintenance mode on and off, for every space or
+// for one. While a space is frozen its imports fail with
+// models.ErrMaintenance and the API refuses changes to it; reads keep
+// working. The switches are stored rather than cached, so they survive
+// restarts and the maintenance command reaches a running server.
+type MaintenanceService struct {
+	repo   repositories.MaintenanceRepository
+	spaces *SpaceService
+}
+
+// NewMaintenanceService creates a new MaintenanceService
+func NewMaintenanceService(repo repositories.MaintenanceRepository, spaces *SpaceService) *MaintenanceService {
+	return &MaintenanceService{
+		repo:   repo,
+		spaces: spaces,
+	}
+}
+
+// Status returns the maintenance switches that are on
+func (s *MaintenanceService) Status(ctx context.Context) (*models.MaintenanceState, error) {
+	switches, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewMaintenanceState(switches), nil
+}
+
+// Enable freezes a space, or every space for an empty space ID. Only
+// superusers freeze every space, owners freeze their own. Turning on a switch
+// that is already on only replaces its reason.
+func (s *MaintenanceService) Enable(ctx context.Context, actor SpaceActor, spaceID, reason string) (*models.Maintenance, error) {
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "EnableMaintenance").Logger()
+	if err := s.authorize(ctx, actor, spaceID); err != nil {
+		return nil, err
+	}
+
+	maintenance := &models.Maintenance{
+		SpaceID:   spaceID,
+		Reason:    reason,
+		StartedBy: actor.UserID,
+		StartedAt: time.Now(),
+	}
+	if err := s.repo.Save(ctx, maintenance); err != nil {
+		return nil, err
+	}
+
+	logger.Warn().Str("spaceID", spaceID).Str("reason", reason).Msg("Maintenance mode on")
+	return maintenance, nil
+}
+
+// Disable unfreezes a space, or lifts the global switch for an empty space
+// ID. Turning off a switch that is off does nothing.
+func (s *MaintenanceService) Disable(ctx context.Context, actor SpaceActor, spaceID string) error {
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "DisableMaintenance").Logger()
+	if err := s.authorize(ctx, actor, spaceID); err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.Delete(ctx, spaceID)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		logger.Info().Str("spaceID", spaceID).Msg("Maintenance mode off")
+	}
+	return nil
+}
+
+// Check returns models.ErrMaintenance when a space is frozen, the space of a
+// shared wallet being empty. The check fails closed: when the switches
+// cannot be read, nothing may change.
+func (s *MaintenanceService) Check(ctx context.Context, spaceID string) error {
+	state, err := s.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read maintenance mode: %w", err)
+	}
+	if maintenance := state.Frozen(spaceID); maintenance != nil {
+		return maintenance.Err()
+	}
+	return nil
+}
+
+// CheckWallets returns models.ErrMaintenance when the space of any of the
+// wallets is frozen, for changes to their transactions and balances. Empty
+// wallet IDs, e.g. of a transaction without a destination, are skipped.
+func (s *MaintenanceService) CheckWallets(ctx context.Context, walletIDs ...string) error {
+	state, err := s.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read maintenance mode: %w", err)
+	}
+	if state.Global != nil {
+		return state.Global.Err()
+	}
+	if len(state.Spaces) == 0 {
+		return nil
+	}
+
+	checked := make(map[string]bool, len(walletIDs))
+	for _, walletID := range walletIDs {
+		if walletID == "" || checked[walletID] {
+			continue
+		}
+		checked[walletID] = true
+
+		wallet, err := s.spaces.walletRepo.FindByID(ctx, walletID)
+		if err != nil {
+			return fmt.Errorf("failed to get wallet %s: %w", walletID, err)
+		}
+		if maintenance := state.Frozen(wallet.SpaceID); maintenance != nil {
+			return maintenance.Err()
+		}
+	}
+	return nil
+}
+
+// CheckAll returns models.ErrMaintenance while any space is frozen, for
+// changes that reach into every space such as renaming a tag
+func (s *MaintenanceService) CheckAll(ctx context.Context) error {
+	state, err := s.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read maintenance mode: %w", err)
+	}
+	if state.Global != nil {
+		return state.Global.Err()
+	}
+	if len(state.Spaces) > 0 {
+		return state.Spaces[0].Err()
+	}
+	return nil
+}
+
+// authorize checks that the actor may switch the maintenance mode of a space
+func (s *MaintenanceService) authorize(ctx context.Context, actor SpaceActor, spaceID string) error {
+	if spaceID == "" {
+		if !actor.Superuser {
+			return fmt.Errorf("global maintenance requires a superuser: %w", models.ErrSpaceAccessDenied)
+		}
+		return nil
+	}
+	_, err := s.spaces.authorize(ctx, actor, spaceID, models.SpaceRoleOwner)
+	return err
+}
@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+)
+
+// maintenanceSwitches is a maintenance repository of the switches that are on
+type maintenanceSwitches []*models.Maintenance
+
+func (r maintenanceSwitches) FindAll(ctx context.Context) ([]*models.Maintenance, error) {
+	return r, nil
+}
+
+func (r maintenanceSwitches) Save(ctx context.Context, maintenance *models.Maintenance) error {
+	return nil
+}
+
+func (r maintenanceSwitches) Delete(ctx context.Context, spaceID string) (bool, error) {
+	return false, nil
+}
+
+// walletsByID finds the wallets of a map; the other methods are not used
+type walletsByID struct {
+	repositories.WalletRepository
+	wallets map[string]*models.Wallet
+}
+
+func (r walletsByID) FindByID(ctx context.Context, id string) (*models.Wallet, error) {
+	wallet, ok := r.wallets[id]
+	if !ok {
+		return nil, models.ErrWalletNotFound
+	}
+	return wallet, nil
+}
+
+// transactionsByID finds the transactions of a map; the other methods are not used
+type transactionsByID struct {
+	repositories.TransactionRepository
+	transactions map[string]*models.Transaction
+}
+
+func (r transactionsByID) FindByID(ctx context.Context, id string) (*models.Transaction, error) {
+	tx, ok := r.transactions[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return tx, nil
+}
+
+func TestMaintenanceService_CheckWallets(t *testing.T) {
+	ctx := context.Background()
+	wallets := walletsByID{wallets: map[string]*models.Wallet{
+		"shared":   {ID: "shared"},
+		"personal": {ID: "personal", SpaceID: "home"},
+		"business": {ID: "business", SpaceID: "company"},
+	}}
+	spaces := NewSpaceService(nil, wallets, nil, nil)
+	frozen := NewMaintenanceService(maintenanceSwitches{{SpaceID: "company", Reason: "closing the books"}}, spaces)
+
+	if err := frozen.CheckWallets(ctx, "shared", "personal", ""); err != nil {
+		t.Errorf("CheckWallets(shared, personal) error = %v, want nil outside the frozen space", err)
+	}
+	if err := frozen.CheckWallets(ctx, "personal", "business"); !errors.Is(err, models.ErrMaintenance) {
+		t.Errorf("CheckWallets(personal, business) error = %v, want ErrMaintenance", err)
+	}
+	if err := frozen.CheckAll(ctx); !errors.Is(err, models.ErrMaintenance) {
+		t.Errorf("CheckAll() error = %v, want ErrMaintenance while a space is frozen", err)
+	}
+
+	global := NewMaintenanceService(maintenanceSwitches{{Reason: "restoring a backup"}}, spaces)
+	if err := global.CheckWallets(ctx, "shared"); !errors.Is(err, models.ErrMaintenance) {
+		t.Errorf("CheckWallets(shared) error = %v, want ErrMaintenance during global maintenance", err)
+	}
+
+	off := NewMaintenanceService(maintenanceSwitches{}, spaces)
+	if err := off.CheckWallets(ctx, "business"); err != nil {
+		t.Errorf("CheckWallets(business) error = %v, want nil without maintenance", err)
+	}
+	if err := off.CheckAll(ctx); err != nil {
+		t.Errorf("CheckAll() error = %v, want nil without maintenance", err)
+	}
+}
+
+// Routes without a space in the path rely on the services to refuse changes
+// to frozen spaces
+func TestTransactionService_RefusesChangesToFrozenSpaces(t *testing.T) {
+	ctx := context.Background()
+	wallets := walletsByID{wallets: map[string]*models.Wallet{
+		"personal": {ID: "personal", SpaceID: "home"},
+		"business": {ID: "business", SpaceID: "company"},
+	}}
+	transactions := transactionsByID{transactions: map[string]*models.Transaction{
+		"rent":     {ID: "rent", WalletID: "personal", Type: models.TransactionTypeExpense, Amount: 900},
+		"invoice":  {ID: "invoice", WalletID: "business", Type: models.TransactionTypeIncome, Amount: 2000},
+		"transfer": {ID: "transfer", WalletID: "personal", DestWalletID: "business", Type: models.TransactionTypeTransfer, Amount: 50},
+	}}
+	maintenance := NewMaintenanceService(maintenanceSwitches{{SpaceID: "company"}}, NewSpaceService(nil, wallets, nil, nil))
+	service := NewTransactionService(wallets, nil, transactions).WithMaintenance(maintenance)
+
+	for _, id := range []string{"invoice", "transfer"} {
+		if err := service.DeleteTransaction(ctx, id); !errors.Is(err, models.ErrMaintenance) {
+			t.Errorf("DeleteTransaction(%s) error = %v, want ErrMaintenance", id, err)
+		}
+	}
+	if _, err := service.BulkUpdateTransactions(ctx, []TransactionPatch{{ID: "rent"}, {ID: "invoice"}}); !errors.Is(err, models.ErrMaintenance) {
+		t.Errorf("BulkUpdateTransactions() error = %v, want ErrMaintenance", err)
+	}
+	if _, err := service.MergeTransactions(ctx, "rent", []string{"invoice"}); !errors.Is(err, models.ErrMaintenance) {
+		t.Errorf("MergeTransactions() error = %v, want ErrMaintenance", err)
+	}
+}
@@ -0,0 +1,190 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// normalizerClient is implemented by clients that version the mapping of
+// provider responses to transactions
+type normalizerClient interface {
+	NormalizerVersion() int
+}
+
+// normalizerVersion returns the version of a source's normalizer, zero when
+// its client does not report one
+func normalizerVersion(source Source) int {
+	client, ok := source.Client.(normalizerClient)
+	if !ok {
+		return 0
+	}
+	return client.NormalizerVersion()
+}
+
+// RenormalizationReport is the outcome of re-normalizing the transactions of
+// a source imported by an older version of its normalizer
+type RenormalizationReport struct {
+	SourceID string `json:"sourceId"`
+	Version  int    `json:"version"`  // current version of the source's normalizer
+	Runs     int    `json:"runs"`     // archived runs replayed
+	Compared int    `json:"compared"` // stale transactions compared, summed over the runs
+	Missing  int    `json:"missing"`  // not imported before, summed over the runs
+	Updated  int    `json:"updated"`  // stored with a new description, category or version
+	Imported int    `json:"imported"` // missing transactions imported
+	Firefly  int    `json:"firefly"`  // linked transactions updated in Firefly
+	Applied  bool   `json:"applied"`
+
+	// Changes lists the stale transactions that normalize differently now,
+	// once each
+	Changes []ReplayChange `json:"changes,omitempty"`
+	Errors  []string       `json:"errors,omitempty"`
+}
+
+// NormalizationService re-normalizes the transactions imported by an older
+// version of their source's normalizer, replaying the archived raw payloads of
+// the source's import runs
+type NormalizationService struct {
+	sync         *SourceSyncService
+	firefly      interfaces.FireflyClient        // optional: updates the linked Firefly transactions
+	categoryRepo repositories.CategoryRepository // names the categories sent to Firefly
+}
+
+// NewNormalizationService creates a new NormalizationService. The sync
+// service needs a payload archive.
+func NewNormalizationService(sync *SourceSyncService) *NormalizationService {
+	return &NormalizationService{sync: sync}
+}
+
+// WithFirefly sends the new descriptions and categories of re-normalized
+// transactions to their linked Firefly transactions
+func (s *NormalizationService) WithFirefly(firefly interfaces.FireflyClient, categoryRepo repositories.CategoryRepository) *NormalizationService {
+	s.firefly = firefly
+	s.categoryRepo = categoryRepo
+	return s
+}
+
+// RenormalizeAll re-normalizes the stale transactions of every source whose
+// client versions its normalizer, sources sorted by ID
+func (s *NormalizationService) RenormalizeAll(ctx context.Context, apply bool) ([]*RenormalizationReport, error) {
+	s.sync.mu.Lock()
+	ids := make([]string, 0, len(s.sync.sources))
+	for id, source := range s.sync.sources {
+		if normalizerVersion(source) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	s.sync.mu.Unlock()
+	slices.Sort(ids)
+
+	reports := make([]*RenormalizationReport, 0, len(ids))
+	for _, id := range ids {
+		report, err := s.Renormalize(ctx, id, apply)
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// Renormalize replays the archived runs of a source, oldest first, through
+// the current version of its normalizer and compares the transactions an
+// older version produced. Unless apply is set it only reports the
+// differences. Applied, it stores the new descriptions and categories, tags
+// the transactions with the current version and updates their linked
+// Firefly transactions; running it again changes nothing. Runs that cannot
+// be replayed are reported and skipped.
+func (s *NormalizationService) Renormalize(ctx context.Context, sourceID string, apply bool) (*RenormalizationReport, error) {
+	ctx, _ = internal.EnsureRequestID(ctx)
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "Renormalize").Str("sourceID", sourceID).Logger()
+
+	source, err := s.sync.source(sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if s.sync.archive == nil {
+		return nil, fmt.Errorf("payload archive is disabled: %w", models.ErrNoArchivedPayloads)
+	}
+	report := &RenormalizationReport{SourceID: sourceID, Version: normalizerVersion(source), Applied: apply}
+	if report.Version == 0 {
+		return nil, fmt.Errorf("source %q: %w", sourceID, models.ErrNormalizerNotVersioned)
+	}
+
+	runs, err := s.sync.archive.ListRuns(ctx, sourceID, 0)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(runs)
+
+	changed := make(map[string]bool)
+	for _, run := range runs {
+		replayed, err := s.sync.replay(ctx, run.RunID, source, apply, true)
+		if replayed != nil {
+			report.Runs++
+			report.Compared += replayed.Compared
+			report.Missing += replayed.Missing
+			report.Updated += replayed.Updated
+			if replayed.Import != nil {
+				report.Imported += replayed.Import.Imported
+			}
+			for _, change := range replayed.Changes {
+				if !changed[change.TransactionID] {
+					changed[change.TransactionID] = true
+					report.Changes = append(report.Changes, change)
+				}
+			}
+			s.updateFirefly(ctx, replayed.rewritten, report)
+		}
+		if err != nil {
+			logger.Warn().Err(err).Str("runID", run.RunID).Msg("Failed to replay archived run")
+			report.Errors = append(report.Errors, fmt.Sprintf("run %s: %v", run.RunID, err))
+		}
+	}
+
+	logger.Info().
+		Int("version", report.Version).
+		Int("runs", report.Runs).
+		Int("compared", report.Compared).
+		Int("changes", len(report.Changes)).
+		Int("updated", report.Updated).
+		Bool("applied", apply).
+		Msg("Renormalization complete")
+	return report, nil
+}
+
+// updateFirefly sends the new descriptions and categories of transactions to
+// their linked Firefly transactions. Failures are reported and do not stop
+// the others.
+func (s *NormalizationService) updateFirefly(ctx context.Context, transactions []*models.Transaction, report *RenormalizationReport) {
+	if s.firefly == nil {
+		return
+	}
+	for _, tx := range transactions {
+		if !tx.IsLinked() {
+			continue
+		}
+
+		category := ""
+		if tx.CategoryID != "" {
+			found, err := s.categoryRepo.FindByID(ctx, tx.CategoryID)
+			if err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("transaction %s category: %v", tx.ID, err))
+				continue
+			}
+			category = found.Name
+		}
+
+		edit := interfaces.FireflyTransactionEdit{Description: &tx.Description, Category: &category}
+		if err := s.firefly.UpdateTransaction(ctx, tx.FireflyID, edit); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("firefly transaction %s: %v", tx.FireflyID, err))
+			continue
+		}
+		report.Firefly++
+	}
+}
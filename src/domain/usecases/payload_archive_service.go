@@ -0,0 +1,134 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/payloads"
+)
+
+// transportClient is implemented by clients whose provider calls go through a
+// replaceable HTTP transport, so their raw payloads can be archived and replayed
+type transportClient interface {
+	WithTransport(wrap func(http.RoundTripper) http.RoundTripper) interfaces.BlockchainClient
+}
+
+// PayloadArchiveService archives the raw payloads providers return while a
+// source syncs, keyed by import run, and purges them after the retention
+type PayloadArchiveService struct {
+	repo      repositories.RawPayloadRepository
+	retention time.Duration // zero keeps payloads forever
+	maxBytes  int           // larger payloads are not archived, zero for no limit
+	compress  bool
+}
+
+// NewPayloadArchiveService creates a new PayloadArchiveService
+func NewPayloadArchiveService(repo repositories.RawPayloadRepository, retention time.Duration, maxBytes int) *PayloadArchiveService {
+	return &PayloadArchiveService{
+		repo:      repo,
+		retention: retention,
+		maxBytes:  maxBytes,
+	}
+}
+
+// WithCompression gzips the payloads before they are stored
+func (s *PayloadArchiveService) WithCompression(compress bool) *PayloadArchiveService {
+	s.compress = compress
+	return s
+}
+
+// payloadCapture collects the payloads of one fetch
+type payloadCapture struct {
+	mu       sync.Mutex
+	payloads []*models.RawPayload
+}
+
+func (c *payloadCapture) add(payload models.RawPayload) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.payloads = append(c.payloads, &payload)
+}
+
+// capture returns a copy of the source whose client records the payloads of
+// its provider calls, and the capture collecting them. Sources whose client
+// cannot record are returned as they are, with a nil capture.
+func (s *PayloadArchiveService) capture(source Source) (Source, *payloadCapture) {
+	client, ok := source.Client.(transportClient)
+	if !ok {
+		return source, nil
+	}
+
+	capture := &payloadCapture{}
+	source.Client = client.WithTransport(func(base http.RoundTripper) http.RoundTripper {
+		return payloads.Record(base, s.maxBytes, capture.add)
+	})
+	return source, capture
+}
+
+// store archives the captured payloads of a source under an import run.
+// Failures are logged; archiving never fails a sync.
+func (s *PayloadArchiveService) store(ctx context.Context, runID, sourceID string, capture *payloadCapture) {
+	if capture == nil || len(capture.payloads) == 0 {
+		return
+	}
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "ArchivePayloads").Str("sourceID", sourceID).Logger()
+
+	for _, payload := range capture.payloads {
+		payload.RunID = runID
+		payload.SourceID = sourceID
+		if s.compress {
+			if err := payload.Compress(); err != nil {
+				logger.Warn().Err(err).Msg("Failed to compress payload, archiving it as is")
+			}
+		}
+	}
+	if err := s.repo.CreateMany(ctx, capture.payloads); err != nil {
+		logger.Warn().Err(err).Str("runID", runID).Msg("Failed to archive raw payloads")
+	}
+}
+
+// ListRuns returns the archived import runs of a source, or of every source
+// when sourceID is empty, newest first
+func (s *PayloadArchiveService) ListRuns(ctx context.Context, sourceID string, limit int) ([]models.PayloadRun, error) {
+	return s.repo.FindRuns(ctx, sourceID, limit)
+}
+
+// replayClient returns a copy of the source whose client reads the payloads
+// archived for it in an import run instead of calling its provider
+func (s *PayloadArchiveService) replayClient(ctx context.Context, runID string, source Source) (Source, int, error) {
+	client, ok := source.Client.(transportClient)
+	if !ok {
+		return source, 0, fmt.Errorf("source %q cannot replay payloads: %w", source.ID(), models.ErrNoArchivedPayloads)
+	}
+
+	archived, err := s.repo.FindByRun(ctx, runID, source.ID())
+	if err != nil {
+		return source, 0, err
+	}
+	if len(archived) == 0 {
+		return source, 0, fmt.Errorf("run %s of %s: %w", runID, source.ID(), models.ErrNoArchivedPayloads)
+	}
+
+	transport, err := payloads.Replay(archived)
+	if err != nil {
+		return source, 0, err
+	}
+	source.Client = client.WithTransport(func(http.RoundTripper) http.RoundTripper { return transport })
+	return source, len(archived), nil
+}
+
+// Purge deletes the payloads older than the retention and returns how many
+// were deleted. It does nothing when payloads are kept forever.
+func (s *PayloadArchiveService) Purge(ctx context.Context, now time.Time) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	return s.repo.PurgeBefore(ctx, now.Add(-s.retention))
+}
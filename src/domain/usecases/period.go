@@ -0,0 +1,22 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// PeriodCalendarFromConfig builds the reporting period calendar from the configuration
+func PeriodCalendarFromConfig(cfg internal.PeriodsConfig) (models.PeriodCalendar, error) {
+	calendar := models.PeriodCalendar{
+		FiscalYearStart: time.Month(cfg.FiscalYearStart),
+		PayPeriodStart:  cfg.PayPeriodStart,
+		WeekStart:       time.Weekday(cfg.WeekStart),
+	}
+	if err := calendar.Validate(); err != nil {
+		return calendar, fmt.Errorf("periods: %w", err)
+	}
+	return calendar, nil
+}
@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// PreferencesService manages the preferences of users. Users who have not
+// stored any get the defaults of the instance, taken from the configuration.
+type PreferencesService struct {
+	preferencesRepo repositories.PreferencesRepository
+	walletRepo      repositories.WalletRepository
+	defaults        models.Preferences
+}
+
+// NewPreferencesService creates a new PreferencesService
+func NewPreferencesService(
+	preferencesRepo repositories.PreferencesRepository,
+	walletRepo repositories.WalletRepository,
+	defaults models.Preferences,
+) *PreferencesService {
+	return &PreferencesService{
+		preferencesRepo: preferencesRepo,
+		walletRepo:      walletRepo,
+		defaults:        defaults,
+	}
+}
+
+// PreferencesDefaultsFromConfig returns the preferences of users who have
+// not stored any
+func PreferencesDefaultsFromConfig(cfg internal.PreferencesConfig, baseCurrency string, calendar models.PeriodCalendar) (models.Preferences, error) {
+	defaults := models.Preferences{
+		BaseCurrency:   baseCurrency,
+		FirstDayOfWeek: calendar.WeekStart,
+		DateFormat:     models.DateFormat(cfg.DateFormat),
+		Notifications: models.NotificationSettings{
+			Incidents:         cfg.Notifications.Incidents,
+			Subscriptions:     cfg.Notifications.Subscriptions,
+			BalanceDrift:      cfg.Notifications.BalanceDrift,
+			Consent:           cfg.Notifications.Consent,
+			LargeTransactions: cfg.Notifications.LargeTransactions,
+		},
+	}
+	if err := defaults.Validate(); err != nil {
+		return defaults, fmt.Errorf("preferences: %w", err)
+	}
+	return defaults, nil
+}
+
+// Defaults returns the preferences of users who have not stored any
+func (s *PreferencesService) Defaults() models.Preferences {
+	return s.defaults
+}
+
+// Get returns the preferences of a user, the defaults until they store some
+func (s *PreferencesService) Get(ctx context.Context, userID string) (*models.Preferences, error) {
+	preferences, err := s.preferencesRepo.FindByUser(ctx, userID)
+	if errors.Is(err, models.ErrPreferencesNotFound) {
+		defaults := s.defaults
+		defaults.UserID = userID
+		return &defaults, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// Save validates and stores the preferences of a user. The default wallet
+// must exist.
+func (s *PreferencesService) Save(ctx context.Context, preferences *models.Preferences) error {
+	if err := preferences.Validate(); err != nil {
+		return err
+	}
+	if preferences.DefaultWalletID != "" {
+		if _, err := s.walletRepo.FindByID(ctx, preferences.DefaultWalletID); err != nil {
+			v := validation.New()
+			v.Check(false, "defaultWalletId", validation.RuleValid,
+				fmt.Errorf("%w: default wallet %s: %v", models.ErrInvalidPreferences, preferences.DefaultWalletID, err))
+			return v.Err()
+		}
+	}
+	return s.preferencesRepo.Save(ctx, preferences)
+}
@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/scripting"
+)
+
+// RuleService applies user-defined transformation rules to incoming transactions
+type RuleService struct {
+	ruleRepo     repositories.TransformationRuleRepository
+	categoryRepo repositories.CategoryRepository
+	engine       *scripting.Engine
+}
+
+// NewRuleService creates a new RuleService
+func NewRuleService(
+	ruleRepo repositories.TransformationRuleRepository,
+	categoryRepo repositories.CategoryRepository,
+	engine *scripting.Engine,
+) *RuleService {
+	return &RuleService{
+		ruleRepo:     ruleRepo,
+		categoryRepo: categoryRepo,
+		engine:       engine,
+	}
+}
+
+// RuleApplication is the outcome of running all matching rules against a transaction
+type RuleApplication struct {
+	Transaction  scripting.Transaction `json:"transaction"`
+	AppliedRules []string              `json:"appliedRules"` // IDs of rules that changed the transaction
+	FailedRules  []string              `json:"failedRules,omitempty"`
+}
+
+// Apply runs every enabled rule for the source in priority order.
+// Each rule sees the output of the previous one. A failing rule is logged
+// and skipped so a single broken script cannot block an import.
+func (s *RuleService) Apply(ctx context.Context, source string, tx scripting.Transaction) (*RuleApplication, error) {
+	logger := internal.GetLogger().With().Str("usecase", "ApplyRules").Str("source", source).Logger()
+
+	rules, err := s.ruleRepo.FindAll(ctx, repositories.TransformationRuleFilter{Source: source, OnlyEnabled: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transformation rules: %w", err)
+	}
+
+	tx.Source = source
+	application := &RuleApplication{Transaction: tx}
+
+	for _, rule := range rules {
+		if !rule.AppliesTo(source) {
+			continue
+		}
+
+		result, err := s.engine.Run(ctx, rule.Script, application.Transaction)
+		if err != nil {
+			logger.Warn().Err(err).Str("ruleID", rule.ID).Str("rule", rule.Name).Msg("Transformation rule failed")
+			application.FailedRules = append(application.FailedRules, rule.ID)
+			continue
+		}
+
+		if result.Changed {
+			application.Transaction = result.Transaction
+			application.AppliedRules = append(application.AppliedRules, rule.ID)
+		}
+	}
+
+	return application, nil
+}
+
+// ApplyTo runs the rules for the source against a transaction and copies the
+// transformed description, tags and category back onto it. Category names set
+// by rules are resolved to IDs; unknown names leave the category unchanged.
+func (s *RuleService) ApplyTo(ctx context.Context, source string, tx *models.Transaction) error {
+	logger := internal.GetLogger().With().Str("usecase", "ApplyRulesTo").Str("source", source).Logger()
+
+	categoryName := ""
+	if tx.CategoryID != "" {
+		if category, err := s.categoryRepo.FindByID(ctx, tx.CategoryID); err == nil {
+			categoryName = category.Name
+		}
+	}
+
+	application, err := s.Apply(ctx, source, scripting.Transaction{
+		Description: tx.Description,
+		Amount:      tx.Amount,
+		Type:        string(tx.Type),
+		Date:        tx.Date,
+		Category:    categoryName,
+		Tags:        tx.Tags,
+	})
+	if err != nil {
+		return err
+	}
+	if len(application.AppliedRules) == 0 {
+		return nil
+	}
+
+	tx.Description = application.Transaction.Description
+	tx.Tags = application.Transaction.Tags
+
+	if name := application.Transaction.Category; name != "" && !strings.EqualFold(name, categoryName) {
+		categoryID, err := findCategoryIDByName(ctx, s.categoryRepo, name)
+		if err != nil {
+			logger.Warn().Err(err).Str("category", name).Msg("Rule set unknown category")
+		} else {
+			tx.CategoryID = categoryID
+		}
+	}
+
+	logger.Debug().Strs("rules", application.AppliedRules).Msg("Applied transformation rules")
+	return nil
+}
+
+// TestRule evaluates a script against a sample transaction without storing anything
+func (s *RuleService) TestRule(ctx context.Context, script string, sample scripting.Transaction) (*scripting.Result, error) {
+	if script == "" {
+		return nil, models.ErrMissingRuleScript
+	}
+
+	return s.engine.Run(ctx, script, sample)
+}
+
+// ValidateRule checks the rule fields and that its script compiles
+func (s *RuleService) ValidateRule(rule *models.TransformationRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	return s.engine.Compile(rule.Script)
+}
@@ -0,0 +1,417 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// SeedSource is the import source of the demo transactions
+const SeedSource = "demo"
+
+// demoWallet is a wallet of the demo dataset
+type demoWallet struct {
+	key, name, description, currency string
+	kind                             models.WalletType
+	opening                          float64
+}
+
+var demoWallets = []demoWallet{
+	{"checking", "Everyday Checking", "Salary account and card payments", "EUR", models.WalletTypeBank, 2400},
+	{"savings", "Savings", "Emergency fund", "EUR", models.WalletTypeBank, 8000},
+	{"travel", "Travel Card", "Card for spending abroad", "USD", models.WalletTypeBank, 250},
+	{"london", "London Account", "Freelance income from UK clients", "GBP", models.WalletTypeBank, 600},
+	{"cash", "Cash", "Notes and coins", "EUR", models.WalletTypeCash, 80},
+	{"bitcoin", "Bitcoin", "Cold storage, bought monthly", "BTC", models.WalletTypeCrypto, 0.02},
+}
+
+// demoCategory is a category of the demo transactions. The transactions
+// refer to it by name; it is looked up by its key in the category pack.
+type demoCategory struct {
+	key, name, description string
+	kind                   models.CategoryType
+	color                  string
+}
+
+var demoCategories = []demoCategory{
+	{"income.salary", "Salary", "Regular employment income", models.CategoryTypeIncome, "#4CAF50"},
+	{"income.freelance", "Freelance", "Invoices paid by clients", models.CategoryTypeIncome, "#8BC34A"},
+	{"income.investment", "Investment", "Income from investments", models.CategoryTypeIncome, "#2196F3"},
+	{"income.other", "Other Income", "Miscellaneous income", models.CategoryTypeIncome, "#9C27B0"},
+	{"housing", "Housing", "Rent, mortgage, and housing expenses", models.CategoryTypeExpense, "#F44336"},
+	{"housing.utilities", "Utilities", "Electricity, water, heating, etc.", models.CategoryTypeExpense, "#607D8B"},
+	{"food.groceries", "Groceries", "Supermarkets and markets", models.CategoryTypeExpense, "#A1887F"},
+	{"food.dining", "Dining Out", "Restaurants, cafés and takeaway", models.CategoryTypeExpense, "#FF7043"},
+	{"transport", "Transportation", "Car, public transport, and travel expenses", models.CategoryTypeExpense, "#FF9800"},
+	{"health", "Healthcare", "Medical and health-related expenses", models.CategoryTypeExpense, "#E91E63"},
+	{"leisure.entertainment", "Entertainment", "Recreation and entertainment expenses", models.CategoryTypeExpense, "#673AB7"},
+	{"leisure.subscriptions", "Subscriptions", "Streaming and other recurring services", models.CategoryTypeExpense, "#7E57C2"},
+	{"leisure.travel", "Travel", "Hotels, flights and spending on trips", models.CategoryTypeExpense, "#26A69A"},
+	{"transfer.internal", "Internal Transfer", "Transfer between own accounts", models.CategoryTypeTransfer, "#009688"},
+}
+
+// demoCharge is a charge repeated every month on the same day
+type demoCharge struct {
+	day                   int
+	description, category string
+	amount                float64
+	raised                float64 // amount in the second half of the history, zero when unchanged
+	jitter                float64 // varies the amount by up to ± this share
+	tags                  []string
+}
+
+var demoMonthlyCharges = []demoCharge{
+	{1, "Rent Lindenstraße 12", "Housing", 1150, 0, 0, []string{"home"}},
+	{1, "Monthly transit pass", "Transportation", 49, 0, 0, []string{"commute"}},
+	{3, "FitLife Gym", "Healthcare", 29.9, 0, 0, []string{"recurring"}},
+	{5, "City Power electricity", "Utilities", 72, 0, 0.2, []string{"home"}},
+	{8, "Netflix", "Subscriptions", 13.99, 15.99, 0, []string{"recurring"}},
+	{12, "FiberNet internet", "Utilities", 39.99, 0, 0, []string{"home", "recurring"}},
+	{17, "Spotify", "Subscriptions", 10.99, 0, 0, []string{"recurring"}},
+}
+
+// demoMerchant is a merchant of the random everyday spending
+type demoMerchant struct {
+	description, category string
+	min, max              float64
+	tags                  []string
+}
+
+var (
+	demoGroceries = []demoMerchant{
+		{"REWE", "Groceries", 18, 95, []string{"groceries"}},
+		{"Lidl", "Groceries", 12, 70, []string{"groceries"}},
+		{"Farmers market", "Groceries", 8, 35, []string{"groceries"}},
+	}
+	demoDining = []demoMerchant{
+		{"Café Kranzler", "Dining Out", 6, 18, nil},
+		{"Trattoria Roma", "Dining Out", 28, 75, nil},
+		{"Sushi Bar Kyoto", "Dining Out", 22, 60, nil},
+	}
+	demoLeisure = []demoMerchant{
+		{"Cinema Paradiso", "Entertainment", 12, 30, nil},
+		{"Pharmacy", "Healthcare", 5, 40, nil},
+		{"Taxi", "Transportation", 12, 35, nil},
+		{"Bookshop", "Entertainment", 10, 45, nil},
+	}
+	demoTrip = []demoMerchant{
+		{"Hotel Chelsea", "Travel", 120, 180, []string{"travel"}},
+		{"Diner on 5th", "Dining Out", 18, 55, []string{"travel"}},
+		{"Subway MetroCard", "Transportation", 3, 34, []string{"travel"}},
+		{"Museum of Modern Art", "Entertainment", 25, 30, []string{"travel"}},
+	}
+	demoLondon = []demoMerchant{
+		{"Pret A Manger", "Dining Out", 6, 14, nil},
+		{"Tesco", "Groceries", 10, 45, []string{"groceries"}},
+	}
+)
+
+// Demo exchange rates, close enough to real ones for a believable history
+const (
+	demoEURUSD = 1.08
+	demoEURBTC = 58000.0 // EUR per BTC at the start of the history
+)
+
+// SeedOptions controls a demo seed
+type SeedOptions struct {
+	Months int   // months of history up to now
+	Seed   int64 // seed of the generated amounts and dates; equal seeds give equal data
+	Force  bool  // seed even when the database already has wallets
+}
+
+// SeedReport summarizes a demo seed
+type SeedReport struct {
+	From          time.Time `json:"from"`
+	To            time.Time `json:"to"`
+	Wallets       int       `json:"wallets"`
+	Categories    int       `json:"categories"` // created; existing ones are reused
+	Tags          int       `json:"tags"`
+	Transactions  int       `json:"transactions"`
+	Duplicates    int       `json:"duplicates"` // generated transactions the duplicate policy blocked
+	Subscriptions int       `json:"subscriptions"`
+	Errors        []string  `json:"errors,omitempty"`
+}
+
+// SeedService populates an empty instance with realistic demo data: wallets
+// in several currencies, the system categories and a few months of salary,
+// bills, everyday spending, transfers and a trip abroad. The transactions go
+// through the import so balances, tags and subscriptions come out as for
+// real data.
+type SeedService struct {
+	walletRepo    repositories.WalletRepository
+	categoryRepo  repositories.CategoryRepository
+	importService *ImportService
+	categories    *CategoryBootstrapService // optional: installs the system categories first
+	tags          *TagService               // optional: creates the tags of the demo transactions
+	subscriptions *SubscriptionService      // optional: detects the demo subscriptions
+}
+
+// NewSeedService creates a new SeedService
+func NewSeedService(
+	walletRepo repositories.WalletRepository,
+	categoryRepo repositories.CategoryRepository,
+	importService *ImportService,
+) *SeedService {
+	return &SeedService{
+		walletRepo:    walletRepo,
+		categoryRepo:  categoryRepo,
+		importService: importService,
+	}
+}
+
+// WithCategories installs the system categories before seeding, so the
+// demo transactions use them in the configured locale
+func (s *SeedService) WithCategories(categories *CategoryBootstrapService) *SeedService {
+	s.categories = categories
+	return s
+}
+
+// WithTags creates the tags of the demo transactions
+func (s *SeedService) WithTags(tags *TagService) *SeedService {
+	s.tags = tags
+	return s
+}
+
+// WithSubscriptions detects the subscriptions among the demo charges
+func (s *SeedService) WithSubscriptions(subscriptions *SubscriptionService) *SeedService {
+	s.subscriptions = subscriptions
+	return s
+}
+
+// SeedDemo creates the demo dataset
+func (s *SeedService) SeedDemo(ctx context.Context, opts SeedOptions) (*SeedReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "SeedDemo").Logger()
+	if opts.Months <= 0 {
+		return nil, fmt.Errorf("the number of months must be positive")
+	}
+
+	existing, err := s.walletRepo.FindAll(ctx, repositories.WalletFilter{IncludeArchived: true, Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+	if len(existing) > 0 && !opts.Force {
+		return nil, models.ErrSeedNotEmpty
+	}
+
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -opts.Months, 0)
+	report := &SeedReport{From: start, To: now}
+
+	if s.categories != nil {
+		if _, err := s.categories.Bootstrap(ctx); err != nil {
+			return report, fmt.Errorf("failed to install the system categories: %w", err)
+		}
+	}
+	categories := make(map[string]string, len(demoCategories))
+	for _, def := range demoCategories {
+		id, err := s.findCategory(ctx, def)
+		if errors.Is(err, models.ErrCategoryNotFound) {
+			// not in the installed pack, so it becomes a regular category
+			category := models.NewCategory(def.name, def.description, def.kind, def.color)
+			if err = s.categoryRepo.Create(ctx, category); err == nil {
+				id = category.ID
+				report.Categories++
+			}
+		}
+		if err != nil {
+			return report, fmt.Errorf("failed to create category %s: %w", def.name, err)
+		}
+		categories[def.name] = id
+	}
+
+	wallets := make(map[string]string, len(demoWallets))
+	for _, def := range demoWallets {
+		wallet := models.NewWallet(def.name, def.description, def.currency, def.kind)
+		if err := s.walletRepo.Create(ctx, wallet); err != nil {
+			return report, fmt.Errorf("failed to create wallet %s: %w", def.name, err)
+		}
+		wallets[def.key] = wallet.ID
+		report.Wallets++
+	}
+
+	rng := rand.New(rand.NewPCG(uint64(opts.Seed), uint64(opts.Months)))
+	byWallet := demoTransactions(rng, start, now, wallets, categories)
+
+	var tags []string
+	seen := make(map[string]bool)
+	for _, transactions := range byWallet {
+		for _, tx := range transactions {
+			for _, tag := range tx.Tags {
+				if !seen[tag] {
+					seen[tag] = true
+					tags = append(tags, tag)
+				}
+			}
+		}
+	}
+	if s.tags != nil && len(tags) > 0 {
+		sort.Strings(tags)
+		if err := s.tags.EnsureTags(ctx, tags); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("tags: %v", err))
+		} else {
+			report.Tags = len(tags)
+		}
+	}
+
+	// Import wallet by wallet, in the order of the dataset
+	for _, def := range demoWallets {
+		transactions := byWallet[def.key]
+		if len(transactions) == 0 {
+			continue
+		}
+		imported, err := s.importService.Import(ctx, ImportInput{
+			Source:       SeedSource,
+			WalletID:     wallets[def.key],
+			Transactions: transactions,
+		})
+		if err != nil {
+			return report, fmt.Errorf("failed to import the transactions of %s: %w", def.name, err)
+		}
+		report.Transactions += imported.Imported
+		report.Duplicates += imported.Duplicates
+		report.Errors = append(report.Errors, imported.Errors...)
+	}
+
+	if s.subscriptions != nil {
+		detected, err := s.subscriptions.Detect(ctx, now)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("subscriptions: %v", err))
+		} else {
+			report.Subscriptions = detected.Detected
+		}
+	}
+
+	logger.Info().
+		Int("wallets", report.Wallets).
+		Int("categories", report.Categories).
+		Int("transactions", report.Transactions).
+		Int("subscriptions", report.Subscriptions).
+		Msg("Seeded demo data")
+	return report, nil
+}
+
+// findCategory returns the ID of the category installed under the key of a
+// demo category, or else of the category with its name
+func (s *SeedService) findCategory(ctx context.Context, def demoCategory) (string, error) {
+	byKey, err := s.categoryRepo.FindAll(ctx, repositories.CategoryFilter{Key: def.key, Limit: 1})
+	if err != nil {
+		return "", fmt.Errorf("failed to find categories: %w", err)
+	}
+	if len(byKey) > 0 {
+		return byKey[0].ID, nil
+	}
+	return findCategoryIDByName(ctx, s.categoryRepo, def.name)
+}
+
+// demoTransactions generates the history between start and end, keyed by the
+// demo wallet the transactions are imported into
+func demoTransactions(rng *rand.Rand, start, end time.Time, wallets, categories map[string]string) map[string][]*models.Transaction {
+	byWallet := make(map[string][]*models.Transaction)
+	add := func(wallet string, date time.Time, txType models.TransactionType, amount float64, description, category string, tags []string) *models.Transaction {
+		if date.Before(start) || date.After(end) || amount <= 0 {
+			return nil
+		}
+		tx := models.NewTransaction(amount, description, date, txType, categories[category], wallets[wallet])
+		tx.Tags = append([]string(nil), tags...)
+		tx.Metadata = map[string]string{"demo": "true"}
+		byWallet[wallet] = append(byWallet[wallet], tx)
+		return tx
+	}
+	transfer := func(from string, date time.Time, to string, amount, rate float64, description string) {
+		if tx := add(from, date, models.TransactionTypeTransfer, amount, description, "Internal Transfer", nil); tx != nil {
+			tx.DestWalletID = wallets[to]
+			tx.ExchangeRate = rate
+		}
+	}
+	at := func(day time.Time, hour int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(rng.IntN(60))*time.Minute)
+	}
+	pick := func(merchants []demoMerchant) demoMerchant {
+		return merchants[rng.IntN(len(merchants))]
+	}
+	spend := func(wallet string, day time.Time, merchant demoMerchant) {
+		add(wallet, at(day, 9+rng.IntN(12)), models.TransactionTypeExpense,
+			roundCents(merchant.min+rng.Float64()*(merchant.max-merchant.min)), merchant.description, merchant.category, merchant.tags)
+	}
+
+	for _, def := range demoWallets {
+		add(def.key, start, models.TransactionTypeIncome, def.opening, "Opening balance", "Other Income", nil)
+	}
+
+	// A week in New York in the middle of the history, paid with the travel card
+	tripStart := start.Add(end.Sub(start) / 2).Truncate(24 * time.Hour)
+	tripEnd := tripStart.AddDate(0, 0, 7)
+	transfer("checking", tripStart.AddDate(0, 0, -3).Add(10*time.Hour), "travel", 1500, demoEURUSD, "Top up travel card")
+
+	btcPrice := demoEURBTC
+	for month := start; month.Before(end); month = month.AddDate(0, 1, 0) {
+		for _, charge := range demoMonthlyCharges {
+			amount := charge.amount
+			if charge.raised > 0 && month.Sub(start) > end.Sub(start)/2 {
+				amount = charge.raised
+			}
+			if charge.jitter > 0 {
+				amount *= 1 + charge.jitter*(2*rng.Float64()-1)
+			}
+			add("checking", at(month.AddDate(0, 0, charge.day-1), 6), models.TransactionTypeExpense,
+				roundCents(amount), charge.description, charge.category, charge.tags)
+		}
+
+		add("checking", at(month.AddDate(0, 0, 24), 7), models.TransactionTypeIncome, 3200, "Salary ACME GmbH", "Salary", []string{"salary"})
+		transfer("checking", at(month.AddDate(0, 0, 25), 8), "savings", 400, 0, "Monthly savings")
+		transfer("checking", at(month.AddDate(0, 0, rng.IntN(10)), 12), "cash", 200, 0, "ATM withdrawal")
+
+		btcPrice *= 1 + 0.15*(2*rng.Float64()-1)
+		transfer("checking", at(month.AddDate(0, 0, 26), 9), "bitcoin", 100, 1/btcPrice, "Bitcoin savings plan")
+
+		monthEnd := month.AddDate(0, 1, -1)
+		add("savings", at(monthEnd, 23), models.TransactionTypeIncome, roundCents(8000*0.02/12), "Interest", "Investment", nil)
+
+		for invoice := range 1 + rng.IntN(2) {
+			add("london", at(month.AddDate(0, 0, 10+invoice*10+rng.IntN(5)), 10), models.TransactionTypeIncome,
+				roundCents(300+rng.Float64()*600), "Invoice Thames Design Ltd", "Freelance", []string{"freelance"})
+		}
+	}
+
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !day.Before(tripStart) && day.Before(tripEnd) {
+			spend("travel", day, demoTrip[0]) // the hotel, every night
+			for range 1 + rng.IntN(3) {
+				spend("travel", day, pick(demoTrip[1:]))
+			}
+			continue
+		}
+
+		if rng.Float64() < 0.4 {
+			spend("checking", day, pick(demoGroceries))
+		}
+		if rng.Float64() < 0.2 {
+			wallet := "checking"
+			if rng.Float64() < 0.4 {
+				wallet = "cash"
+			}
+			spend(wallet, day, pick(demoDining))
+		}
+		if rng.Float64() < 0.1 {
+			spend("checking", day, pick(demoLeisure))
+		}
+		if rng.Float64() < 0.05 {
+			spend("london", day, pick(demoLondon))
+		}
+	}
+
+	return byWallet
+}
+
+// roundCents rounds an amount to two decimals
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,174 @@
+package usecases
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+const (
+	// monitorDebounce coalesces bursts of activity into a single sync
+	monitorDebounce = 2 * time.Second
+
+	// monitorMinBackoff and monitorMaxBackoff bound the delay between reconnects
+	monitorMinBackoff = time.Second
+	monitorMaxBackoff = time.Minute
+)
+
+// ActivityWatcher is implemented by clients that can push address activity as it happens
+type ActivityWatcher interface {
+	// CanWatch reports whether activity of the account can be streamed
+	CanWatch(account string) bool
+
+	// WatchAddress subscribes to activity on an account and calls notify for each
+	// event. It blocks until ctx is done or the subscription drops.
+	WatchAddress(ctx context.Context, account string, notify func()) error
+}
+
+// sourceSyncer imports the current transactions of a source
+type sourceSyncer interface {
+	SyncSource(ctx context.Context, id string) (*ImportReport, error)
+}
+
+// SourceMonitor streams activity of the sources whose clients support it and
+// syncs a source within seconds of new activity. Every (re)connect starts with a
+// gap-fill sync, so activity missed while disconnected is imported on resume.
+type SourceMonitor struct {
+	sources  []Source
+	syncer   sourceSyncer
+	debounce time.Duration
+}
+
+// NewSourceMonitor creates a new SourceMonitor
+func NewSourceMonitor(sources []Source, syncer sourceSyncer) *SourceMonitor {
+	return &SourceMonitor{
+		sources:  sources,
+		syncer:   syncer,
+		debounce: monitorDebounce,
+	}
+}
+
+// Run watches every streamable source until ctx is done
+func (m *SourceMonitor) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, source := range m.sources {
+		watcher, ok := source.Client.(ActivityWatcher)
+		if !ok {
+			continue
+		}
+		var addresses []string
+		for _, address := range source.OwnedAddresses() {
+			if watcher.CanWatch(address) {
+				addresses = append(addresses, address)
+			}
+		}
+		if len(addresses) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func(source Source, watcher ActivityWatcher) {
+			defer wg.Done()
+			m.watch(ctx, source, watcher, addresses)
+		}(source, watcher)
+	}
+	wg.Wait()
+}
+
+// watch keeps a subscription to every address of one source open, and syncs
+// the source after activity on any of them
+func (m *SourceMonitor) watch(ctx context.Context, source Source, watcher ActivityWatcher, addresses []string) {
+	id := source.ID()
+
+	activity := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case activity <- struct{}{}:
+		default: // a sync is already pending
+		}
+	}
+
+	syncDone := make(chan struct{})
+	go func() {
+		defer close(syncDone)
+		m.syncOnActivity(ctx, id, activity)
+	}()
+	defer func() { <-syncDone }()
+
+	var wg sync.WaitGroup
+	for _, address := range addresses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.subscribe(ctx, id, watcher, address, notify)
+		}()
+	}
+	wg.Wait()
+}
+
+// subscribe keeps a subscription to one address open, reconnecting with
+// exponential backoff until the subscription fails with an auth or
+// validation error
+func (m *SourceMonitor) subscribe(ctx context.Context, id string, watcher ActivityWatcher, address string, notify func()) {
+	logger := internal.GetLogger().With().Str("usecase", "SourceMonitor").Str("sourceID", id).Str("address", address).Logger()
+
+	backoff := monitorMinBackoff
+	for ctx.Err() == nil {
+		// Gap-fill whatever happened while the subscription was down
+		notify()
+
+		started := time.Now()
+		err := watcher.WatchAddress(ctx, address, notify)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Reconnecting cannot fix a rejected configuration or credentials
+		if errorType := interfaces.ErrorTypeOf(err); errorType == interfaces.ErrorTypeAuth || errorType == interfaces.ErrorTypeValidation {
+			logger.Error().Err(err).Str("errorType", string(errorType)).Msg("Activity subscription failed permanently, streaming disabled")
+			return
+		}
+
+		// A subscription that stayed up for a while resets the backoff
+		if time.Since(started) > monitorMaxBackoff {
+			backoff = monitorMinBackoff
+		}
+		logger.Warn().Err(err).Dur("retryIn", backoff).Msg("Activity subscription dropped, reconnecting")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, monitorMaxBackoff)
+	}
+}
+
+// syncOnActivity syncs the source once activity settles for the debounce period
+func (m *SourceMonitor) syncOnActivity(ctx context.Context, id string, activity <-chan struct{}) {
+	logger := internal.GetLogger().With().Str("usecase", "SourceMonitor").Str("sourceID", id).Logger()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-activity:
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(m.debounce):
+		}
+
+		report, err := m.syncer.SyncSource(ctx, id)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to sync source after activity")
+			continue
+		}
+		logger.Info().Int("imported", report.Imported).Msg("Synced source after activity")
+	}
+}
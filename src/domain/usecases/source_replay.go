@@ -0,0 +1,189 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// ReplayReport is the outcome of re-running the normalization of an import
+// run from its archived payloads
+type ReplayReport struct {
+	RunID    string `json:"runId"`
+	SourceID string `json:"sourceId"`
+	Payloads int    `json:"payloads"` // archived payloads replayed
+	Fetched  int    `json:"fetched"`  // transactions normalized from the payloads
+	Compared int    `json:"compared"` // imported transactions compared with their replay
+	Missing  int    `json:"missing"`  // not imported before, e.g. dropped by a mapping bug
+	Applied  bool   `json:"applied"`
+
+	// Changes lists the imported transactions that normalize differently now
+	Changes []ReplayChange `json:"changes,omitempty"`
+	// Updated counts the transactions stored with a new description, category
+	// or normalizer version when applied
+	Updated int `json:"updated"`
+	// Import is the import of the missing transactions when applied
+	Import *ImportReport `json:"import,omitempty"`
+
+	rewritten []*models.Transaction // stored with a new description or category
+}
+
+// ReplayChange is an imported transaction that normalizes differently from
+// the archived payloads now
+type ReplayChange struct {
+	TransactionID string   `json:"transactionId"`
+	ExternalID    string   `json:"externalId"`
+	Fields        []string `json:"fields"` // amount, fee, date, type, description, category
+}
+
+// Replay re-runs the normalization and categorization of a source's import
+// run from its archived payloads, without calling the provider. Unless apply
+// is set it only reports the transactions missing from the wallet and those
+// that normalize differently. Applied, it imports the missing transactions
+// and stores the new descriptions and categories; changed amounts, fees,
+// dates and types are only reported, as correcting them moves balances.
+// Transactions left without such changes are tagged with the current
+// version of the source's normalizer.
+func (s *SourceSyncService) Replay(ctx context.Context, runID, sourceID string, apply bool) (*ReplayReport, error) {
+	ctx, _ = internal.EnsureRequestID(ctx)
+	source, err := s.source(sourceID)
+	if err != nil {
+		return nil, err
+	}
+	return s.replay(ctx, runID, source, apply, false)
+}
+
+// replay re-runs the normalization of a source's import run. With staleOnly
+// set, only the transactions produced by an older version of the source's
+// normalizer are compared.
+func (s *SourceSyncService) replay(ctx context.Context, runID string, source Source, apply, staleOnly bool) (*ReplayReport, error) {
+	if s.archive == nil {
+		return nil, fmt.Errorf("payload archive is disabled: %w", models.ErrNoArchivedPayloads)
+	}
+	sourceID := source.ID()
+	version := normalizerVersion(source)
+	replaying, count, err := s.archive.replayClient(ctx, runID, source)
+	if err != nil {
+		return nil, err
+	}
+
+	fetched, filtered, err := replaying.FetchTransactions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize archived payloads: %w", err)
+	}
+
+	lock := s.lock(sourceID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	wallet, err := s.sourceWallet(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReplayReport{RunID: runID, SourceID: sourceID, Payloads: count, Fetched: len(fetched), Applied: apply}
+	var missing []models.Transaction
+	var updates, rewritten []*models.Transaction
+	categories := make(map[string]string)
+	for _, tx := range fetched {
+		existing, err := s.transactionRepo.FindAll(ctx, repositories.TransactionFilter{
+			WalletID:       wallet.ID,
+			Metadata:       map[string]string{MetadataExternalID: tx.ID},
+			IncludeDeleted: true,
+			Limit:          1,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up imported transaction: %w", err)
+		}
+		if len(existing) == 0 {
+			missing = append(missing, tx)
+			continue
+		}
+		stored := existing[0]
+		if stored.IsDeleted() || (staleOnly && stored.NormalizerVersion() >= version) {
+			continue
+		}
+		report.Compared++
+
+		normalized := tx
+		normalized.Metadata = nil
+		normalized.MergeMetadata(tx.Metadata)
+		if source.Sandbox {
+			normalized.MarkSandbox()
+		}
+		s.imports.prepare(ctx, source.Account.Source, wallet.ID, &normalized, categories)
+
+		fields := replayChangedFields(stored, &normalized)
+		if len(fields) > 0 {
+			report.Changes = append(report.Changes, ReplayChange{TransactionID: stored.ID, ExternalID: tx.ID, Fields: fields})
+		}
+
+		// Transactions whose amounts, fees, dates or types changed stay at
+		// their version, so they keep showing up until corrected by hand
+		settled := !slices.ContainsFunc(fields, func(field string) bool {
+			return field != "description" && field != "category"
+		})
+		rewrite := stored.Description != normalized.Description || stored.CategoryID != normalized.CategoryID
+		retag := settled && version > 0 && stored.NormalizerVersion() != version
+		if !rewrite && !retag {
+			continue
+		}
+		stored.Description = normalized.Description
+		stored.CategoryID = normalized.CategoryID
+		if retag {
+			stored.SetNormalizerVersion(version)
+		}
+		updates = append(updates, stored)
+		if rewrite {
+			rewritten = append(rewritten, stored)
+		}
+	}
+	report.Missing = len(missing)
+	if !apply {
+		return report, nil
+	}
+
+	if len(updates) > 0 {
+		report.Updated, err = s.transactionRepo.UpdateMany(ctx, updates)
+		if err != nil {
+			return report, fmt.Errorf("failed to store replayed transactions: %w", err)
+		}
+		report.rewritten = rewritten
+	}
+	if len(missing) > 0 {
+		report.Import, err = s.importFetched(ctx, source, wallet.ID, missing, filtered)
+		if err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// replayChangedFields lists the fields in which a stored transaction differs
+// from its normalization replayed
+func replayChangedFields(stored, normalized *models.Transaction) []string {
+	var fields []string
+	if stored.Amount != normalized.Amount {
+		fields = append(fields, "amount")
+	}
+	if stored.Fee != normalized.Fee {
+		fields = append(fields, "fee")
+	}
+	if !stored.Date.Equal(normalized.Date) {
+		fields = append(fields, "date")
+	}
+	if stored.Type != normalized.Type {
+		fields = append(fields, "type")
+	}
+	if stored.Description != normalized.Description {
+		fields = append(fields, "description")
+	}
+	if stored.CategoryID != normalized.CategoryID {
+		fields = append(fields, "category")
+	}
+	return fields
+}
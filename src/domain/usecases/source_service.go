@@ -0,0 +1,393 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/address"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// DefaultSourceTestTimeout bounds a whole source self-test
+const DefaultSourceTestTimeout = 15 * time.Second
+
+// SourceClient reads balances and transactions of source accounts.
+// Both interfaces.BlockchainClient and interfaces.BankClient satisfy it.
+type SourceClient interface {
+	GetBalance(account string) (models.BalanceInfo, error)
+	FetchTransactions(account string) ([]models.Transaction, error)
+}
+
+// credentialValidator is implemented by clients that authenticate against their provider
+type credentialValidator interface {
+	ValidateCredentials() error
+}
+
+// filteredFetcher is implemented by clients that drop token transfers through a token filter
+type filteredFetcher interface {
+	FetchFilteredTransactions(account string) ([]models.Transaction, models.TokenFilterStats, error)
+}
+
+// balancesFetcher is implemented by clients that report every balance type of an
+// account (interfaces.BankAccountClient)
+type balancesFetcher interface {
+	FetchBalances(account string) ([]models.ReportedBalance, error)
+}
+
+// locationClient is implemented by clients that parse calendar dates, such as
+// booking dates, which only mean a point in time in a timezone
+type locationClient interface {
+	WithLocation(loc *time.Location) interfaces.BankAccountClient
+}
+
+// addressValidator is implemented by clients that can check an account address offline
+type addressValidator interface {
+	IsValidAddress(address string) bool
+}
+
+// Source is a configured import source account
+type Source struct {
+	Account AccountRef
+	Client  SourceClient // optional: nil when the source has no client yet
+	SpaceID string       // optional: space that owns the wallet of the source
+	Sandbox bool         // imports test data from the provider's sandbox, kept out of reports
+
+	// Addresses are all addresses of a wallet spanning several, the account
+	// first. Their transactions are merged into the one wallet of the source.
+	Addresses []string
+
+	// BalanceTypes selects, most preferred first, which of the balances a bank
+	// reports drives reconciliation. Defaults to models.DefaultBalanceTypes.
+	BalanceTypes []models.BalanceType
+
+	// Location is the timezone of the source's calendar dates, UTC when nil.
+	// Set it with InLocation so the client parses dates in it too.
+	Location *time.Location
+}
+
+// ID returns the stable identifier of the source, e.g. "ethereum:0xabc..."
+func (s Source) ID() string {
+	return s.Account.Source + ":" + s.Account.Account
+}
+
+// InLocation returns a copy of the source whose calendar dates are in loc:
+// its client parses booking dates as midnight there and duplicates are looked
+// for over whole days there
+func (s Source) InLocation(loc *time.Location) Source {
+	s.Location = loc
+	if client, ok := s.Client.(locationClient); ok {
+		s.Client = client.WithLocation(loc)
+	}
+	return s
+}
+
+// Provider identifies the external service behind the source for outage
+// tracking: the explorer of the chain for blockchain accounts, and the account
+// itself for bank accounts, which can each be held at a different bank
+func (s Source) Provider() string {
+	if s.Account.Currency == "" {
+		return s.ID()
+	}
+	return s.Account.Source
+}
+
+// OwnedAddresses returns the addresses of the source, which is just the
+// account unless the wallet spans several addresses
+func (s Source) OwnedAddresses() []string {
+	if len(s.Addresses) == 0 {
+		return []string{s.Account.Account}
+	}
+	return s.Addresses
+}
+
+// Balance fetches the balance of the source account that drives reconciliation.
+// Clients that report several balance types also return all of them. The
+// balance of a wallet with several addresses is the sum of their balances.
+func (s Source) Balance() (models.BalanceInfo, []models.ReportedBalance, error) {
+	fetcher, ok := s.Client.(balancesFetcher)
+	if !ok {
+		var total models.BalanceInfo
+		for _, address := range s.OwnedAddresses() {
+			balance, err := s.Client.GetBalance(address)
+			if err != nil {
+				return models.BalanceInfo{}, nil, err
+			}
+			amount := total.Amount + balance.Amount
+			total = balance
+			total.Amount = amount
+		}
+		return total, nil, nil
+	}
+
+	balances, err := fetcher.FetchBalances(s.Account.Account)
+	if err != nil {
+		return models.BalanceInfo{}, nil, err
+	}
+	selected, ok := models.SelectBalance(balances, s.BalanceTypes)
+	if !ok {
+		return models.BalanceInfo{}, balances, fmt.Errorf("none of the preferred balance types %v reported for %s", s.preferredBalanceTypes(), s.ID())
+	}
+
+	return models.BalanceInfo{
+		Amount:      selected.Amount,
+		Currency:    selected.Currency,
+		BalanceType: selected.BalanceType,
+	}, balances, nil
+}
+
+// FetchTransactions fetches the transactions every address of the source
+// currently reports, merged into the history of its wallet
+func (s Source) FetchTransactions() ([]models.Transaction, models.TokenFilterStats, error) {
+	addresses := s.OwnedAddresses()
+	histories := make(map[string][]models.Transaction, len(addresses))
+	var filtered models.TokenFilterStats
+	for _, address := range addresses {
+		var transactions []models.Transaction
+		var err error
+		if fetcher, ok := s.Client.(filteredFetcher); ok {
+			var stats models.TokenFilterStats
+			transactions, stats, err = fetcher.FetchFilteredTransactions(address)
+			filtered.Add(stats)
+		} else {
+			transactions, err = s.Client.FetchTransactions(address)
+		}
+		if err != nil {
+			return nil, filtered, err
+		}
+		histories[address] = transactions
+	}
+
+	if len(addresses) == 1 {
+		return histories[addresses[0]], filtered, nil
+	}
+	return models.MergeAddressHistories(histories, addresses), filtered, nil
+}
+
+func (s Source) preferredBalanceTypes() []models.BalanceType {
+	if len(s.BalanceTypes) == 0 {
+		return models.DefaultBalanceTypes
+	}
+	return s.BalanceTypes
+}
+
+// SourceInfo describes a configured source
+type SourceInfo struct {
+	ID        string   `json:"id"`
+	Source    string   `json:"source"`
+	Account   string   `json:"account"`
+	Name      string   `json:"name"`
+	Addresses []string `json:"addresses,omitempty"` // every address of a wallet spanning several
+	HasClient bool     `json:"hasClient"`
+	Sandbox   bool     `json:"sandbox,omitempty"`
+}
+
+// SourceTestStep is the outcome of a single self-test step
+type SourceTestStep struct {
+	Name      string               `json:"name"` // auth, balance, transactions
+	OK        bool                 `json:"ok"`
+	Skipped   bool                 `json:"skipped,omitempty"`
+	LatencyMS int64                `json:"latencyMs"`
+	Count     int                  `json:"count,omitempty"`    // transactions fetched
+	Filtered  int                  `json:"filtered,omitempty"` // token transfers dropped by the token filter
+	Error     string               `json:"error,omitempty"`
+	ErrorType interfaces.ErrorType `json:"errorType,omitempty"`
+}
+
+// SourceTestReport is the structured diagnostic of a source self-test
+type SourceTestReport struct {
+	SourceID  string           `json:"sourceId"`
+	OK        bool             `json:"ok"`
+	StartedAt time.Time        `json:"startedAt"`
+	LatencyMS int64            `json:"latencyMs"`
+	Balance   *float64         `json:"balance,omitempty"`
+	Currency  string           `json:"currency,omitempty"`
+	Steps     []SourceTestStep `json:"steps"`
+
+	// BalanceType is the type of Balance, and Balances every type the bank
+	// reported, for clients that report several
+	BalanceType models.BalanceType       `json:"balanceType,omitempty"`
+	Balances    []models.ReportedBalance `json:"balances,omitempty"`
+}
+
+// SourceService exposes the configured import sources and their self-tests
+type SourceService struct {
+	sources map[string]Source
+	timeout time.Duration
+}
+
+// NewSourceService creates a new SourceService. A zero timeout uses DefaultSourceTestTimeout.
+func NewSourceService(sources []Source, timeout time.Duration) *SourceService {
+	if timeout <= 0 {
+		timeout = DefaultSourceTestTimeout
+	}
+
+	byID := make(map[string]Source, len(sources))
+	for _, source := range sources {
+		byID[source.ID()] = source
+	}
+
+	return &SourceService{
+		sources: byID,
+		timeout: timeout,
+	}
+}
+
+// ListSources returns the configured sources ordered by ID
+func (s *SourceService) ListSources() []SourceInfo {
+	infos := make([]SourceInfo, 0, len(s.sources))
+	for id, source := range s.sources {
+		infos = append(infos, SourceInfo{
+			ID:        id,
+			Source:    source.Account.Source,
+			Account:   source.Account.Account,
+			Name:      source.Account.Name,
+			Addresses: source.Addresses,
+			HasClient: source.Client != nil,
+			Sandbox:   source.Sandbox,
+		})
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// SpaceOf returns the space that owns the wallet of a source, empty for a
+// shared wallet
+func (s *SourceService) SpaceOf(id string) (string, error) {
+	source, ok := s.sources[id]
+	if !ok {
+		return "", fmt.Errorf("source %q: %w", id, models.ErrSourceNotFound)
+	}
+	return source.SpaceID, nil
+}
+
+// TestSource runs a live smoke test against a source: credentials, one balance
+// fetch and one transaction fetch, all within the service timeout. Failing steps
+// are reported in the result; an error is only returned for unknown sources.
+func (s *SourceService) TestSource(ctx context.Context, id string) (*SourceTestReport, error) {
+	source, ok := s.sources[id]
+	if !ok {
+		return nil, fmt.Errorf("source %q: %w", id, models.ErrSourceNotFound)
+	}
+
+	logger := internal.GetLogger().With().Str("usecase", "TestSource").Str("sourceID", id).Logger()
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	report := &SourceTestReport{
+		SourceID:  id,
+		StartedAt: time.Now(),
+		Steps:     make([]SourceTestStep, 0, 3),
+	}
+
+	if source.Client == nil {
+		report.Steps = append(report.Steps, SourceTestStep{
+			Name:      "auth",
+			Error:     "no client is available for this source",
+			ErrorType: interfaces.ErrorTypeValidation,
+		})
+		report.LatencyMS = time.Since(report.StartedAt).Milliseconds()
+		return report, nil
+	}
+
+	// Credentials (or the address format for keyless blockchain sources)
+	auth := runSourceStep(ctx, "auth", func() (int, error) {
+		if validator, ok := source.Client.(credentialValidator); ok {
+			return 0, validator.ValidateCredentials()
+		}
+		for _, account := range source.OwnedAddresses() {
+			if err := address.Validate(source.Account.Source, account); err != nil {
+				return 0, interfaces.NewClientError(interfaces.ErrorTypeValidation, "invalid address "+account, err)
+			}
+			if validator, ok := source.Client.(addressValidator); ok && !validator.IsValidAddress(account) {
+				return 0, interfaces.NewClientError(interfaces.ErrorTypeValidation, "invalid address "+account, nil)
+			}
+		}
+		return 0, nil
+	})
+	report.Steps = append(report.Steps, auth)
+
+	if auth.OK {
+		var balance models.BalanceInfo
+		var balances []models.ReportedBalance
+		report.Steps = append(report.Steps, runSourceStep(ctx, "balance", func() (int, error) {
+			var err error
+			balance, balances, err = source.Balance()
+			return 0, err
+		}))
+		if report.Steps[len(report.Steps)-1].OK {
+			report.Balance = &balance.Amount
+			report.Currency = balance.Currency
+			report.BalanceType = balance.BalanceType
+			report.Balances = balances
+		}
+
+		var filtered models.TokenFilterStats
+		report.Steps = append(report.Steps, runSourceStep(ctx, "transactions", func() (int, error) {
+			transactions, stats, err := source.FetchTransactions()
+			filtered = stats
+			return len(transactions), err
+		}))
+		if step := &report.Steps[len(report.Steps)-1]; step.OK {
+			step.Filtered = filtered.Total()
+		}
+	} else {
+		report.Steps = append(report.Steps,
+			SourceTestStep{Name: "balance", Skipped: true},
+			SourceTestStep{Name: "transactions", Skipped: true},
+		)
+	}
+
+	report.OK = true
+	for _, step := range report.Steps {
+		if !step.OK {
+			report.OK = false
+		}
+	}
+	report.LatencyMS = time.Since(report.StartedAt).Milliseconds()
+
+	logger.Info().Bool("ok", report.OK).Int64("latencyMs", report.LatencyMS).Msg("Source self-test finished")
+	return report, nil
+}
+
+// runSourceStep runs a blocking client call, giving up when ctx is done.
+// The clients are not context-aware, so a timed-out call keeps running in the
+// background until it returns on its own.
+func runSourceStep(ctx context.Context, name string, call func() (int, error)) SourceTestStep {
+	step := SourceTestStep{Name: name}
+	start := time.Now()
+
+	type outcome struct {
+		count int
+		err   error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		count, err := call()
+		done <- outcome{count: count, err: err}
+	}()
+
+	var err error
+	select {
+	case result := <-done:
+		step.Count = result.count
+		err = result.err
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	step.LatencyMS = time.Since(start).Milliseconds()
+	if err != nil {
+		step.Error = err.Error()
+		step.ErrorType = interfaces.ErrorTypeOf(err)
+		return step
+	}
+
+	step.OK = true
+	return step
+}
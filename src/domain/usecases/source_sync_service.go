@@ -0,0 +1,921 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/workerpool"
+	"github.com/google/uuid"
+)
+
+// MetadataExternalID is the metadata key holding the provider's ID of an imported
+// transaction, used to skip transactions a previous sync already stored
+const MetadataExternalID = "externalId"
+
+// SourceSyncService fetches the transactions of configured sources and imports
+// them into the wallet of each source account
+type SourceSyncService struct {
+	sources         map[string]Source
+	walletRepo      repositories.WalletRepository
+	transactionRepo repositories.TransactionRepository
+	imports         *ImportService
+	snapshotRepo    repositories.BalanceSnapshotRepository // optional: records reported balances
+	incidents       *IncidentService                       // optional: tracks provider outages
+	maxRetries      int                                    // retries of a retryable fetch failure
+	retryDelay      time.Duration                          // wait before the first retry, doubled for each next one
+	pool            *workerpool.Pool                       // optional: bounds concurrent syncs of SyncAll
+	runRepo         repositories.ImportRunRepository       // optional: stores import cycle reports
+	stateRepo       repositories.SourceStateRepository     // optional: persists the sync statistics
+	maintenance     *MaintenanceService                    // optional: skips sources of frozen spaces
+	remote          RemoteFetcher                          // optional: fetches sources on worker nodes
+	archive         *PayloadArchiveService                 // optional: archives the raw provider payloads
+	publisher       EventPublisher                         // optional: announces lifecycle changes and consent renewals
+
+	mu        sync.Mutex
+	locks     map[string]*sync.Mutex         // one sync per source at a time
+	lastCycle *models.ImportCycleReport      // report of the last finished SyncAll
+	states    map[string]*models.SourceState // sync statistics and lifecycle by source ID
+	paused    map[string]bool                // sources excluded from syncs until resumed
+}
+
+// NewSourceSyncService creates a new SourceSyncService
+func NewSourceSyncService(
+	sources []Source,
+	walletRepo repositories.WalletRepository,
+	transactionRepo repositories.TransactionRepository,
+	imports *ImportService,
+) *SourceSyncService {
+	byID := make(map[string]Source, len(sources))
+	for _, source := range sources {
+		byID[source.ID()] = source
+	}
+
+	return &SourceSyncService{
+		sources:         byID,
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+		imports:         imports,
+		locks:           make(map[string]*sync.Mutex),
+		states:          make(map[string]*models.SourceState),
+		paused:          make(map[string]bool),
+	}
+}
+
+// WithSnapshots records every balance type a bank reports after each sync
+// in the snapshot history.
+func (s *SourceSyncService) WithSnapshots(snapshotRepo repositories.BalanceSnapshotRepository) *SourceSyncService {
+	s.snapshotRepo = snapshotRepo
+	return s
+}
+
+// WithIncidents reports the outcome of every fetch to the incident tracker
+func (s *SourceSyncService) WithIncidents(incidents *IncidentService) *SourceSyncService {
+	s.incidents = incidents
+	return s
+}
+
+// WithRetries retries a fetch that failed with a retryable client error up to
+// maxRetries times, waiting delay before the first retry and doubling the wait
+// for each next one. A longer wait requested by the provider takes precedence.
+func (s *SourceSyncService) WithRetries(maxRetries int, delay time.Duration) *SourceSyncService {
+	s.maxRetries = maxRetries
+	s.retryDelay = delay
+	return s
+}
+
+// WithPool runs the syncs of SyncAll on a worker pool, which bounds how many
+// sources sync at once in total and per provider
+func (s *SourceSyncService) WithPool(pool *workerpool.Pool) *SourceSyncService {
+	s.pool = pool
+	return s
+}
+
+// WithRuns stores the report of every import cycle
+func (s *SourceSyncService) WithRuns(runRepo repositories.ImportRunRepository) *SourceSyncService {
+	s.runRepo = runRepo
+	return s
+}
+
+// WithState persists the sync statistics of every source, so they survive
+// restarts. Call LoadState before the first sync to restore them.
+func (s *SourceSyncService) WithState(stateRepo repositories.SourceStateRepository) *SourceSyncService {
+	s.stateRepo = stateRepo
+	return s
+}
+
+// WithMaintenance skips the sources of spaces in maintenance mode. Syncing
+// one of them fails with models.ErrMaintenance.
+func (s *SourceSyncService) WithMaintenance(maintenance *MaintenanceService) *SourceSyncService {
+	s.maintenance = maintenance
+	return s
+}
+
+// RemoteFetcher fetches the transactions of a source on another node.
+// *cluster.Coordinator satisfies it.
+type RemoteFetcher interface {
+	// FetchRemote returns models.ErrWorkerUnavailable when no node can fetch
+	// the source, which is then fetched locally
+	FetchRemote(ctx context.Context, sourceID string) ([]models.Transaction, models.TokenFilterStats, error)
+}
+
+// WithRemote fetches the sources served by worker nodes on those nodes and
+// imports the fetched transactions here. Sources without a live worker are
+// fetched locally.
+func (s *SourceSyncService) WithRemote(remote RemoteFetcher) *SourceSyncService {
+	s.remote = remote
+	return s
+}
+
+// WithArchive archives the raw payloads of every local fetch under its import
+// run, so the run can be replayed
+func (s *SourceSyncService) WithArchive(archive *PayloadArchiveService) *SourceSyncService {
+	s.archive = archive
+	return s
+}
+
+// WithPublisher announces sources moving to another lifecycle state and the
+// consent renewals requested, whichever backend stores the states
+func (s *SourceSyncService) WithPublisher(publisher EventPublisher) *SourceSyncService {
+	s.publisher = publisher
+	return s
+}
+
+// LoadState restores the sync statistics and lifecycle states a previous run
+// stored. States stored before sources had a lifecycle are resolved from their
+// statistics, and sources that lost their client become unconfigured.
+func (s *SourceSyncService) LoadState(ctx context.Context) error {
+	if s.stateRepo == nil {
+		return nil
+	}
+
+	states, err := s.stateRepo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var previous, changed []models.SourceState
+	s.mu.Lock()
+	for _, state := range states {
+		source, configured := s.sources[state.SourceID]
+		before := *state
+		if state.Lifecycle == "" {
+			state.Lifecycle = resumedLifecycle(source, state)
+		}
+		if configured && source.Client == nil {
+			_ = state.Transition(models.SourceUnconfigured, "source has no client", now)
+		}
+		if state.Lifecycle != before.Lifecycle {
+			previous = append(previous, before)
+			changed = append(changed, *state)
+		}
+		s.states[state.SourceID] = state
+	}
+	s.mu.Unlock()
+
+	for i := range changed {
+		s.saveState(ctx, previous[i], &changed[i])
+	}
+	return nil
+}
+
+// resumedLifecycle resolves the lifecycle state of a source stored without one
+func resumedLifecycle(source Source, state *models.SourceState) models.SourceLifecycle {
+	switch {
+	case source.Client == nil:
+		return models.SourceUnconfigured
+	case state.Failures >= models.SourceDegradedAfter:
+		return models.SourceDegraded
+	case !state.LastSuccessAt.IsZero():
+		return models.SourceActive
+	default:
+		return models.SourceAuthorized
+	}
+}
+
+// initialLifecycle is the lifecycle state of a source that has no stored state
+func initialLifecycle(source Source) models.SourceLifecycle {
+	if source.Client == nil {
+		return models.SourceUnconfigured
+	}
+	return models.SourceAuthorized
+}
+
+// stateOf returns the state of a source, creating it in its initial lifecycle
+// state. Must be called with s.mu held.
+func (s *SourceSyncService) stateOf(id string) *models.SourceState {
+	state, ok := s.states[id]
+	if !ok {
+		state = models.NewSourceState(id, initialLifecycle(s.sources[id]))
+		s.states[id] = state
+	}
+	return state
+}
+
+// Lifecycle returns the lifecycle state of a source
+func (s *SourceSyncService) Lifecycle(id string) (models.SourceLifecycle, error) {
+	if _, ok := s.sources[id]; !ok {
+		return "", fmt.Errorf("source %q: %w", id, models.ErrSourceNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stateOf(id).Lifecycle, nil
+}
+
+// TransitionSource moves a source to a lifecycle state an operator sets:
+// disabled to turn it off, authorized to turn it back on or after access was
+// granted again, and expired_consent when access was revoked at the provider.
+// The other states follow from the outcome of syncs.
+func (s *SourceSyncService) TransitionSource(ctx context.Context, id string, to models.SourceLifecycle, reason string) (*models.SourceState, error) {
+	source, ok := s.sources[id]
+	if !ok {
+		return nil, fmt.Errorf("source %q: %w", id, models.ErrSourceNotFound)
+	}
+	switch to {
+	case models.SourceAuthorized, models.SourceDisabled, models.SourceConsentExpired:
+	default:
+		if err := to.Validate(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s is set by syncs, not by operators", models.ErrInvalidSourceTransition, to)
+	}
+	if to == models.SourceAuthorized && source.Client == nil {
+		return nil, fmt.Errorf("source %q: %w", id, models.ErrSourceHasNoClient)
+	}
+
+	s.mu.Lock()
+	state := s.stateOf(id)
+	previous := *state
+	if err := state.Transition(to, reason, time.Now()); err != nil {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("source %q: %w", id, err)
+	}
+	snapshot := *state
+	s.mu.Unlock()
+
+	s.saveState(ctx, previous, &snapshot)
+	return &snapshot, nil
+}
+
+// updateState changes the state of a source under the lock and stores it
+// unless change fails
+func (s *SourceSyncService) updateState(ctx context.Context, id string, change func(state *models.SourceState) error) (*models.SourceState, error) {
+	s.mu.Lock()
+	state := s.stateOf(id)
+	previous := *state
+	if err := change(state); err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	snapshot := *state
+	s.mu.Unlock()
+
+	s.saveState(ctx, previous, &snapshot)
+	return &snapshot, nil
+}
+
+// checkSyncable returns models.ErrSourceNotSyncable for a source whose
+// lifecycle state does not allow importing
+func (s *SourceSyncService) checkSyncable(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lifecycle := s.stateOf(id).Lifecycle; !lifecycle.CanSync() {
+		return fmt.Errorf("source %q is %s: %w", id, lifecycle, models.ErrSourceNotSyncable)
+	}
+	return nil
+}
+
+// SourceStates returns the sync statistics and lifecycle state of every
+// source that synced or was checked, sorted by source ID
+func (s *SourceSyncService) SourceStates() []models.SourceState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	states := make([]models.SourceState, 0, len(s.states))
+	for _, state := range s.states {
+		states = append(states, *state)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].SourceID < states[j].SourceID })
+	return states
+}
+
+// recordState accounts a finished sync in the statistics of its source, moves
+// it along its lifecycle and stores them. A provider rejecting the
+// credentials expires the consent of the source.
+func (s *SourceSyncService) recordState(ctx context.Context, id string, report *ImportReport, syncErr error) {
+	imported := 0
+	if report != nil {
+		imported = report.Imported
+	}
+
+	now := time.Now()
+	s.mu.Lock()
+	state := s.stateOf(id)
+	previous := *state
+	state.Record(now, imported, syncErr)
+	if syncErr != nil && interfaces.ErrorTypeOf(syncErr) == interfaces.ErrorTypeAuth {
+		_ = state.Transition(models.SourceConsentExpired, syncErr.Error(), now)
+	}
+	snapshot := *state
+	s.mu.Unlock()
+
+	s.saveState(ctx, previous, &snapshot)
+}
+
+// saveState announces how the state of a source changed since previous and
+// stores it, logging failures
+func (s *SourceSyncService) saveState(ctx context.Context, previous models.SourceState, snapshot *models.SourceState) {
+	s.announce(ctx, previous, snapshot)
+	if s.stateRepo == nil {
+		return
+	}
+	if err := s.stateRepo.Save(ctx, snapshot); err != nil {
+		logger := internal.LoggerFrom(ctx)
+		logger.Warn().Err(err).Str("sourceID", snapshot.SourceID).Msg("Failed to store source state")
+		return
+	}
+
+	s.mu.Lock()
+	if state, ok := s.states[snapshot.SourceID]; ok {
+		state.ID = snapshot.ID
+	}
+	s.mu.Unlock()
+}
+
+// announce publishes source.transitioned when a source moved to another
+// lifecycle state and source.consent_renewal_requested when a renewal link
+// was created since previous
+func (s *SourceSyncService) announce(ctx context.Context, previous models.SourceState, state *models.SourceState) {
+	if s.publisher == nil {
+		return
+	}
+
+	var announced []*interfaces.Event
+	if state.Lifecycle != previous.Lifecycle {
+		announced = append(announced, interfaces.NewEvent(interfaces.EventTypeSourceTransitioned, "sources").
+			WithTarget(state.SourceID).
+			WithData("sourceId", state.SourceID).
+			WithData("from", string(previous.Lifecycle)).
+			WithData("to", string(state.Lifecycle)).
+			WithData("reason", state.LifecycleReason))
+	}
+	if state.Renewal == models.ConsentRenewalPending && !state.RenewalRequestedAt.IsZero() &&
+		!state.RenewalRequestedAt.Equal(previous.RenewalRequestedAt) {
+		announced = append(announced, interfaces.NewEvent(interfaces.EventTypeConsentRenewalRequested, "sources").
+			WithTarget(state.SourceID).
+			WithData("sourceId", state.SourceID).
+			WithData("url", state.RenewalURL).
+			WithData("date", state.ConsentExpiresAt))
+	}
+
+	for _, event := range announced {
+		if err := s.publisher.Publish(ctx, event); err != nil {
+			logger := internal.LoggerFrom(ctx)
+			logger.Warn().Err(err).Str("sourceID", state.SourceID).Str("event", string(event.Type)).Msg("Failed to publish source event")
+		}
+	}
+}
+
+// SyncAll runs an import cycle: it syncs every source that has a client, is
+// not paused, may sync in its lifecycle state and whose space is not frozen,
+// and returns the cycle report with
+// the sources sorted by ID. Without a worker pool the sources are synced one
+// at a time.
+func (s *SourceSyncService) SyncAll(ctx context.Context) *models.ImportCycleReport {
+	var maintenance *models.MaintenanceState
+	if s.maintenance != nil {
+		var err error
+		if maintenance, err = s.maintenance.Status(ctx); err != nil {
+			// Every sync checks again and fails with the error
+			logger := internal.LoggerFrom(ctx)
+			logger.Warn().Err(err).Msg("Failed to read maintenance mode")
+		}
+	}
+
+	s.mu.Lock()
+	ids := make([]string, 0, len(s.sources))
+	for id, source := range s.sources {
+		if source.Client != nil && !s.paused[id] && s.stateOf(id).Lifecycle.CanSync() &&
+			(maintenance == nil || maintenance.Frozen(source.SpaceID) == nil) {
+			ids = append(ids, id)
+		}
+	}
+	s.mu.Unlock()
+
+	return s.runCycle(ctx, ids)
+}
+
+// SyncSources runs an import cycle over the given sources only
+func (s *SourceSyncService) SyncSources(ctx context.Context, ids []string) (*models.ImportCycleReport, error) {
+	for _, id := range ids {
+		if _, err := s.source(id); err != nil {
+			return nil, err
+		}
+	}
+	return s.runCycle(ctx, slices.Clone(ids)), nil
+}
+
+// runCycle syncs the sources of an import cycle and stores its report. The
+// syncs are queued with the priority of ctx, see workerpool.WithPriority, and
+// share its correlation ID, which is generated for scheduled cycles.
+func (s *SourceSyncService) runCycle(ctx context.Context, ids []string) *models.ImportCycleReport {
+	ctx, _ = internal.EnsureRequestID(ctx)
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "SyncAll").Logger()
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	cycle := &models.ImportCycleReport{CycleID: uuid.New().String(), StartedAt: time.Now()}
+	ctx = context.WithValue(ctx, cycleIDKey{}, cycle.CycleID)
+	results := make([]models.SourceCycleReport, len(ids))
+	syncOne := func(i int) {
+		started := time.Now()
+		report, err := s.SyncSource(ctx, ids[i])
+		results[i] = sourceCycleReport(ids[i], report, err)
+		results[i].DurationMS = time.Since(started).Milliseconds()
+	}
+
+	if s.pool == nil {
+		for i := range ids {
+			syncOne(i)
+		}
+	} else {
+		priority := workerpool.PriorityFrom(ctx)
+		var wg sync.WaitGroup
+		for i, id := range ids {
+			wg.Add(1)
+			err := s.pool.SubmitPriority(s.sources[id].Account.Source, priority, func() {
+				defer wg.Done()
+				syncOne(i)
+			})
+			if err != nil {
+				wg.Done()
+				results[i] = sourceCycleReport(id, nil, err)
+			}
+		}
+		wg.Wait()
+	}
+
+	for _, result := range results {
+		cycle.Add(result)
+	}
+	cycle.FinishedAt = time.Now()
+
+	if s.runRepo != nil {
+		if err := s.runRepo.Create(ctx, cycle); err != nil {
+			logger.Warn().Err(err).Str("cycleID", cycle.CycleID).Msg("Failed to store import cycle report")
+		}
+	}
+
+	s.mu.Lock()
+	s.lastCycle = cycle
+	s.mu.Unlock()
+
+	logger.Info().
+		Str("cycleID", cycle.CycleID).
+		Int("sources", len(cycle.Sources)).
+		Int("failed", cycle.Failed).
+		Int("imported", cycle.Imported).
+		Msg("Import cycle finished")
+
+	return cycle
+}
+
+// onPool runs fn on the worker pool in the lane of priority and waits until it
+// returns. Without a pool fn runs on the calling goroutine.
+func (s *SourceSyncService) onPool(source Source, priority workerpool.Priority, fn func()) error {
+	if s.pool == nil {
+		fn()
+		return nil
+	}
+
+	done := make(chan struct{})
+	if err := s.pool.SubmitPriority(source.Account.Source, priority, func() {
+		defer close(done)
+		fn()
+	}); err != nil {
+		return err
+	}
+	<-done
+	return nil
+}
+
+// sourceCycleReport converts the outcome of a source sync into its part of a cycle report
+func sourceCycleReport(id string, report *ImportReport, err error) models.SourceCycleReport {
+	result := models.SourceCycleReport{SourceID: id}
+	if report != nil {
+		result.Received = report.Received
+		result.Imported = report.Imported
+		result.Duplicates = report.Duplicates
+		result.Flagged = report.Flagged
+		result.Invalid = report.Invalid
+		result.Filtered = report.Filtered
+		result.Snapshots = report.Snapshots
+		result.Errors = report.Errors
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// LastCycle returns the report of the last import cycle, falling back to the
+// last stored report after a restart. It returns nil before the first cycle.
+func (s *SourceSyncService) LastCycle(ctx context.Context) (*models.ImportCycleReport, error) {
+	s.mu.Lock()
+	last := s.lastCycle
+	s.mu.Unlock()
+	if last != nil || s.runRepo == nil {
+		return last, nil
+	}
+
+	reports, err := s.runRepo.FindRecent(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, nil
+	}
+	return reports[0], nil
+}
+
+// ListCycles returns the most recent stored import cycle reports, newest first
+func (s *SourceSyncService) ListCycles(ctx context.Context, limit int) ([]*models.ImportCycleReport, error) {
+	if s.runRepo == nil {
+		return []*models.ImportCycleReport{}, nil
+	}
+	return s.runRepo.FindRecent(ctx, limit)
+}
+
+// SyncStatus is the state of the sync service
+type SyncStatus struct {
+	Queue     []workerpool.ProviderStats `json:"queue"`
+	Sources   []models.SourceState       `json:"sources"`
+	Paused    []string                   `json:"paused"`
+	LastCycle *models.ImportCycleReport  `json:"lastCycle"`
+}
+
+// Status returns the sync queue, the statistics of every source and the
+// report of the last import cycle
+func (s *SourceSyncService) Status(ctx context.Context) (*SyncStatus, error) {
+	last, err := s.LastCycle(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &SyncStatus{Queue: s.QueueStats(), Sources: s.SourceStates(), Paused: s.PausedSources(), LastCycle: last}, nil
+}
+
+// PauseSource excludes a source from every sync until it is resumed. Running
+// syncs finish; later ones fail with models.ErrSourcePaused.
+func (s *SourceSyncService) PauseSource(id string) error {
+	if _, ok := s.sources[id]; !ok {
+		return fmt.Errorf("source %q: %w", id, models.ErrSourceNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paused[id] = true
+	return nil
+}
+
+// ResumeSource lets a paused source sync again
+func (s *SourceSyncService) ResumeSource(id string) error {
+	if _, ok := s.sources[id]; !ok {
+		return fmt.Errorf("source %q: %w", id, models.ErrSourceNotFound)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.paused, id)
+	return nil
+}
+
+// PausedSources returns the IDs of the paused sources, sorted
+func (s *SourceSyncService) PausedSources() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paused := make([]string, 0, len(s.paused))
+	for id := range s.paused {
+		paused = append(paused, id)
+	}
+	sort.Strings(paused)
+	return paused
+}
+
+// QueueStats returns the queued and running syncs per provider, or nil without a worker pool
+func (s *SourceSyncService) QueueStats() []workerpool.ProviderStats {
+	if s.pool == nil {
+		return nil
+	}
+	return s.pool.Stats()
+}
+
+// SyncSource imports the transactions a source currently reports. Transactions
+// imported by an earlier sync are skipped by their provider ID, so overlapping
+// syncs (polling, streaming, gap-fills) never store a transaction twice.
+func (s *SourceSyncService) SyncSource(ctx context.Context, id string) (*ImportReport, error) {
+	ctx, _ = internal.EnsureRequestID(ctx)
+	source, err := s.source(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPaused(id); err != nil {
+		return nil, err
+	}
+	if err := s.checkSyncable(id); err != nil {
+		return nil, err
+	}
+	if s.maintenance != nil {
+		if err := s.maintenance.Check(ctx, source.SpaceID); err != nil {
+			return nil, err
+		}
+	}
+
+	lock := s.lock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	report, err := s.syncSource(ctx, source)
+	s.recordState(ctx, id, report, err)
+	return report, err
+}
+
+// syncSource fetches and imports the transactions of a source.
+// Must be called with the lock of the source held.
+func (s *SourceSyncService) syncSource(ctx context.Context, source Source) (*ImportReport, error) {
+	id := source.ID()
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "SyncSource").Str("sourceID", id).Logger()
+
+	wallet, err := s.sourceWallet(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	fetched, filtered, err := s.fetch(ctx, source)
+	if s.incidents != nil {
+		s.incidents.Observe(ctx, source.Provider(), err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transactions: %w", err)
+	}
+
+	report, err := s.importFetched(ctx, source, wallet.ID, fetched, filtered)
+	if err != nil {
+		return report, err
+	}
+
+	snapshots, err := s.recordBalances(ctx, source, wallet.ID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to record reported balances")
+	}
+	report.Snapshots = snapshots
+	return report, nil
+}
+
+// source returns the configured source with a client
+func (s *SourceSyncService) source(id string) (Source, error) {
+	source, ok := s.sources[id]
+	if !ok {
+		return Source{}, fmt.Errorf("source %q: %w", id, models.ErrSourceNotFound)
+	}
+	if source.Client == nil {
+		return Source{}, fmt.Errorf("source %q: %w", id, models.ErrSourceHasNoClient)
+	}
+	return source, nil
+}
+
+// checkPaused returns models.ErrSourcePaused for a paused source
+func (s *SourceSyncService) checkPaused(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.paused[id] {
+		return fmt.Errorf("source %q: %w", id, models.ErrSourcePaused)
+	}
+	return nil
+}
+
+// importFetched imports fetched transactions into the wallet of a source,
+// skipping those an earlier sync already imported
+func (s *SourceSyncService) importFetched(ctx context.Context, source Source, walletID string,
+	fetched []models.Transaction, filtered models.TokenFilterStats) (*ImportReport, error) {
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "SyncSource").Str("sourceID", source.ID()).Logger()
+
+	version := normalizerVersion(source)
+	transactions := make([]*models.Transaction, 0, len(fetched))
+	for i := range fetched {
+		tx := &fetched[i]
+		known, err := alreadyImported(ctx, s.transactionRepo, s.imports.ledger, walletID, tx.ID, tx.Date)
+		if err != nil {
+			return nil, err
+		}
+		if known {
+			continue
+		}
+
+		// Provider IDs are not valid record IDs; keep them in metadata instead
+		tx.MergeMetadata(map[string]string{MetadataExternalID: tx.ID})
+		tx.ID = ""
+		if source.Sandbox {
+			tx.MarkSandbox()
+		}
+		if version > 0 {
+			tx.SetNormalizerVersion(version)
+		}
+		transactions = append(transactions, tx)
+	}
+
+	logger.Debug().Int("fetched", len(fetched)).Int("new", len(transactions)).Msg("Fetched source transactions")
+
+	return s.imports.Import(ctx, ImportInput{
+		Source:       source.Account.Source,
+		WalletID:     walletID,
+		Transactions: transactions,
+		Filtered:     filtered,
+		Location:     source.Location,
+	})
+}
+
+// cycleIDKey carries the ID of the import cycle a sync runs in
+type cycleIDKey struct{}
+
+// runID returns the import run a sync belongs to: its import cycle, or the
+// request of a sync outside a cycle
+func runID(ctx context.Context) string {
+	if id, ok := ctx.Value(cycleIDKey{}).(string); ok {
+		return id
+	}
+	return internal.RequestIDFrom(ctx)
+}
+
+// fetch retrieves the transactions a source reports, on the worker node
+// serving it when there is one, retrying retryable failures. The raw payloads
+// of the last local attempt are archived.
+func (s *SourceSyncService) fetch(ctx context.Context, source Source) ([]models.Transaction, models.TokenFilterStats, error) {
+	var fetched []models.Transaction
+	var filtered models.TokenFilterStats
+	var capture *payloadCapture
+	err := s.retry(ctx, source, func() error {
+		var err error
+		capture = nil
+		if s.remote != nil {
+			fetched, filtered, err = s.remote.FetchRemote(ctx, source.ID())
+			if !errors.Is(err, models.ErrWorkerUnavailable) {
+				return err
+			}
+		}
+		local := source
+		if s.archive != nil {
+			local, capture = s.archive.capture(source)
+		}
+		fetched, filtered, err = local.FetchTransactions()
+		return err
+	})
+	if s.archive != nil {
+		s.archive.store(ctx, runID(ctx), source.ID(), capture)
+	}
+	return fetched, filtered, err
+}
+
+// retry calls a client until it succeeds, fails with an error that is not
+// retryable or runs out of retries, and returns the last error
+func (s *SourceSyncService) retry(ctx context.Context, source Source, call func() error) error {
+	logger := internal.LoggerFrom(ctx).With().Str("usecase", "SyncSource").Str("sourceID", source.ID()).Logger()
+
+	delay := s.retryDelay
+	for attempt := 1; ; attempt++ {
+		err := call()
+		if err == nil || attempt > s.maxRetries || !interfaces.IsRetryable(err) {
+			return err
+		}
+
+		wait := max(delay, interfaces.RetryAfter(err))
+		logger.Warn().Err(err).Int("attempt", attempt).Dur("retryIn", wait).Msg("Fetch failed, retrying")
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		delay *= 2
+	}
+}
+
+// recordBalances stores a snapshot of every balance type the source reports
+// and returns how many snapshots it recorded
+func (s *SourceSyncService) recordBalances(ctx context.Context, source Source, walletID string) (int, error) {
+	fetcher, ok := source.Client.(balancesFetcher)
+	if s.snapshotRepo == nil || !ok {
+		return 0, nil
+	}
+
+	balances, err := fetcher.FetchBalances(source.Account.Account)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch balances: %w", err)
+	}
+
+	takenAt := time.Now()
+	for i, balance := range balances {
+		snapshot := models.NewReportedBalanceSnapshot(walletID, source.Account.Source, balance, takenAt)
+		if err := s.snapshotRepo.Create(ctx, snapshot); err != nil {
+			return i, fmt.Errorf("failed to snapshot %s balance: %w", balance.BalanceType, err)
+		}
+	}
+	return len(balances), nil
+}
+
+func (s *SourceSyncService) lock(id string) *sync.Mutex {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lock, ok := s.locks[id]
+	if !ok {
+		lock = &sync.Mutex{}
+		s.locks[id] = lock
+	}
+	return lock
+}
+
+// alreadyImported reports whether a wallet already holds the transaction with
+// the given provider ID, or held it before a deleted record was pruned into
+// the ledger summaries. The ledger is optional.
+func alreadyImported(ctx context.Context, transactionRepo repositories.TransactionRepository, ledger *LedgerService,
+	walletID, externalID string, date time.Time) (bool, error) {
+	if externalID == "" {
+		return false, nil
+	}
+
+	existing, err := transactionRepo.FindAll(ctx, repositories.TransactionFilter{
+		WalletID:       walletID,
+		Metadata:       map[string]string{MetadataExternalID: externalID},
+		IncludeDeleted: true, // a transaction the user deleted must not come back
+		Limit:          1,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to look up imported transaction: %w", err)
+	}
+	if len(existing) > 0 || ledger == nil {
+		return len(existing) > 0, nil
+	}
+
+	known, err := ledger.Contains(ctx, walletID, externalID, date)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up the import ledger: %w", err)
+	}
+	return known, nil
+}
+
+// sourceWallet returns the wallet named after the source account, creating it on first sync.
+// A wallet in no space yet is moved into the space configured for the source;
+// wallets a user already moved elsewhere stay where they are.
+func (s *SourceSyncService) sourceWallet(ctx context.Context, source Source) (*models.Wallet, error) {
+	account := source.Account
+	walletType := models.WalletTypeCrypto
+	if account.Currency == "" {
+		walletType = models.WalletTypeBank
+	}
+	wallet, err := findOrCreateWallet(ctx, s.walletRepo, account.Name, "Imported from "+account.Source, account.Currency, walletType)
+	if err != nil || source.SpaceID == "" || wallet.SpaceID != "" {
+		return wallet, err
+	}
+
+	wallet.SpaceID = source.SpaceID
+	if err := s.walletRepo.Update(ctx, wallet); err != nil {
+		return nil, fmt.Errorf("failed to move wallet to space %s: %w", source.SpaceID, err)
+	}
+	return wallet, nil
+}
+
+// findOrCreateWallet returns the wallet with the given name, creating it when there is none
+func findOrCreateWallet(ctx context.Context, walletRepo repositories.WalletRepository,
+	name, description, currency string, walletType models.WalletType) (*models.Wallet, error) {
+	wallets, err := walletRepo.FindAll(ctx, repositories.WalletFilter{NameLike: name, IncludeArchived: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wallets: %w", err)
+	}
+	for _, wallet := range wallets {
+		if strings.EqualFold(wallet.Name, name) {
+			return wallet, nil
+		}
+	}
+
+	wallet := models.NewWallet(name, description, currency, walletType)
+	if err := wallet.Validate(); err != nil {
+		return nil, fmt.Errorf("cannot create wallet %q: %w", name, err)
+	}
+	if err := walletRepo.Create(ctx, wallet); err != nil {
+		return nil, fmt.Errorf("failed to create wallet: %w", err)
+	}
+
+	return wallet, nil
+}
@@ -0,0 +1,129 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+// recordingPublisher records the events published to it
+type recordingPublisher struct {
+	events []*interfaces.Event
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, event *interfaces.Event) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+// consentTestClient is a bank client whose access is a consent
+type consentTestClient struct{}
+
+func (consentTestClient) GetBalance(account string) (models.BalanceInfo, error) {
+	return models.BalanceInfo{}, nil
+}
+
+func (consentTestClient) FetchTransactions(account string) ([]models.Transaction, error) {
+	return nil, nil
+}
+
+func (consentTestClient) ConsentExpiry(account, session string) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (consentTestClient) ConsentURL(account, state string) (string, error) {
+	return "https://bank.example/consent?state=" + state, nil
+}
+
+func (consentTestClient) CompleteConsent(account, code string) (time.Time, string, error) {
+	return time.Now().Add(models.ConsentValidity), "session-" + code, nil
+}
+
+// The source states are not stored in PocketBase with the nats backend, so
+// the service announces their changes without any state repository
+func TestSourceSyncService_AnnouncesStateChanges(t *testing.T) {
+	ctx := context.Background()
+	publisher := &recordingPublisher{}
+	source := Source{Account: AccountRef{Source: "enable", Account: "account-1"}, Client: consentTestClient{}}
+	sync := NewSourceSyncService([]Source{source}, nil, nil, nil).WithPublisher(publisher)
+
+	if _, err := sync.TransitionSource(ctx, source.ID(), models.SourceDisabled, "closed the account"); err != nil {
+		t.Fatalf("TransitionSource() error = %v", err)
+	}
+	if len(publisher.events) != 1 {
+		t.Fatalf("published %d events, want the transition", len(publisher.events))
+	}
+	event := publisher.events[0]
+	if event.Type != interfaces.EventTypeSourceTransitioned || event.Data["from"] != string(models.SourceAuthorized) ||
+		event.Data["to"] != string(models.SourceDisabled) || event.Data["reason"] != "closed the account" {
+		t.Errorf("event = %s %v, want the transition from authorized to disabled", event.Type, event.Data)
+	}
+
+	// Moving to the current state only replaces the reason
+	if _, err := sync.TransitionSource(ctx, source.ID(), models.SourceDisabled, "still closed"); err != nil {
+		t.Fatalf("TransitionSource() error = %v", err)
+	}
+	if len(publisher.events) != 1 {
+		t.Errorf("published %d events, want none for the same state", len(publisher.events))
+	}
+
+	publisher.events = nil
+	state, err := NewConsentService(sync, 0).RenewConsent(ctx, source.ID())
+	if err != nil {
+		t.Fatalf("RenewConsent() error = %v", err)
+	}
+	if len(publisher.events) != 1 {
+		t.Fatalf("published %d events, want the renewal request", len(publisher.events))
+	}
+	event = publisher.events[0]
+	if event.Type != interfaces.EventTypeConsentRenewalRequested || event.Data["url"] != state.RenewalURL || event.Target != source.ID() {
+		t.Errorf("event = %s %v, want the renewal link of %s", event.Type, event.Data, source.ID())
+	}
+}
+
+// memorySourceStates stores source states in memory, by source
+type memorySourceStates struct {
+	states map[string]models.SourceState
+}
+
+func (r *memorySourceStates) FindAll(ctx context.Context) ([]*models.SourceState, error) {
+	states := make([]*models.SourceState, 0, len(r.states))
+	for _, state := range r.states {
+		states = append(states, &state)
+	}
+	return states, nil
+}
+
+func (r *memorySourceStates) Save(ctx context.Context, state *models.SourceState) error {
+	r.states[state.SourceID] = *state
+	return nil
+}
+
+// The session of a renewed consent is stored with the consent, so consent
+// checks use it after a restart
+func TestConsentService_StoresTheRenewedSession(t *testing.T) {
+	ctx := context.Background()
+	repo := &memorySourceStates{states: make(map[string]models.SourceState)}
+	source := Source{Account: AccountRef{Source: "enable", Account: "account-1"}, Client: consentTestClient{}}
+
+	consents := NewConsentService(NewSourceSyncService([]Source{source}, nil, nil, nil).WithState(repo), 0)
+	requested, err := consents.RenewConsent(ctx, source.ID())
+	if err != nil {
+		t.Fatalf("RenewConsent() error = %v", err)
+	}
+	if _, err := consents.CompleteConsent(ctx, requested.RenewalToken, "code-1"); err != nil {
+		t.Fatalf("CompleteConsent() error = %v", err)
+	}
+
+	restarted := NewSourceSyncService([]Source{source}, nil, nil, nil).WithState(repo)
+	if err := restarted.LoadState(ctx); err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+	states := NewConsentService(restarted, 0).Consents()
+	if len(states) != 1 || states[0].ConsentSession != "session-code-1" || states[0].Renewal != models.ConsentRenewalCompleted {
+		t.Errorf("Consents() = %+v, want the completed renewal in session-code-1", states)
+	}
+}
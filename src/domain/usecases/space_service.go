@@ -0,0 +1,369 @@
+package usecases
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// SpaceActor is the user acting on a space. Superusers act on every space
+// without being a member.
+type SpaceActor struct {
+	UserID    string
+	Superuser bool
+}
+
+// SpaceService manages spaces and their members, and scopes wallets,
+// categories and transactions to the spaces a user belongs to. Viewers read
+// a space, editors also move wallets and categories in and out, and owners
+// also manage its members.
+type SpaceService struct {
+	spaceRepo       repositories.SpaceRepository
+	walletRepo      repositories.WalletRepository
+	categoryRepo    repositories.CategoryRepository
+	transactionRepo repositories.TransactionRepository
+}
+
+// NewSpaceService creates a new SpaceService
+func NewSpaceService(
+	spaceRepo repositories.SpaceRepository,
+	walletRepo repositories.WalletRepository,
+	categoryRepo repositories.CategoryRepository,
+	transactionRepo repositories.TransactionRepository,
+) *SpaceService {
+	return &SpaceService{
+		spaceRepo:       spaceRepo,
+		walletRepo:      walletRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// CreateSpace creates a space owned by the actor. Spaces created by a
+// superuser start without members.
+func (s *SpaceService) CreateSpace(ctx context.Context, actor SpaceActor, name string, kind models.SpaceKind) (*models.Space, error) {
+	logger := internal.GetLogger().With().Str("usecase", "CreateSpace").Logger()
+
+	space := models.NewSpace(name, kind)
+	if err := space.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.spaceRepo.Create(ctx, space); err != nil {
+		return nil, fmt.Errorf("failed to create space: %w", err)
+	}
+
+	if !actor.Superuser {
+		owner := &models.SpaceMember{SpaceID: space.ID, UserID: actor.UserID, Role: models.SpaceRoleOwner}
+		if err := s.spaceRepo.SaveMember(ctx, owner); err != nil {
+			return nil, fmt.Errorf("failed to add the space owner: %w", err)
+		}
+	}
+
+	logger.Info().Str("spaceID", space.ID).Str("kind", string(space.Kind)).Msg("Created space")
+	return space, nil
+}
+
+// ListSpaces returns the spaces the actor is a member of, or every space for superusers
+func (s *SpaceService) ListSpaces(ctx context.Context, actor SpaceActor) ([]*models.Space, error) {
+	filter := repositories.SpaceFilter{}
+	if !actor.Superuser {
+		filter.UserID = actor.UserID
+	}
+
+	spaces, err := s.spaceRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list spaces: %w", err)
+	}
+	return spaces, nil
+}
+
+// ListMembers returns the members of a space
+func (s *SpaceService) ListMembers(ctx context.Context, actor SpaceActor, spaceID string) ([]*models.SpaceMember, error) {
+	if _, err := s.authorize(ctx, actor, spaceID, models.SpaceRoleViewer); err != nil {
+		return nil, err
+	}
+
+	members, err := s.spaceRepo.FindMembers(ctx, spaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list space members: %w", err)
+	}
+	return members, nil
+}
+
+// SetMember adds a user to a space or changes their role. Only owners manage
+// members, and the last owner cannot be demoted.
+func (s *SpaceService) SetMember(ctx context.Context, actor SpaceActor, spaceID, userID string, role models.SpaceRole) (*models.SpaceMember, error) {
+	logger := internal.GetLogger().With().Str("usecase", "SetSpaceMember").Str("spaceID", spaceID).Logger()
+
+	if !role.Valid() {
+		return nil, models.ErrInvalidSpaceRole
+	}
+	if _, err := s.authorize(ctx, actor, spaceID, models.SpaceRoleOwner); err != nil {
+		return nil, err
+	}
+	if role != models.SpaceRoleOwner {
+		if err := s.keepOwner(ctx, spaceID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	member := &models.SpaceMember{SpaceID: spaceID, UserID: userID, Role: role}
+	if err := s.spaceRepo.SaveMember(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to save space member: %w", err)
+	}
+
+	logger.Info().Str("userID", userID).Str("role", string(role)).Msg("Set space member")
+	return member, nil
+}
+
+// RemoveMember removes a user from a space. Owners remove anyone, other
+// members only themselves; the last owner cannot leave.
+func (s *SpaceService) RemoveMember(ctx context.Context, actor SpaceActor, spaceID, userID string) error {
+	logger := internal.GetLogger().With().Str("usecase", "RemoveSpaceMember").Str("spaceID", spaceID).Logger()
+
+	required := models.SpaceRoleOwner
+	if !actor.Superuser && actor.UserID == userID {
+		required = models.SpaceRoleViewer
+	}
+	if _, err := s.authorize(ctx, actor, spaceID, required); err != nil {
+		return err
+	}
+	if err := s.keepOwner(ctx, spaceID, userID); err != nil {
+		return err
+	}
+
+	err := s.spaceRepo.DeleteMember(ctx, spaceID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("user %s: %w", userID, models.ErrSpaceMemberNotFound)
+	} else if err != nil {
+		return fmt.Errorf("failed to remove space member: %w", err)
+	}
+
+	logger.Info().Str("userID", userID).Msg("Removed space member")
+	return nil
+}
+
+// Wallets returns the wallets owned by a space
+func (s *SpaceService) Wallets(ctx context.Context, actor SpaceActor, spaceID string) ([]*models.Wallet, error) {
+	if _, err := s.authorize(ctx, actor, spaceID, models.SpaceRoleViewer); err != nil {
+		return nil, err
+	}
+
+	wallets, err := s.walletRepo.FindAll(ctx, repositories.WalletFilter{SpaceID: spaceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list space wallets: %w", err)
+	}
+	return wallets, nil
+}
+
+// Categories returns the categories owned by a space
+func (s *SpaceService) Categories(ctx context.Context, actor SpaceActor, spaceID string) ([]*models.Category, error) {
+	if _, err := s.authorize(ctx, actor, spaceID, models.SpaceRoleViewer); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepo.FindAll(ctx, repositories.CategoryFilter{SpaceID: spaceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list space categories: %w", err)
+	}
+	return categories, nil
+}
+
+// Transactions returns the transactions of the wallets owned by a space, newest first
+func (s *SpaceService) Transactions(ctx context.Context, actor SpaceActor, spaceID string, limit, offset int) ([]*models.Transaction, error) {
+	if _, err := s.authorize(ctx, actor, spaceID, models.SpaceRoleViewer); err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.transactionRepo.FindAll(ctx, repositories.TransactionFilter{
+		SpaceID: spaceID,
+		Limit:   limit,
+		Offset:  offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list space transactions: %w", err)
+	}
+	return transactions, nil
+}
+
+// AssignWallet moves a wallet into a space, or back to the shared wallets when
+// spaceID is empty. The actor must be an editor of both the space the wallet
+// leaves and the one it enters.
+func (s *SpaceService) AssignWallet(ctx context.Context, actor SpaceActor, walletID, spaceID string) (*models.Wallet, error) {
+	logger := internal.GetLogger().With().Str("usecase", "AssignWalletSpace").Str("walletID", walletID).Logger()
+
+	wallet, err := s.walletRepo.FindByID(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet: %w", err)
+	}
+	if err := s.authorizeMove(ctx, actor, wallet.SpaceID, spaceID); err != nil {
+		return nil, err
+	}
+
+	wallet.SpaceID = spaceID
+	if err := s.walletRepo.Update(ctx, wallet); err != nil {
+		return nil, fmt.Errorf("failed to update wallet: %w", err)
+	}
+
+	logger.Info().Str("spaceID", spaceID).Msg("Assigned wallet to space")
+	return wallet, nil
+}
+
+// ReleaseWallet moves a wallet out of a space, back to the shared wallets
+func (s *SpaceService) ReleaseWallet(ctx context.Context, actor SpaceActor, walletID, spaceID string) (*models.Wallet, error) {
+	wallet, err := s.walletRepo.FindByID(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet: %w", err)
+	}
+	if wallet.SpaceID != spaceID {
+		return nil, fmt.Errorf("wallet %s: %w", walletID, models.ErrNotInSpace)
+	}
+	return s.AssignWallet(ctx, actor, walletID, "")
+}
+
+// AssignCategory moves a category into a space, or back to the shared
+// categories when spaceID is empty, like AssignWallet. System categories stay shared.
+func (s *SpaceService) AssignCategory(ctx context.Context, actor SpaceActor, categoryID, spaceID string) (*models.Category, error) {
+	logger := internal.GetLogger().With().Str("usecase", "AssignCategorySpace").Str("categoryID", categoryID).Logger()
+
+	category, err := s.categoryRepo.FindByID(ctx, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	if category.IsSystem {
+		return nil, fmt.Errorf("category %s: %w", categoryID, models.ErrSystemCategoryNotMovable)
+	}
+	if err := s.authorizeMove(ctx, actor, category.SpaceID, spaceID); err != nil {
+		return nil, err
+	}
+
+	category.SpaceID = spaceID
+	if err := s.categoryRepo.Update(ctx, category); err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	logger.Info().Str("spaceID", spaceID).Msg("Assigned category to space")
+	return category, nil
+}
+
+// AuthorizeSpace checks that the actor holds at least a role in a space, e.g.
+// owner to manage the import sources of its wallets
+func (s *SpaceService) AuthorizeSpace(ctx context.Context, actor SpaceActor, spaceID string, required models.SpaceRole) error {
+	_, err := s.authorize(ctx, actor, spaceID, required)
+	return err
+}
+
+// AuthorizeWallets checks that the actor may change the wallets and their
+// transactions: every user may change the shared wallets, editors those of
+// their spaces. Wallets of spaces the actor is not a member of are reported as
+// not found, like the spaces themselves.
+func (s *SpaceService) AuthorizeWallets(ctx context.Context, actor SpaceActor, walletIDs ...string) error {
+	if actor.Superuser {
+		return nil
+	}
+
+	wallets, spaces := make(map[string]bool, len(walletIDs)), make(map[string]bool)
+	for _, walletID := range walletIDs {
+		if walletID == "" || wallets[walletID] {
+			continue
+		}
+		wallets[walletID] = true
+
+		wallet, err := s.walletRepo.FindByID(ctx, walletID)
+		if err != nil {
+			return fmt.Errorf("failed to get wallet %s: %w", walletID, err)
+		}
+		if wallet.SpaceID == "" || spaces[wallet.SpaceID] {
+			continue
+		}
+		if _, err := s.authorize(ctx, actor, wallet.SpaceID, models.SpaceRoleEditor); err != nil {
+			return err
+		}
+		spaces[wallet.SpaceID] = true
+	}
+	return nil
+}
+
+// AuthorizeTransactions checks that the actor may change the transactions,
+// i.e. the wallets they move money from and to, like AuthorizeWallets
+func (s *SpaceService) AuthorizeTransactions(ctx context.Context, actor SpaceActor, transactionIDs ...string) error {
+	if actor.Superuser {
+		return nil
+	}
+
+	walletIDs := make([]string, 0, 2*len(transactionIDs))
+	for _, id := range transactionIDs {
+		tx, err := s.transactionRepo.FindByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get transaction %s: %w", id, err)
+		}
+		walletIDs = append(walletIDs, tx.WalletID, tx.DestWalletID)
+	}
+	return s.AuthorizeWallets(ctx, actor, walletIDs...)
+}
+
+// authorize checks that the actor holds at least the required role in a
+// space. Spaces the actor is not a member of are reported as not found, so
+// their existence does not leak.
+func (s *SpaceService) authorize(ctx context.Context, actor SpaceActor, spaceID string, required models.SpaceRole) (*models.Space, error) {
+	space, err := s.spaceRepo.FindByID(ctx, spaceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("space %s: %w", spaceID, models.ErrSpaceNotFound)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get space: %w", err)
+	}
+	if actor.Superuser {
+		return space, nil
+	}
+
+	member, err := s.spaceRepo.FindMember(ctx, spaceID, actor.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("space %s: %w", spaceID, models.ErrSpaceNotFound)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get space member: %w", err)
+	}
+	if !member.Role.Allows(required) {
+		return nil, fmt.Errorf("space %s requires %s: %w", spaceID, required, models.ErrSpaceAccessDenied)
+	}
+	return space, nil
+}
+
+// authorizeMove checks that the actor may edit both spaces of a move; an
+// empty space is the shared one, which every user may edit
+func (s *SpaceService) authorizeMove(ctx context.Context, actor SpaceActor, from, to string) error {
+	for _, spaceID := range []string{from, to} {
+		if spaceID == "" {
+			continue
+		}
+		if _, err := s.authorize(ctx, actor, spaceID, models.SpaceRoleEditor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keepOwner fails when the user is the only owner of a space, as removing or
+// demoting them would leave nobody able to manage it
+func (s *SpaceService) keepOwner(ctx context.Context, spaceID, userID string) error {
+	members, err := s.spaceRepo.FindMembers(ctx, spaceID)
+	if err != nil {
+		return fmt.Errorf("failed to list space members: %w", err)
+	}
+
+	owners, isOwner := 0, false
+	for _, member := range members {
+		if member.Role == models.SpaceRoleOwner {
+			owners++
+			isOwner = isOwner || member.UserID == userID
+		}
+	}
+	if isOwner && owners == 1 {
+		return fmt.Errorf("user %s: %w", userID, models.ErrLastSpaceOwner)
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+)
+
+// StateStore is a backend of the sync state of the import sources
+type StateStore struct {
+	Name         string // pocketbase or nats
+	SourceStates repositories.SourceStateRepository
+	Backfills    repositories.BackfillRepository
+}
+
+// StateMigrationService copies the sync state from one state store backend to
+// another: the last import times and lifecycle of every source, and the
+// cursors of their backfills. The IDs of imported transactions, on which
+// deduplication relies, are stored with the transactions in the database and
+// do not move with the state store.
+type StateMigrationService struct {
+	from StateStore
+	to   StateStore
+}
+
+// NewStateMigrationService creates a new StateMigrationService
+func NewStateMigrationService(from, to StateStore) *StateMigrationService {
+	return &StateMigrationService{from: from, to: to}
+}
+
+// StateMigrationReport summarizes a state migration
+type StateMigrationReport struct {
+	From         string         `json:"from"`
+	To           string         `json:"to"`
+	Applied      bool           `json:"applied"`
+	SourceStates StateCopyStats `json:"sourceStates"`
+	Backfills    StateCopyStats `json:"backfills"`
+
+	// Mismatches lists the records the target backend lacks or holds older
+	// than the source backend: those left after copying, or to copy when not applied
+	Mismatches []StateMismatch `json:"mismatches,omitempty"`
+	// Verified is set when the target holds every record of the source
+	// backend, equal or newer
+	Verified bool `json:"verified"`
+}
+
+// StateCopyStats counts the records of one kind of state
+type StateCopyStats struct {
+	Total  int `json:"total"`  // records in the source backend
+	Copied int `json:"copied"` // written to the target, or to write when not applied
+	Newer  int `json:"newer"`  // kept because the target holds a newer record, e.g. written in dual-write mode
+}
+
+// StateMismatch is a record that differs between the backends
+type StateMismatch struct {
+	Kind     string `json:"kind"` // source_state or backfill
+	SourceID string `json:"sourceId"`
+	Reason   string `json:"reason"` // missing or differs
+}
+
+// Migrate copies the records the target backend lacks or holds older, then
+// reads the target back to verify the copy. Unless apply is set it only
+// reports what would be copied.
+func (s *StateMigrationService) Migrate(ctx context.Context, apply bool) (*StateMigrationReport, error) {
+	report := &StateMigrationReport{From: s.from.Name, To: s.to.Name, Applied: apply}
+
+	if err := s.copySourceStates(ctx, report, apply); err != nil {
+		return report, err
+	}
+	if err := s.copyBackfills(ctx, report, apply); err != nil {
+		return report, err
+	}
+
+	var err error
+	report.Mismatches, err = s.verify(ctx)
+	if err != nil {
+		return report, err
+	}
+	report.Verified = len(report.Mismatches) == 0
+	return report, nil
+}
+
+func (s *StateMigrationService) copySourceStates(ctx context.Context, report *StateMigrationReport, apply bool) error {
+	states, err := s.from.SourceStates.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read source states of %s: %w", s.from.Name, err)
+	}
+	existing, err := s.targetStates(ctx)
+	if err != nil {
+		return err
+	}
+
+	report.SourceStates.Total = len(states)
+	for _, state := range states {
+		if target, ok := existing[state.SourceID]; ok {
+			if sameSourceState(state, target) {
+				continue
+			}
+			if sourceStateUpdatedAt(target).After(sourceStateUpdatedAt(state)) {
+				report.SourceStates.Newer++
+				continue
+			}
+		}
+		report.SourceStates.Copied++
+		if !apply {
+			continue
+		}
+		if err := s.to.SourceStates.Save(ctx, state); err != nil {
+			return fmt.Errorf("failed to copy state of %s to %s: %w", state.SourceID, s.to.Name, err)
+		}
+	}
+	return nil
+}
+
+func (s *StateMigrationService) copyBackfills(ctx context.Context, report *StateMigrationReport, apply bool) error {
+	backfills, err := s.from.Backfills.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read backfills of %s: %w", s.from.Name, err)
+	}
+
+	report.Backfills.Total = len(backfills)
+	for _, backfill := range backfills {
+		target, err := s.to.Backfills.FindBySource(ctx, backfill.SourceID)
+		if err != nil && !errors.Is(err, models.ErrBackfillNotFound) {
+			return fmt.Errorf("failed to read backfill of %s from %s: %w", backfill.SourceID, s.to.Name, err)
+		}
+		if target != nil {
+			if sameBackfill(backfill, target) {
+				continue
+			}
+			if target.UpdatedAt.After(backfill.UpdatedAt) {
+				report.Backfills.Newer++
+				continue
+			}
+		}
+		report.Backfills.Copied++
+		if !apply {
+			continue
+		}
+		if err := s.to.Backfills.Save(ctx, backfill); err != nil {
+			return fmt.Errorf("failed to copy backfill of %s to %s: %w", backfill.SourceID, s.to.Name, err)
+		}
+	}
+	return nil
+}
+
+// verify lists the records of the source backend the target lacks or holds older
+func (s *StateMigrationService) verify(ctx context.Context) ([]StateMismatch, error) {
+	var mismatches []StateMismatch
+
+	states, err := s.from.SourceStates.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source states of %s: %w", s.from.Name, err)
+	}
+	existing, err := s.targetStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range states {
+		target, ok := existing[state.SourceID]
+		switch {
+		case !ok:
+			mismatches = append(mismatches, StateMismatch{Kind: "source_state", SourceID: state.SourceID, Reason: "missing"})
+		case !sameSourceState(state, target) && !sourceStateUpdatedAt(target).After(sourceStateUpdatedAt(state)):
+			mismatches = append(mismatches, StateMismatch{Kind: "source_state", SourceID: state.SourceID, Reason: "differs"})
+		}
+	}
+
+	backfills, err := s.from.Backfills.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backfills of %s: %w", s.from.Name, err)
+	}
+	for _, backfill := range backfills {
+		target, err := s.to.Backfills.FindBySource(ctx, backfill.SourceID)
+		switch {
+		case errors.Is(err, models.ErrBackfillNotFound):
+			mismatches = append(mismatches, StateMismatch{Kind: "backfill", SourceID: backfill.SourceID, Reason: "missing"})
+		case err != nil:
+			return nil, fmt.Errorf("failed to read backfill of %s from %s: %w", backfill.SourceID, s.to.Name, err)
+		case !sameBackfill(backfill, target) && !target.UpdatedAt.After(backfill.UpdatedAt):
+			mismatches = append(mismatches, StateMismatch{Kind: "backfill", SourceID: backfill.SourceID, Reason: "differs"})
+		}
+	}
+
+	return mismatches, nil
+}
+
+func (s *StateMigrationService) targetStates(ctx context.Context) (map[string]*models.SourceState, error) {
+	states, err := s.to.SourceStates.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source states of %s: %w", s.to.Name, err)
+	}
+	bySource := make(map[string]*models.SourceState, len(states))
+	for _, state := range states {
+		bySource[state.SourceID] = state
+	}
+	return bySource, nil
+}
+
+// sourceStateUpdatedAt is the time a source state last changed
+func sourceStateUpdatedAt(state *models.SourceState) time.Time {
+	latest := state.LastRunAt
+	for _, t := range []time.Time{state.TransitionedAt, state.RenewalRequestedAt} {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
+
+// sameSourceState compares two source states regardless of their record IDs.
+// Times are compared at millisecond precision, that of the database.
+func sameSourceState(a, b *models.SourceState) bool {
+	return a.Runs == b.Runs && a.Failures == b.Failures && a.Imported == b.Imported &&
+		a.LastError == b.LastError && sameTime(a.LastRunAt, b.LastRunAt) && sameTime(a.LastSuccessAt, b.LastSuccessAt) &&
+		a.Lifecycle == b.Lifecycle && a.LifecycleReason == b.LifecycleReason && sameTime(a.TransitionedAt, b.TransitionedAt) &&
+		sameTime(a.ConsentExpiresAt, b.ConsentExpiresAt) && a.ConsentSession == b.ConsentSession && a.Renewal == b.Renewal && a.RenewalURL == b.RenewalURL &&
+		a.RenewalToken == b.RenewalToken && sameTime(a.RenewalRequestedAt, b.RenewalRequestedAt)
+}
+
+// sameBackfill compares two backfill checkpoints regardless of their record IDs
+func sameBackfill(a, b *models.Backfill) bool {
+	return a.Status == b.Status && a.Cursor == b.Cursor && a.Pages == b.Pages && a.Imported == b.Imported &&
+		a.Progress == b.Progress && a.Error == b.Error && sameTime(a.StartedAt, b.StartedAt) &&
+		sameTime(a.UpdatedAt, b.UpdatedAt) && sameTime(a.CompletedAt, b.CompletedAt)
+}
+
+func sameTime(a, b time.Time) bool {
+	return a.Truncate(time.Millisecond).Equal(b.Truncate(time.Millisecond))
+}
@@ -0,0 +1,270 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// StatementImportSource is the import source of bank statement files
+const StatementImportSource = "camt"
+
+// StatementParser parses bank statement files. fileimport.CamtParser satisfies it.
+type StatementParser interface {
+	Parse(r io.Reader) ([]models.BankStatement, error)
+}
+
+// StatementImportService imports bank statement files. The bookings become
+// transactions of the wallet of the statement account, and the balances the
+// statement asserts are recorded as balance snapshots for reconciliation.
+type StatementImportService struct {
+	walletRepo      repositories.WalletRepository
+	transactionRepo repositories.TransactionRepository
+	snapshotRepo    repositories.BalanceSnapshotRepository
+	imports         *ImportService
+	parser          StatementParser
+	location        *time.Location
+}
+
+// NewStatementImportService creates a new StatementImportService
+func NewStatementImportService(
+	walletRepo repositories.WalletRepository,
+	transactionRepo repositories.TransactionRepository,
+	snapshotRepo repositories.BalanceSnapshotRepository,
+	imports *ImportService,
+	parser StatementParser,
+) *StatementImportService {
+	return &StatementImportService{
+		walletRepo:      walletRepo,
+		transactionRepo: transactionRepo,
+		snapshotRepo:    snapshotRepo,
+		imports:         imports,
+		parser:          parser,
+	}
+}
+
+// WithLocation sets the timezone of the bank. Duplicates are then looked for
+// across whole booking days there instead of around the exact times.
+func (s *StatementImportService) WithLocation(loc *time.Location) *StatementImportService {
+	s.location = loc
+	return s
+}
+
+// StatementImportReport summarizes the import of one statement file
+type StatementImportReport struct {
+	Statements []*StatementReport `json:"statements"`
+	Imported   int                `json:"imported"`
+}
+
+// StatementReport summarizes the import of one statement
+type StatementReport struct {
+	StatementID string        `json:"statementId"`
+	IBAN        string        `json:"iban"`
+	WalletID    string        `json:"walletId"`
+	Entries     int           `json:"entries"`
+	Pending     int           `json:"pending"` // not yet booked, skipped until a later statement books them
+	Skipped     int           `json:"skipped"` // imported by an earlier run
+	Snapshots   int           `json:"snapshots"`
+	Import      *ImportReport `json:"import,omitempty"`
+
+	// Unbalanced is the amount by which the booked entries miss the closing
+	// balance the statement asserts, non-zero for an incomplete statement
+	Unbalanced float64 `json:"unbalanced"`
+	// Closing is the closing balance the statement asserts, if any
+	Closing *models.ReportedBalance `json:"closing,omitempty"`
+	// Drift is the wallet balance after the import minus the closing balance
+	Drift float64 `json:"drift"`
+}
+
+// ImportFile parses a statement file and imports every statement in it. With
+// walletID set the bookings go into that wallet; otherwise each account gets
+// a wallet named after its IBAN.
+func (s *StatementImportService) ImportFile(ctx context.Context, r io.Reader, walletID string) (*StatementImportReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "ImportStatementFile").Logger()
+
+	statements, err := s.parser.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidImportFile, err)
+	}
+
+	report := &StatementImportReport{Statements: make([]*StatementReport, 0, len(statements))}
+	for _, statement := range statements {
+		statementReport, err := s.importStatement(ctx, statement, walletID)
+		if statementReport != nil {
+			report.Statements = append(report.Statements, statementReport)
+			if statementReport.Import != nil {
+				report.Imported += statementReport.Import.Imported
+			}
+		}
+		if err != nil {
+			return report, fmt.Errorf("statement %s: %w", statement.ID, err)
+		}
+	}
+
+	logger.Info().
+		Int("statements", len(report.Statements)).
+		Int("imported", report.Imported).
+		Msg("Statement file imported")
+
+	return report, nil
+}
+
+func (s *StatementImportService) importStatement(ctx context.Context, statement models.BankStatement, walletID string) (*StatementReport, error) {
+	wallet, err := s.statementWallet(ctx, statement, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &StatementReport{
+		StatementID: statement.ID,
+		IBAN:        statement.IBAN,
+		WalletID:    wallet.ID,
+		Entries:     len(statement.Entries),
+		Unbalanced:  statement.Unbalanced(),
+	}
+
+	transactions := make([]*models.Transaction, 0, len(statement.Entries))
+	for _, entry := range statement.Entries {
+		if !entry.Booked {
+			report.Pending++
+			continue
+		}
+
+		known, err := alreadyImported(ctx, s.transactionRepo, s.imports.ledger, wallet.ID, entry.ID, entry.Date)
+		if err != nil {
+			return report, err
+		}
+		if known {
+			report.Skipped++
+			continue
+		}
+		transactions = append(transactions, statementTransaction(statement, entry))
+	}
+
+	if len(transactions) > 0 {
+		report.Import, err = s.imports.Import(ctx, ImportInput{
+			Source:       StatementImportSource,
+			WalletID:     wallet.ID,
+			Transactions: transactions,
+			Location:     s.location,
+		})
+		if err != nil {
+			return report, err
+		}
+	}
+
+	report.Snapshots, err = s.recordBalances(ctx, wallet.ID, statement)
+	if err != nil {
+		return report, err
+	}
+
+	if closing, ok := statement.Balance(models.BalanceTypeClosingBooked); ok {
+		report.Closing = &closing
+		// Re-read the wallet for the balance the import left it with
+		if updated, err := s.walletRepo.FindByID(ctx, wallet.ID); err == nil {
+			report.Drift = math.Round((updated.Balance-closing.Amount)*100) / 100
+		}
+	}
+
+	return report, nil
+}
+
+// statementWallet returns the wallet the bookings of a statement go into
+func (s *StatementImportService) statementWallet(ctx context.Context, statement models.BankStatement, walletID string) (*models.Wallet, error) {
+	if walletID != "" {
+		wallet, err := s.walletRepo.FindByID(ctx, walletID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get wallet: %w", err)
+		}
+		return wallet, nil
+	}
+
+	if statement.IBAN == "" {
+		return nil, fmt.Errorf("%w: statement has no account", models.ErrInvalidImportFile)
+	}
+	return findOrCreateWallet(ctx, s.walletRepo, "Bank account "+statement.IBAN,
+		"Imported from bank statements", statement.Currency, models.WalletTypeBank)
+}
+
+// recordBalances stores the balances a statement asserts, skipping those an
+// earlier import of the same statement recorded
+func (s *StatementImportService) recordBalances(ctx context.Context, walletID string, statement models.BankStatement) (int, error) {
+	recorded := 0
+	for _, balance := range statement.Balances {
+		existing, err := s.snapshotRepo.FindAll(ctx, repositories.BalanceSnapshotFilter{
+			WalletID:    walletID,
+			Source:      StatementImportSource,
+			BalanceType: balance.BalanceType,
+			From:        balance.ReferenceDate,
+			To:          balance.ReferenceDate,
+			Limit:       1,
+		})
+		if err != nil {
+			return recorded, fmt.Errorf("failed to look up balance snapshots: %w", err)
+		}
+		if len(existing) > 0 {
+			continue
+		}
+
+		snapshot := models.NewReportedBalanceSnapshot(walletID, StatementImportSource, balance, balance.ReferenceDate)
+		if err := s.snapshotRepo.Create(ctx, snapshot); err != nil {
+			return recorded, fmt.Errorf("failed to record statement balance: %w", err)
+		}
+		recorded++
+	}
+	return recorded, nil
+}
+
+// statementTransaction converts a booked statement entry into a transaction
+func statementTransaction(statement models.BankStatement, entry models.BankEntry) *models.Transaction {
+	txType := models.TransactionTypeExpense
+	if entry.Credit {
+		txType = models.TransactionTypeIncome
+	}
+
+	description := entry.Counterparty
+	if description == "" {
+		description = entry.Memo
+	}
+	if description == "" {
+		description = "Bank transaction " + entry.ID
+	}
+
+	metadata := map[string]string{
+		MetadataExternalID: entry.ID,
+		"bank":             StatementImportSource,
+		"account":          statement.IBAN,
+		"currency":         statement.Currency,
+	}
+	if statement.ID != "" {
+		metadata["statement"] = statement.ID
+	}
+	if entry.Counterparty != "" {
+		metadata["counterparty"] = entry.Counterparty
+	}
+	if entry.CounterpartyIBAN != "" {
+		metadata["counterpartyIban"] = entry.CounterpartyIBAN
+	}
+	if entry.Memo != "" {
+		metadata["memo"] = strings.TrimSpace(entry.Memo)
+	}
+
+	return &models.Transaction{
+		Amount:      entry.Amount,
+		Description: description,
+		Date:        entry.Date,
+		Type:        txType,
+		Reference:   entry.Reference,
+		EndToEndID:  entry.EndToEndID,
+		Metadata:    metadata,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,143 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// subscriptionLookback is how far back charges are analyzed; long enough to
+// see three charges of a yearly subscription
+const subscriptionLookback = 26 * 30 * 24 * time.Hour
+
+// subscriptionPageSize is the number of expenses loaded per query while detecting
+const subscriptionPageSize = 500
+
+// SubscriptionService detects recurring merchant charges in the imported
+// transactions and keeps them as subscription records. Storing a price
+// increase or a missed charge is what the event hooks alert on.
+type SubscriptionService struct {
+	transactionRepo  repositories.TransactionRepository
+	subscriptionRepo repositories.SubscriptionRepository
+}
+
+// NewSubscriptionService creates a new SubscriptionService
+func NewSubscriptionService(
+	transactionRepo repositories.TransactionRepository,
+	subscriptionRepo repositories.SubscriptionRepository,
+) *SubscriptionService {
+	return &SubscriptionService{
+		transactionRepo:  transactionRepo,
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+// SubscriptionReport summarizes a detection run
+type SubscriptionReport struct {
+	Checked        int                    `json:"checked"`  // expenses analyzed
+	Detected       int                    `json:"detected"` // subscriptions seen for the first time
+	Updated        int                    `json:"updated"`
+	PriceIncreases []*models.Subscription `json:"priceIncreases,omitempty"` // charged more than last time
+	Missed         []*models.Subscription `json:"missed,omitempty"`         // became overdue
+	Errors         []string               `json:"errors,omitempty"`
+}
+
+// List returns the stored subscriptions, most expensive first
+func (s *SubscriptionService) List(ctx context.Context, filter repositories.SubscriptionFilter) ([]*models.Subscription, error) {
+	subscriptions, err := s.subscriptionRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// Detect analyzes the expenses of the lookback window and stores the
+// subscriptions found. Stored subscriptions whose next charge is overdue are
+// marked missed, whether or not they are detected again.
+func (s *SubscriptionService) Detect(ctx context.Context, now time.Time) (*SubscriptionReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "DetectSubscriptions").Logger()
+	report := &SubscriptionReport{}
+
+	var expenses []*models.Transaction
+	filter := repositories.TransactionFilter{
+		Type:           models.TransactionTypeExpense,
+		DateFrom:       now.Add(-subscriptionLookback),
+		DateTo:         now,
+		SortBy:         "id",
+		Limit:          subscriptionPageSize,
+		ExcludeSandbox: true,
+	}
+	for {
+		page, err := s.transactionRepo.FindAll(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list expenses: %w", err)
+		}
+		expenses = append(expenses, page...)
+		if len(page) < subscriptionPageSize {
+			break
+		}
+		filter.Offset += subscriptionPageSize
+	}
+	report.Checked = len(expenses)
+
+	stored, err := s.subscriptionRepo.FindAll(ctx, repositories.SubscriptionFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+	existing := make(map[string]*models.Subscription, len(stored))
+	for _, subscription := range stored {
+		existing[subscription.Key()] = subscription
+	}
+
+	save := func(subscription *models.Subscription) bool {
+		if err := s.subscriptionRepo.Save(ctx, subscription); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", subscription.Merchant, err))
+			return false
+		}
+		return true
+	}
+
+	for _, subscription := range models.DetectSubscriptions(expenses, now) {
+		previous, known := existing[subscription.Key()]
+		delete(existing, subscription.Key())
+		if !save(subscription) {
+			continue
+		}
+
+		if !known {
+			report.Detected++
+			continue
+		}
+		report.Updated++
+		if subscription.LastChargeAt.After(previous.LastChargeAt) && subscription.PriceIncrease() > 0 {
+			report.PriceIncreases = append(report.PriceIncreases, subscription)
+		}
+		if subscription.Status == models.SubscriptionMissed && previous.Status != models.SubscriptionMissed {
+			report.Missed = append(report.Missed, subscription)
+		}
+	}
+
+	// Subscriptions no longer detected still expect their next charge
+	for _, subscription := range existing {
+		if subscription.Status == models.SubscriptionMissed || !subscription.Overdue(now) {
+			continue
+		}
+		subscription.Status = models.SubscriptionMissed
+		if save(subscription) {
+			report.Updated++
+			report.Missed = append(report.Missed, subscription)
+		}
+	}
+
+	logger.Info().
+		Int("checked", report.Checked).
+		Int("detected", report.Detected).
+		Int("priceIncreases", len(report.PriceIncreases)).
+		Int("missed", len(report.Missed)).
+		Msg("Subscription detection complete")
+	return report, nil
+}
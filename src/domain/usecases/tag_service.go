@@ -0,0 +1,171 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// TagService manages tags and keeps the transactions referencing them consistent
+type TagService struct {
+	tagRepo     repositories.TagRepository
+	periods     models.PeriodCalendar
+	maintenance *MaintenanceService // optional: refuses renames while spaces are frozen
+}
+
+// NewTagService creates a new TagService
+func NewTagService(tagRepo repositories.TagRepository) *TagService {
+	return &TagService{
+		tagRepo: tagRepo,
+	}
+}
+
+// WithPeriods sets the calendar spend is grouped by; calendar months by default
+func (s *TagService) WithPeriods(periods models.PeriodCalendar) *TagService {
+	s.periods = periods
+	return s
+}
+
+// WithMaintenance refuses to rename, merge or delete tags while any space is
+// frozen; tags are shared, so the change reaches the transactions of every space
+func (s *TagService) WithMaintenance(maintenance *MaintenanceService) *TagService {
+	s.maintenance = maintenance
+	return s
+}
+
+// EnsureTags creates tag entities for names that do not exist yet, so tags set
+// by imports, rules or the API show up in the tag list
+func (s *TagService) EnsureTags(ctx context.Context, names []string) error {
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if _, err := s.tagRepo.FindByName(ctx, name); err == nil {
+			continue
+		}
+
+		if err := s.tagRepo.Create(ctx, models.NewTag(name, "", "")); err != nil {
+			// Another writer may have created it concurrently
+			if _, findErr := s.tagRepo.FindByName(ctx, name); findErr == nil {
+				continue
+			}
+			return fmt.Errorf("failed to create tag %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// RenameTag renames a tag and all of its transaction references.
+// It returns the number of transactions updated.
+func (s *TagService) RenameTag(ctx context.Context, id, name string) (int, error) {
+	logger := internal.GetLogger().With().Str("usecase", "RenameTag").Str("tagID", id).Logger()
+
+	tag := &models.Tag{ID: id, Name: strings.TrimSpace(name)}
+	if err := tag.Validate(); err != nil {
+		return 0, err
+	}
+
+	if err := s.checkMaintenance(ctx); err != nil {
+		return 0, err
+	}
+
+	updated, err := s.tagRepo.Rename(ctx, id, tag.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	logger.Info().Str("name", tag.Name).Int("transactions", updated).Msg("Tag renamed")
+	return updated, nil
+}
+
+// MergeTags moves every transaction reference from the source tag to the target
+// tag and deletes the source. It returns the number of transactions updated.
+func (s *TagService) MergeTags(ctx context.Context, sourceID, targetID string) (int, error) {
+	logger := internal.GetLogger().With().Str("usecase", "MergeTags").Str("tagID", sourceID).Logger()
+
+	if err := s.checkMaintenance(ctx); err != nil {
+		return 0, err
+	}
+
+	updated, err := s.tagRepo.Merge(ctx, sourceID, targetID)
+	if err != nil {
+		return 0, err
+	}
+
+	logger.Info().Str("into", targetID).Int("transactions", updated).Msg("Tags merged")
+	return updated, nil
+}
+
+// DeleteTag deletes a tag and removes it from all transactions.
+// It returns the number of transactions updated.
+func (s *TagService) DeleteTag(ctx context.Context, id string) (int, error) {
+	if err := s.checkMaintenance(ctx); err != nil {
+		return 0, err
+	}
+	return s.tagRepo.Delete(ctx, id)
+}
+
+// TagWallets returns the wallets of the transactions referencing a tag, so
+// callers can check the user may change them all before renaming, merging or
+// deleting it
+func (s *TagService) TagWallets(ctx context.Context, id string) ([]string, error) {
+	return s.tagRepo.Wallets(ctx, id)
+}
+
+// checkMaintenance refuses changes to the shared tags while any space is frozen
+func (s *TagService) checkMaintenance(ctx context.Context) error {
+	if s.maintenance == nil {
+		return nil
+	}
+	return s.maintenance.CheckAll(ctx)
+}
+
+// GetSpendReport returns income, expense and transfer totals per tag and currency
+func (s *TagService) GetSpendReport(ctx context.Context, filter repositories.TagSpendFilter) ([]*models.TagSpend, error) {
+	if !filter.DateFrom.IsZero() && !filter.DateTo.IsZero() && filter.DateTo.Before(filter.DateFrom) {
+		return nil, fmt.Errorf("invalid range: %s is before %s",
+			filter.DateTo.Format(time.DateOnly), filter.DateFrom.Format(time.DateOnly))
+	}
+
+	return s.tagRepo.Spend(ctx, filter)
+}
+
+// PeriodSpend is the tag spend of one pay period
+type PeriodSpend struct {
+	models.Period
+	Spend []*models.TagSpend `json:"spend"`
+}
+
+// GetPeriodSpendReport returns the tag spend of every pay period overlapping
+// the filter range, so budgets can be compared against salary cycles
+func (s *TagService) GetPeriodSpendReport(ctx context.Context, filter repositories.TagSpendFilter) ([]PeriodSpend, error) {
+	if filter.DateFrom.IsZero() || filter.DateTo.IsZero() {
+		return nil, fmt.Errorf("a range is required to group spend by period")
+	}
+	if filter.DateTo.Before(filter.DateFrom) {
+		return nil, fmt.Errorf("invalid range: %s is before %s",
+			filter.DateTo.Format(time.DateOnly), filter.DateFrom.Format(time.DateOnly))
+	}
+
+	periods := s.periods.PayPeriods(filter.DateFrom, filter.DateTo)
+	report := make([]PeriodSpend, 0, len(periods))
+	for _, period := range periods {
+		periodFilter := filter
+		periodFilter.DateFrom, periodFilter.DateTo = period.Start, period.Last()
+
+		spend, err := s.tagRepo.Spend(ctx, periodFilter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute spend for %s: %w", period.Name, err)
+		}
+		report = append(report, PeriodSpend{Period: period, Spend: spend})
+	}
+	return report, nil
+}
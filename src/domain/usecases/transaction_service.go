@@ -3,6 +3,7 @@ package usecases
 import (
 	"context" // Add context import
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
@@ -15,6 +16,10 @@ type TransactionService struct {
 	walletRepo      repositories.WalletRepository
 	categoryRepo    repositories.CategoryRepository
 	transactionRepo repositories.TransactionRepository
+	rates           ExchangeRateProvider // optional: resolves missing cross-currency rates
+	rules           *RuleService         // optional: user-defined transformation rules
+	maintenance     *MaintenanceService  // optional: refuses changes to frozen spaces
+	duplicates      models.DuplicatePolicies
 	// Add other dependencies like a UnitOfWork or TxManager if needed
 }
 
@@ -28,9 +33,35 @@ func NewTransactionService(
 		walletRepo:      walletRepo,
 		categoryRepo:    categoryRepo,
 		transactionRepo: transactionRepo,
+		duplicates:      models.DuplicatePolicies{Default: models.DefaultDuplicatePolicy()},
 	}
 }
 
+// WithDuplicatePolicies sets the duplicate policies, selected by the input source.
+func (s *TransactionService) WithDuplicatePolicies(policies models.DuplicatePolicies) *TransactionService {
+	s.duplicates = policies
+	return s
+}
+
+// WithRateProvider sets the provider used to look up exchange rates for
+// cross-currency transfers that do not specify one.
+func (s *TransactionService) WithRateProvider(rates ExchangeRateProvider) *TransactionService {
+	s.rates = rates
+	return s
+}
+
+// WithMaintenance refuses changes to the transactions of wallets in frozen spaces
+func (s *TransactionService) WithMaintenance(maintenance *MaintenanceService) *TransactionService {
+	s.maintenance = maintenance
+	return s
+}
+
+// WithRules sets the transformation rules applied to transactions before validation.
+func (s *TransactionService) WithRules(rules *RuleService) *TransactionService {
+	s.rules = rules
+	return s
+}
+
 // CreateTransactionInput defines the input for creating a transaction.
 // Using specific input struct allows for better control over required fields.
 type CreateTransactionInput struct {
@@ -40,9 +71,12 @@ type CreateTransactionInput struct {
 	Type         models.TransactionType
 	CategoryID   string
 	WalletID     string
-	DestWalletID string   // Optional: for transfers
-	ExchangeRate float64  // Optional: for transfers
-	Tags         []string // Optional
+	DestWalletID string            // Optional: for transfers
+	ExchangeRate float64           // Optional: for transfers
+	Tags         []string          // Optional
+	Notes        string            // Optional: free-text notes
+	Metadata     map[string]string // Optional: provider metadata (chain, address, bank, raw IDs)
+	Source       string            // Optional: import source, selects the transformation rules to apply
 }
 
 // CreateTransaction handles the creation and processing of a new transaction.
@@ -51,6 +85,13 @@ func (s *TransactionService) CreateTransaction(input CreateTransactionInput) (*m
 	logger := internal.GetLogger().With().Str("usecase", "CreateTransaction").Logger()
 	logger.Info().Interface("input", input).Msg("Starting transaction creation")
 
+	ctx := context.Background() // Use background context for now
+
+	// Apply transformation rules first so they can fill in the category
+	if s.rules != nil {
+		s.applyRules(ctx, &input)
+	}
+
 	// --- 1. Validation ---
 	logger.Debug().Msg("Validating input")
 
@@ -69,13 +110,15 @@ func (s *TransactionService) CreateTransaction(input CreateTransactionInput) (*m
 	}
 
 	// Fetch related entities using repositories
-	ctx := context.Background() // Use background context for now
 	logger.Debug().Str("walletID", input.WalletID).Msg("Fetching source wallet")
 	sourceWallet, err := s.walletRepo.FindByID(ctx, input.WalletID)
 	if err != nil {
 		logger.Error().Err(err).Str("walletID", input.WalletID).Msg("Failed to fetch source wallet")
 		return nil, fmt.Errorf("failed to get source wallet: %w", err)
 	}
+	if sourceWallet.Archived {
+		return nil, fmt.Errorf("source wallet %s: %w", sourceWallet.ID, models.ErrWalletArchived)
+	}
 
 	logger.Debug().Str("categoryID", input.CategoryID).Msg("Fetching category")
 	category, err := s.categoryRepo.FindByID(ctx, input.CategoryID)
@@ -115,12 +158,28 @@ func (s *TransactionService) CreateTransaction(input CreateTransactionInput) (*m
 			logger.Error().Err(err).Str("destWalletID", input.DestWalletID).Msg("Failed to fetch destination wallet")
 			return nil, fmt.Errorf("failed to get destination wallet: %w", err)
 		}
+		if destWallet.Archived {
+			return nil, fmt.Errorf("destination wallet %s: %w", destWallet.ID, models.ErrWalletArchived)
+		}
 
 		if !sourceWallet.HasSufficientBalance(input.Amount) {
 			logger.Warn().Float64("balance", sourceWallet.Balance).Float64("amount", input.Amount).Msg("Insufficient balance for transfer")
 			return nil, fmt.Errorf("insufficient balance in source wallet: %w", models.ErrInsufficientBalance)
 		}
 
+		// Look up the exchange rate for cross-currency transfers that don't specify one
+		if sourceWallet.Currency != destWallet.Currency && input.ExchangeRate <= 0 && s.rates != nil {
+			rate, err := s.rates.GetRate(ctx, sourceWallet.Currency, destWallet.Currency, input.Date)
+			if err != nil {
+				logger.Warn().Err(err).
+					Str("sourceCurrency", sourceWallet.Currency).
+					Str("destCurrency", destWallet.Currency).
+					Msg("Failed to look up exchange rate")
+			} else {
+				input.ExchangeRate = rate
+			}
+		}
+
 		// Validate exchange rate if currencies differ
 		if sourceWallet.Currency != destWallet.Currency && input.ExchangeRate <= 0 {
 			err := fmt.Errorf("exchange rate is required for cross-currency transfer: %w", models.ErrInvalidExchangeRate)
@@ -132,32 +191,40 @@ func (s *TransactionService) CreateTransaction(input CreateTransactionInput) (*m
 		}
 	}
 
-	// --- 2. Duplicate Check (Placeholder - refine logic) ---
-// TODO: Implement duplicate check logic using transactionRepo
-// Define a time window for duplicate checks (e.g., 24 hours)
-duplicateCheckWindow := 24 * time.Hour
-potentialDuplicates, err := s.transactionRepo.FindDuplicates(ctx, &models.Transaction{
-Amount:       input.Amount,
-Date:         input.Date,
-Type:         input.Type,
-CategoryID:   input.CategoryID,
-WalletID:     input.WalletID,
-DestWalletID: input.DestWalletID, // Include DestWalletID for transfers
-}, duplicateCheckWindow)
-if err != nil {
-// Log error but potentially continue? Or return error? Deciding to log and continue for now.
-logger.Error().Err(err).Msg("Failed to check for duplicate transactions")
-} else if len(potentialDuplicates) > 0 {
-logger.Warn().Int("count", len(potentialDuplicates)).Msg("Potential duplicate transaction(s) detected")
-// Return an error to prevent duplicate creation
-return nil, fmt.Errorf("potential duplicate transaction detected (found %d similar)", len(potentialDuplicates))
-} else {
-logger.Debug().Msg("No potential duplicates found")
-}
-
-// --- 3. Create Transaction Entity ---
-logger.Debug().Msg("Creating transaction entity")
-tx := models.NewTransaction(
+	walletIDs := []string{sourceWallet.ID}
+	if destWallet != nil {
+		walletIDs = append(walletIDs, destWallet.ID)
+	}
+	if err := s.checkMaintenance(ctx, walletIDs...); err != nil {
+		return nil, err
+	}
+
+	// --- 2. Duplicate Check ---
+	policy := s.duplicates.For(input.Source)
+	decision, err := checkDuplicate(ctx, s.transactionRepo, &models.Transaction{
+		Amount:       input.Amount,
+		Date:         input.Date,
+		Type:         input.Type,
+		CategoryID:   input.CategoryID,
+		WalletID:     input.WalletID,
+		DestWalletID: input.DestWalletID, // Include DestWalletID for transfers
+	}, policy, nil)
+	if err != nil {
+		// Log error but continue; a failed check should not block legitimate transactions
+		logger.Error().Err(err).Msg("Failed to check for duplicate transactions")
+	} else if decision != nil {
+		logger.Warn().Int("count", len(decision.Matches)).Str("action", string(policy.Action)).
+			Msg("Potential duplicate transaction(s) detected")
+		if policy.Action == models.DuplicateActionBlock {
+			return nil, fmt.Errorf("found %d similar transaction(s): %w", len(decision.Matches), models.ErrDuplicateTransaction)
+		}
+	} else {
+		logger.Debug().Msg("No potential duplicates found")
+	}
+
+	// --- 3. Create Transaction Entity ---
+	logger.Debug().Msg("Creating transaction entity")
+	tx := models.NewTransaction(
 		input.Amount,
 		input.Description,
 		input.Date,
@@ -166,6 +233,14 @@ tx := models.NewTransaction(
 		input.WalletID,
 	)
 	tx.Tags = input.Tags // Assign optional tags
+	tx.Notes = input.Notes
+	tx.Metadata = input.Metadata
+	if input.Source != "" {
+		tx.MergeMetadata(map[string]string{"source": input.Source})
+	}
+	if decision != nil {
+		tx.MergeTags(policy.Tags())
+	}
 
 	// Set transfer-specific fields
 	if input.Type == models.TransactionTypeTransfer {
@@ -203,36 +278,292 @@ tx := models.NewTransaction(
 		// Process transfer in for destination wallet (must exist from validation step)
 		destWallet.ProcessTransferIn(tx.Amount, tx.ExchangeRate)
 
-// Update destination wallet changes
-logger.Debug().Str("destWalletID", destWallet.ID).Msg("Updating destination wallet")
-if err := s.walletRepo.Update(ctx, destWallet); err != nil {
-logger.Error().Err(err).Str("destWalletID", destWallet.ID).Msg("Failed to update destination wallet")
-// TODO: Rollback transaction if applicable
-return nil, fmt.Errorf("failed to update destination wallet: %w", err)
+		// Update destination wallet changes
+		logger.Debug().Str("destWalletID", destWallet.ID).Msg("Updating destination wallet")
+		if err := s.walletRepo.Update(ctx, destWallet); err != nil {
+			logger.Error().Err(err).Str("destWalletID", destWallet.ID).Msg("Failed to update destination wallet")
+			// TODO: Rollback transaction if applicable
+			return nil, fmt.Errorf("failed to update destination wallet: %w", err)
+		}
+	}
+
+	// Update source wallet changes
+	logger.Debug().Str("sourceWalletID", sourceWallet.ID).Msg("Updating source wallet")
+	if err := s.walletRepo.Update(ctx, sourceWallet); err != nil {
+		logger.Error().Err(err).Str("sourceWalletID", sourceWallet.ID).Msg("Failed to update source wallet")
+		// TODO: Rollback transaction if applicable
+		return nil, fmt.Errorf("failed to update source wallet: %w", err)
+	}
+
+	// --- 5. Create Transaction Record ---
+	tx.MarkAsCompleted() // Mark as completed after successful processing
+	logger.Debug().Str("transactionID", tx.ID).Msg("Creating transaction record")
+	if err := s.transactionRepo.Create(ctx, tx); err != nil {
+		logger.Error().Err(err).Str("transactionID", tx.ID).Msg("Failed to create transaction record")
+		// TODO: Rollback transaction if applicable
+		// Consider marking wallet balances back? Complex without UoW.
+		return nil, fmt.Errorf("failed to create transaction record: %w", err)
+	}
+
+	logger.Info().Str("transactionID", tx.ID).Msg("Transaction created successfully")
+	return tx, nil
+}
+
+// ListTransactions returns the transactions matching the filter
+func (s *TransactionService) ListTransactions(ctx context.Context, filter repositories.TransactionFilter) ([]*models.Transaction, error) {
+	transactions, err := s.transactionRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	return transactions, nil
+}
+
+// TransactionPatch is a metadata-only change to a transaction. Nil fields are left unchanged.
+// Version must be the version the client read; stale versions fail with models.ErrConflict.
+type TransactionPatch struct {
+	ID          string    `json:"id"`
+	Version     int       `json:"version"`
+	Description *string   `json:"description,omitempty"`
+	CategoryID  *string   `json:"categoryId,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+	Notes       *string   `json:"notes,omitempty"`
 }
+
+// BulkUpdateTransactions applies metadata patches to many transactions using
+// batched writes. Amounts, dates and wallets are not patchable here because
+// changing them requires balance adjustments.
+func (s *TransactionService) BulkUpdateTransactions(ctx context.Context, patches []TransactionPatch) (int, error) {
+	transactions := make([]*models.Transaction, 0, len(patches))
+	for _, patch := range patches {
+		tx, err := s.transactionRepo.FindByID(ctx, patch.ID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get transaction %s: %w", patch.ID, err)
+		}
+		if tx.IsDeleted() {
+			return 0, fmt.Errorf("transaction %s: %w", patch.ID, models.ErrTransactionDeleted)
+		}
+		if tx.Version != patch.Version {
+			return 0, fmt.Errorf("transaction %s has version %d, expected %d: %w", patch.ID, tx.Version, patch.Version, models.ErrConflict)
+		}
+
+		if patch.Description != nil {
+			tx.Description = *patch.Description
+		}
+		if patch.CategoryID != nil {
+			tx.CategoryID = *patch.CategoryID
+		}
+		if patch.Tags != nil {
+			tx.Tags = *patch.Tags
+		}
+		if patch.Notes != nil {
+			tx.Notes = *patch.Notes
+		}
+
+		transactions = append(transactions, tx)
+	}
+	if err := s.checkMaintenance(ctx, transactionWallets(transactions...)...); err != nil {
+		return 0, err
+	}
+
+	return s.transactionRepo.UpdateMany(ctx, transactions)
 }
 
-// Update source wallet changes
-logger.Debug().Str("sourceWalletID", sourceWallet.ID).Msg("Updating source wallet")
-if err := s.walletRepo.Update(ctx, sourceWallet); err != nil {
-logger.Error().Err(err).Str("sourceWalletID", sourceWallet.ID).Msg("Failed to update source wallet")
-// TODO: Rollback transaction if applicable
-return nil, fmt.Errorf("failed to update source wallet: %w", err)
+// applyRules runs the transformation rules for the input's source and copies
+// the transformed description, tags and category back onto the input.
+// Rule failures are logged and never block the transaction.
+func (s *TransactionService) applyRules(ctx context.Context, input *CreateTransactionInput) {
+	tx := &models.Transaction{
+		Amount:      input.Amount,
+		Description: input.Description,
+		Date:        input.Date,
+		Type:        input.Type,
+		CategoryID:  input.CategoryID,
+		Tags:        input.Tags,
+	}
+
+	if err := s.rules.ApplyTo(ctx, input.Source, tx); err != nil {
+		logger := internal.GetLogger().With().Str("usecase", "applyRules").Str("source", input.Source).Logger()
+		logger.Warn().Err(err).Msg("Failed to apply transformation rules")
+		return
+	}
+
+	input.Description = tx.Description
+	input.Tags = tx.Tags
+	input.CategoryID = tx.CategoryID
 }
 
-// --- 5. Create Transaction Record ---
-tx.MarkAsCompleted() // Mark as completed after successful processing
-logger.Debug().Str("transactionID", tx.ID).Msg("Creating transaction record")
-if err := s.transactionRepo.Create(ctx, tx); err != nil {
-logger.Error().Err(err).Str("transactionID", tx.ID).Msg("Failed to create transaction record")
-// TODO: Rollback transaction if applicable
-// Consider marking wallet balances back? Complex without UoW.
-return nil, fmt.Errorf("failed to create transaction record: %w", err)
+// findCategoryIDByName resolves a category name (case-insensitive) to its ID
+func findCategoryIDByName(ctx context.Context, categoryRepo repositories.CategoryRepository, name string) (string, error) {
+	categories, err := categoryRepo.FindAll(ctx, repositories.CategoryFilter{NameLike: name})
+	if err != nil {
+		return "", fmt.Errorf("failed to find categories: %w", err)
 	}
 
-	logger.Info().Str("transactionID", tx.ID).Msg("Transaction created successfully")
-	return tx, nil
+	for _, category := range categories {
+		if strings.EqualFold(category.Name, name) {
+			return category.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("category %q: %w", name, models.ErrCategoryNotFound)
+}
+
+// DeleteTransaction soft-deletes a transaction and reverses its effect on wallet balances.
+// The transaction can be restored with RestoreTransaction within models.TransactionRestoreWindow.
+func (s *TransactionService) DeleteTransaction(ctx context.Context, id string) error {
+	logger := internal.GetLogger().With().Str("usecase", "DeleteTransaction").Str("transactionID", id).Logger()
+
+	tx, err := s.transactionRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if tx.IsDeleted() {
+		return models.ErrTransactionDeleted
+	}
+	if err := s.checkMaintenance(ctx, transactionWallets(tx)...); err != nil {
+		return err
+	}
+
+	// Reverse the balance effect before hiding the record
+	if err := s.applyBalanceEffect(ctx, tx, -1); err != nil {
+		logger.Error().Err(err).Msg("Failed to reverse balance effect")
+		return err
+	}
+
+	if err := s.transactionRepo.SoftDelete(ctx, id); err != nil {
+		logger.Error().Err(err).Msg("Failed to soft-delete transaction")
+		// Re-apply the balance effect so wallets stay consistent with the record
+		if rbErr := s.applyBalanceEffect(ctx, tx, 1); rbErr != nil {
+			logger.Error().Err(rbErr).Msg("Failed to re-apply balance effect after soft-delete failure")
+		}
+		return fmt.Errorf("failed to soft-delete transaction: %w", err)
+	}
+
+	logger.Info().Msg("Transaction soft-deleted")
+	return nil
+}
+
+// RestoreTransaction restores a soft-deleted transaction and re-applies its balance effect
+func (s *TransactionService) RestoreTransaction(ctx context.Context, id string) error {
+	logger := internal.GetLogger().With().Str("usecase", "RestoreTransaction").Str("transactionID", id).Logger()
+
+	tx, err := s.transactionRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	// Validate the restore on the model first so we don't touch balances needlessly
+	if err := tx.Restore(); err != nil {
+		return err
+	}
+	if err := s.checkMaintenance(ctx, transactionWallets(tx)...); err != nil {
+		return err
+	}
+
+	if err := s.transactionRepo.Restore(ctx, id); err != nil {
+		logger.Error().Err(err).Msg("Failed to restore transaction")
+		return fmt.Errorf("failed to restore transaction: %w", err)
+	}
+
+	if err := s.applyBalanceEffect(ctx, tx, 1); err != nil {
+		logger.Error().Err(err).Msg("Failed to re-apply balance effect")
+		return err
+	}
+
+	logger.Info().Msg("Transaction restored")
+	return nil
+}
+
+// MergeTransactions consolidates duplicate transactions into keepID. The dropped
+// transactions are soft-deleted with their balance effects reversed, their tags, notes
+// and metadata are added to the kept transaction, and the merge is recorded in the
+// transaction history.
+// Dropped transactions can still be restored with RestoreTransaction.
+func (s *TransactionService) MergeTransactions(ctx context.Context, keepID string, dropIDs []string) (*models.Transaction, error) {
+	logger := internal.GetLogger().With().Str("usecase", "MergeTransactions").Str("transactionID", keepID).Logger()
+
+	if len(dropIDs) == 0 {
+		return nil, fmt.Errorf("no transactions to merge: %w", models.ErrInvalidMerge)
+	}
+
+	keep, err := s.transactionRepo.FindByID(ctx, keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction %s: %w", keepID, err)
+	}
+	if keep.IsDeleted() {
+		return nil, fmt.Errorf("transaction %s: %w", keepID, models.ErrTransactionDeleted)
+	}
+
+	seen := map[string]bool{keepID: true}
+	dropped := make([]*models.Transaction, 0, len(dropIDs))
+	for _, id := range dropIDs {
+		if seen[id] {
+			return nil, fmt.Errorf("transaction %s listed twice: %w", id, models.ErrInvalidMerge)
+		}
+		seen[id] = true
+
+		tx, err := s.transactionRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get transaction %s: %w", id, err)
+		}
+		if tx.IsDeleted() {
+			return nil, fmt.Errorf("transaction %s: %w", id, models.ErrTransactionDeleted)
+		}
+
+		keep.MergeTags(tx.Tags)
+		keep.MergeNotes(tx.Notes)
+		keep.MergeMetadata(tx.Metadata)
+		// A manual entry merged with its bank import adopts the payment references
+		if keep.Reference == "" {
+			keep.Reference = tx.Reference
+		}
+		if keep.EndToEndID == "" {
+			keep.EndToEndID = tx.EndToEndID
+		}
+		dropped = append(dropped, tx)
+	}
+	if err := s.checkMaintenance(ctx, transactionWallets(append([]*models.Transaction{keep}, dropped...)...)...); err != nil {
+		return nil, err
+	}
+
+	if err := s.transactionRepo.Merge(ctx, keep, dropped); err != nil {
+		logger.Error().Err(err).Strs("dropped", dropIDs).Msg("Failed to merge transactions")
+		return nil, err
+	}
+
+	logger.Info().Strs("dropped", dropIDs).Msg("Transactions merged")
+	return keep, nil
+}
+
+// applyBalanceEffect applies (sign = 1) or reverses (sign = -1) the effect
+// a transaction has on the balances of its wallets, see Transaction.BalanceEffects.
+func (s *TransactionService) applyBalanceEffect(ctx context.Context, tx *models.Transaction, sign float64) error {
+	for walletID, delta := range tx.BalanceEffects() {
+		if err := s.walletRepo.UpdateBalance(ctx, walletID, sign*delta); err != nil {
+			return fmt.Errorf("failed to update balance of wallet %s: %w", walletID, err)
+		}
+	}
+
+	return nil
 }
 
-// TODO: Add methods for UpdateTransaction, DeleteTransaction, GetTransactionByID etc.
+// TODO: Add methods for UpdateTransaction, GetTransactionByID etc.
 // These would involve similar steps: fetch, validate, process (including reversals), save.
+
+// checkMaintenance refuses changes to the wallets of frozen spaces
+func (s *TransactionService) checkMaintenance(ctx context.Context, walletIDs ...string) error {
+	if s.maintenance == nil {
+		return nil
+	}
+	return s.maintenance.CheckWallets(ctx, walletIDs...)
+}
+
+// transactionWallets returns the wallets the transactions move money in or out of
+func transactionWallets(transactions ...*models.Transaction) []string {
+	walletIDs := make([]string, 0, len(transactions))
+	for _, tx := range transactions {
+		walletIDs = append(walletIDs, tx.WalletID, tx.DestWalletID)
+	}
+	return walletIDs
+}
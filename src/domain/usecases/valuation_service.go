@@ -0,0 +1,382 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// ExchangeRateProvider converts between currencies on a given date.
+// The returned rate is the amount of `to` currency for one unit of `from`.
+type ExchangeRateProvider interface {
+	GetRate(ctx context.Context, from, to string, date time.Time) (float64, error)
+}
+
+// ValuationService values wallet balances in a common base currency.
+type ValuationService struct {
+	walletRepo   repositories.WalletRepository
+	snapshotRepo repositories.BalanceSnapshotRepository
+	rates        *dailyRates
+	baseCurrency string
+	chains       map[string]string // chain of the source importing into each wallet, by lowercased wallet name
+	sandbox      map[string]bool   // wallets fed by sandbox sources, by lowercased wallet name
+}
+
+// NewValuationService creates a new ValuationService.
+// rates may be nil, in which case only wallets in the base currency can be valued.
+func NewValuationService(
+	walletRepo repositories.WalletRepository,
+	snapshotRepo repositories.BalanceSnapshotRepository,
+	rates ExchangeRateProvider,
+	baseCurrency string,
+) *ValuationService {
+	return &ValuationService{
+		walletRepo:   walletRepo,
+		snapshotRepo: snapshotRepo,
+		rates:        newDailyRates(rates),
+		baseCurrency: strings.ToUpper(baseCurrency),
+	}
+}
+
+// WithSources sets the import sources, which tell the chain of the crypto
+// wallets they import into for the portfolio breakdown. Wallets fed by
+// sandbox sources hold test data and are left out of the valuations.
+func (s *ValuationService) WithSources(sources []Source) *ValuationService {
+	s.chains = make(map[string]string, len(sources))
+	s.sandbox = make(map[string]bool)
+	for _, source := range sources {
+		name := strings.ToLower(source.Account.Name)
+		s.chains[name] = source.Account.Source
+		if source.Sandbox {
+			s.sandbox[name] = true
+		}
+	}
+	return s
+}
+
+// NetWorthOptions controls how net worth is computed
+type NetWorthOptions struct {
+	BaseCurrency    string // defaults to the service base currency
+	IncludeArchived bool   // archived wallets are excluded by default
+	VisibleTo       string // only the wallets this user can see, empty values every wallet
+}
+
+// WalletValuation is the value of a single wallet in the base currency
+type WalletValuation struct {
+	WalletID string  `json:"walletId"`
+	Name     string  `json:"name"`
+	Currency string  `json:"currency"`
+	Balance  float64 `json:"balance"`
+	Rate     float64 `json:"rate"`
+	Value    float64 `json:"value"`
+	Archived bool    `json:"archived,omitempty"`
+	Error    string  `json:"error,omitempty"` // set when the wallet could not be converted
+}
+
+// NetWorth is the total value of all wallets in the base currency
+type NetWorth struct {
+	BaseCurrency string            `json:"baseCurrency"`
+	Total        float64           `json:"total"`
+	AsOf         time.Time         `json:"asOf"`
+	Wallets      []WalletValuation `json:"wallets"`
+}
+
+// NetWorthPoint is a single point of the historical net worth series
+type NetWorthPoint struct {
+	Date  time.Time `json:"date"`
+	Total float64   `json:"total"`
+}
+
+// GetNetWorth values all wallets at their current balances
+func (s *ValuationService) GetNetWorth(ctx context.Context, opts NetWorthOptions) (*NetWorth, error) {
+	logger := internal.GetLogger().With().Str("usecase", "GetNetWorth").Logger()
+	base := s.resolveBase(opts.BaseCurrency)
+	now := time.Now()
+
+	wallets, err := s.walletRepo.FindAll(ctx, repositories.WalletFilter{IncludeArchived: opts.IncludeArchived, VisibleTo: opts.VisibleTo})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	result := &NetWorth{
+		BaseCurrency: base,
+		AsOf:         now,
+		Wallets:      make([]WalletValuation, 0, len(wallets)),
+	}
+
+	for _, wallet := range wallets {
+		if s.isSandbox(wallet) {
+			continue
+		}
+		valuation := WalletValuation{
+			WalletID: wallet.ID,
+			Name:     wallet.Name,
+			Currency: wallet.Currency,
+			Balance:  wallet.Balance,
+			Archived: wallet.Archived,
+		}
+
+		rate, err := s.rate(ctx, wallet.Currency, base, now)
+		if err != nil {
+			// Report the wallet without a value rather than failing the whole request
+			logger.Warn().Err(err).
+				Str("walletID", wallet.ID).
+				Str("currency", wallet.Currency).
+				Msg("Failed to convert wallet balance")
+			valuation.Error = err.Error()
+		} else {
+			valuation.Rate = rate
+			valuation.Value = wallet.Balance * rate
+			result.Total += valuation.Value
+		}
+
+		result.Wallets = append(result.Wallets, valuation)
+	}
+
+	return result, nil
+}
+
+// GetNetWorthHistory builds a daily net worth series from the balance snapshot store.
+// For each day, the latest snapshot of every wallet taken on or before that day is used.
+func (s *ValuationService) GetNetWorthHistory(ctx context.Context, opts NetWorthOptions, from, to time.Time) ([]NetWorthPoint, error) {
+	base := s.resolveBase(opts.BaseCurrency)
+	if to.Before(from) {
+		return nil, fmt.Errorf("invalid range: %s is before %s", to.Format(time.DateOnly), from.Format(time.DateOnly))
+	}
+
+	wallets, err := s.walletRepo.FindAll(ctx, repositories.WalletFilter{IncludeArchived: opts.IncludeArchived, VisibleTo: opts.VisibleTo})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	from = startOfDay(from)
+	to = startOfDay(to)
+
+	points := make([]NetWorthPoint, 0)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		points = append(points, NetWorthPoint{Date: day})
+	}
+
+	for _, wallet := range wallets {
+		if s.isSandbox(wallet) {
+			continue
+		}
+		// Seed with the last snapshot before the range so early days are not empty
+		filter := repositories.BalanceSnapshotFilter{
+			WalletID: wallet.ID,
+			Source:   models.BalanceSnapshotSourceLocal,
+		}
+		var current *models.BalanceSnapshot
+		seedFilter := filter
+		seedFilter.To = from
+		if seed, err := s.snapshotRepo.FindLatest(ctx, seedFilter); err == nil {
+			current = seed
+		}
+
+		filter.From = from
+		filter.To = to.AddDate(0, 0, 1)
+		snapshots, err := s.snapshotRepo.FindAll(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load snapshots for wallet %s: %w", wallet.ID, err)
+		}
+
+		idx := 0
+		for i := range points {
+			day := points[i].Date
+			dayEnd := day.AddDate(0, 0, 1)
+			for idx < len(snapshots) && snapshots[idx].TakenAt.Before(dayEnd) {
+				current = snapshots[idx]
+				idx++
+			}
+
+			if current == nil {
+				continue
+			}
+
+			rate, err := s.rate(ctx, current.Currency, base, day)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert %s to %s on %s: %w", current.Currency, base, day.Format(time.DateOnly), err)
+			}
+			points[i].Total += current.Balance * rate
+		}
+	}
+
+	return points, nil
+}
+
+// GetPortfolio values the crypto wallets at their latest recorded balances and
+// compares them with the balances recorded a day and a week ago, valued at the
+// prices of those days
+func (s *ValuationService) GetPortfolio(ctx context.Context, opts NetWorthOptions) (*models.Portfolio, error) {
+	logger := internal.GetLogger().With().Str("usecase", "GetPortfolio").Logger()
+	base := s.resolveBase(opts.BaseCurrency)
+	now := time.Now()
+
+	wallets, err := s.walletRepo.FindAll(ctx, repositories.WalletFilter{
+		Type:            models.WalletTypeCrypto,
+		IncludeArchived: opts.IncludeArchived,
+		VisibleTo:       opts.VisibleTo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	holdings := make([]models.PortfolioHolding, 0, len(wallets))
+	for _, wallet := range wallets {
+		if s.isSandbox(wallet) {
+			continue
+		}
+		holding := models.PortfolioHolding{
+			WalletID: wallet.ID,
+			Name:     wallet.Name,
+			Asset:    strings.ToUpper(wallet.Currency),
+			Chain:    s.chain(wallet),
+			Balance:  wallet.Balance,
+		}
+		// Wallets without snapshots yet are valued at their current balance
+		if snapshot, err := s.snapshotRepo.FindLatest(ctx, repositories.BalanceSnapshotFilter{WalletID: wallet.ID, To: now}); err == nil {
+			holding.Asset = strings.ToUpper(snapshot.Currency)
+			holding.Balance = snapshot.Balance
+		}
+
+		price, err := s.rate(ctx, holding.Asset, base, now)
+		if err != nil {
+			// Report the holding without a value rather than failing the whole portfolio
+			logger.Warn().Err(err).
+				Str("walletID", wallet.ID).
+				Str("asset", holding.Asset).
+				Msg("Failed to price holding")
+			holding.Error = err.Error()
+			holdings = append(holdings, holding)
+			continue
+		}
+		holding.Price = price
+		holding.Value = holding.Balance * price
+		holding.Change24h = s.change(ctx, wallet.ID, base, holding.Value, now.Add(-24*time.Hour))
+		holding.Change7d = s.change(ctx, wallet.ID, base, holding.Value, now.Add(-7*24*time.Hour))
+
+		holdings = append(holdings, holding)
+	}
+
+	return models.NewPortfolio(base, now, holdings), nil
+}
+
+// change returns the change of a wallet's value since a past time, or nil
+// when no balance was recorded by then or it cannot be priced
+func (s *ValuationService) change(ctx context.Context, walletID, base string, value float64, since time.Time) *models.PortfolioChange {
+	snapshot, err := s.snapshotRepo.FindLatest(ctx, repositories.BalanceSnapshotFilter{WalletID: walletID, To: since})
+	if err != nil {
+		return nil
+	}
+	price, err := s.rate(ctx, snapshot.Currency, base, since)
+	if err != nil {
+		return nil
+	}
+	return models.NewPortfolioChange(snapshot.Balance*price, value)
+}
+
+// chain returns the chain of the source importing into a wallet
+func (s *ValuationService) chain(wallet *models.Wallet) string {
+	if chain, ok := s.chains[strings.ToLower(wallet.Name)]; ok {
+		return chain
+	}
+	return models.PortfolioChainOther
+}
+
+// isSandbox reports whether a wallet is fed by a sandbox source
+func (s *ValuationService) isSandbox(wallet *models.Wallet) bool {
+	return s.sandbox[strings.ToLower(wallet.Name)]
+}
+
+// RecordSnapshots stores a local balance snapshot for every wallet
+func (s *ValuationService) RecordSnapshots(ctx context.Context, takenAt time.Time) (int, error) {
+	wallets, err := s.walletRepo.FindAll(ctx, repositories.WalletFilter{IncludeArchived: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	for _, wallet := range wallets {
+		snapshot := models.NewBalanceSnapshot(wallet, models.BalanceSnapshotSourceLocal, takenAt)
+		if err := s.snapshotRepo.Create(ctx, snapshot); err != nil {
+			return 0, fmt.Errorf("failed to snapshot wallet %s: %w", wallet.ID, err)
+		}
+	}
+
+	return len(wallets), nil
+}
+
+// rate returns the exchange rate between two currencies for a day, using the daily cache
+func (s *ValuationService) rate(ctx context.Context, from, to string, date time.Time) (float64, error) {
+	return s.rates.get(ctx, from, to, date)
+}
+
+func (s *ValuationService) resolveBase(base string) string {
+	if base == "" {
+		return s.baseCurrency
+	}
+	return strings.ToUpper(base)
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// dailyRates caches the rates of a provider per currency pair and day
+type dailyRates struct {
+	provider ExchangeRateProvider
+
+	// Keyed by from|to|date
+	mu    sync.Mutex
+	cache map[string]float64
+}
+
+func newDailyRates(provider ExchangeRateProvider) *dailyRates {
+	return &dailyRates{
+		provider: provider,
+		cache:    make(map[string]float64),
+	}
+}
+
+// get returns the exchange rate between two currencies for a day.
+// Without a provider only same-currency conversions succeed.
+func (r *dailyRates) get(ctx context.Context, from, to string, date time.Time) (float64, error) {
+	from = strings.ToUpper(from)
+	to = strings.ToUpper(to)
+	if from == to {
+		return 1, nil
+	}
+
+	if r.provider == nil {
+		return 0, fmt.Errorf("no exchange-rate provider configured for %s/%s: %w", from, to, models.ErrInvalidExchangeRate)
+	}
+
+	key := from + "|" + to + "|" + date.Format(time.DateOnly)
+
+	r.mu.Lock()
+	cached, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	rate, err := r.provider.GetRate(ctx, from, to, date)
+	if err != nil {
+		return 0, err
+	}
+	if rate <= 0 {
+		return 0, fmt.Errorf("provider returned rate %f for %s/%s: %w", rate, from, to, models.ErrInvalidExchangeRate)
+	}
+
+	r.mu.Lock()
+	r.cache[key] = rate
+	r.mu.Unlock()
+
+	return rate, nil
+}
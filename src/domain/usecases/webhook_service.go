@@ -0,0 +1,282 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// DefaultWebhookBatch is the number of deliveries sent per run of the queue
+const DefaultWebhookBatch = 100
+
+// WebhookService posts the events queued for the registered webhooks. The
+// event hooks queue a delivery per matching webhook in the transaction of the
+// change; the service signs each payload with the webhook secret, retries
+// failed deliveries with an exponential backoff and gives up after the
+// maximum attempts. Given-up deliveries can be redelivered on request.
+type WebhookService struct {
+	webhookRepo  repositories.WebhookRepository
+	deliveryRepo repositories.WebhookDeliveryRepository
+	client       *http.Client
+	timeout      time.Duration // of one attempt, zero leaves it to the client
+	maxAttempts  int           // zero retries forever
+	leadership   Leadership    // optional: only the leader sends the queue
+	wake         chan struct{}
+
+	mu       sync.Mutex
+	draining bool
+	stats    WebhookStats
+}
+
+// NewWebhookService creates a new WebhookService posting with the given client
+func NewWebhookService(webhookRepo repositories.WebhookRepository, deliveryRepo repositories.WebhookDeliveryRepository, client *http.Client) *WebhookService {
+	return &WebhookService{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		client:       client,
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// WithTimeout bounds each delivery attempt
+func (s *WebhookService) WithTimeout(timeout time.Duration) *WebhookService {
+	s.timeout = timeout
+	return s
+}
+
+// WithMaxAttempts gives a delivery up after the given number of failed attempts
+func (s *WebhookService) WithMaxAttempts(maxAttempts int) *WebhookService {
+	s.maxAttempts = maxAttempts
+	return s
+}
+
+// WithLeadership sends the queue only while this replica leads, so a
+// delivery is not posted by several replicas at once
+func (s *WebhookService) WithLeadership(leadership Leadership) *WebhookService {
+	s.leadership = leadership
+	return s
+}
+
+// WebhookStats are the delivery counters since the service started and the
+// number of deliveries waiting
+type WebhookStats struct {
+	Delivered int64 `json:"delivered"`
+	Failed    int64 `json:"failed"`
+	GaveUp    int64 `json:"gaveUp"`
+	Pending   int   `json:"pending"`
+}
+
+// Notify wakes Run after new deliveries were committed. It never blocks.
+func (s *WebhookService) Notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run sends the due deliveries when notified and every interval until ctx is done
+func (s *WebhookService) Run(ctx context.Context, interval time.Duration) {
+	logger := internal.GetLogger().With().Str("usecase", "DeliverWebhooks").Logger()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if s.leadership == nil || s.leadership.IsLeader() {
+			if _, err := s.Deliver(ctx); err != nil && ctx.Err() == nil {
+				logger.Warn().Err(err).Msg("Failed to deliver webhooks")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.wake:
+		}
+	}
+}
+
+// Deliver sends the due deliveries, oldest first. A delivery failing is
+// retried with its own backoff and does not hold back the others.
+func (s *WebhookService) Deliver(ctx context.Context) (*models.WebhookDeliveryReport, error) {
+	logger := internal.GetLogger().With().Str("usecase", "DeliverWebhooks").Logger()
+	report := &models.WebhookDeliveryReport{}
+
+	s.mu.Lock()
+	if s.draining {
+		s.mu.Unlock()
+		return report, nil
+	}
+	s.draining = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.draining = false
+		s.mu.Unlock()
+	}()
+
+	deliveries, err := s.deliveryRepo.FindDue(ctx, time.Now(), DefaultWebhookBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make(map[string]*models.Webhook)
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+
+		webhook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			webhook, err = s.webhookRepo.FindByID(ctx, delivery.WebhookID)
+			if err != nil && !errors.Is(err, models.ErrWebhookNotFound) {
+				return report, err
+			}
+			webhooks[delivery.WebhookID] = webhook
+		}
+
+		switch {
+		case webhook == nil:
+			delivery.Cancel("webhook was deleted")
+			report.GaveUp++
+		case !webhook.Enabled:
+			delivery.Cancel("webhook is disabled")
+			report.GaveUp++
+		default:
+			s.attempt(ctx, webhook, delivery, report)
+		}
+		if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+			return report, err
+		}
+	}
+
+	s.record(report)
+	if report.Delivered > 0 || report.Failed > 0 || report.GaveUp > 0 {
+		logger.Info().
+			Int("delivered", report.Delivered).
+			Int("failed", report.Failed).
+			Int("gaveUp", report.GaveUp).
+			Msg("Webhooks delivered")
+	}
+	return report, nil
+}
+
+// Redeliver posts a delivery again now, whatever its status, and returns it
+// with the outcome of the attempt. A failed attempt is retried as usual.
+func (s *WebhookService) Redeliver(ctx context.Context, deliveryID string) (*models.WebhookDelivery, error) {
+	delivery, err := s.deliveryRepo.FindByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	webhook, err := s.webhookRepo.FindByID(ctx, delivery.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.WebhookDeliveryReport{}
+	delivery.Redeliver(time.Now())
+	s.attempt(ctx, webhook, delivery, report)
+	if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+		return nil, err
+	}
+	s.record(report)
+	return delivery, nil
+}
+
+// Deliveries returns the deliveries of a webhook, newest first, optionally of one status
+func (s *WebhookService) Deliveries(ctx context.Context, webhookID string, status models.WebhookDeliveryStatus, limit int) ([]*models.WebhookDelivery, error) {
+	if _, err := s.webhookRepo.FindByID(ctx, webhookID); err != nil {
+		return nil, err
+	}
+	return s.deliveryRepo.FindByWebhook(ctx, webhookID, status, limit)
+}
+
+// Purge deletes the deliveries delivered before the given time
+func (s *WebhookService) Purge(ctx context.Context, before time.Time) (int, error) {
+	return s.deliveryRepo.PurgeDelivered(ctx, before)
+}
+
+// Stats returns the delivery counters and the number of deliveries waiting
+func (s *WebhookService) Stats(ctx context.Context) (WebhookStats, error) {
+	s.mu.Lock()
+	stats := s.stats
+	s.mu.Unlock()
+
+	var err error
+	stats.Pending, err = s.deliveryRepo.CountPending(ctx)
+	return stats, err
+}
+
+// attempt posts a delivery to its webhook and records the outcome on it
+func (s *WebhookService) attempt(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery, report *models.WebhookDeliveryReport) {
+	status, err := s.post(ctx, webhook, delivery)
+	now := time.Now()
+	if err == nil {
+		delivery.Deliver(status, now)
+		report.Delivered++
+		return
+	}
+
+	delivery.Fail(err, status, now, s.maxAttempts)
+	if delivery.Status == models.WebhookDeliveryFailed {
+		report.GaveUp++
+	} else {
+		report.Failed++
+	}
+	report.Errors = append(report.Errors, fmt.Sprintf("delivery %s to %s: %v", delivery.ID, webhook.URL, err))
+}
+
+// post sends the signed payload of a delivery and returns the response status.
+// Any status outside 2xx fails the attempt.
+func (s *WebhookService) post(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (int, error) {
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "FireDragon-Webhooks")
+	req.Header.Set(models.WebhookEventHeader, delivery.EventType)
+	req.Header.Set(models.WebhookDeliveryHeader, delivery.ID)
+	req.Header.Set(models.WebhookTimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(models.WebhookSignatureHeader, models.SignWebhook(webhook.Secret, now, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// record adds the outcome of a run to the counters
+func (s *WebhookService) record(report *models.WebhookDeliveryReport) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.Delivered += int64(report.Delivered)
+	s.stats.Failed += int64(report.Failed)
+	s.stats.GaveUp += int64(report.GaveUp)
+}
@@ -0,0 +1,225 @@
+package usecases
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// memoryWebhooks keeps the registered webhooks by ID
+type memoryWebhooks map[string]*models.Webhook
+
+func (r memoryWebhooks) FindByID(ctx context.Context, id string) (*models.Webhook, error) {
+	if webhook, ok := r[id]; ok {
+		return webhook, nil
+	}
+	return nil, models.ErrWebhookNotFound
+}
+
+func (r memoryWebhooks) FindAll(ctx context.Context) ([]*models.Webhook, error) {
+	var webhooks []*models.Webhook
+	for _, webhook := range r {
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, nil
+}
+
+// memoryDeliveries keeps the queued deliveries in the order they were queued
+type memoryDeliveries struct {
+	deliveries []*models.WebhookDelivery
+}
+
+func (r *memoryDeliveries) FindDue(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	var due []*models.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status == models.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now) && len(due) < limit {
+			copied := *delivery
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (r *memoryDeliveries) FindByID(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	for _, delivery := range r.deliveries {
+		if delivery.ID == id {
+			copied := *delivery
+			return &copied, nil
+		}
+	}
+	return nil, models.ErrWebhookDeliveryNotFound
+}
+
+func (r *memoryDeliveries) FindByWebhook(ctx context.Context, webhookID string, status models.WebhookDeliveryStatus, limit int) ([]*models.WebhookDelivery, error) {
+	var found []*models.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.WebhookID == webhookID && (status == "" || delivery.Status == status) {
+			found = append(found, delivery)
+		}
+	}
+	return found, nil
+}
+
+func (r *memoryDeliveries) Update(ctx context.Context, delivery *models.WebhookDelivery) error {
+	for i, stored := range r.deliveries {
+		if stored.ID == delivery.ID {
+			copied := *delivery
+			r.deliveries[i] = &copied
+			return nil
+		}
+	}
+	return models.ErrWebhookDeliveryNotFound
+}
+
+func (r *memoryDeliveries) CountPending(ctx context.Context) (int, error) {
+	pending := 0
+	for _, delivery := range r.deliveries {
+		if delivery.Status == models.WebhookDeliveryPending {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+func (r *memoryDeliveries) PurgeDelivered(ctx context.Context, before time.Time) (int, error) {
+	return 0, nil
+}
+
+func (r *memoryDeliveries) get(t *testing.T, id string) *models.WebhookDelivery {
+	t.Helper()
+	delivery, err := r.FindByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("FindByID(%s) error = %v", id, err)
+	}
+	return delivery
+}
+
+// webhookReceiver is a webhook endpoint answering with a settable status
+type webhookReceiver struct {
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	w.WriteHeader(r.status)
+}
+
+func (r *webhookReceiver) respond(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func TestWebhookService_Deliver(t *testing.T) {
+	ctx := context.Background()
+	receiver := &webhookReceiver{status: http.StatusNoContent}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	webhooks := memoryWebhooks{
+		"ledger": {ID: "ledger", URL: server.URL, Secret: "s3cret", Events: []string{"*"}, Enabled: true},
+		"paused": {ID: "paused", URL: server.URL, Secret: "s3cret", Events: []string{"*"}},
+	}
+	deliveries := &memoryDeliveries{deliveries: []*models.WebhookDelivery{
+		{ID: "d1", WebhookID: "ledger", EventType: "transaction.created", Payload: []byte(`{"id":"tx1"}`), Status: models.WebhookDeliveryPending},
+		{ID: "d2", WebhookID: "paused", EventType: "transaction.created", Payload: []byte(`{"id":"tx1"}`), Status: models.WebhookDeliveryPending},
+		{ID: "d3", WebhookID: "deleted", EventType: "transaction.created", Payload: []byte(`{"id":"tx1"}`), Status: models.WebhookDeliveryPending},
+	}}
+	service := NewWebhookService(webhooks, deliveries, server.Client()).WithMaxAttempts(2)
+
+	// A 2xx delivers, deliveries of disabled and deleted webhooks are given up unsent
+	report, err := service.Deliver(ctx)
+	if err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	if report.Delivered != 1 || report.GaveUp != 2 || report.Failed != 0 {
+		t.Fatalf("Deliver() = %+v, want 1 delivered and 2 given up", report)
+	}
+	if len(receiver.requests) != 1 {
+		t.Fatalf("receiver got %d requests, want only that of the enabled webhook", len(receiver.requests))
+	}
+	request, body := receiver.requests[0], receiver.bodies[0]
+	if request.Header.Get(models.WebhookEventHeader) != "transaction.created" || request.Header.Get(models.WebhookDeliveryHeader) != "d1" {
+		t.Errorf("headers = %v, want the event type and delivery ID", request.Header)
+	}
+	signedAt, err := strconv.ParseInt(request.Header.Get(models.WebhookTimestampHeader), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid %s: %v", models.WebhookTimestampHeader, err)
+	}
+	if !models.VerifyWebhook("s3cret", time.Unix(signedAt, 0), body, request.Header.Get(models.WebhookSignatureHeader)) {
+		t.Errorf("signature %q does not verify the payload %s", request.Header.Get(models.WebhookSignatureHeader), body)
+	}
+	if d1 := deliveries.get(t, "d1"); d1.Status != models.WebhookDeliveryDelivered || d1.ResponseStatus != http.StatusNoContent || d1.Attempts != 1 {
+		t.Errorf("d1 = %+v, want delivered on the first attempt", d1)
+	}
+	for _, id := range []string{"d2", "d3"} {
+		if delivery := deliveries.get(t, id); delivery.Status != models.WebhookDeliveryFailed || delivery.Attempts != 0 || delivery.LastError == "" {
+			t.Errorf("%s = %+v, want it cancelled without an attempt", id, delivery)
+		}
+	}
+
+	// A non-2xx fails the attempt and backs off, the last allowed attempt gives up
+	receiver.respond(http.StatusServiceUnavailable)
+	deliveries.deliveries = append(deliveries.deliveries,
+		&models.WebhookDelivery{ID: "d4", WebhookID: "ledger", EventType: "wallet.updated", Payload: []byte(`{"id":"w1"}`), Status: models.WebhookDeliveryPending})
+
+	before := time.Now()
+	if report, err = service.Deliver(ctx); err != nil || report.Failed != 1 || report.GaveUp != 0 || len(report.Errors) != 1 {
+		t.Fatalf("Deliver() = %+v, %v, want 1 failed attempt", report, err)
+	}
+	d4 := deliveries.get(t, "d4")
+	if d4.Status != models.WebhookDeliveryPending || d4.Attempts != 1 || d4.ResponseStatus != http.StatusServiceUnavailable {
+		t.Fatalf("d4 = %+v, want pending after a 503", d4)
+	}
+	if wait := d4.NextAttemptAt.Sub(before); wait < models.WebhookBackoff(1) || wait > models.WebhookBackoff(1)+time.Minute {
+		t.Errorf("next attempt in %v, want the first backoff of %v", wait, models.WebhookBackoff(1))
+	}
+
+	// Not due yet: nothing is sent
+	if report, err = service.Deliver(ctx); err != nil || report.Failed != 0 || len(receiver.requests) != 2 {
+		t.Fatalf("Deliver() = %+v, %v with %d requests, want the backed off delivery left alone", report, err, len(receiver.requests))
+	}
+
+	deliveries.deliveries[3].NextAttemptAt = time.Now().Add(-time.Second)
+	if report, err = service.Deliver(ctx); err != nil || report.GaveUp != 1 || report.Failed != 0 {
+		t.Fatalf("Deliver() = %+v, %v, want the delivery given up at max attempts", report, err)
+	}
+	if d4 = deliveries.get(t, "d4"); d4.Status != models.WebhookDeliveryFailed || d4.Attempts != 2 {
+		t.Errorf("d4 = %+v, want failed after 2 attempts", d4)
+	}
+
+	// A redelivery posts it again with the same delivery ID
+	receiver.respond(http.StatusOK)
+	redelivered, err := service.Redeliver(ctx, "d4")
+	if err != nil {
+		t.Fatalf("Redeliver() error = %v", err)
+	}
+	if redelivered.Status != models.WebhookDeliveryDelivered || redelivered.Attempts != 3 || deliveries.get(t, "d4").Status != models.WebhookDeliveryDelivered {
+		t.Errorf("Redeliver() = %+v, want it delivered and stored", redelivered)
+	}
+	if last := receiver.requests[len(receiver.requests)-1]; last.Header.Get(models.WebhookDeliveryHeader) != "d4" {
+		t.Errorf("redelivery header %s = %q, want d4", models.WebhookDeliveryHeader, last.Header.Get(models.WebhookDeliveryHeader))
+	}
+
+	stats, err := service.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+	if stats.Delivered != 2 || stats.Failed != 1 || stats.GaveUp != 3 || stats.Pending != 0 {
+		t.Errorf("Stats() = %+v, want 2 delivered, 1 failed, 3 given up and none pending", stats)
+	}
+}
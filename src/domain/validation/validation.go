@@ -0,0 +1,136 @@
+// Package validation collects field-level validation failures, so callers
+// see every problem of an input at once and API clients can tell which
+// field failed which rule.
+package validation
+
+import (
+	"errors"
+	"strings"
+)
+
+// Rules a field can fail
+const (
+	RuleRequired = "required" // the field must be set
+	RulePositive = "positive" // the number must be greater than zero
+	RuleMin      = "min"      // the number must not be below a minimum
+	RuleOneOf    = "one_of"   // the value must be one of a fixed set
+	RuleRange    = "range"    // the range must not end before it starts
+	RuleFormat   = "format"   // the value must have a specific format
+	RuleDistinct = "distinct" // the value must differ from another field
+	RulePast     = "past"     // the date must not be in the future
+	RuleValid    = "valid"    // a nested value must pass its own validation
+)
+
+// FieldError is a validation failure of one field
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+
+	// Err is the sentinel error of the failure, kept for errors.Is
+	Err error `json:"-"`
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Errors are the field errors of one validation
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the field errors, so errors.Is finds the sentinel of any of them
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Validator collects the field errors of a validation
+type Validator struct {
+	errs Errors
+}
+
+// New starts a validation
+func New() *Validator {
+	return &Validator{}
+}
+
+// Check records a failure of rule on field unless ok. The message is taken
+// from err, which stays matchable with errors.Is.
+func (v *Validator) Check(ok bool, field, rule string, err error) {
+	if !ok {
+		v.errs = append(v.errs, &FieldError{Field: field, Rule: rule, Message: err.Error(), Err: err})
+	}
+}
+
+// Add records a failure of rule on field with a message
+func (v *Validator) Add(field, rule, message string) {
+	v.errs = append(v.errs, &FieldError{Field: field, Rule: rule, Message: message})
+}
+
+// Required records a failure when value is blank
+func (v *Validator) Required(field, value string, err error) {
+	v.Check(strings.TrimSpace(value) != "", field, RuleRequired, err)
+}
+
+// Include records the failures of a nested validation under prefix, e.g.
+// "where.tag_is". Errors other than validation failures are recorded on the
+// prefix itself.
+func (v *Validator) Include(prefix string, err error) {
+	if err == nil {
+		return
+	}
+	fields := FieldErrors(err)
+	if fields == nil {
+		v.Check(false, prefix, RuleValid, err)
+		return
+	}
+	for _, field := range fields {
+		nested := *field
+		if prefix != "" {
+			nested.Field = prefix + "." + field.Field
+		}
+		v.errs = append(v.errs, &nested)
+	}
+}
+
+// Valid reports whether no failure has been recorded yet
+func (v *Validator) Valid() bool {
+	return len(v.errs) == 0
+}
+
+// Err returns the recorded failures, or nil when there are none
+func (v *Validator) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// FieldErrors returns the field errors in err, or nil when err is not a
+// validation failure
+func FieldErrors(err error) Errors {
+	var errs Errors
+	if errors.As(err, &errs) {
+		return errs
+	}
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return Errors{fieldErr}
+	}
+	return nil
+}
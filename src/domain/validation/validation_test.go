@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+var errMissingName = errors.New("must have a name")
+
+func TestValidator(t *testing.T) {
+	v := New()
+	v.Required("name", "  ", errMissingName)
+	v.Check(true, "amount", RulePositive, errors.New("unused"))
+	v.Add("currency", RuleFormat, "must be a three letter code")
+
+	err := v.Err()
+	if err == nil || v.Valid() {
+		t.Fatal("Err() = nil, want two failures")
+	}
+	if err.Error() != "name: must have a name; currency: must be a three letter code" {
+		t.Errorf("Error() = %q", err)
+	}
+	if !errors.Is(err, errMissingName) {
+		t.Errorf("errors.Is(%v, errMissingName) = false", err)
+	}
+
+	fields := FieldErrors(fmt.Errorf("create wallet: %w", err))
+	if len(fields) != 2 || fields[0].Field != "name" || fields[0].Rule != RuleRequired || fields[1].Rule != RuleFormat {
+		t.Errorf("FieldErrors() = %+v", fields)
+	}
+
+	if err := New().Err(); err != nil {
+		t.Errorf("Err() without failures = %v, want nil", err)
+	}
+	if fields := FieldErrors(errMissingName); fields != nil {
+		t.Errorf("FieldErrors() of a plain error = %+v, want nil", fields)
+	}
+}
@@ -6,39 +6,6 @@ import (
 "github.com/ZanzyTHEbar/firedragon-go/domain/models"
 )
 
-// ErrorType represents different types of client errors
-type ErrorType string
-
-const (
-	ErrorTypeNetwork  ErrorType = "network"
-	ErrorTypeAuth     ErrorType = "auth"
-	ErrorTypeInvalid  ErrorType = "invalid"
-	ErrorTypeNotFound ErrorType = "not_found"
-)
-
-// ClientError represents an error from a client
-type ClientError struct {
-	Type    ErrorType
-	Message string
-	Err     error
-}
-
-func (e *ClientError) Error() string {
-	if e.Err != nil {
-		return e.Message + ": " + e.Err.Error()
-	}
-	return e.Message
-}
-
-// NewClientError creates a new client error
-func NewClientError(errorType ErrorType, message string, err error) error {
-	return &ClientError{
-		Type:    errorType,
-		Message: message,
-		Err:     err,
-	}
-}
-
 // BlockchainClient defines the interface for blockchain clients
 type BlockchainClient interface {
 // FetchTransactions retrieves transactions for a wallet address
@@ -72,6 +39,21 @@ GetProviderType() string
 	RefreshToken() error
 }
 
+// Balance is one balance of a bank account: its amount and currency, which
+// balance type it is (closingBooked, interimAvailable, ...) and when the bank
+// computed it
+type Balance = models.ReportedBalance
+
+// BankAccountClient is a BankClient that reports every balance type of an
+// account instead of a single flattened balance
+type BankAccountClient interface {
+	BankClient
+
+	// FetchBalances returns all balances the bank reports for an account,
+	// one entry per balance type and currency
+	FetchBalances(accountID string) ([]Balance, error)
+}
+
 // DatabaseClient defines the interface for database operations
 type DatabaseClient interface {
 	// IsTransactionImported checks if a transaction has already been imported
@@ -104,6 +86,9 @@ type MetricsClient interface {
 	// RecordLatency records operation latency
 	RecordLatency(operation string, duration time.Duration)
 
+	// RecordQueueDepth records the number of jobs waiting in a queue
+	RecordQueueDepth(queue string, depth int)
+
 	// GetMetrics returns current metrics
 	GetMetrics() map[string]interface{}
 }
@@ -0,0 +1,173 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrorType classifies client errors, so callers decide whether to retry,
+// back off or give up without parsing error messages
+type ErrorType string
+
+const (
+	ErrorTypeAuth        ErrorType = "auth"         // credentials missing, expired or rejected
+	ErrorTypeRateLimit   ErrorType = "rate_limit"   // the provider throttled the request
+	ErrorTypeNetwork     ErrorType = "network"      // the provider is unreachable or temporarily unavailable
+	ErrorTypeTimeout     ErrorType = "timeout"      // the request did not complete in time
+	ErrorTypeValidation  ErrorType = "validation"   // the request is invalid, e.g. a malformed address or payload
+	ErrorTypeNotFound    ErrorType = "not_found"    // the requested resource does not exist
+	ErrorTypeProviderBug ErrorType = "provider_bug" // the provider failed internally or answered with a malformed response
+	ErrorTypeUnknown     ErrorType = "unknown"
+)
+
+// Retryable reports whether a request failing with this type can succeed
+// when repeated unchanged
+func (t ErrorType) Retryable() bool {
+	switch t {
+	case ErrorTypeRateLimit, ErrorTypeNetwork, ErrorTypeTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// ClientError represents an error from a client
+type ClientError struct {
+	Type       ErrorType
+	Message    string
+	Err        error
+	RetryAfter time.Duration // wait requested by the provider, zero when it asked for none
+}
+
+func (e *ClientError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *ClientError) Unwrap() error {
+	return e.Err
+}
+
+// NewClientError creates a new client error
+func NewClientError(errorType ErrorType, message string, err error) error {
+	return &ClientError{
+		Type:    errorType,
+		Message: message,
+		Err:     err,
+	}
+}
+
+// WrapClientError adds context to err. An error that is already classified
+// keeps its type and requested wait, so wrapping never turns e.g. a rate limit
+// into a plain network error. Returns nil when err is nil.
+func WrapClientError(errorType ErrorType, message string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	wrapped := &ClientError{Type: errorType, Message: message, Err: err}
+	var clientErr *ClientError
+	if errors.As(err, &clientErr) {
+		wrapped.Type = clientErr.Type
+		wrapped.RetryAfter = clientErr.RetryAfter
+	}
+	return wrapped
+}
+
+// NewTransportError creates a client error for a request that got no response,
+// classified as a timeout when a deadline expired and as a network error otherwise
+func NewTransportError(message string, err error) error {
+	errorType := ErrorTypeNetwork
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		errorType = ErrorTypeTimeout
+	}
+	return NewClientError(errorType, message, err)
+}
+
+// NewStatusError creates a client error for an unsuccessful HTTP response,
+// classified by its status code. A Retry-After header is honoured.
+// The response body is not read.
+func NewStatusError(resp *http.Response, message string) error {
+	return &ClientError{
+		Type:       ErrorTypeForStatus(resp.StatusCode),
+		Message:    message,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+}
+
+// ErrorTypeForStatus classifies an unsuccessful HTTP status code
+func ErrorTypeForStatus(status int) ErrorType {
+	switch {
+	case status == http.StatusUnauthorized, status == http.StatusForbidden:
+		return ErrorTypeAuth
+	case status == http.StatusNotFound, status == http.StatusGone:
+		return ErrorTypeNotFound
+	case status == http.StatusRequestTimeout, status == http.StatusGatewayTimeout:
+		return ErrorTypeTimeout
+	case status == http.StatusTooManyRequests:
+		return ErrorTypeRateLimit
+	case status == http.StatusBadGateway, status == http.StatusServiceUnavailable:
+		return ErrorTypeNetwork
+	case status >= 400 && status < 500:
+		return ErrorTypeValidation
+	default:
+		// Internal server errors and statuses a provider should never answer with
+		return ErrorTypeProviderBug
+	}
+}
+
+// ErrorTypeOf returns the type of the first client error in err's chain.
+// An expired or canceled context counts as a timeout.
+func ErrorTypeOf(err error) ErrorType {
+	var clientErr *ClientError
+	switch {
+	case errors.As(err, &clientErr):
+		return clientErr.Type
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return ErrorTypeTimeout
+	default:
+		return ErrorTypeUnknown
+	}
+}
+
+// IsRetryable reports whether the operation that failed with err may succeed
+// when repeated. Errors of a canceled context are not retryable.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	return ErrorTypeOf(err).Retryable()
+}
+
+// RetryAfter returns how long the provider asked to wait before retrying err,
+// or zero when it did not ask
+func RetryAfter(err error) time.Duration {
+	var clientErr *ClientError
+	if errors.As(err, &clientErr) {
+		return clientErr.RetryAfter
+	}
+	return 0
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
@@ -0,0 +1,93 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestErrorTypeForStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		want   ErrorType
+	}{
+		{http.StatusUnauthorized, ErrorTypeAuth},
+		{http.StatusForbidden, ErrorTypeAuth},
+		{http.StatusNotFound, ErrorTypeNotFound},
+		{http.StatusUnprocessableEntity, ErrorTypeValidation},
+		{http.StatusTooManyRequests, ErrorTypeRateLimit},
+		{http.StatusGatewayTimeout, ErrorTypeTimeout},
+		{http.StatusServiceUnavailable, ErrorTypeNetwork},
+		{http.StatusInternalServerError, ErrorTypeProviderBug},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			if got := ErrorTypeForStatus(tt.status); got != tt.want {
+				t.Errorf("ErrorTypeForStatus(%d) = %s, want %s", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewStatusError_RetryAfter(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"30"}}}
+	err := fmt.Errorf("sync failed: %w", NewStatusError(resp, "throttled"))
+
+	if got := ErrorTypeOf(err); got != ErrorTypeRateLimit {
+		t.Errorf("ErrorTypeOf() = %s, want %s", got, ErrorTypeRateLimit)
+	}
+	if got := RetryAfter(err); got != 30*time.Second {
+		t.Errorf("RetryAfter() = %s, want 30s", got)
+	}
+	if !IsRetryable(err) {
+		t.Error("IsRetryable() = false, want true")
+	}
+}
+
+func TestWrapClientError(t *testing.T) {
+	if WrapClientError(ErrorTypeNetwork, "fetch failed", nil) != nil {
+		t.Error("WrapClientError(nil) should return nil")
+	}
+
+	inner := NewClientError(ErrorTypeAuth, "token expired", nil)
+	wrapped := WrapClientError(ErrorTypeNetwork, "fetch failed", inner)
+	if got := ErrorTypeOf(wrapped); got != ErrorTypeAuth {
+		t.Errorf("wrapped error type = %s, want %s", got, ErrorTypeAuth)
+	}
+	if !errors.Is(wrapped, inner) {
+		t.Error("wrapped error should unwrap to the original error")
+	}
+
+	plain := WrapClientError(ErrorTypeNetwork, "fetch failed", errors.New("connection reset"))
+	if got := ErrorTypeOf(plain); got != ErrorTypeNetwork {
+		t.Errorf("wrapped plain error type = %s, want %s", got, ErrorTypeNetwork)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"network", NewClientError(ErrorTypeNetwork, "unreachable", nil), true},
+		{"deadline", NewTransportError("request failed", context.DeadlineExceeded), true},
+		{"canceled", NewTransportError("request failed", context.Canceled), false},
+		{"validation", NewClientError(ErrorTypeValidation, "bad address", nil), false},
+		{"provider bug", NewClientError(ErrorTypeProviderBug, "malformed response", nil), false},
+		{"unclassified", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -30,6 +30,38 @@ const (
 	EventTypeError        = "error"
 )
 
+// Domain events published when PocketBase records change
+const (
+	EventTypeTransactionCreated         EventType = "transaction.created"
+	EventTypeTransactionUpdated         EventType = "transaction.updated"
+	EventTypeTransactionDeleted         EventType = "transaction.deleted"
+	EventTypeWalletBalanceChanged       EventType = "wallet.balance_changed"
+	EventTypeBudgetThreshold            EventType = "budget.threshold"
+	EventTypeIncidentOpened             EventType = "incident.opened"
+	EventTypeIncidentClosed             EventType = "incident.closed"
+	EventTypeFireflyConflict            EventType = "firefly.conflict"
+	EventTypeExportReady                EventType = "export.ready"
+	EventTypeSubscriptionDetected       EventType = "subscription.detected"
+	EventTypeSubscriptionPriceIncreased EventType = "subscription.price_increased"
+	EventTypeSubscriptionMissed         EventType = "subscription.missed"
+	EventTypeStreamStorage              EventType = "stream.storage"
+	EventTypeBalanceAssertionFailed     EventType = "balance.assertion_failed"
+	EventTypeSourceTransitioned         EventType = "source.transitioned"
+	EventTypeConsentRenewalRequested    EventType = "source.consent_renewal_requested"
+)
+
+// ImportReportEventType returns the event type an import cycle report is
+// published as, import.report.<cycle_id>
+func ImportReportEventType(cycleID string) EventType {
+	return EventType("import.report." + cycleID)
+}
+
+// NotificationEventType returns the event type the notifications of a user
+// are published as, notification.<user_id>
+func NotificationEventType(userID string) EventType {
+	return EventType("notification." + userID)
+}
+
 type Event struct {
 	ID         string            `json:"id"`
 	Type       EventType         `json:"type"`
@@ -0,0 +1,313 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// FireflyAccount is an account as stored in Firefly III
+type FireflyAccount struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"` // asset, expense, revenue, liability, ...
+	IBAN          string `json:"iban,omitempty"`
+	AccountNumber string `json:"account_number,omitempty"`
+	CurrencyCode  string `json:"currency_code,omitempty"`
+	Active        bool   `json:"active"`
+
+	CurrentBalance float64 `json:"current_balance"` // in CurrencyCode, as of now
+}
+
+// FireflyAccountInUseError is returned when deleting a Firefly account that still has transactions
+type FireflyAccountInUseError struct {
+	AccountID    string
+	Transactions int
+}
+
+func (e *FireflyAccountInUseError) Error() string {
+	return fmt.Sprintf("firefly account %s still has %d transactions; archive it or force the deletion", e.AccountID, e.Transactions)
+}
+
+// FireflyAccountRequest describes an account to create in Firefly III
+type FireflyAccountRequest struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	AccountRole   string `json:"account_role,omitempty"` // required for asset accounts, e.g. defaultAsset
+	IBAN          string `json:"iban,omitempty"`
+	AccountNumber string `json:"account_number,omitempty"`
+	CurrencyCode  string `json:"currency_code,omitempty"`
+	Notes         string `json:"notes,omitempty"`
+
+	// Opening balance booked when the account is created; ignored when zero
+	OpeningBalance     float64   `json:"opening_balance,omitempty"`
+	OpeningBalanceDate time.Time `json:"opening_balance_date,omitempty"`
+}
+
+// FireflyMaxDecimals is the largest number of decimal places Firefly III
+// stores for an amount
+const FireflyMaxDecimals = 12
+
+// FireflyCurrency is a currency as stored in Firefly III
+type FireflyCurrency struct {
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	Symbol        string `json:"symbol"`
+	DecimalPlaces int    `json:"decimal_places"`
+	Enabled       bool   `json:"enabled"`
+}
+
+// FireflyTransaction is a single-split transaction sent to Firefly III
+type FireflyTransaction struct {
+	Type            string    `json:"type"` // withdrawal, deposit, transfer
+	Date            time.Time `json:"date"`
+	Amount          float64   `json:"amount"`
+	Description     string    `json:"description"`
+	CurrencyCode    string    `json:"currency_code,omitempty"`
+	SourceID        string    `json:"source_id,omitempty"`
+	SourceName      string    `json:"source_name,omitempty"`
+	DestinationID   string    `json:"destination_id,omitempty"`
+	DestinationName string    `json:"destination_name,omitempty"`
+	CategoryName    string    `json:"category_name,omitempty"`
+	Tags            []string  `json:"tags,omitempty"`
+	ExternalID      string    `json:"external_id,omitempty"`
+	Notes           string    `json:"notes,omitempty"`
+
+	// Payment references, available to Firefly rules
+	InternalReference string `json:"internal_reference,omitempty"`
+	SepaCtID          string `json:"sepa_ct_id,omitempty"` // SEPA end-to-end ID
+}
+
+// FireflyTransactionEdit changes the splits of one transaction group. Nil
+// fields are left unchanged.
+type FireflyTransactionEdit struct {
+	Description *string `json:"description,omitempty"`
+	Category    *string `json:"category,omitempty"` // category name, empty removes the category
+}
+
+// FireflyTransactionSplit is a single split of a transaction group read from Firefly III.
+// Date is the booking date of the split, not the time the group was created.
+type FireflyTransactionSplit struct {
+	FireflyTransaction
+	JournalID  string `json:"journal_id"`
+	CategoryID string `json:"category_id,omitempty"`
+	BudgetName string `json:"budget_name,omitempty"`
+}
+
+// FireflyTransactionGroup is a transaction group as stored in Firefly III
+type FireflyTransactionGroup struct {
+	ID        string                    `json:"id"`
+	Title     string                    `json:"title,omitempty"` // only set for groups with several splits
+	CreatedAt time.Time                 `json:"created_at"`
+	UpdatedAt time.Time                 `json:"updated_at"`
+	Splits    []FireflyTransactionSplit `json:"splits"`
+}
+
+// FireflyTransactionFilter restricts a transaction listing. Zero values are ignored.
+type FireflyTransactionFilter struct {
+	Start time.Time // booking date, inclusive
+	End   time.Time // booking date, inclusive
+	Type  string    // withdrawal, deposit, transfer, ...
+}
+
+// FireflyCategory is a category as stored in Firefly III
+type FireflyCategory struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Notes string `json:"notes,omitempty"`
+}
+
+// FireflyCategoryAmount is a category sum in a single currency
+type FireflyCategoryAmount struct {
+	CurrencyCode string  `json:"currency_code"`
+	Sum          float64 `json:"sum"` // always positive, for spent as well as earned
+}
+
+// FireflyCategoryPeriod holds what was spent and earned in a category during one period
+type FireflyCategoryPeriod struct {
+	Start  time.Time               `json:"start"`
+	End    time.Time               `json:"end"` // inclusive
+	Spent  []FireflyCategoryAmount `json:"spent"`
+	Earned []FireflyCategoryAmount `json:"earned"`
+}
+
+// FireflyCategoryReport is the spent/earned history of a category, bucketed by calendar month
+type FireflyCategoryReport struct {
+	CategoryID string                  `json:"category_id"`
+	Name       string                  `json:"name"`
+	Start      time.Time               `json:"start"`
+	End        time.Time               `json:"end"`
+	Periods    []FireflyCategoryPeriod `json:"periods"`
+	Spent      []FireflyCategoryAmount `json:"spent"`  // totals over all periods
+	Earned     []FireflyCategoryAmount `json:"earned"` // totals over all periods
+}
+
+// Link types every Firefly III installation ships with
+const (
+	FireflyLinkRelated       = "Related"
+	FireflyLinkRefund        = "Refund"        // outward (partially) refunds inward
+	FireflyLinkPaid          = "Paid"          // outward (partially) pays for inward
+	FireflyLinkReimbursement = "Reimbursement" // outward (partially) reimburses inward
+)
+
+// FireflyLinkType is a kind of relation between two transactions
+type FireflyLinkType struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`  // e.g. "is (partially) refunded by"
+	Outward string `json:"outward"` // e.g. "(partially) refunds"
+}
+
+// FireflyLink relates two transaction journals: OutwardID <outward phrase> InwardID
+type FireflyLink struct {
+	ID         string `json:"id"`
+	LinkTypeID string `json:"link_type_id"`
+	InwardID   string `json:"inward_id"`  // transaction journal ID
+	OutwardID  string `json:"outward_id"` // transaction journal ID
+	Notes      string `json:"notes,omitempty"`
+}
+
+// FireflyLinkRequest describes a link to create
+type FireflyLinkRequest struct {
+	LinkType  string `json:"link_type"` // link type name, e.g. FireflyLinkRefund
+	InwardID  string `json:"inward_id"`
+	OutwardID string `json:"outward_id"`
+	Notes     string `json:"notes,omitempty"`
+}
+
+// FireflyAbout describes the connected Firefly III instance
+type FireflyAbout struct {
+	Version    string `json:"version"`
+	APIVersion string `json:"api_version"`
+	PHPVersion string `json:"php_version"`
+	OS         string `json:"os"`
+	Driver     string `json:"driver"`
+}
+
+// FireflyFeature is an optional part of the Firefly III API that only some
+// releases support
+type FireflyFeature string
+
+const (
+	FireflyFeatureWebhooks      FireflyFeature = "webhooks"       // /api/v1/webhooks
+	FireflyFeatureBulkUpdate    FireflyFeature = "bulk_update"    // /api/v1/data/bulk/transactions
+	FireflyFeatureUpdatedSearch FireflyFeature = "updated_search" // updated_at_after search operator
+	FireflyFeatureInsights      FireflyFeature = "insights"       // /api/v1/insight
+	FireflyFeatureV2API         FireflyFeature = "v2_api"         // /api/v2
+)
+
+// FireflyCapabilities lists the optional features the connected Firefly III
+// instance supports
+type FireflyCapabilities struct {
+	Version    string                  `json:"version"`
+	APIVersion string                  `json:"api_version"`
+	Features   map[FireflyFeature]bool `json:"features"`
+}
+
+// FireflyUnsupportedError is returned for requests that need a newer Firefly
+// III release than the connected one
+type FireflyUnsupportedError struct {
+	Feature  FireflyFeature
+	Required string // first release supporting the feature
+	Version  string // release of the connected instance
+}
+
+func (e *FireflyUnsupportedError) Error() string {
+	return fmt.Sprintf("%s requires Firefly >= %s, connected to %s", e.Feature, e.Required, e.Version)
+}
+
+// FireflyItemError describes a list item that could not be decoded and was skipped
+type FireflyItemError struct {
+	Index int    `json:"index"` // position in the page
+	Page  int    `json:"page"`
+	ID    string `json:"id,omitempty"`
+	Error string `json:"error"`
+}
+
+// FireflyClient defines the interface for the Firefly III API
+type FireflyClient interface {
+	// About returns the version information of the Firefly III instance
+	About(ctx context.Context) (*FireflyAbout, error)
+
+	// Capabilities returns the optional features of the connected instance,
+	// detecting its version on first use
+	Capabilities(ctx context.Context) (*FireflyCapabilities, error)
+
+	// ListAccounts lists all accounts of a type (empty lists all types)
+	ListAccounts(ctx context.Context, accountType string) ([]FireflyAccount, error)
+
+	// ListAccountsLenient lists accounts like ListAccounts and also reports the items it had to skip
+	ListAccountsLenient(ctx context.Context, accountType string) ([]FireflyAccount, []FireflyItemError, error)
+
+	// GetAccount gets an account by ID
+	GetAccount(ctx context.Context, id string) (*FireflyAccount, error)
+
+	// CreateAccount creates a new account
+	CreateAccount(ctx context.Context, account FireflyAccountRequest) (*FireflyAccount, error)
+
+	// ArchiveAccount deactivates an account, keeping its transactions
+	ArchiveAccount(ctx context.Context, id string) error
+
+	// DeleteAccount deletes an account together with its transactions. Unless force is
+	// set, accounts with transactions are refused with a *FireflyAccountInUseError.
+	DeleteAccount(ctx context.Context, id string, force bool) error
+
+	// GetCurrency gets a currency by its code
+	GetCurrency(ctx context.Context, code string) (*FireflyCurrency, error)
+
+	// CreateCurrency creates a new, enabled currency
+	CreateCurrency(ctx context.Context, currency FireflyCurrency) (*FireflyCurrency, error)
+
+	// EnableCurrency enables a disabled currency
+	EnableCurrency(ctx context.Context, code string) error
+
+	// ListCategories lists all categories
+	ListCategories(ctx context.Context) ([]FireflyCategory, error)
+
+	// GetCategoryReport returns the monthly spent/earned figures of a category between start and end (inclusive)
+	GetCategoryReport(ctx context.Context, id string, start, end time.Time) (*FireflyCategoryReport, error)
+
+	// CreateTransaction creates a transaction and returns its Firefly ID
+	CreateTransaction(ctx context.Context, tx FireflyTransaction) (string, error)
+
+	// UpdateTransaction applies an edit to every split of a transaction group
+	UpdateTransaction(ctx context.Context, id string, edit FireflyTransactionEdit) error
+
+	// GetTransaction gets a transaction group by ID
+	GetTransaction(ctx context.Context, id string) (*FireflyTransactionGroup, error)
+
+	// ListTransactions lists transaction groups, following pagination
+	ListTransactions(ctx context.Context, filter FireflyTransactionFilter) ([]FireflyTransactionGroup, error)
+
+	// EachTransaction streams transaction groups to fn page by page, following
+	// pagination, and stops at the first error fn returns
+	EachTransaction(ctx context.Context, filter FireflyTransactionFilter, fn func(FireflyTransactionGroup) error) error
+
+	// ListAccountTransactions lists the transaction groups touching one account
+	ListAccountTransactions(ctx context.Context, accountID string, filter FireflyTransactionFilter) ([]FireflyTransactionGroup, error)
+
+	// ListTransactionsUpdatedSince lists the transaction groups changed after since
+	ListTransactionsUpdatedSince(ctx context.Context, since time.Time) ([]FireflyTransactionGroup, error)
+
+	// SearchTransactions lists the transaction groups matching a search
+	SearchTransactions(ctx context.Context, search *FireflySearch) ([]FireflyTransactionGroup, error)
+
+	// BulkUpdateTransactions applies a bulk update and returns the number of
+	// transactions changed, or only counts the matching transactions when dryRun is set
+	BulkUpdateTransactions(ctx context.Context, update *FireflyBulkUpdate, dryRun bool) (int, error)
+
+	// ListLinkTypes lists the available link types
+	ListLinkTypes(ctx context.Context) ([]FireflyLinkType, error)
+
+	// CreateLink links two transaction journals
+	CreateLink(ctx context.Context, link FireflyLinkRequest) (*FireflyLink, error)
+
+	// ListLinks lists the links of a transaction journal
+	ListLinks(ctx context.Context, journalID string) ([]FireflyLink, error)
+
+	// DeleteLink deletes a link by ID
+	DeleteLink(ctx context.Context, id string) error
+
+	// FindTransactionByExternalID returns the ID of the transaction with the given external ID
+	FindTransactionByExternalID(ctx context.Context, externalID string) (string, error)
+}
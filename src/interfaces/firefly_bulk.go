@@ -0,0 +1,139 @@
+package interfaces
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+)
+
+// FireflyTransactionQuery selects Firefly transactions. Empty fields do not filter.
+type FireflyTransactionQuery struct {
+	Tag       string    `json:"tag,omitempty"`
+	Category  string    `json:"category,omitempty"` // category name
+	AccountID string    `json:"account_id,omitempty"`
+	DateFrom  time.Time `json:"date_from,omitempty"` // inclusive
+	DateTo    time.Time `json:"date_to,omitempty"`   // inclusive
+}
+
+// IsEmpty reports whether the query selects every transaction
+func (q FireflyTransactionQuery) IsEmpty() bool {
+	return q.Tag == "" && q.Category == "" && q.AccountID == "" && q.DateFrom.IsZero() && q.DateTo.IsZero()
+}
+
+// Search returns the transaction search selecting the same transactions
+func (q FireflyTransactionQuery) Search() *FireflySearch {
+	search := NewFireflySearch()
+	if q.Tag != "" {
+		search.Tag(q.Tag)
+	}
+	if q.Category != "" {
+		search.Category(q.Category)
+	}
+	if q.AccountID != "" {
+		search.Account(q.AccountID)
+	}
+	return search.Between(q.DateFrom, q.DateTo)
+}
+
+// FireflyTransactionUpdate describes the changes applied to every selected transaction.
+// Nil fields are left unchanged.
+type FireflyTransactionUpdate struct {
+	Category  *string  `json:"category,omitempty"` // category name, empty removes the category
+	Tags      []string `json:"tags,omitempty"`     // replaces all tags
+	Notes     *string  `json:"notes,omitempty"`
+	AccountID string   `json:"account_id,omitempty"` // moves transactions from the queried account to this one
+}
+
+// IsEmpty reports whether the update changes nothing
+func (u FireflyTransactionUpdate) IsEmpty() bool {
+	return u.Category == nil && u.Tags == nil && u.Notes == nil && u.AccountID == ""
+}
+
+// FireflyBulkUpdate is a typed bulk update of Firefly transactions, e.g.
+//
+//	NewFireflyBulkUpdate().WithTag("coffee").Between(from, to).SetCategory("Dining")
+type FireflyBulkUpdate struct {
+	Where FireflyTransactionQuery  `json:"where"`
+	Set   FireflyTransactionUpdate `json:"set"`
+}
+
+// NewFireflyBulkUpdate starts an empty bulk update
+func NewFireflyBulkUpdate() *FireflyBulkUpdate {
+	return &FireflyBulkUpdate{}
+}
+
+// WithTag selects transactions carrying tag
+func (u *FireflyBulkUpdate) WithTag(tag string) *FireflyBulkUpdate {
+	u.Where.Tag = tag
+	return u
+}
+
+// InCategory selects transactions in the named category
+func (u *FireflyBulkUpdate) InCategory(category string) *FireflyBulkUpdate {
+	u.Where.Category = category
+	return u
+}
+
+// ForAccount selects transactions from or to an account
+func (u *FireflyBulkUpdate) ForAccount(accountID string) *FireflyBulkUpdate {
+	u.Where.AccountID = accountID
+	return u
+}
+
+// Between selects transactions booked between from and to (inclusive). Zero times are open ends.
+func (u *FireflyBulkUpdate) Between(from, to time.Time) *FireflyBulkUpdate {
+	u.Where.DateFrom = from
+	u.Where.DateTo = to
+	return u
+}
+
+// SetCategory sets the category of the selected transactions, empty removes it
+func (u *FireflyBulkUpdate) SetCategory(category string) *FireflyBulkUpdate {
+	u.Set.Category = &category
+	return u
+}
+
+// SetTags replaces the tags of the selected transactions
+func (u *FireflyBulkUpdate) SetTags(tags ...string) *FireflyBulkUpdate {
+	u.Set.Tags = append([]string{}, tags...)
+	return u
+}
+
+// SetNotes replaces the notes of the selected transactions
+func (u *FireflyBulkUpdate) SetNotes(notes string) *FireflyBulkUpdate {
+	u.Set.Notes = &notes
+	return u
+}
+
+// MoveToAccount moves all transactions of the ForAccount account to another account
+func (u *FireflyBulkUpdate) MoveToAccount(accountID string) *FireflyBulkUpdate {
+	u.Set.AccountID = accountID
+	return u
+}
+
+// IsAccountMove reports whether the update is a plain account move, which
+// Firefly's bulk endpoint handles server-side
+func (u *FireflyBulkUpdate) IsAccountMove() bool {
+	return u.Set.AccountID != ""
+}
+
+// Validate checks the update is complete and unambiguous
+func (u *FireflyBulkUpdate) Validate() error {
+	v := validation.New()
+	v.Check(!u.Where.IsEmpty(), "where", validation.RuleRequired, errors.New("bulk update must filter transactions"))
+	v.Check(!u.Set.IsEmpty(), "set", validation.RuleRequired, errors.New("bulk update must change at least one field"))
+	v.Include("where", u.Where.Search().Validate())
+
+	if u.IsAccountMove() {
+		// Firefly only supports moving all transactions of one account
+		v.Check(u.Where == (FireflyTransactionQuery{AccountID: u.Where.AccountID}) && u.Where.AccountID != "",
+			"where", validation.RuleValid, errors.New("moving transactions requires filtering by account only"))
+		v.Check(u.Set.Category == nil && u.Set.Tags == nil && u.Set.Notes == nil,
+			"set", validation.RuleValid, errors.New("moving transactions cannot be combined with other changes"))
+		v.Check(u.Set.AccountID != u.Where.AccountID,
+			"set.account_id", validation.RuleDistinct, errors.New("cannot move transactions to the same account"))
+	}
+
+	return v.Err()
+}
@@ -0,0 +1,255 @@
+package interfaces
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+)
+
+// FireflySearchOperator is an operator of Firefly's transaction search syntax
+type FireflySearchOperator string
+
+// Operators of Firefly's transaction search. Terms of a search must all match.
+const (
+	SearchTagIs                FireflySearchOperator = "tag_is"
+	SearchCategoryIs           FireflySearchOperator = "category_is"
+	SearchHasNoCategory        FireflySearchOperator = "has_no_category"
+	SearchAccountID            FireflySearchOperator = "account_id" // source or destination
+	SearchSourceAccountID      FireflySearchOperator = "source_account_id"
+	SearchDestinationAccountID FireflySearchOperator = "destination_account_id"
+	SearchAmountIs             FireflySearchOperator = "amount_is"
+	SearchAmountMore           FireflySearchOperator = "amount_more"
+	SearchAmountLess           FireflySearchOperator = "amount_less"
+	SearchDateOn               FireflySearchOperator = "date_on"
+	SearchDateAfter            FireflySearchOperator = "date_after"  // on or after the day
+	SearchDateBefore           FireflySearchOperator = "date_before" // on or before the day
+	SearchUpdatedAtAfter       FireflySearchOperator = "updated_at_after"
+	SearchDescriptionIs        FireflySearchOperator = "description_is"
+	SearchDescriptionContains  FireflySearchOperator = "description_contains"
+	SearchDescriptionStarts    FireflySearchOperator = "description_starts"
+	SearchDescriptionEnds      FireflySearchOperator = "description_ends"
+	SearchExternalIDIs         FireflySearchOperator = "external_id_is"
+	SearchType                 FireflySearchOperator = "type"
+)
+
+// Transaction types a search can select
+const (
+	FireflyTypeWithdrawal = "withdrawal"
+	FireflyTypeDeposit    = "deposit"
+	FireflyTypeTransfer   = "transfer"
+)
+
+// FireflySearchTerm is one operator of a search with its value
+type FireflySearchTerm struct {
+	Operator FireflySearchOperator `json:"operator"`
+	Value    string                `json:"value,omitempty"`
+}
+
+// FireflySearch builds a transaction search in Firefly's query syntax, e.g.
+//
+//	NewFireflySearch().Tag("coffee").AmountBetween(5, 20).Between(from, to)
+//
+// Values are quoted and formatted by the builder; Validate rejects empty
+// values, negative amounts and inverted ranges before a request is sent.
+type FireflySearch struct {
+	Terms []FireflySearchTerm `json:"terms"`
+
+	problems validation.Validator // invalid arguments passed to the builder
+}
+
+// NewFireflySearch starts a search matching every transaction
+func NewFireflySearch() *FireflySearch {
+	return &FireflySearch{}
+}
+
+// Tag selects transactions carrying tag
+func (s *FireflySearch) Tag(tag string) *FireflySearch {
+	return s.text(SearchTagIs, tag)
+}
+
+// Category selects transactions in the named category
+func (s *FireflySearch) Category(name string) *FireflySearch {
+	return s.text(SearchCategoryIs, name)
+}
+
+// NoCategory selects transactions without a category
+func (s *FireflySearch) NoCategory() *FireflySearch {
+	return s.add(SearchHasNoCategory, "true")
+}
+
+// Account selects transactions from or to an account
+func (s *FireflySearch) Account(id string) *FireflySearch {
+	return s.id(SearchAccountID, id)
+}
+
+// SourceAccount selects transactions from an account
+func (s *FireflySearch) SourceAccount(id string) *FireflySearch {
+	return s.id(SearchSourceAccountID, id)
+}
+
+// DestinationAccount selects transactions to an account
+func (s *FireflySearch) DestinationAccount(id string) *FireflySearch {
+	return s.id(SearchDestinationAccountID, id)
+}
+
+// Amount selects transactions of exactly amount. Firefly compares absolute amounts.
+func (s *FireflySearch) Amount(amount float64) *FireflySearch {
+	return s.amount(SearchAmountIs, amount)
+}
+
+// AmountMore selects transactions of more than amount
+func (s *FireflySearch) AmountMore(amount float64) *FireflySearch {
+	return s.amount(SearchAmountMore, amount)
+}
+
+// AmountLess selects transactions of less than amount
+func (s *FireflySearch) AmountLess(amount float64) *FireflySearch {
+	return s.amount(SearchAmountLess, amount)
+}
+
+// AmountBetween selects transactions of more than min and less than max
+func (s *FireflySearch) AmountBetween(min, max float64) *FireflySearch {
+	return s.AmountMore(min).AmountLess(max)
+}
+
+// On selects transactions booked on the day of t
+func (s *FireflySearch) On(t time.Time) *FireflySearch {
+	return s.date(SearchDateOn, t)
+}
+
+// Between selects transactions booked between from and to (inclusive). Zero
+// times are open ends.
+func (s *FireflySearch) Between(from, to time.Time) *FireflySearch {
+	if !from.IsZero() {
+		s.date(SearchDateAfter, from)
+	}
+	if !to.IsZero() {
+		s.date(SearchDateBefore, to)
+	}
+	return s
+}
+
+// UpdatedAfter selects transactions changed after the day of t
+func (s *FireflySearch) UpdatedAfter(t time.Time) *FireflySearch {
+	return s.date(SearchUpdatedAtAfter, t)
+}
+
+// Description selects transactions whose description is text
+func (s *FireflySearch) Description(text string) *FireflySearch {
+	return s.text(SearchDescriptionIs, text)
+}
+
+// DescriptionContains selects transactions whose description contains text
+func (s *FireflySearch) DescriptionContains(text string) *FireflySearch {
+	return s.text(SearchDescriptionContains, text)
+}
+
+// DescriptionStarts selects transactions whose description starts with text
+func (s *FireflySearch) DescriptionStarts(text string) *FireflySearch {
+	return s.text(SearchDescriptionStarts, text)
+}
+
+// DescriptionEnds selects transactions whose description ends with text
+func (s *FireflySearch) DescriptionEnds(text string) *FireflySearch {
+	return s.text(SearchDescriptionEnds, text)
+}
+
+// ExternalID selects the transactions with an external ID
+func (s *FireflySearch) ExternalID(id string) *FireflySearch {
+	return s.text(SearchExternalIDIs, id)
+}
+
+// Type selects transactions of a type, e.g. FireflyTypeWithdrawal
+func (s *FireflySearch) Type(transactionType string) *FireflySearch {
+	switch transactionType {
+	case FireflyTypeWithdrawal, FireflyTypeDeposit, FireflyTypeTransfer:
+		return s.add(SearchType, transactionType)
+	}
+	s.problems.Add(string(SearchType), validation.RuleOneOf, fmt.Sprintf("unknown transaction type %q", transactionType))
+	return s
+}
+
+// IsEmpty reports whether the search matches every transaction
+func (s *FireflySearch) IsEmpty() bool {
+	return len(s.Terms) == 0 && s.problems.Valid()
+}
+
+// Validate checks the arguments of the builder and that the amount and date
+// ranges do not end before they start. Failures are reported per operator.
+func (s *FireflySearch) Validate() error {
+	v := validation.New()
+	v.Include("", s.problems.Err())
+
+	values := make(map[FireflySearchOperator]string)
+	for _, term := range s.Terms {
+		values[term.Operator] = term.Value
+	}
+	if more, less := values[SearchAmountMore], values[SearchAmountLess]; more != "" && less != "" {
+		min, _ := strconv.ParseFloat(more, 64)
+		max, _ := strconv.ParseFloat(less, 64)
+		if min >= max {
+			v.Add(string(SearchAmountLess), validation.RuleRange, fmt.Sprintf("amount range %s to %s is empty", more, less))
+		}
+	}
+	// Dates are formatted as YYYY-MM-DD, so they compare as strings
+	if after, before := values[SearchDateAfter], values[SearchDateBefore]; after != "" && before != "" && before < after {
+		v.Add(string(SearchDateBefore), validation.RuleRange, fmt.Sprintf("date range ends on %s before it starts on %s", before, after))
+	}
+	return v.Err()
+}
+
+// String returns the search in Firefly's query syntax
+func (s *FireflySearch) String() string {
+	terms := make([]string, len(s.Terms))
+	for i, term := range s.Terms {
+		terms[i] = string(term.Operator) + ":" + term.Value
+	}
+	return strings.Join(terms, " ")
+}
+
+func (s *FireflySearch) add(operator FireflySearchOperator, value string) *FireflySearch {
+	s.Terms = append(s.Terms, FireflySearchTerm{Operator: operator, Value: value})
+	return s
+}
+
+func (s *FireflySearch) text(operator FireflySearchOperator, value string) *FireflySearch {
+	if strings.TrimSpace(value) == "" {
+		s.problems.Add(string(operator), validation.RuleRequired, "needs a value")
+		return s
+	}
+	return s.add(operator, quoteSearchValue(value))
+}
+
+func (s *FireflySearch) id(operator FireflySearchOperator, id string) *FireflySearch {
+	if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+		s.problems.Add(string(operator), validation.RuleFormat, fmt.Sprintf("needs a numeric Firefly ID, got %q", id))
+		return s
+	}
+	return s.add(operator, id)
+}
+
+func (s *FireflySearch) amount(operator FireflySearchOperator, amount float64) *FireflySearch {
+	if amount < 0 || math.IsNaN(amount) || math.IsInf(amount, 0) {
+		s.problems.Add(string(operator), validation.RuleMin, fmt.Sprintf("needs a finite amount of zero or more, got %v", amount))
+		return s
+	}
+	return s.add(operator, strconv.FormatFloat(amount, 'f', -1, 64))
+}
+
+func (s *FireflySearch) date(operator FireflySearchOperator, t time.Time) *FireflySearch {
+	if t.IsZero() {
+		s.problems.Add(string(operator), validation.RuleRequired, "needs a date")
+		return s
+	}
+	return s.add(operator, t.Format(time.DateOnly))
+}
+
+// quoteSearchValue quotes a value so spaces and operators in it are searched
+// literally
+func quoteSearchValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
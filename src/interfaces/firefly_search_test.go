@@ -0,0 +1,63 @@
+package interfaces
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFireflySearch_String(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 23, 0, 0, 0, time.UTC)
+
+	search := NewFireflySearch().
+		Tag("coffee").
+		Account("12").
+		AmountBetween(2.5, 20).
+		Between(from, to).
+		DescriptionContains(`Joe's "Beans"`).
+		Type(FireflyTypeWithdrawal)
+
+	if err := search.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	want := `tag_is:"coffee" account_id:12 amount_more:2.5 amount_less:20 date_after:2024-01-01 date_before:2024-03-31 description_contains:"Joe's \"Beans\"" type:withdrawal`
+	if got := search.String(); got != want {
+		t.Errorf("String() = %s, want %s", got, want)
+	}
+
+	if got := NewFireflySearch().Between(time.Time{}, to).NoCategory().String(); got != "date_before:2024-03-31 has_no_category:true" {
+		t.Errorf("open range String() = %s", got)
+	}
+}
+
+func TestFireflySearch_Validate(t *testing.T) {
+	day := time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		search *FireflySearch
+	}{
+		{"empty tag", NewFireflySearch().Tag(" ")},
+		{"account name", NewFireflySearch().Account("Checking")},
+		{"negative amount", NewFireflySearch().AmountLess(-1)},
+		{"NaN amount", NewFireflySearch().Amount(math.NaN())},
+		{"empty amount range", NewFireflySearch().AmountBetween(10, 10)},
+		{"inverted date range", NewFireflySearch().Between(day, day.AddDate(0, 0, -1))},
+		{"zero date", NewFireflySearch().On(time.Time{})},
+		{"unknown type", NewFireflySearch().Type("refund")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.search.Validate(); err == nil {
+				t.Errorf("Validate() = nil, want an error for %s", tt.search)
+			}
+		})
+	}
+
+	// A single day range is valid
+	if err := NewFireflySearch().Between(day, day).Validate(); err != nil {
+		t.Errorf("Validate() of a single day = %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+// Package chaos injects faults into outbound HTTP calls, NATS publishes and
+// sync workers, to verify that retries, the outbox and worker restarts
+// actually recover. Faults are only injected by binaries built with the chaos
+// build tag; other builds refuse to create an injector.
+package chaos
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// ErrUnavailable is returned when the binary was built without the chaos tag
+	ErrUnavailable = errors.New("fault injection is not built in; rebuild with -tags chaos")
+
+	// ErrInjected is the cause of every injected failure
+	ErrInjected = errors.New("chaos: injected fault")
+)
+
+// Scenario describes which faults are injected and how often. Rates are
+// probabilities between 0 and 1; the zero scenario injects nothing.
+type Scenario struct {
+	HTTPFailureRate float64       `json:"httpFailureRate"`      // outbound HTTP calls failing
+	HTTPStatus      int           `json:"httpStatus,omitempty"` // status of failed calls; 0 fails the connection instead
+	HTTPDelayRate   float64       `json:"httpDelayRate"`        // outbound HTTP calls delayed by HTTPDelay
+	HTTPDelay       time.Duration `json:"httpDelay"`
+	Hosts           []string      `json:"hosts,omitempty"` // hosts HTTP faults apply to; every host when empty
+	NATSDropRate    float64       `json:"natsDropRate"`    // event publishes failing
+	WorkerKillRate  float64       `json:"workerKillRate"`  // sync jobs whose worker panics
+}
+
+// Validate checks that the rates are probabilities
+func (s Scenario) Validate() error {
+	rates := map[string]float64{
+		"http_failure_rate": s.HTTPFailureRate,
+		"http_delay_rate":   s.HTTPDelayRate,
+		"nats_drop_rate":    s.NATSDropRate,
+		"worker_kill_rate":  s.WorkerKillRate,
+	}
+	for name, rate := range rates {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("chaos.%s must be between 0 and 1", name)
+		}
+	}
+	if s.HTTPDelay < 0 {
+		return fmt.Errorf("chaos.http_delay must not be negative")
+	}
+	if s.HTTPStatus != 0 && (s.HTTPStatus < 400 || s.HTTPStatus > 599) {
+		return fmt.Errorf("chaos.http_status must be an error status")
+	}
+	return nil
+}
+
+// Stats counts the injected faults
+type Stats struct {
+	HTTPFailed  int64 `json:"httpFailed"`
+	HTTPDelayed int64 `json:"httpDelayed"`
+	NATSDropped int64 `json:"natsDropped"`
+	WorkersKill int64 `json:"workersKilled"`
+}
+
+// Injector decides which calls fail. It is safe for concurrent use and its
+// scenario can be changed while running.
+type Injector struct {
+	mu       sync.Mutex
+	scenario Scenario
+	rand     *rand.Rand
+
+	httpFailed  atomic.Int64
+	httpDelayed atomic.Int64
+	natsDropped atomic.Int64
+	workersKill atomic.Int64
+}
+
+// New creates an injector running scenario. A zero seed picks a random one;
+// the same seed injects the same sequence of faults.
+func New(scenario Scenario, seed uint64) (*Injector, error) {
+	if !Enabled {
+		return nil, ErrUnavailable
+	}
+	return newInjector(scenario, seed)
+}
+
+func newInjector(scenario Scenario, seed uint64) (*Injector, error) {
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+	return &Injector{scenario: scenario, rand: rand.New(rand.NewPCG(seed, seed))}, nil
+}
+
+// Scenario returns the running scenario
+func (i *Injector) Scenario() Scenario {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.scenario
+}
+
+// Set replaces the running scenario
+func (i *Injector) Set(scenario Scenario) error {
+	if err := scenario.Validate(); err != nil {
+		return err
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.scenario = scenario
+	return nil
+}
+
+// Stats returns the faults injected so far
+func (i *Injector) Stats() Stats {
+	return Stats{
+		HTTPFailed:  i.httpFailed.Load(),
+		HTTPDelayed: i.httpDelayed.Load(),
+		NATSDropped: i.natsDropped.Load(),
+		WorkersKill: i.workersKill.Load(),
+	}
+}
+
+// roll reports whether a fault with the rate picked from the scenario happens
+func (i *Injector) roll(rate func(Scenario) float64) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	r := rate(i.scenario)
+	return r > 0 && i.rand.Float64() < r
+}
+
+// targets reports whether HTTP faults apply to host
+func (i *Injector) targets(host string) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return len(i.scenario.Hosts) == 0 || slices.ContainsFunc(i.scenario.Hosts, func(h string) bool {
+		return strings.EqualFold(h, host)
+	})
+}
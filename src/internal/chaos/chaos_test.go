@@ -0,0 +1,127 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+// stubPublisher counts the events that reached it
+type stubPublisher struct {
+	published int
+}
+
+func (p *stubPublisher) Publish(ctx context.Context, event *interfaces.Event) error {
+	p.published++
+	return nil
+}
+
+func (p *stubPublisher) Close() {}
+
+func TestScenarioValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		scenario Scenario
+		wantErr  bool
+	}{
+		{"zero", Scenario{}, false},
+		{"rates", Scenario{HTTPFailureRate: 0.5, NATSDropRate: 1, WorkerKillRate: 0.1}, false},
+		{"rate above one", Scenario{HTTPDelayRate: 1.5}, true},
+		{"negative delay", Scenario{HTTPDelay: -time.Second}, true},
+		{"success status", Scenario{HTTPStatus: 200}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.scenario.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewRequiresBuildTag(t *testing.T) {
+	_, err := New(Scenario{}, 1)
+	if Enabled != (err == nil) {
+		t.Errorf("New() error = %v with Enabled = %v", err, Enabled)
+	}
+}
+
+func TestTransportInjectsFaults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	injector, err := newInjector(Scenario{HTTPFailureRate: 1}, 1)
+	if err != nil {
+		t.Fatalf("newInjector() error = %v", err)
+	}
+	client := &http.Client{Transport: injector.Transport(http.DefaultTransport)}
+
+	if _, err := client.Get(server.URL); !errors.Is(err, ErrInjected) {
+		t.Errorf("Get() error = %v, want an injected fault", err)
+	}
+
+	injector.Set(Scenario{HTTPFailureRate: 1, HTTPStatus: http.StatusServiceUnavailable})
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", resp.StatusCode)
+	}
+
+	injector.Set(Scenario{HTTPFailureRate: 1, Hosts: []string{"firefly.example"}})
+	resp, err = client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() of an untargeted host error = %v", err)
+	}
+	resp.Body.Close()
+
+	if stats := injector.Stats(); stats.HTTPFailed != 2 {
+		t.Errorf("HTTPFailed = %d, want 2", stats.HTTPFailed)
+	}
+}
+
+func TestPublisherDropsEvents(t *testing.T) {
+	injector, _ := newInjector(Scenario{NATSDropRate: 1}, 1)
+	stub := &stubPublisher{}
+	publisher := injector.Publisher(stub)
+
+	event := interfaces.NewEvent(interfaces.EventTypeTransactionCreated, "test")
+	if err := publisher.Publish(context.Background(), event); !errors.Is(err, ErrInjected) {
+		t.Errorf("Publish() error = %v, want an injected fault", err)
+	}
+
+	injector.Set(Scenario{})
+	if err := publisher.Publish(context.Background(), event); err != nil {
+		t.Errorf("Publish() without faults error = %v", err)
+	}
+	if stub.published != 1 || injector.Stats().NATSDropped != 1 {
+		t.Errorf("published %d, dropped %d; want 1 and 1", stub.published, injector.Stats().NATSDropped)
+	}
+}
+
+func TestJobKillsWorkerAfterRunning(t *testing.T) {
+	injector, _ := newInjector(Scenario{WorkerKillRate: 1}, 1)
+
+	ran := false
+	job := injector.Job("solana", func() { ran = true })
+
+	defer func() {
+		if recover() == nil {
+			t.Error("killed job did not panic")
+		}
+		if !ran {
+			t.Error("killed job did not run before its worker was killed")
+		}
+	}()
+	job()
+}
@@ -0,0 +1,95 @@
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/events"
+)
+
+// Transport wraps base so outbound calls are delayed or failed per the scenario
+func (i *Injector) Transport(base http.RoundTripper) http.RoundTripper {
+	return &transport{injector: i, base: base}
+}
+
+type transport struct {
+	injector *Injector
+	base     http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	i := t.injector
+	if !i.targets(req.URL.Hostname()) {
+		return t.base.RoundTrip(req)
+	}
+
+	if i.roll(func(s Scenario) float64 { return s.HTTPDelayRate }) {
+		i.httpDelayed.Add(1)
+		select {
+		case <-time.After(i.Scenario().HTTPDelay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	if i.roll(func(s Scenario) float64 { return s.HTTPFailureRate }) {
+		i.httpFailed.Add(1)
+		status := i.Scenario().HTTPStatus
+		if status == 0 {
+			return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Host, ErrInjected)
+		}
+		body := fmt.Sprintf(`{"message":%q}`, ErrInjected.Error())
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+			StatusCode:    status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {"application/json"}},
+			Body:          io.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// Publisher wraps publisher so publishes are dropped per the scenario. A
+// dropped event is reported as a failed publish, as NATS would.
+func (i *Injector) Publisher(publisher events.Publisher) events.Publisher {
+	return &faultyPublisher{injector: i, Publisher: publisher}
+}
+
+type faultyPublisher struct {
+	injector *Injector
+	events.Publisher
+}
+
+func (p *faultyPublisher) Publish(ctx context.Context, event *interfaces.Event) error {
+	if p.injector.roll(func(s Scenario) float64 { return s.NATSDropRate }) {
+		p.injector.natsDropped.Add(1)
+		return fmt.Errorf("publish %s: %w", event.Type, ErrInjected)
+	}
+	return p.Publisher.Publish(ctx, event)
+}
+
+// Job wraps a worker pool job so its worker panics per the scenario, as if it
+// crashed. The panic is raised once the job has run: callers wait for their
+// jobs from inside them, so a job that never ran would block them forever.
+func (i *Injector) Job(provider string, job func()) func() {
+	return func() {
+		defer func() {
+			if i.roll(func(s Scenario) float64 { return s.WorkerKillRate }) {
+				i.workersKill.Add(1)
+				panic(fmt.Sprintf("worker of %s killed: %v", provider, ErrInjected))
+			}
+		}()
+		job()
+	}
+}
@@ -0,0 +1,130 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/nats-io/nats.go"
+)
+
+type fakeClient struct {
+	transactions []models.Transaction
+	err          error
+}
+
+func (f *fakeClient) GetBalance(account string) (models.BalanceInfo, error) {
+	return models.BalanceInfo{}, nil
+}
+
+func (f *fakeClient) FetchTransactions(account string) ([]models.Transaction, error) {
+	return f.transactions, f.err
+}
+
+func TestRegistryRoutesToLiveWorkers(t *testing.T) {
+	registry := NewRegistry()
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	registry.Observe(Heartbeat{Node: "b", Sources: []string{"enable:1"}, Interval: 10 * time.Second}, start)
+	registry.Observe(Heartbeat{Node: "a", Sources: []string{"enable:1", "solana:x"}, Interval: 10 * time.Second}, start)
+
+	if node, ok := registry.Node("enable:1", start.Add(time.Second)); !ok || node != "a" {
+		t.Errorf("Node(enable:1) = %q, %v, want a", node, ok)
+	}
+	if _, ok := registry.Node("ethereum:y", start); ok {
+		t.Error("Node() routed a source no worker serves")
+	}
+
+	registry.Forget("a")
+	if node, ok := registry.Node("enable:1", start.Add(time.Second)); !ok || node != "b" {
+		t.Errorf("Node(enable:1) after forgetting a = %q, %v, want b", node, ok)
+	}
+
+	// Three missed heartbeats and the worker is presumed gone
+	if _, ok := registry.Node("enable:1", start.Add(30*time.Second)); ok {
+		t.Error("Node() routed to a worker that missed its heartbeats")
+	}
+	if nodes := registry.Nodes(start.Add(time.Minute)); len(nodes) != 1 || nodes[0].Live {
+		t.Errorf("Nodes() = %+v, want b no longer live", nodes)
+	}
+}
+
+func TestWorkerHandle(t *testing.T) {
+	worker := newWorker(DefaultSubject, "node-1", time.Second, []usecases.Source{
+		{
+			Account: usecases.AccountRef{Source: "enable", Account: "1"},
+			Client:  &fakeClient{transactions: []models.Transaction{{ID: "tx-1", Amount: 12.5}}},
+		},
+		{
+			Account: usecases.AccountRef{Source: "enable", Account: "2"},
+			Client:  &fakeClient{err: &interfaces.ClientError{Type: interfaces.ErrorTypeRateLimit, Message: "throttled", RetryAfter: time.Minute}},
+		},
+	})
+
+	decode := func(request string) FetchReply {
+		var reply FetchReply
+		if err := json.Unmarshal(worker.Handle([]byte(request)), &reply); err != nil {
+			t.Fatalf("reply is not JSON: %v", err)
+		}
+		return reply
+	}
+
+	reply := decode(`{"source":"enable:1"}`)
+	if !reply.OK || len(reply.Transactions) != 1 || reply.Transactions[0].Amount != 12.5 {
+		t.Errorf("fetch reply = %+v", reply)
+	}
+
+	reply = decode(`{"source":"enable:2"}`)
+	err := reply.Err()
+	if !interfaces.IsRetryable(err) || interfaces.RetryAfter(err) != time.Minute {
+		t.Errorf("failed fetch error = %v, want a retryable rate limit", err)
+	}
+
+	if reply = decode(`{"source":"enable:3"}`); reply.OK || !reply.Unserved {
+		t.Errorf("unserved reply = %+v", reply)
+	}
+	if heartbeat := worker.Heartbeat(); heartbeat.Node != "node-1" || len(heartbeat.Sources) != 2 || heartbeat.Sources[0] != "enable:1" {
+		t.Errorf("Heartbeat() = %+v", heartbeat)
+	}
+}
+
+func TestCoordinatorFailsOver(t *testing.T) {
+	worker := newWorker(DefaultSubject, "node-1", time.Second, []usecases.Source{{
+		Account: usecases.AccountRef{Source: "enable", Account: "1"},
+		Client:  &fakeClient{transactions: []models.Transaction{{ID: "tx-1"}}},
+	}})
+
+	answering := true
+	coordinator := newCoordinator(DefaultSubject, time.Second, func(ctx context.Context, subject string, data []byte) ([]byte, error) {
+		if subject != fetchSubject(DefaultSubject, "node-1") {
+			t.Errorf("request subject = %q", subject)
+		}
+		if !answering {
+			return nil, nats.ErrNoResponders
+		}
+		return worker.Handle(data), nil
+	})
+
+	if _, _, err := coordinator.FetchRemote(context.Background(), "enable:1"); !errors.Is(err, models.ErrWorkerUnavailable) {
+		t.Errorf("FetchRemote() without workers error = %v, want ErrWorkerUnavailable", err)
+	}
+
+	heartbeat, _ := json.Marshal(worker.Heartbeat())
+	coordinator.Observe(heartbeat)
+	transactions, _, err := coordinator.FetchRemote(context.Background(), "enable:1")
+	if err != nil || len(transactions) != 1 || transactions[0].ID != "tx-1" {
+		t.Fatalf("FetchRemote() = %+v, %v", transactions, err)
+	}
+
+	answering = false
+	if _, _, err := coordinator.FetchRemote(context.Background(), "enable:1"); !errors.Is(err, models.ErrWorkerUnavailable) {
+		t.Errorf("FetchRemote() of a gone worker error = %v, want ErrWorkerUnavailable", err)
+	}
+	if nodes := coordinator.Nodes(); len(nodes) != 0 {
+		t.Errorf("Nodes() = %+v, want the gone worker forgotten", nodes)
+	}
+}
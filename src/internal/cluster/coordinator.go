@@ -0,0 +1,138 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/nats-io/nats.go"
+)
+
+// requester sends a request and waits for its reply
+type requester func(ctx context.Context, subject string, data []byte) ([]byte, error)
+
+// Coordinator sends the fetches of the sources served by live workers to
+// them. It satisfies usecases.RemoteFetcher.
+type Coordinator struct {
+	conn     *nats.Conn // nil when not connected, e.g. in tests
+	sub      *nats.Subscription
+	subject  string
+	timeout  time.Duration
+	registry *Registry
+	request  requester
+	now      func() time.Time
+}
+
+// NewCoordinator connects to NATS and listens to the heartbeats of the workers
+func NewCoordinator(cfg internal.NATSConfig) (*Coordinator, error) {
+	conn, err := nats.Connect(cfg.URL, nats.Name(cfg.ClientName("-coordinator")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	c := newCoordinator(subject(cfg), cfg.FetchTimeout, func(ctx context.Context, subject string, data []byte) ([]byte, error) {
+		msg, err := conn.RequestWithContext(ctx, subject, data)
+		if err != nil {
+			return nil, err
+		}
+		return msg.Data, nil
+	})
+	c.conn = conn
+
+	heartbeats := heartbeatSubject(c.subject)
+	c.sub, err = conn.Subscribe(heartbeats, func(msg *nats.Msg) { c.Observe(msg.Data) })
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", heartbeats, err)
+	}
+	return c, nil
+}
+
+func newCoordinator(subject string, timeout time.Duration, request requester) *Coordinator {
+	return &Coordinator{
+		subject:  subject,
+		timeout:  timeout,
+		registry: NewRegistry(),
+		request:  request,
+		now:      time.Now,
+	}
+}
+
+// Observe records an encoded heartbeat
+func (c *Coordinator) Observe(data []byte) {
+	var heartbeat Heartbeat
+	if err := json.Unmarshal(data, &heartbeat); err != nil || heartbeat.Node == "" || heartbeat.Interval <= 0 {
+		logger := internal.GetLogger().With().Str("component", "cluster").Logger()
+		logger.Warn().Err(err).Msg("Ignoring malformed heartbeat")
+		return
+	}
+	c.registry.Observe(heartbeat, c.now())
+}
+
+// Nodes returns the workers heard from, by name
+func (c *Coordinator) Nodes() []NodeStatus {
+	return c.registry.Nodes(c.now())
+}
+
+// FetchRemote fetches the transactions of a source on the live worker
+// serving it. It returns models.ErrWorkerUnavailable when no worker serves the
+// source or the worker does not answer in time; a worker that does not answer
+// is forgotten until its next heartbeat, so the following fetches fail over
+// at once. Errors of the fetch itself keep their client error type.
+func (c *Coordinator) FetchRemote(ctx context.Context, sourceID string) ([]models.Transaction, models.TokenFilterStats, error) {
+	var none models.TokenFilterStats
+	node, ok := c.registry.Node(sourceID, c.now())
+	if !ok {
+		return nil, none, fmt.Errorf("source %q: %w", sourceID, models.ErrWorkerUnavailable)
+	}
+	logger := internal.LoggerFrom(ctx).With().Str("component", "cluster").Str("node", node).Str("sourceID", sourceID).Logger()
+
+	data, err := json.Marshal(FetchRequest{Source: sourceID})
+	if err != nil {
+		return nil, none, fmt.Errorf("failed to encode fetch request: %w", err)
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	data, err = c.request(requestCtx, fetchSubject(c.subject, node), data)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, none, ctx.Err()
+		}
+		c.registry.Forget(node)
+		logger.Warn().Err(err).Msg("Worker did not answer, failing over")
+		return nil, none, fmt.Errorf("worker %s: %v: %w", node, err, models.ErrWorkerUnavailable)
+	}
+
+	var reply FetchReply
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return nil, none, fmt.Errorf("worker %s answered with a malformed reply: %w", node, err)
+	}
+	if reply.Unserved {
+		c.registry.Forget(node)
+		logger.Warn().Msg("Worker no longer serves the source, failing over")
+		return nil, none, fmt.Errorf("worker %s: %s: %w", node, reply.Error, models.ErrWorkerUnavailable)
+	}
+	if err := reply.Err(); err != nil {
+		return nil, none, err
+	}
+	return reply.Transactions, reply.Filtered, nil
+}
+
+// Close stops listening to heartbeats and closes the connection
+func (c *Coordinator) Close() {
+	if c.sub != nil {
+		if err := c.sub.Unsubscribe(); err != nil {
+			logger := internal.GetLogger()
+			logger.Warn().Err(err).Msg("Failed to unsubscribe from heartbeats")
+		}
+	}
+	if c.conn != nil {
+		if err := c.conn.Drain(); err != nil {
+			c.conn.Close()
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Package cluster lets import sources be fetched on worker nodes, e.g. a
+// machine closer to a rate-limited API. Workers announce the sources they
+// serve with heartbeats on NATS. The coordinator on the main node sends the
+// fetch of a served source to a live worker as a NATS request and imports the
+// fetched transactions itself, so workers need no database. When no worker
+// serves a source, or its worker disappears, the coordinator fetches locally.
+package cluster
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+// DefaultSubject is the subject the heartbeats and fetch requests are sent below
+const DefaultSubject = "firedragon.cluster"
+
+// missedHeartbeats is how many heartbeats a worker may miss before it is presumed gone
+const missedHeartbeats = 3
+
+// ErrUnknownSource is returned by a worker asked to fetch a source it does not serve
+var ErrUnknownSource = errors.New("source is not served by this worker")
+
+// heartbeatSubject is where workers announce themselves
+func heartbeatSubject(subject string) string {
+	return subject + ".heartbeat"
+}
+
+// fetchSubject is where a worker answers fetch requests
+func fetchSubject(subject, node string) string {
+	return subject + ".fetch." + node
+}
+
+// Heartbeat announces a live worker and the sources it serves
+type Heartbeat struct {
+	Node     string        `json:"node"`
+	Sources  []string      `json:"sources"`
+	Interval time.Duration `json:"interval"` // time until the next heartbeat
+}
+
+// FetchRequest asks a worker for the transactions of a source
+type FetchRequest struct {
+	Source string `json:"source"`
+}
+
+// FetchReply answers a fetch request. A failed fetch keeps the type of the
+// client error, so the coordinator retries and backs off as it would locally.
+type FetchReply struct {
+	OK           bool                    `json:"ok"`
+	Error        string                  `json:"error,omitempty"`
+	ErrorType    interfaces.ErrorType    `json:"errorType,omitempty"`
+	RetryAfter   time.Duration           `json:"retryAfter,omitempty"`
+	Unserved     bool                    `json:"unserved,omitempty"` // the worker does not serve the source
+	Transactions []models.Transaction    `json:"transactions,omitempty"`
+	Filtered     models.TokenFilterStats `json:"filtered"`
+}
+
+// newFetchReply encodes the outcome of a fetch
+func newFetchReply(transactions []models.Transaction, filtered models.TokenFilterStats, err error) FetchReply {
+	if err != nil {
+		return FetchReply{
+			Error:      err.Error(),
+			ErrorType:  interfaces.ErrorTypeOf(err),
+			RetryAfter: interfaces.RetryAfter(err),
+		}
+	}
+	return FetchReply{OK: true, Transactions: transactions, Filtered: filtered}
+}
+
+// Err returns the error of a failed fetch as a client error, or nil
+func (r FetchReply) Err() error {
+	if r.OK {
+		return nil
+	}
+	return &interfaces.ClientError{Type: r.ErrorType, Message: r.Error, RetryAfter: r.RetryAfter}
+}
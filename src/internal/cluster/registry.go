@@ -0,0 +1,95 @@
+package cluster
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// NodeStatus is what the coordinator knows of a worker
+type NodeStatus struct {
+	Node     string    `json:"node"`
+	Sources  []string  `json:"sources"`
+	LastSeen time.Time `json:"lastSeen"`
+	Live     bool      `json:"live"`
+}
+
+// worker is a node the registry heard from
+type worker struct {
+	sources  map[string]bool
+	list     []string
+	lastSeen time.Time
+	until    time.Time // presumed gone after this unless it sends another heartbeat
+}
+
+// Registry tracks the workers from their heartbeats and routes each source to
+// a live worker serving it
+type Registry struct {
+	mu      sync.Mutex
+	workers map[string]*worker
+}
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{workers: make(map[string]*worker)}
+}
+
+// Observe records a heartbeat received at the given time
+func (r *Registry) Observe(heartbeat Heartbeat, at time.Time) {
+	sources := make(map[string]bool, len(heartbeat.Sources))
+	for _, source := range heartbeat.Sources {
+		sources[source] = true
+	}
+	list := append([]string(nil), heartbeat.Sources...)
+	sort.Strings(list)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.workers[heartbeat.Node] = &worker{
+		sources:  sources,
+		list:     list,
+		lastSeen: at,
+		until:    at.Add(missedHeartbeats * heartbeat.Interval),
+	}
+}
+
+// Node returns the live worker that fetches a source, the first by name when
+// several serve it
+func (r *Registry) Node(source string, now time.Time) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	node := ""
+	for name, w := range r.workers {
+		if w.sources[source] && now.Before(w.until) && (node == "" || name < node) {
+			node = name
+		}
+	}
+	return node, node != ""
+}
+
+// Forget drops a worker that stopped answering, so its sources fail over
+// until it sends another heartbeat
+func (r *Registry) Forget(node string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.workers, node)
+}
+
+// Nodes returns the workers heard from, by name
+func (r *Registry) Nodes(now time.Time) []NodeStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	nodes := make([]NodeStatus, 0, len(r.workers))
+	for name, w := range r.workers {
+		nodes = append(nodes, NodeStatus{
+			Node:     name,
+			Sources:  w.list,
+			LastSeen: w.lastSeen,
+			Live:     now.Before(w.until),
+		})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Node < nodes[j].Node })
+	return nodes
+}
@@ -0,0 +1,176 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+)
+
+// Worker fetches the transactions of the sources it serves for the coordinator
+type Worker struct {
+	conn     *nats.Conn // nil when not connected, e.g. in tests
+	sub      *nats.Subscription
+	subject  string
+	node     string
+	interval time.Duration
+	sources  map[string]usecases.Source
+}
+
+// NewWorker connects to NATS and answers the fetch requests of the given
+// sources under the node name of the instance
+func NewWorker(cfg internal.NATSConfig, sources []usecases.Source) (*Worker, error) {
+	if cfg.HeartbeatInterval <= 0 {
+		return nil, fmt.Errorf("nats.heartbeat_interval must be positive for a worker")
+	}
+	w := newWorker(subject(cfg), NodeName(cfg.InstanceID), cfg.HeartbeatInterval, sources)
+
+	conn, err := nats.Connect(cfg.URL, nats.Name(cfg.ClientName("-worker")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+	w.conn = conn
+
+	requests := fetchSubject(w.subject, w.node)
+	// Every request is served on its own goroutine, so a slow provider does not hold up the others
+	w.sub, err = conn.Subscribe(requests, func(msg *nats.Msg) { go w.serve(msg) })
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", requests, err)
+	}
+	return w, nil
+}
+
+func newWorker(subject, node string, interval time.Duration, sources []usecases.Source) *Worker {
+	byID := make(map[string]usecases.Source, len(sources))
+	for _, source := range sources {
+		byID[source.ID()] = source
+	}
+	return &Worker{subject: subject, node: node, interval: interval, sources: byID}
+}
+
+// subject returns the cluster subject in the namespace of the install
+func subject(cfg internal.NATSConfig) string {
+	subject := cfg.ClusterSubject
+	if subject == "" {
+		subject = DefaultSubject
+	}
+	return cfg.Subject(subject)
+}
+
+// NodeName returns the configured instance ID or one derived from the
+// hostname, made a single subject token so fetch requests can address it
+func NodeName(configured string) string {
+	name := configured
+	if name == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			hostname = internal.DefaultAppName
+		}
+		name = hostname + "-" + uuid.NewString()[:8]
+	}
+	return strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_").Replace(name)
+}
+
+// Node returns the name the worker announces itself with
+func (w *Worker) Node() string {
+	return w.node
+}
+
+// Heartbeat returns the heartbeat announcing the worker
+func (w *Worker) Heartbeat() Heartbeat {
+	sources := make([]string, 0, len(w.sources))
+	for id := range w.sources {
+		sources = append(sources, id)
+	}
+	sort.Strings(sources)
+	return Heartbeat{Node: w.node, Sources: sources, Interval: w.interval}
+}
+
+// Run sends heartbeats until ctx is done
+func (w *Worker) Run(ctx context.Context) {
+	w.beat()
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.beat()
+		}
+	}
+}
+
+func (w *Worker) beat() {
+	data, err := json.Marshal(w.Heartbeat())
+	if err == nil {
+		err = w.conn.Publish(heartbeatSubject(w.subject), data)
+	}
+	if err != nil {
+		logger := internal.GetLogger().With().Str("component", "cluster").Str("node", w.node).Logger()
+		logger.Warn().Err(err).Msg("Failed to send heartbeat")
+	}
+}
+
+// Handle decodes a fetch request, fetches the source and returns the encoded reply
+func (w *Worker) Handle(data []byte) []byte {
+	var request FetchRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return encodeFetchReply(newFetchReply(nil, models.TokenFilterStats{}, fmt.Errorf("invalid fetch request: %w", err)))
+	}
+
+	source, ok := w.sources[request.Source]
+	if !ok {
+		reply := newFetchReply(nil, models.TokenFilterStats{}, fmt.Errorf("source %q: %w", request.Source, ErrUnknownSource))
+		reply.Unserved = true
+		return encodeFetchReply(reply)
+	}
+
+	transactions, filtered, err := source.FetchTransactions()
+	return encodeFetchReply(newFetchReply(transactions, filtered, err))
+}
+
+func (w *Worker) serve(msg *nats.Msg) {
+	reply := w.Handle(msg.Data)
+	if msg.Reply == "" {
+		return
+	}
+	if err := msg.Respond(reply); err != nil {
+		logger := internal.GetLogger().With().Str("component", "cluster").Str("node", w.node).Logger()
+		logger.Warn().Err(err).Msg("Failed to reply to fetch request")
+	}
+}
+
+func encodeFetchReply(reply FetchReply) []byte {
+	data, err := json.Marshal(reply)
+	if err != nil {
+		data, _ = json.Marshal(FetchReply{Error: fmt.Sprintf("failed to encode reply: %v", err)})
+	}
+	return data
+}
+
+// Close stops answering fetch requests and closes the connection
+func (w *Worker) Close() {
+	if w.sub != nil {
+		if err := w.sub.Unsubscribe(); err != nil {
+			logger := internal.GetLogger()
+			logger.Warn().Err(err).Msg("Failed to unsubscribe from fetch requests")
+		}
+	}
+	if w.conn != nil {
+		if err := w.conn.Drain(); err != nil {
+			w.conn.Close()
+		}
+	}
+}
@@ -8,38 +8,154 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ZanzyTHEbar/firedragon-go/domain/address"
+	"github.com/pocketbase/pocketbase/tools/cron"
 	"github.com/spf13/viper"
 )
 
 // Config represents the application configuration
 type Config struct {
-	Firefly   FireflyConfig   `mapstructure:"firefly"`
-	Ethereum  EthereumConfig  `mapstructure:"ethereum"`
-	Solana    SolanaConfig    `mapstructure:"solana"`
-	Sui       SuiConfig       `mapstructure:"sui"`
-	Banking   BankingConfig   `mapstructure:"banking"`
-	Database  DatabaseConfig  `mapstructure:"database"`
-	Service   ServiceConfig   `mapstructure:"service"`
+	Firefly        FireflyConfig        `mapstructure:"firefly"`
+	Ethereum       EthereumConfig       `mapstructure:"ethereum"`
+	Solana         SolanaConfig         `mapstructure:"solana"`
+	Sui            SuiConfig            `mapstructure:"sui"`
+	Banking        BankingConfig        `mapstructure:"banking"`
+	Database       DatabaseConfig       `mapstructure:"database"`
+	Service        ServiceConfig        `mapstructure:"service"`
+	FX             FXConfig             `mapstructure:"fx"`
+	NATS           NATSConfig           `mapstructure:"nats"`
+	Duplicates     DuplicatesConfig     `mapstructure:"duplicates"`
+	Descriptions   DescriptionsConfig   `mapstructure:"descriptions"`
+	Currencies     CurrenciesConfig     `mapstructure:"currencies"`
+	Timezones      TimezonesConfig      `mapstructure:"timezones"`
+	Periods        PeriodsConfig        `mapstructure:"periods"`
+	Secrets        SecretsConfig        `mapstructure:"secrets"`
+	Categorization CategorizationConfig `mapstructure:"categorization"`
+	Categories     CategoriesConfig     `mapstructure:"categories"`
+	Preferences    PreferencesConfig    `mapstructure:"preferences"`
+	Spaces         SpacesConfig         `mapstructure:"spaces"`
+	Audit          AuditConfig          `mapstructure:"audit"`
+	Archive        ArchiveConfig        `mapstructure:"archive"`
+	Ledger         LedgerConfig         `mapstructure:"ledger"`
+	Webhooks       WebhooksConfig       `mapstructure:"webhooks"`
+	State          StateConfig          `mapstructure:"state"`
+	Encryption     EncryptionConfig     `mapstructure:"encryption"`
+	Chaos          ChaosConfig          `mapstructure:"chaos"`
+	HTTP           HTTPConfig           `mapstructure:"http"`
 }
 
 // FireflyConfig contains Firefly III API configuration
 type FireflyConfig struct {
-	URL   string `mapstructure:"url"`
-	Token string `mapstructure:"token"`
+	URL                string                  `mapstructure:"url"`
+	Token              string                  `mapstructure:"token"` // personal access token, unused when OAuth is configured
+	OAuth              FireflyOAuthConfig      `mapstructure:"oauth"`
+	AutoCreateAccounts bool                    `mapstructure:"auto_create_accounts"` // create missing asset accounts during import
+	AccountMappings    []FireflyAccountMapping `mapstructure:"account_mappings"`
+	PullSchedule       string                  `mapstructure:"pull_schedule"` // cron schedule pulling edits made in Firefly, empty disables
+	Outbox             FireflyOutboxConfig     `mapstructure:"outbox"`
+	Cache              FireflyCacheConfig      `mapstructure:"cache"`
+}
+
+// FireflyCacheConfig contains the cache of Firefly accounts, currencies and categories
+type FireflyCacheConfig struct {
+	TTL     time.Duration `mapstructure:"ttl"`     // zero disables the cache
+	Persist bool          `mapstructure:"persist"` // keep the cache in the secrets store across restarts
+}
+
+// FireflyOutboxConfig contains the queue that delivers imported transactions to
+// Firefly III, retrying while Firefly is unreachable
+type FireflyOutboxConfig struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	Schedule string `mapstructure:"schedule"` // cron schedule draining the queue
+	Batch    int    `mapstructure:"batch"`    // transactions delivered per drain
+}
+
+// FireflyOAuthConfig contains the Firefly III OAuth2 client used instead of a personal access token.
+// Tokens obtained through the authorization code flow are kept in the secrets store.
+type FireflyOAuthConfig struct {
+	ClientID     string `mapstructure:"client_id"` // empty disables OAuth
+	ClientSecret string `mapstructure:"client_secret"`
+	RedirectURL  string `mapstructure:"redirect_url"` // must point at /api/firedragon/firefly/oauth/callback
+}
+
+// FireflyAccountMapping maps a source account to a Firefly asset account
+type FireflyAccountMapping struct {
+	Source           string `mapstructure:"source"`  // ethereum, solana, enable, ...
+	Account          string `mapstructure:"account"` // wallet address or bank account ID
+	FireflyAccountID string `mapstructure:"firefly_account_id"`
+	IBAN             string `mapstructure:"iban"` // match an existing Firefly account by IBAN instead of ID
 }
 
 // EthereumConfig contains Ethereum configuration
 type EthereumConfig struct {
-	APIKey      string   `mapstructure:"api_key"`
+	APIKey      string   `mapstructure:"api_key"` // default explorer key for networks without their own
 	Addresses   []string `mapstructure:"addresses"`
-	NetworkType string   `mapstructure:"network_type"` // mainnet, testnet, etc.
+	NetworkType string   `mapstructure:"network_type"` // mainnet, testnet, etc.; used when no networks are listed
+	Sandbox     bool     `mapstructure:"sandbox"`      // import test data from sepolia unless networks are listed
+
+	// Wallets groups addresses imported into one wallet each
+	Wallets []AddressWalletConfig `mapstructure:"wallets"`
+
+	// Networks lists the EVM networks to import from, keyed by name (ethereum, arbitrum,
+	// optimism, base, sepolia or a custom name with an explorer URL)
+	Networks map[string]EthereumNetworkConfig `mapstructure:"networks"`
+	// AddressNetworks restricts an address to some of the networks; unlisted addresses use all of them
+	AddressNetworks map[string][]string `mapstructure:"address_networks"`
+
+	TokenFilter         TokenFilterConfig            `mapstructure:"token_filter"`          // applied to every address
+	AddressTokenFilters map[string]TokenFilterConfig `mapstructure:"address_token_filters"` // replaces token_filter for an address
+	FeeMode             string                       `mapstructure:"fee_mode"`              // separate or field
+}
+
+// EthereumNetworkConfig contains the Etherscan-compatible explorer API of one network.
+// Empty fields fall back to the built-in defaults for known networks.
+type EthereumNetworkConfig struct {
+	ExplorerURL    string `mapstructure:"explorer_url"`
+	ChainID        int64  `mapstructure:"chain_id"` // sent as chainid for multichain explorer APIs
+	APIKey         string `mapstructure:"api_key"`
+	NativeCurrency string `mapstructure:"native_currency"`
+	WebSocketURL   string `mapstructure:"ws_url"` // node websocket for streaming; empty disables streaming
 }
 
 // SolanaConfig contains Solana configuration
 type SolanaConfig struct {
 	RPCEndpoint string   `mapstructure:"rpc_endpoint"`
+	WSEndpoint  string   `mapstructure:"ws_endpoint"` // defaults to rpc_endpoint over ws(s)
 	Addresses   []string `mapstructure:"addresses"`
 	NetworkType string   `mapstructure:"network_type"` // mainnet, testnet, etc.
+	Sandbox     bool     `mapstructure:"sandbox"`      // import test data from devnet
+
+	// Wallets groups addresses imported into one wallet each
+	Wallets []AddressWalletConfig `mapstructure:"wallets"`
+
+	TokenFilter         TokenFilterConfig            `mapstructure:"token_filter"`          // applied to every address
+	AddressTokenFilters map[string]TokenFilterConfig `mapstructure:"address_token_filters"` // replaces token_filter for an address
+	FeeMode             string                       `mapstructure:"fee_mode"`              // separate or field
+}
+
+// AddressWalletConfig is a wallet spanning several addresses of one chain,
+// e.g. fresh receive addresses and those of an old seed. Their transactions
+// are merged into the one wallet.
+type AddressWalletConfig struct {
+	Name      string   `mapstructure:"name"`      // defaults to the chain and the first address
+	Addresses []string `mapstructure:"addresses"` // the first identifies the wallet
+}
+
+// AddressCount returns the number of addresses configured on their own or in wallets
+func AddressCount(addresses []string, wallets []AddressWalletConfig) int {
+	count := len(addresses)
+	for _, wallet := range wallets {
+		count += len(wallet.Addresses)
+	}
+	return count
+}
+
+// TokenFilterConfig selects which ERC-20 / SPL token transfers are imported.
+// Native coin transfers are never filtered.
+type TokenFilterConfig struct {
+	Allow       []string `mapstructure:"allow"`         // token symbols or contract/mint addresses; empty allows all
+	Deny        []string `mapstructure:"deny"`          // regular expressions matched against symbol and address
+	ScamListURL string   `mapstructure:"scam_list_url"` // list of token addresses to ignore, one per line or a JSON array
 }
 
 // SuiConfig contains SUI configuration
@@ -56,10 +172,320 @@ type BankingConfig struct {
 
 // EnableBankingConfig contains Enable Banking API configuration
 type EnableBankingConfig struct {
-	ClientID     string `mapstructure:"client_id"`
-	ClientSecret string `mapstructure:"client_secret"`
-	RedirectURI  string `mapstructure:"redirect_uri"`
+	ClientID     string   `mapstructure:"client_id"`     // application ID
+	ClientSecret string   `mapstructure:"client_secret"` // PEM private key of the application, signs the API requests
+	RedirectURI  string   `mapstructure:"redirect_uri"`
+	ASPSP        string   `mapstructure:"aspsp"`      // name of the bank, as Enable Banking lists it
+	Country      string   `mapstructure:"country"`    // country of the bank, e.g. FI
+	SessionID    string   `mapstructure:"session_id"` // session the account IDs belong to
 	AccountIDs   []string `mapstructure:"account_ids"`
+	BalanceTypes []string `mapstructure:"balance_types"` // balance types driving reconciliation, most preferred first
+	APIURL       string   `mapstructure:"api_url"`       // defaults to the production or sandbox API
+	Sandbox      bool     `mapstructure:"sandbox"`       // import test data from the sandbox API
+
+	// ConsentRenewBefore is how long before a consent expires the user is
+	// sent its renewal link. The bank redirects to redirect_uri afterwards,
+	// which must point at /api/firedragon/sources/consent/callback.
+	ConsentRenewBefore time.Duration `mapstructure:"consent_renew_before"`
+}
+
+// FXConfig contains exchange-rate provider configuration
+type FXConfig struct {
+	Providers           []string      `mapstructure:"providers"` // fallback order: manual, ecb, exchangerate_host
+	ExchangeRateHostKey string        `mapstructure:"exchangerate_host_key"`
+	CacheTTL            time.Duration `mapstructure:"cache_ttl"`
+	Overrides           []FXOverride  `mapstructure:"overrides"`
+}
+
+// FXOverride is a manually configured exchange rate
+type FXOverride struct {
+	From string  `mapstructure:"from"`
+	To   string  `mapstructure:"to"`
+	Date string  `mapstructure:"date"` // YYYY-MM-DD, empty applies to all dates
+	Rate float64 `mapstructure:"rate"`
+}
+
+// NATSConfig contains NATS JetStream configuration for domain event publishing
+type NATSConfig struct {
+	URL            string        `mapstructure:"url"`             // empty disables event publishing
+	Namespace      string        `mapstructure:"namespace"`       // prefixes every subject, stream and bucket so installs can share a cluster, e.g. acme.prod
+	Stream         string        `mapstructure:"stream"`          // JetStream stream holding domain events
+	SubjectPrefix  string        `mapstructure:"subject_prefix"`  // events are published on <prefix>.<event type>
+	PublishTimeout time.Duration `mapstructure:"publish_timeout"` // per-event publish timeout
+	ControlSubject string        `mapstructure:"control_subject"` // remote control commands are requests on this subject
+
+	// Limits of the event stream; zero keeps the limits the stream has, e.g. set with the streams command
+	StreamMaxAge   time.Duration `mapstructure:"stream_max_age"`   // events older than this are removed
+	StreamMaxBytes int64         `mapstructure:"stream_max_bytes"` // the oldest events are removed above this size
+
+	// Alerts are raised when a stream grows past the lower of these thresholds
+	StorageAlertPercent float64 `mapstructure:"storage_alert_percent"` // of the byte limit of the stream
+	StorageAlertBytes   int64   `mapstructure:"storage_alert_bytes"`   // zero for no absolute threshold
+
+	// Events of record changes are stored in an outbox and relayed to the stream
+	OutboxInterval  time.Duration `mapstructure:"outbox_interval"`  // retry interval while publishing fails
+	OutboxRetention time.Duration `mapstructure:"outbox_retention"` // published events are purged after this
+
+	// Payloads at or above the threshold are compressed; the encoding travels in the Content-Encoding header
+	Compression          string `mapstructure:"compression"`           // none, gzip or zstd
+	CompressionThreshold int    `mapstructure:"compression_threshold"` // payload size in bytes
+
+	// Event consumers remember the messages they handled so redeliveries are skipped
+	DedupeBucket string        `mapstructure:"dedupe_bucket"` // JetStream key-value bucket holding the claims
+	DedupeTTL    time.Duration `mapstructure:"dedupe_ttl"`    // claims expire after this; exceed the redelivery window
+
+	// Leader election lets replicas share one NATS server while only the leader runs scheduled imports
+	LeaderElection bool          `mapstructure:"leader_election"`
+	LeaderBucket   string        `mapstructure:"leader_bucket"` // JetStream key-value bucket holding the lease
+	StateBucket    string        `mapstructure:"state_bucket"`  // JetStream key-value bucket holding the sync state with state.backend nats
+	LeaderTTL      time.Duration `mapstructure:"leader_ttl"`    // a leader that stops renewing loses the lease after this
+	InstanceID     string        `mapstructure:"instance_id"`   // identifies this replica in the lease and as a worker node, defaults to the hostname
+
+	// Worker nodes fetch the sources they serve for the coordinator, e.g. on a machine closer to a rate-limited API
+	Coordinator       bool          `mapstructure:"coordinator"`        // send the fetches of sources served by a live worker to the worker
+	ClusterSubject    string        `mapstructure:"cluster_subject"`    // heartbeats and fetch requests are sent below this subject
+	WorkerSources     []string      `mapstructure:"worker_sources"`     // IDs of the sources the worker command serves, all configured ones when empty
+	HeartbeatInterval time.Duration `mapstructure:"heartbeat_interval"` // a worker missing three heartbeats is presumed gone
+	FetchTimeout      time.Duration `mapstructure:"fetch_timeout"`      // a worker not answering in time is failed over
+}
+
+// DuplicatesConfig contains the duplicate detection policy and per-source overrides
+type DuplicatesConfig struct {
+	DuplicatePolicyConfig `mapstructure:",squash"`
+	Sources               map[string]DuplicatePolicyConfig `mapstructure:"sources"` // keyed by import source
+}
+
+// MaxWindow returns the longest duplicate window, global or of a source
+func (c DuplicatesConfig) MaxWindow() time.Duration {
+	window := c.Window
+	for _, policy := range c.Sources {
+		window = max(window, policy.Window)
+	}
+	return window
+}
+
+// DuplicatePolicyConfig describes how potential duplicates are handled.
+// Zero values in a per-source override inherit the global setting.
+type DuplicatePolicyConfig struct {
+	Window    time.Duration `mapstructure:"window"`
+	Tolerance float64       `mapstructure:"tolerance"`
+	Action    string        `mapstructure:"action"` // block, flag or allow
+}
+
+// DescriptionsConfig contains the Go templates that describe imported
+// transactions. Templates see the fields Amount, Asset, Counterparty, Chain,
+// Memo, Reference, Type, Source, Description and Date; an empty template keeps the
+// description generated by the source client.
+type DescriptionsConfig struct {
+	Template string            `mapstructure:"template"` // applies to every source without an override
+	Sources  map[string]string `mapstructure:"sources"`  // keyed by import source
+}
+
+// CurrenciesConfig adds currencies to the registry of decimal places amounts
+// are formatted and parsed with, e.g. the tokens a wallet holds
+type CurrenciesConfig struct {
+	Decimals map[string]int `mapstructure:"decimals"` // decimal places keyed by currency code, 0-18
+}
+
+// TimezonesConfig sets the timezones import sources date their transactions
+// in. Banks and budgeting apps report calendar dates without a zone, which
+// are read as midnight in the timezone of the source, and duplicates of
+// their transactions are looked for across whole days there.
+type TimezonesConfig struct {
+	Default string                 `mapstructure:"default"` // IANA name, e.g. Europe/Berlin; UTC when empty
+	Sources []SourceTimezoneConfig `mapstructure:"sources"`
+}
+
+// SourceTimezoneConfig overrides the timezone of a source, or of one of its accounts
+type SourceTimezoneConfig struct {
+	Source   string `mapstructure:"source"`   // enable, camt, or budget for YNAB and Actual exports
+	Account  string `mapstructure:"account"`  // bank account ID, every account of the source when empty
+	Timezone string `mapstructure:"timezone"` // IANA name
+}
+
+// For returns the name of the timezone of a source account: that of the
+// account, else that of the source, else the default
+func (c TimezonesConfig) For(source, account string) string {
+	timezone := c.Default
+	for _, override := range c.Sources {
+		if override.Source != source {
+			continue
+		}
+		if override.Account == account {
+			return override.Timezone
+		}
+		if override.Account == "" {
+			timezone = override.Timezone
+		}
+	}
+	return timezone
+}
+
+// PeriodsConfig defines the periods reports and budgets are grouped by
+type PeriodsConfig struct {
+	FiscalYearStart int `mapstructure:"fiscal_year_start"` // month the fiscal year starts in, 1-12
+	PayPeriodStart  int `mapstructure:"pay_period_start"`  // day of the month pay periods start on, e.g. 25 for the 25th to the 24th
+	WeekStart       int `mapstructure:"week_start"`        // day weeks start on, 0 for Sunday to 6 for Saturday
+}
+
+// CategorizationConfig enables the classifier suggesting categories for
+// imported transactions the source did not categorize. It is trained on the
+// categorized transactions when the server starts and learns from every review.
+type CategorizationConfig struct {
+	Enabled       bool    `mapstructure:"enabled"`
+	MinConfidence float64 `mapstructure:"min_confidence"` // suggestions below this are dropped
+	AutoApply     float64 `mapstructure:"auto_apply"`     // suggestions at or above this skip the review queue
+}
+
+// CategoriesConfig selects the category pack installed as system categories
+// on first run and the locale naming them. Categories are only ever added, so
+// existing ones keep their names when the locale changes.
+type CategoriesConfig struct {
+	Bootstrap bool   `mapstructure:"bootstrap"`
+	Pack      string `mapstructure:"pack"`   // standard or minimal
+	Locale    string `mapstructure:"locale"` // e.g. en, de or fr; regional variants fall back to the language
+}
+
+// PreferencesConfig sets the preferences of users who have not stored their
+// own. The base currency is service.base_currency and the first day of the
+// week periods.week_start.
+type PreferencesConfig struct {
+	DateFormat    string                    `mapstructure:"date_format"` // YYYY-MM-DD, DD.MM.YYYY, DD/MM/YYYY or MM/DD/YYYY
+	Notifications NotificationDefaultConfig `mapstructure:"notifications"`
+}
+
+// NotificationDefaultConfig selects the notifications users receive by default
+type NotificationDefaultConfig struct {
+	Incidents         bool    `mapstructure:"incidents"`
+	Subscriptions     bool    `mapstructure:"subscriptions"`
+	BalanceDrift      bool    `mapstructure:"balance_drift"`
+	Consent           bool    `mapstructure:"consent"`
+	LargeTransactions float64 `mapstructure:"large_transactions"` // absolute amount at or above which new transactions are announced, zero for none
+}
+
+// SpacesConfig assigns the wallets of import sources to spaces, so shared
+// accounts land in a household space while personal ones stay private
+type SpacesConfig struct {
+	Sources []SpaceSourceConfig `mapstructure:"sources"`
+}
+
+// SpaceSourceConfig moves the wallet of a source account into a space when
+// it is created, or on the next sync if it is in no space yet
+type SpaceSourceConfig struct {
+	Source  string `mapstructure:"source"`  // ethereum, solana, sui, enable
+	Account string `mapstructure:"account"` // wallet address or bank account ID
+	Space   string `mapstructure:"space"`   // ID of the space
+}
+
+// AuditConfig controls the audit log of changes to domain records and of
+// mutating API requests
+type AuditConfig struct {
+	Enabled   bool          `mapstructure:"enabled"`
+	Retention time.Duration `mapstructure:"retention"` // age at which entries are purged, zero keeps them forever
+}
+
+// State store backends
+const (
+	StateBackendPocketBase = "pocketbase" // the collections of the database
+	StateBackendNATS       = "nats"       // a JetStream key-value bucket shared by every replica
+)
+
+// StateConfig selects the store of the sync state of the import sources: their
+// last import times and lifecycle, and the cursors of their backfills. While
+// moving to another backend, MigrateFrom keeps writing the previous one, and
+// reads fall back to it for the sources the new backend does not have yet,
+// until the state migrate command has copied them.
+type StateConfig struct {
+	Backend     string `mapstructure:"backend"`      // pocketbase or nats
+	MigrateFrom string `mapstructure:"migrate_from"` // previous backend, written alongside during a migration
+}
+
+// ArchiveConfig controls the archive of the raw payloads providers return
+// during imports, kept to debug and replay the normalization. Secrets are
+// redacted before payloads are stored.
+type ArchiveConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	Compress        bool          `mapstructure:"compress"`          // gzip the payloads
+	Retention       time.Duration `mapstructure:"retention"`         // age at which payloads are purged, zero keeps them forever
+	MaxPayloadBytes int           `mapstructure:"max_payload_bytes"` // larger payloads are not archived, zero for no limit
+}
+
+// LedgerConfig controls the pruning of the import ledger: the soft-deleted
+// imported transactions kept so a provider cannot import them again. Past
+// keep_months their records are purged, and with compact their provider IDs
+// are kept as bloom filter summaries per wallet and month.
+type LedgerConfig struct {
+	KeepMonths int    `mapstructure:"keep_months"` // months deleted transactions are kept, at least the duplicate windows
+	Compact    bool   `mapstructure:"compact"`     // keep the provider IDs of purged transactions as summaries
+	Schedule   string `mapstructure:"schedule"`    // cron schedule of the pruning
+}
+
+// WebhooksConfig controls the delivery of events to the webhooks superusers
+// register in the webhooks collection
+type WebhooksConfig struct {
+	Enabled     bool          `mapstructure:"enabled"`
+	MaxAttempts int           `mapstructure:"max_attempts"` // failed attempts before a delivery is given up, zero retries forever
+	Timeout     time.Duration `mapstructure:"timeout"`      // of one attempt
+	Interval    time.Duration `mapstructure:"interval"`     // how often due retries are sent
+	Retention   time.Duration `mapstructure:"retention"`    // age at which delivered deliveries are purged, zero keeps them forever
+}
+
+// EncryptionConfig enables encryption at rest of the sensitive transaction
+// fields (description, notes and counterparty). Each space gets a data key,
+// and data keys are stored wrapped with the master key.
+type EncryptionConfig struct {
+	Enabled   bool   `mapstructure:"enabled"`
+	MasterKey string `mapstructure:"master_key"` // 32 byte AES-256 key wrapping the data keys
+}
+
+// ChaosConfig injects faults for resilience testing. It only takes effect in
+// binaries built with the chaos build tag. Rates are probabilities between 0 and 1.
+type ChaosConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	Seed            uint64        `mapstructure:"seed"` // zero picks a random seed
+	HTTPFailureRate float64       `mapstructure:"http_failure_rate"`
+	HTTPStatus      int           `mapstructure:"http_status"` // status of failed calls; zero fails the connection
+	HTTPDelayRate   float64       `mapstructure:"http_delay_rate"`
+	HTTPDelay       time.Duration `mapstructure:"http_delay"`
+	Hosts           []string      `mapstructure:"hosts"` // hosts HTTP faults apply to; every host when empty
+	NATSDropRate    float64       `mapstructure:"nats_drop_rate"`
+	WorkerKillRate  float64       `mapstructure:"worker_kill_rate"`
+}
+
+// HTTPConfig tunes the pooled connections of the clients calling external
+// services. Providers (firefly, fx, ethereum, solana) may override the proxy,
+// timeout and connection limits; overridden providers get their own pool.
+type HTTPConfig struct {
+	Timeout               time.Duration                 `mapstructure:"timeout"` // whole request, including the body
+	DialTimeout           time.Duration                 `mapstructure:"dial_timeout"`
+	KeepAlive             time.Duration                 `mapstructure:"keep_alive"`
+	TLSHandshakeTimeout   time.Duration                 `mapstructure:"tls_handshake_timeout"`
+	ResponseHeaderTimeout time.Duration                 `mapstructure:"response_header_timeout"`
+	IdleConnTimeout       time.Duration                 `mapstructure:"idle_conn_timeout"`
+	MaxIdleConns          int                           `mapstructure:"max_idle_conns"`
+	MaxIdleConnsPerHost   int                           `mapstructure:"max_idle_conns_per_host"`
+	MaxConnsPerHost       int                           `mapstructure:"max_conns_per_host"` // zero is unlimited
+	HTTP2                 bool                          `mapstructure:"http2"`
+	Proxy                 string                        `mapstructure:"proxy"` // empty uses HTTP(S)_PROXY, "direct" none
+	Providers             map[string]HTTPProviderConfig `mapstructure:"providers"`
+}
+
+// HTTPProviderConfig overrides the HTTP settings of one provider
+type HTTPProviderConfig struct {
+	Proxy               string        `mapstructure:"proxy"`
+	Timeout             time.Duration `mapstructure:"timeout"`
+	MaxConnsPerHost     int           `mapstructure:"max_conns_per_host"`
+	MaxIdleConnsPerHost int           `mapstructure:"max_idle_conns_per_host"`
+}
+
+// ChangesTransport reports whether the override needs a connection pool of its own
+func (c HTTPProviderConfig) ChangesTransport() bool {
+	return c.Proxy != "" || c.MaxConnsPerHost > 0 || c.MaxIdleConnsPerHost > 0
+}
+
+// SecretsConfig contains the secrets store configuration
+type SecretsConfig struct {
+	Key string `mapstructure:"key"` // 32 byte AES-256 key encrypting stored secrets
 }
 
 // DatabaseConfig contains database configuration
@@ -71,12 +497,37 @@ type DatabaseConfig struct {
 
 // ServiceConfig contains service-level configuration
 type ServiceConfig struct {
-	UpdateInterval      time.Duration `mapstructure:"update_interval"`
-	MaxRetries         int           `mapstructure:"max_retries"`
-	RetryDelay         time.Duration `mapstructure:"retry_delay"`
-	LogLevel           string        `mapstructure:"log_level"`
-	MetricsEnabled     bool          `mapstructure:"metrics_enabled"`
-	MetricsInterval    time.Duration `mapstructure:"metrics_interval"`
+	UpdateInterval    time.Duration `mapstructure:"update_interval"`
+	MaxRetries        int           `mapstructure:"max_retries"`
+	RetryDelay        time.Duration `mapstructure:"retry_delay"`
+	LogLevel          string        `mapstructure:"log_level"`
+	MetricsEnabled    bool          `mapstructure:"metrics_enabled"`
+	MetricsInterval   time.Duration `mapstructure:"metrics_interval"`
+	BaseCurrency      string        `mapstructure:"base_currency"`       // currency used for net worth and valuations
+	RuleTimeout       time.Duration `mapstructure:"rule_timeout"`        // evaluation timeout for a single transformation rule
+	SourceTestTimeout time.Duration `mapstructure:"source_test_timeout"` // overall timeout of a source self-test
+	StreamingEnabled  bool          `mapstructure:"streaming_enabled"`   // sync sources on live blockchain activity
+	CostBasisMethod   string        `mapstructure:"cost_basis_method"`   // fifo or average, used for capital gains
+	IncidentThreshold int           `mapstructure:"incident_threshold"`  // consecutive provider failures that open an incident
+
+	// Sync worker pool: at most SyncWorkers sources sync at once, and at most
+	// ProviderConcurrency (or its ProviderLimits override) per provider
+	SyncWorkers         int            `mapstructure:"sync_workers"`
+	ProviderConcurrency int            `mapstructure:"provider_concurrency"`
+	ProviderLimits      map[string]int `mapstructure:"provider_limits"` // keyed by source, e.g. ethereum
+
+	// Balance updates: on BalanceSchedule (cron, empty disables) the balances
+	// of every source are snapshotted and compared with their Firefly asset
+	// accounts; differences above BalanceTolerance are reported as drift
+	BalanceSchedule  string  `mapstructure:"balance_schedule"`
+	BalanceTolerance float64 `mapstructure:"balance_tolerance"`
+
+	// SubscriptionSchedule is the cron schedule detecting recurring charges, empty disables
+	SubscriptionSchedule string `mapstructure:"subscription_schedule"`
+
+	// StartupChecks verifies the configured dependencies before serving, each
+	// within SourceTestTimeout; fatal failures stop the server
+	StartupChecks bool `mapstructure:"startup_checks"`
 }
 
 // LoadConfig loads the application configuration from file and environment
@@ -132,6 +583,21 @@ func LoadConfig(configPath string) (*Config, error) {
 	return &config, nil
 }
 
+// DefaultConfig returns a configuration populated only with default values.
+// It is used when no configuration file is available.
+func DefaultConfig() *Config {
+	v := viper.New()
+	setDefaults(v)
+
+	var config Config
+	if err := v.Unmarshal(&config); err != nil {
+		// Defaults are static, so this only happens on a programming error
+		panic(fmt.Sprintf("failed to unmarshal default config: %v", err))
+	}
+
+	return &config
+}
+
 // setDefaults sets default configuration values
 func setDefaults(v *viper.Viper) {
 	v.SetDefault("service.update_interval", "15m")
@@ -140,6 +606,83 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("service.log_level", "info")
 	v.SetDefault("service.metrics_enabled", true)
 	v.SetDefault("service.metrics_interval", "1m")
+	v.SetDefault("service.base_currency", "USD")
+	v.SetDefault("service.rule_timeout", "250ms")
+	v.SetDefault("service.source_test_timeout", "15s")
+	v.SetDefault("service.cost_basis_method", "fifo")
+	v.SetDefault("service.incident_threshold", 3)
+	v.SetDefault("service.sync_workers", 4)
+	v.SetDefault("service.provider_concurrency", 2)
+	v.SetDefault("service.balance_schedule", "*/30 * * * *")
+	v.SetDefault("service.balance_tolerance", 0.01)
+	v.SetDefault("service.subscription_schedule", "0 6 * * *")
+	v.SetDefault("service.startup_checks", true)
+	v.SetDefault("periods.fiscal_year_start", 1)
+	v.SetDefault("periods.pay_period_start", 1)
+	v.SetDefault("periods.week_start", 1)
+	v.SetDefault("firefly.pull_schedule", "*/15 * * * *")
+	v.SetDefault("firefly.outbox.enabled", true)
+	v.SetDefault("firefly.outbox.schedule", "* * * * *")
+	v.SetDefault("firefly.outbox.batch", 100)
+	v.SetDefault("firefly.cache.ttl", "15m")
+	v.SetDefault("fx.providers", []string{"manual", "ecb", "exchangerate_host"})
+	v.SetDefault("fx.cache_ttl", "6h")
+	v.SetDefault("nats.stream", "FIREDRAGON_EVENTS")
+	v.SetDefault("nats.subject_prefix", "firedragon.events")
+	v.SetDefault("nats.publish_timeout", "5s")
+	v.SetDefault("nats.control_subject", "firedragon.control")
+	v.SetDefault("nats.storage_alert_percent", 80)
+	v.SetDefault("nats.compression", "none")
+	v.SetDefault("nats.compression_threshold", 4096)
+	v.SetDefault("nats.outbox_interval", "5s")
+	v.SetDefault("nats.outbox_retention", "24h")
+	v.SetDefault("nats.dedupe_bucket", "FIREDRAGON_DEDUPE")
+	v.SetDefault("nats.dedupe_ttl", "24h")
+	v.SetDefault("nats.leader_bucket", "FIREDRAGON_LEADER")
+	v.SetDefault("nats.leader_ttl", "15s")
+	v.SetDefault("nats.state_bucket", "FIREDRAGON_STATE")
+	v.SetDefault("state.backend", StateBackendPocketBase)
+	v.SetDefault("nats.cluster_subject", "firedragon.cluster")
+	v.SetDefault("nats.heartbeat_interval", "10s")
+	v.SetDefault("nats.fetch_timeout", "2m")
+	v.SetDefault("ethereum.fee_mode", "separate")
+	v.SetDefault("solana.fee_mode", "separate")
+	v.SetDefault("banking.enable.consent_renew_before", "168h")
+	v.SetDefault("duplicates.window", "24h")
+	v.SetDefault("duplicates.tolerance", 0.01)
+	v.SetDefault("duplicates.action", "block")
+	v.SetDefault("categorization.min_confidence", 0.3)
+	v.SetDefault("categorization.auto_apply", 0.9)
+	v.SetDefault("categories.bootstrap", true)
+	v.SetDefault("categories.pack", "standard")
+	v.SetDefault("categories.locale", "en")
+	v.SetDefault("preferences.date_format", "YYYY-MM-DD")
+	v.SetDefault("preferences.notifications.incidents", true)
+	v.SetDefault("preferences.notifications.subscriptions", true)
+	v.SetDefault("preferences.notifications.balance_drift", true)
+	v.SetDefault("preferences.notifications.consent", true)
+	v.SetDefault("audit.enabled", true)
+	v.SetDefault("audit.retention", "8760h")
+	v.SetDefault("archive.compress", true)
+	v.SetDefault("archive.retention", "720h")
+	v.SetDefault("archive.max_payload_bytes", 4*1024*1024)
+	v.SetDefault("ledger.keep_months", 1)
+	v.SetDefault("ledger.compact", true)
+	v.SetDefault("ledger.schedule", "0 3 * * *")
+	v.SetDefault("webhooks.enabled", true)
+	v.SetDefault("webhooks.max_attempts", 12)
+	v.SetDefault("webhooks.timeout", "10s")
+	v.SetDefault("webhooks.interval", "30s")
+	v.SetDefault("webhooks.retention", "720h")
+	v.SetDefault("http.timeout", "30s")
+	v.SetDefault("http.dial_timeout", "10s")
+	v.SetDefault("http.keep_alive", "30s")
+	v.SetDefault("http.tls_handshake_timeout", "10s")
+	v.SetDefault("http.response_header_timeout", "30s")
+	v.SetDefault("http.idle_conn_timeout", "90s")
+	v.SetDefault("http.max_idle_conns", 100)
+	v.SetDefault("http.max_idle_conns_per_host", 16)
+	v.SetDefault("http.http2", true)
 	v.SetDefault("database.type", "sqlite")
 	v.SetDefault("database.filename", "firedragon.db")
 }
@@ -149,11 +692,31 @@ func bindEnvVariables(v *viper.Viper) {
 	// Firefly III
 	v.BindEnv("firefly.url", "FIREFLY_URL")
 	v.BindEnv("firefly.token", "FIREFLY_TOKEN")
+	v.BindEnv("firefly.oauth.client_id", "FIREFLY_CLIENT_ID")
+	v.BindEnv("firefly.oauth.client_secret", "FIREFLY_CLIENT_SECRET")
+	v.BindEnv("firefly.oauth.redirect_url", "FIREFLY_REDIRECT_URL")
+
+	// Secrets store
+	v.BindEnv("secrets.key", "FIREDRAGON_SECRETS_KEY")
+	v.BindEnv("encryption.master_key", "FIREDRAGON_MASTER_KEY")
 
 	// Ethereum
 	v.BindEnv("ethereum.api_key", "ETHERSCAN_API_KEY")
 	v.BindEnv("ethereum.network_type", "ETH_NETWORK")
 
+	// Exchange rates
+	v.BindEnv("fx.exchangerate_host_key", "EXCHANGERATE_HOST_KEY")
+
+	// NATS
+	v.BindEnv("nats.url", "NATS_URL")
+	v.BindEnv("nats.namespace", "FIREDRAGON_NATS_NAMESPACE")
+
+	// Categories
+	v.BindEnv("categories.locale", "FIREDRAGON_LOCALE")
+
+	// Outbound HTTP
+	v.BindEnv("http.proxy", "FIREDRAGON_HTTP_PROXY")
+
 	// Enable Banking
 	v.BindEnv("banking.enable.client_id", "ENABLE_CLIENT_ID")
 	v.BindEnv("banking.enable.client_secret", "ENABLE_CLIENT_SECRET")
@@ -166,13 +729,273 @@ func validateConfig(config *Config) error {
 	if config.Firefly.URL == "" {
 		return fmt.Errorf("firefly.url is required")
 	}
-	if config.Firefly.Token == "" {
-		return fmt.Errorf("firefly.token is required")
+	if config.Firefly.OAuth.ClientID != "" {
+		if config.Firefly.OAuth.RedirectURL == "" {
+			return fmt.Errorf("firefly.oauth.redirect_url is required when oauth is configured")
+		}
+		if config.Secrets.Key == "" {
+			return fmt.Errorf("secrets.key is required to store firefly oauth tokens")
+		}
+	} else if config.Firefly.Token == "" {
+		return fmt.Errorf("firefly.token or firefly.oauth.client_id is required")
+	}
+
+	// AES-256 needs exactly 32 bytes
+	if config.Secrets.Key != "" && len(config.Secrets.Key) != 32 {
+		return fmt.Errorf("secrets.key must be 32 bytes long")
+	}
+
+	if config.Encryption.Enabled && len(config.Encryption.MasterKey) != 32 {
+		return fmt.Errorf("encryption.master_key must be 32 bytes long when encryption is enabled")
 	}
 
 	// Validate blockchain configuration if addresses are provided
-	if len(config.Ethereum.Addresses) > 0 && config.Ethereum.APIKey == "" {
-		return fmt.Errorf("ethereum.api_key is required when addresses are configured")
+	if AddressCount(config.Ethereum.Addresses, config.Ethereum.Wallets) > 0 {
+		if len(config.Ethereum.Networks) == 0 && config.Ethereum.APIKey == "" {
+			return fmt.Errorf("ethereum.api_key is required when addresses are configured")
+		}
+		for name, network := range config.Ethereum.Networks {
+			if network.APIKey == "" && config.Ethereum.APIKey == "" {
+				return fmt.Errorf("ethereum.networks.%s.api_key or ethereum.api_key is required", name)
+			}
+		}
+		for address, networks := range config.Ethereum.AddressNetworks {
+			for _, name := range networks {
+				if _, ok := config.Ethereum.Networks[name]; !ok {
+					return fmt.Errorf("ethereum.address_networks.%s: unknown network %q", address, name)
+				}
+			}
+		}
+	}
+
+	for chain, chainConfig := range map[string]struct {
+		addresses []string
+		wallets   []AddressWalletConfig
+		sandbox   bool
+	}{
+		"ethereum": {config.Ethereum.Addresses, config.Ethereum.Wallets, config.Ethereum.Sandbox},
+		"solana":   {config.Solana.Addresses, config.Solana.Wallets, config.Solana.Sandbox},
+	} {
+		// An address belongs to one wallet only, or it would be imported twice
+		seen := make(map[string]bool)
+		check := func(field, account string) error {
+			checked := account
+			if chainConfig.sandbox && chain == "ethereum" {
+				// Test addresses are often copied without their EIP-55 checksum
+				checked = strings.ToLower(account)
+			}
+			if err := address.Validate(chain, checked); err != nil {
+				return fmt.Errorf("%s.%s: %w", chain, field, err)
+			}
+			if seen[strings.ToLower(account)] {
+				return fmt.Errorf("%s.%s: address %s is configured more than once", chain, field, account)
+			}
+			seen[strings.ToLower(account)] = true
+			return nil
+		}
+
+		for _, account := range chainConfig.addresses {
+			if err := check("addresses", account); err != nil {
+				return err
+			}
+		}
+		for i, wallet := range chainConfig.wallets {
+			field := fmt.Sprintf("wallets.%d.addresses", i)
+			if len(wallet.Addresses) == 0 {
+				return fmt.Errorf("%s.%s must list at least one address", chain, field)
+			}
+			for _, account := range wallet.Addresses {
+				if err := check(field, account); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for chain, mode := range map[string]string{"ethereum": config.Ethereum.FeeMode, "solana": config.Solana.FeeMode} {
+		if mode != "" && mode != "separate" && mode != "field" {
+			return fmt.Errorf("%s.fee_mode must be separate or field", chain)
+		}
+	}
+
+	if method := config.Service.CostBasisMethod; method != "" && method != "fifo" && method != "average" {
+		return fmt.Errorf("service.cost_basis_method must be fifo or average")
+	}
+
+	if config.Service.IncidentThreshold < 0 {
+		return fmt.Errorf("service.incident_threshold must not be negative")
+	}
+
+	if config.Service.SyncWorkers < 0 || config.Service.ProviderConcurrency < 0 {
+		return fmt.Errorf("service.sync_workers and service.provider_concurrency must not be negative")
+	}
+	for provider, limit := range config.Service.ProviderLimits {
+		if limit <= 0 {
+			return fmt.Errorf("service.provider_limits.%s must be positive", provider)
+		}
+	}
+
+	if config.Service.BalanceTolerance < 0 {
+		return fmt.Errorf("service.balance_tolerance must not be negative")
+	}
+	if schedule := config.Service.BalanceSchedule; schedule != "" {
+		if _, err := cron.NewSchedule(schedule); err != nil {
+			return fmt.Errorf("service.balance_schedule is not a valid cron expression: %w", err)
+		}
+	}
+	if schedule := config.Service.SubscriptionSchedule; schedule != "" {
+		if _, err := cron.NewSchedule(schedule); err != nil {
+			return fmt.Errorf("service.subscription_schedule is not a valid cron expression: %w", err)
+		}
+	}
+	if schedule := config.Firefly.PullSchedule; schedule != "" {
+		if _, err := cron.NewSchedule(schedule); err != nil {
+			return fmt.Errorf("firefly.pull_schedule is not a valid cron expression: %w", err)
+		}
+	}
+	if outbox := config.Firefly.Outbox; outbox.Enabled {
+		if _, err := cron.NewSchedule(outbox.Schedule); err != nil {
+			return fmt.Errorf("firefly.outbox.schedule is not a valid cron expression: %w", err)
+		}
+		if outbox.Batch < 0 {
+			return fmt.Errorf("firefly.outbox.batch must not be negative")
+		}
+	}
+	if config.Firefly.Cache.TTL < 0 {
+		return fmt.Errorf("firefly.cache.ttl must not be negative")
+	}
+
+	if c := config.Categorization; c.MinConfidence < 0 || c.AutoApply > 1 || c.MinConfidence > c.AutoApply {
+		return fmt.Errorf("categorization.min_confidence and categorization.auto_apply must satisfy 0 <= min_confidence <= auto_apply <= 1")
+	}
+
+	for code, decimals := range config.Currencies.Decimals {
+		if decimals < 0 || decimals > 18 {
+			return fmt.Errorf("currencies.decimals.%s must be between 0 and 18", code)
+		}
+	}
+
+	if _, err := time.LoadLocation(config.Timezones.Default); err != nil {
+		return fmt.Errorf("timezones.default: %w", err)
+	}
+	for i, source := range config.Timezones.Sources {
+		if source.Source == "" || source.Timezone == "" {
+			return fmt.Errorf("timezones.sources[%d]: source and timezone are required", i)
+		}
+		if _, err := time.LoadLocation(source.Timezone); err != nil {
+			return fmt.Errorf("timezones.sources[%d]: %w", i, err)
+		}
+	}
+
+	if config.Audit.Retention < 0 {
+		return fmt.Errorf("audit.retention must not be negative")
+	}
+	if config.Archive.Retention < 0 {
+		return fmt.Errorf("archive.retention must not be negative")
+	}
+	if config.Archive.MaxPayloadBytes < 0 {
+		return fmt.Errorf("archive.max_payload_bytes must not be negative")
+	}
+
+	if config.Ledger.KeepMonths < 1 {
+		return fmt.Errorf("ledger.keep_months must be at least 1")
+	}
+	if _, err := cron.NewSchedule(config.Ledger.Schedule); err != nil {
+		return fmt.Errorf("ledger.schedule is not a valid cron expression: %w", err)
+	}
+	// The shortest keep period, that of February, must cover every duplicate window
+	keep := time.Duration(config.Ledger.KeepMonths) * 28 * 24 * time.Hour
+	if config.Duplicates.MaxWindow() > keep {
+		return fmt.Errorf("ledger.keep_months must cover the longest duplicate window, %s", config.Duplicates.MaxWindow())
+	}
+
+	if w := config.Webhooks; w.Enabled && (w.MaxAttempts < 0 || w.Timeout < 0 || w.Interval <= 0 || w.Retention < 0) {
+		return fmt.Errorf("webhooks.interval must be positive and webhooks.max_attempts, webhooks.timeout and webhooks.retention not negative")
+	}
+
+	for key, backend := range map[string]string{"state.backend": config.State.Backend, "state.migrate_from": config.State.MigrateFrom} {
+		switch backend {
+		case StateBackendPocketBase:
+		case StateBackendNATS:
+			if config.NATS.URL == "" {
+				return fmt.Errorf("%s nats requires nats.url", key)
+			}
+		case "":
+			if key == "state.backend" {
+				return fmt.Errorf("state.backend is required")
+			}
+		default:
+			return fmt.Errorf("%s must be pocketbase or nats, got %q", key, backend)
+		}
+	}
+	if config.State.MigrateFrom == config.State.Backend {
+		return fmt.Errorf("state.migrate_from must differ from state.backend")
+	}
+
+	seen := make(map[string]bool)
+	for i, source := range config.Spaces.Sources {
+		if source.Source == "" || source.Account == "" || source.Space == "" {
+			return fmt.Errorf("spaces.sources[%d]: source, account and space are required", i)
+		}
+		key := source.Source + ":" + source.Account
+		if seen[key] {
+			return fmt.Errorf("spaces.sources[%d]: %s is assigned to more than one space", i, key)
+		}
+		seen[key] = true
+	}
+
+	if c := config.Chaos; c.Enabled {
+		for name, rate := range map[string]float64{
+			"http_failure_rate": c.HTTPFailureRate,
+			"http_delay_rate":   c.HTTPDelayRate,
+			"nats_drop_rate":    c.NATSDropRate,
+			"worker_kill_rate":  c.WorkerKillRate,
+		} {
+			if rate < 0 || rate > 1 {
+				return fmt.Errorf("chaos.%s must be between 0 and 1", name)
+			}
+		}
+	}
+
+	if h := config.HTTP; h.Timeout < 0 || h.DialTimeout < 0 || h.KeepAlive < 0 || h.TLSHandshakeTimeout < 0 ||
+		h.ResponseHeaderTimeout < 0 || h.IdleConnTimeout < 0 {
+		return fmt.Errorf("http timeouts must not be negative")
+	}
+	if h := config.HTTP; h.MaxIdleConns < 0 || h.MaxIdleConnsPerHost < 0 || h.MaxConnsPerHost < 0 {
+		return fmt.Errorf("http connection limits must not be negative")
+	}
+	for provider, override := range config.HTTP.Providers {
+		if override.Timeout < 0 || override.MaxConnsPerHost < 0 || override.MaxIdleConnsPerHost < 0 {
+			return fmt.Errorf("http.providers.%s: timeout and connection limits must not be negative", provider)
+		}
+	}
+
+	if err := validateNATS(config.NATS); err != nil {
+		return err
+	}
+	if config.NATS.OutboxInterval < 0 || config.NATS.OutboxRetention < 0 {
+		return fmt.Errorf("nats.outbox_interval and nats.outbox_retention must not be negative")
+	}
+	if config.NATS.DedupeTTL < 0 {
+		return fmt.Errorf("nats.dedupe_ttl must not be negative")
+	}
+
+	if config.NATS.LeaderElection {
+		if config.NATS.URL == "" {
+			return fmt.Errorf("nats.url is required for leader election")
+		}
+		if config.NATS.LeaderTTL < 3*time.Second {
+			return fmt.Errorf("nats.leader_ttl must be at least 3s")
+		}
+	}
+
+	if config.NATS.Coordinator {
+		if config.NATS.URL == "" {
+			return fmt.Errorf("nats.url is required for the coordinator")
+		}
+		if config.NATS.FetchTimeout <= 0 {
+			return fmt.Errorf("nats.fetch_timeout must be positive for the coordinator")
+		}
 	}
 
 	// Validate banking configuration if accounts are configured
@@ -180,13 +1003,25 @@ func validateConfig(config *Config) error {
 		if config.Banking.Enable.ClientID == "" {
 			return fmt.Errorf("banking.enable.client_id is required when accounts are configured")
 		}
-		if config.Banking.Enable.ClientSecret == "" {
+		// The sandbox API signs in with test credentials only
+		if config.Banking.Enable.ClientSecret == "" && !config.Banking.Enable.Sandbox {
 			return fmt.Errorf("banking.enable.client_secret is required when accounts are configured")
 		}
-		if config.Banking.Enable.RedirectURI == "" {
+		if config.Banking.Enable.RedirectURI == "" && !config.Banking.Enable.Sandbox {
 			return fmt.Errorf("banking.enable.redirect_uri is required when accounts are configured")
 		}
 	}
+	if config.Banking.Enable.ConsentRenewBefore < 0 {
+		return fmt.Errorf("banking.enable.consent_renew_before must not be negative")
+	}
+
+	for _, balanceType := range config.Banking.Enable.BalanceTypes {
+		switch balanceType {
+		case "closingBooked", "openingBooked", "interimBooked", "interimAvailable", "expected", "forwardAvailable":
+		default:
+			return fmt.Errorf("banking.enable.balance_types: unknown balance type %q", balanceType)
+		}
+	}
 
 	return nil
 }
@@ -240,8 +1075,14 @@ func GetDefaultConfigPath() string {
 func GetConfigTemplate() *Config {
 	return &Config{
 		Firefly: FireflyConfig{
-			URL:   "http://localhost:8080",
-			Token: "your-token-here",
+			URL:          "http://localhost:8080",
+			Token:        "your-token-here",
+			PullSchedule: "*/15 * * * *",
+			Outbox: FireflyOutboxConfig{
+				Enabled:  true,
+				Schedule: "* * * * *",
+				Batch:    100,
+			},
 		},
 		Ethereum: EthereumConfig{
 			APIKey:      "your-etherscan-api-key",
@@ -259,15 +1100,60 @@ func GetConfigTemplate() *Config {
 				ClientSecret: "your-client-secret",
 				RedirectURI:  "http://localhost:8081/callback",
 				AccountIDs:   []string{"account-id"},
+
+				ConsentRenewBefore: 7 * 24 * time.Hour,
 			},
 		},
 		Service: ServiceConfig{
-			UpdateInterval:   15 * time.Minute,
-			MaxRetries:      3,
-			RetryDelay:      time.Minute,
-			LogLevel:        "info",
-			MetricsEnabled:  true,
-			MetricsInterval: time.Minute,
+			UpdateInterval:       15 * time.Minute,
+			MaxRetries:           3,
+			RetryDelay:           time.Minute,
+			LogLevel:             "info",
+			MetricsEnabled:       true,
+			MetricsInterval:      time.Minute,
+			BaseCurrency:         "USD",
+			RuleTimeout:          250 * time.Millisecond,
+			SourceTestTimeout:    15 * time.Second,
+			CostBasisMethod:      "fifo",
+			IncidentThreshold:    3,
+			SyncWorkers:          4,
+			ProviderConcurrency:  2,
+			BalanceSchedule:      "*/30 * * * *",
+			BalanceTolerance:     0.01,
+			SubscriptionSchedule: "0 6 * * *",
+			StartupChecks:        true,
+		},
+		Duplicates: DuplicatesConfig{
+			DuplicatePolicyConfig: DuplicatePolicyConfig{
+				Window:    24 * time.Hour,
+				Tolerance: 0.01,
+				Action:    "block",
+			},
+		},
+		Categories: CategoriesConfig{
+			Bootstrap: true,
+			Pack:      "standard",
+			Locale:    "en",
+		},
+		Preferences: PreferencesConfig{
+			DateFormat: "YYYY-MM-DD",
+			Notifications: NotificationDefaultConfig{
+				Incidents:     true,
+				Subscriptions: true,
+				BalanceDrift:  true,
+				Consent:       true,
+			},
+		},
+		HTTP: HTTPConfig{
+			Timeout:               30 * time.Second,
+			DialTimeout:           10 * time.Second,
+			KeepAlive:             30 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: 30 * time.Second,
+			IdleConnTimeout:       90 * time.Second,
+			MaxIdleConns:          100,
+			MaxIdleConnsPerHost:   16,
+			HTTP2:                 true,
 		},
 	}
 }
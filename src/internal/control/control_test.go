@@ -0,0 +1,145 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+)
+
+type fakeSyncer struct {
+	synced []string
+	paused map[string]bool
+}
+
+func (f *fakeSyncer) SyncAll(ctx context.Context) *models.ImportCycleReport {
+	f.synced = append(f.synced, "*")
+	return &models.ImportCycleReport{CycleID: "all"}
+}
+
+func (f *fakeSyncer) SyncSources(ctx context.Context, ids []string) (*models.ImportCycleReport, error) {
+	f.synced = append(f.synced, ids...)
+	return &models.ImportCycleReport{CycleID: "some"}, nil
+}
+
+func (f *fakeSyncer) PauseSource(id string) error {
+	if id == "missing" {
+		return models.ErrSourceNotFound
+	}
+	f.paused[id] = true
+	return nil
+}
+
+func (f *fakeSyncer) ResumeSource(id string) error {
+	delete(f.paused, id)
+	return nil
+}
+
+func (f *fakeSyncer) Status(ctx context.Context) (*usecases.SyncStatus, error) {
+	return &usecases.SyncStatus{}, nil
+}
+
+type fakeScheduler struct {
+	interval time.Duration
+}
+
+func (f *fakeScheduler) SetInterval(interval time.Duration) error {
+	f.interval = interval
+	return nil
+}
+
+func TestCommandValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		command Command
+		valid   bool
+	}{
+		{"run all", Command{Command: CommandRunImport}, true},
+		{"run some", Command{Command: CommandRunImport, Sources: []string{"a", "b"}}, true},
+		{"run empty source", Command{Command: CommandRunImport, Sources: []string{""}}, false},
+		{"run with interval", Command{Command: CommandRunImport, Interval: "1m"}, false},
+		{"interval", Command{Command: CommandSetInterval, Interval: "30m"}, true},
+		{"interval disabled", Command{Command: CommandSetInterval, Interval: "0s"}, true},
+		{"interval missing", Command{Command: CommandSetInterval}, false},
+		{"interval negative", Command{Command: CommandSetInterval, Interval: "-1m"}, false},
+		{"pause", Command{Command: CommandPauseSource, Source: "a"}, true},
+		{"pause without source", Command{Command: CommandPauseSource}, false},
+		{"resume with sources", Command{Command: CommandResumeSource, Source: "a", Sources: []string{"b"}}, false},
+		{"stats", Command{Command: CommandGetStats}, true},
+		{"stats with source", Command{Command: CommandGetStats, Source: "a"}, false},
+		{"missing", Command{}, false},
+		{"unknown", Command{Command: "start"}, false},
+	}
+
+	for _, tt := range tests {
+		err := tt.command.Validate()
+		if tt.valid && err != nil {
+			t.Errorf("%s: Validate() error = %v", tt.name, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidCommand) {
+			t.Errorf("%s: Validate() error = %v, want ErrInvalidCommand", tt.name, err)
+		}
+	}
+}
+
+func handle(t *testing.T, h *Handler, request string) map[string]any {
+	t.Helper()
+	var reply map[string]any
+	if err := json.Unmarshal(h.Handle(context.Background(), []byte(request)), &reply); err != nil {
+		t.Fatalf("reply is not JSON: %v", err)
+	}
+	return reply
+}
+
+func TestHandlerRoutesCommands(t *testing.T) {
+	syncer := &fakeSyncer{paused: make(map[string]bool)}
+	scheduler := &fakeScheduler{}
+	h := NewHandler(syncer, scheduler)
+
+	reply := handle(t, h, `{"command":"run-import","sources":["a","b"]}`)
+	if reply["ok"] != true || reply["result"].(map[string]any)["cycleId"] != "some" {
+		t.Errorf("run-import reply = %v", reply)
+	}
+	if strings.Join(syncer.synced, ",") != "a,b" {
+		t.Errorf("synced = %v, want [a b]", syncer.synced)
+	}
+
+	reply = handle(t, h, `{"command":"set-interval","interval":"90m"}`)
+	if reply["ok"] != true || scheduler.interval != 90*time.Minute {
+		t.Errorf("set-interval reply = %v, interval = %s", reply, scheduler.interval)
+	}
+	if got := reply["result"].(map[string]any)["interval"]; got != "1h30m0s" {
+		t.Errorf("set-interval result = %v", got)
+	}
+
+	reply = handle(t, h, `{"command":"pause-source","source":"a"}`)
+	if reply["ok"] != true || !syncer.paused["a"] {
+		t.Errorf("pause-source reply = %v, paused = %v", reply, syncer.paused)
+	}
+
+	reply = handle(t, h, `{"command":"get-stats"}`)
+	if reply["ok"] != true || reply["result"] == nil {
+		t.Errorf("get-stats reply = %v", reply)
+	}
+}
+
+func TestHandlerReportsErrors(t *testing.T) {
+	h := NewHandler(&fakeSyncer{paused: make(map[string]bool)}, &fakeScheduler{})
+
+	for _, request := range []string{
+		`not json`,
+		`{"command":"stop"}`,
+		`{"command":"pause-source"}`,
+		`{"command":"pause-source","source":"missing"}`,
+	} {
+		reply := handle(t, h, request)
+		if reply["ok"] != false || reply["error"] == "" {
+			t.Errorf("%s: reply = %v, want an error", request, reply)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Package control implements the remote control protocol of the daemon:
+// JSON commands sent as NATS requests and answered on the reply subject with
+// a typed result.
+package control
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CommandName identifies a control command
+type CommandName string
+
+const (
+	// CommandRunImport runs an import cycle, of the listed sources only when Sources is set
+	CommandRunImport CommandName = "run-import"
+
+	// CommandSetInterval changes the time between scheduled import cycles; "0s" disables them
+	CommandSetInterval CommandName = "set-interval"
+
+	// CommandPauseSource excludes Source from syncs until it is resumed
+	CommandPauseSource CommandName = "pause-source"
+
+	// CommandResumeSource lets a paused Source sync again
+	CommandResumeSource CommandName = "resume-source"
+
+	// CommandGetStats returns the sync status: queue, source statistics and the last cycle
+	CommandGetStats CommandName = "get-stats"
+)
+
+// ErrInvalidCommand is returned for commands that do not match the protocol
+var ErrInvalidCommand = errors.New("invalid control command")
+
+// Command is a request to the daemon
+type Command struct {
+	Command  CommandName `json:"command"`
+	Sources  []string    `json:"sources,omitempty"`  // run-import: source IDs to sync
+	Source   string      `json:"source,omitempty"`   // pause-source, resume-source
+	Interval string      `json:"interval,omitempty"` // set-interval: Go duration, e.g. "30m"
+}
+
+// Validate checks that the command is known and carries exactly the fields it uses
+func (c Command) Validate() error {
+	switch c.Command {
+	case CommandRunImport:
+		if c.Source != "" || c.Interval != "" {
+			return fmt.Errorf("%w: run-import takes only sources", ErrInvalidCommand)
+		}
+		for _, source := range c.Sources {
+			if source == "" {
+				return fmt.Errorf("%w: run-import sources must not be empty", ErrInvalidCommand)
+			}
+		}
+	case CommandSetInterval:
+		if len(c.Sources) > 0 || c.Source != "" {
+			return fmt.Errorf("%w: set-interval takes only interval", ErrInvalidCommand)
+		}
+		if _, err := c.ParseInterval(); err != nil {
+			return err
+		}
+	case CommandPauseSource, CommandResumeSource:
+		if len(c.Sources) > 0 || c.Interval != "" {
+			return fmt.Errorf("%w: %s takes only source", ErrInvalidCommand, c.Command)
+		}
+		if c.Source == "" {
+			return fmt.Errorf("%w: %s requires source", ErrInvalidCommand, c.Command)
+		}
+	case CommandGetStats:
+		if len(c.Sources) > 0 || c.Source != "" || c.Interval != "" {
+			return fmt.Errorf("%w: get-stats takes no arguments", ErrInvalidCommand)
+		}
+	case "":
+		return fmt.Errorf("%w: command is required", ErrInvalidCommand)
+	default:
+		return fmt.Errorf("%w: unknown command %q", ErrInvalidCommand, c.Command)
+	}
+	return nil
+}
+
+// ParseInterval parses the interval of a set-interval command
+func (c Command) ParseInterval() (time.Duration, error) {
+	interval, err := time.ParseDuration(c.Interval)
+	if err != nil || interval < 0 {
+		return 0, fmt.Errorf("%w: interval must be a non-negative duration, got %q", ErrInvalidCommand, c.Interval)
+	}
+	return interval, nil
+}
+
+// Reply answers a command. Result holds the typed result of a successful command:
+//
+//	run-import     *models.ImportCycleReport
+//	set-interval   IntervalResult
+//	pause-source   SourceResult
+//	resume-source  SourceResult
+//	get-stats      *usecases.SyncStatus
+type Reply struct {
+	OK     bool   `json:"ok"`
+	Error  string `json:"error,omitempty"`
+	Result any    `json:"result,omitempty"`
+}
+
+// IntervalResult is the result of set-interval
+type IntervalResult struct {
+	Interval string `json:"interval"`
+}
+
+// SourceResult is the result of pause-source and resume-source
+type SourceResult struct {
+	Source string `json:"source"`
+	Paused bool   `json:"paused"`
+}
@@ -0,0 +1,160 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/workerpool"
+	"github.com/nats-io/nats.go"
+)
+
+// DefaultSubject is the subject control commands are sent to
+const DefaultSubject = "firedragon.control"
+
+// commandTimeout bounds the handling of a single command
+const commandTimeout = 10 * time.Minute
+
+// Syncer runs and inspects source syncs. *usecases.SourceSyncService satisfies it.
+type Syncer interface {
+	SyncAll(ctx context.Context) *models.ImportCycleReport
+	SyncSources(ctx context.Context, ids []string) (*models.ImportCycleReport, error)
+	PauseSource(id string) error
+	ResumeSource(id string) error
+	Status(ctx context.Context) (*usecases.SyncStatus, error)
+}
+
+// Scheduler controls scheduled import cycles. *usecases.ImportScheduler satisfies it.
+type Scheduler interface {
+	SetInterval(interval time.Duration) error
+}
+
+// Handler executes control commands
+type Handler struct {
+	syncer    Syncer
+	scheduler Scheduler
+}
+
+// NewHandler creates a new Handler
+func NewHandler(syncer Syncer, scheduler Scheduler) *Handler {
+	return &Handler{syncer: syncer, scheduler: scheduler}
+}
+
+// Handle decodes, validates and executes a command and returns its encoded reply
+func (h *Handler) Handle(ctx context.Context, data []byte) []byte {
+	var command Command
+	if err := json.Unmarshal(data, &command); err != nil {
+		return encodeReply(nil, fmt.Errorf("%w: %v", ErrInvalidCommand, err))
+	}
+	if err := command.Validate(); err != nil {
+		return encodeReply(nil, err)
+	}
+
+	return encodeReply(h.execute(ctx, command))
+}
+
+// execute routes a valid command to the service that handles it
+func (h *Handler) execute(ctx context.Context, command Command) (any, error) {
+	switch command.Command {
+	case CommandRunImport:
+		// An operator is waiting, so the syncs jump ahead of scheduled and bulk work
+		ctx = workerpool.WithPriority(ctx, workerpool.PriorityInteractive)
+		if len(command.Sources) == 0 {
+			return h.syncer.SyncAll(ctx), nil
+		}
+		return h.syncer.SyncSources(ctx, command.Sources)
+	case CommandSetInterval:
+		interval, _ := command.ParseInterval()
+		if err := h.scheduler.SetInterval(interval); err != nil {
+			return nil, err
+		}
+		return IntervalResult{Interval: interval.String()}, nil
+	case CommandPauseSource:
+		if err := h.syncer.PauseSource(command.Source); err != nil {
+			return nil, err
+		}
+		return SourceResult{Source: command.Source, Paused: true}, nil
+	case CommandResumeSource:
+		if err := h.syncer.ResumeSource(command.Source); err != nil {
+			return nil, err
+		}
+		return SourceResult{Source: command.Source, Paused: false}, nil
+	case CommandGetStats:
+		return h.syncer.Status(ctx)
+	}
+	return nil, fmt.Errorf("%w: unknown command %q", ErrInvalidCommand, command.Command)
+}
+
+func encodeReply(result any, err error) []byte {
+	reply := Reply{OK: err == nil, Result: result}
+	if err != nil {
+		reply = Reply{Error: err.Error()}
+	}
+
+	data, err := json.Marshal(reply)
+	if err != nil {
+		data, _ = json.Marshal(Reply{Error: fmt.Sprintf("failed to encode reply: %v", err)})
+	}
+	return data
+}
+
+// Server answers control commands sent as NATS requests
+type Server struct {
+	conn    *nats.Conn
+	sub     *nats.Subscription
+	handler *Handler
+}
+
+// NewServer connects to NATS and subscribes to the control subject
+func NewServer(cfg internal.NATSConfig, handler *Handler) (*Server, error) {
+	subject := cfg.ControlSubject
+	if subject == "" {
+		subject = DefaultSubject
+	}
+	subject = cfg.Subject(subject)
+
+	conn, err := nats.Connect(cfg.URL, nats.Name(cfg.ClientName("-control")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	s := &Server{conn: conn, handler: handler}
+	// Commands are handled one at a time, in the order they arrive
+	s.sub, err = conn.Subscribe(subject, s.serve)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+	}
+	return s, nil
+}
+
+func (s *Server) serve(msg *nats.Msg) {
+	logger := internal.GetLogger().With().Str("component", "control").Logger()
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	reply := s.handler.Handle(ctx, msg.Data)
+	if msg.Reply == "" {
+		logger.Warn().Msg("Control command without a reply subject, result dropped")
+		return
+	}
+	if err := msg.Respond(reply); err != nil {
+		logger.Warn().Err(err).Msg("Failed to reply to control command")
+	}
+}
+
+// Close unsubscribes and closes the connection
+func (s *Server) Close() {
+	if err := s.sub.Unsubscribe(); err != nil {
+		logger := internal.GetLogger()
+		logger.Warn().Err(err).Msg("Failed to unsubscribe from control subject")
+	}
+	if err := s.conn.Drain(); err != nil {
+		s.conn.Close()
+	}
+}
@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// RequestIDHeader carries the correlation ID of a request in HTTP requests,
+// HTTP responses and NATS messages
+const RequestIDHeader = "X-Request-ID"
+
+// MetadataRequestID is the event metadata key holding the correlation ID of
+// the request or import cycle that caused the event
+const MetadataRequestID = "requestId"
+
+type requestIDKey struct{}
+
+// NewRequestID generates a correlation ID
+func NewRequestID() string {
+	return uuid.NewString()
+}
+
+// WithRequestID returns a context carrying the correlation ID id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFrom returns the correlation ID carried by ctx, or "" when it carries none
+func RequestIDFrom(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// EnsureRequestID returns ctx and its correlation ID, generating one when ctx
+// carries none yet, e.g. for a scheduled import cycle
+func EnsureRequestID(ctx context.Context) (context.Context, string) {
+	if id := RequestIDFrom(ctx); id != "" {
+		return ctx, id
+	}
+	id := NewRequestID()
+	return WithRequestID(ctx, id), id
+}
+
+// LoggerFrom returns the global logger, tagged with the correlation ID of ctx
+// when it carries one
+func LoggerFrom(ctx context.Context) zerolog.Logger {
+	logger := GetLogger()
+	if id := RequestIDFrom(ctx); id != "" {
+		return logger.With().Str(MetadataRequestID, id).Logger()
+	}
+	return logger
+}
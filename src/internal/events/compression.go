@@ -0,0 +1,179 @@
+package events
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/klauspost/compress/zstd"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// EncodingHeader names the compression of a message payload. Messages without
+// it carry the plain JSON envelope.
+const EncodingHeader = "Content-Encoding"
+
+// Payload encodings
+const (
+	EncodingGzip = "gzip"
+	EncodingZstd = "zstd"
+)
+
+// maxDecompressedSize bounds a decompressed payload, well above any envelope
+// the hooks produce
+const maxDecompressedSize = 64 << 20
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedSize))
+)
+
+// CompressionStats counts the payloads a compressor handled
+type CompressionStats struct {
+	Messages        int64 `json:"messages"`        // payloads seen
+	Compressed      int64 `json:"compressed"`      // payloads sent compressed
+	BytesIn         int64 `json:"bytesIn"`         // size of the compressed payloads before compression
+	BytesOut        int64 `json:"bytesOut"`        // size of the compressed payloads after compression
+	BytesSaved      int64 `json:"bytesSaved"`      // BytesIn - BytesOut
+	SkippedNoSaving int64 `json:"skippedNoSaving"` // payloads above the threshold that did not shrink
+}
+
+// Compressor compresses payloads at or above a size threshold. It is safe for
+// concurrent use.
+type Compressor struct {
+	encoding  string // empty when compression is disabled
+	threshold int
+
+	messages, compressed, bytesIn, bytesOut, skipped atomic.Int64
+}
+
+// NewCompressor creates a compressor for an encoding, none or empty to leave
+// payloads as they are
+func NewCompressor(encoding string, threshold int) (*Compressor, error) {
+	switch encoding {
+	case "", "none":
+		encoding = ""
+	case EncodingGzip, EncodingZstd:
+	default:
+		return nil, fmt.Errorf("unknown payload compression %q", encoding)
+	}
+	return &Compressor{encoding: encoding, threshold: threshold}, nil
+}
+
+// Compress returns the payload to send and its encoding. Payloads below the
+// threshold and payloads that do not shrink are returned unchanged with an
+// empty encoding.
+func (c *Compressor) Compress(data []byte) ([]byte, string, error) {
+	c.messages.Add(1)
+	if c.encoding == "" || len(data) < c.threshold {
+		return data, "", nil
+	}
+
+	compressed, err := compress(c.encoding, data)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(compressed) >= len(data) {
+		c.skipped.Add(1)
+		return data, "", nil
+	}
+
+	c.compressed.Add(1)
+	c.bytesIn.Add(int64(len(data)))
+	c.bytesOut.Add(int64(len(compressed)))
+	return compressed, c.encoding, nil
+}
+
+// Stats returns the counters of the compressor
+func (c *Compressor) Stats() CompressionStats {
+	in, out := c.bytesIn.Load(), c.bytesOut.Load()
+	return CompressionStats{
+		Messages:        c.messages.Load(),
+		Compressed:      c.compressed.Load(),
+		BytesIn:         in,
+		BytesOut:        out,
+		BytesSaved:      in - out,
+		SkippedNoSaving: c.skipped.Load(),
+	}
+}
+
+// Decompress returns the plain payload of a message with the given encoding.
+// An empty encoding returns data unchanged.
+func Decompress(encoding string, data []byte) ([]byte, error) {
+	switch encoding {
+	case "":
+		return data, nil
+	case EncodingGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gzip payload: %w", err)
+		}
+		defer reader.Close()
+		plain, err := io.ReadAll(io.LimitReader(reader, maxDecompressedSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gzip payload: %w", err)
+		}
+		if len(plain) > maxDecompressedSize {
+			return nil, fmt.Errorf("gzip payload exceeds %d bytes", maxDecompressedSize)
+		}
+		return plain, nil
+	case EncodingZstd:
+		plain, err := zstdDecoder.DecodeAll(data, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read zstd payload: %w", err)
+		}
+		return plain, nil
+	}
+	return nil, fmt.Errorf("unknown payload encoding %q", encoding)
+}
+
+// Payload returns the plain payload of a received message
+func Payload(header nats.Header, data []byte) ([]byte, error) {
+	return Decompress(header.Get(EncodingHeader), data)
+}
+
+// MessageHandler adapts an event handler to JetStream consumers. The payload
+// is decompressed before the handler sees it; a handled message is acked, a
+// failed one is negatively acknowledged for redelivery and a payload that
+// cannot be decompressed is terminated, since redelivering it cannot help.
+func MessageHandler(handler interfaces.EventHandler) jetstream.MessageHandler {
+	logger := internal.GetLogger().With().Str("component", "events").Logger()
+
+	return func(msg jetstream.Msg) {
+		data, err := Payload(msg.Headers(), msg.Data())
+		if err != nil {
+			logger.Error().Err(err).Str("subject", msg.Subject()).Msg("Dropping undecodable event")
+			_ = msg.Term()
+			return
+		}
+		if err := handler(data); err != nil {
+			logger.Warn().Err(err).Str("subject", msg.Subject()).Msg("Failed to handle event")
+			_ = msg.Nak()
+			return
+		}
+		_ = msg.Ack()
+	}
+}
+
+func compress(encoding string, data []byte) ([]byte, error) {
+	switch encoding {
+	case EncodingGzip:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, fmt.Errorf("failed to gzip payload: %w", err)
+		}
+		if err := writer.Close(); err != nil {
+			return nil, fmt.Errorf("failed to gzip payload: %w", err)
+		}
+		return buf.Bytes(), nil
+	case EncodingZstd:
+		return zstdEncoder.EncodeAll(data, make([]byte, 0, len(data)/2)), nil
+	}
+	return nil, fmt.Errorf("unknown payload compression %q", encoding)
+}
@@ -0,0 +1,113 @@
+package events
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCompressorRoundTrip(t *testing.T) {
+	payload := []byte(`{"id":"1","type":"import.report.c1","data":{"lines":"` + strings.Repeat("status ok; ", 500) + `"}}`)
+
+	for _, encoding := range []string{EncodingGzip, EncodingZstd} {
+		t.Run(encoding, func(t *testing.T) {
+			c, err := NewCompressor(encoding, 1024)
+			if err != nil {
+				t.Fatalf("NewCompressor() error = %v", err)
+			}
+
+			data, got, err := c.Compress(payload)
+			if err != nil {
+				t.Fatalf("Compress() error = %v", err)
+			}
+			if got != encoding || len(data) >= len(payload) {
+				t.Fatalf("Compress() = %d bytes as %q, want fewer than %d as %q", len(data), got, len(payload), encoding)
+			}
+
+			plain, err := Decompress(got, data)
+			if err != nil {
+				t.Fatalf("Decompress() error = %v", err)
+			}
+			if !bytes.Equal(plain, payload) {
+				t.Error("Decompress() did not return the original payload")
+			}
+
+			stats := c.Stats()
+			if stats.Messages != 1 || stats.Compressed != 1 || stats.BytesIn != int64(len(payload)) ||
+				stats.BytesSaved != int64(len(payload)-len(data)) {
+				t.Errorf("Stats() = %+v", stats)
+			}
+		})
+	}
+}
+
+func TestCompressorLeavesSmallPayloads(t *testing.T) {
+	c, err := NewCompressor(EncodingZstd, 1024)
+	if err != nil {
+		t.Fatalf("NewCompressor() error = %v", err)
+	}
+	payload := []byte(`{"id":"1","type":"transaction.created"}`)
+
+	data, encoding, err := c.Compress(payload)
+	if err != nil {
+		t.Fatalf("Compress() error = %v", err)
+	}
+	if encoding != "" || !bytes.Equal(data, payload) {
+		t.Errorf("Compress() = %q as %q, want the payload unchanged", data, encoding)
+	}
+	if stats := c.Stats(); stats.Messages != 1 || stats.Compressed != 0 {
+		t.Errorf("Stats() = %+v", stats)
+	}
+}
+
+func TestCompressorSkipsPayloadsThatDoNotShrink(t *testing.T) {
+	c, err := NewCompressor(EncodingGzip, 0)
+	if err != nil {
+		t.Fatalf("NewCompressor() error = %v", err)
+	}
+	payload := []byte(`{"id":"1"}`)
+
+	data, encoding, err := c.Compress(payload)
+	if err != nil {
+		t.Fatalf("Compress() error = %v", err)
+	}
+	if encoding != "" || !bytes.Equal(data, payload) {
+		t.Errorf("Compress() = %q as %q, want the payload unchanged", data, encoding)
+	}
+	if stats := c.Stats(); stats.SkippedNoSaving != 1 {
+		t.Errorf("Stats().SkippedNoSaving = %d, want 1", stats.SkippedNoSaving)
+	}
+}
+
+func TestCompressorDisabled(t *testing.T) {
+	for _, encoding := range []string{"", "none"} {
+		c, err := NewCompressor(encoding, 0)
+		if err != nil {
+			t.Fatalf("NewCompressor(%q) error = %v", encoding, err)
+		}
+		payload := []byte(strings.Repeat("a", 4096))
+		if _, got, _ := c.Compress(payload); got != "" {
+			t.Errorf("NewCompressor(%q).Compress() encoding = %q, want none", encoding, got)
+		}
+	}
+
+	if _, err := NewCompressor("brotli", 0); err == nil {
+		t.Error("NewCompressor(brotli) error = nil")
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	payload := []byte(`{"id":"1"}`)
+	if got, err := Decompress("", payload); err != nil || !bytes.Equal(got, payload) {
+		t.Errorf("Decompress(\"\") = %q, %v, want the payload unchanged", got, err)
+	}
+	if _, err := Decompress("brotli", payload); err == nil {
+		t.Error("Decompress(brotli) error = nil")
+	}
+	if _, err := Decompress(EncodingGzip, payload); err == nil {
+		t.Error("Decompress(gzip) of a plain payload error = nil")
+	}
+	if _, err := Decompress(EncodingZstd, payload); err == nil {
+		t.Error("Decompress(zstd) of a plain payload error = nil")
+	}
+}
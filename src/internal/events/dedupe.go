@@ -0,0 +1,201 @@
+package events
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// dedupeTimeout bounds a single claim or release
+const dedupeTimeout = 5 * time.Second
+
+// Deduplicator tracks which messages a consumer has handled, so messages
+// delivered more than once are handled once. Claims expire after a TTL that
+// should exceed the redelivery window of the stream.
+type Deduplicator interface {
+	// Claim marks a message as handled and reports whether it was claimed
+	// for the first time. A message already claimed returns false.
+	Claim(ctx context.Context, key string) (bool, error)
+
+	// Release forgets a claim, so a message whose handling failed is handled again
+	Release(ctx context.Context, key string) error
+}
+
+// Idempotent wraps the handler of a consumer so each event is handled once per
+// consumer, identified by its envelope ID. Events handled by another consumer
+// are not affected. A failed handling releases the claim so the redelivery is
+// handled again; events without an ID are always handled.
+func Idempotent(dedupe Deduplicator, consumer string, handler interfaces.EventHandler) interfaces.EventHandler {
+	logger := internal.GetLogger().With().Str("component", "dedupe").Str("consumer", consumer).Logger()
+
+	return func(data []byte) error {
+		id := EventID(data)
+		if id == "" {
+			return handler(data)
+		}
+		key := consumer + "." + id
+
+		ctx, cancel := context.WithTimeout(context.Background(), dedupeTimeout)
+		claimed, err := dedupe.Claim(ctx, key)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to claim event %s: %w", id, err)
+		}
+		if !claimed {
+			logger.Debug().Str("eventID", id).Msg("Skipping event handled before")
+			return nil
+		}
+
+		if err := handler(data); err != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), dedupeTimeout)
+			defer cancel()
+			if releaseErr := dedupe.Release(ctx, key); releaseErr != nil {
+				return errors.Join(err, fmt.Errorf("failed to release event %s: %w", id, releaseErr))
+			}
+			return err
+		}
+		return nil
+	}
+}
+
+// EventID returns the ID of an encoded event envelope, or an empty string
+// when data is not an envelope
+func EventID(data []byte) string {
+	var envelope struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return ""
+	}
+	return envelope.ID
+}
+
+// MemoryDeduplicator keeps claims in memory. It suits a single instance and
+// loses its claims on restart.
+type MemoryDeduplicator struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu     sync.Mutex
+	claims map[string]time.Time // expiry by key
+}
+
+// NewMemoryDeduplicator creates a deduplicator whose claims expire after ttl
+func NewMemoryDeduplicator(ttl time.Duration) *MemoryDeduplicator {
+	return &MemoryDeduplicator{ttl: ttl, now: time.Now, claims: make(map[string]time.Time)}
+}
+
+// Claim marks key as handled unless it already is
+func (d *MemoryDeduplicator) Claim(ctx context.Context, key string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	for k, expiry := range d.claims {
+		if !now.Before(expiry) {
+			delete(d.claims, k)
+		}
+	}
+
+	if _, ok := d.claims[key]; ok {
+		return false, nil
+	}
+	d.claims[key] = now.Add(d.ttl)
+	return true, nil
+}
+
+// Release forgets the claim of key
+func (d *MemoryDeduplicator) Release(ctx context.Context, key string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.claims, key)
+	return nil
+}
+
+// claimStore is the subset of jetstream.KeyValue the KV deduplicator uses
+type claimStore interface {
+	Create(ctx context.Context, key string, value []byte) (uint64, error)
+	Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error
+}
+
+// KVDeduplicator keeps claims in a JetStream key-value bucket, so they are
+// shared by every replica and survive restarts. The bucket TTL expires them.
+type KVDeduplicator struct {
+	conn *nats.Conn // nil when the store is not backed by a connection
+	kv   claimStore
+}
+
+// NewKVDeduplicator connects to NATS and makes sure the claim bucket exists
+func NewKVDeduplicator(ctx context.Context, cfg internal.NATSConfig) (*KVDeduplicator, error) {
+	bucket := cfg.ResourceName(cfg.DedupeBucket)
+	conn, err := nats.Connect(cfg.URL, nats.Name(cfg.ClientName("-dedupe")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:      bucket,
+		Description: "Messages handled by event consumers",
+		TTL:         cfg.DedupeTTL,
+		History:     1,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create key-value bucket %s: %w", bucket, err)
+	}
+
+	return &KVDeduplicator{conn: conn, kv: kv}, nil
+}
+
+// Claim creates the key, which fails when another delivery created it first
+func (d *KVDeduplicator) Claim(ctx context.Context, key string) (bool, error) {
+	_, err := d.kv.Create(ctx, kvKey(key), []byte(time.Now().UTC().Format(time.RFC3339)))
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Release deletes the key
+func (d *KVDeduplicator) Release(ctx context.Context, key string) error {
+	return d.kv.Delete(ctx, kvKey(key))
+}
+
+// Close closes the connection
+func (d *KVDeduplicator) Close() {
+	if d.conn != nil {
+		d.conn.Close()
+	}
+}
+
+// validKVKey matches the keys a JetStream bucket accepts
+var validKVKey = regexp.MustCompile(`^[-/_=.a-zA-Z0-9]+$`)
+
+// kvKey returns key, or its hash when the bucket would refuse it
+func kvKey(key string) string {
+	if validKVKey.MatchString(key) && key[0] != '.' && key[len(key)-1] != '.' {
+		return key
+	}
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
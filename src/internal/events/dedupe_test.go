@@ -0,0 +1,159 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+func encodedEvent(t *testing.T, id string) []byte {
+	t.Helper()
+	event := interfaces.NewEvent(interfaces.EventTypeTransactionCreated, "test")
+	event.ID = id
+	data, err := Encode(event)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	return data
+}
+
+func TestIdempotentHandlesEachEventOnce(t *testing.T) {
+	dedupe := NewMemoryDeduplicator(time.Hour)
+
+	sent := 0
+	notify := Idempotent(dedupe, "notifications", func(data []byte) error {
+		sent++
+		return nil
+	})
+	reports := 0
+	report := Idempotent(dedupe, "reports", func(data []byte) error {
+		reports++
+		return nil
+	})
+
+	data := encodedEvent(t, "e1")
+	for range 3 {
+		if err := notify(data); err != nil {
+			t.Fatalf("notify() error = %v", err)
+		}
+	}
+	if err := report(data); err != nil {
+		t.Fatalf("report() error = %v", err)
+	}
+
+	if sent != 1 {
+		t.Errorf("notifications sent = %d, want 1", sent)
+	}
+	if reports != 1 {
+		t.Errorf("reports updated = %d, want 1, consumers must not share claims", reports)
+	}
+}
+
+func TestIdempotentRetriesFailedHandling(t *testing.T) {
+	dedupe := NewMemoryDeduplicator(time.Hour)
+
+	calls := 0
+	handler := Idempotent(dedupe, "reports", func(data []byte) error {
+		calls++
+		if calls == 1 {
+			return errors.New("report store unavailable")
+		}
+		return nil
+	})
+
+	data := encodedEvent(t, "e1")
+	if err := handler(data); err == nil {
+		t.Fatal("handler() error = nil, want the handler's error")
+	}
+	if err := handler(data); err != nil {
+		t.Fatalf("handler() on redelivery error = %v", err)
+	}
+	if err := handler(data); err != nil || calls != 2 {
+		t.Errorf("handler calls = %d, want 2", calls)
+	}
+}
+
+func TestIdempotentPassesEventsWithoutID(t *testing.T) {
+	calls := 0
+	handler := Idempotent(NewMemoryDeduplicator(time.Hour), "raw", func(data []byte) error {
+		calls++
+		return nil
+	})
+
+	for range 2 {
+		if err := handler([]byte("not an envelope")); err != nil {
+			t.Fatalf("handler() error = %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("handler calls = %d, want 2", calls)
+	}
+}
+
+func TestMemoryDeduplicatorExpiresClaims(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	dedupe := NewMemoryDeduplicator(time.Minute)
+	dedupe.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	if claimed, _ := dedupe.Claim(ctx, "k"); !claimed {
+		t.Fatal("first Claim() = false, want true")
+	}
+	if claimed, _ := dedupe.Claim(ctx, "k"); claimed {
+		t.Error("second Claim() = true, want false")
+	}
+
+	now = now.Add(time.Minute)
+	if claimed, _ := dedupe.Claim(ctx, "k"); !claimed {
+		t.Error("Claim() after the TTL = false, want true")
+	}
+}
+
+// memoryClaims behaves like a JetStream bucket for Create and Delete
+type memoryClaims map[string]bool
+
+func (m memoryClaims) Create(ctx context.Context, key string, value []byte) (uint64, error) {
+	if m[key] {
+		return 0, jetstream.ErrKeyExists
+	}
+	m[key] = true
+	return uint64(len(m)), nil
+}
+
+func (m memoryClaims) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	delete(m, key)
+	return nil
+}
+
+func TestKVDeduplicatorClaims(t *testing.T) {
+	claims := memoryClaims{}
+	dedupe := &KVDeduplicator{kv: claims}
+	ctx := context.Background()
+
+	key := "notifications.3f9a0c52-8d7e-4b1a-9c1e-2f6d5b7a8e90"
+	if claimed, err := dedupe.Claim(ctx, key); err != nil || !claimed {
+		t.Fatalf("Claim() = %v, %v, want true", claimed, err)
+	}
+	if claimed, err := dedupe.Claim(ctx, key); err != nil || claimed {
+		t.Errorf("Claim() of a claimed key = %v, %v, want false", claimed, err)
+	}
+	if err := dedupe.Release(ctx, key); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if claimed, _ := dedupe.Claim(ctx, key); !claimed {
+		t.Error("Claim() after Release() = false, want true")
+	}
+}
+
+func TestKVKey(t *testing.T) {
+	if got := kvKey("reports.e1"); got != "reports.e1" {
+		t.Errorf("kvKey(valid) = %q, want it unchanged", got)
+	}
+	if got := kvKey("reports.msg id*1"); !validKVKey.MatchString(got) || len(got) != 64 {
+		t.Errorf("kvKey(invalid) = %q, want a hex hash", got)
+	}
+}
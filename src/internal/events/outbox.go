@@ -0,0 +1,110 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// outboxBatch is the number of events read from the outbox at a time
+const outboxBatch = 100
+
+// OutboxRelay publishes the events stored in the outbox, in the order they were
+// stored, and marks them published. A failed publish stops the relay until the
+// next attempt so events keep their order; an event published again after a
+// crash carries the same ID and is dropped by the stream's deduplication.
+type OutboxRelay struct {
+	outbox    repositories.EventOutboxRepository
+	publisher Publisher
+	interval  time.Duration
+	now       func() time.Time
+	wake      chan struct{}
+}
+
+// NewOutboxRelay creates a relay retrying failed publishes every interval
+func NewOutboxRelay(outbox repositories.EventOutboxRepository, publisher Publisher, interval time.Duration) *OutboxRelay {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	return &OutboxRelay{
+		outbox:    outbox,
+		publisher: publisher,
+		interval:  interval,
+		now:       time.Now,
+		wake:      make(chan struct{}, 1),
+	}
+}
+
+// Notify wakes the relay after new events were committed. It never blocks.
+func (r *OutboxRelay) Notify() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run relays the outbox when notified and every interval until ctx is done
+func (r *OutboxRelay) Run(ctx context.Context) {
+	logger := internal.GetLogger().With().Str("component", "outbox").Logger()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Relay(ctx); err != nil && ctx.Err() == nil {
+			logger.Warn().Err(err).Msg("Failed to relay domain events")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+	}
+}
+
+// Relay publishes the unpublished events and returns how many were published
+func (r *OutboxRelay) Relay(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		pending, err := r.outbox.FindUnpublished(ctx, outboxBatch)
+		if err != nil {
+			return published, err
+		}
+		before := published
+
+		for _, stored := range pending {
+			var event interfaces.Event
+			if err := json.Unmarshal(stored.Payload, &event); err != nil {
+				// The payload was encoded by the hooks, so this does not heal on retry
+				err = fmt.Errorf("failed to decode event %s: %w", stored.EventID, err)
+				if markErr := r.outbox.MarkFailed(ctx, stored.ID, err); markErr != nil {
+					return published, markErr
+				}
+				continue
+			}
+
+			if err := r.publisher.Publish(ctx, &event); err != nil {
+				if markErr := r.outbox.MarkFailed(ctx, stored.ID, err); markErr != nil {
+					return published, markErr
+				}
+				return published, err
+			}
+			if err := r.outbox.MarkPublished(ctx, stored.ID, r.now()); err != nil {
+				return published, err
+			}
+			published++
+		}
+
+		// A full batch of undecodable events would otherwise be read forever
+		if len(pending) < outboxBatch || published == before {
+			return published, nil
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+)
+
+// memoryOutbox keeps outbox events in insertion order
+type memoryOutbox struct {
+	events []*models.OutboxEvent
+}
+
+func (o *memoryOutbox) add(t *testing.T, event *interfaces.Event) {
+	t.Helper()
+	payload, err := Encode(event)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	o.events = append(o.events, &models.OutboxEvent{
+		ID:      event.ID,
+		EventID: event.ID,
+		Type:    string(event.Type),
+		Payload: payload,
+	})
+}
+
+func (o *memoryOutbox) FindUnpublished(ctx context.Context, limit int) ([]*models.OutboxEvent, error) {
+	var pending []*models.OutboxEvent
+	for _, event := range o.events {
+		if !event.IsPublished() && (limit <= 0 || len(pending) < limit) {
+			pending = append(pending, event)
+		}
+	}
+	return pending, nil
+}
+
+func (o *memoryOutbox) find(id string) *models.OutboxEvent {
+	for _, event := range o.events {
+		if event.ID == id {
+			return event
+		}
+	}
+	return nil
+}
+
+func (o *memoryOutbox) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	o.find(id).PublishedAt = at
+	return nil
+}
+
+func (o *memoryOutbox) MarkFailed(ctx context.Context, id string, cause error) error {
+	event := o.find(id)
+	event.Attempts++
+	event.LastError = cause.Error()
+	return nil
+}
+
+func (o *memoryOutbox) PurgePublished(ctx context.Context, before time.Time) (int, error) {
+	return 0, nil
+}
+
+// recordingPublisher records the IDs of published events and fails while down
+type recordingPublisher struct {
+	published []string
+	down      bool
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, event *interfaces.Event) error {
+	if p.down {
+		return errors.New("nats: no servers available for connection")
+	}
+	p.published = append(p.published, event.ID)
+	return nil
+}
+
+func (p *recordingPublisher) Close() {}
+
+func TestOutboxRelayPublishesInOrderAfterOutage(t *testing.T) {
+	outbox := &memoryOutbox{}
+	for _, id := range []string{"e1", "e2", "e3"} {
+		event := interfaces.NewEvent(interfaces.EventTypeTransactionCreated, "test").WithTarget(id)
+		event.ID = id
+		outbox.add(t, event)
+	}
+
+	publisher := &recordingPublisher{down: true}
+	relay := NewOutboxRelay(outbox, publisher, time.Second)
+
+	published, err := relay.Relay(context.Background())
+	if err == nil || published != 0 {
+		t.Fatalf("Relay() while down = %d, %v, want 0 and an error", published, err)
+	}
+	if first := outbox.find("e1"); first.Attempts != 1 || first.LastError == "" {
+		t.Errorf("failed event = %+v, want one recorded attempt", first)
+	}
+	if outbox.find("e2").Attempts != 0 {
+		t.Error("Relay() attempted events after a failed publish")
+	}
+
+	publisher.down = false
+	published, err = relay.Relay(context.Background())
+	if err != nil || published != 3 {
+		t.Fatalf("Relay() = %d, %v, want 3 and no error", published, err)
+	}
+	want := []string{"e1", "e2", "e3"}
+	for i, id := range want {
+		if publisher.published[i] != id {
+			t.Errorf("published[%d] = %s, want %s", i, publisher.published[i], id)
+		}
+	}
+
+	if published, _ := relay.Relay(context.Background()); published != 0 {
+		t.Errorf("Relay() republished %d events", published)
+	}
+}
+
+func TestOutboxRelaySkipsUndecodableEvents(t *testing.T) {
+	outbox := &memoryOutbox{events: []*models.OutboxEvent{{ID: "bad", EventID: "bad", Payload: []byte("{")}}}
+	event := interfaces.NewEvent(interfaces.EventTypeTransactionCreated, "test")
+	event.ID = "good"
+	outbox.add(t, event)
+
+	publisher := &recordingPublisher{}
+	published, err := NewOutboxRelay(outbox, publisher, time.Second).Relay(context.Background())
+	if err != nil || published != 1 {
+		t.Fatalf("Relay() = %d, %v, want 1 and no error", published, err)
+	}
+	if bad := outbox.find("bad"); bad.IsPublished() || bad.Attempts != 1 {
+		t.Errorf("undecodable event = %+v, want unpublished with one attempt", bad)
+	}
+}
@@ -0,0 +1,195 @@
+// Package events publishes domain events onto NATS JetStream so downstream
+// services can react to changes without polling PocketBase.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Publisher publishes domain events
+type Publisher interface {
+	// Publish sends a single event. Implementations must be safe for concurrent use.
+	Publish(ctx context.Context, event *interfaces.Event) error
+
+	// Close releases the underlying connection
+	Close()
+}
+
+// Subject returns the subject an event type is published on
+func Subject(prefix string, eventType interfaces.EventType) string {
+	prefix = strings.TrimSuffix(prefix, ".")
+	if prefix == "" {
+		return string(eventType)
+	}
+	return prefix + "." + string(eventType)
+}
+
+// Encode fills in the envelope defaults and serializes the event
+func Encode(event *interfaces.Event) ([]byte, error) {
+	if event.ID == "" {
+		event.ID = uuid.NewString()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event %s: %w", event.Type, err)
+	}
+
+	return data, nil
+}
+
+// Correlate records the correlation ID carried by ctx in the metadata of the
+// event, unless the event already carries one
+func Correlate(ctx context.Context, event *interfaces.Event) {
+	id := internal.RequestIDFrom(ctx)
+	if id == "" || event.Metadata[internal.MetadataRequestID] != "" {
+		return
+	}
+	if event.Metadata == nil {
+		event.Metadata = make(map[string]string)
+	}
+	event.Metadata[internal.MetadataRequestID] = id
+}
+
+// JetStreamPublisher publishes events onto a JetStream stream
+type JetStreamPublisher struct {
+	conn          *nats.Conn
+	js            jetstream.JetStream
+	subjectPrefix string
+	timeout       time.Duration
+	compressor    *Compressor
+}
+
+// NewJetStreamPublisher connects to NATS and makes sure the event stream exists.
+// The stream and its subjects are placed in the namespace of the install.
+func NewJetStreamPublisher(ctx context.Context, cfg internal.NATSConfig) (*JetStreamPublisher, error) {
+	stream := cfg.ResourceName(cfg.Stream)
+	subjectPrefix := cfg.Subject(cfg.SubjectPrefix)
+	compressor, err := NewCompressor(cfg.Compression, cfg.CompressionThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := nats.Connect(cfg.URL, nats.Name(cfg.ClientName("")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	// Limits changed since the stream was created are kept unless configured
+	streamConfig := jetstream.StreamConfig{Name: stream, Storage: jetstream.FileStorage}
+	if existing, err := js.Stream(ctx, stream); err == nil {
+		streamConfig = existing.CachedInfo().Config
+	}
+	streamConfig.Subjects = []string{Subject(subjectPrefix, ">")}
+	if cfg.StreamMaxAge > 0 {
+		streamConfig.MaxAge = cfg.StreamMaxAge
+	}
+	if cfg.StreamMaxBytes > 0 {
+		streamConfig.MaxBytes = cfg.StreamMaxBytes
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, streamConfig)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create stream %s: %w", stream, err)
+	}
+
+	timeout := cfg.PublishTimeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+
+	return &JetStreamPublisher{
+		conn:          conn,
+		js:            js,
+		subjectPrefix: subjectPrefix,
+		timeout:       timeout,
+		compressor:    compressor,
+	}, nil
+}
+
+// Publish sends the event and waits for the stream acknowledgement.
+// The event ID is used as the message ID so redelivered publishes are deduplicated.
+// Large payloads are compressed and carry their encoding in the EncodingHeader,
+// and the correlation ID of the event is passed on in the RequestIDHeader.
+func (p *JetStreamPublisher) Publish(ctx context.Context, event *interfaces.Event) error {
+	Correlate(ctx, event)
+	data, err := Encode(event)
+	if err != nil {
+		return err
+	}
+
+	subject := Subject(p.subjectPrefix, event.Type)
+	payload, encoding, err := p.compressor.Compress(data)
+	if err != nil {
+		return fmt.Errorf("failed to compress %s: %w", subject, err)
+	}
+	msg := nats.NewMsg(subject)
+	msg.Data = payload
+	if encoding != "" {
+		msg.Header.Set(EncodingHeader, encoding)
+	}
+	if id := event.Metadata[internal.MetadataRequestID]; id != "" {
+		msg.Header.Set(internal.RequestIDHeader, id)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if _, err := p.js.PublishMsg(ctx, msg, jetstream.WithMsgID(event.ID)); err != nil {
+		return fmt.Errorf("failed to publish %s: %w", subject, err)
+	}
+
+	return nil
+}
+
+// Compression returns the payload compression counters
+func (p *JetStreamPublisher) Compression() CompressionStats {
+	return p.compressor.Stats()
+}
+
+// Close drains pending messages and closes the connection
+func (p *JetStreamPublisher) Close() {
+	if err := p.conn.Drain(); err != nil {
+		p.conn.Close()
+	}
+}
+
+// Ping connects to NATS, checks that JetStream is enabled and returns the
+// version of the server, without touching the event stream
+func Ping(ctx context.Context, cfg internal.NATSConfig) (string, error) {
+	conn, err := nats.Connect(cfg.URL, nats.Name(cfg.ClientName("")))
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to nats: %w", err)
+	}
+	defer conn.Close()
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return "", fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+	if _, err := js.AccountInfo(ctx); err != nil {
+		return "", fmt.Errorf("jetstream is not available: %w", err)
+	}
+
+	return conn.ConnectedServerVersion(), nil
+}
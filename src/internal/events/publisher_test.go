@@ -0,0 +1,74 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+func TestSubject(t *testing.T) {
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{"firedragon.events", "firedragon.events.transaction.created"},
+		{"firedragon.events.", "firedragon.events.transaction.created"},
+		{"", "transaction.created"},
+	}
+
+	for _, tt := range tests {
+		if got := Subject(tt.prefix, interfaces.EventTypeTransactionCreated); got != tt.want {
+			t.Errorf("Subject(%q) = %q, want %q", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeFillsEnvelope(t *testing.T) {
+	event := interfaces.NewEvent(interfaces.EventTypeWalletBalanceChanged, "test").
+		WithTarget("wallet1").
+		WithData("delta", 12.5)
+
+	data, err := Encode(event)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if event.ID == "" {
+		t.Error("Encode() did not assign an event ID")
+	}
+
+	var decoded interfaces.Event
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	if decoded.Type != interfaces.EventTypeWalletBalanceChanged || decoded.Target != "wallet1" || decoded.ID != event.ID {
+		t.Errorf("decoded envelope = %+v", decoded)
+	}
+	if decoded.Data["delta"] != 12.5 {
+		t.Errorf("decoded delta = %v, want 12.5", decoded.Data["delta"])
+	}
+}
+
+func TestCorrelate(t *testing.T) {
+	ctx := internal.WithRequestID(context.Background(), "req-1")
+
+	event := interfaces.NewEvent(interfaces.EventTypeTransactionCreated, "test")
+	Correlate(ctx, event)
+	if got := event.Metadata[internal.MetadataRequestID]; got != "req-1" {
+		t.Errorf("request ID = %q, want the one of the context", got)
+	}
+
+	// Events stored in the outbox keep the ID of the request that caused them
+	Correlate(internal.WithRequestID(ctx, "relay"), event)
+	if got := event.Metadata[internal.MetadataRequestID]; got != "req-1" {
+		t.Errorf("request ID = %q after relaying, want the original one", got)
+	}
+
+	uncorrelated := interfaces.NewEvent(interfaces.EventTypeTransactionCreated, "test")
+	Correlate(context.Background(), uncorrelated)
+	if uncorrelated.Metadata != nil {
+		t.Errorf("Metadata = %v without a request ID, want none", uncorrelated.Metadata)
+	}
+}
@@ -0,0 +1,440 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Kinds of the streams the app creates
+const (
+	StreamEvents = "events" // domain events
+	StreamDedupe = "dedupe" // key-value bucket of the consumer claims
+	StreamLeader = "leader" // key-value bucket of the scheduler lease
+)
+
+var (
+	// ErrUnknownStream is returned for streams the app did not create
+	ErrUnknownStream = errors.New("unknown stream")
+
+	// ErrRetentionFixed is returned when changing the limits of a key-value bucket
+	ErrRetentionFixed = errors.New("retention cannot be changed")
+)
+
+// sequenceWait bounds the wait for the first message after a purge cutoff
+const sequenceWait = 2 * time.Second
+
+// StreamUsage describes the storage and limits of a stream
+type StreamUsage struct {
+	Kind      string    `json:"kind"`
+	Stream    string    `json:"stream"`
+	Messages  uint64    `json:"messages"`
+	Bytes     uint64    `json:"bytes"`
+	Subjects  uint64    `json:"subjects"`
+	Consumers int       `json:"consumers"`
+	FirstSeq  uint64    `json:"firstSeq"`
+	LastSeq   uint64    `json:"lastSeq"`
+	FirstTime time.Time `json:"firstTime,omitempty"`
+	LastTime  time.Time `json:"lastTime,omitempty"`
+	MaxAge    string    `json:"maxAge"`   // e.g. 720h0m0s, empty for unlimited
+	MaxBytes  int64     `json:"maxBytes"` // -1 for unlimited
+	MaxMsgs   int64     `json:"maxMsgs"`  // -1 for unlimited
+}
+
+// PurgeOptions selects the messages a purge removes. Without options the
+// whole stream is purged.
+type PurgeOptions struct {
+	Subject string    `json:"subject,omitempty"` // only messages on this subject, wildcards allowed
+	Before  time.Time `json:"before,omitempty"`  // only messages stored before this time
+}
+
+// PurgeResult reports the messages a purge or compaction removed
+type PurgeResult struct {
+	Stream string `json:"stream"`
+	Purged uint64 `json:"purged"`
+}
+
+// Retention changes the limits of a stream. Fields left nil keep their value;
+// zero removes the limit.
+type Retention struct {
+	MaxAge   *time.Duration `json:"maxAge,omitempty"`
+	MaxBytes *int64         `json:"maxBytes,omitempty"`
+	MaxMsgs  *int64         `json:"maxMsgs,omitempty"`
+}
+
+// streamStore is the subset of jetstream.JetStream the stream admin uses
+type streamStore interface {
+	Stream(ctx context.Context, name string) (jetstream.Stream, error)
+	UpdateStream(ctx context.Context, cfg jetstream.StreamConfig) (jetstream.Stream, error)
+}
+
+// StreamAdmin inspects and maintains the streams the app creates: the event
+// stream and the key-value buckets of the consumer claims and the scheduler
+// lease. Streams are addressed by kind or by name; other streams on the
+// server are never touched.
+type StreamAdmin struct {
+	conn    *nats.Conn // nil when the store is not backed by a connection
+	js      streamStore
+	streams map[string]string // stream name by kind
+
+	// sequenceAt returns the sequence of the first message at or after t, zero
+	// when there is none
+	sequenceAt func(ctx context.Context, stream jetstream.Stream, subject string, t time.Time) (uint64, error)
+}
+
+// NewStreamAdmin connects to NATS to manage the streams of the install
+func NewStreamAdmin(cfg internal.NATSConfig) (*StreamAdmin, error) {
+	conn, err := nats.Connect(cfg.URL, nats.Name(cfg.ClientName("-admin")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	admin := newStreamAdmin(js, cfg)
+	admin.conn = conn
+	return admin, nil
+}
+
+func newStreamAdmin(js streamStore, cfg internal.NATSConfig) *StreamAdmin {
+	// Key-value buckets are stored in streams named KV_<bucket>
+	return &StreamAdmin{
+		js: js,
+		streams: map[string]string{
+			StreamEvents: cfg.ResourceName(cfg.Stream),
+			StreamDedupe: "KV_" + cfg.ResourceName(cfg.DedupeBucket),
+			StreamLeader: "KV_" + cfg.ResourceName(cfg.LeaderBucket),
+		},
+		sequenceAt: firstSequenceAt,
+	}
+}
+
+// Usage returns the storage of every stream the app created, sorted by kind.
+// Streams that do not exist yet are left out.
+func (a *StreamAdmin) Usage(ctx context.Context) ([]StreamUsage, error) {
+	kinds := make([]string, 0, len(a.streams))
+	for kind := range a.streams {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	usage := make([]StreamUsage, 0, len(kinds))
+	for _, kind := range kinds {
+		stream, err := a.js.Stream(ctx, a.streams[kind])
+		if errors.Is(err, jetstream.ErrStreamNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find stream %s: %w", a.streams[kind], err)
+		}
+		info, err := stream.Info(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stream %s: %w", a.streams[kind], err)
+		}
+		usage = append(usage, streamUsage(kind, info))
+	}
+	return usage, nil
+}
+
+// Purge removes the messages of a stream selected by opts and reports how many
+// were removed
+func (a *StreamAdmin) Purge(ctx context.Context, name string, opts PurgeOptions) (*PurgeResult, error) {
+	stream, info, err := a.stream(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var purge []jetstream.StreamPurgeOpt
+	if opts.Subject != "" {
+		purge = append(purge, jetstream.WithPurgeSubject(opts.Subject))
+	}
+	if !opts.Before.IsZero() && opts.Before.Before(info.State.LastTime) {
+		if opts.Before.Before(info.State.FirstTime) {
+			return &PurgeResult{Stream: info.Config.Name}, nil
+		}
+		sequence, err := a.sequenceAt(ctx, stream, opts.Subject, opts.Before)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the first message after %s: %w", opts.Before.Format(time.RFC3339), err)
+		}
+		if sequence > 0 {
+			purge = append(purge, jetstream.WithPurgeSequence(sequence))
+		}
+	}
+
+	if err := stream.Purge(ctx, purge...); err != nil {
+		return nil, fmt.Errorf("failed to purge stream %s: %w", info.Config.Name, err)
+	}
+	return a.purged(ctx, stream, info)
+}
+
+// Compact keeps the last keep messages of every subject of a stream and
+// removes the older ones, e.g. superseded key-value revisions
+func (a *StreamAdmin) Compact(ctx context.Context, name string, keep uint64) (*PurgeResult, error) {
+	if keep == 0 {
+		return nil, fmt.Errorf("compaction must keep at least one message per subject")
+	}
+
+	stream, current, err := a.stream(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := stream.Info(ctx, jetstream.WithSubjectFilter(">"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the subjects of stream %s: %w", current.Config.Name, err)
+	}
+
+	subjects := make([]string, 0, len(info.State.Subjects))
+	for subject, messages := range info.State.Subjects {
+		if messages > keep {
+			subjects = append(subjects, subject)
+		}
+	}
+	sort.Strings(subjects)
+
+	for _, subject := range subjects {
+		if err := stream.Purge(ctx, jetstream.WithPurgeSubject(subject), jetstream.WithPurgeKeep(keep)); err != nil {
+			return nil, fmt.Errorf("failed to compact %s of stream %s: %w", subject, info.Config.Name, err)
+		}
+	}
+	return a.purged(ctx, stream, info)
+}
+
+// SetRetention changes the limits of the event stream and returns its usage.
+// The limits are kept across restarts unless configured. The key-value
+// buckets expire their entries after the configured TTLs, so their limits
+// cannot be changed here.
+func (a *StreamAdmin) SetRetention(ctx context.Context, name string, retention Retention) (*StreamUsage, error) {
+	_, info, err := a.stream(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if a.kind(info.Config.Name) != StreamEvents {
+		return nil, fmt.Errorf("%w: the retention of %s follows the configured TTL", ErrRetentionFixed, info.Config.Name)
+	}
+
+	config := info.Config
+	if retention.MaxAge != nil {
+		if *retention.MaxAge < 0 {
+			return nil, fmt.Errorf("max age must not be negative")
+		}
+		config.MaxAge = *retention.MaxAge
+	}
+	if retention.MaxBytes != nil {
+		config.MaxBytes = unlimited(*retention.MaxBytes)
+	}
+	if retention.MaxMsgs != nil {
+		config.MaxMsgs = unlimited(*retention.MaxMsgs)
+	}
+
+	stream, err := a.js.UpdateStream(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update stream %s: %w", config.Name, err)
+	}
+	updated, err := stream.Info(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stream %s: %w", config.Name, err)
+	}
+	usage := streamUsage(a.kind(config.Name), updated)
+	return &usage, nil
+}
+
+// Close releases the connection
+func (a *StreamAdmin) Close() {
+	if a.conn != nil {
+		a.conn.Close()
+	}
+}
+
+// stream returns a stream of the app by kind or name with its current info
+func (a *StreamAdmin) stream(ctx context.Context, name string) (jetstream.Stream, *jetstream.StreamInfo, error) {
+	if streamName, ok := a.streams[name]; ok {
+		name = streamName
+	} else if a.kind(name) == "" {
+		return nil, nil, fmt.Errorf("%w %q", ErrUnknownStream, name)
+	}
+
+	stream, err := a.js.Stream(ctx, name)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		return nil, nil, fmt.Errorf("%w %q: not created yet", ErrUnknownStream, name)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find stream %s: %w", name, err)
+	}
+	info, err := stream.Info(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read stream %s: %w", name, err)
+	}
+	return stream, info, nil
+}
+
+// kind returns the kind of an app stream name, empty for other streams
+func (a *StreamAdmin) kind(name string) string {
+	for kind, streamName := range a.streams {
+		if streamName == name {
+			return kind
+		}
+	}
+	return ""
+}
+
+// purged reports the messages removed since before was read
+func (a *StreamAdmin) purged(ctx context.Context, stream jetstream.Stream, before *jetstream.StreamInfo) (*PurgeResult, error) {
+	after, err := stream.Info(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stream %s: %w", before.Config.Name, err)
+	}
+	result := &PurgeResult{Stream: before.Config.Name}
+	if after.State.Msgs < before.State.Msgs {
+		result.Purged = before.State.Msgs - after.State.Msgs
+	}
+	return result, nil
+}
+
+// firstSequenceAt reads the first message at or after t with an ordered consumer
+func firstSequenceAt(ctx context.Context, stream jetstream.Stream, subject string, t time.Time) (uint64, error) {
+	config := jetstream.OrderedConsumerConfig{
+		DeliverPolicy: jetstream.DeliverByStartTimePolicy,
+		OptStartTime:  &t,
+	}
+	if subject != "" {
+		config.FilterSubjects = []string{subject}
+	}
+	consumer, err := stream.OrderedConsumer(ctx, config)
+	if err != nil {
+		return 0, err
+	}
+
+	msg, err := consumer.Next(jetstream.FetchMaxWait(sequenceWait))
+	if errors.Is(err, nats.ErrTimeout) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	metadata, err := msg.Metadata()
+	if err != nil {
+		return 0, err
+	}
+	return metadata.Sequence.Stream, nil
+}
+
+func streamUsage(kind string, info *jetstream.StreamInfo) StreamUsage {
+	return StreamUsage{
+		Kind:      kind,
+		Stream:    info.Config.Name,
+		Messages:  info.State.Msgs,
+		Bytes:     info.State.Bytes,
+		Subjects:  info.State.NumSubjects,
+		Consumers: info.State.Consumers,
+		FirstSeq:  info.State.FirstSeq,
+		LastSeq:   info.State.LastSeq,
+		FirstTime: info.State.FirstTime,
+		LastTime:  info.State.LastTime,
+		MaxAge:    maxAge(info.Config.MaxAge),
+		MaxBytes:  info.Config.MaxBytes,
+		MaxMsgs:   info.Config.MaxMsgs,
+	}
+}
+
+func maxAge(age time.Duration) string {
+	if age <= 0 {
+		return ""
+	}
+	return age.String()
+}
+
+// unlimited maps zero, meaning no limit to callers, to JetStream's -1
+func unlimited(limit int64) int64 {
+	if limit <= 0 {
+		return -1
+	}
+	return limit
+}
+
+// StorageAlert reports a stream whose storage crossed its alert threshold
+type StorageAlert struct {
+	Kind      string `json:"kind"`
+	Stream    string `json:"stream"`
+	Bytes     uint64 `json:"bytes"`
+	Threshold uint64 `json:"threshold"`
+	Above     bool   `json:"above"` // false when the storage dropped back below
+}
+
+// StorageMonitor alerts when the storage of a stream rises above its
+// threshold and again when it drops back below. The threshold of a stream is
+// the lower of an absolute size and a percentage of its byte limit.
+type StorageMonitor struct {
+	usage   func(ctx context.Context) ([]StreamUsage, error)
+	percent float64 // of the byte limit of a stream, zero to ignore the limit
+	bytes   uint64  // zero for no absolute threshold
+
+	mu    sync.Mutex
+	above map[string]bool // streams currently above their threshold
+}
+
+// NewStorageMonitor creates a monitor of the streams of admin
+func NewStorageMonitor(admin *StreamAdmin, percent float64, bytes int64) *StorageMonitor {
+	return newStorageMonitor(admin.Usage, percent, bytes)
+}
+
+func newStorageMonitor(usage func(ctx context.Context) ([]StreamUsage, error), percent float64, bytes int64) *StorageMonitor {
+	return &StorageMonitor{
+		usage:   usage,
+		percent: percent,
+		bytes:   uint64(max(bytes, 0)),
+		above:   make(map[string]bool),
+	}
+}
+
+// Check reads the storage of the streams and returns the alerts of the
+// streams that crossed their threshold since the last check
+func (m *StorageMonitor) Check(ctx context.Context) ([]StorageAlert, error) {
+	usage, err := m.usage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var alerts []StorageAlert
+	for _, stream := range usage {
+		threshold := m.threshold(stream)
+		above := threshold > 0 && stream.Bytes >= threshold
+		if above == m.above[stream.Stream] {
+			continue
+		}
+		m.above[stream.Stream] = above
+		alerts = append(alerts, StorageAlert{
+			Kind:      stream.Kind,
+			Stream:    stream.Stream,
+			Bytes:     stream.Bytes,
+			Threshold: threshold,
+			Above:     above,
+		})
+	}
+	return alerts, nil
+}
+
+// threshold returns the alert threshold of a stream in bytes, zero for none
+func (m *StorageMonitor) threshold(stream StreamUsage) uint64 {
+	threshold := m.bytes
+	if m.percent > 0 && stream.MaxBytes > 0 {
+		relative := uint64(float64(stream.MaxBytes) * m.percent / 100)
+		if threshold == 0 || relative < threshold {
+			threshold = relative
+		}
+	}
+	return threshold
+}
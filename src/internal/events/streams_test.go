@@ -0,0 +1,202 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// fakeStream implements the parts of jetstream.Stream the stream admin uses
+type fakeStream struct {
+	jetstream.Stream
+	info   jetstream.StreamInfo
+	purges int
+	purge  func(s *fakeStream) // applies a purge to info
+}
+
+func (s *fakeStream) Info(ctx context.Context, opts ...jetstream.StreamInfoOpt) (*jetstream.StreamInfo, error) {
+	info := s.info
+	return &info, nil
+}
+
+func (s *fakeStream) Purge(ctx context.Context, opts ...jetstream.StreamPurgeOpt) error {
+	s.purges++
+	if s.purge != nil {
+		s.purge(s)
+	}
+	return nil
+}
+
+type fakeStreamStore struct {
+	streams map[string]*fakeStream
+	updated []jetstream.StreamConfig
+}
+
+func (f *fakeStreamStore) Stream(ctx context.Context, name string) (jetstream.Stream, error) {
+	stream, ok := f.streams[name]
+	if !ok {
+		return nil, jetstream.ErrStreamNotFound
+	}
+	return stream, nil
+}
+
+func (f *fakeStreamStore) UpdateStream(ctx context.Context, cfg jetstream.StreamConfig) (jetstream.Stream, error) {
+	f.updated = append(f.updated, cfg)
+	stream := f.streams[cfg.Name]
+	stream.info.Config = cfg
+	return stream, nil
+}
+
+func testStreamAdmin() (*StreamAdmin, *fakeStreamStore) {
+	now := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+	store := &fakeStreamStore{streams: map[string]*fakeStream{
+		"ACME_FIREDRAGON_EVENTS": {info: jetstream.StreamInfo{
+			Config: jetstream.StreamConfig{Name: "ACME_FIREDRAGON_EVENTS", MaxBytes: -1, MaxMsgs: -1},
+			State: jetstream.StreamState{
+				Msgs: 100, Bytes: 4096, FirstSeq: 1, LastSeq: 100, NumSubjects: 3,
+				FirstTime: now.Add(-30 * 24 * time.Hour), LastTime: now,
+			},
+		}},
+		"KV_ACME_FIREDRAGON_DEDUPE": {info: jetstream.StreamInfo{
+			Config: jetstream.StreamConfig{Name: "KV_ACME_FIREDRAGON_DEDUPE", MaxAge: 24 * time.Hour, MaxBytes: -1, MaxMsgs: -1},
+			State:  jetstream.StreamState{Msgs: 10, Bytes: 512},
+		}},
+	}}
+	admin := newStreamAdmin(store, internal.NATSConfig{
+		Namespace:    "acme",
+		Stream:       "FIREDRAGON_EVENTS",
+		DedupeBucket: "FIREDRAGON_DEDUPE",
+		LeaderBucket: "FIREDRAGON_LEADER",
+	})
+	return admin, store
+}
+
+func TestStreamAdmin_Usage(t *testing.T) {
+	admin, _ := testStreamAdmin()
+
+	usage, err := admin.Usage(context.Background())
+	if err != nil {
+		t.Fatalf("Usage() error = %v", err)
+	}
+	// The leader bucket does not exist yet
+	if len(usage) != 2 || usage[0].Kind != StreamDedupe || usage[1].Kind != StreamEvents {
+		t.Fatalf("Usage() = %+v, want the dedupe and events streams", usage)
+	}
+	if usage[1].Stream != "ACME_FIREDRAGON_EVENTS" || usage[1].Messages != 100 || usage[1].Bytes != 4096 || usage[1].MaxAge != "" {
+		t.Errorf("events usage = %+v", usage[1])
+	}
+	if usage[0].MaxAge != "24h0m0s" {
+		t.Errorf("dedupe max age = %q, want 24h0m0s", usage[0].MaxAge)
+	}
+}
+
+func TestStreamAdmin_UnknownStream(t *testing.T) {
+	admin, _ := testStreamAdmin()
+
+	for _, name := range []string{"OTHER_APP", "leader"} {
+		if _, err := admin.Purge(context.Background(), name, PurgeOptions{}); !errors.Is(err, ErrUnknownStream) {
+			t.Errorf("Purge(%q) error = %v, want ErrUnknownStream", name, err)
+		}
+	}
+}
+
+func TestStreamAdmin_Purge(t *testing.T) {
+	admin, store := testStreamAdmin()
+	events := store.streams["ACME_FIREDRAGON_EVENTS"]
+	events.purge = func(s *fakeStream) { s.info.State.Msgs = 40 }
+
+	var cutoff time.Time
+	admin.sequenceAt = func(ctx context.Context, stream jetstream.Stream, subject string, t time.Time) (uint64, error) {
+		cutoff = t
+		return 61, nil
+	}
+
+	before := events.info.State.LastTime.Add(-7 * 24 * time.Hour)
+	result, err := admin.Purge(context.Background(), "events", PurgeOptions{Before: before})
+	if err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+	if result.Stream != "ACME_FIREDRAGON_EVENTS" || result.Purged != 60 || !cutoff.Equal(before) {
+		t.Errorf("Purge() = %+v with cutoff %v", result, cutoff)
+	}
+
+	// Nothing is older than the first message
+	result, err = admin.Purge(context.Background(), "ACME_FIREDRAGON_EVENTS", PurgeOptions{Before: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+	if result.Purged != 0 || events.purges != 1 {
+		t.Errorf("Purge() before the first message = %+v after %d purges, want nothing purged", result, events.purges)
+	}
+}
+
+func TestStreamAdmin_SetRetention(t *testing.T) {
+	admin, store := testStreamAdmin()
+
+	maxAge := 90 * 24 * time.Hour
+	maxBytes := int64(0)
+	usage, err := admin.SetRetention(context.Background(), "events", Retention{MaxAge: &maxAge, MaxBytes: &maxBytes})
+	if err != nil {
+		t.Fatalf("SetRetention() error = %v", err)
+	}
+	if len(store.updated) != 1 || store.updated[0].MaxAge != maxAge || store.updated[0].MaxBytes != -1 || store.updated[0].MaxMsgs != -1 {
+		t.Errorf("updated config = %+v", store.updated)
+	}
+	if usage.MaxAge != "2160h0m0s" || usage.Kind != StreamEvents {
+		t.Errorf("SetRetention() = %+v", usage)
+	}
+
+	if _, err := admin.SetRetention(context.Background(), "dedupe", Retention{MaxAge: &maxAge}); !errors.Is(err, ErrRetentionFixed) {
+		t.Errorf("SetRetention(dedupe) error = %v, want ErrRetentionFixed", err)
+	}
+}
+
+func TestStorageMonitor(t *testing.T) {
+	usage := []StreamUsage{
+		{Kind: StreamEvents, Stream: "EVENTS", Bytes: 700, MaxBytes: 1000},
+		{Kind: StreamDedupe, Stream: "KV_DEDUPE", Bytes: 300, MaxBytes: -1},
+	}
+	monitor := newStorageMonitor(func(ctx context.Context) ([]StreamUsage, error) {
+		return usage, nil
+	}, 80, 500)
+
+	check := func() []StorageAlert {
+		t.Helper()
+		alerts, err := monitor.Check(context.Background())
+		if err != nil {
+			t.Fatalf("Check() error = %v", err)
+		}
+		return alerts
+	}
+
+	// The absolute threshold is below 80% of the limit
+	alerts := check()
+	if len(alerts) != 1 || alerts[0].Stream != "EVENTS" || !alerts[0].Above || alerts[0].Threshold != 500 {
+		t.Fatalf("first Check() = %+v, want EVENTS above 500 bytes", alerts)
+	}
+	if alerts := check(); len(alerts) != 0 {
+		t.Errorf("second Check() = %+v, want no repeated alert", alerts)
+	}
+
+	usage[0].Bytes = 100
+	usage[1].Bytes = 600
+	alerts = check()
+	if len(alerts) != 2 || alerts[0].Above || alerts[0].Stream != "EVENTS" || !alerts[1].Above || alerts[1].Threshold != 500 {
+		t.Errorf("third Check() = %+v, want EVENTS back below and KV_DEDUPE above", alerts)
+	}
+
+	relative := newStorageMonitor(func(ctx context.Context) ([]StreamUsage, error) {
+		return []StreamUsage{{Stream: "EVENTS", Bytes: 850, MaxBytes: 1000}, {Stream: "KV", Bytes: 1 << 30, MaxBytes: -1}}, nil
+	}, 80, 0)
+	alerts, err := relative.Check(context.Background())
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].Threshold != 800 {
+		t.Errorf("Check() without an absolute threshold = %+v, want EVENTS above 800 bytes only", alerts)
+	}
+}
@@ -0,0 +1,67 @@
+package fx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CachingProvider memoizes rates from another provider.
+// Past dates are cached indefinitely since reference rates do not change;
+// today's (and undated) lookups expire after the configured TTL.
+type CachingProvider struct {
+	next RateProvider
+	ttl  time.Duration
+
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	rate      float64
+	expiresAt time.Time // zero means never
+}
+
+// NewCachingProvider wraps a provider with a rate cache
+func NewCachingProvider(next RateProvider, ttl time.Duration) *CachingProvider {
+	return &CachingProvider{
+		next:    next,
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// Name returns the wrapped provider name
+func (p *CachingProvider) Name() string {
+	return p.next.Name()
+}
+
+// GetRate returns the cached rate or fetches it from the wrapped provider
+func (p *CachingProvider) GetRate(ctx context.Context, from, to string, date time.Time) (float64, error) {
+	from, to = normalizePair(from, to)
+	key := from + "|" + to + "|" + dateKey(date)
+
+	p.mu.RLock()
+	entry, ok := p.entries[key]
+	p.mu.RUnlock()
+	if ok && (entry.expiresAt.IsZero() || time.Now().Before(entry.expiresAt)) {
+		return entry.rate, nil
+	}
+
+	rate, err := p.next.GetRate(ctx, from, to, date)
+	if err != nil {
+		return 0, err
+	}
+
+	// Only today's (and undated) rates can still change
+	entry = cacheEntry{rate: rate}
+	if dateKey(date) >= dateKey(time.Now()) {
+		entry.expiresAt = time.Now().Add(p.ttl)
+	}
+
+	p.mu.Lock()
+	p.entries[key] = entry
+	p.mu.Unlock()
+
+	return rate, nil
+}
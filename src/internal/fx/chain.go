@@ -0,0 +1,53 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ChainProvider tries each provider in order and returns the first successful rate
+type ChainProvider struct {
+	providers []RateProvider
+}
+
+// NewChainProvider creates a fallback chain of providers
+func NewChainProvider(providers ...RateProvider) *ChainProvider {
+	return &ChainProvider{
+		providers: providers,
+	}
+}
+
+// Name returns the provider name
+func (p *ChainProvider) Name() string {
+	return "chain"
+}
+
+// GetRate returns the first rate any provider in the chain can supply
+func (p *ChainProvider) GetRate(ctx context.Context, from, to string, date time.Time) (float64, error) {
+	from, to = normalizePair(from, to)
+	if from == to {
+		return 1, nil
+	}
+
+	var errs []error
+	for _, provider := range p.providers {
+		rate, err := provider.GetRate(ctx, from, to, date)
+		if err == nil {
+			return rate, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+
+		// Stop early if the caller gave up
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	if len(errs) == 0 {
+		return 0, fmt.Errorf("%s/%s: no providers configured: %w", from, to, ErrRateNotFound)
+	}
+
+	return 0, errors.Join(errs...)
+}
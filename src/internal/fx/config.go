@@ -0,0 +1,44 @@
+package fx
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// NewProviderFromConfig builds the cached fallback chain described by the configuration
+func NewProviderFromConfig(cfg internal.FXConfig, httpClient *http.Client) (RateProvider, error) {
+	manual := NewManualProvider()
+	for _, override := range cfg.Overrides {
+		var date time.Time
+		if override.Date != "" {
+			parsed, err := time.Parse(time.DateOnly, override.Date)
+			if err != nil {
+				return nil, fmt.Errorf("invalid fx override date %q: %w", override.Date, err)
+			}
+			date = parsed
+		}
+		if err := manual.Set(override.From, override.To, date, override.Rate); err != nil {
+			return nil, err
+		}
+	}
+
+	providers := make([]RateProvider, 0, len(cfg.Providers))
+	for _, name := range cfg.Providers {
+		switch name {
+		case "manual":
+			// Overrides are never cached so edits apply immediately
+			providers = append(providers, manual)
+		case "ecb":
+			providers = append(providers, NewCachingProvider(NewECBProvider(httpClient), cfg.CacheTTL))
+		case "exchangerate_host":
+			providers = append(providers, NewCachingProvider(NewExchangeRateHostProvider(cfg.ExchangeRateHostKey, httpClient), cfg.CacheTTL))
+		default:
+			return nil, fmt.Errorf("unknown fx provider %q", name)
+		}
+	}
+
+	return NewChainProvider(providers...), nil
+}
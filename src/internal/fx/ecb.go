@@ -0,0 +1,190 @@
+package fx
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	ecbDailyURL  = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+	ecbHist90URL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist-90d.xml"
+	ecbHistURL   = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist.xml"
+
+	// ecbLookback is how many days we walk back to skip weekends and TARGET holidays
+	ecbLookback = 7
+)
+
+// ECBProvider serves the European Central Bank euro foreign exchange reference rates.
+// Rates are published per business day against EUR; other pairs are derived as cross rates.
+type ECBProvider struct {
+	httpClient *http.Client
+	baseURLs   ecbURLs
+
+	mu   sync.RWMutex
+	days map[string]map[string]float64 // date -> currency -> rate per EUR
+	// fetched records when each feed was last downloaded, so it is refreshed at most once per period
+	fetched map[string]time.Time
+}
+
+type ecbURLs struct {
+	daily, hist90, hist string
+}
+
+// ecbEnvelope matches the gesmes envelope published by the ECB
+type ecbEnvelope struct {
+	Cube struct {
+		Days []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string  `xml:"currency,attr"`
+				Rate     float64 `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+// NewECBProvider creates an ECB reference-rate provider
+func NewECBProvider(httpClient *http.Client) *ECBProvider {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &ECBProvider{
+		httpClient: httpClient,
+		baseURLs:   ecbURLs{daily: ecbDailyURL, hist90: ecbHist90URL, hist: ecbHistURL},
+		days:       make(map[string]map[string]float64),
+		fetched:    make(map[string]time.Time),
+	}
+}
+
+// Name returns the provider name
+func (p *ECBProvider) Name() string {
+	return "ecb"
+}
+
+// GetRate returns the ECB cross rate for the pair on the given date, or the closest previous business day
+func (p *ECBProvider) GetRate(ctx context.Context, from, to string, date time.Time) (float64, error) {
+	from, to = normalizePair(from, to)
+	if from == to {
+		return 1, nil
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	if err := p.ensureLoaded(ctx, date); err != nil {
+		return 0, err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for i := 0; i <= ecbLookback; i++ {
+		day, ok := p.days[date.AddDate(0, 0, -i).Format(time.DateOnly)]
+		if !ok {
+			continue
+		}
+
+		fromRate, err := eurRate(day, from)
+		if err != nil {
+			return 0, err
+		}
+		toRate, err := eurRate(day, to)
+		if err != nil {
+			return 0, err
+		}
+
+		return toRate / fromRate, nil
+	}
+
+	return 0, fmt.Errorf("ecb %s/%s on %s: %w", from, to, dateKey(date), ErrRateNotFound)
+}
+
+// eurRate returns the number of units of currency per EUR
+func eurRate(day map[string]float64, currency string) (float64, error) {
+	if currency == "EUR" {
+		return 1, nil
+	}
+	rate, ok := day[currency]
+	if !ok || rate <= 0 {
+		return 0, fmt.Errorf("ecb %s: %w", currency, ErrUnsupportedCurrency)
+	}
+	return rate, nil
+}
+
+// ensureLoaded downloads the smallest feed that covers the requested date
+func (p *ECBProvider) ensureLoaded(ctx context.Context, date time.Time) error {
+	age := time.Since(date)
+
+	url := p.baseURLs.hist
+	switch {
+	case age < 48*time.Hour:
+		url = p.baseURLs.daily
+	case age < 85*24*time.Hour:
+		url = p.baseURLs.hist90
+	}
+
+	// Weekends are never published, so look for the Friday before
+	day := date
+	for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	p.mu.RLock()
+	lastFetch, ok := p.fetched[url]
+	_, haveDay := p.days[day.Format(time.DateOnly)]
+	p.mu.RUnlock()
+
+	// The daily feed is refreshed every few hours. The historical feeds gain
+	// each business day once it is published, so a feed missing the day is
+	// fetched again when it is older than a day.
+	maxAge := 24 * time.Hour
+	if url == p.baseURLs.daily {
+		maxAge = 6 * time.Hour
+	}
+	if haveDay || (ok && time.Since(lastFetch) < maxAge) {
+		return nil
+	}
+
+	return p.load(ctx, url)
+}
+
+// load fetches and parses an ECB feed into the in-memory table
+func (p *ECBProvider) load(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create ecb request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch ecb rates: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("ecb returned non-200 status: %d", resp.StatusCode)
+	}
+
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to decode ecb rates: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, day := range envelope.Cube.Days {
+		rates := make(map[string]float64, len(day.Rates))
+		for _, r := range day.Rates {
+			rates[r.Currency] = r.Rate
+		}
+		p.days[day.Time] = rates
+	}
+	p.fetched[url] = time.Now()
+
+	return nil
+}
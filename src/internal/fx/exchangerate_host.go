@@ -0,0 +1,91 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const exchangeRateHostBaseURL = "https://api.exchangerate.host"
+
+// ExchangeRateHostProvider serves rates from the exchangerate.host API
+type ExchangeRateHostProvider struct {
+	baseURL    string
+	accessKey  string
+	httpClient *http.Client
+}
+
+// NewExchangeRateHostProvider creates an exchangerate.host provider
+func NewExchangeRateHostProvider(accessKey string, httpClient *http.Client) *ExchangeRateHostProvider {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &ExchangeRateHostProvider{
+		baseURL:    exchangeRateHostBaseURL,
+		accessKey:  accessKey,
+		httpClient: httpClient,
+	}
+}
+
+// Name returns the provider name
+func (p *ExchangeRateHostProvider) Name() string {
+	return "exchangerate.host"
+}
+
+// GetRate converts one unit of `from` into `to` on the given date
+func (p *ExchangeRateHostProvider) GetRate(ctx context.Context, from, to string, date time.Time) (float64, error) {
+	from, to = normalizePair(from, to)
+	if from == to {
+		return 1, nil
+	}
+
+	params := url.Values{}
+	params.Set("from", from)
+	params.Set("to", to)
+	params.Set("amount", "1")
+	if !date.IsZero() {
+		params.Set("date", date.Format(time.DateOnly))
+	}
+	if p.accessKey != "" {
+		params.Set("access_key", p.accessKey)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/convert?"+params.Encode(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create exchangerate.host request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch exchangerate.host rate: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("exchangerate.host returned non-200 status: %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Success bool    `json:"success"`
+		Result  float64 `json:"result"`
+		Error   *struct {
+			Code int    `json:"code"`
+			Info string `json:"info"`
+		} `json:"error,omitempty"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("failed to decode exchangerate.host response: %w", err)
+	}
+
+	if result.Error != nil {
+		return 0, fmt.Errorf("exchangerate.host error %d: %s", result.Error.Code, result.Error.Info)
+	}
+	if !result.Success || result.Result <= 0 {
+		return 0, fmt.Errorf("exchangerate.host %s/%s on %s: %w", from, to, dateKey(date), ErrRateNotFound)
+	}
+
+	return result.Result, nil
+}
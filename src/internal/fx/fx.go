@@ -0,0 +1,41 @@
+// Package fx provides exchange-rate lookups between currencies, with
+// pluggable backends, caching and fallback chaining.
+package fx
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrRateNotFound is returned when a provider has no rate for the requested pair and date
+	ErrRateNotFound = errors.New("exchange rate not found")
+
+	// ErrUnsupportedCurrency is returned when a provider does not know one of the currencies
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+)
+
+// RateProvider returns the exchange rate between two currencies on a given date.
+// The rate is the amount of `to` currency for one unit of `from`.
+type RateProvider interface {
+	// GetRate returns the rate for the pair on the given date (zero date means latest)
+	GetRate(ctx context.Context, from, to string, date time.Time) (float64, error)
+
+	// Name returns the provider name used in logs and errors
+	Name() string
+}
+
+// normalizePair upper-cases and trims a currency pair
+func normalizePair(from, to string) (string, string) {
+	return strings.ToUpper(strings.TrimSpace(from)), strings.ToUpper(strings.TrimSpace(to))
+}
+
+// dateKey formats a date for map keys and query parameters, using today for the zero date
+func dateKey(date time.Time) string {
+	if date.IsZero() {
+		date = time.Now()
+	}
+	return date.Format(time.DateOnly)
+}
@@ -0,0 +1,154 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const ecbFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2025-03-21">
+			<Cube currency="USD" rate="1.0800"/>
+			<Cube currency="GBP" rate="0.8400"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestECBProvider_GetRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(ecbFixture))
+	}))
+	defer server.Close()
+
+	provider := NewECBProvider(server.Client())
+	provider.baseURLs = ecbURLs{daily: server.URL, hist90: server.URL, hist: server.URL}
+
+	friday := time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC)
+	sunday := time.Date(2025, 3, 23, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		from, to string
+		date     time.Time
+		want     float64
+	}{
+		{"EUR to USD", "EUR", "USD", friday, 1.08},
+		{"USD to EUR", "usd", "eur", friday, 1 / 1.08},
+		{"Cross rate", "USD", "GBP", friday, 0.84 / 1.08},
+		{"Weekend uses previous business day", "EUR", "GBP", sunday, 0.84},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := provider.GetRate(context.Background(), tt.from, tt.to, tt.date)
+			if err != nil {
+				t.Fatalf("GetRate() returned unexpected error: %v", err)
+			}
+			if !almostEqual(got, tt.want) {
+				t.Errorf("GetRate() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := provider.GetRate(context.Background(), "EUR", "JPY", friday); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("GetRate() error = %v, want %v", err, ErrUnsupportedCurrency)
+	}
+}
+
+// A historical feed loaded before a business day was published is fetched
+// again for that day once it is a day old, and not before
+func TestECBProvider_RefetchesStaleHistoricalFeeds(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(ecbFixture))
+	}))
+	defer server.Close()
+
+	provider := NewECBProvider(server.Client())
+	provider.baseURLs = ecbURLs{daily: server.URL + "/daily", hist90: server.URL + "/hist90", hist: server.URL + "/hist"}
+	monday := time.Date(2025, 3, 24, 0, 0, 0, 0, time.UTC)
+
+	// The feed was fetched an hour ago, before Monday was published, and held
+	// no earlier days either
+	provider.fetched[provider.baseURLs.hist] = time.Now().Add(-time.Hour)
+	if _, err := provider.GetRate(context.Background(), "EUR", "USD", monday); !errors.Is(err, ErrRateNotFound) {
+		t.Fatalf("GetRate() error = %v, want %v", err, ErrRateNotFound)
+	}
+	if requests != 0 {
+		t.Errorf("requests = %d, want 0 for a feed fetched within the day", requests)
+	}
+
+	provider.fetched[provider.baseURLs.hist] = time.Now().Add(-25 * time.Hour)
+	if _, err := provider.GetRate(context.Background(), "EUR", "USD", monday); err != nil {
+		t.Fatalf("GetRate() returned unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1 for a feed older than a day missing the date", requests)
+	}
+
+	// Sunday falls back to Friday, which the feed has
+	provider.fetched[provider.baseURLs.hist] = time.Now().Add(-25 * time.Hour)
+	if _, err := provider.GetRate(context.Background(), "EUR", "USD", monday.AddDate(0, 0, -1)); err != nil {
+		t.Fatalf("GetRate() returned unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want no refetch for a weekend covered by Friday", requests)
+	}
+}
+
+func TestManualProvider_GetRate(t *testing.T) {
+	provider := NewManualProvider()
+	day := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	if err := provider.Set("USD", "CHF", time.Time{}, 0.9); err != nil {
+		t.Fatalf("Set() returned unexpected error: %v", err)
+	}
+	if err := provider.Set("USD", "CHF", day, 0.8); err != nil {
+		t.Fatalf("Set() returned unexpected error: %v", err)
+	}
+
+	if got, _ := provider.GetRate(context.Background(), "USD", "CHF", day); !almostEqual(got, 0.8) {
+		t.Errorf("Expected dated override 0.8, got %f", got)
+	}
+	if got, _ := provider.GetRate(context.Background(), "USD", "CHF", day.AddDate(0, 0, 1)); !almostEqual(got, 0.9) {
+		t.Errorf("Expected undated override 0.9, got %f", got)
+	}
+	if got, _ := provider.GetRate(context.Background(), "CHF", "USD", day.AddDate(0, 0, 1)); !almostEqual(got, 1/0.9) {
+		t.Errorf("Expected inverse override %f, got %f", 1/0.9, got)
+	}
+	if _, err := provider.GetRate(context.Background(), "USD", "JPY", day); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("GetRate() error = %v, want %v", err, ErrRateNotFound)
+	}
+}
+
+func TestChainProvider_FallsBack(t *testing.T) {
+	empty := NewManualProvider()
+	fallback := NewManualProvider()
+	fallback.Set("EUR", "USD", time.Time{}, 1.1)
+
+	chain := NewChainProvider(empty, fallback)
+
+	got, err := chain.GetRate(context.Background(), "EUR", "USD", time.Now())
+	if err != nil {
+		t.Fatalf("GetRate() returned unexpected error: %v", err)
+	}
+	if !almostEqual(got, 1.1) {
+		t.Errorf("GetRate() = %f, want 1.1", got)
+	}
+
+	if _, err := chain.GetRate(context.Background(), "EUR", "JPY", time.Now()); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("GetRate() error = %v, want %v", err, ErrRateNotFound)
+	}
+}
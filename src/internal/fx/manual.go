@@ -0,0 +1,72 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ManualProvider serves user-defined rate overrides.
+// An override without a date applies to every date that has no dated override.
+type ManualProvider struct {
+	mu    sync.RWMutex
+	rates map[string]float64 // from|to|date, date empty for undated overrides
+}
+
+// NewManualProvider creates an empty manual override table
+func NewManualProvider() *ManualProvider {
+	return &ManualProvider{
+		rates: make(map[string]float64),
+	}
+}
+
+// Name returns the provider name
+func (p *ManualProvider) Name() string {
+	return "manual"
+}
+
+// Set stores an override for the pair. A zero date makes the override apply to all dates.
+func (p *ManualProvider) Set(from, to string, date time.Time, rate float64) error {
+	if rate <= 0 {
+		return fmt.Errorf("invalid manual rate %f for %s/%s", rate, from, to)
+	}
+
+	from, to = normalizePair(from, to)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rates[manualKey(from, to, date)] = rate
+
+	return nil
+}
+
+// GetRate returns the dated override, then the undated one, trying the inverse pair as well
+func (p *ManualProvider) GetRate(ctx context.Context, from, to string, date time.Time) (float64, error) {
+	from, to = normalizePair(from, to)
+	if from == to {
+		return 1, nil
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	// Dated overrides win over undated ones
+	for _, d := range []time.Time{date, {}} {
+		if rate, ok := p.rates[manualKey(from, to, d)]; ok {
+			return rate, nil
+		}
+		if rate, ok := p.rates[manualKey(to, from, d)]; ok {
+			return 1 / rate, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%s/%s on %s: %w", from, to, dateKey(date), ErrRateNotFound)
+}
+
+func manualKey(from, to string, date time.Time) string {
+	if date.IsZero() {
+		return from + "|" + to + "|"
+	}
+	return from + "|" + to + "|" + date.Format(time.DateOnly)
+}
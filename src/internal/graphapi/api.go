@@ -0,0 +1,164 @@
+// Package graphapi serves the read-only GraphQL API over wallets,
+// transactions, categories and the net worth and tag spend reports.
+package graphapi
+
+import (
+	"context"
+	_ "embed"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schema string
+
+const (
+	// maxPageSize bounds first, larger pages are refused
+	maxPageSize = 500
+	// maxDepth bounds the nesting of a query, e.g. wallet.transactions.wallet...
+	maxDepth = 10
+)
+
+// Request is a GraphQL request as posted by clients
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// API executes GraphQL requests against the repositories and services
+type API struct {
+	schema       *graphql.Schema
+	wallets      repositories.WalletRepository
+	categories   repositories.CategoryRepository
+	transactions repositories.TransactionRepository
+	valuation    *usecases.ValuationService
+	tags         *usecases.TagService
+	preferences  *usecases.PreferencesService
+}
+
+// NewAPI parses the schema and binds it to the repositories and services
+func NewAPI(
+	wallets repositories.WalletRepository,
+	categories repositories.CategoryRepository,
+	transactions repositories.TransactionRepository,
+	valuation *usecases.ValuationService,
+	tags *usecases.TagService,
+	preferences *usecases.PreferencesService,
+) (*API, error) {
+	api := &API{
+		wallets:      wallets,
+		categories:   categories,
+		transactions: transactions,
+		valuation:    valuation,
+		tags:         tags,
+		preferences:  preferences,
+	}
+
+	parsed, err := graphql.ParseSchema(schema, &queryResolver{api: api}, graphql.MaxDepth(maxDepth))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GraphQL schema: %w", err)
+	}
+	api.schema = parsed
+	return api, nil
+}
+
+// Exec executes a request for an actor. Errors are reported in the response,
+// next to the data that could be resolved.
+func (a *API) Exec(ctx context.Context, actor usecases.SpaceActor, request Request) *graphql.Response {
+	ctx = context.WithValue(ctx, requestKey{}, a.newRequestState(actor))
+	return a.schema.Exec(ctx, request.Query, request.OperationName, request.Variables)
+}
+
+// requestKey is the context key of the requestState
+type requestKey struct{}
+
+// requestState is what the resolvers of one request share: the actor and the
+// loaders that batch and cache its wallet and category lookups
+type requestState struct {
+	actor      usecases.SpaceActor
+	wallets    *loader[walletResolver]
+	categories *loader[categoryResolver]
+}
+
+// newRequestState creates the state of a request by an actor
+func (a *API) newRequestState(actor usecases.SpaceActor) *requestState {
+	state := &requestState{actor: actor}
+	state.wallets = newLoader(func(ctx context.Context, ids []string) (map[string]*walletResolver, error) {
+		wallets, err := a.wallets.FindAll(ctx, repositories.WalletFilter{
+			IDs:             ids,
+			VisibleTo:       state.visibleTo(),
+			IncludeArchived: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load wallets: %w", err)
+		}
+		resolvers := make(map[string]*walletResolver, len(wallets))
+		for _, wallet := range wallets {
+			resolvers[wallet.ID] = &walletResolver{api: a, wallet: wallet}
+		}
+		return resolvers, nil
+	})
+	state.categories = newLoader(func(ctx context.Context, ids []string) (map[string]*categoryResolver, error) {
+		categories, err := a.categories.FindAll(ctx, repositories.CategoryFilter{IDs: ids, VisibleTo: state.visibleTo()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load categories: %w", err)
+		}
+		resolvers := make(map[string]*categoryResolver, len(categories))
+		for _, category := range categories {
+			resolvers[category.ID] = &categoryResolver{category: category}
+		}
+		return resolvers, nil
+	})
+	return state
+}
+
+// visibleTo is the user the lists are scoped to, empty for superusers who
+// see every wallet
+func (s *requestState) visibleTo() string {
+	if s.actor.Superuser {
+		return ""
+	}
+	return s.actor.UserID
+}
+
+// stateFrom returns the state of the request being resolved
+func stateFrom(ctx context.Context) *requestState {
+	return ctx.Value(requestKey{}).(*requestState)
+}
+
+// cursorPrefix marks the offsets encoded in cursors
+const cursorPrefix = "offset:"
+
+// encodeCursor returns the opaque cursor of the item at an offset
+func encodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+// page resolves the first and after arguments of a connection to a limit and
+// the offset of the first item
+func page(first int32, after *string) (limit, offset int, err error) {
+	if first < 0 || first > maxPageSize {
+		return 0, 0, fmt.Errorf("first must be between 0 and %d", maxPageSize)
+	}
+	limit = int(first)
+	if after == nil {
+		return limit, 0, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(*after)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid cursor %q", *after)
+	}
+	last, err := strconv.Atoi(strings.TrimPrefix(string(decoded), cursorPrefix))
+	if err != nil || !strings.HasPrefix(string(decoded), cursorPrefix) || last < 0 {
+		return 0, 0, fmt.Errorf("invalid cursor %q", *after)
+	}
+	return limit, last + 1, nil
+}
@@ -0,0 +1,259 @@
+package graphapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+)
+
+// members maps the spaces to their users
+var members = map[string][]string{
+	"home":    {"alice"},
+	"company": {"bob"},
+}
+
+// visible reports whether a user sees the records of a space
+func visible(user, space string) bool {
+	return user == "" || space == "" || slices.Contains(members[space], user)
+}
+
+// fakeWallets lists wallets in order and counts the queries; the other
+// methods are not used
+type fakeWallets struct {
+	repositories.WalletRepository
+	wallets []*models.Wallet
+	queries int
+}
+
+func (r *fakeWallets) FindAll(ctx context.Context, filter repositories.WalletFilter) ([]*models.Wallet, error) {
+	r.queries++
+	var found []*models.Wallet
+	for _, wallet := range r.wallets {
+		if (len(filter.IDs) == 0 || slices.Contains(filter.IDs, wallet.ID)) && visible(filter.VisibleTo, wallet.SpaceID) {
+			found = append(found, wallet)
+		}
+	}
+	return window(found, filter.Offset, filter.Limit), nil
+}
+
+// fakeCategories lists categories in order; the other methods are not used
+type fakeCategories struct {
+	repositories.CategoryRepository
+	categories []*models.Category
+}
+
+func (r *fakeCategories) FindAll(ctx context.Context, filter repositories.CategoryFilter) ([]*models.Category, error) {
+	var found []*models.Category
+	for _, category := range r.categories {
+		if (len(filter.IDs) == 0 || slices.Contains(filter.IDs, category.ID)) && visible(filter.VisibleTo, category.SpaceID) {
+			found = append(found, category)
+		}
+	}
+	return found, nil
+}
+
+// fakeTransactions finds and lists the transactions of a wallet in order; the
+// other methods are not used
+type fakeTransactions struct {
+	repositories.TransactionRepository
+	transactions []*models.Transaction
+	wallets      *fakeWallets
+}
+
+func (r *fakeTransactions) FindAll(ctx context.Context, filter repositories.TransactionFilter) ([]*models.Transaction, error) {
+	var found []*models.Transaction
+	for _, tx := range r.transactions {
+		if filter.WalletID != "" && tx.WalletID != filter.WalletID {
+			continue
+		}
+		if i := slices.IndexFunc(r.wallets.wallets, func(w *models.Wallet) bool { return w.ID == tx.WalletID }); !visible(filter.VisibleTo, r.wallets.wallets[i].SpaceID) {
+			continue
+		}
+		found = append(found, tx)
+	}
+	return window(found, filter.Offset, filter.Limit), nil
+}
+
+func (r *fakeTransactions) FindByID(ctx context.Context, id string) (*models.Transaction, error) {
+	i := slices.IndexFunc(r.transactions, func(tx *models.Transaction) bool { return tx.ID == id })
+	if i < 0 {
+		return nil, sql.ErrNoRows
+	}
+	return r.transactions[i], nil
+}
+
+// window returns the items of a page, all from the offset without a limit
+func window[T any](items []T, offset, limit int) []T {
+	items = items[min(offset, len(items)):]
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+func newTestAPI(t *testing.T) (*API, *fakeWallets) {
+	t.Helper()
+	wallets := &fakeWallets{wallets: []*models.Wallet{
+		{ID: "cash", Name: "Cash", Currency: "EUR"},
+		{ID: "checking", Name: "Checking", Currency: "EUR", SpaceID: "home"},
+		{ID: "savings", Name: "Savings", Currency: "EUR", SpaceID: "home"},
+		{ID: "payroll", Name: "Payroll", Currency: "EUR", SpaceID: "company"},
+	}}
+	categories := &fakeCategories{categories: []*models.Category{
+		{ID: "food", Name: "Food"},
+		{ID: "groceries", Name: "Groceries", ParentID: "food", SpaceID: "home"},
+	}}
+	date := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	transactions := &fakeTransactions{wallets: wallets, transactions: []*models.Transaction{
+		{ID: "market", WalletID: "checking", CategoryID: "groceries", Amount: 40, Date: date, Metadata: map[string]string{"iban": "DE00"}},
+		{ID: "bakery", WalletID: "cash", CategoryID: "food", Amount: 5, Date: date},
+		{ID: "move", WalletID: "checking", DestWalletID: "savings", Amount: 100, Date: date},
+		{ID: "salaries", WalletID: "payroll", Amount: 9000, Date: date},
+	}}
+
+	api, err := NewAPI(wallets, categories, transactions, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewAPI() error = %v", err)
+	}
+	return api, wallets
+}
+
+// exec runs a query and decodes its data, failing on errors
+func exec(t *testing.T, api *API, actor usecases.SpaceActor, query string, variables map[string]any, data any) {
+	t.Helper()
+	response := api.Exec(context.Background(), actor, Request{Query: query, Variables: variables})
+	if len(response.Errors) > 0 {
+		t.Fatalf("Exec(%s) errors = %v", query, response.Errors)
+	}
+	if err := json.Unmarshal(response.Data, data); err != nil {
+		t.Fatalf("failed to decode %s: %v", response.Data, err)
+	}
+}
+
+type walletPage struct {
+	Wallets struct {
+		Edges []struct {
+			Node struct{ ID string }
+		}
+		PageInfo struct {
+			EndCursor   *string
+			HasNextPage bool
+		}
+	}
+}
+
+func (p walletPage) ids() []string {
+	var ids []string
+	for _, edge := range p.Wallets.Edges {
+		ids = append(ids, edge.Node.ID)
+	}
+	return ids
+}
+
+const walletsQuery = `query($after: String) {
+	wallets(first: 2, after: $after) { edges { node { id } } pageInfo { endCursor hasNextPage } }
+}`
+
+func TestAPI_WalletsArePagedWithinTheCallersSpaces(t *testing.T) {
+	api, _ := newTestAPI(t)
+	alice := usecases.SpaceActor{UserID: "alice"}
+
+	var first walletPage
+	exec(t, api, alice, walletsQuery, nil, &first)
+	if ids := first.ids(); !slices.Equal(ids, []string{"cash", "checking"}) || !first.Wallets.PageInfo.HasNextPage {
+		t.Fatalf("first page = %v, next %v; want [cash checking] and a next page", ids, first.Wallets.PageInfo.HasNextPage)
+	}
+
+	var second walletPage
+	exec(t, api, alice, walletsQuery, map[string]any{"after": *first.Wallets.PageInfo.EndCursor}, &second)
+	if ids := second.ids(); !slices.Equal(ids, []string{"savings"}) || second.Wallets.PageInfo.HasNextPage {
+		t.Errorf("second page = %v, next %v; want [savings] and no next page, without the payroll of another space", ids, second.Wallets.PageInfo.HasNextPage)
+	}
+
+	var other struct{ Wallet *struct{ ID string } }
+	exec(t, api, alice, `{ wallet(id: "payroll") { id } }`, nil, &other)
+	if other.Wallet != nil {
+		t.Errorf("wallet(payroll) = %v, want null for a wallet of another space", other.Wallet)
+	}
+
+	var all walletPage
+	exec(t, api, usecases.SpaceActor{Superuser: true}, `{ wallets(first: 10) { edges { node { id } } } }`, nil, &all)
+	if ids := all.ids(); len(ids) != 4 {
+		t.Errorf("superuser wallets = %v, want all 4", ids)
+	}
+}
+
+func TestAPI_LoadsTheWalletsOfAPageInOneQuery(t *testing.T) {
+	api, wallets := newTestAPI(t)
+
+	var data struct {
+		Transactions struct {
+			Edges []struct {
+				Node struct {
+					ID                string
+					Wallet            struct{ Name string }
+					DestinationWallet *struct{ Name string }
+					Category          *struct {
+						Name   string
+						Parent *struct{ Name string }
+					}
+				}
+			}
+		}
+	}
+	exec(t, api, usecases.SpaceActor{UserID: "alice"}, `{
+		transactions {
+			edges { node { id wallet { name } destinationWallet { name } category { name parent { name } } } }
+		}
+	}`, nil, &data)
+
+	edges := data.Transactions.Edges
+	if len(edges) != 3 {
+		t.Fatalf("transactions = %d, want the 3 of alice's wallets", len(edges))
+	}
+	if wallets.queries != 1 {
+		t.Errorf("wallet queries = %d, want 1 for the whole page", wallets.queries)
+	}
+	if market := edges[0].Node; market.Wallet.Name != "Checking" || market.Category == nil || market.Category.Parent == nil || market.Category.Parent.Name != "Food" {
+		t.Errorf("market = %+v, want the checking wallet and groceries under food", market)
+	}
+	if move := edges[2].Node; move.DestinationWallet == nil || move.DestinationWallet.Name != "Savings" {
+		t.Errorf("move destination = %v, want savings", move.DestinationWallet)
+	}
+}
+
+func TestAPI_MetadataIsOnlyResolvedForSuperusers(t *testing.T) {
+	api, _ := newTestAPI(t)
+	query := `{ transaction(id: "market") { id metadata { key value } } }`
+
+	response := api.Exec(context.Background(), usecases.SpaceActor{UserID: "alice"}, Request{Query: query})
+	if len(response.Errors) != 1 {
+		t.Fatalf("user errors = %v, want one for the metadata", response.Errors)
+	}
+	var partial struct {
+		Transaction struct {
+			ID       string
+			Metadata []any
+		}
+	}
+	if err := json.Unmarshal(response.Data, &partial); err != nil || partial.Transaction.ID != "market" || partial.Transaction.Metadata != nil {
+		t.Errorf("user data = %s, want the transaction without its metadata", response.Data)
+	}
+
+	var full struct {
+		Transaction struct {
+			Metadata []struct{ Key, Value string }
+		}
+	}
+	exec(t, api, usecases.SpaceActor{Superuser: true}, query, nil, &full)
+	if metadata := full.Transaction.Metadata; len(metadata) != 1 || metadata[0].Key != "iban" {
+		t.Errorf("superuser metadata = %v, want the iban", metadata)
+	}
+}
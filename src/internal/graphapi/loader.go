@@ -0,0 +1,64 @@
+package graphapi
+
+import (
+	"context"
+	"sync"
+)
+
+// loader batches the lookups of one kind of record by ID within a request.
+// A connection primes it with the IDs its page refers to, so they are loaded
+// in one query instead of one per item; later lookups hit the cache.
+type loader[T any] struct {
+	fetch func(ctx context.Context, ids []string) (map[string]*T, error)
+
+	mu    sync.Mutex
+	cache map[string]*T // nil for IDs that were not found or are not visible
+}
+
+// newLoader creates a loader that fetches the records of many IDs at once
+func newLoader[T any](fetch func(ctx context.Context, ids []string) (map[string]*T, error)) *loader[T] {
+	return &loader[T]{fetch: fetch, cache: make(map[string]*T)}
+}
+
+// prime loads the IDs that are not cached yet in one fetch. Empty IDs, e.g.
+// of a transaction without a category, are skipped.
+func (l *loader[T]) prime(ctx context.Context, ids ...string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	missing := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if _, ok := l.cache[id]; ok || id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		missing = append(missing, id)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	found, err := l.fetch(ctx, missing)
+	if err != nil {
+		return err
+	}
+	for _, id := range missing {
+		l.cache[id] = found[id]
+	}
+	return nil
+}
+
+// load returns the record of an ID, nil when it is empty, not found or not visible
+func (l *loader[T]) load(ctx context.Context, id string) (*T, error) {
+	if id == "" {
+		return nil, nil
+	}
+	if err := l.prime(ctx, id); err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.cache[id], nil
+}
@@ -0,0 +1,443 @@
+package graphapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+// queryResolver resolves the root query fields
+type queryResolver struct {
+	api *API
+}
+
+// connectionArgs are the pagination arguments of a connection
+type connectionArgs struct {
+	First int32 // the schema defaults it to the default page size
+	After *string
+}
+
+// Wallets resolves the wallets, by name
+func (r *queryResolver) Wallets(ctx context.Context, args struct {
+	connectionArgs
+	IncludeArchived bool
+}) (*walletConnection, error) {
+	limit, offset, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	state := stateFrom(ctx)
+	wallets, err := r.api.wallets.FindAll(ctx, repositories.WalletFilter{
+		VisibleTo:       state.visibleTo(),
+		IncludeArchived: args.IncludeArchived,
+		Limit:           limit + 1, // one more tells whether there is a next page
+		Offset:          offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+
+	connection := &walletConnection{pageInfo: &pageInfoResolver{hasNextPage: len(wallets) > limit}}
+	for i, wallet := range wallets[:min(limit, len(wallets))] {
+		connection.edges = append(connection.edges, &walletEdge{
+			cursor: encodeCursor(offset + i),
+			node:   &walletResolver{api: r.api, wallet: wallet},
+		})
+	}
+	connection.pageInfo.setEnd(offset, len(connection.edges))
+	return connection, nil
+}
+
+// Wallet resolves a visible wallet by ID
+func (r *queryResolver) Wallet(ctx context.Context, args struct{ ID graphql.ID }) (*walletResolver, error) {
+	return stateFrom(ctx).wallets.load(ctx, string(args.ID))
+}
+
+// Transactions resolves the transactions, newest first
+func (r *queryResolver) Transactions(ctx context.Context, args struct {
+	connectionArgs
+	WalletID   *graphql.ID
+	CategoryID *graphql.ID
+	From       *graphql.Time
+	To         *graphql.Time
+}) (*transactionConnection, error) {
+	filter := repositories.TransactionFilter{VisibleTo: stateFrom(ctx).visibleTo()}
+	if args.WalletID != nil {
+		filter.WalletID = string(*args.WalletID)
+	}
+	if args.CategoryID != nil {
+		filter.CategoryID = string(*args.CategoryID)
+	}
+	if args.From != nil {
+		filter.DateFrom = args.From.Time
+	}
+	if args.To != nil {
+		filter.DateTo = args.To.Time
+	}
+	return r.api.transactionPage(ctx, filter, args.connectionArgs)
+}
+
+// Transaction resolves a transaction by ID when its wallet is visible
+func (r *queryResolver) Transaction(ctx context.Context, args struct{ ID graphql.ID }) (*transactionResolver, error) {
+	tx, err := r.api.transactions.FindByID(ctx, string(args.ID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if tx.IsDeleted() {
+		return nil, nil
+	}
+
+	wallet, err := stateFrom(ctx).wallets.load(ctx, tx.WalletID)
+	if err != nil || wallet == nil {
+		return nil, err
+	}
+	return &transactionResolver{tx: tx}, nil
+}
+
+// Categories resolves the visible categories, by name
+func (r *queryResolver) Categories(ctx context.Context) ([]*categoryResolver, error) {
+	categories, err := r.api.categories.FindAll(ctx, repositories.CategoryFilter{VisibleTo: stateFrom(ctx).visibleTo()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	resolvers := make([]*categoryResolver, len(categories))
+	for i, category := range categories {
+		resolvers[i] = &categoryResolver{category: category}
+	}
+	return resolvers, nil
+}
+
+// NetWorth resolves the value of the visible wallets
+func (r *queryResolver) NetWorth(ctx context.Context, args struct {
+	Base            *string
+	IncludeArchived bool
+}) (*netWorthResolver, error) {
+	state := stateFrom(ctx)
+	opts := usecases.NetWorthOptions{
+		IncludeArchived: args.IncludeArchived,
+		VisibleTo:       state.visibleTo(),
+	}
+	if args.Base != nil {
+		opts.BaseCurrency = *args.Base
+	}
+	// Without a base users see their own base currency, like the REST routes
+	if opts.BaseCurrency == "" && !state.actor.Superuser && r.api.preferences != nil {
+		preferences, err := r.api.preferences.Get(ctx, state.actor.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load preferences: %w", err)
+		}
+		opts.BaseCurrency = preferences.BaseCurrency
+	}
+
+	netWorth, err := r.api.valuation.GetNetWorth(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &netWorthResolver{netWorth: netWorth}, nil
+}
+
+// TagSpend resolves the spend per tag of the visible wallets
+func (r *queryResolver) TagSpend(ctx context.Context, args struct {
+	Tag  *string
+	From *graphql.Time
+	To   *graphql.Time
+}) ([]*tagSpendResolver, error) {
+	filter := repositories.TagSpendFilter{VisibleTo: stateFrom(ctx).visibleTo()}
+	if args.Tag != nil {
+		filter.Tag = *args.Tag
+	}
+	if args.From != nil {
+		filter.DateFrom = args.From.Time
+	}
+	if args.To != nil {
+		filter.DateTo = args.To.Time
+	}
+
+	spend, err := r.api.tags.GetSpendReport(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*tagSpendResolver, len(spend))
+	for i, entry := range spend {
+		resolvers[i] = &tagSpendResolver{spend: entry}
+	}
+	return resolvers, nil
+}
+
+// transactionPage resolves a page of the transactions matching a filter and
+// primes the loaders with the wallets and categories the page refers to
+func (a *API) transactionPage(ctx context.Context, filter repositories.TransactionFilter, args connectionArgs) (*transactionConnection, error) {
+	limit, offset, err := page(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+	filter.Limit = limit + 1 // one more tells whether there is a next page
+	filter.Offset = offset
+
+	transactions, err := a.transactions.FindAll(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	connection := &transactionConnection{pageInfo: &pageInfoResolver{hasNextPage: len(transactions) > limit}}
+	transactions = transactions[:min(limit, len(transactions))]
+	walletIDs := make([]string, 0, 2*len(transactions))
+	categoryIDs := make([]string, 0, len(transactions))
+	for i, tx := range transactions {
+		connection.edges = append(connection.edges, &transactionEdge{
+			cursor: encodeCursor(offset + i),
+			node:   &transactionResolver{tx: tx},
+		})
+		walletIDs = append(walletIDs, tx.WalletID, tx.DestWalletID)
+		categoryIDs = append(categoryIDs, tx.CategoryID)
+	}
+	connection.pageInfo.setEnd(offset, len(connection.edges))
+
+	state := stateFrom(ctx)
+	if err := state.wallets.prime(ctx, walletIDs...); err != nil {
+		return nil, err
+	}
+	if err := state.categories.prime(ctx, categoryIDs...); err != nil {
+		return nil, err
+	}
+	return connection, nil
+}
+
+// pageInfoResolver resolves the position of a page
+type pageInfoResolver struct {
+	endCursor   *string
+	hasNextPage bool
+}
+
+// setEnd sets the cursor of the last of the count items from offset
+func (r *pageInfoResolver) setEnd(offset, count int) {
+	if count > 0 {
+		cursor := encodeCursor(offset + count - 1)
+		r.endCursor = &cursor
+	}
+}
+
+func (r *pageInfoResolver) EndCursor() *string { return r.endCursor }
+func (r *pageInfoResolver) HasNextPage() bool  { return r.hasNextPage }
+
+// walletResolver resolves the fields of a wallet
+type walletResolver struct {
+	api    *API
+	wallet *models.Wallet
+}
+
+func (r *walletResolver) ID() graphql.ID       { return graphql.ID(r.wallet.ID) }
+func (r *walletResolver) Name() string         { return r.wallet.Name }
+func (r *walletResolver) Description() string  { return r.wallet.Description }
+func (r *walletResolver) Balance() float64     { return r.wallet.Balance }
+func (r *walletResolver) Currency() string     { return r.wallet.Currency }
+func (r *walletResolver) Type() string         { return string(r.wallet.Type) }
+func (r *walletResolver) SpaceID() *graphql.ID { return optionalID(r.wallet.SpaceID) }
+func (r *walletResolver) Archived() bool       { return r.wallet.Archived }
+
+// Transactions resolves the transactions of the wallet, newest first
+func (r *walletResolver) Transactions(ctx context.Context, args connectionArgs) (*transactionConnection, error) {
+	return r.api.transactionPage(ctx, repositories.TransactionFilter{WalletID: r.wallet.ID}, args)
+}
+
+type walletConnection struct {
+	edges    []*walletEdge
+	pageInfo *pageInfoResolver
+}
+
+func (r *walletConnection) Edges() []*walletEdge        { return r.edges }
+func (r *walletConnection) PageInfo() *pageInfoResolver { return r.pageInfo }
+
+type walletEdge struct {
+	cursor string
+	node   *walletResolver
+}
+
+func (r *walletEdge) Cursor() string        { return r.cursor }
+func (r *walletEdge) Node() *walletResolver { return r.node }
+
+// transactionResolver resolves the fields of a transaction
+type transactionResolver struct {
+	tx *models.Transaction
+}
+
+func (r *transactionResolver) ID() graphql.ID      { return graphql.ID(r.tx.ID) }
+func (r *transactionResolver) Amount() float64     { return r.tx.Amount }
+func (r *transactionResolver) Description() string { return r.tx.Description }
+func (r *transactionResolver) Date() graphql.Time  { return graphql.Time{Time: r.tx.Date} }
+func (r *transactionResolver) Type() string        { return string(r.tx.Type) }
+func (r *transactionResolver) Status() string      { return string(r.tx.Status) }
+func (r *transactionResolver) Fee() float64        { return r.tx.Fee }
+func (r *transactionResolver) Notes() string       { return r.tx.Notes }
+func (r *transactionResolver) Reference() string   { return r.tx.Reference }
+
+// Tags resolves the tags, an empty list when there are none
+func (r *transactionResolver) Tags() []string {
+	if r.tx.Tags == nil {
+		return []string{}
+	}
+	return r.tx.Tags
+}
+
+// Wallet resolves the wallet through the loader the page primed
+func (r *transactionResolver) Wallet(ctx context.Context) (*walletResolver, error) {
+	return stateFrom(ctx).wallets.load(ctx, r.tx.WalletID)
+}
+
+// DestinationWallet resolves the wallet a transfer goes to, null when the
+// transfer leaves the caller's wallets
+func (r *transactionResolver) DestinationWallet(ctx context.Context) (*walletResolver, error) {
+	return stateFrom(ctx).wallets.load(ctx, r.tx.DestWalletID)
+}
+
+// Category resolves the category through the loader the page primed
+func (r *transactionResolver) Category(ctx context.Context) (*categoryResolver, error) {
+	return stateFrom(ctx).categories.load(ctx, r.tx.CategoryID)
+}
+
+// Metadata resolves the provider metadata, sorted by key. It exposes the
+// addresses and raw IDs of the providers, so only superusers may read it.
+func (r *transactionResolver) Metadata(ctx context.Context) (*[]*metadataEntry, error) {
+	if err := requireSuperuser(ctx, "metadata"); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(r.tx.Metadata))
+	for key := range r.tx.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	entries := make([]*metadataEntry, len(keys))
+	for i, key := range keys {
+		entries[i] = &metadataEntry{key: key, value: r.tx.Metadata[key]}
+	}
+	return &entries, nil
+}
+
+// FireflyID resolves the ID of the linked Firefly III transaction; superusers only
+func (r *transactionResolver) FireflyID(ctx context.Context) (*string, error) {
+	if err := requireSuperuser(ctx, "fireflyId"); err != nil {
+		return nil, err
+	}
+	if r.tx.FireflyID == "" {
+		return nil, nil
+	}
+	return &r.tx.FireflyID, nil
+}
+
+type transactionConnection struct {
+	edges    []*transactionEdge
+	pageInfo *pageInfoResolver
+}
+
+func (r *transactionConnection) Edges() []*transactionEdge   { return r.edges }
+func (r *transactionConnection) PageInfo() *pageInfoResolver { return r.pageInfo }
+
+type transactionEdge struct {
+	cursor string
+	node   *transactionResolver
+}
+
+func (r *transactionEdge) Cursor() string             { return r.cursor }
+func (r *transactionEdge) Node() *transactionResolver { return r.node }
+
+type metadataEntry struct {
+	key, value string
+}
+
+func (r *metadataEntry) Key() string   { return r.key }
+func (r *metadataEntry) Value() string { return r.value }
+
+// categoryResolver resolves the fields of a category
+type categoryResolver struct {
+	category *models.Category
+}
+
+func (r *categoryResolver) ID() graphql.ID       { return graphql.ID(r.category.ID) }
+func (r *categoryResolver) Name() string         { return r.category.Name }
+func (r *categoryResolver) Description() string  { return r.category.Description }
+func (r *categoryResolver) Type() string         { return string(r.category.Type) }
+func (r *categoryResolver) Color() string        { return r.category.Color }
+func (r *categoryResolver) SpaceID() *graphql.ID { return optionalID(r.category.SpaceID) }
+
+// Parent resolves the parent category through the loader
+func (r *categoryResolver) Parent(ctx context.Context) (*categoryResolver, error) {
+	return stateFrom(ctx).categories.load(ctx, r.category.ParentID)
+}
+
+// netWorthResolver resolves the fields of a net worth
+type netWorthResolver struct {
+	netWorth *usecases.NetWorth
+}
+
+func (r *netWorthResolver) BaseCurrency() string { return r.netWorth.BaseCurrency }
+func (r *netWorthResolver) Total() float64       { return r.netWorth.Total }
+func (r *netWorthResolver) AsOf() graphql.Time   { return graphql.Time{Time: r.netWorth.AsOf} }
+
+func (r *netWorthResolver) Wallets() []*walletValuationResolver {
+	resolvers := make([]*walletValuationResolver, len(r.netWorth.Wallets))
+	for i := range r.netWorth.Wallets {
+		resolvers[i] = &walletValuationResolver{valuation: &r.netWorth.Wallets[i]}
+	}
+	return resolvers
+}
+
+type walletValuationResolver struct {
+	valuation *usecases.WalletValuation
+}
+
+func (r *walletValuationResolver) WalletID() graphql.ID { return graphql.ID(r.valuation.WalletID) }
+func (r *walletValuationResolver) Name() string         { return r.valuation.Name }
+func (r *walletValuationResolver) Currency() string     { return r.valuation.Currency }
+func (r *walletValuationResolver) Balance() float64     { return r.valuation.Balance }
+func (r *walletValuationResolver) Rate() float64        { return r.valuation.Rate }
+func (r *walletValuationResolver) Value() float64       { return r.valuation.Value }
+
+func (r *walletValuationResolver) Error() *string {
+	if r.valuation.Error == "" {
+		return nil
+	}
+	return &r.valuation.Error
+}
+
+// tagSpendResolver resolves the fields of the spend of a tag
+type tagSpendResolver struct {
+	spend *models.TagSpend
+}
+
+func (r *tagSpendResolver) Tag() string             { return r.spend.Tag }
+func (r *tagSpendResolver) Currency() string        { return r.spend.Currency }
+func (r *tagSpendResolver) TransactionCount() int32 { return int32(r.spend.TransactionCount) }
+func (r *tagSpendResolver) Income() float64         { return r.spend.Income }
+func (r *tagSpendResolver) Expense() float64        { return r.spend.Expense }
+func (r *tagSpendResolver) Transfer() float64       { return r.spend.Transfer }
+func (r *tagSpendResolver) Net() float64            { return r.spend.Net() }
+
+// requireSuperuser refuses a field to everyone but superusers
+func requireSuperuser(ctx context.Context, field string) error {
+	if !stateFrom(ctx).actor.Superuser {
+		return fmt.Errorf("%s is only visible to superusers: %w", field, models.ErrSpaceAccessDenied)
+	}
+	return nil
+}
+
+// optionalID returns the ID, nil when it is empty
+func optionalID(id string) *graphql.ID {
+	if id == "" {
+		return nil
+	}
+	value := graphql.ID(id)
+	return &value
+}
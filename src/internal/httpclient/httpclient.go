@@ -0,0 +1,148 @@
+// Package httpclient builds the HTTP clients of the adapters calling external
+// services. Clients share pooled keep-alive connections, so an import storm
+// reuses warm TLS connections instead of handshaking for every request, and
+// each provider can have its own proxy and connection limits.
+package httpclient
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+// ProxyDirect disables the proxy of a provider, including one set in the environment
+const ProxyDirect = "direct"
+
+// Factory creates the HTTP clients of external providers. Providers without
+// transport overrides share one connection pool; the others get their own.
+type Factory struct {
+	cfg        internal.HTTPConfig
+	middleware func(http.RoundTripper) http.RoundTripper // optional: wraps every transport
+
+	mu         sync.Mutex
+	shared     *http.Transport
+	transports map[string]*http.Transport // providers with transport overrides
+}
+
+// New creates a factory tuned by the configuration
+func New(cfg internal.HTTPConfig) (*Factory, error) {
+	if _, err := proxyFunc(cfg.Proxy); err != nil {
+		return nil, fmt.Errorf("http.proxy: %w", err)
+	}
+	for provider, override := range cfg.Providers {
+		if _, err := proxyFunc(override.Proxy); err != nil {
+			return nil, fmt.Errorf("http.providers.%s.proxy: %w", provider, err)
+		}
+	}
+
+	return &Factory{cfg: cfg, transports: make(map[string]*http.Transport)}, nil
+}
+
+// WithMiddleware wraps the transport of every client created afterwards
+func (f *Factory) WithMiddleware(middleware func(http.RoundTripper) http.RoundTripper) *Factory {
+	f.middleware = middleware
+	return f
+}
+
+// Client returns a client for a provider, e.g. "firefly" or "ethereum"
+func (f *Factory) Client(provider string) *http.Client {
+	timeout := f.cfg.Timeout
+	if override, ok := f.cfg.Providers[provider]; ok && override.Timeout > 0 {
+		timeout = override.Timeout
+	}
+
+	var transport http.RoundTripper = f.transport(provider)
+	if f.middleware != nil {
+		transport = f.middleware(transport)
+	}
+	return &http.Client{Transport: transport, Timeout: timeout}
+}
+
+// CloseIdleConnections closes the pooled connections that are not in use
+func (f *Factory) CloseIdleConnections() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.shared != nil {
+		f.shared.CloseIdleConnections()
+	}
+	for _, transport := range f.transports {
+		transport.CloseIdleConnections()
+	}
+}
+
+// transport returns the transport of a provider, creating it on first use
+func (f *Factory) transport(provider string) *http.Transport {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	override, ok := f.cfg.Providers[provider]
+	if !ok || !override.ChangesTransport() {
+		if f.shared == nil {
+			f.shared = newTransport(f.cfg, internal.HTTPProviderConfig{})
+		}
+		return f.shared
+	}
+
+	transport, ok := f.transports[provider]
+	if !ok {
+		transport = newTransport(f.cfg, override)
+		f.transports[provider] = transport
+	}
+	return transport
+}
+
+// newTransport creates a pooled transport with the overrides of a provider applied
+func newTransport(cfg internal.HTTPConfig, override internal.HTTPProviderConfig) *http.Transport {
+	proxyURL, maxConns, maxIdlePerHost := cfg.Proxy, cfg.MaxConnsPerHost, cfg.MaxIdleConnsPerHost
+	if override.Proxy != "" {
+		proxyURL = override.Proxy
+	}
+	if override.MaxConnsPerHost > 0 {
+		maxConns = override.MaxConnsPerHost
+	}
+	if override.MaxIdleConnsPerHost > 0 {
+		maxIdlePerHost = override.MaxIdleConnsPerHost
+	}
+	proxy, _ := proxyFunc(proxyURL) // validated by New
+
+	dialer := &net.Dialer{Timeout: cfg.DialTimeout, KeepAlive: cfg.KeepAlive}
+	return &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     cfg.HTTP2,
+		MaxIdleConns:          cfg.MaxIdleConns,
+		MaxIdleConnsPerHost:   maxIdlePerHost,
+		MaxConnsPerHost:       maxConns,
+		IdleConnTimeout:       cfg.IdleConnTimeout,
+		TLSHandshakeTimeout:   cfg.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: cfg.ResponseHeaderTimeout,
+		ExpectContinueTimeout: time.Second,
+	}
+}
+
+// proxyFunc resolves a configured proxy: empty uses the HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables, ProxyDirect none
+func proxyFunc(proxy string) (func(*http.Request) (*url.URL, error), error) {
+	switch proxy {
+	case "":
+		return http.ProxyFromEnvironment, nil
+	case ProxyDirect:
+		return nil, nil
+	}
+
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy url: %w", err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("proxy url %q needs an http, https or socks5 scheme", proxy)
+	}
+	return http.ProxyURL(u), nil
+}
@@ -0,0 +1,219 @@
+package httpclient
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+func testConfig() internal.HTTPConfig {
+	return internal.HTTPConfig{
+		Timeout:             5 * time.Second,
+		DialTimeout:         time.Second,
+		KeepAlive:           30 * time.Second,
+		TLSHandshakeTimeout: time.Second,
+		IdleConnTimeout:     time.Minute,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 16,
+		HTTP2:               true,
+		Proxy:               ProxyDirect,
+	}
+}
+
+// countingServer starts a TLS server counting the connections it accepts
+func countingServer(t *testing.T) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var conns atomic.Int64
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "ok")
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server, &conns
+}
+
+// trustServer makes the pooled transports of f trust the test certificate
+func trustServer(f *Factory, provider string, server *httptest.Server) {
+	f.transport(provider).TLSClientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig.Clone()
+}
+
+func get(t *testing.T, client *http.Client, url string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+func TestFactory_ReusesConnections(t *testing.T) {
+	server, conns := countingServer(t)
+	f, err := New(testConfig())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	trustServer(f, "firefly", server)
+
+	// Clients of providers without overrides share one pool
+	firefly, fx := f.Client("firefly"), f.Client("fx")
+	for i := 0; i < 20; i++ {
+		get(t, firefly, server.URL)
+		get(t, fx, server.URL)
+	}
+	if got := conns.Load(); got != 1 {
+		t.Errorf("connections = %d, want 1", got)
+	}
+
+	// Concurrent requests reuse the idle pool instead of handshaking each time
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				get(t, firefly, server.URL)
+			}
+		}()
+	}
+	wg.Wait()
+	if got, limit := conns.Load(), int64(1+testConfig().MaxIdleConnsPerHost); got > limit {
+		t.Errorf("connections = %d after 80 concurrent requests, want at most %d", got, limit)
+	}
+}
+
+func TestFactory_ProviderOverrides(t *testing.T) {
+	cfg := testConfig()
+	cfg.Providers = map[string]internal.HTTPProviderConfig{
+		"ethereum": {Timeout: time.Minute},
+		"solana":   {MaxConnsPerHost: 2, Proxy: "http://proxy.internal:3128"},
+	}
+	f, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if got := f.Client("ethereum").Timeout; got != time.Minute {
+		t.Errorf("ethereum timeout = %v, want 1m", got)
+	}
+	if got := f.Client("firefly").Timeout; got != cfg.Timeout {
+		t.Errorf("firefly timeout = %v, want %v", got, cfg.Timeout)
+	}
+	// A timeout alone keeps the shared pool
+	if f.transport("ethereum") != f.transport("firefly") {
+		t.Error("ethereum got its own transport for a timeout override")
+	}
+
+	solana := f.transport("solana")
+	if solana == f.transport("firefly") {
+		t.Fatal("solana shares the pool despite its overrides")
+	}
+	if solana.MaxConnsPerHost != 2 || solana.MaxIdleConnsPerHost != cfg.MaxIdleConnsPerHost {
+		t.Errorf("solana limits = %d/%d, want 2/%d", solana.MaxConnsPerHost, solana.MaxIdleConnsPerHost, cfg.MaxIdleConnsPerHost)
+	}
+	req := httptest.NewRequest(http.MethodGet, "https://api.solscan.io/", nil)
+	proxy, err := solana.Proxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.internal:3128" {
+		t.Errorf("solana proxy = %v, %v, want proxy.internal:3128", proxy, err)
+	}
+	if f.transport("firefly").Proxy != nil {
+		t.Error("firefly uses a proxy despite proxy: direct")
+	}
+}
+
+func TestFactory_Middleware(t *testing.T) {
+	server, _ := countingServer(t)
+	f, err := New(testConfig())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	trustServer(f, "fx", server)
+
+	var calls atomic.Int64
+	f.WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+		return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			calls.Add(1)
+			return next.RoundTrip(req)
+		})
+	})
+	get(t, f.Client("fx"), server.URL)
+	if calls.Load() != 1 {
+		t.Errorf("middleware calls = %d, want 1", calls.Load())
+	}
+}
+
+func TestNew_InvalidProxy(t *testing.T) {
+	tests := []struct {
+		name  string
+		proxy string
+		ok    bool
+	}{
+		{"environment", "", true},
+		{"direct", ProxyDirect, true},
+		{"http", "http://proxy:3128", true},
+		{"socks5", "socks5://proxy:1080", true},
+		{"no scheme", "proxy:3128", false},
+		{"unsupported scheme", "ftp://proxy", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig()
+			cfg.Providers = map[string]internal.HTTPProviderConfig{"fx": {Proxy: tt.proxy}}
+			if _, err := New(cfg); (err == nil) != tt.ok {
+				t.Errorf("New() error = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// BenchmarkTLSRequests compares pooled clients with a fresh client per
+// request, which pays a TLS handshake every time:
+//
+//	go test ./internal/httpclient -run '^$' -bench TLSRequests
+func BenchmarkTLSRequests(b *testing.B) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+	tlsConfig := server.Client().Transport.(*http.Transport).TLSClientConfig
+
+	do := func(b *testing.B, client *http.Client) {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			b.Fatal(err)
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+
+	b.Run("pooled", func(b *testing.B) {
+		f, _ := New(testConfig())
+		f.transport("bench").TLSClientConfig = tlsConfig.Clone()
+		client := f.Client("bench")
+		for b.Loop() {
+			do(b, client)
+		}
+	})
+	b.Run("unpooled", func(b *testing.B) {
+		for b.Loop() {
+			transport := &http.Transport{TLSClientConfig: tlsConfig.Clone(), DisableKeepAlives: true}
+			do(b, &http.Client{Transport: transport})
+		}
+	})
+}
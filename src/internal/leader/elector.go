@@ -0,0 +1,178 @@
+// Package leader elects one replica as the leader through a lease held in a
+// NATS JetStream key-value bucket. The leader renews the lease well within its
+// TTL; when it stops, the lease expires and another replica takes it over.
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// leaseKey is the key of the scheduler lease in the bucket
+const leaseKey = "scheduler"
+
+// keyValue is the subset of jetstream.KeyValue the elector uses
+type keyValue interface {
+	Create(ctx context.Context, key string, value []byte) (uint64, error)
+	Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error)
+	Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error
+}
+
+// Elector campaigns for the scheduler lease and reports whether this replica holds it
+type Elector struct {
+	conn *nats.Conn // nil when the key-value store is not backed by a connection
+	kv   keyValue
+	id   string
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu       sync.Mutex
+	revision uint64    // revision of the lease entry written by this replica, zero when not leader
+	until    time.Time // the lease is held until this time unless renewed
+}
+
+// NewElector connects to NATS and makes sure the lease bucket exists
+func NewElector(ctx context.Context, cfg internal.NATSConfig) (*Elector, error) {
+	bucket := cfg.ResourceName(cfg.LeaderBucket)
+	conn, err := nats.Connect(cfg.URL, nats.Name(cfg.ClientName("-leader")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	// Entries older than the TTL expire, so a lease that is not renewed disappears
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:      bucket,
+		Description: "Leader lease of the import scheduler",
+		TTL:         cfg.LeaderTTL,
+		History:     1,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create key-value bucket %s: %w", bucket, err)
+	}
+
+	elector := newElector(kv, instanceID(cfg.InstanceID), cfg.LeaderTTL)
+	elector.conn = conn
+	return elector, nil
+}
+
+func newElector(kv keyValue, id string, ttl time.Duration) *Elector {
+	return &Elector{kv: kv, id: id, ttl: ttl, now: time.Now}
+}
+
+// instanceID returns the configured instance ID or one derived from the hostname
+func instanceID(configured string) string {
+	if configured != "" {
+		return configured
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = internal.DefaultAppName
+	}
+	return hostname + "-" + uuid.NewString()[:8]
+}
+
+// ID returns the ID this replica campaigns with
+func (e *Elector) ID() string {
+	return e.id
+}
+
+// IsLeader reports whether this replica holds an unexpired lease
+func (e *Elector) IsLeader() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.revision != 0 && e.now().Before(e.until)
+}
+
+// Run campaigns for the lease, renewing it while held, until ctx is done.
+// The lease is released on return so another replica can take over at once.
+func (e *Elector) Run(ctx context.Context) {
+	interval := e.ttl / 3
+
+	e.campaign(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			releaseCtx, cancel := context.WithTimeout(context.Background(), interval)
+			e.release(releaseCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			e.campaign(ctx)
+		}
+	}
+}
+
+// campaign renews the lease when held and tries to acquire it otherwise
+func (e *Elector) campaign(ctx context.Context) {
+	logger := internal.GetLogger().With().Str("component", "leader").Str("instance", e.id).Logger()
+
+	ctx, cancel := context.WithTimeout(ctx, e.ttl/3)
+	defer cancel()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	start := e.now()
+	if e.revision != 0 {
+		revision, err := e.kv.Update(ctx, leaseKey, []byte(e.id), e.revision)
+		if err == nil {
+			e.revision, e.until = revision, start.Add(e.ttl)
+			return
+		}
+		// The lease may still be ours, but it cannot be trusted without a renewal
+		logger.Warn().Err(err).Msg("Lost scheduler leadership")
+		e.revision, e.until = 0, time.Time{}
+		return
+	}
+
+	revision, err := e.kv.Create(ctx, leaseKey, []byte(e.id))
+	if err != nil {
+		if !errors.Is(err, jetstream.ErrKeyExists) {
+			logger.Warn().Err(err).Msg("Failed to campaign for scheduler leadership")
+		}
+		return
+	}
+	e.revision, e.until = revision, start.Add(e.ttl)
+	logger.Info().Msg("Acquired scheduler leadership")
+}
+
+// release deletes the lease if this replica still holds it
+func (e *Elector) release(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.revision == 0 {
+		return
+	}
+	if err := e.kv.Delete(ctx, leaseKey, jetstream.LastRevision(e.revision)); err != nil {
+		logger := internal.GetLogger()
+		logger.Warn().Err(err).Msg("Failed to release scheduler leadership")
+	}
+	e.revision, e.until = 0, time.Time{}
+}
+
+// Close closes the NATS connection. Call it after Run has returned.
+func (e *Elector) Close() {
+	if e.conn != nil {
+		e.conn.Close()
+	}
+}
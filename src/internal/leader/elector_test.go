@@ -0,0 +1,140 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// memoryKV is a single-key store with the compare-and-set semantics of a JetStream bucket
+type memoryKV struct {
+	value    string
+	revision uint64
+	seq      uint64
+	fail     error
+}
+
+func (kv *memoryKV) Create(ctx context.Context, key string, value []byte) (uint64, error) {
+	if kv.fail != nil {
+		return 0, kv.fail
+	}
+	if kv.revision != 0 {
+		return 0, jetstream.ErrKeyExists
+	}
+	kv.seq++
+	kv.value, kv.revision = string(value), kv.seq
+	return kv.revision, nil
+}
+
+func (kv *memoryKV) Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	if kv.fail != nil {
+		return 0, kv.fail
+	}
+	if revision != kv.revision {
+		return 0, errors.New("wrong last sequence")
+	}
+	kv.seq++
+	kv.value, kv.revision = string(value), kv.seq
+	return kv.revision, nil
+}
+
+func (kv *memoryKV) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	kv.value, kv.revision = "", 0
+	return nil
+}
+
+// expire simulates the bucket TTL removing an unrenewed lease
+func (kv *memoryKV) expire() {
+	kv.value, kv.revision = "", 0
+}
+
+func TestElectorSingleLeader(t *testing.T) {
+	kv := &memoryKV{}
+	a := newElector(kv, "a", 15*time.Second)
+	b := newElector(kv, "b", 15*time.Second)
+	ctx := context.Background()
+
+	a.campaign(ctx)
+	b.campaign(ctx)
+	if !a.IsLeader() || b.IsLeader() {
+		t.Fatalf("leaders: a=%v b=%v, want only a", a.IsLeader(), b.IsLeader())
+	}
+
+	// Renewals keep the lease with a
+	a.campaign(ctx)
+	b.campaign(ctx)
+	if !a.IsLeader() || b.IsLeader() || kv.value != "a" {
+		t.Fatalf("after renewal: a=%v b=%v holder=%q", a.IsLeader(), b.IsLeader(), kv.value)
+	}
+}
+
+func TestElectorFailover(t *testing.T) {
+	kv := &memoryKV{}
+	a := newElector(kv, "a", 15*time.Second)
+	b := newElector(kv, "b", 15*time.Second)
+	ctx := context.Background()
+
+	a.campaign(ctx)
+
+	// a stops renewing; once the lease expires b takes over
+	kv.expire()
+	b.campaign(ctx)
+	if !b.IsLeader() {
+		t.Fatal("b did not take over the expired lease")
+	}
+
+	// a notices on its next renewal that the lease is gone
+	a.campaign(ctx)
+	if a.IsLeader() {
+		t.Error("a still leads after b took over")
+	}
+}
+
+func TestElectorLeaseExpiresLocally(t *testing.T) {
+	now := time.Now()
+	e := newElector(&memoryKV{}, "a", 15*time.Second)
+	e.now = func() time.Time { return now }
+
+	e.campaign(context.Background())
+	if !e.IsLeader() {
+		t.Fatal("campaign did not acquire the free lease")
+	}
+
+	// Without a renewal the leader must step down before the bucket TTL frees the lease
+	now = now.Add(15 * time.Second)
+	if e.IsLeader() {
+		t.Error("IsLeader() = true after the lease TTL elapsed")
+	}
+}
+
+func TestElectorStepsDownOnRenewalError(t *testing.T) {
+	kv := &memoryKV{}
+	e := newElector(kv, "a", 15*time.Second)
+	e.campaign(context.Background())
+
+	kv.fail = errors.New("connection closed")
+	e.campaign(context.Background())
+	if e.IsLeader() {
+		t.Error("IsLeader() = true after a failed renewal")
+	}
+}
+
+func TestElectorRelease(t *testing.T) {
+	kv := &memoryKV{}
+	a := newElector(kv, "a", 15*time.Second)
+	b := newElector(kv, "b", 15*time.Second)
+
+	a.campaign(context.Background())
+	a.release(context.Background())
+	if a.IsLeader() {
+		t.Error("a still leads after releasing the lease")
+	}
+
+	b.campaign(context.Background())
+	if !b.IsLeader() {
+		t.Error("b did not acquire the released lease")
+	}
+}
@@ -0,0 +1,393 @@
+// Package migrate applies and reverts the app migrations with a preview of
+// their schema changes. Every run is planned first: the migrations are
+// executed in a transaction that is rolled back, and the collections before
+// and after each one are compared. Plans dropping collections or fields that
+// hold records are only applied when destructive changes are allowed.
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// ErrDestructive is returned when a plan drops data and destructive changes are not allowed
+var ErrDestructive = errors.New("migration drops collections or fields")
+
+// ErrNoMigrations is returned when no migration is registered, e.g. because
+// the binary does not import the migrations package
+var ErrNoMigrations = errors.New("no migrations are registered")
+
+// errRollback undoes the migrations of a plan
+var errRollback = errors.New("rollback")
+
+// ChangeKind is the kind of a schema change
+type ChangeKind string
+
+const (
+	CollectionCreated ChangeKind = "create_collection"
+	CollectionDeleted ChangeKind = "delete_collection"
+	CollectionRenamed ChangeKind = "rename_collection"
+	FieldAdded        ChangeKind = "add_field"
+	FieldRemoved      ChangeKind = "remove_field"
+	FieldRenamed      ChangeKind = "rename_field"
+	FieldRetyped      ChangeKind = "change_field_type"
+)
+
+// Destructive reports whether changes of the kind lose data
+func (k ChangeKind) Destructive() bool {
+	return k == CollectionDeleted || k == FieldRemoved || k == FieldRetyped
+}
+
+// Change is a schema change of a migration
+type Change struct {
+	Kind       ChangeKind `json:"kind"`
+	Collection string     `json:"collection"`
+	Field      string     `json:"field,omitempty"`
+	Detail     string     `json:"detail,omitempty"`  // e.g. the old name or type
+	Records    int64      `json:"records,omitempty"` // records losing data with destructive changes
+}
+
+// Step is a planned migration
+type Step struct {
+	File    string   `json:"file"`
+	Changes []Change `json:"changes"`
+}
+
+// Destructive reports whether the migration loses data. Dropping an empty
+// collection or field loses nothing, e.g. when recreating it on a fresh database.
+func (s Step) Destructive() bool {
+	for _, change := range s.Changes {
+		if change.Kind.Destructive() && change.Records > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Plan lists the migrations a run applies or reverts, in execution order
+type Plan struct {
+	Direction string `json:"direction"` // up or down
+	Steps     []Step `json:"steps"`
+	Applied   bool   `json:"applied"` // false for dry runs and refused plans
+}
+
+// Destructive reports whether any migration of the plan loses data
+func (p *Plan) Destructive() bool {
+	for _, step := range p.Steps {
+		if step.Destructive() {
+			return true
+		}
+	}
+	return false
+}
+
+// Status is the state of a registered migration
+type Status struct {
+	File      string     `json:"file"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"appliedAt,omitempty"`
+}
+
+// Options controls a run
+type Options struct {
+	DryRun           bool // only plan the run
+	AllowDestructive bool // apply plans that drop collections or fields
+}
+
+// Runner applies the migrations of a list, e.g. core.AppMigrations
+type Runner struct {
+	app  core.App
+	list core.MigrationsList
+}
+
+// NewRunner creates a new Runner. System migrations are applied when the
+// app bootstraps, so the list usually only holds the app migrations.
+func NewRunner(app core.App, list core.MigrationsList) *Runner {
+	return &Runner{app: app, list: list}
+}
+
+// Status returns every registered migration in file order
+func (r *Runner) Status() ([]Status, error) {
+	if len(r.list.Items()) == 0 {
+		return nil, ErrNoMigrations
+	}
+	applied, err := r.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(r.list.Items()))
+	for _, m := range r.list.Items() {
+		status := Status{File: m.File}
+		if at, ok := applied[m.File]; ok {
+			status.Applied = true
+			status.AppliedAt = &at
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Up applies the pending migrations
+func (r *Runner) Up(opts Options) (*Plan, error) {
+	if len(r.list.Items()) == 0 {
+		return nil, ErrNoMigrations
+	}
+	pending, err := r.pending()
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := r.plan("up", pending, func(m *core.Migration) func(core.App) error { return m.Up })
+	if err != nil || !proceed(plan, opts) {
+		return plan, err
+	}
+	if plan.Destructive() && !opts.AllowDestructive {
+		return plan, ErrDestructive
+	}
+
+	if _, err := core.NewMigrationsRunner(r.app, r.list).Up(); err != nil {
+		return plan, err
+	}
+	plan.Applied = true
+	return plan, nil
+}
+
+// Down reverts the last n applied migrations, most recent first
+func (r *Runner) Down(n int, opts Options) (*Plan, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("the number of migrations to revert must be positive")
+	}
+
+	last, err := r.lastApplied(n)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := r.plan("down", last, func(m *core.Migration) func(core.App) error { return m.Down })
+	if err != nil || !proceed(plan, opts) {
+		return plan, err
+	}
+	if plan.Destructive() && !opts.AllowDestructive {
+		return plan, ErrDestructive
+	}
+
+	if _, err := core.NewMigrationsRunner(r.app, r.list).Down(len(last)); err != nil {
+		return plan, err
+	}
+	plan.Applied = true
+	return plan, nil
+}
+
+// proceed reports whether a planned run is applied
+func proceed(plan *Plan, opts Options) bool {
+	return !opts.DryRun && len(plan.Steps) > 0
+}
+
+// plan runs the migrations in a transaction that is rolled back and records
+// the schema changes of each
+func (r *Runner) plan(direction string, migrations []*core.Migration, action func(*core.Migration) func(core.App) error) (*Plan, error) {
+	plan := &Plan{Direction: direction, Steps: make([]Step, 0, len(migrations))}
+
+	err := r.app.RunInTransaction(func(txApp core.App) error {
+		for _, m := range migrations {
+			before, err := snapshot(txApp)
+			if err != nil {
+				return err
+			}
+			if run := action(m); run != nil {
+				if err := run(txApp); err != nil {
+					return fmt.Errorf("migration %s failed: %w", m.File, err)
+				}
+			}
+			after, err := snapshot(txApp)
+			if err != nil {
+				return err
+			}
+
+			plan.Steps = append(plan.Steps, Step{File: m.File, Changes: diff(before, after)})
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		return nil, err
+	}
+
+	// The rolled back saves may have refreshed the collection cache
+	if err := r.app.ReloadCachedCollections(); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// pending returns the registered migrations that are not applied
+func (r *Runner) pending() ([]*core.Migration, error) {
+	applied, err := r.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []*core.Migration
+	for _, m := range r.list.Items() {
+		if _, ok := applied[m.File]; !ok {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// lastApplied returns up to n applied migrations of the list, most recent first
+func (r *Runner) lastApplied(n int) ([]*core.Migration, error) {
+	applied, err := r.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []*core.Migration
+	for _, m := range r.list.Items() {
+		if _, ok := applied[m.File]; ok {
+			migrations = append(migrations, m)
+		}
+	}
+	// Same order as the PocketBase runner: latest first, by file on ties
+	sort.SliceStable(migrations, func(i, j int) bool {
+		ai, aj := applied[migrations[i].File], applied[migrations[j].File]
+		if !ai.Equal(aj) {
+			return ai.After(aj)
+		}
+		return migrations[i].File > migrations[j].File
+	})
+
+	if len(migrations) > n {
+		migrations = migrations[:n]
+	}
+	return migrations, nil
+}
+
+// applied returns when each applied migration was applied
+func (r *Runner) applied() (map[string]time.Time, error) {
+	var rows []struct {
+		File    string `db:"file"`
+		Applied int64  `db:"applied"`
+	}
+	err := r.app.DB().Select("file", "applied").From(core.DefaultMigrationsTable).All(&rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the applied migrations: %w", err)
+	}
+
+	applied := make(map[string]time.Time, len(rows))
+	for _, row := range rows {
+		applied[row.File] = appliedTime(row.Applied)
+	}
+	return applied, nil
+}
+
+// appliedTime converts the applied column, stored in seconds by older
+// PocketBase versions and in microseconds since
+func appliedTime(applied int64) time.Time {
+	if applied < 1e12 {
+		return time.Unix(applied, 0)
+	}
+	return time.UnixMicro(applied)
+}
+
+// collectionSnapshot is the schema of a collection
+type collectionSnapshot struct {
+	name    string
+	records int64                    // zero for views
+	fields  map[string]fieldSnapshot // by field ID
+}
+
+// fieldSnapshot is the schema of a field
+type fieldSnapshot struct {
+	name, kind string
+}
+
+// snapshot captures the schema of every collection, keyed by collection ID
+func snapshot(app core.App) (map[string]collectionSnapshot, error) {
+	collections, err := app.FindAllCollections()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make(map[string]collectionSnapshot, len(collections))
+	for _, collection := range collections {
+		fields := make(map[string]fieldSnapshot, len(collection.Fields))
+		for _, field := range collection.Fields {
+			fields[field.GetId()] = fieldSnapshot{name: field.GetName(), kind: field.Type()}
+		}
+		snapshot := collectionSnapshot{name: collection.Name, fields: fields}
+		if !collection.IsView() {
+			if snapshot.records, err = countRecords(app, collection.Name); err != nil {
+				return nil, err
+			}
+		}
+		snapshots[collection.Id] = snapshot
+	}
+	return snapshots, nil
+}
+
+// diff compares two snapshots. Destructive changes report the records the
+// collection held before.
+func diff(before, after map[string]collectionSnapshot) []Change {
+	changes := make([]Change, 0)
+
+	for id, old := range before {
+		current, ok := after[id]
+		if !ok {
+			changes = append(changes, Change{Kind: CollectionDeleted, Collection: old.name, Records: old.records})
+			continue
+		}
+		if current.name != old.name {
+			changes = append(changes, Change{Kind: CollectionRenamed, Collection: current.name, Detail: old.name})
+		}
+
+		for fieldID, field := range old.fields {
+			now, ok := current.fields[fieldID]
+			switch {
+			case !ok:
+				changes = append(changes, Change{Kind: FieldRemoved, Collection: current.name, Field: field.name, Records: old.records})
+			case now.kind != field.kind:
+				changes = append(changes, Change{Kind: FieldRetyped, Collection: current.name, Field: now.name,
+					Detail: field.kind + " -> " + now.kind, Records: old.records})
+			case now.name != field.name:
+				changes = append(changes, Change{Kind: FieldRenamed, Collection: current.name, Field: now.name, Detail: field.name})
+			}
+		}
+		for fieldID, field := range current.fields {
+			if _, ok := old.fields[fieldID]; !ok {
+				changes = append(changes, Change{Kind: FieldAdded, Collection: current.name, Field: field.name})
+			}
+		}
+	}
+	for id, collection := range after {
+		if _, ok := before[id]; !ok {
+			changes = append(changes, Change{Kind: CollectionCreated, Collection: collection.name})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Collection != changes[j].Collection {
+			return changes[i].Collection < changes[j].Collection
+		}
+		if changes[i].Kind != changes[j].Kind {
+			return changes[i].Kind < changes[j].Kind
+		}
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+// countRecords counts the rows of a collection table
+func countRecords(app core.App, collection string) (int64, error) {
+	var count int64
+	err := app.DB().Select("count(*)").From(collection).Row(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count the records of %s: %w", collection, err)
+	}
+	return count, nil
+}
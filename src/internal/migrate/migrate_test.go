@@ -0,0 +1,174 @@
+package migrate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pocketbase/pocketbase/core"
+	_ "github.com/pocketbase/pocketbase/migrations" // system migrations, applied by Bootstrap
+)
+
+func newTestApp(t *testing.T) core.App {
+	t.Helper()
+	app := core.NewBaseApp(core.BaseAppConfig{DataDir: t.TempDir()})
+	if err := app.Bootstrap(); err != nil {
+		t.Fatalf("Bootstrap() error = %v", err)
+	}
+	t.Cleanup(func() { app.ResetBootstrapState() })
+	return app
+}
+
+// notesMigrations creates a notes collection and adds a body field to it
+func notesMigrations() core.MigrationsList {
+	var list core.MigrationsList
+	list.Register(func(app core.App) error {
+		notes := core.NewBaseCollection("notes")
+		notes.Fields.Add(&core.TextField{Name: "title"})
+		return app.Save(notes)
+	}, func(app core.App) error {
+		notes, err := app.FindCollectionByNameOrId("notes")
+		if err != nil {
+			return err
+		}
+		return app.Delete(notes)
+	}, "1_create_notes.go")
+	list.Register(func(app core.App) error {
+		notes, err := app.FindCollectionByNameOrId("notes")
+		if err != nil {
+			return err
+		}
+		notes.Fields.Add(&core.TextField{Name: "body"})
+		return app.Save(notes)
+	}, func(app core.App) error {
+		notes, err := app.FindCollectionByNameOrId("notes")
+		if err != nil {
+			return err
+		}
+		notes.Fields.RemoveByName("body")
+		return app.Save(notes)
+	}, "2_add_note_body.go")
+	return list
+}
+
+func TestRunner_UpDryRun(t *testing.T) {
+	app := newTestApp(t)
+	runner := NewRunner(app, notesMigrations())
+
+	plan, err := runner.Up(Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if plan.Applied || len(plan.Steps) != 2 || plan.Destructive() {
+		t.Fatalf("Up() = %+v, want two unapplied constructive steps", plan)
+	}
+	want := []Change{{Kind: CollectionCreated, Collection: "notes"}}
+	if got := plan.Steps[0].Changes; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("step 1 changes = %+v, want %+v", got, want)
+	}
+	want = []Change{{Kind: FieldAdded, Collection: "notes", Field: "body"}}
+	if got := plan.Steps[1].Changes; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("step 2 changes = %+v, want %+v", got, want)
+	}
+
+	// Nothing was applied
+	if _, err := app.FindCollectionByNameOrId("notes"); err == nil {
+		t.Error("dry run created the notes collection")
+	}
+	statuses, err := runner.Status()
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	for _, status := range statuses {
+		if status.Applied {
+			t.Errorf("%s is applied after a dry run", status.File)
+		}
+	}
+}
+
+func TestRunner_UpAndDown(t *testing.T) {
+	app := newTestApp(t)
+	runner := NewRunner(app, notesMigrations())
+
+	plan, err := runner.Up(Options{})
+	if err != nil || !plan.Applied {
+		t.Fatalf("Up() = %+v, %v, want applied", plan, err)
+	}
+	statuses, err := runner.Status()
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	if len(statuses) != 2 || !statuses[0].Applied || !statuses[1].Applied {
+		t.Fatalf("Status() = %+v, want both applied", statuses)
+	}
+
+	// Nothing left to apply
+	plan, err = runner.Up(Options{})
+	if err != nil || plan.Applied || len(plan.Steps) != 0 {
+		t.Errorf("second Up() = %+v, %v, want an empty plan", plan, err)
+	}
+
+	notes, _ := app.FindCollectionByNameOrId("notes")
+	record := core.NewRecord(notes)
+	record.Set("title", "groceries")
+	record.Set("body", "milk")
+	if err := app.Save(record); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// Dropping the body field is refused without AllowDestructive
+	plan, err = runner.Down(1, Options{})
+	if !errors.Is(err, ErrDestructive) {
+		t.Fatalf("Down() error = %v, want ErrDestructive", err)
+	}
+	want := Change{Kind: FieldRemoved, Collection: "notes", Field: "body", Records: 1}
+	if len(plan.Steps) != 1 || plan.Steps[0].File != "2_add_note_body.go" ||
+		len(plan.Steps[0].Changes) != 1 || plan.Steps[0].Changes[0] != want {
+		t.Fatalf("Down() plan = %+v, want %+v", plan, want)
+	}
+	if notes, _ := app.FindCollectionByNameOrId("notes"); notes.Fields.GetByName("body") == nil {
+		t.Fatal("refused Down() removed the body field")
+	}
+
+	plan, err = runner.Down(1, Options{AllowDestructive: true})
+	if err != nil || !plan.Applied {
+		t.Fatalf("Down() = %+v, %v, want applied", plan, err)
+	}
+	if notes, _ := app.FindCollectionByNameOrId("notes"); notes.Fields.GetByName("body") != nil {
+		t.Error("Down() kept the body field")
+	}
+	statuses, _ = runner.Status()
+	if !statuses[0].Applied || statuses[1].Applied {
+		t.Errorf("Status() = %+v, want only the first applied", statuses)
+	}
+}
+
+func TestRunner_DownNeedsCount(t *testing.T) {
+	runner := NewRunner(newTestApp(t), notesMigrations())
+	if _, err := runner.Down(0, Options{}); err == nil {
+		t.Error("Down(0) error = nil, want an error")
+	}
+}
+
+func TestRunner_NoMigrations(t *testing.T) {
+	runner := NewRunner(newTestApp(t), core.MigrationsList{})
+	if _, err := runner.Status(); !errors.Is(err, ErrNoMigrations) {
+		t.Errorf("Status() error = %v, want ErrNoMigrations", err)
+	}
+	if _, err := runner.Up(Options{}); !errors.Is(err, ErrNoMigrations) {
+		t.Errorf("Up() error = %v, want ErrNoMigrations", err)
+	}
+}
+
+func TestRunner_DownEmptyField(t *testing.T) {
+	app := newTestApp(t)
+	runner := NewRunner(app, notesMigrations())
+	if _, err := runner.Up(Options{}); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+
+	// Without records dropping the body field loses nothing
+	plan, err := runner.Down(1, Options{})
+	if err != nil || !plan.Applied || plan.Destructive() {
+		t.Fatalf("Down() = %+v, %v, want applied and not destructive", plan, err)
+	}
+}
@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// subjectToken is a literal token of a NATS subject
+	subjectToken = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+	// resourceName is a valid JetStream stream or key-value bucket name
+	resourceName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
+
+// Subject returns a subject in the namespace of the install. Without a
+// namespace the subject is returned unchanged.
+func (c NATSConfig) Subject(subject string) string {
+	switch {
+	case c.Namespace == "":
+		return subject
+	case subject == "":
+		return c.Namespace
+	}
+	return c.Namespace + "." + subject
+}
+
+// ResourceName returns a stream or key-value bucket name in the namespace of
+// the install, e.g. ACME_PROD_FIREDRAGON_EVENTS for the namespace acme.prod
+func (c NATSConfig) ResourceName(name string) string {
+	if c.Namespace == "" {
+		return name
+	}
+	return strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(c.Namespace)) + "_" + name
+}
+
+// ClientName returns the connection name of a component, so the connections
+// of installs sharing a server can be told apart
+func (c NATSConfig) ClientName(component string) string {
+	name := DefaultAppName + component
+	if c.Namespace != "" {
+		name += "@" + c.Namespace
+	}
+	return name
+}
+
+// validateNATS checks that the namespace and subjects are literal subjects,
+// the stream and bucket names are valid JetStream names, the compression is
+// known and the limits are in range
+func validateNATS(c NATSConfig) error {
+	subjects := []struct {
+		key, value string
+		optional   bool
+	}{
+		{"nats.namespace", c.Namespace, true},
+		{"nats.subject_prefix", c.SubjectPrefix, true},
+		{"nats.control_subject", c.ControlSubject, true},
+		{"nats.cluster_subject", c.ClusterSubject, true},
+	}
+	for _, s := range subjects {
+		if s.value == "" && s.optional {
+			continue
+		}
+		for _, token := range strings.Split(s.value, ".") {
+			if !subjectToken.MatchString(token) {
+				return fmt.Errorf("%s %q must be dot-separated tokens of letters, digits, '-' and '_' without wildcards", s.key, s.value)
+			}
+		}
+	}
+
+	switch c.Compression {
+	case "", "none", "gzip", "zstd":
+	default:
+		return fmt.Errorf("nats.compression %q must be none, gzip or zstd", c.Compression)
+	}
+	if c.StreamMaxAge < 0 || c.StreamMaxBytes < 0 {
+		return fmt.Errorf("nats.stream_max_age and nats.stream_max_bytes must not be negative")
+	}
+	if c.StorageAlertPercent < 0 || c.StorageAlertPercent > 100 || c.StorageAlertBytes < 0 {
+		return fmt.Errorf("nats.storage_alert_percent must be from 0 to 100 and nats.storage_alert_bytes must not be negative")
+	}
+	if c.CompressionThreshold < 0 {
+		return fmt.Errorf("nats.compression_threshold must not be negative")
+	}
+	if c.HeartbeatInterval < 0 || c.FetchTimeout < 0 {
+		return fmt.Errorf("nats.heartbeat_interval and nats.fetch_timeout must not be negative")
+	}
+
+	names := map[string]string{
+		"nats.stream":        c.Stream,
+		"nats.dedupe_bucket": c.DedupeBucket,
+		"nats.leader_bucket": c.LeaderBucket,
+		"nats.state_bucket":  c.StateBucket,
+	}
+	for key, name := range names {
+		if name != "" && !resourceName.MatchString(name) {
+			return fmt.Errorf("%s %q may only contain letters, digits, '-' and '_'", key, name)
+		}
+	}
+	return nil
+}
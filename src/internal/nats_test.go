@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNATSConfig_Namespace(t *testing.T) {
+	plain := NATSConfig{}
+	if got := plain.Subject("firedragon.events"); got != "firedragon.events" {
+		t.Errorf("Subject() = %q without a namespace, want it unchanged", got)
+	}
+	if got := plain.ResourceName("FIREDRAGON_EVENTS"); got != "FIREDRAGON_EVENTS" {
+		t.Errorf("ResourceName() = %q without a namespace, want it unchanged", got)
+	}
+
+	tenant := NATSConfig{Namespace: "acme.prod-eu"}
+	if got := tenant.Subject("firedragon.events"); got != "acme.prod-eu.firedragon.events" {
+		t.Errorf("Subject() = %q", got)
+	}
+	if got := tenant.Subject(""); got != "acme.prod-eu" {
+		t.Errorf("Subject(\"\") = %q, want the namespace", got)
+	}
+	if got := tenant.ResourceName("FIREDRAGON_EVENTS"); got != "ACME_PROD_EU_FIREDRAGON_EVENTS" {
+		t.Errorf("ResourceName() = %q", got)
+	}
+	if got := tenant.ClientName("-leader"); got != DefaultAppName+"-leader@acme.prod-eu" {
+		t.Errorf("ClientName() = %q", got)
+	}
+}
+
+func TestValidateNATS(t *testing.T) {
+	valid := NATSConfig{
+		Namespace:      "acme.prod",
+		Stream:         "FIREDRAGON_EVENTS",
+		SubjectPrefix:  "firedragon.events",
+		ControlSubject: "firedragon.control",
+		DedupeBucket:   "FIREDRAGON_DEDUPE",
+		LeaderBucket:   "FIREDRAGON_LEADER",
+	}
+	if err := validateNATS(valid); err != nil {
+		t.Fatalf("validateNATS() error = %v", err)
+	}
+	if err := validateNATS(NATSConfig{}); err != nil {
+		t.Errorf("validateNATS() error = %v for an empty config", err)
+	}
+
+	invalid := map[string]func(c *NATSConfig){
+		"wildcard namespace":     func(c *NATSConfig) { c.Namespace = "acme.*" },
+		"empty namespace token":  func(c *NATSConfig) { c.Namespace = "acme..prod" },
+		"trailing dot":           func(c *NATSConfig) { c.SubjectPrefix = "firedragon.events." },
+		"full wildcard subject":  func(c *NATSConfig) { c.ControlSubject = "firedragon.>" },
+		"space in subject":       func(c *NATSConfig) { c.ControlSubject = "firedragon control" },
+		"dot in stream name":     func(c *NATSConfig) { c.Stream = "FIREDRAGON.EVENTS" },
+		"wildcard in bucket":     func(c *NATSConfig) { c.DedupeBucket = "DEDUPE*" },
+		"slash in leader bucket": func(c *NATSConfig) { c.LeaderBucket = "a/b" },
+		"unknown compression":    func(c *NATSConfig) { c.Compression = "brotli" },
+		"negative threshold":     func(c *NATSConfig) { c.CompressionThreshold = -1 },
+		"wildcard cluster":       func(c *NATSConfig) { c.ClusterSubject = "firedragon.*" },
+		"negative fetch timeout": func(c *NATSConfig) { c.FetchTimeout = -time.Second },
+		"negative max age":       func(c *NATSConfig) { c.StreamMaxAge = -time.Hour },
+		"alert above 100%":       func(c *NATSConfig) { c.StorageAlertPercent = 120 },
+	}
+	for name, change := range invalid {
+		t.Run(name, func(t *testing.T) {
+			c := valid
+			change(&c)
+			if err := validateNATS(c); err == nil {
+				t.Error("validateNATS() error = nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Package payloads archives and replays the raw responses of provider APIs.
+// Recording wraps the transport of a client so every response body is handed
+// to a callback with its secrets redacted; replaying serves archived
+// responses to a client instead of calling the provider, so the
+// normalization of an import run can be re-run after a mapping fix.
+package payloads
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// ErrNotArchived is returned when replaying a request no archived payload answers
+var ErrNotArchived = errors.New("request was not archived")
+
+// key identifies the archived answer of a request
+func key(method, redactedURL string) string {
+	return method + " " + redactedURL
+}
+
+// Record wraps base so the body of every response is passed to record, with
+// the secrets of its URL and body redacted. Bodies larger than maxBytes are
+// not passed on; zero means no limit. The response read by the client is
+// unchanged.
+func Record(base http.RoundTripper, maxBytes int, record func(models.RawPayload)) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &recorder{base: base, maxBytes: maxBytes, record: record}
+}
+
+type recorder struct {
+	base     http.RoundTripper
+	maxBytes int
+	record   func(models.RawPayload)
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		// The client sees the same truncated body and fails on it
+		return resp, nil
+	}
+
+	if r.maxBytes <= 0 || len(body) <= r.maxBytes {
+		r.record(models.RawPayload{
+			Method:     req.Method,
+			URL:        models.RedactPayloadURL(req.URL.String()),
+			Status:     resp.StatusCode,
+			Body:       models.RedactPayloadBody(body),
+			ReceivedAt: time.Now(),
+		})
+	}
+	return resp, nil
+}
+
+// Replay returns a transport answering requests with archived payloads
+// instead of calling the provider. Requests are matched by method and
+// redacted URL; repeated requests get the archived answers in order, the
+// last one again once they run out. Other requests fail with ErrNotArchived.
+func Replay(archived []*models.RawPayload) (http.RoundTripper, error) {
+	answers := make(map[string][]*models.RawPayload)
+	for _, payload := range archived {
+		content, err := payload.Content()
+		if err != nil {
+			return nil, err
+		}
+		answer := *payload
+		answer.Body = content
+		answer.Compressed = false
+		k := key(payload.Method, payload.URL)
+		answers[k] = append(answers[k], &answer)
+	}
+	return &replayer{answers: answers, served: make(map[string]int)}, nil
+}
+
+type replayer struct {
+	mu      sync.Mutex
+	answers map[string][]*models.RawPayload
+	served  map[string]int
+}
+
+func (r *replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	k := key(req.Method, models.RedactPayloadURL(req.URL.String()))
+
+	r.mu.Lock()
+	answers := r.answers[k]
+	i := min(r.served[k], len(answers)-1)
+	r.served[k]++
+	r.mu.Unlock()
+
+	if len(answers) == 0 {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Redacted(), ErrNotArchived)
+	}
+	answer := answers[i]
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", answer.Status, http.StatusText(answer.Status)),
+		StatusCode:    answer.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {contentType(answer.Body)}},
+		Body:          io.NopCloser(bytes.NewReader(answer.Body)),
+		ContentLength: int64(len(answer.Body)),
+		Request:       req,
+	}, nil
+}
+
+// contentType guesses the content type of an archived body, whose headers
+// are not archived
+func contentType(body []byte) string {
+	trimmed := strings.TrimSpace(string(body))
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		return "application/json"
+	}
+	return http.DetectContentType(body)
+}
+
+// Client returns a copy of client whose transport is wrapped by wrap
+func Client(client *http.Client, wrap func(http.RoundTripper) http.RoundTripper) *http.Client {
+	wrapped := *client
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	wrapped.Transport = wrap(base)
+	return &wrapped
+}
@@ -0,0 +1,87 @@
+package payloads
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"result":[{"hash":"0x1"}],"token":"SECRET"}`)
+	}))
+	defer server.Close()
+
+	var archived []*models.RawPayload
+	client := Client(server.Client(), func(base http.RoundTripper) http.RoundTripper {
+		return Record(base, 0, func(payload models.RawPayload) { archived = append(archived, &payload) })
+	})
+
+	get := func(client *http.Client, url string) (string, error) {
+		resp, err := client.Get(url)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	body, err := get(client, server.URL+"/api?address=0xabc&apikey=SECRET")
+	if err != nil || !strings.Contains(body, "SECRET") {
+		t.Fatalf("recorded response = %q, %v, want it unchanged for the client", body, err)
+	}
+	if len(archived) != 1 || strings.Contains(archived[0].URL, "SECRET") || strings.Contains(string(archived[0].Body), "SECRET") {
+		t.Fatalf("archived = %+v, want one redacted payload", archived)
+	}
+	if err := archived[0].Compress(); err != nil {
+		t.Fatal(err)
+	}
+
+	transport, err := Replay(archived)
+	if err != nil {
+		t.Fatalf("Replay() error = %v", err)
+	}
+	replaying := &http.Client{Transport: transport}
+	body, err = get(replaying, server.URL+"/api?address=0xabc&apikey=OTHER")
+	if err != nil || !strings.Contains(body, `"hash":"0x1"`) {
+		t.Errorf("replayed response = %q, %v", body, err)
+	}
+	if calls != 1 {
+		t.Errorf("provider called %d times, want the replay to stay offline", calls)
+	}
+
+	if _, err := get(replaying, server.URL+"/api?address=0xdef"); !errors.Is(err, ErrNotArchived) {
+		t.Errorf("replaying an unknown request error = %v, want ErrNotArchived", err)
+	}
+}
+
+func TestRecordSkipsLargeBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, strings.Repeat("x", 100))
+	}))
+	defer server.Close()
+
+	recorded := 0
+	client := Client(server.Client(), func(base http.RoundTripper) http.RoundTripper {
+		return Record(base, 10, func(models.RawPayload) { recorded++ })
+	})
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if recorded != 0 || len(body) != 100 {
+		t.Errorf("recorded %d payloads, client read %d bytes", recorded, len(body))
+	}
+}
@@ -0,0 +1,121 @@
+package perf
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fireflyServer mocks the parts of the Firefly III API the outbox delivers
+// through: booking transactions and finding them by external ID. Every
+// response is delayed by the configured latency to stand in for the network.
+type fireflyServer struct {
+	*httptest.Server
+	latency time.Duration
+
+	mu         sync.Mutex
+	nextID     int
+	byExternal map[string]string // external ID -> transaction ID
+}
+
+func newFireflyServer(latency time.Duration) *fireflyServer {
+	s := &fireflyServer{latency: latency, byExternal: make(map[string]string)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/transactions", s.createTransaction)
+	mux.HandleFunc("GET /api/v1/search/transactions", s.searchTransactions)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// transactions returns the number of booked transactions
+func (s *fireflyServer) transactions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.byExternal)
+}
+
+func (s *fireflyServer) createTransaction(w http.ResponseWriter, r *http.Request) {
+	time.Sleep(s.latency)
+
+	var body struct {
+		ErrorIfDuplicateHash bool `json:"error_if_duplicate_hash"`
+		Transactions         []struct {
+			Type            string `json:"type"`
+			Amount          string `json:"amount"`
+			SourceID        string `json:"source_id"`
+			SourceName      string `json:"source_name"`
+			DestinationID   string `json:"destination_id"`
+			DestinationName string `json:"destination_name"`
+			ExternalID      string `json:"external_id"`
+		} `json:"transactions"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.Transactions) != 1 {
+		writeError(w, http.StatusBadRequest, "invalid transaction")
+		return
+	}
+
+	split := body.Transactions[0]
+	if _, err := strconv.ParseFloat(split.Amount, 64); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalid amount")
+		return
+	}
+	if !slices.Contains([]string{"withdrawal", "deposit", "transfer"}, split.Type) ||
+		(split.SourceID == "" && split.SourceName == "") ||
+		(split.DestinationID == "" && split.DestinationName == "") {
+		writeError(w, http.StatusUnprocessableEntity, "invalid split")
+		return
+	}
+
+	s.mu.Lock()
+	_, duplicate := s.byExternal[split.ExternalID]
+	if duplicate && body.ErrorIfDuplicateHash {
+		s.mu.Unlock()
+		writeError(w, http.StatusUnprocessableEntity, "duplicate of transaction with external ID "+split.ExternalID)
+		return
+	}
+	s.nextID++
+	id := strconv.Itoa(s.nextID)
+	s.byExternal[split.ExternalID] = id
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]string{"type": "transactions", "id": id}})
+}
+
+func (s *fireflyServer) searchTransactions(w http.ResponseWriter, r *http.Request) {
+	time.Sleep(s.latency)
+
+	externalID, ok := strings.CutPrefix(r.URL.Query().Get("query"), "external_id_is:")
+	if !ok {
+		writeError(w, http.StatusBadRequest, "unsupported query")
+		return
+	}
+	if unquoted, err := strconv.Unquote(externalID); err == nil {
+		externalID = unquoted
+	}
+
+	data := []map[string]string{}
+	s.mu.Lock()
+	if id, ok := s.byExternal[externalID]; ok {
+		data = append(data, map[string]string{"type": "transactions", "id": id})
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{"data": data})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message, "exception": fmt.Sprintf("HTTP %d", status)})
+}
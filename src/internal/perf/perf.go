@@ -0,0 +1,340 @@
+// Package perf load-tests the import pipeline. It generates synthetic
+// sources (wallets × transactions), runs them through the source sync,
+// duplicate detection, import and Firefly outbox against a mock Firefly III
+// server, and reports throughput, latencies and allocations, so performance
+// regressions show up before release. Storage is in memory, so the numbers
+// measure the pipeline itself rather than the database.
+package perf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"runtime"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/firefly"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/workerpool"
+)
+
+const (
+	// DefaultWallets is the number of synthetic sources when none is configured
+	DefaultWallets = 10
+	// DefaultTransactions is the history length of each source when none is configured
+	DefaultTransactions = 100
+	// DefaultRounds is the number of import cycles when none is configured
+	DefaultRounds = 2
+)
+
+// sourceName is the source of the synthetic accounts
+const sourceName = "perf"
+
+// Config describes a load run
+type Config struct {
+	Wallets       int           `json:"wallets"`       // synthetic sources, each with its own wallet
+	Transactions  int           `json:"transactions"`  // history length of each source
+	Rounds        int           `json:"rounds"`        // import cycles, each fetching a longer part of the history
+	DuplicateRate float64       `json:"duplicateRate"` // share of transactions re-issued with a new provider ID
+	Workers       int           `json:"workers"`       // sources synced at once
+	Batch         int           `json:"batch"`         // outbox entries delivered per drain
+	Latency       time.Duration `json:"latency"`       // response delay of the mock Firefly server
+	Seed          uint64        `json:"seed"`          // seed of the generated histories
+}
+
+// withDefaults fills in the unset fields
+func (c Config) withDefaults() Config {
+	if c.Wallets == 0 {
+		c.Wallets = DefaultWallets
+	}
+	if c.Transactions == 0 {
+		c.Transactions = DefaultTransactions
+	}
+	if c.Rounds == 0 {
+		c.Rounds = DefaultRounds
+	}
+	if c.Workers == 0 {
+		c.Workers = workerpool.DefaultWorkers
+	}
+	if c.Batch == 0 {
+		c.Batch = usecases.DefaultFireflyOutboxBatch
+	}
+	return c
+}
+
+// Validate checks that the run is possible
+func (c Config) Validate() error {
+	switch {
+	case c.Wallets < 0, c.Transactions < 0, c.Rounds < 0, c.Workers < 0, c.Batch < 0, c.Latency < 0:
+		return errors.New("perf: sizes and latency must not be negative")
+	case c.Rounds > c.Transactions:
+		return fmt.Errorf("perf: %d rounds need at least as many transactions per wallet", c.Rounds)
+	case c.DuplicateRate < 0 || c.DuplicateRate >= 1:
+		return fmt.Errorf("perf: duplicate rate %v is not in [0, 1)", c.DuplicateRate)
+	}
+	return nil
+}
+
+// Latency summarizes the durations of one kind of operation
+type Latency struct {
+	Count int     `json:"count"`
+	P50MS float64 `json:"p50Ms"`
+	P90MS float64 `json:"p90Ms"`
+	P99MS float64 `json:"p99Ms"`
+	MaxMS float64 `json:"maxMs"`
+}
+
+// Result is the outcome of a load run
+type Result struct {
+	Config    Config `json:"config"`
+	Generated int    `json:"generated"` // transactions in the generated histories
+	Injected  int    `json:"injected"`  // generated transactions that re-issue an earlier one
+
+	Imported   int `json:"imported"`
+	Duplicates int `json:"duplicates"` // blocked as duplicates, counted once per round
+	Invalid    int `json:"invalid"`
+	Failed     int `json:"failed"`    // source syncs that failed
+	Delivered  int `json:"delivered"` // booked in the mock Firefly server
+	Pending    int `json:"pending"`   // still queued for Firefly after the run
+
+	DurationMS float64 `json:"durationMs"`
+	ImportMS   float64 `json:"importMs"`   // spent in import cycles
+	DeliveryMS float64 `json:"deliveryMs"` // spent draining the Firefly outbox
+	Throughput float64 `json:"throughput"` // transactions imported and delivered per second
+
+	SyncLatency    Latency `json:"syncLatency"`    // per source sync
+	RequestLatency Latency `json:"requestLatency"` // per Firefly API request
+
+	Allocs               uint64  `json:"allocs"`
+	AllocBytes           uint64  `json:"allocBytes"`
+	AllocsPerTransaction float64 `json:"allocsPerTransaction"` // per imported transaction
+}
+
+// pipeline is the import pipeline of a run wired to in-memory storage and a
+// mock Firefly server
+type pipeline struct {
+	sources []*syntheticSource
+	syncer  *usecases.SourceSyncService
+	outbox  *usecases.FireflyOutboxService
+	pool    *workerpool.Pool
+	server  *fireflyServer
+
+	syncs    *recorder
+	requests *recorder
+}
+
+// Run generates the sources of a load run, imports them in cfg.Rounds cycles,
+// delivers the imported transactions to the mock Firefly server after each
+// cycle and reports the measurements. Setup is not measured.
+func Run(ctx context.Context, cfg Config) (*Result, error) {
+	cfg = cfg.withDefaults()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	p, err := newPipeline(cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer p.close()
+
+	result := &Result{Config: cfg}
+	for _, source := range p.sources {
+		result.Generated += len(source.history)
+		result.Injected += source.duplicates
+	}
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	started := time.Now()
+
+	var importTime, deliveryTime time.Duration
+	for round := range cfg.Rounds {
+		for _, source := range p.sources {
+			source.advance(round)
+		}
+
+		roundStarted := time.Now()
+		cycle := p.syncer.SyncAll(ctx)
+		importTime += time.Since(roundStarted)
+		result.Failed += cycle.Failed
+		for _, source := range cycle.Sources {
+			result.Imported += source.Imported
+			result.Duplicates += source.Duplicates
+			result.Invalid += source.Invalid
+		}
+
+		drainStarted := time.Now()
+		if err := p.drain(ctx, result); err != nil {
+			return nil, err
+		}
+		deliveryTime += time.Since(drainStarted)
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	elapsed := time.Since(started)
+	runtime.ReadMemStats(&after)
+
+	pending, err := p.outbox.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Pending = pending.Pending
+	result.DurationMS = milliseconds(elapsed)
+	result.ImportMS = milliseconds(importTime)
+	result.DeliveryMS = milliseconds(deliveryTime)
+	if elapsed > 0 {
+		result.Throughput = float64(result.Imported) / elapsed.Seconds()
+	}
+	result.SyncLatency = p.syncs.summary()
+	result.RequestLatency = p.requests.summary()
+	result.Allocs = after.Mallocs - before.Mallocs
+	result.AllocBytes = after.TotalAlloc - before.TotalAlloc
+	if result.Imported > 0 {
+		result.AllocsPerTransaction = float64(result.Allocs) / float64(result.Imported)
+	}
+	return result, nil
+}
+
+// newPipeline generates the sources and wires the services the server would
+func newPipeline(cfg Config) (*pipeline, error) {
+	wallets, transactions, categories := newWalletStore(), newTransactionStore(), newCategoryStore()
+	p := &pipeline{
+		server:   newFireflyServer(cfg.Latency),
+		syncs:    &recorder{},
+		requests: &recorder{},
+	}
+
+	client, err := firefly.NewClient(internal.FireflyConfig{URL: p.server.URL, Token: sourceName},
+		&http.Client{Transport: p.requests.transport(http.DefaultTransport), Timeout: 30 * time.Second})
+	if err != nil {
+		p.server.Close()
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewPCG(cfg.Seed, cfg.Seed^0x9e3779b97f4a7c15))
+	now := time.Now()
+	sources := make([]usecases.Source, cfg.Wallets)
+	mappings := make([]*models.AccountMapping, cfg.Wallets)
+	for i := range cfg.Wallets {
+		synthetic := newSyntheticSource(rng, i, cfg.Transactions, cfg.Rounds, cfg.DuplicateRate, now)
+		p.sources = append(p.sources, synthetic)
+
+		account := usecases.AccountRef{
+			Source:   sourceName,
+			Account:  fmt.Sprintf("account-%d", i),
+			Name:     fmt.Sprintf("Perf wallet %d", i),
+			Currency: "EUR",
+		}
+		sources[i] = usecases.Source{Account: account, Client: synthetic}
+		mappings[i] = models.NewAccountMapping(account.Source, account.Account, strconv.Itoa(i+1))
+	}
+
+	// Every source shares the provider, so let it use all the workers
+	p.pool = workerpool.New(workerpool.Config{Workers: cfg.Workers, ProviderLimit: cfg.Workers}).
+		WithInterceptor(func(provider string, job func()) func() {
+			return func() {
+				started := time.Now()
+				defer func() { p.syncs.record(time.Since(started)) }()
+				job()
+			}
+		})
+
+	accounts := usecases.NewAccountMappingService(nil, client, mappings, false)
+	p.outbox = usecases.NewFireflyOutboxService(
+		newOutboxStore(),
+		usecases.NewFireflyExportService(client, accounts, transactions),
+		client, wallets, categories, transactions, sources,
+	).WithBatchSize(cfg.Batch)
+
+	imports := usecases.NewImportService(wallets, transactions).
+		WithCategories(categories).
+		WithOutbox(p.outbox)
+	p.syncer = usecases.NewSourceSyncService(sources, wallets, transactions, imports).WithPool(p.pool)
+	return p, nil
+}
+
+// drain delivers the queued transactions until the outbox is empty or a
+// drain makes no progress
+func (p *pipeline) drain(ctx context.Context, result *Result) error {
+	for {
+		report, err := p.outbox.Drain(ctx, true)
+		if err != nil {
+			return fmt.Errorf("failed to drain Firefly outbox: %w", err)
+		}
+		result.Delivered += report.Delivered
+		if report.Delivered+report.Discarded == 0 || report.Failed > 0 {
+			return nil
+		}
+	}
+}
+
+func (p *pipeline) close() {
+	p.pool.Close()
+	p.server.Close()
+}
+
+// recorder collects durations
+type recorder struct {
+	mu        sync.Mutex
+	durations []time.Duration
+}
+
+func (r *recorder) record(d time.Duration) {
+	r.mu.Lock()
+	r.durations = append(r.durations, d)
+	r.mu.Unlock()
+}
+
+// transport records the duration of every round trip through base
+func (r *recorder) transport(base http.RoundTripper) http.RoundTripper {
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		started := time.Now()
+		resp, err := base.RoundTrip(req)
+		r.record(time.Since(started))
+		return resp, err
+	})
+}
+
+// summary returns the percentiles of the recorded durations
+func (r *recorder) summary() Latency {
+	r.mu.Lock()
+	durations := slices.Clone(r.durations)
+	r.mu.Unlock()
+	if len(durations) == 0 {
+		return Latency{}
+	}
+
+	slices.Sort(durations)
+	at := func(p float64) float64 {
+		return milliseconds(durations[int(p*float64(len(durations)-1))])
+	}
+	return Latency{
+		Count: len(durations),
+		P50MS: at(0.50),
+		P90MS: at(0.90),
+		P99MS: at(0.99),
+		MaxMS: milliseconds(durations[len(durations)-1]),
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
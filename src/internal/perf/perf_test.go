@@ -0,0 +1,112 @@
+package perf
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestMain(m *testing.M) {
+	// Every import logs a summary; keep the output readable
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	os.Exit(m.Run())
+}
+
+func TestRun(t *testing.T) {
+	result, err := Run(context.Background(), Config{
+		Wallets:       4,
+		Transactions:  50,
+		Rounds:        2,
+		DuplicateRate: 0.2,
+		Seed:          1,
+	})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if result.Generated != 200 {
+		t.Errorf("Generated = %d, want 200", result.Generated)
+	}
+	if result.Injected == 0 {
+		t.Fatal("Injected = 0, want duplicates in the second round")
+	}
+	// Duplicates only appear in the last round, so each is blocked once
+	if result.Duplicates != result.Injected {
+		t.Errorf("Duplicates = %d, want %d", result.Duplicates, result.Injected)
+	}
+	if want := result.Generated - result.Injected; result.Imported != want {
+		t.Errorf("Imported = %d, want %d", result.Imported, want)
+	}
+	if result.Delivered != result.Imported || result.Pending != 0 {
+		t.Errorf("Delivered = %d, Pending = %d, want %d delivered", result.Delivered, result.Pending, result.Imported)
+	}
+	if result.Failed != 0 || result.Invalid != 0 {
+		t.Errorf("Failed = %d, Invalid = %d, want none", result.Failed, result.Invalid)
+	}
+	if result.SyncLatency.Count != 8 {
+		t.Errorf("SyncLatency.Count = %d, want one per source and round", result.SyncLatency.Count)
+	}
+	if result.RequestLatency.Count == 0 || result.Allocs == 0 || result.Throughput <= 0 {
+		t.Errorf("missing measurements: %+v", result)
+	}
+}
+
+func TestRunIsIdempotentAcrossRounds(t *testing.T) {
+	// Every round fetches the earlier history again; none of it is imported twice
+	result, err := Run(context.Background(), Config{Wallets: 2, Transactions: 30, Rounds: 3, Seed: 7})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if result.Imported != 60 || result.Duplicates != 0 || result.Delivered != 60 {
+		t.Errorf("Imported = %d, Duplicates = %d, Delivered = %d, want 60, 0, 60",
+			result.Imported, result.Duplicates, result.Delivered)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{"defaults", Config{}.withDefaults(), false},
+		{"negative wallets", Config{Wallets: -1}, true},
+		{"more rounds than transactions", Config{Transactions: 2, Rounds: 3}, true},
+		{"duplicate rate of one", Config{Transactions: 1, Rounds: 1, DuplicateRate: 1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// BenchmarkImportPipeline reports the throughput and allocations of the
+// import pipeline for growing loads:
+//
+//	go test ./internal/perf -run '^$' -bench ImportPipeline -benchmem
+func BenchmarkImportPipeline(b *testing.B) {
+	sizes := []struct{ wallets, transactions int }{{1, 100}, {10, 100}, {10, 1000}}
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%dx%d", size.wallets, size.transactions), func(b *testing.B) {
+			cfg := Config{Wallets: size.wallets, Transactions: size.transactions, DuplicateRate: 0.05, Seed: 1}
+			var imported int
+			var throughput float64
+			for b.Loop() {
+				result, err := Run(context.Background(), cfg)
+				if err != nil {
+					b.Fatal(err)
+				}
+				imported += result.Imported
+				throughput += result.Throughput
+			}
+			b.ReportMetric(throughput/float64(b.N), "tx/s")
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(imported), "ns/tx")
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package perf
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+)
+
+// transactionSpacing separates the dates of generated transactions by more
+// than the default duplicate window, so only the injected duplicates match
+const transactionSpacing = 25 * time.Hour
+
+// categoryHints are the categories generated transactions are classified with
+var categoryHints = []string{"Groceries", "Transport", "Utilities", "Dining", "Salary", "Refunds"}
+
+// syntheticSource is a source client reporting a generated transaction
+// history. Like a provider returning a date window, each round reports the
+// whole history up to the round, so earlier rounds are fetched again.
+type syntheticSource struct {
+	history    []models.Transaction
+	boundaries []int // end of the history reported by each round
+	duplicates int   // transactions in history that copy an earlier one
+
+	mu       sync.Mutex
+	reported int
+}
+
+// newSyntheticSource generates the history of one wallet. After the first
+// round, a transaction is a copy of one from an earlier round with a new
+// provider ID at duplicateRate, as when a provider re-issues a booking. The
+// import blocks such a copy again in every later round that fetches it.
+func newSyntheticSource(rng *rand.Rand, wallet, transactions, rounds int, duplicateRate float64, now time.Time) *syntheticSource {
+	s := &syntheticSource{
+		history:    make([]models.Transaction, transactions),
+		boundaries: make([]int, rounds),
+	}
+	for round := range rounds {
+		s.boundaries[round] = (round + 1) * transactions / rounds
+	}
+
+	roundStart := 0
+	for i := range s.history {
+		if slices.Contains(s.boundaries, i) {
+			roundStart = i
+		}
+		id := fmt.Sprintf("perf-%d-%d", wallet, i)
+
+		if roundStart > 0 && rng.Float64() < duplicateRate {
+			tx := cloneHistoryEntry(&s.history[rng.IntN(roundStart)])
+			tx.ID = id
+			tx.MergeMetadata(map[string]string{"providerId": id})
+			s.history[i] = *tx
+			s.duplicates++
+			continue
+		}
+
+		txType := models.TransactionTypeExpense
+		if rng.Float64() < 0.3 {
+			txType = models.TransactionTypeIncome
+		}
+		amount := math.Round((1+rng.Float64()*499)*100) / 100
+		date := now.Add(-time.Duration(transactions-i) * transactionSpacing)
+
+		tx := models.NewTransaction(amount, fmt.Sprintf("Synthetic payment %d", i), date, txType, "", "")
+		tx.ID = id
+		tx.Reference = fmt.Sprintf("RF%08d", rng.IntN(1e8))
+		tx.Metadata = map[string]string{
+			"providerId":                id,
+			"counterparty":              fmt.Sprintf("Merchant %d", rng.IntN(50)),
+			models.MetadataCategoryHint: categoryHints[rng.IntN(len(categoryHints))],
+		}
+		s.history[i] = *tx
+	}
+	return s
+}
+
+// cloneHistoryEntry copies a transaction with its metadata, as the import
+// pipeline changes the transactions it is handed
+func cloneHistoryEntry(tx *models.Transaction) *models.Transaction {
+	copied := *tx
+	copied.Metadata = maps.Clone(tx.Metadata)
+	return &copied
+}
+
+// advance makes the source report the history up to the end of a round
+func (s *syntheticSource) advance(round int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reported = s.boundaries[round]
+}
+
+// GetBalance returns the net amount of the reported history
+func (s *syntheticSource) GetBalance(account string) (models.BalanceInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	balance := 0.0
+	for _, tx := range s.history[:s.reported] {
+		for _, delta := range tx.BalanceEffects() {
+			balance += delta
+		}
+	}
+	return models.BalanceInfo{Amount: balance, Currency: "EUR"}, nil
+}
+
+// FetchTransactions returns copies of the reported history
+func (s *syntheticSource) FetchTransactions(account string) ([]models.Transaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fetched := make([]models.Transaction, s.reported)
+	for i := range fetched {
+		fetched[i] = *cloneHistoryEntry(&s.history[i])
+	}
+	return fetched, nil
+}
@@ -0,0 +1,478 @@
+package perf
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/google/uuid"
+)
+
+// The stores below keep the pipeline's data in memory, so a run measures the
+// pipeline rather than the database. They copy models in and out like the
+// PocketBase repositories do; operations the import pipeline never calls
+// return errors.ErrUnsupported.
+
+// walletStore implements repositories.WalletRepository in memory
+type walletStore struct {
+	mu      sync.Mutex
+	wallets map[string]*models.Wallet
+}
+
+func newWalletStore() *walletStore {
+	return &walletStore{wallets: make(map[string]*models.Wallet)}
+}
+
+func (s *walletStore) FindByID(ctx context.Context, id string) (*models.Wallet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wallet, ok := s.wallets[id]
+	if !ok {
+		return nil, fmt.Errorf("failed to find wallet: %w", sql.ErrNoRows)
+	}
+	copied := *wallet
+	return &copied, nil
+}
+
+func (s *walletStore) FindAll(ctx context.Context, filter repositories.WalletFilter) ([]*models.Wallet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wallets := make([]*models.Wallet, 0)
+	for _, wallet := range s.wallets {
+		switch {
+		case filter.Type != "" && wallet.Type != filter.Type,
+			filter.Currency != "" && wallet.Currency != filter.Currency,
+			filter.NameLike != "" && !strings.Contains(strings.ToLower(wallet.Name), strings.ToLower(filter.NameLike)),
+			filter.SpaceID != "" && wallet.SpaceID != filter.SpaceID,
+			!filter.IncludeArchived && wallet.Archived:
+			continue
+		}
+		copied := *wallet
+		wallets = append(wallets, &copied)
+	}
+	slices.SortFunc(wallets, func(a, b *models.Wallet) int { return strings.Compare(a.Name, b.Name) })
+	return window(wallets, filter.Offset, filter.Limit), nil
+}
+
+func (s *walletStore) Create(ctx context.Context, wallet *models.Wallet) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if wallet.ID == "" {
+		wallet.ID = uuid.New().String()
+	}
+	copied := *wallet
+	s.wallets[wallet.ID] = &copied
+	return nil
+}
+
+func (s *walletStore) Update(ctx context.Context, wallet *models.Wallet) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.wallets[wallet.ID]; !ok {
+		return fmt.Errorf("failed to find wallet: %w", sql.ErrNoRows)
+	}
+	wallet.Version++
+	copied := *wallet
+	s.wallets[wallet.ID] = &copied
+	return nil
+}
+
+func (s *walletStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.wallets, id)
+	return nil
+}
+
+func (s *walletStore) UpdateBalance(ctx context.Context, id string, amount float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wallet, ok := s.wallets[id]
+	if !ok {
+		return fmt.Errorf("failed to find wallet: %w", sql.ErrNoRows)
+	}
+	wallet.UpdateBalance(amount)
+	return nil
+}
+
+func (s *walletStore) Archive(ctx context.Context, id string) error {
+	return errors.ErrUnsupported
+}
+
+func (s *walletStore) Unarchive(ctx context.Context, id string) error {
+	return errors.ErrUnsupported
+}
+
+func (s *walletStore) ReplayBalances(ctx context.Context, walletID string, fix bool) ([]*models.WalletBalanceCheck, error) {
+	return nil, errors.ErrUnsupported
+}
+
+// transactionStore implements repositories.TransactionRepository in memory.
+// Transactions are indexed by wallet and by the external ID of the source, as
+// the lookups of the import pipeline are in the database.
+type transactionStore struct {
+	mu           sync.Mutex
+	transactions map[string]*models.Transaction
+	byWallet     map[string][]*models.Transaction
+	byExternalID map[string]*models.Transaction // wallet ID + "|" + external ID
+}
+
+func newTransactionStore() *transactionStore {
+	return &transactionStore{
+		transactions: make(map[string]*models.Transaction),
+		byWallet:     make(map[string][]*models.Transaction),
+		byExternalID: make(map[string]*models.Transaction),
+	}
+}
+
+// cloneTransaction copies a transaction with its tags and metadata
+func cloneTransaction(tx *models.Transaction) *models.Transaction {
+	copied := *tx
+	copied.Tags = slices.Clone(tx.Tags)
+	copied.Metadata = maps.Clone(tx.Metadata)
+	return &copied
+}
+
+func (s *transactionStore) FindByID(ctx context.Context, id string) (*models.Transaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tx, ok := s.transactions[id]
+	if !ok {
+		return nil, fmt.Errorf("failed to find transaction: %w", sql.ErrNoRows)
+	}
+	return cloneTransaction(tx), nil
+}
+
+func (s *transactionStore) FindAll(ctx context.Context, filter repositories.TransactionFilter) ([]*models.Transaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	candidates := s.byWallet[filter.WalletID]
+	if filter.WalletID == "" {
+		candidates = slices.Collect(maps.Values(s.transactions))
+	} else if externalID, ok := filter.Metadata[usecases.MetadataExternalID]; ok {
+		candidates = nil
+		if tx, ok := s.byExternalID[filter.WalletID+"|"+externalID]; ok {
+			candidates = []*models.Transaction{tx}
+		}
+	}
+
+	found := make([]*models.Transaction, 0)
+	for _, tx := range candidates {
+		if matchesFilter(tx, filter) {
+			found = append(found, cloneTransaction(tx))
+		}
+	}
+	slices.SortFunc(found, func(a, b *models.Transaction) int { return b.Date.Compare(a.Date) })
+	return window(found, filter.Offset, filter.Limit), nil
+}
+
+// matchesFilter reports whether a transaction passes a filter
+func matchesFilter(tx *models.Transaction, filter repositories.TransactionFilter) bool {
+	switch {
+	case filter.WalletID != "" && tx.WalletID != filter.WalletID,
+		filter.CategoryID != "" && tx.CategoryID != filter.CategoryID,
+		filter.Type != "" && tx.Type != filter.Type,
+		filter.Status != "" && tx.Status != filter.Status,
+		!filter.DateFrom.IsZero() && tx.Date.Before(filter.DateFrom),
+		!filter.DateTo.IsZero() && tx.Date.After(filter.DateTo),
+		filter.OnlyDeleted && !tx.IsDeleted(),
+		!filter.OnlyDeleted && !filter.IncludeDeleted && tx.IsDeleted(),
+		!filter.DeletedBefore.IsZero() && (!tx.IsDeleted() || !tx.DeletedAt.Before(filter.DeletedBefore)),
+		filter.OnlyUnlinked && tx.IsLinked(),
+		filter.ExcludeSandbox && tx.IsSandbox():
+		return false
+	}
+	for key, value := range filter.Metadata {
+		if tx.Metadata[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *transactionStore) Create(ctx context.Context, tx *models.Transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.insert(tx)
+	return nil
+}
+
+// insert stores a new transaction and indexes it. Must be called with the lock held.
+func (s *transactionStore) insert(tx *models.Transaction) {
+	if tx.ID == "" {
+		tx.ID = uuid.New().String()
+	}
+	now := time.Now()
+	tx.CreatedAt, tx.UpdatedAt = now, now
+
+	stored := cloneTransaction(tx)
+	s.transactions[tx.ID] = stored
+	s.byWallet[tx.WalletID] = append(s.byWallet[tx.WalletID], stored)
+	if externalID := tx.Metadata[usecases.MetadataExternalID]; externalID != "" {
+		s.byExternalID[tx.WalletID+"|"+externalID] = stored
+	}
+}
+
+func (s *transactionStore) Update(ctx context.Context, tx *models.Transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.transactions[tx.ID]
+	if !ok {
+		return fmt.Errorf("failed to find transaction: %w", sql.ErrNoRows)
+	}
+	if stored.Version != tx.Version {
+		return models.ErrConflict
+	}
+	tx.Version++
+	tx.UpdatedAt = time.Now()
+	*stored = *cloneTransaction(tx)
+	return nil
+}
+
+func (s *transactionStore) CreateMany(ctx context.Context, transactions []*models.Transaction) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, tx := range transactions {
+		s.insert(tx)
+	}
+	return len(transactions), nil
+}
+
+func (s *transactionStore) UpdateMany(ctx context.Context, transactions []*models.Transaction) (int, error) {
+	return 0, errors.ErrUnsupported
+}
+
+func (s *transactionStore) Delete(ctx context.Context, id string) error {
+	return errors.ErrUnsupported
+}
+
+// FindDuplicates matches like the PocketBase repository: same wallet and type,
+// an amount within tolerance and a date within the range, where end-to-end
+// IDs and references tell payments apart
+func (s *transactionStore) FindDuplicates(ctx context.Context, tx *models.Transaction, start, end time.Time, tolerance float64) ([]*models.Transaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	similar := func(other *models.Transaction) bool {
+		return math.Abs(other.Amount-tx.Amount) <= tolerance &&
+			!other.Date.Before(start) && !other.Date.After(end) &&
+			other.Type == tx.Type
+	}
+
+	duplicates := make([]*models.Transaction, 0)
+	for _, other := range s.byWallet[tx.WalletID] {
+		switch {
+		case other.ID == tx.ID, other.IsDeleted(),
+			tx.Reference != "" && other.Reference != "" && other.Reference != tx.Reference,
+			tx.Type == models.TransactionTypeTransfer && tx.DestWalletID != "" && other.DestWalletID != tx.DestWalletID:
+			continue
+		}
+
+		match := similar(other)
+		if tx.EndToEndID != "" {
+			match = other.EndToEndID == tx.EndToEndID || (match && other.EndToEndID == "")
+		}
+		if match {
+			duplicates = append(duplicates, cloneTransaction(other))
+		}
+	}
+	return duplicates, nil
+}
+
+func (s *transactionStore) SoftDelete(ctx context.Context, id string) error {
+	return errors.ErrUnsupported
+}
+
+func (s *transactionStore) Restore(ctx context.Context, id string) error {
+	return errors.ErrUnsupported
+}
+
+func (s *transactionStore) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	return 0, errors.ErrUnsupported
+}
+
+func (s *transactionStore) FindByFireflyID(ctx context.Context, fireflyID string) (*models.Transaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, tx := range s.transactions {
+		if tx.FireflyID == fireflyID {
+			return cloneTransaction(tx), nil
+		}
+	}
+	return nil, fmt.Errorf("failed to find transaction: %w", sql.ErrNoRows)
+}
+
+func (s *transactionStore) Merge(ctx context.Context, keep *models.Transaction, dropped []*models.Transaction) error {
+	return errors.ErrUnsupported
+}
+
+func (s *transactionStore) SetFireflyID(ctx context.Context, id, fireflyID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tx, ok := s.transactions[id]
+	if !ok {
+		return fmt.Errorf("failed to find transaction: %w", sql.ErrNoRows)
+	}
+	tx.FireflyID = fireflyID
+	return nil
+}
+
+func (s *transactionStore) ReencryptFields(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+// categoryStore implements repositories.CategoryRepository in memory
+type categoryStore struct {
+	mu         sync.Mutex
+	categories map[string]*models.Category
+}
+
+func newCategoryStore() *categoryStore {
+	return &categoryStore{categories: make(map[string]*models.Category)}
+}
+
+func (s *categoryStore) FindByID(ctx context.Context, id string) (*models.Category, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	category, ok := s.categories[id]
+	if !ok {
+		return nil, fmt.Errorf("failed to find category: %w", sql.ErrNoRows)
+	}
+	copied := *category
+	return &copied, nil
+}
+
+func (s *categoryStore) FindAll(ctx context.Context, filter repositories.CategoryFilter) ([]*models.Category, error) {
+	return s.find(func(*models.Category) bool { return true }), nil
+}
+
+func (s *categoryStore) Create(ctx context.Context, category *models.Category) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if category.ID == "" {
+		category.ID = uuid.New().String()
+	}
+	copied := *category
+	s.categories[category.ID] = &copied
+	return nil
+}
+
+func (s *categoryStore) Update(ctx context.Context, category *models.Category) error {
+	return errors.ErrUnsupported
+}
+
+func (s *categoryStore) Delete(ctx context.Context, id string) error {
+	return errors.ErrUnsupported
+}
+
+func (s *categoryStore) FindByType(ctx context.Context, categoryType models.CategoryType) ([]*models.Category, error) {
+	return s.find(func(c *models.Category) bool { return c.Type == categoryType }), nil
+}
+
+func (s *categoryStore) FindSystemCategories(ctx context.Context) ([]*models.Category, error) {
+	return s.find(func(c *models.Category) bool { return c.IsSystem }), nil
+}
+
+// find returns copies of the categories matching a predicate
+func (s *categoryStore) find(match func(*models.Category) bool) []*models.Category {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := make([]*models.Category, 0)
+	for _, category := range s.categories {
+		if match(category) {
+			copied := *category
+			found = append(found, &copied)
+		}
+	}
+	return found
+}
+
+// outboxStore implements repositories.FireflyOutboxRepository in memory
+type outboxStore struct {
+	mu      sync.Mutex
+	entries []*models.FireflyOutboxEntry // in queue order
+	byTx    map[string]*models.FireflyOutboxEntry
+}
+
+func newOutboxStore() *outboxStore {
+	return &outboxStore{byTx: make(map[string]*models.FireflyOutboxEntry)}
+}
+
+func (s *outboxStore) Enqueue(ctx context.Context, entries []*models.FireflyOutboxEntry) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	queued := 0
+	for _, entry := range entries {
+		if _, ok := s.byTx[entry.TransactionID]; ok {
+			continue
+		}
+		entry.ID = uuid.New().String()
+		copied := *entry
+		s.entries = append(s.entries, &copied)
+		s.byTx[entry.TransactionID] = &copied
+		queued++
+	}
+	return queued, nil
+}
+
+func (s *outboxStore) FindDue(ctx context.Context, now time.Time, limit int) ([]*models.FireflyOutboxEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	due := make([]*models.FireflyOutboxEntry, 0)
+	for _, entry := range s.entries {
+		if limit > 0 && len(due) == limit {
+			break
+		}
+		if entry.Status == models.FireflyOutboxPending && !entry.NextAttemptAt.After(now) {
+			copied := *entry
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (s *outboxStore) Update(ctx context.Context, entry *models.FireflyOutboxEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.byTx[entry.TransactionID]
+	if !ok || stored.ID != entry.ID {
+		return fmt.Errorf("failed to find outbox entry %s: %w", entry.ID, sql.ErrNoRows)
+	}
+	*stored = *entry
+	return nil
+}
+
+func (s *outboxStore) CountPending(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := 0
+	for _, entry := range s.entries {
+		if entry.Status == models.FireflyOutboxPending {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+// window applies an offset and a limit to a result
+func window[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return items[:0]
+	}
+	items = items[offset:]
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
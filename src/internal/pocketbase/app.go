@@ -4,10 +4,61 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/firefly"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/events"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/graphapi"
 	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/apis"
 	"github.com/pocketbase/pocketbase/core"
 )
 
+// Services bundles the domain services exposed through the custom API routes
+type Services struct {
+	Valuation         *usecases.ValuationService
+	Rules             *usecases.RuleService
+	Transactions      *usecases.TransactionService
+	Import            *usecases.ImportService
+	Balances          *usecases.BalanceService
+	Tags              *usecases.TagService
+	Sources           *usecases.SourceService
+	SourceSync        *usecases.SourceSyncService
+	Consents          *usecases.ConsentService
+	CostBasis         *usecases.CostBasisService
+	ExchangeImport    *usecases.ExchangeImportService
+	StatementImport   *usecases.StatementImportService
+	BudgetImport      *usecases.BudgetImportService
+	Incidents         *usecases.IncidentService
+	Backfills         *usecases.BackfillService
+	BalanceUpdates    *usecases.BalanceUpdateService
+	BalanceAssertions *usecases.BalanceAssertionService
+	Scheduler         *usecases.ImportScheduler
+	Export            *usecases.ExportService
+	Subscriptions     *usecases.SubscriptionService
+	Spaces            *usecases.SpaceService
+	Preferences       *usecases.PreferencesService
+	Maintenance       *usecases.MaintenanceService
+	Ledger            *usecases.LedgerService
+	Calendar          *usecases.CalendarService
+	GraphQL           *graphapi.API
+	Audit             *usecases.AuditService // nil when auditing is disabled
+	Periods           models.PeriodCalendar
+	Categorization    *usecases.CategorizationService // nil when the classifier is disabled
+	Webhooks          *usecases.WebhookService        // nil when webhooks are disabled
+	Events            *events.JetStreamPublisher      // nil while NATS is not connected
+	Streams           *events.StreamAdmin             // nil while NATS is not connected
+
+	// Optional services, nil when Firefly is not configured
+	FireflyAccounts  *usecases.AccountMappingService
+	FireflyLinks     *usecases.FireflyLinkService
+	FireflyBootstrap *usecases.FireflyBootstrapService
+	FireflySync      *usecases.FireflySyncService
+	FireflyOutbox    *usecases.FireflyOutboxService // also nil when the outbox is disabled
+	FireflyOAuth     *usecases.FireflyOAuthService  // also nil when Firefly uses a personal access token
+	FireflyCache     *firefly.CachingClient         // also nil when the reference cache is disabled
+}
+
 // RegisterHooks is currently unused as hooks are registered directly in main.go
 // func RegisterHooks(app *pocketbase.PocketBase) error {
 //  // Hooks are now registered via pb_hooks.RegisterTransactionHooks in main.go
@@ -15,7 +66,7 @@ import (
 // }
 
 // RegisterRoutes registers all custom API routes
-func RegisterRoutes(app *pocketbase.PocketBase) error {
+func RegisterRoutes(app *pocketbase.PocketBase, services *Services) error {
 	// Register custom API routes using OnServe hook with BindFunc
 	app.OnServe().BindFunc(func(e *core.ServeEvent) error {
 		// Example: Add a custom /api/hello endpoint
@@ -35,7 +86,38 @@ func RegisterRoutes(app *pocketbase.PocketBase) error {
 			return err // Return potential write error
 		})
 
-		// TODO: Add more custom API endpoints here
+		// Maintenance mode also covers the record API and the health check
+		e.Router.BindFunc(recordMaintenanceGuard(services.Maintenance))
+
+		// FireDragon domain routes require an authenticated user or superuser
+		// and respond to errors with problem+json
+		api := e.Router.Group("/api/firedragon")
+		api.Bind(problems(), apis.RequireAuth())
+
+		registerAuditRoutes(api, services)
+		registerMaintenanceRoutes(api, services)
+		registerNetWorthRoutes(api, services)
+		registerCostBasisRoutes(api, services)
+		registerRuleRoutes(api, services)
+		registerTransactionRoutes(api, services)
+		registerBalanceRoutes(api, services)
+		registerSourceRoutes(api, services)
+		registerImportRoutes(api, services)
+		registerTagRoutes(api, services)
+		registerCategorizationRoutes(api, services)
+		registerSubscriptionRoutes(api, services)
+		registerSpaceRoutes(api, services)
+		registerPeriodRoutes(api, services)
+		registerPreferenceRoutes(api, services)
+		registerExportRoutes(api, services)
+		registerIncidentRoutes(api, services)
+		registerMetricsRoutes(api, services)
+		registerWebhookRoutes(api, services)
+		registerCalendarRoutes(api, services)
+		registerGraphQLRoutes(api, services)
+		registerStreamRoutes(api, services)
+		registerFireflyRoutes(api, services)
+		registerOpenAPIRoutes(api)
 
 		return e.Next() // Call e.Next() to proceed with the hook chain
 	})
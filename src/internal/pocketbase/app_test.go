@@ -0,0 +1,173 @@
+package pocketbase
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	pbrepo "github.com/ZanzyTHEbar/firedragon-go/adapters/repositories/pocketbase"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	_ "github.com/ZanzyTHEbar/firedragon-go/pb_migrations" // the app migrations, applied by the test app
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/apis"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tests"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// testDate is the date of the test transactions
+var testDate = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+// testSpaces is a migrated test app with two users and their spaces: alice
+// edits home and views company, which bob owns
+type testSpaces struct {
+	app      *pocketbase.PocketBase
+	services *Services
+
+	alice, bob, superuser string // auth tokens
+	home, company         *models.Space
+	checking, payroll     *models.Wallet   // of home and company
+	category              *models.Category // a system category
+	rent, salary          *models.Transaction
+	travel                *models.Tag // on the salary
+}
+
+func newTestSpaces(t *testing.T) *testSpaces {
+	t.Helper()
+	testApp, err := tests.NewTestAppWithConfig(core.BaseAppConfig{DataDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewTestAppWithConfig() error = %v", err)
+	}
+	t.Cleanup(testApp.Cleanup)
+
+	ctx := context.Background()
+	app := &pocketbase.PocketBase{App: testApp}
+	factory := pbrepo.NewRepositoryFactory(app)
+	spaceRepo := factory.CreateSpaceRepository()
+	walletRepo := factory.CreateWalletRepository()
+	categoryRepo := factory.CreateCategoryRepository()
+	transactionRepo := factory.CreateTransactionRepository()
+	tagRepo := factory.CreateTagRepository()
+
+	s := &testSpaces{
+		app: app,
+		services: &Services{
+			Spaces:       usecases.NewSpaceService(spaceRepo, walletRepo, categoryRepo, transactionRepo),
+			Transactions: usecases.NewTransactionService(walletRepo, categoryRepo, transactionRepo),
+			Import:       usecases.NewImportService(walletRepo, transactionRepo),
+			Tags:         usecases.NewTagService(tagRepo),
+		},
+	}
+
+	alice := s.createUser(t, "users", "alice@example.com")
+	bob := s.createUser(t, "users", "bob@example.com")
+	s.alice, s.bob = s.token(t, alice), s.token(t, bob)
+	s.superuser = s.token(t, s.createUser(t, core.CollectionNameSuperusers, "admin@example.com"))
+
+	s.home = models.NewSpace("Home", models.SpaceKindHousehold)
+	s.company = models.NewSpace("Company", models.SpaceKindBusiness)
+	for _, space := range []*models.Space{s.home, s.company} {
+		if err := spaceRepo.Create(ctx, space); err != nil {
+			t.Fatalf("failed to create space: %v", err)
+		}
+	}
+	for _, member := range []*models.SpaceMember{
+		{SpaceID: s.home.ID, UserID: alice.Id, Role: models.SpaceRoleEditor},
+		{SpaceID: s.company.ID, UserID: bob.Id, Role: models.SpaceRoleOwner},
+		{SpaceID: s.company.ID, UserID: alice.Id, Role: models.SpaceRoleViewer},
+	} {
+		if err := spaceRepo.SaveMember(ctx, member); err != nil {
+			t.Fatalf("failed to add space member: %v", err)
+		}
+	}
+
+	s.checking = &models.Wallet{Name: "Checking", Currency: "EUR", Type: models.WalletTypeBank, Balance: 1000, SpaceID: s.home.ID}
+	s.payroll = &models.Wallet{Name: "Payroll", Currency: "EUR", Type: models.WalletTypeBank, Balance: 5000, SpaceID: s.company.ID}
+	for _, wallet := range []*models.Wallet{s.checking, s.payroll} {
+		if err := walletRepo.Create(ctx, wallet); err != nil {
+			t.Fatalf("failed to create wallet: %v", err)
+		}
+	}
+
+	s.travel = models.NewTag("travel", "", "")
+	if err := tagRepo.Create(ctx, s.travel); err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+	categories, err := categoryRepo.FindAll(ctx, repositories.CategoryFilter{Limit: 1})
+	if err != nil || len(categories) == 0 {
+		t.Fatalf("failed to find a system category: %v", err)
+	}
+	s.category = categories[0]
+	s.rent = &models.Transaction{ID: core.GenerateDefaultRandomId(), Amount: 900, Description: "Rent", Date: testDate, Type: models.TransactionTypeExpense,
+		CategoryID: s.category.ID, WalletID: s.checking.ID, Status: models.TransactionStatusCompleted}
+	s.salary = &models.Transaction{ID: core.GenerateDefaultRandomId(), Amount: 3000, Description: "Salary", Date: testDate, Type: models.TransactionTypeIncome,
+		CategoryID: s.category.ID, WalletID: s.payroll.ID, Status: models.TransactionStatusCompleted, Tags: []string{"travel"}}
+	for _, tx := range []*models.Transaction{s.rent, s.salary} {
+		if err := transactionRepo.Create(ctx, tx); err != nil {
+			t.Fatalf("failed to create transaction: %v", err)
+		}
+	}
+	// Read them back for the versions the records start at
+	if s.rent, err = transactionRepo.FindByID(ctx, s.rent.ID); err != nil {
+		t.Fatalf("failed to read transaction: %v", err)
+	}
+	if s.salary, err = transactionRepo.FindByID(ctx, s.salary.ID); err != nil {
+		t.Fatalf("failed to read transaction: %v", err)
+	}
+	return s
+}
+
+func (s *testSpaces) createUser(t *testing.T, collection, email string) *core.Record {
+	t.Helper()
+	users, err := s.app.FindCollectionByNameOrId(collection)
+	if err != nil {
+		t.Fatalf("failed to find %s: %v", collection, err)
+	}
+	user := core.NewRecord(users)
+	user.SetEmail(email)
+	user.SetPassword("1234567890")
+	if err := s.app.Save(user); err != nil {
+		t.Fatalf("failed to create user %s: %v", email, err)
+	}
+	return user
+}
+
+func (s *testSpaces) token(t *testing.T, record *core.Record) string {
+	t.Helper()
+	token, err := record.NewAuthToken()
+	if err != nil {
+		t.Fatalf("NewAuthToken() error = %v", err)
+	}
+	return token
+}
+
+// serve sends a request with an auth token to the routes that register binds
+// and returns the response status and body
+func (s *testSpaces) serve(t *testing.T, register func(*router.RouterGroup[*core.RequestEvent], *Services), method, url, token, body string) (int, string) {
+	t.Helper()
+	r, err := apis.NewRouter(s.app)
+	if err != nil {
+		t.Fatalf("NewRouter() error = %v", err)
+	}
+	api := r.Group("/api/firedragon")
+	api.Bind(problems(), apis.RequireAuth())
+	register(api, s.services)
+	mux, err := r.BuildMux()
+	if err != nil {
+		t.Fatalf("BuildMux() error = %v", err)
+	}
+
+	request := httptest.NewRequest(method, url, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", token)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+
+	response, _ := io.ReadAll(recorder.Result().Body)
+	return recorder.Code, string(response)
+}
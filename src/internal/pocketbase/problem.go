@@ -0,0 +1,253 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/hook"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// problemContentType is the media type of RFC 7807 problem details
+const problemContentType = "application/problem+json"
+
+// Stable codes of the problems the API responds with. Clients switch on the
+// code, the title is for humans and may change.
+const (
+	ProblemValidation          = "validation_failed"    // the fields listed in errors failed validation
+	ProblemBadRequest          = "bad_request"          // the request is malformed
+	ProblemUnauthorized        = "unauthorized"         // the request carries no valid auth token
+	ProblemForbidden           = "forbidden"            // the caller may not access the resource
+	ProblemNotFound            = "not_found"            // the resource does not exist
+	ProblemConflict            = "conflict"             // the resource was modified concurrently or is in use
+	ProblemRateLimited         = "rate_limited"         // the caller or an upstream provider throttled the request
+	ProblemUpstreamAuth        = "upstream_auth"        // a provider or Firefly rejected the stored credentials
+	ProblemUpstreamError       = "upstream_error"       // a provider or Firefly failed or answered malformed
+	ProblemUpstreamUnavailable = "upstream_unavailable" // a provider or Firefly is unreachable
+	ProblemUpstreamUnsupported = "upstream_unsupported" // the connected Firefly release lacks the feature
+	ProblemTimeout             = "timeout"              // the request did not complete in time
+	ProblemMaintenance         = "maintenance"          // maintenance mode refuses changes, reads keep working
+	ProblemInternal            = "internal"             // the server failed unexpectedly
+)
+
+// validRequestID limits the correlation IDs accepted from clients, so they
+// are safe to log and echo
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// Problem is an RFC 7807 problem details body
+type Problem struct {
+	Type      string                   `json:"type"`
+	Title     string                   `json:"title"`
+	Status    int                      `json:"status"`
+	Detail    string                   `json:"detail,omitempty"`
+	Instance  string                   `json:"instance,omitempty"`
+	Code      string                   `json:"code"`
+	RequestID string                   `json:"requestId,omitempty"`
+	Errors    []*validation.FieldError `json:"errors,omitempty"` // the fields that failed validation
+}
+
+// problemType is the type URI of a problem code
+func problemType(code string) string {
+	return "urn:firedragon:problem:" + code
+}
+
+// problems is the middleware of the custom routes that tags every request
+// with a correlation ID, carried on in its context, and responds to the
+// errors the handlers return with problem+json. It runs before the auth
+// check, so rejected tokens are reported the same way.
+func problems() *hook.Handler[*core.RequestEvent] {
+	return &hook.Handler[*core.RequestEvent]{
+		Id:       "firedragonProblems",
+		Priority: -1,
+		Func: func(e *core.RequestEvent) error {
+			id := e.Request.Header.Get(internal.RequestIDHeader)
+			if !validRequestID.MatchString(id) {
+				id = internal.NewRequestID()
+			}
+			e.Request = e.Request.WithContext(internal.WithRequestID(e.Request.Context(), id))
+			e.Response.Header().Set(internal.RequestIDHeader, id)
+
+			err := e.Next()
+			if err == nil || e.Written() {
+				return err
+			}
+
+			problem, retryAfter := problemFor(err)
+			problem.Instance = e.Request.URL.Path
+			problem.RequestID = id
+			if problem.Status >= http.StatusInternalServerError {
+				logger := internal.LoggerFrom(e.Request.Context())
+				logger.Error().Err(err).Str("path", e.Request.URL.Path).Int("status", problem.Status).Msg("API request failed")
+			}
+			if retryAfter > 0 {
+				e.Response.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			}
+
+			e.Response.Header().Set("Content-Type", problemContentType)
+			if e.Request.Method == http.MethodHead {
+				e.Response.WriteHeader(problem.Status)
+			} else if writeErr := e.JSON(problem.Status, problem); writeErr != nil {
+				return writeErr
+			}
+
+			// The response is written, returning the error keeps it in the request log
+			return err
+		},
+	}
+}
+
+// problemFor maps an error returned by a handler to its problem and the
+// seconds a client should wait before retrying, zero when it need not wait.
+//
+// Errors the handler already classified with a status keep it, except that a
+// generic 400 or 500 is refined by the typed error it wraps. Unclassified
+// errors are mapped by their type and are internal errors otherwise.
+func problemFor(err error) (Problem, int) {
+	problem := Problem{Status: http.StatusInternalServerError, Title: "Something went wrong"}
+	cause := err
+
+	var apiErr *router.ApiError
+	if errors.As(err, &apiErr) {
+		problem.Status = apiErr.Status
+		problem.Title = apiErr.Message
+		cause, _ = apiErr.RawData().(error)
+	}
+
+	if fields := validation.FieldErrors(cause); fields != nil {
+		problem.Status = http.StatusBadRequest
+		problem.Code = ProblemValidation
+		problem.Detail = fields.Error()
+		problem.Errors = fields
+		problem.Type = problemType(problem.Code)
+		return problem, 0
+	}
+
+	// Maintenance is expected downtime, its reason is shown to the client
+	if cause != nil && errors.Is(cause, models.ErrMaintenance) {
+		problem.Status = http.StatusServiceUnavailable
+		problem.Code = ProblemMaintenance
+		problem.Detail = cause.Error()
+		problem.Type = problemType(problem.Code)
+		if apiErr == nil {
+			problem.Title = "Maintenance mode is on"
+		}
+		return problem, 0
+	}
+
+	retryAfter := 0
+	if cause != nil && (apiErr == nil || apiErr.Status == http.StatusBadRequest || apiErr.Status == http.StatusInternalServerError) {
+		if status, code, ok := classify(cause); ok {
+			problem.Status = status
+			problem.Code = code
+			if apiErr == nil {
+				problem.Title = http.StatusText(status)
+			}
+			if status < http.StatusInternalServerError {
+				problem.Detail = cause.Error()
+			}
+		}
+
+		var clientErr *interfaces.ClientError
+		if errors.As(cause, &clientErr) && clientErr.RetryAfter > 0 {
+			retryAfter = int(clientErr.RetryAfter.Seconds() + 0.5)
+		}
+	}
+
+	if problem.Code == "" {
+		problem.Code = statusCode(problem.Status)
+	}
+	problem.Type = problemType(problem.Code)
+	return problem, retryAfter
+}
+
+// classify maps the typed domain and client errors to a status and problem code
+func classify(err error) (int, string, bool) {
+	switch {
+	case errors.Is(err, models.ErrConflict):
+		return http.StatusConflict, ProblemConflict, true
+	case errors.Is(err, models.ErrSpaceAccessDenied):
+		return http.StatusForbidden, ProblemForbidden, true
+	case errors.Is(err, sql.ErrNoRows),
+		errors.Is(err, models.ErrWalletNotFound),
+		errors.Is(err, models.ErrCategoryNotFound),
+		errors.Is(err, models.ErrAccountMappingNotFound),
+		errors.Is(err, models.ErrSourceNotFound),
+		errors.Is(err, models.ErrBackfillNotFound),
+		errors.Is(err, models.ErrExportNotFound),
+		errors.Is(err, models.ErrBudgetImportNotFound),
+		errors.Is(err, models.ErrSpaceNotFound),
+		errors.Is(err, models.ErrSpaceMemberNotFound),
+		errors.Is(err, models.ErrPreferencesNotFound),
+		errors.Is(err, models.ErrWebhookNotFound),
+		errors.Is(err, models.ErrWebhookDeliveryNotFound),
+		errors.Is(err, models.ErrCalendarFeedNotFound):
+		return http.StatusNotFound, ProblemNotFound, true
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, ProblemTimeout, true
+	}
+
+	var unsupported *interfaces.FireflyUnsupportedError
+	if errors.As(err, &unsupported) {
+		return http.StatusNotImplemented, ProblemUpstreamUnsupported, true
+	}
+
+	var clientErr *interfaces.ClientError
+	if !errors.As(err, &clientErr) {
+		return 0, "", false
+	}
+	switch clientErr.Type {
+	case interfaces.ErrorTypeValidation:
+		return http.StatusBadRequest, ProblemBadRequest, true
+	case interfaces.ErrorTypeNotFound:
+		return http.StatusNotFound, ProblemNotFound, true
+	case interfaces.ErrorTypeRateLimit:
+		return http.StatusTooManyRequests, ProblemRateLimited, true
+	case interfaces.ErrorTypeAuth:
+		return http.StatusBadGateway, ProblemUpstreamAuth, true
+	case interfaces.ErrorTypeNetwork:
+		return http.StatusServiceUnavailable, ProblemUpstreamUnavailable, true
+	case interfaces.ErrorTypeTimeout:
+		return http.StatusGatewayTimeout, ProblemTimeout, true
+	default:
+		return http.StatusBadGateway, ProblemUpstreamError, true
+	}
+}
+
+// statusCode is the problem code of a status the handler chose itself
+func statusCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return ProblemBadRequest
+	case http.StatusUnauthorized:
+		return ProblemUnauthorized
+	case http.StatusForbidden:
+		return ProblemForbidden
+	case http.StatusNotFound:
+		return ProblemNotFound
+	case http.StatusConflict:
+		return ProblemConflict
+	case http.StatusTooManyRequests:
+		return ProblemRateLimited
+	case http.StatusBadGateway:
+		return ProblemUpstreamError
+	case http.StatusServiceUnavailable:
+		return ProblemUpstreamUnavailable
+	case http.StatusNotImplemented:
+		return ProblemUpstreamUnsupported
+	case http.StatusGatewayTimeout:
+		return ProblemTimeout
+	}
+	if status < http.StatusInternalServerError {
+		return ProblemBadRequest
+	}
+	return ProblemInternal
+}
@@ -0,0 +1,237 @@
+package pocketbase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/pocketbase/pocketbase/apis"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tests"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// The middleware answers handler errors with problem+json, tagged with the
+// correlation ID of the request
+func TestProblems_RespondsWithProblemDetails(t *testing.T) {
+	app, err := tests.NewTestAppWithConfig(core.BaseAppConfig{DataDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewTestAppWithConfig() error = %v", err)
+	}
+	t.Cleanup(app.Cleanup)
+
+	r, err := apis.NewRouter(app)
+	if err != nil {
+		t.Fatalf("NewRouter() error = %v", err)
+	}
+	api := r.Group("/api/firedragon")
+	api.Bind(problems())
+	api.GET("/throttled", func(e *core.RequestEvent) error {
+		return interfaces.WrapClientError(interfaces.ErrorTypeNetwork, "failed to sync", &interfaces.ClientError{
+			Type: interfaces.ErrorTypeRateLimit, Message: "slow down", RetryAfter: 30 * time.Second,
+		})
+	})
+	api.GET("/ok", func(e *core.RequestEvent) error {
+		return e.NoContent(http.StatusNoContent)
+	})
+	mux, err := r.BuildMux()
+	if err != nil {
+		t.Fatalf("BuildMux() error = %v", err)
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "/api/firedragon/throttled", nil)
+	request.Header.Set(internal.RequestIDHeader, "sync-42")
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429: %s", recorder.Code, recorder.Body)
+	}
+	if got := recorder.Header().Get("Content-Type"); got != problemContentType {
+		t.Errorf("Content-Type = %q, want %q", got, problemContentType)
+	}
+	if got := recorder.Header().Get("Retry-After"); got != "30" {
+		t.Errorf("Retry-After = %q, want 30", got)
+	}
+	if got := recorder.Header().Get(internal.RequestIDHeader); got != "sync-42" {
+		t.Errorf("%s = %q, want the one of the request", internal.RequestIDHeader, got)
+	}
+	var problem Problem
+	if err := json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("failed to decode problem: %v", err)
+	}
+	if problem.Code != ProblemRateLimited || problem.Instance != "/api/firedragon/throttled" || problem.RequestID != "sync-42" {
+		t.Errorf("problem = %+v, want a rate limit of the request", problem)
+	}
+
+	// Invalid correlation IDs are replaced, successful responses pass untouched
+	request = httptest.NewRequest(http.MethodGet, "/api/firedragon/ok", nil)
+	request.Header.Set(internal.RequestIDHeader, "bad id\n")
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want 204", recorder.Code)
+	}
+	if got := recorder.Header().Get(internal.RequestIDHeader); got == "" || got == "bad id\n" {
+		t.Errorf("%s = %q, want a generated ID", internal.RequestIDHeader, got)
+	}
+}
+
+func TestProblemFor(t *testing.T) {
+	invalid := validation.New()
+	invalid.Add("amount", validation.RuleRequired, "amount is required")
+
+	tests := []struct {
+		name           string
+		err            error
+		wantStatus     int
+		wantCode       string
+		wantTitle      string
+		wantDetail     string
+		wantRetryAfter int
+	}{
+		{
+			name: "validation failure", err: fmt.Errorf("invalid transaction: %w", invalid.Err()),
+			wantStatus: http.StatusBadRequest, wantCode: ProblemValidation, wantTitle: "Something went wrong", wantDetail: "amount: amount is required",
+		},
+		{
+			name: "version conflict", err: fmt.Errorf("failed to update wallet: %w", models.ErrConflict),
+			wantStatus: http.StatusConflict, wantCode: ProblemConflict, wantTitle: "Conflict", wantDetail: "failed to update wallet: " + models.ErrConflict.Error(),
+		},
+		{
+			name: "space access denied", err: models.ErrSpaceAccessDenied,
+			wantStatus: http.StatusForbidden, wantCode: ProblemForbidden, wantTitle: "Forbidden", wantDetail: models.ErrSpaceAccessDenied.Error(),
+		},
+		{
+			name: "missing wallet", err: fmt.Errorf("failed to find wallet: %w", models.ErrWalletNotFound),
+			wantStatus: http.StatusNotFound, wantCode: ProblemNotFound, wantTitle: "Not Found", wantDetail: "failed to find wallet: " + models.ErrWalletNotFound.Error(),
+		},
+		{
+			name: "missing row", err: sql.ErrNoRows,
+			wantStatus: http.StatusNotFound, wantCode: ProblemNotFound, wantTitle: "Not Found", wantDetail: sql.ErrNoRows.Error(),
+		},
+		{
+			name: "maintenance", err: fmt.Errorf("%w: migrating", models.ErrMaintenance),
+			wantStatus: http.StatusServiceUnavailable, wantCode: ProblemMaintenance, wantTitle: "Maintenance mode is on", wantDetail: models.ErrMaintenance.Error() + ": migrating",
+		},
+		{
+			name: "deadline exceeded", err: context.DeadlineExceeded,
+			wantStatus: http.StatusGatewayTimeout, wantCode: ProblemTimeout, wantTitle: "Gateway Timeout",
+		},
+		{
+			name: "unsupported Firefly release", err: &interfaces.FireflyUnsupportedError{Feature: "webhooks", Required: "6.0.0", Version: "5.7.0"},
+			wantStatus: http.StatusNotImplemented, wantCode: ProblemUpstreamUnsupported, wantTitle: "Not Implemented",
+		},
+		{
+			name: "rate limited provider", err: &interfaces.ClientError{Type: interfaces.ErrorTypeRateLimit, Message: "slow down", RetryAfter: 1500 * time.Millisecond},
+			wantStatus: http.StatusTooManyRequests, wantCode: ProblemRateLimited, wantTitle: "Too Many Requests", wantDetail: "slow down", wantRetryAfter: 2,
+		},
+		{
+			name: "rejected credentials", err: interfaces.NewClientError(interfaces.ErrorTypeAuth, "token expired", nil),
+			wantStatus: http.StatusBadGateway, wantCode: ProblemUpstreamAuth, wantTitle: "Bad Gateway",
+		},
+		{
+			name: "unreachable provider", err: interfaces.NewClientError(interfaces.ErrorTypeNetwork, "connection refused", nil),
+			wantStatus: http.StatusServiceUnavailable, wantCode: ProblemUpstreamUnavailable, wantTitle: "Service Unavailable",
+		},
+		{
+			name: "provider bug", err: interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "malformed response", nil),
+			wantStatus: http.StatusBadGateway, wantCode: ProblemUpstreamError, wantTitle: "Bad Gateway",
+		},
+		{
+			name: "api error keeps its status", err: router.NewForbiddenError("Only superusers can sync", nil),
+			wantStatus: http.StatusForbidden, wantCode: ProblemForbidden, wantTitle: "Only superusers can sync.",
+		},
+		{
+			name: "api error keeps its status over the cause", err: router.NewNotFoundError("Unknown wallet", models.ErrConflict),
+			wantStatus: http.StatusNotFound, wantCode: ProblemNotFound, wantTitle: "Unknown wallet.",
+		},
+		{
+			name: "bad request refined by its cause", err: router.NewBadRequestError("Failed to update wallet", models.ErrConflict),
+			wantStatus: http.StatusConflict, wantCode: ProblemConflict, wantTitle: "Failed to update wallet.", wantDetail: models.ErrConflict.Error(),
+		},
+		{
+			name: "internal error refined by its cause", err: router.NewInternalServerError("Failed to list wallets", models.ErrSpaceNotFound),
+			wantStatus: http.StatusNotFound, wantCode: ProblemNotFound, wantTitle: "Failed to list wallets.", wantDetail: models.ErrSpaceNotFound.Error(),
+		},
+		{
+			name: "api error with a status of its own", err: router.NewApiError(http.StatusRequestEntityTooLarge, "Upload too large", nil),
+			wantStatus: http.StatusRequestEntityTooLarge, wantCode: ProblemBadRequest, wantTitle: "Upload too large.",
+		},
+		{
+			name: "unclassified error", err: errors.New("disk full"),
+			wantStatus: http.StatusInternalServerError, wantCode: ProblemInternal, wantTitle: "Something went wrong",
+		},
+		{
+			name: "unclassified api error", err: router.NewInternalServerError("Failed to save", errors.New("disk full")),
+			wantStatus: http.StatusInternalServerError, wantCode: ProblemInternal, wantTitle: "Failed to save.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problem, retryAfter := problemFor(tt.err)
+			if problem.Status != tt.wantStatus || problem.Code != tt.wantCode {
+				t.Errorf("problemFor() = %d %s, want %d %s", problem.Status, problem.Code, tt.wantStatus, tt.wantCode)
+			}
+			if problem.Type != problemType(tt.wantCode) {
+				t.Errorf("Type = %q, want %q", problem.Type, problemType(tt.wantCode))
+			}
+			if problem.Title != tt.wantTitle {
+				t.Errorf("Title = %q, want %q", problem.Title, tt.wantTitle)
+			}
+			if problem.Detail != tt.wantDetail {
+				t.Errorf("Detail = %q, want %q", problem.Detail, tt.wantDetail)
+			}
+			if retryAfter != tt.wantRetryAfter {
+				t.Errorf("retryAfter = %d, want %d", retryAfter, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	tests := map[int]string{
+		http.StatusBadRequest:              ProblemBadRequest,
+		http.StatusUnauthorized:            ProblemUnauthorized,
+		http.StatusForbidden:               ProblemForbidden,
+		http.StatusNotFound:                ProblemNotFound,
+		http.StatusConflict:                ProblemConflict,
+		http.StatusTooManyRequests:         ProblemRateLimited,
+		http.StatusBadGateway:              ProblemUpstreamError,
+		http.StatusServiceUnavailable:      ProblemUpstreamUnavailable,
+		http.StatusNotImplemented:          ProblemUpstreamUnsupported,
+		http.StatusGatewayTimeout:          ProblemTimeout,
+		http.StatusUnprocessableEntity:     ProblemBadRequest,
+		http.StatusInternalServerError:     ProblemInternal,
+		http.StatusHTTPVersionNotSupported: ProblemInternal,
+	}
+	for status, want := range tests {
+		if got := statusCode(status); got != want {
+			t.Errorf("statusCode(%d) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+// Server errors never show their cause, which may hold internals
+func TestProblemFor_HidesTheCauseOfServerErrors(t *testing.T) {
+	for _, err := range []error{
+		errors.New("open /var/lib/firedragon/pb_data/data.db: permission denied"),
+		interfaces.NewClientError(interfaces.ErrorTypeProviderBug, "decode https://bank.example/accounts?key=secret", nil),
+	} {
+		problem, _ := problemFor(err)
+		if problem.Detail != "" {
+			t.Errorf("problemFor(%v).Detail = %q, want it empty", err, problem.Detail)
+		}
+	}
+}
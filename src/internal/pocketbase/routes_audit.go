@@ -0,0 +1,111 @@
+package pocketbase
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerAuditRoutes attributes the changes made through the custom routes
+// to the authenticated user, records every mutating request in the audit log
+// and registers the audit log routes, when auditing is enabled
+func registerAuditRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	if services.Audit == nil {
+		return
+	}
+
+	api.BindFunc(auditRequests(services.Audit))
+
+	// GET /api/firedragon/audit?collection=transactions&record=...&actor=...&action=update&source=api&from=2025-01-01&to=2025-03-31&limit=50&offset=0
+	// Lists audit entries, newest first. Superusers see every entry, users only their own.
+	api.GET("/audit", func(e *core.RequestEvent) error {
+		query := e.Request.URL.Query()
+		filter := repositories.AuditFilter{
+			Collection: query.Get("collection"),
+			RecordID:   query.Get("record"),
+			ActorID:    query.Get("actor"),
+			Action:     models.AuditAction(query.Get("action")),
+			Source:     models.AuditSource(query.Get("source")),
+			Limit:      50,
+		}
+		if !e.HasSuperuserAuth() {
+			filter.ActorID = e.Auth.Id
+		}
+
+		var err error
+		if query.Get("from") != "" {
+			filter.From, err = time.Parse(time.DateOnly, query.Get("from"))
+			if err != nil {
+				return e.BadRequestError("Invalid 'from' date, expected YYYY-MM-DD", err)
+			}
+		}
+		if query.Get("to") != "" {
+			filter.To, err = time.Parse(time.DateOnly, query.Get("to"))
+			if err != nil {
+				return e.BadRequestError("Invalid 'to' date, expected YYYY-MM-DD", err)
+			}
+			// Include the whole end day
+			filter.To = filter.To.Add(24 * time.Hour)
+		}
+		if raw := query.Get("limit"); raw != "" {
+			filter.Limit, err = strconv.Atoi(raw)
+			if err != nil || filter.Limit <= 0 || filter.Limit > maxBulkItems {
+				return e.BadRequestError("Invalid 'limit'", err)
+			}
+		}
+		if raw := query.Get("offset"); raw != "" {
+			filter.Offset, err = strconv.Atoi(raw)
+			if err != nil || filter.Offset < 0 {
+				return e.BadRequestError("Invalid 'offset'", err)
+			}
+		}
+
+		entries, err := services.Audit.List(e.Request.Context(), filter)
+		if err != nil {
+			return e.InternalServerError("Failed to list audit entries", err)
+		}
+		return e.JSON(http.StatusOK, entries)
+	})
+}
+
+// auditRequests is a middleware carrying the authenticated user in the
+// request context, so the records changed by the request are attributed to
+// them, and recording every mutating request with its response status
+func auditRequests(audit *usecases.AuditService) func(e *core.RequestEvent) error {
+	return func(e *core.RequestEvent) error {
+		origin := models.AuditOrigin{ActorType: models.AuditActorUser, Source: models.AuditSourceAPI}
+		if e.Auth != nil {
+			origin.ActorID = e.Auth.Id
+			if e.HasSuperuserAuth() {
+				origin.ActorType = models.AuditActorSuperuser
+			}
+		}
+		ctx := models.WithAuditOrigin(e.Request.Context(), origin)
+		e.Request = e.Request.WithContext(ctx)
+
+		err := e.Next()
+
+		switch e.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return err
+		}
+
+		status := e.Status()
+		var apiErr *router.ApiError
+		if errors.As(err, &apiErr) {
+			status = apiErr.Status
+		} else if err != nil {
+			status = http.StatusInternalServerError
+		}
+		audit.RecordRequest(ctx, e.Request.Method, e.Request.URL.Path, status)
+
+		return err
+	}
+}
@@ -0,0 +1,138 @@
+package pocketbase
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerBalanceRoutes registers the wallet balance maintenance and assertion routes
+func registerBalanceRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// POST /api/firedragon/balances/recalculate
+	// {"walletId": "...", "fix": true}
+	api.POST("/balances/recalculate", func(e *core.RequestEvent) error {
+		var opts usecases.RecalculateOptions
+		if err := e.BindBody(&opts); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		report, err := services.Balances.RecalculateBalances(e.Request.Context(), opts)
+		if err != nil {
+			return e.BadRequestError("Failed to recalculate balances", err)
+		}
+
+		return e.JSON(http.StatusOK, report)
+	})
+
+	// POST /api/firedragon/balances/update
+	// Snapshots the balance of every source and reports drift from Firefly;
+	// per-source failures are listed in the body
+	api.POST("/balances/update", func(e *core.RequestEvent) error {
+		return e.JSON(http.StatusOK, services.BalanceUpdates.UpdateBalances(e.Request.Context()))
+	})
+
+	// GET /api/firedragon/balances/drift
+	// Returns the report of the last balance update, or null before the first
+	api.GET("/balances/drift", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can view the balance reports", nil)
+		}
+		return e.JSON(http.StatusOK, services.BalanceUpdates.LastReport())
+	})
+
+	// GET /api/firedragon/balances/assertions
+	// Lists the balance assertions, by name
+	api.GET("/balances/assertions", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can view the balance reports", nil)
+		}
+		assertions, err := services.BalanceAssertions.ListAssertions(e.Request.Context())
+		if err != nil {
+			return e.InternalServerError("Failed to list balance assertions", err)
+		}
+		return e.JSON(http.StatusOK, assertions)
+	})
+
+	// POST /api/firedragon/balances/assertions
+	// {"name": "Checking", "sourceId": "gocardless:acc-1", "compare": ["local", "provider"], "schedule": "0 7 * * *", "tolerance": 0.01, "enabled": true}
+	// Creates an assertion checked on its cron schedule. compare defaults to
+	// local and provider; a zero tolerance uses the configured one.
+	api.POST("/balances/assertions", func(e *core.RequestEvent) error {
+		var assertion models.BalanceAssertion
+		if err := e.BindBody(&assertion); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		assertion.ID = ""
+
+		if err := services.BalanceAssertions.CreateAssertion(e.Request.Context(), &assertion); err != nil {
+			return e.BadRequestError("Failed to create balance assertion", err)
+		}
+		return e.JSON(http.StatusCreated, assertion)
+	})
+
+	// PUT /api/firedragon/balances/assertions/{id}
+	// Replaces an assertion with the body, as for POST
+	api.PUT("/balances/assertions/{id}", func(e *core.RequestEvent) error {
+		var assertion models.BalanceAssertion
+		if err := e.BindBody(&assertion); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		assertion.ID = e.Request.PathValue("id")
+
+		if err := services.BalanceAssertions.UpdateAssertion(e.Request.Context(), &assertion); err != nil {
+			return e.BadRequestError("Failed to update balance assertion", err)
+		}
+		return e.JSON(http.StatusOK, assertion)
+	})
+
+	// DELETE /api/firedragon/balances/assertions/{id}
+	// Deletes an assertion and its results
+	api.DELETE("/balances/assertions/{id}", func(e *core.RequestEvent) error {
+		if err := services.BalanceAssertions.DeleteAssertion(e.Request.Context(), e.Request.PathValue("id")); err != nil {
+			return e.BadRequestError("Failed to delete balance assertion", err)
+		}
+		return e.NoContent(http.StatusNoContent)
+	})
+
+	// POST /api/firedragon/balances/assertions/{id}/check
+	// Checks an assertion now and returns the stored result; balances that
+	// cannot be read fail the result, not the request
+	api.POST("/balances/assertions/{id}/check", func(e *core.RequestEvent) error {
+		result, err := services.BalanceAssertions.Check(e.Request.Context(), e.Request.PathValue("id"))
+		if err != nil {
+			return e.BadRequestError("Failed to check balance assertion", err)
+		}
+		return e.JSON(http.StatusOK, result)
+	})
+
+	// GET /api/firedragon/balances/assertions/{id}/results?failed=true&limit=50
+	// Lists the results of an assertion, most recent first
+	api.GET("/balances/assertions/{id}/results", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can view the balance reports", nil)
+		}
+		filter := repositories.BalanceAssertionResultFilter{
+			AssertionID: e.Request.PathValue("id"),
+			OnlyFailed:  e.Request.URL.Query().Get("failed") == "true",
+			Limit:       50,
+		}
+		if raw := e.Request.URL.Query().Get("limit"); raw != "" {
+			limit, err := strconv.Atoi(raw)
+			if err != nil || limit <= 0 {
+				return e.BadRequestError("Invalid 'limit', expected a positive number", err)
+			}
+			filter.Limit = limit
+		}
+
+		results, err := services.BalanceAssertions.Results(e.Request.Context(), filter)
+		if err != nil {
+			return e.InternalServerError("Failed to list balance assertion results", err)
+		}
+		return e.JSON(http.StatusOK, results)
+	})
+}
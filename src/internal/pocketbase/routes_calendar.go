@@ -0,0 +1,77 @@
+package pocketbase
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pocketbase/pocketbase/apis"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// calendarFeedPath is the path of the iCalendar feed below the app URL
+const calendarFeedPath = "/api/firedragon/calendar/feed.ics"
+
+// registerCalendarRoutes registers the calendar feed routes
+func registerCalendarRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/calendar/events
+	// Lists the money events of the next twelve months the feed holds:
+	// expected subscription charges and renewals and budget period starts.
+	api.GET("/calendar/events", func(e *core.RequestEvent) error {
+		if e.Auth == nil || e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only users have a calendar", nil)
+		}
+		events, err := services.Calendar.Events(e.Request.Context(), e.Auth.Id, time.Now())
+		if err != nil {
+			return e.InternalServerError("Failed to list calendar events", err)
+		}
+		return e.JSON(http.StatusOK, events)
+	})
+
+	// POST /api/firedragon/calendar/feed
+	// Issues the calendar feed URL of the authenticated user to subscribe to
+	// in a calendar app. Issuing again replaces the token, so the previous URL
+	// stops working.
+	api.POST("/calendar/feed", func(e *core.RequestEvent) error {
+		if e.Auth == nil || e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only users have a calendar", nil)
+		}
+		token, err := services.Calendar.Issue(e.Request.Context(), e.Auth.Id)
+		if err != nil {
+			return e.InternalServerError("Failed to issue the calendar feed", err)
+		}
+		feedURL := strings.TrimRight(e.App.Settings().Meta.AppURL, "/") + calendarFeedPath + "?token=" + url.QueryEscape(token)
+		return e.JSON(http.StatusCreated, map[string]string{"url": feedURL, "token": token})
+	})
+
+	// DELETE /api/firedragon/calendar/feed
+	// Revokes the calendar feed URL of the authenticated user.
+	api.DELETE("/calendar/feed", func(e *core.RequestEvent) error {
+		if e.Auth == nil || e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only users have a calendar", nil)
+		}
+		if err := services.Calendar.Revoke(e.Request.Context(), e.Auth.Id); err != nil {
+			return e.InternalServerError("Failed to revoke the calendar feed", err)
+		}
+		return e.NoContent(http.StatusNoContent)
+	})
+
+	// GET /api/firedragon/calendar/feed.ics?token=...
+	// Serves the iCalendar feed of the token. Calendar apps cannot send an
+	// auth header, so the token in the URL authenticates the request; unknown
+	// and revoked tokens get 404.
+	api.GET("/calendar/feed.ics", func(e *core.RequestEvent) error {
+		token := e.Request.URL.Query().Get("token")
+		if token == "" {
+			return e.NotFoundError("Calendar feed not found", nil)
+		}
+		feed, err := services.Calendar.Feed(e.Request.Context(), token, time.Now())
+		if err != nil {
+			return e.InternalServerError("Failed to render the calendar feed", err)
+		}
+		e.Response.Header().Set("Cache-Control", "private, max-age=3600")
+		return e.Blob(http.StatusOK, "text/calendar; charset=utf-8", feed)
+	}).Unbind(apis.DefaultRequireAuthMiddlewareId)
+}
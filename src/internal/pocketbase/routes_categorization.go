@@ -0,0 +1,120 @@
+package pocketbase
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// maxSuggestions bounds the categories returned for a sample transaction
+const maxSuggestions = 10
+
+// registerCategorizationRoutes registers the category classifier routes, when it is enabled
+func registerCategorizationRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	if services.Categorization == nil {
+		return
+	}
+
+	// GET /api/firedragon/categorization
+	// Describes the trained classifier and its confidence thresholds.
+	api.GET("/categorization", func(e *core.RequestEvent) error {
+		return e.JSON(http.StatusOK, services.Categorization.Status())
+	})
+
+	// POST /api/firedragon/categorization/train
+	// Retrains the classifier from every transaction whose category the user chose.
+	api.POST("/categorization/train", func(e *core.RequestEvent) error {
+		status, err := services.Categorization.Train(e.Request.Context())
+		if err != nil {
+			return e.InternalServerError("Failed to train the classifier", err)
+		}
+		return e.JSON(http.StatusOK, status)
+	})
+
+	// POST /api/firedragon/categorization/suggest?limit=3
+	// {"description": "REWE Markt", "amount": 42.1, "type": "expense", "metadata": {"counterparty": "REWE"}}
+	// Suggests categories for a sample transaction, most likely first.
+	api.POST("/categorization/suggest", func(e *core.RequestEvent) error {
+		var tx models.Transaction
+		if err := e.BindBody(&tx); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		limit := 3
+		if raw := e.Request.URL.Query().Get("limit"); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n <= 0 || n > maxSuggestions {
+				return e.BadRequestError("Invalid 'limit'", err)
+			}
+			limit = n
+		}
+
+		suggestions := services.Categorization.Suggest(&tx, limit)
+		if suggestions == nil {
+			suggestions = []models.CategorySuggestion{}
+		}
+		return e.JSON(http.StatusOK, suggestions)
+	})
+
+	// GET /api/firedragon/categorization/reviews?limit=50&offset=0
+	// Lists the imported transactions whose suggested category awaits review,
+	// with the best alternatives.
+	api.GET("/categorization/reviews", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can list the category reviews", nil)
+		}
+		query := e.Request.URL.Query()
+		limit, offset := 50, 0
+		if raw := query.Get("limit"); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n <= 0 || n > maxBulkItems {
+				return e.BadRequestError("Invalid 'limit'", err)
+			}
+			limit = n
+		}
+		if raw := query.Get("offset"); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 0 {
+				return e.BadRequestError("Invalid 'offset'", err)
+			}
+			offset = n
+		}
+
+		reviews, err := services.Categorization.ListReviews(e.Request.Context(), limit, offset)
+		if err != nil {
+			return e.InternalServerError("Failed to list category reviews", err)
+		}
+		return e.JSON(http.StatusOK, reviews)
+	})
+
+	// POST /api/firedragon/categorization/reviews/{id}
+	// {"categoryId": "..."}
+	// Accepts the suggested category of a transaction, or corrects it when a
+	// category is given. The classifier learns from the review right away.
+	api.POST("/categorization/reviews/{id}", func(e *core.RequestEvent) error {
+		var body struct {
+			CategoryID string `json:"categoryId"`
+		}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		tx, err := services.Categorization.Review(e.Request.Context(), e.Request.PathValue("id"), body.CategoryID)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return e.NotFoundError("Transaction or category not found", err)
+		case errors.Is(err, models.ErrNoCategoryReview), errors.Is(err, models.ErrTransactionDeleted):
+			return e.Error(http.StatusConflict, "Transaction has no suggested category to review", err)
+		case errors.Is(err, models.ErrConflict):
+			return e.Error(http.StatusConflict, "Transaction was modified concurrently", err)
+		case err != nil:
+			return e.InternalServerError("Failed to review the category", err)
+		}
+		return e.JSON(http.StatusOK, tx)
+	})
+}
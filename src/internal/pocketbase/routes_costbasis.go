@@ -0,0 +1,75 @@
+package pocketbase
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerCostBasisRoutes registers the crypto cost basis and capital gains routes
+func registerCostBasisRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/cost-basis?method=fifo&base=EUR
+	api.GET("/cost-basis", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can report the cost basis of every wallet", nil)
+		}
+		report, err := services.CostBasis.GetReport(e.Request.Context(), costBasisOptions(e))
+		if errors.Is(err, models.ErrInvalidCostBasisMethod) {
+			return e.BadRequestError("Invalid cost basis method", err)
+		}
+		if err != nil {
+			return e.InternalServerError("Failed to compute cost basis", err)
+		}
+		return e.JSON(http.StatusOK, report)
+	})
+
+	// GET /api/firedragon/cost-basis/{year}?method=average&format=csv
+	api.GET("/cost-basis/{year}", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can report the cost basis of every wallet", nil)
+		}
+		year, err := strconv.Atoi(e.Request.PathValue("year"))
+		if err != nil {
+			return e.BadRequestError("Invalid year", err)
+		}
+		opts := costBasisOptions(e)
+
+		if e.Request.URL.Query().Get("format") == "csv" {
+			var buf bytes.Buffer
+			err := services.CostBasis.WriteYearGainsCSV(e.Request.Context(), &buf, year, opts)
+			if errors.Is(err, models.ErrInvalidCostBasisMethod) {
+				return e.BadRequestError("Invalid cost basis method", err)
+			}
+			if err != nil {
+				return e.InternalServerError("Failed to export capital gains", err)
+			}
+
+			e.Response.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="capital-gains-%d.csv"`, year))
+			return e.Blob(http.StatusOK, "text/csv", buf.Bytes())
+		}
+
+		summary, err := services.CostBasis.GetYearGains(e.Request.Context(), year, opts)
+		if errors.Is(err, models.ErrInvalidCostBasisMethod) {
+			return e.BadRequestError("Invalid cost basis method", err)
+		}
+		if err != nil {
+			return e.InternalServerError("Failed to compute capital gains", err)
+		}
+		return e.JSON(http.StatusOK, summary)
+	})
+}
+
+func costBasisOptions(e *core.RequestEvent) usecases.CostBasisOptions {
+	query := e.Request.URL.Query()
+	return usecases.CostBasisOptions{
+		Method:       models.CostBasisMethod(query.Get("method")),
+		BaseCurrency: query.Get("base"),
+	}
+}
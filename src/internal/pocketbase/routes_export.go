@@ -0,0 +1,111 @@
+package pocketbase
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerExportRoutes registers the data export routes
+func registerExportRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/export/{dataset}?format=csv&from=2025-01-01&to=2025-03-31&wallet=...&category=...
+	// Streams transactions, wallets or categories as CSV or JSON. With async=true
+	// the export is written to the file storage instead and 202 returns the job
+	// to poll; an export.ready event announces the finished file.
+	api.GET("/export/{dataset}", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can export the instance data", nil)
+		}
+		query := e.Request.URL.Query()
+		request := models.ExportRequest{
+			Dataset:    models.ExportDataset(e.Request.PathValue("dataset")),
+			Format:     models.ExportFormat(query.Get("format")),
+			WalletID:   query.Get("wallet"),
+			CategoryID: query.Get("category"),
+		}
+		if request.Format == "" {
+			request.Format = models.ExportCSV
+		}
+
+		var err error
+		if query.Get("from") != "" {
+			request.DateFrom, err = time.Parse(time.DateOnly, query.Get("from"))
+			if err != nil {
+				return e.BadRequestError("Invalid 'from' date, expected YYYY-MM-DD", err)
+			}
+		}
+		if query.Get("to") != "" {
+			request.DateTo, err = time.Parse(time.DateOnly, query.Get("to"))
+			if err != nil {
+				return e.BadRequestError("Invalid 'to' date, expected YYYY-MM-DD", err)
+			}
+			// Include the whole end day
+			request.DateTo = request.DateTo.Add(24*time.Hour - time.Nanosecond)
+		}
+		if err := request.Validate(); err != nil {
+			return e.BadRequestError("Invalid export", err)
+		}
+
+		if query.Get("async") == "true" {
+			job, err := services.Export.StartExport(request)
+			if err != nil {
+				return e.InternalServerError("Failed to start export", err)
+			}
+			return e.JSON(http.StatusAccepted, job)
+		}
+
+		e.Response.Header().Set("Content-Type", request.Format.ContentType())
+		e.Response.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, request.FileName(time.Now())))
+		e.Response.WriteHeader(http.StatusOK)
+
+		// The status is sent, so a failure can only cut the download short
+		if _, err := services.Export.Export(e.Request.Context(), e.Response, request); err != nil {
+			logger := internal.GetLogger()
+			logger.Error().Err(err).Str("dataset", string(request.Dataset)).Msg("Export aborted")
+		}
+		return nil
+	})
+
+	// GET /api/firedragon/export/jobs/{id}
+	api.GET("/export/jobs/{id}", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can export the instance data", nil)
+		}
+		job, err := services.Export.GetJob(e.Request.PathValue("id"))
+		if err != nil {
+			return e.NotFoundError("Export not found", err)
+		}
+		return e.JSON(http.StatusOK, job)
+	})
+
+	// GET /api/firedragon/export/jobs/{id}/download
+	api.GET("/export/jobs/{id}/download", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can export the instance data", nil)
+		}
+		job, file, err := services.Export.OpenJob(e.Request.Context(), e.Request.PathValue("id"))
+		if errors.Is(err, models.ErrExportNotFound) {
+			return e.NotFoundError("Export not found", err)
+		}
+		if errors.Is(err, models.ErrExportNotReady) {
+			return e.Error(http.StatusConflict, "Export is not ready", err)
+		}
+		if err != nil {
+			return e.InternalServerError("Failed to open export", err)
+		}
+		defer file.Close()
+
+		e.Response.Header().Set("Content-Type", job.Request.Format.ContentType())
+		e.Response.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, job.FileName))
+		e.Response.WriteHeader(http.StatusOK)
+		_, err = io.Copy(e.Response, file)
+		return err
+	})
+}
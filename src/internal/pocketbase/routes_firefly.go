@@ -0,0 +1,177 @@
+package pocketbase
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/pocketbase/pocketbase/apis"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerFireflyRoutes registers the Firefly III integration routes
+func registerFireflyRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	if services.FireflyOAuth != nil {
+		// GET /api/firedragon/firefly/oauth/authorize
+		// Returns the Firefly consent page URL to open in the browser; superusers
+		// only, as completing it replaces the token of the whole instance.
+		api.GET("/firefly/oauth/authorize", func(e *core.RequestEvent) error {
+			if !e.HasSuperuserAuth() {
+				return e.ForbiddenError("Only superusers can connect Firefly", nil)
+			}
+
+			url, err := services.FireflyOAuth.StartAuthorization()
+			if err != nil {
+				return e.InternalServerError("Failed to start Firefly authorization", err)
+			}
+
+			return e.JSON(http.StatusOK, map[string]string{"url": url})
+		})
+
+		// GET /api/firedragon/firefly/oauth/callback?code=...&state=...
+		// Firefly redirects the browser here, so the request is authenticated by its state instead.
+		api.GET("/firefly/oauth/callback", func(e *core.RequestEvent) error {
+			query := e.Request.URL.Query()
+			if reason := query.Get("error"); reason != "" {
+				return e.BadRequestError("Firefly authorization was denied: "+reason, nil)
+			}
+
+			err := services.FireflyOAuth.CompleteAuthorization(e.Request.Context(), query.Get("state"), query.Get("code"))
+			if errors.Is(err, models.ErrInvalidOAuthState) {
+				return e.BadRequestError("Invalid or expired authorization request", err)
+			}
+			if err != nil {
+				return e.Error(http.StatusBadGateway, "Failed to complete Firefly authorization", err)
+			}
+
+			return e.JSON(http.StatusOK, map[string]string{"status": "authorized"})
+		}).Unbind(apis.DefaultRequireAuthMiddlewareId)
+	}
+
+	if services.FireflyAccounts != nil {
+		// POST /api/firedragon/firefly/accounts/{id}/archive
+		// Deactivates the account and keeps its transactions. This is the safe way to retire an account.
+		// Superusers only, like deleting.
+		api.POST("/firefly/accounts/{id}/archive", func(e *core.RequestEvent) error {
+			if !e.HasSuperuserAuth() {
+				return e.ForbiddenError("Only superusers can remove Firefly accounts", nil)
+			}
+
+			removal, err := services.FireflyAccounts.RemoveAccount(e.Request.Context(), e.Request.PathValue("id"), usecases.RemoveAccountOptions{})
+			if err != nil {
+				return e.BadRequestError("Failed to archive Firefly account", err)
+			}
+
+			return e.JSON(http.StatusOK, removal)
+		})
+
+		// DELETE /api/firedragon/firefly/accounts/{id}?force=true
+		// Refused with 409 while the account is configured or has transactions (unless forced).
+		// Superusers only.
+		api.DELETE("/firefly/accounts/{id}", func(e *core.RequestEvent) error {
+			if !e.HasSuperuserAuth() {
+				return e.ForbiddenError("Only superusers can remove Firefly accounts", nil)
+			}
+
+			opts := usecases.RemoveAccountOptions{
+				Delete: true,
+				Force:  e.Request.URL.Query().Get("force") == "true",
+			}
+
+			removal, err := services.FireflyAccounts.RemoveAccount(e.Request.Context(), e.Request.PathValue("id"), opts)
+			var inUse *interfaces.FireflyAccountInUseError
+			if errors.As(err, &inUse) || errors.Is(err, models.ErrAccountMappingConfigured) {
+				return e.Error(http.StatusConflict, err.Error(), err)
+			}
+			if err != nil {
+				return e.BadRequestError("Failed to delete Firefly account", err)
+			}
+
+			return e.JSON(http.StatusOK, removal)
+		})
+	}
+
+	if services.FireflyLinks != nil {
+		// POST /api/firedragon/firefly/links
+		// {"outward": "<local transaction ID>", "inward": "<local transaction ID>", "type": "Refund", "notes": "..."}
+		// Reads as "outward refunds inward"; both transactions must have been exported.
+		api.POST("/firefly/links", func(e *core.RequestEvent) error {
+			var body struct {
+				Outward string `json:"outward"`
+				Inward  string `json:"inward"`
+				Type    string `json:"type"`
+				Notes   string `json:"notes"`
+			}
+			if err := e.BindBody(&body); err != nil {
+				return e.BadRequestError("Invalid request body", err)
+			}
+			if body.Outward == "" || body.Inward == "" || body.Type == "" {
+				return e.BadRequestError("outward, inward and type are required", nil)
+			}
+
+			link, err := services.FireflyLinks.LinkTransactions(e.Request.Context(), body.Outward, body.Inward, body.Type, body.Notes)
+			if err != nil {
+				return e.BadRequestError("Failed to link transactions", err)
+			}
+
+			return e.JSON(http.StatusOK, link)
+		})
+	}
+
+	if services.FireflySync != nil {
+		// POST /api/firedragon/firefly/pull
+		// Pulls the category and tag edits made in Firefly since the last pull;
+		// conflicts and per-transaction failures are listed in the body
+		api.POST("/firefly/pull", func(e *core.RequestEvent) error {
+			report, err := services.FireflySync.Pull(e.Request.Context())
+			if err != nil {
+				return e.InternalServerError("Failed to pull Firefly edits", err)
+			}
+
+			return e.JSON(http.StatusOK, report)
+		})
+	}
+
+	if services.FireflyOutbox != nil {
+		// GET /api/firedragon/firefly/outbox
+		// Returns the number of transactions waiting for Firefly and whether Firefly is reachable.
+		api.GET("/firefly/outbox", func(e *core.RequestEvent) error {
+			stats, err := services.FireflyOutbox.Stats(e.Request.Context())
+			if err != nil {
+				return e.InternalServerError("Failed to read the Firefly outbox", err)
+			}
+
+			return e.JSON(http.StatusOK, stats)
+		})
+
+		// POST /api/firedragon/firefly/outbox/drain
+		// Delivers the due transactions now, even while Firefly is considered unreachable.
+		api.POST("/firefly/outbox/drain", func(e *core.RequestEvent) error {
+			report, err := services.FireflyOutbox.Drain(e.Request.Context(), true)
+			if err != nil {
+				return e.InternalServerError("Failed to drain the Firefly outbox", err)
+			}
+
+			return e.JSON(http.StatusOK, report)
+		})
+	}
+
+	if services.FireflyBootstrap == nil {
+		return
+	}
+
+	// POST /api/firedragon/firefly/bootstrap
+	// Provisions missing Firefly currencies and asset accounts for all configured sources.
+	api.POST("/firefly/bootstrap", func(e *core.RequestEvent) error {
+		report, err := services.FireflyBootstrap.Bootstrap(e.Request.Context())
+		if err != nil {
+			// Partial failures are reported per account
+			return e.JSON(http.StatusMultiStatus, report)
+		}
+
+		return e.JSON(http.StatusOK, report)
+	})
+}
@@ -0,0 +1,30 @@
+package pocketbase
+
+import (
+	"net/http"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal/graphapi"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerGraphQLRoutes registers the GraphQL endpoint
+func registerGraphQLRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// POST /api/firedragon/graphql
+	// Executes a GraphQL query over the wallets, transactions, categories and
+	// reports the caller can see. Lists are connections paged with first and
+	// after; transaction metadata is only resolved for superusers. Errors are
+	// reported in the response next to the data that could be resolved.
+	// {"query": "{ wallets(first: 10) { edges { node { id name balance } } } }"}
+	api.POST("/graphql", func(e *core.RequestEvent) error {
+		var request graphapi.Request
+		if err := e.BindBody(&request); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		if request.Query == "" {
+			return e.BadRequestError("A query is required", nil)
+		}
+
+		return e.JSON(http.StatusOK, services.GraphQL.Exec(e.Request.Context(), spaceActor(e), request))
+	})
+}
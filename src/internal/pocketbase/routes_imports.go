@@ -0,0 +1,207 @@
+package pocketbase
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// maxImportFileSize bounds the size of an uploaded export file
+const maxImportFileSize = 20 << 20
+
+// registerImportRoutes registers the file import routes
+func registerImportRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/imports/profiles
+	api.GET("/imports/profiles", func(e *core.RequestEvent) error {
+		return e.JSON(http.StatusOK, services.ExchangeImport.ListProfiles())
+	})
+
+	// POST /api/firedragon/imports/descriptions/preview
+	// {"source": "ethereum", "template": "...", "transaction": {"amount": 1.5, "metadata": {...}, ...}}
+	// Renders the description a sample transaction would get on import; without
+	// a template the one configured for the source is used.
+	api.POST("/imports/descriptions/preview", func(e *core.RequestEvent) error {
+		var body usecases.DescriptionPreviewInput
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		preview, err := services.Import.PreviewDescription(body)
+		if err != nil {
+			return e.BadRequestError("Failed to render description", err)
+		}
+		return e.JSON(http.StatusOK, preview)
+	})
+
+	// POST /api/firedragon/imports/statements?wallet={walletId}
+	// Imports a camt.053 or camt.052 bank statement, sent like an exchange export.
+	// Without a wallet each account is imported into a wallet named after its IBAN.
+	api.POST("/imports/statements", func(e *core.RequestEvent) error {
+		file, closeFile, err := importFile(e)
+		if err != nil {
+			return e.BadRequestError("Missing 'file' upload", err)
+		}
+		defer closeFile()
+
+		report, err := services.StatementImport.ImportFile(e.Request.Context(), file, e.Request.URL.Query().Get("wallet"))
+		if errors.Is(err, models.ErrInvalidImportFile) {
+			return e.BadRequestError("Invalid statement file", err)
+		}
+		if err != nil {
+			if report == nil {
+				return e.InternalServerError("Statement import failed", err)
+			}
+			return e.JSON(http.StatusMultiStatus, report)
+		}
+
+		return e.JSON(http.StatusOK, report)
+	})
+
+	// GET /api/firedragon/imports/budgets/profiles
+	api.GET("/imports/budgets/profiles", func(e *core.RequestEvent) error {
+		return e.JSON(http.StatusOK, services.BudgetImport.ListProfiles())
+	})
+
+	// POST /api/firedragon/imports/budgets/{profile}?currency=EUR
+	// Stages a YNAB or Actual Budget export, sent like an exchange export, in the
+	// review queue. New wallets use the given currency, USD by default.
+	api.POST("/imports/budgets/{profile}", func(e *core.RequestEvent) error {
+		file, closeFile, err := importFile(e)
+		if err != nil {
+			return e.BadRequestError("Missing 'file' upload", err)
+		}
+		defer closeFile()
+
+		currency := e.Request.URL.Query().Get("currency")
+		if currency == "" {
+			currency = "USD"
+		}
+
+		review, err := services.BudgetImport.Stage(e.Request.Context(), e.Request.PathValue("profile"), file, currency)
+		if errors.Is(err, models.ErrUnknownImportProfile) {
+			return e.NotFoundError("Unknown import profile", err)
+		}
+		if errors.Is(err, models.ErrInvalidImportFile) {
+			return e.BadRequestError("Invalid import file", err)
+		}
+		if err != nil {
+			return e.InternalServerError("Failed to stage import", err)
+		}
+
+		return e.JSON(http.StatusCreated, review)
+	})
+
+	// GET /api/firedragon/imports/reviews
+	// Lists the staged budget imports, newest first
+	api.GET("/imports/reviews", func(e *core.RequestEvent) error {
+		return e.JSON(http.StatusOK, services.BudgetImport.ListReviews())
+	})
+
+	// GET /api/firedragon/imports/reviews/{id}
+	api.GET("/imports/reviews/{id}", func(e *core.RequestEvent) error {
+		review, err := services.BudgetImport.GetReview(e.Request.PathValue("id"))
+		if err != nil {
+			return e.NotFoundError("Import not found", err)
+		}
+		return e.JSON(http.StatusOK, review)
+	})
+
+	// PUT /api/firedragon/imports/reviews/{id}/mapping
+	// {"accounts": [{"account": "Checking", "walletId": "..."}], "categories": [{"category": "Rent", "type": "expense"}]}
+	// Accounts and categories left out keep their proposed mapping.
+	api.PUT("/imports/reviews/{id}/mapping", func(e *core.RequestEvent) error {
+		var body usecases.BudgetMappingInput
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		review, err := services.BudgetImport.UpdateMapping(e.Request.Context(), e.Request.PathValue("id"), body)
+		if err != nil {
+			return budgetImportError(e, err)
+		}
+		return e.JSON(http.StatusOK, review)
+	})
+
+	// POST /api/firedragon/imports/reviews/{id}/commit
+	api.POST("/imports/reviews/{id}/commit", func(e *core.RequestEvent) error {
+		report, err := services.BudgetImport.Commit(e.Request.Context(), e.Request.PathValue("id"))
+		if err != nil {
+			if report == nil {
+				return budgetImportError(e, err)
+			}
+			return e.JSON(http.StatusMultiStatus, report)
+		}
+		return e.JSON(http.StatusOK, report)
+	})
+
+	// DELETE /api/firedragon/imports/reviews/{id}
+	// Discards a staged import without importing it
+	api.DELETE("/imports/reviews/{id}", func(e *core.RequestEvent) error {
+		review, err := services.BudgetImport.Discard(e.Request.PathValue("id"))
+		if err != nil {
+			return budgetImportError(e, err)
+		}
+		return e.JSON(http.StatusOK, review)
+	})
+
+	// POST /api/firedragon/imports/{profile}
+	// The export is sent as the request body or as the "file" field of a multipart form.
+	api.POST("/imports/{profile}", func(e *core.RequestEvent) error {
+		file, closeFile, err := importFile(e)
+		if err != nil {
+			return e.BadRequestError("Missing 'file' upload", err)
+		}
+		defer closeFile()
+
+		report, err := services.ExchangeImport.ImportFile(e.Request.Context(), e.Request.PathValue("profile"), file)
+		if errors.Is(err, models.ErrUnknownImportProfile) {
+			return e.NotFoundError("Unknown import profile", err)
+		}
+		if errors.Is(err, models.ErrInvalidImportFile) {
+			return e.BadRequestError("Invalid import file", err)
+		}
+		if err != nil {
+			if report == nil {
+				return e.InternalServerError("Import failed", err)
+			}
+			return e.JSON(http.StatusMultiStatus, report)
+		}
+
+		return e.JSON(http.StatusOK, report)
+	})
+}
+
+// importFile returns the uploaded file of an import request, sent as the
+// request body or as the "file" field of a multipart form
+func importFile(e *core.RequestEvent) (io.Reader, func(), error) {
+	e.Request.Body = http.MaxBytesReader(e.Response, e.Request.Body, maxImportFileSize)
+
+	if !strings.HasPrefix(e.Request.Header.Get("Content-Type"), "multipart/form-data") {
+		return e.Request.Body, func() {}, nil
+	}
+
+	upload, _, err := e.Request.FormFile("file")
+	if err != nil {
+		return nil, nil, err
+	}
+	return upload, func() { upload.Close() }, nil
+}
+
+// budgetImportError maps the errors of the budget import review queue to responses
+func budgetImportError(e *core.RequestEvent, err error) error {
+	switch {
+	case errors.Is(err, models.ErrBudgetImportNotFound):
+		return e.NotFoundError("Import not found", err)
+	case errors.Is(err, models.ErrBudgetImportNotPending):
+		return e.Error(http.StatusConflict, "Import is no longer pending", err)
+	case errors.Is(err, models.ErrInvalidBudgetMapping):
+		return e.BadRequestError("Invalid mapping", err)
+	}
+	return e.InternalServerError("Budget import failed", err)
+}
@@ -0,0 +1,52 @@
+package pocketbase
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerIncidentRoutes registers the provider incident timeline routes
+func registerIncidentRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/incidents?provider=firefly&open=true&from=2025-01-01&to=2025-03-31&limit=50
+	api.GET("/incidents", func(e *core.RequestEvent) error {
+		query := e.Request.URL.Query()
+		filter := repositories.IncidentFilter{
+			Provider: query.Get("provider"),
+			OnlyOpen: query.Get("open") == "true",
+		}
+
+		var err error
+		if query.Get("from") != "" {
+			filter.From, err = time.Parse(time.DateOnly, query.Get("from"))
+			if err != nil {
+				return e.BadRequestError("Invalid 'from' date, expected YYYY-MM-DD", err)
+			}
+		}
+		if query.Get("to") != "" {
+			filter.To, err = time.Parse(time.DateOnly, query.Get("to"))
+			if err != nil {
+				return e.BadRequestError("Invalid 'to' date, expected YYYY-MM-DD", err)
+			}
+			// Include the whole end day
+			filter.To = filter.To.Add(24*time.Hour - time.Nanosecond)
+		}
+		if query.Get("limit") != "" {
+			filter.Limit, err = strconv.Atoi(query.Get("limit"))
+			if err != nil || filter.Limit < 0 {
+				return e.BadRequestError("Invalid 'limit', expected a positive number", err)
+			}
+		}
+
+		incidents, err := services.Incidents.ListIncidents(e.Request.Context(), filter)
+		if err != nil {
+			return e.InternalServerError("Failed to list incidents", err)
+		}
+
+		return e.JSON(http.StatusOK, incidents)
+	})
+}
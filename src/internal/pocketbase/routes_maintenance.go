@@ -0,0 +1,169 @@
+package pocketbase
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// readOnlyRoutes are the POST routes that evaluate a request body without
+// storing anything, so they keep working during maintenance
+var readOnlyRoutes = map[string]bool{
+	"POST /api/firedragon/rules/test":                   true,
+	"POST /api/firedragon/imports/descriptions/preview": true,
+	"POST /api/firedragon/categorization/suggest":       true,
+	"POST /api/firedragon/sources/{id}/test":            true,
+	"POST /api/firedragon/graphql":                      true, // queries only, there are no mutations
+}
+
+// maintenanceHeader reports the maintenance mode on health checks
+const maintenanceHeader = "X-Firedragon-Maintenance"
+
+// registerMaintenanceRoutes refuses the changes made through the custom
+// routes while maintenance mode is on and registers the routes that switch it
+func registerMaintenanceRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	api.BindFunc(maintenanceGuard(services.Maintenance))
+
+	// GET /api/firedragon/maintenance
+	// Returns the maintenance switches that are on: the global one and the frozen spaces.
+	api.GET("/maintenance", func(e *core.RequestEvent) error {
+		state, err := services.Maintenance.Status(e.Request.Context())
+		if err != nil {
+			return e.InternalServerError("Failed to read maintenance mode", err)
+		}
+		return e.JSON(http.StatusOK, state)
+	})
+
+	// PUT /api/firedragon/maintenance
+	// {"reason": "restoring last night's backup"}
+	// Freezes every space: importers pause and changes are refused until it is
+	// turned off; superusers only.
+	api.PUT("/maintenance", func(e *core.RequestEvent) error {
+		return enableMaintenance(e, services, "")
+	})
+
+	// DELETE /api/firedragon/maintenance
+	// Lifts global maintenance; spaces frozen on their own stay frozen. Superusers only.
+	api.DELETE("/maintenance", func(e *core.RequestEvent) error {
+		return disableMaintenance(e, services, "")
+	})
+
+	// PUT /api/firedragon/spaces/{space}/maintenance
+	// {"reason": "migrating the business books"}
+	// Freezes one space; owners only.
+	api.PUT("/spaces/{space}/maintenance", func(e *core.RequestEvent) error {
+		return enableMaintenance(e, services, e.Request.PathValue("space"))
+	})
+
+	// DELETE /api/firedragon/spaces/{space}/maintenance
+	// Unfreezes a space; owners only.
+	api.DELETE("/spaces/{space}/maintenance", func(e *core.RequestEvent) error {
+		return disableMaintenance(e, services, e.Request.PathValue("space"))
+	})
+}
+
+// enableMaintenance turns on the maintenance switch of a space, the global
+// one for an empty space ID
+func enableMaintenance(e *core.RequestEvent, services *Services, spaceID string) error {
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if err := e.BindBody(&body); err != nil {
+		return e.BadRequestError("Invalid request body", err)
+	}
+
+	maintenance, err := services.Maintenance.Enable(e.Request.Context(), maintenanceActor(e), spaceID, strings.TrimSpace(body.Reason))
+	if err != nil {
+		return spaceError(e, err)
+	}
+	return e.JSON(http.StatusOK, maintenance)
+}
+
+// disableMaintenance turns off the maintenance switch of a space, the global
+// one for an empty space ID
+func disableMaintenance(e *core.RequestEvent, services *Services, spaceID string) error {
+	if err := services.Maintenance.Disable(e.Request.Context(), maintenanceActor(e), spaceID); err != nil {
+		return spaceError(e, err)
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+// maintenanceActor is the space actor that switches maintenance mode,
+// recorded by ID also when it is a superuser
+func maintenanceActor(e *core.RequestEvent) usecases.SpaceActor {
+	actor := spaceActor(e)
+	actor.UserID = e.Auth.Id
+	return actor
+}
+
+// maintenanceGuard refuses the mutating requests of the custom routes with
+// 503 while every space is frozen, or the space named in the path is. Routes
+// without a space in the path are checked by the services, against the
+// spaces of the wallets they change. Reads, the read-only POST routes and the
+// maintenance routes stay available.
+func maintenanceGuard(maintenance *usecases.MaintenanceService) func(e *core.RequestEvent) error {
+	return func(e *core.RequestEvent) error {
+		switch e.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return e.Next()
+		}
+		if readOnlyRoutes[e.Request.Pattern] || strings.HasSuffix(e.Request.Pattern, "/maintenance") {
+			return e.Next()
+		}
+
+		if err := maintenance.Check(e.Request.Context(), e.Request.PathValue("space")); err != nil {
+			return maintenanceError(e, err)
+		}
+		return e.Next()
+	}
+}
+
+// recordMaintenanceGuard refuses changes through the PocketBase record API
+// while every space is frozen, and reports the maintenance mode on the
+// health check. Records carry no space the guard could resolve, so frozen
+// spaces alone do not block it.
+func recordMaintenanceGuard(maintenance *usecases.MaintenanceService) func(e *core.RequestEvent) error {
+	return func(e *core.RequestEvent) error {
+		path := e.Request.URL.Path
+		if path == "/api/health" {
+			state, err := maintenance.Status(e.Request.Context())
+			switch {
+			case err != nil:
+				e.Response.Header().Set(maintenanceHeader, "unknown")
+			case state.Global != nil:
+				e.Response.Header().Set(maintenanceHeader, "global")
+			case len(state.Spaces) > 0:
+				e.Response.Header().Set(maintenanceHeader, "spaces")
+			default:
+				e.Response.Header().Set(maintenanceHeader, "off")
+			}
+			return e.Next()
+		}
+
+		switch e.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return e.Next()
+		}
+		if !strings.HasPrefix(path, "/api/collections/") || !strings.Contains(path, "/records") {
+			return e.Next()
+		}
+
+		if err := maintenance.Check(e.Request.Context(), ""); err != nil {
+			return maintenanceError(e, err)
+		}
+		return e.Next()
+	}
+}
+
+// maintenanceError maps the error of a maintenance check to a response
+func maintenanceError(e *core.RequestEvent, err error) error {
+	if errors.Is(err, models.ErrMaintenance) {
+		return e.Error(http.StatusServiceUnavailable, "Maintenance mode is on", err)
+	}
+	return e.InternalServerError("Failed to read maintenance mode", err)
+}
@@ -0,0 +1,170 @@
+package pocketbase
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/firefly"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/events"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/workerpool"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerMetricsRoutes registers the Prometheus metrics route
+func registerMetricsRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/metrics
+	// Sync worker metrics per provider, the scheduler leadership of this
+	// replica, the maintenance mode, the import ledger pruning, the webhook
+	// deliveries, the event payload compression and the Firefly reference
+	// cache in the Prometheus text exposition format
+	api.GET("/metrics", func(e *core.RequestEvent) error {
+		body := renderPoolMetrics(services.SourceSync.QueueStats()) +
+			renderLeaderMetric(services.Scheduler.IsLeader())
+		if state, err := services.Maintenance.Status(e.Request.Context()); err == nil {
+			body += renderMaintenanceMetrics(state)
+		}
+		if services.Ledger != nil {
+			if stats, err := services.Ledger.Stats(e.Request.Context()); err == nil {
+				body += renderLedgerMetrics(stats)
+			}
+		}
+		if services.Webhooks != nil {
+			if stats, err := services.Webhooks.Stats(e.Request.Context()); err == nil {
+				body += renderWebhookMetrics(stats)
+			}
+		}
+		if services.Events != nil {
+			body += renderCompressionMetrics(services.Events.Compression())
+		}
+		if services.FireflyCache != nil {
+			body += renderCacheMetrics(services.FireflyCache.Stats())
+		}
+		e.Response.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		return e.String(http.StatusOK, body)
+	})
+}
+
+// renderPoolMetrics renders worker pool stats as Prometheus metrics
+func renderPoolMetrics(stats []workerpool.ProviderStats) string {
+	metrics := []struct {
+		name, kind, help string
+		value            func(workerpool.ProviderStats) float64
+	}{
+		{"firedragon_sync_jobs_processed_total", "counter", "Sync jobs processed.",
+			func(s workerpool.ProviderStats) float64 { return float64(s.Processed) }},
+		{"firedragon_sync_worker_restarts_total", "counter", "Sync workers restarted after a job panicked.",
+			func(s workerpool.ProviderStats) float64 { return float64(s.Restarts) }},
+		{"firedragon_sync_queue_depth", "gauge", "Sync jobs waiting for a worker.",
+			func(s workerpool.ProviderStats) float64 { return float64(s.Queued) }},
+		{"firedragon_sync_jobs_running", "gauge", "Sync jobs currently running.",
+			func(s workerpool.ProviderStats) float64 { return float64(s.Running) }},
+		{"firedragon_sync_job_latency_seconds_mean", "gauge", "Mean processing time of a sync job.",
+			func(s workerpool.ProviderStats) float64 { return s.LatencyMS / 1000 }},
+		{"firedragon_sync_job_latency_seconds_max", "gauge", "Longest processing time of a sync job.",
+			func(s workerpool.ProviderStats) float64 { return s.MaxMS / 1000 }},
+	}
+
+	var b strings.Builder
+	for _, metric := range metrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.kind)
+		for _, stat := range stats {
+			fmt.Fprintf(&b, "%s{provider=%q} %g\n", metric.name, stat.Provider, metric.value(stat))
+		}
+	}
+	return b.String()
+}
+
+// renderLeaderMetric renders whether this replica runs the scheduled imports
+func renderLeaderMetric(leader bool) string {
+	value := 0
+	if leader {
+		value = 1
+	}
+	return fmt.Sprintf("# HELP firedragon_scheduler_leader Whether this replica runs the scheduled imports.\n"+
+		"# TYPE firedragon_scheduler_leader gauge\nfiredragon_scheduler_leader %d\n", value)
+}
+
+// renderMaintenanceMetrics renders whether every space is frozen and how many
+// spaces are frozen on their own
+func renderMaintenanceMetrics(state *models.MaintenanceState) string {
+	global := 0
+	if state.Global != nil {
+		global = 1
+	}
+	return fmt.Sprintf("# HELP firedragon_maintenance_global Whether maintenance mode freezes every space.\n"+
+		"# TYPE firedragon_maintenance_global gauge\nfiredragon_maintenance_global %d\n"+
+		"# HELP firedragon_maintenance_spaces Spaces frozen by their own maintenance switch.\n"+
+		"# TYPE firedragon_maintenance_spaces gauge\nfiredragon_maintenance_spaces %d\n", global, len(state.Spaces))
+}
+
+// renderLedgerMetrics renders the pruning of the import ledger
+func renderLedgerMetrics(stats usecases.LedgerStats) string {
+	metrics := []struct {
+		name, kind, help string
+		value            float64
+	}{
+		{"firedragon_ledger_prune_runs_total", "counter", "Import ledger pruning runs.", float64(stats.Runs)},
+		{"firedragon_ledger_pruned_total", "counter", "Deleted transactions pruned from the import ledger.", float64(stats.Pruned)},
+		{"firedragon_ledger_compacted_total", "counter", "Provider IDs of pruned transactions compacted into summaries.", float64(stats.Compacted)},
+		{"firedragon_ledger_summaries", "gauge", "Import ledger summaries stored.", float64(stats.Summaries)},
+		{"firedragon_ledger_summarized_ids", "gauge", "Provider IDs held by the import ledger summaries.", float64(stats.SummarizedIDs)},
+	}
+
+	var b strings.Builder
+	for _, metric := range metrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n%s %g\n", metric.name, metric.help, metric.name, metric.kind, metric.name, metric.value)
+	}
+	if !stats.LastRunAt.IsZero() {
+		fmt.Fprintf(&b, "# HELP firedragon_ledger_last_prune_timestamp_seconds Time of the last import ledger pruning.\n"+
+			"# TYPE firedragon_ledger_last_prune_timestamp_seconds gauge\nfiredragon_ledger_last_prune_timestamp_seconds %d\n", stats.LastRunAt.Unix())
+	}
+	return b.String()
+}
+
+// renderWebhookMetrics renders the deliveries of events to webhooks
+func renderWebhookMetrics(stats usecases.WebhookStats) string {
+	return fmt.Sprintf("# HELP firedragon_webhook_deliveries_total Webhook deliveries accepted by the receiver.\n"+
+		"# TYPE firedragon_webhook_deliveries_total counter\nfiredragon_webhook_deliveries_total %d\n"+
+		"# HELP firedragon_webhook_failures_total Webhook delivery attempts that failed and are retried.\n"+
+		"# TYPE firedragon_webhook_failures_total counter\nfiredragon_webhook_failures_total %d\n"+
+		"# HELP firedragon_webhook_given_up_total Webhook deliveries given up.\n"+
+		"# TYPE firedragon_webhook_given_up_total counter\nfiredragon_webhook_given_up_total %d\n"+
+		"# HELP firedragon_webhook_deliveries_pending Webhook deliveries waiting to be posted.\n"+
+		"# TYPE firedragon_webhook_deliveries_pending gauge\nfiredragon_webhook_deliveries_pending %d\n",
+		stats.Delivered, stats.Failed, stats.GaveUp, stats.Pending)
+}
+
+// renderCompressionMetrics renders the payload compression of published events
+func renderCompressionMetrics(stats events.CompressionStats) string {
+	metrics := []struct {
+		name, help string
+		value      int64
+	}{
+		{"firedragon_events_published_total", "Events published.", stats.Messages},
+		{"firedragon_events_compressed_total", "Events published with a compressed payload.", stats.Compressed},
+		{"firedragon_events_compression_skipped_total", "Events above the compression threshold that did not shrink.", stats.SkippedNoSaving},
+		{"firedragon_events_compression_bytes_in_total", "Size of the compressed payloads before compression.", stats.BytesIn},
+		{"firedragon_events_compression_bytes_saved_total", "Bytes saved by compressing event payloads.", stats.BytesSaved},
+	}
+
+	var b strings.Builder
+	for _, metric := range metrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s counter\n%s %d\n", metric.name, metric.help, metric.name, metric.name, metric.value)
+	}
+	return b.String()
+}
+
+// renderCacheMetrics renders the lookups of the Firefly reference data cache
+func renderCacheMetrics(stats firefly.CacheStats) string {
+	return fmt.Sprintf("# HELP firedragon_firefly_cache_hits_total Firefly reference data lookups answered from the cache.\n"+
+		"# TYPE firedragon_firefly_cache_hits_total counter\nfiredragon_firefly_cache_hits_total %d\n"+
+		"# HELP firedragon_firefly_cache_misses_total Firefly reference data lookups sent to Firefly.\n"+
+		"# TYPE firedragon_firefly_cache_misses_total counter\nfiredragon_firefly_cache_misses_total %d\n"+
+		"# HELP firedragon_firefly_cache_entries Firefly reference data responses currently cached.\n"+
+		"# TYPE firedragon_firefly_cache_entries gauge\nfiredragon_firefly_cache_entries %d\n",
+		stats.Hits, stats.Misses, stats.Entries)
+}
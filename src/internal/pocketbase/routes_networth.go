@@ -0,0 +1,110 @@
+package pocketbase
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerNetWorthRoutes registers the net worth and portfolio valuation routes
+func registerNetWorthRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/networth?base=EUR&include_archived=true&from=2025-01-01&to=2025-03-31
+	// GET /api/firedragon/networth?period=year
+	// Without a base the net worth is valued in the user's base currency.
+	api.GET("/networth", func(e *core.RequestEvent) error {
+		query := e.Request.URL.Query()
+		preferences, err := userPreferences(e, services)
+		if err != nil {
+			return e.InternalServerError("Failed to load preferences", err)
+		}
+		opts := usecases.NetWorthOptions{
+			BaseCurrency:    query.Get("base"),
+			IncludeArchived: query.Get("include_archived") == "true",
+		}
+		if !e.HasSuperuserAuth() {
+			opts.VisibleTo = e.Auth.Id
+		}
+		if opts.BaseCurrency == "" {
+			opts.BaseCurrency = preferences.BaseCurrency
+		}
+
+		netWorth, err := services.Valuation.GetNetWorth(e.Request.Context(), opts)
+		if err != nil {
+			return e.InternalServerError("Failed to compute net worth", err)
+		}
+
+		type response struct {
+			*usecases.NetWorth
+			History []usecases.NetWorthPoint `json:"history,omitempty"`
+		}
+		resp := response{NetWorth: netWorth}
+
+		// Historical series is only computed when a range or period is requested
+		if query.Get("from") != "" || query.Get("period") != "" {
+			var from, to time.Time
+			if query.Get("period") != "" {
+				period, err := preferences.Calendar(services.Periods).Resolve(query.Get("period"), time.Now())
+				if err != nil {
+					return e.BadRequestError("Invalid 'period'", err)
+				}
+				from, to = period.Start, period.Last()
+				// A running period ends today
+				if now := time.Now(); to.After(now) {
+					to = now
+				}
+			} else {
+				from, err = time.Parse(time.DateOnly, query.Get("from"))
+				if err != nil {
+					return e.BadRequestError("Invalid 'from' date, expected YYYY-MM-DD", err)
+				}
+				to = time.Now()
+			}
+
+			if query.Get("to") != "" {
+				to, err = time.Parse(time.DateOnly, query.Get("to"))
+				if err != nil {
+					return e.BadRequestError("Invalid 'to' date, expected YYYY-MM-DD", err)
+				}
+			}
+
+			history, err := services.Valuation.GetNetWorthHistory(e.Request.Context(), opts, from, to)
+			if err != nil {
+				return e.BadRequestError("Failed to compute net worth history", err)
+			}
+			resp.History = history
+		}
+
+		return e.JSON(http.StatusOK, resp)
+	})
+
+	// GET /api/firedragon/portfolio?base=EUR&include_archived=true
+	// Crypto holdings across all wallets by asset and chain, with their 24h
+	// and 7d change. Without a base they are valued in the user's base currency.
+	api.GET("/portfolio", func(e *core.RequestEvent) error {
+		query := e.Request.URL.Query()
+		opts := usecases.NetWorthOptions{
+			BaseCurrency:    query.Get("base"),
+			IncludeArchived: query.Get("include_archived") == "true",
+		}
+		if !e.HasSuperuserAuth() {
+			opts.VisibleTo = e.Auth.Id
+		}
+		if opts.BaseCurrency == "" {
+			preferences, err := userPreferences(e, services)
+			if err != nil {
+				return e.InternalServerError("Failed to load preferences", err)
+			}
+			opts.BaseCurrency = preferences.BaseCurrency
+		}
+
+		portfolio, err := services.Valuation.GetPortfolio(e.Request.Context(), opts)
+		if err != nil {
+			return e.InternalServerError("Failed to compute portfolio", err)
+		}
+
+		return e.JSON(http.StatusOK, portfolio)
+	})
+}
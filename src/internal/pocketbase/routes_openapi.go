@@ -0,0 +1,53 @@
+package pocketbase
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/pocketbase/pocketbase/apis"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+//go:generate go run ../../tools/openapigen -out openapi.json
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -generate types,client -package client -o ../../client/client.gen.go openapi.json
+
+// openAPIDocument describes the custom routes. It is generated from the route
+// registrations, so run go generate after adding or changing a route.
+//
+//go:embed openapi.json
+var openAPIDocument []byte
+
+// swaggerUI browses the document with Swagger UI served from a CDN
+const swaggerUI = `<!doctype html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>FireDragon API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "openapi.json", dom_id: "#swagger-ui", persistAuthorization: true });
+  </script>
+</body>
+</html>
+`
+
+// registerOpenAPIRoutes registers the API description routes. They are public
+// so the document can be fetched by tooling before it has a token.
+func registerOpenAPIRoutes(api *router.RouterGroup[*core.RequestEvent]) {
+	// GET /api/firedragon/openapi.json
+	// Describes the custom routes in an OpenAPI 3.1 document.
+	api.GET("/openapi.json", func(e *core.RequestEvent) error {
+		return e.Blob(http.StatusOK, "application/json", openAPIDocument)
+	}).Unbind(apis.DefaultRequireAuthMiddlewareId)
+
+	// GET /api/firedragon/docs
+	// Browses the custom routes with Swagger UI.
+	api.GET("/docs", func(e *core.RequestEvent) error {
+		return e.HTML(http.StatusOK, swaggerUI)
+	}).Unbind(apis.DefaultRequireAuthMiddlewareId)
+}
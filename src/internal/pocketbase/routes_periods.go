@@ -0,0 +1,63 @@
+package pocketbase
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// maxListedPeriods bounds the number of pay periods listed at once
+const maxListedPeriods = 120
+
+// registerPeriodRoutes registers the reporting period routes
+func registerPeriodRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/periods?count=12
+	// Lists the current fiscal year and week and the most recent pay periods,
+	// newest first. Weeks start on the day the user prefers.
+	api.GET("/periods", func(e *core.RequestEvent) error {
+		count := 12
+		if raw := e.Request.URL.Query().Get("count"); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n <= 0 || n > maxListedPeriods {
+				return e.BadRequestError("Invalid 'count'", err)
+			}
+			count = n
+		}
+
+		calendar, err := userCalendar(e, services)
+		if err != nil {
+			return e.InternalServerError("Failed to load preferences", err)
+		}
+
+		now := time.Now()
+		payPeriods := make([]models.Period, 0, count)
+		for period := calendar.PayPeriod(now); len(payPeriods) < count; period = calendar.PayPeriod(period.Start.Add(-time.Nanosecond)) {
+			payPeriods = append(payPeriods, period)
+		}
+
+		return e.JSON(http.StatusOK, map[string]any{
+			"calendar":   calendar,
+			"fiscalYear": calendar.FiscalYear(now),
+			"week":       calendar.Week(now),
+			"payPeriods": payPeriods,
+		})
+	})
+
+	// GET /api/firedragon/periods/{name}
+	// Resolves a period name such as "last-week", "last-month", "2025-03" or "2025/26".
+	api.GET("/periods/{name...}", func(e *core.RequestEvent) error {
+		calendar, err := userCalendar(e, services)
+		if err != nil {
+			return e.InternalServerError("Failed to load preferences", err)
+		}
+		period, err := calendar.Resolve(e.Request.PathValue("name"), time.Now())
+		if err != nil {
+			return e.BadRequestError("Invalid period", err)
+		}
+		return e.JSON(http.StatusOK, period)
+	})
+}
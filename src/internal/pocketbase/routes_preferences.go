@@ -0,0 +1,74 @@
+package pocketbase
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerPreferenceRoutes registers the user preferences routes
+func registerPreferenceRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/preferences
+	// Returns the preferences of the authenticated user, the defaults of the
+	// instance until they store their own.
+	api.GET("/preferences", func(e *core.RequestEvent) error {
+		preferences, err := userPreferences(e, services)
+		if err != nil {
+			return e.InternalServerError("Failed to load preferences", err)
+		}
+		return e.JSON(http.StatusOK, preferences)
+	})
+
+	// PUT /api/firedragon/preferences
+	// {"baseCurrency": "EUR", "firstDayOfWeek": 1, "dateFormat": "DD.MM.YYYY", "notifications": {"incidents": true}}
+	// Changes the preferences of the authenticated user. Fields left out keep
+	// their value; a stale version is rejected with 409.
+	api.PUT("/preferences", func(e *core.RequestEvent) error {
+		if e.Auth == nil || e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only users have preferences", nil)
+		}
+
+		preferences, err := userPreferences(e, services)
+		if err != nil {
+			return e.InternalServerError("Failed to load preferences", err)
+		}
+		if err := e.BindBody(preferences); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		preferences.UserID = e.Auth.Id
+
+		if err := services.Preferences.Save(e.Request.Context(), preferences); err != nil {
+			switch {
+			case errors.Is(err, models.ErrInvalidPreferences):
+				return e.BadRequestError("Invalid preferences", err)
+			case errors.Is(err, models.ErrConflict):
+				return e.Error(http.StatusConflict, "Preferences changed since they were read", err)
+			}
+			return e.InternalServerError("Failed to save preferences", err)
+		}
+		return e.JSON(http.StatusOK, preferences)
+	})
+}
+
+// userPreferences returns the preferences of the authenticated user.
+// Superusers get the defaults of the instance.
+func userPreferences(e *core.RequestEvent, services *Services) (*models.Preferences, error) {
+	if e.Auth == nil || e.HasSuperuserAuth() {
+		defaults := services.Preferences.Defaults()
+		return &defaults, nil
+	}
+	return services.Preferences.Get(e.Request.Context(), e.Auth.Id)
+}
+
+// userCalendar returns the reporting calendar with the weeks of the
+// authenticated user
+func userCalendar(e *core.RequestEvent, services *Services) (models.PeriodCalendar, error) {
+	preferences, err := userPreferences(e, services)
+	if err != nil {
+		return services.Periods, err
+	}
+	return preferences.Calendar(services.Periods), nil
+}
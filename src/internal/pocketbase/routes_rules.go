@@ -0,0 +1,56 @@
+package pocketbase
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal/scripting"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerRuleRoutes registers the transformation rule routes
+func registerRuleRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// POST /api/firedragon/rules/test
+	// {"script": "...", "transaction": {"description": "...", "amount": 12.5, ...}}
+	// Evaluates a rule against a sample transaction without storing it.
+	api.POST("/rules/test", func(e *core.RequestEvent) error {
+		var body struct {
+			Script      string                `json:"script"`
+			Transaction scripting.Transaction `json:"transaction"`
+		}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		result, err := services.Rules.TestRule(e.Request.Context(), body.Script, body.Transaction)
+		if err != nil {
+			if errors.Is(err, scripting.ErrTimeout) {
+				return e.BadRequestError("Rule evaluation timed out", err)
+			}
+			return e.BadRequestError("Rule evaluation failed", err)
+		}
+
+		return e.JSON(http.StatusOK, result)
+	})
+
+	// POST /api/firedragon/rules/apply
+	// {"source": "solana", "transaction": {...}}
+	// Runs all stored rules for a source against a sample transaction.
+	api.POST("/rules/apply", func(e *core.RequestEvent) error {
+		var body struct {
+			Source      string                `json:"source"`
+			Transaction scripting.Transaction `json:"transaction"`
+		}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		application, err := services.Rules.Apply(e.Request.Context(), body.Source, body.Transaction)
+		if err != nil {
+			return e.InternalServerError("Failed to apply transformation rules", err)
+		}
+
+		return e.JSON(http.StatusOK, application)
+	})
+}
@@ -0,0 +1,279 @@
+package pocketbase
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/workerpool"
+	"github.com/pocketbase/pocketbase/apis"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerSourceRoutes registers the import source diagnostics routes. The
+// routes that sync or change a source are for superusers and the owners of the
+// space of its wallet.
+func registerSourceRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/sources
+	api.GET("/sources", func(e *core.RequestEvent) error {
+		return e.JSON(http.StatusOK, services.Sources.ListSources())
+	})
+
+	// GET /api/firedragon/sources/queue
+	// Lists the queued and running syncs per provider
+	api.GET("/sources/queue", func(e *core.RequestEvent) error {
+		return e.JSON(http.StatusOK, services.SourceSync.QueueStats())
+	})
+
+	// GET /api/firedragon/sources/status
+	// Returns the sync queue and the report of the last import cycle
+	api.GET("/sources/status", func(e *core.RequestEvent) error {
+		status, err := services.SourceSync.Status(e.Request.Context())
+		if err != nil {
+			return e.InternalServerError("Failed to load sync status", err)
+		}
+		return e.JSON(http.StatusOK, status)
+	})
+
+	// GET /api/firedragon/sources/scheduler
+	// Returns the import interval and whether this replica leads the scheduled imports
+	api.GET("/sources/scheduler", func(e *core.RequestEvent) error {
+		return e.JSON(http.StatusOK, services.Scheduler.Status())
+	})
+
+	// POST /api/firedragon/sources/sync
+	// Runs an import cycle on the worker pool and returns its report;
+	// per-source failures are listed in the body. The syncs are queued ahead
+	// of scheduled cycles and backfills. Superusers only, as the cycle syncs
+	// every space.
+	api.POST("/sources/sync", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can sync every source", nil)
+		}
+		ctx := workerpool.WithPriority(e.Request.Context(), workerpool.PriorityInteractive)
+		return e.JSON(http.StatusOK, services.SourceSync.SyncAll(ctx))
+	})
+
+	// GET /api/firedragon/sources/cycles?limit=20
+	// Lists the stored import cycle reports, newest first
+	api.GET("/sources/cycles", func(e *core.RequestEvent) error {
+		limit := 20
+		if value := e.Request.URL.Query().Get("limit"); value != "" {
+			var err error
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit < 0 {
+				return e.BadRequestError("Invalid 'limit', expected a positive number", err)
+			}
+		}
+
+		cycles, err := services.SourceSync.ListCycles(e.Request.Context(), limit)
+		if err != nil {
+			return e.InternalServerError("Failed to list import cycles", err)
+		}
+		return e.JSON(http.StatusOK, cycles)
+	})
+
+	// GET /api/firedragon/sources/backfills
+	// Lists every backfill with its progress percentage
+	api.GET("/sources/backfills", func(e *core.RequestEvent) error {
+		backfills, err := services.Backfills.ListBackfills(e.Request.Context())
+		if err != nil {
+			return e.InternalServerError("Failed to list backfills", err)
+		}
+		return e.JSON(http.StatusOK, backfills)
+	})
+
+	// POST /api/firedragon/sources/{id}/test
+	// Runs a live smoke test (auth, one balance fetch, one transaction fetch).
+	// Step failures are reported in the body; the status is 200 whenever the test ran.
+	api.POST("/sources/{id}/test", func(e *core.RequestEvent) error {
+		report, err := services.Sources.TestSource(e.Request.Context(), e.Request.PathValue("id"))
+		if errors.Is(err, models.ErrSourceNotFound) {
+			return e.NotFoundError("Source not found", err)
+		}
+		if err != nil {
+			return e.BadRequestError("Failed to test source", err)
+		}
+
+		return e.JSON(http.StatusOK, report)
+	})
+
+	// POST /api/firedragon/sources/{id}/sync
+	// Imports the transactions the source currently reports into its wallet
+	api.POST("/sources/{id}/sync", func(e *core.RequestEvent) error {
+		if err := authorizeSource(e, services, e.Request.PathValue("id")); err != nil {
+			return err
+		}
+		report, err := services.SourceSync.SyncSource(e.Request.Context(), e.Request.PathValue("id"))
+		if errors.Is(err, models.ErrSourceNotFound) {
+			return e.NotFoundError("Source not found", err)
+		}
+		if errors.Is(err, models.ErrSourcePaused) {
+			return e.Error(http.StatusConflict, "Source is paused", err)
+		}
+		if errors.Is(err, models.ErrSourceNotSyncable) {
+			return e.Error(http.StatusConflict, "Source cannot sync in its current state", err)
+		}
+		if report == nil {
+			return e.BadRequestError("Failed to sync source", err)
+		}
+
+		// Partial failures are listed in the report
+		return e.JSON(http.StatusOK, report)
+	})
+
+	// POST /api/firedragon/sources/{id}/pause
+	// Excludes the source from syncs until it is resumed and returns the paused sources
+	api.POST("/sources/{id}/pause", func(e *core.RequestEvent) error {
+		if err := authorizeSource(e, services, e.Request.PathValue("id")); err != nil {
+			return err
+		}
+		if err := services.SourceSync.PauseSource(e.Request.PathValue("id")); err != nil {
+			return e.NotFoundError("Source not found", err)
+		}
+		return e.JSON(http.StatusOK, map[string][]string{"paused": services.SourceSync.PausedSources()})
+	})
+
+	// PUT /api/firedragon/sources/{id}/lifecycle
+	// {"state": "disabled", "reason": "bank account closed"}
+	// Moves the source to disabled, authorized (to turn it back on or after
+	// access was granted again) or expired_consent, and returns its state.
+	// Active and degraded follow from the outcome of syncs.
+	api.PUT("/sources/{id}/lifecycle", func(e *core.RequestEvent) error {
+		if err := authorizeSource(e, services, e.Request.PathValue("id")); err != nil {
+			return err
+		}
+
+		var body struct {
+			State  models.SourceLifecycle `json:"state"`
+			Reason string                 `json:"reason"`
+		}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		state, err := services.SourceSync.TransitionSource(e.Request.Context(), e.Request.PathValue("id"), body.State, body.Reason)
+		switch {
+		case errors.Is(err, models.ErrSourceNotFound):
+			return e.NotFoundError("Source not found", err)
+		case errors.Is(err, models.ErrInvalidSourceTransition):
+			return e.Error(http.StatusConflict, "Invalid lifecycle transition", err)
+		case err != nil:
+			return e.BadRequestError("Failed to change the lifecycle of the source", err)
+		}
+		return e.JSON(http.StatusOK, state)
+	})
+
+	// POST /api/firedragon/sources/{id}/resume
+	api.POST("/sources/{id}/resume", func(e *core.RequestEvent) error {
+		if err := authorizeSource(e, services, e.Request.PathValue("id")); err != nil {
+			return err
+		}
+		if err := services.SourceSync.ResumeSource(e.Request.PathValue("id")); err != nil {
+			return e.NotFoundError("Source not found", err)
+		}
+		return e.JSON(http.StatusOK, map[string][]string{"paused": services.SourceSync.PausedSources()})
+	})
+
+	// GET /api/firedragon/sources/{id}/backfill
+	api.GET("/sources/{id}/backfill", func(e *core.RequestEvent) error {
+		backfill, err := services.Backfills.GetBackfill(e.Request.Context(), e.Request.PathValue("id"))
+		if errors.Is(err, models.ErrBackfillNotFound) {
+			return e.NotFoundError("Backfill not found", err)
+		}
+		if err != nil {
+			return e.InternalServerError("Failed to get backfill", err)
+		}
+		return e.JSON(http.StatusOK, backfill)
+	})
+
+	// POST /api/firedragon/sources/{id}/backfill?restart=true
+	// Imports the full history of the source in the background, resuming a
+	// stopped backfill from its checkpoint unless restart is set
+	api.POST("/sources/{id}/backfill", func(e *core.RequestEvent) error {
+		if err := authorizeSource(e, services, e.Request.PathValue("id")); err != nil {
+			return err
+		}
+		restart := e.Request.URL.Query().Get("restart") == "true"
+		backfill, err := services.Backfills.Start(e.Request.Context(), e.Request.PathValue("id"), restart)
+		switch {
+		case errors.Is(err, models.ErrSourceNotFound):
+			return e.NotFoundError("Source not found", err)
+		case errors.Is(err, models.ErrBackfillRunning):
+			return e.Error(http.StatusConflict, "A backfill of this source is already running", err)
+		case errors.Is(err, models.ErrSourceNotSyncable):
+			return e.Error(http.StatusConflict, "Source cannot sync in its current state", err)
+		case err != nil:
+			return e.BadRequestError("Failed to start backfill", err)
+		}
+
+		return e.JSON(http.StatusAccepted, backfill)
+	})
+
+	// GET /api/firedragon/sources/consents
+	// Lists the sources with a bank consent: when it expires and its pending renewal
+	api.GET("/sources/consents", func(e *core.RequestEvent) error {
+		return e.JSON(http.StatusOK, services.Consents.Consents())
+	})
+
+	// POST /api/firedragon/sources/{id}/consent/renew
+	// Creates a renewal link for the consent of the source and sends it to the
+	// user, e.g. after the consent expired. Returns the state with the link.
+	api.POST("/sources/{id}/consent/renew", func(e *core.RequestEvent) error {
+		if err := authorizeSource(e, services, e.Request.PathValue("id")); err != nil {
+			return err
+		}
+		state, err := services.Consents.RenewConsent(e.Request.Context(), e.Request.PathValue("id"))
+		switch {
+		case errors.Is(err, models.ErrSourceNotFound):
+			return e.NotFoundError("Source not found", err)
+		case errors.Is(err, models.ErrConsentNotSupported), errors.Is(err, models.ErrSourceHasNoClient):
+			return e.Error(http.StatusConflict, "Source has no consent to renew", err)
+		case err != nil:
+			return e.Error(http.StatusBadGateway, "Failed to create renewal link", err)
+		}
+		return e.JSON(http.StatusOK, state)
+	})
+
+	// GET /api/firedragon/sources/consent/callback?code=...&state=...
+	// The bank redirects the browser here after the user granted access at a
+	// renewal link, so the request is authenticated by its state instead. A
+	// source whose consent expired syncs again from now on.
+	api.GET("/sources/consent/callback", func(e *core.RequestEvent) error {
+		query := e.Request.URL.Query()
+		if reason := query.Get("error"); reason != "" {
+			return e.BadRequestError("Consent renewal was denied: "+reason, nil)
+		}
+
+		state, err := services.Consents.CompleteConsent(e.Request.Context(), query.Get("state"), query.Get("code"))
+		switch {
+		case errors.Is(err, models.ErrInvalidOAuthState):
+			return e.BadRequestError("Invalid or expired renewal request", err)
+		case err != nil:
+			return e.Error(http.StatusBadGateway, "Failed to complete consent renewal", err)
+		}
+		return e.JSON(http.StatusOK, state)
+	}).Unbind(apis.DefaultRequireAuthMiddlewareId)
+}
+
+// authorizeSource checks that the caller may sync or change an import source:
+// superusers every source, users those whose wallet is in a space they own
+func authorizeSource(e *core.RequestEvent, services *Services, id string) error {
+	if e.HasSuperuserAuth() {
+		return nil
+	}
+
+	spaceID, err := services.Sources.SpaceOf(id)
+	if err != nil {
+		return e.NotFoundError("Source not found", err)
+	}
+	if spaceID == "" {
+		return e.ForbiddenError("Only superusers can manage the sources of shared wallets", nil)
+	}
+	if err := services.Spaces.AuthorizeSpace(e.Request.Context(), spaceActor(e), spaceID, models.SpaceRoleOwner); err != nil {
+		return spaceError(e, err)
+	}
+	return nil
+}
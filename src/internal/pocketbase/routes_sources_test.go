@@ -0,0 +1,48 @@
+package pocketbase
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+)
+
+// Users only sync and change the sources of the spaces they own; the sources
+// of shared wallets are for superusers
+func TestSourceRoutes_RefuseChangesOutsideOwnedSpaces(t *testing.T) {
+	s := newTestSpaces(t)
+	sources := []usecases.Source{
+		{Account: usecases.AccountRef{Source: "enable", Account: "payroll"}, SpaceID: s.company.ID},
+		{Account: usecases.AccountRef{Source: "enable", Account: "shared"}},
+	}
+	s.services.Sources = usecases.NewSourceService(sources, 0)
+	s.services.SourceSync = usecases.NewSourceSyncService(sources, nil, nil, nil)
+	payroll, shared := "/api/firedragon/sources/enable:payroll", "/api/firedragon/sources/enable:shared"
+
+	tests := []struct {
+		name        string
+		method, url string
+		token       string
+		body        string
+		wantStatus  int
+	}{
+		{name: "pause as a viewer", method: http.MethodPost, url: payroll + "/pause", token: s.alice, wantStatus: http.StatusForbidden},
+		{name: "disable as a viewer", method: http.MethodPut, url: payroll + "/lifecycle", token: s.alice, body: `{"state": "disabled"}`, wantStatus: http.StatusForbidden},
+		{name: "sync as a viewer", method: http.MethodPost, url: payroll + "/sync", token: s.alice, wantStatus: http.StatusForbidden},
+		{name: "backfill as a viewer", method: http.MethodPost, url: payroll + "/backfill", token: s.alice, wantStatus: http.StatusForbidden},
+		{name: "resume as a viewer", method: http.MethodPost, url: payroll + "/resume", token: s.alice, wantStatus: http.StatusForbidden},
+		{name: "pause a shared source", method: http.MethodPost, url: shared + "/pause", token: s.bob, wantStatus: http.StatusForbidden},
+		{name: "pause an unknown source", method: http.MethodPost, url: "/api/firedragon/sources/enable:missing/pause", token: s.bob, wantStatus: http.StatusNotFound},
+		{name: "sync every source as a user", method: http.MethodPost, url: "/api/firedragon/sources/sync", token: s.bob, wantStatus: http.StatusForbidden},
+		{name: "pause as an owner", method: http.MethodPost, url: payroll + "/pause", token: s.bob, wantStatus: http.StatusOK},
+		{name: "superusers resume a shared source", method: http.MethodPost, url: shared + "/resume", token: s.superuser, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := s.serve(t, registerSourceRoutes, tt.method, tt.url, tt.token, tt.body)
+			if status != tt.wantStatus {
+				t.Errorf("%s %s status = %d, want %d: %s", tt.method, tt.url, status, tt.wantStatus, body)
+			}
+		})
+	}
+}
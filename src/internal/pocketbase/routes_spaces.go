@@ -0,0 +1,198 @@
+package pocketbase
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/validation"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerSpaceRoutes registers the shared space routes. Users only see the
+// spaces they are a member of; superusers see every space.
+func registerSpaceRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/spaces
+	// Lists the spaces of the authenticated user, by name.
+	api.GET("/spaces", func(e *core.RequestEvent) error {
+		spaces, err := services.Spaces.ListSpaces(e.Request.Context(), spaceActor(e))
+		if err != nil {
+			return e.InternalServerError("Failed to list spaces", err)
+		}
+		return e.JSON(http.StatusOK, spaces)
+	})
+
+	// POST /api/firedragon/spaces
+	// {"name": "Home", "kind": "household"}
+	// Creates a household, personal or business space owned by the authenticated user.
+	api.POST("/spaces", func(e *core.RequestEvent) error {
+		var body struct {
+			Name string           `json:"name"`
+			Kind models.SpaceKind `json:"kind"`
+		}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		space, err := services.Spaces.CreateSpace(e.Request.Context(), spaceActor(e), body.Name, body.Kind)
+		if err != nil {
+			return spaceError(e, err)
+		}
+		return e.JSON(http.StatusCreated, space)
+	})
+
+	// GET /api/firedragon/spaces/{space}/members
+	// Lists the members of a space and their roles.
+	api.GET("/spaces/{space}/members", func(e *core.RequestEvent) error {
+		members, err := services.Spaces.ListMembers(e.Request.Context(), spaceActor(e), e.Request.PathValue("space"))
+		if err != nil {
+			return spaceError(e, err)
+		}
+		return e.JSON(http.StatusOK, members)
+	})
+
+	// PUT /api/firedragon/spaces/{space}/members/{user}
+	// {"role": "editor"}
+	// Adds a user to a space or changes their role; owners only. The last
+	// owner of a space cannot be demoted.
+	api.PUT("/spaces/{space}/members/{user}", func(e *core.RequestEvent) error {
+		var body struct {
+			Role models.SpaceRole `json:"role"`
+		}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		member, err := services.Spaces.SetMember(e.Request.Context(), spaceActor(e),
+			e.Request.PathValue("space"), e.Request.PathValue("user"), body.Role)
+		if err != nil {
+			return spaceError(e, err)
+		}
+		return e.JSON(http.StatusOK, member)
+	})
+
+	// DELETE /api/firedragon/spaces/{space}/members/{user}
+	// Removes a user from a space. Owners remove anyone, other members only
+	// themselves; the last owner cannot leave.
+	api.DELETE("/spaces/{space}/members/{user}", func(e *core.RequestEvent) error {
+		err := services.Spaces.RemoveMember(e.Request.Context(), spaceActor(e),
+			e.Request.PathValue("space"), e.Request.PathValue("user"))
+		if err != nil {
+			return spaceError(e, err)
+		}
+		return e.JSON(http.StatusOK, map[string]string{"status": "removed"})
+	})
+
+	// GET /api/firedragon/spaces/{space}/wallets
+	// Lists the wallets owned by a space.
+	api.GET("/spaces/{space}/wallets", func(e *core.RequestEvent) error {
+		wallets, err := services.Spaces.Wallets(e.Request.Context(), spaceActor(e), e.Request.PathValue("space"))
+		if err != nil {
+			return spaceError(e, err)
+		}
+		return e.JSON(http.StatusOK, wallets)
+	})
+
+	// POST /api/firedragon/spaces/{space}/wallets/{wallet}
+	// Moves a wallet into a space; editors of both the old and the new space only.
+	api.POST("/spaces/{space}/wallets/{wallet}", func(e *core.RequestEvent) error {
+		wallet, err := services.Spaces.AssignWallet(e.Request.Context(), spaceActor(e),
+			e.Request.PathValue("wallet"), e.Request.PathValue("space"))
+		if err != nil {
+			return spaceError(e, err)
+		}
+		return e.JSON(http.StatusOK, wallet)
+	})
+
+	// DELETE /api/firedragon/spaces/{space}/wallets/{wallet}
+	// Moves a wallet out of a space, back to the wallets shared by every user.
+	api.DELETE("/spaces/{space}/wallets/{wallet}", func(e *core.RequestEvent) error {
+		wallet, err := services.Spaces.ReleaseWallet(e.Request.Context(), spaceActor(e),
+			e.Request.PathValue("wallet"), e.Request.PathValue("space"))
+		if err != nil {
+			return spaceError(e, err)
+		}
+		return e.JSON(http.StatusOK, wallet)
+	})
+
+	// GET /api/firedragon/spaces/{space}/categories
+	// Lists the categories owned by a space.
+	api.GET("/spaces/{space}/categories", func(e *core.RequestEvent) error {
+		categories, err := services.Spaces.Categories(e.Request.Context(), spaceActor(e), e.Request.PathValue("space"))
+		if err != nil {
+			return spaceError(e, err)
+		}
+		return e.JSON(http.StatusOK, categories)
+	})
+
+	// POST /api/firedragon/spaces/{space}/categories/{category}
+	// Moves a category into a space; editors of both the old and the new space
+	// only. System categories stay shared.
+	api.POST("/spaces/{space}/categories/{category}", func(e *core.RequestEvent) error {
+		category, err := services.Spaces.AssignCategory(e.Request.Context(), spaceActor(e),
+			e.Request.PathValue("category"), e.Request.PathValue("space"))
+		if err != nil {
+			return spaceError(e, err)
+		}
+		return e.JSON(http.StatusOK, category)
+	})
+
+	// GET /api/firedragon/spaces/{space}/transactions?limit=50&offset=0
+	// Lists the transactions of the wallets owned by a space, newest first.
+	api.GET("/spaces/{space}/transactions", func(e *core.RequestEvent) error {
+		query := e.Request.URL.Query()
+		limit, offset := 50, 0
+		if raw := query.Get("limit"); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n <= 0 || n > maxBulkItems {
+				return e.BadRequestError("Invalid 'limit'", err)
+			}
+			limit = n
+		}
+		if raw := query.Get("offset"); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 0 {
+				return e.BadRequestError("Invalid 'offset'", err)
+			}
+			offset = n
+		}
+
+		transactions, err := services.Spaces.Transactions(e.Request.Context(), spaceActor(e), e.Request.PathValue("space"), limit, offset)
+		if err != nil {
+			return spaceError(e, err)
+		}
+		return e.JSON(http.StatusOK, transactions)
+	})
+}
+
+// spaceActor returns the authenticated user acting on spaces
+func spaceActor(e *core.RequestEvent) usecases.SpaceActor {
+	if e.HasSuperuserAuth() {
+		return usecases.SpaceActor{Superuser: true}
+	}
+	return usecases.SpaceActor{UserID: e.Auth.Id}
+}
+
+// spaceError maps the errors of the space service to responses
+func spaceError(e *core.RequestEvent, err error) error {
+	switch {
+	case errors.Is(err, models.ErrSpaceNotFound), errors.Is(err, models.ErrSpaceMemberNotFound),
+		errors.Is(err, models.ErrNotInSpace), errors.Is(err, sql.ErrNoRows):
+		return e.NotFoundError("Space, member, wallet or category not found", err)
+	case errors.Is(err, models.ErrSpaceAccessDenied):
+		return e.ForbiddenError("Your role in the space does not allow this", err)
+	case errors.Is(err, models.ErrLastSpaceOwner), errors.Is(err, models.ErrConflict):
+		return e.Error(http.StatusConflict, err.Error(), err)
+	case errors.Is(err, models.ErrMissingSpaceName), errors.Is(err, models.ErrInvalidSpaceKind),
+		errors.Is(err, models.ErrInvalidSpaceRole), errors.Is(err, models.ErrSystemCategoryNotMovable):
+		if validation.FieldErrors(err) != nil {
+			return e.BadRequestError("Invalid space", err)
+		}
+		return e.BadRequestError(err.Error(), err)
+	}
+	return e.InternalServerError("Space request failed", err)
+}
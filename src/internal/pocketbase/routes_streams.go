@@ -0,0 +1,143 @@
+package pocketbase
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal/events"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// purgeRequest selects the messages of a stream to purge
+type purgeRequest struct {
+	Subject   string `json:"subject,omitempty"`   // only messages on this subject, wildcards allowed
+	OlderThan string `json:"olderThan,omitempty"` // only messages older than this duration, e.g. 720h
+}
+
+// retentionRequest changes the limits of the event stream. Fields left out
+// keep their value; zero removes the limit.
+type retentionRequest struct {
+	MaxAge   *string `json:"maxAge,omitempty"` // e.g. 720h
+	MaxBytes *int64  `json:"maxBytes,omitempty"`
+	MaxMsgs  *int64  `json:"maxMsgs,omitempty"`
+}
+
+// compactRequest sets how many messages of every subject a compaction keeps
+type compactRequest struct {
+	Keep uint64 `json:"keep"`
+}
+
+// registerStreamRoutes registers the JetStream administration routes. They
+// are limited to superusers and only exist while NATS is connected.
+func registerStreamRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	if services.Streams == nil {
+		return
+	}
+
+	// GET /api/firedragon/streams
+	// Lists the storage, subjects and limits of the event stream and the
+	// key-value buckets of the install.
+	api.GET("/streams", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can manage streams", nil)
+		}
+		usage, err := services.Streams.Usage(e.Request.Context())
+		if err != nil {
+			return e.InternalServerError("Failed to read streams", err)
+		}
+		return e.JSON(http.StatusOK, usage)
+	})
+
+	// POST /api/firedragon/streams/{stream}/purge
+	// {"subject": "firedragon.events.import.report.>", "olderThan": "720h"}
+	// Removes the messages of a stream, by kind (events, dedupe, leader) or
+	// name, on a subject and/or older than a duration; an empty body purges
+	// the whole stream.
+	api.POST("/streams/{stream}/purge", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can manage streams", nil)
+		}
+		var body purgeRequest
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		opts := events.PurgeOptions{Subject: body.Subject}
+		if body.OlderThan != "" {
+			age, err := time.ParseDuration(body.OlderThan)
+			if err != nil || age <= 0 {
+				return e.BadRequestError("Invalid 'olderThan', expected a positive duration such as 720h", err)
+			}
+			opts.Before = time.Now().Add(-age)
+		}
+
+		result, err := services.Streams.Purge(e.Request.Context(), e.Request.PathValue("stream"), opts)
+		if errors.Is(err, events.ErrUnknownStream) {
+			return e.NotFoundError("Stream not found", err)
+		}
+		if err != nil {
+			return e.InternalServerError("Failed to purge stream", err)
+		}
+		return e.JSON(http.StatusOK, result)
+	})
+
+	// PUT /api/firedragon/streams/{stream}/retention
+	// {"maxAge": "2160h", "maxBytes": 1073741824}
+	// Changes the limits of the event stream. They are kept across restarts
+	// unless nats.stream_max_age or nats.stream_max_bytes are configured.
+	api.PUT("/streams/{stream}/retention", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can manage streams", nil)
+		}
+		var body retentionRequest
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		retention := events.Retention{MaxBytes: body.MaxBytes, MaxMsgs: body.MaxMsgs}
+		if body.MaxAge != nil {
+			age, err := time.ParseDuration(*body.MaxAge)
+			if err != nil || age < 0 {
+				return e.BadRequestError("Invalid 'maxAge', expected a duration such as 720h", err)
+			}
+			retention.MaxAge = &age
+		}
+
+		usage, err := services.Streams.SetRetention(e.Request.Context(), e.Request.PathValue("stream"), retention)
+		switch {
+		case errors.Is(err, events.ErrUnknownStream):
+			return e.NotFoundError("Stream not found", err)
+		case errors.Is(err, events.ErrRetentionFixed):
+			return e.BadRequestError(err.Error(), err)
+		case err != nil:
+			return e.InternalServerError("Failed to change stream retention", err)
+		}
+		return e.JSON(http.StatusOK, usage)
+	})
+
+	// POST /api/firedragon/streams/{stream}/compact
+	// {"keep": 1}
+	// Keeps the last messages of every subject of a stream and removes the
+	// older ones, e.g. superseded key-value revisions.
+	api.POST("/streams/{stream}/compact", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can manage streams", nil)
+		}
+		body := compactRequest{Keep: 1}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		if body.Keep == 0 {
+			return e.BadRequestError("Invalid 'keep', expected at least 1", nil)
+		}
+
+		result, err := services.Streams.Compact(e.Request.Context(), e.Request.PathValue("stream"), body.Keep)
+		if errors.Is(err, events.ErrUnknownStream) {
+			return e.NotFoundError("Stream not found", err)
+		}
+		if err != nil {
+			return e.InternalServerError("Failed to compact stream", err)
+		}
+		return e.JSON(http.StatusOK, result)
+	})
+}
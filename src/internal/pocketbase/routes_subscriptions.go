@@ -0,0 +1,55 @@
+package pocketbase
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerSubscriptionRoutes registers the detected subscription routes
+func registerSubscriptionRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/subscriptions?wallet=...&status=missed
+	// Lists the detected subscriptions with their monthly cost and next expected charge,
+	// most expensive first.
+	api.GET("/subscriptions", func(e *core.RequestEvent) error {
+		query := e.Request.URL.Query()
+		filter := repositories.SubscriptionFilter{
+			WalletID: query.Get("wallet"),
+			Status:   models.SubscriptionStatus(query.Get("status")),
+		}
+		if !e.HasSuperuserAuth() {
+			filter.VisibleTo = e.Auth.Id
+		}
+		switch filter.Status {
+		case "", models.SubscriptionActive, models.SubscriptionMissed:
+		default:
+			return e.BadRequestError("Invalid 'status', expected active or missed", nil)
+		}
+
+		subscriptions, err := services.Subscriptions.List(e.Request.Context(), filter)
+		if err != nil {
+			return e.InternalServerError("Failed to list subscriptions", err)
+		}
+
+		return e.JSON(http.StatusOK, subscriptions)
+	})
+
+	// POST /api/firedragon/subscriptions/detect
+	// Detects recurring charges now instead of waiting for the schedule. Price
+	// increases and missed charges found are listed in the body.
+	api.POST("/subscriptions/detect", func(e *core.RequestEvent) error {
+		report, err := services.Subscriptions.Detect(e.Request.Context(), time.Now())
+		if err != nil {
+			return e.InternalServerError("Subscription detection failed", err)
+		}
+		if len(report.Errors) > 0 {
+			return e.JSON(http.StatusMultiStatus, report)
+		}
+
+		return e.JSON(http.StatusOK, report)
+	})
+}
@@ -0,0 +1,149 @@
+package pocketbase
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerTagRoutes registers the tag maintenance and reporting routes.
+// Plain create and update go through the regular PocketBase collection API.
+func registerTagRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/tags/spend?tag=travel&from=2025-01-01&to=2025-03-31
+	// GET /api/firedragon/tags/spend?period=last-month
+	// GET /api/firedragon/tags/spend?from=2025-01-01&to=2025-06-30&group=period
+	// A period replaces the range; grouping reports each pay period in the range separately.
+	api.GET("/tags/spend", func(e *core.RequestEvent) error {
+		query := e.Request.URL.Query()
+		filter := repositories.TagSpendFilter{Tag: query.Get("tag")}
+		if !e.HasSuperuserAuth() {
+			filter.VisibleTo = e.Auth.Id
+		}
+
+		var err error
+		if query.Get("period") != "" {
+			calendar, err := userCalendar(e, services)
+			if err != nil {
+				return e.InternalServerError("Failed to load preferences", err)
+			}
+			period, err := calendar.Resolve(query.Get("period"), time.Now())
+			if err != nil {
+				return e.BadRequestError("Invalid 'period'", err)
+			}
+			filter.DateFrom, filter.DateTo = period.Start, period.Last()
+		}
+		if query.Get("from") != "" {
+			filter.DateFrom, err = time.Parse(time.DateOnly, query.Get("from"))
+			if err != nil {
+				return e.BadRequestError("Invalid 'from' date, expected YYYY-MM-DD", err)
+			}
+		}
+		if query.Get("to") != "" {
+			filter.DateTo, err = time.Parse(time.DateOnly, query.Get("to"))
+			if err != nil {
+				return e.BadRequestError("Invalid 'to' date, expected YYYY-MM-DD", err)
+			}
+			// Include the whole end day
+			filter.DateTo = filter.DateTo.Add(24*time.Hour - time.Nanosecond)
+		}
+
+		if query.Get("group") == "period" {
+			report, err := services.Tags.GetPeriodSpendReport(e.Request.Context(), filter)
+			if err != nil {
+				return e.BadRequestError("Failed to compute tag spend", err)
+			}
+			return e.JSON(http.StatusOK, report)
+		}
+
+		spend, err := services.Tags.GetSpendReport(e.Request.Context(), filter)
+		if err != nil {
+			return e.BadRequestError("Failed to compute tag spend", err)
+		}
+
+		return e.JSON(http.StatusOK, spend)
+	})
+
+	// POST /api/firedragon/tags/{id}/rename
+	// {"name": "travel"}
+	// Tags are shared, so users only rename the tags whose transactions are
+	// all in the shared wallets and the spaces they edit. The same holds for
+	// merging and deleting.
+	api.POST("/tags/{id}/rename", func(e *core.RequestEvent) error {
+		var body struct {
+			Name string `json:"name"`
+		}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		if err := authorizeTag(e, services, e.Request.PathValue("id")); err != nil {
+			return err
+		}
+
+		updated, err := services.Tags.RenameTag(e.Request.Context(), e.Request.PathValue("id"), body.Name)
+		if err != nil {
+			return e.BadRequestError("Failed to rename tag", err)
+		}
+
+		return e.JSON(http.StatusOK, map[string]int{"transactions": updated})
+	})
+
+	// POST /api/firedragon/tags/{id}/merge
+	// {"into": "<target tag id>"}
+	api.POST("/tags/{id}/merge", func(e *core.RequestEvent) error {
+		var body struct {
+			Into string `json:"into"`
+		}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		if err := authorizeTag(e, services, e.Request.PathValue("id")); err != nil {
+			return err
+		}
+
+		updated, err := services.Tags.MergeTags(e.Request.Context(), e.Request.PathValue("id"), body.Into)
+		if err != nil {
+			return e.BadRequestError("Failed to merge tags", err)
+		}
+
+		return e.JSON(http.StatusOK, map[string]int{"transactions": updated})
+	})
+
+	// DELETE /api/firedragon/tags/{id}
+	api.DELETE("/tags/{id}", func(e *core.RequestEvent) error {
+		if err := authorizeTag(e, services, e.Request.PathValue("id")); err != nil {
+			return err
+		}
+
+		updated, err := services.Tags.DeleteTag(e.Request.Context(), e.Request.PathValue("id"))
+		if err != nil {
+			return e.BadRequestError("Failed to delete tag", err)
+		}
+
+		return e.JSON(http.StatusOK, map[string]int{"transactions": updated})
+	})
+}
+
+// authorizeTag checks that a user may change every transaction referencing a
+// tag, as renaming, merging or deleting it rewrites them all
+func authorizeTag(e *core.RequestEvent, services *Services, id string) error {
+	if e.HasSuperuserAuth() {
+		return nil
+	}
+
+	walletIDs, err := services.Tags.TagWallets(e.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return e.NotFoundError("Tag not found", err)
+		}
+		return e.InternalServerError("Failed to check the tag", err)
+	}
+	if err := services.Spaces.AuthorizeWallets(e.Request.Context(), spaceActor(e), walletIDs...); err != nil {
+		return spaceError(e, err)
+	}
+	return nil
+}
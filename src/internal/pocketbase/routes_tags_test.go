@@ -0,0 +1,36 @@
+package pocketbase
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// Tags are shared, so users only change those whose transactions are all in
+// the shared wallets and the spaces they edit
+func TestTagRoutes_RefuseRewritingOtherSpaces(t *testing.T) {
+	s := newTestSpaces(t)
+	url := "/api/firedragon/tags/" + s.travel.ID
+
+	tests := []struct {
+		name        string
+		method, url string
+		token       string
+		body        string
+		wantStatus  int
+	}{
+		{name: "rename as a viewer", method: http.MethodPost, url: url + "/rename", token: s.alice, body: `{"name": "trips"}`, wantStatus: http.StatusForbidden},
+		{name: "merge as a viewer", method: http.MethodPost, url: url + "/merge", token: s.alice, body: fmt.Sprintf(`{"into": %q}`, s.travel.ID), wantStatus: http.StatusForbidden},
+		{name: "delete as a viewer", method: http.MethodDelete, url: url, token: s.alice, wantStatus: http.StatusForbidden},
+		{name: "rename an unknown tag", method: http.MethodPost, url: "/api/firedragon/tags/missing/rename", token: s.alice, body: `{"name": "trips"}`, wantStatus: http.StatusNotFound},
+		{name: "rename as an owner", method: http.MethodPost, url: url + "/rename", token: s.bob, body: `{"name": "trips"}`, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := s.serve(t, registerTagRoutes, tt.method, tt.url, tt.token, tt.body)
+			if status != tt.wantStatus {
+				t.Errorf("%s %s status = %d, want %d: %s", tt.method, tt.url, status, tt.wantStatus, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package pocketbase
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// maxBulkItems bounds the size of a single bulk request
+const maxBulkItems = 10000
+
+// registerTransactionRoutes registers the bulk transaction routes
+func registerTransactionRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	// GET /api/firedragon/transactions?wallet=...&description=coffee&notes=refund&meta.chain=ethereum&limit=50&offset=0
+	// Every meta.<key> parameter must match the transaction metadata exactly.
+	api.GET("/transactions", func(e *core.RequestEvent) error {
+		query := e.Request.URL.Query()
+		filter := repositories.TransactionFilter{
+			WalletID:    query.Get("wallet"),
+			Description: query.Get("description"),
+			Notes:       query.Get("notes"),
+			Limit:       100,
+		}
+		// Users only see the transactions of shared wallets and their spaces
+		if !e.HasSuperuserAuth() {
+			filter.VisibleTo = e.Auth.Id
+		}
+
+		for key, values := range query {
+			if name, ok := strings.CutPrefix(key, "meta."); ok && name != "" && len(values) > 0 {
+				if filter.Metadata == nil {
+					filter.Metadata = make(map[string]string)
+				}
+				filter.Metadata[name] = values[0]
+			}
+		}
+
+		if raw := query.Get("limit"); raw != "" {
+			limit, err := strconv.Atoi(raw)
+			if err != nil || limit <= 0 || limit > maxBulkItems {
+				return e.BadRequestError("Invalid 'limit'", err)
+			}
+			filter.Limit = limit
+		}
+		if raw := query.Get("offset"); raw != "" {
+			offset, err := strconv.Atoi(raw)
+			if err != nil || offset < 0 {
+				return e.BadRequestError("Invalid 'offset'", err)
+			}
+			filter.Offset = offset
+		}
+
+		transactions, err := services.Transactions.ListTransactions(e.Request.Context(), filter)
+		if err != nil {
+			return e.InternalServerError("Failed to list transactions", err)
+		}
+
+		return e.JSON(http.StatusOK, transactions)
+	})
+
+	// POST /api/firedragon/transactions/import
+	// {"source": "csv", "walletId": "...", "transactions": [...]}
+	// Users import into the shared wallets and those of the spaces they edit,
+	// and transfer only to those.
+	api.POST("/transactions/import", func(e *core.RequestEvent) error {
+		var input usecases.ImportInput
+		if err := e.BindBody(&input); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		if len(input.Transactions) > maxBulkItems {
+			return e.BadRequestError("Too many transactions in a single request", nil)
+		}
+
+		walletIDs := []string{input.WalletID}
+		for _, tx := range input.Transactions {
+			if tx != nil {
+				walletIDs = append(walletIDs, tx.DestWalletID)
+			}
+		}
+		if err := services.Spaces.AuthorizeWallets(e.Request.Context(), spaceActor(e), walletIDs...); err != nil {
+			return spaceError(e, err)
+		}
+
+		report, err := services.Import.Import(e.Request.Context(), input)
+		if err != nil {
+			if report == nil {
+				return e.BadRequestError("Import failed", err)
+			}
+			return e.JSON(http.StatusMultiStatus, report)
+		}
+
+		return e.JSON(http.StatusOK, report)
+	})
+
+	// PATCH /api/firedragon/transactions/bulk
+	// {"transactions": [{"id": "...", "version": 3, "categoryId": "..."}]}
+	// Users patch the transactions of the shared wallets and the spaces they edit.
+	api.PATCH("/transactions/bulk", func(e *core.RequestEvent) error {
+		var body struct {
+			Transactions []usecases.TransactionPatch `json:"transactions"`
+		}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+		if len(body.Transactions) > maxBulkItems {
+			return e.BadRequestError("Too many transactions in a single request", nil)
+		}
+
+		ids := make([]string, len(body.Transactions))
+		for i, patch := range body.Transactions {
+			ids[i] = patch.ID
+		}
+		if err := services.Spaces.AuthorizeTransactions(e.Request.Context(), spaceActor(e), ids...); err != nil {
+			return spaceError(e, err)
+		}
+
+		updated, err := services.Transactions.BulkUpdateTransactions(e.Request.Context(), body.Transactions)
+		if err != nil {
+			if errors.Is(err, models.ErrConflict) {
+				return e.Error(http.StatusConflict, "A transaction was modified by someone else; reload and retry.", err)
+			}
+			return e.BadRequestError("Bulk update failed", err)
+		}
+
+		return e.JSON(http.StatusOK, map[string]int{"updated": updated})
+	})
+
+	// POST /api/firedragon/transactions/{id}/merge
+	// {"drop": ["...", "..."]}
+	// Users merge the transactions of the shared wallets and the spaces they edit.
+	api.POST("/transactions/{id}/merge", func(e *core.RequestEvent) error {
+		var body struct {
+			Drop []string `json:"drop"`
+		}
+		if err := e.BindBody(&body); err != nil {
+			return e.BadRequestError("Invalid request body", err)
+		}
+
+		ids := append([]string{e.Request.PathValue("id")}, body.Drop...)
+		if err := services.Spaces.AuthorizeTransactions(e.Request.Context(), spaceActor(e), ids...); err != nil {
+			return spaceError(e, err)
+		}
+
+		merged, err := services.Transactions.MergeTransactions(e.Request.Context(), e.Request.PathValue("id"), body.Drop)
+		if err != nil {
+			if errors.Is(err, models.ErrConflict) {
+				return e.Error(http.StatusConflict, "A transaction was modified by someone else; reload and retry.", err)
+			}
+			return e.BadRequestError("Merge failed", err)
+		}
+
+		return e.JSON(http.StatusOK, merged)
+	})
+}
@@ -0,0 +1,109 @@
+package pocketbase
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Users only change the transactions of the shared wallets and the spaces
+// they edit; other spaces are reported as not found, as their members are
+func TestTransactionRoutes_RefuseWritesToOtherSpaces(t *testing.T) {
+	s := newTestSpaces(t)
+
+	tests := []struct {
+		name        string
+		method, url string
+		token       string
+		body        string
+		wantStatus  int
+		wantBody    string
+	}{
+		{
+			name: "import into a viewed space", method: http.MethodPost, url: "/api/firedragon/transactions/import", token: s.alice,
+			body:       fmt.Sprintf(`{"source": "csv", "walletId": %q, "transactions": [{"amount": 5, "description": "Coffee", "type": "expense", "date": "2025-03-02T00:00:00Z"}]}`, s.payroll.ID),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "import a transfer into a viewed space", method: http.MethodPost, url: "/api/firedragon/transactions/import", token: s.alice,
+			body:       fmt.Sprintf(`{"source": "csv", "walletId": %q, "transactions": [{"amount": 5, "description": "Loan", "type": "transfer", "destWalletId": %q, "date": "2025-03-02T00:00:00Z"}]}`, s.checking.ID, s.payroll.ID),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "import into an edited space", method: http.MethodPost, url: "/api/firedragon/transactions/import", token: s.alice,
+			body:       fmt.Sprintf(`{"source": "csv", "walletId": %q, "transactions": [{"amount": 5, "description": "Coffee", "type": "expense", "categoryId": %q, "date": "2025-03-02T00:00:00Z"}]}`, s.checking.ID, s.category.ID),
+			wantStatus: http.StatusOK, wantBody: `"imported":1`,
+		},
+		{
+			name: "patch a transaction of a viewed space", method: http.MethodPatch, url: "/api/firedragon/transactions/bulk", token: s.alice,
+			body:       fmt.Sprintf(`{"transactions": [{"id": %q, "version": %d, "notes": "mine"}, {"id": %q, "version": %d, "notes": "mine"}]}`, s.rent.ID, s.rent.Version, s.salary.ID, s.salary.Version),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "patch a transaction of a foreign space", method: http.MethodPatch, url: "/api/firedragon/transactions/bulk", token: s.bob,
+			body:       fmt.Sprintf(`{"transactions": [{"id": %q, "version": %d, "notes": "mine"}]}`, s.rent.ID, s.rent.Version),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "merge a transaction of a viewed space", method: http.MethodPost, url: "/api/firedragon/transactions/" + s.rent.ID + "/merge", token: s.alice,
+			body:       fmt.Sprintf(`{"drop": [%q]}`, s.salary.ID),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "patch a transaction of an edited space", method: http.MethodPatch, url: "/api/firedragon/transactions/bulk", token: s.alice,
+			body:       fmt.Sprintf(`{"transactions": [{"id": %q, "version": %d, "notes": "mine"}]}`, s.rent.ID, s.rent.Version),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "superusers patch every space", method: http.MethodPatch, url: "/api/firedragon/transactions/bulk", token: s.superuser,
+			body:       fmt.Sprintf(`{"transactions": [{"id": %q, "version": %d, "notes": "audited"}]}`, s.salary.ID, s.salary.Version),
+			wantStatus: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := s.serve(t, registerTransactionRoutes, tt.method, tt.url, tt.token, tt.body)
+			if status != tt.wantStatus {
+				t.Errorf("%s %s status = %d, want %d: %s", tt.method, tt.url, status, tt.wantStatus, body)
+			}
+			if !strings.Contains(body, tt.wantBody) {
+				t.Errorf("%s %s body = %s, want %s", tt.method, tt.url, body, tt.wantBody)
+			}
+		})
+	}
+}
+
+// Users list the transactions of the shared wallets and their spaces only
+func TestTransactionRoutes_ListTheCallersSpaces(t *testing.T) {
+	s := newTestSpaces(t)
+
+	status, body := s.serve(t, registerTransactionRoutes, http.MethodGet, "/api/firedragon/transactions", s.bob, "")
+	if status != http.StatusOK {
+		t.Fatalf("GET /transactions status = %d, want 200: %s", status, body)
+	}
+	if !strings.Contains(body, s.salary.ID) || strings.Contains(body, s.rent.ID) {
+		t.Errorf("bob's transactions = %s, want the salary of company without the rent of home", body)
+	}
+
+	status, body = s.serve(t, registerTransactionRoutes, http.MethodGet, "/api/firedragon/transactions", s.superuser, "")
+	if status != http.StatusOK || !strings.Contains(body, s.salary.ID) || !strings.Contains(body, s.rent.ID) {
+		t.Errorf("superuser transactions = %d %s, want both spaces", status, body)
+	}
+}
+
+// A patch carrying the version the caller read is refused with 409 once the
+// transaction changed since
+func TestTransactionRoutes_RefuseStaleVersions(t *testing.T) {
+	s := newTestSpaces(t)
+	url := "/api/firedragon/transactions/bulk"
+	patch := fmt.Sprintf(`{"transactions": [{"id": %q, "version": %d, "notes": "mine"}]}`, s.rent.ID, s.rent.Version)
+
+	if status, body := s.serve(t, registerTransactionRoutes, http.MethodPatch, url, s.alice, patch); status != http.StatusOK {
+		t.Fatalf("PATCH %s status = %d, want 200: %s", url, status, body)
+	}
+	status, body := s.serve(t, registerTransactionRoutes, http.MethodPatch, url, s.alice, patch)
+	if status != http.StatusConflict || !strings.Contains(body, `"code":"conflict"`) {
+		t.Errorf("PATCH %s with a stale version = %d %s, want a 409 conflict", url, status, body)
+	}
+}
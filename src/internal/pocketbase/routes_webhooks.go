@@ -0,0 +1,63 @@
+package pocketbase
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+)
+
+// registerWebhookRoutes registers the webhook delivery routes. Webhooks
+// receive the events of every space, so they are registered in the webhooks
+// collection and inspected here by superusers only.
+func registerWebhookRoutes(api *router.RouterGroup[*core.RequestEvent], services *Services) {
+	if services.Webhooks == nil {
+		return
+	}
+
+	// GET /api/firedragon/webhooks/{id}/deliveries?status=failed&limit=50
+	// Lists the deliveries of a webhook, newest first, optionally of one
+	// status: pending, delivered or failed.
+	api.GET("/webhooks/{id}/deliveries", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can manage webhooks", nil)
+		}
+		query := e.Request.URL.Query()
+		status := models.WebhookDeliveryStatus(query.Get("status"))
+		switch status {
+		case "", models.WebhookDeliveryPending, models.WebhookDeliveryDelivered, models.WebhookDeliveryFailed:
+		default:
+			return e.BadRequestError("Invalid 'status', expected pending, delivered or failed", nil)
+		}
+		limit := 50
+		if query.Get("limit") != "" {
+			var err error
+			limit, err = strconv.Atoi(query.Get("limit"))
+			if err != nil || limit < 0 {
+				return e.BadRequestError("Invalid 'limit', expected a positive number", err)
+			}
+		}
+
+		deliveries, err := services.Webhooks.Deliveries(e.Request.Context(), e.Request.PathValue("id"), status, limit)
+		if err != nil {
+			return e.InternalServerError("Failed to list webhook deliveries", err)
+		}
+		return e.JSON(http.StatusOK, deliveries)
+	})
+
+	// POST /api/firedragon/webhooks/deliveries/{id}/redeliver
+	// Posts a delivery again now, also one that was delivered or given up,
+	// and returns it with the outcome. A failed attempt is retried as usual.
+	api.POST("/webhooks/deliveries/{id}/redeliver", func(e *core.RequestEvent) error {
+		if !e.HasSuperuserAuth() {
+			return e.ForbiddenError("Only superusers can manage webhooks", nil)
+		}
+		delivery, err := services.Webhooks.Redeliver(e.Request.Context(), e.Request.PathValue("id"))
+		if err != nil {
+			return e.InternalServerError("Failed to redeliver the webhook delivery", err)
+		}
+		return e.JSON(http.StatusOK, delivery)
+	})
+}
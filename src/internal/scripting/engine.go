@@ -0,0 +1,203 @@
+// Package scripting evaluates user-defined transformation rules written in the
+// expr language (https://expr-lang.org) against incoming transactions.
+//
+// A rule is a single expression evaluated with the transaction fields in scope
+// and a small set of action functions that modify the transaction:
+//
+//	description contains "AMAZON" ? setCategory("Shopping") && addTag("online") : false
+//
+//	if lower(description) matches "uber|lyft" { setCategory("Transport") } else { false }
+package scripting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+)
+
+const (
+	// DefaultTimeout bounds the evaluation time of a single rule
+	DefaultTimeout = 250 * time.Millisecond
+
+	// maxNodes bounds the size of a rule expression
+	maxNodes = 2000
+
+	// maxCachedPrograms bounds the compiled program cache
+	maxCachedPrograms = 1024
+)
+
+var (
+	// ErrCompile is returned when a script fails to compile
+	ErrCompile = errors.New("script compilation failed")
+
+	// ErrTimeout is returned when a script does not finish within the engine timeout
+	ErrTimeout = errors.New("script evaluation timed out")
+)
+
+// Transaction is the view of a transaction exposed to scripts
+type Transaction struct {
+	Description string    `json:"description"`
+	Amount      float64   `json:"amount"`
+	Currency    string    `json:"currency"`
+	Type        string    `json:"type"`
+	Date        time.Time `json:"date"`
+	Source      string    `json:"source"`
+	Category    string    `json:"category"` // category name
+	Tags        []string  `json:"tags"`
+}
+
+// Result is the outcome of evaluating a script against a transaction
+type Result struct {
+	Transaction Transaction `json:"transaction"`
+	Changed     bool        `json:"changed"` // true when an action modified the transaction
+	Output      any         `json:"output"`  // raw value of the expression
+}
+
+// env is the evaluation environment. Action functions close over the
+// transaction being evaluated, so a new env is built for every run.
+type env struct {
+	Description string    `expr:"description"`
+	Amount      float64   `expr:"amount"`
+	Currency    string    `expr:"currency"`
+	Type        string    `expr:"type"`
+	Date        time.Time `expr:"date"`
+	Source      string    `expr:"source"`
+	Category    string    `expr:"category"`
+	Tags        []string  `expr:"tags"`
+
+	SetCategory    func(name string) bool `expr:"setCategory"`
+	SetDescription func(text string) bool `expr:"setDescription"`
+	AddTag         func(tag string) bool  `expr:"addTag"`
+	RemoveTag      func(tag string) bool  `expr:"removeTag"`
+}
+
+// Engine compiles and evaluates transformation scripts
+type Engine struct {
+	timeout time.Duration
+
+	mu       sync.RWMutex
+	programs map[string]*vm.Program
+}
+
+// NewEngine creates a new Engine. A non-positive timeout uses DefaultTimeout.
+func NewEngine(timeout time.Duration) *Engine {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Engine{
+		timeout:  timeout,
+		programs: make(map[string]*vm.Program),
+	}
+}
+
+// Compile checks a script for syntax and type errors
+func (e *Engine) Compile(script string) error {
+	_, err := e.program(script)
+	return err
+}
+
+// Run evaluates a script against a copy of the transaction and returns the transformed copy
+func (e *Engine) Run(ctx context.Context, script string, tx Transaction) (*Result, error) {
+	program, err := e.program(script)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Transaction: tx}
+	result.Transaction.Tags = slices.Clone(tx.Tags)
+	out := &result.Transaction
+
+	environment := env{
+		Description: tx.Description,
+		Amount:      tx.Amount,
+		Currency:    tx.Currency,
+		Type:        tx.Type,
+		Date:        tx.Date,
+		Source:      tx.Source,
+		Category:    tx.Category,
+		Tags:        slices.Clone(tx.Tags),
+		SetCategory: func(name string) bool {
+			out.Category = name
+			result.Changed = true
+			return true
+		},
+		SetDescription: func(text string) bool {
+			out.Description = text
+			result.Changed = true
+			return true
+		},
+		AddTag: func(tag string) bool {
+			if tag != "" && !slices.Contains(out.Tags, tag) {
+				out.Tags = append(out.Tags, tag)
+				result.Changed = true
+			}
+			return true
+		},
+		RemoveTag: func(tag string) bool {
+			if idx := slices.Index(out.Tags, tag); idx >= 0 {
+				out.Tags = slices.Delete(out.Tags, idx, idx+1)
+				result.Changed = true
+			}
+			return true
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	type outcome struct {
+		value any
+		err   error
+	}
+	done := make(chan outcome, 1)
+
+	// The VM cannot be interrupted, so the goroutine is abandoned on timeout.
+	// maxNodes and the VM memory budget keep such runs bounded.
+	go func() {
+		value, err := expr.Run(program, environment)
+		done <- outcome{value: value, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%w after %s", ErrTimeout, e.timeout)
+	case o := <-done:
+		if o.err != nil {
+			return nil, fmt.Errorf("script evaluation failed: %w", o.err)
+		}
+		result.Output = o.value
+		return result, nil
+	}
+}
+
+// program returns the compiled program for a script, compiling it on first use
+func (e *Engine) program(script string) (*vm.Program, error) {
+	e.mu.RLock()
+	program, ok := e.programs[script]
+	e.mu.RUnlock()
+	if ok {
+		return program, nil
+	}
+
+	program, err := expr.Compile(script, expr.Env(env{}), expr.MaxNodes(maxNodes))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCompile, err)
+	}
+
+	e.mu.Lock()
+	// Rules rarely change; dropping the whole cache keeps it bounded without LRU bookkeeping
+	if len(e.programs) >= maxCachedPrograms {
+		clear(e.programs)
+	}
+	e.programs[script] = program
+	e.mu.Unlock()
+
+	return program, nil
+}
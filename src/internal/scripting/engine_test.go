@@ -0,0 +1,84 @@
+package scripting
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestEngine_Run(t *testing.T) {
+	engine := NewEngine(0)
+	sample := Transaction{
+		Description: "AMAZON MKTPLACE 123",
+		Amount:      42.5,
+		Type:        "expense",
+		Tags:        []string{"card"},
+	}
+
+	tests := []struct {
+		name         string
+		script       string
+		wantChanged  bool
+		wantCategory string
+		wantTags     []string
+	}{
+		{
+			name:         "Ternary sets category and tag",
+			script:       `description contains "AMAZON" ? setCategory("Shopping") && addTag("online") : false`,
+			wantChanged:  true,
+			wantCategory: "Shopping",
+			wantTags:     []string{"card", "online"},
+		},
+		{
+			name:         "If block with regex",
+			script:       `if lower(description) matches "uber|lyft" { setCategory("Transport") } else { false }`,
+			wantChanged:  false,
+			wantCategory: "",
+			wantTags:     []string{"card"},
+		},
+		{
+			name:        "Remove tag",
+			script:      `amount > 10 && removeTag("card")`,
+			wantChanged: true,
+			wantTags:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := engine.Run(context.Background(), tt.script, sample)
+			if err != nil {
+				t.Fatalf("Run() returned unexpected error: %v", err)
+			}
+			if result.Changed != tt.wantChanged {
+				t.Errorf("Run() changed = %v, want %v", result.Changed, tt.wantChanged)
+			}
+			if result.Transaction.Category != tt.wantCategory {
+				t.Errorf("Run() category = %q, want %q", result.Transaction.Category, tt.wantCategory)
+			}
+			if !slices.Equal(result.Transaction.Tags, tt.wantTags) {
+				t.Errorf("Run() tags = %v, want %v", result.Transaction.Tags, tt.wantTags)
+			}
+		})
+	}
+
+	// The input transaction must not be modified
+	if !slices.Equal(sample.Tags, []string{"card"}) {
+		t.Errorf("Run() modified input tags: %v", sample.Tags)
+	}
+}
+
+func TestEngine_CompileError(t *testing.T) {
+	engine := NewEngine(0)
+
+	for _, script := range []string{
+		`description contains`,
+		`unknownVariable == 1`,
+		`setCategory(42)`,
+	} {
+		if err := engine.Compile(script); !errors.Is(err, ErrCompile) {
+			t.Errorf("Compile(%q) error = %v, want %v", script, err, ErrCompile)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+// Package workerpool runs jobs on a bounded number of workers with a
+// concurrency limit per provider, so many accounts at one provider cannot
+// flood its API or starve the accounts at other providers. Jobs are queued in
+// priority lanes, so interactive imports do not wait behind bulk work.
+package workerpool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+)
+
+const (
+	// DefaultWorkers is the number of jobs run at once across all providers
+	DefaultWorkers = 4
+
+	// DefaultProviderLimit is the number of jobs run at once for one provider
+	DefaultProviderLimit = 2
+)
+
+// ErrClosed is returned when a job is submitted to a closed pool
+var ErrClosed = errors.New("worker pool is closed")
+
+// Metrics receives the queue depth of a provider whenever it changes and the
+// processing time of every job. interfaces.MetricsClient satisfies it.
+type Metrics interface {
+	RecordQueueDepth(queue string, depth int)
+	RecordLatency(operation string, duration time.Duration)
+}
+
+// Config configures a Pool
+type Config struct {
+	Workers       int            // jobs run at once across all providers
+	ProviderLimit int            // jobs run at once per provider without an override
+	Limits        map[string]int // per-provider overrides of ProviderLimit
+}
+
+// ProviderStats describes the jobs of one provider
+type ProviderStats struct {
+	Provider  string         `json:"provider"`
+	Queued    int            `json:"queued"`
+	Lanes     map[string]int `json:"lanes"` // queued jobs by priority
+	Running   int            `json:"running"`
+	Limit     int            `json:"limit"`
+	Processed int64          `json:"processed"`
+	Restarts  int64          `json:"restarts"`  // workers restarted after a job panicked
+	LatencyMS float64        `json:"latencyMs"` // mean processing time of a job
+	MaxMS     float64        `json:"maxMs"`     // longest processing time of a job
+}
+
+// jobStats accumulates the processed jobs of one provider
+type jobStats struct {
+	processed int64
+	restarts  int64
+	total     time.Duration
+	max       time.Duration
+}
+
+// Pool runs submitted jobs on a fixed set of workers. A worker takes the next
+// job of the highest priority waiting, round-robin over the providers that are
+// below their limit, so jobs of one priority are scheduled fairly between
+// providers and in submission order within one.
+type Pool struct {
+	config    Config
+	metrics   Metrics
+	intercept func(provider string, job func()) func() // optional: wraps every submitted job
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	queues  map[string]*lanes
+	running map[string]int
+	stats   map[string]*jobStats
+	order   []string // providers in round-robin order
+	next    int      // position in order of the next provider to serve
+	queued  int
+	closed  bool
+	done    sync.WaitGroup
+}
+
+// New creates a pool and starts its workers
+func New(config Config) *Pool {
+	if config.Workers <= 0 {
+		config.Workers = DefaultWorkers
+	}
+	if config.ProviderLimit <= 0 {
+		config.ProviderLimit = DefaultProviderLimit
+	}
+
+	p := &Pool{
+		config:  config,
+		queues:  make(map[string]*lanes),
+		running: make(map[string]int),
+		stats:   make(map[string]*jobStats),
+	}
+	p.cond = sync.NewCond(&p.mu)
+
+	p.done.Add(config.Workers)
+	for i := 0; i < config.Workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+// WithMetrics reports queue depths to metrics
+func (p *Pool) WithMetrics(metrics Metrics) *Pool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.metrics = metrics
+	return p
+}
+
+// WithInterceptor wraps every job submitted afterwards, e.g. to inject faults
+func (p *Pool) WithInterceptor(intercept func(provider string, job func()) func()) *Pool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.intercept = intercept
+	return p
+}
+
+// Submit queues a job of a provider with PriorityScheduled. It never blocks;
+// callers wait for their jobs themselves, e.g. with a sync.WaitGroup.
+func (p *Pool) Submit(provider string, job func()) error {
+	return p.SubmitPriority(provider, PriorityScheduled, job)
+}
+
+// SubmitPriority queues a job of a provider in the lane of priority. It never
+// blocks.
+func (p *Pool) SubmitPriority(provider string, priority Priority, job func()) error {
+	if priority < PriorityBulk || priority > PriorityInteractive {
+		return fmt.Errorf("unknown job priority %d", priority)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return ErrClosed
+	}
+
+	if _, ok := p.queues[provider]; !ok {
+		p.order = append(p.order, provider)
+		p.queues[provider] = &lanes{}
+	}
+	if _, ok := p.stats[provider]; !ok {
+		p.stats[provider] = &jobStats{}
+	}
+	if p.intercept != nil {
+		job = p.intercept(provider, job)
+	}
+	p.queues[provider][priority] = append(p.queues[provider][priority], job)
+	p.queued++
+	p.recordDepth(provider)
+
+	p.cond.Signal()
+	return nil
+}
+
+// Stats returns the queued, running and processed jobs per provider, sorted by provider
+func (p *Pool) Stats() []ProviderStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make([]ProviderStats, 0, len(p.order))
+	for _, provider := range p.order {
+		jobs := p.stats[provider]
+		stat := ProviderStats{
+			Provider:  provider,
+			Queued:    p.queues[provider].len(),
+			Lanes:     p.queues[provider].depths(),
+			Running:   p.running[provider],
+			Limit:     p.limit(provider),
+			Processed: jobs.processed,
+			Restarts:  jobs.restarts,
+			MaxMS:     milliseconds(jobs.max),
+		}
+		if jobs.processed > 0 {
+			stat.LatencyMS = milliseconds(jobs.total) / float64(jobs.processed)
+		}
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Provider < stats[j].Provider })
+	return stats
+}
+
+// Close stops accepting jobs and waits until the queued ones have run
+func (p *Pool) Close() {
+	p.mu.Lock()
+	p.closed = true
+	p.cond.Broadcast()
+	p.mu.Unlock()
+
+	p.done.Wait()
+}
+
+func (p *Pool) work() {
+	defer p.done.Done()
+
+	for {
+		p.mu.Lock()
+		provider, job, ok := p.take()
+		for !ok {
+			if p.closed && p.queued == 0 {
+				p.mu.Unlock()
+				return
+			}
+			p.cond.Wait()
+			provider, job, ok = p.take()
+		}
+		p.mu.Unlock()
+
+		started := time.Now()
+		panicked := p.run(provider, job)
+		elapsed := time.Since(started)
+
+		p.mu.Lock()
+		jobs := p.stats[provider]
+		jobs.processed++
+		jobs.total += elapsed
+		jobs.max = max(jobs.max, elapsed)
+		if panicked {
+			jobs.restarts++
+		}
+		if p.metrics != nil {
+			p.metrics.RecordLatency(provider, elapsed)
+		}
+		p.running[provider]--
+		// A slot of this provider freed up; any idle worker may now take its next job
+		p.cond.Broadcast()
+		p.mu.Unlock()
+	}
+}
+
+// run runs a job, recovering a panic so it cannot take the worker down
+func (p *Pool) run(provider string, job func()) (panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			logger := internal.GetLogger()
+			logger.Error().
+				Str("provider", provider).
+				Err(fmt.Errorf("%v", r)).
+				Msg("Job panicked, worker restarted")
+		}
+	}()
+
+	job()
+	return false
+}
+
+// take dequeues the next job of the highest priority waiting, round-robin over
+// the providers below their limit. Must be called with mu held.
+func (p *Pool) take() (string, func(), bool) {
+	for priority := PriorityInteractive; priority >= PriorityBulk; priority-- {
+		for i := range p.order {
+			index := (p.next + i) % len(p.order)
+			provider := p.order[index]
+			queue := p.queues[provider][priority]
+			if len(queue) == 0 || p.running[provider] >= p.limit(provider) {
+				continue
+			}
+
+			job := queue[0]
+			p.queues[provider][priority] = queue[1:]
+			p.queued--
+			p.running[provider]++
+			p.next = index + 1
+			p.recordDepth(provider)
+			return provider, job, true
+		}
+	}
+	return "", nil, false
+}
+
+func (p *Pool) limit(provider string) int {
+	if limit, ok := p.config.Limits[provider]; ok && limit > 0 {
+		return limit
+	}
+	return p.config.ProviderLimit
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func (p *Pool) recordDepth(provider string) {
+	if p.metrics != nil {
+		p.metrics.RecordQueueDepth(provider, p.queues[provider].len())
+	}
+}
+
+// lanes holds the queued jobs of a provider by priority
+type lanes [priorities][]func()
+
+func (l *lanes) len() int {
+	n := 0
+	for _, queue := range l {
+		n += len(queue)
+	}
+	return n
+}
+
+func (l *lanes) depths() map[string]int {
+	depths := make(map[string]int, priorities)
+	for priority, queue := range l {
+		depths[Priority(priority).String()] = len(queue)
+	}
+	return depths
+}
@@ -0,0 +1,247 @@
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type recordingMetrics struct {
+	mu        sync.Mutex
+	depths    map[string]int
+	max       map[string]int
+	latencies int
+}
+
+func (m *recordingMetrics) RecordQueueDepth(queue string, depth int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.depths[queue] = depth
+	m.max[queue] = max(m.max[queue], depth)
+}
+
+func (m *recordingMetrics) RecordLatency(operation string, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latencies++
+}
+
+func TestPool_ProviderLimits(t *testing.T) {
+	pool := New(Config{Workers: 4, ProviderLimit: 1, Limits: map[string]int{"solana": 2}})
+	defer pool.Close()
+
+	var mu sync.Mutex
+	running := make(map[string]int)
+	peak := make(map[string]int)
+	var total, peakTotal int32
+
+	var wg sync.WaitGroup
+	for _, provider := range []string{"ethereum", "ethereum", "ethereum", "solana", "solana", "solana", "solana"} {
+		wg.Add(1)
+		provider := provider
+		err := pool.Submit(provider, func() {
+			defer wg.Done()
+
+			mu.Lock()
+			running[provider]++
+			peak[provider] = max(peak[provider], running[provider])
+			mu.Unlock()
+			now := atomic.AddInt32(&total, 1)
+			for {
+				seen := atomic.LoadInt32(&peakTotal)
+				if now <= seen || atomic.CompareAndSwapInt32(&peakTotal, seen, now) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+
+			atomic.AddInt32(&total, -1)
+			mu.Lock()
+			running[provider]--
+			mu.Unlock()
+		})
+		if err != nil {
+			t.Fatalf("Submit() returned unexpected error: %v", err)
+		}
+	}
+	wg.Wait()
+
+	if peak["ethereum"] != 1 {
+		t.Errorf("ethereum ran %d jobs at once, want 1", peak["ethereum"])
+	}
+	if peak["solana"] != 2 {
+		t.Errorf("solana ran %d jobs at once, want 2", peak["solana"])
+	}
+	if peakTotal > 4 {
+		t.Errorf("pool ran %d jobs at once, want at most 4", peakTotal)
+	}
+}
+
+func TestPool_FairScheduling(t *testing.T) {
+	pool := New(Config{Workers: 1, ProviderLimit: 1})
+	defer pool.Close()
+
+	// Hold the only worker so the whole backlog is queued before anything runs
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	pool.Submit("blocker", func() {
+		defer wg.Done()
+		<-release
+	})
+
+	var mu sync.Mutex
+	var order []string
+	for _, provider := range []string{"a", "a", "a", "b", "b"} {
+		wg.Add(1)
+		provider := provider
+		pool.Submit(provider, func() {
+			defer wg.Done()
+			mu.Lock()
+			order = append(order, provider)
+			mu.Unlock()
+		})
+	}
+	close(release)
+	wg.Wait()
+
+	want := []string{"a", "b", "a", "b", "a"}
+	for i := range want {
+		if i >= len(order) || order[i] != want[i] {
+			t.Fatalf("jobs ran in order %v, want %v", order, want)
+		}
+	}
+}
+
+func TestPool_PriorityLanes(t *testing.T) {
+	pool := New(Config{Workers: 1, ProviderLimit: 1})
+	defer pool.Close()
+
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	pool.Submit("blocker", func() {
+		defer wg.Done()
+		<-release
+	})
+
+	var mu sync.Mutex
+	var order []string
+	submit := func(name, provider string, priority Priority) {
+		wg.Add(1)
+		if err := pool.SubmitPriority(provider, priority, func() {
+			defer wg.Done()
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+		}); err != nil {
+			t.Fatalf("SubmitPriority() error = %v", err)
+		}
+	}
+	submit("backfill1", "a", PriorityBulk)
+	submit("backfill2", "a", PriorityBulk)
+	submit("cycle", "a", PriorityScheduled)
+	submit("now", "b", PriorityInteractive)
+	submit("backfill3", "b", PriorityBulk)
+
+	var queued ProviderStats
+	for _, stat := range pool.Stats() {
+		if stat.Provider == "b" {
+			queued = stat
+		}
+	}
+	if queued.Queued != 2 || queued.Lanes["interactive"] != 1 || queued.Lanes["bulk"] != 1 || queued.Lanes["scheduled"] != 0 {
+		t.Errorf("stats of b = %+v, want one interactive and one bulk job queued", queued)
+	}
+
+	close(release)
+	wg.Wait()
+
+	want := []string{"now", "cycle", "backfill3", "backfill1", "backfill2"}
+	for i := range want {
+		if i >= len(order) || order[i] != want[i] {
+			t.Fatalf("jobs ran in order %v, want %v", order, want)
+		}
+	}
+
+	if err := pool.SubmitPriority("a", Priority(7), func() {}); err == nil {
+		t.Error("SubmitPriority() with an unknown priority error = nil")
+	}
+}
+
+func TestPriorityFrom(t *testing.T) {
+	ctx := context.Background()
+	if got := PriorityFrom(ctx); got != PriorityScheduled {
+		t.Errorf("PriorityFrom() = %v without a priority, want scheduled", got)
+	}
+	if got := PriorityFrom(WithPriority(ctx, PriorityInteractive)); got != PriorityInteractive {
+		t.Errorf("PriorityFrom() = %v, want interactive", got)
+	}
+}
+
+func TestPool_CloseDrainsQueue(t *testing.T) {
+	metrics := &recordingMetrics{depths: make(map[string]int), max: make(map[string]int)}
+	pool := New(Config{Workers: 1}).WithMetrics(metrics)
+
+	var ran int32
+	for i := 0; i < 5; i++ {
+		pool.Submit("ethereum", func() {
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&ran, 1)
+		})
+	}
+	pool.Close()
+
+	if ran != 5 {
+		t.Errorf("%d jobs ran before Close returned, want 5", ran)
+	}
+	if err := pool.Submit("ethereum", func() {}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Submit() after Close error = %v, want ErrClosed", err)
+	}
+	if metrics.depths["ethereum"] != 0 || metrics.max["ethereum"] == 0 {
+		t.Errorf("recorded queue depth = %d (max %d), want 0 after a non-empty queue", metrics.depths["ethereum"], metrics.max["ethereum"])
+	}
+	if metrics.latencies != 5 {
+		t.Errorf("recorded %d latencies, want 5", metrics.latencies)
+	}
+}
+
+func TestPool_RecoversPanics(t *testing.T) {
+	pool := New(Config{Workers: 1})
+
+	pool.Submit("solana", func() { panic("boom") })
+	pool.Submit("solana", func() { time.Sleep(time.Millisecond) })
+	pool.Close()
+
+	stats := pool.Stats()
+	if len(stats) != 1 {
+		t.Fatalf("expected stats for 1 provider, got %d", len(stats))
+	}
+	if stats[0].Processed != 2 || stats[0].Restarts != 1 {
+		t.Errorf("processed %d jobs with %d restarts, want 2 and 1", stats[0].Processed, stats[0].Restarts)
+	}
+	if stats[0].LatencyMS <= 0 || stats[0].MaxMS < stats[0].LatencyMS {
+		t.Errorf("latency = %vms (max %vms)", stats[0].LatencyMS, stats[0].MaxMS)
+	}
+}
+
+func TestPool_Interceptor(t *testing.T) {
+	var wrapped []string
+	pool := New(Config{Workers: 1}).WithInterceptor(func(provider string, job func()) func() {
+		wrapped = append(wrapped, provider)
+		return job
+	})
+
+	ran := 0
+	pool.Submit("ethereum", func() { ran++ })
+	pool.Submit("solana", func() { ran++ })
+	pool.Close()
+
+	if ran != 2 || len(wrapped) != 2 || wrapped[0] != "ethereum" {
+		t.Errorf("ran %d jobs, wrapped %v; want 2 jobs wrapped in submission order", ran, wrapped)
+	}
+}
@@ -0,0 +1,50 @@
+package workerpool
+
+import "context"
+
+// Priority orders the jobs waiting in a pool. A worker takes the jobs of the
+// highest priority first, so interactive requests jump ahead of scheduled
+// cycles and bulk backfills. Running jobs are never preempted.
+type Priority int
+
+const (
+	// PriorityBulk is for long-running work such as history backfills
+	PriorityBulk Priority = iota
+
+	// PriorityScheduled is for scheduled import cycles, the default
+	PriorityScheduled
+
+	// PriorityInteractive is for imports a user is waiting for
+	PriorityInteractive
+
+	priorities = int(PriorityInteractive) + 1
+)
+
+// String returns the name of the priority
+func (p Priority) String() string {
+	switch p {
+	case PriorityBulk:
+		return "bulk"
+	case PriorityScheduled:
+		return "scheduled"
+	case PriorityInteractive:
+		return "interactive"
+	}
+	return "unknown"
+}
+
+type priorityKey struct{}
+
+// WithPriority returns a context whose jobs are submitted with priority
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFrom returns the priority of a context, PriorityScheduled unless
+// WithPriority set one
+func PriorityFrom(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityKey{}).(Priority); ok && priority >= PriorityBulk && priority <= PriorityInteractive {
+		return priority
+	}
+	return PriorityScheduled
+}
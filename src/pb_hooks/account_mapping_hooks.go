@@ -0,0 +1,18 @@
+package pb_hooks
+
+import (
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// RegisterAccountMappingHooks drops cached account resolutions whenever a mapping changes
+func RegisterAccountMappingHooks(app *pocketbase.PocketBase, accounts *usecases.AccountMappingService) {
+	invalidate := func(e *core.ModelEvent) error {
+		accounts.Invalidate()
+		return e.Next()
+	}
+
+	app.OnModelAfterUpdateSuccess("account_mappings").BindFunc(invalidate)
+	app.OnModelAfterDeleteSuccess("account_mappings").BindFunc(invalidate)
+}
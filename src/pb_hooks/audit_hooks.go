@@ -0,0 +1,102 @@
+package pb_hooks
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/hook"
+)
+
+// auditedCollections are the domain collections whose changes are audited
+var auditedCollections = []string{
+	"transactions", "wallets", "categories", "tags", "transformation_rules",
+	"account_mappings", "spaces", "space_members",
+}
+
+// auditHookPriority runs the audit hooks before the other hooks of a
+// collection, so a hook ending the chain early cannot skip the audit
+const auditHookPriority = -100
+
+// RegisterAuditHooks records every create, update and delete of the audited
+// collections in the audit log, and keeps the log append-only. Changes are
+// attributed to the origin in the context they were saved with; record API
+// requests save without one, so the authenticated user of the request is
+// kept aside while their record is saved.
+func RegisterAuditHooks(app *pocketbase.PocketBase, audit *usecases.AuditService) {
+	var requests sync.Map // *core.Record -> models.AuditOrigin
+
+	attribute := func(e *core.RecordRequestEvent) error {
+		origin := models.AuditOrigin{ActorType: models.AuditActorUser, Source: models.AuditSourceAPI}
+		if e.Auth != nil {
+			origin.ActorID = e.Auth.Id
+			if e.Auth.IsSuperuser() {
+				origin.ActorType = models.AuditActorSuperuser
+			}
+		}
+
+		requests.Store(e.Record, origin)
+		defer requests.Delete(e.Record)
+		return e.Next()
+	}
+
+	app.OnRecordCreateRequest(auditedCollections...).Bind(&hook.Handler[*core.RecordRequestEvent]{Func: attribute, Priority: auditHookPriority})
+	app.OnRecordUpdateRequest(auditedCollections...).Bind(&hook.Handler[*core.RecordRequestEvent]{Func: attribute, Priority: auditHookPriority})
+	app.OnRecordDeleteRequest(auditedCollections...).Bind(&hook.Handler[*core.RecordRequestEvent]{Func: attribute, Priority: auditHookPriority})
+
+	record := func(action models.AuditAction) func(e *core.ModelEvent) error {
+		return func(e *core.ModelEvent) error {
+			record, ok := e.Model.(*core.Record)
+			if !ok {
+				return e.Next()
+			}
+
+			ctx := e.Context
+			if origin, ok := requests.Load(record); ok {
+				ctx = models.WithAuditOrigin(ctx, origin.(models.AuditOrigin))
+			}
+
+			var before, after map[string]any
+			switch action {
+			case models.AuditActionCreate:
+				after = record.PublicExport()
+			case models.AuditActionUpdate:
+				before, after = record.Original().PublicExport(), record.PublicExport()
+			case models.AuditActionDelete:
+				before = record.PublicExport()
+			}
+			audit.RecordChange(ctx, action, record.Collection().Name, record.Id, before, after)
+
+			return e.Next()
+		}
+	}
+
+	app.OnModelAfterCreateSuccess(auditedCollections...).Bind(&hook.Handler[*core.ModelEvent]{Func: record(models.AuditActionCreate), Priority: auditHookPriority})
+	app.OnModelAfterUpdateSuccess(auditedCollections...).Bind(&hook.Handler[*core.ModelEvent]{Func: record(models.AuditActionUpdate), Priority: auditHookPriority})
+	app.OnModelAfterDeleteSuccess(auditedCollections...).Bind(&hook.Handler[*core.ModelEvent]{Func: record(models.AuditActionDelete), Priority: auditHookPriority})
+
+	// The log is append-only and written by the server alone: entries are only
+	// removed by the retention purge, which deletes them directly in the database
+	app.OnRecordCreateRequest("audit_log").BindFunc(func(e *core.RecordRequestEvent) error {
+		return e.Error(http.StatusForbidden, "Audit log entries are recorded by the server.", nil)
+	})
+
+	app.OnRecordUpdateRequest("audit_log").BindFunc(func(e *core.RecordRequestEvent) error {
+		return e.Error(http.StatusForbidden, "Audit log entries cannot be changed.", nil)
+	})
+
+	app.OnRecordDeleteRequest("audit_log").BindFunc(func(e *core.RecordRequestEvent) error {
+		return e.Error(http.StatusForbidden, "Audit log entries cannot be deleted.", nil)
+	})
+
+	app.OnModelUpdate("audit_log").BindFunc(func(e *core.ModelEvent) error {
+		return models.ErrAuditLogAppendOnly
+	})
+
+	app.OnModelDelete("audit_log").BindFunc(func(e *core.ModelEvent) error {
+		return models.ErrAuditLogAppendOnly
+	})
+}
@@ -0,0 +1,43 @@
+package pb_hooks
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// RegisterConcurrencyHooks enforces optimistic concurrency on record API updates.
+// Clients send the version they read; a mismatch is rejected with 409 Conflict
+// so the client can reload and merge. Requests without a version keep
+// last-write-wins semantics, but still bump the version.
+func RegisterConcurrencyHooks(app *pocketbase.PocketBase) {
+	collections := []string{"transactions", "wallets", "categories"}
+
+	app.OnRecordCreateRequest(collections...).BindFunc(func(e *core.RecordRequestEvent) error {
+		e.Record.Set("version", 1)
+		return e.Next()
+	})
+
+	app.OnRecordUpdateRequest(collections...).BindFunc(func(e *core.RecordRequestEvent) error {
+		info, err := e.RequestInfo()
+		if err != nil {
+			return err
+		}
+
+		current := e.Record.Original().GetInt("version")
+
+		// The submitted body is already loaded into the record at this point
+		if _, ok := info.Body["version"]; ok {
+			if expected := e.Record.GetInt("version"); expected != current {
+				return e.Error(http.StatusConflict,
+					fmt.Sprintf("The record was modified by someone else (version %d, expected %d).", current, expected),
+					map[string]any{"currentVersion": current})
+			}
+		}
+
+		e.Record.Set("version", current+1)
+		return e.Next()
+	})
+}
@@ -0,0 +1,83 @@
+package pb_hooks
+
+import (
+	"context"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// RegisterEncryptionHooks keeps the sensitive transaction fields encrypted
+// when they are written through the record API, and decrypts them in the
+// records the API returns. The API only returns records the user may view,
+// so authorized users never see ciphertext.
+func RegisterEncryptionHooks(app *pocketbase.PocketBase, cipher repositories.FieldCipher) {
+	logger := internal.GetLogger().With().Str("hooks", "encryption").Logger()
+
+	seal := func(e *core.RecordRequestEvent) error {
+		spaceID := ""
+		if wallet, err := e.App.FindRecordById("wallets", e.Record.GetString("wallet")); err == nil {
+			spaceID = wallet.GetString("space")
+		}
+
+		for _, field := range []string{"description", "notes"} {
+			value, err := cipher.EncryptField(e.Request.Context(), spaceID, e.Record.GetString(field))
+			if err != nil {
+				return e.InternalServerError("Failed to encrypt the transaction.", err)
+			}
+			e.Record.Set(field, value)
+		}
+
+		metadata := map[string]string{}
+		if err := e.Record.UnmarshalJSONField("metadata", &metadata); err == nil && len(metadata) > 0 {
+			for _, key := range models.EncryptedMetadataKeys {
+				if metadata[key] == "" {
+					continue
+				}
+				value, err := cipher.EncryptField(e.Request.Context(), spaceID, metadata[key])
+				if err != nil {
+					return e.InternalServerError("Failed to encrypt the transaction.", err)
+				}
+				metadata[key] = value
+			}
+			e.Record.Set("metadata", metadata)
+		}
+
+		return e.Next()
+	}
+
+	app.OnRecordCreateRequest("transactions").BindFunc(seal)
+	app.OnRecordUpdateRequest("transactions").BindFunc(seal)
+
+	app.OnRecordEnrich("transactions").BindFunc(func(e *core.RecordEnrichEvent) error {
+		ctx := context.Background()
+		for _, field := range []string{"description", "notes"} {
+			value, err := cipher.DecryptField(ctx, e.Record.GetString(field))
+			if err != nil {
+				logger.Warn().Err(err).Str("transactionID", e.Record.Id).Str("field", field).Msg("Failed to decrypt field")
+				continue
+			}
+			e.Record.Set(field, value)
+		}
+
+		metadata := map[string]string{}
+		if err := e.Record.UnmarshalJSONField("metadata", &metadata); err == nil && len(metadata) > 0 {
+			for _, key := range models.EncryptedMetadataKeys {
+				value, err := cipher.DecryptField(ctx, metadata[key])
+				if err != nil {
+					logger.Warn().Err(err).Str("transactionID", e.Record.Id).Str("field", "metadata."+key).Msg("Failed to decrypt field")
+					continue
+				}
+				if value != "" {
+					metadata[key] = value
+				}
+			}
+			e.Record.Set("metadata", metadata)
+		}
+
+		return e.Next()
+	})
+}
@@ -0,0 +1,265 @@
+package pb_hooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/ZanzyTHEbar/firedragon-go/internal/events"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+	"github.com/rs/zerolog"
+)
+
+// eventSource identifies events emitted by these hooks
+const eventSource = "pocketbase"
+
+// RegisterEventHooks stores domain events whenever transactions or wallet balances change
+// and when provider incidents open or close. Every stored import cycle report is
+// published on its own subject, import.report.<cycle_id>. Detected subscriptions are
+// announced when first stored, when a new charge costs more and when a charge is missed.
+// Balance assertion checks that fail are announced with their drift.
+// Events are written to the outbox in the same database transaction as the change, so a
+// change is never committed without its events; the relay publishes them after the commit
+// and is woken for every new event. The relay may be nil while NATS is unreachable, in
+// which case events wait in the outbox. Events are likewise queued for every enabled
+// webhook whose event types match, and the webhook service is woken after the commit.
+// Incidents, subscription changes, failed balance
+// assertions and large transactions are also published as notifications to the users
+// whose preferences ask for them, on notification.<user_id>; defaults are the
+// preferences of users who stored none.
+// Source lifecycle changes and consent renewals are announced by the source sync
+// service through the queue, as their states need not be stored in PocketBase.
+func RegisterEventHooks(app *pocketbase.PocketBase, queue *EventQueue) {
+	sinks := queue.sinks
+
+	// transactional runs the write of a record in a transaction and stores the
+	// events built from the written record within it. The record still holds its
+	// previous values in Original().
+	transactional := func(build func(record *core.Record) []*interfaces.Event) func(e *core.RecordEvent) error {
+		return func(e *core.RecordEvent) error {
+			originalApp := e.App
+			err := e.App.RunInTransaction(func(txApp core.App) error {
+				e.App = txApp
+				if err := e.Next(); err != nil {
+					return err
+				}
+
+				for _, event := range build(e.Record) {
+					if err := queue.store(e.Context, txApp, event); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			e.App = originalApp
+
+			return err
+		}
+	}
+
+	recordEvent := func(eventType interfaces.EventType) func(record *core.Record) []*interfaces.Event {
+		return func(record *core.Record) []*interfaces.Event {
+			event := interfaces.NewEvent(eventType, eventSource).WithTarget(record.Id)
+			event.Data = record.PublicExport()
+			return []*interfaces.Event{event}
+		}
+	}
+
+	app.OnRecordCreateExecute("transactions").BindFunc(transactional(recordEvent(interfaces.EventTypeTransactionCreated)))
+	app.OnRecordUpdateExecute("transactions").BindFunc(transactional(recordEvent(interfaces.EventTypeTransactionUpdated)))
+	app.OnRecordDeleteExecute("transactions").BindFunc(transactional(recordEvent(interfaces.EventTypeTransactionDeleted)))
+
+	app.OnRecordUpdateExecute("wallets").BindFunc(transactional(func(record *core.Record) []*interfaces.Event {
+		previous := record.Original().GetFloat("balance")
+		balance := record.GetFloat("balance")
+		if previous == balance {
+			return nil
+		}
+
+		return []*interfaces.Event{interfaces.NewEvent(interfaces.EventTypeWalletBalanceChanged, eventSource).
+			WithTarget(record.Id).
+			WithData("walletId", record.Id).
+			WithData("currency", record.GetString("currency")).
+			WithData("previousBalance", previous).
+			WithData("balance", balance).
+			WithData("delta", balance-previous)}
+	}))
+
+	app.OnRecordCreateExecute("incidents").BindFunc(transactional(recordEvent(interfaces.EventTypeIncidentOpened)))
+
+	app.OnRecordUpdateExecute("incidents").BindFunc(transactional(func(record *core.Record) []*interfaces.Event {
+		if record.Original().GetDateTime("ended_at").IsZero() && !record.GetDateTime("ended_at").IsZero() {
+			return recordEvent(interfaces.EventTypeIncidentClosed)(record)
+		}
+		return nil
+	}))
+
+	app.OnRecordCreateExecute("subscriptions").BindFunc(transactional(recordEvent(interfaces.EventTypeSubscriptionDetected)))
+
+	app.OnRecordUpdateExecute("subscriptions").BindFunc(transactional(func(record *core.Record) []*interfaces.Event {
+		var changes []*interfaces.Event
+		original := record.Original()
+		subscription := models.Subscription{Amount: record.GetFloat("amount"), PreviousAmount: record.GetFloat("previous_amount")}
+		if record.GetDateTime("last_charge_at").After(original.GetDateTime("last_charge_at")) && subscription.PriceIncrease() > 0 {
+			changes = append(changes, recordEvent(interfaces.EventTypeSubscriptionPriceIncreased)(record)...)
+		}
+		if record.GetString("status") == string(models.SubscriptionMissed) && original.GetString("status") != string(models.SubscriptionMissed) {
+			changes = append(changes, recordEvent(interfaces.EventTypeSubscriptionMissed)(record)...)
+		}
+		return changes
+	}))
+
+	app.OnRecordCreateExecute("balance_assertion_results").BindFunc(transactional(func(record *core.Record) []*interfaces.Event {
+		if record.GetBool("passed") {
+			return nil
+		}
+		return recordEvent(interfaces.EventTypeBalanceAssertionFailed)(record)
+	}))
+
+	app.OnRecordCreateExecute("import_runs").BindFunc(transactional(func(record *core.Record) []*interfaces.Event {
+		return recordEvent(interfaces.ImportReportEventType(record.GetString("cycle_id")))(record)
+	}))
+
+	// Wake the relay and the webhook service once the events of a change have committed
+	app.OnModelAfterCreateSuccess("event_outbox").BindFunc(func(e *core.ModelEvent) error {
+		if sinks.Relay != nil {
+			sinks.Relay.Notify()
+		}
+		return e.Next()
+	})
+	app.OnModelAfterCreateSuccess("webhook_deliveries").BindFunc(func(e *core.ModelEvent) error {
+		if sinks.Webhooks != nil {
+			sinks.Webhooks.Notify()
+		}
+		return e.Next()
+	})
+}
+
+// EventSinks are where the event hooks store the events of a change
+type EventSinks struct {
+	Outbox   bool                     // store events in the outbox published to NATS
+	Relay    *events.OutboxRelay      // woken after events were stored, nil while NATS is unreachable
+	Webhooks *usecases.WebhookService // queue events for the registered webhooks, nil when disabled
+}
+
+// EventQueue stores events with the notifications they cause in the sinks.
+// The event hooks store the events of a record change in its transaction;
+// services announce changes of state kept outside PocketBase with Publish.
+type EventQueue struct {
+	app      core.App
+	sinks    EventSinks
+	defaults models.Preferences
+	logger   zerolog.Logger
+}
+
+// NewEventQueue creates an EventQueue storing events in the sinks. defaults
+// are the preferences of users who stored none.
+func NewEventQueue(app core.App, sinks EventSinks, defaults models.Preferences) *EventQueue {
+	return &EventQueue{
+		app:      app,
+		sinks:    sinks,
+		defaults: defaults,
+		logger:   internal.GetLogger().With().Str("hooks", "events").Logger(),
+	}
+}
+
+// Publish stores an event and its notifications in a transaction of their own
+func (q *EventQueue) Publish(ctx context.Context, event *interfaces.Event) error {
+	return q.app.RunInTransaction(func(txApp core.App) error {
+		return q.store(ctx, txApp, event)
+	})
+}
+
+// store stores an event and its notifications through app, the transaction
+// of the change
+func (q *EventQueue) store(ctx context.Context, app core.App, event *interfaces.Event) error {
+	events.Correlate(ctx, event)
+	if err := q.queue(app, event); err != nil {
+		return err
+	}
+	// A notification failing to resolve must not hold back the change
+	notes, err := notifications(app, q.defaults, event)
+	if err != nil {
+		q.logger.Warn().Err(err).Str("event", string(event.Type)).Msg("Failed to resolve notifications")
+	}
+	for _, note := range notes {
+		events.Correlate(ctx, note)
+		if err := q.queue(app, note); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queue stores an event in the outbox and the webhook deliveries through app
+func (q *EventQueue) queue(app core.App, event *interfaces.Event) error {
+	payload, err := events.Encode(event)
+	if err != nil {
+		return err
+	}
+
+	if q.sinks.Outbox {
+		collection, err := app.FindCachedCollectionByNameOrId("event_outbox")
+		if err != nil {
+			return fmt.Errorf("failed to find event_outbox collection: %w", err)
+		}
+		record := core.NewRecord(collection)
+		record.Set("event_id", event.ID)
+		record.Set("type", string(event.Type))
+		record.Set("payload", types.JSONRaw(payload))
+		if err := app.Save(record); err != nil {
+			return fmt.Errorf("failed to store %s event: %w", event.Type, err)
+		}
+	}
+
+	if q.sinks.Webhooks != nil {
+		return queueWebhookDeliveries(app, event, payload)
+	}
+	return nil
+}
+
+// queueWebhookDeliveries stores a delivery of an event for every enabled
+// webhook whose event types match, through app, the transaction of the change
+func queueWebhookDeliveries(app core.App, event *interfaces.Event, payload []byte) error {
+	webhooks, err := app.FindAllRecords("webhooks", dbx.HashExp{"enabled": true})
+	if err != nil {
+		return fmt.Errorf("failed to find webhooks: %w", err)
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	collection, err := app.FindCachedCollectionByNameOrId("webhook_deliveries")
+	if err != nil {
+		return fmt.Errorf("failed to find webhook_deliveries collection: %w", err)
+	}
+	now := time.Now()
+	for _, record := range webhooks {
+		webhook := models.Webhook{}
+		if err := record.UnmarshalJSONField("events", &webhook.Events); err != nil {
+			return fmt.Errorf("failed to decode events of webhook %s: %w", record.Id, err)
+		}
+		if !webhook.Matches(string(event.Type)) {
+			continue
+		}
+
+		delivery := core.NewRecord(collection)
+		delivery.Set("webhook", record.Id)
+		delivery.Set("event_id", event.ID)
+		delivery.Set("event_type", string(event.Type))
+		delivery.Set("payload", types.JSONRaw(payload))
+		delivery.Set("status", string(models.WebhookDeliveryPending))
+		delivery.Set("next_attempt_at", now)
+		if err := app.Save(delivery); err != nil {
+			return fmt.Errorf("failed to queue %s event for webhook %s: %w", event.Type, record.Id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package pb_hooks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/interfaces"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+// notificationKinds are the events users can be notified about
+var notificationKinds = map[interfaces.EventType]models.NotificationKind{
+	interfaces.EventTypeIncidentOpened:             models.NotificationIncident,
+	interfaces.EventTypeIncidentClosed:             models.NotificationIncident,
+	interfaces.EventTypeSubscriptionDetected:       models.NotificationSubscription,
+	interfaces.EventTypeSubscriptionPriceIncreased: models.NotificationSubscription,
+	interfaces.EventTypeSubscriptionMissed:         models.NotificationSubscription,
+	interfaces.EventTypeTransactionCreated:         models.NotificationLargeTransaction,
+	interfaces.EventTypeBalanceAssertionFailed:     models.NotificationBalanceDrift,
+	interfaces.EventTypeConsentRenewalRequested:    models.NotificationConsent,
+}
+
+// notifications returns a notification of an event for every user whose
+// preferences ask for it, published on the user's own subject. Events of a
+// wallet in a space only reach the members of the space. Users without
+// stored preferences get the defaults.
+func notifications(app core.App, defaults models.Preferences, event *interfaces.Event) ([]*interfaces.Event, error) {
+	kind, ok := notificationKinds[event.Type]
+	if !ok {
+		return nil, nil
+	}
+	walletID, _ := event.Data["wallet"].(string)
+	amount, _ := event.Data["amount"].(float64)
+
+	// New transactions are frequent; skip the lookups when nobody has a threshold
+	if kind == models.NotificationLargeTransaction && !defaults.Notifications.Wants(kind, amount) {
+		count, err := app.CountRecords("preferences", dbx.NewExp("notify_large_transactions > 0"))
+		if err != nil || count == 0 {
+			return nil, err
+		}
+	}
+
+	users, err := notificationUsers(app, walletID)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := app.FindAllRecords("preferences")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find preferences: %w", err)
+	}
+	byUser := make(map[string]*core.Record, len(stored))
+	for _, record := range stored {
+		byUser[record.GetString("user")] = record
+	}
+
+	var result []*interfaces.Event
+	for _, userID := range users {
+		preferences := defaults
+		if record, ok := byUser[userID]; ok {
+			preferences.DateFormat = models.DateFormat(record.GetString("date_format"))
+			preferences.Notifications = models.NotificationSettings{
+				Incidents:         record.GetBool("notify_incidents"),
+				Subscriptions:     record.GetBool("notify_subscriptions"),
+				BalanceDrift:      record.GetBool("notify_balance_drift"),
+				Consent:           record.GetBool("notify_consent"),
+				LargeTransactions: record.GetFloat("notify_large_transactions"),
+			}
+		}
+		if !preferences.Notifications.Wants(kind, amount) {
+			continue
+		}
+
+		notification := interfaces.NewEvent(interfaces.NotificationEventType(userID), eventSource).
+			WithTarget(event.Target).
+			WithData("kind", string(kind)).
+			WithData("event", string(event.Type)).
+			WithData("data", event.Data)
+		if date := eventDate(event); !date.IsZero() {
+			layout := preferences.DateFormat.Layout()
+			if layout == "" {
+				layout = time.DateOnly
+			}
+			notification.WithData("date", date.Format(layout))
+		}
+		result = append(result, notification)
+	}
+	return result, nil
+}
+
+// eventDate returns the date of an event, set by record hooks as a
+// types.DateTime and by services as a time.Time
+func eventDate(event *interfaces.Event) time.Time {
+	switch date := event.Data["date"].(type) {
+	case types.DateTime:
+		return date.Time()
+	case time.Time:
+		return date
+	}
+	return time.Time{}
+}
+
+// notificationUsers returns the users who see a wallet: the members of its
+// space, or every user for wallets outside any space and events of no wallet
+func notificationUsers(app core.App, walletID string) ([]string, error) {
+	if walletID != "" {
+		wallet, err := app.FindRecordById("wallets", walletID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find wallet %s: %w", walletID, err)
+		}
+		if space := wallet.GetString("space"); space != "" {
+			members, err := app.FindAllRecords("space_members", dbx.HashExp{"space": space})
+			if err != nil {
+				return nil, fmt.Errorf("failed to find members of space %s: %w", space, err)
+			}
+			users := make([]string, 0, len(members))
+			for _, member := range members {
+				users = append(users, member.GetString("user"))
+			}
+			return users, nil
+		}
+	}
+
+	records, err := app.FindAllRecords("users")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users: %w", err)
+	}
+	users := make([]string, 0, len(records))
+	for _, record := range records {
+		users = append(users, record.Id)
+	}
+	return users, nil
+}
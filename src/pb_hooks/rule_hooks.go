@@ -0,0 +1,27 @@
+package pb_hooks
+
+import (
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// RegisterRuleHooks rejects transformation rules whose script does not compile
+func RegisterRuleHooks(app *pocketbase.PocketBase, rules *usecases.RuleService) {
+	validate := func(e *core.RecordEvent) error {
+		rule := &models.TransformationRule{
+			Name:   e.Record.GetString("name"),
+			Source: e.Record.GetString("source"),
+			Script: e.Record.GetString("script"),
+		}
+		if err := rules.ValidateRule(rule); err != nil {
+			return err
+		}
+
+		return e.Next()
+	}
+
+	app.OnRecordCreate("transformation_rules").BindFunc(validate)
+	app.OnRecordUpdate("transformation_rules").BindFunc(validate)
+}
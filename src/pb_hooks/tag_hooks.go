@@ -0,0 +1,49 @@
+package pb_hooks
+
+import (
+	"net/http"
+
+	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
+	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// RegisterTagHooks keeps tag entities and transaction tag references consistent.
+// Renames and deletes must go through the tag routes, which rewrite the
+// referencing transactions atomically.
+func RegisterTagHooks(app *pocketbase.PocketBase, tags *usecases.TagService) {
+	logger := internal.GetLogger().With().Str("hooks", "tags").Logger()
+
+	app.OnRecordUpdateRequest("tags").BindFunc(func(e *core.RecordRequestEvent) error {
+		if e.Record.GetString("name") != e.Record.Original().GetString("name") {
+			return e.Error(http.StatusBadRequest, "Use POST /api/firedragon/tags/{id}/rename to rename a tag.", nil)
+		}
+		return e.Next()
+	})
+
+	app.OnRecordDeleteRequest("tags").BindFunc(func(e *core.RecordRequestEvent) error {
+		return e.Error(http.StatusBadRequest, "Use DELETE /api/firedragon/tags/{id} to delete a tag.", nil)
+	})
+
+	// Create tag entities for tags first seen on a transaction
+	ensure := func(e *core.ModelEvent) error {
+		record, ok := e.Model.(*core.Record)
+		if !ok {
+			return e.Next()
+		}
+
+		names := []string{}
+		if err := record.UnmarshalJSONField("tags", &names); err == nil && len(names) > 0 {
+			if err := tags.EnsureTags(models.WithAuditSource(e.Context, models.AuditSourceHook), names); err != nil {
+				logger.Warn().Err(err).Str("transactionID", record.Id).Msg("Failed to create tags")
+			}
+		}
+
+		return e.Next()
+	}
+
+	app.OnModelAfterCreateSuccess("transactions").BindFunc(ensure)
+	app.OnModelAfterUpdateSuccess("transactions").BindFunc(ensure)
+}
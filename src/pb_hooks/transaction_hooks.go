@@ -1,154 +1,138 @@
 package pb_hooks
 
 import (
-	"log" // Keep standard log for now
+	"context"
+	"fmt"
+	"sync"
 
-	// Import necessary domain packages
 	"github.com/ZanzyTHEbar/firedragon-go/domain/models"
-	"github.com/ZanzyTHEbar/firedragon-go/domain/repositories"
-	"github.com/ZanzyTHEbar/firedragon-go/domain/usecases"
-
-	// Import PocketBase packages
+	"github.com/ZanzyTHEbar/firedragon-go/internal"
 	"github.com/pocketbase/pocketbase"
 	"github.com/pocketbase/pocketbase/core"
 )
 
-// RegisterTransactionHooks registers simplified transaction hooks.
-// It accepts repository dependencies (currently unused in this simplified version).
-func RegisterTransactionHooks(
-	app *pocketbase.PocketBase,
-	walletRepo repositories.WalletRepository,
-	categoryRepo repositories.CategoryRepository,
-	transactionRepo repositories.TransactionRepository,
-) {
-	log.Println("[INFO] Registering simplified PocketBase transaction hooks...")
-
-	// Create transaction service
-	transactionService := usecases.NewTransactionService(walletRepo, categoryRepo, transactionRepo)
-
-	// Use Model Hook: OnModelCreate with BindFunc and filter by collection name
-	app.OnModelCreate("transactions").BindFunc(func(e *core.ModelEvent) error {
-		log.Printf("[Hook OnModelBeforeCreate] Triggered for transactions")
-		
-		// Validate transaction record
-		// TODO: Add validation before creating transaction
-		
-		return nil
-	})
+// RegisterTransactionHooks keeps wallet balances in step with the transactions
+// written through the records API, e.g. in the dashboard. Those writes bypass
+// the transaction service, so their balance effects are applied here, in the
+// same DB transaction as the write: a create applies the effects of the new
+// transaction, an update swaps the effects of the previous values for the new
+// ones and a delete reverses them. Saves of the repositories are not requests:
+// the services making them apply the effects themselves. Wallets created
+// through the records API open at their balance, as those of the repository.
+func RegisterTransactionHooks(app *pocketbase.PocketBase) {
+	logger := internal.GetLogger().With().Str("hooks", "transactions").Logger()
 
-	// Use Model Hook: OnModelUpdate with BindFunc and filter by collection name
-	app.OnModelUpdate("transactions").BindFunc(func(e *core.ModelEvent) error {
-		record, ok := e.Model.(*core.Record)
-		if !ok {
-			return nil
+	app.OnRecordCreateRequest("wallets").BindFunc(func(e *core.RecordRequestEvent) error {
+		if e.Record.GetFloat("opening_balance") == 0 {
+			e.Record.Set("opening_balance", e.Record.GetFloat("balance"))
 		}
-		log.Printf("[Hook OnModelBeforeUpdate] Triggered for transactions ID: %s", record.Id)
-		
-		// Validate transaction update
-		// TODO: Add validation before updating transaction
-		
-		return nil
+		return e.Next()
 	})
 
-	// Use Model Hook: OnModelDelete with BindFunc and filter by collection name
-	app.OnModelDelete("transactions").BindFunc(func(e *core.ModelEvent) error {
-		record, ok := e.Model.(*core.Record)
-		if !ok {
-			return nil
-		}
-		log.Printf("[Hook OnModelBeforeDelete] Triggered for transactions ID: %s", record.Id)
-		
-		// TODO: Implement transaction deletion logic
-		
-		return nil
-	})
+	var requests sync.Map // *core.Record of a record API request -> struct{}
 
-	// Use Model Hook: OnModelAfterCreateSuccess with BindFunc and filter by collection name
-	app.OnModelAfterCreateSuccess("transactions").BindFunc(func(e *core.ModelEvent) error {
-		record, ok := e.Model.(*core.Record)
-		if !ok {
-			return nil
-		}
-		log.Printf("[Hook OnModelAfterCreateSuccess] Triggered for transactions ID: %s", record.Id)
-		
-		// Convert PocketBase record to domain model input
-		input := mapRecordToTransactionInput(record)
-		
-		// Process transaction using service
-		_, err := transactionService.CreateTransaction(input)
-		if err != nil {
-			log.Printf("[ERROR] Failed to process transaction: %v", err)
+	mark := func(e *core.RecordRequestEvent) error {
+		requests.Store(e.Record, struct{}{})
+		defer requests.Delete(e.Record)
+		return e.Next()
+	}
+
+	app.OnRecordCreateRequest("transactions").BindFunc(mark)
+	app.OnRecordUpdateRequest("transactions").BindFunc(mark)
+	app.OnRecordDeleteRequest("transactions").BindFunc(mark)
+
+	// balanced runs the write of a record API request and adds the balance
+	// changes it makes, keyed by wallet ID, to the wallets within one DB transaction
+	balanced := func(changes func(record *core.Record) map[string]float64) func(e *core.RecordEvent) error {
+		return func(e *core.RecordEvent) error {
+			if _, ok := requests.Load(e.Record); !ok {
+				return e.Next()
+			}
+
+			originalApp := e.App
+			err := e.App.RunInTransaction(func(txApp core.App) error {
+				e.App = txApp
+				if err := e.Next(); err != nil {
+					return err
+				}
+
+				for walletID, delta := range changes(e.Record) {
+					if err := adjustBalance(e.Context, txApp, walletID, delta); err != nil {
+						return fmt.Errorf("failed to apply transaction %s: %w", e.Record.Id, err)
+					}
+				}
+				return nil
+			})
+			e.App = originalApp
+
+			if err != nil {
+				logger.Error().Err(err).Str("transactionID", e.Record.Id).Msg("Failed to write transaction")
+			}
 			return err
 		}
-		
-		log.Printf("[INFO] Successfully processed transaction ID: %s", record.Id)
-		return nil
-	})
+	}
+
+	app.OnRecordCreateExecute("transactions").BindFunc(balanced(func(record *core.Record) map[string]float64 {
+		return balanceEffects(record)
+	}))
 
-	// Use Model Hook: OnModelAfterUpdateSuccess with BindFunc and filter by collection name
-	app.OnModelAfterUpdateSuccess("transactions").BindFunc(func(e *core.ModelEvent) error {
-		record, ok := e.Model.(*core.Record)
-		if !ok {
-			return nil
+	app.OnRecordUpdateExecute("transactions").BindFunc(balanced(func(record *core.Record) map[string]float64 {
+		changes := balanceEffects(record)
+		for walletID, delta := range balanceEffects(record.Original()) {
+			changes[walletID] -= delta
 		}
-		log.Printf("[Hook OnModelAfterUpdateSuccess] Triggered for transactions ID: %s", record.Id)
-		
-		// TODO: Implement transaction update logic using service
-		
-		return nil
-	})
+		return changes
+	}))
 
-	log.Println("[INFO] PocketBase transaction hooks registration attempt complete.")
+	app.OnRecordDeleteExecute("transactions").BindFunc(balanced(func(record *core.Record) map[string]float64 {
+		changes := make(map[string]float64)
+		for walletID, delta := range balanceEffects(record) {
+			changes[walletID] = -delta
+		}
+		return changes
+	}))
+
+	logger.Info().Msg("Registered transaction hooks")
 }
 
-// mapRecordToTransactionInput converts a PocketBase record to a CreateTransactionInput
-func mapRecordToTransactionInput(record *core.Record) usecases.CreateTransactionInput {
-	// Extract required data from the record
-	amount := record.GetFloat("amount")
-	description := record.GetString("description")
-	date := record.GetDateTime("date").Time()
-	txType := models.TransactionType(record.GetString("type"))
-	categoryID := record.GetString("category")
-	walletID := record.GetString("wallet")
-	
-	// Initialize optional fields
-	var destWalletID string
-	var exchangeRate float64
-	
-	// Handle optional fields based on transaction type
-	if txType == models.TransactionTypeTransfer {
-		// For transfer transactions, get destination wallet if available
-		destWalletID = record.GetString("destination_wallet")
-		
-		// Get exchange rate or use default value of 1.0
-		exchangeRate = record.GetFloat("exchange_rate")
-		if exchangeRate == 0 {
-			// Default exchange rate for transfers is 1.0 if not specified or invalid
-			exchangeRate = 1.0
-		}
+// balanceEffects returns the changes a transaction record makes to the wallet
+// balances, keyed by wallet ID; the fields they depend on are never encrypted
+func balanceEffects(record *core.Record) map[string]float64 {
+	tx := &models.Transaction{
+		Amount:    record.GetFloat("amount"),
+		Fee:       record.GetFloat("fee"),
+		Type:      models.TransactionType(record.GetString("type")),
+		Status:    models.TransactionStatus(record.GetString("status")),
+		WalletID:  record.GetString("wallet"),
+		DeletedAt: record.GetDateTime("deleted_at").Time(),
 	}
-	
-	// Handle tags if available
-	var tags []string
-	tagValues := record.Get("tags")
-	if tagSlice, ok := tagValues.([]any); ok {
-		for _, tag := range tagSlice {
-			if tagStr, ok := tag.(string); ok && tagStr != "" {
-				tags = append(tags, tagStr)
-			}
-		}
+	if tx.Type == models.TransactionTypeTransfer {
+		tx.DestWalletID = record.GetString("destination_wallet")
+		tx.ExchangeRate = record.GetFloat("exchange_rate")
 	}
-	
-	// Create input struct with all available fields
-	return usecases.CreateTransactionInput{
-		Amount:       amount,
-		Description:  description,
-		Date:         date,
-		Type:         txType,
-		CategoryID:   categoryID,
-		WalletID:     walletID,
-		DestWalletID: destWalletID,
-		ExchangeRate: exchangeRate,
-		Tags:         tags,
+
+	effects := make(map[string]float64)
+	for walletID, delta := range tx.BalanceEffects() {
+		effects[walletID] += delta
+	}
+	return effects
+}
+
+// adjustBalance adds delta to a wallet balance and bumps its version
+func adjustBalance(ctx context.Context, txApp core.App, walletID string, delta float64) error {
+	if walletID == "" || delta == 0 {
+		return nil
+	}
+
+	wallet, err := txApp.FindRecordById("wallets", walletID)
+	if err != nil {
+		return fmt.Errorf("failed to find wallet %s: %w", walletID, err)
+	}
+
+	wallet.Set("balance", wallet.GetFloat("balance")+delta)
+	wallet.Set("version", wallet.GetInt("version")+1)
+	if err := txApp.SaveWithContext(ctx, wallet); err != nil {
+		return fmt.Errorf("failed to update balance of wallet %s: %w", walletID, err)
 	}
+	return nil
 }
@@ -0,0 +1,93 @@
+package pb_hooks
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	_ "github.com/ZanzyTHEbar/firedragon-go/pb_migrations" // the app migrations, applied by the test app
+	"github.com/pocketbase/pocketbase"
+	"github.com/pocketbase/pocketbase/apis"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tests"
+)
+
+// Creates, updates and deletes through the records API move the wallet balance
+func TestTransactionHooks_RecordAPIWritesMoveBalances(t *testing.T) {
+	testApp, err := tests.NewTestAppWithConfig(core.BaseAppConfig{DataDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewTestAppWithConfig() error = %v", err)
+	}
+	t.Cleanup(testApp.Cleanup)
+	app := &pocketbase.PocketBase{App: testApp}
+	RegisterTransactionHooks(app)
+
+	superusers, _ := app.FindCollectionByNameOrId(core.CollectionNameSuperusers)
+	superuser := core.NewRecord(superusers)
+	superuser.SetEmail("admin@example.com")
+	superuser.SetPassword("1234567890")
+	if err := app.Save(superuser); err != nil {
+		t.Fatalf("failed to create superuser: %v", err)
+	}
+	token, err := superuser.NewAuthToken()
+	if err != nil {
+		t.Fatalf("NewAuthToken() error = %v", err)
+	}
+
+	wallets, _ := app.FindCollectionByNameOrId("wallets")
+	wallet := core.NewRecord(wallets)
+	wallet.Load(map[string]any{"name": "Checking", "currency": "EUR", "type": "bank", "balance": 100})
+	if err := app.Save(wallet); err != nil {
+		t.Fatalf("failed to create wallet: %v", err)
+	}
+	category, err := app.FindFirstRecordByFilter("categories", "")
+	if err != nil {
+		t.Fatalf("failed to find a category: %v", err)
+	}
+
+	send := func(method, url, body string) (int, string) {
+		t.Helper()
+		r, err := apis.NewRouter(app)
+		if err != nil {
+			t.Fatalf("NewRouter() error = %v", err)
+		}
+		mux, err := r.BuildMux()
+		if err != nil {
+			t.Fatalf("BuildMux() error = %v", err)
+		}
+		request := httptest.NewRequest(method, url, strings.NewReader(body))
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set("Authorization", token)
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, request)
+		response, _ := io.ReadAll(recorder.Result().Body)
+		return recorder.Code, string(response)
+	}
+	balance := func() float64 {
+		t.Helper()
+		record, err := app.FindRecordById("wallets", wallet.Id)
+		if err != nil {
+			t.Fatalf("failed to read wallet: %v", err)
+		}
+		return record.GetFloat("balance")
+	}
+
+	records := "/api/collections/transactions/records"
+	status, body := send(http.MethodPost, records, fmt.Sprintf(`{"id": "coffee000000001", "amount": 5, "description": "Coffee", "type": "expense",
+		"status": "completed", "date": "2025-03-01 00:00:00Z", "category": %q, "wallet": %q}`, category.Id, wallet.Id))
+	if status != http.StatusOK || balance() != 95 {
+		t.Fatalf("create = %d %s, balance %v; want 200 and 95", status, body, balance())
+	}
+
+	if status, body := send(http.MethodPatch, records+"/coffee000000001", `{"amount": 8}`); status != http.StatusOK || balance() != 92 {
+		t.Fatalf("update = %d %s, balance %v; want 200 and 92", status, body, balance())
+	}
+
+	if status, body := send(http.MethodDelete, records+"/coffee000000001", ""); status != http.StatusNoContent || balance() != 100 {
+		t.Fatalf("delete = %d %s, balance %v; want 204 and 100", status, body, balance())
+	}
+
+}
@@ -6,85 +6,82 @@ import (
 	"github.com/pocketbase/pocketbase/tools/types"
 )
 
+// Initial schema migration for FireDragon
 func init() {
 	m.Register(func(app core.App) error {
-		// Create transactions collection
-		transactions := core.NewCollection("transactions", core.CollectionTypeBase)
+		// Create wallets collection first, transactions relate to it by its ID
+		wallets := core.NewBaseCollection("wallets")
 
 		// Add fields
-		transactions.Fields.Add(
-			&core.NumberField{
-				Name:     "amount",
-				Required: true,
-				Min:      types.Pointer(0.0),
-			},
+		wallets.Fields.Add(
 			&core.TextField{
-				Name:     "description",
+				Name:     "name",
 				Required: true,
 			},
-			&core.DateField{
-				Name:     "date",
+			&core.NumberField{
+				Name:     "balance",
 				Required: true,
+				Min:      types.Pointer(0.0),
 			},
 			&core.TextField{
-				Name:     "category",
-				Required: true,
-			},
-			&core.SelectField{
-				Name:     "type",
-				Required: true,
-				Values:   []string{"income", "expense", "transfer"},
-				MaxSelect: 1,
-			},
-			&core.RelationField{
-				Name:     "wallet",
+				Name:     "currency",
 				Required: true,
-				CollectionId: "wallets",
-				MaxSelect: 1,
 			},
 			&core.SelectField{
-				Name:     "status",
-				Required: true,
-				Values:   []string{"pending", "completed", "failed"},
+				Name:      "type",
+				Required:  true,
+				Values:    []string{"bank", "crypto", "cash"},
 				MaxSelect: 1,
 			},
 		)
 
-		if err := app.Save(transactions); err != nil {
+		if err := app.Save(wallets); err != nil {
 			return err
 		}
 
-		// Create wallets collection
-		wallets := core.NewCollection("wallets", core.CollectionTypeBase)
+		// Create transactions collection
+		transactions := core.NewBaseCollection("transactions")
 
 		// Add fields
-		wallets.Fields.Add(
+		transactions.Fields.Add(
+			&core.NumberField{
+				Name:     "amount",
+				Required: true,
+				Min:      types.Pointer(0.0),
+			},
 			&core.TextField{
-				Name:     "name",
+				Name:     "description",
 				Required: true,
 			},
-			&core.NumberField{
-				Name:     "balance",
+			&core.DateField{
+				Name:     "date",
 				Required: true,
-				Min:      types.Pointer(0.0),
 			},
 			&core.TextField{
-				Name:     "currency",
+				Name:     "category",
 				Required: true,
 			},
 			&core.SelectField{
-				Name:     "type",
-				Required: true,
-				Values:   []string{"bank", "crypto", "cash"},
+				Name:      "type",
+				Required:  true,
+				Values:    []string{"income", "expense", "transfer"},
+				MaxSelect: 1,
+			},
+			&core.RelationField{
+				Name:         "wallet",
+				Required:     true,
+				CollectionId: wallets.Id,
+				MaxSelect:    1,
+			},
+			&core.SelectField{
+				Name:      "status",
+				Required:  true,
+				Values:    []string{"pending", "completed", "failed"},
 				MaxSelect: 1,
 			},
 		)
 
-		if err := app.Save(wallets); err != nil {
-			return err
-		}
-
-		return nil
+		return app.Save(transactions)
 	}, func(app core.App) error {
 		// Delete collections (in reverse order to handle relations)
 		collections := []string{"transactions", "wallets"}
@@ -100,4 +97,4 @@ func init() {
 
 		return nil
 	})
-} 
\ No newline at end of file
+}
@@ -7,6 +7,11 @@ import (
 
 func init() {
 	m.Register(func(app core.App) error {
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
 		// Get the transactions collection
 		collection, err := app.FindCollectionByNameOrId("transactions")
 		if err != nil {
@@ -18,7 +23,7 @@ func init() {
 			&core.RelationField{
 				Name:         "destination_wallet",
 				Required:     false, // Only required for transfers
-				CollectionId: "wallets",
+				CollectionId: wallets.Id,
 				MaxSelect:    1,
 			},
 		)
@@ -35,6 +40,11 @@ func init() {
 
 		return app.Save(collection)
 	}, func(app core.App) error {
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
 		// Revert changes by deleting the collection and recreating it
 		collection, err := app.FindCollectionByNameOrId("transactions")
 		if err != nil {
@@ -46,7 +56,7 @@ func init() {
 		}
 
 		// Create a new collection without the fields
-		newCollection := core.NewCollection("transactions", core.CollectionTypeBase)
+		newCollection := core.NewBaseCollection("transactions")
 		newCollection.Fields.Add(
 			&core.NumberField{
 				Name:     "amount",
@@ -73,7 +83,7 @@ func init() {
 			&core.RelationField{
 				Name:         "wallet",
 				Required:    true,
-				CollectionId: "wallets",
+				CollectionId: wallets.Id,
 				MaxSelect:   1,
 			},
 			&core.SelectField{
@@ -7,8 +7,13 @@ import (
 
 func init() {
 	m.Register(func(app core.App) error {
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
 		// Create categories collection
-		categories := core.NewCollection("categories", core.CollectionTypeBase)
+		categories := core.NewBaseCollection("categories")
 
 		// Add fields
 		categories.Fields.Add(
@@ -30,11 +35,8 @@ func init() {
 				Name:     "color",
 				Required: false,
 			},
-			&core.SelectField{
-				Name:      "is_system",
-				Required:  true,
-				Values:    []string{"true", "false"},
-				MaxSelect: 1,
+			&core.BoolField{
+				Name: "is_system",
 			},
 		)
 
@@ -53,7 +55,7 @@ func init() {
 		}
 
 		// Recreate transactions collection with updated fields
-		newTransactions := core.NewCollection("transactions", core.CollectionTypeBase)
+		newTransactions := core.NewBaseCollection("transactions")
 		newTransactions.Fields.Add(
 			&core.NumberField{
 				Name:     "amount",
@@ -77,13 +79,13 @@ func init() {
 			&core.RelationField{
 				Name:         "wallet",
 				Required:     true,
-				CollectionId: "wallets",
+				CollectionId: wallets.Id,
 				MaxSelect:    1,
 			},
 			&core.RelationField{
 				Name:         "destination_wallet",
 				Required:     false,
-				CollectionId: "wallets",
+				CollectionId: wallets.Id,
 				MaxSelect:    1,
 			},
 			&core.NumberField{
@@ -94,7 +96,7 @@ func init() {
 			&core.RelationField{
 				Name:         "category",
 				Required:     true,
-				CollectionId: "categories",
+				CollectionId: categories.Id,
 				MaxSelect:    1,
 			},
 			&core.SelectField{
@@ -107,6 +109,11 @@ func init() {
 
 		return app.Save(newTransactions)
 	}, func(app core.App) error {
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
 		// Delete the categories collection
 		categories, err := app.FindCollectionByNameOrId("categories")
 		if err != nil {
@@ -128,7 +135,7 @@ func init() {
 		}
 
 		// Recreate transactions collection with original fields
-		newTransactions := core.NewCollection("transactions", core.CollectionTypeBase)
+		newTransactions := core.NewBaseCollection("transactions")
 		newTransactions.Fields.Add(
 			&core.NumberField{
 				Name:     "amount",
@@ -156,13 +163,13 @@ func init() {
 			&core.RelationField{
 				Name:         "wallet",
 				Required:     true,
-				CollectionId: "wallets",
+				CollectionId: wallets.Id,
 				MaxSelect:    1,
 			},
 			&core.RelationField{
 				Name:         "destination_wallet",
 				Required:     false,
-				CollectionId: "wallets",
+				CollectionId: wallets.Id,
 				MaxSelect:    1,
 			},
 			&core.NumberField{
@@ -20,7 +20,7 @@ func init() {
 			description string
 			type_       string
 			color       string
-			is_system   string
+			is_system   bool
 		}{
 			// Income categories
 			{
@@ -28,21 +28,21 @@ func init() {
 				description: "Regular employment income",
 				type_:       "income",
 				color:       "#4CAF50", // Green
-				is_system:   "true",
+				is_system:   true,
 			},
 			{
 				name:        "Investment",
 				description: "Income from investments",
 				type_:       "income",
 				color:       "#2196F3", // Blue
-				is_system:   "true",
+				is_system:   true,
 			},
 			{
 				name:        "Other Income",
 				description: "Miscellaneous income",
 				type_:       "income",
 				color:       "#9C27B0", // Purple
-				is_system:   "true",
+				is_system:   true,
 			},
 
 			// Expense categories
@@ -51,49 +51,49 @@ func init() {
 				description: "Rent, mortgage, and housing expenses",
 				type_:       "expense",
 				color:       "#F44336", // Red
-				is_system:   "true",
+				is_system:   true,
 			},
 			{
 				name:        "Transportation",
 				description: "Car, public transport, and travel expenses",
 				type_:       "expense",
 				color:       "#FF9800", // Orange
-				is_system:   "true",
+				is_system:   true,
 			},
 			{
 				name:        "Food",
 				description: "Groceries and dining out",
 				type_:       "expense",
 				color:       "#795548", // Brown
-				is_system:   "true",
+				is_system:   true,
 			},
 			{
 				name:        "Utilities",
 				description: "Electricity, water, internet, etc.",
 				type_:       "expense",
 				color:       "#607D8B", // Blue Grey
-				is_system:   "true",
+				is_system:   true,
 			},
 			{
 				name:        "Healthcare",
 				description: "Medical and health-related expenses",
 				type_:       "expense",
 				color:       "#E91E63", // Pink
-				is_system:   "true",
+				is_system:   true,
 			},
 			{
 				name:        "Entertainment",
 				description: "Recreation and entertainment expenses",
 				type_:       "expense",
 				color:       "#673AB7", // Deep Purple
-				is_system:   "true",
+				is_system:   true,
 			},
 			{
 				name:        "Other Expenses",
 				description: "Miscellaneous expenses",
 				type_:       "expense",
 				color:       "#757575", // Grey
-				is_system:   "true",
+				is_system:   true,
 			},
 
 			// Transfer categories
@@ -102,14 +102,14 @@ func init() {
 				description: "Transfer between own accounts",
 				type_:       "transfer",
 				color:       "#009688", // Teal
-				is_system:   "true",
+				is_system:   true,
 			},
 			{
 				name:        "External Transfer",
 				description: "Transfer to external accounts",
 				type_:       "transfer",
 				color:       "#00BCD4", // Cyan
-				is_system:   "true",
+				is_system:   true,
 			},
 		}
 
@@ -138,7 +138,7 @@ func init() {
 		// Find and delete all system categories
 		records := []*core.Record{}
 		err = app.RecordQuery(collection.Name).
-			AndWhere(dbx.HashExp{"is_system": "true"}).
+			AndWhere(dbx.HashExp{"is_system": true}).
 			All(&records)
 
 		if err != nil {
@@ -7,15 +7,30 @@ import (
 
 func init() {
 	m.Register(func(app core.App) error {
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
 		// Create transaction history collection
-		collection := core.NewCollection("transaction_history", core.CollectionTypeBase)
+		collection := core.NewBaseCollection("transaction_history")
 
 		// Add fields
 		collection.Fields.Add(
 			&core.RelationField{
 				Name:         "transaction",
 				Required:     true,
-				CollectionId: "transactions",
+				CollectionId: transactions.Id,
 				MaxSelect:    1,
 			},
 			&core.SelectField{
@@ -31,7 +46,7 @@ func init() {
 			&core.RelationField{
 				Name:         "performed_by",
 				Required:     false,
-				CollectionId: "users",
+				CollectionId: users.Id,
 				MaxSelect:    1,
 			},
 			&core.DateField{
@@ -53,13 +68,13 @@ func init() {
 			&core.RelationField{
 				Name:         "wallet",
 				Required:     true,
-				CollectionId: "wallets",
+				CollectionId: wallets.Id,
 				MaxSelect:    1,
 			},
 			&core.RelationField{
 				Name:         "destination_wallet",
 				Required:     false,
-				CollectionId: "wallets",
+				CollectionId: wallets.Id,
 				MaxSelect:    1,
 			},
 			&core.NumberField{
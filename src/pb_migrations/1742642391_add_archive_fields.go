@@ -0,0 +1,75 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Add archive fields to wallets
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
+		wallets.Fields.Add(
+			&core.BoolField{
+				Name:     "archived",
+				Required: false,
+			},
+			&core.DateField{
+				Name:     "archived_at",
+				Required: false,
+			},
+		)
+
+		wallets.AddIndex("idx_wallets_archived", false, "archived", "")
+
+		if err := app.Save(wallets); err != nil {
+			return err
+		}
+
+		// Add soft-delete marker to transactions
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.Fields.Add(
+			&core.DateField{
+				Name:     "deleted_at",
+				Required: false,
+			},
+		)
+
+		transactions.AddIndex("idx_transactions_deleted_at", false, "deleted_at", "")
+
+		return app.Save(transactions)
+	}, func(app core.App) error {
+		// Remove archive fields from wallets
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
+		wallets.RemoveIndex("idx_wallets_archived")
+		wallets.Fields.RemoveByName("archived")
+		wallets.Fields.RemoveByName("archived_at")
+
+		if err := app.Save(wallets); err != nil {
+			return err
+		}
+
+		// Remove soft-delete marker from transactions
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.RemoveIndex("idx_transactions_deleted_at")
+		transactions.Fields.RemoveByName("deleted_at")
+
+		return app.Save(transactions)
+	})
+}
@@ -0,0 +1,63 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
+		// Create balance snapshots collection
+		collection := core.NewBaseCollection("balance_snapshots")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.RelationField{
+				Name:          "wallet",
+				Required:      true,
+				CollectionId:  wallets.Id,
+				MaxSelect:     1,
+				CascadeDelete: true,
+			},
+			&core.NumberField{
+				Name:     "balance",
+				Required: false,
+				Min:      nil,
+				Max:      nil,
+			},
+			&core.TextField{
+				Name:     "currency",
+				Required: true,
+			},
+			&core.TextField{
+				Name:     "source",
+				Required: true,
+			},
+			&core.DateField{
+				Name:     "taken_at",
+				Required: true,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE INDEX idx_balance_snapshots_wallet_taken_at ON balance_snapshots (wallet, taken_at)",
+			"CREATE INDEX idx_balance_snapshots_taken_at ON balance_snapshots (taken_at)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("balance_snapshots")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,63 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create transformation rules collection
+		collection := core.NewBaseCollection("transformation_rules")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.TextField{
+				Name:     "name",
+				Required: true,
+			},
+			&core.TextField{
+				Name:     "source",
+				Required: false,
+			},
+			&core.TextField{
+				Name:     "script",
+				Required: true,
+				Max:      10000,
+			},
+			&core.NumberField{
+				Name:     "priority",
+				Required: false,
+				OnlyInt:  true,
+			},
+			&core.BoolField{
+				Name:     "enabled",
+				Required: false,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+			&core.AutodateField{
+				Name:     "updated",
+				OnCreate: true,
+				OnUpdate: true,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE INDEX idx_transformation_rules_source ON transformation_rules (source, priority)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("transformation_rules")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,61 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create Firefly account mappings collection
+		collection := core.NewBaseCollection("account_mappings")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.TextField{
+				Name:     "source",
+				Required: true,
+			},
+			&core.TextField{
+				Name:     "source_account",
+				Required: true,
+			},
+			&core.TextField{
+				Name:     "firefly_account_id",
+				Required: false,
+			},
+			&core.TextField{
+				Name:     "iban",
+				Required: false,
+			},
+			&core.BoolField{
+				Name:     "auto_create",
+				Required: false,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+			&core.AutodateField{
+				Name:     "updated",
+				OnCreate: true,
+				OnUpdate: true,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_account_mappings_source_account ON account_mappings (source, source_account)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("account_mappings")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,37 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Link local transactions to their Firefly III counterpart
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.Fields.Add(
+			&core.TextField{
+				Name:     "firefly_id",
+				Required: false,
+			},
+		)
+
+		transactions.AddIndex("idx_transactions_firefly_id", false, "firefly_id", "")
+
+		return app.Save(transactions)
+	}, func(app core.App) error {
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.RemoveIndex("idx_transactions_firefly_id")
+		transactions.Fields.RemoveByName("firefly_id")
+
+		return app.Save(transactions)
+	})
+}
@@ -0,0 +1,49 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+// versionedCollections use a version field for optimistic concurrency control
+var versionedCollections = []string{"transactions", "wallets", "categories"}
+
+func init() {
+	m.Register(func(app core.App) error {
+		for _, name := range versionedCollections {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+
+			collection.Fields.Add(
+				&core.NumberField{
+					Name:     "version",
+					Required: false,
+					OnlyInt:  true,
+				},
+			)
+
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(app core.App) error {
+		for _, name := range versionedCollections {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+
+			collection.Fields.RemoveByName("version")
+
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,44 @@
+package pb_migrations
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Allow transaction merges to be recorded in the history
+		collection, err := app.FindCollectionByNameOrId("transaction_history")
+		if err != nil {
+			return err
+		}
+
+		action, ok := collection.Fields.GetByName("action").(*core.SelectField)
+		if !ok {
+			return fmt.Errorf("transaction_history.action is not a select field")
+		}
+		if !slices.Contains(action.Values, "merged") {
+			action.Values = append(action.Values, "merged")
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		collection, err := app.FindCollectionByNameOrId("transaction_history")
+		if err != nil {
+			return err
+		}
+
+		action, ok := collection.Fields.GetByName("action").(*core.SelectField)
+		if !ok {
+			return fmt.Errorf("transaction_history.action is not a select field")
+		}
+		action.Values = slices.DeleteFunc(action.Values, func(value string) bool {
+			return value == "merged"
+		})
+
+		return app.Save(collection)
+	})
+}
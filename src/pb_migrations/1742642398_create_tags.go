@@ -0,0 +1,82 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create tags collection
+		collection := core.NewBaseCollection("tags")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.TextField{
+				Name:     "name",
+				Required: true,
+				Max:      100,
+			},
+			&core.TextField{
+				Name:     "color",
+				Required: false,
+				Max:      20,
+			},
+			&core.TextField{
+				Name:     "description",
+				Required: false,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+			&core.AutodateField{
+				Name:     "updated",
+				OnCreate: true,
+				OnUpdate: true,
+			},
+		)
+
+		// Tag names are unique regardless of case
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_tags_name ON tags (name COLLATE NOCASE)",
+		}
+
+		if err := app.Save(collection); err != nil {
+			return err
+		}
+
+		// Store transaction tags as a JSON array of tag names
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.Fields.Add(
+			&core.JSONField{
+				Name:     "tags",
+				Required: false,
+			},
+		)
+
+		return app.Save(transactions)
+	}, func(app core.App) error {
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.Fields.RemoveByName("tags")
+		if err := app.Save(transactions); err != nil {
+			return err
+		}
+
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("tags")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,41 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Add free-text notes and provider metadata to transactions
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.Fields.Add(
+			&core.TextField{
+				Name:     "notes",
+				Required: false,
+				Max:      10000,
+			},
+			&core.JSONField{
+				Name:     "metadata",
+				Required: false,
+				MaxSize:  64 * 1024,
+			},
+		)
+
+		return app.Save(transactions)
+	}, func(app core.App) error {
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.Fields.RemoveByName("notes")
+		transactions.Fields.RemoveByName("metadata")
+
+		return app.Save(transactions)
+	})
+}
@@ -0,0 +1,50 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create secrets collection. It has no API rules, so only superusers can access it.
+		collection := core.NewBaseCollection("secrets")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.TextField{
+				Name:     "name",
+				Required: true,
+				Max:      100,
+			},
+			&core.TextField{
+				Name:     "value", // encrypted
+				Required: true,
+				Hidden:   true,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+			&core.AutodateField{
+				Name:     "updated",
+				OnCreate: true,
+				OnUpdate: true,
+			},
+		)
+
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_secrets_name ON secrets (name)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("secrets")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,36 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Record network and processing fees paid on top of the transaction amount
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.Fields.Add(
+			&core.NumberField{
+				Name:     "fee",
+				Required: false,
+				Min:      types.Pointer(0.0),
+			},
+		)
+
+		return app.Save(transactions)
+	}, func(app core.App) error {
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.Fields.RemoveByName("fee")
+
+		return app.Save(transactions)
+	})
+}
@@ -0,0 +1,36 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Keep every balance type a bank reports (closingBooked, interimAvailable, ...)
+		// apart in the snapshot history
+		snapshots, err := app.FindCollectionByNameOrId("balance_snapshots")
+		if err != nil {
+			return err
+		}
+
+		snapshots.Fields.Add(
+			&core.TextField{
+				Name:     "balance_type",
+				Required: false,
+				Max:      32,
+			},
+		)
+
+		return app.Save(snapshots)
+	}, func(app core.App) error {
+		snapshots, err := app.FindCollectionByNameOrId("balance_snapshots")
+		if err != nil {
+			return err
+		}
+
+		snapshots.Fields.RemoveByName("balance_type")
+
+		return app.Save(snapshots)
+	})
+}
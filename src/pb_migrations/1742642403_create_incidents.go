@@ -0,0 +1,62 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create provider incidents collection
+		collection := core.NewBaseCollection("incidents")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.TextField{
+				Name:     "provider",
+				Required: true,
+				Max:      200,
+			},
+			&core.TextField{
+				Name:     "error_class",
+				Required: false,
+				Max:      50,
+			},
+			&core.TextField{
+				Name:     "last_error",
+				Required: false,
+			},
+			&core.NumberField{
+				Name:     "failures",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.DateField{
+				Name:     "started_at",
+				Required: true,
+			},
+			&core.DateField{
+				Name:     "ended_at",
+				Required: false,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE INDEX idx_incidents_provider_ended_at ON incidents (provider, ended_at)",
+			"CREATE INDEX idx_incidents_started_at ON incidents (started_at)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("incidents")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
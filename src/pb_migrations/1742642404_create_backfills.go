@@ -0,0 +1,82 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create source backfill checkpoints collection
+		collection := core.NewBaseCollection("backfills")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.TextField{
+				Name:     "source_id",
+				Required: true,
+				Max:      300,
+			},
+			&core.SelectField{
+				Name:      "status",
+				Required:  true,
+				MaxSelect: 1,
+				Values:    []string{"running", "failed", "completed"},
+			},
+			&core.TextField{
+				Name:     "cursor",
+				Required: false,
+			},
+			&core.NumberField{
+				Name:     "pages",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.NumberField{
+				Name:     "imported",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.NumberField{
+				Name:     "progress",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				Max:      types.Pointer(100.0),
+			},
+			&core.TextField{
+				Name:     "error",
+				Required: false,
+			},
+			&core.DateField{
+				Name:     "started_at",
+				Required: true,
+			},
+			&core.DateField{
+				Name:     "updated_at",
+				Required: true,
+			},
+			&core.DateField{
+				Name:     "completed_at",
+				Required: false,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_backfills_source_id ON backfills (source_id)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("backfills")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
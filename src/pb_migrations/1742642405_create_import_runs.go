@@ -0,0 +1,69 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create import cycle reports collection
+		collection := core.NewBaseCollection("import_runs")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.TextField{
+				Name:     "cycle_id",
+				Required: true,
+				Max:      100,
+			},
+			&core.DateField{
+				Name:     "started_at",
+				Required: true,
+			},
+			&core.DateField{
+				Name:     "finished_at",
+				Required: false,
+			},
+			&core.NumberField{
+				Name:     "imported",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.NumberField{
+				Name:     "failed",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.NumberField{
+				Name:     "snapshots",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.JSONField{
+				Name:     "sources",
+				Required: false,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_import_runs_cycle_id ON import_runs (cycle_id)",
+			"CREATE INDEX idx_import_runs_started_at ON import_runs (started_at)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("import_runs")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,68 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create source sync state collection
+		collection := core.NewBaseCollection("source_states")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.TextField{
+				Name:     "source_id",
+				Required: true,
+				Max:      300,
+			},
+			&core.NumberField{
+				Name:     "runs",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.NumberField{
+				Name:     "failures",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.NumberField{
+				Name:     "imported",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.TextField{
+				Name:     "last_error",
+				Required: false,
+			},
+			&core.DateField{
+				Name:     "last_run_at",
+				Required: false,
+			},
+			&core.DateField{
+				Name:     "last_success_at",
+				Required: false,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_source_states_source_id ON source_states (source_id)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("source_states")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,44 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Store the payment references banks report in remittance information
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.Fields.Add(
+			&core.TextField{
+				Name:     "reference",
+				Required: false,
+				Max:      140,
+			},
+			&core.TextField{
+				Name:     "end_to_end_id",
+				Required: false,
+				Max:      35,
+			},
+		)
+
+		transactions.AddIndex("idx_transactions_end_to_end_id", false, "wallet, end_to_end_id", "")
+
+		return app.Save(transactions)
+	}, func(app core.App) error {
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		transactions.RemoveIndex("idx_transactions_end_to_end_id")
+		transactions.Fields.RemoveByName("reference")
+		transactions.Fields.RemoveByName("end_to_end_id")
+
+		return app.Save(transactions)
+	})
+}
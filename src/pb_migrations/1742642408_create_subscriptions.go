@@ -0,0 +1,111 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
+		// Create detected subscriptions collection
+		collection := core.NewBaseCollection("subscriptions")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.RelationField{
+				Name:          "wallet",
+				Required:      true,
+				CollectionId:  wallets.Id,
+				MaxSelect:     1,
+				CascadeDelete: true,
+			},
+			&core.TextField{
+				Name:     "merchant",
+				Required: true,
+				Max:      300,
+			},
+			&core.TextField{
+				Name:     "name",
+				Required: false,
+				Max:      300,
+			},
+			&core.TextField{
+				Name:     "category",
+				Required: false,
+				Max:      50,
+			},
+			&core.SelectField{
+				Name:      "interval",
+				Required:  true,
+				Values:    []string{"weekly", "monthly", "quarterly", "yearly"},
+				MaxSelect: 1,
+			},
+			&core.NumberField{
+				Name:     "amount",
+				Required: false,
+				Min:      types.Pointer(0.0),
+			},
+			&core.NumberField{
+				Name:     "previous_amount",
+				Required: false,
+				Min:      types.Pointer(0.0),
+			},
+			&core.NumberField{
+				Name:     "monthly_cost",
+				Required: false,
+				Min:      types.Pointer(0.0),
+			},
+			&core.NumberField{
+				Name:     "charges",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.DateField{
+				Name:     "first_charge_at",
+				Required: true,
+			},
+			&core.DateField{
+				Name:     "last_charge_at",
+				Required: true,
+			},
+			&core.DateField{
+				Name:     "next_charge_at",
+				Required: true,
+			},
+			&core.SelectField{
+				Name:      "status",
+				Required:  true,
+				Values:    []string{"active", "missed"},
+				MaxSelect: 1,
+			},
+			&core.AutodateField{
+				Name:     "updated",
+				OnCreate: true,
+				OnUpdate: true,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_subscriptions_wallet_merchant ON subscriptions (wallet, merchant)",
+			"CREATE INDEX idx_subscriptions_status ON subscriptions (status)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("subscriptions")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
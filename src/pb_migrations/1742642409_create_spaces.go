@@ -0,0 +1,137 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create spaces collection
+		spaces := core.NewBaseCollection("spaces")
+
+		// Add fields
+		spaces.Fields.Add(
+			&core.TextField{
+				Name:     "name",
+				Required: true,
+				Max:      100,
+			},
+			&core.SelectField{
+				Name:      "kind",
+				Required:  true,
+				Values:    []string{"household", "personal", "business"},
+				MaxSelect: 1,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+			&core.AutodateField{
+				Name:     "updated",
+				OnCreate: true,
+				OnUpdate: true,
+			},
+		)
+
+		if err := app.Save(spaces); err != nil {
+			return err
+		}
+
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// Create space members collection
+		members := core.NewBaseCollection("space_members")
+
+		// Add fields
+		members.Fields.Add(
+			&core.RelationField{
+				Name:          "space",
+				Required:      true,
+				CollectionId:  spaces.Id,
+				MaxSelect:     1,
+				CascadeDelete: true,
+			},
+			&core.RelationField{
+				Name:          "user",
+				Required:      true,
+				CollectionId:  users.Id,
+				MaxSelect:     1,
+				CascadeDelete: true,
+			},
+			&core.SelectField{
+				Name:      "role",
+				Required:  true,
+				Values:    []string{"owner", "editor", "viewer"},
+				MaxSelect: 1,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+		)
+
+		// Add indexes
+		members.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_space_members_space_user ON space_members (space, user)",
+			"CREATE INDEX idx_space_members_user ON space_members (user)",
+		}
+
+		if err := app.Save(members); err != nil {
+			return err
+		}
+
+		// Wallets and categories outside any space stay shared by every user
+		for _, name := range []string{"wallets", "categories"} {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+
+			collection.Fields.Add(&core.RelationField{
+				Name:         "space",
+				Required:     false,
+				CollectionId: spaces.Id,
+				MaxSelect:    1,
+			})
+			collection.AddIndex("idx_"+name+"_space", false, "space", "")
+
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(app core.App) error {
+		for _, name := range []string{"wallets", "categories"} {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+
+			collection.RemoveIndex("idx_" + name + "_space")
+			collection.Fields.RemoveByName("space")
+
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+		}
+
+		// Get and delete the collections, members first as they reference spaces
+		for _, name := range []string{"space_members", "spaces"} {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+
+			if err := app.Delete(collection); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,87 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create append-only audit log collection
+		collection := core.NewBaseCollection("audit_log")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.SelectField{
+				Name:      "action",
+				Required:  true,
+				Values:    []string{"create", "update", "delete", "request"},
+				MaxSelect: 1,
+			},
+			&core.TextField{
+				Name:     "collection_name",
+				Required: false,
+				Max:      100,
+			},
+			&core.TextField{
+				Name:     "record",
+				Required: false,
+				Max:      50,
+			},
+			&core.TextField{
+				Name:     "request",
+				Required: false,
+				Max:      500,
+			},
+			&core.NumberField{
+				Name:     "status",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.TextField{
+				Name:     "actor",
+				Required: false,
+				Max:      50,
+			},
+			&core.SelectField{
+				Name:      "actor_type",
+				Required:  true,
+				Values:    []string{"user", "superuser", "system"},
+				MaxSelect: 1,
+			},
+			&core.SelectField{
+				Name:      "source",
+				Required:  true,
+				Values:    []string{"api", "hook", "import", "system"},
+				MaxSelect: 1,
+			},
+			&core.JSONField{
+				Name:     "changes",
+				Required: false,
+			},
+			&core.DateField{
+				Name:     "at",
+				Required: true,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE INDEX idx_audit_log_at ON audit_log (at)",
+			"CREATE INDEX idx_audit_log_record ON audit_log (collection_name, record)",
+			"CREATE INDEX idx_audit_log_actor ON audit_log (actor)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("audit_log")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
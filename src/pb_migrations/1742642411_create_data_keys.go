@@ -0,0 +1,85 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		spaces, err := app.FindCollectionByNameOrId("spaces")
+		if err != nil {
+			return err
+		}
+
+		// Create data keys collection. It has no API rules, so only superusers can access it.
+		collection := core.NewBaseCollection("data_keys")
+
+		// Add fields. Keys outlive their space: fields they encrypted stay readable
+		// after the space is deleted.
+		collection.Fields.Add(
+			&core.RelationField{
+				Name:         "space",
+				Required:     false,
+				CollectionId: spaces.Id,
+				MaxSelect:    1,
+			},
+			&core.TextField{
+				Name:     "key", // wrapped with the master key
+				Required: true,
+				Hidden:   true,
+			},
+			&core.BoolField{
+				Name: "active",
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+		)
+
+		collection.Indexes = []string{
+			"CREATE INDEX idx_data_keys_space_active ON data_keys (space, active)",
+		}
+
+		if err := app.Save(collection); err != nil {
+			return err
+		}
+
+		// Encrypted values are about a third longer than their plaintext
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+		if field, ok := transactions.Fields.GetByName("description").(*core.TextField); ok {
+			field.Max = 8000
+		}
+		if field, ok := transactions.Fields.GetByName("notes").(*core.TextField); ok {
+			field.Max = 16000
+		}
+
+		return app.Save(transactions)
+	}, func(app core.App) error {
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+		if field, ok := transactions.Fields.GetByName("description").(*core.TextField); ok {
+			field.Max = 0
+		}
+		if field, ok := transactions.Fields.GetByName("notes").(*core.TextField); ok {
+			field.Max = 10000
+		}
+		if err := app.Save(transactions); err != nil {
+			return err
+		}
+
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("data_keys")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
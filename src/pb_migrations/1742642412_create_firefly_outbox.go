@@ -0,0 +1,79 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		transactions, err := app.FindCollectionByNameOrId("transactions")
+		if err != nil {
+			return err
+		}
+
+		// Create Firefly delivery outbox collection. It has no API rules, so only superusers can access it.
+		collection := core.NewBaseCollection("firefly_outbox")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.RelationField{
+				Name:          "transaction",
+				Required:      true,
+				CollectionId:  transactions.Id,
+				CascadeDelete: true,
+				MaxSelect:     1,
+			},
+			&core.SelectField{
+				Name:      "status",
+				Required:  true,
+				MaxSelect: 1,
+				Values:    []string{"pending", "delivered", "discarded"},
+			},
+			&core.NumberField{
+				Name:     "attempts",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.TextField{
+				Name:     "last_error",
+				Required: false,
+			},
+			&core.TextField{
+				Name:     "firefly_id",
+				Required: false,
+				Max:      100,
+			},
+			&core.DateField{
+				Name:     "next_attempt_at",
+				Required: true,
+			},
+			&core.DateField{
+				Name:     "delivered_at",
+				Required: false,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_firefly_outbox_transaction ON firefly_outbox (transaction)",
+			"CREATE INDEX idx_firefly_outbox_due ON firefly_outbox (status, next_attempt_at)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("firefly_outbox")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,67 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create domain event outbox collection. It has no API rules, so only superusers can access it.
+		collection := core.NewBaseCollection("event_outbox")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.TextField{
+				Name:     "event_id",
+				Required: true,
+				Max:      100,
+			},
+			&core.TextField{
+				Name:     "type",
+				Required: true,
+				Max:      200,
+			},
+			&core.JSONField{
+				Name:     "payload",
+				Required: true,
+				MaxSize:  1024 * 1024,
+			},
+			&core.NumberField{
+				Name:     "attempts",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				OnlyInt:  true,
+			},
+			&core.TextField{
+				Name:     "last_error",
+				Required: false,
+			},
+			&core.DateField{
+				Name:     "published_at",
+				Required: false,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_event_outbox_event_id ON event_outbox (event_id)",
+			"CREATE INDEX idx_event_outbox_published_at ON event_outbox (published_at)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("event_outbox")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,47 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Identify the categories installed from a category pack and nest
+		// them under their parent
+		categories, err := app.FindCollectionByNameOrId("categories")
+		if err != nil {
+			return err
+		}
+
+		categories.Fields.Add(
+			&core.TextField{
+				Name:     "key",
+				Required: false,
+				Max:      100,
+			},
+			&core.RelationField{
+				Name:          "parent",
+				Required:      false,
+				CollectionId:  categories.Id,
+				CascadeDelete: false,
+				MaxSelect:     1,
+			},
+		)
+
+		categories.AddIndex("idx_categories_key", false, "key", "")
+
+		return app.Save(categories)
+	}, func(app core.App) error {
+		categories, err := app.FindCollectionByNameOrId("categories")
+		if err != nil {
+			return err
+		}
+
+		categories.RemoveIndex("idx_categories_key")
+		categories.Fields.RemoveByName("key")
+		categories.Fields.RemoveByName("parent")
+
+		return app.Save(categories)
+	})
+}
@@ -0,0 +1,99 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// Create user preferences collection. Users read and change their
+		// preferences through the custom API, so it has no API rules.
+		collection := core.NewBaseCollection("preferences")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.RelationField{
+				Name:          "user",
+				Required:      true,
+				CollectionId:  users.Id,
+				MaxSelect:     1,
+				CascadeDelete: true,
+			},
+			&core.TextField{
+				Name:     "base_currency",
+				Required: true,
+				Max:      10,
+			},
+			&core.NumberField{
+				Name:     "first_day_of_week",
+				Required: false,
+				Min:      types.Pointer(0.0),
+				Max:      types.Pointer(6.0),
+				OnlyInt:  true,
+			},
+			&core.RelationField{
+				Name:         "default_wallet",
+				Required:     false,
+				CollectionId: wallets.Id,
+				MaxSelect:    1,
+			},
+			&core.TextField{
+				Name:     "date_format",
+				Required: true,
+				Max:      20,
+			},
+			&core.BoolField{
+				Name: "notify_incidents",
+			},
+			&core.BoolField{
+				Name: "notify_subscriptions",
+			},
+			&core.NumberField{
+				Name:     "notify_large_transactions",
+				Required: false,
+				Min:      types.Pointer(0.0),
+			},
+			&core.NumberField{
+				Name:     "version",
+				Required: false,
+				OnlyInt:  true,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+			&core.AutodateField{
+				Name:     "updated",
+				OnCreate: true,
+				OnUpdate: true,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_preferences_user ON preferences (user)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("preferences")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
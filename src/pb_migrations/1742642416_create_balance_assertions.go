@@ -0,0 +1,138 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
+		// Create balance assertions collection
+		assertions := core.NewBaseCollection("balance_assertions")
+
+		// Add fields
+		assertions.Fields.Add(
+			&core.TextField{
+				Name:     "name",
+				Required: true,
+				Max:      200,
+			},
+			&core.TextField{
+				Name:     "source",
+				Required: true,
+				Max:      300,
+			},
+			&core.JSONField{
+				Name:     "compare",
+				Required: false,
+			},
+			&core.TextField{
+				Name:     "schedule",
+				Required: true,
+				Max:      100,
+			},
+			&core.NumberField{
+				Name:     "tolerance",
+				Required: false,
+				Min:      types.Pointer(0.0),
+			},
+			&core.BoolField{
+				Name: "enabled",
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+			&core.AutodateField{
+				Name:     "updated",
+				OnCreate: true,
+				OnUpdate: true,
+			},
+		)
+
+		// Add indexes
+		assertions.Indexes = []string{
+			"CREATE INDEX idx_balance_assertions_source ON balance_assertions (source)",
+		}
+
+		if err := app.Save(assertions); err != nil {
+			return err
+		}
+
+		// Create balance assertion results collection
+		results := core.NewBaseCollection("balance_assertion_results")
+
+		// Add fields
+		results.Fields.Add(
+			&core.RelationField{
+				Name:          "assertion",
+				Required:      true,
+				CollectionId:  assertions.Id,
+				MaxSelect:     1,
+				CascadeDelete: true,
+			},
+			&core.RelationField{
+				Name:          "wallet",
+				Required:      false,
+				CollectionId:  wallets.Id,
+				MaxSelect:     1,
+				CascadeDelete: true,
+			},
+			&core.DateField{
+				Name:     "checked_at",
+				Required: true,
+			},
+			&core.TextField{
+				Name:     "currency",
+				Required: false,
+				Max:      10,
+			},
+			&core.JSONField{
+				Name:     "balances",
+				Required: false,
+			},
+			&core.NumberField{
+				Name:     "drift",
+				Required: false,
+			},
+			&core.NumberField{
+				Name:     "tolerance",
+				Required: false,
+				Min:      types.Pointer(0.0),
+			},
+			&core.BoolField{
+				Name: "passed",
+			},
+			&core.TextField{
+				Name:     "error",
+				Required: false,
+			},
+		)
+
+		// Add indexes
+		results.Indexes = []string{
+			"CREATE INDEX idx_balance_assertion_results_assertion_checked_at ON balance_assertion_results (assertion, checked_at)",
+		}
+
+		return app.Save(results)
+	}, func(app core.App) error {
+		// Get and delete the collections, results first
+		for _, name := range []string{"balance_assertion_results", "balance_assertions"} {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+			if err := app.Delete(collection); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
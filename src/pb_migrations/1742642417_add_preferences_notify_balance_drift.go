@@ -0,0 +1,40 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Let users opt out of failed balance assertions
+		preferences, err := app.FindCollectionByNameOrId("preferences")
+		if err != nil {
+			return err
+		}
+
+		preferences.Fields.Add(
+			&core.BoolField{
+				Name: "notify_balance_drift",
+			},
+		)
+
+		if err := app.Save(preferences); err != nil {
+			return err
+		}
+
+		// Users who already stored preferences are alerted like about incidents
+		_, err = app.DB().Update("preferences", dbx.Params{"notify_balance_drift": dbx.NewExp("notify_incidents")}, nil).Execute()
+		return err
+	}, func(app core.App) error {
+		preferences, err := app.FindCollectionByNameOrId("preferences")
+		if err != nil {
+			return err
+		}
+
+		preferences.Fields.RemoveByName("notify_balance_drift")
+
+		return app.Save(preferences)
+	})
+}
@@ -0,0 +1,60 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		spaces, err := app.FindCollectionByNameOrId("spaces")
+		if err != nil {
+			return err
+		}
+
+		// Create maintenance collection. A record is a switch that is on; the
+		// one without a space freezes every space. It is changed through the
+		// custom API and the maintenance command, so it has no API rules.
+		collection := core.NewBaseCollection("maintenance")
+
+		// Add fields
+		collection.Fields.Add(
+			&core.RelationField{
+				Name:          "space",
+				Required:      false,
+				CollectionId:  spaces.Id,
+				MaxSelect:     1,
+				CascadeDelete: true,
+			},
+			&core.TextField{
+				Name:     "reason",
+				Required: false,
+				Max:      500,
+			},
+			&core.TextField{
+				Name:     "started_by",
+				Required: false,
+				Max:      200,
+			},
+			&core.DateField{
+				Name:     "started_at",
+				Required: true,
+			},
+		)
+
+		// Add indexes
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_maintenance_space ON maintenance (space)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		// Get and delete the collection
+		collection, err := app.FindCollectionByNameOrId("maintenance")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,44 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Persist the lifecycle state of every source next to its sync statistics
+		states, err := app.FindCollectionByNameOrId("source_states")
+		if err != nil {
+			return err
+		}
+
+		states.Fields.Add(
+			&core.SelectField{
+				Name:      "lifecycle",
+				Values:    []string{"unconfigured", "authorized", "active", "degraded", "disabled", "expired_consent"},
+				MaxSelect: 1,
+			},
+			&core.TextField{
+				Name: "lifecycle_reason",
+			},
+			&core.DateField{
+				Name: "transitioned_at",
+			},
+		)
+
+		// Sources stored before are resolved from their statistics when loaded
+		return app.Save(states)
+	}, func(app core.App) error {
+		states, err := app.FindCollectionByNameOrId("source_states")
+		if err != nil {
+			return err
+		}
+
+		states.Fields.RemoveByName("lifecycle")
+		states.Fields.RemoveByName("lifecycle_reason")
+		states.Fields.RemoveByName("transitioned_at")
+
+		return app.Save(states)
+	})
+}
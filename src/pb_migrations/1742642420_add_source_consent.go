@@ -0,0 +1,52 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Track when the consent of a source expires and the renewal sent to the user
+		states, err := app.FindCollectionByNameOrId("source_states")
+		if err != nil {
+			return err
+		}
+
+		states.Fields.Add(
+			&core.DateField{
+				Name: "consent_expires_at",
+			},
+			&core.SelectField{
+				Name:      "renewal",
+				Values:    []string{"pending", "completed"},
+				MaxSelect: 1,
+			},
+			&core.TextField{
+				Name: "renewal_url",
+			},
+			&core.TextField{
+				Name:   "renewal_token",
+				Hidden: true,
+			},
+			&core.DateField{
+				Name: "renewal_requested_at",
+			},
+		)
+
+		return app.Save(states)
+	}, func(app core.App) error {
+		states, err := app.FindCollectionByNameOrId("source_states")
+		if err != nil {
+			return err
+		}
+
+		states.Fields.RemoveByName("consent_expires_at")
+		states.Fields.RemoveByName("renewal")
+		states.Fields.RemoveByName("renewal_url")
+		states.Fields.RemoveByName("renewal_token")
+		states.Fields.RemoveByName("renewal_requested_at")
+
+		return app.Save(states)
+	})
+}
@@ -0,0 +1,40 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Let users opt out of consent renewal links
+		preferences, err := app.FindCollectionByNameOrId("preferences")
+		if err != nil {
+			return err
+		}
+
+		preferences.Fields.Add(
+			&core.BoolField{
+				Name: "notify_consent",
+			},
+		)
+
+		if err := app.Save(preferences); err != nil {
+			return err
+		}
+
+		// Users who already stored preferences get renewal links like incidents
+		_, err = app.DB().Update("preferences", dbx.Params{"notify_consent": dbx.NewExp("notify_incidents")}, nil).Execute()
+		return err
+	}, func(app core.App) error {
+		preferences, err := app.FindCollectionByNameOrId("preferences")
+		if err != nil {
+			return err
+		}
+
+		preferences.Fields.RemoveByName("notify_consent")
+
+		return app.Save(preferences)
+	})
+}
@@ -0,0 +1,71 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create the archive of raw provider payloads. It has no API rules, so only superusers can access it.
+		collection := core.NewBaseCollection("raw_payloads")
+
+		collection.Fields.Add(
+			&core.TextField{
+				Name:     "run_id",
+				Required: true,
+				Max:      100,
+			},
+			&core.TextField{
+				Name:     "source_id",
+				Required: true,
+				Max:      200,
+			},
+			&core.TextField{
+				Name: "method",
+				Max:  10,
+			},
+			&core.TextField{
+				Name: "url",
+				Max:  4000,
+			},
+			&core.NumberField{
+				Name:    "status",
+				Min:     types.Pointer(0.0),
+				OnlyInt: true,
+			},
+			&core.TextField{
+				// Bodies over archive.max_payload_bytes are not archived;
+				// compressed bodies are base64 encoded
+				Name: "body",
+				Max:  16 * 1024 * 1024,
+			},
+			&core.BoolField{
+				Name: "compressed",
+			},
+			&core.DateField{
+				Name:     "received_at",
+				Required: true,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+		)
+
+		collection.Indexes = []string{
+			"CREATE INDEX idx_raw_payloads_run ON raw_payloads (run_id, source_id)",
+			"CREATE INDEX idx_raw_payloads_received_at ON raw_payloads (received_at)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		collection, err := app.FindCollectionByNameOrId("raw_payloads")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
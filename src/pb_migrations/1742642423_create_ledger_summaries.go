@@ -0,0 +1,67 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
+		// Create the compacted import ledger: the provider IDs of purged
+		// transactions as bloom filters per wallet and date range. It has no
+		// API rules, so only superusers can access it.
+		collection := core.NewBaseCollection("ledger_summaries")
+
+		collection.Fields.Add(
+			&core.RelationField{
+				Name:          "wallet",
+				Required:      true,
+				CollectionId:  wallets.Id,
+				MaxSelect:     1,
+				CascadeDelete: true,
+			},
+			&core.DateField{
+				Name:     "from",
+				Required: true,
+			},
+			&core.DateField{
+				Name:     "to",
+				Required: true,
+			},
+			&core.NumberField{
+				Name:    "count",
+				Min:     types.Pointer(0.0),
+				OnlyInt: true,
+			},
+			&core.TextField{
+				// The base64 encoded filter, see models.BloomFilter
+				Name:     "filter",
+				Required: true,
+				Max:      16 * 1024 * 1024,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+		)
+
+		collection.Indexes = []string{
+			"CREATE INDEX idx_ledger_summaries_wallet ON ledger_summaries (wallet, `from`)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		collection, err := app.FindCollectionByNameOrId("ledger_summaries")
+		if err != nil {
+			return err
+		}
+
+		return app.Delete(collection)
+	})
+}
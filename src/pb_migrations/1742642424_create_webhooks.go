@@ -0,0 +1,130 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+	"github.com/pocketbase/pocketbase/tools/types"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Create the outbound webhooks. They receive the events of every
+		// space, so the collection has no API rules and only superusers can
+		// register webhooks.
+		webhooks := core.NewBaseCollection("webhooks")
+
+		webhooks.Fields.Add(
+			&core.URLField{
+				Name:     "url",
+				Required: true,
+			},
+			&core.TextField{
+				Name:     "secret", // HMAC key of the delivery signatures
+				Required: true,
+				Min:      16,
+				Hidden:   true,
+			},
+			&core.JSONField{
+				// Event types, e.g. ["transaction.created", "budget.*"], or ["*"]
+				Name:     "events",
+				Required: true,
+			},
+			&core.BoolField{
+				Name: "enabled",
+			},
+			&core.TextField{
+				Name: "description",
+				Max:  500,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+			&core.AutodateField{
+				Name:     "updated",
+				OnCreate: true,
+				OnUpdate: true,
+			},
+		)
+
+		if err := app.Save(webhooks); err != nil {
+			return err
+		}
+
+		// Create the webhook delivery queue. Deliveries are stored by the
+		// event hooks in the transaction of the change.
+		deliveries := core.NewBaseCollection("webhook_deliveries")
+
+		deliveries.Fields.Add(
+			&core.RelationField{
+				Name:          "webhook",
+				Required:      true,
+				CollectionId:  webhooks.Id,
+				CascadeDelete: true,
+				MaxSelect:     1,
+			},
+			&core.TextField{
+				Name:     "event_id",
+				Required: true,
+				Max:      100,
+			},
+			&core.TextField{
+				Name:     "event_type",
+				Required: true,
+				Max:      200,
+			},
+			&core.JSONField{
+				Name:     "payload",
+				Required: true,
+			},
+			&core.SelectField{
+				Name:      "status",
+				Required:  true,
+				MaxSelect: 1,
+				Values:    []string{"pending", "delivered", "failed"},
+			},
+			&core.NumberField{
+				Name:    "attempts",
+				Min:     types.Pointer(0.0),
+				OnlyInt: true,
+			},
+			&core.NumberField{
+				Name:    "response_status",
+				Min:     types.Pointer(0.0),
+				OnlyInt: true,
+			},
+			&core.TextField{
+				Name: "last_error",
+			},
+			&core.DateField{
+				Name:     "next_attempt_at",
+				Required: true,
+			},
+			&core.DateField{
+				Name: "delivered_at",
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+		)
+
+		deliveries.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_webhook_deliveries_event ON webhook_deliveries (webhook, event_id)",
+			"CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at)",
+		}
+
+		return app.Save(deliveries)
+	}, func(app core.App) error {
+		for _, name := range []string{"webhook_deliveries", "webhooks"} {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+			if err := app.Delete(collection); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
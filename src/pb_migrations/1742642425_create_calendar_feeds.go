@@ -0,0 +1,59 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// Create the calendar feed tokens, one per user. Users issue and
+		// revoke their token through the custom API, so the collection has
+		// no API rules; only the hash of the token is stored.
+		collection := core.NewBaseCollection("calendar_feeds")
+
+		collection.Fields.Add(
+			&core.RelationField{
+				Name:          "user",
+				Required:      true,
+				CollectionId:  users.Id,
+				CascadeDelete: true,
+				MaxSelect:     1,
+			},
+			&core.TextField{
+				Name:     "token_hash", // hex SHA-256 of the feed token
+				Required: true,
+				Min:      64,
+				Max:      64,
+				Hidden:   true,
+			},
+			&core.AutodateField{
+				Name:     "created",
+				OnCreate: true,
+			},
+			&core.AutodateField{
+				Name:     "updated",
+				OnCreate: true,
+				OnUpdate: true,
+			},
+		)
+
+		collection.Indexes = []string{
+			"CREATE UNIQUE INDEX idx_calendar_feeds_user ON calendar_feeds (user)",
+			"CREATE UNIQUE INDEX idx_calendar_feeds_token ON calendar_feeds (token_hash)",
+		}
+
+		return app.Save(collection)
+	}, func(app core.App) error {
+		collection, err := app.FindCollectionByNameOrId("calendar_feeds")
+		if err != nil {
+			return err
+		}
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,70 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+// timestampedCollections predate the created and updated fields the repositories read
+var timestampedCollections = []string{"wallets", "categories", "transactions"}
+
+func init() {
+	m.Register(func(app core.App) error {
+		for _, name := range timestampedCollections {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+
+			collection.Fields.Add(
+				&core.AutodateField{
+					Name:     "created",
+					OnCreate: true,
+				},
+				&core.AutodateField{
+					Name:     "updated",
+					OnCreate: true,
+					OnUpdate: true,
+				},
+			)
+
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+		}
+
+		// Tags were created after the record versions were added
+		tags, err := app.FindCollectionByNameOrId("tags")
+		if err != nil {
+			return err
+		}
+		tags.Fields.Add(&core.NumberField{
+			Name:     "version",
+			Required: false,
+			OnlyInt:  true,
+		})
+		return app.Save(tags)
+	}, func(app core.App) error {
+		tags, err := app.FindCollectionByNameOrId("tags")
+		if err != nil {
+			return err
+		}
+		tags.Fields.RemoveByName("version")
+		if err := app.Save(tags); err != nil {
+			return err
+		}
+
+		for _, name := range timestampedCollections {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+			collection.Fields.RemoveByName("created")
+			collection.Fields.RemoveByName("updated")
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
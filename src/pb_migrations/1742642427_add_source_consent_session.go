@@ -0,0 +1,32 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Keep the provider session a consent was renewed in across restarts
+		states, err := app.FindCollectionByNameOrId("source_states")
+		if err != nil {
+			return err
+		}
+
+		states.Fields.Add(&core.TextField{
+			Name:   "consent_session",
+			Hidden: true,
+		})
+
+		return app.Save(states)
+	}, func(app core.App) error {
+		states, err := app.FindCollectionByNameOrId("source_states")
+		if err != nil {
+			return err
+		}
+
+		states.Fields.RemoveByName("consent_session")
+
+		return app.Save(states)
+	})
+}
@@ -0,0 +1,54 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Store the balance a wallet starts with, which replaying its
+		// transactions starts from
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
+		wallets.Fields.Add(&core.NumberField{
+			Name: "opening_balance",
+		})
+		if err := app.Save(wallets); err != nil {
+			return err
+		}
+
+		// Existing wallets open at their stored balance less the effects of
+		// their transactions, so their stored balance replays unchanged
+		_, err = app.DB().NewQuery(`
+			UPDATE wallets SET opening_balance = COALESCE(balance, 0) - COALESCE((
+				SELECT SUM(CASE
+					WHEN t.wallet = wallets.id AND t.type = 'income' THEN t.amount - COALESCE(t.fee, 0)
+					WHEN t.wallet = wallets.id THEN -t.amount - COALESCE(t.fee, 0)
+					ELSE 0 END
+					+ CASE
+					WHEN t.type = 'transfer' AND t.destination_wallet = wallets.id
+						THEN t.amount * (CASE WHEN COALESCE(t.exchange_rate, 0) > 0 THEN t.exchange_rate ELSE 1 END)
+					ELSE 0 END)
+				FROM transactions t
+				WHERE (t.wallet = wallets.id OR t.destination_wallet = wallets.id)
+					AND (t.deleted_at IS NULL OR t.deleted_at = '')
+					AND t.status != {:failed}
+					AND t.type IN ('income', 'expense', 'transfer')
+			), 0)`).Bind(dbx.Params{"failed": "failed"}).Execute()
+		return err
+	}, func(app core.App) error {
+		wallets, err := app.FindCollectionByNameOrId("wallets")
+		if err != nil {
+			return err
+		}
+
+		wallets.Fields.RemoveByName("opening_balance")
+
+		return app.Save(wallets)
+	})
+}
@@ -0,0 +1,39 @@
+package pb_migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Store the currency of the wallet a subscription charges, which its
+		// amounts are in
+		subscriptions, err := app.FindCollectionByNameOrId("subscriptions")
+		if err != nil {
+			return err
+		}
+
+		subscriptions.Fields.Add(&core.TextField{
+			Name: "currency",
+		})
+		if err := app.Save(subscriptions); err != nil {
+			return err
+		}
+
+		_, err = app.DB().NewQuery(`
+			UPDATE subscriptions SET currency = COALESCE((
+				SELECT currency FROM wallets WHERE wallets.id = subscriptions.wallet
+			), '')`).Execute()
+		return err
+	}, func(app core.App) error {
+		subscriptions, err := app.FindCollectionByNameOrId("subscriptions")
+		if err != nil {
+			return err
+		}
+
+		subscriptions.Fields.RemoveByName("currency")
+
+		return app.Save(subscriptions)
+	})
+}
@@ -0,0 +1,110 @@
+package pb_migrations
+
+import (
+	"testing"
+
+	"github.com/ZanzyTHEbar/firedragon-go/adapters/repositories/pocketbase/schema"
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	_ "github.com/pocketbase/pocketbase/migrations" // system migrations, applied by Bootstrap
+)
+
+func newTestApp(t *testing.T) core.App {
+	t.Helper()
+	app := core.NewBaseApp(core.BaseAppConfig{DataDir: t.TempDir()})
+	if err := app.Bootstrap(); err != nil {
+		t.Fatalf("Bootstrap() error = %v", err)
+	}
+	t.Cleanup(func() { app.ResetBootstrapState() })
+	return app
+}
+
+// TestMigrations applies every registered migration to a fresh database and
+// checks the result against the schema snapshot the repositories are
+// generated from
+func TestMigrations(t *testing.T) {
+	app := newTestApp(t)
+	applied, err := core.NewMigrationsRunner(app, core.AppMigrations).Up()
+	if err != nil {
+		t.Fatalf("Up() error = %v, applied %v", err, applied)
+	}
+	if len(applied) != len(core.AppMigrations.Items()) {
+		t.Fatalf("applied %d migrations, want all %d", len(applied), len(core.AppMigrations.Items()))
+	}
+
+	drift, err := schema.Check(app)
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	for _, d := range drift {
+		t.Errorf("schema drift after the migrations: %s", d)
+	}
+
+	// The system categories keep their flag through the change to a bool
+	system, err := app.CountRecords("categories", dbx.HashExp{"is_system": true})
+	if err != nil {
+		t.Fatalf("failed to count system categories: %v", err)
+	}
+	if system == 0 {
+		t.Error("no system categories after the migrations")
+	}
+}
+
+// Existing wallets open at the balance that replays to their stored balance
+func TestMigrations_BackfillOpeningBalances(t *testing.T) {
+	app := newTestApp(t)
+	const file = "1742642428_add_wallet_opening_balance.go"
+	var before, backfill core.MigrationsList
+	for _, migration := range core.AppMigrations.Items() {
+		switch {
+		case migration.File < file:
+			before.Register(migration.Up, migration.Down, migration.File)
+		case migration.File == file:
+			backfill.Register(migration.Up, migration.Down, migration.File)
+		}
+	}
+	if _, err := core.NewMigrationsRunner(app, before).Up(); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+
+	wallets, _ := app.FindCollectionByNameOrId("wallets")
+	checking, savings := core.NewRecord(wallets), core.NewRecord(wallets)
+	checking.Load(map[string]any{"name": "Checking", "currency": "EUR", "type": "bank", "balance": 870})
+	savings.Load(map[string]any{"name": "Savings", "currency": "EUR", "type": "bank", "balance": 700})
+	for _, wallet := range []*core.Record{checking, savings} {
+		if err := app.Save(wallet); err != nil {
+			t.Fatalf("failed to create wallet: %v", err)
+		}
+	}
+	category, err := app.FindFirstRecordByFilter("categories", "")
+	if err != nil {
+		t.Fatalf("failed to find a category: %v", err)
+	}
+	transactions, _ := app.FindCollectionByNameOrId("transactions")
+	for _, values := range []map[string]any{
+		{"type": "income", "amount": 1000, "fee": 10, "status": "completed"},
+		{"type": "expense", "amount": 20, "status": "completed"},
+		{"type": "expense", "amount": 500, "status": "failed"},
+		{"type": "transfer", "amount": 100, "destination_wallet": savings.Id, "exchange_rate": 2, "status": "completed"},
+	} {
+		tx := core.NewRecord(transactions)
+		tx.Load(values)
+		tx.Load(map[string]any{"description": "test", "date": "2025-03-01 00:00:00Z", "wallet": checking.Id, "category": category.Id})
+		if err := app.Save(tx); err != nil {
+			t.Fatalf("failed to create transaction: %v", err)
+		}
+	}
+
+	if _, err := core.NewMigrationsRunner(app, backfill).Up(); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	for wallet, want := range map[*core.Record]float64{checking: 0, savings: 500} {
+		record, err := app.FindRecordById("wallets", wallet.Id)
+		if err != nil {
+			t.Fatalf("failed to read wallet: %v", err)
+		}
+		if got := record.GetFloat("opening_balance"); got != want {
+			t.Errorf("%s opening balance = %v, want %v", record.GetString("name"), got, want)
+		}
+	}
+}
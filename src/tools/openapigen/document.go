@@ -0,0 +1,328 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Document is an OpenAPI 3.1 document. Schemas avoid the features 3.1 added
+// so the generated client can be produced by tools still reading 3.0.
+type Document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       Info                  `json:"info"`
+	Paths      map[string]PathItem   `json:"paths"`
+	Components Components            `json:"components"`
+	Security   []map[string][]string `json:"security"`
+	Tags       []Tag                 `json:"tags,omitempty"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+}
+
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	In          string `json:"in"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem maps lowercase HTTP methods to operations
+type PathItem map[string]*Operation
+
+type Operation struct {
+	OperationID string                 `json:"operationId"`
+	Summary     string                 `json:"summary,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Tags        []string               `json:"tags,omitempty"`
+	Parameters  []Parameter            `json:"parameters,omitempty"`
+	RequestBody *RequestBody           `json:"requestBody,omitempty"`
+	Responses   map[string]*Response   `json:"responses"`
+	Security    *[]map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+	Example  any     `json:"example,omitempty"`
+}
+
+type RequestBody struct {
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required"`
+	Content     map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+}
+
+// problemContentType is the media type of the errors the routes respond with
+const problemContentType = "application/problem+json"
+
+// problemSchema is the RFC 7807 body the error middleware responds with to
+// the errors the handlers return
+var problemSchema = &Schema{
+	Type: "object",
+	Properties: map[string]*Schema{
+		"type":      {Type: "string"},
+		"title":     {Type: "string"},
+		"status":    {Type: "integer"},
+		"detail":    {Type: "string"},
+		"instance":  {Type: "string"},
+		"code":      {Type: "string"},
+		"requestId": {Type: "string"},
+		"errors": {Type: "array", Items: &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"field":   {Type: "string"},
+				"rule":    {Type: "string"},
+				"message": {Type: "string"},
+			},
+			Required: []string{"field", "rule", "message"},
+		}},
+	},
+	Required: []string{"type", "title", "status", "code"},
+}
+
+var pathParam = regexp.MustCompile(`\{(\w+)(\.\.\.)?\}`)
+
+// buildDocument describes the routes, which are mounted below prefix
+func buildDocument(routes []*route, prefix string) (*Document, error) {
+	schemas := newSchemaBuilder()
+	schemas.schemas["Problem"] = problemSchema
+
+	doc := &Document{
+		OpenAPI: "3.1.0",
+		Info: Info{
+			Title:   "FireDragon API",
+			Version: "1.0.0",
+			Description: "Custom routes of FireDragon. Requests are authenticated with the " +
+				"PocketBase token of a user or superuser; the collections themselves are " +
+				"served by the regular PocketBase API.",
+		},
+		Paths: make(map[string]PathItem),
+		Components: Components{
+			Schemas: schemas.schemas,
+			SecuritySchemes: map[string]SecurityScheme{
+				"pocketbase": {
+					Type:        "apiKey",
+					In:          "header",
+					Name:        "Authorization",
+					Description: "PocketBase auth token",
+				},
+			},
+		},
+		Security: []map[string][]string{{"pocketbase": {}}},
+	}
+
+	operationIDs := make(map[string]string)
+	tags := make(map[string]bool)
+	for _, r := range routes {
+		path := prefix + pathParam.ReplaceAllString(r.Path, "{$1}")
+		op := buildOperation(r, schemas)
+
+		if other, ok := operationIDs[op.OperationID]; ok {
+			return nil, fmt.Errorf("%s %s and %s share the operation ID %s", r.Method, path, other, op.OperationID)
+		}
+		operationIDs[op.OperationID] = r.Method + " " + path
+
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = make(PathItem)
+			doc.Paths[path] = item
+		}
+		item[strings.ToLower(r.Method)] = op
+
+		if !tags[r.Tag] {
+			tags[r.Tag] = true
+			doc.Tags = append(doc.Tags, Tag{Name: r.Tag})
+		}
+	}
+
+	return doc, nil
+}
+
+func buildOperation(r *route, schemas *schemaBuilder) *Operation {
+	op := &Operation{
+		OperationID: operationID(r.Method, r.Path),
+		Tags:        []string{r.Tag},
+		Responses:   make(map[string]*Response),
+	}
+
+	schemas.scope = op.OperationID
+
+	description := strings.Join(r.Description, " ")
+	op.Summary = summary(description)
+	if op.Summary == "" {
+		op.Summary = r.Method + " " + strings.SplitN(r.Path, "?", 2)[0]
+	}
+	if description != op.Summary && description != op.Summary+"." {
+		op.Description = description
+	}
+	if len(r.Signatures) > 1 {
+		op.Description = strings.TrimSpace(op.Description + "\n\nExamples:\n\n    " + strings.Join(r.Signatures, "\n    "))
+	}
+
+	for _, match := range pathParam.FindAllStringSubmatch(r.Path, -1) {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	for _, param := range r.Query {
+		parameter := Parameter{Name: param.Name, In: "query", Schema: &Schema{Type: "string"}}
+		switch {
+		case param.Example == "true" || param.Example == "false":
+			parameter.Schema.Type = "boolean"
+		case isInteger(param.Example):
+			parameter.Schema.Type = "integer"
+		case param.Example != "" && !strings.ContainsAny(param.Example, "{.<"):
+			parameter.Example = param.Example
+		}
+		op.Parameters = append(op.Parameters, parameter)
+	}
+
+	switch {
+	case r.Upload:
+		op.RequestBody = &RequestBody{
+			Description: "The file, as the request body or as the file field of a multipart form",
+			Required:    true,
+			Content: map[string]MediaType{
+				"application/octet-stream": {Schema: &Schema{Type: "string", Format: "binary"}},
+				"multipart/form-data": {Schema: &Schema{
+					Type:       "object",
+					Properties: map[string]*Schema{"file": {Type: "string", Format: "binary"}},
+					Required:   []string{"file"},
+				}},
+			},
+		}
+	case r.Body != nil:
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{"application/json": {Schema: schemas.schemaOf(r.Body)}},
+		}
+		if len(r.Examples) > 0 {
+			op.RequestBody.Description = "Example: `" + strings.Join(r.Examples, "` `") + "`"
+		}
+	}
+
+	for _, resp := range r.Responses {
+		code := strconv.Itoa(resp.Status)
+		out, ok := op.Responses[code]
+		if !ok {
+			out = &Response{Description: http.StatusText(resp.Status)}
+			op.Responses[code] = out
+		}
+		if resp.ContentType == "" {
+			continue
+		}
+		if out.Content == nil {
+			out.Content = make(map[string]MediaType)
+		}
+
+		var schema *Schema
+		switch {
+		case resp.Status >= 400:
+			schema = &Schema{Ref: "#/components/schemas/Problem"}
+		case resp.Type != nil:
+			schema = schemas.schemaOf(resp.Type)
+		case strings.Contains(resp.ContentType, "json"):
+			schema = &Schema{Type: "object"}
+		default:
+			schema = &Schema{Type: "string"}
+			if !strings.HasPrefix(resp.ContentType, "text/") {
+				schema.Format = "binary"
+			}
+		}
+
+		if existing, ok := out.Content[resp.ContentType]; ok {
+			if schema.Ref != "" && existing.Schema.Ref == schema.Ref {
+				continue
+			}
+			if existing.Schema.OneOf == nil {
+				existing.Schema = &Schema{OneOf: []*Schema{existing.Schema}}
+			}
+			existing.Schema.OneOf = append(existing.Schema.OneOf, schema)
+			out.Content[resp.ContentType] = existing
+			continue
+		}
+		out.Content[resp.ContentType] = MediaType{Schema: schema}
+	}
+	if len(op.Responses) == 0 {
+		op.Responses["200"] = &Response{Description: http.StatusText(http.StatusOK)}
+	}
+
+	if r.Public {
+		op.Security = &[]map[string][]string{}
+	}
+	return op
+}
+
+// operationID names an operation after its method and path, e.g.
+// POST /sources/{id}/sync becomes postSourcesByIdSync
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, segment := range strings.Split(path, "/") {
+		if match := pathParam.FindStringSubmatch(segment); match != nil {
+			b.WriteString("By")
+			segment = match[1]
+		}
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool { return r == '-' || r == '_' || r == '.' }) {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
+
+// summary returns the first sentence of a description
+func summary(description string) string {
+	for _, end := range []string{". ", "; "} {
+		if i := strings.Index(description, end); i >= 0 {
+			description = description[:i]
+		}
+	}
+	return strings.TrimSuffix(description, ".")
+}
+
+func isInteger(value string) bool {
+	_, err := strconv.Atoi(value)
+	return err == nil
+}
@@ -0,0 +1,53 @@
+// Command openapigen generates the OpenAPI document of the custom
+// /api/firedragon routes from the route registrations themselves: the comment
+// above each route documents its path, query parameters and purpose, and the
+// handler's calls tell the request body, responses and status codes.
+//
+// Run it through go generate in internal/pocketbase.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+func main() {
+	pkg := flag.String("pkg", "github.com/ZanzyTHEbar/firedragon-go/internal/pocketbase", "package registering the routes")
+	prefix := flag.String("prefix", "/api/firedragon", "path of the route group")
+	out := flag.String("out", "openapi.json", "file to write the document to")
+	flag.Parse()
+
+	data, err := generate(*pkg, *prefix)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the OpenAPI document of the routes registered by pkg
+func generate(pkg, prefix string) ([]byte, error) {
+	routes, err := loadRoutes(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := buildDocument(routes, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestDocumentUpToDate(t *testing.T) {
+	want, err := generate("github.com/ZanzyTHEbar/firedragon-go/internal/pocketbase", "/api/firedragon")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	got, err := os.ReadFile("../../internal/pocketbase/openapi.json")
+	if err != nil {
+		t.Fatalf("failed to read the served document: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("internal/pocketbase/openapi.json is stale; run go generate ./internal/pocketbase")
+	}
+}
+
+func TestOperationID(t *testing.T) {
+	tests := []struct {
+		method, path, want string
+	}{
+		{"GET", "/sources", "getSources"},
+		{"POST", "/sources/{id}/sync", "postSourcesByIdSync"},
+		{"GET", "/cost-basis/{year}", "getCostBasisByYear"},
+		{"GET", "/periods/{name...}", "getPeriodsByName"},
+		{"GET", "/openapi.json", "getOpenapiJson"},
+	}
+
+	for _, tt := range tests {
+		if got := operationID(tt.method, tt.path); got != tt.want {
+			t.Errorf("operationID(%s, %s) = %s, want %s", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestSummary(t *testing.T) {
+	tests := []struct {
+		description, want string
+	}{
+		{"Lists the staged budget imports, newest first", "Lists the staged budget imports, newest first"},
+		{"Runs a live smoke test. Step failures are reported in the body.", "Runs a live smoke test"},
+		{"Pulls the edits made in Firefly; conflicts are listed in the body", "Pulls the edits made in Firefly"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := summary(tt.description); got != tt.want {
+			t.Errorf("summary(%q) = %q, want %q", tt.description, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,374 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// route is a route registered on the route group, as read from its registration
+type route struct {
+	Method      string
+	Path        string // relative to the group, e.g. /sources/{id}/sync
+	Tag         string // named after the routes_<tag>.go file registering it
+	Signatures  []string
+	Description []string
+	Examples    []string // example request bodies
+	Public      bool     // the auth middleware is unbound
+	Query       []queryParam
+	Body        types.Type
+	Upload      bool
+	Responses   []response
+
+	contentType string // Content-Type header set by the handler
+}
+
+type queryParam struct {
+	Name    string
+	Example string
+}
+
+type response struct {
+	Status      int
+	ContentType string     // empty for responses without a body
+	Type        types.Type // nil for bodies that are not JSON
+}
+
+var (
+	routeMethods = map[string]bool{"GET": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true}
+	signature    = regexp.MustCompile(`^(GET|POST|PUT|PATCH|DELETE) (/\S*)`)
+
+	// errorHelpers are the core.RequestEvent methods that respond with an error status
+	errorHelpers = map[string]int{
+		"BadRequestError":      400,
+		"UnauthorizedError":    401,
+		"ForbiddenError":       403,
+		"NotFoundError":        404,
+		"TooManyRequestsError": 429,
+		"InternalServerError":  500,
+	}
+)
+
+// routeLoader collects the routes of one package
+type routeLoader struct {
+	pkg   *packages.Package
+	funcs map[*types.Func]*ast.FuncDecl
+}
+
+// loadRoutes type-checks pkg and returns the routes its register functions add
+// to a router group, in file order
+func loadRoutes(pattern string) ([]*route, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo |
+			packages.NeedImports | packages.NeedDeps,
+	}
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", pattern, err)
+	}
+	if packages.PrintErrors(pkgs) > 0 || len(pkgs) != 1 {
+		return nil, fmt.Errorf("failed to load %s", pattern)
+	}
+
+	loader := &routeLoader{pkg: pkgs[0], funcs: make(map[*types.Func]*ast.FuncDecl)}
+	files := make([]*ast.File, len(loader.pkg.Syntax))
+	copy(files, loader.pkg.Syntax)
+	sort.Slice(files, func(i, j int) bool { return loader.fileName(files[i]) < loader.fileName(files[j]) })
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+				if obj, ok := loader.pkg.TypesInfo.Defs[fn.Name].(*types.Func); ok {
+					loader.funcs[obj] = fn
+				}
+			}
+		}
+	}
+
+	var routes []*route
+	for _, file := range files {
+		routes = append(routes, loader.fileRoutes(file)...)
+	}
+	return routes, nil
+}
+
+func (l *routeLoader) fileName(file *ast.File) string {
+	return filepath.Base(l.pkg.Fset.Position(file.Pos()).Filename)
+}
+
+// fileRoutes returns the routes registered in a file
+func (l *routeLoader) fileRoutes(file *ast.File) []*route {
+	tag := strings.TrimSuffix(strings.TrimPrefix(l.fileName(file), "routes_"), ".go")
+
+	// Comments by the line they end on, to find the comment above a registration
+	comments := make(map[int]*ast.CommentGroup)
+	for _, group := range file.Comments {
+		comments[l.pkg.Fset.Position(group.End()).Line] = group
+	}
+
+	var routes []*route
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		// api.GET("/path", handler).Unbind(apis.DefaultRequireAuthMiddlewareId)
+		if sel.Sel.Name == "Unbind" {
+			if inner, ok := sel.X.(*ast.CallExpr); ok {
+				if r, ok := l.routeCall(inner, tag, comments); ok {
+					r.Public = true
+					routes = append(routes, r)
+					return false
+				}
+			}
+		}
+
+		if r, ok := l.routeCall(call, tag, comments); ok {
+			routes = append(routes, r)
+			return false
+		}
+		return true
+	})
+	return routes
+}
+
+// routeCall reads a route registration such as api.GET("/path", handler)
+func (l *routeLoader) routeCall(call *ast.CallExpr, tag string, comments map[int]*ast.CommentGroup) (*route, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !routeMethods[sel.Sel.Name] || len(call.Args) < 2 {
+		return nil, false
+	}
+	if receiver := l.pkg.TypesInfo.TypeOf(sel.X); receiver == nil || !strings.Contains(receiver.String(), "RouterGroup") {
+		return nil, false
+	}
+	path, ok := l.constString(call.Args[0])
+	if !ok {
+		return nil, false
+	}
+
+	r := &route{Method: sel.Sel.Name, Path: path, Tag: tag}
+	if group, ok := comments[l.pkg.Fset.Position(call.Pos()).Line-1]; ok {
+		r.readComment(group.Text())
+	}
+	if handler, ok := call.Args[1].(*ast.FuncLit); ok {
+		l.analyze(handler.Body, r, make(map[*ast.FuncDecl]bool))
+	}
+	return r, true
+}
+
+// readComment splits the comment above a route into the signature lines, the
+// example bodies and the description
+func (r *route) readComment(text string) {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case signature.MatchString(line):
+			r.Signatures = append(r.Signatures, line)
+			r.readQuery(signature.FindStringSubmatch(line)[2])
+		case strings.HasPrefix(line, "{") || strings.HasPrefix(line, "["):
+			r.Examples = append(r.Examples, line)
+		default:
+			r.Description = append(r.Description, line)
+		}
+	}
+}
+
+// readQuery adds the query parameters of a signature line. Parameters with a
+// dot are examples of a family, like meta.<key>, and only documented when the
+// handler reads them by name.
+func (r *route) readQuery(path string) {
+	_, query, ok := strings.Cut(path, "?")
+	if !ok {
+		return
+	}
+	for _, pair := range strings.Split(query, "&") {
+		name, example, _ := strings.Cut(pair, "=")
+		if name == "" || strings.Contains(name, ".") {
+			continue
+		}
+		r.addQuery(name, example)
+	}
+}
+
+func (r *route) addQuery(name, example string) {
+	for i := range r.Query {
+		if r.Query[i].Name == name {
+			if r.Query[i].Example == "" {
+				r.Query[i].Example = example
+			}
+			return
+		}
+	}
+	r.Query = append(r.Query, queryParam{Name: name, Example: example})
+}
+
+func (r *route) addResponse(status int, contentType string, typ types.Type) {
+	for _, existing := range r.Responses {
+		if existing.Status == status && existing.ContentType == contentType &&
+			(existing.Type == typ || (existing.Type != nil && typ != nil && types.Identical(existing.Type, typ))) {
+			return
+		}
+	}
+	r.Responses = append(r.Responses, response{Status: status, ContentType: contentType, Type: typ})
+}
+
+// analyze reads the parameters, body and responses of a handler, following
+// calls to functions of the package that respond on its behalf
+func (l *routeLoader) analyze(body ast.Node, r *route, visited map[*ast.FuncDecl]bool) {
+	info := l.pkg.TypesInfo
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			if obj, ok := info.Uses[fun].(*types.Func); ok {
+				if decl, ok := l.funcs[obj]; ok && !visited[decl] {
+					visited[decl] = true
+					// Headers a helper sets only apply to its own responses
+					contentType := r.contentType
+					l.analyze(decl.Body, r, visited)
+					r.contentType = contentType
+				}
+			}
+		case *ast.SelectorExpr:
+			receiver := info.TypeOf(fun.X)
+			if receiver == nil {
+				return true
+			}
+			l.analyzeMethod(call, fun.Sel.Name, receiver.String(), r)
+		}
+		return true
+	})
+}
+
+func (l *routeLoader) analyzeMethod(call *ast.CallExpr, method, receiver string, r *route) {
+	switch receiver {
+	case "net/url.Values":
+		if method == "Get" || method == "Has" {
+			if name, ok := l.constString(call.Args[0]); ok {
+				r.addQuery(name, "")
+			}
+		}
+
+	case "net/http.Header":
+		if method == "Set" && len(call.Args) == 2 {
+			key, _ := l.constString(call.Args[0])
+			if value, ok := l.constString(call.Args[1]); ok && strings.EqualFold(key, "Content-Type") {
+				r.contentType = value
+			}
+		}
+
+	case "net/http.ResponseWriter":
+		if method == "WriteHeader" {
+			if status, ok := l.constInt(call.Args[0]); ok {
+				contentType := r.contentType
+				if contentType == "" {
+					contentType = "application/octet-stream"
+				}
+				r.addResponse(status, contentType, nil)
+			}
+		}
+
+	case "*net/http.Request":
+		if method == "FormFile" {
+			r.Upload = true
+		}
+
+	case "*github.com/pocketbase/pocketbase/core.RequestEvent":
+		l.analyzeEvent(call, method, r)
+	}
+}
+
+// analyzeEvent reads the calls of the core.RequestEvent a handler receives
+func (l *routeLoader) analyzeEvent(call *ast.CallExpr, method string, r *route) {
+	if status, ok := errorHelpers[method]; ok {
+		r.addResponse(status, problemContentType, nil)
+		return
+	}
+
+	status, ok := 0, false
+	if len(call.Args) > 0 {
+		status, ok = l.constInt(call.Args[0])
+	}
+
+	switch method {
+	case "BindBody":
+		typ := l.pkg.TypesInfo.TypeOf(call.Args[0])
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		r.Body = typ
+	case "JSON":
+		if ok {
+			contentType := "application/json"
+			if strings.HasSuffix(r.contentType, "+json") {
+				contentType = r.contentType
+			}
+			r.addResponse(status, contentType, l.pkg.TypesInfo.TypeOf(call.Args[1]))
+		}
+	case "Blob":
+		if contentType, known := l.constString(call.Args[1]); ok && known {
+			r.addResponse(status, contentType, nil)
+		}
+	case "String", "HTML":
+		if ok {
+			contentType := r.contentType
+			if contentType == "" {
+				contentType = "text/plain"
+				if method == "HTML" {
+					contentType = "text/html"
+				}
+			}
+			r.addResponse(status, contentType, nil)
+		}
+	case "NoContent", "Redirect":
+		if ok {
+			r.addResponse(status, "", nil)
+		}
+	case "Error":
+		if ok {
+			r.addResponse(status, problemContentType, nil)
+		}
+	}
+}
+
+func (l *routeLoader) constValue(expr ast.Expr) constant.Value {
+	tv, ok := l.pkg.TypesInfo.Types[expr]
+	if !ok {
+		return nil
+	}
+	return tv.Value
+}
+
+func (l *routeLoader) constString(expr ast.Expr) (string, bool) {
+	value := l.constValue(expr)
+	if value == nil || value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(value), true
+}
+
+func (l *routeLoader) constInt(expr ast.Expr) (int, bool) {
+	value := l.constValue(expr)
+	if value == nil || value.Kind() != constant.Int {
+		return 0, false
+	}
+	n, exact := constant.Int64Val(value)
+	return int(n), exact
+}
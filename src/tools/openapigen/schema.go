@@ -0,0 +1,275 @@
+package main
+
+import (
+	"go/constant"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// schemaBuilder converts Go types into JSON schemas the way encoding/json
+// encodes them. Named structs and enumerations become components.
+type schemaBuilder struct {
+	schemas map[string]*Schema
+	names   map[string]string // qualified type name -> component name
+	scope   string            // operation whose types are built, to name types declared in handlers
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{
+		schemas: make(map[string]*Schema),
+		names:   make(map[string]string),
+	}
+}
+
+func (b *schemaBuilder) schemaOf(t types.Type) *Schema {
+	switch t := types.Unalias(t).(type) {
+	case *types.Pointer:
+		return b.schemaOf(t.Elem())
+	case *types.Named:
+		return b.namedSchema(t)
+	case *types.Basic:
+		return basicSchema(t)
+	case *types.Slice:
+		if isByte(t.Elem()) {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: b.schemaOf(t.Elem())}
+	case *types.Array:
+		return &Schema{Type: "array", Items: b.schemaOf(t.Elem())}
+	case *types.Map:
+		return &Schema{Type: "object", AdditionalProperties: b.schemaOf(t.Elem())}
+	case *types.Struct:
+		return b.structSchema(t)
+	}
+	// Interfaces hold any value; functions and channels are not encoded
+	return &Schema{}
+}
+
+func (b *schemaBuilder) namedSchema(t *types.Named) *Schema {
+	obj := t.Obj()
+	qualified := obj.Name()
+	if obj.Pkg() != nil {
+		qualified = obj.Pkg().Path() + "." + obj.Name()
+	}
+
+	switch qualified {
+	case "time.Time":
+		return &Schema{Type: "string", Format: "date-time"}
+	case "time.Duration":
+		return &Schema{Type: "integer", Format: "int64", Description: "nanoseconds"}
+	}
+
+	methods := types.NewMethodSet(types.NewPointer(t))
+	if methods.Lookup(nil, "MarshalJSON") != nil {
+		return &Schema{}
+	}
+	if methods.Lookup(nil, "MarshalText") != nil {
+		return &Schema{Type: "string"}
+	}
+
+	switch underlying := t.Underlying().(type) {
+	case *types.Struct:
+		return b.component(t, qualified, func() *Schema { return b.structSchema(underlying) })
+	case *types.Basic:
+		enum := enumValues(t)
+		if len(enum) == 0 {
+			return basicSchema(underlying)
+		}
+		return b.component(t, qualified, func() *Schema {
+			schema := basicSchema(underlying)
+			schema.Enum = enum
+			return schema
+		})
+	}
+	return b.schemaOf(t.Underlying())
+}
+
+// component returns a reference to the component of a named type, building it on first use
+func (b *schemaBuilder) component(t *types.Named, qualified string, build func() *Schema) *Schema {
+	local := isLocal(t)
+	if local {
+		// Types declared inside functions may share their name
+		qualified += "@" + strconv.Itoa(int(t.Obj().Pos()))
+	}
+
+	name, ok := b.names[qualified]
+	if !ok {
+		name = b.componentName(t, local)
+		b.names[qualified] = name
+		b.schemas[name] = &Schema{} // placeholder for recursive types
+		b.schemas[name] = build()
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// componentName picks an unused component name for a type, qualifying it
+// with its package when another type has the same name. Types declared in
+// handlers are named after their operation; as the generated client names
+// its response wrappers <operation>Response, they end in Result instead.
+func (b *schemaBuilder) componentName(t *types.Named, local bool) string {
+	base := exported(t.Obj().Name())
+	if local {
+		base = exported(b.scope) + strings.TrimSuffix(base, "Response")
+		if !strings.HasSuffix(base, "Result") {
+			base += "Result"
+		}
+	}
+	for _, arg := range typeArgs(t) {
+		base += "Of" + exported(arg)
+	}
+
+	candidates := []string{base}
+	if t.Obj().Pkg() != nil {
+		candidates = append(candidates, exported(t.Obj().Pkg().Name())+base)
+	}
+	for _, candidate := range candidates {
+		if _, taken := b.schemas[candidate]; !taken {
+			return candidate
+		}
+	}
+	for i := 2; ; i++ {
+		candidate := candidates[len(candidates)-1] + strconv.Itoa(i)
+		if _, taken := b.schemas[candidate]; !taken {
+			return candidate
+		}
+	}
+}
+
+func (b *schemaBuilder) structSchema(st *types.Struct) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	b.addFields(schema, st)
+	if len(schema.Properties) == 0 {
+		schema.Properties = nil
+	}
+	return schema
+}
+
+// addFields adds the encoded fields of a struct, inlining embedded structs
+func (b *schemaBuilder) addFields(schema *Schema, st *types.Struct) {
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		name, options, _ := strings.Cut(reflect.StructTag(st.Tag(i)).Get("json"), ",")
+		if name == "-" && options == "" {
+			continue
+		}
+
+		if field.Embedded() && name == "" {
+			embedded := field.Type()
+			if ptr, ok := embedded.(*types.Pointer); ok {
+				embedded = ptr.Elem()
+			}
+			if inner, ok := embedded.Underlying().(*types.Struct); ok {
+				b.addFields(schema, inner)
+				continue
+			}
+		}
+		if !field.Exported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name()
+		}
+		fieldSchema := b.schemaOf(field.Type())
+		if strings.Contains(","+options+",", ",string,") {
+			fieldSchema = &Schema{Type: "string"}
+		}
+		schema.Properties[name] = fieldSchema
+
+		optional := strings.Contains(","+options+",", ",omitempty,") || strings.Contains(","+options+",", ",omitzero,")
+		if !optional {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+func basicSchema(t *types.Basic) *Schema {
+	info := t.Info()
+	switch {
+	case info&types.IsBoolean != 0:
+		return &Schema{Type: "boolean"}
+	case info&types.IsInteger != 0:
+		if t.Kind() == types.Int64 || t.Kind() == types.Uint64 {
+			return &Schema{Type: "integer", Format: "int64"}
+		}
+		return &Schema{Type: "integer"}
+	case info&types.IsFloat != 0:
+		return &Schema{Type: "number", Format: "double"}
+	case info&types.IsString != 0:
+		return &Schema{Type: "string"}
+	}
+	return &Schema{}
+}
+
+// enumValues returns the values of the exported constants declared with a named type
+func enumValues(t *types.Named) []any {
+	pkg := t.Obj().Pkg()
+	if pkg == nil {
+		return nil
+	}
+
+	var values []any
+	seen := make(map[string]bool)
+	for _, name := range pkg.Scope().Names() {
+		c, ok := pkg.Scope().Lookup(name).(*types.Const)
+		if !ok || !c.Exported() || !types.Identical(c.Type(), t) || seen[c.Val().ExactString()] {
+			continue
+		}
+		seen[c.Val().ExactString()] = true
+
+		switch c.Val().Kind() {
+		case constant.String:
+			values = append(values, constant.StringVal(c.Val()))
+		case constant.Int:
+			n, _ := constant.Int64Val(c.Val())
+			values = append(values, n)
+		}
+	}
+
+	sort.Slice(values, func(i, j int) bool { return formatValue(values[i]) < formatValue(values[j]) })
+	return values
+}
+
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	}
+	return ""
+}
+
+func typeArgs(t *types.Named) []string {
+	var names []string
+	args := t.TypeArgs()
+	for i := 0; i < args.Len(); i++ {
+		if named, ok := args.At(i).(*types.Named); ok {
+			names = append(names, named.Obj().Name())
+		} else {
+			names = append(names, args.At(i).String())
+		}
+	}
+	return names
+}
+
+// isLocal reports whether a type is declared inside a function
+func isLocal(t *types.Named) bool {
+	obj := t.Obj()
+	return obj.Pkg() != nil && obj.Parent() != nil && obj.Parent() != obj.Pkg().Scope()
+}
+
+func isByte(t types.Type) bool {
+	basic, ok := t.(*types.Basic)
+	return ok && basic.Kind() == types.Byte
+}
+
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pocketbase/pocketbase/core"
+)
+
+// initialisms are the name parts written in upper case
+var initialisms = map[string]bool{"id": true, "url": true, "iban": true, "api": true, "json": true, "ip": true}
+
+// reserved are the methods and fields of the record proxy the accessors
+// must not shadow
+var reserved = func() map[string]bool {
+	names := map[string]bool{"Record": true, "BaseRecordProxy": true}
+	proxy := reflect.TypeOf(&core.BaseRecordProxy{})
+	for i := 0; i < proxy.NumMethod(); i++ {
+		names[proxy.Method(i).Name] = true
+	}
+	for _, field := range reflect.VisibleFields(proxy.Elem()) {
+		names[field.Name] = true
+	}
+	return names
+}()
+
+// exportCollection is a collection of the export. Only the options affecting the
+// accessors are decoded.
+type exportCollection struct {
+	Name   string        `json:"name"`
+	System bool          `json:"system"`
+	Fields []exportField `json:"fields"`
+}
+
+type exportField struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	OnlyInt   bool   `json:"onlyInt"`
+	MaxSelect int    `json:"maxSelect"`
+}
+
+// accessor is the Go side of a field type
+type accessor struct {
+	goType, getter string
+	readOnly       bool
+}
+
+// accessorFor returns the accessor of a field, false for fields without one
+func accessorFor(f exportField) (accessor, bool) {
+	switch f.Type {
+	case core.FieldTypeText, core.FieldTypeEmail, core.FieldTypeURL, core.FieldTypeEditor:
+		return accessor{goType: "string", getter: "GetString"}, true
+	case core.FieldTypeNumber:
+		if f.OnlyInt {
+			return accessor{goType: "int", getter: "GetInt"}, true
+		}
+		return accessor{goType: "float64", getter: "GetFloat"}, true
+	case core.FieldTypeBool:
+		return accessor{goType: "bool", getter: "GetBool"}, true
+	case core.FieldTypeDate:
+		return accessor{goType: "types.DateTime", getter: "GetDateTime"}, true
+	case core.FieldTypeAutodate:
+		return accessor{goType: "types.DateTime", getter: "GetDateTime", readOnly: true}, true
+	case core.FieldTypeSelect, core.FieldTypeRelation, core.FieldTypeFile:
+		// same as IsMultiple of the fields
+		if f.MaxSelect > 1 {
+			return accessor{goType: "[]string", getter: "GetStringSlice"}, true
+		}
+		return accessor{goType: "string", getter: "GetString"}, true
+	case core.FieldTypeJSON:
+		return accessor{goType: "any"}, true
+	}
+	return accessor{}, false
+}
+
+// goName converts a collection or field name to an exported identifier
+func goName(name string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' || r == ' ' }) {
+		if initialisms[part] {
+			b.WriteString(strings.ToUpper(part))
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+// generate returns the schema code for the non-system collections of a
+// collections export
+func generate(export []byte, pkg string) ([]byte, error) {
+	var all []exportCollection
+	if err := json.Unmarshal(export, &all); err != nil {
+		return nil, fmt.Errorf("invalid collections export: %w", err)
+	}
+	var collections []exportCollection
+	for _, c := range all {
+		if !c.System {
+			collections = append(collections, c)
+		}
+	}
+	sort.Slice(collections, func(i, j int) bool { return collections[i].Name < collections[j].Name })
+
+	declared := make(map[string]string)
+	declare := func(ident, what string) error {
+		if other, ok := declared[ident]; ok {
+			return fmt.Errorf("%s and %s both generate %s", other, what, ident)
+		}
+		declared[ident] = what
+		return nil
+	}
+
+	var code bytes.Buffer
+	code.WriteString("// Collection names\nconst (\n")
+	for _, collection := range collections {
+		ident := "Collection" + goName(collection.Name)
+		if err := declare(ident, "collection "+collection.Name); err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&code, "%s = %q\n", ident, collection.Name)
+	}
+	code.WriteString(")\n\n")
+
+	for _, collection := range collections {
+		typeName := goName(collection.Name)
+		if err := declare(typeName, "collection "+collection.Name); err != nil {
+			return nil, err
+		}
+		if err := declare("New"+typeName, "collection "+collection.Name); err != nil {
+			return nil, err
+		}
+
+		fmt.Fprintf(&code, "// Fields of the %s collection\nconst (\n", collection.Name)
+		for _, field := range collection.Fields {
+			ident := typeName + goName(field.Name)
+			if err := declare(ident, "field "+collection.Name+"."+field.Name); err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(&code, "%s = %q\n", ident, field.Name)
+		}
+		code.WriteString(")\n\n")
+
+		fmt.Fprintf(&code, "// %s is a typed record of the %s collection\ntype %s struct {\ncore.BaseRecordProxy\n}\n\n", typeName, collection.Name, typeName)
+		fmt.Fprintf(&code, "// New%s wraps a record of the %s collection\nfunc New%s(record *core.Record) *%s {\nr := &%s{}\nr.SetProxyRecord(record)\nreturn r\n}\n\n",
+			typeName, collection.Name, typeName, typeName, typeName)
+
+		for _, field := range collection.Fields {
+			writeAccessors(&code, typeName, field)
+		}
+	}
+
+	code.WriteString("// Snapshot is the schema the code was generated from\nvar Snapshot = []Collection{\n")
+	for _, collection := range collections {
+		fmt.Fprintf(&code, "{Name: %s, Fields: []Field{\n", "Collection"+goName(collection.Name))
+		for _, field := range collection.Fields {
+			fmt.Fprintf(&code, "{Name: %s, Type: %q},\n", goName(collection.Name)+goName(field.Name), field.Type)
+		}
+		code.WriteString("}},\n")
+	}
+	code.WriteString("}\n")
+
+	var file bytes.Buffer
+	fmt.Fprintf(&file, "// Code generated by schemagen. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	file.WriteString("import (\n\"github.com/pocketbase/pocketbase/core\"\n")
+	if bytes.Contains(code.Bytes(), []byte("types.DateTime")) {
+		file.WriteString("\"github.com/pocketbase/pocketbase/tools/types\"\n")
+	}
+	file.WriteString(")\n\n")
+	file.Write(code.Bytes())
+
+	formatted, err := format.Source(file.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format the generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+// writeAccessors writes the getter and setter of a field. The id and
+// password fields and fields shadowing the record proxy get none.
+func writeAccessors(code *bytes.Buffer, typeName string, field exportField) {
+	name := goName(field.Name)
+	if field.Name == core.FieldNameId || reserved[name] {
+		return
+	}
+	acc, ok := accessorFor(field)
+	if !ok {
+		return
+	}
+	constant := typeName + name
+
+	if acc.goType == "any" {
+		if reserved["Unmarshal"+name] {
+			return
+		}
+		fmt.Fprintf(code, "// Unmarshal%s decodes the %s field into v\nfunc (r *%s) Unmarshal%s(v any) error {\nreturn r.UnmarshalJSONField(%s, v)\n}\n\n",
+			name, field.Name, typeName, name, constant)
+	} else {
+		fmt.Fprintf(code, "// %s returns the %s field\nfunc (r *%s) %s() %s {\nreturn r.%s(%s)\n}\n\n",
+			name, field.Name, typeName, name, acc.goType, acc.getter, constant)
+	}
+	if acc.readOnly || reserved["Set"+name] {
+		return
+	}
+	fmt.Fprintf(code, "// Set%s sets the %s field\nfunc (r *%s) Set%s(v %s) {\nr.Set(%s, v)\n}\n\n",
+		name, field.Name, typeName, name, acc.goType, constant)
+}
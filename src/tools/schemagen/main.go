@@ -0,0 +1,94 @@
+// Command schemagen generates the schema package of the PocketBase
+// repositories: constants for the collection and field names, typed record
+// accessors and the snapshot the startup check compares databases with.
+//
+// It reads a collections export (-collections), the JSON the dashboard
+// exports and imports, or introspects the collections of a live data
+// directory (-dir). With -dir, -export also refreshes the committed export.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pocketbase/pocketbase/core"
+	_ "github.com/pocketbase/pocketbase/migrations" // system collections, applied by Bootstrap
+)
+
+func main() {
+	dir := flag.String("dir", "", "PocketBase data directory to introspect")
+	collections := flag.String("collections", "", "collections export to read instead of a data directory")
+	export := flag.String("export", "", "file to write the collections export of -dir to")
+	pkg := flag.String("pkg", "schema", "package of the generated file")
+	out := flag.String("out", "schema.gen.go", "file to write the generated code to")
+	flag.Parse()
+
+	if (*dir == "") == (*collections == "") {
+		log.Fatal("either -dir or -collections is required")
+	}
+
+	var data []byte
+	var err error
+	if *dir != "" {
+		data, err = exportDir(*dir)
+	} else {
+		data, err = os.ReadFile(*collections)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	code, err := generate(data, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	if *dir != "" && *export != "" {
+		if err := os.WriteFile(*export, data, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// exportDir returns the collections export of a data directory, without the
+// system collections
+func exportDir(dir string) ([]byte, error) {
+	if _, err := os.Stat(filepath.Join(dir, "data.db")); err != nil {
+		return nil, fmt.Errorf("%s is not a PocketBase data directory: %w", dir, err)
+	}
+	app := core.NewBaseApp(core.BaseAppConfig{DataDir: dir})
+	if err := app.Bootstrap(); err != nil {
+		return nil, err
+	}
+	defer app.ResetBootstrapState()
+
+	return exportCollections(app)
+}
+
+// exportCollections marshals the non-system collections of app by name
+func exportCollections(app core.App) ([]byte, error) {
+	all, err := app.FindAllCollections()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	var collections []*core.Collection
+	for _, collection := range all {
+		if !collection.System {
+			collections = append(collections, collection)
+		}
+	}
+	sort.Slice(collections, func(i, j int) bool { return collections[i].Name < collections[j].Name })
+
+	data, err := json.MarshalIndent(collections, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
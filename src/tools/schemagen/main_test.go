@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pocketbase/pocketbase/core"
+)
+
+func TestSchemaUpToDate(t *testing.T) {
+	export, err := os.ReadFile("../../pb_schema.json")
+	if err != nil {
+		t.Fatalf("failed to read the collections export: %v", err)
+	}
+	want, err := generate(export, "schema")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	got, err := os.ReadFile("../../adapters/repositories/pocketbase/schema/schema.gen.go")
+	if err != nil {
+		t.Fatalf("failed to read the generated schema: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("schema.gen.go is stale; run go generate ./adapters/repositories/pocketbase/schema")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	app := core.NewBaseApp(core.BaseAppConfig{DataDir: t.TempDir()})
+	if err := app.Bootstrap(); err != nil {
+		t.Fatalf("Bootstrap() error = %v", err)
+	}
+	defer app.ResetBootstrapState()
+
+	wallets := core.NewBaseCollection("wallets")
+	wallets.Fields.Add(
+		&core.TextField{Name: "name"},
+		&core.NumberField{Name: "version", OnlyInt: true},
+		&core.JSONField{Name: "metadata"},
+		&core.SelectField{Name: "labels", Values: []string{"a", "b"}, MaxSelect: 2},
+		&core.TextField{Name: "collection"},
+		&core.AutodateField{Name: "created", OnCreate: true},
+	)
+	if err := app.Save(wallets); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	export, err := exportCollections(app)
+	if err != nil {
+		t.Fatalf("exportCollections() error = %v", err)
+	}
+	code, err := generate(export, "schema")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	for _, want := range []string{
+		`CollectionWallets = "wallets"`,
+		`WalletsName       = "name"`,
+		"func (r *Wallets) Version() int",
+		"func (r *Wallets) UnmarshalMetadata(v any) error",
+		"func (r *Wallets) Labels() []string",
+		"func (r *Wallets) Created() types.DateTime",
+		`{Name: WalletsVersion, Type: "number"}`,
+	} {
+		if !bytes.Contains(code, []byte(want)) {
+			t.Errorf("generated code is missing %q", want)
+		}
+	}
+	for _, unwanted := range []string{
+		"func (r *Wallets) SetCreated",   // autodate
+		"func (r *Wallets) Collection()", // shadows the record
+		"func (r *Wallets) ID()",         // the record has Id
+	} {
+		if bytes.Contains(code, []byte(unwanted)) {
+			t.Errorf("generated code contains %q", unwanted)
+		}
+	}
+}
+
+func TestGenerate_DuplicateIdentifier(t *testing.T) {
+	export := `[{"name": "wallet", "fields": [{"name": "ab", "type": "text"}, {"name": "Ab", "type": "text"}]}]`
+
+	_, err := generate([]byte(export), "schema")
+	if err == nil || !strings.Contains(err.Error(), "WalletAb") {
+		t.Errorf("generate() error = %v, want a duplicate WalletAb", err)
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"destination_wallet", "DestinationWallet"},
+		{"firefly_account_id", "FireflyAccountID"},
+		{"end_to_end_id", "EndToEndID"},
+		{"iban", "IBAN"},
+		{"audit_log", "AuditLog"},
+	}
+
+	for _, tt := range tests {
+		if got := goName(tt.name); got != tt.want {
+			t.Errorf("goName(%s) = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}